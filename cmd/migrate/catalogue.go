@@ -0,0 +1,62 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/Jiruu246/rms/internal/authz"
+	"github.com/Jiruu246/rms/internal/blobs"
+	"github.com/Jiruu246/rms/internal/dto"
+	"github.com/Jiruu246/rms/internal/ent"
+	"github.com/Jiruu246/rms/internal/repos"
+	"github.com/Jiruu246/rms/internal/services"
+	"github.com/google/uuid"
+)
+
+// importCatalogue upserts the catalogue file at path into a restaurant, the
+// same as POST /api/restaurants/{id}/catalogue but run as an admin. Files
+// ending in .csv are read as CSV, anything else as JSON. The report of what
+// changed, or would have on a dry run, is printed as JSON.
+func importCatalogue(ctx context.Context, client *ent.Client, blobStore blobs.Store, restaurantID, path string, dryRun bool) error {
+	id, err := uuid.Parse(restaurantID)
+	if err != nil {
+		return fmt.Errorf("invalid restaurant ID %q: %w", restaurantID, err)
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		return fmt.Errorf("failed to open catalogue: %w", err)
+	}
+	defer file.Close()
+
+	var catalogue *dto.Catalogue
+	if strings.EqualFold(filepath.Ext(path), ".csv") {
+		catalogue, err = services.ReadCatalogueCSV(file)
+		if err != nil {
+			return err
+		}
+	} else {
+		catalogue = &dto.Catalogue{}
+		if err := json.NewDecoder(file).Decode(catalogue); err != nil {
+			return fmt.Errorf("invalid catalogue JSON: %w", err)
+		}
+	}
+
+	restaurantService := services.NewRestaurantService(repos.NewEntRestaurantRepository(client), blobStore)
+	catalogueService := services.NewCatalogueService(repos.NewEntCatalogueRepository(client), restaurantService, blobStore)
+	report, err := catalogueService.Import(ctx, authz.Actor{Role: authz.RoleAdmin}, id, catalogue, dryRun)
+	if err != nil {
+		return err
+	}
+
+	out, err := json.MarshalIndent(report, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to write report: %w", err)
+	}
+	fmt.Println(string(out))
+	return nil
+}
@@ -0,0 +1,126 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+
+	"github.com/Jiruu246/rms/pkg/hours"
+	"github.com/google/uuid"
+)
+
+// legacyHours is the old free-form operating_hours shape: one open/close
+// pair per lower-case weekday, e.g. {"monday": {"open": "09:00", "close": "22:00"}}.
+type legacyHours map[string]struct {
+	Open  string `json:"open"`
+	Close string `json:"close"`
+}
+
+// convertHours rewrites every restaurant's operating_hours into the typed
+// hours.Schedule shape. Values that already are a valid schedule are left
+// alone and legacy one-interval-per-day objects are converted; anything
+// else is cleared (the restaurant is then treated as always open) and
+// logged so it can be re-entered. It runs in a single transaction and is
+// safe to run more than once.
+//
+// Run it after upgrading and before restaurants are read: values that
+// don't unmarshal into hours.Schedule make reading the restaurant fail.
+func convertHours(ctx context.Context, db *sql.DB) error {
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func() {
+		if err := tx.Rollback(); err != nil && err != sql.ErrTxDone {
+			log.Printf("failed to rollback transaction: %v", err)
+		}
+	}()
+
+	rows, err := tx.QueryContext(ctx, "SELECT id, operating_hours FROM restaurants WHERE operating_hours IS NOT NULL")
+	if err != nil {
+		return fmt.Errorf("failed to read operating hours: %w", err)
+	}
+	type row struct {
+		id  uuid.UUID
+		raw []byte
+	}
+	var all []row
+	for rows.Next() {
+		var r row
+		if err := rows.Scan(&r.id, &r.raw); err != nil {
+			rows.Close()
+			return fmt.Errorf("failed to scan operating hours: %w", err)
+		}
+		all = append(all, r)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("failed to read operating hours: %w", err)
+	}
+
+	var kept, converted, cleared int
+	for _, r := range all {
+		schedule, err := parseHours(r.raw)
+		if err == nil && schedule == nil {
+			kept++
+			continue
+		}
+		var value any
+		if err != nil {
+			log.Printf("  ⚠️  restaurant %s: clearing operating hours %s: %v", r.id, r.raw, err)
+			cleared++
+		} else {
+			b, err := json.Marshal(schedule)
+			if err != nil {
+				return fmt.Errorf("failed to encode operating hours of restaurant %s: %w", r.id, err)
+			}
+			value = b
+			converted++
+		}
+		if _, err := tx.ExecContext(ctx, "UPDATE restaurants SET operating_hours = $1 WHERE id = $2", value, r.id); err != nil {
+			return fmt.Errorf("failed to update operating hours of restaurant %s: %w", r.id, err)
+		}
+	}
+	log.Printf("  ✅ restaurants.operating_hours: %d kept, %d converted, %d cleared", kept, converted, cleared)
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return nil
+}
+
+// parseHours returns nil when raw already is a valid hours.Schedule, the
+// converted schedule when it is in the legacy shape, and an error when it
+// is neither.
+func parseHours(raw []byte) (*hours.Schedule, error) {
+	var schedule hours.Schedule
+	if err := json.Unmarshal(raw, &schedule); err == nil {
+		return nil, schedule.Validate()
+	}
+
+	var legacy legacyHours
+	if err := json.Unmarshal(raw, &legacy); err != nil {
+		return nil, errors.New("unrecognised format")
+	}
+	intervals := make(map[string][]hours.Interval, len(legacy))
+	for day, iv := range legacy {
+		if iv.Open == "" && iv.Close == "" {
+			continue
+		}
+		intervals[day] = []hours.Interval{{Open: iv.Open, Close: iv.Close}}
+	}
+	b, err := json.Marshal(intervals)
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(b, &schedule); err != nil {
+		return nil, err
+	}
+	if err := schedule.Validate(); err != nil {
+		return nil, err
+	}
+	return &schedule, nil
+}
@@ -0,0 +1,85 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log"
+
+	"github.com/Jiruu246/rms/internal/ent"
+)
+
+// legacyItemModifierLinks are the columns that used to link a modifier to
+// a single menu item, or a menu item to a single modifier, before modifiers
+// were attached through menu_item_modifiers. Each query yields
+// (menu_item_id, modifier_id) pairs.
+var legacyItemModifierLinks = []struct {
+	table  string
+	column string
+	pairs  string
+}{
+	{
+		table: "modifiers", column: "menu_item_modifiers",
+		pairs: "SELECT t.menu_item_modifiers, t.id FROM modifiers t WHERE t.menu_item_modifiers IS NOT NULL",
+	},
+	{
+		table: "menu_items", column: "modifier_menu_items",
+		pairs: "SELECT t.id, t.modifier_menu_items FROM menu_items t WHERE t.modifier_menu_items IS NOT NULL",
+	},
+}
+
+// convertItemModifiers creates menu_item_modifiers and copies the links held
+// in the legacy columns into it, skipping links that already exist, so it
+// is safe to run more than once. The schema change it makes drops nothing.
+//
+// It must run before `apply`, which drops the legacy columns.
+func convertItemModifiers(ctx context.Context, client *ent.Client, db *sql.DB) error {
+	if err := client.Schema.Create(ctx); err != nil {
+		return fmt.Errorf("failed to create menu_item_modifiers: %w", err)
+	}
+
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func() {
+		if err := tx.Rollback(); err != nil && err != sql.ErrTxDone {
+			log.Printf("failed to rollback transaction: %v", err)
+		}
+	}()
+
+	for _, link := range legacyItemModifierLinks {
+		var exists bool
+		err := tx.QueryRowContext(ctx,
+			`SELECT EXISTS (SELECT 1 FROM information_schema.columns
+			 WHERE table_schema = current_schema() AND table_name = $1 AND column_name = $2)`,
+			link.table, link.column,
+		).Scan(&exists)
+		if err != nil {
+			return fmt.Errorf("failed to inspect %s.%s: %w", link.table, link.column, err)
+		}
+		if !exists {
+			log.Printf("  ⏭️  %s.%s does not exist, skipping", link.table, link.column)
+			continue
+		}
+
+		insert := fmt.Sprintf(
+			`INSERT INTO menu_item_modifiers (id, update_time, display_order, menu_item_id, modifier_id)
+			 SELECT gen_random_uuid(), now(), 0, pairs.menu_item_id, pairs.modifier_id
+			 FROM (%s) AS pairs (menu_item_id, modifier_id)
+			 ON CONFLICT (menu_item_id, modifier_id) DO NOTHING`,
+			link.pairs,
+		)
+		res, err := tx.ExecContext(ctx, insert)
+		if err != nil {
+			return fmt.Errorf("failed to copy links from %s.%s: %w", link.table, link.column, err)
+		}
+		rows, _ := res.RowsAffected()
+		log.Printf("  ✅ %s.%s: copied %d links", link.table, link.column, rows)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return nil
+}
@@ -14,6 +14,7 @@ import (
 	_ "github.com/jackc/pgx/v5/stdlib"
 	"github.com/joho/godotenv"
 
+	"github.com/Jiruu246/rms/internal/blobs"
 	"github.com/Jiruu246/rms/internal/config"
 	"github.com/Jiruu246/rms/internal/ent"
 	"github.com/Jiruu246/rms/internal/ent/migrate"
@@ -26,7 +27,10 @@ func main() {
 	}
 
 	var (
-		flags = flag.NewFlagSet("migrate", flag.ExitOnError)
+		flags        = flag.NewFlagSet("migrate", flag.ExitOnError)
+		restaurantID = flags.String("restaurant", "", "restaurant ID (import-catalogue)")
+		file         = flags.String("file", "", "catalogue file, .json or .csv (import-catalogue)")
+		dryRun       = flags.Bool("dry-run", false, "report what would change without saving it (import-catalogue)")
 	)
 	flags.Usage = usage
 	if err := flags.Parse(os.Args[2:]); err != nil {
@@ -96,6 +100,37 @@ func main() {
 		}
 		fmt.Println("✅ Database seeding completed successfully")
 
+	case "convert-money":
+		if err := convertMoney(ctx, db); err != nil {
+			log.Fatalf("money conversion failed: %v", err)
+		}
+		fmt.Println("✅ Money conversion completed successfully")
+
+	case "convert-hours":
+		if err := convertHours(ctx, db); err != nil {
+			log.Fatalf("operating hours conversion failed: %v", err)
+		}
+		fmt.Println("✅ Operating hours conversion completed successfully")
+
+	case "convert-item-modifiers":
+		if err := convertItemModifiers(ctx, client, db); err != nil {
+			log.Fatalf("item modifier conversion failed: %v", err)
+		}
+		fmt.Println("✅ Item modifier conversion completed successfully")
+
+	case "import-catalogue":
+		if *restaurantID == "" || *file == "" {
+			log.Fatal("-restaurant and -file are required for import-catalogue command")
+		}
+		if err := importCatalogue(ctx, client, blobs.New(cfg.BlobConfig), *restaurantID, *file, *dryRun); err != nil {
+			log.Fatalf("catalogue import failed: %v", err)
+		}
+		if *dryRun {
+			fmt.Println("✅ Catalogue dry run completed successfully, nothing was saved")
+		} else {
+			fmt.Println("✅ Catalogue import completed successfully")
+		}
+
 	case "create":
 		if len(flags.Args()) == 0 {
 			log.Fatal("migration name is required for create command")
@@ -194,5 +229,11 @@ Commands:
   reset    		Drop all tables and recreate schema (destructive!)
   seed    		Populate database with initial sample data
   create NAME  	Create a new migration file with given name
+  convert-money	Convert float money columns to integer minor units (run before apply)
+  convert-hours	Convert operating hours to the weekly schedule format (run after upgrading)
+  convert-item-modifiers
+  		Copy menu item modifier links into menu_item_modifiers (run before apply)
+  import-catalogue -restaurant ID -file FILE [-dry-run]
+  		Upsert a catalogue export (.json or .csv) into a restaurant
 `, os.Args[0])
 }
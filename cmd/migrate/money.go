@@ -0,0 +1,165 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+
+	"github.com/Jiruu246/rms/pkg/money"
+)
+
+// moneyColumn is a column that used to hold a float amount in major units
+// (12.99) and now holds integer minor units (1299).
+type moneyColumn struct {
+	table  string
+	column string
+	// from is an optional FROM/WHERE clause joining the table (aliased t)
+	// to the row that carries its currency.
+	from string
+	// currency is the SQL expression yielding the row's ISO 4217 code.
+	currency string
+}
+
+var moneyColumns = []moneyColumn{
+	{
+		table: "menu_items", column: "price",
+		from:     "FROM restaurants r WHERE t.restaurant_id = r.id",
+		currency: "r.currency",
+	},
+	{
+		table: "modifier_options", column: "price",
+		from:     "FROM modifiers m JOIN restaurants r ON r.id = m.restaurant_id WHERE t.modifier_id = m.id",
+		currency: "r.currency",
+	},
+	{table: "orders", column: "subtotal", currency: "t.currency"},
+	{table: "orders", column: "modifiers_total", currency: "t.currency"},
+	{table: "orders", column: "tax_total", currency: "t.currency"},
+	{table: "orders", column: "total", currency: "t.currency"},
+	{
+		table: "order_items", column: "item_price",
+		from:     "FROM orders o WHERE t.order_id = o.id",
+		currency: "o.currency",
+	},
+	{
+		table: "order_items", column: "modifiers_total",
+		from:     "FROM orders o WHERE t.order_id = o.id",
+		currency: "o.currency",
+	},
+	{
+		table: "order_items", column: "line_total",
+		from:     "FROM orders o WHERE t.order_id = o.id",
+		currency: "o.currency",
+	},
+	{
+		table: "order_item_modifier_options", column: "option_price",
+		from:     "FROM order_items oi JOIN orders o ON o.id = oi.order_id WHERE t.order_item_id = oi.id",
+		currency: "o.currency",
+	},
+}
+
+// convertMoney rewrites every float money column as bigint minor units of
+// the owning restaurant's currency, and snapshots that currency onto orders.
+// It runs in a single transaction and skips columns that are already
+// converted (or don't exist yet), so it is safe to run more than once.
+//
+// It must run before `apply`: ent would otherwise change the column type
+// itself and truncate 12.99 to 12.
+func convertMoney(ctx context.Context, db *sql.DB) error {
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func() {
+		if err := tx.Rollback(); err != nil && err != sql.ErrTxDone {
+			log.Printf("failed to rollback transaction: %v", err)
+		}
+	}()
+
+	if err := snapshotOrderCurrency(ctx, tx); err != nil {
+		return err
+	}
+
+	for _, col := range moneyColumns {
+		var dataType string
+		err := tx.QueryRowContext(ctx,
+			`SELECT data_type FROM information_schema.columns
+			 WHERE table_schema = current_schema() AND table_name = $1 AND column_name = $2`,
+			col.table, col.column,
+		).Scan(&dataType)
+		if err == sql.ErrNoRows {
+			log.Printf("  ⏭️  %s.%s does not exist, skipping", col.table, col.column)
+			continue
+		}
+		if err != nil {
+			return fmt.Errorf("failed to inspect %s.%s: %w", col.table, col.column, err)
+		}
+		if dataType != "double precision" {
+			log.Printf("  ⏭️  %s.%s is already %s, skipping", col.table, col.column, dataType)
+			continue
+		}
+
+		update := fmt.Sprintf(
+			"UPDATE %s t SET %s = round(t.%s * power(10, %s)) %s",
+			col.table, col.column, col.column, exponentSQL(col.currency), col.from,
+		)
+		res, err := tx.ExecContext(ctx, update)
+		if err != nil {
+			return fmt.Errorf("failed to convert %s.%s: %w", col.table, col.column, err)
+		}
+
+		alter := fmt.Sprintf(
+			"ALTER TABLE %s ALTER COLUMN %s TYPE bigint USING round(%s)::bigint",
+			col.table, col.column, col.column,
+		)
+		if _, err := tx.ExecContext(ctx, alter); err != nil {
+			return fmt.Errorf("failed to change type of %s.%s: %w", col.table, col.column, err)
+		}
+
+		rows, _ := res.RowsAffected()
+		log.Printf("  ✅ %s.%s: converted %d rows", col.table, col.column, rows)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return nil
+}
+
+// snapshotOrderCurrency adds orders.currency if needed and fills it from the
+// restaurant for any order that doesn't have one yet.
+func snapshotOrderCurrency(ctx context.Context, tx *sql.Tx) error {
+	stmts := []string{
+		"ALTER TABLE orders ADD COLUMN IF NOT EXISTS currency character varying",
+		`UPDATE orders t SET currency = r.currency
+		 FROM restaurants r WHERE t.restaurant_id = r.id AND t.currency IS NULL`,
+		"ALTER TABLE orders ALTER COLUMN currency SET NOT NULL",
+	}
+	for _, stmt := range stmts {
+		if _, err := tx.ExecContext(ctx, stmt); err != nil {
+			return fmt.Errorf("failed to snapshot order currency: %w", err)
+		}
+	}
+	return nil
+}
+
+// exponentSQL returns a CASE expression mapping the currency code produced
+// by expr to its minor-unit exponent, mirroring money.Currency.Exponent.
+func exponentSQL(expr string) string {
+	exponents := money.Exponents()
+	codes := make([]string, 0, len(exponents))
+	for c := range exponents {
+		codes = append(codes, string(c))
+	}
+	sort.Strings(codes)
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "CASE upper(%s)", expr)
+	for _, c := range codes {
+		fmt.Fprintf(&b, " WHEN '%s' THEN %d", c, exponents[money.Currency(c)])
+	}
+	fmt.Fprintf(&b, " ELSE %d END", money.DefaultExponent())
+	return b.String()
+}
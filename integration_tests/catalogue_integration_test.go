@@ -0,0 +1,170 @@
+package integration_tests
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/Jiruu246/rms/internal/dto"
+	"github.com/Jiruu246/rms/internal/ent/category"
+	"github.com/Jiruu246/rms/internal/ent/menuitem"
+	"github.com/Jiruu246/rms/pkg/utils"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+)
+
+type CatalogueTestSuite struct {
+	IntegrationTestSuite
+}
+
+func TestCatalogueTestSuite(t *testing.T) {
+	suite.Run(t, new(CatalogueTestSuite))
+}
+
+func (s *CatalogueTestSuite) send(userID uuid.UUID, method, path, contentType string, body io.Reader) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(method, path, body)
+	req.Header.Set("Content-Type", contentType)
+	w := httptest.NewRecorder()
+	s.CreateServerWithMiddleware(middlewareForUser(userID)).Engine().ServeHTTP(w, req)
+	return w
+}
+
+func (s *CatalogueTestSuite) importJSON(userID, restaurantID uuid.UUID, c *dto.Catalogue, dryRun bool) *httptest.ResponseRecorder {
+	b, err := json.Marshal(c)
+	s.Require().NoError(err)
+	path := fmt.Sprintf("/api/restaurants/%s/catalogue?dry_run=%t", restaurantID, dryRun)
+	return s.send(userID, http.MethodPost, path, "application/json", bytes.NewReader(b))
+}
+
+func (s *CatalogueTestSuite) report(w *httptest.ResponseRecorder) dto.CatalogueImportReport {
+	s.Require().Equal(http.StatusOK, w.Code, w.Body.String())
+	var response utils.APIResponse[dto.CatalogueImportReport]
+	s.Require().NoError(json.Unmarshal(w.Body.Bytes(), &response))
+	return response.Data
+}
+
+func (s *CatalogueTestSuite) TestImportAndExport() {
+	ctx := s.T().Context()
+	restaurant, err := SetupRestaurant(s.client, ctx)
+	s.Require().NoError(err)
+	owner := restaurant.UserID
+	existing, err := CreateMenuItemForRestaurant(s.client, ctx, restaurant)
+	s.Require().NoError(err)
+
+	catalogue := &dto.Catalogue{
+		Version:    dto.CatalogueVersion,
+		Categories: []dto.CatalogueCategory{{Name: "Mains", DisplayOrder: 1}},
+		Modifiers: []dto.CatalogueModifier{{
+			Name: "Size",
+			Max:  1,
+			Options: []dto.CatalogueModifierOption{
+				{Name: "Small"},
+				{Name: "Large", Price: 250},
+			},
+		}},
+		Items: []dto.CatalogueItem{
+			{Name: existing.Name, Description: existing.Description, Price: 1099, Category: "Mains", Modifiers: []string{"Size"}},
+			{Name: "Fries", Price: 450, Category: "Mains"},
+		},
+	}
+
+	// A dry run reports the changes without saving them.
+	report := s.report(s.importJSON(owner, restaurant.ID, catalogue, true))
+	s.True(report.DryRun)
+	s.Equal(5, report.Created)
+	s.Equal(1, report.Updated)
+	for _, change := range report.Changes {
+		if change.Action == dto.CatalogueUPDATE {
+			s.Equal(dto.CatalogueITEM, change.Kind)
+			s.Equal([]string{"price", "category", "modifiers"}, change.Fields)
+		} else {
+			s.Empty(change.ID)
+		}
+	}
+	n, err := s.client.Category.Query().Where(category.RestaurantIDEQ(restaurant.ID)).Count(ctx)
+	s.Require().NoError(err)
+	s.Zero(n)
+	item, err := s.client.MenuItem.Get(ctx, existing.ID)
+	s.Require().NoError(err)
+	s.Equal(int64(999), item.Price)
+
+	report = s.report(s.importJSON(owner, restaurant.ID, catalogue, false))
+	s.False(report.DryRun)
+	s.Equal(5, report.Created)
+	s.Equal(1, report.Updated)
+	item, err = s.client.MenuItem.Query().
+		Where(menuitem.IDEQ(existing.ID)).
+		WithCategory().
+		WithModifiers().
+		Only(ctx)
+	s.Require().NoError(err)
+	s.Equal(int64(1099), item.Price)
+	s.Equal("Mains", item.Edges.Category.Name)
+	s.Require().Len(item.Edges.Modifiers, 1)
+	s.Equal("Size", item.Edges.Modifiers[0].Name)
+
+	// Importing the same file again changes nothing.
+	report = s.report(s.importJSON(owner, restaurant.ID, catalogue, false))
+	s.Zero(report.Created)
+	s.Zero(report.Updated)
+	s.Equal(6, report.Unchanged)
+
+	// Nor does importing an export of it, as JSON or CSV.
+	exportPath := fmt.Sprintf("/api/restaurants/%s/catalogue", restaurant.ID)
+	w := s.send(owner, http.MethodGet, exportPath, "", nil)
+	s.Require().Equal(http.StatusOK, w.Code, w.Body.String())
+	var exported dto.Catalogue
+	s.Require().NoError(json.Unmarshal(w.Body.Bytes(), &exported))
+	s.Equal(restaurant.Currency, exported.Currency)
+	s.Len(exported.Items, 2)
+	report = s.report(s.importJSON(owner, restaurant.ID, &exported, false))
+	s.Zero(report.Created)
+	s.Zero(report.Updated)
+
+	w = s.send(owner, http.MethodGet, exportPath+"?format=csv", "", nil)
+	s.Require().Equal(http.StatusOK, w.Code, w.Body.String())
+	s.Contains(w.Header().Get("Content-Type"), "text/csv")
+	w = s.send(owner, http.MethodPost, exportPath+"?dry_run=true", "text/csv", bytes.NewReader(w.Body.Bytes()))
+	report = s.report(w)
+	s.Zero(report.Created)
+	s.Zero(report.Updated)
+	s.Equal(6, report.Unchanged)
+
+	// A failing entry rolls the whole import back.
+	catalogue.Categories = append(catalogue.Categories, dto.CatalogueCategory{Name: "Desserts"})
+	catalogue.Items = append(catalogue.Items, dto.CatalogueItem{ID: new(int64), Name: "Ghost", Price: 100})
+	w = s.importJSON(owner, restaurant.ID, catalogue, false)
+	s.Equal(http.StatusBadRequest, w.Code, w.Body.String())
+	exists, err := s.client.Category.Query().Where(category.NameEQ("Desserts")).Exist(ctx)
+	s.Require().NoError(err)
+	s.False(exists)
+}
+
+func (s *CatalogueTestSuite) TestOtherRestaurants() {
+	ctx := s.T().Context()
+	restaurant, err := SetupRestaurant(s.client, ctx)
+	s.Require().NoError(err)
+	other, err := SetupRestaurant(s.client, ctx)
+	s.Require().NoError(err)
+	otherItem, err := CreateMenuItemForRestaurant(s.client, ctx, other)
+	s.Require().NoError(err)
+
+	// Another restaurant's owner can neither export nor import.
+	w := s.send(other.UserID, http.MethodGet, fmt.Sprintf("/api/restaurants/%s/catalogue", restaurant.ID), "", nil)
+	s.Equal(http.StatusNotFound, w.Code, w.Body.String())
+	w = s.importJSON(other.UserID, restaurant.ID, &dto.Catalogue{}, true)
+	s.Equal(http.StatusNotFound, w.Code, w.Body.String())
+
+	// Nor can an import reach another restaurant's rows by ID.
+	w = s.importJSON(restaurant.UserID, restaurant.ID, &dto.Catalogue{
+		Items: []dto.CatalogueItem{{ID: &otherItem.ID, Name: "Stolen", Price: 1}},
+	}, false)
+	s.Equal(http.StatusBadRequest, w.Code, w.Body.String())
+	item, err := s.client.MenuItem.Get(ctx, otherItem.ID)
+	s.Require().NoError(err)
+	s.Equal(otherItem.Name, item.Name)
+}
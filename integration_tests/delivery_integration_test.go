@@ -0,0 +1,98 @@
+package integration_tests
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/Jiruu246/rms/internal/dto"
+	"github.com/Jiruu246/rms/internal/handler"
+	"github.com/Jiruu246/rms/pkg/utils"
+	"github.com/stretchr/testify/suite"
+)
+
+type DeliveryTestSuite struct {
+	IntegrationTestSuite
+}
+
+func TestDeliveryTestSuite(t *testing.T) {
+	suite.Run(t, new(DeliveryTestSuite))
+}
+
+func (s *DeliveryTestSuite) TestDeliveryOrders() {
+	ctx := s.T().Context()
+	restaurant, err := SetupRestaurant(s.client, ctx)
+	s.Require().NoError(err)
+	owner := restaurant.UserID
+	restaurant, err = restaurant.Update().SetLatitude(40.7128).SetLongitude(-74.0060).Save(ctx)
+	s.Require().NoError(err)
+	item, err := CreateMenuItemForRestaurant(s.client, ctx, restaurant)
+	s.Require().NoError(err)
+
+	radius := 2_000
+	w := s.SendJSON(owner, http.MethodPost, "/api/delivery-zones", dto.CreateDeliveryZoneRequest{
+		Name:          "Nearby",
+		Kind:          string(dto.DeliveryZoneKindRADIUS),
+		RadiusMeters:  &radius,
+		DeliveryFee:   250,
+		MinOrderValue: 1_500,
+		RestaurantID:  restaurant.ID,
+	})
+	s.Require().Equal(http.StatusCreated, w.Code, w.Body.String())
+	var created utils.APIResponse[dto.DeliveryZone]
+	s.Require().NoError(json.Unmarshal(w.Body.Bytes(), &created))
+	zone := created.Data
+
+	order := func(quantity int, lat float64, delivery bool) *httptest.ResponseRecorder {
+		req := handler.CreateOrderSchema{
+			OrderType:    dto.OrderTypeDELIVERY,
+			RestaurantID: restaurant.ID,
+			OrderItems:   []handler.OrderItemSchema{{MenuItemID: item.ID, Quantity: quantity}},
+		}
+		if delivery {
+			req.Delivery = &handler.DeliverySchema{
+				Address:      "1 Main St",
+				Latitude:     lat,
+				Longitude:    -74.0060,
+				ContactName:  "Sam",
+				ContactPhone: "555-0100",
+			}
+		}
+		return s.SendJSON(owner, http.MethodPost, "/api/orders", req)
+	}
+
+	s.Run("InZone", func() {
+		// About 1.1 km north of the restaurant.
+		w := order(2, 40.7228, true)
+		s.Require().Equal(http.StatusCreated, w.Code, w.Body.String())
+		var response utils.APIResponse[dto.Order]
+		s.Require().NoError(json.Unmarshal(w.Body.Bytes(), &response))
+		ord := response.Data
+		s.Require().NotNil(ord.Delivery)
+		s.Equal("1 Main St", ord.Delivery.Address)
+		s.Equal(zone.ID, *ord.Delivery.ZoneID)
+		s.Equal(int64(250), ord.DeliveryFee.Amount)
+		s.Equal(ord.Subtotal.Amount+ord.TaxTotal.Amount+250, ord.Total.Amount)
+	})
+
+	s.Run("OutOfZone", func() {
+		// About 11 km north.
+		s.Equal(http.StatusBadRequest, order(2, 40.8128, true).Code)
+	})
+
+	s.Run("UnderMinimum", func() {
+		s.Equal(http.StatusBadRequest, order(1, 40.7228, true).Code)
+	})
+
+	s.Run("MissingDetails", func() {
+		s.Equal(http.StatusBadRequest, order(2, 0, false).Code)
+	})
+
+	s.Run("InactiveZone", func() {
+		inactive := false
+		w := s.SendJSON(owner, http.MethodPatch, "/api/delivery-zones/"+zone.ID.String(), dto.UpdateDeliveryZoneRequest{IsActive: &inactive})
+		s.Require().Equal(http.StatusOK, w.Code, w.Body.String())
+		s.Equal(http.StatusBadRequest, order(2, 40.7228, true).Code)
+	})
+}
@@ -0,0 +1,217 @@
+package integration_tests
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/Jiruu246/rms/internal/dto"
+	"github.com/Jiruu246/rms/internal/ent/order"
+	"github.com/Jiruu246/rms/internal/handler"
+	"github.com/Jiruu246/rms/internal/middlewares"
+	"github.com/Jiruu246/rms/internal/repos"
+	"github.com/Jiruu246/rms/pkg/utils"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+)
+
+type IdempotencyTestSuite struct {
+	IntegrationTestSuite
+}
+
+func TestIdempotencyTestSuite(t *testing.T) {
+	suite.Run(t, new(IdempotencyTestSuite))
+}
+
+func (s *IdempotencyTestSuite) post(key string, body any) *httptest.ResponseRecorder {
+	req := s.NewJSONRequest(http.MethodPost, "/api/public/order", body)
+	if key != "" {
+		req.Header.Set(middlewares.IdempotencyKeyHeader, key)
+	}
+	w := httptest.NewRecorder()
+	s.CreateServer().Engine().ServeHTTP(w, req)
+	return w
+}
+
+func (s *IdempotencyTestSuite) TestOrderCreation() {
+	ctx := s.T().Context()
+	restaurant, err := SetupRestaurant(s.client, ctx)
+	s.Require().NoError(err)
+	item, err := CreateMenuItemForRestaurant(s.client, ctx, restaurant)
+	s.Require().NoError(err)
+	body := handler.CreateOrderSchema{
+		OrderType:    dto.OrderTypeTAKEOUT,
+		RestaurantID: restaurant.ID,
+		OrderItems:   []handler.OrderItemSchema{{MenuItemID: item.ID, Quantity: 1}},
+	}
+	countOrders := func() int {
+		n, err := s.client.Order.Query().Where(order.RestaurantID(restaurant.ID)).Count(ctx)
+		s.Require().NoError(err)
+		return n
+	}
+
+	key := uuid.NewString()
+	first := s.post(key, body)
+	s.Require().Equal(http.StatusCreated, first.Code, first.Body.String())
+
+	s.Run("RetryIsReplayed", func() {
+		retry := s.post(key, body)
+		s.Equal(http.StatusCreated, retry.Code)
+		s.Equal("true", retry.Header().Get(middlewares.IdempotentReplayedHeader))
+		s.Equal(first.Body.String(), retry.Body.String())
+		s.Equal(1, countOrders())
+	})
+
+	s.Run("KeyReusedForOtherRequest", func() {
+		other := body
+		other.OrderItems = []handler.OrderItemSchema{{MenuItemID: item.ID, Quantity: 2}}
+		w := s.post(key, other)
+		s.Equal(http.StatusBadRequest, w.Code)
+		s.Equal(1, countOrders())
+	})
+
+	s.Run("KeysAreUniquePerRestaurant", func() {
+		otherRestaurant, err := SetupRestaurant(s.client, ctx)
+		s.Require().NoError(err)
+		otherItem, err := CreateMenuItemForRestaurant(s.client, ctx, otherRestaurant)
+		s.Require().NoError(err)
+		w := s.post(key, handler.CreateOrderSchema{
+			OrderType:    dto.OrderTypeTAKEOUT,
+			RestaurantID: otherRestaurant.ID,
+			OrderItems:   []handler.OrderItemSchema{{MenuItemID: otherItem.ID, Quantity: 1}},
+		})
+		s.Equal(http.StatusCreated, w.Code, w.Body.String())
+		s.Empty(w.Header().Get(middlewares.IdempotentReplayedHeader))
+	})
+
+	s.Run("WithoutKey", func() {
+		s.Equal(http.StatusCreated, s.post("", body).Code)
+		s.Equal(http.StatusCreated, s.post("", body).Code)
+		s.Equal(3, countOrders())
+	})
+
+	s.Run("StillBeingProcessed", func() {
+		// Another server has claimed the key and not finished yet.
+		inFlight := uuid.NewString()
+		_, err := s.client.IdempotencyKey.Create().
+			SetScope(restaurant.ID.String()).
+			SetKey(inFlight).
+			SetFingerprint("in-flight").
+			SetExpiresAt(time.Now().Add(time.Minute)).
+			Save(ctx)
+		s.Require().NoError(err)
+
+		w := s.post(inFlight, body)
+		s.Equal(http.StatusConflict, w.Code)
+		s.NotEmpty(w.Header().Get("Retry-After"))
+	})
+
+	s.Run("ClientErrorsAreReplayed", func() {
+		failing := uuid.NewString()
+		invalid := body
+		invalid.OrderItems = []handler.OrderItemSchema{{MenuItemID: -1, Quantity: 1}}
+		w := s.post(failing, invalid)
+		s.Require().Equal(http.StatusBadRequest, w.Code)
+		retry := s.post(failing, invalid)
+		s.Equal(http.StatusBadRequest, retry.Code)
+		s.Equal("true", retry.Header().Get(middlewares.IdempotentReplayedHeader))
+	})
+
+	s.Run("ExpiredKeysAreDeleted", func() {
+		n, err := repos.NewEntIdempotencyRepository(s.client).DeleteExpired(ctx, time.Now().Add(48*time.Hour))
+		s.Require().NoError(err)
+		s.GreaterOrEqual(n, 1)
+
+		var response utils.APIResponse[dto.Order]
+		w := s.post(key, body)
+		s.Require().Equal(http.StatusCreated, w.Code)
+		s.Require().NoError(json.Unmarshal(w.Body.Bytes(), &response))
+		s.NotEqual(first.Body.String(), w.Body.String())
+		s.Empty(w.Header().Get(middlewares.IdempotentReplayedHeader))
+	})
+}
+
+// Orders sent with only a table token are scoped by the table's restaurant.
+func (s *IdempotencyTestSuite) TestOrderCreation_TableToken() {
+	ctx := s.T().Context()
+	orderAtTable := func() (handler.CreateOrderSchema, uuid.UUID) {
+		restaurant, err := SetupRestaurant(s.client, ctx)
+		s.Require().NoError(err)
+		item, err := CreateMenuItemForRestaurant(s.client, ctx, restaurant)
+		s.Require().NoError(err)
+		w := s.SendJSON(restaurant.UserID, http.MethodPost, "/api/tables", dto.CreateTableRequest{Name: "T1", Seats: 2, RestaurantID: restaurant.ID})
+		s.Require().Equal(http.StatusCreated, w.Code, w.Body.String())
+		var table utils.APIResponse[dto.Table]
+		s.Require().NoError(json.Unmarshal(w.Body.Bytes(), &table))
+		return handler.CreateOrderSchema{
+			OrderType:  dto.OrderTypeDINE_IN,
+			TableToken: table.Data.QRToken,
+			OrderItems: []handler.OrderItemSchema{{MenuItemID: item.ID, Quantity: 1}},
+		}, restaurant.ID
+	}
+	body, restaurantID := orderAtTable()
+
+	key := uuid.NewString()
+	first := s.post(key, body)
+	s.Require().Equal(http.StatusCreated, first.Code, first.Body.String())
+
+	retry := s.post(key, body)
+	s.Equal(http.StatusCreated, retry.Code)
+	s.Equal("true", retry.Header().Get(middlewares.IdempotentReplayedHeader))
+
+	s.Run("SameRestaurantByID", func() {
+		byID := body
+		byID.TableToken = ""
+		byID.OrderType = dto.OrderTypeTAKEOUT
+		byID.RestaurantID = restaurantID
+		s.Equal(http.StatusBadRequest, s.post(key, byID).Code)
+	})
+
+	s.Run("OtherRestaurant", func() {
+		other, _ := orderAtTable()
+		w := s.post(key, other)
+		s.Equal(http.StatusCreated, w.Code, w.Body.String())
+		s.Empty(w.Header().Get(middlewares.IdempotentReplayedHeader))
+	})
+}
+
+// Keys sent to authenticated routes are unique per user, so a user who
+// reuses another's key and body gets their own request authorized instead of
+// the stored response.
+func (s *IdempotencyTestSuite) TestOrderCreation_Authenticated() {
+	ctx := s.T().Context()
+	restaurant, err := SetupRestaurant(s.client, ctx)
+	s.Require().NoError(err)
+	item, err := CreateMenuItemForRestaurant(s.client, ctx, restaurant)
+	s.Require().NoError(err)
+	stranger, err := SetupUser(s.client, ctx)
+	s.Require().NoError(err)
+	body := handler.CreateOrderSchema{
+		OrderType:    dto.OrderTypeTAKEOUT,
+		RestaurantID: restaurant.ID,
+		OrderItems:   []handler.OrderItemSchema{{MenuItemID: item.ID, Quantity: 1}},
+	}
+	key := uuid.NewString()
+	post := func(userID uuid.UUID) *httptest.ResponseRecorder {
+		req := s.NewJSONRequest(http.MethodPost, "/api/orders", body)
+		req.Header.Set(middlewares.IdempotencyKeyHeader, key)
+		w := httptest.NewRecorder()
+		s.CreateServerWithMiddleware(middlewareForUser(userID)).Engine().ServeHTTP(w, req)
+		return w
+	}
+
+	first := post(restaurant.UserID)
+	s.Require().Equal(http.StatusCreated, first.Code, first.Body.String())
+
+	// ErrForbidden renders as 404, as for a missing restaurant.
+	w := post(stranger.ID)
+	s.Equal(http.StatusNotFound, w.Code, w.Body.String())
+	s.Empty(w.Header().Get(middlewares.IdempotentReplayedHeader))
+
+	retry := post(restaurant.UserID)
+	s.Equal(http.StatusCreated, retry.Code)
+	s.Equal("true", retry.Header().Get(middlewares.IdempotentReplayedHeader))
+	s.Equal(first.Body.String(), retry.Body.String())
+}
@@ -0,0 +1,237 @@
+package integration_tests
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/Jiruu246/rms/internal/config"
+	"github.com/Jiruu246/rms/internal/dto"
+	"github.com/Jiruu246/rms/internal/server"
+	"github.com/Jiruu246/rms/pkg/utils"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+)
+
+type ImageTestSuite struct {
+	IntegrationTestSuite
+	dir string
+}
+
+func TestImageTestSuite(t *testing.T) {
+	suite.Run(t, new(ImageTestSuite))
+}
+
+func (s *ImageTestSuite) SetupTest() {
+	s.dir = s.T().TempDir()
+}
+
+// serverFor serves the API as userID, storing uploads in a local store in
+// the test's temporary directory.
+func (s *ImageTestSuite) serverFor(userID uuid.UUID) *server.Server {
+	cfg := *s.cfg
+	cfg.BlobConfig = config.BlobConfig{
+		Store:          config.BlobStoreLocal,
+		MaxUploadBytes: 1 << 20,
+		BaseURL:        "/uploads",
+		LocalDir:       s.dir,
+	}
+	return server.New(&cfg, s.client, middlewareForUser(userID))
+}
+
+func (s *ImageTestSuite) upload(userID uuid.UUID, path string, data []byte) *httptest.ResponseRecorder {
+	var body bytes.Buffer
+	form := multipart.NewWriter(&body)
+	part, err := form.CreateFormFile("image", "photo.jpg")
+	s.Require().NoError(err)
+	_, err = part.Write(data)
+	s.Require().NoError(err)
+	s.Require().NoError(form.Close())
+
+	req := httptest.NewRequest(http.MethodPut, path, &body)
+	req.Header.Set("Content-Type", form.FormDataContentType())
+	w := httptest.NewRecorder()
+	s.serverFor(userID).Engine().ServeHTTP(w, req)
+	return w
+}
+
+func (s *ImageTestSuite) send(userID uuid.UUID, method, path string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(method, path, nil)
+	w := httptest.NewRecorder()
+	s.serverFor(userID).Engine().ServeHTTP(w, req)
+	return w
+}
+
+func (s *ImageTestSuite) storedFiles() []string {
+	var files []string
+	err := filepath.WalkDir(s.dir, func(path string, d os.DirEntry, err error) error {
+		if err == nil && !d.IsDir() {
+			files = append(files, path)
+		}
+		return err
+	})
+	s.Require().NoError(err)
+	return files
+}
+
+func testJPEG(width, height int) []byte {
+	img := image.NewRGBA(image.Rect(0, 0, width, height))
+	for x := 0; x < width; x++ {
+		img.Set(x, x%height, color.RGBA{G: 180, A: 255})
+	}
+	var buf bytes.Buffer
+	_ = jpeg.Encode(&buf, img, nil)
+	return buf.Bytes()
+}
+
+func (s *ImageTestSuite) TestMenuItemImage() {
+	ctx := s.T().Context()
+	restaurant, err := SetupRestaurant(s.client, ctx)
+	s.Require().NoError(err)
+	item, err := CreateMenuItemForRestaurant(s.client, ctx, restaurant)
+	s.Require().NoError(err)
+	path := fmt.Sprintf("/api/menu-items/%d/image", item.ID)
+
+	w := s.upload(restaurant.UserID, path, testJPEG(1200, 800))
+	s.Require().Equal(http.StatusOK, w.Code, w.Body.String())
+	var response utils.APIResponse[dto.MenuItem]
+	s.Require().NoError(json.Unmarshal(w.Body.Bytes(), &response))
+	first := response.Data
+	prefix := fmt.Sprintf("/uploads/restaurants/%s/menu-items/%d/", restaurant.ID, item.ID)
+	s.True(strings.HasPrefix(first.ImageURL, prefix), first.ImageURL)
+	s.True(strings.HasSuffix(first.ImageURL, ".jpg"), first.ImageURL)
+	s.True(strings.HasSuffix(first.ThumbnailURL, "-thumb.jpg"), first.ThumbnailURL)
+	s.Len(s.storedFiles(), 2)
+
+	// The uploads are served from the base URL.
+	w = s.send(restaurant.UserID, http.MethodGet, first.ThumbnailURL)
+	s.Require().Equal(http.StatusOK, w.Code)
+	thumb, err := jpeg.DecodeConfig(bytes.NewReader(w.Body.Bytes()))
+	s.Require().NoError(err)
+	s.Equal(320, thumb.Width)
+	s.Equal(213, thumb.Height)
+
+	// Replacing the image deletes the old one.
+	w = s.upload(restaurant.UserID, path, testJPEG(200, 200))
+	s.Require().Equal(http.StatusOK, w.Code, w.Body.String())
+	s.Require().NoError(json.Unmarshal(w.Body.Bytes(), &response))
+	s.NotEqual(first.ImageURL, response.Data.ImageURL)
+	s.Len(s.storedFiles(), 2)
+	w = s.send(restaurant.UserID, http.MethodGet, first.ImageURL)
+	s.Equal(http.StatusNotFound, w.Code)
+
+	// Deleting the item deletes its image.
+	w = s.send(restaurant.UserID, http.MethodDelete, fmt.Sprintf("/api/menu-items/%d", item.ID))
+	s.Require().Equal(http.StatusNoContent, w.Code, w.Body.String())
+	s.Empty(s.storedFiles())
+}
+
+func (s *ImageTestSuite) TestCatalogueImportReplacesImage() {
+	ctx := s.T().Context()
+	restaurant, err := SetupRestaurant(s.client, ctx)
+	s.Require().NoError(err)
+	item, err := CreateMenuItemForRestaurant(s.client, ctx, restaurant)
+	s.Require().NoError(err)
+	w := s.upload(restaurant.UserID, fmt.Sprintf("/api/menu-items/%d/image", item.ID), testJPEG(64, 64))
+	s.Require().Equal(http.StatusOK, w.Code, w.Body.String())
+	s.Len(s.storedFiles(), 2)
+
+	catalogue := dto.Catalogue{
+		Version: dto.CatalogueVersion,
+		Items: []dto.CatalogueItem{{
+			ID:        &item.ID,
+			Name:      item.Name,
+			Price:     item.Price,
+			ImageURL:  "https://example.com/burger.png",
+			Modifiers: []string{},
+		}},
+	}
+	req := s.NewJSONRequest(http.MethodPost, fmt.Sprintf("/api/restaurants/%s/catalogue", restaurant.ID), catalogue)
+	w = httptest.NewRecorder()
+	s.serverFor(restaurant.UserID).Engine().ServeHTTP(w, req)
+	s.Require().Equal(http.StatusOK, w.Code, w.Body.String())
+
+	w = s.send(restaurant.UserID, http.MethodGet, fmt.Sprintf("/api/menu-items/%d", item.ID))
+	s.Require().Equal(http.StatusOK, w.Code, w.Body.String())
+	var response utils.APIResponse[dto.MenuItem]
+	s.Require().NoError(json.Unmarshal(w.Body.Bytes(), &response))
+	s.Equal("https://example.com/burger.png", response.Data.ImageURL)
+	s.Empty(response.Data.ThumbnailURL)
+	s.Empty(s.storedFiles())
+}
+
+func (s *ImageTestSuite) TestInvalidUploads() {
+	ctx := s.T().Context()
+	restaurant, err := SetupRestaurant(s.client, ctx)
+	s.Require().NoError(err)
+	other, err := SetupRestaurant(s.client, ctx)
+	s.Require().NoError(err)
+	item, err := CreateMenuItemForRestaurant(s.client, ctx, restaurant)
+	s.Require().NoError(err)
+	path := fmt.Sprintf("/api/menu-items/%d/image", item.ID)
+
+	w := s.upload(restaurant.UserID, path, []byte("%PDF-1.4 not an image"))
+	s.Equal(http.StatusBadRequest, w.Code, w.Body.String())
+
+	w = s.upload(restaurant.UserID, path, make([]byte, 2<<20))
+	s.Equal(http.StatusBadRequest, w.Code, w.Body.String())
+
+	w = s.upload(other.UserID, path, testJPEG(100, 100))
+	s.Equal(http.StatusNotFound, w.Code, w.Body.String())
+
+	s.Empty(s.storedFiles())
+}
+
+func (s *ImageTestSuite) TestModifierOptionImage() {
+	ctx := s.T().Context()
+	restaurant, err := SetupRestaurant(s.client, ctx)
+	s.Require().NoError(err)
+	modifier, err := CreateModifierForRestaurant(s.client, ctx, restaurant)
+	s.Require().NoError(err)
+	option, err := CreateModifierOptionForModifier(s.client, ctx, modifier)
+	s.Require().NoError(err)
+	path := fmt.Sprintf("/api/modifiers/options/%s/image", option.ID)
+
+	w := s.upload(restaurant.UserID, path, testJPEG(64, 64))
+	s.Require().Equal(http.StatusOK, w.Code, w.Body.String())
+	var response utils.APIResponse[dto.ModifierOption]
+	s.Require().NoError(json.Unmarshal(w.Body.Bytes(), &response))
+	s.NotEmpty(response.Data.ImageURL)
+	s.NotEmpty(response.Data.ThumbnailURL)
+
+	w = s.send(restaurant.UserID, http.MethodDelete, path)
+	s.Require().Equal(http.StatusOK, w.Code, w.Body.String())
+	s.Require().NoError(json.Unmarshal(w.Body.Bytes(), &response))
+	s.Empty(response.Data.ImageURL)
+	s.Empty(response.Data.ThumbnailURL)
+	s.Empty(s.storedFiles())
+}
+
+func (s *ImageTestSuite) TestRestaurantLogo() {
+	ctx := s.T().Context()
+	restaurant, err := SetupRestaurant(s.client, ctx)
+	s.Require().NoError(err)
+
+	w := s.upload(restaurant.UserID, fmt.Sprintf("/api/restaurants/%s/logo", restaurant.ID), testJPEG(500, 500))
+	s.Require().Equal(http.StatusOK, w.Code, w.Body.String())
+	var response utils.APIResponse[dto.RestaurantResponse]
+	s.Require().NoError(json.Unmarshal(w.Body.Bytes(), &response))
+	s.True(strings.HasPrefix(response.Data.LogoURL, fmt.Sprintf("/uploads/restaurants/%s/logo/", restaurant.ID)))
+	s.NotEmpty(response.Data.LogoThumbnailURL)
+	s.Len(s.storedFiles(), 2)
+
+	// Deleting the restaurant deletes its logo.
+	w = s.send(restaurant.UserID, http.MethodDelete, fmt.Sprintf("/api/restaurants/%s", restaurant.ID))
+	s.Require().Equal(http.StatusNoContent, w.Code, w.Body.String())
+	s.Empty(s.storedFiles())
+}
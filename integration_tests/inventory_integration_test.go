@@ -0,0 +1,206 @@
+package integration_tests
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/Jiruu246/rms/internal/dto"
+	"github.com/Jiruu246/rms/internal/handler"
+	"github.com/Jiruu246/rms/pkg/utils"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+)
+
+type InventoryTestSuite struct {
+	IntegrationTestSuite
+}
+
+func TestInventoryTestSuite(t *testing.T) {
+	suite.Run(t, new(InventoryTestSuite))
+}
+
+func (s *InventoryTestSuite) createIngredient(userID, restaurantID uuid.UUID, name, unit string, onHand int64) dto.Ingredient {
+	w := s.SendJSON(userID, http.MethodPost, "/api/ingredients", dto.CreateIngredientRequest{
+		Name:         name,
+		Unit:         unit,
+		OnHand:       onHand,
+		RestaurantID: restaurantID,
+	})
+	s.Require().Equal(http.StatusCreated, w.Code, w.Body.String())
+	var response utils.APIResponse[dto.Ingredient]
+	s.Require().NoError(json.Unmarshal(w.Body.Bytes(), &response))
+	return response.Data
+}
+
+func (s *InventoryTestSuite) onHand(userID, ingredientID uuid.UUID) int64 {
+	w := s.SendJSON(userID, http.MethodGet, fmt.Sprintf("/api/ingredients/%s", ingredientID), nil)
+	s.Require().Equal(http.StatusOK, w.Code, w.Body.String())
+	var response utils.APIResponse[dto.Ingredient]
+	s.Require().NoError(json.Unmarshal(w.Body.Bytes(), &response))
+	return response.Data.OnHand
+}
+
+func (s *InventoryTestSuite) TestStockFollowsSales() {
+	ctx := s.T().Context()
+	restaurant, err := SetupRestaurant(s.client, ctx)
+	s.Require().NoError(err)
+	owner := restaurant.UserID
+	burger, err := CreateMenuItemForRestaurant(s.client, ctx, restaurant)
+	s.Require().NoError(err)
+	modifier, err := CreateModifierForItem(s.client, ctx, burger)
+	s.Require().NoError(err)
+	cheese, err := CreateModifierOptionForModifier(s.client, ctx, modifier)
+	s.Require().NoError(err)
+
+	buns := s.createIngredient(owner, restaurant.ID, "Buns", "each", 2)
+	patties := s.createIngredient(owner, restaurant.ID, "Patties", "each", 5)
+	cheddar := s.createIngredient(owner, restaurant.ID, "Cheddar", "g", 30)
+
+	w := s.SendJSON(owner, http.MethodPut, fmt.Sprintf("/api/menu-items/%d/recipe", burger.ID), dto.SetRecipeRequest{
+		Ingredients: []dto.RecipeLineRequest{
+			{IngredientID: buns.ID, Quantity: 1},
+			{IngredientID: patties.ID, Quantity: 1},
+		},
+	})
+	s.Require().Equal(http.StatusOK, w.Code, w.Body.String())
+	var recipe utils.APIResponse[dto.Recipe]
+	s.Require().NoError(json.Unmarshal(w.Body.Bytes(), &recipe))
+	s.Require().Len(recipe.Data.Ingredients, 2)
+	s.Equal("Buns", recipe.Data.Ingredients[0].Name)
+	w = s.SendJSON(owner, http.MethodPut, fmt.Sprintf("/api/modifiers/options/%s/recipe", cheese.ID), dto.SetRecipeRequest{
+		Ingredients: []dto.RecipeLineRequest{{IngredientID: cheddar.ID, Quantity: 20}},
+	})
+	s.Require().Equal(http.StatusOK, w.Code, w.Body.String())
+
+	order := func(quantity int, withCheese bool) *httptest.ResponseRecorder {
+		item := handler.OrderItemSchema{MenuItemID: burger.ID, Quantity: quantity}
+		if withCheese {
+			item.ModifierOptions = []handler.ModifierOption{{ModifierID: cheese.ID, Quantity: 1}}
+		}
+		return s.SendJSON(owner, http.MethodPost, "/api/orders", handler.CreateOrderSchema{
+			OrderType:    dto.OrderTypeTAKEOUT,
+			RestaurantID: restaurant.ID,
+			OrderItems:   []handler.OrderItemSchema{item},
+		})
+	}
+
+	// A cheeseburger leaves too little cheddar for another slice.
+	w = order(1, true)
+	s.Require().Equal(http.StatusCreated, w.Code, w.Body.String())
+	s.Equal(int64(1), s.onHand(owner, buns.ID))
+	s.Equal(int64(4), s.onHand(owner, patties.ID))
+	s.Equal(int64(10), s.onHand(owner, cheddar.ID))
+	cheese, err = s.client.ModifierOption.Get(ctx, cheese.ID)
+	s.Require().NoError(err)
+	s.False(cheese.Available)
+	s.True(cheese.OutOfStock)
+
+	// Two burgers need two buns; nothing is drawn from stock.
+	w = order(2, false)
+	s.Equal(http.StatusBadRequest, w.Code, w.Body.String())
+	s.Contains(w.Body.String(), "not enough Buns in stock")
+	s.Equal(int64(4), s.onHand(owner, patties.ID))
+
+	// The last bun 86es the burger.
+	w = order(1, false)
+	s.Require().Equal(http.StatusCreated, w.Code, w.Body.String())
+	burger, err = s.client.MenuItem.Get(ctx, burger.ID)
+	s.Require().NoError(err)
+	s.False(burger.IsAvailable)
+	s.True(burger.OutOfStock)
+
+	// A delivery brings it back.
+	w = s.SendJSON(owner, http.MethodPost, fmt.Sprintf("/api/ingredients/%s/adjustments", buns.ID), dto.StockAdjustmentRequest{
+		Kind:     "DELIVERY",
+		Quantity: 10,
+		Note:     "bakery",
+	})
+	s.Require().Equal(http.StatusCreated, w.Code, w.Body.String())
+	var movement utils.APIResponse[dto.StockMovement]
+	s.Require().NoError(json.Unmarshal(w.Body.Bytes(), &movement))
+	s.Equal(int64(10), movement.Data.OnHandAfter)
+	burger, err = s.client.MenuItem.Get(ctx, burger.ID)
+	s.Require().NoError(err)
+	s.True(burger.IsAvailable)
+	s.False(burger.OutOfStock)
+
+	// Throwing away more than is left is refused; a count sets stock outright.
+	w = s.SendJSON(owner, http.MethodPost, fmt.Sprintf("/api/ingredients/%s/adjustments", cheddar.ID), dto.StockAdjustmentRequest{Kind: "WASTE", Quantity: 50})
+	s.Equal(http.StatusBadRequest, w.Code, w.Body.String())
+	w = s.SendJSON(owner, http.MethodPost, fmt.Sprintf("/api/ingredients/%s/adjustments", cheddar.ID), dto.StockAdjustmentRequest{Kind: "COUNT", Quantity: 400})
+	s.Require().Equal(http.StatusCreated, w.Code, w.Body.String())
+	s.Require().NoError(json.Unmarshal(w.Body.Bytes(), &movement))
+	s.Equal(int64(390), movement.Data.Quantity)
+	cheese, err = s.client.ModifierOption.Get(ctx, cheese.ID)
+	s.Require().NoError(err)
+	s.True(cheese.Available)
+
+	w = s.SendJSON(owner, http.MethodGet, fmt.Sprintf("/api/ingredients/%s/movements", buns.ID), nil)
+	s.Require().Equal(http.StatusOK, w.Code, w.Body.String())
+	var movements utils.APIResponse[[]dto.StockMovement]
+	s.Require().NoError(json.Unmarshal(w.Body.Bytes(), &movements))
+	s.Require().Len(movements.Data, 4)
+	s.Equal(dto.StockMovementDELIVERY, movements.Data[0].Kind)
+	s.Equal(dto.StockMovementSALE, movements.Data[1].Kind)
+	s.Equal(int64(-1), movements.Data[1].Quantity)
+	s.NotNil(movements.Data[1].OrderID)
+	s.Equal(dto.StockMovementCOUNT, movements.Data[3].Kind)
+	s.Equal(int64(2), movements.Data[3].OnHandAfter)
+}
+
+func (s *InventoryTestSuite) TestManualAvailabilityOverridesStock() {
+	ctx := s.T().Context()
+	restaurant, err := SetupRestaurant(s.client, ctx)
+	s.Require().NoError(err)
+	owner := restaurant.UserID
+	item, err := CreateMenuItemForRestaurant(s.client, ctx, restaurant)
+	s.Require().NoError(err)
+	flour := s.createIngredient(owner, restaurant.ID, "Flour", "g", 0)
+
+	// Setting a recipe that can't be made 86es the item right away.
+	w := s.SendJSON(owner, http.MethodPut, fmt.Sprintf("/api/menu-items/%d/recipe", item.ID), dto.SetRecipeRequest{
+		Ingredients: []dto.RecipeLineRequest{{IngredientID: flour.ID, Quantity: 100}},
+	})
+	s.Require().Equal(http.StatusOK, w.Code, w.Body.String())
+	item, err = s.client.MenuItem.Get(ctx, item.ID)
+	s.Require().NoError(err)
+	s.True(item.OutOfStock)
+
+	// Turned off by hand, it stays off when flour comes in.
+	available := false
+	w = s.SendJSON(owner, http.MethodPatch, fmt.Sprintf("/api/menu-items/%d", item.ID), dto.UpdateMenuItemRequest{IsAvailable: &available})
+	s.Require().Equal(http.StatusOK, w.Code, w.Body.String())
+	w = s.SendJSON(owner, http.MethodPost, fmt.Sprintf("/api/ingredients/%s/adjustments", flour.ID), dto.StockAdjustmentRequest{Kind: "DELIVERY", Quantity: 1000})
+	s.Require().Equal(http.StatusCreated, w.Code, w.Body.String())
+	item, err = s.client.MenuItem.Get(ctx, item.ID)
+	s.Require().NoError(err)
+	s.False(item.IsAvailable)
+	s.False(item.OutOfStock)
+}
+
+func (s *InventoryTestSuite) TestOtherRestaurants() {
+	ctx := s.T().Context()
+	restaurant, err := SetupRestaurant(s.client, ctx)
+	s.Require().NoError(err)
+	other, err := SetupRestaurant(s.client, ctx)
+	s.Require().NoError(err)
+	item, err := CreateMenuItemForRestaurant(s.client, ctx, restaurant)
+	s.Require().NoError(err)
+	theirs := s.createIngredient(other.UserID, other.ID, "Salt", "g", 100)
+
+	w := s.SendJSON(restaurant.UserID, http.MethodGet, fmt.Sprintf("/api/ingredients/%s", theirs.ID), nil)
+	s.Equal(http.StatusNotFound, w.Code, w.Body.String())
+	w = s.SendJSON(restaurant.UserID, http.MethodPost, fmt.Sprintf("/api/ingredients/%s/adjustments", theirs.ID), dto.StockAdjustmentRequest{Kind: "WASTE", Quantity: 10})
+	s.Equal(http.StatusNotFound, w.Code, w.Body.String())
+
+	// Recipes only use the restaurant's own ingredients.
+	w = s.SendJSON(restaurant.UserID, http.MethodPut, fmt.Sprintf("/api/menu-items/%d/recipe", item.ID), dto.SetRecipeRequest{
+		Ingredients: []dto.RecipeLineRequest{{IngredientID: theirs.ID, Quantity: 1}},
+	})
+	s.Equal(http.StatusBadRequest, w.Code, w.Body.String())
+	w = s.SendJSON(other.UserID, http.MethodGet, fmt.Sprintf("/api/menu-items/%d/recipe", item.ID), nil)
+	s.Equal(http.StatusNotFound, w.Code, w.Body.String())
+}
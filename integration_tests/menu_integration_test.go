@@ -0,0 +1,247 @@
+package integration_tests
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/Jiruu246/rms/internal/dto"
+	"github.com/Jiruu246/rms/internal/ent"
+	"github.com/Jiruu246/rms/internal/handler"
+	"github.com/Jiruu246/rms/pkg/hours"
+	"github.com/Jiruu246/rms/pkg/utils"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+)
+
+type MenuTestSuite struct {
+	IntegrationTestSuite
+}
+
+func TestMenuTestSuite(t *testing.T) {
+	suite.Run(t, new(MenuTestSuite))
+}
+
+func (s *MenuTestSuite) createMenu(userID uuid.UUID, req dto.CreateMenuRequest, contents dto.SetMenuContentsRequest) dto.Menu {
+	w := s.SendJSON(userID, http.MethodPost, "/api/menus", req)
+	s.Require().Equal(http.StatusCreated, w.Code, w.Body.String())
+	var created utils.APIResponse[dto.Menu]
+	s.Require().NoError(json.Unmarshal(w.Body.Bytes(), &created))
+
+	w = s.SendJSON(userID, http.MethodPut, fmt.Sprintf("/api/menus/%s/contents", created.Data.ID), contents)
+	s.Require().Equal(http.StatusOK, w.Code, w.Body.String())
+	var response utils.APIResponse[dto.Menu]
+	s.Require().NoError(json.Unmarshal(w.Body.Bytes(), &response))
+	return response.Data
+}
+
+func (s *MenuTestSuite) TestMenusGateOrders() {
+	ctx := s.T().Context()
+	restaurant, err := SetupRestaurant(s.client, ctx)
+	s.Require().NoError(err)
+	owner := restaurant.UserID
+	mains, err := s.client.Category.Create().
+		SetName("Mains").
+		SetRestaurant(restaurant).
+		Save(ctx)
+	s.Require().NoError(err)
+	burger, err := CreateMenuItemForRestaurant(s.client, ctx, restaurant)
+	s.Require().NoError(err)
+	burger, err = burger.Update().SetCategory(mains).Save(ctx)
+	s.Require().NoError(err)
+
+	order := func(orderType dto.OrderType) *httptest.ResponseRecorder {
+		return s.SendJSON(owner, http.MethodPost, "/api/orders", handler.CreateOrderSchema{
+			OrderType:    orderType,
+			RestaurantID: restaurant.ID,
+			OrderItems:   []handler.OrderItemSchema{{MenuItemID: burger.ID, Quantity: 1}},
+		})
+	}
+
+	// Without menus anything available can be ordered.
+	w := order(dto.OrderTypeTAKEOUT)
+	s.Require().Equal(http.StatusCreated, w.Code, w.Body.String())
+
+	// Lunch is served every day except today and tomorrow (UTC).
+	allDay := []hours.Interval{{Open: "00:00", Close: "24:00"}}
+	now := time.Now().UTC()
+	s.createMenu(owner, dto.CreateMenuRequest{
+		Name: "Lunch",
+		Schedule: &hours.Schedule{
+			Monday: allDay, Tuesday: allDay, Wednesday: allDay, Thursday: allDay,
+			Friday: allDay, Saturday: allDay, Sunday: allDay,
+			Exceptions: []hours.Exception{
+				{Date: now.Format(time.DateOnly)},
+				{Date: now.AddDate(0, 0, 1).Format(time.DateOnly)},
+			},
+		},
+		RestaurantID: restaurant.ID,
+	}, dto.SetMenuContentsRequest{CategoryIDs: []uuid.UUID{mains.ID}})
+
+	w = order(dto.OrderTypeTAKEOUT)
+	s.Equal(http.StatusBadRequest, w.Code, w.Body.String())
+	s.Contains(w.Body.String(), "is not on a menu served")
+
+	dinner := s.createMenu(owner, dto.CreateMenuRequest{
+		Name:         "Dinner",
+		OrderTypes:   []string{string(dto.OrderTypeDINE_IN)},
+		RestaurantID: restaurant.ID,
+	}, dto.SetMenuContentsRequest{
+		CategoryIDs: []uuid.UUID{mains.ID},
+		ItemPrices:  []dto.MenuItemPriceRequest{{MenuItemID: burger.ID, Price: 1500}},
+	})
+	s.Equal([]uuid.UUID{mains.ID}, dinner.CategoryIDs)
+	s.Require().Len(dinner.ItemPrices, 1)
+	s.Equal(int64(1500), dinner.ItemPrices[0].Price.Amount)
+
+	w = order(dto.OrderTypeTAKEOUT)
+	s.Equal(http.StatusBadRequest, w.Code, w.Body.String())
+
+	w = order(dto.OrderTypeDINE_IN)
+	s.Require().Equal(http.StatusCreated, w.Code, w.Body.String())
+	var created utils.APIResponse[dto.Order]
+	s.Require().NoError(json.Unmarshal(w.Body.Bytes(), &created))
+	s.Require().Len(created.Data.OrderItems, 1)
+	s.Equal(int64(1500), created.Data.OrderItems[0].ItemPrice.Amount)
+
+	inactive := false
+	w = s.SendJSON(owner, http.MethodPatch, fmt.Sprintf("/api/menus/%s", dinner.ID), dto.UpdateMenuRequest{IsActive: &inactive})
+	s.Require().Equal(http.StatusOK, w.Code, w.Body.String())
+	w = order(dto.OrderTypeDINE_IN)
+	s.Equal(http.StatusBadRequest, w.Code, w.Body.String())
+}
+
+func (s *MenuTestSuite) TestOtherRestaurants() {
+	ctx := s.T().Context()
+	restaurant, err := SetupRestaurant(s.client, ctx)
+	s.Require().NoError(err)
+	other, err := SetupRestaurant(s.client, ctx)
+	s.Require().NoError(err)
+	theirCategory, err := s.client.Category.Create().
+		SetName("Theirs").
+		SetRestaurant(other).
+		Save(ctx)
+	s.Require().NoError(err)
+	theirItem, err := CreateMenuItemForRestaurant(s.client, ctx, other)
+	s.Require().NoError(err)
+
+	menu := s.createMenu(restaurant.UserID, dto.CreateMenuRequest{Name: "All day", RestaurantID: restaurant.ID}, dto.SetMenuContentsRequest{})
+
+	w := s.SendJSON(restaurant.UserID, http.MethodPut, fmt.Sprintf("/api/menus/%s/contents", menu.ID), dto.SetMenuContentsRequest{
+		CategoryIDs: []uuid.UUID{theirCategory.ID},
+	})
+	s.Equal(http.StatusBadRequest, w.Code, w.Body.String())
+	w = s.SendJSON(restaurant.UserID, http.MethodPut, fmt.Sprintf("/api/menus/%s/contents", menu.ID), dto.SetMenuContentsRequest{
+		ItemPrices: []dto.MenuItemPriceRequest{{MenuItemID: theirItem.ID, Price: 100}},
+	})
+	s.Equal(http.StatusBadRequest, w.Code, w.Body.String())
+
+	w = s.SendJSON(other.UserID, http.MethodGet, fmt.Sprintf("/api/menus/%s", menu.ID), nil)
+	s.Equal(http.StatusNotFound, w.Code, w.Body.String())
+	w = s.SendJSON(other.UserID, http.MethodPost, "/api/menus", dto.CreateMenuRequest{Name: "Sneaky", RestaurantID: restaurant.ID})
+	s.Equal(http.StatusNotFound, w.Code, w.Body.String())
+}
+
+func (s *MenuTestSuite) TestPublicMenu() {
+	ctx := s.T().Context()
+	restaurant, err := SetupRestaurant(s.client, ctx)
+	s.Require().NoError(err)
+	category := func(name string, displayOrder int, active bool) *ent.Category {
+		cat, err := s.client.Category.Create().
+			SetName(name).
+			SetDisplayOrder(displayOrder).
+			SetIsActive(active).
+			SetRestaurant(restaurant).
+			Save(ctx)
+		s.Require().NoError(err)
+		return cat
+	}
+	item := func(name string, cat *ent.Category, displayOrder int, available bool) *ent.MenuItem {
+		item, err := s.client.MenuItem.Create().
+			SetName(name).
+			SetPrice(999).
+			SetDisplayOrder(displayOrder).
+			SetIsAvailable(available).
+			SetCategory(cat).
+			SetRestaurant(restaurant).
+			Save(ctx)
+		s.Require().NoError(err)
+		return item
+	}
+	drinks := category("Drinks", 2, true)
+	mains := category("Mains", 1, true)
+	hidden := category("Staff meals", 0, false)
+	category("Desserts", 3, true)
+	fries := item("Fries", mains, 2, true)
+	burger := item("Burger", mains, 1, true)
+	item("Soup", mains, 0, false)
+	item("Cola", drinks, 0, true)
+	item("Leftovers", hidden, 0, true)
+	modifier, err := CreateModifierForItem(s.client, ctx, burger)
+	s.Require().NoError(err)
+	cheese, err := CreateModifierOptionForModifier(s.client, ctx, modifier)
+	s.Require().NoError(err)
+	_, err = s.client.ModifierOption.Create().
+		SetName("Truffle").
+		SetAvailable(false).
+		SetModifier(modifier).
+		Save(ctx)
+	s.Require().NoError(err)
+
+	get := func(ifNoneMatch string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, fmt.Sprintf("/api/public/restaurants/%s/menu", restaurant.ID), nil)
+		if ifNoneMatch != "" {
+			req.Header.Set("If-None-Match", ifNoneMatch)
+		}
+		w := httptest.NewRecorder()
+		s.CreateServerWithMiddleware(DefaultMiddleware()).Engine().ServeHTTP(w, req)
+		return w
+	}
+
+	w := get("")
+	s.Require().Equal(http.StatusOK, w.Code, w.Body.String())
+	etag := w.Header().Get("ETag")
+	s.NotEmpty(etag)
+	s.Contains(w.Header().Get("Cache-Control"), "max-age=")
+	var response utils.APIResponse[dto.PublicMenu]
+	s.Require().NoError(json.Unmarshal(w.Body.Bytes(), &response))
+	tree := response.Data
+	s.Require().Len(tree.Categories, 2)
+	s.Equal("Mains", tree.Categories[0].Name)
+	s.Equal("Drinks", tree.Categories[1].Name)
+	s.Require().Len(tree.Categories[0].Items, 2)
+	s.Equal(burger.ID, tree.Categories[0].Items[0].ID)
+	s.Equal(fries.ID, tree.Categories[0].Items[1].ID)
+	s.Require().Len(tree.Categories[0].Items[0].Modifiers, 1)
+	options := tree.Categories[0].Items[0].Modifiers[0].Options
+	s.Require().Len(options, 1)
+	s.Equal(cheese.ID, options[0].ID)
+
+	w = get(etag)
+	s.Equal(http.StatusNotModified, w.Code)
+	s.Empty(w.Body.String())
+
+	// Orders don't change the menu.
+	w = s.SendJSON(uuid.Nil, http.MethodPost, "/api/public/order", handler.CreateOrderSchema{
+		OrderType:    dto.OrderTypeTAKEOUT,
+		RestaurantID: restaurant.ID,
+		OrderItems:   []handler.OrderItemSchema{{MenuItemID: fries.ID, Quantity: 1}},
+	})
+	s.Require().Equal(http.StatusCreated, w.Code, w.Body.String())
+	w = get(etag)
+	s.Equal(http.StatusNotModified, w.Code)
+
+	_, err = fries.Update().SetPrice(450).Save(ctx)
+	s.Require().NoError(err)
+	w = get(etag)
+	s.Require().Equal(http.StatusOK, w.Code, w.Body.String())
+	s.NotEqual(etag, w.Header().Get("ETag"))
+
+	req := httptest.NewRequest(http.MethodGet, fmt.Sprintf("/api/public/restaurants/%s/menu", uuid.New()), nil)
+	w = httptest.NewRecorder()
+	s.CreateServerWithMiddleware(DefaultMiddleware()).Engine().ServeHTTP(w, req)
+	s.Equal(http.StatusNotFound, w.Code, w.Body.String())
+}
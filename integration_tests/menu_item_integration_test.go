@@ -10,6 +10,7 @@ import (
 	"testing"
 
 	"github.com/Jiruu246/rms/internal/dto"
+	"github.com/Jiruu246/rms/pkg/money"
 	"github.com/Jiruu246/rms/pkg/utils"
 	"github.com/stretchr/testify/suite"
 )
@@ -44,7 +45,7 @@ func (s *MenuItemTestSuite) TestCreateMenuItem() {
 			body: dto.CreateMenuItemRequest{
 				Name:         "Test Menu Item",
 				Description:  "A test menu item description",
-				Price:        9.99,
+				Price:        999,
 				RestaurantID: restaurant.ID,
 			},
 			expectedStatus: http.StatusCreated,
@@ -54,7 +55,7 @@ func (s *MenuItemTestSuite) TestCreateMenuItem() {
 				s.Require().NoError(err)
 				s.Equal("Test Menu Item", response.Data.Name)
 				s.Equal("A test menu item description", response.Data.Description)
-				s.Equal(9.99, response.Data.Price)
+				s.Equal(money.New(999, money.Currency(restaurant.Currency)), response.Data.Price)
 				s.Equal(restaurant.ID, response.Data.RestaurantID)
 			},
 		},
@@ -73,7 +74,7 @@ func (s *MenuItemTestSuite) TestCreateMenuItem() {
 			req.Header.Set("Content-Type", "application/json")
 			w := httptest.NewRecorder()
 
-			server := s.CreateServer()
+			server := s.CreateServerWithMiddleware(middlewareForUser(restaurant.UserID))
 			server.Engine().ServeHTTP(w, req)
 			s.Equal(tt.expectedStatus, w.Code)
 
@@ -83,16 +84,22 @@ func (s *MenuItemTestSuite) TestCreateMenuItem() {
 }
 
 func (s *MenuItemTestSuite) TestGetMenuItem() {
-	initialMenuItem, err := CreateMenuItem(s.client, s.T().Context())
+	restaurant, err := SetupRestaurant(s.client, s.T().Context())
+	s.Require().NoError(err)
+	initialMenuItem, err := CreateMenuItemForRestaurant(s.client, s.T().Context(), restaurant)
 	s.Require().NoError(err)
 	_, err = initialMenuItem.Update().
 		SetName("Initial Menu Item").
 		SetDescription("Initial Description").
-		SetPrice(19.99).
+		SetPrice(1999).
 		Save(s.T().Context())
 	s.Require().NoError(err)
 
-	_, err = CreateMenuItem(s.client, s.T().Context())
+	sameOwnerMenuItem, err := CreateMenuItemForRestaurant(s.client, s.T().Context(), restaurant)
+	s.Require().NoError(err)
+
+	// An item of another restaurant must not be visible to this owner.
+	otherOwnerMenuItem, err := CreateMenuItem(s.client, s.T().Context())
 	s.Require().NoError(err)
 
 	tests := []struct {
@@ -124,21 +131,45 @@ func (s *MenuItemTestSuite) TestGetMenuItem() {
 				s.Equal(initialMenuItem.ID, response.Data.ID)
 				s.Equal("Initial Menu Item", response.Data.Name)
 				s.Equal("Initial Description", response.Data.Description)
-				s.Equal(19.99, response.Data.Price)
+				s.Equal(int64(1999), response.Data.Price.Amount)
 			},
 		},
+		{
+			// ErrForbidden renders as 404, as for a missing item.
+			testName: "GetMenuItemByID_OtherRestaurant_NotFound",
+			url:      path.Join(menuItemAPIBase, fmt.Sprintf("%d", otherOwnerMenuItem.ID)),
+			expected: http.StatusNotFound,
+			validate: func(w *httptest.ResponseRecorder) {},
+		},
 		{
 			testName: "GetAllMenuItems",
-			url:      menuItemAPIBase,
+			url:      menuItemAPIBase + "?restaurant_id=" + restaurant.ID.String(),
 			expected: http.StatusOK,
 			validate: func(w *httptest.ResponseRecorder) {
 				var response utils.APIResponse[[]dto.MenuItem]
 				err := json.Unmarshal(w.Body.Bytes(), &response)
 				s.Require().NoError(err)
 				s.True(response.Success)
-				s.True(len(response.Data) >= 2)
+
+				ids := make([]int64, len(response.Data))
+				for i, item := range response.Data {
+					ids[i] = item.ID
+				}
+				s.ElementsMatch([]int64{initialMenuItem.ID, sameOwnerMenuItem.ID}, ids)
 			},
 		},
+		{
+			testName: "GetAllMenuItems_MissingRestaurantID",
+			url:      menuItemAPIBase,
+			expected: http.StatusBadRequest,
+			validate: func(w *httptest.ResponseRecorder) {},
+		},
+		{
+			testName: "GetAllMenuItems_OtherRestaurant_NotFound",
+			url:      menuItemAPIBase + "?restaurant_id=" + otherOwnerMenuItem.RestaurantID.String(),
+			expected: http.StatusNotFound,
+			validate: func(w *httptest.ResponseRecorder) {},
+		},
 	}
 
 	for _, tt := range tests {
@@ -147,7 +178,7 @@ func (s *MenuItemTestSuite) TestGetMenuItem() {
 			req.Header.Set("Content-Type", "application/json")
 			w := httptest.NewRecorder()
 
-			server := s.CreateServer()
+			server := s.CreateServerWithMiddleware(middlewareForUser(restaurant.UserID))
 			server.Engine().ServeHTTP(w, req)
 			s.Equal(tt.expected, w.Code)
 
@@ -157,17 +188,19 @@ func (s *MenuItemTestSuite) TestGetMenuItem() {
 }
 
 func (s *MenuItemTestSuite) TestUpdateMenuItem() {
-	initialMenuItem, err := CreateMenuItem(s.client, s.T().Context())
+	restaurant, err := SetupRestaurant(s.client, s.T().Context())
+	s.Require().NoError(err)
+	initialMenuItem, err := CreateMenuItemForRestaurant(s.client, s.T().Context(), restaurant)
 	s.Require().NoError(err)
 
 	_, err = initialMenuItem.Update().
 		SetName("Initial Menu Item").
 		SetDescription("Initial Description").
-		SetPrice(19.99).
+		SetPrice(1999).
 		Save(s.T().Context())
 	s.Require().NoError(err)
 
-	_, err = CreateMenuItem(s.client, s.T().Context())
+	otherOwnerMenuItem, err := CreateMenuItem(s.client, s.T().Context())
 	s.Require().NoError(err)
 
 	tests := []struct {
@@ -183,7 +216,7 @@ func (s *MenuItemTestSuite) TestUpdateMenuItem() {
 			body: dto.UpdateMenuItemRequest{
 				Name:        ptr("Updated Menu Item"),
 				Description: ptr("Updated description"),
-				Price:       ptrFloat(29.99),
+				Price:       ptrInt64(2999),
 			},
 			expected: http.StatusOK,
 			validate: func(w *httptest.ResponseRecorder) {
@@ -193,9 +226,16 @@ func (s *MenuItemTestSuite) TestUpdateMenuItem() {
 				s.Equal(initialMenuItem.ID, updatedMenuItem.Data.ID)
 				s.Equal("Updated Menu Item", updatedMenuItem.Data.Name)
 				s.Equal("Updated description", updatedMenuItem.Data.Description)
-				s.Equal(29.99, updatedMenuItem.Data.Price)
+				s.Equal(int64(2999), updatedMenuItem.Data.Price.Amount)
 			},
 		},
+		{
+			testName: "UpdateMenuItem_OtherRestaurant_NotFound",
+			url:      path.Join(menuItemAPIBase, fmt.Sprintf("%d", otherOwnerMenuItem.ID)),
+			body:     dto.UpdateMenuItemRequest{Name: ptr("Hijacked")},
+			expected: http.StatusNotFound,
+			validate: func(w *httptest.ResponseRecorder) {},
+		},
 	}
 
 	for _, tt := range tests {
@@ -211,7 +251,7 @@ func (s *MenuItemTestSuite) TestUpdateMenuItem() {
 			req.Header.Set("Content-Type", "application/json")
 			w := httptest.NewRecorder()
 
-			server := s.CreateServer()
+			server := s.CreateServerWithMiddleware(middlewareForUser(restaurant.UserID))
 			server.Engine().ServeHTTP(w, req)
 			s.Equal(tt.expected, w.Code)
 
@@ -221,7 +261,11 @@ func (s *MenuItemTestSuite) TestUpdateMenuItem() {
 }
 
 func (s *MenuItemTestSuite) TestDeleteMenuItem() {
-	initialMenuItem, err := CreateMenuItem(s.client, s.T().Context())
+	restaurant, err := SetupRestaurant(s.client, s.T().Context())
+	s.Require().NoError(err)
+	initialMenuItem, err := CreateMenuItemForRestaurant(s.client, s.T().Context(), restaurant)
+	s.Require().NoError(err)
+	otherOwnerMenuItem, err := CreateMenuItem(s.client, s.T().Context())
 	s.Require().NoError(err)
 
 	tests := []struct {
@@ -234,6 +278,11 @@ func (s *MenuItemTestSuite) TestDeleteMenuItem() {
 			url:      path.Join(menuItemAPIBase, fmt.Sprintf("%d", initialMenuItem.ID)),
 			expected: http.StatusNoContent,
 		},
+		{
+			testName: "DeleteMenuItem_OtherRestaurant_NotFound",
+			url:      path.Join(menuItemAPIBase, fmt.Sprintf("%d", otherOwnerMenuItem.ID)),
+			expected: http.StatusNotFound,
+		},
 	}
 
 	for _, tt := range tests {
@@ -242,13 +291,13 @@ func (s *MenuItemTestSuite) TestDeleteMenuItem() {
 			req.Header.Set("Content-Type", "application/json")
 			w := httptest.NewRecorder()
 
-			server := s.CreateServer()
+			server := s.CreateServerWithMiddleware(middlewareForUser(restaurant.UserID))
 			server.Engine().ServeHTTP(w, req)
 			s.Equal(tt.expected, w.Code)
 		})
 	}
 }
 
-func ptrFloat(f float64) *float64 {
-	return &f
+func ptrInt64(i int64) *int64 {
+	return &i
 }
@@ -0,0 +1,206 @@
+package integration_tests
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/Jiruu246/rms/internal/dto"
+	"github.com/Jiruu246/rms/internal/handler"
+	"github.com/Jiruu246/rms/pkg/utils"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+)
+
+type MenuItemModifierTestSuite struct {
+	IntegrationTestSuite
+}
+
+func TestMenuItemModifierTestSuite(t *testing.T) {
+	suite.Run(t, new(MenuItemModifierTestSuite))
+}
+
+func (s *MenuItemModifierTestSuite) TestAttachReorderDetach() {
+	ctx := s.T().Context()
+	restaurant, err := SetupRestaurant(s.client, ctx)
+	s.Require().NoError(err)
+	other, err := SetupRestaurant(s.client, ctx)
+	s.Require().NoError(err)
+	item, err := CreateMenuItemForRestaurant(s.client, ctx, restaurant)
+	s.Require().NoError(err)
+	sauce, err := CreateModifierForRestaurant(s.client, ctx, restaurant)
+	s.Require().NoError(err)
+	sides, err := CreateModifierForRestaurant(s.client, ctx, restaurant)
+	s.Require().NoError(err)
+	foreign, err := CreateModifierForRestaurant(s.client, ctx, other)
+	s.Require().NoError(err)
+	path := fmt.Sprintf("%s/%d/modifiers", menuItemAPIBase, item.ID)
+
+	w := s.SendJSON(restaurant.UserID, http.MethodPost, path, dto.AttachMenuItemModifierRequest{ModifierID: sauce.ID})
+	s.Require().Equal(http.StatusCreated, w.Code, w.Body.String())
+	var attached utils.APIResponse[dto.MenuItemModifier]
+	s.Require().NoError(json.Unmarshal(w.Body.Bytes(), &attached))
+	s.Equal(sauce.ID, attached.Data.Modifier.ID)
+	s.Equal(0, attached.Data.DisplayOrder)
+
+	w = s.SendJSON(restaurant.UserID, http.MethodPost, path, dto.AttachMenuItemModifierRequest{ModifierID: sides.ID})
+	s.Require().Equal(http.StatusCreated, w.Code, w.Body.String())
+	s.Require().NoError(json.Unmarshal(w.Body.Bytes(), &attached))
+	s.Equal(1, attached.Data.DisplayOrder)
+
+	// A modifier is attached once, and only to items of its restaurant.
+	w = s.SendJSON(restaurant.UserID, http.MethodPost, path, dto.AttachMenuItemModifierRequest{ModifierID: sauce.ID})
+	s.Equal(http.StatusConflict, w.Code, w.Body.String())
+	w = s.SendJSON(restaurant.UserID, http.MethodPost, path, dto.AttachMenuItemModifierRequest{ModifierID: foreign.ID})
+	s.Equal(http.StatusBadRequest, w.Code, w.Body.String())
+
+	// Another restaurant's owner cannot see or change them.
+	w = s.SendJSON(other.UserID, http.MethodGet, path, nil)
+	s.Equal(http.StatusNotFound, w.Code, w.Body.String())
+	w = s.SendJSON(other.UserID, http.MethodPost, path, dto.AttachMenuItemModifierRequest{ModifierID: foreign.ID})
+	s.Equal(http.StatusNotFound, w.Code, w.Body.String())
+
+	// Reordering must name every attached modifier.
+	w = s.SendJSON(restaurant.UserID, http.MethodPut, path, dto.ReorderMenuItemModifiersRequest{ModifierIDs: []uuid.UUID{sides.ID}})
+	s.Equal(http.StatusBadRequest, w.Code, w.Body.String())
+	w = s.SendJSON(restaurant.UserID, http.MethodPut, path, dto.ReorderMenuItemModifiersRequest{ModifierIDs: []uuid.UUID{sides.ID, sauce.ID}})
+	s.Require().Equal(http.StatusOK, w.Code, w.Body.String())
+	var listed utils.APIResponse[[]dto.MenuItemModifier]
+	s.Require().NoError(json.Unmarshal(w.Body.Bytes(), &listed))
+	s.Require().Len(listed.Data, 2)
+	s.Equal(sides.ID, listed.Data[0].Modifier.ID)
+	s.Equal(sauce.ID, listed.Data[1].Modifier.ID)
+
+	// Detaching keeps the modifier itself.
+	w = s.SendJSON(restaurant.UserID, http.MethodDelete, fmt.Sprintf("%s/%s", path, sides.ID), nil)
+	s.Require().Equal(http.StatusNoContent, w.Code, w.Body.String())
+	w = s.SendJSON(restaurant.UserID, http.MethodDelete, fmt.Sprintf("%s/%s", path, sides.ID), nil)
+	s.Equal(http.StatusNotFound, w.Code, w.Body.String())
+	_, err = s.client.Modifier.Get(ctx, sides.ID)
+	s.NoError(err)
+
+	w = s.SendJSON(restaurant.UserID, http.MethodGet, path, nil)
+	s.Require().Equal(http.StatusOK, w.Code, w.Body.String())
+	listed = utils.APIResponse[[]dto.MenuItemModifier]{}
+	s.Require().NoError(json.Unmarshal(w.Body.Bytes(), &listed))
+	s.Require().Len(listed.Data, 1)
+	s.Equal(sauce.ID, listed.Data[0].Modifier.ID)
+}
+
+// A modifier shared by two items is required, capped and priced per item.
+func (s *MenuItemModifierTestSuite) TestOverrides() {
+	ctx := s.T().Context()
+	restaurant, err := SetupRestaurant(s.client, ctx)
+	s.Require().NoError(err)
+	burger, err := CreateMenuItemForRestaurant(s.client, ctx, restaurant)
+	s.Require().NoError(err)
+	salad, err := CreateMenuItemForRestaurant(s.client, ctx, restaurant)
+	s.Require().NoError(err)
+	toppings, err := CreateModifierForRestaurant(s.client, ctx, restaurant)
+	s.Require().NoError(err)
+	toppings, err = toppings.Update().SetMultiSelect(true).SetMax(3).Save(ctx)
+	s.Require().NoError(err)
+	cheese, err := CreateModifierOptionForModifier(s.client, ctx, toppings)
+	s.Require().NoError(err)
+	olives, err := CreateModifierOptionForModifier(s.client, ctx, toppings)
+	s.Require().NoError(err)
+
+	required, limit := true, 1
+	w := s.SendJSON(restaurant.UserID, http.MethodPost, fmt.Sprintf("%s/%d/modifiers", menuItemAPIBase, burger.ID), dto.AttachMenuItemModifierRequest{
+		ModifierID: toppings.ID,
+		MenuItemModifierOverrides: dto.MenuItemModifierOverrides{
+			Required:     &required,
+			Max:          &limit,
+			OptionPrices: map[uuid.UUID]int64{cheese.ID: 50},
+		},
+	})
+	s.Require().Equal(http.StatusCreated, w.Code, w.Body.String())
+	var attached utils.APIResponse[dto.MenuItemModifier]
+	s.Require().NoError(json.Unmarshal(w.Body.Bytes(), &attached))
+	s.True(attached.Data.Modifier.Required)
+	s.Equal(1, attached.Data.Modifier.Max)
+	w = s.SendJSON(restaurant.UserID, http.MethodPost, fmt.Sprintf("%s/%d/modifiers", menuItemAPIBase, salad.ID), dto.AttachMenuItemModifierRequest{ModifierID: toppings.ID})
+	s.Require().Equal(http.StatusCreated, w.Code, w.Body.String())
+
+	// Prices may only be overridden for the modifier's own options.
+	overridePath := fmt.Sprintf("%s/%d/modifiers/%s", menuItemAPIBase, salad.ID, toppings.ID)
+	w = s.SendJSON(restaurant.UserID, http.MethodPut, overridePath, dto.MenuItemModifierOverrides{
+		OptionPrices: map[uuid.UUID]int64{uuid.New(): 50},
+	})
+	s.Equal(http.StatusBadRequest, w.Code, w.Body.String())
+
+	order := func(itemID int64, options ...uuid.UUID) *httptest.ResponseRecorder {
+		mods := make([]handler.ModifierOption, 0, len(options))
+		for _, id := range options {
+			mods = append(mods, handler.ModifierOption{ModifierID: id, Quantity: 1})
+		}
+		return s.SendJSON(restaurant.UserID, http.MethodPost, "/api/public/order", handler.CreateOrderSchema{
+			OrderType:    dto.OrderTypeTAKEOUT,
+			RestaurantID: restaurant.ID,
+			OrderItems: []handler.OrderItemSchema{{
+				MenuItemID:      itemID,
+				Quantity:        1,
+				ModifierOptions: mods,
+			}},
+		})
+	}
+
+	// The burger needs a topping and takes only one.
+	w = order(burger.ID)
+	s.Equal(http.StatusBadRequest, w.Code, w.Body.String())
+	w = order(burger.ID, cheese.ID, olives.ID)
+	s.Equal(http.StatusBadRequest, w.Code, w.Body.String())
+
+	// Cheese costs less on the burger than on the salad.
+	w = order(burger.ID, cheese.ID)
+	s.Require().Equal(http.StatusCreated, w.Code, w.Body.String())
+	var response utils.APIResponse[dto.Order]
+	s.Require().NoError(json.Unmarshal(w.Body.Bytes(), &response))
+	s.Equal(int64(50), response.Data.OrderItems[0].ModifierOptions[0].OptionPrice.Amount)
+
+	w = order(salad.ID)
+	s.Require().Equal(http.StatusCreated, w.Code, w.Body.String())
+	w = order(salad.ID, cheese.ID, olives.ID)
+	s.Require().Equal(http.StatusCreated, w.Code, w.Body.String())
+	response = utils.APIResponse[dto.Order]{}
+	s.Require().NoError(json.Unmarshal(w.Body.Bytes(), &response))
+	s.Equal(int64(398), response.Data.OrderItems[0].ModifiersTotal.Amount)
+
+	// The public menu shows each item's own rules.
+	req := httptest.NewRequest(http.MethodGet, fmt.Sprintf("/api/public/restaurants/%s/menu", restaurant.ID), nil)
+	rec := httptest.NewRecorder()
+	s.CreateServerWithMiddleware(DefaultMiddleware()).Engine().ServeHTTP(rec, req)
+	s.Require().Equal(http.StatusOK, rec.Code, rec.Body.String())
+	var menu utils.APIResponse[dto.PublicMenu]
+	s.Require().NoError(json.Unmarshal(rec.Body.Bytes(), &menu))
+	s.Require().Len(menu.Data.UncategorizedItems, 2)
+	for _, item := range menu.Data.UncategorizedItems {
+		s.Require().Len(item.Modifiers, 1, item.Name)
+		group := item.Modifiers[0]
+		s.Require().Len(group.Options, 2)
+		prices := map[uuid.UUID]int64{}
+		for _, option := range group.Options {
+			prices[option.ID] = option.Price.Amount
+		}
+		if item.ID == burger.ID {
+			s.True(group.Required)
+			s.Equal(1, group.Max)
+			s.Equal(int64(50), prices[cheese.ID])
+		} else {
+			s.False(group.Required)
+			s.Equal(3, group.Max)
+			s.Equal(int64(199), prices[cheese.ID])
+		}
+	}
+
+	// Clearing the overrides falls back to the modifier's own rules.
+	w = s.SendJSON(restaurant.UserID, http.MethodPut, fmt.Sprintf("%s/%d/modifiers/%s", menuItemAPIBase, burger.ID, toppings.ID), dto.MenuItemModifierOverrides{})
+	s.Require().Equal(http.StatusOK, w.Code, w.Body.String())
+	s.Require().NoError(json.Unmarshal(w.Body.Bytes(), &attached))
+	s.False(attached.Data.Modifier.Required)
+	s.Equal(3, attached.Data.Modifier.Max)
+	s.Nil(attached.Data.Max)
+	s.Empty(attached.Data.Modifier.OptionPrices)
+}
@@ -0,0 +1,142 @@
+package integration_tests
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/Jiruu246/rms/internal/dto"
+	"github.com/Jiruu246/rms/internal/handler"
+	"github.com/Jiruu246/rms/pkg/utils"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+)
+
+type MenuItemVariantTestSuite struct {
+	IntegrationTestSuite
+}
+
+func TestMenuItemVariantTestSuite(t *testing.T) {
+	suite.Run(t, new(MenuItemVariantTestSuite))
+}
+
+func (s *MenuItemVariantTestSuite) setVariants(userID uuid.UUID, itemID int64, variants ...dto.MenuItemVariantInput) *httptest.ResponseRecorder {
+	path := fmt.Sprintf("/api/menu-items/%d/variants", itemID)
+	return s.SendJSON(userID, http.MethodPut, path, dto.SetMenuItemVariantsRequest{Variants: variants})
+}
+
+func (s *MenuItemVariantTestSuite) TestSetVariants() {
+	ctx := s.T().Context()
+	restaurant, err := SetupRestaurant(s.client, ctx)
+	s.Require().NoError(err)
+	other, err := SetupRestaurant(s.client, ctx)
+	s.Require().NoError(err)
+	item, err := CreateMenuItemForRestaurant(s.client, ctx, restaurant)
+	s.Require().NoError(err)
+
+	w := s.setVariants(restaurant.UserID, item.ID,
+		dto.MenuItemVariantInput{Name: "Small", Price: 800, SKU: "LAT-S"},
+		dto.MenuItemVariantInput{Name: "Large", Price: 1100, DisplayOrder: 1},
+	)
+	s.Require().Equal(http.StatusOK, w.Code, w.Body.String())
+	var response utils.APIResponse[dto.MenuItem]
+	s.Require().NoError(json.Unmarshal(w.Body.Bytes(), &response))
+	s.Require().Len(response.Data.Variants, 2)
+	small := response.Data.Variants[0]
+	s.Equal("Small", small.Name)
+	s.Equal("LAT-S", small.SKU)
+	s.True(small.IsAvailable)
+
+	// Listed variants are kept and updated, the rest deleted.
+	w = s.setVariants(restaurant.UserID, item.ID,
+		dto.MenuItemVariantInput{ID: &small.ID, Name: "Regular", Price: 850},
+	)
+	s.Require().Equal(http.StatusOK, w.Code, w.Body.String())
+	s.Require().NoError(json.Unmarshal(w.Body.Bytes(), &response))
+	s.Require().Len(response.Data.Variants, 1)
+	s.Equal(small.ID, response.Data.Variants[0].ID)
+	s.Equal("Regular", response.Data.Variants[0].Name)
+	s.Equal(int64(850), response.Data.Variants[0].Price.Amount)
+
+	// Names must be unique within the item.
+	w = s.setVariants(restaurant.UserID, item.ID,
+		dto.MenuItemVariantInput{Name: "Regular", Price: 1},
+		dto.MenuItemVariantInput{Name: "regular", Price: 2},
+	)
+	s.Equal(http.StatusBadRequest, w.Code, w.Body.String())
+
+	// Another restaurant's owner cannot change them.
+	w = s.setVariants(other.UserID, item.ID, dto.MenuItemVariantInput{Name: "Stolen", Price: 1})
+	s.Equal(http.StatusNotFound, w.Code, w.Body.String())
+}
+
+func (s *MenuItemVariantTestSuite) TestOrderVariant() {
+	ctx := s.T().Context()
+	restaurant, err := SetupRestaurant(s.client, ctx)
+	s.Require().NoError(err)
+	item, err := CreateMenuItemForRestaurant(s.client, ctx, restaurant)
+	s.Require().NoError(err)
+	modifier, err := CreateModifierForItem(s.client, ctx, item)
+	s.Require().NoError(err)
+	option, err := CreateModifierOptionForModifier(s.client, ctx, modifier)
+	s.Require().NoError(err)
+	_, err = option.Update().SetVariantPrices(map[string]int64{"Large": 299}).Save(ctx)
+	s.Require().NoError(err)
+
+	w := s.setVariants(restaurant.UserID, item.ID,
+		dto.MenuItemVariantInput{Name: "Small", Price: 800},
+		dto.MenuItemVariantInput{Name: "Large", Price: 1100},
+	)
+	s.Require().Equal(http.StatusOK, w.Code, w.Body.String())
+	var updated utils.APIResponse[dto.MenuItem]
+	s.Require().NoError(json.Unmarshal(w.Body.Bytes(), &updated))
+	small, large := updated.Data.Variants[0], updated.Data.Variants[1]
+	s.Require().Equal("Large", large.Name)
+
+	order := func(variantID *uuid.UUID) *httptest.ResponseRecorder {
+		return s.SendJSON(restaurant.UserID, http.MethodPost, "/api/public/order", handler.CreateOrderSchema{
+			OrderType:    dto.OrderTypeTAKEOUT,
+			RestaurantID: restaurant.ID,
+			OrderItems: []handler.OrderItemSchema{{
+				MenuItemID:      item.ID,
+				VariantID:       variantID,
+				Quantity:        1,
+				ModifierOptions: []handler.ModifierOption{{ModifierID: option.ID, Quantity: 1}},
+			}},
+		})
+	}
+
+	// An item with variants needs one chosen.
+	w = order(nil)
+	s.Equal(http.StatusBadRequest, w.Code, w.Body.String())
+
+	// Variants are priced at their own price, and options at theirs for
+	// the variant if they set one.
+	w = order(&large.ID)
+	s.Require().Equal(http.StatusCreated, w.Code, w.Body.String())
+	var response utils.APIResponse[dto.Order]
+	s.Require().NoError(json.Unmarshal(w.Body.Bytes(), &response))
+	line := response.Data.OrderItems[0]
+	s.Equal(large.ID, *line.VariantID)
+	s.Equal("Large", line.VariantName)
+	s.Equal(int64(1100), line.ItemPrice.Amount)
+	s.Equal(int64(299), line.ModifierOptions[0].OptionPrice.Amount)
+
+	w = order(&small.ID)
+	s.Require().Equal(http.StatusCreated, w.Code, w.Body.String())
+	s.Require().NoError(json.Unmarshal(w.Body.Bytes(), &response))
+	line = response.Data.OrderItems[0]
+	s.Equal(int64(800), line.ItemPrice.Amount)
+	s.Equal(int64(199), line.ModifierOptions[0].OptionPrice.Amount)
+
+	// Deleting the variant keeps its name on the order.
+	w = s.setVariants(restaurant.UserID, item.ID)
+	s.Require().Equal(http.StatusOK, w.Code, w.Body.String())
+	w = s.SendJSON(restaurant.UserID, http.MethodGet, "/api/orders/"+response.Data.ID.String(), nil)
+	s.Require().Equal(http.StatusOK, w.Code, w.Body.String())
+	s.Require().NoError(json.Unmarshal(w.Body.Bytes(), &response))
+	s.Nil(response.Data.OrderItems[0].VariantID)
+	s.Equal("Small", response.Data.OrderItems[0].VariantName)
+}
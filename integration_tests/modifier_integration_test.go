@@ -33,6 +33,8 @@ func TestModifierTestSuite(t *testing.T) {
 func (s *ModifierTestSuite) TestCreateModifier() {
 	restaurant, err := SetupRestaurant(s.client, s.T().Context())
 	s.Require().NoError(err)
+	otherRestaurant, err := SetupRestaurant(s.client, s.T().Context())
+	s.Require().NoError(err)
 
 	tests := []struct {
 		testName string
@@ -55,6 +57,15 @@ func (s *ModifierTestSuite) TestCreateModifier() {
 				s.NotEqual(uuid.Nil, response.Data.ID)
 			},
 		},
+		{
+			testName: "CreateModifier_OtherRestaurant_NotFound",
+			body: dto.CreateModifierRequest{
+				Name:         "Test Modifier",
+				RestaurantID: otherRestaurant.ID,
+			},
+			expected: http.StatusNotFound,
+			validate: func(w *httptest.ResponseRecorder) {},
+		},
 	}
 
 	for _, tt := range tests {
@@ -70,7 +81,7 @@ func (s *ModifierTestSuite) TestCreateModifier() {
 			req.Header.Set("Content-Type", "application/json")
 			w := httptest.NewRecorder()
 
-			server := s.CreateServer()
+			server := s.CreateServerWithMiddleware(middlewareForUser(restaurant.UserID))
 			server.Engine().ServeHTTP(w, req)
 			s.Equal(tt.expected, w.Code)
 
@@ -80,7 +91,11 @@ func (s *ModifierTestSuite) TestCreateModifier() {
 }
 
 func (s *ModifierTestSuite) TestGetModifier() {
-	initialModifier, err := CreateModifier(s.client, s.T().Context())
+	restaurant, err := SetupRestaurant(s.client, s.T().Context())
+	s.Require().NoError(err)
+	initialModifier, err := CreateModifierForRestaurant(s.client, s.T().Context(), restaurant)
+	s.Require().NoError(err)
+	otherOwnerModifier, err := CreateModifier(s.client, s.T().Context())
 	s.Require().NoError(err)
 	_, err = initialModifier.Update().
 		SetName("Initial Modifier").
@@ -117,6 +132,37 @@ func (s *ModifierTestSuite) TestGetModifier() {
 				s.Equal("Initial Modifier", response.Data.Name)
 			},
 		},
+		{
+			// ErrForbidden renders as 404, as for a missing modifier.
+			testName: "GetModifierByID_OtherRestaurant_NotFound",
+			url:      path.Join(modifierAPIBase, otherOwnerModifier.ID.String()),
+			expected: http.StatusNotFound,
+			validate: func(w *httptest.ResponseRecorder) {},
+		},
+		{
+			testName: "GetAllModifiers",
+			url:      modifierAPIBase + "?restaurant_id=" + restaurant.ID.String(),
+			expected: http.StatusOK,
+			validate: func(w *httptest.ResponseRecorder) {
+				var response utils.APIResponse[[]dto.Modifier]
+				err := json.Unmarshal(w.Body.Bytes(), &response)
+				s.Require().NoError(err)
+				s.Require().Len(response.Data, 1)
+				s.Equal(initialModifier.ID, response.Data[0].ID)
+			},
+		},
+		{
+			testName: "GetAllModifiers_MissingRestaurantID",
+			url:      modifierAPIBase,
+			expected: http.StatusBadRequest,
+			validate: func(w *httptest.ResponseRecorder) {},
+		},
+		{
+			testName: "GetAllModifiers_OtherRestaurant_NotFound",
+			url:      modifierAPIBase + "?restaurant_id=" + otherOwnerModifier.RestaurantID.String(),
+			expected: http.StatusNotFound,
+			validate: func(w *httptest.ResponseRecorder) {},
+		},
 	}
 
 	for _, tt := range tests {
@@ -125,7 +171,7 @@ func (s *ModifierTestSuite) TestGetModifier() {
 			req.Header.Set("Content-Type", "application/json")
 			w := httptest.NewRecorder()
 
-			server := s.CreateServer()
+			server := s.CreateServerWithMiddleware(middlewareForUser(restaurant.UserID))
 			server.Engine().ServeHTTP(w, req)
 			s.Equal(tt.expected, w.Code)
 
@@ -135,7 +181,11 @@ func (s *ModifierTestSuite) TestGetModifier() {
 }
 
 func (s *ModifierTestSuite) TestUpdateModifier() {
-	initialModifier, err := CreateModifier(s.client, s.T().Context())
+	restaurant, err := SetupRestaurant(s.client, s.T().Context())
+	s.Require().NoError(err)
+	initialModifier, err := CreateModifierForRestaurant(s.client, s.T().Context(), restaurant)
+	s.Require().NoError(err)
+	otherOwnerModifier, err := CreateModifier(s.client, s.T().Context())
 	s.Require().NoError(err)
 
 	_, err = initialModifier.Update().
@@ -165,6 +215,25 @@ func (s *ModifierTestSuite) TestUpdateModifier() {
 				s.Equal("Updated Modifier", updatedModifier.Data.Name)
 			},
 		},
+		{
+			testName: "UpdateModifier_OtherRestaurant_NotFound",
+			url:      path.Join(modifierAPIBase, otherOwnerModifier.ID.String()),
+			body:     dto.UpdateModifierRequest{Name: ptr("Hijacked")},
+			expected: http.StatusNotFound,
+			validate: func(w *httptest.ResponseRecorder) {},
+		},
+		{
+			// A modifier cannot be moved to another restaurant.
+			testName: "UpdateModifier_RestaurantIDIgnored",
+			url:      path.Join(modifierAPIBase, initialModifier.ID.String()),
+			body:     map[string]any{"restaurant_id": otherOwnerModifier.RestaurantID},
+			expected: http.StatusOK,
+			validate: func(w *httptest.ResponseRecorder) {
+				var updatedModifier utils.APIResponse[dto.Modifier]
+				s.Require().NoError(json.Unmarshal(w.Body.Bytes(), &updatedModifier))
+				s.Equal(initialModifier.RestaurantID, updatedModifier.Data.RestaurantID)
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -180,7 +249,7 @@ func (s *ModifierTestSuite) TestUpdateModifier() {
 			req.Header.Set("Content-Type", "application/json")
 			w := httptest.NewRecorder()
 
-			server := s.CreateServer()
+			server := s.CreateServerWithMiddleware(middlewareForUser(restaurant.UserID))
 			server.Engine().ServeHTTP(w, req)
 			s.Equal(tt.expected, w.Code)
 
@@ -190,7 +259,11 @@ func (s *ModifierTestSuite) TestUpdateModifier() {
 }
 
 func (s *ModifierTestSuite) TestDeleteModifier() {
-	initialModifier, err := CreateModifier(s.client, s.T().Context())
+	restaurant, err := SetupRestaurant(s.client, s.T().Context())
+	s.Require().NoError(err)
+	initialModifier, err := CreateModifierForRestaurant(s.client, s.T().Context(), restaurant)
+	s.Require().NoError(err)
+	otherOwnerModifier, err := CreateModifier(s.client, s.T().Context())
 	s.Require().NoError(err)
 
 	tests := []struct {
@@ -203,6 +276,11 @@ func (s *ModifierTestSuite) TestDeleteModifier() {
 			url:      path.Join(modifierAPIBase, initialModifier.ID.String()),
 			expected: http.StatusNoContent,
 		},
+		{
+			testName: "DeleteModifier_OtherRestaurant_NotFound",
+			url:      path.Join(modifierAPIBase, otherOwnerModifier.ID.String()),
+			expected: http.StatusNotFound,
+		},
 	}
 
 	for _, tt := range tests {
@@ -211,7 +289,7 @@ func (s *ModifierTestSuite) TestDeleteModifier() {
 			req.Header.Set("Content-Type", "application/json")
 			w := httptest.NewRecorder()
 
-			server := s.CreateServer()
+			server := s.CreateServerWithMiddleware(middlewareForUser(restaurant.UserID))
 			server.Engine().ServeHTTP(w, req)
 			s.Equal(tt.expected, w.Code)
 		})
@@ -31,7 +31,11 @@ func TestModifierOptionTestSuite(t *testing.T) {
 }
 
 func (s *ModifierOptionTestSuite) TestCreateModifierOption() {
-	modifier, err := CreateModifier(s.client, s.T().Context())
+	restaurant, err := SetupRestaurant(s.client, s.T().Context())
+	s.Require().NoError(err)
+	modifier, err := CreateModifierForRestaurant(s.client, s.T().Context(), restaurant)
+	s.Require().NoError(err)
+	otherOwnerModifier, err := CreateModifier(s.client, s.T().Context())
 	s.Require().NoError(err)
 
 	tests := []struct {
@@ -55,6 +59,16 @@ func (s *ModifierOptionTestSuite) TestCreateModifierOption() {
 				s.NotEqual(uuid.Nil, response.Data.ID)
 			},
 		},
+		{
+			// Options are created under the modifier's restaurant.
+			testName: "CreateModifierOption_OtherRestaurant_NotFound",
+			body: dto.CreateModifierOptionRequest{
+				Name:       "Test Modifier Option",
+				ModifierID: otherOwnerModifier.ID,
+			},
+			expected: http.StatusNotFound,
+			validate: func(w *httptest.ResponseRecorder) {},
+		},
 	}
 
 	for _, tt := range tests {
@@ -70,7 +84,7 @@ func (s *ModifierOptionTestSuite) TestCreateModifierOption() {
 			req.Header.Set("Content-Type", "application/json")
 			w := httptest.NewRecorder()
 
-			server := s.CreateServer()
+			server := s.CreateServerWithMiddleware(middlewareForUser(restaurant.UserID))
 			server.Engine().ServeHTTP(w, req)
 			s.Equal(tt.expected, w.Code)
 
@@ -80,12 +94,20 @@ func (s *ModifierOptionTestSuite) TestCreateModifierOption() {
 }
 
 func (s *ModifierOptionTestSuite) TestGetModifierOption() {
-	initialOption, err := CreateModifierOption(s.client, s.T().Context())
+	restaurant, err := SetupRestaurant(s.client, s.T().Context())
+	s.Require().NoError(err)
+	modifier, err := CreateModifierForRestaurant(s.client, s.T().Context(), restaurant)
+	s.Require().NoError(err)
+	initialOption, err := CreateModifierOptionForModifier(s.client, s.T().Context(), modifier)
+	s.Require().NoError(err)
+	otherOwnerOption, err := CreateModifierOption(s.client, s.T().Context())
 	s.Require().NoError(err)
 	_, err = initialOption.Update().
 		SetName("Initial Modifier Option").
 		Save(s.T().Context())
 	s.Require().NoError(err)
+	otherRestaurant, err := otherOwnerOption.QueryModifier().QueryRestaurant().Only(s.T().Context())
+	s.Require().NoError(err)
 
 	tests := []struct {
 		testName string
@@ -117,6 +139,37 @@ func (s *ModifierOptionTestSuite) TestGetModifierOption() {
 				s.Equal("Initial Modifier Option", response.Data.Name)
 			},
 		},
+		{
+			// ErrForbidden renders as 404, as for a missing option.
+			testName: "GetModifierOptionByID_OtherRestaurant_NotFound",
+			url:      path.Join(modifierOptionAPIBase, otherOwnerOption.ID.String()),
+			expected: http.StatusNotFound,
+			validate: func(w *httptest.ResponseRecorder) {},
+		},
+		{
+			testName: "GetAllModifierOptions",
+			url:      modifierOptionAPIBase + "?restaurant_id=" + restaurant.ID.String(),
+			expected: http.StatusOK,
+			validate: func(w *httptest.ResponseRecorder) {
+				var response utils.APIResponse[[]dto.ModifierOption]
+				err := json.Unmarshal(w.Body.Bytes(), &response)
+				s.Require().NoError(err)
+				s.Require().Len(response.Data, 1)
+				s.Equal(initialOption.ID, response.Data[0].ID)
+			},
+		},
+		{
+			testName: "GetAllModifierOptions_MissingRestaurantID",
+			url:      modifierOptionAPIBase,
+			expected: http.StatusBadRequest,
+			validate: func(w *httptest.ResponseRecorder) {},
+		},
+		{
+			testName: "GetAllModifierOptions_OtherRestaurant_NotFound",
+			url:      modifierOptionAPIBase + "?restaurant_id=" + otherRestaurant.ID.String(),
+			expected: http.StatusNotFound,
+			validate: func(w *httptest.ResponseRecorder) {},
+		},
 	}
 
 	for _, tt := range tests {
@@ -125,7 +178,7 @@ func (s *ModifierOptionTestSuite) TestGetModifierOption() {
 			req.Header.Set("Content-Type", "application/json")
 			w := httptest.NewRecorder()
 
-			server := s.CreateServer()
+			server := s.CreateServerWithMiddleware(middlewareForUser(restaurant.UserID))
 			server.Engine().ServeHTTP(w, req)
 			s.Equal(tt.expected, w.Code)
 
@@ -135,7 +188,13 @@ func (s *ModifierOptionTestSuite) TestGetModifierOption() {
 }
 
 func (s *ModifierOptionTestSuite) TestUpdateModifierOption() {
-	initialOption, err := CreateModifierOption(s.client, s.T().Context())
+	restaurant, err := SetupRestaurant(s.client, s.T().Context())
+	s.Require().NoError(err)
+	modifier, err := CreateModifierForRestaurant(s.client, s.T().Context(), restaurant)
+	s.Require().NoError(err)
+	initialOption, err := CreateModifierOptionForModifier(s.client, s.T().Context(), modifier)
+	s.Require().NoError(err)
+	otherOwnerOption, err := CreateModifierOption(s.client, s.T().Context())
 	s.Require().NoError(err)
 
 	_, err = initialOption.Update().
@@ -165,6 +224,21 @@ func (s *ModifierOptionTestSuite) TestUpdateModifierOption() {
 				s.Equal("Updated Modifier Option", updatedOption.Data.Name)
 			},
 		},
+		{
+			testName: "UpdateModifierOption_OtherRestaurant_NotFound",
+			url:      path.Join(modifierOptionAPIBase, otherOwnerOption.ID.String()),
+			body:     dto.UpdateModifierOptionRequest{Name: ptr("Hijacked")},
+			expected: http.StatusNotFound,
+			validate: func(w *httptest.ResponseRecorder) {},
+		},
+		{
+			// Options only move between modifiers of the same restaurant.
+			testName: "UpdateModifierOption_MoveToOtherRestaurant_BadRequest",
+			url:      path.Join(modifierOptionAPIBase, initialOption.ID.String()),
+			body:     dto.UpdateModifierOptionRequest{ModifierID: &otherOwnerOption.ModifierID},
+			expected: http.StatusBadRequest,
+			validate: func(w *httptest.ResponseRecorder) {},
+		},
 	}
 
 	for _, tt := range tests {
@@ -180,7 +254,7 @@ func (s *ModifierOptionTestSuite) TestUpdateModifierOption() {
 			req.Header.Set("Content-Type", "application/json")
 			w := httptest.NewRecorder()
 
-			server := s.CreateServer()
+			server := s.CreateServerWithMiddleware(middlewareForUser(restaurant.UserID))
 			server.Engine().ServeHTTP(w, req)
 			s.Equal(tt.expected, w.Code)
 
@@ -190,7 +264,13 @@ func (s *ModifierOptionTestSuite) TestUpdateModifierOption() {
 }
 
 func (s *ModifierOptionTestSuite) TestDeleteModifierOption() {
-	initialOption, err := CreateModifierOption(s.client, s.T().Context())
+	restaurant, err := SetupRestaurant(s.client, s.T().Context())
+	s.Require().NoError(err)
+	modifier, err := CreateModifierForRestaurant(s.client, s.T().Context(), restaurant)
+	s.Require().NoError(err)
+	initialOption, err := CreateModifierOptionForModifier(s.client, s.T().Context(), modifier)
+	s.Require().NoError(err)
+	otherOwnerOption, err := CreateModifierOption(s.client, s.T().Context())
 	s.Require().NoError(err)
 
 	tests := []struct {
@@ -203,6 +283,11 @@ func (s *ModifierOptionTestSuite) TestDeleteModifierOption() {
 			url:      path.Join(modifierOptionAPIBase, initialOption.ID.String()),
 			expected: http.StatusNoContent,
 		},
+		{
+			testName: "DeleteModifierOption_OtherRestaurant_NotFound",
+			url:      path.Join(modifierOptionAPIBase, otherOwnerOption.ID.String()),
+			expected: http.StatusNotFound,
+		},
 	}
 
 	for _, tt := range tests {
@@ -211,7 +296,7 @@ func (s *ModifierOptionTestSuite) TestDeleteModifierOption() {
 			req.Header.Set("Content-Type", "application/json")
 			w := httptest.NewRecorder()
 
-			server := s.CreateServer()
+			server := s.CreateServerWithMiddleware(middlewareForUser(restaurant.UserID))
 			server.Engine().ServeHTTP(w, req)
 			s.Equal(tt.expected, w.Code)
 		})
@@ -0,0 +1,164 @@
+package integration_tests
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/Jiruu246/rms/internal/dto"
+	"github.com/Jiruu246/rms/internal/ent"
+	"github.com/Jiruu246/rms/internal/handler"
+	"github.com/Jiruu246/rms/pkg/utils"
+	"github.com/stretchr/testify/suite"
+)
+
+type ModifierRulesTestSuite struct {
+	IntegrationTestSuite
+}
+
+func TestModifierRulesTestSuite(t *testing.T) {
+	suite.Run(t, new(ModifierRulesTestSuite))
+}
+
+func (s *ModifierRulesTestSuite) TestModifierBounds() {
+	restaurant, err := SetupRestaurant(s.client, s.T().Context())
+	s.Require().NoError(err)
+
+	w := s.SendJSON(restaurant.UserID, http.MethodPost, modifierAPIBase, dto.CreateModifierRequest{
+		Name:         "Toppings",
+		MultiSelect:  true,
+		Min:          3,
+		Max:          2,
+		RestaurantID: restaurant.ID,
+	})
+	s.Equal(http.StatusBadRequest, w.Code, w.Body.String())
+
+	w = s.SendJSON(restaurant.UserID, http.MethodPost, modifierAPIBase, dto.CreateModifierRequest{
+		Name:         "Toppings",
+		MultiSelect:  true,
+		Min:          1,
+		Max:          3,
+		FreeQuantity: 2,
+		RestaurantID: restaurant.ID,
+	})
+	s.Require().Equal(http.StatusCreated, w.Code, w.Body.String())
+	var response utils.APIResponse[dto.Modifier]
+	s.Require().NoError(json.Unmarshal(w.Body.Bytes(), &response))
+	s.Equal(1, response.Data.Min)
+	s.Equal(2, response.Data.FreeQuantity)
+
+	// The min is checked against the stored max.
+	raised := 4
+	w = s.SendJSON(restaurant.UserID, http.MethodPatch, fmt.Sprintf("%s/%s", modifierAPIBase, response.Data.ID), dto.UpdateModifierRequest{Min: &raised})
+	s.Equal(http.StatusBadRequest, w.Code, w.Body.String())
+}
+
+// toppings sets up an item with a Toppings group taking two to three
+// selections, the first two free, from Cheese (at most two) and Olives,
+// and a single-select Bread group whose White option is pre-selected. Bread
+// allows two units so that ordering two breads is down to multi_select.
+func (s *ModifierRulesTestSuite) toppings() (restaurant *ent.Restaurant, item *ent.MenuItem, cheese, olives, white, rye *ent.ModifierOption) {
+	ctx := s.T().Context()
+	restaurant, err := SetupRestaurant(s.client, ctx)
+	s.Require().NoError(err)
+	item, err = CreateMenuItemForRestaurant(s.client, ctx, restaurant)
+	s.Require().NoError(err)
+
+	toppings, err := CreateModifierForItem(s.client, ctx, item)
+	s.Require().NoError(err)
+	toppings, err = toppings.Update().
+		SetName("Toppings").
+		SetMultiSelect(true).
+		SetMin(2).
+		SetMax(3).
+		SetFreeQuantity(2).
+		Save(ctx)
+	s.Require().NoError(err)
+	cheese, err = CreateModifierOptionForModifier(s.client, ctx, toppings)
+	s.Require().NoError(err)
+	cheese, err = cheese.Update().SetName("Cheese").SetPrice(150).SetMaxQuantity(2).Save(ctx)
+	s.Require().NoError(err)
+	olives, err = CreateModifierOptionForModifier(s.client, ctx, toppings)
+	s.Require().NoError(err)
+	olives, err = olives.Update().SetName("Olives").SetPrice(100).Save(ctx)
+	s.Require().NoError(err)
+
+	bread, err := CreateModifierForItem(s.client, ctx, item)
+	s.Require().NoError(err)
+	bread, err = bread.Update().SetName("Bread").SetMax(2).Save(ctx)
+	s.Require().NoError(err)
+	white, err = CreateModifierOptionForModifier(s.client, ctx, bread)
+	s.Require().NoError(err)
+	white, err = white.Update().SetName("White").SetPrice(0).SetPreSelect(true).Save(ctx)
+	s.Require().NoError(err)
+	rye, err = CreateModifierOptionForModifier(s.client, ctx, bread)
+	s.Require().NoError(err)
+	rye, err = rye.Update().SetName("Rye").SetPrice(50).Save(ctx)
+	s.Require().NoError(err)
+	return restaurant, item, cheese, olives, white, rye
+}
+
+func (s *ModifierRulesTestSuite) TestOrderSelectionRules() {
+	restaurant, item, cheese, olives, white, rye := s.toppings()
+	order := func(mods ...handler.ModifierOption) *httptest.ResponseRecorder {
+		return s.SendJSON(restaurant.UserID, http.MethodPost, "/api/public/order", handler.CreateOrderSchema{
+			OrderType:    dto.OrderTypeTAKEOUT,
+			RestaurantID: restaurant.ID,
+			OrderItems: []handler.OrderItemSchema{{
+				MenuItemID:      item.ID,
+				Quantity:        1,
+				ModifierOptions: mods,
+			}},
+		})
+	}
+
+	// Below the min of two toppings.
+	w := order(handler.ModifierOption{ModifierID: olives.ID, Quantity: 1})
+	s.Equal(http.StatusBadRequest, w.Code, w.Body.String())
+	s.Contains(w.Body.String(), "needs at least 2")
+
+	// Over Cheese's max_quantity.
+	w = order(handler.ModifierOption{ModifierID: cheese.ID, Quantity: 3})
+	s.Equal(http.StatusBadRequest, w.Code, w.Body.String())
+	s.Contains(w.Body.String(), "at most 2 times")
+
+	// Bread takes a single option.
+	w = order(
+		handler.ModifierOption{ModifierID: olives.ID, Quantity: 2},
+		handler.ModifierOption{ModifierID: white.ID, Quantity: 1},
+		handler.ModifierOption{ModifierID: rye.ID, Quantity: 1},
+	)
+	s.Equal(http.StatusBadRequest, w.Code, w.Body.String())
+	s.Contains(w.Body.String(), "takes a single option")
+
+	// White bread is added by default, and the two olives are the free
+	// toppings: only the cheese is charged.
+	w = order(
+		handler.ModifierOption{ModifierID: cheese.ID, Quantity: 1},
+		handler.ModifierOption{ModifierID: olives.ID, Quantity: 2},
+	)
+	s.Require().Equal(http.StatusCreated, w.Code, w.Body.String())
+	var response utils.APIResponse[dto.Order]
+	s.Require().NoError(json.Unmarshal(w.Body.Bytes(), &response))
+	line := response.Data.OrderItems[0]
+	s.Require().Len(line.ModifierOptions, 3)
+	s.Equal(int64(150), line.ModifiersTotal.Amount)
+	names := make(map[string]int, len(line.ModifierOptions))
+	for _, mod := range line.ModifierOptions {
+		names[mod.OptionName] = mod.FreeQuantity
+	}
+	s.Equal(map[string]int{"Cheese": 0, "Olives": 2, "White": 0}, names)
+
+	// Ordering none of the White bread leaves the Bread group empty.
+	w = order(
+		handler.ModifierOption{ModifierID: olives.ID, Quantity: 2},
+		handler.ModifierOption{ModifierID: white.ID, Quantity: 0},
+	)
+	s.Require().Equal(http.StatusCreated, w.Code, w.Body.String())
+	response = utils.APIResponse[dto.Order]{}
+	s.Require().NoError(json.Unmarshal(w.Body.Bytes(), &response))
+	s.Require().Len(response.Data.OrderItems[0].ModifierOptions, 1)
+	s.Equal("Olives", response.Data.OrderItems[0].ModifierOptions[0].OptionName)
+}
@@ -0,0 +1,136 @@
+package integration_tests
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/Jiruu246/rms/internal/dto"
+	"github.com/Jiruu246/rms/internal/ent"
+	"github.com/Jiruu246/rms/internal/handler"
+	"github.com/Jiruu246/rms/pkg/utils"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+)
+
+type NestedModifierTestSuite struct {
+	IntegrationTestSuite
+}
+
+func TestNestedModifierTestSuite(t *testing.T) {
+	suite.Run(t, new(NestedModifierTestSuite))
+}
+
+// combo sets up a Combo item with a required Side group, whose Fries option
+// opens a required Size group.
+func (s *NestedModifierTestSuite) combo() (restaurant *ent.Restaurant, item *ent.MenuItem, side, size *ent.Modifier, fries, large *ent.ModifierOption) {
+	ctx := s.T().Context()
+	restaurant, err := SetupRestaurant(s.client, ctx)
+	s.Require().NoError(err)
+	item, err = CreateMenuItemForRestaurant(s.client, ctx, restaurant)
+	s.Require().NoError(err)
+	side, err = CreateModifierForItem(s.client, ctx, item)
+	s.Require().NoError(err)
+	side, err = side.Update().SetName("Side").SetRequired(true).Save(ctx)
+	s.Require().NoError(err)
+	size, err = s.client.Modifier.Create().
+		SetName("Size").
+		SetRequired(true).
+		SetRestaurantID(restaurant.ID).
+		Save(ctx)
+	s.Require().NoError(err)
+	large, err = CreateModifierOptionForModifier(s.client, ctx, size)
+	s.Require().NoError(err)
+
+	w := s.SendJSON(restaurant.UserID, http.MethodPost, modifierOptionAPIBase, dto.CreateModifierOptionRequest{
+		Name:             "Fries",
+		Price:            300,
+		Available:        true,
+		ModifierID:       side.ID,
+		ChildModifierIDs: []uuid.UUID{size.ID},
+	})
+	s.Require().Equal(http.StatusCreated, w.Code, w.Body.String())
+	var response utils.APIResponse[dto.ModifierOption]
+	s.Require().NoError(json.Unmarshal(w.Body.Bytes(), &response))
+	s.Require().Len(response.Data.ChildModifiers, 1)
+	s.Equal(size.ID, response.Data.ChildModifiers[0].ID)
+	fries, err = s.client.ModifierOption.Get(ctx, response.Data.ID)
+	s.Require().NoError(err)
+	return restaurant, item, side, size, fries, large
+}
+
+func (s *NestedModifierTestSuite) TestLinkChildModifiers() {
+	ctx := s.T().Context()
+	restaurant, _, side, size, fries, large := s.combo()
+	other, err := SetupRestaurant(s.client, ctx)
+	s.Require().NoError(err)
+	otherModifier, err := CreateModifierForRestaurant(s.client, ctx, other)
+	s.Require().NoError(err)
+	path := fmt.Sprintf("%s/%s", modifierOptionAPIBase, large.ID)
+
+	// A group cannot lead back to itself: Side -> Fries -> Size -> Large -> Side.
+	w := s.SendJSON(restaurant.UserID, http.MethodPatch, path, dto.UpdateModifierOptionRequest{ChildModifierIDs: &[]uuid.UUID{side.ID}})
+	s.Equal(http.StatusBadRequest, w.Code, w.Body.String())
+	w = s.SendJSON(restaurant.UserID, http.MethodPatch, path, dto.UpdateModifierOptionRequest{ChildModifierIDs: &[]uuid.UUID{size.ID}})
+	s.Equal(http.StatusBadRequest, w.Code, w.Body.String())
+
+	// Child groups must be the same restaurant's.
+	w = s.SendJSON(restaurant.UserID, http.MethodPatch, path, dto.UpdateModifierOptionRequest{ChildModifierIDs: &[]uuid.UUID{otherModifier.ID}})
+	s.Equal(http.StatusBadRequest, w.Code, w.Body.String())
+
+	// An empty list clears them.
+	w = s.SendJSON(restaurant.UserID, http.MethodPatch, fmt.Sprintf("%s/%s", modifierOptionAPIBase, fries.ID), dto.UpdateModifierOptionRequest{ChildModifierIDs: &[]uuid.UUID{}})
+	s.Require().Equal(http.StatusOK, w.Code, w.Body.String())
+	var response utils.APIResponse[dto.ModifierOption]
+	s.Require().NoError(json.Unmarshal(w.Body.Bytes(), &response))
+	s.Empty(response.Data.ChildModifiers)
+}
+
+func (s *NestedModifierTestSuite) TestOrderNestedSelection() {
+	restaurant, item, _, _, fries, large := s.combo()
+	order := func(side handler.ModifierOption) *httptest.ResponseRecorder {
+		return s.SendJSON(restaurant.UserID, http.MethodPost, "/api/public/order", handler.CreateOrderSchema{
+			OrderType:    dto.OrderTypeTAKEOUT,
+			RestaurantID: restaurant.ID,
+			OrderItems: []handler.OrderItemSchema{{
+				MenuItemID:      item.ID,
+				Quantity:        2,
+				ModifierOptions: []handler.ModifierOption{side},
+			}},
+		})
+	}
+
+	// Fries need a size.
+	w := order(handler.ModifierOption{ModifierID: fries.ID, Quantity: 1})
+	s.Equal(http.StatusBadRequest, w.Code, w.Body.String())
+
+	w = order(handler.ModifierOption{
+		ModifierID: fries.ID,
+		Quantity:   1,
+		Modifiers:  []handler.ModifierOption{{ModifierID: large.ID, Quantity: 1}},
+	})
+	s.Require().Equal(http.StatusCreated, w.Code, w.Body.String())
+	var response utils.APIResponse[dto.Order]
+	s.Require().NoError(json.Unmarshal(w.Body.Bytes(), &response))
+	line := response.Data.OrderItems[0]
+	s.Require().Len(line.ModifierOptions, 1)
+	s.Equal("Fries", line.ModifierOptions[0].OptionName)
+	s.Require().Len(line.ModifierOptions[0].ModifierOptions, 1)
+	s.Equal(large.ID, line.ModifierOptions[0].ModifierOptions[0].ModifierOptionID)
+	// (300 fries + 199 large) for each of the two combos.
+	s.Equal(int64(998), line.ModifiersTotal.Amount)
+
+	// The public menu nests the Size group under Fries.
+	w = s.SendJSON(restaurant.UserID, http.MethodGet, fmt.Sprintf("/api/public/restaurants/%s/menu", restaurant.ID), nil)
+	s.Require().Equal(http.StatusOK, w.Code, w.Body.String())
+	var menu utils.APIResponse[dto.PublicMenu]
+	s.Require().NoError(json.Unmarshal(w.Body.Bytes(), &menu))
+	s.Require().Len(menu.Data.UncategorizedItems, 1)
+	side := menu.Data.UncategorizedItems[0].Modifiers[0]
+	s.Equal("Side", side.Name)
+	s.Require().Len(side.Options, 1)
+	s.Require().Len(side.Options[0].Modifiers, 1)
+	s.Equal("Size", side.Options[0].Modifiers[0].Name)
+}
@@ -0,0 +1,97 @@
+package integration_tests
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/Jiruu246/rms/internal/dto"
+	"github.com/google/uuid"
+)
+
+// streamOrders reads the restaurant's order stream for a short while and
+// returns the events it sent.
+func (s *OrderTestSuite) streamOrders(userID, restaurantID uuid.UUID, lastEventID string) (*httptest.ResponseRecorder, []dto.OrderEvent) {
+	ctx, cancel := context.WithTimeout(s.T().Context(), 500*time.Millisecond)
+	defer cancel()
+	req := httptest.NewRequestWithContext(ctx, http.MethodGet, orderAPIBase+"/stream?restaurant_id="+restaurantID.String(), nil)
+	if lastEventID != "" {
+		req.Header.Set("Last-Event-ID", lastEventID)
+	}
+	w := httptest.NewRecorder()
+	s.CreateServerWithMiddleware(middlewareForUser(userID)).Engine().ServeHTTP(w, req)
+
+	var events []dto.OrderEvent
+	scanner := bufio.NewScanner(bytes.NewReader(w.Body.Bytes()))
+	for scanner.Scan() {
+		if data, ok := strings.CutPrefix(scanner.Text(), "data: "); ok {
+			var event dto.OrderEvent
+			s.Require().NoError(json.Unmarshal([]byte(data), &event))
+			events = append(events, event)
+		}
+	}
+	return w, events
+}
+
+func (s *OrderTestSuite) TestOrderStream() {
+	ctx := s.T().Context()
+	restaurant, err := SetupRestaurant(s.client, ctx)
+	s.Require().NoError(err)
+	menuItem, err := CreateMenuItemForRestaurant(s.client, ctx, restaurant)
+	s.Require().NoError(err)
+
+	first := s.placeOrder(restaurant.ID, menuItem.ID)
+	second := s.placeOrder(restaurant.ID, menuItem.ID)
+
+	body, err := json.Marshal(dto.UpdateOrderRequest{OrderStatus: ptrString(string(dto.OrderStatusCONFIRMED))})
+	s.Require().NoError(err)
+	req := httptest.NewRequest(http.MethodPatch, path.Join(orderAPIBase, first.ID.String()), bytes.NewBuffer(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	s.CreateServerWithMiddleware(middlewareForUser(restaurant.UserID)).Engine().ServeHTTP(w, req)
+	s.Require().Equal(http.StatusOK, w.Code, w.Body.String())
+
+	s.Run("ReplaysFromLastEventID", func() {
+		w, events := s.streamOrders(restaurant.UserID, restaurant.ID, "0")
+		s.Equal(http.StatusOK, w.Code)
+		s.Equal("text/event-stream", w.Header().Get("Content-Type"))
+
+		s.Require().Len(events, 3)
+		s.Equal([]int64{1, 2, 3}, []int64{events[0].ID, events[1].ID, events[2].ID})
+		s.Equal(dto.OrderEventCreated, events[0].Type)
+		s.Equal(first.ID, events[0].OrderID)
+		s.Equal(dto.OrderEventCreated, events[1].Type)
+		s.Equal(second.ID, events[1].OrderID)
+		s.Equal(dto.OrderEventStatusChanged, events[2].Type)
+		s.Require().NotNil(events[2].Order)
+		s.Equal(dto.OrderStatusCONFIRMED, events[2].Order.OrderStatus)
+	})
+
+	s.Run("ResumesAfterLastEventID", func() {
+		_, events := s.streamOrders(restaurant.UserID, restaurant.ID, "2")
+		s.Require().Len(events, 1)
+		s.Equal(int64(3), events[0].ID)
+	})
+
+	s.Run("WithoutLastEventIDOnlyNewEvents", func() {
+		_, events := s.streamOrders(restaurant.UserID, restaurant.ID, "")
+		s.Empty(events)
+	})
+
+	s.Run("InvalidLastEventID", func() {
+		w, _ := s.streamOrders(restaurant.UserID, restaurant.ID, "abc")
+		s.Equal(http.StatusBadRequest, w.Code)
+	})
+
+	s.Run("OtherUsersCannotStream", func() {
+		w, events := s.streamOrders(uuid.New(), restaurant.ID, "0")
+		s.Equal(http.StatusNotFound, w.Code)
+		s.Empty(events)
+	})
+}
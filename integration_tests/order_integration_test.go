@@ -6,11 +6,14 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"path"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/Jiruu246/rms/internal/dto"
 	"github.com/Jiruu246/rms/internal/ent/order"
 	"github.com/Jiruu246/rms/internal/handler"
+	"github.com/Jiruu246/rms/pkg/money"
 	"github.com/Jiruu246/rms/pkg/utils"
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
@@ -30,6 +33,10 @@ func TestOrderTestSuite(t *testing.T) {
 func (s *OrderTestSuite) TestCreateOrder() {
 	restaurant, err := SetupRestaurant(s.client, s.T().Context())
 	s.Require().NoError(err)
+	restaurant, err = s.client.Restaurant.UpdateOne(restaurant).
+		SetTaxRateBps(1000).
+		Save(s.T().Context())
+	s.Require().NoError(err)
 
 	menuItem, err := CreateMenuItemForRestaurant(s.client, s.T().Context(), restaurant)
 	s.Require().NoError(err)
@@ -106,6 +113,17 @@ func (s *OrderTestSuite) TestCreateOrder() {
 				s.Require().NotNil(modOpt2, "Modifier option 2 not found in response")
 				s.Equal(1, modOpt1.Quantity)
 				s.Equal(2, modOpt2.Quantity)
+
+				// 2 x 9.99 with (1 x 1.99 + 2 x 1.99) of modifiers per unit, 10% tax.
+				usd := func(amount int64) money.Money { return money.New(amount, "USD") }
+				s.Equal(money.Currency("USD"), response.Data.Currency)
+				s.Equal(usd(999), orderItem.ItemPrice)
+				s.Equal(usd(1194), orderItem.ModifiersTotal)
+				s.Equal(usd(3192), orderItem.LineTotal)
+				s.Equal(usd(3192), response.Data.Subtotal)
+				s.Equal(usd(1194), response.Data.ModifiersTotal)
+				s.Equal(usd(319), response.Data.TaxTotal)
+				s.Equal(usd(3511), response.Data.Total)
 			},
 		},
 	}
@@ -134,6 +152,10 @@ func (s *OrderTestSuite) TestCreateOrder() {
 func (s *OrderTestSuite) TestGetOrder() {
 	order, err := SetupOrder(s.client, s.T().Context())
 	s.Require().NoError(err)
+	restaurant, err := s.client.Restaurant.Get(s.T().Context(), order.RestaurantID)
+	s.Require().NoError(err)
+	otherOwnerOrder, err := SetupOrder(s.client, s.T().Context())
+	s.Require().NoError(err)
 
 	tests := []struct {
 		testName string
@@ -165,6 +187,19 @@ func (s *OrderTestSuite) TestGetOrder() {
 				s.Equal(order.RestaurantID, response.Data.RestaurantID)
 			},
 		},
+		{
+			// ErrForbidden renders as 404, as for a missing order.
+			testName: "GetOrderByID_OtherRestaurant_NotFound",
+			url:      path.Join(orderAPIBase, otherOwnerOrder.ID.String()),
+			expected: http.StatusNotFound,
+			validate: func(w *httptest.ResponseRecorder) {},
+		},
+		{
+			testName: "GetOrderHistory_OtherRestaurant_NotFound",
+			url:      path.Join(orderAPIBase, otherOwnerOrder.ID.String(), "history"),
+			expected: http.StatusNotFound,
+			validate: func(w *httptest.ResponseRecorder) {},
+		},
 	}
 
 	for _, tt := range tests {
@@ -173,7 +208,7 @@ func (s *OrderTestSuite) TestGetOrder() {
 			req.Header.Set("Content-Type", "application/json")
 			w := httptest.NewRecorder()
 
-			server := s.CreateServer()
+			server := s.CreateServerWithMiddleware(middlewareForUser(restaurant.UserID))
 			server.Engine().ServeHTTP(w, req)
 			s.Equal(tt.expected, w.Code)
 
@@ -187,9 +222,12 @@ func (s *OrderTestSuite) TestGetOrders() {
 	s.Require().NoError(err)
 	_, err = s.client.Order.Create().
 		SetOrderType(order.OrderTypeDINE_IN).
+		SetCurrency(restaurant.Currency).
 		SetRestaurant(restaurant).
 		Save(s.T().Context())
 	s.Require().NoError(err)
+	otherOwnerOrder, err := SetupOrder(s.client, s.T().Context())
+	s.Require().NoError(err)
 
 	tests := []struct {
 		testName string
@@ -221,6 +259,12 @@ func (s *OrderTestSuite) TestGetOrders() {
 			expected: http.StatusBadRequest,
 			validate: func(w *httptest.ResponseRecorder) {},
 		},
+		{
+			testName: "GetOrdersByRestaurantID_OtherRestaurant_NotFound",
+			url:      orderAPIBase + "?restaurant_id=" + otherOwnerOrder.RestaurantID.String(),
+			expected: http.StatusNotFound,
+			validate: func(w *httptest.ResponseRecorder) {},
+		},
 	}
 
 	for _, tt := range tests {
@@ -229,7 +273,7 @@ func (s *OrderTestSuite) TestGetOrders() {
 			req.Header.Set("Content-Type", "application/json")
 			w := httptest.NewRecorder()
 
-			server := s.CreateServer()
+			server := s.CreateServerWithMiddleware(middlewareForUser(restaurant.UserID))
 			server.Engine().ServeHTTP(w, req)
 			s.Equal(tt.expected, w.Code)
 
@@ -241,6 +285,10 @@ func (s *OrderTestSuite) TestGetOrders() {
 func (s *OrderTestSuite) TestUpdateOrder() {
 	order, err := SetupOrder(s.client, s.T().Context())
 	s.Require().NoError(err)
+	restaurant, err := s.client.Restaurant.Get(s.T().Context(), order.RestaurantID)
+	s.Require().NoError(err)
+	otherOwnerOrder, err := SetupOrder(s.client, s.T().Context())
+	s.Require().NoError(err)
 
 	tests := []struct {
 		testName string
@@ -250,18 +298,87 @@ func (s *OrderTestSuite) TestUpdateOrder() {
 		validate func(*httptest.ResponseRecorder)
 	}{
 		{
-			testName: "UpdateOrder_Success",
+			testName: "UpdateOrder_IllegalTransition",
 			url:      path.Join(orderAPIBase, order.ID.String()),
 			body: dto.UpdateOrderRequest{
 				OrderStatus: ptrString(string(dto.OrderStatusCOMPLETED)),
 			},
+			expected: http.StatusConflict,
+			validate: func(w *httptest.ResponseRecorder) {},
+		},
+		{
+			testName: "UpdateOrder_InvalidStatus",
+			url:      path.Join(orderAPIBase, order.ID.String()),
+			body: dto.UpdateOrderRequest{
+				OrderStatus: ptrString("SERVED"),
+			},
+			expected: http.StatusBadRequest,
+			validate: func(w *httptest.ResponseRecorder) {},
+		},
+		{
+			testName: "UpdateOrder_InvalidType",
+			url:      path.Join(orderAPIBase, order.ID.String()),
+			body: dto.UpdateOrderRequest{
+				OrderType: ptrString("BOAT"),
+			},
+			expected: http.StatusBadRequest,
+			validate: func(w *httptest.ResponseRecorder) {},
+		},
+		{
+			// The order type is fixed once the order is placed.
+			testName: "UpdateOrder_ChangeType",
+			url:      path.Join(orderAPIBase, order.ID.String()),
+			body: dto.UpdateOrderRequest{
+				OrderType: ptrString(string(dto.OrderTypeDELIVERY)),
+			},
+			expected: http.StatusConflict,
+			validate: func(w *httptest.ResponseRecorder) {},
+		},
+		{
+			testName: "UpdateOrder_Success",
+			url:      path.Join(orderAPIBase, order.ID.String()),
+			body: dto.UpdateOrderRequest{
+				OrderStatus: ptrString(string(dto.OrderStatusCONFIRMED)),
+			},
 			expected: http.StatusOK,
 			validate: func(w *httptest.ResponseRecorder) {
 				var response utils.APIResponse[dto.Order]
 				err := json.Unmarshal(w.Body.Bytes(), &response)
 				s.Require().NoError(err)
 				s.Equal(order.ID, response.Data.ID)
-				s.Equal(dto.OrderStatusCOMPLETED, response.Data.OrderStatus)
+				s.Equal(dto.OrderStatusCONFIRMED, response.Data.OrderStatus)
+			},
+		},
+		{
+			testName: "UpdateOrder_NotFound",
+			url:      path.Join(orderAPIBase, uuid.New().String()),
+			body: dto.UpdateOrderRequest{
+				OrderStatus: ptrString(string(dto.OrderStatusCONFIRMED)),
+			},
+			expected: http.StatusNotFound,
+			validate: func(w *httptest.ResponseRecorder) {},
+		},
+		{
+			testName: "UpdateOrder_OtherRestaurant_NotFound",
+			url:      path.Join(orderAPIBase, otherOwnerOrder.ID.String()),
+			body: dto.UpdateOrderRequest{
+				OrderStatus: ptrString(string(dto.OrderStatusCONFIRMED)),
+			},
+			expected: http.StatusNotFound,
+			validate: func(w *httptest.ResponseRecorder) {},
+		},
+		{
+			// An order cannot be moved to another restaurant.
+			testName: "UpdateOrder_RestaurantIDIgnored",
+			url:      path.Join(orderAPIBase, order.ID.String()),
+			body: map[string]any{
+				"restaurant_id": otherOwnerOrder.RestaurantID,
+			},
+			expected: http.StatusOK,
+			validate: func(w *httptest.ResponseRecorder) {
+				var response utils.APIResponse[dto.Order]
+				s.Require().NoError(json.Unmarshal(w.Body.Bytes(), &response))
+				s.Equal(order.RestaurantID, response.Data.RestaurantID)
 			},
 		},
 	}
@@ -279,7 +396,7 @@ func (s *OrderTestSuite) TestUpdateOrder() {
 			req.Header.Set("Content-Type", "application/json")
 			w := httptest.NewRecorder()
 
-			server := s.CreateServer()
+			server := s.CreateServerWithMiddleware(middlewareForUser(restaurant.UserID))
 			server.Engine().ServeHTTP(w, req)
 			s.Equal(tt.expected, w.Code)
 
@@ -288,9 +405,93 @@ func (s *OrderTestSuite) TestUpdateOrder() {
 	}
 }
 
+func (s *OrderTestSuite) TestOrderStatusLifecycle() {
+	restaurant, err := SetupRestaurant(s.client, s.T().Context())
+	s.Require().NoError(err)
+	menuItem, err := CreateMenuItemForRestaurant(s.client, s.T().Context(), restaurant)
+	s.Require().NoError(err)
+
+	server := s.CreateServerWithMiddleware(middlewareForUser(restaurant.UserID))
+
+	body, err := json.Marshal(handler.CreateOrderSchema{
+		OrderType:    dto.OrderTypeTAKEOUT,
+		RestaurantID: restaurant.ID,
+		OrderItems:   []handler.OrderItemSchema{{MenuItemID: menuItem.ID, Quantity: 1}},
+	})
+	s.Require().NoError(err)
+	req := httptest.NewRequest(http.MethodPost, "/api/public/order", bytes.NewBuffer(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	server.Engine().ServeHTTP(w, req)
+	s.Require().Equal(http.StatusCreated, w.Code, w.Body.String())
+
+	var created utils.APIResponse[dto.Order]
+	s.Require().NoError(json.Unmarshal(w.Body.Bytes(), &created))
+	orderURL := path.Join(orderAPIBase, created.Data.ID.String())
+
+	steps := []struct {
+		status   dto.OrderStatus
+		reason   string
+		expected int
+	}{
+		{status: dto.OrderStatusCONFIRMED, expected: http.StatusOK},
+		{status: dto.OrderStatusOPEN, expected: http.StatusConflict},
+		{status: dto.OrderStatusCANCELLED, reason: "customer left", expected: http.StatusOK},
+		{status: dto.OrderStatusCONFIRMED, expected: http.StatusConflict},
+		{status: dto.OrderStatusCOMPLETED, expected: http.StatusConflict},
+	}
+	for _, step := range steps {
+		req := dto.UpdateOrderRequest{OrderStatus: ptrString(string(step.status))}
+		if step.reason != "" {
+			req.Reason = ptrString(step.reason)
+		}
+		body, err := json.Marshal(req)
+		s.Require().NoError(err)
+
+		r := httptest.NewRequest(http.MethodPatch, orderURL, bytes.NewBuffer(body))
+		r.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+		server.Engine().ServeHTTP(w, r)
+		s.Equal(step.expected, w.Code, "transition to %s: %s", step.status, w.Body.String())
+	}
+
+	r := httptest.NewRequest(http.MethodGet, orderURL+"/history", nil)
+	w = httptest.NewRecorder()
+	server.Engine().ServeHTTP(w, r)
+	s.Require().Equal(http.StatusOK, w.Code)
+
+	var history utils.APIResponse[[]dto.OrderStatusEvent]
+	s.Require().NoError(json.Unmarshal(w.Body.Bytes(), &history))
+	s.Require().Len(history.Data, 3)
+
+	s.Nil(history.Data[0].FromStatus)
+	s.Equal(dto.OrderStatusOPEN, history.Data[0].ToStatus)
+	s.Nil(history.Data[0].ChangedBy, "public orders have no creator")
+
+	s.Require().NotNil(history.Data[1].FromStatus)
+	s.Equal(dto.OrderStatusOPEN, *history.Data[1].FromStatus)
+	s.Equal(dto.OrderStatusCONFIRMED, history.Data[1].ToStatus)
+	s.Require().NotNil(history.Data[1].ChangedBy)
+	s.Equal(restaurant.UserID, *history.Data[1].ChangedBy)
+
+	s.Require().NotNil(history.Data[2].FromStatus)
+	s.Equal(dto.OrderStatusCONFIRMED, *history.Data[2].FromStatus)
+	s.Equal(dto.OrderStatusCANCELLED, history.Data[2].ToStatus)
+	s.Equal("customer left", history.Data[2].Reason)
+
+	r = httptest.NewRequest(http.MethodGet, path.Join(orderAPIBase, uuid.New().String(), "history"), nil)
+	w = httptest.NewRecorder()
+	server.Engine().ServeHTTP(w, r)
+	s.Equal(http.StatusNotFound, w.Code)
+}
+
 func (s *OrderTestSuite) TestDeleteOrder() {
 	order, err := SetupOrder(s.client, s.T().Context())
 	s.Require().NoError(err)
+	restaurant, err := s.client.Restaurant.Get(s.T().Context(), order.RestaurantID)
+	s.Require().NoError(err)
+	otherOwnerOrder, err := SetupOrder(s.client, s.T().Context())
+	s.Require().NoError(err)
 
 	tests := []struct {
 		testName string
@@ -302,6 +503,11 @@ func (s *OrderTestSuite) TestDeleteOrder() {
 			url:      path.Join(orderAPIBase, order.ID.String()),
 			expected: http.StatusNoContent,
 		},
+		{
+			testName: "DeleteOrder_OtherRestaurant_NotFound",
+			url:      path.Join(orderAPIBase, otherOwnerOrder.ID.String()),
+			expected: http.StatusNotFound,
+		},
 	}
 
 	for _, tt := range tests {
@@ -310,7 +516,7 @@ func (s *OrderTestSuite) TestDeleteOrder() {
 			req.Header.Set("Content-Type", "application/json")
 			w := httptest.NewRecorder()
 
-			server := s.CreateServer()
+			server := s.CreateServerWithMiddleware(middlewareForUser(restaurant.UserID))
 			server.Engine().ServeHTTP(w, req)
 			s.Equal(tt.expected, w.Code)
 		})
@@ -703,7 +909,7 @@ func (s *OrderTestSuite) TestCreateOrderItemValidations() {
 					},
 				},
 			},
-			expected: http.StatusInternalServerError,
+			expected: http.StatusBadRequest,
 		},
 		{
 			testName: "ValidOrderWithOptionalModifiers",
@@ -743,3 +949,165 @@ func (s *OrderTestSuite) TestCreateOrderItemValidations() {
 		})
 	}
 }
+
+func (s *OrderTestSuite) placeOrder(restaurantID uuid.UUID, menuItemID int64) dto.Order {
+	body, err := json.Marshal(handler.CreateOrderSchema{
+		OrderType:    dto.OrderTypeTAKEOUT,
+		RestaurantID: restaurantID,
+		OrderItems:   []handler.OrderItemSchema{{MenuItemID: menuItemID, Quantity: 1}},
+	})
+	s.Require().NoError(err)
+	req := httptest.NewRequest(http.MethodPost, "/api/public/order", bytes.NewBuffer(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	s.CreateServer().Engine().ServeHTTP(w, req)
+	s.Require().Equal(http.StatusCreated, w.Code, w.Body.String())
+
+	var response utils.APIResponse[dto.Order]
+	s.Require().NoError(json.Unmarshal(w.Body.Bytes(), &response))
+	return response.Data
+}
+
+func (s *OrderTestSuite) TestOrderNumbers() {
+	ctx := s.T().Context()
+	restaurant, err := SetupRestaurant(s.client, ctx)
+	s.Require().NoError(err)
+	menuItem, err := CreateMenuItemForRestaurant(s.client, ctx, restaurant)
+	s.Require().NoError(err)
+	other, err := SetupRestaurant(s.client, ctx)
+	s.Require().NoError(err)
+	otherItem, err := CreateMenuItemForRestaurant(s.client, ctx, other)
+	s.Require().NoError(err)
+
+	s.Run("SequentialPerRestaurant", func() {
+		first := s.placeOrder(restaurant.ID, menuItem.ID)
+		second := s.placeOrder(restaurant.ID, menuItem.ID)
+		otherFirst := s.placeOrder(other.ID, otherItem.ID)
+
+		s.Require().NotNil(first.OrderNumber)
+		s.Require().NotNil(second.OrderNumber)
+		s.Require().NotNil(otherFirst.OrderNumber)
+		s.Equal(1, *first.OrderNumber)
+		s.Equal(2, *second.OrderNumber)
+		s.Equal(1, *otherFirst.OrderNumber)
+		s.Empty(first.OrderNumberPeriod)
+	})
+
+	s.Run("ConcurrentOrdersGetDistinctNumbers", func() {
+		busy, err := SetupRestaurant(s.client, ctx)
+		s.Require().NoError(err)
+		busyItem, err := CreateMenuItemForRestaurant(s.client, ctx, busy)
+		s.Require().NoError(err)
+		body, err := json.Marshal(handler.CreateOrderSchema{
+			OrderType:    dto.OrderTypeTAKEOUT,
+			RestaurantID: busy.ID,
+			OrderItems:   []handler.OrderItemSchema{{MenuItemID: busyItem.ID, Quantity: 1}},
+		})
+		s.Require().NoError(err)
+
+		const n = 10
+		server := s.CreateServer()
+		numbers := make(chan int, n)
+		var wg sync.WaitGroup
+		for range n {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				req := httptest.NewRequest(http.MethodPost, "/api/public/order", bytes.NewBuffer(body))
+				req.Header.Set("Content-Type", "application/json")
+				w := httptest.NewRecorder()
+				server.Engine().ServeHTTP(w, req)
+				var response utils.APIResponse[dto.Order]
+				if w.Code == http.StatusCreated && json.Unmarshal(w.Body.Bytes(), &response) == nil && response.Data.OrderNumber != nil {
+					numbers <- *response.Data.OrderNumber
+				}
+			}()
+		}
+		wg.Wait()
+		close(numbers)
+
+		seen := make(map[int]bool)
+		for number := range numbers {
+			seen[number] = true
+		}
+		s.Len(seen, n)
+		for i := 1; i <= n; i++ {
+			s.True(seen[i], "order number %d was not handed out", i)
+		}
+	})
+
+	s.Run("SearchByNumber", func() {
+		req := httptest.NewRequest(http.MethodGet, orderAPIBase+"?restaurant_id="+restaurant.ID.String()+"&order_number=2", nil)
+		w := httptest.NewRecorder()
+		s.CreateServerWithMiddleware(middlewareForUser(restaurant.UserID)).Engine().ServeHTTP(w, req)
+		s.Require().Equal(http.StatusOK, w.Code)
+
+		var response utils.APIResponse[[]dto.Order]
+		s.Require().NoError(json.Unmarshal(w.Body.Bytes(), &response))
+		s.Require().Len(response.Data, 1)
+		s.Equal(2, *response.Data[0].OrderNumber)
+
+		req = httptest.NewRequest(http.MethodGet, orderAPIBase+"?restaurant_id="+restaurant.ID.String()+"&order_number=abc", nil)
+		w = httptest.NewRecorder()
+		s.CreateServerWithMiddleware(middlewareForUser(restaurant.UserID)).Engine().ServeHTTP(w, req)
+		s.Equal(http.StatusBadRequest, w.Code)
+	})
+
+	s.Run("DailyResetInRestaurantTimezone", func() {
+		_, err := s.client.Restaurant.UpdateOne(restaurant).
+			SetTimezone("Pacific/Auckland").
+			SetOrderNumberReset("daily").
+			Save(ctx)
+		s.Require().NoError(err)
+
+		// A busy previous day doesn't carry over.
+		loc, err := time.LoadLocation("Pacific/Auckland")
+		s.Require().NoError(err)
+		yesterday := time.Now().In(loc).AddDate(0, 0, -1).Format(time.DateOnly)
+		_, err = s.client.OrderNumberSequence.Create().
+			SetRestaurantID(restaurant.ID).
+			SetPeriod(yesterday).
+			SetLastValue(41).
+			Save(ctx)
+		s.Require().NoError(err)
+
+		first := s.placeOrder(restaurant.ID, menuItem.ID)
+		second := s.placeOrder(restaurant.ID, menuItem.ID)
+
+		today := time.Now().In(loc).Format(time.DateOnly)
+		s.Equal(1, *first.OrderNumber)
+		s.Equal(2, *second.OrderNumber)
+		s.Equal(today, first.OrderNumberPeriod)
+	})
+}
+
+// The authenticated endpoint takes orders for the owner's restaurants only,
+// and for those even while they are not taking public orders.
+func (s *OrderTestSuite) TestCreateOrderAuthenticated() {
+	ctx := s.T().Context()
+	restaurant, err := SetupRestaurant(s.client, ctx)
+	s.Require().NoError(err)
+	menuItem, err := CreateMenuItemForRestaurant(s.client, ctx, restaurant)
+	s.Require().NoError(err)
+	other, err := SetupRestaurant(s.client, ctx)
+	s.Require().NoError(err)
+	s.Require().NoError(restaurant.Update().SetStatus("inactive").Exec(ctx))
+
+	body := handler.CreateOrderSchema{
+		OrderType:    dto.OrderTypeTAKEOUT,
+		RestaurantID: restaurant.ID,
+		OrderItems:   []handler.OrderItemSchema{{MenuItemID: menuItem.ID, Quantity: 1}},
+	}
+
+	w := s.SendJSON(other.UserID, http.MethodPost, orderAPIBase, body)
+	s.Equal(http.StatusNotFound, w.Code, w.Body.String())
+
+	w = s.SendJSON(uuid.Nil, http.MethodPost, "/api/public/order", body)
+	s.Equal(http.StatusBadRequest, w.Code, w.Body.String())
+
+	w = s.SendJSON(restaurant.UserID, http.MethodPost, orderAPIBase, body)
+	s.Require().Equal(http.StatusCreated, w.Code, w.Body.String())
+	var created utils.APIResponse[dto.Order]
+	s.Require().NoError(json.Unmarshal(w.Body.Bytes(), &created))
+	s.Equal(restaurant.ID, created.Data.RestaurantID)
+}
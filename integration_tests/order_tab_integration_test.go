@@ -0,0 +1,191 @@
+package integration_tests
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"testing"
+
+	"github.com/Jiruu246/rms/internal/dto"
+	"github.com/Jiruu246/rms/internal/ent"
+	"github.com/Jiruu246/rms/internal/handler"
+	"github.com/Jiruu246/rms/pkg/utils"
+	"github.com/stretchr/testify/suite"
+)
+
+type OrderTabTestSuite struct {
+	IntegrationTestSuite
+}
+
+func TestOrderTabTestSuite(t *testing.T) {
+	suite.Run(t, new(OrderTabTestSuite))
+}
+
+func (s *OrderTabTestSuite) decodeOrder(w *httptest.ResponseRecorder) dto.Order {
+	s.Require().Equal(http.StatusOK, w.Code, w.Body.String())
+	var response utils.APIResponse[dto.Order]
+	s.Require().NoError(json.Unmarshal(w.Body.Bytes(), &response))
+	return response.Data
+}
+
+// setupTab opens a tab at a restaurant with 10% tax: one beer (500), which
+// the bar prepares, so the order is sent to the kitchen on creation.
+func (s *OrderTabTestSuite) setupTab() (*ent.Restaurant, *ent.MenuItem, dto.Order) {
+	ctx := s.T().Context()
+	restaurant, err := SetupRestaurant(s.client, ctx)
+	s.Require().NoError(err)
+	restaurant, err = s.client.Restaurant.UpdateOne(restaurant).SetTaxRateBps(1000).Save(ctx)
+	s.Require().NoError(err)
+	bar, err := s.client.Station.Create().SetName("Bar").SetRestaurant(restaurant).Save(ctx)
+	s.Require().NoError(err)
+	beer, err := s.client.MenuItem.Create().
+		SetName("Beer").
+		SetPrice(500).
+		SetRestaurant(restaurant).
+		SetStation(bar).
+		Save(ctx)
+	s.Require().NoError(err)
+
+	w := s.SendJSON(restaurant.UserID, http.MethodPost, "/api/orders", handler.CreateOrderSchema{
+		OrderType:    dto.OrderTypeDINE_IN,
+		RestaurantID: restaurant.ID,
+		OrderItems:   []handler.OrderItemSchema{{MenuItemID: beer.ID, Quantity: 1}},
+	})
+	s.Require().Equal(http.StatusCreated, w.Code, w.Body.String())
+	var created utils.APIResponse[dto.Order]
+	s.Require().NoError(json.Unmarshal(w.Body.Bytes(), &created))
+	s.Require().NotNil(created.Data.ReleasedAt)
+	return restaurant, beer, created.Data
+}
+
+func (s *OrderTabTestSuite) TestRunningTab() {
+	restaurant, beer, tab := s.setupTab()
+	owner := restaurant.UserID
+	itemsPath := fmt.Sprintf("/api/orders/%s/items", tab.ID)
+
+	// Another round goes to the bar on a ticket of its own.
+	tab = s.decodeOrder(s.SendJSON(owner, http.MethodPost, itemsPath, handler.AddOrderItemsSchema{
+		OrderItems: []handler.OrderItemSchema{{MenuItemID: beer.ID, Quantity: 2, Notes: "pint"}},
+	}))
+	s.Require().Len(tab.OrderItems, 2)
+	s.Equal(1, tab.ItemsVersion)
+	s.Equal(int64(1500), tab.Subtotal.Amount)
+	s.Equal(int64(1650), tab.Total.Amount)
+	first, round := tab.OrderItems[0], tab.OrderItems[1]
+	if first.Quantity != 1 {
+		first, round = round, first
+	}
+	s.Require().NotNil(first.StationTicketID)
+	s.Require().NotNil(round.StationTicketID)
+	s.NotEqual(*first.StationTicketID, *round.StationTicketID)
+
+	roundPath := fmt.Sprintf("%s/%s", itemsPath, round.ID)
+
+	// Another restaurant's owner can neither change the tab nor read its changes.
+	other, err := SetupRestaurant(s.client, s.T().Context())
+	s.Require().NoError(err)
+	w := s.SendJSON(other.UserID, http.MethodPost, itemsPath, handler.AddOrderItemsSchema{
+		OrderItems: []handler.OrderItemSchema{{MenuItemID: beer.ID, Quantity: 1}},
+	})
+	s.Equal(http.StatusNotFound, w.Code, w.Body.String())
+	w = s.SendJSON(other.UserID, http.MethodGet, fmt.Sprintf("/api/orders/%s/item-changes", tab.ID), nil)
+	s.Equal(http.StatusNotFound, w.Code, w.Body.String())
+
+	// Lowering a quantity sent to the kitchen needs a reason; raising it does not.
+	w = s.SendJSON(owner, http.MethodPatch, roundPath, dto.UpdateOrderItemRequest{Quantity: intPtr(1)})
+	s.Equal(http.StatusBadRequest, w.Code, w.Body.String())
+	tab = s.decodeOrder(s.SendJSON(owner, http.MethodPatch, roundPath, dto.UpdateOrderItemRequest{
+		Quantity:            intPtr(3),
+		SpecialInstructions: ptr("half pints"),
+	}))
+	s.Equal(int64(2000), tab.Subtotal.Amount)
+
+	// So does voiding.
+	w = s.SendJSON(owner, http.MethodPost, roundPath+"/void", dto.VoidOrderItemRequest{})
+	s.Equal(http.StatusBadRequest, w.Code, w.Body.String())
+	tab = s.decodeOrder(s.SendJSON(owner, http.MethodPost, roundPath+"/void", dto.VoidOrderItemRequest{Reason: "spilled"}))
+	s.Equal(int64(500), tab.Subtotal.Amount)
+	s.Equal(int64(550), tab.Total.Amount)
+	for _, item := range tab.OrderItems {
+		if item.ID == round.ID {
+			s.NotNil(item.VoidedAt)
+			s.Equal("spilled", item.VoidReason)
+		}
+	}
+
+	// Voided lines stay voided, and the last line can't go.
+	w = s.SendJSON(owner, http.MethodPatch, roundPath, dto.UpdateOrderItemRequest{Quantity: intPtr(1)})
+	s.Equal(http.StatusConflict, w.Code, w.Body.String())
+	w = s.SendJSON(owner, http.MethodPost, fmt.Sprintf("%s/%s/void", itemsPath, first.ID), dto.VoidOrderItemRequest{Reason: "gone"})
+	s.Equal(http.StatusConflict, w.Code, w.Body.String())
+
+	w = s.SendJSON(owner, http.MethodGet, fmt.Sprintf("/api/orders/%s/item-changes", tab.ID), nil)
+	s.Require().Equal(http.StatusOK, w.Code, w.Body.String())
+	var changes utils.APIResponse[[]dto.OrderItemChange]
+	s.Require().NoError(json.Unmarshal(w.Body.Bytes(), &changes))
+	s.Require().Len(changes.Data, 3)
+	s.Equal(dto.OrderItemChangeADDED, changes.Data[0].Action)
+	s.Nil(changes.Data[0].QuantityBefore)
+	s.Equal(2, changes.Data[0].QuantityAfter)
+	s.Equal(dto.OrderItemChangeUPDATED, changes.Data[1].Action)
+	s.Equal("pint", changes.Data[1].InstructionsBefore)
+	s.Equal("half pints", changes.Data[1].InstructionsAfter)
+	s.Equal(dto.OrderItemChangeVOIDED, changes.Data[2].Action)
+	s.Equal("spilled", changes.Data[2].Reason)
+	s.Require().NotNil(changes.Data[2].ChangedBy)
+	s.Equal(owner, *changes.Data[2].ChangedBy)
+
+	// Items of a finished order are frozen.
+	w = s.SendJSON(owner, http.MethodPatch, fmt.Sprintf("/api/orders/%s", tab.ID), dto.UpdateOrderRequest{OrderStatus: ptr(string(dto.OrderStatusCANCELLED))})
+	s.Require().Equal(http.StatusOK, w.Code, w.Body.String())
+	w = s.SendJSON(owner, http.MethodPost, itemsPath, handler.AddOrderItemsSchema{
+		OrderItems: []handler.OrderItemSchema{{MenuItemID: beer.ID, Quantity: 1}},
+	})
+	s.Equal(http.StatusConflict, w.Code, w.Body.String())
+}
+
+func (s *OrderTabTestSuite) TestPaidTab() {
+	restaurant, beer, tab := s.setupTab()
+	owner := restaurant.UserID
+	itemsPath := fmt.Sprintf("/api/orders/%s/items", tab.ID)
+	addRound := func(quantity int) (dto.Order, dto.OrderItem) {
+		before := len(tab.OrderItems)
+		updated := s.decodeOrder(s.SendJSON(owner, http.MethodPost, itemsPath, handler.AddOrderItemsSchema{
+			OrderItems: []handler.OrderItemSchema{{MenuItemID: beer.ID, Quantity: quantity}},
+		}))
+		s.Require().Len(updated.OrderItems, before+1)
+		for _, item := range updated.OrderItems {
+			if !slices.ContainsFunc(tab.OrderItems, func(oi dto.OrderItem) bool { return oi.ID == item.ID }) {
+				return updated, item
+			}
+		}
+		s.FailNow("added item not found")
+		return updated, dto.OrderItem{}
+	}
+
+	tab, round := addRound(2)
+	s.Equal(int64(1650), tab.Total.Amount)
+	w := s.SendJSON(owner, http.MethodPost, fmt.Sprintf("/api/orders/%s/payments", tab.ID), dto.CreatePaymentRequest{
+		Method: dto.PaymentMethodCASH,
+		Amount: tab.Total.Amount,
+	})
+	s.Require().Equal(http.StatusCreated, w.Code, w.Body.String())
+
+	// What has been paid can't be taken off the tab without a refund.
+	w = s.SendJSON(owner, http.MethodPatch, fmt.Sprintf("%s/%s", itemsPath, round.ID), dto.UpdateOrderItemRequest{
+		Quantity: intPtr(1),
+		Reason:   ptr("one was on the house"),
+	})
+	s.Equal(http.StatusConflict, w.Code, w.Body.String())
+
+	// Another round reopens the bill, and voiding it settles it again.
+	tab, round = addRound(1)
+	s.Equal(dto.PaymentStatusPENDING, tab.PaymentStatus)
+	s.Equal(int64(2200), tab.Total.Amount)
+
+	tab = s.decodeOrder(s.SendJSON(owner, http.MethodPost, fmt.Sprintf("%s/%s/void", itemsPath, round.ID), dto.VoidOrderItemRequest{Reason: "mistake"}))
+	s.Equal(dto.PaymentStatusPAID, tab.PaymentStatus)
+	s.Equal(int64(1650), tab.Total.Amount)
+}
@@ -0,0 +1,126 @@
+package integration_tests
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/Jiruu246/rms/internal/dto"
+	"github.com/Jiruu246/rms/internal/ent"
+	"github.com/Jiruu246/rms/internal/ent/order"
+	"github.com/Jiruu246/rms/internal/payments"
+	"github.com/Jiruu246/rms/pkg/utils"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+)
+
+type PaymentTestSuite struct {
+	IntegrationTestSuite
+}
+
+func TestPaymentTestSuite(t *testing.T) {
+	suite.Run(t, new(PaymentTestSuite))
+}
+
+func (s *PaymentTestSuite) setupOrderWithTotal(total int64) (*ent.Restaurant, *ent.Order) {
+	restaurant, err := SetupRestaurant(s.client, s.T().Context())
+	s.Require().NoError(err)
+	ord, err := s.client.Order.Create().
+		SetOrderType(order.OrderTypeDINE_IN).
+		SetCurrency(restaurant.Currency).
+		SetSubtotal(total).
+		SetTotal(total).
+		SetRestaurant(restaurant).
+		Save(s.T().Context())
+	s.Require().NoError(err)
+	return restaurant, ord
+}
+
+func (s *PaymentTestSuite) capture(userID, orderID uuid.UUID, body dto.CreatePaymentRequest) *httptest.ResponseRecorder {
+	return s.SendJSON(userID, http.MethodPost, fmt.Sprintf("/api/orders/%s/payments", orderID), body)
+}
+
+func (s *PaymentTestSuite) getOrder(userID, orderID uuid.UUID) dto.Order {
+	req := httptest.NewRequest(http.MethodGet, fmt.Sprintf("/api/orders/%s", orderID), nil)
+	w := httptest.NewRecorder()
+	s.CreateServerWithMiddleware(middlewareForUser(userID)).Engine().ServeHTTP(w, req)
+	s.Require().Equal(http.StatusOK, w.Code)
+	var response utils.APIResponse[dto.Order]
+	s.Require().NoError(json.Unmarshal(w.Body.Bytes(), &response))
+	return response.Data
+}
+
+func (s *PaymentTestSuite) TestSplitTender() {
+	restaurant, ord := s.setupOrderWithTotal(3000)
+	owner := restaurant.UserID
+
+	// Part cash.
+	w := s.capture(owner, ord.ID, dto.CreatePaymentRequest{Method: dto.PaymentMethodCASH, Amount: 1000})
+	s.Require().Equal(http.StatusCreated, w.Code, w.Body.String())
+	var cash utils.APIResponse[dto.Payment]
+	s.Require().NoError(json.Unmarshal(w.Body.Bytes(), &cash))
+	s.Equal(dto.PaymentStateSUCCEEDED, cash.Data.Status)
+	s.Equal("cash", cash.Data.Provider)
+
+	current := s.getOrder(owner, ord.ID)
+	s.Equal(dto.PaymentStatusPENDING, current.PaymentStatus)
+	s.Equal(int64(1000), current.AmountPaid.Amount)
+
+	// A declined card doesn't move the balance.
+	w = s.capture(owner, ord.ID, dto.CreatePaymentRequest{
+		Method: dto.PaymentMethodCARD, Amount: 2000, CardToken: payments.FakeCardTokenDeclined,
+	})
+	s.Require().Equal(http.StatusCreated, w.Code, w.Body.String())
+	var declined utils.APIResponse[dto.Payment]
+	s.Require().NoError(json.Unmarshal(w.Body.Bytes(), &declined))
+	s.Equal(dto.PaymentStateFAILED, declined.Data.Status)
+	s.Equal("card declined", declined.Data.FailureReason)
+	s.Equal(int64(1000), s.getOrder(owner, ord.ID).AmountPaid.Amount)
+
+	// Overpaying is rejected.
+	w = s.capture(owner, ord.ID, dto.CreatePaymentRequest{Method: dto.PaymentMethodCASH, Amount: 2001})
+	s.Equal(http.StatusBadRequest, w.Code)
+
+	// Rest on card.
+	w = s.capture(owner, ord.ID, dto.CreatePaymentRequest{Method: dto.PaymentMethodCARD, Amount: 2000, CardToken: "tok_visa"})
+	s.Require().Equal(http.StatusCreated, w.Code, w.Body.String())
+
+	current = s.getOrder(owner, ord.ID)
+	s.Equal(dto.PaymentStatusPAID, current.PaymentStatus)
+	s.Equal(int64(3000), current.AmountPaid.Amount)
+
+	// Nothing more can be taken.
+	w = s.capture(owner, ord.ID, dto.CreatePaymentRequest{Method: dto.PaymentMethodCASH, Amount: 1})
+	s.Equal(http.StatusConflict, w.Code)
+
+	req := httptest.NewRequest(http.MethodGet, fmt.Sprintf("/api/orders/%s/payments", ord.ID), nil)
+	rec := httptest.NewRecorder()
+	s.CreateServerWithMiddleware(middlewareForUser(owner)).Engine().ServeHTTP(rec, req)
+	s.Require().Equal(http.StatusOK, rec.Code)
+	var list utils.APIResponse[[]dto.Payment]
+	s.Require().NoError(json.Unmarshal(rec.Body.Bytes(), &list))
+	s.Require().Len(list.Data, 3)
+	s.Equal(dto.PaymentMethodCASH, list.Data[0].Method)
+	s.Equal(dto.PaymentStateFAILED, list.Data[1].Status)
+	s.Equal(dto.PaymentMethodCARD, list.Data[2].Method)
+}
+
+func (s *PaymentTestSuite) TestCaptureValidation() {
+	restaurant, ord := s.setupOrderWithTotal(1000)
+	owner := restaurant.UserID
+
+	w := s.capture(owner, ord.ID, dto.CreatePaymentRequest{Method: "CHEQUE", Amount: 100})
+	s.Equal(http.StatusBadRequest, w.Code)
+
+	w = s.capture(owner, ord.ID, dto.CreatePaymentRequest{Method: dto.PaymentMethodCARD, Amount: 100})
+	s.Equal(http.StatusBadRequest, w.Code)
+
+	w = s.capture(owner, uuid.New(), dto.CreatePaymentRequest{Method: dto.PaymentMethodCASH, Amount: 100})
+	s.Equal(http.StatusNotFound, w.Code)
+
+	// Someone who doesn't own the restaurant can't see the order exists.
+	w = s.capture(uuid.New(), ord.ID, dto.CreatePaymentRequest{Method: dto.PaymentMethodCASH, Amount: 100})
+	s.Equal(http.StatusNotFound, w.Code)
+}
@@ -0,0 +1,141 @@
+package integration_tests
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/Jiruu246/rms/internal/config"
+	"github.com/Jiruu246/rms/internal/dto"
+	"github.com/Jiruu246/rms/internal/handler"
+	"github.com/Jiruu246/rms/internal/repos"
+	"github.com/Jiruu246/rms/internal/server"
+	"github.com/Jiruu246/rms/pkg/ratelimit"
+	"github.com/Jiruu246/rms/pkg/utils"
+	"github.com/stretchr/testify/suite"
+)
+
+type RateLimitTestSuite struct {
+	IntegrationTestSuite
+}
+
+func TestRateLimitTestSuite(t *testing.T) {
+	suite.Run(t, new(RateLimitTestSuite))
+}
+
+// createRateLimitedServer returns a server with the given limits, backed by
+// Postgres.
+func (s *RateLimitTestSuite) createRateLimitedServer(limits config.RateLimitConfig) *server.Server {
+	cfg := *s.cfg
+	limits.Store = config.RateLimitStorePostgres
+	cfg.RateLimitConfig = limits
+	return server.New(&cfg, s.client, DefaultMiddleware())
+}
+
+func (s *RateLimitTestSuite) post(srv *server.Server, path string, body any) *httptest.ResponseRecorder {
+	w := httptest.NewRecorder()
+	srv.Engine().ServeHTTP(w, s.NewJSONRequest(http.MethodPost, path, body))
+	return w
+}
+
+func (s *RateLimitTestSuite) TestLogin() {
+	twoPerHour := ratelimit.Limit{Requests: 2, Period: time.Hour}
+	srv := s.createRateLimitedServer(config.RateLimitConfig{LoginPerEmail: twoPerHour})
+	login := dto.LoginUserRequest{Email: "victim@example.com", Password: "wrong-password"}
+
+	for range 2 {
+		s.Equal(http.StatusUnauthorized, s.post(srv, "/api/auth/login", login).Code)
+	}
+	w := s.post(srv, "/api/auth/login", login)
+	s.Equal(http.StatusTooManyRequests, w.Code)
+	s.NotEmpty(w.Header().Get("Retry-After"))
+
+	s.Run("SharedBetweenInstances", func() {
+		other := s.createRateLimitedServer(config.RateLimitConfig{LoginPerEmail: twoPerHour})
+		s.Equal(http.StatusTooManyRequests, s.post(other, "/api/auth/login", login).Code)
+	})
+
+	s.Run("OtherEmailsAreNotLimited", func() {
+		other := dto.LoginUserRequest{Email: "someone@example.com", Password: "wrong-password"}
+		s.Equal(http.StatusUnauthorized, s.post(srv, "/api/auth/login", other).Code)
+	})
+}
+
+func (s *RateLimitTestSuite) TestPublicOrder() {
+	ctx := s.T().Context()
+	restaurant, err := SetupRestaurant(s.client, ctx)
+	s.Require().NoError(err)
+	item, err := CreateMenuItemForRestaurant(s.client, ctx, restaurant)
+	s.Require().NoError(err)
+	srv := s.createRateLimitedServer(config.RateLimitConfig{
+		PublicOrderPerRestaurant: ratelimit.Limit{Requests: 5, Period: time.Hour},
+	})
+	order := handler.CreateOrderSchema{
+		OrderType:    dto.OrderTypeTAKEOUT,
+		RestaurantID: restaurant.ID,
+		OrderItems:   []handler.OrderItemSchema{{MenuItemID: item.ID, Quantity: 1}},
+	}
+
+	// Concurrent requests must not get more than the limit through.
+	codes := make(chan int, 10)
+	var wg sync.WaitGroup
+	for range 10 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			codes <- s.post(srv, "/api/public/order", order).Code
+		}()
+	}
+	wg.Wait()
+	close(codes)
+	created := 0
+	for code := range codes {
+		if code == http.StatusCreated {
+			created++
+		} else {
+			s.Equal(http.StatusTooManyRequests, code)
+		}
+	}
+	s.LessOrEqual(created, 5)
+	s.Positive(created)
+
+	s.Run("ExpiredBucketsAreDeleted", func() {
+		n, err := repos.NewEntRateLimitRepository(s.client).DeleteExpired(ctx, time.Now().Add(2*time.Hour))
+		s.Require().NoError(err)
+		s.GreaterOrEqual(n, 1)
+		s.Equal(http.StatusCreated, s.post(srv, "/api/public/order", order).Code)
+	})
+}
+
+// Orders sent with only a table token count against the table's restaurant.
+func (s *RateLimitTestSuite) TestOrdersPerRestaurant_TableToken() {
+	ctx := s.T().Context()
+	restaurant, err := SetupRestaurant(s.client, ctx)
+	s.Require().NoError(err)
+	item, err := CreateMenuItemForRestaurant(s.client, ctx, restaurant)
+	s.Require().NoError(err)
+	w := s.SendJSON(restaurant.UserID, http.MethodPost, "/api/tables", dto.CreateTableRequest{Name: "T1", Seats: 2, RestaurantID: restaurant.ID})
+	s.Require().Equal(http.StatusCreated, w.Code, w.Body.String())
+	var table utils.APIResponse[dto.Table]
+	s.Require().NoError(json.Unmarshal(w.Body.Bytes(), &table))
+
+	srv := s.createRateLimitedServer(config.RateLimitConfig{
+		PublicOrderPerRestaurant: ratelimit.Limit{Requests: 2, Period: time.Hour},
+	})
+	items := []handler.OrderItemSchema{{MenuItemID: item.ID, Quantity: 1}}
+	s.Equal(http.StatusCreated, s.post(srv, "/api/public/order", handler.CreateOrderSchema{
+		OrderType:    dto.OrderTypeTAKEOUT,
+		RestaurantID: restaurant.ID,
+		OrderItems:   items,
+	}).Code)
+	atTable := handler.CreateOrderSchema{
+		OrderType:  dto.OrderTypeDINE_IN,
+		TableToken: table.Data.QRToken,
+		OrderItems: items,
+	}
+	s.Equal(http.StatusCreated, s.post(srv, "/api/public/order", atTable).Code)
+	s.Equal(http.StatusTooManyRequests, s.post(srv, "/api/public/order", atTable).Code)
+}
@@ -0,0 +1,170 @@
+package integration_tests
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/Jiruu246/rms/internal/dto"
+	"github.com/Jiruu246/rms/internal/ent"
+	"github.com/Jiruu246/rms/internal/ent/order"
+	"github.com/Jiruu246/rms/pkg/utils"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+)
+
+type RefundTestSuite struct {
+	IntegrationTestSuite
+}
+
+func TestRefundTestSuite(t *testing.T) {
+	suite.Run(t, new(RefundTestSuite))
+}
+
+// setupPaidOrder creates an order for 2 items at 1000 each plus 10% tax
+// (2200), paid 1000 in cash and then 1200 by card.
+func (s *RefundTestSuite) setupPaidOrder() (*ent.Restaurant, *ent.Order, *ent.OrderItem) {
+	ctx := s.T().Context()
+	restaurant, err := SetupRestaurant(s.client, ctx)
+	s.Require().NoError(err)
+	menuItem, err := CreateMenuItemForRestaurant(s.client, ctx, restaurant)
+	s.Require().NoError(err)
+	ord, err := s.client.Order.Create().
+		SetOrderType(order.OrderTypeDINE_IN).
+		SetCurrency(restaurant.Currency).
+		SetSubtotal(2000).
+		SetTaxTotal(200).
+		SetTotal(2200).
+		SetRestaurant(restaurant).
+		Save(ctx)
+	s.Require().NoError(err)
+	item, err := s.client.OrderItem.Create().
+		SetOrder(ord).
+		SetMenuItem(menuItem).
+		SetItemName(menuItem.Name).
+		SetItemPrice(1000).
+		SetQuantity(2).
+		SetLineTotal(2000).
+		Save(ctx)
+	s.Require().NoError(err)
+
+	for _, p := range []dto.CreatePaymentRequest{
+		{Method: dto.PaymentMethodCASH, Amount: 1000},
+		{Method: dto.PaymentMethodCARD, Amount: 1200, CardToken: "tok_visa"},
+	} {
+		w := s.SendJSON(restaurant.UserID, http.MethodPost, fmt.Sprintf("/api/orders/%s/payments", ord.ID), p)
+		s.Require().Equal(http.StatusCreated, w.Code, w.Body.String())
+	}
+	return restaurant, ord, item
+}
+
+func (s *RefundTestSuite) refund(userID, orderID uuid.UUID, body dto.CreateRefundRequest) ([]dto.Refund, *httptest.ResponseRecorder) {
+	w := s.SendJSON(userID, http.MethodPost, fmt.Sprintf("/api/orders/%s/refunds", orderID), body)
+	if w.Code != http.StatusCreated {
+		return nil, w
+	}
+	var response utils.APIResponse[[]dto.Refund]
+	s.Require().NoError(json.Unmarshal(w.Body.Bytes(), &response))
+	return response.Data, w
+}
+
+func (s *RefundTestSuite) getOrder(userID, orderID uuid.UUID) dto.Order {
+	req := httptest.NewRequest(http.MethodGet, fmt.Sprintf("/api/orders/%s", orderID), nil)
+	w := httptest.NewRecorder()
+	s.CreateServerWithMiddleware(middlewareForUser(userID)).Engine().ServeHTTP(w, req)
+	s.Require().Equal(http.StatusOK, w.Code)
+	var response utils.APIResponse[dto.Order]
+	s.Require().NoError(json.Unmarshal(w.Body.Bytes(), &response))
+	return response.Data
+}
+
+func (s *RefundTestSuite) TestItemPartialAndFullRefunds() {
+	restaurant, ord, item := s.setupPaidOrder()
+	owner := restaurant.UserID
+
+	// One of the two items, with its share of tax, comes off the card.
+	refunds, w := s.refund(owner, ord.ID, dto.CreateRefundRequest{
+		Reason: dto.RefundReasonQUALITY_ISSUE,
+		Items:  []dto.RefundItemRequest{{OrderItemID: item.ID, Quantity: 1}},
+	})
+	s.Require().Equal(http.StatusCreated, w.Code, w.Body.String())
+	s.Require().Len(refunds, 1)
+	s.Equal(int64(1100), refunds[0].Amount.Amount)
+	s.Equal(dto.PaymentStateSUCCEEDED, refunds[0].Status)
+	s.Equal([]dto.RefundItem{{OrderItemID: item.ID, Quantity: 1}}, refunds[0].Items)
+
+	current := s.getOrder(owner, ord.ID)
+	s.Equal(dto.PaymentStatusPAID, current.PaymentStatus)
+	s.Equal(int64(1100), current.AmountRefunded.Amount)
+	s.Require().Len(current.OrderItems, 1)
+	s.Equal(1, current.OrderItems[0].RefundedQuantity)
+
+	// The same unit can't be refunded twice.
+	_, w = s.refund(owner, ord.ID, dto.CreateRefundRequest{
+		Reason: dto.RefundReasonQUALITY_ISSUE,
+		Items:  []dto.RefundItemRequest{{OrderItemID: item.ID, Quantity: 2}},
+	})
+	s.Equal(http.StatusBadRequest, w.Code)
+
+	// An arbitrary amount.
+	amount := int64(100)
+	refunds, w = s.refund(owner, ord.ID, dto.CreateRefundRequest{Reason: dto.RefundReasonOTHER, Note: "goodwill", Amount: &amount})
+	s.Require().Equal(http.StatusCreated, w.Code, w.Body.String())
+	s.Require().Len(refunds, 1)
+	s.Equal("goodwill", refunds[0].Note)
+
+	// The rest: what's left on the card, then the cash.
+	refunds, w = s.refund(owner, ord.ID, dto.CreateRefundRequest{Reason: dto.RefundReasonCUSTOMER_REQUEST, Full: true})
+	s.Require().Equal(http.StatusCreated, w.Code, w.Body.String())
+	s.Require().Len(refunds, 1)
+	s.Equal(int64(1000), refunds[0].Amount.Amount)
+
+	current = s.getOrder(owner, ord.ID)
+	s.Equal(dto.PaymentStatusREFUNDED, current.PaymentStatus)
+	s.Equal(int64(2200), current.AmountRefunded.Amount)
+
+	_, w = s.refund(owner, ord.ID, dto.CreateRefundRequest{Reason: dto.RefundReasonCUSTOMER_REQUEST, Full: true})
+	s.Equal(http.StatusConflict, w.Code)
+
+	req := httptest.NewRequest(http.MethodGet, fmt.Sprintf("/api/orders/%s/refunds", ord.ID), nil)
+	rec := httptest.NewRecorder()
+	s.CreateServerWithMiddleware(middlewareForUser(owner)).Engine().ServeHTTP(rec, req)
+	s.Require().Equal(http.StatusOK, rec.Code)
+	var list utils.APIResponse[[]dto.Refund]
+	s.Require().NoError(json.Unmarshal(rec.Body.Bytes(), &list))
+	s.Len(list.Data, 3)
+}
+
+func (s *RefundTestSuite) TestFullRefundAcrossSplitTender() {
+	restaurant, ord, _ := s.setupPaidOrder()
+
+	refunds, w := s.refund(restaurant.UserID, ord.ID, dto.CreateRefundRequest{Reason: dto.RefundReasonDUPLICATE, Full: true})
+	s.Require().Equal(http.StatusCreated, w.Code, w.Body.String())
+	s.Require().Len(refunds, 2)
+	s.Equal(int64(1200), refunds[0].Amount.Amount)
+	s.Equal(int64(1000), refunds[1].Amount.Amount)
+	s.NotEqual(refunds[0].PaymentID, refunds[1].PaymentID)
+
+	s.Equal(dto.PaymentStatusREFUNDED, s.getOrder(restaurant.UserID, ord.ID).PaymentStatus)
+}
+
+func (s *RefundTestSuite) TestRefundValidationAndAccess() {
+	restaurant, ord, _ := s.setupPaidOrder()
+
+	_, w := s.refund(restaurant.UserID, ord.ID, dto.CreateRefundRequest{Reason: "BECAUSE", Full: true})
+	s.Equal(http.StatusBadRequest, w.Code)
+
+	_, w = s.refund(restaurant.UserID, ord.ID, dto.CreateRefundRequest{Reason: dto.RefundReasonOTHER})
+	s.Equal(http.StatusBadRequest, w.Code)
+
+	amount := int64(2201)
+	_, w = s.refund(restaurant.UserID, ord.ID, dto.CreateRefundRequest{Reason: dto.RefundReasonOTHER, Amount: &amount})
+	s.Equal(http.StatusBadRequest, w.Code)
+
+	// Only the restaurant's owner can refund.
+	_, w = s.refund(uuid.New(), ord.ID, dto.CreateRefundRequest{Reason: dto.RefundReasonOTHER, Full: true})
+	s.Equal(http.StatusNotFound, w.Code)
+	s.Equal(dto.PaymentStatusPAID, s.getOrder(restaurant.UserID, ord.ID).PaymentStatus)
+}
@@ -0,0 +1,167 @@
+package integration_tests
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/Jiruu246/rms/internal/dto"
+	"github.com/Jiruu246/rms/internal/ent/stationticket"
+	"github.com/Jiruu246/rms/internal/handler"
+	"github.com/Jiruu246/rms/internal/repos"
+	"github.com/Jiruu246/rms/pkg/hours"
+	"github.com/Jiruu246/rms/pkg/utils"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+)
+
+type ScheduledOrderTestSuite struct {
+	IntegrationTestSuite
+}
+
+func TestScheduledOrderTestSuite(t *testing.T) {
+	suite.Run(t, new(ScheduledOrderTestSuite))
+}
+
+func (s *ScheduledOrderTestSuite) TestScheduledOrders() {
+	ctx := s.T().Context()
+	restaurant, err := SetupRestaurant(s.client, ctx)
+	s.Require().NoError(err)
+	day := []hours.Interval{{Open: "10:00", Close: "22:00"}}
+	restaurant, err = restaurant.Update().
+		SetOperatingHours(&hours.Schedule{Monday: day, Tuesday: day, Wednesday: day, Thursday: day, Friday: day, Saturday: day, Sunday: day}).
+		SetSlotMinutes(30).
+		SetSlotCapacity(1).
+		Save(ctx)
+	s.Require().NoError(err)
+
+	item, err := CreateMenuItemForRestaurant(s.client, ctx, restaurant)
+	s.Require().NoError(err)
+	grill, err := s.client.Station.Create().SetName("Grill").SetRestaurant(restaurant).Save(ctx)
+	s.Require().NoError(err)
+	s.Require().NoError(item.Update().SetStation(grill).Exec(ctx))
+
+	tomorrow := time.Now().UTC().AddDate(0, 0, 1).Truncate(24 * time.Hour)
+	noon := tomorrow.Add(12 * time.Hour)
+	place := func(at time.Time) *httptest.ResponseRecorder {
+		return s.SendJSON(uuid.Nil, http.MethodPost, "/api/public/order", handler.CreateOrderSchema{
+			OrderType:    dto.OrderTypeTAKEOUT,
+			RestaurantID: restaurant.ID,
+			OrderItems:   []handler.OrderItemSchema{{MenuItemID: item.ID, Quantity: 1}},
+			ScheduledFor: &at,
+		})
+	}
+
+	w := place(noon.Add(5 * time.Minute))
+	s.Require().Equal(http.StatusCreated, w.Code, w.Body.String())
+	var created utils.APIResponse[dto.Order]
+	s.Require().NoError(json.Unmarshal(w.Body.Bytes(), &created))
+	ord := created.Data
+
+	s.Run("HeldFromKitchen", func() {
+		s.Require().NotNil(ord.ScheduledFor)
+		s.True(noon.Add(5 * time.Minute).Equal(*ord.ScheduledFor))
+		s.Nil(ord.ReleasedAt)
+		count, err := s.client.StationTicket.Query().Where(stationticket.OrderIDEQ(ord.ID)).Count(ctx)
+		s.Require().NoError(err)
+		s.Zero(count)
+	})
+
+	s.Run("FullSlot", func() {
+		w := place(noon.Add(20 * time.Minute))
+		s.Equal(http.StatusBadRequest, w.Code, w.Body.String())
+		// The next slot still has room.
+		w = place(noon.Add(30 * time.Minute))
+		s.Equal(http.StatusCreated, w.Code, w.Body.String())
+	})
+
+	s.Run("OutsideHours", func() {
+		w := place(tomorrow.Add(23 * time.Hour))
+		s.Equal(http.StatusBadRequest, w.Code, w.Body.String())
+	})
+
+	s.Run("Slots", func() {
+		w := s.SendJSON(uuid.Nil, http.MethodGet, fmt.Sprintf("/api/public/restaurants/%s/slots?date=%s", restaurant.ID, tomorrow.Format(time.DateOnly)), nil)
+		s.Require().Equal(http.StatusOK, w.Code, w.Body.String())
+		var response utils.APIResponse[[]dto.TimeSlot]
+		s.Require().NoError(json.Unmarshal(w.Body.Bytes(), &response))
+		s.Require().Len(response.Data, 24)
+		s.True(tomorrow.Add(10 * time.Hour).Equal(response.Data[0].Start))
+		s.True(response.Data[0].Available)
+		s.False(response.Data[4].Available)
+		s.Equal(0, *response.Data[4].Remaining)
+		s.False(response.Data[5].Available)
+	})
+
+	s.Run("Release", func() {
+		released, err := repos.NewEntOrderRepository(s.client).ReleaseDue(ctx, noon)
+		s.Require().NoError(err)
+		s.GreaterOrEqual(released, 1)
+
+		got, err := s.client.Order.Get(ctx, ord.ID)
+		s.Require().NoError(err)
+		s.NotNil(got.ReleasedAt)
+		count, err := s.client.StationTicket.Query().Where(stationticket.OrderIDEQ(ord.ID)).Count(ctx)
+		s.Require().NoError(err)
+		s.Equal(1, count)
+	})
+}
+
+func (s *ScheduledOrderTestSuite) TestOpeningHours() {
+	ctx := s.T().Context()
+	restaurant, err := SetupRestaurant(s.client, ctx)
+	s.Require().NoError(err)
+	item, err := CreateMenuItemForRestaurant(s.client, ctx, restaurant)
+	s.Require().NoError(err)
+
+	day := []hours.Interval{{Open: "00:00", Close: "24:00"}}
+	always := hours.Schedule{Monday: day, Tuesday: day, Wednesday: day, Thursday: day, Friday: day, Saturday: day, Sunday: day}
+	today := time.Now().UTC().Truncate(24 * time.Hour)
+	holiday := always
+	holiday.Exceptions = []hours.Exception{{Date: today.Format(time.DateOnly), Note: "Holiday"}}
+	restaurant, err = restaurant.Update().SetOperatingHours(&holiday).Save(ctx)
+	s.Require().NoError(err)
+
+	openStatus := func(query string) dto.OpenStatus {
+		w := s.SendJSON(uuid.Nil, http.MethodGet, fmt.Sprintf("/api/public/restaurants/%s/open-status%s", restaurant.ID, query), nil)
+		s.Require().Equal(http.StatusOK, w.Code, w.Body.String())
+		var response utils.APIResponse[dto.OpenStatus]
+		s.Require().NoError(json.Unmarshal(w.Body.Bytes(), &response))
+		return response.Data
+	}
+	place := func() *httptest.ResponseRecorder {
+		return s.SendJSON(uuid.Nil, http.MethodPost, "/api/public/order", handler.CreateOrderSchema{
+			OrderType:    dto.OrderTypeTAKEOUT,
+			RestaurantID: restaurant.ID,
+			OrderItems:   []handler.OrderItemSchema{{MenuItemID: item.ID, Quantity: 1}},
+		})
+	}
+
+	s.Run("ClosedOnHoliday", func() {
+		status := openStatus("")
+		s.False(status.IsOpen)
+		s.Require().NotNil(status.NextOpening)
+		s.True(today.AddDate(0, 0, 1).Equal(*status.NextOpening))
+
+		s.Equal(http.StatusBadRequest, place().Code)
+	})
+
+	s.Run("OpenAtGivenTime", func() {
+		status := openStatus("?at=" + today.AddDate(0, 0, 1).Add(12*time.Hour).Format(time.RFC3339))
+		s.True(status.IsOpen)
+	})
+
+	s.Run("OpenWithoutException", func() {
+		s.Require().NoError(restaurant.Update().SetOperatingHours(&always).Exec(ctx))
+		s.Equal(http.StatusCreated, place().Code)
+	})
+
+	s.Run("Inactive", func() {
+		s.Require().NoError(restaurant.Update().SetStatus("inactive").Exec(ctx))
+		s.False(openStatus("").IsOpen)
+		s.Equal(http.StatusBadRequest, place().Code)
+	})
+}
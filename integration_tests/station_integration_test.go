@@ -0,0 +1,167 @@
+package integration_tests
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/Jiruu246/rms/internal/dto"
+	"github.com/Jiruu246/rms/internal/handler"
+	"github.com/Jiruu246/rms/pkg/utils"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+)
+
+type StationTestSuite struct {
+	IntegrationTestSuite
+}
+
+func TestStationTestSuite(t *testing.T) {
+	suite.Run(t, new(StationTestSuite))
+}
+
+func (s *StationTestSuite) createStation(userID, restaurantID uuid.UUID, name string) dto.Station {
+	w := s.SendJSON(userID, http.MethodPost, "/api/stations", dto.CreateStationRequest{Name: name, RestaurantID: restaurantID})
+	s.Require().Equal(http.StatusCreated, w.Code, w.Body.String())
+	var response utils.APIResponse[dto.Station]
+	s.Require().NoError(json.Unmarshal(w.Body.Bytes(), &response))
+	return response.Data
+}
+
+func (s *StationTestSuite) tickets(userID, stationID uuid.UUID, query string) []dto.StationTicket {
+	w := s.SendJSON(userID, http.MethodGet, fmt.Sprintf("/api/stations/%s/tickets%s", stationID, query), nil)
+	s.Require().Equal(http.StatusOK, w.Code, w.Body.String())
+	var response utils.APIResponse[[]dto.StationTicket]
+	s.Require().NoError(json.Unmarshal(w.Body.Bytes(), &response))
+	return response.Data
+}
+
+func (s *StationTestSuite) setTicketStatus(userID, ticketID uuid.UUID, status dto.TicketStatus) *httptest.ResponseRecorder {
+	return s.SendJSON(userID, http.MethodPatch, fmt.Sprintf("/api/station-tickets/%s", ticketID), dto.UpdateStationTicketRequest{Status: string(status)})
+}
+
+func (s *StationTestSuite) TestTicketRouting() {
+	ctx := s.T().Context()
+	restaurant, err := SetupRestaurant(s.client, ctx)
+	s.Require().NoError(err)
+	owner := restaurant.UserID
+
+	drinks, err := s.client.Category.Create().SetName("Drinks").SetRestaurant(restaurant).Save(ctx)
+	s.Require().NoError(err)
+	burger, err := CreateMenuItemForRestaurant(s.client, ctx, restaurant)
+	s.Require().NoError(err)
+	cola, err := s.client.MenuItem.Create().
+		SetName("Cola").
+		SetPrice(300).
+		SetRestaurant(restaurant).
+		SetCategory(drinks).
+		Save(ctx)
+	s.Require().NoError(err)
+	// A drink that is routed to the grill by itself, overriding its category.
+	shake, err := s.client.MenuItem.Create().
+		SetName("Grilled Shake").
+		SetPrice(500).
+		SetRestaurant(restaurant).
+		SetCategory(drinks).
+		Save(ctx)
+	s.Require().NoError(err)
+	unrouted, err := CreateMenuItemForRestaurant(s.client, ctx, restaurant)
+	s.Require().NoError(err)
+
+	grill := s.createStation(owner, restaurant.ID, "Grill")
+	bar := s.createStation(owner, restaurant.ID, "Bar")
+
+	w := s.SendJSON(owner, http.MethodPut, fmt.Sprintf("/api/stations/%s/routing", grill.ID), dto.SetStationRoutingRequest{
+		MenuItemIDs: []int64{burger.ID, shake.ID},
+	})
+	s.Require().Equal(http.StatusOK, w.Code, w.Body.String())
+	w = s.SendJSON(owner, http.MethodPut, fmt.Sprintf("/api/stations/%s/routing", bar.ID), dto.SetStationRoutingRequest{
+		CategoryIDs: []uuid.UUID{drinks.ID},
+	})
+	s.Require().Equal(http.StatusOK, w.Code, w.Body.String())
+
+	body, err := json.Marshal(handler.CreateOrderSchema{
+		OrderType:    dto.OrderTypeDINE_IN,
+		RestaurantID: restaurant.ID,
+		OrderItems: []handler.OrderItemSchema{
+			{MenuItemID: burger.ID, Quantity: 2},
+			{MenuItemID: cola.ID, Quantity: 1},
+			{MenuItemID: shake.ID, Quantity: 1},
+			{MenuItemID: unrouted.ID, Quantity: 1},
+		},
+	})
+	s.Require().NoError(err)
+	req := httptest.NewRequest(http.MethodPost, "/api/public/order", bytes.NewBuffer(body))
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+	s.CreateServer().Engine().ServeHTTP(rec, req)
+	s.Require().Equal(http.StatusCreated, rec.Code, rec.Body.String())
+	var created utils.APIResponse[dto.Order]
+	s.Require().NoError(json.Unmarshal(rec.Body.Bytes(), &created))
+	ord := created.Data
+
+	s.Run("OrderIsSplitPerStation", func() {
+		grillTickets := s.tickets(owner, grill.ID, "")
+		s.Require().Len(grillTickets, 1)
+		s.Equal(ord.ID, grillTickets[0].OrderID)
+		s.Equal(dto.TicketStatusQUEUED, grillTickets[0].Status)
+		names := []string{}
+		for _, item := range grillTickets[0].Items {
+			names = append(names, item.ItemName)
+		}
+		s.ElementsMatch([]string{burger.Name, shake.Name}, names)
+
+		barTickets := s.tickets(owner, bar.ID, "")
+		s.Require().Len(barTickets, 1)
+		s.Require().Len(barTickets[0].Items, 1)
+		s.Equal(cola.Name, barTickets[0].Items[0].ItemName)
+	})
+
+	s.Run("IllegalTransition", func() {
+		barTicket := s.tickets(owner, bar.ID, "")[0]
+		s.Require().Equal(http.StatusOK, s.setTicketStatus(owner, barTicket.ID, dto.TicketStatusREADY).Code)
+		s.Equal(http.StatusConflict, s.setTicketStatus(owner, barTicket.ID, dto.TicketStatusIN_PROGRESS).Code)
+		s.Empty(s.tickets(owner, bar.ID, ""))
+		s.Len(s.tickets(owner, bar.ID, "?status=READY"), 1)
+	})
+
+	s.Run("OrderBecomesReadyWithLastTicket", func() {
+		grillTicket := s.tickets(owner, grill.ID, "")[0]
+		s.Require().Equal(http.StatusOK, s.setTicketStatus(owner, grillTicket.ID, dto.TicketStatusIN_PROGRESS).Code)
+
+		got, err := s.client.Order.Get(ctx, ord.ID)
+		s.Require().NoError(err)
+		s.Equal(string(dto.OrderStatusOPEN), string(got.OrderStatus))
+
+		s.Require().Equal(http.StatusOK, s.setTicketStatus(owner, grillTicket.ID, dto.TicketStatusREADY).Code)
+
+		got, err = s.client.Order.Get(ctx, ord.ID)
+		s.Require().NoError(err)
+		s.Equal(string(dto.OrderStatusREADY), string(got.OrderStatus))
+	})
+
+	s.Run("OtherUsersCannotSeeTickets", func() {
+		w := s.SendJSON(uuid.New(), http.MethodGet, fmt.Sprintf("/api/stations/%s/tickets", grill.ID), nil)
+		s.Equal(http.StatusNotFound, w.Code)
+	})
+
+	s.Run("RoutingRejectsOtherRestaurantsItems", func() {
+		other, err := SetupRestaurant(s.client, ctx)
+		s.Require().NoError(err)
+		otherItem, err := CreateMenuItemForRestaurant(s.client, ctx, other)
+		s.Require().NoError(err)
+
+		w := s.SendJSON(owner, http.MethodPut, fmt.Sprintf("/api/stations/%s/routing", grill.ID), dto.SetStationRoutingRequest{
+			MenuItemIDs: []int64{burger.ID, otherItem.ID},
+		})
+		s.Equal(http.StatusBadRequest, w.Code)
+	})
+
+	s.Run("DuplicateName", func() {
+		w := s.SendJSON(owner, http.MethodPost, "/api/stations", dto.CreateStationRequest{Name: "Grill", RestaurantID: restaurant.ID})
+		s.Equal(http.StatusConflict, w.Code)
+	})
+}
@@ -1,10 +1,14 @@
 package integration_tests
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"log"
+	"net/http"
+	"net/http/httptest"
 	"os"
 	"time"
 
@@ -13,6 +17,7 @@ import (
 	"github.com/Jiruu246/rms/internal/server"
 	"github.com/Jiruu246/rms/pkg/database"
 	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
 	_ "github.com/jackc/pgx/v5/stdlib"
 	"github.com/joho/godotenv"
@@ -106,6 +111,31 @@ func (s *IntegrationTestSuite) CreateServer() *server.Server {
 	return s.CreateServerWithMiddleware(DefaultMiddleware())
 }
 
+// NewJSONRequest builds a request carrying body, JSON encoded unless nil.
+func (s *IntegrationTestSuite) NewJSONRequest(method, path string, body any) *http.Request {
+	var b []byte
+	if body != nil {
+		var err error
+		b, err = json.Marshal(body)
+		s.Require().NoError(err)
+	}
+	req := httptest.NewRequest(method, path, bytes.NewReader(b))
+	req.Header.Set("Content-Type", "application/json")
+	return req
+}
+
+// SendJSON sends a JSON request to a new server as userID, or
+// unauthenticated if userID is uuid.Nil, and returns the recorded response.
+func (s *IntegrationTestSuite) SendJSON(userID uuid.UUID, method, path string, body any) *httptest.ResponseRecorder {
+	srv := s.CreateServer()
+	if userID != uuid.Nil {
+		srv = s.CreateServerWithMiddleware(middlewareForUser(userID))
+	}
+	w := httptest.NewRecorder()
+	srv.Engine().ServeHTTP(w, s.NewJSONRequest(method, path, body))
+	return w
+}
+
 func (s *IntegrationTestSuite) TearDownTest() {
 	s.cleanupTestData()
 }
@@ -0,0 +1,149 @@
+package integration_tests
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/Jiruu246/rms/internal/dto"
+	"github.com/Jiruu246/rms/internal/ent/order"
+	"github.com/Jiruu246/rms/internal/handler"
+	"github.com/Jiruu246/rms/pkg/utils"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+)
+
+type TableTestSuite struct {
+	IntegrationTestSuite
+}
+
+func TestTableTestSuite(t *testing.T) {
+	suite.Run(t, new(TableTestSuite))
+}
+
+func (s *TableTestSuite) orderAtTable(token string, menuItemID int64) dto.Order {
+	w := s.SendJSON(uuid.Nil, http.MethodPost, "/api/public/order", handler.CreateOrderSchema{
+		OrderType:  dto.OrderTypeDINE_IN,
+		TableToken: token,
+		OrderItems: []handler.OrderItemSchema{{MenuItemID: menuItemID, Quantity: 1}},
+	})
+	s.Require().Equal(http.StatusCreated, w.Code, w.Body.String())
+	var response utils.APIResponse[dto.Order]
+	s.Require().NoError(json.Unmarshal(w.Body.Bytes(), &response))
+	return response.Data
+}
+
+func (s *TableTestSuite) openSession(userID, tableID uuid.UUID) *httptest.ResponseRecorder {
+	return s.SendJSON(userID, http.MethodGet, fmt.Sprintf("/api/tables/%s/session", tableID), nil)
+}
+
+func (s *TableTestSuite) TestTableOrdering() {
+	ctx := s.T().Context()
+	restaurant, err := SetupRestaurant(s.client, ctx)
+	s.Require().NoError(err)
+	owner := restaurant.UserID
+	item, err := CreateMenuItemForRestaurant(s.client, ctx, restaurant)
+	s.Require().NoError(err)
+
+	w := s.SendJSON(owner, http.MethodPost, "/api/tables", dto.CreateTableRequest{Name: "T1", Section: "Patio", Seats: 4, RestaurantID: restaurant.ID})
+	s.Require().Equal(http.StatusCreated, w.Code, w.Body.String())
+	var created utils.APIResponse[dto.Table]
+	s.Require().NoError(json.Unmarshal(w.Body.Bytes(), &created))
+	table := created.Data
+	s.Require().NotEmpty(table.QRToken)
+
+	s.Run("QRCodeResolves", func() {
+		w := s.SendJSON(uuid.Nil, http.MethodGet, "/api/public/tables/"+table.QRToken, nil)
+		s.Require().Equal(http.StatusOK, w.Code, w.Body.String())
+		var response utils.APIResponse[dto.PublicTable]
+		s.Require().NoError(json.Unmarshal(w.Body.Bytes(), &response))
+		s.Equal(table.ID, response.Data.ID)
+		s.Equal(restaurant.ID, response.Data.RestaurantID)
+	})
+
+	var sessionID uuid.UUID
+	s.Run("OrdersRollUpIntoOneSession", func() {
+		first := s.orderAtTable(table.QRToken, item.ID)
+		second := s.orderAtTable(table.QRToken, item.ID)
+		s.Require().NotNil(first.TableSessionID)
+		s.Equal(table.ID, *first.TableID)
+		s.Equal(restaurant.ID, first.RestaurantID)
+		s.Equal(first.TableSessionID, second.TableSessionID)
+		sessionID = *first.TableSessionID
+
+		w := s.openSession(owner, table.ID)
+		s.Require().Equal(http.StatusOK, w.Code, w.Body.String())
+		var response utils.APIResponse[dto.TableSession]
+		s.Require().NoError(json.Unmarshal(w.Body.Bytes(), &response))
+		s.Equal(sessionID, response.Data.ID)
+		s.Len(response.Data.Orders, 2)
+		s.Equal(first.Total.Amount+second.Total.Amount, response.Data.Total.Amount)
+		s.Equal(response.Data.Total.Amount, response.Data.Balance.Amount)
+	})
+
+	s.Run("CloseRequiresPayment", func() {
+		w := s.SendJSON(owner, http.MethodPost, fmt.Sprintf("/api/table-sessions/%s/close", sessionID), nil)
+		s.Equal(http.StatusConflict, w.Code)
+
+		_, err := s.client.Order.Update().
+			Where(order.TableSessionIDEQ(sessionID)).
+			SetPaymentStatus(order.PaymentStatusPAID).
+			Save(ctx)
+		s.Require().NoError(err)
+
+		w = s.SendJSON(owner, http.MethodPost, fmt.Sprintf("/api/table-sessions/%s/close", sessionID), nil)
+		s.Require().Equal(http.StatusOK, w.Code, w.Body.String())
+		s.Equal(http.StatusNotFound, s.openSession(owner, table.ID).Code)
+
+		w = s.SendJSON(owner, http.MethodPost, fmt.Sprintf("/api/table-sessions/%s/close", sessionID), nil)
+		s.Equal(http.StatusConflict, w.Code)
+	})
+
+	s.Run("NextOrderOpensNewSession", func() {
+		next := s.orderAtTable(table.QRToken, item.ID)
+		s.Require().NotNil(next.TableSessionID)
+		s.NotEqual(sessionID, *next.TableSessionID)
+	})
+
+	s.Run("DeleteWithOpenSession", func() {
+		w := s.SendJSON(owner, http.MethodDelete, fmt.Sprintf("/api/tables/%s", table.ID), nil)
+		s.Equal(http.StatusConflict, w.Code)
+	})
+
+	s.Run("RotatedTokenStopsWorking", func() {
+		w := s.SendJSON(owner, http.MethodPost, fmt.Sprintf("/api/tables/%s/qr-token", table.ID), nil)
+		s.Require().Equal(http.StatusOK, w.Code, w.Body.String())
+		var response utils.APIResponse[dto.Table]
+		s.Require().NoError(json.Unmarshal(w.Body.Bytes(), &response))
+		s.NotEqual(table.QRToken, response.Data.QRToken)
+
+		s.Equal(http.StatusNotFound, s.SendJSON(uuid.Nil, http.MethodGet, "/api/public/tables/"+table.QRToken, nil).Code)
+		w = s.SendJSON(uuid.Nil, http.MethodPost, "/api/public/order", handler.CreateOrderSchema{
+			OrderType:  dto.OrderTypeDINE_IN,
+			TableToken: table.QRToken,
+			OrderItems: []handler.OrderItemSchema{{MenuItemID: item.ID, Quantity: 1}},
+		})
+		s.Equal(http.StatusBadRequest, w.Code)
+	})
+
+	s.Run("InactiveTableRejectsOrders", func() {
+		inactive := false
+		w := s.SendJSON(owner, http.MethodPatch, fmt.Sprintf("/api/tables/%s", table.ID), dto.UpdateTableRequest{IsActive: &inactive})
+		s.Require().Equal(http.StatusOK, w.Code, w.Body.String())
+
+		w = s.SendJSON(owner, http.MethodPost, "/api/orders", handler.CreateOrderSchema{
+			OrderType:    dto.OrderTypeDINE_IN,
+			RestaurantID: restaurant.ID,
+			TableID:      &table.ID,
+			OrderItems:   []handler.OrderItemSchema{{MenuItemID: item.ID, Quantity: 1}},
+		})
+		s.Equal(http.StatusBadRequest, w.Code)
+	})
+
+	s.Run("OtherUsersCannotSeeTables", func() {
+		w := s.SendJSON(uuid.New(), http.MethodGet, fmt.Sprintf("/api/tables/%s", table.ID), nil)
+		s.Equal(http.StatusNotFound, w.Code)
+	})
+}
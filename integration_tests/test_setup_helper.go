@@ -65,7 +65,7 @@ func CreateMenuItemForRestaurant(client *ent.Client, ctx context.Context, restau
 	menuitem, err := client.MenuItem.Create().
 		SetName("Test Menu Item").
 		SetDescription("A test menu item description").
-		SetPrice(9.99).
+		SetPrice(999).
 		SetIsAvailable(true).
 		SetRestaurant(restaurant).
 		Save(ctx)
@@ -124,7 +124,7 @@ func CreateModifierOption(client *ent.Client, ctx context.Context) (*ent.Modifie
 func CreateModifierOptionForModifier(client *ent.Client, ctx context.Context, modifier *ent.Modifier) (*ent.ModifierOption, error) {
 	modifierOption, err := client.ModifierOption.Create().
 		SetName("Test Modifier Option").
-		SetPrice(1.99).
+		SetPrice(199).
 		SetModifier(modifier).
 		Save(ctx)
 	if err != nil {
@@ -141,6 +141,7 @@ func SetupOrder(client *ent.Client, ctx context.Context) (*ent.Order, error) {
 
 	return client.Order.Create().
 		SetOrderType(order.OrderTypeDINE_IN).
+		SetCurrency(restaurant.Currency).
 		SetRestaurant(restaurant).
 		Save(ctx)
 }
@@ -152,3 +153,7 @@ func ptrString(s string) *string {
 func ptr(s string) *string {
 	return &s
 }
+
+func intPtr(n int) *int {
+	return &n
+}
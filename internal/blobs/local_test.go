@@ -0,0 +1,32 @@
+package blobs
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLocalStore(t *testing.T) {
+	dir := t.TempDir()
+	store := NewLocalStore(dir, "/uploads")
+	ctx := context.Background()
+
+	require.NoError(t, store.Put(ctx, "items/1/image.jpg", "image/jpeg", []byte("first")))
+	require.NoError(t, store.Put(ctx, "items/1/image.jpg", "image/jpeg", []byte("second")))
+	data, err := os.ReadFile(filepath.Join(dir, "items", "1", "image.jpg"))
+	require.NoError(t, err)
+	assert.Equal(t, "second", string(data))
+	assert.Equal(t, "/uploads/items/1/image.jpg", store.URL("items/1/image.jpg"))
+
+	require.NoError(t, store.Delete(ctx, "items/1/image.jpg", "items/1/missing.jpg"))
+	_, err = os.Stat(filepath.Join(dir, "items", "1", "image.jpg"))
+	assert.ErrorIs(t, err, os.ErrNotExist)
+
+	for _, key := range []string{"", "/etc/passwd", "../escape", "items/../../escape", "items//image.jpg"} {
+		assert.Error(t, store.Put(ctx, key, "image/jpeg", []byte("x")), key)
+	}
+}
@@ -0,0 +1,66 @@
+package blobs
+
+import (
+	"context"
+	"slices"
+	"sync"
+)
+
+// MemoryStore keeps blobs in memory, for tests.
+type MemoryStore struct {
+	mu      sync.Mutex
+	blobs   map[string]memoryBlob
+	baseURL string
+}
+
+type memoryBlob struct {
+	contentType string
+	data        []byte
+}
+
+func NewMemoryStore(baseURL string) *MemoryStore {
+	return &MemoryStore{blobs: make(map[string]memoryBlob), baseURL: baseURL}
+}
+
+func (s *MemoryStore) Put(ctx context.Context, key, contentType string, data []byte) error {
+	if err := checkKey(key); err != nil {
+		return err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.blobs[key] = memoryBlob{contentType: contentType, data: slices.Clone(data)}
+	return nil
+}
+
+func (s *MemoryStore) Delete(ctx context.Context, keys ...string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, key := range keys {
+		delete(s.blobs, key)
+	}
+	return nil
+}
+
+func (s *MemoryStore) URL(key string) string {
+	return joinURL(s.baseURL, key)
+}
+
+// Get returns the blob under key and its content type.
+func (s *MemoryStore) Get(key string) (data []byte, contentType string, ok bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	blob, ok := s.blobs[key]
+	return blob.data, blob.contentType, ok
+}
+
+// Keys returns the keys of every stored blob, sorted.
+func (s *MemoryStore) Keys() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	keys := make([]string, 0, len(s.blobs))
+	for key := range s.blobs {
+		keys = append(keys, key)
+	}
+	slices.Sort(keys)
+	return keys
+}
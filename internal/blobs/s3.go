@@ -0,0 +1,186 @@
+package blobs
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+)
+
+// S3Options configures an S3Store.
+type S3Options struct {
+	// Endpoint is the service URL, e.g. https://s3.eu-west-1.amazonaws.com
+	// or a MinIO or R2 endpoint. Buckets are addressed path-style.
+	Endpoint        string
+	Region          string
+	Bucket          string
+	AccessKeyID     string
+	SecretAccessKey string
+	// BaseURL is the public URL of the bucket, or of a CDN in front of it.
+	BaseURL string
+}
+
+// S3Store keeps blobs in an S3-compatible bucket, signing requests with AWS
+// Signature Version 4. Objects must be publicly readable at BaseURL, e.g.
+// through a bucket policy.
+type S3Store struct {
+	opts   S3Options
+	client *http.Client
+	now    func() time.Time
+}
+
+func NewS3Store(opts S3Options) *S3Store {
+	return &S3Store{
+		opts:   opts,
+		client: &http.Client{Timeout: 30 * time.Second},
+		now:    time.Now,
+	}
+}
+
+func (s *S3Store) Put(ctx context.Context, key, contentType string, data []byte) error {
+	if err := checkKey(key); err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, s.objectURL(key), bytes.NewReader(data))
+	if err != nil {
+		return fmt.Errorf("failed to store blob %s: %w", key, err)
+	}
+	req.Header.Set("Content-Type", contentType)
+	return s.do(req, data, key, http.StatusOK)
+}
+
+func (s *S3Store) Delete(ctx context.Context, keys ...string) error {
+	var errs []error
+	for _, key := range keys {
+		if err := checkKey(key); err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		req, err := http.NewRequestWithContext(ctx, http.MethodDelete, s.objectURL(key), nil)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("failed to delete blob %s: %w", key, err))
+			continue
+		}
+		// S3 answers 204 whether or not the object existed; some
+		// compatible services answer 404 when it did not.
+		if err := s.do(req, nil, key, http.StatusNoContent, http.StatusOK, http.StatusNotFound); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func (s *S3Store) URL(key string) string {
+	return joinURL(s.opts.BaseURL, key)
+}
+
+func (s *S3Store) objectURL(key string) string {
+	return s.opts.Endpoint + "/" + escapePath(s.opts.Bucket) + "/" + escapePath(key)
+}
+
+// do signs and sends req, whose body is payload, and expects one of ok.
+func (s *S3Store) do(req *http.Request, payload []byte, key string, ok ...int) error {
+	sum := sha256.Sum256(payload)
+	s.sign(req, hex.EncodeToString(sum[:]), s.now())
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to %s blob %s: %w", strings.ToLower(req.Method), key, err)
+	}
+	defer resp.Body.Close()
+	if !slices.Contains(ok, resp.StatusCode) {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return fmt.Errorf("failed to %s blob %s: %s: %s", strings.ToLower(req.Method), key, resp.Status, bytes.TrimSpace(body))
+	}
+	return nil
+}
+
+// sign adds AWS Signature Version 4 headers to req, covering the host and
+// every header already set. payloadHash is the hex SHA-256 of the body.
+func (s *S3Store) sign(req *http.Request, payloadHash string, now time.Time) {
+	amzDate := now.UTC().Format("20060102T150405Z")
+	date := amzDate[:8]
+	req.Header.Set("X-Amz-Date", amzDate)
+	req.Header.Set("X-Amz-Content-Sha256", payloadHash)
+
+	host := req.Host
+	if host == "" {
+		host = req.URL.Host
+	}
+	headers := map[string]string{"host": host}
+	for name, values := range req.Header {
+		trimmed := make([]string, len(values))
+		for i, v := range values {
+			trimmed[i] = strings.Join(strings.Fields(v), " ")
+		}
+		headers[strings.ToLower(name)] = strings.Join(trimmed, ",")
+	}
+	names := make([]string, 0, len(headers))
+	for name := range headers {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	var canonicalHeaders strings.Builder
+	for _, name := range names {
+		canonicalHeaders.WriteString(name + ":" + headers[name] + "\n")
+	}
+	signedHeaders := strings.Join(names, ";")
+
+	path := req.URL.EscapedPath()
+	if path == "" {
+		path = "/"
+	}
+	canonicalRequest := strings.Join([]string{
+		req.Method,
+		path,
+		canonicalQuery(req),
+		canonicalHeaders.String(),
+		signedHeaders,
+		payloadHash,
+	}, "\n")
+
+	scope := date + "/" + s.opts.Region + "/s3/aws4_request"
+	requestHash := sha256.Sum256([]byte(canonicalRequest))
+	stringToSign := "AWS4-HMAC-SHA256\n" + amzDate + "\n" + scope + "\n" + hex.EncodeToString(requestHash[:])
+
+	key := hmacSHA256([]byte("AWS4"+s.opts.SecretAccessKey), date)
+	key = hmacSHA256(key, s.opts.Region)
+	key = hmacSHA256(key, "s3")
+	key = hmacSHA256(key, "aws4_request")
+	signature := hex.EncodeToString(hmacSHA256(key, stringToSign))
+
+	req.Header.Set("Authorization", fmt.Sprintf("AWS4-HMAC-SHA256 Credential=%s/%s,SignedHeaders=%s,Signature=%s",
+		s.opts.AccessKeyID, scope, signedHeaders, signature))
+}
+
+// canonicalQuery is req's query string sorted by name and value, encoded
+// as SigV4 expects.
+func canonicalQuery(req *http.Request) string {
+	query := req.URL.Query()
+	pairs := make([]string, 0, len(query))
+	for name, values := range query {
+		for _, v := range values {
+			pairs = append(pairs, escapeQuery(name)+"="+escapeQuery(v))
+		}
+	}
+	slices.Sort(pairs)
+	return strings.Join(pairs, "&")
+}
+
+func escapeQuery(s string) string {
+	return strings.ReplaceAll(escapePath(s), "/", "%2F")
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(data))
+	return mac.Sum(nil)
+}
@@ -0,0 +1,72 @@
+// Package blobs stores uploaded files, such as menu item images, behind the
+// Store interface: on the local filesystem, in an S3-compatible bucket, or
+// in memory for tests.
+package blobs
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"strings"
+
+	"github.com/Jiruu246/rms/internal/config"
+)
+
+// Store keeps blobs by key, a slash-separated relative path such as
+// "restaurants/{id}/menu-items/12/{uuid}.jpg", and serves them publicly at
+// URL(key).
+type Store interface {
+	// Put writes data under key, replacing anything already there.
+	Put(ctx context.Context, key, contentType string, data []byte) error
+	// Delete removes the blobs under keys. Keys with nothing stored under
+	// them are not an error.
+	Delete(ctx context.Context, keys ...string) error
+	// URL is the public URL of the blob under key.
+	URL(key string) string
+}
+
+// New returns the Store cfg configures.
+func New(cfg config.BlobConfig) Store {
+	if cfg.Store == config.BlobStoreS3 {
+		return NewS3Store(S3Options{
+			Endpoint:        cfg.S3Endpoint,
+			Region:          cfg.S3Region,
+			Bucket:          cfg.S3Bucket,
+			AccessKeyID:     cfg.S3AccessKeyID,
+			SecretAccessKey: cfg.S3SecretAccessKey,
+			BaseURL:         cfg.BaseURL,
+		})
+	}
+	return NewLocalStore(cfg.LocalDir, cfg.BaseURL)
+}
+
+// checkKey rejects keys that could escape the store's root.
+func checkKey(key string) error {
+	if key == "" || strings.HasPrefix(key, "/") || path.Clean(key) != key ||
+		key == ".." || strings.HasPrefix(key, "../") {
+		return fmt.Errorf("invalid blob key %q", key)
+	}
+	return nil
+}
+
+// joinURL appends key to baseURL, escaping each segment of key.
+func joinURL(baseURL, key string) string {
+	return baseURL + "/" + escapePath(key)
+}
+
+// escapePath percent-encodes everything in p but unreserved characters and
+// slashes, as S3 expects object keys in request paths.
+func escapePath(p string) string {
+	var b strings.Builder
+	for i := 0; i < len(p); i++ {
+		c := p[i]
+		switch {
+		case 'A' <= c && c <= 'Z', 'a' <= c && c <= 'z', '0' <= c && c <= '9',
+			c == '-', c == '_', c == '.', c == '~', c == '/':
+			b.WriteByte(c)
+		default:
+			fmt.Fprintf(&b, "%%%02X", c)
+		}
+	}
+	return b.String()
+}
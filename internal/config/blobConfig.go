@@ -0,0 +1,79 @@
+package config
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/viper"
+)
+
+const (
+	BlobStoreLocal = "local"
+	BlobStoreS3    = "s3"
+
+	defaultBlobLocalDir       = "uploads"
+	defaultBlobMaxUploadBytes = 5 << 20
+)
+
+// BlobConfig holds where uploaded images are stored and served from.
+type BlobConfig struct {
+	// Store keeps blobs on the local filesystem, served by the API itself,
+	// or in an S3-compatible bucket.
+	Store string
+	// MaxUploadBytes caps the size of an uploaded image.
+	MaxUploadBytes int64
+	// BaseURL is the public URL blobs are served under: a path on the API
+	// for the local store, by default /uploads, or a bucket or CDN URL.
+	BaseURL string
+
+	LocalDir string
+
+	// S3Endpoint is the bucket service's URL, e.g.
+	// https://s3.eu-west-1.amazonaws.com; buckets are addressed path-style.
+	S3Endpoint        string
+	S3Region          string
+	S3Bucket          string
+	S3AccessKeyID     string
+	S3SecretAccessKey string
+}
+
+func NewBlobConfig(configurator *viper.Viper) (BlobConfig, error) {
+	cfg := BlobConfig{
+		Store:             strings.ToLower(strings.TrimSpace(configurator.GetString("BLOB_STORE"))),
+		MaxUploadBytes:    configurator.GetInt64("BLOB_MAX_UPLOAD_BYTES"),
+		BaseURL:           strings.TrimSuffix(configurator.GetString("BLOB_BASE_URL"), "/"),
+		LocalDir:          configurator.GetString("BLOB_LOCAL_DIR"),
+		S3Endpoint:        strings.TrimSuffix(configurator.GetString("BLOB_S3_ENDPOINT"), "/"),
+		S3Region:          configurator.GetString("BLOB_S3_REGION"),
+		S3Bucket:          configurator.GetString("BLOB_S3_BUCKET"),
+		S3AccessKeyID:     configurator.GetString("BLOB_S3_ACCESS_KEY_ID"),
+		S3SecretAccessKey: configurator.GetString("BLOB_S3_SECRET_ACCESS_KEY"),
+	}
+	if cfg.MaxUploadBytes <= 0 {
+		cfg.MaxUploadBytes = defaultBlobMaxUploadBytes
+	}
+
+	switch cfg.Store {
+	case "", BlobStoreLocal:
+		cfg.Store = BlobStoreLocal
+		if cfg.LocalDir == "" {
+			cfg.LocalDir = defaultBlobLocalDir
+		}
+		if cfg.BaseURL == "" {
+			cfg.BaseURL = "/" + defaultBlobLocalDir
+		}
+	case BlobStoreS3:
+		if cfg.S3Endpoint == "" || cfg.S3Bucket == "" || cfg.S3AccessKeyID == "" || cfg.S3SecretAccessKey == "" {
+			return BlobConfig{}, fmt.Errorf("BLOB_STORE s3 needs BLOB_S3_ENDPOINT, BLOB_S3_BUCKET, BLOB_S3_ACCESS_KEY_ID and BLOB_S3_SECRET_ACCESS_KEY")
+		}
+		if cfg.S3Region == "" {
+			cfg.S3Region = "us-east-1"
+		}
+		if cfg.BaseURL == "" {
+			cfg.BaseURL = cfg.S3Endpoint + "/" + cfg.S3Bucket
+		}
+	default:
+		return BlobConfig{}, fmt.Errorf("invalid BLOB_STORE %q, want local or s3", cfg.Store)
+	}
+	return cfg, nil
+}
@@ -0,0 +1,47 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewBlobConfig_DefaultsToLocal(t *testing.T) {
+	blobConfig, err := NewBlobConfig(viper.New())
+
+	require.NoError(t, err)
+	require.Equal(t, BlobStoreLocal, blobConfig.Store)
+	require.Equal(t, "uploads", blobConfig.LocalDir)
+	require.Equal(t, "/uploads", blobConfig.BaseURL)
+	require.Equal(t, int64(5<<20), blobConfig.MaxUploadBytes)
+}
+
+func TestNewBlobConfig_UsesS3Settings(t *testing.T) {
+	configurator := viper.New()
+	configurator.Set("BLOB_STORE", "S3")
+	configurator.Set("BLOB_S3_ENDPOINT", "https://s3.eu-west-1.amazonaws.com/")
+	configurator.Set("BLOB_S3_BUCKET", "menus")
+	configurator.Set("BLOB_S3_ACCESS_KEY_ID", "key")
+	configurator.Set("BLOB_S3_SECRET_ACCESS_KEY", "secret")
+
+	blobConfig, err := NewBlobConfig(configurator)
+
+	require.NoError(t, err)
+	require.Equal(t, BlobStoreS3, blobConfig.Store)
+	require.Equal(t, "us-east-1", blobConfig.S3Region)
+	require.Equal(t, "https://s3.eu-west-1.amazonaws.com/menus", blobConfig.BaseURL)
+}
+
+func TestNewBlobConfig_RejectsInvalidSettings(t *testing.T) {
+	configurator := viper.New()
+	configurator.Set("BLOB_STORE", "ftp")
+	_, err := NewBlobConfig(configurator)
+	require.Error(t, err)
+
+	configurator = viper.New()
+	configurator.Set("BLOB_STORE", "s3")
+	configurator.Set("BLOB_S3_BUCKET", "menus")
+	_, err = NewBlobConfig(configurator)
+	require.Error(t, err)
+}
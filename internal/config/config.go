@@ -24,6 +24,28 @@ type Config struct {
 	RefreshTokenExp  time.Duration
 	CookieConfig     CookieConfig
 	AuthConfig       AuthConfig
+	RateLimitConfig  RateLimitConfig
+	BlobConfig       BlobConfig
+	// TrustedProxies are the proxies whose X-Forwarded-For is believed when
+	// working out a client's IP; none by default.
+	TrustedProxies []string
+
+	// OrderReleaseInterval is how often held scheduled orders are checked
+	// for release to the kitchen; 0 disables the check.
+	OrderReleaseInterval time.Duration
+	// IdempotencyTTL is how long responses to requests made with an
+	// Idempotency-Key are replayed for retries.
+	IdempotencyTTL time.Duration
+	// FakeCardPayments registers the fake card provider, which approves
+	// card payments without taking any money, in production too. Outside
+	// production it is always registered.
+	FakeCardPayments bool
+}
+
+// UseFakeCardPayments reports whether card payments go to the fake card
+// provider.
+func (c *Config) UseFakeCardPayments() bool {
+	return c.Env != "production" || c.FakeCardPayments
 }
 
 // Load reads configuration from environment variables and optional file.
@@ -40,9 +62,24 @@ func Load() (*Config, error) {
 	configurator.SetDefault("WRITE_TIMEOUT", 15)
 	configurator.SetDefault("SHUTDOWN_TIMEOUT", 15)
 	configurator.SetDefault("ALLOWED_ORIGINS", "http://localhost:3000,http://localhost:5173")
+	configurator.SetDefault("ORDER_RELEASE_INTERVAL", 30)
+	configurator.SetDefault("IDEMPOTENCY_TTL", 86400)
+	configurator.SetDefault("RATE_LIMIT_STORE", "memory")
+	configurator.SetDefault("RATE_LIMIT_ORDER_IP", "30/1m")
+	configurator.SetDefault("RATE_LIMIT_ORDER_RESTAURANT", "600/1m")
+	configurator.SetDefault("RATE_LIMIT_LOGIN_IP", "20/1m")
+	configurator.SetDefault("RATE_LIMIT_LOGIN_EMAIL", "10/15m")
 
 	CookieConfig := NewCookieConfig(configurator)
 	AuthConfig := NewAuthConfig(configurator)
+	RateLimitConfig, err := NewRateLimitConfig(configurator)
+	if err != nil {
+		return nil, err
+	}
+	BlobConfig, err := NewBlobConfig(configurator)
+	if err != nil {
+		return nil, err
+	}
 
 	cfg := &Config{
 		Env:              configurator.GetString("ENV"),
@@ -57,6 +94,13 @@ func Load() (*Config, error) {
 		AllowedOrigins:   strings.Split(configurator.GetString("ALLOWED_ORIGINS"), ","),
 		CookieConfig:     CookieConfig,
 		AuthConfig:       AuthConfig,
+		RateLimitConfig:  RateLimitConfig,
+		BlobConfig:       BlobConfig,
+		TrustedProxies:   splitList(configurator.GetString("TRUSTED_PROXIES")),
+
+		OrderReleaseInterval: time.Duration(configurator.GetInt("ORDER_RELEASE_INTERVAL")) * time.Second,
+		IdempotencyTTL:       time.Duration(configurator.GetInt("IDEMPOTENCY_TTL")) * time.Second,
+		FakeCardPayments:     configurator.GetBool("FAKE_CARD_PAYMENTS"),
 	}
 
 	if cfg.Port <= 0 {
@@ -66,6 +110,17 @@ func Load() (*Config, error) {
 	return cfg, nil
 }
 
+// splitList splits a comma-separated list, dropping empty entries.
+func splitList(s string) []string {
+	var list []string
+	for _, item := range strings.Split(s, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			list = append(list, item)
+		}
+	}
+	return list
+}
+
 func LoadTestConfig() (*Config, error) {
 	configurator := viper.New()
 	configurator.SetEnvPrefix("APP")
@@ -75,6 +130,10 @@ func LoadTestConfig() (*Config, error) {
 
 	cookieConfig := NewCookieConfig(configurator)
 	AuthConfig := NewAuthConfig(configurator)
+	BlobConfig, err := NewBlobConfig(configurator)
+	if err != nil {
+		return nil, err
+	}
 
 	cfg := &Config{
 		Env:              configurator.GetString("ENV"),
@@ -83,6 +142,7 @@ func LoadTestConfig() (*Config, error) {
 		PostgresPassword: configurator.GetString("POSTGRES_PASSWORD"),
 		CookieConfig:     cookieConfig,
 		AuthConfig:       AuthConfig,
+		BlobConfig:       BlobConfig,
 	}
 
 	return cfg, nil
@@ -0,0 +1,58 @@
+package config
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/Jiruu246/rms/pkg/ratelimit"
+	"github.com/spf13/viper"
+)
+
+const (
+	RateLimitStoreMemory   = "memory"
+	RateLimitStorePostgres = "postgres"
+)
+
+// RateLimitConfig holds the limits on unauthenticated endpoints. A zero
+// limit turns that limit off.
+type RateLimitConfig struct {
+	// Store keeps the buckets in memory, limiting each instance on its own,
+	// or in Postgres, shared by every instance.
+	Store string
+
+	PublicOrderPerIP         ratelimit.Limit
+	PublicOrderPerRestaurant ratelimit.Limit
+	LoginPerIP               ratelimit.Limit
+	LoginPerEmail            ratelimit.Limit
+}
+
+func NewRateLimitConfig(configurator *viper.Viper) (RateLimitConfig, error) {
+	cfg := RateLimitConfig{
+		Store: strings.ToLower(strings.TrimSpace(configurator.GetString("RATE_LIMIT_STORE"))),
+	}
+	switch cfg.Store {
+	case "":
+		cfg.Store = RateLimitStoreMemory
+	case RateLimitStoreMemory, RateLimitStorePostgres:
+	default:
+		return RateLimitConfig{}, fmt.Errorf("invalid RATE_LIMIT_STORE %q, want memory or postgres", cfg.Store)
+	}
+
+	limits := []struct {
+		name  string
+		limit *ratelimit.Limit
+	}{
+		{"RATE_LIMIT_ORDER_IP", &cfg.PublicOrderPerIP},
+		{"RATE_LIMIT_ORDER_RESTAURANT", &cfg.PublicOrderPerRestaurant},
+		{"RATE_LIMIT_LOGIN_IP", &cfg.LoginPerIP},
+		{"RATE_LIMIT_LOGIN_EMAIL", &cfg.LoginPerEmail},
+	}
+	for _, l := range limits {
+		limit, err := ratelimit.ParseLimit(configurator.GetString(l.name))
+		if err != nil {
+			return RateLimitConfig{}, fmt.Errorf("%s: %w", l.name, err)
+		}
+		*l.limit = limit
+	}
+	return cfg, nil
+}
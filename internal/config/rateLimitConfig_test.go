@@ -0,0 +1,48 @@
+package config
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Jiruu246/rms/pkg/ratelimit"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewRateLimitConfig_DefaultsToMemoryWithoutLimits(t *testing.T) {
+	configurator := viper.New()
+
+	rateLimitConfig, err := NewRateLimitConfig(configurator)
+
+	require.NoError(t, err)
+	require.Equal(t, RateLimitStoreMemory, rateLimitConfig.Store)
+	require.True(t, rateLimitConfig.PublicOrderPerIP.IsZero())
+	require.True(t, rateLimitConfig.LoginPerEmail.IsZero())
+}
+
+func TestNewRateLimitConfig_UsesEnvSettings(t *testing.T) {
+	configurator := viper.New()
+	configurator.Set("RATE_LIMIT_STORE", "Postgres")
+	configurator.Set("RATE_LIMIT_ORDER_IP", "30/1m")
+	configurator.Set("RATE_LIMIT_LOGIN_EMAIL", "10/15m")
+
+	rateLimitConfig, err := NewRateLimitConfig(configurator)
+
+	require.NoError(t, err)
+	require.Equal(t, RateLimitStorePostgres, rateLimitConfig.Store)
+	require.Equal(t, ratelimit.Limit{Requests: 30, Period: time.Minute}, rateLimitConfig.PublicOrderPerIP)
+	require.Equal(t, ratelimit.Limit{Requests: 10, Period: 15 * time.Minute}, rateLimitConfig.LoginPerEmail)
+	require.True(t, rateLimitConfig.PublicOrderPerRestaurant.IsZero())
+}
+
+func TestNewRateLimitConfig_RejectsInvalidSettings(t *testing.T) {
+	configurator := viper.New()
+	configurator.Set("RATE_LIMIT_STORE", "redis")
+	_, err := NewRateLimitConfig(configurator)
+	require.Error(t, err)
+
+	configurator = viper.New()
+	configurator.Set("RATE_LIMIT_LOGIN_IP", "lots")
+	_, err = NewRateLimitConfig(configurator)
+	require.Error(t, err)
+}
@@ -19,7 +19,7 @@ const docTemplate = `{
     "paths": {
         "/auth/login": {
             "post": {
-                "description": "Authenticates a user and returns an access token; sets a refresh token cookie",
+                "description": "Authenticates a user and returns an access token; sets a refresh token cookie. Attempts are rate limited per client IP and per email; over the limit the response is 429 with Retry-After.",
                 "consumes": [
                     "application/json"
                 ],
@@ -59,6 +59,12 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-any"
                         }
+                    },
+                    "429": {
+                        "description": "Too Many Requests",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-any"
+                        }
                     }
                 }
             }
@@ -457,7 +463,7 @@ const docTemplate = `{
                 }
             }
         },
-        "/menu-items": {
+        "/delivery-zones": {
             "get": {
                 "security": [
                     {
@@ -468,14 +474,36 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "menu-items"
+                    "delivery-zones"
+                ],
+                "summary": "List a restaurant's delivery zones",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Restaurant ID",
+                        "name": "restaurant_id",
+                        "in": "query",
+                        "required": true
+                    }
                 ],
-                "summary": "List menu items",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-array_github_com_Jiruu246_rms_internal_dto_MenuItem"
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-array_github_com_Jiruu246_rms_internal_dto_DeliveryZone"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-any"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-any"
                         }
                     },
                     "500": {
@@ -492,6 +520,7 @@ const docTemplate = `{
                         "BearerAuth": []
                     }
                 ],
+                "description": "RADIUS zones need radius_meters and are centred on the restaurant's latitude/longitude; POLYGON zones need a polygon of at least 3 points.",
                 "consumes": [
                     "application/json"
                 ],
@@ -499,17 +528,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "menu-items"
+                    "delivery-zones"
                 ],
-                "summary": "Create a menu item",
+                "summary": "Create a delivery zone",
                 "parameters": [
                     {
-                        "description": "Menu item details",
+                        "description": "Zone details",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/github_com_Jiruu246_rms_internal_dto.CreateMenuItemRequest"
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_internal_dto.CreateDeliveryZoneRequest"
                         }
                     }
                 ],
@@ -517,7 +546,7 @@ const docTemplate = `{
                     "201": {
                         "description": "Created",
                         "schema": {
-                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-github_com_Jiruu246_rms_internal_dto_MenuItem"
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-github_com_Jiruu246_rms_internal_dto_DeliveryZone"
                         }
                     },
                     "400": {
@@ -526,6 +555,18 @@ const docTemplate = `{
                             "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-any"
                         }
                     },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-any"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-any"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -535,7 +576,7 @@ const docTemplate = `{
                 }
             }
         },
-        "/menu-items/{id}": {
+        "/delivery-zones/{id}": {
             "get": {
                 "security": [
                     {
@@ -546,13 +587,14 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "menu-items"
+                    "delivery-zones"
                 ],
-                "summary": "Get a menu item by ID",
+                "summary": "Get a delivery zone by ID",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "Menu item ID",
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Delivery zone ID",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -562,7 +604,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-github_com_Jiruu246_rms_internal_dto_MenuItem"
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-github_com_Jiruu246_rms_internal_dto_DeliveryZone"
                         }
                     },
                     "400": {
@@ -576,6 +618,12 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-any"
                         }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-any"
+                        }
                     }
                 }
             },
@@ -586,13 +634,14 @@ const docTemplate = `{
                     }
                 ],
                 "tags": [
-                    "menu-items"
+                    "delivery-zones"
                 ],
-                "summary": "Delete a menu item",
+                "summary": "Delete a delivery zone",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "Menu item ID",
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Delivery zone ID",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -628,6 +677,7 @@ const docTemplate = `{
                         "BearerAuth": []
                     }
                 ],
+                "description": "Changing kind drops the old shape, so send the new radius_meters or polygon along with it.",
                 "consumes": [
                     "application/json"
                 ],
@@ -635,13 +685,14 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "menu-items"
+                    "delivery-zones"
                 ],
-                "summary": "Update a menu item",
+                "summary": "Update a delivery zone",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "Menu item ID",
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Delivery zone ID",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -652,7 +703,7 @@ const docTemplate = `{
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/github_com_Jiruu246_rms_internal_dto.UpdateMenuItemRequest"
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_internal_dto.UpdateDeliveryZoneRequest"
                         }
                     }
                 ],
@@ -660,7 +711,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-github_com_Jiruu246_rms_internal_dto_MenuItem"
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-github_com_Jiruu246_rms_internal_dto_DeliveryZone"
                         }
                     },
                     "400": {
@@ -675,35 +726,11 @@ const docTemplate = `{
                             "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-any"
                         }
                     },
-                    "500": {
-                        "description": "Internal Server Error",
+                    "409": {
+                        "description": "Conflict",
                         "schema": {
                             "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-any"
                         }
-                    }
-                }
-            }
-        },
-        "/modifiers": {
-            "get": {
-                "security": [
-                    {
-                        "BearerAuth": []
-                    }
-                ],
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "modifiers"
-                ],
-                "summary": "List modifiers",
-                "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-array_github_com_Jiruu246_rms_internal_dto_Modifier"
-                        }
                     },
                     "500": {
                         "description": "Internal Server Error",
@@ -712,39 +739,37 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "post": {
+            }
+        },
+        "/ingredients": {
+            "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "consumes": [
-                    "application/json"
-                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "modifiers"
+                    "inventory"
                 ],
-                "summary": "Create a modifier",
+                "summary": "List a restaurant's ingredients",
                 "parameters": [
                     {
-                        "description": "Modifier details",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/github_com_Jiruu246_rms_internal_dto.CreateModifierRequest"
-                        }
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Restaurant ID",
+                        "name": "restaurant_id",
+                        "in": "query",
+                        "required": true
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created",
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-github_com_Jiruu246_rms_internal_dto_Modifier"
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-array_github_com_Jiruu246_rms_internal_dto_Ingredient"
                         }
                     },
                     "400": {
@@ -753,35 +778,11 @@ const docTemplate = `{
                             "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-any"
                         }
                     },
-                    "500": {
-                        "description": "Internal Server Error",
+                    "404": {
+                        "description": "Not Found",
                         "schema": {
                             "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-any"
                         }
-                    }
-                }
-            }
-        },
-        "/modifiers/options": {
-            "get": {
-                "security": [
-                    {
-                        "BearerAuth": []
-                    }
-                ],
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "modifier-options"
-                ],
-                "summary": "List modifier options",
-                "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-array_github_com_Jiruu246_rms_internal_dto_ModifierOption"
-                        }
                     },
                     "500": {
                         "description": "Internal Server Error",
@@ -797,6 +798,7 @@ const docTemplate = `{
                         "BearerAuth": []
                     }
                 ],
+                "description": "A non-zero on_hand is recorded in the ingredient's ledger as an initial COUNT.",
                 "consumes": [
                     "application/json"
                 ],
@@ -804,17 +806,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "modifier-options"
+                    "inventory"
                 ],
-                "summary": "Create a modifier option",
+                "summary": "Create an ingredient",
                 "parameters": [
                     {
-                        "description": "Modifier option details",
+                        "description": "Ingredient details",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/github_com_Jiruu246_rms_internal_dto.CreateModifierOptionRequest"
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_internal_dto.CreateIngredientRequest"
                         }
                     }
                 ],
@@ -822,7 +824,7 @@ const docTemplate = `{
                     "201": {
                         "description": "Created",
                         "schema": {
-                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-github_com_Jiruu246_rms_internal_dto_ModifierOption"
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-github_com_Jiruu246_rms_internal_dto_Ingredient"
                         }
                     },
                     "400": {
@@ -831,6 +833,18 @@ const docTemplate = `{
                             "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-any"
                         }
                     },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-any"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-any"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -840,7 +854,7 @@ const docTemplate = `{
                 }
             }
         },
-        "/modifiers/options/{id}": {
+        "/ingredients/{id}": {
             "get": {
                 "security": [
                     {
@@ -851,14 +865,14 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "modifier-options"
+                    "inventory"
                 ],
-                "summary": "Get a modifier option by ID",
+                "summary": "Get an ingredient by ID",
                 "parameters": [
                     {
                         "type": "string",
                         "format": "uuid",
-                        "description": "Modifier option ID",
+                        "description": "Ingredient ID",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -868,7 +882,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-github_com_Jiruu246_rms_internal_dto_ModifierOption"
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-github_com_Jiruu246_rms_internal_dto_Ingredient"
                         }
                     },
                     "400": {
@@ -882,6 +896,12 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-any"
                         }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-any"
+                        }
                     }
                 }
             },
@@ -891,15 +911,16 @@ const docTemplate = `{
                         "BearerAuth": []
                     }
                 ],
+                "description": "Deletes the ingredient, its ledger and the recipe lines using it. Menu items and modifier options it had 86ed are made available again.",
                 "tags": [
-                    "modifier-options"
+                    "inventory"
                 ],
-                "summary": "Delete a modifier option",
+                "summary": "Delete an ingredient",
                 "parameters": [
                     {
                         "type": "string",
                         "format": "uuid",
-                        "description": "Modifier option ID",
+                        "description": "Ingredient ID",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -935,6 +956,7 @@ const docTemplate = `{
                         "BearerAuth": []
                     }
                 ],
+                "description": "Stock is changed through adjustments; the unit cannot be changed.",
                 "consumes": [
                     "application/json"
                 ],
@@ -942,14 +964,14 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "modifier-options"
+                    "inventory"
                 ],
-                "summary": "Update a modifier option",
+                "summary": "Rename an ingredient",
                 "parameters": [
                     {
                         "type": "string",
                         "format": "uuid",
-                        "description": "Modifier option ID",
+                        "description": "Ingredient ID",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -960,7 +982,7 @@ const docTemplate = `{
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/github_com_Jiruu246_rms_internal_dto.UpdateModifierOptionRequest"
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_internal_dto.UpdateIngredientRequest"
                         }
                     }
                 ],
@@ -968,7 +990,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-github_com_Jiruu246_rms_internal_dto_ModifierOption"
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-github_com_Jiruu246_rms_internal_dto_Ingredient"
                         }
                     },
                     "400": {
@@ -983,6 +1005,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-any"
                         }
                     },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-any"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -992,35 +1020,48 @@ const docTemplate = `{
                 }
             }
         },
-        "/modifiers/{id}": {
-            "get": {
+        "/ingredients/{id}/adjustments": {
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
+                "description": "Records a DELIVERY (adds quantity), WASTE (takes quantity away) or COUNT (sets stock to quantity) in the ingredient's ledger. Menu items and modifier options the ingredient had 86ed are made available again once there is enough for one of them; those it can no longer make are 86ed.",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "modifiers"
+                    "inventory"
                 ],
-                "summary": "Get a modifier by ID",
+                "summary": "Adjust an ingredient's stock",
                 "parameters": [
                     {
                         "type": "string",
                         "format": "uuid",
-                        "description": "Modifier ID",
+                        "description": "Ingredient ID",
                         "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "description": "Adjustment",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_internal_dto.StockAdjustmentRequest"
+                        }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
+                    "201": {
+                        "description": "Created",
                         "schema": {
-                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-github_com_Jiruu246_rms_internal_dto_Modifier"
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-github_com_Jiruu246_rms_internal_dto_StockMovement"
                         }
                     },
                     "400": {
@@ -1034,32 +1075,53 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-any"
                         }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-any"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-any"
+                        }
                     }
                 }
-            },
-            "delete": {
+            }
+        },
+        "/ingredients/{id}/movements": {
+            "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
+                "description": "Lists sales, deliveries, waste and counts of the ingredient, newest first.",
+                "produces": [
+                    "application/json"
+                ],
                 "tags": [
-                    "modifiers"
+                    "inventory"
                 ],
-                "summary": "Delete a modifier",
+                "summary": "Get an ingredient's stock ledger",
                 "parameters": [
                     {
                         "type": "string",
                         "format": "uuid",
-                        "description": "Modifier ID",
+                        "description": "Ingredient ID",
                         "name": "id",
                         "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": "No Content"
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-array_github_com_Jiruu246_rms_internal_dto_StockMovement"
+                        }
                     },
                     "400": {
                         "description": "Bad Request",
@@ -1080,47 +1142,37 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "patch": {
+            }
+        },
+        "/menu-items": {
+            "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
+                "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "modifiers"
+                    "menu-items"
                 ],
-                "summary": "Update a modifier",
+                "summary": "List a restaurant's menu items",
                 "parameters": [
                     {
                         "type": "string",
                         "format": "uuid",
-                        "description": "Modifier ID",
-                        "name": "id",
-                        "in": "path",
+                        "description": "Restaurant ID",
+                        "name": "restaurant_id",
+                        "in": "query",
                         "required": true
-                    },
-                    {
-                        "description": "Fields to update",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/github_com_Jiruu246_rms_internal_dto.UpdateModifierRequest"
-                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-github_com_Jiruu246_rms_internal_dto_Modifier"
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-array_github_com_Jiruu246_rms_internal_dto_MenuItem"
                         }
                     },
                     "400": {
@@ -1142,55 +1194,13 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/orders": {
-            "get": {
+            },
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "orders"
-                ],
-                "summary": "List orders for a restaurant",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "format": "uuid",
-                        "description": "Restaurant ID",
-                        "name": "restaurant_id",
-                        "in": "query",
-                        "required": true
-                    }
-                ],
-                "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-array_github_com_Jiruu246_rms_internal_dto_Order"
-                        }
-                    },
-                    "400": {
-                        "description": "Bad Request",
-                        "schema": {
-                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-any"
-                        }
-                    },
-                    "500": {
-                        "description": "Internal Server Error",
-                        "schema": {
-                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-any"
-                        }
-                    }
-                }
-            },
-            "post": {
-                "description": "Creates an order. Mounted both as an authenticated endpoint and as a public (no-auth) endpoint for customer-facing ordering.",
                 "consumes": [
                     "application/json"
                 ],
@@ -1198,17 +1208,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "orders"
+                    "menu-items"
                 ],
-                "summary": "Create an order",
+                "summary": "Create a menu item",
                 "parameters": [
                     {
-                        "description": "Order details",
+                        "description": "Menu item details",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/internal_handler.CreateOrderSchema"
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_internal_dto.CreateMenuItemRequest"
                         }
                     }
                 ],
@@ -1216,7 +1226,7 @@ const docTemplate = `{
                     "201": {
                         "description": "Created",
                         "schema": {
-                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-github_com_Jiruu246_rms_internal_dto_Order"
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-github_com_Jiruu246_rms_internal_dto_MenuItem"
                         }
                     },
                     "400": {
@@ -1225,6 +1235,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-any"
                         }
                     },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-any"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -1234,7 +1250,7 @@ const docTemplate = `{
                 }
             }
         },
-        "/orders/{id}": {
+        "/menu-items/{id}": {
             "get": {
                 "security": [
                     {
@@ -1245,14 +1261,13 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "orders"
+                    "menu-items"
                 ],
-                "summary": "Get an order by ID",
+                "summary": "Get a menu item by ID",
                 "parameters": [
                     {
-                        "type": "string",
-                        "format": "uuid",
-                        "description": "Order ID",
+                        "type": "integer",
+                        "description": "Menu item ID",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -1262,7 +1277,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-github_com_Jiruu246_rms_internal_dto_Order"
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-github_com_Jiruu246_rms_internal_dto_MenuItem"
                         }
                     },
                     "400": {
@@ -1286,14 +1301,13 @@ const docTemplate = `{
                     }
                 ],
                 "tags": [
-                    "orders"
+                    "menu-items"
                 ],
-                "summary": "Delete an order",
+                "summary": "Delete a menu item",
                 "parameters": [
                     {
-                        "type": "string",
-                        "format": "uuid",
-                        "description": "Order ID",
+                        "type": "integer",
+                        "description": "Menu item ID",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -1314,6 +1328,12 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-any"
                         }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-any"
+                        }
                     }
                 }
             },
@@ -1330,14 +1350,13 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "orders"
+                    "menu-items"
                 ],
-                "summary": "Update an order",
+                "summary": "Update a menu item",
                 "parameters": [
                     {
-                        "type": "string",
-                        "format": "uuid",
-                        "description": "Order ID",
+                        "type": "integer",
+                        "description": "Menu item ID",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -1348,7 +1367,7 @@ const docTemplate = `{
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/github_com_Jiruu246_rms_internal_dto.UpdateOrderRequest"
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_internal_dto.UpdateMenuItemRequest"
                         }
                     }
                 ],
@@ -1356,7 +1375,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-github_com_Jiruu246_rms_internal_dto_Order"
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-github_com_Jiruu246_rms_internal_dto_MenuItem"
                         }
                     },
                     "400": {
@@ -1380,35 +1399,45 @@ const docTemplate = `{
                 }
             }
         },
-        "/public/order": {
-            "post": {
-                "description": "Creates an order. Mounted both as an authenticated endpoint and as a public (no-auth) endpoint for customer-facing ordering.",
+        "/menu-items/{id}/image": {
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Stores a JPEG, PNG or GIF image for the menu item along with a thumbnail, and sets its image_url and thumbnail_url. Any previous image is deleted.",
                 "consumes": [
-                    "application/json"
+                    "multipart/form-data"
                 ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "orders"
+                    "images"
                 ],
-                "summary": "Create an order",
+                "summary": "Upload a menu item's image",
                 "parameters": [
                     {
-                        "description": "Order details",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/internal_handler.CreateOrderSchema"
-                        }
+                        "type": "integer",
+                        "description": "Menu item ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "file",
+                        "description": "Image file",
+                        "name": "image",
+                        "in": "formData",
+                        "required": true
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created",
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-github_com_Jiruu246_rms_internal_dto_Order"
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-github_com_Jiruu246_rms_internal_dto_MenuItem"
                         }
                     },
                     "400": {
@@ -1417,35 +1446,11 @@ const docTemplate = `{
                             "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-any"
                         }
                     },
-                    "500": {
-                        "description": "Internal Server Error",
+                    "404": {
+                        "description": "Not Found",
                         "schema": {
                             "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-any"
                         }
-                    }
-                }
-            }
-        },
-        "/restaurants": {
-            "get": {
-                "security": [
-                    {
-                        "BearerAuth": []
-                    }
-                ],
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "restaurants"
-                ],
-                "summary": "List restaurants",
-                "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-array_github_com_Jiruu246_rms_internal_dto_RestaurantResponse"
-                        }
                     },
                     "500": {
                         "description": "Internal Server Error",
@@ -1455,38 +1460,34 @@ const docTemplate = `{
                     }
                 }
             },
-            "post": {
+            "delete": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Deletes the menu item's uploaded image and thumbnail, and clears its image_url and thumbnail_url.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "restaurants"
+                    "images"
                 ],
-                "summary": "Create a restaurant",
+                "summary": "Remove a menu item's image",
                 "parameters": [
                     {
-                        "description": "Restaurant details",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/github_com_Jiruu246_rms_internal_dto.CreateRestaurantRequest"
-                        }
+                        "type": "integer",
+                        "description": "Menu item ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created",
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-github_com_Jiruu246_rms_internal_dto_RestaurantResponse"
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-github_com_Jiruu246_rms_internal_dto_MenuItem"
                         }
                     },
                     "400": {
@@ -1495,6 +1496,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-any"
                         }
                     },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-any"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -1504,25 +1511,25 @@ const docTemplate = `{
                 }
             }
         },
-        "/restaurants/{id}": {
+        "/menu-items/{id}/modifiers": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
+                "description": "Lists the modifier groups attached to the item in display order, with the item's overrides applied.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "restaurants"
+                    "menu-items"
                 ],
-                "summary": "Get a restaurant by ID",
+                "summary": "List a menu item's modifiers",
                 "parameters": [
                     {
-                        "type": "string",
-                        "format": "uuid",
-                        "description": "Restaurant ID",
+                        "type": "integer",
+                        "description": "Menu item ID",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -1532,7 +1539,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-github_com_Jiruu246_rms_internal_dto_RestaurantResponse"
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-array_github_com_Jiruu246_rms_internal_dto_MenuItemModifier"
                         }
                     },
                     "400": {
@@ -1546,33 +1553,57 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-any"
                         }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-any"
+                        }
                     }
                 }
             },
-            "delete": {
+            "put": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
+                "description": "Sets the display order of the item's modifiers to the order given. The list must name every attached modifier exactly once.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
                 "tags": [
-                    "restaurants"
+                    "menu-items"
                 ],
-                "summary": "Delete a restaurant",
+                "summary": "Reorder a menu item's modifiers",
                 "parameters": [
                     {
-                        "type": "string",
-                        "format": "uuid",
-                        "description": "Restaurant ID",
+                        "type": "integer",
+                        "description": "Menu item ID",
                         "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "description": "The modifiers in their new order",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_internal_dto.ReorderMenuItemModifiersRequest"
+                        }
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": "No Content"
-                    },
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-array_github_com_Jiruu246_rms_internal_dto_MenuItemModifier"
+                        }
+                    },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
@@ -1584,15 +1615,22 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-any"
                         }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-any"
+                        }
                     }
                 }
             },
-            "patch": {
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
+                "description": "Attaches a modifier of the item's restaurant to the item, last in display order unless display_order is given, with optional overrides of its required, max and option prices.",
                 "consumes": [
                     "application/json"
                 ],
@@ -1600,25 +1638,101 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "restaurants"
+                    "menu-items"
                 ],
-                "summary": "Update a restaurant",
+                "summary": "Attach a modifier to a menu item",
                 "parameters": [
                     {
-                        "type": "string",
-                        "format": "uuid",
-                        "description": "Restaurant ID",
+                        "type": "integer",
+                        "description": "Menu item ID",
                         "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "Fields to update",
+                        "description": "The modifier and its overrides",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/github_com_Jiruu246_rms_internal_dto.UpdateRestaurantRequest"
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_internal_dto.AttachMenuItemModifierRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-github_com_Jiruu246_rms_internal_dto_MenuItemModifier"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-any"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-any"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-any"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-any"
+                        }
+                    }
+                }
+            }
+        },
+        "/menu-items/{id}/modifiers/{modifierId}": {
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Replaces the item's overrides of the modifier's required, max and option prices. Overrides left out are cleared, so the modifier's own values apply.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "menu-items"
+                ],
+                "summary": "Replace a menu item's overrides of a modifier",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Menu item ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Modifier ID",
+                        "name": "modifierId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "The item's overrides",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_internal_dto.MenuItemModifierOverrides"
                         }
                     }
                 ],
@@ -1626,8 +1740,59 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-github_com_Jiruu246_rms_internal_dto_RestaurantResponse"
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-github_com_Jiruu246_rms_internal_dto_MenuItemModifier"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-any"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-any"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-any"
                         }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Detaches the modifier from the item, dropping the item's overrides of it. The modifier itself is kept.",
+                "tags": [
+                    "menu-items"
+                ],
+                "summary": "Detach a modifier from a menu item",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Menu item ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Modifier ID",
+                        "name": "modifierId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
                     },
                     "400": {
                         "description": "Bad Request",
@@ -1650,7 +1815,7 @@ const docTemplate = `{
                 }
             }
         },
-        "/users/profile": {
+        "/menu-items/{id}/recipe": {
             "get": {
                 "security": [
                     {
@@ -1661,14 +1826,29 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "users"
+                    "inventory"
+                ],
+                "summary": "Get a menu item's recipe",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Menu item ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
                 ],
-                "summary": "Get the current user's profile",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-github_com_Jiruu246_rms_internal_dto_User"
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-github_com_Jiruu246_rms_internal_dto_Recipe"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-any"
                         }
                     },
                     "404": {
@@ -1676,15 +1856,22 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-any"
                         }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-any"
+                        }
                     }
                 }
             },
-            "patch": {
+            "put": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
+                "description": "Replaces how much of each ingredient one unit of the menu item uses; an empty list clears it. Selling the item draws its recipe from stock, and it is 86ed while an ingredient has less on hand than one unit uses.",
                 "consumes": [
                     "application/json"
                 ],
@@ -1692,17 +1879,24 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "users"
+                    "inventory"
                 ],
-                "summary": "Update the current user's profile",
+                "summary": "Set a menu item's recipe",
                 "parameters": [
                     {
-                        "description": "Fields to update",
+                        "type": "integer",
+                        "description": "Menu item ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Recipe",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/github_com_Jiruu246_rms_internal_dto.UpdateUserRequest"
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_internal_dto.SetRecipeRequest"
                         }
                     }
                 ],
@@ -1710,7 +1904,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-github_com_Jiruu246_rms_internal_dto_User"
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-github_com_Jiruu246_rms_internal_dto_Recipe"
                         }
                     },
                     "400": {
@@ -1719,20 +1913,72 @@ const docTemplate = `{
                             "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-any"
                         }
                     },
-                    "401": {
-                        "description": "Unauthorized",
+                    "404": {
+                        "description": "Not Found",
                         "schema": {
                             "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-any"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-any"
+                        }
+                    }
+                }
+            }
+        },
+        "/menu-items/{id}/variants": {
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Replaces the item's variants with the given list. Variants with an id are updated, those without are created, and any not listed are deleted. An empty list removes them all.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "menu-items"
+                ],
+                "summary": "Replace a menu item's variants",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Menu item ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "The item's variants",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_internal_dto.SetMenuItemVariantsRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-github_com_Jiruu246_rms_internal_dto_MenuItem"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
                         "schema": {
                             "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-any"
                         }
                     },
-                    "409": {
-                        "description": "Conflict",
+                    "404": {
+                        "description": "Not Found",
                         "schema": {
                             "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-any"
                         }
@@ -1745,716 +1991,7473 @@ const docTemplate = `{
                     }
                 }
             }
-        }
-    },
-    "definitions": {
-        "github_com_Jiruu246_rms_internal_dto.AccessToken": {
-            "type": "object",
-            "properties": {
-                "expires_at": {
-                    "type": "string"
-                },
-                "token": {
-                    "type": "string"
-                }
-            }
         },
-        "github_com_Jiruu246_rms_internal_dto.Category": {
-            "type": "object",
-            "properties": {
-                "description": {
-                    "type": "string"
-                },
+        "/menus": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "menus"
+                ],
+                "summary": "List a restaurant's menus",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Restaurant ID",
+                        "name": "restaurant_id",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-array_github_com_Jiruu246_rms_internal_dto_Menu"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-any"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-any"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-any"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "A menu is served during its schedule, in the restaurant's timezone, for its order types. Once a restaurant has a menu, only items on a menu served at order time can be ordered.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "menus"
+                ],
+                "summary": "Create a menu",
+                "parameters": [
+                    {
+                        "description": "Menu details",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_internal_dto.CreateMenuRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-github_com_Jiruu246_rms_internal_dto_Menu"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-any"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-any"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-any"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-any"
+                        }
+                    }
+                }
+            }
+        },
+        "/menus/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Includes the menu's categories and item prices.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "menus"
+                ],
+                "summary": "Get a menu by ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Menu ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-github_com_Jiruu246_rms_internal_dto_Menu"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-any"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-any"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-any"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Deletes the menu and its item prices. Its categories and items are kept.",
+                "tags": [
+                    "menus"
+                ],
+                "summary": "Delete a menu",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Menu ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-any"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-any"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-any"
+                        }
+                    }
+                }
+            },
+            "patch": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "menus"
+                ],
+                "summary": "Update a menu",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Menu ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Fields to update",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_internal_dto.UpdateMenuRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-github_com_Jiruu246_rms_internal_dto_Menu"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-any"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-any"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-any"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-any"
+                        }
+                    }
+                }
+            }
+        },
+        "/menus/{id}/contents": {
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Replaces the menu's categories and item prices. Items in the categories can be ordered from the menu, at the menu's price for them if it lists one and at their own price otherwise. A category can be on several menus.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "menus"
+                ],
+                "summary": "Set what is on a menu",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Menu ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Categories and item prices",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_internal_dto.SetMenuContentsRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-github_com_Jiruu246_rms_internal_dto_Menu"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-any"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-any"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-any"
+                        }
+                    }
+                }
+            }
+        },
+        "/modifiers": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "modifiers"
+                ],
+                "summary": "List a restaurant's modifiers",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Restaurant ID",
+                        "name": "restaurant_id",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-array_github_com_Jiruu246_rms_internal_dto_Modifier"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-any"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-any"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-any"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "modifiers"
+                ],
+                "summary": "Create a modifier",
+                "parameters": [
+                    {
+                        "description": "Modifier details",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_internal_dto.CreateModifierRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-github_com_Jiruu246_rms_internal_dto_Modifier"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-any"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-any"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-any"
+                        }
+                    }
+                }
+            }
+        },
+        "/modifiers/options": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "modifier-options"
+                ],
+                "summary": "List a restaurant's modifier options",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Restaurant ID",
+                        "name": "restaurant_id",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-array_github_com_Jiruu246_rms_internal_dto_ModifierOption"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-any"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-any"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-any"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "modifier-options"
+                ],
+                "summary": "Create a modifier option",
+                "parameters": [
+                    {
+                        "description": "Modifier option details",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_internal_dto.CreateModifierOptionRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-github_com_Jiruu246_rms_internal_dto_ModifierOption"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-any"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-any"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-any"
+                        }
+                    }
+                }
+            }
+        },
+        "/modifiers/options/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "modifier-options"
+                ],
+                "summary": "Get a modifier option by ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Modifier option ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-github_com_Jiruu246_rms_internal_dto_ModifierOption"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-any"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-any"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "tags": [
+                    "modifier-options"
+                ],
+                "summary": "Delete a modifier option",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Modifier option ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-any"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-any"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-any"
+                        }
+                    }
+                }
+            },
+            "patch": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "modifier-options"
+                ],
+                "summary": "Update a modifier option",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Modifier option ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Fields to update",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_internal_dto.UpdateModifierOptionRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-github_com_Jiruu246_rms_internal_dto_ModifierOption"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-any"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-any"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-any"
+                        }
+                    }
+                }
+            }
+        },
+        "/modifiers/options/{id}/image": {
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Stores a JPEG, PNG or GIF image for the modifier option along with a thumbnail, and sets its image_url and thumbnail_url. Any previous image is deleted.",
+                "consumes": [
+                    "multipart/form-data"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "images"
+                ],
+                "summary": "Upload a modifier option's image",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Modifier option ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "file",
+                        "description": "Image file",
+                        "name": "image",
+                        "in": "formData",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-github_com_Jiruu246_rms_internal_dto_ModifierOption"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-any"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-any"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-any"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Deletes the modifier option's uploaded image and thumbnail, and clears its image_url and thumbnail_url.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "images"
+                ],
+                "summary": "Remove a modifier option's image",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Modifier option ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-github_com_Jiruu246_rms_internal_dto_ModifierOption"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-any"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-any"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-any"
+                        }
+                    }
+                }
+            }
+        },
+        "/modifiers/options/{id}/recipe": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "inventory"
+                ],
+                "summary": "Get a modifier option's recipe",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Modifier option ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-github_com_Jiruu246_rms_internal_dto_Recipe"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-any"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-any"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-any"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Replaces how much of each ingredient one of the option uses; an empty list clears it. The option's recipe is drawn from stock once per unit chosen on each unit of an order item, and it is 86ed while an ingredient has less on hand than one uses.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "inventory"
+                ],
+                "summary": "Set a modifier option's recipe",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Modifier option ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Recipe",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_internal_dto.SetRecipeRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-github_com_Jiruu246_rms_internal_dto_Recipe"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-any"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-any"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-any"
+                        }
+                    }
+                }
+            }
+        },
+        "/modifiers/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "modifiers"
+                ],
+                "summary": "Get a modifier by ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Modifier ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-github_com_Jiruu246_rms_internal_dto_Modifier"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-any"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-any"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "tags": [
+                    "modifiers"
+                ],
+                "summary": "Delete a modifier",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Modifier ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-any"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-any"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-any"
+                        }
+                    }
+                }
+            },
+            "patch": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "modifiers"
+                ],
+                "summary": "Update a modifier",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Modifier ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Fields to update",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_internal_dto.UpdateModifierRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-github_com_Jiruu246_rms_internal_dto_Modifier"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-any"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-any"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-any"
+                        }
+                    }
+                }
+            }
+        },
+        "/orders": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "orders"
+                ],
+                "summary": "List orders for a restaurant",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Restaurant ID",
+                        "name": "restaurant_id",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Only orders with this order number",
+                        "name": "order_number",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Only orders numbered in this period (YYYY-MM-DD, for restaurants that reset numbers daily)",
+                        "name": "order_number_period",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-array_github_com_Jiruu246_rms_internal_dto_Order"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-any"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-any"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-any"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Creates an order. Mounted both as an authenticated endpoint and as a public (no-auth) endpoint for customer-facing ordering. On the authenticated endpoint the user must own the restaurant (404 otherwise), and orders are taken even while it is inactive or closed. A DINE_IN order can be placed at a table with table_id or, from the table's QR code, table_token (restaurant_id can then be left out); it joins the table's open session, opening one if needed. DELIVERY orders need delivery details; the address must fall in one of the restaurant's active delivery zones and the subtotal must meet the zone's minimum, and the zone's fee is added to the total. With scheduled_for, the order is booked for that time (orders placed at a table cannot be scheduled): it must be within the restaurant's opening hours, at least its kitchen lead time from now, at most 14 days ahead, and in a time slot that is not full (see GET /public/restaurants/{id}/slots). Scheduled orders are held from the kitchen until the lead time before their slot starts. Retries sent with the same Idempotency-Key (unique per restaurant) and body get the first response back, with Idempotent-Replayed: true, instead of creating another order; a retry while the first request is still being handled is 409. The public endpoint is rate limited per client IP and per restaurant; over the limit the response is 429 with Retry-After.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "orders"
+                ],
+                "summary": "Create an order",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Client-chosen key making retries safe, at most 255 characters",
+                        "name": "Idempotency-Key",
+                        "in": "header"
+                    },
+                    {
+                        "description": "Order details",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/internal_handler.CreateOrderSchema"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-github_com_Jiruu246_rms_internal_dto_Order"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-any"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-any"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-any"
+                        }
+                    },
+                    "429": {
+                        "description": "Too Many Requests",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-any"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-any"
+                        }
+                    }
+                }
+            }
+        },
+        "/orders/stream": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Server-Sent Events feed of order.created, order.updated, order.status_changed, order.released (a scheduled order sent to the kitchen) and order.deleted events for the restaurant, plus the ticket.created and ticket.status_changed events of its stations. Each event's id is a per-restaurant sequence number; send it back as the Last-Event-ID header (or last_event_id query parameter) when reconnecting to receive every event missed in between. Without it, only events from now on are sent. The data of each event is an order event: id, type, order_id, order (the order after the change; absent for deletions) and created_at.",
+                "produces": [
+                    "text/event-stream"
+                ],
+                "tags": [
+                    "orders"
+                ],
+                "summary": "Stream a restaurant's order events",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Restaurant ID",
+                        "name": "restaurant_id",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Resume after this event ID",
+                        "name": "Last-Event-ID",
+                        "in": "header"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Resume after this event ID, for clients that can't set headers",
+                        "name": "last_event_id",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "text/event-stream of order events",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-any"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-any"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-any"
+                        }
+                    }
+                }
+            }
+        },
+        "/orders/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "orders"
+                ],
+                "summary": "Get an order by ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Order ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-github_com_Jiruu246_rms_internal_dto_Order"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-any"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-any"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "tags": [
+                    "orders"
+                ],
+                "summary": "Delete an order",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Order ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-any"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-any"
+                        }
+                    }
+                }
+            },
+            "patch": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Partially updates an order. order_status changes must follow the order lifecycle (OPEN -\u003e CONFIRMED -\u003e COMPLETED, CANCELLED from any non-terminal status); illegal transitions return 409. order_type cannot be changed once the order is placed (409).",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "orders"
+                ],
+                "summary": "Update an order",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Order ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Fields to update",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_internal_dto.UpdateOrderRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-github_com_Jiruu246_rms_internal_dto_Order"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-any"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-any"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-any"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-any"
+                        }
+                    }
+                }
+            }
+        },
+        "/orders/{id}/history": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Lists every order_status transition of the order, oldest first, including the initial OPEN status recorded at creation.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "orders"
+                ],
+                "summary": "Get an order's status history",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Order ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-array_github_com_Jiruu246_rms_internal_dto_OrderStatusEvent"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-any"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-any"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-any"
+                        }
+                    }
+                }
+            }
+        },
+        "/orders/{id}/item-changes": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Lists every line added, updated or voided on the order after it was placed, oldest first, with the quantities and instructions before and after, the reason and the user who made the change.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "orders"
+                ],
+                "summary": "Get an order's item changes",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Order ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-array_github_com_Jiruu246_rms_internal_dto_OrderItemChange"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-any"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-any"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-any"
+                        }
+                    }
+                }
+            }
+        },
+        "/orders/{id}/items": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Adds lines to an OPEN or CONFIRMED order, such as a bar tab, validated like those of a new order. The order is re-priced at the restaurant's current tax rate. On an order already sent to the kitchen the new lines go out on new station tickets. The change is recorded in the order's item changes.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "orders"
+                ],
+                "summary": "Add items to an order",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Order ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Items to add",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/internal_handler.AddOrderItemsSchema"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-github_com_Jiruu246_rms_internal_dto_Order"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-any"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-any"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-any"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-any"
+                        }
+                    }
+                }
+            }
+        },
+        "/orders/{id}/items/{itemId}": {
+            "patch": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Changes the quantity and/or special instructions of a line of an OPEN or CONFIRMED order and re-prices the order. Lowering the quantity of a line already sent to the kitchen requires a reason; a line cannot go below the units refunded on it (409), and the total cannot drop below what has been paid (409).",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "orders"
+                ],
+                "summary": "Update an order item",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Order ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Order item ID",
+                        "name": "itemId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Fields to update",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_internal_dto.UpdateOrderItemRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-github_com_Jiruu246_rms_internal_dto_Order"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-any"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-any"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-any"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-any"
+                        }
+                    }
+                }
+            }
+        },
+        "/orders/{id}/items/{itemId}/void": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Voids a line of an OPEN or CONFIRMED order: it stays on the order with voided_at and void_reason set but no longer counts towards its totals. Voiding a line already sent to the kitchen requires a reason. Lines with refunded units and the order's last line cannot be voided (409); cancel the order instead.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "orders"
+                ],
+                "summary": "Void an order item",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Order ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Order item ID",
+                        "name": "itemId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Reason for the void",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_internal_dto.VoidOrderItemRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-github_com_Jiruu246_rms_internal_dto_Order"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-any"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-any"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-any"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-any"
+                        }
+                    }
+                }
+            }
+        },
+        "/orders/{id}/payments": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Lists every payment attempt on the order, oldest first, including failed ones.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "payments"
+                ],
+                "summary": "List an order's payments",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Order ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-array_github_com_Jiruu246_rms_internal_dto_Payment"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-any"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-any"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-any"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Takes one tender (cash or card) for part or all of the order's outstanding balance. Call it several times to split the bill across methods. The order's payment_status becomes PENDING while partially paid and PAID once fully paid. A declined card still returns 201 with status FAILED and a failure_reason.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "payments"
+                ],
+                "summary": "Capture a payment against an order",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Order ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Payment details",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_internal_dto.CreatePaymentRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-github_com_Jiruu246_rms_internal_dto_Payment"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-any"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-any"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-any"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-any"
+                        }
+                    }
+                }
+            }
+        },
+        "/orders/{id}/refunds": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Lists every refund on the order, oldest first, including failed ones.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "refunds"
+                ],
+                "summary": "List an order's refunds",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Order ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-array_github_com_Jiruu246_rms_internal_dto_Refund"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-any"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-any"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-any"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Refunds the whole order (full), specific order items (items, including their share of tax) or an arbitrary amount; exactly one must be set. The money is returned from the order's payments, newest first, as one refund per payment. The order's payment_status becomes REFUNDED once everything paid has been refunded. Only the restaurant's owner may refund.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "refunds"
+                ],
+                "summary": "Refund an order",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Order ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Refund details",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_internal_dto.CreateRefundRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-array_github_com_Jiruu246_rms_internal_dto_Refund"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-any"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-any"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-any"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-any"
+                        }
+                    }
+                }
+            }
+        },
+        "/public/order": {
+            "post": {
+                "description": "Creates an order. Mounted both as an authenticated endpoint and as a public (no-auth) endpoint for customer-facing ordering. On the authenticated endpoint the user must own the restaurant (404 otherwise), and orders are taken even while it is inactive or closed. A DINE_IN order can be placed at a table with table_id or, from the table's QR code, table_token (restaurant_id can then be left out); it joins the table's open session, opening one if needed. DELIVERY orders need delivery details; the address must fall in one of the restaurant's active delivery zones and the subtotal must meet the zone's minimum, and the zone's fee is added to the total. With scheduled_for, the order is booked for that time (orders placed at a table cannot be scheduled): it must be within the restaurant's opening hours, at least its kitchen lead time from now, at most 14 days ahead, and in a time slot that is not full (see GET /public/restaurants/{id}/slots). Scheduled orders are held from the kitchen until the lead time before their slot starts. Retries sent with the same Idempotency-Key (unique per restaurant) and body get the first response back, with Idempotent-Replayed: true, instead of creating another order; a retry while the first request is still being handled is 409. The public endpoint is rate limited per client IP and per restaurant; over the limit the response is 429 with Retry-After.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "orders"
+                ],
+                "summary": "Create an order",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Client-chosen key making retries safe, at most 255 characters",
+                        "name": "Idempotency-Key",
+                        "in": "header"
+                    },
+                    {
+                        "description": "Order details",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/internal_handler.CreateOrderSchema"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-github_com_Jiruu246_rms_internal_dto_Order"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-any"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-any"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-any"
+                        }
+                    },
+                    "429": {
+                        "description": "Too Many Requests",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-any"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-any"
+                        }
+                    }
+                }
+            }
+        },
+        "/public/restaurants/{id}/menu": {
+            "get": {
+                "description": "Public (no-auth) catalogue tree: active categories in display order, their available items, each item's modifiers and available options, plus the restaurant's active menus with their schedules (in timezone), order types, categories and item prices. Categories without available items are left out. The response carries a strong ETag and Cache-Control; send the ETag back in If-None-Match to get 304 Not Modified while nothing changed.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "menus"
+                ],
+                "summary": "Get a restaurant's full menu",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Restaurant ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag of a cached copy",
+                        "name": "If-None-Match",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-github_com_Jiruu246_rms_internal_dto_PublicMenu"
+                        }
+                    },
+                    "304": {
+                        "description": "Not Modified"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-any"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-any"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-any"
+                        }
+                    }
+                }
+            }
+        },
+        "/public/restaurants/{id}/open-status": {
+            "get": {
+                "description": "Public (no-auth) check of a restaurant's operating hours, holiday exceptions included, at a time (default now). Returns whether it is open, when the current opening ends and when it next opens. Inactive and closed restaurants are never open; a restaurant without weekly hours is always open.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "restaurants"
+                ],
+                "summary": "Check whether a restaurant is open",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Restaurant ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "format": "date-time",
+                        "description": "Time to check (RFC 3339)",
+                        "name": "at",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-github_com_Jiruu246_rms_internal_dto_OpenStatus"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-any"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-any"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-any"
+                        }
+                    }
+                }
+            }
+        },
+        "/public/restaurants/{id}/slots": {
+            "get": {
+                "description": "Public (no-auth) list of the time slots a restaurant takes scheduled orders for on a local date, cut from its operating hours into slots of its slot_minutes. Slots that are already too close (within the kitchen lead time) or too far ahead (over 14 days) are left out; full slots are listed with available false. remaining is null when the restaurant does not limit orders per slot.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "orders"
+                ],
+                "summary": "List pickup/delivery time slots",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Restaurant ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "format": "date",
+                        "description": "Local date",
+                        "name": "date",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-array_github_com_Jiruu246_rms_internal_dto_TimeSlot"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-any"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-any"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-any"
+                        }
+                    }
+                }
+            }
+        },
+        "/public/tables/{token}": {
+            "get": {
+                "description": "Public (no-auth) lookup of the table behind a QR token, so the ordering page can show the table and load the restaurant's menu. Place orders with the same token as table_token.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "tables"
+                ],
+                "summary": "Resolve a table QR code",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Table QR token",
+                        "name": "token",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-github_com_Jiruu246_rms_internal_dto_PublicTable"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-any"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-any"
+                        }
+                    }
+                }
+            }
+        },
+        "/restaurants": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "restaurants"
+                ],
+                "summary": "List restaurants",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-array_github_com_Jiruu246_rms_internal_dto_RestaurantResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-any"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "restaurants"
+                ],
+                "summary": "Create a restaurant",
+                "parameters": [
+                    {
+                        "description": "Restaurant details",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_internal_dto.CreateRestaurantRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-github_com_Jiruu246_rms_internal_dto_RestaurantResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-any"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-any"
+                        }
+                    }
+                }
+            }
+        },
+        "/restaurants/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "restaurants"
+                ],
+                "summary": "Get a restaurant by ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Restaurant ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-github_com_Jiruu246_rms_internal_dto_RestaurantResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-any"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-any"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "tags": [
+                    "restaurants"
+                ],
+                "summary": "Delete a restaurant",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Restaurant ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-any"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-any"
+                        }
+                    }
+                }
+            },
+            "patch": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "restaurants"
+                ],
+                "summary": "Update a restaurant",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Restaurant ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Fields to update",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_internal_dto.UpdateRestaurantRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-github_com_Jiruu246_rms_internal_dto_RestaurantResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-any"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-any"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-any"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-any"
+                        }
+                    }
+                }
+            }
+        },
+        "/restaurants/{id}/catalogue": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Downloads the restaurant's categories, modifiers, options and menu items as a catalogue file that can be edited and imported again. The file is the bare catalogue, not wrapped in the usual response envelope.",
+                "produces": [
+                    "application/json",
+                    "text/csv"
+                ],
+                "tags": [
+                    "catalogue"
+                ],
+                "summary": "Export a restaurant's catalogue",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Restaurant ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            "json",
+                            "csv"
+                        ],
+                        "type": "string",
+                        "default": "json",
+                        "description": "File format",
+                        "name": "format",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_internal_dto.Catalogue"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-any"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-any"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-any"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Upserts a catalogue file into the restaurant in one transaction: entries with an id update that row, others are matched by name or created. Rows not in the file are left alone. Send JSON, or CSV with Content-Type text/csv. With dry_run nothing is saved, and the report says what would change.",
+                "consumes": [
+                    "application/json",
+                    "text/csv"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "catalogue"
+                ],
+                "summary": "Import a catalogue",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Restaurant ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Only report what would change",
+                        "name": "dry_run",
+                        "in": "query"
+                    },
+                    {
+                        "description": "Catalogue",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_internal_dto.Catalogue"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-github_com_Jiruu246_rms_internal_dto_CatalogueImportReport"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-any"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-any"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-any"
+                        }
+                    }
+                }
+            }
+        },
+        "/restaurants/{id}/logo": {
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Stores a JPEG, PNG or GIF logo for the restaurant along with a thumbnail, and sets its logo_url and logo_thumbnail_url. Any previous logo is deleted.",
+                "consumes": [
+                    "multipart/form-data"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "images"
+                ],
+                "summary": "Upload a restaurant's logo",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Restaurant ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "file",
+                        "description": "Image file",
+                        "name": "image",
+                        "in": "formData",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-github_com_Jiruu246_rms_internal_dto_RestaurantResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-any"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-any"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-any"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Deletes the restaurant's uploaded logo and thumbnail, and clears its logo_url and logo_thumbnail_url.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "images"
+                ],
+                "summary": "Remove a restaurant's logo",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Restaurant ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-github_com_Jiruu246_rms_internal_dto_RestaurantResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-any"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-any"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-any"
+                        }
+                    }
+                }
+            }
+        },
+        "/station-tickets/{id}": {
+            "patch": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Moves a ticket QUEUED -\u003e IN_PROGRESS -\u003e READY (QUEUED -\u003e READY is allowed too); anything else is 409. When the last of an order's tickets becomes READY, an OPEN or CONFIRMED order becomes READY as well.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "stations"
+                ],
+                "summary": "Update a station ticket's status",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Station ticket ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "New status",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_internal_dto.UpdateStationTicketRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-github_com_Jiruu246_rms_internal_dto_StationTicket"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-any"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-any"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-any"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-any"
+                        }
+                    }
+                }
+            }
+        },
+        "/stations": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "stations"
+                ],
+                "summary": "List a restaurant's kitchen stations",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Restaurant ID",
+                        "name": "restaurant_id",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-array_github_com_Jiruu246_rms_internal_dto_Station"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-any"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-any"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-any"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "stations"
+                ],
+                "summary": "Create a kitchen station",
+                "parameters": [
+                    {
+                        "description": "Station details",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_internal_dto.CreateStationRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-github_com_Jiruu246_rms_internal_dto_Station"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-any"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-any"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-any"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-any"
+                        }
+                    }
+                }
+            }
+        },
+        "/stations/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Includes the menu items and categories routed to the station.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "stations"
+                ],
+                "summary": "Get a kitchen station by ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Station ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-github_com_Jiruu246_rms_internal_dto_Station"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-any"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-any"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-any"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Deletes the station and its tickets. Menu items and categories routed to it are no longer routed anywhere.",
+                "tags": [
+                    "stations"
+                ],
+                "summary": "Delete a kitchen station",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Station ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-any"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-any"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-any"
+                        }
+                    }
+                }
+            },
+            "patch": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "stations"
+                ],
+                "summary": "Update a kitchen station",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Station ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Fields to update",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_internal_dto.UpdateStationRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-github_com_Jiruu246_rms_internal_dto_Station"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-any"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-any"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-any"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-any"
+                        }
+                    }
+                }
+            }
+        },
+        "/stations/{id}/routing": {
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Replaces the menu items and categories routed to the station. An order item goes to its menu item's station, or failing that its category's; items routed nowhere are not put on a ticket. Routing an item or category here takes it off any other station.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "stations"
+                ],
+                "summary": "Set what is prepared at a station",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Station ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Menu items and categories",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_internal_dto.SetStationRoutingRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-github_com_Jiruu246_rms_internal_dto_Station"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-any"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-any"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-any"
+                        }
+                    }
+                }
+            }
+        },
+        "/stations/{id}/stream": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Server-Sent Events feed of ticket.created and ticket.status_changed events for the station. Event ids come from the restaurant's order feed, so they increase but have gaps; resume with Last-Event-ID as for /orders/stream. The data of each event is an order event with station_id and ticket set.",
+                "produces": [
+                    "text/event-stream"
+                ],
+                "tags": [
+                    "stations"
+                ],
+                "summary": "Stream a station's ticket events",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Station ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Resume after this event ID",
+                        "name": "Last-Event-ID",
+                        "in": "header"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Resume after this event ID, for clients that can't set headers",
+                        "name": "last_event_id",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "text/event-stream of ticket events",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-any"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-any"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-any"
+                        }
+                    }
+                }
+            }
+        },
+        "/stations/{id}/tickets": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Lists the station's tickets, oldest first, leaving out those of cancelled orders. Without status, tickets that are not READY yet are listed.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "stations"
+                ],
+                "summary": "List a station's tickets",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Station ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            "QUEUED",
+                            "IN_PROGRESS",
+                            "READY"
+                        ],
+                        "type": "string",
+                        "description": "Only tickets with this status",
+                        "name": "status",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-array_github_com_Jiruu246_rms_internal_dto_StationTicket"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-any"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-any"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-any"
+                        }
+                    }
+                }
+            }
+        },
+        "/table-sessions/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "tables"
+                ],
+                "summary": "Get a table session by ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Table session ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-github_com_Jiruu246_rms_internal_dto_TableSession"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-any"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-any"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-any"
+                        }
+                    }
+                }
+            }
+        },
+        "/table-sessions/{id}/close": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Closes the bill once every order in it is paid (cancelled orders and orders with nothing to pay don't count); otherwise 409. The next order at the table opens a new session.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "tables"
+                ],
+                "summary": "Close a table session",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Table session ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-github_com_Jiruu246_rms_internal_dto_TableSession"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-any"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-any"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-any"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-any"
+                        }
+                    }
+                }
+            }
+        },
+        "/tables": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "tables"
+                ],
+                "summary": "List a restaurant's tables",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Restaurant ID",
+                        "name": "restaurant_id",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-array_github_com_Jiruu246_rms_internal_dto_Table"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-any"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-any"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-any"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Creates a dine-in table with a fresh QR token.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "tables"
+                ],
+                "summary": "Create a table",
+                "parameters": [
+                    {
+                        "description": "Table details",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_internal_dto.CreateTableRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-github_com_Jiruu246_rms_internal_dto_Table"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-any"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-any"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-any"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-any"
+                        }
+                    }
+                }
+            }
+        },
+        "/tables/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "tables"
+                ],
+                "summary": "Get a table by ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Table ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-github_com_Jiruu246_rms_internal_dto_Table"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-any"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-any"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-any"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Fails with 409 while the table has an open session. Its closed sessions are deleted; their orders are kept.",
+                "tags": [
+                    "tables"
+                ],
+                "summary": "Delete a table",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Table ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-any"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-any"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-any"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-any"
+                        }
+                    }
+                }
+            },
+            "patch": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Inactive tables reject new orders and their QR codes stop resolving.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "tables"
+                ],
+                "summary": "Update a table",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Table ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Fields to update",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_internal_dto.UpdateTableRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-github_com_Jiruu246_rms_internal_dto_Table"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-any"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-any"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-any"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-any"
+                        }
+                    }
+                }
+            }
+        },
+        "/tables/{id}/qr-token": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Replaces the table's QR token. QR codes printed with the old token stop working.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "tables"
+                ],
+                "summary": "Issue a new QR token for a table",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Table ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-github_com_Jiruu246_rms_internal_dto_Table"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-any"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-any"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-any"
+                        }
+                    }
+                }
+            }
+        },
+        "/tables/{id}/session": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Returns the open bill at the table with its orders, or 404 if nobody is seated there.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "tables"
+                ],
+                "summary": "Get a table's open session",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Table ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-github_com_Jiruu246_rms_internal_dto_TableSession"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-any"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-any"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-any"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/profile": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "Get the current user's profile",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-github_com_Jiruu246_rms_internal_dto_User"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-any"
+                        }
+                    }
+                }
+            },
+            "patch": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "Update the current user's profile",
+                "parameters": [
+                    {
+                        "description": "Fields to update",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_internal_dto.UpdateUserRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-github_com_Jiruu246_rms_internal_dto_User"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-any"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-any"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-any"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-any"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIResponse-any"
+                        }
+                    }
+                }
+            }
+        }
+    },
+    "definitions": {
+        "github_com_Jiruu246_rms_internal_dto.AccessToken": {
+            "type": "object",
+            "properties": {
+                "expires_at": {
+                    "type": "string"
+                },
+                "token": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_Jiruu246_rms_internal_dto.AttachMenuItemModifierRequest": {
+            "type": "object",
+            "required": [
+                "modifier_id"
+            ],
+            "properties": {
+                "display_order": {
+                    "description": "DisplayOrder defaults to after the item's other modifiers.",
+                    "type": "integer",
+                    "minimum": 0
+                },
+                "max": {
+                    "type": "integer",
+                    "minimum": 0
+                },
+                "modifier_id": {
+                    "type": "string"
+                },
+                "option_prices": {
+                    "description": "OptionPrices are prices of the group's options on this item, by\noption ID, in minor units of the restaurant currency.",
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "integer",
+                        "format": "int64"
+                    }
+                },
+                "required": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "github_com_Jiruu246_rms_internal_dto.Catalogue": {
+            "type": "object",
+            "properties": {
+                "categories": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_Jiruu246_rms_internal_dto.CatalogueCategory"
+                    }
+                },
+                "currency": {
+                    "type": "string"
+                },
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_Jiruu246_rms_internal_dto.CatalogueItem"
+                    }
+                },
+                "modifiers": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_Jiruu246_rms_internal_dto.CatalogueModifier"
+                    }
+                },
+                "version": {
+                    "type": "integer"
+                }
+            }
+        },
+        "github_com_Jiruu246_rms_internal_dto.CatalogueAction": {
+            "type": "string",
+            "enum": [
+                "create",
+                "update"
+            ],
+            "x-enum-varnames": [
+                "CatalogueCREATE",
+                "CatalogueUPDATE"
+            ]
+        },
+        "github_com_Jiruu246_rms_internal_dto.CatalogueCategory": {
+            "type": "object",
+            "required": [
+                "name"
+            ],
+            "properties": {
+                "description": {
+                    "type": "string",
+                    "maxLength": 1000
+                },
+                "display_order": {
+                    "type": "integer",
+                    "minimum": 0
+                },
+                "id": {
+                    "type": "string"
+                },
+                "is_active": {
+                    "description": "IsActive defaults to true when left out.",
+                    "type": "boolean"
+                },
+                "key": {
+                    "type": "string",
+                    "maxLength": 255
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 255,
+                    "minLength": 1
+                }
+            }
+        },
+        "github_com_Jiruu246_rms_internal_dto.CatalogueChange": {
+            "type": "object",
+            "properties": {
+                "action": {
+                    "$ref": "#/definitions/github_com_Jiruu246_rms_internal_dto.CatalogueAction"
+                },
+                "fields": {
+                    "description": "Fields lists what an update changed.",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "id": {
+                    "description": "ID is empty for rows a dry run would create.",
+                    "type": "string"
+                },
+                "key": {
+                    "type": "string"
+                },
+                "kind": {
+                    "$ref": "#/definitions/github_com_Jiruu246_rms_internal_dto.CatalogueEntityKind"
+                }
+            }
+        },
+        "github_com_Jiruu246_rms_internal_dto.CatalogueEntityKind": {
+            "type": "string",
+            "enum": [
+                "category",
+                "modifier",
+                "option",
+                "item"
+            ],
+            "x-enum-varnames": [
+                "CatalogueCATEGORY",
+                "CatalogueMODIFIER",
+                "CatalogueOPTION",
+                "CatalogueITEM"
+            ]
+        },
+        "github_com_Jiruu246_rms_internal_dto.CatalogueImportReport": {
+            "type": "object",
+            "properties": {
+                "changes": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_Jiruu246_rms_internal_dto.CatalogueChange"
+                    }
+                },
+                "created": {
+                    "type": "integer"
+                },
+                "dry_run": {
+                    "type": "boolean"
+                },
+                "unchanged": {
+                    "type": "integer"
+                },
+                "updated": {
+                    "type": "integer"
+                }
+            }
+        },
+        "github_com_Jiruu246_rms_internal_dto.CatalogueItem": {
+            "type": "object",
+            "required": [
+                "name"
+            ],
+            "properties": {
+                "category": {
+                    "description": "Category is the key of the item's category; empty leaves it\nuncategorized.",
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string",
+                    "maxLength": 1000
+                },
+                "display_order": {
+                    "type": "integer",
+                    "minimum": 0
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "image_url": {
+                    "type": "string"
+                },
+                "is_available": {
+                    "description": "IsAvailable defaults to true when left out.",
+                    "type": "boolean"
+                },
+                "modifiers": {
+                    "description": "Modifiers are the keys of the item's modifiers, replacing any it had.",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 255,
+                    "minLength": 1
+                },
+                "price": {
+                    "description": "minor units of the restaurant currency",
+                    "type": "integer",
+                    "minimum": 0
+                }
+            }
+        },
+        "github_com_Jiruu246_rms_internal_dto.CatalogueModifier": {
+            "type": "object",
+            "required": [
+                "name"
+            ],
+            "properties": {
+                "free_quantity": {
+                    "type": "integer",
+                    "minimum": 0
+                },
+                "id": {
+                    "type": "string"
+                },
+                "key": {
+                    "type": "string",
+                    "maxLength": 255
+                },
+                "max": {
+                    "type": "integer",
+                    "minimum": 0
+                },
+                "min": {
+                    "type": "integer",
+                    "minimum": 0
+                },
+                "multi_select": {
+                    "type": "boolean"
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 255,
+                    "minLength": 1
+                },
+                "options": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_Jiruu246_rms_internal_dto.CatalogueModifierOption"
+                    }
+                },
+                "required": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "github_com_Jiruu246_rms_internal_dto.CatalogueModifierOption": {
+            "type": "object",
+            "required": [
+                "name"
+            ],
+            "properties": {
+                "available": {
+                    "description": "Available defaults to true when left out.",
+                    "type": "boolean"
+                },
+                "display_order": {
+                    "type": "integer",
+                    "minimum": 0
+                },
+                "id": {
+                    "type": "string"
+                },
+                "image_url": {
+                    "type": "string"
+                },
+                "key": {
+                    "type": "string",
+                    "maxLength": 255
+                },
+                "max_quantity": {
+                    "type": "integer",
+                    "minimum": 0
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 255,
+                    "minLength": 1
+                },
+                "pre_select": {
+                    "type": "boolean"
+                },
+                "price": {
+                    "description": "minor units of the restaurant currency",
+                    "type": "integer",
+                    "minimum": 0
+                }
+            }
+        },
+        "github_com_Jiruu246_rms_internal_dto.Category": {
+            "type": "object",
+            "properties": {
+                "description": {
+                    "type": "string"
+                },
+                "display_order": {
+                    "type": "integer"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "is_active": {
+                    "type": "boolean"
+                },
+                "name": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_Jiruu246_rms_internal_dto.CategoryListItem": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "string"
+                },
+                "is_active": {
+                    "type": "boolean"
+                },
+                "name": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_Jiruu246_rms_internal_dto.CreateCategoryRequest": {
+            "type": "object",
+            "required": [
+                "name",
+                "restaurant_id"
+            ],
+            "properties": {
+                "description": {
+                    "type": "string",
+                    "maxLength": 1000
+                },
+                "display_order": {
+                    "type": "integer",
+                    "minimum": 0
+                },
+                "is_active": {
+                    "type": "boolean"
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 255,
+                    "minLength": 1
+                },
+                "restaurant_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_Jiruu246_rms_internal_dto.CreateDeliveryZoneRequest": {
+            "type": "object",
+            "required": [
+                "kind",
+                "name",
+                "restaurant_id"
+            ],
+            "properties": {
+                "delivery_fee": {
+                    "description": "minor units of the restaurant currency",
+                    "type": "integer",
+                    "minimum": 0
+                },
+                "kind": {
+                    "type": "string",
+                    "enum": [
+                        "RADIUS",
+                        "POLYGON"
+                    ]
+                },
+                "min_order_value": {
+                    "description": "minor units of the restaurant currency",
+                    "type": "integer",
+                    "minimum": 0
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 100,
+                    "minLength": 1
+                },
+                "polygon": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_geo.Point"
+                    }
+                },
+                "radius_meters": {
+                    "type": "integer",
+                    "minimum": 1
+                },
+                "restaurant_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_Jiruu246_rms_internal_dto.CreateIngredientRequest": {
+            "type": "object",
+            "required": [
+                "name",
+                "restaurant_id",
+                "unit"
+            ],
+            "properties": {
+                "name": {
+                    "type": "string",
+                    "maxLength": 255,
+                    "minLength": 1
+                },
+                "on_hand": {
+                    "type": "integer",
+                    "minimum": 0
+                },
+                "restaurant_id": {
+                    "type": "string"
+                },
+                "unit": {
+                    "type": "string",
+                    "enum": [
+                        "g",
+                        "ml",
+                        "each"
+                    ]
+                }
+            }
+        },
+        "github_com_Jiruu246_rms_internal_dto.CreateMenuItemRequest": {
+            "type": "object",
+            "required": [
+                "name",
+                "price",
+                "restaurant_id"
+            ],
+            "properties": {
+                "category_id": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "display_order": {
+                    "type": "integer",
+                    "minimum": 0
+                },
+                "is_available": {
+                    "type": "boolean"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "price": {
+                    "description": "minor units of the restaurant currency",
+                    "type": "integer",
+                    "minimum": 0
+                },
+                "restaurant_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_Jiruu246_rms_internal_dto.CreateMenuRequest": {
+            "type": "object",
+            "required": [
+                "name",
+                "restaurant_id"
+            ],
+            "properties": {
+                "description": {
+                    "type": "string",
+                    "maxLength": 1000
+                },
+                "display_order": {
+                    "type": "integer",
+                    "minimum": 0
+                },
+                "is_active": {
+                    "type": "boolean"
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 255,
+                    "minLength": 1
+                },
+                "order_types": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "restaurant_id": {
+                    "type": "string"
+                },
+                "schedule": {
+                    "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_hours.Schedule"
+                }
+            }
+        },
+        "github_com_Jiruu246_rms_internal_dto.CreateModifierOptionRequest": {
+            "type": "object",
+            "required": [
+                "child_modifier_ids",
+                "modifier_id",
+                "name",
+                "variant_prices"
+            ],
+            "properties": {
+                "available": {
+                    "type": "boolean"
+                },
+                "child_modifier_ids": {
+                    "description": "ChildModifierIDs are modifier groups of the same restaurant chosen\nfrom once this option is, e.g. a Size group under a Fries option.",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "display_order": {
+                    "type": "integer",
+                    "minimum": 0
+                },
+                "image_url": {
+                    "type": "string"
+                },
+                "max_quantity": {
+                    "type": "integer",
+                    "minimum": 0
+                },
+                "modifier_id": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 255,
+                    "minLength": 1
+                },
+                "pre_select": {
+                    "type": "boolean"
+                },
+                "price": {
+                    "description": "minor units of the restaurant currency",
+                    "type": "integer",
+                    "minimum": 0
+                },
+                "variant_prices": {
+                    "description": "VariantPrices price the option differently when it is chosen with a\nmenu item variant of a given name, e.g. {\"Large\": 80}.",
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "integer",
+                        "format": "int64"
+                    }
+                }
+            }
+        },
+        "github_com_Jiruu246_rms_internal_dto.CreateModifierRequest": {
+            "type": "object",
+            "required": [
+                "name",
+                "restaurant_id"
+            ],
+            "properties": {
+                "free_quantity": {
+                    "type": "integer",
+                    "minimum": 0
+                },
+                "max": {
+                    "type": "integer",
+                    "minimum": 0
+                },
+                "min": {
+                    "type": "integer",
+                    "minimum": 0
+                },
+                "multi_select": {
+                    "type": "boolean"
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 255,
+                    "minLength": 1
+                },
+                "required": {
+                    "type": "boolean"
+                },
+                "restaurant_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_Jiruu246_rms_internal_dto.CreatePaymentRequest": {
+            "type": "object",
+            "required": [
+                "amount",
+                "method"
+            ],
+            "properties": {
+                "amount": {
+                    "description": "Amount is in minor units of the order currency.",
+                    "type": "integer",
+                    "minimum": 1
+                },
+                "card_token": {
+                    "type": "string"
+                },
+                "method": {
+                    "enum": [
+                        "CASH",
+                        "CARD"
+                    ],
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_internal_dto.PaymentMethod"
+                        }
+                    ]
+                }
+            }
+        },
+        "github_com_Jiruu246_rms_internal_dto.CreateRefundRequest": {
+            "type": "object",
+            "required": [
+                "reason"
+            ],
+            "properties": {
+                "amount": {
+                    "description": "Amount refunds an arbitrary amount, in minor units of the order\ncurrency.",
+                    "type": "integer",
+                    "minimum": 1
+                },
+                "full": {
+                    "description": "Full refunds everything that has been paid and not yet refunded.",
+                    "type": "boolean"
+                },
+                "items": {
+                    "description": "Items refunds the given quantities of order items, including their\nshare of tax.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_Jiruu246_rms_internal_dto.RefundItemRequest"
+                    }
+                },
+                "note": {
+                    "type": "string",
+                    "maxLength": 1000
+                },
+                "reason": {
+                    "enum": [
+                        "CUSTOMER_REQUEST",
+                        "ORDER_ERROR",
+                        "QUALITY_ISSUE",
+                        "DUPLICATE",
+                        "OTHER"
+                    ],
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_internal_dto.RefundReason"
+                        }
+                    ]
+                }
+            }
+        },
+        "github_com_Jiruu246_rms_internal_dto.CreateRestaurantRequest": {
+            "type": "object",
+            "required": [
+                "address",
+                "city",
+                "country",
+                "currency",
+                "email",
+                "name",
+                "phone",
+                "state",
+                "zip_code"
+            ],
+            "properties": {
+                "address": {
+                    "type": "string"
+                },
+                "city": {
+                    "type": "string"
+                },
+                "country": {
+                    "type": "string"
+                },
+                "cover_image_url": {
+                    "type": "string"
+                },
+                "currency": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string",
+                    "maxLength": 1000
+                },
+                "email": {
+                    "type": "string"
+                },
+                "kitchen_lead_minutes": {
+                    "type": "integer",
+                    "maximum": 1440,
+                    "minimum": 0
+                },
+                "latitude": {
+                    "type": "number",
+                    "maximum": 90,
+                    "minimum": -90
+                },
+                "logo_url": {
+                    "type": "string"
+                },
+                "longitude": {
+                    "type": "number",
+                    "maximum": 180,
+                    "minimum": -180
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 255,
+                    "minLength": 1
+                },
+                "operating_hours": {
+                    "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_hours.Schedule"
+                },
+                "order_number_reset": {
+                    "type": "string",
+                    "enum": [
+                        "never",
+                        "daily"
+                    ]
+                },
+                "phone": {
+                    "type": "string"
+                },
+                "slot_capacity": {
+                    "type": "integer",
+                    "minimum": 0
+                },
+                "slot_minutes": {
+                    "description": "SlotMinutes, SlotCapacity and KitchenLeadMinutes configure scheduled\norders; omitted, they default to 15, 0 (no limit) and 20.",
+                    "type": "integer",
+                    "maximum": 240,
+                    "minimum": 5
+                },
+                "state": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string",
+                    "enum": [
+                        "active",
+                        "inactive",
+                        "closed"
+                    ]
+                },
+                "tax_rate_bps": {
+                    "type": "integer",
+                    "maximum": 10000,
+                    "minimum": 0
+                },
+                "timezone": {
+                    "type": "string"
+                },
+                "zip_code": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_Jiruu246_rms_internal_dto.CreateStationRequest": {
+            "type": "object",
+            "required": [
+                "name",
+                "restaurant_id"
+            ],
+            "properties": {
+                "display_order": {
+                    "type": "integer",
+                    "minimum": 0
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 255,
+                    "minLength": 1
+                },
+                "restaurant_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_Jiruu246_rms_internal_dto.CreateTableRequest": {
+            "type": "object",
+            "required": [
+                "name",
+                "restaurant_id",
+                "seats"
+            ],
+            "properties": {
+                "name": {
+                    "type": "string",
+                    "maxLength": 100,
+                    "minLength": 1
+                },
+                "restaurant_id": {
+                    "type": "string"
+                },
+                "seats": {
+                    "type": "integer",
+                    "minimum": 1
+                },
+                "section": {
+                    "type": "string",
+                    "maxLength": 100
+                }
+            }
+        },
+        "github_com_Jiruu246_rms_internal_dto.DeliveryDetails": {
+            "type": "object",
+            "properties": {
+                "address": {
+                    "type": "string"
+                },
+                "contact_name": {
+                    "type": "string"
+                },
+                "contact_phone": {
+                    "type": "string"
+                },
+                "instructions": {
+                    "type": "string"
+                },
+                "latitude": {
+                    "type": "number"
+                },
+                "longitude": {
+                    "type": "number"
+                },
+                "min_order_value": {
+                    "description": "MinOrderValue is the zone's minimum when the order was placed. Item\nchanges may not take the order's subtotal below it.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_money.Money"
+                        }
+                    ]
+                },
+                "zone_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_Jiruu246_rms_internal_dto.DeliveryZone": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "delivery_fee": {
+                    "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_money.Money"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "is_active": {
+                    "type": "boolean"
+                },
+                "kind": {
+                    "$ref": "#/definitions/github_com_Jiruu246_rms_internal_dto.DeliveryZoneKind"
+                },
+                "min_order_value": {
+                    "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_money.Money"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "polygon": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_geo.Point"
+                    }
+                },
+                "radius_meters": {
+                    "type": "integer"
+                },
+                "restaurant_id": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_Jiruu246_rms_internal_dto.DeliveryZoneKind": {
+            "type": "string",
+            "enum": [
+                "RADIUS",
+                "POLYGON"
+            ],
+            "x-enum-varnames": [
+                "DeliveryZoneKindRADIUS",
+                "DeliveryZoneKindPOLYGON"
+            ]
+        },
+        "github_com_Jiruu246_rms_internal_dto.Ingredient": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "on_hand": {
+                    "description": "OnHand is the quantity in stock, in Unit.",
+                    "type": "integer"
+                },
+                "restaurant_id": {
+                    "type": "string"
+                },
+                "unit": {
+                    "$ref": "#/definitions/github_com_Jiruu246_rms_internal_dto.IngredientUnit"
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_Jiruu246_rms_internal_dto.IngredientUnit": {
+            "type": "string",
+            "enum": [
+                "g",
+                "ml",
+                "each"
+            ],
+            "x-enum-varnames": [
+                "IngredientUnitG",
+                "IngredientUnitML",
+                "IngredientUnitEACH"
+            ]
+        },
+        "github_com_Jiruu246_rms_internal_dto.LoginUserRequest": {
+            "type": "object",
+            "required": [
+                "email",
+                "password"
+            ],
+            "properties": {
+                "email": {
+                    "type": "string"
+                },
+                "password": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_Jiruu246_rms_internal_dto.Menu": {
+            "type": "object",
+            "properties": {
+                "category_ids": {
+                    "description": "CategoryIDs and ItemPrices are what is on the menu. Only set when\nfetching a single menu.",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "display_order": {
+                    "type": "integer"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "is_active": {
+                    "type": "boolean"
+                },
+                "item_prices": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_Jiruu246_rms_internal_dto.MenuItemPrice"
+                    }
+                },
+                "name": {
+                    "type": "string"
+                },
+                "order_types": {
+                    "description": "OrderTypes are the order types the menu applies to; empty means all.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_Jiruu246_rms_internal_dto.OrderType"
+                    }
+                },
+                "restaurant_id": {
+                    "type": "string"
+                },
+                "schedule": {
+                    "description": "Schedule is when the menu is served, in the restaurant's timezone.\nWithout weekly hours it is served at all times.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_hours.Schedule"
+                        }
+                    ]
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_Jiruu246_rms_internal_dto.MenuItem": {
+            "type": "object",
+            "properties": {
+                "category_id": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "display_order": {
+                    "type": "integer"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "image_url": {
+                    "description": "ImageURL and ThumbnailURL are set by uploading an image.",
+                    "type": "string"
+                },
+                "is_available": {
+                    "type": "boolean"
+                },
+                "modifiers": {
+                    "description": "Modifiers are the groups attached to the item, in display order,\nwith the item's overrides applied.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_Jiruu246_rms_internal_dto.Modifier"
+                    }
+                },
+                "name": {
+                    "type": "string"
+                },
+                "out_of_stock": {
+                    "description": "OutOfStock is set when IsAvailable was turned off because an\ningredient ran out; restocking turns the item back on.",
+                    "type": "boolean"
+                },
+                "price": {
+                    "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_money.Money"
+                },
+                "restaurant_id": {
+                    "type": "string"
+                },
+                "thumbnail_url": {
+                    "type": "string"
+                },
+                "variants": {
+                    "description": "Variants are the sizes or versions the item is sold in, in display\norder. An item with variants is ordered as one of them, at its price.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_Jiruu246_rms_internal_dto.MenuItemVariant"
+                    }
+                }
+            }
+        },
+        "github_com_Jiruu246_rms_internal_dto.MenuItemModifier": {
+            "type": "object",
+            "properties": {
+                "display_order": {
+                    "type": "integer"
+                },
+                "max": {
+                    "type": "integer"
+                },
+                "modifier": {
+                    "$ref": "#/definitions/github_com_Jiruu246_rms_internal_dto.Modifier"
+                },
+                "option_prices": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_money.Money"
+                    }
+                },
+                "required": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "github_com_Jiruu246_rms_internal_dto.MenuItemModifierOverrides": {
+            "type": "object",
+            "properties": {
+                "max": {
+                    "type": "integer",
+                    "minimum": 0
+                },
+                "option_prices": {
+                    "description": "OptionPrices are prices of the group's options on this item, by\noption ID, in minor units of the restaurant currency.",
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "integer",
+                        "format": "int64"
+                    }
+                },
+                "required": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "github_com_Jiruu246_rms_internal_dto.MenuItemPrice": {
+            "type": "object",
+            "properties": {
+                "menu_item_id": {
+                    "type": "integer"
+                },
+                "price": {
+                    "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_money.Money"
+                }
+            }
+        },
+        "github_com_Jiruu246_rms_internal_dto.MenuItemPriceRequest": {
+            "type": "object",
+            "required": [
+                "menu_item_id"
+            ],
+            "properties": {
+                "menu_item_id": {
+                    "type": "integer"
+                },
+                "price": {
+                    "description": "minor units of the restaurant currency",
+                    "type": "integer",
+                    "minimum": 0
+                }
+            }
+        },
+        "github_com_Jiruu246_rms_internal_dto.MenuItemVariant": {
+            "type": "object",
+            "properties": {
+                "display_order": {
+                    "type": "integer"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "is_available": {
+                    "type": "boolean"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "price": {
+                    "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_money.Money"
+                },
+                "sku": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_Jiruu246_rms_internal_dto.MenuItemVariantInput": {
+            "type": "object",
+            "required": [
+                "name"
+            ],
+            "properties": {
+                "display_order": {
+                    "type": "integer",
+                    "minimum": 0
+                },
+                "id": {
+                    "type": "string"
+                },
+                "is_available": {
+                    "description": "defaults to true",
+                    "type": "boolean"
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 255
+                },
+                "price": {
+                    "description": "minor units of the restaurant currency",
+                    "type": "integer",
+                    "minimum": 0
+                },
+                "sku": {
+                    "type": "string",
+                    "maxLength": 64
+                }
+            }
+        },
+        "github_com_Jiruu246_rms_internal_dto.Modifier": {
+            "type": "object",
+            "properties": {
+                "free_quantity": {
+                    "type": "integer"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "max": {
+                    "type": "integer"
+                },
+                "min": {
+                    "type": "integer"
+                },
+                "multi_select": {
+                    "type": "boolean"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "option_prices": {
+                    "description": "OptionPrices are the prices a menu item sets for the group's options,\nby option ID, when the group is read as attached to that item.",
+                    "type": "object",
+                    "additionalProperties": {
+                        "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_money.Money"
+                    }
+                },
+                "required": {
+                    "type": "boolean"
+                },
+                "restaurant_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_Jiruu246_rms_internal_dto.ModifierOption": {
+            "type": "object",
+            "properties": {
+                "available": {
+                    "type": "boolean"
+                },
+                "child_modifiers": {
+                    "description": "ChildModifiers are the groups chosen from once the option is.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_Jiruu246_rms_internal_dto.Modifier"
+                    }
+                },
+                "display_order": {
+                    "type": "integer"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "image_url": {
+                    "type": "string"
+                },
+                "max_quantity": {
+                    "description": "MaxQuantity caps how many times the option can be chosen in one\nselection; 0 leaves it to the modifier's max.",
+                    "type": "integer"
+                },
+                "modifier_id": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "out_of_stock": {
+                    "description": "OutOfStock is set when Available was turned off because an\ningredient ran out; restocking turns the option back on.",
+                    "type": "boolean"
+                },
+                "pre_select": {
+                    "type": "boolean"
+                },
+                "price": {
+                    "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_money.Money"
+                },
+                "quantity": {
+                    "type": "integer"
+                },
+                "thumbnail_url": {
+                    "type": "string"
+                },
+                "variant_prices": {
+                    "description": "VariantPrices are what the option costs with menu item variants of\nthe given names instead of Price.",
+                    "type": "object",
+                    "additionalProperties": {
+                        "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_money.Money"
+                    }
+                }
+            }
+        },
+        "github_com_Jiruu246_rms_internal_dto.OpenStatus": {
+            "type": "object",
+            "properties": {
+                "at": {
+                    "type": "string"
+                },
+                "closes_at": {
+                    "description": "ClosesAt is when the current opening ends; nil when closed, or when\nthe restaurant does not close within a year.",
+                    "type": "string"
+                },
+                "is_open": {
+                    "type": "boolean"
+                },
+                "next_opening": {
+                    "description": "NextOpening is the start of the next opening after At; nil when there\nis none within a year, or the restaurant is inactive or closed.",
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_Jiruu246_rms_internal_dto.Order": {
+            "type": "object",
+            "properties": {
+                "amount_paid": {
+                    "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_money.Money"
+                },
+                "amount_refunded": {
+                    "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_money.Money"
+                },
+                "currency": {
+                    "type": "string"
+                },
+                "delivery": {
+                    "description": "Delivery is set on DELIVERY orders.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/github_com_Jiruu246_rms_internal_dto.DeliveryDetails"
+                        }
+                    ]
+                },
+                "delivery_fee": {
+                    "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_money.Money"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "items_version": {
+                    "description": "ItemsVersion counts the changes made to the order's items since it\nwas placed.",
+                    "type": "integer"
+                },
+                "modifiers_total": {
+                    "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_money.Money"
+                },
+                "order_items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_Jiruu246_rms_internal_dto.OrderItem"
+                    }
+                },
+                "order_number": {
+                    "description": "OrderNumber is the number called out in the kitchen. It is unique\nwithin OrderNumberPeriod, which is empty unless the restaurant resets\nnumbers daily, in which case it is the local date (YYYY-MM-DD).",
+                    "type": "integer"
+                },
+                "order_number_period": {
+                    "type": "string"
+                },
+                "order_status": {
+                    "$ref": "#/definitions/github_com_Jiruu246_rms_internal_dto.OrderStatus"
+                },
+                "order_type": {
+                    "$ref": "#/definitions/github_com_Jiruu246_rms_internal_dto.OrderType"
+                },
+                "payment_status": {
+                    "$ref": "#/definitions/github_com_Jiruu246_rms_internal_dto.PaymentStatus"
+                },
+                "released_at": {
+                    "type": "string"
+                },
+                "restaurant_id": {
+                    "type": "string"
+                },
+                "scheduled_for": {
+                    "description": "ScheduledFor is the requested pickup or delivery time of a scheduled\norder. ReleasedAt is when the order was sent to the kitchen; it is\nnil while a scheduled order is held back until its slot draws near.",
+                    "type": "string"
+                },
+                "subtotal": {
+                    "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_money.Money"
+                },
+                "table_id": {
+                    "description": "TableID and TableSessionID are set on dine-in orders placed at a\ntable; see TableSession.",
+                    "type": "string"
+                },
+                "table_session_id": {
+                    "type": "string"
+                },
+                "tax_rate_bps": {
+                    "description": "TaxRateBps is the tax rate the order was placed, and is repriced, at.\nIt is nil on orders placed before the rate was recorded.",
+                    "type": "integer"
+                },
+                "tax_total": {
+                    "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_money.Money"
+                },
+                "total": {
+                    "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_money.Money"
+                }
+            }
+        },
+        "github_com_Jiruu246_rms_internal_dto.OrderItem": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "string"
+                },
+                "item_name": {
+                    "type": "string"
+                },
+                "item_price": {
+                    "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_money.Money"
+                },
+                "line_total": {
+                    "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_money.Money"
+                },
+                "menu_item_id": {
+                    "type": "integer"
+                },
+                "modifier_options": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_Jiruu246_rms_internal_dto.OrderItemModifierOption"
+                    }
+                },
+                "modifiers_total": {
+                    "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_money.Money"
+                },
+                "order_id": {
+                    "type": "string"
+                },
+                "quantity": {
+                    "type": "integer"
+                },
+                "refunded_quantity": {
+                    "type": "integer"
+                },
+                "special_instructions": {
+                    "type": "string"
+                },
+                "station_ticket_id": {
+                    "type": "string"
+                },
+                "variant_id": {
+                    "description": "VariantID and VariantName are set on lines of items with variants;\nItemPrice is then the variant's price.",
+                    "type": "string"
+                },
+                "variant_name": {
+                    "type": "string"
+                },
+                "void_reason": {
+                    "type": "string"
+                },
+                "voided_at": {
+                    "description": "VoidedAt is set on voided lines, which are kept for the record but\nnot counted in the order's totals.",
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_Jiruu246_rms_internal_dto.OrderItemChange": {
+            "type": "object",
+            "properties": {
+                "action": {
+                    "$ref": "#/definitions/github_com_Jiruu246_rms_internal_dto.OrderItemChangeAction"
+                },
+                "changed_by": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "instructions_after": {
+                    "type": "string"
+                },
+                "instructions_before": {
+                    "type": "string"
+                },
+                "order_id": {
+                    "type": "string"
+                },
+                "order_item_id": {
+                    "type": "string"
+                },
+                "quantity_after": {
+                    "type": "integer"
+                },
+                "quantity_before": {
+                    "description": "QuantityBefore is nil for ADDED; QuantityAfter is 0 for VOIDED.",
+                    "type": "integer"
+                },
+                "reason": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_Jiruu246_rms_internal_dto.OrderItemChangeAction": {
+            "type": "string",
+            "enum": [
+                "ADDED",
+                "UPDATED",
+                "VOIDED"
+            ],
+            "x-enum-varnames": [
+                "OrderItemChangeADDED",
+                "OrderItemChangeUPDATED",
+                "OrderItemChangeVOIDED"
+            ]
+        },
+        "github_com_Jiruu246_rms_internal_dto.OrderItemModifierOption": {
+            "type": "object",
+            "properties": {
+                "free_quantity": {
+                    "description": "FreeQuantity is how many of Quantity were free under the\nmodifier's free allowance, and not charged.",
+                    "type": "integer"
+                },
+                "modifier_option_id": {
+                    "type": "string"
+                },
+                "modifier_options": {
+                    "description": "ModifierOptions are the options chosen in this option's child\nmodifier groups, for each of its Quantity.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_Jiruu246_rms_internal_dto.OrderItemModifierOption"
+                    }
+                },
+                "option_name": {
+                    "type": "string"
+                },
+                "option_price": {
+                    "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_money.Money"
+                },
+                "order_item_id": {
+                    "type": "string"
+                },
+                "quantity": {
+                    "type": "integer"
+                }
+            }
+        },
+        "github_com_Jiruu246_rms_internal_dto.OrderStatus": {
+            "type": "string",
+            "enum": [
+                "OPEN",
+                "CONFIRMED",
+                "READY",
+                "COMPLETED",
+                "CANCELLED"
+            ],
+            "x-enum-varnames": [
+                "OrderStatusOPEN",
+                "OrderStatusCONFIRMED",
+                "OrderStatusREADY",
+                "OrderStatusCOMPLETED",
+                "OrderStatusCANCELLED"
+            ]
+        },
+        "github_com_Jiruu246_rms_internal_dto.OrderStatusEvent": {
+            "type": "object",
+            "properties": {
+                "changed_by": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "from_status": {
+                    "$ref": "#/definitions/github_com_Jiruu246_rms_internal_dto.OrderStatus"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "order_id": {
+                    "type": "string"
+                },
+                "reason": {
+                    "type": "string"
+                },
+                "to_status": {
+                    "$ref": "#/definitions/github_com_Jiruu246_rms_internal_dto.OrderStatus"
+                }
+            }
+        },
+        "github_com_Jiruu246_rms_internal_dto.OrderType": {
+            "type": "string",
+            "enum": [
+                "DINE_IN",
+                "TAKEOUT",
+                "DELIVERY"
+            ],
+            "x-enum-varnames": [
+                "OrderTypeDINE_IN",
+                "OrderTypeTAKEOUT",
+                "OrderTypeDELIVERY"
+            ]
+        },
+        "github_com_Jiruu246_rms_internal_dto.Payment": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_money.Money"
+                },
+                "amount_refunded": {
+                    "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_money.Money"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "created_by": {
+                    "type": "string"
+                },
+                "failure_reason": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "method": {
+                    "$ref": "#/definitions/github_com_Jiruu246_rms_internal_dto.PaymentMethod"
+                },
+                "order_id": {
+                    "type": "string"
+                },
+                "provider": {
+                    "type": "string"
+                },
+                "provider_reference": {
+                    "type": "string"
+                },
+                "status": {
+                    "$ref": "#/definitions/github_com_Jiruu246_rms_internal_dto.PaymentState"
+                }
+            }
+        },
+        "github_com_Jiruu246_rms_internal_dto.PaymentMethod": {
+            "type": "string",
+            "enum": [
+                "CASH",
+                "CARD"
+            ],
+            "x-enum-varnames": [
+                "PaymentMethodCASH",
+                "PaymentMethodCARD"
+            ]
+        },
+        "github_com_Jiruu246_rms_internal_dto.PaymentState": {
+            "type": "string",
+            "enum": [
+                "PENDING",
+                "SUCCEEDED",
+                "FAILED"
+            ],
+            "x-enum-varnames": [
+                "PaymentStatePENDING",
+                "PaymentStateSUCCEEDED",
+                "PaymentStateFAILED"
+            ]
+        },
+        "github_com_Jiruu246_rms_internal_dto.PaymentStatus": {
+            "type": "string",
+            "enum": [
+                "UNPAID",
+                "PENDING",
+                "PAID",
+                "REFUNDED"
+            ],
+            "x-enum-varnames": [
+                "PaymentStatusUNPAID",
+                "PaymentStatusPENDING",
+                "PaymentStatusPAID",
+                "PaymentStatusREFUNDED"
+            ]
+        },
+        "github_com_Jiruu246_rms_internal_dto.PublicCategory": {
+            "type": "object",
+            "properties": {
+                "description": {
+                    "type": "string"
+                },
+                "display_order": {
+                    "type": "integer"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_Jiruu246_rms_internal_dto.PublicMenuItem"
+                    }
+                },
+                "name": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_Jiruu246_rms_internal_dto.PublicMenu": {
+            "type": "object",
+            "properties": {
+                "categories": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_Jiruu246_rms_internal_dto.PublicCategory"
+                    }
+                },
+                "currency": {
+                    "type": "string"
+                },
+                "menus": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_Jiruu246_rms_internal_dto.Menu"
+                    }
+                },
+                "restaurant_id": {
+                    "type": "string"
+                },
+                "timezone": {
+                    "description": "Timezone is the IANA time zone menu schedules are in.",
+                    "type": "string"
+                },
+                "uncategorized_items": {
+                    "description": "UncategorizedItems are the available items without a category.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_Jiruu246_rms_internal_dto.PublicMenuItem"
+                    }
+                },
+                "updated_at": {
+                    "description": "UpdatedAt is the latest change to anything listed. Changes to the\nrestaurant's currency or time zone change the ETag but not this.",
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_Jiruu246_rms_internal_dto.PublicMenuItem": {
+            "type": "object",
+            "properties": {
+                "description": {
+                    "type": "string"
+                },
+                "display_order": {
+                    "type": "integer"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "image_url": {
+                    "type": "string"
+                },
+                "modifiers": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_Jiruu246_rms_internal_dto.PublicModifier"
+                    }
+                },
+                "name": {
+                    "type": "string"
+                },
+                "price": {
+                    "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_money.Money"
+                },
+                "thumbnail_url": {
+                    "type": "string"
+                },
+                "variants": {
+                    "description": "Variants are the available variants, one of which must be ordered.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_Jiruu246_rms_internal_dto.PublicMenuItemVariant"
+                    }
+                }
+            }
+        },
+        "github_com_Jiruu246_rms_internal_dto.PublicMenuItemVariant": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "price": {
+                    "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_money.Money"
+                }
+            }
+        },
+        "github_com_Jiruu246_rms_internal_dto.PublicModifier": {
+            "type": "object",
+            "properties": {
+                "free_quantity": {
+                    "type": "integer"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "max": {
+                    "type": "integer"
+                },
+                "min": {
+                    "type": "integer"
+                },
+                "multi_select": {
+                    "type": "boolean"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "options": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_Jiruu246_rms_internal_dto.PublicModifierOption"
+                    }
+                },
+                "required": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "github_com_Jiruu246_rms_internal_dto.PublicModifierOption": {
+            "type": "object",
+            "properties": {
+                "display_order": {
+                    "type": "integer"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "image_url": {
+                    "type": "string"
+                },
+                "max_quantity": {
+                    "type": "integer"
+                },
+                "modifiers": {
+                    "description": "Modifiers are the groups chosen from once the option is.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_Jiruu246_rms_internal_dto.PublicModifier"
+                    }
+                },
+                "name": {
+                    "type": "string"
+                },
+                "pre_select": {
+                    "type": "boolean"
+                },
+                "price": {
+                    "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_money.Money"
+                },
+                "thumbnail_url": {
+                    "type": "string"
+                },
+                "variant_prices": {
+                    "description": "VariantPrices are the option's prices with variants of the given\nnames, instead of Price.",
+                    "type": "object",
+                    "additionalProperties": {
+                        "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_money.Money"
+                    }
+                }
+            }
+        },
+        "github_com_Jiruu246_rms_internal_dto.PublicTable": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "restaurant_id": {
+                    "type": "string"
+                },
+                "section": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_Jiruu246_rms_internal_dto.Recipe": {
+            "type": "object",
+            "properties": {
+                "ingredients": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_Jiruu246_rms_internal_dto.RecipeLine"
+                    }
+                },
+                "menu_item_id": {
+                    "type": "integer"
+                },
+                "modifier_option_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_Jiruu246_rms_internal_dto.RecipeLine": {
+            "type": "object",
+            "properties": {
+                "ingredient_id": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "quantity": {
+                    "type": "integer"
+                },
+                "unit": {
+                    "$ref": "#/definitions/github_com_Jiruu246_rms_internal_dto.IngredientUnit"
+                }
+            }
+        },
+        "github_com_Jiruu246_rms_internal_dto.RecipeLineRequest": {
+            "type": "object",
+            "required": [
+                "ingredient_id",
+                "quantity"
+            ],
+            "properties": {
+                "ingredient_id": {
+                    "type": "string"
+                },
+                "quantity": {
+                    "type": "integer",
+                    "minimum": 1
+                }
+            }
+        },
+        "github_com_Jiruu246_rms_internal_dto.Refund": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_money.Money"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "created_by": {
+                    "type": "string"
+                },
+                "failure_reason": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_Jiruu246_rms_internal_dto.RefundItem"
+                    }
+                },
+                "note": {
+                    "type": "string"
+                },
+                "order_id": {
+                    "type": "string"
+                },
+                "payment_id": {
+                    "type": "string"
+                },
+                "provider_reference": {
+                    "type": "string"
+                },
+                "reason": {
+                    "$ref": "#/definitions/github_com_Jiruu246_rms_internal_dto.RefundReason"
+                },
+                "status": {
+                    "$ref": "#/definitions/github_com_Jiruu246_rms_internal_dto.PaymentState"
+                }
+            }
+        },
+        "github_com_Jiruu246_rms_internal_dto.RefundItem": {
+            "type": "object",
+            "properties": {
+                "order_item_id": {
+                    "type": "string"
+                },
+                "quantity": {
+                    "type": "integer"
+                }
+            }
+        },
+        "github_com_Jiruu246_rms_internal_dto.RefundItemRequest": {
+            "type": "object",
+            "required": [
+                "order_item_id",
+                "quantity"
+            ],
+            "properties": {
+                "order_item_id": {
+                    "type": "string"
+                },
+                "quantity": {
+                    "type": "integer",
+                    "minimum": 1
+                }
+            }
+        },
+        "github_com_Jiruu246_rms_internal_dto.RefundReason": {
+            "type": "string",
+            "enum": [
+                "CUSTOMER_REQUEST",
+                "ORDER_ERROR",
+                "QUALITY_ISSUE",
+                "DUPLICATE",
+                "OTHER"
+            ],
+            "x-enum-varnames": [
+                "RefundReasonCUSTOMER_REQUEST",
+                "RefundReasonORDER_ERROR",
+                "RefundReasonQUALITY_ISSUE",
+                "RefundReasonDUPLICATE",
+                "RefundReasonOTHER"
+            ]
+        },
+        "github_com_Jiruu246_rms_internal_dto.ReorderMenuItemModifiersRequest": {
+            "type": "object",
+            "required": [
+                "modifier_ids"
+            ],
+            "properties": {
+                "modifier_ids": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "github_com_Jiruu246_rms_internal_dto.RestaurantResponse": {
+            "type": "object",
+            "properties": {
+                "address": {
+                    "type": "string"
+                },
+                "city": {
+                    "type": "string"
+                },
+                "country": {
+                    "type": "string"
+                },
+                "cover_image_url": {
+                    "type": "string"
+                },
+                "currency": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "email": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "kitchen_lead_minutes": {
+                    "type": "integer"
+                },
+                "latitude": {
+                    "type": "number"
+                },
+                "logo_thumbnail_url": {
+                    "type": "string"
+                },
+                "logo_url": {
+                    "type": "string"
+                },
+                "longitude": {
+                    "type": "number"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "operating_hours": {
+                    "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_hours.Schedule"
+                },
+                "order_number_reset": {
+                    "type": "string"
+                },
+                "phone": {
+                    "type": "string"
+                },
+                "slot_capacity": {
+                    "type": "integer"
+                },
+                "slot_minutes": {
+                    "type": "integer"
+                },
+                "state": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string"
+                },
+                "tax_rate_bps": {
+                    "type": "integer"
+                },
+                "timezone": {
+                    "type": "string"
+                },
+                "zip_code": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_Jiruu246_rms_internal_dto.SetMenuContentsRequest": {
+            "type": "object",
+            "properties": {
+                "category_ids": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "item_prices": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_Jiruu246_rms_internal_dto.MenuItemPriceRequest"
+                    }
+                }
+            }
+        },
+        "github_com_Jiruu246_rms_internal_dto.SetMenuItemVariantsRequest": {
+            "type": "object",
+            "properties": {
+                "variants": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_Jiruu246_rms_internal_dto.MenuItemVariantInput"
+                    }
+                }
+            }
+        },
+        "github_com_Jiruu246_rms_internal_dto.SetRecipeRequest": {
+            "type": "object",
+            "properties": {
+                "ingredients": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_Jiruu246_rms_internal_dto.RecipeLineRequest"
+                    }
+                }
+            }
+        },
+        "github_com_Jiruu246_rms_internal_dto.SetStationRoutingRequest": {
+            "type": "object",
+            "properties": {
+                "category_ids": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "menu_item_ids": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                }
+            }
+        },
+        "github_com_Jiruu246_rms_internal_dto.Station": {
+            "type": "object",
+            "properties": {
+                "category_ids": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
                 "display_order": {
                     "type": "integer"
                 },
                 "id": {
                     "type": "string"
                 },
+                "menu_item_ids": {
+                    "description": "MenuItemIDs and CategoryIDs are what is routed to the station. Only\nset when fetching a single station.",
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "name": {
+                    "type": "string"
+                },
+                "restaurant_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_Jiruu246_rms_internal_dto.StationTicket": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_Jiruu246_rms_internal_dto.OrderItem"
+                    }
+                },
+                "order_id": {
+                    "type": "string"
+                },
+                "order_number": {
+                    "type": "integer"
+                },
+                "order_type": {
+                    "$ref": "#/definitions/github_com_Jiruu246_rms_internal_dto.OrderType"
+                },
+                "station_id": {
+                    "type": "string"
+                },
+                "status": {
+                    "$ref": "#/definitions/github_com_Jiruu246_rms_internal_dto.TicketStatus"
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_Jiruu246_rms_internal_dto.StockAdjustmentRequest": {
+            "type": "object",
+            "required": [
+                "kind"
+            ],
+            "properties": {
+                "kind": {
+                    "type": "string",
+                    "enum": [
+                        "DELIVERY",
+                        "WASTE",
+                        "COUNT"
+                    ]
+                },
+                "note": {
+                    "type": "string",
+                    "maxLength": 1000
+                },
+                "quantity": {
+                    "type": "integer",
+                    "minimum": 0
+                }
+            }
+        },
+        "github_com_Jiruu246_rms_internal_dto.StockMovement": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "created_by": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "ingredient_id": {
+                    "type": "string"
+                },
+                "kind": {
+                    "$ref": "#/definitions/github_com_Jiruu246_rms_internal_dto.StockMovementKind"
+                },
+                "note": {
+                    "type": "string"
+                },
+                "on_hand_after": {
+                    "type": "integer"
+                },
+                "order_id": {
+                    "type": "string"
+                },
+                "quantity": {
+                    "description": "Quantity is the change to on-hand stock, negative when stock was used\nup or thrown away.",
+                    "type": "integer"
+                }
+            }
+        },
+        "github_com_Jiruu246_rms_internal_dto.StockMovementKind": {
+            "type": "string",
+            "enum": [
+                "SALE",
+                "DELIVERY",
+                "WASTE",
+                "COUNT"
+            ],
+            "x-enum-varnames": [
+                "StockMovementSALE",
+                "StockMovementDELIVERY",
+                "StockMovementWASTE",
+                "StockMovementCOUNT"
+            ]
+        },
+        "github_com_Jiruu246_rms_internal_dto.Table": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "is_active": {
+                    "type": "boolean"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "qr_token": {
+                    "description": "QRToken goes into the table's QR code; customers send it as\ntable_token when ordering through the public endpoint.",
+                    "type": "string"
+                },
+                "restaurant_id": {
+                    "type": "string"
+                },
+                "seats": {
+                    "type": "integer"
+                },
+                "section": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_Jiruu246_rms_internal_dto.TableSession": {
+            "type": "object",
+            "properties": {
+                "amount_paid": {
+                    "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_money.Money"
+                },
+                "balance": {
+                    "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_money.Money"
+                },
+                "closed_at": {
+                    "type": "string"
+                },
+                "closed_by": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "currency": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "orders": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_Jiruu246_rms_internal_dto.Order"
+                    }
+                },
+                "restaurant_id": {
+                    "type": "string"
+                },
+                "status": {
+                    "$ref": "#/definitions/github_com_Jiruu246_rms_internal_dto.TableSessionStatus"
+                },
+                "table_id": {
+                    "type": "string"
+                },
+                "total": {
+                    "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_money.Money"
+                }
+            }
+        },
+        "github_com_Jiruu246_rms_internal_dto.TableSessionStatus": {
+            "type": "string",
+            "enum": [
+                "OPEN",
+                "CLOSED"
+            ],
+            "x-enum-varnames": [
+                "TableSessionStatusOPEN",
+                "TableSessionStatusCLOSED"
+            ]
+        },
+        "github_com_Jiruu246_rms_internal_dto.TicketStatus": {
+            "type": "string",
+            "enum": [
+                "QUEUED",
+                "IN_PROGRESS",
+                "READY"
+            ],
+            "x-enum-varnames": [
+                "TicketStatusQUEUED",
+                "TicketStatusIN_PROGRESS",
+                "TicketStatusREADY"
+            ]
+        },
+        "github_com_Jiruu246_rms_internal_dto.TimeSlot": {
+            "type": "object",
+            "properties": {
+                "available": {
+                    "type": "boolean"
+                },
+                "end": {
+                    "type": "string"
+                },
+                "remaining": {
+                    "description": "Remaining is how many more orders the slot takes; nil when the\nrestaurant does not limit orders per slot.",
+                    "type": "integer"
+                },
+                "start": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_Jiruu246_rms_internal_dto.UpdateCategoryRequest": {
+            "type": "object",
+            "properties": {
+                "description": {
+                    "type": "string",
+                    "maxLength": 1000
+                },
+                "display_order": {
+                    "type": "integer",
+                    "minimum": 0
+                },
+                "is_active": {
+                    "type": "boolean"
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 255,
+                    "minLength": 1
+                }
+            }
+        },
+        "github_com_Jiruu246_rms_internal_dto.UpdateDeliveryZoneRequest": {
+            "type": "object",
+            "properties": {
+                "delivery_fee": {
+                    "type": "integer",
+                    "minimum": 0
+                },
+                "is_active": {
+                    "type": "boolean"
+                },
+                "kind": {
+                    "type": "string",
+                    "enum": [
+                        "RADIUS",
+                        "POLYGON"
+                    ]
+                },
+                "min_order_value": {
+                    "type": "integer",
+                    "minimum": 0
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 100,
+                    "minLength": 1
+                },
+                "polygon": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_geo.Point"
+                    }
+                },
+                "radius_meters": {
+                    "type": "integer",
+                    "minimum": 1
+                }
+            }
+        },
+        "github_com_Jiruu246_rms_internal_dto.UpdateIngredientRequest": {
+            "type": "object",
+            "properties": {
+                "name": {
+                    "type": "string",
+                    "maxLength": 255,
+                    "minLength": 1
+                }
+            }
+        },
+        "github_com_Jiruu246_rms_internal_dto.UpdateMenuItemRequest": {
+            "type": "object",
+            "properties": {
+                "category_id": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "display_order": {
+                    "type": "integer",
+                    "minimum": 0
+                },
+                "is_available": {
+                    "type": "boolean"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "price": {
+                    "description": "minor units of the restaurant currency",
+                    "type": "integer",
+                    "minimum": 0
+                }
+            }
+        },
+        "github_com_Jiruu246_rms_internal_dto.UpdateMenuRequest": {
+            "type": "object",
+            "properties": {
+                "description": {
+                    "type": "string",
+                    "maxLength": 1000
+                },
+                "display_order": {
+                    "type": "integer",
+                    "minimum": 0
+                },
                 "is_active": {
                     "type": "boolean"
                 },
                 "name": {
-                    "type": "string"
+                    "type": "string",
+                    "maxLength": 255,
+                    "minLength": 1
+                },
+                "order_types": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "schedule": {
+                    "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_hours.Schedule"
+                }
+            }
+        },
+        "github_com_Jiruu246_rms_internal_dto.UpdateModifierOptionRequest": {
+            "type": "object",
+            "required": [
+                "child_modifier_ids",
+                "variant_prices"
+            ],
+            "properties": {
+                "available": {
+                    "type": "boolean"
+                },
+                "child_modifier_ids": {
+                    "description": "ChildModifierIDs replaces the option's child modifier groups; []\nclears them.",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "display_order": {
+                    "type": "integer",
+                    "minimum": 0
+                },
+                "max_quantity": {
+                    "type": "integer",
+                    "minimum": 0
+                },
+                "modifier_id": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 255,
+                    "minLength": 1
+                },
+                "pre_select": {
+                    "type": "boolean"
+                },
+                "price": {
+                    "description": "minor units of the restaurant currency",
+                    "type": "integer",
+                    "minimum": 0
+                },
+                "variant_prices": {
+                    "description": "VariantPrices replaces the option's variant prices; {} clears them.",
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "integer",
+                        "format": "int64"
+                    }
+                }
+            }
+        },
+        "github_com_Jiruu246_rms_internal_dto.UpdateModifierRequest": {
+            "type": "object",
+            "properties": {
+                "free_quantity": {
+                    "type": "integer",
+                    "minimum": 0
+                },
+                "max": {
+                    "type": "integer",
+                    "minimum": 1
+                },
+                "min": {
+                    "type": "integer",
+                    "minimum": 0
+                },
+                "multi_select": {
+                    "type": "boolean"
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 255,
+                    "minLength": 1
+                },
+                "required": {
+                    "type": "boolean"
                 }
             }
         },
-        "github_com_Jiruu246_rms_internal_dto.CategoryListItem": {
+        "github_com_Jiruu246_rms_internal_dto.UpdateOrderItemRequest": {
             "type": "object",
             "properties": {
-                "id": {
-                    "type": "string"
+                "quantity": {
+                    "type": "integer",
+                    "minimum": 1
                 },
-                "is_active": {
-                    "type": "boolean"
+                "reason": {
+                    "type": "string",
+                    "maxLength": 1000
                 },
-                "name": {
-                    "type": "string"
+                "special_instructions": {
+                    "type": "string",
+                    "maxLength": 255
                 }
             }
         },
-        "github_com_Jiruu246_rms_internal_dto.CreateCategoryRequest": {
+        "github_com_Jiruu246_rms_internal_dto.UpdateOrderRequest": {
+            "type": "object",
+            "properties": {
+                "order_status": {
+                    "type": "string",
+                    "enum": [
+                        "OPEN",
+                        "CONFIRMED",
+                        "READY",
+                        "COMPLETED",
+                        "CANCELLED"
+                    ]
+                },
+                "order_type": {
+                    "type": "string",
+                    "enum": [
+                        "DINE_IN",
+                        "TAKEOUT",
+                        "DELIVERY"
+                    ]
+                },
+                "reason": {
+                    "type": "string",
+                    "maxLength": 1000
+                }
+            }
+        },
+        "github_com_Jiruu246_rms_internal_dto.UpdateRestaurantRequest": {
             "type": "object",
-            "required": [
-                "name",
-                "restaurant_id"
-            ],
             "properties": {
+                "address": {
+                    "type": "string"
+                },
+                "city": {
+                    "type": "string"
+                },
+                "country": {
+                    "type": "string"
+                },
+                "cover_image_url": {
+                    "type": "string"
+                },
+                "currency": {
+                    "type": "string"
+                },
                 "description": {
                     "type": "string",
                     "maxLength": 1000
                 },
+                "email": {
+                    "type": "string"
+                },
+                "kitchen_lead_minutes": {
+                    "type": "integer",
+                    "maximum": 1440,
+                    "minimum": 0
+                },
+                "latitude": {
+                    "type": "number",
+                    "maximum": 90,
+                    "minimum": -90
+                },
+                "longitude": {
+                    "type": "number",
+                    "maximum": 180,
+                    "minimum": -180
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 255,
+                    "minLength": 1
+                },
+                "operating_hours": {
+                    "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_hours.Schedule"
+                },
+                "order_number_reset": {
+                    "type": "string",
+                    "enum": [
+                        "never",
+                        "daily"
+                    ]
+                },
+                "phone": {
+                    "type": "string"
+                },
+                "slot_capacity": {
+                    "type": "integer",
+                    "minimum": 0
+                },
+                "slot_minutes": {
+                    "type": "integer",
+                    "maximum": 240,
+                    "minimum": 5
+                },
+                "state": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string",
+                    "enum": [
+                        "active",
+                        "inactive",
+                        "closed"
+                    ]
+                },
+                "tax_rate_bps": {
+                    "type": "integer",
+                    "maximum": 10000,
+                    "minimum": 0
+                },
+                "timezone": {
+                    "type": "string"
+                },
+                "zip_code": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_Jiruu246_rms_internal_dto.UpdateStationRequest": {
+            "type": "object",
+            "properties": {
                 "display_order": {
                     "type": "integer",
                     "minimum": 0
                 },
+                "name": {
+                    "type": "string",
+                    "maxLength": 255,
+                    "minLength": 1
+                }
+            }
+        },
+        "github_com_Jiruu246_rms_internal_dto.UpdateStationTicketRequest": {
+            "type": "object",
+            "required": [
+                "status"
+            ],
+            "properties": {
+                "status": {
+                    "type": "string",
+                    "enum": [
+                        "QUEUED",
+                        "IN_PROGRESS",
+                        "READY"
+                    ]
+                }
+            }
+        },
+        "github_com_Jiruu246_rms_internal_dto.UpdateTableRequest": {
+            "type": "object",
+            "properties": {
                 "is_active": {
                     "type": "boolean"
                 },
                 "name": {
                     "type": "string",
-                    "maxLength": 255,
+                    "maxLength": 100,
                     "minLength": 1
                 },
-                "restaurant_id": {
+                "seats": {
+                    "type": "integer",
+                    "minimum": 1
+                },
+                "section": {
+                    "type": "string",
+                    "maxLength": 100
+                }
+            }
+        },
+        "github_com_Jiruu246_rms_internal_dto.UpdateUserRequest": {
+            "type": "object",
+            "properties": {
+                "email": {
+                    "type": "string"
+                },
+                "name": {
                     "type": "string"
                 }
             }
         },
-        "github_com_Jiruu246_rms_internal_dto.CreateMenuItemRequest": {
+        "github_com_Jiruu246_rms_internal_dto.User": {
+            "type": "object",
+            "properties": {
+                "email": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_Jiruu246_rms_internal_dto.VoidOrderItemRequest": {
+            "type": "object",
+            "properties": {
+                "reason": {
+                    "type": "string",
+                    "maxLength": 1000
+                }
+            }
+        },
+        "github_com_Jiruu246_rms_pkg_geo.Point": {
+            "type": "object",
+            "properties": {
+                "lat": {
+                    "type": "number",
+                    "maximum": 90,
+                    "minimum": -90
+                },
+                "lng": {
+                    "type": "number",
+                    "maximum": 180,
+                    "minimum": -180
+                }
+            }
+        },
+        "github_com_Jiruu246_rms_pkg_hours.Exception": {
             "type": "object",
             "required": [
-                "name",
-                "price",
-                "restaurant_id"
+                "date"
             ],
             "properties": {
-                "category_id": {
+                "date": {
                     "type": "string"
                 },
-                "description": {
+                "intervals": {
+                    "type": "array",
+                    "maxItems": 8,
+                    "items": {
+                        "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_hours.Interval"
+                    }
+                },
+                "note": {
+                    "type": "string",
+                    "maxLength": 255
+                }
+            }
+        },
+        "github_com_Jiruu246_rms_pkg_hours.Interval": {
+            "type": "object",
+            "required": [
+                "close",
+                "open"
+            ],
+            "properties": {
+                "close": {
                     "type": "string"
                 },
-                "is_available": {
+                "open": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_Jiruu246_rms_pkg_hours.Schedule": {
+            "type": "object",
+            "properties": {
+                "exceptions": {
+                    "type": "array",
+                    "maxItems": 366,
+                    "items": {
+                        "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_hours.Exception"
+                    }
+                },
+                "friday": {
+                    "type": "array",
+                    "maxItems": 8,
+                    "items": {
+                        "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_hours.Interval"
+                    }
+                },
+                "monday": {
+                    "type": "array",
+                    "maxItems": 8,
+                    "items": {
+                        "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_hours.Interval"
+                    }
+                },
+                "saturday": {
+                    "type": "array",
+                    "maxItems": 8,
+                    "items": {
+                        "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_hours.Interval"
+                    }
+                },
+                "sunday": {
+                    "type": "array",
+                    "maxItems": 8,
+                    "items": {
+                        "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_hours.Interval"
+                    }
+                },
+                "thursday": {
+                    "type": "array",
+                    "maxItems": 8,
+                    "items": {
+                        "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_hours.Interval"
+                    }
+                },
+                "tuesday": {
+                    "type": "array",
+                    "maxItems": 8,
+                    "items": {
+                        "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_hours.Interval"
+                    }
+                },
+                "wednesday": {
+                    "type": "array",
+                    "maxItems": 8,
+                    "items": {
+                        "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_hours.Interval"
+                    }
+                }
+            }
+        },
+        "github_com_Jiruu246_rms_pkg_money.Money": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "type": "integer"
+                },
+                "currency": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_Jiruu246_rms_pkg_pagination.PageResponse-github_com_Jiruu246_rms_internal_dto_CategoryListItem": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_Jiruu246_rms_internal_dto.CategoryListItem"
+                    }
+                },
+                "has_more": {
                     "type": "boolean"
                 },
-                "name": {
+                "next_cursor": {
                     "type": "string"
                 },
-                "price": {
-                    "type": "number"
-                },
-                "restaurant_id": {
+                "prev_cursor": {
                     "type": "string"
                 }
             }
         },
-        "github_com_Jiruu246_rms_internal_dto.CreateModifierOptionRequest": {
+        "github_com_Jiruu246_rms_pkg_utils.APIError": {
             "type": "object",
-            "required": [
-                "modifier_id",
-                "name"
-            ],
             "properties": {
-                "available": {
-                    "type": "boolean"
+                "code": {
+                    "type": "integer"
                 },
-                "image_url": {
-                    "type": "string"
+                "details": {
+                    "type": "object",
+                    "additionalProperties": {}
                 },
-                "modifier_id": {
+                "message": {
                     "type": "string"
-                },
-                "name": {
-                    "type": "string",
-                    "maxLength": 255,
-                    "minLength": 1
-                },
-                "pre_select": {
-                    "type": "boolean"
-                },
-                "price": {
-                    "type": "number",
-                    "minimum": 0
                 }
             }
         },
-        "github_com_Jiruu246_rms_internal_dto.CreateModifierRequest": {
+        "github_com_Jiruu246_rms_pkg_utils.APIResponse-any": {
             "type": "object",
-            "required": [
-                "name",
-                "restaurant_id"
-            ],
             "properties": {
-                "max": {
-                    "type": "integer",
-                    "minimum": 0
-                },
-                "multi_select": {
-                    "type": "boolean"
-                },
-                "name": {
-                    "type": "string",
-                    "maxLength": 255,
-                    "minLength": 1
+                "data": {},
+                "error": {
+                    "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIError"
                 },
-                "required": {
+                "success": {
                     "type": "boolean"
                 },
-                "restaurant_id": {
+                "timestamp": {
                     "type": "string"
                 }
             }
         },
-        "github_com_Jiruu246_rms_internal_dto.CreateRestaurantRequest": {
+        "github_com_Jiruu246_rms_pkg_utils.APIResponse-array_github_com_Jiruu246_rms_internal_dto_DeliveryZone": {
             "type": "object",
-            "required": [
-                "address",
-                "city",
-                "country",
-                "currency",
-                "email",
-                "name",
-                "phone",
-                "state",
-                "zip_code"
-            ],
             "properties": {
-                "address": {
-                    "type": "string"
-                },
-                "city": {
-                    "type": "string"
-                },
-                "country": {
-                    "type": "string"
-                },
-                "cover_image_url": {
-                    "type": "string"
-                },
-                "currency": {
-                    "type": "string"
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_Jiruu246_rms_internal_dto.DeliveryZone"
+                    }
                 },
-                "description": {
-                    "type": "string",
-                    "maxLength": 1000
+                "error": {
+                    "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIError"
                 },
-                "email": {
-                    "type": "string"
+                "success": {
+                    "type": "boolean"
                 },
-                "logo_url": {
+                "timestamp": {
                     "type": "string"
+                }
+            }
+        },
+        "github_com_Jiruu246_rms_pkg_utils.APIResponse-array_github_com_Jiruu246_rms_internal_dto_Ingredient": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_Jiruu246_rms_internal_dto.Ingredient"
+                    }
                 },
-                "name": {
-                    "type": "string",
-                    "maxLength": 255,
-                    "minLength": 1
+                "error": {
+                    "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIError"
                 },
-                "operating_hours": {
-                    "type": "object",
-                    "additionalProperties": {}
+                "success": {
+                    "type": "boolean"
                 },
-                "phone": {
+                "timestamp": {
                     "type": "string"
+                }
+            }
+        },
+        "github_com_Jiruu246_rms_pkg_utils.APIResponse-array_github_com_Jiruu246_rms_internal_dto_Menu": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_Jiruu246_rms_internal_dto.Menu"
+                    }
                 },
-                "state": {
-                    "type": "string"
+                "error": {
+                    "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIError"
                 },
-                "status": {
-                    "type": "string",
-                    "enum": [
-                        "active",
-                        "inactive",
-                        "closed"
-                    ]
+                "success": {
+                    "type": "boolean"
                 },
-                "zip_code": {
+                "timestamp": {
                     "type": "string"
                 }
             }
         },
-        "github_com_Jiruu246_rms_internal_dto.LoginUserRequest": {
+        "github_com_Jiruu246_rms_pkg_utils.APIResponse-array_github_com_Jiruu246_rms_internal_dto_MenuItem": {
             "type": "object",
-            "required": [
-                "email",
-                "password"
-            ],
             "properties": {
-                "email": {
-                    "type": "string"
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_Jiruu246_rms_internal_dto.MenuItem"
+                    }
                 },
-                "password": {
+                "error": {
+                    "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIError"
+                },
+                "success": {
+                    "type": "boolean"
+                },
+                "timestamp": {
                     "type": "string"
                 }
             }
         },
-        "github_com_Jiruu246_rms_internal_dto.MenuItem": {
+        "github_com_Jiruu246_rms_pkg_utils.APIResponse-array_github_com_Jiruu246_rms_internal_dto_MenuItemModifier": {
             "type": "object",
             "properties": {
-                "category_id": {
-                    "type": "string"
-                },
-                "description": {
-                    "type": "string"
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_Jiruu246_rms_internal_dto.MenuItemModifier"
+                    }
                 },
-                "id": {
-                    "type": "integer"
+                "error": {
+                    "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIError"
                 },
-                "is_available": {
+                "success": {
                     "type": "boolean"
                 },
-                "modifiers": {
+                "timestamp": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_Jiruu246_rms_pkg_utils.APIResponse-array_github_com_Jiruu246_rms_internal_dto_Modifier": {
+            "type": "object",
+            "properties": {
+                "data": {
                     "type": "array",
                     "items": {
                         "$ref": "#/definitions/github_com_Jiruu246_rms_internal_dto.Modifier"
                     }
                 },
-                "name": {
-                    "type": "string"
+                "error": {
+                    "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIError"
                 },
-                "price": {
-                    "type": "number"
+                "success": {
+                    "type": "boolean"
                 },
-                "restaurant_id": {
+                "timestamp": {
                     "type": "string"
                 }
             }
         },
-        "github_com_Jiruu246_rms_internal_dto.Modifier": {
+        "github_com_Jiruu246_rms_pkg_utils.APIResponse-array_github_com_Jiruu246_rms_internal_dto_ModifierOption": {
             "type": "object",
             "properties": {
-                "id": {
-                    "type": "string"
-                },
-                "max": {
-                    "type": "integer"
-                },
-                "multi_select": {
-                    "type": "boolean"
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_Jiruu246_rms_internal_dto.ModifierOption"
+                    }
                 },
-                "name": {
-                    "type": "string"
+                "error": {
+                    "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIError"
                 },
-                "required": {
+                "success": {
                     "type": "boolean"
                 },
-                "restaurant_id": {
+                "timestamp": {
                     "type": "string"
                 }
             }
         },
-        "github_com_Jiruu246_rms_internal_dto.ModifierOption": {
+        "github_com_Jiruu246_rms_pkg_utils.APIResponse-array_github_com_Jiruu246_rms_internal_dto_Order": {
             "type": "object",
             "properties": {
-                "available": {
-                    "type": "boolean"
-                },
-                "id": {
-                    "type": "string"
-                },
-                "image_url": {
-                    "type": "string"
-                },
-                "modifier_id": {
-                    "type": "string"
-                },
-                "name": {
-                    "type": "string"
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_Jiruu246_rms_internal_dto.Order"
+                    }
                 },
-                "pre_select": {
-                    "type": "boolean"
+                "error": {
+                    "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIError"
                 },
-                "price": {
-                    "type": "number"
+                "success": {
+                    "type": "boolean"
                 },
-                "quantity": {
-                    "type": "integer"
+                "timestamp": {
+                    "type": "string"
                 }
             }
         },
-        "github_com_Jiruu246_rms_internal_dto.Order": {
+        "github_com_Jiruu246_rms_pkg_utils.APIResponse-array_github_com_Jiruu246_rms_internal_dto_OrderItemChange": {
             "type": "object",
             "properties": {
-                "id": {
-                    "type": "string"
-                },
-                "order_items": {
+                "data": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/github_com_Jiruu246_rms_internal_dto.OrderItem"
+                        "$ref": "#/definitions/github_com_Jiruu246_rms_internal_dto.OrderItemChange"
                     }
                 },
-                "order_number": {
-                    "type": "string"
-                },
-                "order_status": {
-                    "$ref": "#/definitions/github_com_Jiruu246_rms_internal_dto.OrderStatus"
+                "error": {
+                    "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIError"
                 },
-                "order_type": {
-                    "$ref": "#/definitions/github_com_Jiruu246_rms_internal_dto.OrderType"
+                "success": {
+                    "type": "boolean"
                 },
-                "restaurant_id": {
+                "timestamp": {
                     "type": "string"
                 }
             }
         },
-        "github_com_Jiruu246_rms_internal_dto.OrderItem": {
+        "github_com_Jiruu246_rms_pkg_utils.APIResponse-array_github_com_Jiruu246_rms_internal_dto_OrderStatusEvent": {
             "type": "object",
             "properties": {
-                "id": {
-                    "type": "string"
-                },
-                "item_name": {
-                    "type": "string"
-                },
-                "item_price": {
-                    "type": "number"
-                },
-                "menu_item_id": {
-                    "type": "integer"
-                },
-                "modifier_options": {
+                "data": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/github_com_Jiruu246_rms_internal_dto.OrderItemModifierOption"
+                        "$ref": "#/definitions/github_com_Jiruu246_rms_internal_dto.OrderStatusEvent"
                     }
                 },
-                "order_id": {
-                    "type": "string"
+                "error": {
+                    "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIError"
                 },
-                "quantity": {
-                    "type": "integer"
+                "success": {
+                    "type": "boolean"
                 },
-                "special_instructions": {
+                "timestamp": {
                     "type": "string"
                 }
             }
         },
-        "github_com_Jiruu246_rms_internal_dto.OrderItemModifierOption": {
+        "github_com_Jiruu246_rms_pkg_utils.APIResponse-array_github_com_Jiruu246_rms_internal_dto_Payment": {
             "type": "object",
             "properties": {
-                "modifier_option_id": {
-                    "type": "string"
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_Jiruu246_rms_internal_dto.Payment"
+                    }
                 },
-                "option_name": {
-                    "type": "string"
+                "error": {
+                    "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIError"
                 },
-                "option_price": {
-                    "type": "number"
+                "success": {
+                    "type": "boolean"
                 },
-                "order_item_id": {
+                "timestamp": {
                     "type": "string"
-                },
-                "quantity": {
-                    "type": "integer"
                 }
             }
         },
-        "github_com_Jiruu246_rms_internal_dto.OrderStatus": {
-            "type": "string",
-            "enum": [
-                "OPEN",
-                "CONFIRMED",
-                "COMPLETED",
-                "CANCELLED"
-            ],
-            "x-enum-varnames": [
-                "OrderStatusOPEN",
-                "OrderStatusCONFIRMED",
-                "OrderStatusCOMPLETED",
-                "OrderStatusCANCELLED"
-            ]
-        },
-        "github_com_Jiruu246_rms_internal_dto.OrderType": {
-            "type": "string",
-            "enum": [
-                "DINE_IN",
-                "TAKEOUT",
-                "DELIVERY"
-            ],
-            "x-enum-varnames": [
-                "OrderTypeDINE_IN",
-                "OrderTypeTAKEOUT",
-                "OrderTypeDELIVERY"
-            ]
-        },
-        "github_com_Jiruu246_rms_internal_dto.RestaurantResponse": {
+        "github_com_Jiruu246_rms_pkg_utils.APIResponse-array_github_com_Jiruu246_rms_internal_dto_Refund": {
             "type": "object",
             "properties": {
-                "address": {
-                    "type": "string"
-                },
-                "city": {
-                    "type": "string"
-                },
-                "country": {
-                    "type": "string"
-                },
-                "cover_image_url": {
-                    "type": "string"
-                },
-                "currency": {
-                    "type": "string"
-                },
-                "description": {
-                    "type": "string"
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_Jiruu246_rms_internal_dto.Refund"
+                    }
                 },
-                "email": {
-                    "type": "string"
+                "error": {
+                    "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIError"
                 },
-                "id": {
-                    "type": "string"
+                "success": {
+                    "type": "boolean"
                 },
-                "logo_url": {
+                "timestamp": {
                     "type": "string"
+                }
+            }
+        },
+        "github_com_Jiruu246_rms_pkg_utils.APIResponse-array_github_com_Jiruu246_rms_internal_dto_RestaurantResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_Jiruu246_rms_internal_dto.RestaurantResponse"
+                    }
                 },
-                "name": {
-                    "type": "string"
+                "error": {
+                    "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIError"
                 },
-                "operating_hours": {
-                    "type": "object",
-                    "additionalProperties": {}
+                "success": {
+                    "type": "boolean"
                 },
-                "phone": {
+                "timestamp": {
                     "type": "string"
+                }
+            }
+        },
+        "github_com_Jiruu246_rms_pkg_utils.APIResponse-array_github_com_Jiruu246_rms_internal_dto_Station": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_Jiruu246_rms_internal_dto.Station"
+                    }
                 },
-                "state": {
-                    "type": "string"
+                "error": {
+                    "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIError"
                 },
-                "status": {
-                    "type": "string"
+                "success": {
+                    "type": "boolean"
                 },
-                "zip_code": {
+                "timestamp": {
                     "type": "string"
                 }
             }
         },
-        "github_com_Jiruu246_rms_internal_dto.UpdateCategoryRequest": {
+        "github_com_Jiruu246_rms_pkg_utils.APIResponse-array_github_com_Jiruu246_rms_internal_dto_StationTicket": {
             "type": "object",
             "properties": {
-                "description": {
-                    "type": "string",
-                    "maxLength": 1000
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_Jiruu246_rms_internal_dto.StationTicket"
+                    }
                 },
-                "display_order": {
-                    "type": "integer",
-                    "minimum": 0
+                "error": {
+                    "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIError"
                 },
-                "is_active": {
+                "success": {
                     "type": "boolean"
                 },
-                "name": {
-                    "type": "string",
-                    "maxLength": 255,
-                    "minLength": 1
+                "timestamp": {
+                    "type": "string"
                 }
             }
         },
-        "github_com_Jiruu246_rms_internal_dto.UpdateMenuItemRequest": {
+        "github_com_Jiruu246_rms_pkg_utils.APIResponse-array_github_com_Jiruu246_rms_internal_dto_StockMovement": {
             "type": "object",
             "properties": {
-                "category_id": {
-                    "type": "string"
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_Jiruu246_rms_internal_dto.StockMovement"
+                    }
                 },
-                "description": {
-                    "type": "string"
+                "error": {
+                    "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIError"
                 },
-                "is_available": {
+                "success": {
                     "type": "boolean"
                 },
-                "name": {
+                "timestamp": {
                     "type": "string"
-                },
-                "price": {
-                    "type": "number"
                 }
             }
         },
-        "github_com_Jiruu246_rms_internal_dto.UpdateModifierOptionRequest": {
+        "github_com_Jiruu246_rms_pkg_utils.APIResponse-array_github_com_Jiruu246_rms_internal_dto_Table": {
             "type": "object",
             "properties": {
-                "available": {
-                    "type": "boolean"
-                },
-                "image_url": {
-                    "type": "string"
-                },
-                "modifier_id": {
-                    "type": "string"
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_Jiruu246_rms_internal_dto.Table"
+                    }
                 },
-                "name": {
-                    "type": "string",
-                    "maxLength": 255,
-                    "minLength": 1
+                "error": {
+                    "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIError"
                 },
-                "pre_select": {
+                "success": {
                     "type": "boolean"
                 },
-                "price": {
-                    "type": "number"
+                "timestamp": {
+                    "type": "string"
                 }
             }
         },
-        "github_com_Jiruu246_rms_internal_dto.UpdateModifierRequest": {
+        "github_com_Jiruu246_rms_pkg_utils.APIResponse-array_github_com_Jiruu246_rms_internal_dto_TimeSlot": {
             "type": "object",
             "properties": {
-                "max": {
-                    "type": "integer",
-                    "minimum": 1
-                },
-                "multi_select": {
-                    "type": "boolean"
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_Jiruu246_rms_internal_dto.TimeSlot"
+                    }
                 },
-                "name": {
-                    "type": "string",
-                    "maxLength": 255,
-                    "minLength": 1
+                "error": {
+                    "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIError"
                 },
-                "required": {
+                "success": {
                     "type": "boolean"
                 },
-                "restaurant_id": {
+                "timestamp": {
                     "type": "string"
                 }
             }
         },
-        "github_com_Jiruu246_rms_internal_dto.UpdateOrderRequest": {
+        "github_com_Jiruu246_rms_pkg_utils.APIResponse-github_com_Jiruu246_rms_internal_dto_AccessToken": {
             "type": "object",
             "properties": {
-                "order_number": {
-                    "type": "string"
+                "data": {
+                    "$ref": "#/definitions/github_com_Jiruu246_rms_internal_dto.AccessToken"
                 },
-                "order_status": {
-                    "type": "string"
+                "error": {
+                    "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIError"
                 },
-                "order_type": {
-                    "type": "string"
+                "success": {
+                    "type": "boolean"
                 },
-                "restaurant_id": {
+                "timestamp": {
                     "type": "string"
                 }
             }
         },
-        "github_com_Jiruu246_rms_internal_dto.UpdateRestaurantRequest": {
+        "github_com_Jiruu246_rms_pkg_utils.APIResponse-github_com_Jiruu246_rms_internal_dto_CatalogueImportReport": {
             "type": "object",
             "properties": {
-                "address": {
-                    "type": "string"
-                },
-                "city": {
-                    "type": "string"
+                "data": {
+                    "$ref": "#/definitions/github_com_Jiruu246_rms_internal_dto.CatalogueImportReport"
                 },
-                "country": {
-                    "type": "string"
+                "error": {
+                    "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIError"
                 },
-                "cover_image_url": {
-                    "type": "string"
+                "success": {
+                    "type": "boolean"
                 },
-                "currency": {
+                "timestamp": {
                     "type": "string"
+                }
+            }
+        },
+        "github_com_Jiruu246_rms_pkg_utils.APIResponse-github_com_Jiruu246_rms_internal_dto_Category": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/github_com_Jiruu246_rms_internal_dto.Category"
                 },
-                "description": {
-                    "type": "string",
-                    "maxLength": 1000
+                "error": {
+                    "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIError"
                 },
-                "email": {
-                    "type": "string"
+                "success": {
+                    "type": "boolean"
                 },
-                "logo_url": {
+                "timestamp": {
                     "type": "string"
+                }
+            }
+        },
+        "github_com_Jiruu246_rms_pkg_utils.APIResponse-github_com_Jiruu246_rms_internal_dto_DeliveryZone": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/github_com_Jiruu246_rms_internal_dto.DeliveryZone"
                 },
-                "name": {
-                    "type": "string",
-                    "maxLength": 255,
-                    "minLength": 1
+                "error": {
+                    "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIError"
                 },
-                "operating_hours": {
-                    "type": "object",
-                    "additionalProperties": {}
+                "success": {
+                    "type": "boolean"
                 },
-                "phone": {
+                "timestamp": {
                     "type": "string"
+                }
+            }
+        },
+        "github_com_Jiruu246_rms_pkg_utils.APIResponse-github_com_Jiruu246_rms_internal_dto_Ingredient": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/github_com_Jiruu246_rms_internal_dto.Ingredient"
                 },
-                "state": {
-                    "type": "string"
+                "error": {
+                    "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIError"
                 },
-                "status": {
-                    "type": "string",
-                    "enum": [
-                        "active",
-                        "inactive",
-                        "closed"
-                    ]
+                "success": {
+                    "type": "boolean"
                 },
-                "zip_code": {
+                "timestamp": {
                     "type": "string"
                 }
             }
         },
-        "github_com_Jiruu246_rms_internal_dto.UpdateUserRequest": {
+        "github_com_Jiruu246_rms_pkg_utils.APIResponse-github_com_Jiruu246_rms_internal_dto_Menu": {
             "type": "object",
             "properties": {
-                "email": {
-                    "type": "string"
+                "data": {
+                    "$ref": "#/definitions/github_com_Jiruu246_rms_internal_dto.Menu"
                 },
-                "name": {
+                "error": {
+                    "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIError"
+                },
+                "success": {
+                    "type": "boolean"
+                },
+                "timestamp": {
                     "type": "string"
                 }
             }
         },
-        "github_com_Jiruu246_rms_internal_dto.User": {
+        "github_com_Jiruu246_rms_pkg_utils.APIResponse-github_com_Jiruu246_rms_internal_dto_MenuItem": {
             "type": "object",
             "properties": {
-                "email": {
-                    "type": "string"
+                "data": {
+                    "$ref": "#/definitions/github_com_Jiruu246_rms_internal_dto.MenuItem"
                 },
-                "id": {
-                    "type": "string"
+                "error": {
+                    "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIError"
                 },
-                "name": {
+                "success": {
+                    "type": "boolean"
+                },
+                "timestamp": {
                     "type": "string"
                 }
             }
         },
-        "github_com_Jiruu246_rms_pkg_pagination.PageResponse-github_com_Jiruu246_rms_internal_dto_CategoryListItem": {
+        "github_com_Jiruu246_rms_pkg_utils.APIResponse-github_com_Jiruu246_rms_internal_dto_MenuItemModifier": {
             "type": "object",
             "properties": {
                 "data": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/github_com_Jiruu246_rms_internal_dto.CategoryListItem"
-                    }
+                    "$ref": "#/definitions/github_com_Jiruu246_rms_internal_dto.MenuItemModifier"
                 },
-                "has_more": {
-                    "type": "boolean"
+                "error": {
+                    "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIError"
                 },
-                "next_cursor": {
-                    "type": "string"
+                "success": {
+                    "type": "boolean"
                 },
-                "prev_cursor": {
+                "timestamp": {
                     "type": "string"
                 }
             }
         },
-        "github_com_Jiruu246_rms_pkg_utils.APIError": {
+        "github_com_Jiruu246_rms_pkg_utils.APIResponse-github_com_Jiruu246_rms_internal_dto_Modifier": {
             "type": "object",
             "properties": {
-                "code": {
-                    "type": "integer"
+                "data": {
+                    "$ref": "#/definitions/github_com_Jiruu246_rms_internal_dto.Modifier"
                 },
-                "details": {
-                    "type": "object",
-                    "additionalProperties": {}
+                "error": {
+                    "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIError"
                 },
-                "message": {
+                "success": {
+                    "type": "boolean"
+                },
+                "timestamp": {
                     "type": "string"
                 }
             }
         },
-        "github_com_Jiruu246_rms_pkg_utils.APIResponse-any": {
+        "github_com_Jiruu246_rms_pkg_utils.APIResponse-github_com_Jiruu246_rms_internal_dto_ModifierOption": {
             "type": "object",
             "properties": {
-                "data": {},
+                "data": {
+                    "$ref": "#/definitions/github_com_Jiruu246_rms_internal_dto.ModifierOption"
+                },
                 "error": {
                     "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIError"
                 },
@@ -2466,14 +9469,11 @@ const docTemplate = `{
                 }
             }
         },
-        "github_com_Jiruu246_rms_pkg_utils.APIResponse-array_github_com_Jiruu246_rms_internal_dto_MenuItem": {
+        "github_com_Jiruu246_rms_pkg_utils.APIResponse-github_com_Jiruu246_rms_internal_dto_OpenStatus": {
             "type": "object",
             "properties": {
                 "data": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/github_com_Jiruu246_rms_internal_dto.MenuItem"
-                    }
+                    "$ref": "#/definitions/github_com_Jiruu246_rms_internal_dto.OpenStatus"
                 },
                 "error": {
                     "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIError"
@@ -2486,14 +9486,11 @@ const docTemplate = `{
                 }
             }
         },
-        "github_com_Jiruu246_rms_pkg_utils.APIResponse-array_github_com_Jiruu246_rms_internal_dto_Modifier": {
+        "github_com_Jiruu246_rms_pkg_utils.APIResponse-github_com_Jiruu246_rms_internal_dto_Order": {
             "type": "object",
             "properties": {
                 "data": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/github_com_Jiruu246_rms_internal_dto.Modifier"
-                    }
+                    "$ref": "#/definitions/github_com_Jiruu246_rms_internal_dto.Order"
                 },
                 "error": {
                     "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIError"
@@ -2506,14 +9503,11 @@ const docTemplate = `{
                 }
             }
         },
-        "github_com_Jiruu246_rms_pkg_utils.APIResponse-array_github_com_Jiruu246_rms_internal_dto_ModifierOption": {
+        "github_com_Jiruu246_rms_pkg_utils.APIResponse-github_com_Jiruu246_rms_internal_dto_Payment": {
             "type": "object",
             "properties": {
                 "data": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/github_com_Jiruu246_rms_internal_dto.ModifierOption"
-                    }
+                    "$ref": "#/definitions/github_com_Jiruu246_rms_internal_dto.Payment"
                 },
                 "error": {
                     "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIError"
@@ -2526,14 +9520,11 @@ const docTemplate = `{
                 }
             }
         },
-        "github_com_Jiruu246_rms_pkg_utils.APIResponse-array_github_com_Jiruu246_rms_internal_dto_Order": {
+        "github_com_Jiruu246_rms_pkg_utils.APIResponse-github_com_Jiruu246_rms_internal_dto_PublicMenu": {
             "type": "object",
             "properties": {
                 "data": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/github_com_Jiruu246_rms_internal_dto.Order"
-                    }
+                    "$ref": "#/definitions/github_com_Jiruu246_rms_internal_dto.PublicMenu"
                 },
                 "error": {
                     "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIError"
@@ -2546,14 +9537,11 @@ const docTemplate = `{
                 }
             }
         },
-        "github_com_Jiruu246_rms_pkg_utils.APIResponse-array_github_com_Jiruu246_rms_internal_dto_RestaurantResponse": {
+        "github_com_Jiruu246_rms_pkg_utils.APIResponse-github_com_Jiruu246_rms_internal_dto_PublicTable": {
             "type": "object",
             "properties": {
                 "data": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/github_com_Jiruu246_rms_internal_dto.RestaurantResponse"
-                    }
+                    "$ref": "#/definitions/github_com_Jiruu246_rms_internal_dto.PublicTable"
                 },
                 "error": {
                     "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIError"
@@ -2566,11 +9554,11 @@ const docTemplate = `{
                 }
             }
         },
-        "github_com_Jiruu246_rms_pkg_utils.APIResponse-github_com_Jiruu246_rms_internal_dto_AccessToken": {
+        "github_com_Jiruu246_rms_pkg_utils.APIResponse-github_com_Jiruu246_rms_internal_dto_Recipe": {
             "type": "object",
             "properties": {
                 "data": {
-                    "$ref": "#/definitions/github_com_Jiruu246_rms_internal_dto.AccessToken"
+                    "$ref": "#/definitions/github_com_Jiruu246_rms_internal_dto.Recipe"
                 },
                 "error": {
                     "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIError"
@@ -2583,11 +9571,11 @@ const docTemplate = `{
                 }
             }
         },
-        "github_com_Jiruu246_rms_pkg_utils.APIResponse-github_com_Jiruu246_rms_internal_dto_Category": {
+        "github_com_Jiruu246_rms_pkg_utils.APIResponse-github_com_Jiruu246_rms_internal_dto_RestaurantResponse": {
             "type": "object",
             "properties": {
                 "data": {
-                    "$ref": "#/definitions/github_com_Jiruu246_rms_internal_dto.Category"
+                    "$ref": "#/definitions/github_com_Jiruu246_rms_internal_dto.RestaurantResponse"
                 },
                 "error": {
                     "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIError"
@@ -2600,11 +9588,11 @@ const docTemplate = `{
                 }
             }
         },
-        "github_com_Jiruu246_rms_pkg_utils.APIResponse-github_com_Jiruu246_rms_internal_dto_MenuItem": {
+        "github_com_Jiruu246_rms_pkg_utils.APIResponse-github_com_Jiruu246_rms_internal_dto_Station": {
             "type": "object",
             "properties": {
                 "data": {
-                    "$ref": "#/definitions/github_com_Jiruu246_rms_internal_dto.MenuItem"
+                    "$ref": "#/definitions/github_com_Jiruu246_rms_internal_dto.Station"
                 },
                 "error": {
                     "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIError"
@@ -2617,11 +9605,11 @@ const docTemplate = `{
                 }
             }
         },
-        "github_com_Jiruu246_rms_pkg_utils.APIResponse-github_com_Jiruu246_rms_internal_dto_Modifier": {
+        "github_com_Jiruu246_rms_pkg_utils.APIResponse-github_com_Jiruu246_rms_internal_dto_StationTicket": {
             "type": "object",
             "properties": {
                 "data": {
-                    "$ref": "#/definitions/github_com_Jiruu246_rms_internal_dto.Modifier"
+                    "$ref": "#/definitions/github_com_Jiruu246_rms_internal_dto.StationTicket"
                 },
                 "error": {
                     "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIError"
@@ -2634,11 +9622,11 @@ const docTemplate = `{
                 }
             }
         },
-        "github_com_Jiruu246_rms_pkg_utils.APIResponse-github_com_Jiruu246_rms_internal_dto_ModifierOption": {
+        "github_com_Jiruu246_rms_pkg_utils.APIResponse-github_com_Jiruu246_rms_internal_dto_StockMovement": {
             "type": "object",
             "properties": {
                 "data": {
-                    "$ref": "#/definitions/github_com_Jiruu246_rms_internal_dto.ModifierOption"
+                    "$ref": "#/definitions/github_com_Jiruu246_rms_internal_dto.StockMovement"
                 },
                 "error": {
                     "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIError"
@@ -2651,11 +9639,11 @@ const docTemplate = `{
                 }
             }
         },
-        "github_com_Jiruu246_rms_pkg_utils.APIResponse-github_com_Jiruu246_rms_internal_dto_Order": {
+        "github_com_Jiruu246_rms_pkg_utils.APIResponse-github_com_Jiruu246_rms_internal_dto_Table": {
             "type": "object",
             "properties": {
                 "data": {
-                    "$ref": "#/definitions/github_com_Jiruu246_rms_internal_dto.Order"
+                    "$ref": "#/definitions/github_com_Jiruu246_rms_internal_dto.Table"
                 },
                 "error": {
                     "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIError"
@@ -2668,11 +9656,11 @@ const docTemplate = `{
                 }
             }
         },
-        "github_com_Jiruu246_rms_pkg_utils.APIResponse-github_com_Jiruu246_rms_internal_dto_RestaurantResponse": {
+        "github_com_Jiruu246_rms_pkg_utils.APIResponse-github_com_Jiruu246_rms_internal_dto_TableSession": {
             "type": "object",
             "properties": {
                 "data": {
-                    "$ref": "#/definitions/github_com_Jiruu246_rms_internal_dto.RestaurantResponse"
+                    "$ref": "#/definitions/github_com_Jiruu246_rms_internal_dto.TableSession"
                 },
                 "error": {
                     "$ref": "#/definitions/github_com_Jiruu246_rms_pkg_utils.APIError"
@@ -2719,13 +9707,35 @@ const docTemplate = `{
                 }
             }
         },
+        "internal_handler.AddOrderItemsSchema": {
+            "type": "object",
+            "required": [
+                "order_items"
+            ],
+            "properties": {
+                "order_items": {
+                    "type": "array",
+                    "minItems": 1,
+                    "items": {
+                        "$ref": "#/definitions/internal_handler.OrderItemSchema"
+                    }
+                }
+            }
+        },
         "internal_handler.CreateOrderSchema": {
             "type": "object",
             "required": [
-                "order_type",
-                "restaurant_id"
+                "order_type"
             ],
             "properties": {
+                "delivery": {
+                    "description": "Delivery is required for DELIVERY orders.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/internal_handler.DeliverySchema"
+                        }
+                    ]
+                },
                 "order_items": {
                     "type": "array",
                     "items": {
@@ -2736,23 +9746,80 @@ const docTemplate = `{
                     "$ref": "#/definitions/github_com_Jiruu246_rms_internal_dto.OrderType"
                 },
                 "restaurant_id": {
+                    "description": "RestaurantID may be left out when ordering with a TableToken, which\nidentifies the restaurant.",
                     "type": "string"
+                },
+                "scheduled_for": {
+                    "description": "ScheduledFor places the order for a later pickup or delivery time;\nomitted, the order is prepared as soon as possible.",
+                    "type": "string"
+                },
+                "table_id": {
+                    "description": "TableID places a DINE_IN order at one of the restaurant's tables.",
+                    "type": "string"
+                },
+                "table_token": {
+                    "description": "TableToken is the token from a table's QR code, for customers\nordering from their table through the public endpoint.",
+                    "type": "string",
+                    "maxLength": 64
+                }
+            }
+        },
+        "internal_handler.DeliverySchema": {
+            "type": "object",
+            "required": [
+                "address",
+                "contact_name",
+                "contact_phone"
+            ],
+            "properties": {
+                "address": {
+                    "type": "string",
+                    "maxLength": 500
+                },
+                "contact_name": {
+                    "type": "string",
+                    "maxLength": 255
+                },
+                "contact_phone": {
+                    "type": "string",
+                    "maxLength": 50
+                },
+                "instructions": {
+                    "type": "string",
+                    "maxLength": 1000
+                },
+                "latitude": {
+                    "type": "number",
+                    "maximum": 90,
+                    "minimum": -90
+                },
+                "longitude": {
+                    "type": "number",
+                    "maximum": 180,
+                    "minimum": -180
                 }
             }
         },
         "internal_handler.ModifierOption": {
             "type": "object",
             "required": [
-                "modifier_id",
-                "quantity"
+                "modifier_id"
             ],
             "properties": {
                 "modifier_id": {
                     "type": "string"
                 },
+                "modifiers": {
+                    "description": "Modifiers are the options chosen in this option's child modifier\ngroups, e.g. the size of the fries chosen as a combo's side.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/internal_handler.ModifierOption"
+                    }
+                },
                 "quantity": {
+                    "description": "Quantity 0 deselects an option that is pre-selected by default.",
                     "type": "integer",
-                    "minimum": 1
+                    "minimum": 0
                 }
             }
         },
@@ -2779,6 +9846,10 @@ const docTemplate = `{
                 "quantity": {
                     "type": "integer",
                     "minimum": 1
+                },
+                "variant_id": {
+                    "description": "VariantID chooses one of the item's variants; required for items\nthat have them.",
+                    "type": "string"
                 }
             }
         },
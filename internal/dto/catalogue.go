@@ -0,0 +1,115 @@
+package dto
+
+import (
+	"github.com/google/uuid"
+)
+
+// CatalogueVersion is the version of the catalogue format written by export.
+const CatalogueVersion = 1
+
+// Catalogue is a restaurant's whole menu catalogue in the import/export
+// format: its categories, modifiers with their options, and menu items
+// linked to them by key. Prices are in minor units of Currency.
+//
+// Categories and modifiers are referred to by Key, which defaults to their
+// name; options are keyed within their modifier. On import, an entry with
+// an ID updates that row, one without is matched by name, and anything
+// unmatched is created. Rows not in the file are left alone. Changing the
+// image_url of an item or option drops the image uploaded for it.
+type Catalogue struct {
+	Version    int                 `json:"version"`
+	Currency   string              `json:"currency,omitempty" validate:"omitempty,len=3"`
+	Categories []CatalogueCategory `json:"categories" validate:"dive"`
+	Modifiers  []CatalogueModifier `json:"modifiers" validate:"dive"`
+	Items      []CatalogueItem     `json:"items" validate:"dive"`
+}
+
+type CatalogueCategory struct {
+	Key          string     `json:"key,omitempty" validate:"max=255"`
+	ID           *uuid.UUID `json:"id,omitempty"`
+	Name         string     `json:"name" validate:"required,min=1,max=255"`
+	Description  string     `json:"description" validate:"max=1000"`
+	DisplayOrder int        `json:"display_order" validate:"min=0"`
+	// IsActive defaults to true when left out.
+	IsActive *bool `json:"is_active,omitempty"`
+}
+
+type CatalogueModifier struct {
+	Key          string                    `json:"key,omitempty" validate:"max=255"`
+	ID           *uuid.UUID                `json:"id,omitempty"`
+	Name         string                    `json:"name" validate:"required,min=1,max=255"`
+	Required     bool                      `json:"required"`
+	MultiSelect  bool                      `json:"multi_select"`
+	Max          int                       `json:"max" validate:"min=0"`
+	Min          int                       `json:"min" validate:"min=0"`
+	FreeQuantity int                       `json:"free_quantity" validate:"min=0"`
+	Options      []CatalogueModifierOption `json:"options" validate:"dive"`
+}
+
+type CatalogueModifierOption struct {
+	Key          string     `json:"key,omitempty" validate:"max=255"`
+	ID           *uuid.UUID `json:"id,omitempty"`
+	Name         string     `json:"name" validate:"required,min=1,max=255"`
+	Price        int64      `json:"price" validate:"min=0"` // minor units of the restaurant currency
+	ImageURL     string     `json:"image_url"`
+	PreSelect    bool       `json:"pre_select"`
+	DisplayOrder int        `json:"display_order" validate:"min=0"`
+	MaxQuantity  int        `json:"max_quantity" validate:"min=0"`
+	// Available defaults to true when left out.
+	Available *bool `json:"available,omitempty"`
+}
+
+type CatalogueItem struct {
+	ID           *int64 `json:"id,omitempty"`
+	Name         string `json:"name" validate:"required,min=1,max=255"`
+	Description  string `json:"description" validate:"max=1000"`
+	Price        int64  `json:"price" validate:"min=0"` // minor units of the restaurant currency
+	ImageURL     string `json:"image_url"`
+	DisplayOrder int    `json:"display_order" validate:"min=0"`
+	// IsAvailable defaults to true when left out.
+	IsAvailable *bool `json:"is_available,omitempty"`
+	// Category is the key of the item's category; empty leaves it
+	// uncategorized.
+	Category string `json:"category"`
+	// Modifiers are the keys of the item's modifiers, replacing any it had.
+	Modifiers []string `json:"modifiers"`
+}
+
+type CatalogueEntityKind string
+
+const (
+	CatalogueCATEGORY CatalogueEntityKind = "category"
+	CatalogueMODIFIER CatalogueEntityKind = "modifier"
+	CatalogueOPTION   CatalogueEntityKind = "option"
+	CatalogueITEM     CatalogueEntityKind = "item"
+)
+
+type CatalogueAction string
+
+const (
+	CatalogueCREATE CatalogueAction = "create"
+	CatalogueUPDATE CatalogueAction = "update"
+)
+
+// CatalogueChange is a row an import created or updated, or would have on
+// a dry run. Key is the entry's key, "modifier/option" for options and the
+// name for items.
+type CatalogueChange struct {
+	Kind   CatalogueEntityKind `json:"kind"`
+	Key    string              `json:"key"`
+	Action CatalogueAction     `json:"action"`
+	// ID is empty for rows a dry run would create.
+	ID string `json:"id,omitempty"`
+	// Fields lists what an update changed.
+	Fields []string `json:"fields,omitempty"`
+}
+
+// CatalogueImportReport describes what an import changed. On a dry run
+// nothing is saved.
+type CatalogueImportReport struct {
+	DryRun    bool              `json:"dry_run"`
+	Created   int               `json:"created"`
+	Updated   int               `json:"updated"`
+	Unchanged int               `json:"unchanged"`
+	Changes   []CatalogueChange `json:"changes"`
+}
@@ -0,0 +1,74 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/Jiruu246/rms/pkg/geo"
+	"github.com/Jiruu246/rms/pkg/money"
+	"github.com/google/uuid"
+)
+
+type DeliveryZoneKind string
+
+const (
+	// DeliveryZoneKindRADIUS zones are circles of RadiusMeters around the
+	// restaurant's location.
+	DeliveryZoneKindRADIUS DeliveryZoneKind = "RADIUS"
+	// DeliveryZoneKindPOLYGON zones are the area enclosed by Polygon.
+	DeliveryZoneKindPOLYGON DeliveryZoneKind = "POLYGON"
+)
+
+type DeliveryZone struct {
+	ID            uuid.UUID        `json:"id"`
+	Name          string           `json:"name"`
+	Kind          DeliveryZoneKind `json:"kind"`
+	RadiusMeters  *int             `json:"radius_meters,omitempty"`
+	Polygon       []geo.Point      `json:"polygon,omitempty"`
+	DeliveryFee   money.Money      `json:"delivery_fee"`
+	MinOrderValue money.Money      `json:"min_order_value"`
+	IsActive      bool             `json:"is_active"`
+	RestaurantID  uuid.UUID        `json:"restaurant_id"`
+	CreatedAt     time.Time        `json:"created_at"`
+	UpdatedAt     time.Time        `json:"updated_at"`
+}
+
+// CreateDeliveryZoneRequest represents the request body for creating a
+// delivery zone. RADIUS zones need radius_meters and POLYGON zones a
+// polygon of at least three points.
+type CreateDeliveryZoneRequest struct {
+	Name          string      `json:"name" validate:"required,min=1,max=100" binding:"required"`
+	Kind          string      `json:"kind" validate:"required,oneof=RADIUS POLYGON" binding:"required"`
+	RadiusMeters  *int        `json:"radius_meters" validate:"omitempty,min=1"`
+	Polygon       []geo.Point `json:"polygon" validate:"omitempty,dive"`
+	DeliveryFee   int64       `json:"delivery_fee" validate:"min=0"`    // minor units of the restaurant currency
+	MinOrderValue int64       `json:"min_order_value" validate:"min=0"` // minor units of the restaurant currency
+	RestaurantID  uuid.UUID   `json:"restaurant_id" validate:"required" binding:"required"`
+}
+
+// UpdateDeliveryZoneRequest represents the request body for updating a delivery zone
+// Uses pointers to distinguish between omitted values (nil) and deliberately empty/zero values
+type UpdateDeliveryZoneRequest struct {
+	Name          *string      `json:"name" validate:"omitempty,min=1,max=100"`
+	Kind          *string      `json:"kind" validate:"omitempty,oneof=RADIUS POLYGON"`
+	RadiusMeters  *int         `json:"radius_meters" validate:"omitempty,min=1"`
+	Polygon       *[]geo.Point `json:"polygon" validate:"omitempty,dive"`
+	DeliveryFee   *int64       `json:"delivery_fee" validate:"omitempty,min=0"`
+	MinOrderValue *int64       `json:"min_order_value" validate:"omitempty,min=0"`
+	IsActive      *bool        `json:"is_active"`
+}
+
+// DeliveryDetails is where a DELIVERY order goes and who to hand it to.
+// Latitude and Longitude place the address in one of the restaurant's
+// delivery zones.
+type DeliveryDetails struct {
+	Address      string     `json:"address"`
+	Latitude     float64    `json:"latitude"`
+	Longitude    float64    `json:"longitude"`
+	ContactName  string     `json:"contact_name"`
+	ContactPhone string     `json:"contact_phone"`
+	Instructions string     `json:"instructions"`
+	ZoneID       *uuid.UUID `json:"zone_id"`
+	// MinOrderValue is the zone's minimum when the order was placed. Item
+	// changes may not take the order's subtotal below it.
+	MinOrderValue money.Money `json:"min_order_value"`
+}
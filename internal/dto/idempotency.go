@@ -0,0 +1,9 @@
+package dto
+
+// IdempotentResponse is the response stored for an Idempotency-Key, replayed
+// as is to retries of the request.
+type IdempotentResponse struct {
+	StatusCode  int
+	ContentType string
+	Body        []byte
+}
@@ -0,0 +1,101 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type IngredientUnit string
+
+const (
+	IngredientUnitG    IngredientUnit = "g"
+	IngredientUnitML   IngredientUnit = "ml"
+	IngredientUnitEACH IngredientUnit = "each"
+)
+
+type Ingredient struct {
+	ID   uuid.UUID      `json:"id"`
+	Name string         `json:"name"`
+	Unit IngredientUnit `json:"unit"`
+	// OnHand is the quantity in stock, in Unit.
+	OnHand       int64     `json:"on_hand"`
+	RestaurantID uuid.UUID `json:"restaurant_id"`
+	CreatedAt    time.Time `json:"created_at"`
+	UpdatedAt    time.Time `json:"updated_at"`
+}
+
+// CreateIngredientRequest represents the request body for creating an
+// ingredient. A non-zero OnHand is recorded as an initial COUNT.
+type CreateIngredientRequest struct {
+	Name         string    `json:"name" validate:"required,min=1,max=255" binding:"required"`
+	Unit         string    `json:"unit" validate:"required,oneof=g ml each" binding:"required"`
+	OnHand       int64     `json:"on_hand" validate:"min=0"`
+	RestaurantID uuid.UUID `json:"restaurant_id" validate:"required" binding:"required"`
+}
+
+// UpdateIngredientRequest represents the request body for updating an
+// ingredient. Stock is changed through adjustments, and the unit cannot be
+// changed once recipes are counted in it.
+type UpdateIngredientRequest struct {
+	Name *string `json:"name" validate:"omitempty,min=1,max=255"`
+}
+
+type StockMovementKind string
+
+const (
+	StockMovementSALE     StockMovementKind = "SALE"
+	StockMovementDELIVERY StockMovementKind = "DELIVERY"
+	StockMovementWASTE    StockMovementKind = "WASTE"
+	StockMovementCOUNT    StockMovementKind = "COUNT"
+)
+
+// StockAdjustmentRequest records stock coming in or going out other than
+// through sales: a DELIVERY adds Quantity, WASTE takes it away, and a COUNT
+// sets on-hand stock to Quantity.
+type StockAdjustmentRequest struct {
+	Kind     string `json:"kind" validate:"required,oneof=DELIVERY WASTE COUNT" binding:"required"`
+	Quantity int64  `json:"quantity" validate:"min=0"`
+	Note     string `json:"note" validate:"max=1000"`
+}
+
+// StockMovement is a ledger entry of a change to an ingredient's stock.
+type StockMovement struct {
+	ID           uuid.UUID         `json:"id"`
+	IngredientID uuid.UUID         `json:"ingredient_id"`
+	Kind         StockMovementKind `json:"kind"`
+	// Quantity is the change to on-hand stock, negative when stock was used
+	// up or thrown away.
+	Quantity    int64      `json:"quantity"`
+	OnHandAfter int64      `json:"on_hand_after"`
+	Note        string     `json:"note,omitempty"`
+	OrderID     *uuid.UUID `json:"order_id,omitempty"`
+	CreatedBy   *uuid.UUID `json:"created_by,omitempty"`
+	CreatedAt   time.Time  `json:"created_at"`
+}
+
+type RecipeLineRequest struct {
+	IngredientID uuid.UUID `json:"ingredient_id" validate:"required" binding:"required"`
+	Quantity     int64     `json:"quantity" validate:"required,min=1" binding:"required"`
+}
+
+// SetRecipeRequest replaces the recipe of a menu item or modifier option:
+// how much of each ingredient one of it uses. An empty list clears it.
+type SetRecipeRequest struct {
+	Ingredients []RecipeLineRequest `json:"ingredients" validate:"dive"`
+}
+
+type RecipeLine struct {
+	IngredientID uuid.UUID      `json:"ingredient_id"`
+	Name         string         `json:"name"`
+	Unit         IngredientUnit `json:"unit"`
+	Quantity     int64          `json:"quantity"`
+}
+
+// Recipe is what one unit of a menu item, or one of a modifier option,
+// draws from stock. Exactly one of MenuItemID and ModifierOptionID is set.
+type Recipe struct {
+	MenuItemID       *int64       `json:"menu_item_id,omitempty"`
+	ModifierOptionID *uuid.UUID   `json:"modifier_option_id,omitempty"`
+	Ingredients      []RecipeLine `json:"ingredients"`
+}
@@ -0,0 +1,144 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/Jiruu246/rms/pkg/hours"
+	"github.com/Jiruu246/rms/pkg/money"
+	"github.com/google/uuid"
+)
+
+// Menu is a set of categories served at certain times and for certain
+// order types.
+type Menu struct {
+	ID          uuid.UUID `json:"id"`
+	Name        string    `json:"name"`
+	Description string    `json:"description"`
+	// Schedule is when the menu is served, in the restaurant's timezone.
+	// Without weekly hours it is served at all times.
+	Schedule *hours.Schedule `json:"schedule"`
+	// OrderTypes are the order types the menu applies to; empty means all.
+	OrderTypes   []OrderType `json:"order_types"`
+	DisplayOrder int         `json:"display_order"`
+	IsActive     bool        `json:"is_active"`
+	RestaurantID uuid.UUID   `json:"restaurant_id"`
+	// CategoryIDs and ItemPrices are what is on the menu. Only set when
+	// fetching a single menu.
+	CategoryIDs []uuid.UUID     `json:"category_ids,omitempty"`
+	ItemPrices  []MenuItemPrice `json:"item_prices,omitempty"`
+	CreatedAt   time.Time       `json:"created_at"`
+	UpdatedAt   time.Time       `json:"updated_at"`
+}
+
+// MenuItemPrice is the price of an item when ordered from a menu.
+type MenuItemPrice struct {
+	MenuItemID int64       `json:"menu_item_id"`
+	Price      money.Money `json:"price"`
+}
+
+// CreateMenuRequest represents the request body for creating a menu
+type CreateMenuRequest struct {
+	Name         string          `json:"name" validate:"required,min=1,max=255" binding:"required"`
+	Description  string          `json:"description" validate:"max=1000"`
+	Schedule     *hours.Schedule `json:"schedule"`
+	OrderTypes   []string        `json:"order_types" validate:"omitempty,dive,oneof=DINE_IN TAKEOUT DELIVERY"`
+	DisplayOrder int             `json:"display_order" validate:"min=0"`
+	IsActive     *bool           `json:"is_active"`
+	RestaurantID uuid.UUID       `json:"restaurant_id" validate:"required" binding:"required"`
+}
+
+// UpdateMenuRequest represents the request body for updating a menu
+// Uses pointers to distinguish between omitted values (nil) and deliberately empty/zero values
+type UpdateMenuRequest struct {
+	Name         *string         `json:"name" validate:"omitempty,min=1,max=255"`
+	Description  *string         `json:"description" validate:"omitempty,max=1000"`
+	Schedule     *hours.Schedule `json:"schedule"`
+	OrderTypes   *[]string       `json:"order_types" validate:"omitempty,dive,oneof=DINE_IN TAKEOUT DELIVERY"`
+	DisplayOrder *int            `json:"display_order" validate:"omitempty,min=0"`
+	IsActive     *bool           `json:"is_active"`
+}
+
+type MenuItemPriceRequest struct {
+	MenuItemID int64 `json:"menu_item_id" validate:"required" binding:"required"`
+	Price      int64 `json:"price" validate:"min=0"` // minor units of the restaurant currency
+}
+
+// SetMenuContentsRequest replaces what is on a menu: its categories, and
+// the items whose price on the menu differs from their own. Items keep
+// their own price on menus that do not list one for them.
+type SetMenuContentsRequest struct {
+	CategoryIDs []uuid.UUID            `json:"category_ids"`
+	ItemPrices  []MenuItemPriceRequest `json:"item_prices" validate:"dive"`
+}
+
+// PublicMenu is a restaurant's catalogue as shown to customers: its active
+// categories with their available items, each item's modifiers with their
+// available options, and its active menus, which say when which categories
+// can be ordered and at what price.
+type PublicMenu struct {
+	RestaurantID uuid.UUID      `json:"restaurant_id"`
+	Currency     money.Currency `json:"currency"`
+	// Timezone is the IANA time zone menu schedules are in.
+	Timezone   string           `json:"timezone"`
+	Menus      []Menu           `json:"menus"`
+	Categories []PublicCategory `json:"categories"`
+	// UncategorizedItems are the available items without a category.
+	UncategorizedItems []PublicMenuItem `json:"uncategorized_items"`
+	// UpdatedAt is the latest change to anything listed. Changes to the
+	// restaurant's currency or time zone change the ETag but not this.
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+type PublicCategory struct {
+	ID           uuid.UUID        `json:"id"`
+	Name         string           `json:"name"`
+	Description  string           `json:"description"`
+	DisplayOrder int              `json:"display_order"`
+	Items        []PublicMenuItem `json:"items"`
+}
+
+type PublicMenuItem struct {
+	ID           int64            `json:"id"`
+	Name         string           `json:"name"`
+	Description  string           `json:"description"`
+	Price        money.Money      `json:"price"`
+	ImageURL     string           `json:"image_url"`
+	ThumbnailURL string           `json:"thumbnail_url,omitempty"`
+	DisplayOrder int              `json:"display_order"`
+	Modifiers    []PublicModifier `json:"modifiers"`
+	// Variants are the available variants, one of which must be ordered.
+	Variants []PublicMenuItemVariant `json:"variants,omitempty"`
+}
+
+type PublicMenuItemVariant struct {
+	ID    uuid.UUID   `json:"id"`
+	Name  string      `json:"name"`
+	Price money.Money `json:"price"`
+}
+
+type PublicModifier struct {
+	ID           uuid.UUID              `json:"id"`
+	Name         string                 `json:"name"`
+	Required     bool                   `json:"required"`
+	MultiSelect  bool                   `json:"multi_select"`
+	Max          int                    `json:"max"`
+	Min          int                    `json:"min"`
+	FreeQuantity int                    `json:"free_quantity"`
+	Options      []PublicModifierOption `json:"options"`
+}
+
+type PublicModifierOption struct {
+	ID    uuid.UUID   `json:"id"`
+	Name  string      `json:"name"`
+	Price money.Money `json:"price"`
+	// VariantPrices are the option's prices with variants of the given
+	// names, instead of Price.
+	VariantPrices map[string]money.Money `json:"variant_prices,omitempty"`
+	ImageURL      string                 `json:"image_url"`
+	ThumbnailURL  string                 `json:"thumbnail_url,omitempty"`
+	PreSelect     bool                   `json:"pre_select"`
+	DisplayOrder  int                    `json:"display_order"`
+	MaxQuantity   int                    `json:"max_quantity"`
+	// Modifiers are the groups chosen from once the option is.
+	Modifiers []PublicModifier `json:"modifiers,omitempty"`
+}
@@ -1,6 +1,7 @@
 package dto
 
 import (
+	"github.com/Jiruu246/rms/pkg/money"
 	"github.com/google/uuid"
 )
 
@@ -8,29 +9,106 @@ import (
 type CreateMenuItemRequest struct {
 	Name         string    `json:"name" validate:"required" binding:"required"`
 	Description  string    `json:"description"`
-	Price        float64   `json:"price" validate:"required" binding:"required"`
+	Price        int64     `json:"price" validate:"required,min=0" binding:"required"` // minor units of the restaurant currency
 	IsAvailable  bool      `json:"is_available"`
+	DisplayOrder int       `json:"display_order" validate:"min=0"`
 	RestaurantID uuid.UUID `json:"restaurant_id" validate:"required" binding:"required"`
 	CategoryID   uuid.UUID `json:"category_id"`
 }
 
 type UpdateMenuItemRequest struct {
-	Name        *string    `json:"name"`
-	Description *string    `json:"description"`
-	Price       *float64   `json:"price"`
-	IsAvailable *bool      `json:"is_available"`
-	CategoryID  *uuid.UUID `json:"category_id"`
+	Name         *string    `json:"name"`
+	Description  *string    `json:"description"`
+	Price        *int64     `json:"price" validate:"omitempty,min=0"` // minor units of the restaurant currency
+	IsAvailable  *bool      `json:"is_available"`
+	DisplayOrder *int       `json:"display_order" validate:"omitempty,min=0"`
+	CategoryID   *uuid.UUID `json:"category_id"`
 }
 
 type MenuItem struct {
-	ID           int64      `json:"id"`
-	Name         string     `json:"name"`
-	Description  string     `json:"description"`
-	Price        float64    `json:"price"`
-	IsAvailable  bool       `json:"is_available"`
-	RestaurantID uuid.UUID  `json:"restaurant_id"`
-	CategoryID   uuid.UUID  `json:"category_id"`
-	Modifiers    []Modifier `json:"modifiers,omitempty"`
+	ID          int64       `json:"id"`
+	Name        string      `json:"name"`
+	Description string      `json:"description"`
+	Price       money.Money `json:"price"`
+	// ImageURL and ThumbnailURL are set by uploading an image.
+	ImageURL     string `json:"image_url"`
+	ThumbnailURL string `json:"thumbnail_url,omitempty"`
+	IsAvailable  bool   `json:"is_available"`
+	// OutOfStock is set when IsAvailable was turned off because an
+	// ingredient ran out; restocking turns the item back on.
+	OutOfStock   bool      `json:"out_of_stock"`
+	DisplayOrder int       `json:"display_order"`
+	RestaurantID uuid.UUID `json:"restaurant_id"`
+	CategoryID   uuid.UUID `json:"category_id"`
+	// Modifiers are the groups attached to the item, in display order,
+	// with the item's overrides applied.
+	Modifiers []Modifier `json:"modifiers,omitempty"`
+	// Variants are the sizes or versions the item is sold in, in display
+	// order. An item with variants is ordered as one of them, at its price.
+	Variants []MenuItemVariant `json:"variants,omitempty"`
+}
+
+type MenuItemVariant struct {
+	ID           uuid.UUID   `json:"id"`
+	Name         string      `json:"name"`
+	Price        money.Money `json:"price"`
+	SKU          string      `json:"sku"`
+	IsAvailable  bool        `json:"is_available"`
+	DisplayOrder int         `json:"display_order"`
+}
+
+// MenuItemVariantInput is a variant in SetMenuItemVariantsRequest. With an
+// ID it updates that variant of the item, without one it adds a variant.
+type MenuItemVariantInput struct {
+	ID           *uuid.UUID `json:"id,omitempty"`
+	Name         string     `json:"name" validate:"required,max=255"`
+	Price        int64      `json:"price" validate:"min=0"` // minor units of the restaurant currency
+	SKU          string     `json:"sku" validate:"max=64"`
+	IsAvailable  *bool      `json:"is_available"` // defaults to true
+	DisplayOrder int        `json:"display_order" validate:"min=0"`
+}
+
+// SetMenuItemVariantsRequest replaces a menu item's variants: variants of
+// the item left out are deleted, and an empty list removes them all.
+type SetMenuItemVariantsRequest struct {
+	Variants []MenuItemVariantInput `json:"variants" validate:"dive"`
+}
+
+// MenuItemModifierOverrides are a menu item's own rules for a modifier
+// group attached to it, in place of the group's. Left out, the group's
+// apply.
+type MenuItemModifierOverrides struct {
+	Required *bool `json:"required"`
+	Max      *int  `json:"max" validate:"omitempty,min=0"`
+	// OptionPrices are prices of the group's options on this item, by
+	// option ID, in minor units of the restaurant currency.
+	OptionPrices map[uuid.UUID]int64 `json:"option_prices,omitempty" validate:"dive,min=0"`
+}
+
+// AttachMenuItemModifierRequest attaches a modifier group of the item's
+// restaurant to the item.
+type AttachMenuItemModifierRequest struct {
+	ModifierID uuid.UUID `json:"modifier_id" validate:"required" binding:"required"`
+	// DisplayOrder defaults to after the item's other modifiers.
+	DisplayOrder *int `json:"display_order" validate:"omitempty,min=0"`
+	MenuItemModifierOverrides
+}
+
+// ReorderMenuItemModifiersRequest lists all of a menu item's modifier
+// groups in their new display order.
+type ReorderMenuItemModifiersRequest struct {
+	ModifierIDs []uuid.UUID `json:"modifier_ids" validate:"dive,required"`
+}
+
+// MenuItemModifier is a modifier group attached to a menu item. Modifier
+// holds the group's rules as they apply on the item, overrides included;
+// Required, Max and OptionPrices are the item's overrides alone.
+type MenuItemModifier struct {
+	Modifier     Modifier                  `json:"modifier"`
+	DisplayOrder int                       `json:"display_order"`
+	Required     *bool                     `json:"required,omitempty"`
+	Max          *int                      `json:"max,omitempty"`
+	OptionPrices map[uuid.UUID]money.Money `json:"option_prices,omitempty"`
 }
 
 // type MenuItemQueryParams struct {
@@ -1,6 +1,7 @@
 package dto
 
 import (
+	"github.com/Jiruu246/rms/pkg/money"
 	"github.com/google/uuid"
 )
 
@@ -9,6 +10,8 @@ type CreateModifierRequest struct {
 	Required     bool      `json:"required"`
 	MultiSelect  bool      `json:"multi_select"`
 	Max          int       `json:"max" validate:"min=0"`
+	Min          int       `json:"min" validate:"min=0"`
+	FreeQuantity int       `json:"free_quantity" validate:"min=0"`
 	RestaurantID uuid.UUID `json:"restaurant_id" validate:"required" binding:"required"`
 }
 
@@ -16,12 +19,15 @@ type CreateModifierData struct {
 	Request *CreateModifierRequest
 }
 
+// UpdateModifierRequest has no restaurant: a modifier stays with the
+// restaurant whose menu items and options it is linked to.
 type UpdateModifierRequest struct {
-	Name         *string    `json:"name" validate:"omitempty,min=1,max=255"`
-	Required     *bool      `json:"required"`
-	MultiSelect  *bool      `json:"multi_select"`
-	Max          *int       `json:"max" validate:"omitempty,min=1"`
-	RestaurantID *uuid.UUID `json:"restaurant_id"`
+	Name         *string `json:"name" validate:"omitempty,min=1,max=255"`
+	Required     *bool   `json:"required"`
+	MultiSelect  *bool   `json:"multi_select"`
+	Max          *int    `json:"max" validate:"omitempty,min=1"`
+	Min          *int    `json:"min" validate:"omitempty,min=0"`
+	FreeQuantity *int    `json:"free_quantity" validate:"omitempty,min=0"`
 }
 
 type UpdateModifierData struct {
@@ -29,11 +35,20 @@ type UpdateModifierData struct {
 	ID      uuid.UUID
 }
 
+// Modifier is a group of options chosen for an item. At least Min options
+// must be chosen, one if Required, and at most Max; a group that is not
+// MultiSelect takes a single option. The FreeQuantity cheapest selections
+// are free.
 type Modifier struct {
 	ID           uuid.UUID `json:"id"`
 	Name         string    `json:"name"`
 	Required     bool      `json:"required"`
 	MultiSelect  bool      `json:"multi_select"`
 	Max          int       `json:"max"`
+	Min          int       `json:"min"`
+	FreeQuantity int       `json:"free_quantity"`
 	RestaurantID uuid.UUID `json:"restaurant_id"`
+	// OptionPrices are the prices a menu item sets for the group's options,
+	// by option ID, when the group is read as attached to that item.
+	OptionPrices map[uuid.UUID]money.Money `json:"option_prices,omitempty"`
 }
@@ -1,16 +1,25 @@
 package dto
 
 import (
+	"github.com/Jiruu246/rms/pkg/money"
 	"github.com/google/uuid"
 )
 
 type CreateModifierOptionRequest struct {
-	Name       string    `json:"name" validate:"required,min=1,max=255" binding:"required"`
-	Price      float64   `json:"price" validate:"min=0"`
-	ImageURL   string    `json:"image_url"`
-	Available  bool      `json:"available"`
-	PreSelect  bool      `json:"pre_select"`
-	ModifierID uuid.UUID `json:"modifier_id" validate:"required" binding:"required"`
+	Name  string `json:"name" validate:"required,min=1,max=255" binding:"required"`
+	Price int64  `json:"price" validate:"min=0"` // minor units of the restaurant currency
+	// VariantPrices price the option differently when it is chosen with a
+	// menu item variant of a given name, e.g. {"Large": 80}.
+	VariantPrices map[string]int64 `json:"variant_prices,omitempty" validate:"dive,keys,required,max=255,endkeys,min=0"`
+	ImageURL      string           `json:"image_url"`
+	Available     bool             `json:"available"`
+	PreSelect     bool             `json:"pre_select"`
+	DisplayOrder  int              `json:"display_order" validate:"min=0"`
+	MaxQuantity   int              `json:"max_quantity" validate:"min=0"`
+	ModifierID    uuid.UUID        `json:"modifier_id" validate:"required" binding:"required"`
+	// ChildModifierIDs are modifier groups of the same restaurant chosen
+	// from once this option is, e.g. a Size group under a Fries option.
+	ChildModifierIDs []uuid.UUID `json:"child_modifier_ids,omitempty" validate:"dive,required"`
 }
 
 type CreateModifierOptionData struct {
@@ -18,12 +27,18 @@ type CreateModifierOptionData struct {
 }
 
 type UpdateModifierOptionRequest struct {
-	Name       *string    `json:"name" validate:"omitempty,min=1,max=255"`
-	Price      *float64   `json:"price"`
-	ImageURL   *string    `json:"image_url"`
-	Available  *bool      `json:"available"`
-	PreSelect  *bool      `json:"pre_select"`
-	ModifierID *uuid.UUID `json:"modifier_id"`
+	Name  *string `json:"name" validate:"omitempty,min=1,max=255"`
+	Price *int64  `json:"price" validate:"omitempty,min=0"` // minor units of the restaurant currency
+	// VariantPrices replaces the option's variant prices; {} clears them.
+	VariantPrices *map[string]int64 `json:"variant_prices" validate:"omitempty,dive,keys,required,max=255,endkeys,min=0"`
+	Available     *bool             `json:"available"`
+	PreSelect     *bool             `json:"pre_select"`
+	DisplayOrder  *int              `json:"display_order" validate:"omitempty,min=0"`
+	MaxQuantity   *int              `json:"max_quantity" validate:"omitempty,min=0"`
+	ModifierID    *uuid.UUID        `json:"modifier_id"`
+	// ChildModifierIDs replaces the option's child modifier groups; []
+	// clears them.
+	ChildModifierIDs *[]uuid.UUID `json:"child_modifier_ids" validate:"omitempty,dive,required"`
 }
 
 type UpdateModifierOptionData struct {
@@ -32,12 +47,25 @@ type UpdateModifierOptionData struct {
 }
 
 type ModifierOption struct {
-	ID         uuid.UUID `json:"id"`
-	Name       string    `json:"name"`
-	Price      float64   `json:"price"`
-	ImageURL   string    `json:"image_url"`
-	Available  bool      `json:"available"`
-	PreSelect  bool      `json:"pre_select"`
-	ModifierID uuid.UUID `json:"modifier_id"`
-	Quantity   int       `json:"quantity,omitempty"`
+	ID    uuid.UUID   `json:"id"`
+	Name  string      `json:"name"`
+	Price money.Money `json:"price"`
+	// VariantPrices are what the option costs with menu item variants of
+	// the given names instead of Price.
+	VariantPrices map[string]money.Money `json:"variant_prices,omitempty"`
+	ImageURL      string                 `json:"image_url"`
+	ThumbnailURL  string                 `json:"thumbnail_url,omitempty"`
+	Available     bool                   `json:"available"`
+	// OutOfStock is set when Available was turned off because an
+	// ingredient ran out; restocking turns the option back on.
+	OutOfStock   bool      `json:"out_of_stock"`
+	PreSelect    bool      `json:"pre_select"`
+	DisplayOrder int       `json:"display_order"`
+	ModifierID   uuid.UUID `json:"modifier_id"`
+	Quantity     int       `json:"quantity,omitempty"`
+	// MaxQuantity caps how many times the option can be chosen in one
+	// selection; 0 leaves it to the modifier's max.
+	MaxQuantity int `json:"max_quantity"`
+	// ChildModifiers are the groups chosen from once the option is.
+	ChildModifiers []Modifier `json:"child_modifiers,omitempty"`
 }
@@ -1,6 +1,9 @@
 package dto
 
 import (
+	"time"
+
+	"github.com/Jiruu246/rms/pkg/money"
 	"github.com/google/uuid"
 )
 
@@ -9,6 +12,7 @@ type OrderStatus string
 const (
 	OrderStatusOPEN      OrderStatus = "OPEN"
 	OrderStatusCONFIRMED OrderStatus = "CONFIRMED"
+	OrderStatusREADY     OrderStatus = "READY"
 	OrderStatusCOMPLETED OrderStatus = "COMPLETED"
 	OrderStatusCANCELLED OrderStatus = "CANCELLED"
 )
@@ -30,43 +34,191 @@ const (
 	PaymentStatusREFUNDED PaymentStatus = "REFUNDED"
 )
 
-// UpdateOrderRequest for PATCH (partial update)
+// UpdateOrderRequest for PATCH (partial update). An order stays with the
+// restaurant it was placed at: its number, table session, payments and
+// tickets all belong to that restaurant. OrderType may only repeat the
+// order's current type.
 type UpdateOrderRequest struct {
-	OrderNumber  *string    `json:"order_number"`
-	OrderType    *string    `json:"order_type"`
-	OrderStatus  *string    `json:"order_status"`
-	RestaurantID *uuid.UUID `json:"restaurant_id"`
+	OrderType   *string `json:"order_type" validate:"omitempty,oneof=DINE_IN TAKEOUT DELIVERY"`
+	OrderStatus *string `json:"order_status" validate:"omitempty,oneof=OPEN CONFIRMED READY COMPLETED CANCELLED"`
+	Reason      *string `json:"reason" validate:"omitempty,max=1000"`
+}
+
+// UpdateOrderItemRequest for PATCH /orders/{id}/items/{itemId}. Reason is
+// required when lowering the quantity of a line already sent to the kitchen.
+type UpdateOrderItemRequest struct {
+	Quantity            *int    `json:"quantity" validate:"omitempty,min=1"`
+	SpecialInstructions *string `json:"special_instructions" validate:"omitempty,max=255"`
+	Reason              *string `json:"reason" validate:"omitempty,max=1000"`
+}
+
+// VoidOrderItemRequest for POST /orders/{id}/items/{itemId}/void. Reason is
+// required once the order has been sent to the kitchen.
+type VoidOrderItemRequest struct {
+	Reason string `json:"reason" validate:"max=1000"`
+}
+
+// OrderListFilters holds optional filter options for listing orders.
+type OrderListFilters struct {
+	OrderNumber       *int
+	OrderNumberPeriod *string
 }
 
 type UpdateOrderData struct {
 	Request *UpdateOrderRequest
 	ID      uuid.UUID
+	// StatusTransition is set by the service once the requested order_status
+	// has been checked against the order lifecycle; the repository ignores
+	// Request.OrderStatus and only ever applies a validated transition.
+	StatusTransition *OrderStatusTransition
+}
+
+// OrderStatusTransition describes a single, already validated, order_status
+// change. From is used as an optimistic-concurrency guard: the repository
+// only applies the change if the order is still in From.
+type OrderStatusTransition struct {
+	From      OrderStatus
+	To        OrderStatus
+	ChangedBy uuid.UUID
+	Reason    string
+}
+
+type OrderStatusEvent struct {
+	ID         uuid.UUID    `json:"id"`
+	OrderID    uuid.UUID    `json:"order_id"`
+	FromStatus *OrderStatus `json:"from_status"`
+	ToStatus   OrderStatus  `json:"to_status"`
+	Reason     string       `json:"reason"`
+	ChangedBy  *uuid.UUID   `json:"changed_by"`
+	CreatedAt  time.Time    `json:"created_at"`
+}
+
+type OrderItemChangeAction string
+
+const (
+	OrderItemChangeADDED   OrderItemChangeAction = "ADDED"
+	OrderItemChangeUPDATED OrderItemChangeAction = "UPDATED"
+	OrderItemChangeVOIDED  OrderItemChangeAction = "VOIDED"
+)
+
+// OrderItemChange is one entry of an order's item audit trail.
+type OrderItemChange struct {
+	ID          uuid.UUID             `json:"id"`
+	OrderID     uuid.UUID             `json:"order_id"`
+	OrderItemID uuid.UUID             `json:"order_item_id"`
+	Action      OrderItemChangeAction `json:"action"`
+	// QuantityBefore is nil for ADDED; QuantityAfter is 0 for VOIDED.
+	QuantityBefore     *int       `json:"quantity_before"`
+	QuantityAfter      int        `json:"quantity_after"`
+	InstructionsBefore string     `json:"instructions_before"`
+	InstructionsAfter  string     `json:"instructions_after"`
+	Reason             string     `json:"reason"`
+	ChangedBy          *uuid.UUID `json:"changed_by"`
+	CreatedAt          time.Time  `json:"created_at"`
 }
 
 type OrderItemModifierOption struct {
-	OrderItemID      uuid.UUID `json:"order_item_id"`
-	ModifierOptionID uuid.UUID `json:"modifier_option_id"`
-	Quantity         int       `json:"quantity"`
-	OptionName       string    `json:"option_name"`
-	OptionPrice      float64   `json:"option_price"`
+	OrderItemID      uuid.UUID   `json:"order_item_id"`
+	ModifierOptionID uuid.UUID   `json:"modifier_option_id"`
+	Quantity         int         `json:"quantity"`
+	OptionName       string      `json:"option_name"`
+	OptionPrice      money.Money `json:"option_price"`
+	// FreeQuantity is how many of Quantity were free under the
+	// modifier's free allowance, and not charged.
+	FreeQuantity int `json:"free_quantity,omitempty"`
+	// ModifierOptions are the options chosen in this option's child
+	// modifier groups, for each of its Quantity.
+	ModifierOptions []OrderItemModifierOption `json:"modifier_options,omitempty"`
 }
 
 type OrderItem struct {
-	ID                  uuid.UUID                 `json:"id"`
-	Quantity            int                       `json:"quantity"`
-	SpecialInstructions string                    `json:"special_instructions"`
-	MenuItemID          int64                     `json:"menu_item_id"`
-	ItemName            string                    `json:"item_name"`
-	ItemPrice           float64                   `json:"item_price"`
-	ModifierOptions     []OrderItemModifierOption `json:"modifier_options"`
-	OrderID             uuid.UUID                 `json:"order_id"`
+	ID                  uuid.UUID `json:"id"`
+	Quantity            int       `json:"quantity"`
+	SpecialInstructions string    `json:"special_instructions"`
+	MenuItemID          int64     `json:"menu_item_id"`
+	ItemName            string    `json:"item_name"`
+	// VariantID and VariantName are set on lines of items with variants;
+	// ItemPrice is then the variant's price.
+	VariantID        *uuid.UUID                `json:"variant_id,omitempty"`
+	VariantName      string                    `json:"variant_name,omitempty"`
+	ItemPrice        money.Money               `json:"item_price"`
+	ModifiersTotal   money.Money               `json:"modifiers_total"`
+	LineTotal        money.Money               `json:"line_total"`
+	RefundedQuantity int                       `json:"refunded_quantity"`
+	ModifierOptions  []OrderItemModifierOption `json:"modifier_options"`
+	OrderID          uuid.UUID                 `json:"order_id"`
+	StationTicketID  *uuid.UUID                `json:"station_ticket_id,omitempty"`
+	// VoidedAt is set on voided lines, which are kept for the record but
+	// not counted in the order's totals.
+	VoidedAt   *time.Time `json:"voided_at,omitempty"`
+	VoidReason string     `json:"void_reason,omitempty"`
 }
 
 type Order struct {
-	ID           uuid.UUID   `json:"id"`
-	OrderNumber  string      `json:"order_number"`
-	OrderType    OrderType   `json:"order_type"`
-	OrderStatus  OrderStatus `json:"order_status"`
-	RestaurantID uuid.UUID   `json:"restaurant_id"`
-	OrderItems   []OrderItem `json:"order_items"`
+	ID uuid.UUID `json:"id"`
+	// OrderNumber is the number called out in the kitchen. It is unique
+	// within OrderNumberPeriod, which is empty unless the restaurant resets
+	// numbers daily, in which case it is the local date (YYYY-MM-DD).
+	OrderNumber       *int           `json:"order_number"`
+	OrderNumberPeriod string         `json:"order_number_period,omitempty"`
+	OrderType         OrderType      `json:"order_type"`
+	OrderStatus       OrderStatus    `json:"order_status"`
+	PaymentStatus     PaymentStatus  `json:"payment_status"`
+	RestaurantID      uuid.UUID      `json:"restaurant_id"`
+	OrderItems        []OrderItem    `json:"order_items"`
+	Currency          money.Currency `json:"currency"`
+	Subtotal          money.Money    `json:"subtotal"`
+	ModifiersTotal    money.Money    `json:"modifiers_total"`
+	TaxTotal          money.Money    `json:"tax_total"`
+	// TaxRateBps is the tax rate the order was placed, and is repriced, at.
+	// It is nil on orders placed before the rate was recorded.
+	TaxRateBps     *int        `json:"tax_rate_bps,omitempty"`
+	DeliveryFee    money.Money `json:"delivery_fee"`
+	Total          money.Money `json:"total"`
+	AmountPaid     money.Money `json:"amount_paid"`
+	AmountRefunded money.Money `json:"amount_refunded"`
+	// TableID and TableSessionID are set on dine-in orders placed at a
+	// table; see TableSession.
+	TableID        *uuid.UUID `json:"table_id,omitempty"`
+	TableSessionID *uuid.UUID `json:"table_session_id,omitempty"`
+	// Delivery is set on DELIVERY orders.
+	Delivery *DeliveryDetails `json:"delivery,omitempty"`
+	// ScheduledFor is the requested pickup or delivery time of a scheduled
+	// order. ReleasedAt is when the order was sent to the kitchen; it is
+	// nil while a scheduled order is held back until its slot draws near.
+	ScheduledFor *time.Time `json:"scheduled_for,omitempty"`
+	ReleasedAt   *time.Time `json:"released_at,omitempty"`
+	// ItemsVersion counts the changes made to the order's items since it
+	// was placed.
+	ItemsVersion int `json:"items_version"`
+}
+
+type OrderEventType string
+
+const (
+	OrderEventCreated       OrderEventType = "order.created"
+	OrderEventUpdated       OrderEventType = "order.updated"
+	OrderEventStatusChanged OrderEventType = "order.status_changed"
+	OrderEventDeleted       OrderEventType = "order.deleted"
+	OrderEventReleased      OrderEventType = "order.released"
+
+	OrderEventTicketCreated       OrderEventType = "ticket.created"
+	OrderEventTicketStatusChanged OrderEventType = "ticket.status_changed"
+	OrderEventTicketUpdated       OrderEventType = "ticket.updated"
+)
+
+// OrderEvent is one entry of a restaurant's live order feed. ID increases
+// with every event of the restaurant and is what clients send back as
+// Last-Event-ID to resume.
+type OrderEvent struct {
+	ID      int64          `json:"id"`
+	Type    OrderEventType `json:"type"`
+	OrderID uuid.UUID      `json:"order_id"`
+	// Order is the order as it was right after the change; nil for
+	// order.deleted and ticket events.
+	Order *Order `json:"order,omitempty"`
+	// StationID and Ticket are set for ticket events only.
+	StationID *uuid.UUID     `json:"station_id,omitempty"`
+	Ticket    *StationTicket `json:"ticket,omitempty"`
+	CreatedAt time.Time      `json:"created_at"`
 }
@@ -0,0 +1,46 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/Jiruu246/rms/pkg/money"
+	"github.com/google/uuid"
+)
+
+type PaymentMethod string
+
+const (
+	PaymentMethodCASH PaymentMethod = "CASH"
+	PaymentMethodCARD PaymentMethod = "CARD"
+)
+
+// PaymentState is the state of a single Payment, as opposed to
+// PaymentStatus which summarises all payments on an order.
+type PaymentState string
+
+const (
+	PaymentStatePENDING   PaymentState = "PENDING"
+	PaymentStateSUCCEEDED PaymentState = "SUCCEEDED"
+	PaymentStateFAILED    PaymentState = "FAILED"
+)
+
+type CreatePaymentRequest struct {
+	Method PaymentMethod `json:"method" validate:"required,oneof=CASH CARD" binding:"required"`
+	// Amount is in minor units of the order currency.
+	Amount    int64  `json:"amount" validate:"required,min=1" binding:"required"`
+	CardToken string `json:"card_token,omitempty"`
+}
+
+type Payment struct {
+	ID                uuid.UUID     `json:"id"`
+	OrderID           uuid.UUID     `json:"order_id"`
+	Method            PaymentMethod `json:"method"`
+	Provider          string        `json:"provider"`
+	ProviderReference string        `json:"provider_reference,omitempty"`
+	Amount            money.Money   `json:"amount"`
+	AmountRefunded    money.Money   `json:"amount_refunded"`
+	Status            PaymentState  `json:"status"`
+	FailureReason     string        `json:"failure_reason,omitempty"`
+	CreatedBy         *uuid.UUID    `json:"created_by,omitempty"`
+	CreatedAt         time.Time     `json:"created_at"`
+}
@@ -0,0 +1,60 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/Jiruu246/rms/pkg/money"
+	"github.com/google/uuid"
+)
+
+type RefundReason string
+
+const (
+	RefundReasonCUSTOMER_REQUEST RefundReason = "CUSTOMER_REQUEST"
+	RefundReasonORDER_ERROR      RefundReason = "ORDER_ERROR"
+	RefundReasonQUALITY_ISSUE    RefundReason = "QUALITY_ISSUE"
+	RefundReasonDUPLICATE        RefundReason = "DUPLICATE"
+	RefundReasonOTHER            RefundReason = "OTHER"
+)
+
+type RefundItemRequest struct {
+	OrderItemID uuid.UUID `json:"order_item_id" validate:"required" binding:"required"`
+	Quantity    int       `json:"quantity" validate:"required,min=1" binding:"required"`
+}
+
+// CreateRefundRequest refunds an order in one of three ways; exactly one of
+// Full, Items or Amount must be set.
+type CreateRefundRequest struct {
+	Reason RefundReason `json:"reason" validate:"required,oneof=CUSTOMER_REQUEST ORDER_ERROR QUALITY_ISSUE DUPLICATE OTHER" binding:"required"`
+	Note   string       `json:"note,omitempty" validate:"max=1000"`
+	// Full refunds everything that has been paid and not yet refunded.
+	Full bool `json:"full,omitempty"`
+	// Items refunds the given quantities of order items, including their
+	// share of tax.
+	Items []RefundItemRequest `json:"items,omitempty" validate:"dive"`
+	// Amount refunds an arbitrary amount, in minor units of the order
+	// currency.
+	Amount *int64 `json:"amount,omitempty" validate:"omitempty,min=1"`
+}
+
+type RefundItem struct {
+	OrderItemID uuid.UUID `json:"order_item_id"`
+	Quantity    int       `json:"quantity"`
+}
+
+// Refund is money returned from a single payment. Its Status uses the same
+// states as a payment.
+type Refund struct {
+	ID                uuid.UUID    `json:"id"`
+	OrderID           uuid.UUID    `json:"order_id"`
+	PaymentID         uuid.UUID    `json:"payment_id"`
+	Amount            money.Money  `json:"amount"`
+	Reason            RefundReason `json:"reason"`
+	Note              string       `json:"note,omitempty"`
+	Items             []RefundItem `json:"items,omitempty"`
+	Status            PaymentState `json:"status"`
+	ProviderReference string       `json:"provider_reference,omitempty"`
+	FailureReason     string       `json:"failure_reason,omitempty"`
+	CreatedBy         *uuid.UUID   `json:"created_by,omitempty"`
+	CreatedAt         time.Time    `json:"created_at"`
+}
@@ -1,25 +1,45 @@
 package dto
 
 import (
+	"time"
+
+	"github.com/Jiruu246/rms/pkg/hours"
 	"github.com/google/uuid"
 )
 
+const (
+	RestaurantStatusActive   = "active"
+	RestaurantStatusInactive = "inactive"
+	RestaurantStatusClosed   = "closed"
+)
+
 // CreateRestaurantRequest represents the request body for creating a restaurant
 type CreateRestaurantRequest struct {
-	Name           string         `json:"name" validate:"required,min=1,max=255" binding:"required"`
-	Description    string         `json:"description" validate:"max=1000"`
-	Phone          string         `json:"phone" validate:"required" binding:"required"`
-	Email          string         `json:"email" validate:"required,email" binding:"required"`
-	Address        string         `json:"address" validate:"required" binding:"required"`
-	City           string         `json:"city" validate:"required" binding:"required"`
-	State          string         `json:"state" validate:"required" binding:"required"`
-	ZipCode        string         `json:"zip_code" validate:"required" binding:"required"`
-	Country        string         `json:"country" validate:"required" binding:"required"`
-	LogoURL        string         `json:"logo_url" validate:"omitempty,url"`
-	CoverImageURL  string         `json:"cover_image_url" validate:"omitempty,url"`
-	Status         string         `json:"status" validate:"omitempty,oneof=active inactive closed"`
-	OperatingHours map[string]any `json:"operating_hours"`
-	Currency       string         `json:"currency" validate:"required" binding:"required"`
+	Name             string          `json:"name" validate:"required,min=1,max=255" binding:"required"`
+	Description      string          `json:"description" validate:"max=1000"`
+	Phone            string          `json:"phone" validate:"required" binding:"required"`
+	Email            string          `json:"email" validate:"required,email" binding:"required"`
+	Address          string          `json:"address" validate:"required" binding:"required"`
+	City             string          `json:"city" validate:"required" binding:"required"`
+	State            string          `json:"state" validate:"required" binding:"required"`
+	ZipCode          string          `json:"zip_code" validate:"required" binding:"required"`
+	Country          string          `json:"country" validate:"required" binding:"required"`
+	Latitude         *float64        `json:"latitude" validate:"required_with=Longitude,omitempty,min=-90,max=90"`
+	Longitude        *float64        `json:"longitude" validate:"required_with=Latitude,omitempty,min=-180,max=180"`
+	LogoURL          string          `json:"logo_url" validate:"omitempty,url"`
+	CoverImageURL    string          `json:"cover_image_url" validate:"omitempty,url"`
+	Status           string          `json:"status" validate:"omitempty,oneof=active inactive closed"`
+	OperatingHours   *hours.Schedule `json:"operating_hours"`
+	Currency         string          `json:"currency" validate:"required,iso4217" binding:"required"`
+	TaxRateBps       int             `json:"tax_rate_bps" validate:"min=0,max=10000"`
+	Timezone         string          `json:"timezone" validate:"omitempty,timezone"`
+	OrderNumberReset string          `json:"order_number_reset" validate:"omitempty,oneof=never daily"`
+
+	// SlotMinutes, SlotCapacity and KitchenLeadMinutes configure scheduled
+	// orders; omitted, they default to 15, 0 (no limit) and 20.
+	SlotMinutes        *int `json:"slot_minutes" validate:"omitempty,min=5,max=240"`
+	SlotCapacity       *int `json:"slot_capacity" validate:"omitempty,min=0"`
+	KitchenLeadMinutes *int `json:"kitchen_lead_minutes" validate:"omitempty,min=0,max=1440"`
 }
 
 type CreateRestaurantData struct {
@@ -30,20 +50,28 @@ type CreateRestaurantData struct {
 // UpdateRestaurantRequest represents the request body for updating a restaurant
 // Uses pointers to distinguish between omitted values (nil) and deliberately empty/zero values
 type UpdateRestaurantRequest struct {
-	Name           *string         `json:"name" validate:"omitempty,min=1,max=255"`
-	Description    *string         `json:"description" validate:"omitempty,max=1000"`
-	Phone          *string         `json:"phone" validate:"omitempty"`
-	Email          *string         `json:"email" validate:"omitempty,email"`
-	Address        *string         `json:"address" validate:"omitempty"`
-	City           *string         `json:"city" validate:"omitempty"`
-	State          *string         `json:"state" validate:"omitempty"`
-	ZipCode        *string         `json:"zip_code" validate:"omitempty"`
-	Country        *string         `json:"country" validate:"omitempty"`
-	LogoURL        *string         `json:"logo_url" validate:"omitempty,url"`
-	CoverImageURL  *string         `json:"cover_image_url" validate:"omitempty,url"`
-	Status         *string         `json:"status" validate:"omitempty,oneof=active inactive closed"`
-	OperatingHours *map[string]any `json:"operating_hours"`
-	Currency       *string         `json:"currency" validate:"omitempty"`
+	Name             *string         `json:"name" validate:"omitempty,min=1,max=255"`
+	Description      *string         `json:"description" validate:"omitempty,max=1000"`
+	Phone            *string         `json:"phone" validate:"omitempty"`
+	Email            *string         `json:"email" validate:"omitempty,email"`
+	Address          *string         `json:"address" validate:"omitempty"`
+	City             *string         `json:"city" validate:"omitempty"`
+	State            *string         `json:"state" validate:"omitempty"`
+	ZipCode          *string         `json:"zip_code" validate:"omitempty"`
+	Country          *string         `json:"country" validate:"omitempty"`
+	Latitude         *float64        `json:"latitude" validate:"required_with=Longitude,omitempty,min=-90,max=90"`
+	Longitude        *float64        `json:"longitude" validate:"required_with=Latitude,omitempty,min=-180,max=180"`
+	CoverImageURL    *string         `json:"cover_image_url" validate:"omitempty,url"`
+	Status           *string         `json:"status" validate:"omitempty,oneof=active inactive closed"`
+	OperatingHours   *hours.Schedule `json:"operating_hours"`
+	Currency         *string         `json:"currency" validate:"omitempty,iso4217"`
+	TaxRateBps       *int            `json:"tax_rate_bps" validate:"omitempty,min=0,max=10000"`
+	Timezone         *string         `json:"timezone" validate:"omitempty,timezone"`
+	OrderNumberReset *string         `json:"order_number_reset" validate:"omitempty,oneof=never daily"`
+
+	SlotMinutes        *int `json:"slot_minutes" validate:"omitempty,min=5,max=240"`
+	SlotCapacity       *int `json:"slot_capacity" validate:"omitempty,min=0"`
+	KitchenLeadMinutes *int `json:"kitchen_lead_minutes" validate:"omitempty,min=0,max=1440"`
 }
 
 type UpdateRestaurantData struct {
@@ -53,19 +81,42 @@ type UpdateRestaurantData struct {
 
 // RestaurantResponse represents the response structure for restaurant data
 type RestaurantResponse struct {
-	ID             uuid.UUID      `json:"id"`
-	Name           string         `json:"name"`
-	Description    string         `json:"description"`
-	Phone          string         `json:"phone"`
-	Email          string         `json:"email"`
-	Address        string         `json:"address"`
-	City           string         `json:"city"`
-	State          string         `json:"state"`
-	ZipCode        string         `json:"zip_code"`
-	Country        string         `json:"country"`
-	LogoURL        string         `json:"logo_url"`
-	CoverImageURL  string         `json:"cover_image_url"`
-	Status         string         `json:"status"`
-	OperatingHours map[string]any `json:"operating_hours"`
-	Currency       string         `json:"currency"`
+	ID               uuid.UUID       `json:"id"`
+	Name             string          `json:"name"`
+	Description      string          `json:"description"`
+	Phone            string          `json:"phone"`
+	Email            string          `json:"email"`
+	Address          string          `json:"address"`
+	City             string          `json:"city"`
+	State            string          `json:"state"`
+	ZipCode          string          `json:"zip_code"`
+	Country          string          `json:"country"`
+	Latitude         *float64        `json:"latitude"`
+	Longitude        *float64        `json:"longitude"`
+	LogoURL          string          `json:"logo_url"`
+	LogoThumbnailURL string          `json:"logo_thumbnail_url,omitempty"`
+	CoverImageURL    string          `json:"cover_image_url"`
+	Status           string          `json:"status"`
+	OperatingHours   *hours.Schedule `json:"operating_hours"`
+	Currency         string          `json:"currency"`
+	TaxRateBps       int             `json:"tax_rate_bps"`
+	Timezone         string          `json:"timezone"`
+	OrderNumberReset string          `json:"order_number_reset"`
+
+	SlotMinutes        int `json:"slot_minutes"`
+	SlotCapacity       int `json:"slot_capacity"`
+	KitchenLeadMinutes int `json:"kitchen_lead_minutes"`
+}
+
+// OpenStatus answers whether a restaurant is open at At. A restaurant
+// without operating hours is always open unless its status says otherwise.
+type OpenStatus struct {
+	At     time.Time `json:"at"`
+	IsOpen bool      `json:"is_open"`
+	// ClosesAt is when the current opening ends; nil when closed, or when
+	// the restaurant does not close within a year.
+	ClosesAt *time.Time `json:"closes_at,omitempty"`
+	// NextOpening is the start of the next opening after At; nil when there
+	// is none within a year, or the restaurant is inactive or closed.
+	NextOpening *time.Time `json:"next_opening,omitempty"`
 }
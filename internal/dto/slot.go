@@ -0,0 +1,14 @@
+package dto
+
+import "time"
+
+// TimeSlot is a window of a restaurant's opening hours that scheduled
+// pickup and delivery orders are booked into.
+type TimeSlot struct {
+	Start time.Time `json:"start"`
+	End   time.Time `json:"end"`
+	// Remaining is how many more orders the slot takes; nil when the
+	// restaurant does not limit orders per slot.
+	Remaining *int `json:"remaining"`
+	Available bool `json:"available"`
+}
@@ -0,0 +1,74 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type Station struct {
+	ID           uuid.UUID `json:"id"`
+	Name         string    `json:"name"`
+	DisplayOrder int       `json:"display_order"`
+	RestaurantID uuid.UUID `json:"restaurant_id"`
+	// MenuItemIDs and CategoryIDs are what is routed to the station. Only
+	// set when fetching a single station.
+	MenuItemIDs []int64     `json:"menu_item_ids,omitempty"`
+	CategoryIDs []uuid.UUID `json:"category_ids,omitempty"`
+}
+
+// CreateStationRequest represents the request body for creating a station
+type CreateStationRequest struct {
+	Name         string    `json:"name" validate:"required,min=1,max=255" binding:"required"`
+	DisplayOrder int       `json:"display_order" validate:"min=0"`
+	RestaurantID uuid.UUID `json:"restaurant_id" validate:"required" binding:"required"`
+}
+
+// UpdateStationRequest represents the request body for updating a station
+// Uses pointers to distinguish between omitted values (nil) and deliberately empty/zero values
+type UpdateStationRequest struct {
+	Name         *string `json:"name" validate:"omitempty,min=1,max=255"`
+	DisplayOrder *int    `json:"display_order" validate:"omitempty,min=0"`
+}
+
+// SetStationRoutingRequest replaces everything routed to a station. A menu
+// item goes to its own station if it has one, otherwise to its category's.
+// Routing an item or category here takes it off any other station.
+type SetStationRoutingRequest struct {
+	MenuItemIDs []int64     `json:"menu_item_ids"`
+	CategoryIDs []uuid.UUID `json:"category_ids"`
+}
+
+type TicketStatus string
+
+const (
+	TicketStatusQUEUED      TicketStatus = "QUEUED"
+	TicketStatusIN_PROGRESS TicketStatus = "IN_PROGRESS"
+	TicketStatusREADY       TicketStatus = "READY"
+)
+
+type UpdateStationTicketRequest struct {
+	Status string `json:"status" validate:"required,oneof=QUEUED IN_PROGRESS READY" binding:"required"`
+}
+
+// StationTicketListFilters holds optional filter options for listing a
+// station's tickets.
+type StationTicketListFilters struct {
+	// Status limits the list to one status. Without it, tickets that are
+	// not READY yet are listed.
+	Status *TicketStatus
+}
+
+// StationTicket is the part of an order one station prepares: the order's
+// items routed to the station, with their modifiers.
+type StationTicket struct {
+	ID          uuid.UUID    `json:"id"`
+	Status      TicketStatus `json:"status"`
+	OrderID     uuid.UUID    `json:"order_id"`
+	OrderNumber *int         `json:"order_number"`
+	OrderType   OrderType    `json:"order_type"`
+	StationID   uuid.UUID    `json:"station_id"`
+	Items       []OrderItem  `json:"items"`
+	CreatedAt   time.Time    `json:"created_at"`
+	UpdatedAt   time.Time    `json:"updated_at"`
+}
@@ -0,0 +1,72 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/Jiruu246/rms/pkg/money"
+	"github.com/google/uuid"
+)
+
+type Table struct {
+	ID       uuid.UUID `json:"id"`
+	Name     string    `json:"name"`
+	Section  string    `json:"section"`
+	Seats    int       `json:"seats"`
+	IsActive bool      `json:"is_active"`
+	// QRToken goes into the table's QR code; customers send it as
+	// table_token when ordering through the public endpoint.
+	QRToken      string    `json:"qr_token"`
+	RestaurantID uuid.UUID `json:"restaurant_id"`
+	CreatedAt    time.Time `json:"created_at"`
+	UpdatedAt    time.Time `json:"updated_at"`
+}
+
+// PublicTable is what a customer who scanned a table's QR code gets to see.
+type PublicTable struct {
+	ID           uuid.UUID `json:"id"`
+	Name         string    `json:"name"`
+	Section      string    `json:"section"`
+	RestaurantID uuid.UUID `json:"restaurant_id"`
+}
+
+// CreateTableRequest represents the request body for creating a table
+type CreateTableRequest struct {
+	Name         string    `json:"name" validate:"required,min=1,max=100" binding:"required"`
+	Section      string    `json:"section" validate:"max=100"`
+	Seats        int       `json:"seats" validate:"required,min=1" binding:"required"`
+	RestaurantID uuid.UUID `json:"restaurant_id" validate:"required" binding:"required"`
+}
+
+// UpdateTableRequest represents the request body for updating a table
+// Uses pointers to distinguish between omitted values (nil) and deliberately empty/zero values
+type UpdateTableRequest struct {
+	Name     *string `json:"name" validate:"omitempty,min=1,max=100"`
+	Section  *string `json:"section" validate:"omitempty,max=100"`
+	Seats    *int    `json:"seats" validate:"omitempty,min=1"`
+	IsActive *bool   `json:"is_active"`
+}
+
+type TableSessionStatus string
+
+const (
+	TableSessionStatusOPEN   TableSessionStatus = "OPEN"
+	TableSessionStatusCLOSED TableSessionStatus = "CLOSED"
+)
+
+// TableSession is the bill of one party at a table: every dine-in order
+// placed at the table while the session is OPEN. Total, AmountPaid and
+// Balance leave out cancelled orders.
+type TableSession struct {
+	ID           uuid.UUID          `json:"id"`
+	Status       TableSessionStatus `json:"status"`
+	TableID      uuid.UUID          `json:"table_id"`
+	RestaurantID uuid.UUID          `json:"restaurant_id"`
+	Orders       []Order            `json:"orders"`
+	Currency     money.Currency     `json:"currency"`
+	Total        money.Money        `json:"total"`
+	AmountPaid   money.Money        `json:"amount_paid"`
+	Balance      money.Money        `json:"balance"`
+	CreatedAt    time.Time          `json:"created_at"`
+	ClosedAt     *time.Time         `json:"closed_at"`
+	ClosedBy     *uuid.UUID         `json:"closed_by"`
+}
@@ -11,6 +11,7 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"github.com/Jiruu246/rms/internal/ent/category"
 	"github.com/Jiruu246/rms/internal/ent/restaurant"
+	"github.com/Jiruu246/rms/internal/ent/station"
 	"github.com/google/uuid"
 )
 
@@ -33,6 +34,8 @@ type Category struct {
 	IsActive bool `json:"is_active,omitempty"`
 	// ID of the restaurant this category belongs to
 	RestaurantID uuid.UUID `json:"restaurant_id,omitempty"`
+	// ID of the station that prepares this category's items, unless an item names its own
+	StationID *uuid.UUID `json:"station_id,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the CategoryQuery when eager-loading is set.
 	Edges        CategoryEdges `json:"edges"`
@@ -45,9 +48,13 @@ type CategoryEdges struct {
 	Restaurant *Restaurant `json:"restaurant,omitempty"`
 	// MenuItems holds the value of the menu_items edge.
 	MenuItems []*MenuItem `json:"menu_items,omitempty"`
+	// Station holds the value of the station edge.
+	Station *Station `json:"station,omitempty"`
+	// Menus holds the value of the menus edge.
+	Menus []*Menu `json:"menus,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [2]bool
+	loadedTypes [4]bool
 }
 
 // RestaurantOrErr returns the Restaurant value or an error if the edge
@@ -70,11 +77,33 @@ func (e CategoryEdges) MenuItemsOrErr() ([]*MenuItem, error) {
 	return nil, &NotLoadedError{edge: "menu_items"}
 }
 
+// StationOrErr returns the Station value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e CategoryEdges) StationOrErr() (*Station, error) {
+	if e.Station != nil {
+		return e.Station, nil
+	} else if e.loadedTypes[2] {
+		return nil, &NotFoundError{label: station.Label}
+	}
+	return nil, &NotLoadedError{edge: "station"}
+}
+
+// MenusOrErr returns the Menus value or an error if the edge
+// was not loaded in eager-loading.
+func (e CategoryEdges) MenusOrErr() ([]*Menu, error) {
+	if e.loadedTypes[3] {
+		return e.Menus, nil
+	}
+	return nil, &NotLoadedError{edge: "menus"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Category) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
+		case category.FieldStationID:
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
 		case category.FieldIsActive:
 			values[i] = new(sql.NullBool)
 		case category.FieldDisplayOrder:
@@ -148,6 +177,13 @@ func (_m *Category) assignValues(columns []string, values []any) error {
 			} else if value != nil {
 				_m.RestaurantID = *value
 			}
+		case category.FieldStationID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field station_id", values[i])
+			} else if value.Valid {
+				_m.StationID = new(uuid.UUID)
+				*_m.StationID = *value.S.(*uuid.UUID)
+			}
 		default:
 			_m.selectValues.Set(columns[i], values[i])
 		}
@@ -171,6 +207,16 @@ func (_m *Category) QueryMenuItems() *MenuItemQuery {
 	return NewCategoryClient(_m.config).QueryMenuItems(_m)
 }
 
+// QueryStation queries the "station" edge of the Category entity.
+func (_m *Category) QueryStation() *StationQuery {
+	return NewCategoryClient(_m.config).QueryStation(_m)
+}
+
+// QueryMenus queries the "menus" edge of the Category entity.
+func (_m *Category) QueryMenus() *MenuQuery {
+	return NewCategoryClient(_m.config).QueryMenus(_m)
+}
+
 // Update returns a builder for updating this Category.
 // Note that you need to call Category.Unwrap() before calling this method if this Category
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -214,6 +260,11 @@ func (_m *Category) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("restaurant_id=")
 	builder.WriteString(fmt.Sprintf("%v", _m.RestaurantID))
+	builder.WriteString(", ")
+	if v := _m.StationID; v != nil {
+		builder.WriteString("station_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
 	builder.WriteByte(')')
 	return builder.String()
 }
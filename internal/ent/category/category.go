@@ -29,10 +29,16 @@ const (
 	FieldIsActive = "is_active"
 	// FieldRestaurantID holds the string denoting the restaurant_id field in the database.
 	FieldRestaurantID = "restaurant_id"
+	// FieldStationID holds the string denoting the station_id field in the database.
+	FieldStationID = "station_id"
 	// EdgeRestaurant holds the string denoting the restaurant edge name in mutations.
 	EdgeRestaurant = "restaurant"
 	// EdgeMenuItems holds the string denoting the menu_items edge name in mutations.
 	EdgeMenuItems = "menu_items"
+	// EdgeStation holds the string denoting the station edge name in mutations.
+	EdgeStation = "station"
+	// EdgeMenus holds the string denoting the menus edge name in mutations.
+	EdgeMenus = "menus"
 	// Table holds the table name of the category in the database.
 	Table = "categories"
 	// RestaurantTable is the table that holds the restaurant relation/edge.
@@ -49,6 +55,18 @@ const (
 	MenuItemsInverseTable = "menu_items"
 	// MenuItemsColumn is the table column denoting the menu_items relation/edge.
 	MenuItemsColumn = "category_id"
+	// StationTable is the table that holds the station relation/edge.
+	StationTable = "categories"
+	// StationInverseTable is the table name for the Station entity.
+	// It exists in this package in order to avoid circular dependency with the "station" package.
+	StationInverseTable = "stations"
+	// StationColumn is the table column denoting the station relation/edge.
+	StationColumn = "station_id"
+	// MenusTable is the table that holds the menus relation/edge. The primary key declared below.
+	MenusTable = "menu_categories"
+	// MenusInverseTable is the table name for the Menu entity.
+	// It exists in this package in order to avoid circular dependency with the "menu" package.
+	MenusInverseTable = "menus"
 )
 
 // Columns holds all SQL columns for category fields.
@@ -61,8 +79,15 @@ var Columns = []string{
 	FieldDisplayOrder,
 	FieldIsActive,
 	FieldRestaurantID,
+	FieldStationID,
 }
 
+var (
+	// MenusPrimaryKey and MenusColumn2 are the table columns denoting the
+	// primary key for the menus relation (M2M).
+	MenusPrimaryKey = []string{"menu_id", "category_id"}
+)
+
 // ValidColumn reports if the column name is valid (part of the table columns).
 func ValidColumn(column string) bool {
 	for i := range Columns {
@@ -139,6 +164,11 @@ func ByRestaurantID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldRestaurantID, opts...).ToFunc()
 }
 
+// ByStationID orders the results by the station_id field.
+func ByStationID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStationID, opts...).ToFunc()
+}
+
 // ByRestaurantField orders the results by restaurant field.
 func ByRestaurantField(field string, opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -159,6 +189,27 @@ func ByMenuItems(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newMenuItemsStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
+
+// ByStationField orders the results by station field.
+func ByStationField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newStationStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByMenusCount orders the results by menus count.
+func ByMenusCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newMenusStep(), opts...)
+	}
+}
+
+// ByMenus orders the results by menus terms.
+func ByMenus(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newMenusStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newRestaurantStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -173,3 +224,17 @@ func newMenuItemsStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, false, MenuItemsTable, MenuItemsColumn),
 	)
 }
+func newStationStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(StationInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, StationTable, StationColumn),
+	)
+}
+func newMenusStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(MenusInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2M, true, MenusTable, MenusPrimaryKey...),
+	)
+}
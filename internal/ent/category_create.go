@@ -11,8 +11,10 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/Jiruu246/rms/internal/ent/category"
+	"github.com/Jiruu246/rms/internal/ent/menu"
 	"github.com/Jiruu246/rms/internal/ent/menuitem"
 	"github.com/Jiruu246/rms/internal/ent/restaurant"
+	"github.com/Jiruu246/rms/internal/ent/station"
 	"github.com/google/uuid"
 )
 
@@ -105,6 +107,20 @@ func (_c *CategoryCreate) SetRestaurantID(v uuid.UUID) *CategoryCreate {
 	return _c
 }
 
+// SetStationID sets the "station_id" field.
+func (_c *CategoryCreate) SetStationID(v uuid.UUID) *CategoryCreate {
+	_c.mutation.SetStationID(v)
+	return _c
+}
+
+// SetNillableStationID sets the "station_id" field if the given value is not nil.
+func (_c *CategoryCreate) SetNillableStationID(v *uuid.UUID) *CategoryCreate {
+	if v != nil {
+		_c.SetStationID(*v)
+	}
+	return _c
+}
+
 // SetID sets the "id" field.
 func (_c *CategoryCreate) SetID(v uuid.UUID) *CategoryCreate {
 	_c.mutation.SetID(v)
@@ -139,6 +155,26 @@ func (_c *CategoryCreate) AddMenuItems(v ...*MenuItem) *CategoryCreate {
 	return _c.AddMenuItemIDs(ids...)
 }
 
+// SetStation sets the "station" edge to the Station entity.
+func (_c *CategoryCreate) SetStation(v *Station) *CategoryCreate {
+	return _c.SetStationID(v.ID)
+}
+
+// AddMenuIDs adds the "menus" edge to the Menu entity by IDs.
+func (_c *CategoryCreate) AddMenuIDs(ids ...uuid.UUID) *CategoryCreate {
+	_c.mutation.AddMenuIDs(ids...)
+	return _c
+}
+
+// AddMenus adds the "menus" edges to the Menu entity.
+func (_c *CategoryCreate) AddMenus(v ...*Menu) *CategoryCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddMenuIDs(ids...)
+}
+
 // Mutation returns the CategoryMutation object of the builder.
 func (_c *CategoryCreate) Mutation() *CategoryMutation {
 	return _c.mutation
@@ -333,6 +369,39 @@ func (_c *CategoryCreate) createSpec() (*Category, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.StationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   category.StationTable,
+			Columns: []string{category.StationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(station.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.StationID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.MenusIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   category.MenusTable,
+			Columns: category.MenusPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(menu.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
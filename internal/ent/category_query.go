@@ -13,9 +13,11 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/Jiruu246/rms/internal/ent/category"
+	"github.com/Jiruu246/rms/internal/ent/menu"
 	"github.com/Jiruu246/rms/internal/ent/menuitem"
 	"github.com/Jiruu246/rms/internal/ent/predicate"
 	"github.com/Jiruu246/rms/internal/ent/restaurant"
+	"github.com/Jiruu246/rms/internal/ent/station"
 	"github.com/google/uuid"
 )
 
@@ -28,6 +30,8 @@ type CategoryQuery struct {
 	predicates     []predicate.Category
 	withRestaurant *RestaurantQuery
 	withMenuItems  *MenuItemQuery
+	withStation    *StationQuery
+	withMenus      *MenuQuery
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -108,6 +112,50 @@ func (_q *CategoryQuery) QueryMenuItems() *MenuItemQuery {
 	return query
 }
 
+// QueryStation chains the current query on the "station" edge.
+func (_q *CategoryQuery) QueryStation() *StationQuery {
+	query := (&StationClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(category.Table, category.FieldID, selector),
+			sqlgraph.To(station.Table, station.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, category.StationTable, category.StationColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryMenus chains the current query on the "menus" edge.
+func (_q *CategoryQuery) QueryMenus() *MenuQuery {
+	query := (&MenuClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(category.Table, category.FieldID, selector),
+			sqlgraph.To(menu.Table, menu.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, category.MenusTable, category.MenusPrimaryKey...),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Category entity from the query.
 // Returns a *NotFoundError when no Category was found.
 func (_q *CategoryQuery) First(ctx context.Context) (*Category, error) {
@@ -302,6 +350,8 @@ func (_q *CategoryQuery) Clone() *CategoryQuery {
 		predicates:     append([]predicate.Category{}, _q.predicates...),
 		withRestaurant: _q.withRestaurant.Clone(),
 		withMenuItems:  _q.withMenuItems.Clone(),
+		withStation:    _q.withStation.Clone(),
+		withMenus:      _q.withMenus.Clone(),
 		// clone intermediate query.
 		sql:  _q.sql.Clone(),
 		path: _q.path,
@@ -330,6 +380,28 @@ func (_q *CategoryQuery) WithMenuItems(opts ...func(*MenuItemQuery)) *CategoryQu
 	return _q
 }
 
+// WithStation tells the query-builder to eager-load the nodes that are connected to
+// the "station" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *CategoryQuery) WithStation(opts ...func(*StationQuery)) *CategoryQuery {
+	query := (&StationClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withStation = query
+	return _q
+}
+
+// WithMenus tells the query-builder to eager-load the nodes that are connected to
+// the "menus" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *CategoryQuery) WithMenus(opts ...func(*MenuQuery)) *CategoryQuery {
+	query := (&MenuClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withMenus = query
+	return _q
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -408,9 +480,11 @@ func (_q *CategoryQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Cat
 	var (
 		nodes       = []*Category{}
 		_spec       = _q.querySpec()
-		loadedTypes = [2]bool{
+		loadedTypes = [4]bool{
 			_q.withRestaurant != nil,
 			_q.withMenuItems != nil,
+			_q.withStation != nil,
+			_q.withMenus != nil,
 		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
@@ -444,6 +518,19 @@ func (_q *CategoryQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Cat
 			return nil, err
 		}
 	}
+	if query := _q.withStation; query != nil {
+		if err := _q.loadStation(ctx, query, nodes, nil,
+			func(n *Category, e *Station) { n.Edges.Station = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withMenus; query != nil {
+		if err := _q.loadMenus(ctx, query, nodes,
+			func(n *Category) { n.Edges.Menus = []*Menu{} },
+			func(n *Category, e *Menu) { n.Edges.Menus = append(n.Edges.Menus, e) }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -486,7 +573,6 @@ func (_q *CategoryQuery) loadMenuItems(ctx context.Context, query *MenuItemQuery
 			init(nodes[i])
 		}
 	}
-	query.withFKs = true
 	if len(query.ctx.Fields) > 0 {
 		query.ctx.AppendFieldOnce(menuitem.FieldCategoryID)
 	}
@@ -507,6 +593,99 @@ func (_q *CategoryQuery) loadMenuItems(ctx context.Context, query *MenuItemQuery
 	}
 	return nil
 }
+func (_q *CategoryQuery) loadStation(ctx context.Context, query *StationQuery, nodes []*Category, init func(*Category), assign func(*Category, *Station)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*Category)
+	for i := range nodes {
+		if nodes[i].StationID == nil {
+			continue
+		}
+		fk := *nodes[i].StationID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(station.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "station_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (_q *CategoryQuery) loadMenus(ctx context.Context, query *MenuQuery, nodes []*Category, init func(*Category), assign func(*Category, *Menu)) error {
+	edgeIDs := make([]driver.Value, len(nodes))
+	byID := make(map[uuid.UUID]*Category)
+	nids := make(map[uuid.UUID]map[*Category]struct{})
+	for i, node := range nodes {
+		edgeIDs[i] = node.ID
+		byID[node.ID] = node
+		if init != nil {
+			init(node)
+		}
+	}
+	query.Where(func(s *sql.Selector) {
+		joinT := sql.Table(category.MenusTable)
+		s.Join(joinT).On(s.C(menu.FieldID), joinT.C(category.MenusPrimaryKey[0]))
+		s.Where(sql.InValues(joinT.C(category.MenusPrimaryKey[1]), edgeIDs...))
+		columns := s.SelectedColumns()
+		s.Select(joinT.C(category.MenusPrimaryKey[1]))
+		s.AppendSelect(columns...)
+		s.SetDistinct(false)
+	})
+	if err := query.prepareQuery(ctx); err != nil {
+		return err
+	}
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		return query.sqlAll(ctx, func(_ context.Context, spec *sqlgraph.QuerySpec) {
+			assign := spec.Assign
+			values := spec.ScanValues
+			spec.ScanValues = func(columns []string) ([]any, error) {
+				values, err := values(columns[1:])
+				if err != nil {
+					return nil, err
+				}
+				return append([]any{new(uuid.UUID)}, values...), nil
+			}
+			spec.Assign = func(columns []string, values []any) error {
+				outValue := *values[0].(*uuid.UUID)
+				inValue := *values[1].(*uuid.UUID)
+				if nids[inValue] == nil {
+					nids[inValue] = map[*Category]struct{}{byID[outValue]: {}}
+					return assign(columns[1:], values[1:])
+				}
+				nids[inValue][byID[outValue]] = struct{}{}
+				return nil
+			}
+		})
+	})
+	neighbors, err := withInterceptors[[]*Menu](ctx, query, qr, query.inters)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected "menus" node returned %v`, n.ID)
+		}
+		for kn := range nodes {
+			assign(kn, n)
+		}
+	}
+	return nil
+}
 
 func (_q *CategoryQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := _q.querySpec()
@@ -536,6 +715,9 @@ func (_q *CategoryQuery) querySpec() *sqlgraph.QuerySpec {
 		if _q.withRestaurant != nil {
 			_spec.Node.AddColumnOnce(category.FieldRestaurantID)
 		}
+		if _q.withStation != nil {
+			_spec.Node.AddColumnOnce(category.FieldStationID)
+		}
 	}
 	if ps := _q.predicates; len(ps) > 0 {
 		_spec.Predicate = func(selector *sql.Selector) {
@@ -12,9 +12,11 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/Jiruu246/rms/internal/ent/category"
+	"github.com/Jiruu246/rms/internal/ent/menu"
 	"github.com/Jiruu246/rms/internal/ent/menuitem"
 	"github.com/Jiruu246/rms/internal/ent/predicate"
 	"github.com/Jiruu246/rms/internal/ent/restaurant"
+	"github.com/Jiruu246/rms/internal/ent/station"
 	"github.com/google/uuid"
 )
 
@@ -114,6 +116,26 @@ func (_u *CategoryUpdate) SetNillableRestaurantID(v *uuid.UUID) *CategoryUpdate
 	return _u
 }
 
+// SetStationID sets the "station_id" field.
+func (_u *CategoryUpdate) SetStationID(v uuid.UUID) *CategoryUpdate {
+	_u.mutation.SetStationID(v)
+	return _u
+}
+
+// SetNillableStationID sets the "station_id" field if the given value is not nil.
+func (_u *CategoryUpdate) SetNillableStationID(v *uuid.UUID) *CategoryUpdate {
+	if v != nil {
+		_u.SetStationID(*v)
+	}
+	return _u
+}
+
+// ClearStationID clears the value of the "station_id" field.
+func (_u *CategoryUpdate) ClearStationID() *CategoryUpdate {
+	_u.mutation.ClearStationID()
+	return _u
+}
+
 // SetRestaurant sets the "restaurant" edge to the Restaurant entity.
 func (_u *CategoryUpdate) SetRestaurant(v *Restaurant) *CategoryUpdate {
 	return _u.SetRestaurantID(v.ID)
@@ -134,6 +156,26 @@ func (_u *CategoryUpdate) AddMenuItems(v ...*MenuItem) *CategoryUpdate {
 	return _u.AddMenuItemIDs(ids...)
 }
 
+// SetStation sets the "station" edge to the Station entity.
+func (_u *CategoryUpdate) SetStation(v *Station) *CategoryUpdate {
+	return _u.SetStationID(v.ID)
+}
+
+// AddMenuIDs adds the "menus" edge to the Menu entity by IDs.
+func (_u *CategoryUpdate) AddMenuIDs(ids ...uuid.UUID) *CategoryUpdate {
+	_u.mutation.AddMenuIDs(ids...)
+	return _u
+}
+
+// AddMenus adds the "menus" edges to the Menu entity.
+func (_u *CategoryUpdate) AddMenus(v ...*Menu) *CategoryUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddMenuIDs(ids...)
+}
+
 // Mutation returns the CategoryMutation object of the builder.
 func (_u *CategoryUpdate) Mutation() *CategoryMutation {
 	return _u.mutation
@@ -166,6 +208,33 @@ func (_u *CategoryUpdate) RemoveMenuItems(v ...*MenuItem) *CategoryUpdate {
 	return _u.RemoveMenuItemIDs(ids...)
 }
 
+// ClearStation clears the "station" edge to the Station entity.
+func (_u *CategoryUpdate) ClearStation() *CategoryUpdate {
+	_u.mutation.ClearStation()
+	return _u
+}
+
+// ClearMenus clears all "menus" edges to the Menu entity.
+func (_u *CategoryUpdate) ClearMenus() *CategoryUpdate {
+	_u.mutation.ClearMenus()
+	return _u
+}
+
+// RemoveMenuIDs removes the "menus" edge to Menu entities by IDs.
+func (_u *CategoryUpdate) RemoveMenuIDs(ids ...uuid.UUID) *CategoryUpdate {
+	_u.mutation.RemoveMenuIDs(ids...)
+	return _u
+}
+
+// RemoveMenus removes "menus" edges to Menu entities.
+func (_u *CategoryUpdate) RemoveMenus(v ...*Menu) *CategoryUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveMenuIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (_u *CategoryUpdate) Save(ctx context.Context) (int, error) {
 	_u.defaults()
@@ -329,6 +398,80 @@ func (_u *CategoryUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.StationCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   category.StationTable,
+			Columns: []string{category.StationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(station.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.StationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   category.StationTable,
+			Columns: []string{category.StationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(station.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.MenusCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   category.MenusTable,
+			Columns: category.MenusPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(menu.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedMenusIDs(); len(nodes) > 0 && !_u.mutation.MenusCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   category.MenusTable,
+			Columns: category.MenusPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(menu.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.MenusIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   category.MenusTable,
+			Columns: category.MenusPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(menu.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{category.Label}
@@ -432,6 +575,26 @@ func (_u *CategoryUpdateOne) SetNillableRestaurantID(v *uuid.UUID) *CategoryUpda
 	return _u
 }
 
+// SetStationID sets the "station_id" field.
+func (_u *CategoryUpdateOne) SetStationID(v uuid.UUID) *CategoryUpdateOne {
+	_u.mutation.SetStationID(v)
+	return _u
+}
+
+// SetNillableStationID sets the "station_id" field if the given value is not nil.
+func (_u *CategoryUpdateOne) SetNillableStationID(v *uuid.UUID) *CategoryUpdateOne {
+	if v != nil {
+		_u.SetStationID(*v)
+	}
+	return _u
+}
+
+// ClearStationID clears the value of the "station_id" field.
+func (_u *CategoryUpdateOne) ClearStationID() *CategoryUpdateOne {
+	_u.mutation.ClearStationID()
+	return _u
+}
+
 // SetRestaurant sets the "restaurant" edge to the Restaurant entity.
 func (_u *CategoryUpdateOne) SetRestaurant(v *Restaurant) *CategoryUpdateOne {
 	return _u.SetRestaurantID(v.ID)
@@ -452,6 +615,26 @@ func (_u *CategoryUpdateOne) AddMenuItems(v ...*MenuItem) *CategoryUpdateOne {
 	return _u.AddMenuItemIDs(ids...)
 }
 
+// SetStation sets the "station" edge to the Station entity.
+func (_u *CategoryUpdateOne) SetStation(v *Station) *CategoryUpdateOne {
+	return _u.SetStationID(v.ID)
+}
+
+// AddMenuIDs adds the "menus" edge to the Menu entity by IDs.
+func (_u *CategoryUpdateOne) AddMenuIDs(ids ...uuid.UUID) *CategoryUpdateOne {
+	_u.mutation.AddMenuIDs(ids...)
+	return _u
+}
+
+// AddMenus adds the "menus" edges to the Menu entity.
+func (_u *CategoryUpdateOne) AddMenus(v ...*Menu) *CategoryUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddMenuIDs(ids...)
+}
+
 // Mutation returns the CategoryMutation object of the builder.
 func (_u *CategoryUpdateOne) Mutation() *CategoryMutation {
 	return _u.mutation
@@ -484,6 +667,33 @@ func (_u *CategoryUpdateOne) RemoveMenuItems(v ...*MenuItem) *CategoryUpdateOne
 	return _u.RemoveMenuItemIDs(ids...)
 }
 
+// ClearStation clears the "station" edge to the Station entity.
+func (_u *CategoryUpdateOne) ClearStation() *CategoryUpdateOne {
+	_u.mutation.ClearStation()
+	return _u
+}
+
+// ClearMenus clears all "menus" edges to the Menu entity.
+func (_u *CategoryUpdateOne) ClearMenus() *CategoryUpdateOne {
+	_u.mutation.ClearMenus()
+	return _u
+}
+
+// RemoveMenuIDs removes the "menus" edge to Menu entities by IDs.
+func (_u *CategoryUpdateOne) RemoveMenuIDs(ids ...uuid.UUID) *CategoryUpdateOne {
+	_u.mutation.RemoveMenuIDs(ids...)
+	return _u
+}
+
+// RemoveMenus removes "menus" edges to Menu entities.
+func (_u *CategoryUpdateOne) RemoveMenus(v ...*Menu) *CategoryUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveMenuIDs(ids...)
+}
+
 // Where appends a list predicates to the CategoryUpdate builder.
 func (_u *CategoryUpdateOne) Where(ps ...predicate.Category) *CategoryUpdateOne {
 	_u.mutation.Where(ps...)
@@ -677,6 +887,80 @@ func (_u *CategoryUpdateOne) sqlSave(ctx context.Context) (_node *Category, err
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.StationCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   category.StationTable,
+			Columns: []string{category.StationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(station.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.StationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   category.StationTable,
+			Columns: []string{category.StationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(station.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.MenusCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   category.MenusTable,
+			Columns: category.MenusPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(menu.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedMenusIDs(); len(nodes) > 0 && !_u.mutation.MenusCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   category.MenusTable,
+			Columns: category.MenusPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(menu.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.MenusIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   category.MenusTable,
+			Columns: category.MenusPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(menu.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &Category{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -17,14 +17,34 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"github.com/Jiruu246/rms/internal/ent/category"
+	"github.com/Jiruu246/rms/internal/ent/deliveryzone"
+	"github.com/Jiruu246/rms/internal/ent/idempotencykey"
+	"github.com/Jiruu246/rms/internal/ent/ingredient"
+	"github.com/Jiruu246/rms/internal/ent/menu"
 	"github.com/Jiruu246/rms/internal/ent/menuitem"
+	"github.com/Jiruu246/rms/internal/ent/menuitemmodifier"
+	"github.com/Jiruu246/rms/internal/ent/menuitemprice"
+	"github.com/Jiruu246/rms/internal/ent/menuitemvariant"
 	"github.com/Jiruu246/rms/internal/ent/modifier"
 	"github.com/Jiruu246/rms/internal/ent/modifieroption"
 	"github.com/Jiruu246/rms/internal/ent/order"
+	"github.com/Jiruu246/rms/internal/ent/orderevent"
 	"github.com/Jiruu246/rms/internal/ent/orderitem"
+	"github.com/Jiruu246/rms/internal/ent/orderitemchange"
 	"github.com/Jiruu246/rms/internal/ent/orderitemmodifieroption"
+	"github.com/Jiruu246/rms/internal/ent/ordernumbersequence"
+	"github.com/Jiruu246/rms/internal/ent/orderstatusevent"
+	"github.com/Jiruu246/rms/internal/ent/payment"
+	"github.com/Jiruu246/rms/internal/ent/ratelimitbucket"
+	"github.com/Jiruu246/rms/internal/ent/recipeingredient"
 	"github.com/Jiruu246/rms/internal/ent/refreshtoken"
+	"github.com/Jiruu246/rms/internal/ent/refund"
 	"github.com/Jiruu246/rms/internal/ent/restaurant"
+	"github.com/Jiruu246/rms/internal/ent/station"
+	"github.com/Jiruu246/rms/internal/ent/stationticket"
+	"github.com/Jiruu246/rms/internal/ent/stockmovement"
+	"github.com/Jiruu246/rms/internal/ent/table"
+	"github.com/Jiruu246/rms/internal/ent/tablesession"
 	"github.com/Jiruu246/rms/internal/ent/user"
 	"github.com/Jiruu246/rms/internal/ent/userauthprovider"
 )
@@ -36,22 +56,62 @@ type Client struct {
 	Schema *migrate.Schema
 	// Category is the client for interacting with the Category builders.
 	Category *CategoryClient
+	// DeliveryZone is the client for interacting with the DeliveryZone builders.
+	DeliveryZone *DeliveryZoneClient
+	// IdempotencyKey is the client for interacting with the IdempotencyKey builders.
+	IdempotencyKey *IdempotencyKeyClient
+	// Ingredient is the client for interacting with the Ingredient builders.
+	Ingredient *IngredientClient
+	// Menu is the client for interacting with the Menu builders.
+	Menu *MenuClient
 	// MenuItem is the client for interacting with the MenuItem builders.
 	MenuItem *MenuItemClient
+	// MenuItemModifier is the client for interacting with the MenuItemModifier builders.
+	MenuItemModifier *MenuItemModifierClient
+	// MenuItemPrice is the client for interacting with the MenuItemPrice builders.
+	MenuItemPrice *MenuItemPriceClient
+	// MenuItemVariant is the client for interacting with the MenuItemVariant builders.
+	MenuItemVariant *MenuItemVariantClient
 	// Modifier is the client for interacting with the Modifier builders.
 	Modifier *ModifierClient
 	// ModifierOption is the client for interacting with the ModifierOption builders.
 	ModifierOption *ModifierOptionClient
 	// Order is the client for interacting with the Order builders.
 	Order *OrderClient
+	// OrderEvent is the client for interacting with the OrderEvent builders.
+	OrderEvent *OrderEventClient
 	// OrderItem is the client for interacting with the OrderItem builders.
 	OrderItem *OrderItemClient
+	// OrderItemChange is the client for interacting with the OrderItemChange builders.
+	OrderItemChange *OrderItemChangeClient
 	// OrderItemModifierOption is the client for interacting with the OrderItemModifierOption builders.
 	OrderItemModifierOption *OrderItemModifierOptionClient
+	// OrderNumberSequence is the client for interacting with the OrderNumberSequence builders.
+	OrderNumberSequence *OrderNumberSequenceClient
+	// OrderStatusEvent is the client for interacting with the OrderStatusEvent builders.
+	OrderStatusEvent *OrderStatusEventClient
+	// Payment is the client for interacting with the Payment builders.
+	Payment *PaymentClient
+	// RateLimitBucket is the client for interacting with the RateLimitBucket builders.
+	RateLimitBucket *RateLimitBucketClient
+	// RecipeIngredient is the client for interacting with the RecipeIngredient builders.
+	RecipeIngredient *RecipeIngredientClient
 	// RefreshToken is the client for interacting with the RefreshToken builders.
 	RefreshToken *RefreshTokenClient
+	// Refund is the client for interacting with the Refund builders.
+	Refund *RefundClient
 	// Restaurant is the client for interacting with the Restaurant builders.
 	Restaurant *RestaurantClient
+	// Station is the client for interacting with the Station builders.
+	Station *StationClient
+	// StationTicket is the client for interacting with the StationTicket builders.
+	StationTicket *StationTicketClient
+	// StockMovement is the client for interacting with the StockMovement builders.
+	StockMovement *StockMovementClient
+	// Table is the client for interacting with the Table builders.
+	Table *TableClient
+	// TableSession is the client for interacting with the TableSession builders.
+	TableSession *TableSessionClient
 	// User is the client for interacting with the User builders.
 	User *UserClient
 	// UserAuthProvider is the client for interacting with the UserAuthProvider builders.
@@ -68,14 +128,34 @@ func NewClient(opts ...Option) *Client {
 func (c *Client) init() {
 	c.Schema = migrate.NewSchema(c.driver)
 	c.Category = NewCategoryClient(c.config)
+	c.DeliveryZone = NewDeliveryZoneClient(c.config)
+	c.IdempotencyKey = NewIdempotencyKeyClient(c.config)
+	c.Ingredient = NewIngredientClient(c.config)
+	c.Menu = NewMenuClient(c.config)
 	c.MenuItem = NewMenuItemClient(c.config)
+	c.MenuItemModifier = NewMenuItemModifierClient(c.config)
+	c.MenuItemPrice = NewMenuItemPriceClient(c.config)
+	c.MenuItemVariant = NewMenuItemVariantClient(c.config)
 	c.Modifier = NewModifierClient(c.config)
 	c.ModifierOption = NewModifierOptionClient(c.config)
 	c.Order = NewOrderClient(c.config)
+	c.OrderEvent = NewOrderEventClient(c.config)
 	c.OrderItem = NewOrderItemClient(c.config)
+	c.OrderItemChange = NewOrderItemChangeClient(c.config)
 	c.OrderItemModifierOption = NewOrderItemModifierOptionClient(c.config)
+	c.OrderNumberSequence = NewOrderNumberSequenceClient(c.config)
+	c.OrderStatusEvent = NewOrderStatusEventClient(c.config)
+	c.Payment = NewPaymentClient(c.config)
+	c.RateLimitBucket = NewRateLimitBucketClient(c.config)
+	c.RecipeIngredient = NewRecipeIngredientClient(c.config)
 	c.RefreshToken = NewRefreshTokenClient(c.config)
+	c.Refund = NewRefundClient(c.config)
 	c.Restaurant = NewRestaurantClient(c.config)
+	c.Station = NewStationClient(c.config)
+	c.StationTicket = NewStationTicketClient(c.config)
+	c.StockMovement = NewStockMovementClient(c.config)
+	c.Table = NewTableClient(c.config)
+	c.TableSession = NewTableSessionClient(c.config)
 	c.User = NewUserClient(c.config)
 	c.UserAuthProvider = NewUserAuthProviderClient(c.config)
 }
@@ -171,14 +251,34 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 		ctx:                     ctx,
 		config:                  cfg,
 		Category:                NewCategoryClient(cfg),
+		DeliveryZone:            NewDeliveryZoneClient(cfg),
+		IdempotencyKey:          NewIdempotencyKeyClient(cfg),
+		Ingredient:              NewIngredientClient(cfg),
+		Menu:                    NewMenuClient(cfg),
 		MenuItem:                NewMenuItemClient(cfg),
+		MenuItemModifier:        NewMenuItemModifierClient(cfg),
+		MenuItemPrice:           NewMenuItemPriceClient(cfg),
+		MenuItemVariant:         NewMenuItemVariantClient(cfg),
 		Modifier:                NewModifierClient(cfg),
 		ModifierOption:          NewModifierOptionClient(cfg),
 		Order:                   NewOrderClient(cfg),
+		OrderEvent:              NewOrderEventClient(cfg),
 		OrderItem:               NewOrderItemClient(cfg),
+		OrderItemChange:         NewOrderItemChangeClient(cfg),
 		OrderItemModifierOption: NewOrderItemModifierOptionClient(cfg),
+		OrderNumberSequence:     NewOrderNumberSequenceClient(cfg),
+		OrderStatusEvent:        NewOrderStatusEventClient(cfg),
+		Payment:                 NewPaymentClient(cfg),
+		RateLimitBucket:         NewRateLimitBucketClient(cfg),
+		RecipeIngredient:        NewRecipeIngredientClient(cfg),
 		RefreshToken:            NewRefreshTokenClient(cfg),
+		Refund:                  NewRefundClient(cfg),
 		Restaurant:              NewRestaurantClient(cfg),
+		Station:                 NewStationClient(cfg),
+		StationTicket:           NewStationTicketClient(cfg),
+		StockMovement:           NewStockMovementClient(cfg),
+		Table:                   NewTableClient(cfg),
+		TableSession:            NewTableSessionClient(cfg),
 		User:                    NewUserClient(cfg),
 		UserAuthProvider:        NewUserAuthProviderClient(cfg),
 	}, nil
@@ -201,14 +301,34 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 		ctx:                     ctx,
 		config:                  cfg,
 		Category:                NewCategoryClient(cfg),
+		DeliveryZone:            NewDeliveryZoneClient(cfg),
+		IdempotencyKey:          NewIdempotencyKeyClient(cfg),
+		Ingredient:              NewIngredientClient(cfg),
+		Menu:                    NewMenuClient(cfg),
 		MenuItem:                NewMenuItemClient(cfg),
+		MenuItemModifier:        NewMenuItemModifierClient(cfg),
+		MenuItemPrice:           NewMenuItemPriceClient(cfg),
+		MenuItemVariant:         NewMenuItemVariantClient(cfg),
 		Modifier:                NewModifierClient(cfg),
 		ModifierOption:          NewModifierOptionClient(cfg),
 		Order:                   NewOrderClient(cfg),
+		OrderEvent:              NewOrderEventClient(cfg),
 		OrderItem:               NewOrderItemClient(cfg),
+		OrderItemChange:         NewOrderItemChangeClient(cfg),
 		OrderItemModifierOption: NewOrderItemModifierOptionClient(cfg),
+		OrderNumberSequence:     NewOrderNumberSequenceClient(cfg),
+		OrderStatusEvent:        NewOrderStatusEventClient(cfg),
+		Payment:                 NewPaymentClient(cfg),
+		RateLimitBucket:         NewRateLimitBucketClient(cfg),
+		RecipeIngredient:        NewRecipeIngredientClient(cfg),
 		RefreshToken:            NewRefreshTokenClient(cfg),
+		Refund:                  NewRefundClient(cfg),
 		Restaurant:              NewRestaurantClient(cfg),
+		Station:                 NewStationClient(cfg),
+		StationTicket:           NewStationTicketClient(cfg),
+		StockMovement:           NewStockMovementClient(cfg),
+		Table:                   NewTableClient(cfg),
+		TableSession:            NewTableSessionClient(cfg),
 		User:                    NewUserClient(cfg),
 		UserAuthProvider:        NewUserAuthProviderClient(cfg),
 	}, nil
@@ -240,9 +360,13 @@ func (c *Client) Close() error {
 // In order to add hooks to a specific client, call: `client.Node.Use(...)`.
 func (c *Client) Use(hooks ...Hook) {
 	for _, n := range []interface{ Use(...Hook) }{
-		c.Category, c.MenuItem, c.Modifier, c.ModifierOption, c.Order, c.OrderItem,
-		c.OrderItemModifierOption, c.RefreshToken, c.Restaurant, c.User,
-		c.UserAuthProvider,
+		c.Category, c.DeliveryZone, c.IdempotencyKey, c.Ingredient, c.Menu, c.MenuItem,
+		c.MenuItemModifier, c.MenuItemPrice, c.MenuItemVariant, c.Modifier,
+		c.ModifierOption, c.Order, c.OrderEvent, c.OrderItem, c.OrderItemChange,
+		c.OrderItemModifierOption, c.OrderNumberSequence, c.OrderStatusEvent,
+		c.Payment, c.RateLimitBucket, c.RecipeIngredient, c.RefreshToken, c.Refund,
+		c.Restaurant, c.Station, c.StationTicket, c.StockMovement, c.Table,
+		c.TableSession, c.User, c.UserAuthProvider,
 	} {
 		n.Use(hooks...)
 	}
@@ -252,9 +376,13 @@ func (c *Client) Use(hooks ...Hook) {
 // In order to add interceptors to a specific client, call: `client.Node.Intercept(...)`.
 func (c *Client) Intercept(interceptors ...Interceptor) {
 	for _, n := range []interface{ Intercept(...Interceptor) }{
-		c.Category, c.MenuItem, c.Modifier, c.ModifierOption, c.Order, c.OrderItem,
-		c.OrderItemModifierOption, c.RefreshToken, c.Restaurant, c.User,
-		c.UserAuthProvider,
+		c.Category, c.DeliveryZone, c.IdempotencyKey, c.Ingredient, c.Menu, c.MenuItem,
+		c.MenuItemModifier, c.MenuItemPrice, c.MenuItemVariant, c.Modifier,
+		c.ModifierOption, c.Order, c.OrderEvent, c.OrderItem, c.OrderItemChange,
+		c.OrderItemModifierOption, c.OrderNumberSequence, c.OrderStatusEvent,
+		c.Payment, c.RateLimitBucket, c.RecipeIngredient, c.RefreshToken, c.Refund,
+		c.Restaurant, c.Station, c.StationTicket, c.StockMovement, c.Table,
+		c.TableSession, c.User, c.UserAuthProvider,
 	} {
 		n.Intercept(interceptors...)
 	}
@@ -265,22 +393,62 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 	switch m := m.(type) {
 	case *CategoryMutation:
 		return c.Category.mutate(ctx, m)
+	case *DeliveryZoneMutation:
+		return c.DeliveryZone.mutate(ctx, m)
+	case *IdempotencyKeyMutation:
+		return c.IdempotencyKey.mutate(ctx, m)
+	case *IngredientMutation:
+		return c.Ingredient.mutate(ctx, m)
+	case *MenuMutation:
+		return c.Menu.mutate(ctx, m)
 	case *MenuItemMutation:
 		return c.MenuItem.mutate(ctx, m)
+	case *MenuItemModifierMutation:
+		return c.MenuItemModifier.mutate(ctx, m)
+	case *MenuItemPriceMutation:
+		return c.MenuItemPrice.mutate(ctx, m)
+	case *MenuItemVariantMutation:
+		return c.MenuItemVariant.mutate(ctx, m)
 	case *ModifierMutation:
 		return c.Modifier.mutate(ctx, m)
 	case *ModifierOptionMutation:
 		return c.ModifierOption.mutate(ctx, m)
 	case *OrderMutation:
 		return c.Order.mutate(ctx, m)
+	case *OrderEventMutation:
+		return c.OrderEvent.mutate(ctx, m)
 	case *OrderItemMutation:
 		return c.OrderItem.mutate(ctx, m)
+	case *OrderItemChangeMutation:
+		return c.OrderItemChange.mutate(ctx, m)
 	case *OrderItemModifierOptionMutation:
 		return c.OrderItemModifierOption.mutate(ctx, m)
+	case *OrderNumberSequenceMutation:
+		return c.OrderNumberSequence.mutate(ctx, m)
+	case *OrderStatusEventMutation:
+		return c.OrderStatusEvent.mutate(ctx, m)
+	case *PaymentMutation:
+		return c.Payment.mutate(ctx, m)
+	case *RateLimitBucketMutation:
+		return c.RateLimitBucket.mutate(ctx, m)
+	case *RecipeIngredientMutation:
+		return c.RecipeIngredient.mutate(ctx, m)
 	case *RefreshTokenMutation:
 		return c.RefreshToken.mutate(ctx, m)
+	case *RefundMutation:
+		return c.Refund.mutate(ctx, m)
 	case *RestaurantMutation:
 		return c.Restaurant.mutate(ctx, m)
+	case *StationMutation:
+		return c.Station.mutate(ctx, m)
+	case *StationTicketMutation:
+		return c.StationTicket.mutate(ctx, m)
+	case *StockMovementMutation:
+		return c.StockMovement.mutate(ctx, m)
+	case *TableMutation:
+		return c.Table.mutate(ctx, m)
+	case *TableSessionMutation:
+		return c.TableSession.mutate(ctx, m)
 	case *UserMutation:
 		return c.User.mutate(ctx, m)
 	case *UserAuthProviderMutation:
@@ -430,6 +598,38 @@ func (c *CategoryClient) QueryMenuItems(_m *Category) *MenuItemQuery {
 	return query
 }
 
+// QueryStation queries the station edge of a Category.
+func (c *CategoryClient) QueryStation(_m *Category) *StationQuery {
+	query := (&StationClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(category.Table, category.FieldID, id),
+			sqlgraph.To(station.Table, station.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, category.StationTable, category.StationColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryMenus queries the menus edge of a Category.
+func (c *CategoryClient) QueryMenus(_m *Category) *MenuQuery {
+	query := (&MenuClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(category.Table, category.FieldID, id),
+			sqlgraph.To(menu.Table, menu.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, category.MenusTable, category.MenusPrimaryKey...),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
 func (c *CategoryClient) Hooks() []Hook {
 	return c.hooks.Category
@@ -455,107 +655,107 @@ func (c *CategoryClient) mutate(ctx context.Context, m *CategoryMutation) (Value
 	}
 }
 
-// MenuItemClient is a client for the MenuItem schema.
-type MenuItemClient struct {
+// DeliveryZoneClient is a client for the DeliveryZone schema.
+type DeliveryZoneClient struct {
 	config
 }
 
-// NewMenuItemClient returns a client for the MenuItem from the given config.
-func NewMenuItemClient(c config) *MenuItemClient {
-	return &MenuItemClient{config: c}
+// NewDeliveryZoneClient returns a client for the DeliveryZone from the given config.
+func NewDeliveryZoneClient(c config) *DeliveryZoneClient {
+	return &DeliveryZoneClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `menuitem.Hooks(f(g(h())))`.
-func (c *MenuItemClient) Use(hooks ...Hook) {
-	c.hooks.MenuItem = append(c.hooks.MenuItem, hooks...)
+// A call to `Use(f, g, h)` equals to `deliveryzone.Hooks(f(g(h())))`.
+func (c *DeliveryZoneClient) Use(hooks ...Hook) {
+	c.hooks.DeliveryZone = append(c.hooks.DeliveryZone, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `menuitem.Intercept(f(g(h())))`.
-func (c *MenuItemClient) Intercept(interceptors ...Interceptor) {
-	c.inters.MenuItem = append(c.inters.MenuItem, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `deliveryzone.Intercept(f(g(h())))`.
+func (c *DeliveryZoneClient) Intercept(interceptors ...Interceptor) {
+	c.inters.DeliveryZone = append(c.inters.DeliveryZone, interceptors...)
 }
 
-// Create returns a builder for creating a MenuItem entity.
-func (c *MenuItemClient) Create() *MenuItemCreate {
-	mutation := newMenuItemMutation(c.config, OpCreate)
-	return &MenuItemCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a DeliveryZone entity.
+func (c *DeliveryZoneClient) Create() *DeliveryZoneCreate {
+	mutation := newDeliveryZoneMutation(c.config, OpCreate)
+	return &DeliveryZoneCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of MenuItem entities.
-func (c *MenuItemClient) CreateBulk(builders ...*MenuItemCreate) *MenuItemCreateBulk {
-	return &MenuItemCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of DeliveryZone entities.
+func (c *DeliveryZoneClient) CreateBulk(builders ...*DeliveryZoneCreate) *DeliveryZoneCreateBulk {
+	return &DeliveryZoneCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *MenuItemClient) MapCreateBulk(slice any, setFunc func(*MenuItemCreate, int)) *MenuItemCreateBulk {
+func (c *DeliveryZoneClient) MapCreateBulk(slice any, setFunc func(*DeliveryZoneCreate, int)) *DeliveryZoneCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &MenuItemCreateBulk{err: fmt.Errorf("calling to MenuItemClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &DeliveryZoneCreateBulk{err: fmt.Errorf("calling to DeliveryZoneClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*MenuItemCreate, rv.Len())
+	builders := make([]*DeliveryZoneCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &MenuItemCreateBulk{config: c.config, builders: builders}
+	return &DeliveryZoneCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for MenuItem.
-func (c *MenuItemClient) Update() *MenuItemUpdate {
-	mutation := newMenuItemMutation(c.config, OpUpdate)
-	return &MenuItemUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for DeliveryZone.
+func (c *DeliveryZoneClient) Update() *DeliveryZoneUpdate {
+	mutation := newDeliveryZoneMutation(c.config, OpUpdate)
+	return &DeliveryZoneUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *MenuItemClient) UpdateOne(_m *MenuItem) *MenuItemUpdateOne {
-	mutation := newMenuItemMutation(c.config, OpUpdateOne, withMenuItem(_m))
-	return &MenuItemUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *DeliveryZoneClient) UpdateOne(_m *DeliveryZone) *DeliveryZoneUpdateOne {
+	mutation := newDeliveryZoneMutation(c.config, OpUpdateOne, withDeliveryZone(_m))
+	return &DeliveryZoneUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *MenuItemClient) UpdateOneID(id int64) *MenuItemUpdateOne {
-	mutation := newMenuItemMutation(c.config, OpUpdateOne, withMenuItemID(id))
-	return &MenuItemUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *DeliveryZoneClient) UpdateOneID(id uuid.UUID) *DeliveryZoneUpdateOne {
+	mutation := newDeliveryZoneMutation(c.config, OpUpdateOne, withDeliveryZoneID(id))
+	return &DeliveryZoneUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for MenuItem.
-func (c *MenuItemClient) Delete() *MenuItemDelete {
-	mutation := newMenuItemMutation(c.config, OpDelete)
-	return &MenuItemDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for DeliveryZone.
+func (c *DeliveryZoneClient) Delete() *DeliveryZoneDelete {
+	mutation := newDeliveryZoneMutation(c.config, OpDelete)
+	return &DeliveryZoneDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *MenuItemClient) DeleteOne(_m *MenuItem) *MenuItemDeleteOne {
+func (c *DeliveryZoneClient) DeleteOne(_m *DeliveryZone) *DeliveryZoneDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *MenuItemClient) DeleteOneID(id int64) *MenuItemDeleteOne {
-	builder := c.Delete().Where(menuitem.ID(id))
+func (c *DeliveryZoneClient) DeleteOneID(id uuid.UUID) *DeliveryZoneDeleteOne {
+	builder := c.Delete().Where(deliveryzone.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &MenuItemDeleteOne{builder}
+	return &DeliveryZoneDeleteOne{builder}
 }
 
-// Query returns a query builder for MenuItem.
-func (c *MenuItemClient) Query() *MenuItemQuery {
-	return &MenuItemQuery{
+// Query returns a query builder for DeliveryZone.
+func (c *DeliveryZoneClient) Query() *DeliveryZoneQuery {
+	return &DeliveryZoneQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeMenuItem},
+		ctx:    &QueryContext{Type: TypeDeliveryZone},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a MenuItem entity by its id.
-func (c *MenuItemClient) Get(ctx context.Context, id int64) (*MenuItem, error) {
-	return c.Query().Where(menuitem.ID(id)).Only(ctx)
+// Get returns a DeliveryZone entity by its id.
+func (c *DeliveryZoneClient) Get(ctx context.Context, id uuid.UUID) (*DeliveryZone, error) {
+	return c.Query().Where(deliveryzone.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *MenuItemClient) GetX(ctx context.Context, id int64) *MenuItem {
+func (c *DeliveryZoneClient) GetX(ctx context.Context, id uuid.UUID) *DeliveryZone {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -563,15 +763,15 @@ func (c *MenuItemClient) GetX(ctx context.Context, id int64) *MenuItem {
 	return obj
 }
 
-// QueryRestaurant queries the restaurant edge of a MenuItem.
-func (c *MenuItemClient) QueryRestaurant(_m *MenuItem) *RestaurantQuery {
+// QueryRestaurant queries the restaurant edge of a DeliveryZone.
+func (c *DeliveryZoneClient) QueryRestaurant(_m *DeliveryZone) *RestaurantQuery {
 	query := (&RestaurantClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(menuitem.Table, menuitem.FieldID, id),
+			sqlgraph.From(deliveryzone.Table, deliveryzone.FieldID, id),
 			sqlgraph.To(restaurant.Table, restaurant.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, menuitem.RestaurantTable, menuitem.RestaurantColumn),
+			sqlgraph.Edge(sqlgraph.M2O, true, deliveryzone.RestaurantTable, deliveryzone.RestaurantColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -579,15 +779,15 @@ func (c *MenuItemClient) QueryRestaurant(_m *MenuItem) *RestaurantQuery {
 	return query
 }
 
-// QueryCategory queries the category edge of a MenuItem.
-func (c *MenuItemClient) QueryCategory(_m *MenuItem) *CategoryQuery {
-	query := (&CategoryClient{config: c.config}).Query()
+// QueryOrders queries the orders edge of a DeliveryZone.
+func (c *DeliveryZoneClient) QueryOrders(_m *DeliveryZone) *OrderQuery {
+	query := (&OrderClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(menuitem.Table, menuitem.FieldID, id),
-			sqlgraph.To(category.Table, category.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, menuitem.CategoryTable, menuitem.CategoryColumn),
+			sqlgraph.From(deliveryzone.Table, deliveryzone.FieldID, id),
+			sqlgraph.To(order.Table, order.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, deliveryzone.OrdersTable, deliveryzone.OrdersColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -595,164 +795,265 @@ func (c *MenuItemClient) QueryCategory(_m *MenuItem) *CategoryQuery {
 	return query
 }
 
-// QueryModifiers queries the modifiers edge of a MenuItem.
-func (c *MenuItemClient) QueryModifiers(_m *MenuItem) *ModifierQuery {
-	query := (&ModifierClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(menuitem.Table, menuitem.FieldID, id),
-			sqlgraph.To(modifier.Table, modifier.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, menuitem.ModifiersTable, menuitem.ModifiersColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
+// Hooks returns the client hooks.
+func (c *DeliveryZoneClient) Hooks() []Hook {
+	return c.hooks.DeliveryZone
+}
+
+// Interceptors returns the client interceptors.
+func (c *DeliveryZoneClient) Interceptors() []Interceptor {
+	return c.inters.DeliveryZone
+}
+
+func (c *DeliveryZoneClient) mutate(ctx context.Context, m *DeliveryZoneMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&DeliveryZoneCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&DeliveryZoneUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&DeliveryZoneUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&DeliveryZoneDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown DeliveryZone mutation op: %q", m.Op())
 	}
-	return query
 }
 
-// QueryOrderItems queries the order_items edge of a MenuItem.
-func (c *MenuItemClient) QueryOrderItems(_m *MenuItem) *OrderItemQuery {
-	query := (&OrderItemClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(menuitem.Table, menuitem.FieldID, id),
-			sqlgraph.To(orderitem.Table, orderitem.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, menuitem.OrderItemsTable, menuitem.OrderItemsColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
+// IdempotencyKeyClient is a client for the IdempotencyKey schema.
+type IdempotencyKeyClient struct {
+	config
+}
+
+// NewIdempotencyKeyClient returns a client for the IdempotencyKey from the given config.
+func NewIdempotencyKeyClient(c config) *IdempotencyKeyClient {
+	return &IdempotencyKeyClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `idempotencykey.Hooks(f(g(h())))`.
+func (c *IdempotencyKeyClient) Use(hooks ...Hook) {
+	c.hooks.IdempotencyKey = append(c.hooks.IdempotencyKey, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `idempotencykey.Intercept(f(g(h())))`.
+func (c *IdempotencyKeyClient) Intercept(interceptors ...Interceptor) {
+	c.inters.IdempotencyKey = append(c.inters.IdempotencyKey, interceptors...)
+}
+
+// Create returns a builder for creating a IdempotencyKey entity.
+func (c *IdempotencyKeyClient) Create() *IdempotencyKeyCreate {
+	mutation := newIdempotencyKeyMutation(c.config, OpCreate)
+	return &IdempotencyKeyCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of IdempotencyKey entities.
+func (c *IdempotencyKeyClient) CreateBulk(builders ...*IdempotencyKeyCreate) *IdempotencyKeyCreateBulk {
+	return &IdempotencyKeyCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *IdempotencyKeyClient) MapCreateBulk(slice any, setFunc func(*IdempotencyKeyCreate, int)) *IdempotencyKeyCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &IdempotencyKeyCreateBulk{err: fmt.Errorf("calling to IdempotencyKeyClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	return query
+	builders := make([]*IdempotencyKeyCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &IdempotencyKeyCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for IdempotencyKey.
+func (c *IdempotencyKeyClient) Update() *IdempotencyKeyUpdate {
+	mutation := newIdempotencyKeyMutation(c.config, OpUpdate)
+	return &IdempotencyKeyUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *IdempotencyKeyClient) UpdateOne(_m *IdempotencyKey) *IdempotencyKeyUpdateOne {
+	mutation := newIdempotencyKeyMutation(c.config, OpUpdateOne, withIdempotencyKey(_m))
+	return &IdempotencyKeyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *IdempotencyKeyClient) UpdateOneID(id uuid.UUID) *IdempotencyKeyUpdateOne {
+	mutation := newIdempotencyKeyMutation(c.config, OpUpdateOne, withIdempotencyKeyID(id))
+	return &IdempotencyKeyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for IdempotencyKey.
+func (c *IdempotencyKeyClient) Delete() *IdempotencyKeyDelete {
+	mutation := newIdempotencyKeyMutation(c.config, OpDelete)
+	return &IdempotencyKeyDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *IdempotencyKeyClient) DeleteOne(_m *IdempotencyKey) *IdempotencyKeyDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *IdempotencyKeyClient) DeleteOneID(id uuid.UUID) *IdempotencyKeyDeleteOne {
+	builder := c.Delete().Where(idempotencykey.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &IdempotencyKeyDeleteOne{builder}
+}
+
+// Query returns a query builder for IdempotencyKey.
+func (c *IdempotencyKeyClient) Query() *IdempotencyKeyQuery {
+	return &IdempotencyKeyQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeIdempotencyKey},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a IdempotencyKey entity by its id.
+func (c *IdempotencyKeyClient) Get(ctx context.Context, id uuid.UUID) (*IdempotencyKey, error) {
+	return c.Query().Where(idempotencykey.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *IdempotencyKeyClient) GetX(ctx context.Context, id uuid.UUID) *IdempotencyKey {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
 }
 
 // Hooks returns the client hooks.
-func (c *MenuItemClient) Hooks() []Hook {
-	return c.hooks.MenuItem
+func (c *IdempotencyKeyClient) Hooks() []Hook {
+	return c.hooks.IdempotencyKey
 }
 
 // Interceptors returns the client interceptors.
-func (c *MenuItemClient) Interceptors() []Interceptor {
-	return c.inters.MenuItem
+func (c *IdempotencyKeyClient) Interceptors() []Interceptor {
+	return c.inters.IdempotencyKey
 }
 
-func (c *MenuItemClient) mutate(ctx context.Context, m *MenuItemMutation) (Value, error) {
+func (c *IdempotencyKeyClient) mutate(ctx context.Context, m *IdempotencyKeyMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&MenuItemCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&IdempotencyKeyCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&MenuItemUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&IdempotencyKeyUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&MenuItemUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&IdempotencyKeyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&MenuItemDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&IdempotencyKeyDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown MenuItem mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown IdempotencyKey mutation op: %q", m.Op())
 	}
 }
 
-// ModifierClient is a client for the Modifier schema.
-type ModifierClient struct {
+// IngredientClient is a client for the Ingredient schema.
+type IngredientClient struct {
 	config
 }
 
-// NewModifierClient returns a client for the Modifier from the given config.
-func NewModifierClient(c config) *ModifierClient {
-	return &ModifierClient{config: c}
+// NewIngredientClient returns a client for the Ingredient from the given config.
+func NewIngredientClient(c config) *IngredientClient {
+	return &IngredientClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `modifier.Hooks(f(g(h())))`.
-func (c *ModifierClient) Use(hooks ...Hook) {
-	c.hooks.Modifier = append(c.hooks.Modifier, hooks...)
+// A call to `Use(f, g, h)` equals to `ingredient.Hooks(f(g(h())))`.
+func (c *IngredientClient) Use(hooks ...Hook) {
+	c.hooks.Ingredient = append(c.hooks.Ingredient, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `modifier.Intercept(f(g(h())))`.
-func (c *ModifierClient) Intercept(interceptors ...Interceptor) {
-	c.inters.Modifier = append(c.inters.Modifier, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `ingredient.Intercept(f(g(h())))`.
+func (c *IngredientClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Ingredient = append(c.inters.Ingredient, interceptors...)
 }
 
-// Create returns a builder for creating a Modifier entity.
-func (c *ModifierClient) Create() *ModifierCreate {
-	mutation := newModifierMutation(c.config, OpCreate)
-	return &ModifierCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a Ingredient entity.
+func (c *IngredientClient) Create() *IngredientCreate {
+	mutation := newIngredientMutation(c.config, OpCreate)
+	return &IngredientCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of Modifier entities.
-func (c *ModifierClient) CreateBulk(builders ...*ModifierCreate) *ModifierCreateBulk {
-	return &ModifierCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of Ingredient entities.
+func (c *IngredientClient) CreateBulk(builders ...*IngredientCreate) *IngredientCreateBulk {
+	return &IngredientCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *ModifierClient) MapCreateBulk(slice any, setFunc func(*ModifierCreate, int)) *ModifierCreateBulk {
+func (c *IngredientClient) MapCreateBulk(slice any, setFunc func(*IngredientCreate, int)) *IngredientCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &ModifierCreateBulk{err: fmt.Errorf("calling to ModifierClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &IngredientCreateBulk{err: fmt.Errorf("calling to IngredientClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*ModifierCreate, rv.Len())
+	builders := make([]*IngredientCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &ModifierCreateBulk{config: c.config, builders: builders}
+	return &IngredientCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for Modifier.
-func (c *ModifierClient) Update() *ModifierUpdate {
-	mutation := newModifierMutation(c.config, OpUpdate)
-	return &ModifierUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for Ingredient.
+func (c *IngredientClient) Update() *IngredientUpdate {
+	mutation := newIngredientMutation(c.config, OpUpdate)
+	return &IngredientUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *ModifierClient) UpdateOne(_m *Modifier) *ModifierUpdateOne {
-	mutation := newModifierMutation(c.config, OpUpdateOne, withModifier(_m))
-	return &ModifierUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *IngredientClient) UpdateOne(_m *Ingredient) *IngredientUpdateOne {
+	mutation := newIngredientMutation(c.config, OpUpdateOne, withIngredient(_m))
+	return &IngredientUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *ModifierClient) UpdateOneID(id uuid.UUID) *ModifierUpdateOne {
-	mutation := newModifierMutation(c.config, OpUpdateOne, withModifierID(id))
-	return &ModifierUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *IngredientClient) UpdateOneID(id uuid.UUID) *IngredientUpdateOne {
+	mutation := newIngredientMutation(c.config, OpUpdateOne, withIngredientID(id))
+	return &IngredientUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for Modifier.
-func (c *ModifierClient) Delete() *ModifierDelete {
-	mutation := newModifierMutation(c.config, OpDelete)
-	return &ModifierDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for Ingredient.
+func (c *IngredientClient) Delete() *IngredientDelete {
+	mutation := newIngredientMutation(c.config, OpDelete)
+	return &IngredientDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *ModifierClient) DeleteOne(_m *Modifier) *ModifierDeleteOne {
+func (c *IngredientClient) DeleteOne(_m *Ingredient) *IngredientDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *ModifierClient) DeleteOneID(id uuid.UUID) *ModifierDeleteOne {
-	builder := c.Delete().Where(modifier.ID(id))
+func (c *IngredientClient) DeleteOneID(id uuid.UUID) *IngredientDeleteOne {
+	builder := c.Delete().Where(ingredient.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &ModifierDeleteOne{builder}
+	return &IngredientDeleteOne{builder}
 }
 
-// Query returns a query builder for Modifier.
-func (c *ModifierClient) Query() *ModifierQuery {
-	return &ModifierQuery{
+// Query returns a query builder for Ingredient.
+func (c *IngredientClient) Query() *IngredientQuery {
+	return &IngredientQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeModifier},
+		ctx:    &QueryContext{Type: TypeIngredient},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a Modifier entity by its id.
-func (c *ModifierClient) Get(ctx context.Context, id uuid.UUID) (*Modifier, error) {
-	return c.Query().Where(modifier.ID(id)).Only(ctx)
+// Get returns a Ingredient entity by its id.
+func (c *IngredientClient) Get(ctx context.Context, id uuid.UUID) (*Ingredient, error) {
+	return c.Query().Where(ingredient.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *ModifierClient) GetX(ctx context.Context, id uuid.UUID) *Modifier {
+func (c *IngredientClient) GetX(ctx context.Context, id uuid.UUID) *Ingredient {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -760,15 +1061,15 @@ func (c *ModifierClient) GetX(ctx context.Context, id uuid.UUID) *Modifier {
 	return obj
 }
 
-// QueryRestaurant queries the restaurant edge of a Modifier.
-func (c *ModifierClient) QueryRestaurant(_m *Modifier) *RestaurantQuery {
+// QueryRestaurant queries the restaurant edge of a Ingredient.
+func (c *IngredientClient) QueryRestaurant(_m *Ingredient) *RestaurantQuery {
 	query := (&RestaurantClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(modifier.Table, modifier.FieldID, id),
+			sqlgraph.From(ingredient.Table, ingredient.FieldID, id),
 			sqlgraph.To(restaurant.Table, restaurant.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, modifier.RestaurantTable, modifier.RestaurantColumn),
+			sqlgraph.Edge(sqlgraph.M2O, true, ingredient.RestaurantTable, ingredient.RestaurantColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -776,15 +1077,15 @@ func (c *ModifierClient) QueryRestaurant(_m *Modifier) *RestaurantQuery {
 	return query
 }
 
-// QueryModifierOptions queries the modifier_options edge of a Modifier.
-func (c *ModifierClient) QueryModifierOptions(_m *Modifier) *ModifierOptionQuery {
-	query := (&ModifierOptionClient{config: c.config}).Query()
+// QueryRecipeLines queries the recipe_lines edge of a Ingredient.
+func (c *IngredientClient) QueryRecipeLines(_m *Ingredient) *RecipeIngredientQuery {
+	query := (&RecipeIngredientClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(modifier.Table, modifier.FieldID, id),
-			sqlgraph.To(modifieroption.Table, modifieroption.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, modifier.ModifierOptionsTable, modifier.ModifierOptionsColumn),
+			sqlgraph.From(ingredient.Table, ingredient.FieldID, id),
+			sqlgraph.To(recipeingredient.Table, recipeingredient.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ingredient.RecipeLinesTable, ingredient.RecipeLinesColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -792,15 +1093,15 @@ func (c *ModifierClient) QueryModifierOptions(_m *Modifier) *ModifierOptionQuery
 	return query
 }
 
-// QueryMenuItems queries the menu_items edge of a Modifier.
-func (c *ModifierClient) QueryMenuItems(_m *Modifier) *MenuItemQuery {
-	query := (&MenuItemClient{config: c.config}).Query()
+// QueryMovements queries the movements edge of a Ingredient.
+func (c *IngredientClient) QueryMovements(_m *Ingredient) *StockMovementQuery {
+	query := (&StockMovementClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(modifier.Table, modifier.FieldID, id),
-			sqlgraph.To(menuitem.Table, menuitem.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, modifier.MenuItemsTable, modifier.MenuItemsColumn),
+			sqlgraph.From(ingredient.Table, ingredient.FieldID, id),
+			sqlgraph.To(stockmovement.Table, stockmovement.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ingredient.MovementsTable, ingredient.MovementsColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -809,131 +1110,131 @@ func (c *ModifierClient) QueryMenuItems(_m *Modifier) *MenuItemQuery {
 }
 
 // Hooks returns the client hooks.
-func (c *ModifierClient) Hooks() []Hook {
-	return c.hooks.Modifier
+func (c *IngredientClient) Hooks() []Hook {
+	return c.hooks.Ingredient
 }
 
 // Interceptors returns the client interceptors.
-func (c *ModifierClient) Interceptors() []Interceptor {
-	return c.inters.Modifier
+func (c *IngredientClient) Interceptors() []Interceptor {
+	return c.inters.Ingredient
 }
 
-func (c *ModifierClient) mutate(ctx context.Context, m *ModifierMutation) (Value, error) {
+func (c *IngredientClient) mutate(ctx context.Context, m *IngredientMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&ModifierCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&IngredientCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&ModifierUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&IngredientUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&ModifierUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&IngredientUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&ModifierDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&IngredientDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown Modifier mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown Ingredient mutation op: %q", m.Op())
 	}
 }
 
-// ModifierOptionClient is a client for the ModifierOption schema.
-type ModifierOptionClient struct {
+// MenuClient is a client for the Menu schema.
+type MenuClient struct {
 	config
 }
 
-// NewModifierOptionClient returns a client for the ModifierOption from the given config.
-func NewModifierOptionClient(c config) *ModifierOptionClient {
-	return &ModifierOptionClient{config: c}
+// NewMenuClient returns a client for the Menu from the given config.
+func NewMenuClient(c config) *MenuClient {
+	return &MenuClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `modifieroption.Hooks(f(g(h())))`.
-func (c *ModifierOptionClient) Use(hooks ...Hook) {
-	c.hooks.ModifierOption = append(c.hooks.ModifierOption, hooks...)
+// A call to `Use(f, g, h)` equals to `menu.Hooks(f(g(h())))`.
+func (c *MenuClient) Use(hooks ...Hook) {
+	c.hooks.Menu = append(c.hooks.Menu, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `modifieroption.Intercept(f(g(h())))`.
-func (c *ModifierOptionClient) Intercept(interceptors ...Interceptor) {
-	c.inters.ModifierOption = append(c.inters.ModifierOption, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `menu.Intercept(f(g(h())))`.
+func (c *MenuClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Menu = append(c.inters.Menu, interceptors...)
 }
 
-// Create returns a builder for creating a ModifierOption entity.
-func (c *ModifierOptionClient) Create() *ModifierOptionCreate {
-	mutation := newModifierOptionMutation(c.config, OpCreate)
-	return &ModifierOptionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a Menu entity.
+func (c *MenuClient) Create() *MenuCreate {
+	mutation := newMenuMutation(c.config, OpCreate)
+	return &MenuCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of ModifierOption entities.
-func (c *ModifierOptionClient) CreateBulk(builders ...*ModifierOptionCreate) *ModifierOptionCreateBulk {
-	return &ModifierOptionCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of Menu entities.
+func (c *MenuClient) CreateBulk(builders ...*MenuCreate) *MenuCreateBulk {
+	return &MenuCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *ModifierOptionClient) MapCreateBulk(slice any, setFunc func(*ModifierOptionCreate, int)) *ModifierOptionCreateBulk {
+func (c *MenuClient) MapCreateBulk(slice any, setFunc func(*MenuCreate, int)) *MenuCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &ModifierOptionCreateBulk{err: fmt.Errorf("calling to ModifierOptionClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &MenuCreateBulk{err: fmt.Errorf("calling to MenuClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*ModifierOptionCreate, rv.Len())
+	builders := make([]*MenuCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &ModifierOptionCreateBulk{config: c.config, builders: builders}
+	return &MenuCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for ModifierOption.
-func (c *ModifierOptionClient) Update() *ModifierOptionUpdate {
-	mutation := newModifierOptionMutation(c.config, OpUpdate)
-	return &ModifierOptionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for Menu.
+func (c *MenuClient) Update() *MenuUpdate {
+	mutation := newMenuMutation(c.config, OpUpdate)
+	return &MenuUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *ModifierOptionClient) UpdateOne(_m *ModifierOption) *ModifierOptionUpdateOne {
-	mutation := newModifierOptionMutation(c.config, OpUpdateOne, withModifierOption(_m))
-	return &ModifierOptionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *MenuClient) UpdateOne(_m *Menu) *MenuUpdateOne {
+	mutation := newMenuMutation(c.config, OpUpdateOne, withMenu(_m))
+	return &MenuUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *ModifierOptionClient) UpdateOneID(id uuid.UUID) *ModifierOptionUpdateOne {
-	mutation := newModifierOptionMutation(c.config, OpUpdateOne, withModifierOptionID(id))
-	return &ModifierOptionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *MenuClient) UpdateOneID(id uuid.UUID) *MenuUpdateOne {
+	mutation := newMenuMutation(c.config, OpUpdateOne, withMenuID(id))
+	return &MenuUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for ModifierOption.
-func (c *ModifierOptionClient) Delete() *ModifierOptionDelete {
-	mutation := newModifierOptionMutation(c.config, OpDelete)
-	return &ModifierOptionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for Menu.
+func (c *MenuClient) Delete() *MenuDelete {
+	mutation := newMenuMutation(c.config, OpDelete)
+	return &MenuDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *ModifierOptionClient) DeleteOne(_m *ModifierOption) *ModifierOptionDeleteOne {
+func (c *MenuClient) DeleteOne(_m *Menu) *MenuDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *ModifierOptionClient) DeleteOneID(id uuid.UUID) *ModifierOptionDeleteOne {
-	builder := c.Delete().Where(modifieroption.ID(id))
+func (c *MenuClient) DeleteOneID(id uuid.UUID) *MenuDeleteOne {
+	builder := c.Delete().Where(menu.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &ModifierOptionDeleteOne{builder}
+	return &MenuDeleteOne{builder}
 }
 
-// Query returns a query builder for ModifierOption.
-func (c *ModifierOptionClient) Query() *ModifierOptionQuery {
-	return &ModifierOptionQuery{
+// Query returns a query builder for Menu.
+func (c *MenuClient) Query() *MenuQuery {
+	return &MenuQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeModifierOption},
+		ctx:    &QueryContext{Type: TypeMenu},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a ModifierOption entity by its id.
-func (c *ModifierOptionClient) Get(ctx context.Context, id uuid.UUID) (*ModifierOption, error) {
-	return c.Query().Where(modifieroption.ID(id)).Only(ctx)
+// Get returns a Menu entity by its id.
+func (c *MenuClient) Get(ctx context.Context, id uuid.UUID) (*Menu, error) {
+	return c.Query().Where(menu.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *ModifierOptionClient) GetX(ctx context.Context, id uuid.UUID) *ModifierOption {
+func (c *MenuClient) GetX(ctx context.Context, id uuid.UUID) *Menu {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -941,15 +1242,15 @@ func (c *ModifierOptionClient) GetX(ctx context.Context, id uuid.UUID) *Modifier
 	return obj
 }
 
-// QueryModifier queries the modifier edge of a ModifierOption.
-func (c *ModifierOptionClient) QueryModifier(_m *ModifierOption) *ModifierQuery {
-	query := (&ModifierClient{config: c.config}).Query()
+// QueryRestaurant queries the restaurant edge of a Menu.
+func (c *MenuClient) QueryRestaurant(_m *Menu) *RestaurantQuery {
+	query := (&RestaurantClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(modifieroption.Table, modifieroption.FieldID, id),
-			sqlgraph.To(modifier.Table, modifier.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, modifieroption.ModifierTable, modifieroption.ModifierColumn),
+			sqlgraph.From(menu.Table, menu.FieldID, id),
+			sqlgraph.To(restaurant.Table, restaurant.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, menu.RestaurantTable, menu.RestaurantColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -957,15 +1258,31 @@ func (c *ModifierOptionClient) QueryModifier(_m *ModifierOption) *ModifierQuery
 	return query
 }
 
-// QueryOrderItemModifierOptions queries the order_item_modifier_options edge of a ModifierOption.
-func (c *ModifierOptionClient) QueryOrderItemModifierOptions(_m *ModifierOption) *OrderItemModifierOptionQuery {
-	query := (&OrderItemModifierOptionClient{config: c.config}).Query()
+// QueryCategories queries the categories edge of a Menu.
+func (c *MenuClient) QueryCategories(_m *Menu) *CategoryQuery {
+	query := (&CategoryClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(modifieroption.Table, modifieroption.FieldID, id),
-			sqlgraph.To(orderitemmodifieroption.Table, orderitemmodifieroption.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, modifieroption.OrderItemModifierOptionsTable, modifieroption.OrderItemModifierOptionsColumn),
+			sqlgraph.From(menu.Table, menu.FieldID, id),
+			sqlgraph.To(category.Table, category.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, false, menu.CategoriesTable, menu.CategoriesPrimaryKey...),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryItemPrices queries the item_prices edge of a Menu.
+func (c *MenuClient) QueryItemPrices(_m *Menu) *MenuItemPriceQuery {
+	query := (&MenuItemPriceClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(menu.Table, menu.FieldID, id),
+			sqlgraph.To(menuitemprice.Table, menuitemprice.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, menu.ItemPricesTable, menu.ItemPricesColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -974,131 +1291,3970 @@ func (c *ModifierOptionClient) QueryOrderItemModifierOptions(_m *ModifierOption)
 }
 
 // Hooks returns the client hooks.
-func (c *ModifierOptionClient) Hooks() []Hook {
-	return c.hooks.ModifierOption
+func (c *MenuClient) Hooks() []Hook {
+	return c.hooks.Menu
 }
 
 // Interceptors returns the client interceptors.
-func (c *ModifierOptionClient) Interceptors() []Interceptor {
-	return c.inters.ModifierOption
+func (c *MenuClient) Interceptors() []Interceptor {
+	return c.inters.Menu
 }
 
-func (c *ModifierOptionClient) mutate(ctx context.Context, m *ModifierOptionMutation) (Value, error) {
+func (c *MenuClient) mutate(ctx context.Context, m *MenuMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&MenuCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&MenuUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&MenuUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&MenuDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Menu mutation op: %q", m.Op())
+	}
+}
+
+// MenuItemClient is a client for the MenuItem schema.
+type MenuItemClient struct {
+	config
+}
+
+// NewMenuItemClient returns a client for the MenuItem from the given config.
+func NewMenuItemClient(c config) *MenuItemClient {
+	return &MenuItemClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `menuitem.Hooks(f(g(h())))`.
+func (c *MenuItemClient) Use(hooks ...Hook) {
+	c.hooks.MenuItem = append(c.hooks.MenuItem, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `menuitem.Intercept(f(g(h())))`.
+func (c *MenuItemClient) Intercept(interceptors ...Interceptor) {
+	c.inters.MenuItem = append(c.inters.MenuItem, interceptors...)
+}
+
+// Create returns a builder for creating a MenuItem entity.
+func (c *MenuItemClient) Create() *MenuItemCreate {
+	mutation := newMenuItemMutation(c.config, OpCreate)
+	return &MenuItemCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of MenuItem entities.
+func (c *MenuItemClient) CreateBulk(builders ...*MenuItemCreate) *MenuItemCreateBulk {
+	return &MenuItemCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *MenuItemClient) MapCreateBulk(slice any, setFunc func(*MenuItemCreate, int)) *MenuItemCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &MenuItemCreateBulk{err: fmt.Errorf("calling to MenuItemClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*MenuItemCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &MenuItemCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for MenuItem.
+func (c *MenuItemClient) Update() *MenuItemUpdate {
+	mutation := newMenuItemMutation(c.config, OpUpdate)
+	return &MenuItemUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *MenuItemClient) UpdateOne(_m *MenuItem) *MenuItemUpdateOne {
+	mutation := newMenuItemMutation(c.config, OpUpdateOne, withMenuItem(_m))
+	return &MenuItemUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *MenuItemClient) UpdateOneID(id int64) *MenuItemUpdateOne {
+	mutation := newMenuItemMutation(c.config, OpUpdateOne, withMenuItemID(id))
+	return &MenuItemUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for MenuItem.
+func (c *MenuItemClient) Delete() *MenuItemDelete {
+	mutation := newMenuItemMutation(c.config, OpDelete)
+	return &MenuItemDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *MenuItemClient) DeleteOne(_m *MenuItem) *MenuItemDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *MenuItemClient) DeleteOneID(id int64) *MenuItemDeleteOne {
+	builder := c.Delete().Where(menuitem.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &MenuItemDeleteOne{builder}
+}
+
+// Query returns a query builder for MenuItem.
+func (c *MenuItemClient) Query() *MenuItemQuery {
+	return &MenuItemQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeMenuItem},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a MenuItem entity by its id.
+func (c *MenuItemClient) Get(ctx context.Context, id int64) (*MenuItem, error) {
+	return c.Query().Where(menuitem.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *MenuItemClient) GetX(ctx context.Context, id int64) *MenuItem {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryRestaurant queries the restaurant edge of a MenuItem.
+func (c *MenuItemClient) QueryRestaurant(_m *MenuItem) *RestaurantQuery {
+	query := (&RestaurantClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(menuitem.Table, menuitem.FieldID, id),
+			sqlgraph.To(restaurant.Table, restaurant.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, menuitem.RestaurantTable, menuitem.RestaurantColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryCategory queries the category edge of a MenuItem.
+func (c *MenuItemClient) QueryCategory(_m *MenuItem) *CategoryQuery {
+	query := (&CategoryClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(menuitem.Table, menuitem.FieldID, id),
+			sqlgraph.To(category.Table, category.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, menuitem.CategoryTable, menuitem.CategoryColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryStation queries the station edge of a MenuItem.
+func (c *MenuItemClient) QueryStation(_m *MenuItem) *StationQuery {
+	query := (&StationClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(menuitem.Table, menuitem.FieldID, id),
+			sqlgraph.To(station.Table, station.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, menuitem.StationTable, menuitem.StationColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryModifiers queries the modifiers edge of a MenuItem.
+func (c *MenuItemClient) QueryModifiers(_m *MenuItem) *ModifierQuery {
+	query := (&ModifierClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(menuitem.Table, menuitem.FieldID, id),
+			sqlgraph.To(modifier.Table, modifier.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, false, menuitem.ModifiersTable, menuitem.ModifiersPrimaryKey...),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryOrderItems queries the order_items edge of a MenuItem.
+func (c *MenuItemClient) QueryOrderItems(_m *MenuItem) *OrderItemQuery {
+	query := (&OrderItemClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(menuitem.Table, menuitem.FieldID, id),
+			sqlgraph.To(orderitem.Table, orderitem.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, menuitem.OrderItemsTable, menuitem.OrderItemsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryRecipeLines queries the recipe_lines edge of a MenuItem.
+func (c *MenuItemClient) QueryRecipeLines(_m *MenuItem) *RecipeIngredientQuery {
+	query := (&RecipeIngredientClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(menuitem.Table, menuitem.FieldID, id),
+			sqlgraph.To(recipeingredient.Table, recipeingredient.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, menuitem.RecipeLinesTable, menuitem.RecipeLinesColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryMenuPrices queries the menu_prices edge of a MenuItem.
+func (c *MenuItemClient) QueryMenuPrices(_m *MenuItem) *MenuItemPriceQuery {
+	query := (&MenuItemPriceClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(menuitem.Table, menuitem.FieldID, id),
+			sqlgraph.To(menuitemprice.Table, menuitemprice.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, menuitem.MenuPricesTable, menuitem.MenuPricesColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryVariants queries the variants edge of a MenuItem.
+func (c *MenuItemClient) QueryVariants(_m *MenuItem) *MenuItemVariantQuery {
+	query := (&MenuItemVariantClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(menuitem.Table, menuitem.FieldID, id),
+			sqlgraph.To(menuitemvariant.Table, menuitemvariant.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, menuitem.VariantsTable, menuitem.VariantsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryItemModifiers queries the item_modifiers edge of a MenuItem.
+func (c *MenuItemClient) QueryItemModifiers(_m *MenuItem) *MenuItemModifierQuery {
+	query := (&MenuItemModifierClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(menuitem.Table, menuitem.FieldID, id),
+			sqlgraph.To(menuitemmodifier.Table, menuitemmodifier.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, menuitem.ItemModifiersTable, menuitem.ItemModifiersColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *MenuItemClient) Hooks() []Hook {
+	return c.hooks.MenuItem
+}
+
+// Interceptors returns the client interceptors.
+func (c *MenuItemClient) Interceptors() []Interceptor {
+	return c.inters.MenuItem
+}
+
+func (c *MenuItemClient) mutate(ctx context.Context, m *MenuItemMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&MenuItemCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&MenuItemUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&MenuItemUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&MenuItemDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown MenuItem mutation op: %q", m.Op())
+	}
+}
+
+// MenuItemModifierClient is a client for the MenuItemModifier schema.
+type MenuItemModifierClient struct {
+	config
+}
+
+// NewMenuItemModifierClient returns a client for the MenuItemModifier from the given config.
+func NewMenuItemModifierClient(c config) *MenuItemModifierClient {
+	return &MenuItemModifierClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `menuitemmodifier.Hooks(f(g(h())))`.
+func (c *MenuItemModifierClient) Use(hooks ...Hook) {
+	c.hooks.MenuItemModifier = append(c.hooks.MenuItemModifier, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `menuitemmodifier.Intercept(f(g(h())))`.
+func (c *MenuItemModifierClient) Intercept(interceptors ...Interceptor) {
+	c.inters.MenuItemModifier = append(c.inters.MenuItemModifier, interceptors...)
+}
+
+// Create returns a builder for creating a MenuItemModifier entity.
+func (c *MenuItemModifierClient) Create() *MenuItemModifierCreate {
+	mutation := newMenuItemModifierMutation(c.config, OpCreate)
+	return &MenuItemModifierCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of MenuItemModifier entities.
+func (c *MenuItemModifierClient) CreateBulk(builders ...*MenuItemModifierCreate) *MenuItemModifierCreateBulk {
+	return &MenuItemModifierCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *MenuItemModifierClient) MapCreateBulk(slice any, setFunc func(*MenuItemModifierCreate, int)) *MenuItemModifierCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &MenuItemModifierCreateBulk{err: fmt.Errorf("calling to MenuItemModifierClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*MenuItemModifierCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &MenuItemModifierCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for MenuItemModifier.
+func (c *MenuItemModifierClient) Update() *MenuItemModifierUpdate {
+	mutation := newMenuItemModifierMutation(c.config, OpUpdate)
+	return &MenuItemModifierUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *MenuItemModifierClient) UpdateOne(_m *MenuItemModifier) *MenuItemModifierUpdateOne {
+	mutation := newMenuItemModifierMutation(c.config, OpUpdateOne, withMenuItemModifier(_m))
+	return &MenuItemModifierUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *MenuItemModifierClient) UpdateOneID(id uuid.UUID) *MenuItemModifierUpdateOne {
+	mutation := newMenuItemModifierMutation(c.config, OpUpdateOne, withMenuItemModifierID(id))
+	return &MenuItemModifierUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for MenuItemModifier.
+func (c *MenuItemModifierClient) Delete() *MenuItemModifierDelete {
+	mutation := newMenuItemModifierMutation(c.config, OpDelete)
+	return &MenuItemModifierDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *MenuItemModifierClient) DeleteOne(_m *MenuItemModifier) *MenuItemModifierDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *MenuItemModifierClient) DeleteOneID(id uuid.UUID) *MenuItemModifierDeleteOne {
+	builder := c.Delete().Where(menuitemmodifier.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &MenuItemModifierDeleteOne{builder}
+}
+
+// Query returns a query builder for MenuItemModifier.
+func (c *MenuItemModifierClient) Query() *MenuItemModifierQuery {
+	return &MenuItemModifierQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeMenuItemModifier},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a MenuItemModifier entity by its id.
+func (c *MenuItemModifierClient) Get(ctx context.Context, id uuid.UUID) (*MenuItemModifier, error) {
+	return c.Query().Where(menuitemmodifier.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *MenuItemModifierClient) GetX(ctx context.Context, id uuid.UUID) *MenuItemModifier {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryMenuItem queries the menu_item edge of a MenuItemModifier.
+func (c *MenuItemModifierClient) QueryMenuItem(_m *MenuItemModifier) *MenuItemQuery {
+	query := (&MenuItemClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(menuitemmodifier.Table, menuitemmodifier.FieldID, id),
+			sqlgraph.To(menuitem.Table, menuitem.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, menuitemmodifier.MenuItemTable, menuitemmodifier.MenuItemColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryModifier queries the modifier edge of a MenuItemModifier.
+func (c *MenuItemModifierClient) QueryModifier(_m *MenuItemModifier) *ModifierQuery {
+	query := (&ModifierClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(menuitemmodifier.Table, menuitemmodifier.FieldID, id),
+			sqlgraph.To(modifier.Table, modifier.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, menuitemmodifier.ModifierTable, menuitemmodifier.ModifierColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *MenuItemModifierClient) Hooks() []Hook {
+	return c.hooks.MenuItemModifier
+}
+
+// Interceptors returns the client interceptors.
+func (c *MenuItemModifierClient) Interceptors() []Interceptor {
+	return c.inters.MenuItemModifier
+}
+
+func (c *MenuItemModifierClient) mutate(ctx context.Context, m *MenuItemModifierMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&MenuItemModifierCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&MenuItemModifierUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&MenuItemModifierUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&MenuItemModifierDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown MenuItemModifier mutation op: %q", m.Op())
+	}
+}
+
+// MenuItemPriceClient is a client for the MenuItemPrice schema.
+type MenuItemPriceClient struct {
+	config
+}
+
+// NewMenuItemPriceClient returns a client for the MenuItemPrice from the given config.
+func NewMenuItemPriceClient(c config) *MenuItemPriceClient {
+	return &MenuItemPriceClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `menuitemprice.Hooks(f(g(h())))`.
+func (c *MenuItemPriceClient) Use(hooks ...Hook) {
+	c.hooks.MenuItemPrice = append(c.hooks.MenuItemPrice, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `menuitemprice.Intercept(f(g(h())))`.
+func (c *MenuItemPriceClient) Intercept(interceptors ...Interceptor) {
+	c.inters.MenuItemPrice = append(c.inters.MenuItemPrice, interceptors...)
+}
+
+// Create returns a builder for creating a MenuItemPrice entity.
+func (c *MenuItemPriceClient) Create() *MenuItemPriceCreate {
+	mutation := newMenuItemPriceMutation(c.config, OpCreate)
+	return &MenuItemPriceCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of MenuItemPrice entities.
+func (c *MenuItemPriceClient) CreateBulk(builders ...*MenuItemPriceCreate) *MenuItemPriceCreateBulk {
+	return &MenuItemPriceCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *MenuItemPriceClient) MapCreateBulk(slice any, setFunc func(*MenuItemPriceCreate, int)) *MenuItemPriceCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &MenuItemPriceCreateBulk{err: fmt.Errorf("calling to MenuItemPriceClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*MenuItemPriceCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &MenuItemPriceCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for MenuItemPrice.
+func (c *MenuItemPriceClient) Update() *MenuItemPriceUpdate {
+	mutation := newMenuItemPriceMutation(c.config, OpUpdate)
+	return &MenuItemPriceUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *MenuItemPriceClient) UpdateOne(_m *MenuItemPrice) *MenuItemPriceUpdateOne {
+	mutation := newMenuItemPriceMutation(c.config, OpUpdateOne, withMenuItemPrice(_m))
+	return &MenuItemPriceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *MenuItemPriceClient) UpdateOneID(id uuid.UUID) *MenuItemPriceUpdateOne {
+	mutation := newMenuItemPriceMutation(c.config, OpUpdateOne, withMenuItemPriceID(id))
+	return &MenuItemPriceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for MenuItemPrice.
+func (c *MenuItemPriceClient) Delete() *MenuItemPriceDelete {
+	mutation := newMenuItemPriceMutation(c.config, OpDelete)
+	return &MenuItemPriceDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *MenuItemPriceClient) DeleteOne(_m *MenuItemPrice) *MenuItemPriceDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *MenuItemPriceClient) DeleteOneID(id uuid.UUID) *MenuItemPriceDeleteOne {
+	builder := c.Delete().Where(menuitemprice.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &MenuItemPriceDeleteOne{builder}
+}
+
+// Query returns a query builder for MenuItemPrice.
+func (c *MenuItemPriceClient) Query() *MenuItemPriceQuery {
+	return &MenuItemPriceQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeMenuItemPrice},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a MenuItemPrice entity by its id.
+func (c *MenuItemPriceClient) Get(ctx context.Context, id uuid.UUID) (*MenuItemPrice, error) {
+	return c.Query().Where(menuitemprice.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *MenuItemPriceClient) GetX(ctx context.Context, id uuid.UUID) *MenuItemPrice {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryMenu queries the menu edge of a MenuItemPrice.
+func (c *MenuItemPriceClient) QueryMenu(_m *MenuItemPrice) *MenuQuery {
+	query := (&MenuClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(menuitemprice.Table, menuitemprice.FieldID, id),
+			sqlgraph.To(menu.Table, menu.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, menuitemprice.MenuTable, menuitemprice.MenuColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryMenuItem queries the menu_item edge of a MenuItemPrice.
+func (c *MenuItemPriceClient) QueryMenuItem(_m *MenuItemPrice) *MenuItemQuery {
+	query := (&MenuItemClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(menuitemprice.Table, menuitemprice.FieldID, id),
+			sqlgraph.To(menuitem.Table, menuitem.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, menuitemprice.MenuItemTable, menuitemprice.MenuItemColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *MenuItemPriceClient) Hooks() []Hook {
+	return c.hooks.MenuItemPrice
+}
+
+// Interceptors returns the client interceptors.
+func (c *MenuItemPriceClient) Interceptors() []Interceptor {
+	return c.inters.MenuItemPrice
+}
+
+func (c *MenuItemPriceClient) mutate(ctx context.Context, m *MenuItemPriceMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&MenuItemPriceCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&MenuItemPriceUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&MenuItemPriceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&MenuItemPriceDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown MenuItemPrice mutation op: %q", m.Op())
+	}
+}
+
+// MenuItemVariantClient is a client for the MenuItemVariant schema.
+type MenuItemVariantClient struct {
+	config
+}
+
+// NewMenuItemVariantClient returns a client for the MenuItemVariant from the given config.
+func NewMenuItemVariantClient(c config) *MenuItemVariantClient {
+	return &MenuItemVariantClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `menuitemvariant.Hooks(f(g(h())))`.
+func (c *MenuItemVariantClient) Use(hooks ...Hook) {
+	c.hooks.MenuItemVariant = append(c.hooks.MenuItemVariant, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `menuitemvariant.Intercept(f(g(h())))`.
+func (c *MenuItemVariantClient) Intercept(interceptors ...Interceptor) {
+	c.inters.MenuItemVariant = append(c.inters.MenuItemVariant, interceptors...)
+}
+
+// Create returns a builder for creating a MenuItemVariant entity.
+func (c *MenuItemVariantClient) Create() *MenuItemVariantCreate {
+	mutation := newMenuItemVariantMutation(c.config, OpCreate)
+	return &MenuItemVariantCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of MenuItemVariant entities.
+func (c *MenuItemVariantClient) CreateBulk(builders ...*MenuItemVariantCreate) *MenuItemVariantCreateBulk {
+	return &MenuItemVariantCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *MenuItemVariantClient) MapCreateBulk(slice any, setFunc func(*MenuItemVariantCreate, int)) *MenuItemVariantCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &MenuItemVariantCreateBulk{err: fmt.Errorf("calling to MenuItemVariantClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*MenuItemVariantCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &MenuItemVariantCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for MenuItemVariant.
+func (c *MenuItemVariantClient) Update() *MenuItemVariantUpdate {
+	mutation := newMenuItemVariantMutation(c.config, OpUpdate)
+	return &MenuItemVariantUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *MenuItemVariantClient) UpdateOne(_m *MenuItemVariant) *MenuItemVariantUpdateOne {
+	mutation := newMenuItemVariantMutation(c.config, OpUpdateOne, withMenuItemVariant(_m))
+	return &MenuItemVariantUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *MenuItemVariantClient) UpdateOneID(id uuid.UUID) *MenuItemVariantUpdateOne {
+	mutation := newMenuItemVariantMutation(c.config, OpUpdateOne, withMenuItemVariantID(id))
+	return &MenuItemVariantUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for MenuItemVariant.
+func (c *MenuItemVariantClient) Delete() *MenuItemVariantDelete {
+	mutation := newMenuItemVariantMutation(c.config, OpDelete)
+	return &MenuItemVariantDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *MenuItemVariantClient) DeleteOne(_m *MenuItemVariant) *MenuItemVariantDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *MenuItemVariantClient) DeleteOneID(id uuid.UUID) *MenuItemVariantDeleteOne {
+	builder := c.Delete().Where(menuitemvariant.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &MenuItemVariantDeleteOne{builder}
+}
+
+// Query returns a query builder for MenuItemVariant.
+func (c *MenuItemVariantClient) Query() *MenuItemVariantQuery {
+	return &MenuItemVariantQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeMenuItemVariant},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a MenuItemVariant entity by its id.
+func (c *MenuItemVariantClient) Get(ctx context.Context, id uuid.UUID) (*MenuItemVariant, error) {
+	return c.Query().Where(menuitemvariant.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *MenuItemVariantClient) GetX(ctx context.Context, id uuid.UUID) *MenuItemVariant {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryMenuItem queries the menu_item edge of a MenuItemVariant.
+func (c *MenuItemVariantClient) QueryMenuItem(_m *MenuItemVariant) *MenuItemQuery {
+	query := (&MenuItemClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(menuitemvariant.Table, menuitemvariant.FieldID, id),
+			sqlgraph.To(menuitem.Table, menuitem.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, menuitemvariant.MenuItemTable, menuitemvariant.MenuItemColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryOrderItems queries the order_items edge of a MenuItemVariant.
+func (c *MenuItemVariantClient) QueryOrderItems(_m *MenuItemVariant) *OrderItemQuery {
+	query := (&OrderItemClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(menuitemvariant.Table, menuitemvariant.FieldID, id),
+			sqlgraph.To(orderitem.Table, orderitem.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, menuitemvariant.OrderItemsTable, menuitemvariant.OrderItemsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *MenuItemVariantClient) Hooks() []Hook {
+	return c.hooks.MenuItemVariant
+}
+
+// Interceptors returns the client interceptors.
+func (c *MenuItemVariantClient) Interceptors() []Interceptor {
+	return c.inters.MenuItemVariant
+}
+
+func (c *MenuItemVariantClient) mutate(ctx context.Context, m *MenuItemVariantMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&MenuItemVariantCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&MenuItemVariantUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&MenuItemVariantUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&MenuItemVariantDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown MenuItemVariant mutation op: %q", m.Op())
+	}
+}
+
+// ModifierClient is a client for the Modifier schema.
+type ModifierClient struct {
+	config
+}
+
+// NewModifierClient returns a client for the Modifier from the given config.
+func NewModifierClient(c config) *ModifierClient {
+	return &ModifierClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `modifier.Hooks(f(g(h())))`.
+func (c *ModifierClient) Use(hooks ...Hook) {
+	c.hooks.Modifier = append(c.hooks.Modifier, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `modifier.Intercept(f(g(h())))`.
+func (c *ModifierClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Modifier = append(c.inters.Modifier, interceptors...)
+}
+
+// Create returns a builder for creating a Modifier entity.
+func (c *ModifierClient) Create() *ModifierCreate {
+	mutation := newModifierMutation(c.config, OpCreate)
+	return &ModifierCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Modifier entities.
+func (c *ModifierClient) CreateBulk(builders ...*ModifierCreate) *ModifierCreateBulk {
+	return &ModifierCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ModifierClient) MapCreateBulk(slice any, setFunc func(*ModifierCreate, int)) *ModifierCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ModifierCreateBulk{err: fmt.Errorf("calling to ModifierClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ModifierCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ModifierCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Modifier.
+func (c *ModifierClient) Update() *ModifierUpdate {
+	mutation := newModifierMutation(c.config, OpUpdate)
+	return &ModifierUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ModifierClient) UpdateOne(_m *Modifier) *ModifierUpdateOne {
+	mutation := newModifierMutation(c.config, OpUpdateOne, withModifier(_m))
+	return &ModifierUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ModifierClient) UpdateOneID(id uuid.UUID) *ModifierUpdateOne {
+	mutation := newModifierMutation(c.config, OpUpdateOne, withModifierID(id))
+	return &ModifierUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Modifier.
+func (c *ModifierClient) Delete() *ModifierDelete {
+	mutation := newModifierMutation(c.config, OpDelete)
+	return &ModifierDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ModifierClient) DeleteOne(_m *Modifier) *ModifierDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ModifierClient) DeleteOneID(id uuid.UUID) *ModifierDeleteOne {
+	builder := c.Delete().Where(modifier.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ModifierDeleteOne{builder}
+}
+
+// Query returns a query builder for Modifier.
+func (c *ModifierClient) Query() *ModifierQuery {
+	return &ModifierQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeModifier},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Modifier entity by its id.
+func (c *ModifierClient) Get(ctx context.Context, id uuid.UUID) (*Modifier, error) {
+	return c.Query().Where(modifier.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ModifierClient) GetX(ctx context.Context, id uuid.UUID) *Modifier {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryRestaurant queries the restaurant edge of a Modifier.
+func (c *ModifierClient) QueryRestaurant(_m *Modifier) *RestaurantQuery {
+	query := (&RestaurantClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(modifier.Table, modifier.FieldID, id),
+			sqlgraph.To(restaurant.Table, restaurant.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, modifier.RestaurantTable, modifier.RestaurantColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryModifierOptions queries the modifier_options edge of a Modifier.
+func (c *ModifierClient) QueryModifierOptions(_m *Modifier) *ModifierOptionQuery {
+	query := (&ModifierOptionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(modifier.Table, modifier.FieldID, id),
+			sqlgraph.To(modifieroption.Table, modifieroption.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, modifier.ModifierOptionsTable, modifier.ModifierOptionsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryMenuItems queries the menu_items edge of a Modifier.
+func (c *ModifierClient) QueryMenuItems(_m *Modifier) *MenuItemQuery {
+	query := (&MenuItemClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(modifier.Table, modifier.FieldID, id),
+			sqlgraph.To(menuitem.Table, menuitem.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, modifier.MenuItemsTable, modifier.MenuItemsPrimaryKey...),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryParentOptions queries the parent_options edge of a Modifier.
+func (c *ModifierClient) QueryParentOptions(_m *Modifier) *ModifierOptionQuery {
+	query := (&ModifierOptionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(modifier.Table, modifier.FieldID, id),
+			sqlgraph.To(modifieroption.Table, modifieroption.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, modifier.ParentOptionsTable, modifier.ParentOptionsPrimaryKey...),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryItemModifiers queries the item_modifiers edge of a Modifier.
+func (c *ModifierClient) QueryItemModifiers(_m *Modifier) *MenuItemModifierQuery {
+	query := (&MenuItemModifierClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(modifier.Table, modifier.FieldID, id),
+			sqlgraph.To(menuitemmodifier.Table, menuitemmodifier.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, modifier.ItemModifiersTable, modifier.ItemModifiersColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *ModifierClient) Hooks() []Hook {
+	return c.hooks.Modifier
+}
+
+// Interceptors returns the client interceptors.
+func (c *ModifierClient) Interceptors() []Interceptor {
+	return c.inters.Modifier
+}
+
+func (c *ModifierClient) mutate(ctx context.Context, m *ModifierMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ModifierCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ModifierUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ModifierUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ModifierDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Modifier mutation op: %q", m.Op())
+	}
+}
+
+// ModifierOptionClient is a client for the ModifierOption schema.
+type ModifierOptionClient struct {
+	config
+}
+
+// NewModifierOptionClient returns a client for the ModifierOption from the given config.
+func NewModifierOptionClient(c config) *ModifierOptionClient {
+	return &ModifierOptionClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `modifieroption.Hooks(f(g(h())))`.
+func (c *ModifierOptionClient) Use(hooks ...Hook) {
+	c.hooks.ModifierOption = append(c.hooks.ModifierOption, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `modifieroption.Intercept(f(g(h())))`.
+func (c *ModifierOptionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ModifierOption = append(c.inters.ModifierOption, interceptors...)
+}
+
+// Create returns a builder for creating a ModifierOption entity.
+func (c *ModifierOptionClient) Create() *ModifierOptionCreate {
+	mutation := newModifierOptionMutation(c.config, OpCreate)
+	return &ModifierOptionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ModifierOption entities.
+func (c *ModifierOptionClient) CreateBulk(builders ...*ModifierOptionCreate) *ModifierOptionCreateBulk {
+	return &ModifierOptionCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ModifierOptionClient) MapCreateBulk(slice any, setFunc func(*ModifierOptionCreate, int)) *ModifierOptionCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ModifierOptionCreateBulk{err: fmt.Errorf("calling to ModifierOptionClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ModifierOptionCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ModifierOptionCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ModifierOption.
+func (c *ModifierOptionClient) Update() *ModifierOptionUpdate {
+	mutation := newModifierOptionMutation(c.config, OpUpdate)
+	return &ModifierOptionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ModifierOptionClient) UpdateOne(_m *ModifierOption) *ModifierOptionUpdateOne {
+	mutation := newModifierOptionMutation(c.config, OpUpdateOne, withModifierOption(_m))
+	return &ModifierOptionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ModifierOptionClient) UpdateOneID(id uuid.UUID) *ModifierOptionUpdateOne {
+	mutation := newModifierOptionMutation(c.config, OpUpdateOne, withModifierOptionID(id))
+	return &ModifierOptionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ModifierOption.
+func (c *ModifierOptionClient) Delete() *ModifierOptionDelete {
+	mutation := newModifierOptionMutation(c.config, OpDelete)
+	return &ModifierOptionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ModifierOptionClient) DeleteOne(_m *ModifierOption) *ModifierOptionDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ModifierOptionClient) DeleteOneID(id uuid.UUID) *ModifierOptionDeleteOne {
+	builder := c.Delete().Where(modifieroption.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ModifierOptionDeleteOne{builder}
+}
+
+// Query returns a query builder for ModifierOption.
+func (c *ModifierOptionClient) Query() *ModifierOptionQuery {
+	return &ModifierOptionQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeModifierOption},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a ModifierOption entity by its id.
+func (c *ModifierOptionClient) Get(ctx context.Context, id uuid.UUID) (*ModifierOption, error) {
+	return c.Query().Where(modifieroption.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ModifierOptionClient) GetX(ctx context.Context, id uuid.UUID) *ModifierOption {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryModifier queries the modifier edge of a ModifierOption.
+func (c *ModifierOptionClient) QueryModifier(_m *ModifierOption) *ModifierQuery {
+	query := (&ModifierClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(modifieroption.Table, modifieroption.FieldID, id),
+			sqlgraph.To(modifier.Table, modifier.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, modifieroption.ModifierTable, modifieroption.ModifierColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryOrderItemModifierOptions queries the order_item_modifier_options edge of a ModifierOption.
+func (c *ModifierOptionClient) QueryOrderItemModifierOptions(_m *ModifierOption) *OrderItemModifierOptionQuery {
+	query := (&OrderItemModifierOptionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(modifieroption.Table, modifieroption.FieldID, id),
+			sqlgraph.To(orderitemmodifieroption.Table, orderitemmodifieroption.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, modifieroption.OrderItemModifierOptionsTable, modifieroption.OrderItemModifierOptionsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryChildModifiers queries the child_modifiers edge of a ModifierOption.
+func (c *ModifierOptionClient) QueryChildModifiers(_m *ModifierOption) *ModifierQuery {
+	query := (&ModifierClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(modifieroption.Table, modifieroption.FieldID, id),
+			sqlgraph.To(modifier.Table, modifier.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, false, modifieroption.ChildModifiersTable, modifieroption.ChildModifiersPrimaryKey...),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryRecipeLines queries the recipe_lines edge of a ModifierOption.
+func (c *ModifierOptionClient) QueryRecipeLines(_m *ModifierOption) *RecipeIngredientQuery {
+	query := (&RecipeIngredientClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(modifieroption.Table, modifieroption.FieldID, id),
+			sqlgraph.To(recipeingredient.Table, recipeingredient.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, modifieroption.RecipeLinesTable, modifieroption.RecipeLinesColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *ModifierOptionClient) Hooks() []Hook {
+	return c.hooks.ModifierOption
+}
+
+// Interceptors returns the client interceptors.
+func (c *ModifierOptionClient) Interceptors() []Interceptor {
+	return c.inters.ModifierOption
+}
+
+func (c *ModifierOptionClient) mutate(ctx context.Context, m *ModifierOptionMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ModifierOptionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ModifierOptionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ModifierOptionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ModifierOptionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown ModifierOption mutation op: %q", m.Op())
+	}
+}
+
+// OrderClient is a client for the Order schema.
+type OrderClient struct {
+	config
+}
+
+// NewOrderClient returns a client for the Order from the given config.
+func NewOrderClient(c config) *OrderClient {
+	return &OrderClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `order.Hooks(f(g(h())))`.
+func (c *OrderClient) Use(hooks ...Hook) {
+	c.hooks.Order = append(c.hooks.Order, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `order.Intercept(f(g(h())))`.
+func (c *OrderClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Order = append(c.inters.Order, interceptors...)
+}
+
+// Create returns a builder for creating a Order entity.
+func (c *OrderClient) Create() *OrderCreate {
+	mutation := newOrderMutation(c.config, OpCreate)
+	return &OrderCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Order entities.
+func (c *OrderClient) CreateBulk(builders ...*OrderCreate) *OrderCreateBulk {
+	return &OrderCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *OrderClient) MapCreateBulk(slice any, setFunc func(*OrderCreate, int)) *OrderCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &OrderCreateBulk{err: fmt.Errorf("calling to OrderClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*OrderCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &OrderCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Order.
+func (c *OrderClient) Update() *OrderUpdate {
+	mutation := newOrderMutation(c.config, OpUpdate)
+	return &OrderUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *OrderClient) UpdateOne(_m *Order) *OrderUpdateOne {
+	mutation := newOrderMutation(c.config, OpUpdateOne, withOrder(_m))
+	return &OrderUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *OrderClient) UpdateOneID(id uuid.UUID) *OrderUpdateOne {
+	mutation := newOrderMutation(c.config, OpUpdateOne, withOrderID(id))
+	return &OrderUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Order.
+func (c *OrderClient) Delete() *OrderDelete {
+	mutation := newOrderMutation(c.config, OpDelete)
+	return &OrderDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *OrderClient) DeleteOne(_m *Order) *OrderDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *OrderClient) DeleteOneID(id uuid.UUID) *OrderDeleteOne {
+	builder := c.Delete().Where(order.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &OrderDeleteOne{builder}
+}
+
+// Query returns a query builder for Order.
+func (c *OrderClient) Query() *OrderQuery {
+	return &OrderQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeOrder},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Order entity by its id.
+func (c *OrderClient) Get(ctx context.Context, id uuid.UUID) (*Order, error) {
+	return c.Query().Where(order.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *OrderClient) GetX(ctx context.Context, id uuid.UUID) *Order {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryRestaurant queries the restaurant edge of a Order.
+func (c *OrderClient) QueryRestaurant(_m *Order) *RestaurantQuery {
+	query := (&RestaurantClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(order.Table, order.FieldID, id),
+			sqlgraph.To(restaurant.Table, restaurant.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, order.RestaurantTable, order.RestaurantColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryOrderItems queries the order_items edge of a Order.
+func (c *OrderClient) QueryOrderItems(_m *Order) *OrderItemQuery {
+	query := (&OrderItemClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(order.Table, order.FieldID, id),
+			sqlgraph.To(orderitem.Table, orderitem.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, order.OrderItemsTable, order.OrderItemsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryStatusEvents queries the status_events edge of a Order.
+func (c *OrderClient) QueryStatusEvents(_m *Order) *OrderStatusEventQuery {
+	query := (&OrderStatusEventClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(order.Table, order.FieldID, id),
+			sqlgraph.To(orderstatusevent.Table, orderstatusevent.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, order.StatusEventsTable, order.StatusEventsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryItemChanges queries the item_changes edge of a Order.
+func (c *OrderClient) QueryItemChanges(_m *Order) *OrderItemChangeQuery {
+	query := (&OrderItemChangeClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(order.Table, order.FieldID, id),
+			sqlgraph.To(orderitemchange.Table, orderitemchange.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, order.ItemChangesTable, order.ItemChangesColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryPayments queries the payments edge of a Order.
+func (c *OrderClient) QueryPayments(_m *Order) *PaymentQuery {
+	query := (&PaymentClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(order.Table, order.FieldID, id),
+			sqlgraph.To(payment.Table, payment.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, order.PaymentsTable, order.PaymentsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryRefunds queries the refunds edge of a Order.
+func (c *OrderClient) QueryRefunds(_m *Order) *RefundQuery {
+	query := (&RefundClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(order.Table, order.FieldID, id),
+			sqlgraph.To(refund.Table, refund.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, order.RefundsTable, order.RefundsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryStationTickets queries the station_tickets edge of a Order.
+func (c *OrderClient) QueryStationTickets(_m *Order) *StationTicketQuery {
+	query := (&StationTicketClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(order.Table, order.FieldID, id),
+			sqlgraph.To(stationticket.Table, stationticket.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, order.StationTicketsTable, order.StationTicketsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryTable queries the table edge of a Order.
+func (c *OrderClient) QueryTable(_m *Order) *TableQuery {
+	query := (&TableClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(order.Table, order.FieldID, id),
+			sqlgraph.To(table.Table, table.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, order.TableTable, order.TableColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryTableSession queries the table_session edge of a Order.
+func (c *OrderClient) QueryTableSession(_m *Order) *TableSessionQuery {
+	query := (&TableSessionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(order.Table, order.FieldID, id),
+			sqlgraph.To(tablesession.Table, tablesession.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, order.TableSessionTable, order.TableSessionColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryDeliveryZone queries the delivery_zone edge of a Order.
+func (c *OrderClient) QueryDeliveryZone(_m *Order) *DeliveryZoneQuery {
+	query := (&DeliveryZoneClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(order.Table, order.FieldID, id),
+			sqlgraph.To(deliveryzone.Table, deliveryzone.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, order.DeliveryZoneTable, order.DeliveryZoneColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *OrderClient) Hooks() []Hook {
+	return c.hooks.Order
+}
+
+// Interceptors returns the client interceptors.
+func (c *OrderClient) Interceptors() []Interceptor {
+	return c.inters.Order
+}
+
+func (c *OrderClient) mutate(ctx context.Context, m *OrderMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&OrderCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&OrderUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&OrderUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&OrderDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Order mutation op: %q", m.Op())
+	}
+}
+
+// OrderEventClient is a client for the OrderEvent schema.
+type OrderEventClient struct {
+	config
+}
+
+// NewOrderEventClient returns a client for the OrderEvent from the given config.
+func NewOrderEventClient(c config) *OrderEventClient {
+	return &OrderEventClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `orderevent.Hooks(f(g(h())))`.
+func (c *OrderEventClient) Use(hooks ...Hook) {
+	c.hooks.OrderEvent = append(c.hooks.OrderEvent, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `orderevent.Intercept(f(g(h())))`.
+func (c *OrderEventClient) Intercept(interceptors ...Interceptor) {
+	c.inters.OrderEvent = append(c.inters.OrderEvent, interceptors...)
+}
+
+// Create returns a builder for creating a OrderEvent entity.
+func (c *OrderEventClient) Create() *OrderEventCreate {
+	mutation := newOrderEventMutation(c.config, OpCreate)
+	return &OrderEventCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of OrderEvent entities.
+func (c *OrderEventClient) CreateBulk(builders ...*OrderEventCreate) *OrderEventCreateBulk {
+	return &OrderEventCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *OrderEventClient) MapCreateBulk(slice any, setFunc func(*OrderEventCreate, int)) *OrderEventCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &OrderEventCreateBulk{err: fmt.Errorf("calling to OrderEventClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*OrderEventCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &OrderEventCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for OrderEvent.
+func (c *OrderEventClient) Update() *OrderEventUpdate {
+	mutation := newOrderEventMutation(c.config, OpUpdate)
+	return &OrderEventUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *OrderEventClient) UpdateOne(_m *OrderEvent) *OrderEventUpdateOne {
+	mutation := newOrderEventMutation(c.config, OpUpdateOne, withOrderEvent(_m))
+	return &OrderEventUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *OrderEventClient) UpdateOneID(id uuid.UUID) *OrderEventUpdateOne {
+	mutation := newOrderEventMutation(c.config, OpUpdateOne, withOrderEventID(id))
+	return &OrderEventUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for OrderEvent.
+func (c *OrderEventClient) Delete() *OrderEventDelete {
+	mutation := newOrderEventMutation(c.config, OpDelete)
+	return &OrderEventDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *OrderEventClient) DeleteOne(_m *OrderEvent) *OrderEventDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *OrderEventClient) DeleteOneID(id uuid.UUID) *OrderEventDeleteOne {
+	builder := c.Delete().Where(orderevent.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &OrderEventDeleteOne{builder}
+}
+
+// Query returns a query builder for OrderEvent.
+func (c *OrderEventClient) Query() *OrderEventQuery {
+	return &OrderEventQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeOrderEvent},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a OrderEvent entity by its id.
+func (c *OrderEventClient) Get(ctx context.Context, id uuid.UUID) (*OrderEvent, error) {
+	return c.Query().Where(orderevent.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *OrderEventClient) GetX(ctx context.Context, id uuid.UUID) *OrderEvent {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryRestaurant queries the restaurant edge of a OrderEvent.
+func (c *OrderEventClient) QueryRestaurant(_m *OrderEvent) *RestaurantQuery {
+	query := (&RestaurantClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(orderevent.Table, orderevent.FieldID, id),
+			sqlgraph.To(restaurant.Table, restaurant.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, orderevent.RestaurantTable, orderevent.RestaurantColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *OrderEventClient) Hooks() []Hook {
+	return c.hooks.OrderEvent
+}
+
+// Interceptors returns the client interceptors.
+func (c *OrderEventClient) Interceptors() []Interceptor {
+	return c.inters.OrderEvent
+}
+
+func (c *OrderEventClient) mutate(ctx context.Context, m *OrderEventMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&OrderEventCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&OrderEventUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&OrderEventUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&OrderEventDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown OrderEvent mutation op: %q", m.Op())
+	}
+}
+
+// OrderItemClient is a client for the OrderItem schema.
+type OrderItemClient struct {
+	config
+}
+
+// NewOrderItemClient returns a client for the OrderItem from the given config.
+func NewOrderItemClient(c config) *OrderItemClient {
+	return &OrderItemClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `orderitem.Hooks(f(g(h())))`.
+func (c *OrderItemClient) Use(hooks ...Hook) {
+	c.hooks.OrderItem = append(c.hooks.OrderItem, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `orderitem.Intercept(f(g(h())))`.
+func (c *OrderItemClient) Intercept(interceptors ...Interceptor) {
+	c.inters.OrderItem = append(c.inters.OrderItem, interceptors...)
+}
+
+// Create returns a builder for creating a OrderItem entity.
+func (c *OrderItemClient) Create() *OrderItemCreate {
+	mutation := newOrderItemMutation(c.config, OpCreate)
+	return &OrderItemCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of OrderItem entities.
+func (c *OrderItemClient) CreateBulk(builders ...*OrderItemCreate) *OrderItemCreateBulk {
+	return &OrderItemCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *OrderItemClient) MapCreateBulk(slice any, setFunc func(*OrderItemCreate, int)) *OrderItemCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &OrderItemCreateBulk{err: fmt.Errorf("calling to OrderItemClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*OrderItemCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &OrderItemCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for OrderItem.
+func (c *OrderItemClient) Update() *OrderItemUpdate {
+	mutation := newOrderItemMutation(c.config, OpUpdate)
+	return &OrderItemUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *OrderItemClient) UpdateOne(_m *OrderItem) *OrderItemUpdateOne {
+	mutation := newOrderItemMutation(c.config, OpUpdateOne, withOrderItem(_m))
+	return &OrderItemUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *OrderItemClient) UpdateOneID(id uuid.UUID) *OrderItemUpdateOne {
+	mutation := newOrderItemMutation(c.config, OpUpdateOne, withOrderItemID(id))
+	return &OrderItemUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for OrderItem.
+func (c *OrderItemClient) Delete() *OrderItemDelete {
+	mutation := newOrderItemMutation(c.config, OpDelete)
+	return &OrderItemDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *OrderItemClient) DeleteOne(_m *OrderItem) *OrderItemDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *OrderItemClient) DeleteOneID(id uuid.UUID) *OrderItemDeleteOne {
+	builder := c.Delete().Where(orderitem.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &OrderItemDeleteOne{builder}
+}
+
+// Query returns a query builder for OrderItem.
+func (c *OrderItemClient) Query() *OrderItemQuery {
+	return &OrderItemQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeOrderItem},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a OrderItem entity by its id.
+func (c *OrderItemClient) Get(ctx context.Context, id uuid.UUID) (*OrderItem, error) {
+	return c.Query().Where(orderitem.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *OrderItemClient) GetX(ctx context.Context, id uuid.UUID) *OrderItem {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryOrder queries the order edge of a OrderItem.
+func (c *OrderItemClient) QueryOrder(_m *OrderItem) *OrderQuery {
+	query := (&OrderClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(orderitem.Table, orderitem.FieldID, id),
+			sqlgraph.To(order.Table, order.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, orderitem.OrderTable, orderitem.OrderColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryMenuItem queries the menu_item edge of a OrderItem.
+func (c *OrderItemClient) QueryMenuItem(_m *OrderItem) *MenuItemQuery {
+	query := (&MenuItemClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(orderitem.Table, orderitem.FieldID, id),
+			sqlgraph.To(menuitem.Table, menuitem.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, orderitem.MenuItemTable, orderitem.MenuItemColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryVariant queries the variant edge of a OrderItem.
+func (c *OrderItemClient) QueryVariant(_m *OrderItem) *MenuItemVariantQuery {
+	query := (&MenuItemVariantClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(orderitem.Table, orderitem.FieldID, id),
+			sqlgraph.To(menuitemvariant.Table, menuitemvariant.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, orderitem.VariantTable, orderitem.VariantColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryOrderItemModifierOptions queries the order_item_modifier_options edge of a OrderItem.
+func (c *OrderItemClient) QueryOrderItemModifierOptions(_m *OrderItem) *OrderItemModifierOptionQuery {
+	query := (&OrderItemModifierOptionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(orderitem.Table, orderitem.FieldID, id),
+			sqlgraph.To(orderitemmodifieroption.Table, orderitemmodifieroption.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, orderitem.OrderItemModifierOptionsTable, orderitem.OrderItemModifierOptionsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryStationTicket queries the station_ticket edge of a OrderItem.
+func (c *OrderItemClient) QueryStationTicket(_m *OrderItem) *StationTicketQuery {
+	query := (&StationTicketClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(orderitem.Table, orderitem.FieldID, id),
+			sqlgraph.To(stationticket.Table, stationticket.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, orderitem.StationTicketTable, orderitem.StationTicketColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryChanges queries the changes edge of a OrderItem.
+func (c *OrderItemClient) QueryChanges(_m *OrderItem) *OrderItemChangeQuery {
+	query := (&OrderItemChangeClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(orderitem.Table, orderitem.FieldID, id),
+			sqlgraph.To(orderitemchange.Table, orderitemchange.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, orderitem.ChangesTable, orderitem.ChangesColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *OrderItemClient) Hooks() []Hook {
+	return c.hooks.OrderItem
+}
+
+// Interceptors returns the client interceptors.
+func (c *OrderItemClient) Interceptors() []Interceptor {
+	return c.inters.OrderItem
+}
+
+func (c *OrderItemClient) mutate(ctx context.Context, m *OrderItemMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&OrderItemCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&OrderItemUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&OrderItemUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&OrderItemDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown OrderItem mutation op: %q", m.Op())
+	}
+}
+
+// OrderItemChangeClient is a client for the OrderItemChange schema.
+type OrderItemChangeClient struct {
+	config
+}
+
+// NewOrderItemChangeClient returns a client for the OrderItemChange from the given config.
+func NewOrderItemChangeClient(c config) *OrderItemChangeClient {
+	return &OrderItemChangeClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `orderitemchange.Hooks(f(g(h())))`.
+func (c *OrderItemChangeClient) Use(hooks ...Hook) {
+	c.hooks.OrderItemChange = append(c.hooks.OrderItemChange, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `orderitemchange.Intercept(f(g(h())))`.
+func (c *OrderItemChangeClient) Intercept(interceptors ...Interceptor) {
+	c.inters.OrderItemChange = append(c.inters.OrderItemChange, interceptors...)
+}
+
+// Create returns a builder for creating a OrderItemChange entity.
+func (c *OrderItemChangeClient) Create() *OrderItemChangeCreate {
+	mutation := newOrderItemChangeMutation(c.config, OpCreate)
+	return &OrderItemChangeCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of OrderItemChange entities.
+func (c *OrderItemChangeClient) CreateBulk(builders ...*OrderItemChangeCreate) *OrderItemChangeCreateBulk {
+	return &OrderItemChangeCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *OrderItemChangeClient) MapCreateBulk(slice any, setFunc func(*OrderItemChangeCreate, int)) *OrderItemChangeCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &OrderItemChangeCreateBulk{err: fmt.Errorf("calling to OrderItemChangeClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*OrderItemChangeCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &OrderItemChangeCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for OrderItemChange.
+func (c *OrderItemChangeClient) Update() *OrderItemChangeUpdate {
+	mutation := newOrderItemChangeMutation(c.config, OpUpdate)
+	return &OrderItemChangeUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *OrderItemChangeClient) UpdateOne(_m *OrderItemChange) *OrderItemChangeUpdateOne {
+	mutation := newOrderItemChangeMutation(c.config, OpUpdateOne, withOrderItemChange(_m))
+	return &OrderItemChangeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *OrderItemChangeClient) UpdateOneID(id uuid.UUID) *OrderItemChangeUpdateOne {
+	mutation := newOrderItemChangeMutation(c.config, OpUpdateOne, withOrderItemChangeID(id))
+	return &OrderItemChangeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for OrderItemChange.
+func (c *OrderItemChangeClient) Delete() *OrderItemChangeDelete {
+	mutation := newOrderItemChangeMutation(c.config, OpDelete)
+	return &OrderItemChangeDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *OrderItemChangeClient) DeleteOne(_m *OrderItemChange) *OrderItemChangeDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *OrderItemChangeClient) DeleteOneID(id uuid.UUID) *OrderItemChangeDeleteOne {
+	builder := c.Delete().Where(orderitemchange.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &OrderItemChangeDeleteOne{builder}
+}
+
+// Query returns a query builder for OrderItemChange.
+func (c *OrderItemChangeClient) Query() *OrderItemChangeQuery {
+	return &OrderItemChangeQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeOrderItemChange},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a OrderItemChange entity by its id.
+func (c *OrderItemChangeClient) Get(ctx context.Context, id uuid.UUID) (*OrderItemChange, error) {
+	return c.Query().Where(orderitemchange.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *OrderItemChangeClient) GetX(ctx context.Context, id uuid.UUID) *OrderItemChange {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryOrder queries the order edge of a OrderItemChange.
+func (c *OrderItemChangeClient) QueryOrder(_m *OrderItemChange) *OrderQuery {
+	query := (&OrderClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(orderitemchange.Table, orderitemchange.FieldID, id),
+			sqlgraph.To(order.Table, order.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, orderitemchange.OrderTable, orderitemchange.OrderColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryOrderItem queries the order_item edge of a OrderItemChange.
+func (c *OrderItemChangeClient) QueryOrderItem(_m *OrderItemChange) *OrderItemQuery {
+	query := (&OrderItemClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(orderitemchange.Table, orderitemchange.FieldID, id),
+			sqlgraph.To(orderitem.Table, orderitem.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, orderitemchange.OrderItemTable, orderitemchange.OrderItemColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryChangedBy queries the changed_by edge of a OrderItemChange.
+func (c *OrderItemChangeClient) QueryChangedBy(_m *OrderItemChange) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(orderitemchange.Table, orderitemchange.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, orderitemchange.ChangedByTable, orderitemchange.ChangedByColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *OrderItemChangeClient) Hooks() []Hook {
+	return c.hooks.OrderItemChange
+}
+
+// Interceptors returns the client interceptors.
+func (c *OrderItemChangeClient) Interceptors() []Interceptor {
+	return c.inters.OrderItemChange
+}
+
+func (c *OrderItemChangeClient) mutate(ctx context.Context, m *OrderItemChangeMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&OrderItemChangeCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&OrderItemChangeUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&OrderItemChangeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&OrderItemChangeDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown OrderItemChange mutation op: %q", m.Op())
+	}
+}
+
+// OrderItemModifierOptionClient is a client for the OrderItemModifierOption schema.
+type OrderItemModifierOptionClient struct {
+	config
+}
+
+// NewOrderItemModifierOptionClient returns a client for the OrderItemModifierOption from the given config.
+func NewOrderItemModifierOptionClient(c config) *OrderItemModifierOptionClient {
+	return &OrderItemModifierOptionClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `orderitemmodifieroption.Hooks(f(g(h())))`.
+func (c *OrderItemModifierOptionClient) Use(hooks ...Hook) {
+	c.hooks.OrderItemModifierOption = append(c.hooks.OrderItemModifierOption, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `orderitemmodifieroption.Intercept(f(g(h())))`.
+func (c *OrderItemModifierOptionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.OrderItemModifierOption = append(c.inters.OrderItemModifierOption, interceptors...)
+}
+
+// Create returns a builder for creating a OrderItemModifierOption entity.
+func (c *OrderItemModifierOptionClient) Create() *OrderItemModifierOptionCreate {
+	mutation := newOrderItemModifierOptionMutation(c.config, OpCreate)
+	return &OrderItemModifierOptionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of OrderItemModifierOption entities.
+func (c *OrderItemModifierOptionClient) CreateBulk(builders ...*OrderItemModifierOptionCreate) *OrderItemModifierOptionCreateBulk {
+	return &OrderItemModifierOptionCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *OrderItemModifierOptionClient) MapCreateBulk(slice any, setFunc func(*OrderItemModifierOptionCreate, int)) *OrderItemModifierOptionCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &OrderItemModifierOptionCreateBulk{err: fmt.Errorf("calling to OrderItemModifierOptionClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*OrderItemModifierOptionCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &OrderItemModifierOptionCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for OrderItemModifierOption.
+func (c *OrderItemModifierOptionClient) Update() *OrderItemModifierOptionUpdate {
+	mutation := newOrderItemModifierOptionMutation(c.config, OpUpdate)
+	return &OrderItemModifierOptionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *OrderItemModifierOptionClient) UpdateOne(_m *OrderItemModifierOption) *OrderItemModifierOptionUpdateOne {
+	mutation := newOrderItemModifierOptionMutation(c.config, OpUpdateOne, withOrderItemModifierOption(_m))
+	return &OrderItemModifierOptionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *OrderItemModifierOptionClient) UpdateOneID(id int) *OrderItemModifierOptionUpdateOne {
+	mutation := newOrderItemModifierOptionMutation(c.config, OpUpdateOne, withOrderItemModifierOptionID(id))
+	return &OrderItemModifierOptionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for OrderItemModifierOption.
+func (c *OrderItemModifierOptionClient) Delete() *OrderItemModifierOptionDelete {
+	mutation := newOrderItemModifierOptionMutation(c.config, OpDelete)
+	return &OrderItemModifierOptionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *OrderItemModifierOptionClient) DeleteOne(_m *OrderItemModifierOption) *OrderItemModifierOptionDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *OrderItemModifierOptionClient) DeleteOneID(id int) *OrderItemModifierOptionDeleteOne {
+	builder := c.Delete().Where(orderitemmodifieroption.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &OrderItemModifierOptionDeleteOne{builder}
+}
+
+// Query returns a query builder for OrderItemModifierOption.
+func (c *OrderItemModifierOptionClient) Query() *OrderItemModifierOptionQuery {
+	return &OrderItemModifierOptionQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeOrderItemModifierOption},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a OrderItemModifierOption entity by its id.
+func (c *OrderItemModifierOptionClient) Get(ctx context.Context, id int) (*OrderItemModifierOption, error) {
+	return c.Query().Where(orderitemmodifieroption.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *OrderItemModifierOptionClient) GetX(ctx context.Context, id int) *OrderItemModifierOption {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryOrderItem queries the order_item edge of a OrderItemModifierOption.
+func (c *OrderItemModifierOptionClient) QueryOrderItem(_m *OrderItemModifierOption) *OrderItemQuery {
+	query := (&OrderItemClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(orderitemmodifieroption.Table, orderitemmodifieroption.FieldID, id),
+			sqlgraph.To(orderitem.Table, orderitem.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, orderitemmodifieroption.OrderItemTable, orderitemmodifieroption.OrderItemColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryModifierOption queries the modifier_option edge of a OrderItemModifierOption.
+func (c *OrderItemModifierOptionClient) QueryModifierOption(_m *OrderItemModifierOption) *ModifierOptionQuery {
+	query := (&ModifierOptionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(orderitemmodifieroption.Table, orderitemmodifieroption.FieldID, id),
+			sqlgraph.To(modifieroption.Table, modifieroption.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, orderitemmodifieroption.ModifierOptionTable, orderitemmodifieroption.ModifierOptionColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryParent queries the parent edge of a OrderItemModifierOption.
+func (c *OrderItemModifierOptionClient) QueryParent(_m *OrderItemModifierOption) *OrderItemModifierOptionQuery {
+	query := (&OrderItemModifierOptionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(orderitemmodifieroption.Table, orderitemmodifieroption.FieldID, id),
+			sqlgraph.To(orderitemmodifieroption.Table, orderitemmodifieroption.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, orderitemmodifieroption.ParentTable, orderitemmodifieroption.ParentColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryChildren queries the children edge of a OrderItemModifierOption.
+func (c *OrderItemModifierOptionClient) QueryChildren(_m *OrderItemModifierOption) *OrderItemModifierOptionQuery {
+	query := (&OrderItemModifierOptionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(orderitemmodifieroption.Table, orderitemmodifieroption.FieldID, id),
+			sqlgraph.To(orderitemmodifieroption.Table, orderitemmodifieroption.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, orderitemmodifieroption.ChildrenTable, orderitemmodifieroption.ChildrenColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *OrderItemModifierOptionClient) Hooks() []Hook {
+	return c.hooks.OrderItemModifierOption
+}
+
+// Interceptors returns the client interceptors.
+func (c *OrderItemModifierOptionClient) Interceptors() []Interceptor {
+	return c.inters.OrderItemModifierOption
+}
+
+func (c *OrderItemModifierOptionClient) mutate(ctx context.Context, m *OrderItemModifierOptionMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&OrderItemModifierOptionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&OrderItemModifierOptionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&OrderItemModifierOptionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&OrderItemModifierOptionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown OrderItemModifierOption mutation op: %q", m.Op())
+	}
+}
+
+// OrderNumberSequenceClient is a client for the OrderNumberSequence schema.
+type OrderNumberSequenceClient struct {
+	config
+}
+
+// NewOrderNumberSequenceClient returns a client for the OrderNumberSequence from the given config.
+func NewOrderNumberSequenceClient(c config) *OrderNumberSequenceClient {
+	return &OrderNumberSequenceClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `ordernumbersequence.Hooks(f(g(h())))`.
+func (c *OrderNumberSequenceClient) Use(hooks ...Hook) {
+	c.hooks.OrderNumberSequence = append(c.hooks.OrderNumberSequence, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `ordernumbersequence.Intercept(f(g(h())))`.
+func (c *OrderNumberSequenceClient) Intercept(interceptors ...Interceptor) {
+	c.inters.OrderNumberSequence = append(c.inters.OrderNumberSequence, interceptors...)
+}
+
+// Create returns a builder for creating a OrderNumberSequence entity.
+func (c *OrderNumberSequenceClient) Create() *OrderNumberSequenceCreate {
+	mutation := newOrderNumberSequenceMutation(c.config, OpCreate)
+	return &OrderNumberSequenceCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of OrderNumberSequence entities.
+func (c *OrderNumberSequenceClient) CreateBulk(builders ...*OrderNumberSequenceCreate) *OrderNumberSequenceCreateBulk {
+	return &OrderNumberSequenceCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *OrderNumberSequenceClient) MapCreateBulk(slice any, setFunc func(*OrderNumberSequenceCreate, int)) *OrderNumberSequenceCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &OrderNumberSequenceCreateBulk{err: fmt.Errorf("calling to OrderNumberSequenceClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*OrderNumberSequenceCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &OrderNumberSequenceCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for OrderNumberSequence.
+func (c *OrderNumberSequenceClient) Update() *OrderNumberSequenceUpdate {
+	mutation := newOrderNumberSequenceMutation(c.config, OpUpdate)
+	return &OrderNumberSequenceUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *OrderNumberSequenceClient) UpdateOne(_m *OrderNumberSequence) *OrderNumberSequenceUpdateOne {
+	mutation := newOrderNumberSequenceMutation(c.config, OpUpdateOne, withOrderNumberSequence(_m))
+	return &OrderNumberSequenceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *OrderNumberSequenceClient) UpdateOneID(id uuid.UUID) *OrderNumberSequenceUpdateOne {
+	mutation := newOrderNumberSequenceMutation(c.config, OpUpdateOne, withOrderNumberSequenceID(id))
+	return &OrderNumberSequenceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for OrderNumberSequence.
+func (c *OrderNumberSequenceClient) Delete() *OrderNumberSequenceDelete {
+	mutation := newOrderNumberSequenceMutation(c.config, OpDelete)
+	return &OrderNumberSequenceDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *OrderNumberSequenceClient) DeleteOne(_m *OrderNumberSequence) *OrderNumberSequenceDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *OrderNumberSequenceClient) DeleteOneID(id uuid.UUID) *OrderNumberSequenceDeleteOne {
+	builder := c.Delete().Where(ordernumbersequence.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &OrderNumberSequenceDeleteOne{builder}
+}
+
+// Query returns a query builder for OrderNumberSequence.
+func (c *OrderNumberSequenceClient) Query() *OrderNumberSequenceQuery {
+	return &OrderNumberSequenceQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeOrderNumberSequence},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a OrderNumberSequence entity by its id.
+func (c *OrderNumberSequenceClient) Get(ctx context.Context, id uuid.UUID) (*OrderNumberSequence, error) {
+	return c.Query().Where(ordernumbersequence.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *OrderNumberSequenceClient) GetX(ctx context.Context, id uuid.UUID) *OrderNumberSequence {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryRestaurant queries the restaurant edge of a OrderNumberSequence.
+func (c *OrderNumberSequenceClient) QueryRestaurant(_m *OrderNumberSequence) *RestaurantQuery {
+	query := (&RestaurantClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(ordernumbersequence.Table, ordernumbersequence.FieldID, id),
+			sqlgraph.To(restaurant.Table, restaurant.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, ordernumbersequence.RestaurantTable, ordernumbersequence.RestaurantColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *OrderNumberSequenceClient) Hooks() []Hook {
+	return c.hooks.OrderNumberSequence
+}
+
+// Interceptors returns the client interceptors.
+func (c *OrderNumberSequenceClient) Interceptors() []Interceptor {
+	return c.inters.OrderNumberSequence
+}
+
+func (c *OrderNumberSequenceClient) mutate(ctx context.Context, m *OrderNumberSequenceMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&OrderNumberSequenceCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&OrderNumberSequenceUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&OrderNumberSequenceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&OrderNumberSequenceDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown OrderNumberSequence mutation op: %q", m.Op())
+	}
+}
+
+// OrderStatusEventClient is a client for the OrderStatusEvent schema.
+type OrderStatusEventClient struct {
+	config
+}
+
+// NewOrderStatusEventClient returns a client for the OrderStatusEvent from the given config.
+func NewOrderStatusEventClient(c config) *OrderStatusEventClient {
+	return &OrderStatusEventClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `orderstatusevent.Hooks(f(g(h())))`.
+func (c *OrderStatusEventClient) Use(hooks ...Hook) {
+	c.hooks.OrderStatusEvent = append(c.hooks.OrderStatusEvent, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `orderstatusevent.Intercept(f(g(h())))`.
+func (c *OrderStatusEventClient) Intercept(interceptors ...Interceptor) {
+	c.inters.OrderStatusEvent = append(c.inters.OrderStatusEvent, interceptors...)
+}
+
+// Create returns a builder for creating a OrderStatusEvent entity.
+func (c *OrderStatusEventClient) Create() *OrderStatusEventCreate {
+	mutation := newOrderStatusEventMutation(c.config, OpCreate)
+	return &OrderStatusEventCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of OrderStatusEvent entities.
+func (c *OrderStatusEventClient) CreateBulk(builders ...*OrderStatusEventCreate) *OrderStatusEventCreateBulk {
+	return &OrderStatusEventCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *OrderStatusEventClient) MapCreateBulk(slice any, setFunc func(*OrderStatusEventCreate, int)) *OrderStatusEventCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &OrderStatusEventCreateBulk{err: fmt.Errorf("calling to OrderStatusEventClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*OrderStatusEventCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &OrderStatusEventCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for OrderStatusEvent.
+func (c *OrderStatusEventClient) Update() *OrderStatusEventUpdate {
+	mutation := newOrderStatusEventMutation(c.config, OpUpdate)
+	return &OrderStatusEventUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *OrderStatusEventClient) UpdateOne(_m *OrderStatusEvent) *OrderStatusEventUpdateOne {
+	mutation := newOrderStatusEventMutation(c.config, OpUpdateOne, withOrderStatusEvent(_m))
+	return &OrderStatusEventUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *OrderStatusEventClient) UpdateOneID(id uuid.UUID) *OrderStatusEventUpdateOne {
+	mutation := newOrderStatusEventMutation(c.config, OpUpdateOne, withOrderStatusEventID(id))
+	return &OrderStatusEventUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for OrderStatusEvent.
+func (c *OrderStatusEventClient) Delete() *OrderStatusEventDelete {
+	mutation := newOrderStatusEventMutation(c.config, OpDelete)
+	return &OrderStatusEventDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *OrderStatusEventClient) DeleteOne(_m *OrderStatusEvent) *OrderStatusEventDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *OrderStatusEventClient) DeleteOneID(id uuid.UUID) *OrderStatusEventDeleteOne {
+	builder := c.Delete().Where(orderstatusevent.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &OrderStatusEventDeleteOne{builder}
+}
+
+// Query returns a query builder for OrderStatusEvent.
+func (c *OrderStatusEventClient) Query() *OrderStatusEventQuery {
+	return &OrderStatusEventQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeOrderStatusEvent},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a OrderStatusEvent entity by its id.
+func (c *OrderStatusEventClient) Get(ctx context.Context, id uuid.UUID) (*OrderStatusEvent, error) {
+	return c.Query().Where(orderstatusevent.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *OrderStatusEventClient) GetX(ctx context.Context, id uuid.UUID) *OrderStatusEvent {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryOrder queries the order edge of a OrderStatusEvent.
+func (c *OrderStatusEventClient) QueryOrder(_m *OrderStatusEvent) *OrderQuery {
+	query := (&OrderClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(orderstatusevent.Table, orderstatusevent.FieldID, id),
+			sqlgraph.To(order.Table, order.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, orderstatusevent.OrderTable, orderstatusevent.OrderColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryRestaurant queries the restaurant edge of a OrderStatusEvent.
+func (c *OrderStatusEventClient) QueryRestaurant(_m *OrderStatusEvent) *RestaurantQuery {
+	query := (&RestaurantClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(orderstatusevent.Table, orderstatusevent.FieldID, id),
+			sqlgraph.To(restaurant.Table, restaurant.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, orderstatusevent.RestaurantTable, orderstatusevent.RestaurantColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryChangedBy queries the changed_by edge of a OrderStatusEvent.
+func (c *OrderStatusEventClient) QueryChangedBy(_m *OrderStatusEvent) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(orderstatusevent.Table, orderstatusevent.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, orderstatusevent.ChangedByTable, orderstatusevent.ChangedByColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *OrderStatusEventClient) Hooks() []Hook {
+	return c.hooks.OrderStatusEvent
+}
+
+// Interceptors returns the client interceptors.
+func (c *OrderStatusEventClient) Interceptors() []Interceptor {
+	return c.inters.OrderStatusEvent
+}
+
+func (c *OrderStatusEventClient) mutate(ctx context.Context, m *OrderStatusEventMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&OrderStatusEventCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&OrderStatusEventUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&OrderStatusEventUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&OrderStatusEventDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown OrderStatusEvent mutation op: %q", m.Op())
+	}
+}
+
+// PaymentClient is a client for the Payment schema.
+type PaymentClient struct {
+	config
+}
+
+// NewPaymentClient returns a client for the Payment from the given config.
+func NewPaymentClient(c config) *PaymentClient {
+	return &PaymentClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `payment.Hooks(f(g(h())))`.
+func (c *PaymentClient) Use(hooks ...Hook) {
+	c.hooks.Payment = append(c.hooks.Payment, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `payment.Intercept(f(g(h())))`.
+func (c *PaymentClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Payment = append(c.inters.Payment, interceptors...)
+}
+
+// Create returns a builder for creating a Payment entity.
+func (c *PaymentClient) Create() *PaymentCreate {
+	mutation := newPaymentMutation(c.config, OpCreate)
+	return &PaymentCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Payment entities.
+func (c *PaymentClient) CreateBulk(builders ...*PaymentCreate) *PaymentCreateBulk {
+	return &PaymentCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *PaymentClient) MapCreateBulk(slice any, setFunc func(*PaymentCreate, int)) *PaymentCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &PaymentCreateBulk{err: fmt.Errorf("calling to PaymentClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*PaymentCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &PaymentCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Payment.
+func (c *PaymentClient) Update() *PaymentUpdate {
+	mutation := newPaymentMutation(c.config, OpUpdate)
+	return &PaymentUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *PaymentClient) UpdateOne(_m *Payment) *PaymentUpdateOne {
+	mutation := newPaymentMutation(c.config, OpUpdateOne, withPayment(_m))
+	return &PaymentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *PaymentClient) UpdateOneID(id uuid.UUID) *PaymentUpdateOne {
+	mutation := newPaymentMutation(c.config, OpUpdateOne, withPaymentID(id))
+	return &PaymentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Payment.
+func (c *PaymentClient) Delete() *PaymentDelete {
+	mutation := newPaymentMutation(c.config, OpDelete)
+	return &PaymentDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *PaymentClient) DeleteOne(_m *Payment) *PaymentDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *PaymentClient) DeleteOneID(id uuid.UUID) *PaymentDeleteOne {
+	builder := c.Delete().Where(payment.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &PaymentDeleteOne{builder}
+}
+
+// Query returns a query builder for Payment.
+func (c *PaymentClient) Query() *PaymentQuery {
+	return &PaymentQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypePayment},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Payment entity by its id.
+func (c *PaymentClient) Get(ctx context.Context, id uuid.UUID) (*Payment, error) {
+	return c.Query().Where(payment.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *PaymentClient) GetX(ctx context.Context, id uuid.UUID) *Payment {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryOrder queries the order edge of a Payment.
+func (c *PaymentClient) QueryOrder(_m *Payment) *OrderQuery {
+	query := (&OrderClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(payment.Table, payment.FieldID, id),
+			sqlgraph.To(order.Table, order.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, payment.OrderTable, payment.OrderColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryRestaurant queries the restaurant edge of a Payment.
+func (c *PaymentClient) QueryRestaurant(_m *Payment) *RestaurantQuery {
+	query := (&RestaurantClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(payment.Table, payment.FieldID, id),
+			sqlgraph.To(restaurant.Table, restaurant.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, payment.RestaurantTable, payment.RestaurantColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryCreatedBy queries the created_by edge of a Payment.
+func (c *PaymentClient) QueryCreatedBy(_m *Payment) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(payment.Table, payment.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, payment.CreatedByTable, payment.CreatedByColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryRefunds queries the refunds edge of a Payment.
+func (c *PaymentClient) QueryRefunds(_m *Payment) *RefundQuery {
+	query := (&RefundClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(payment.Table, payment.FieldID, id),
+			sqlgraph.To(refund.Table, refund.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, payment.RefundsTable, payment.RefundsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *PaymentClient) Hooks() []Hook {
+	return c.hooks.Payment
+}
+
+// Interceptors returns the client interceptors.
+func (c *PaymentClient) Interceptors() []Interceptor {
+	return c.inters.Payment
+}
+
+func (c *PaymentClient) mutate(ctx context.Context, m *PaymentMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&PaymentCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&PaymentUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&PaymentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&PaymentDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Payment mutation op: %q", m.Op())
+	}
+}
+
+// RateLimitBucketClient is a client for the RateLimitBucket schema.
+type RateLimitBucketClient struct {
+	config
+}
+
+// NewRateLimitBucketClient returns a client for the RateLimitBucket from the given config.
+func NewRateLimitBucketClient(c config) *RateLimitBucketClient {
+	return &RateLimitBucketClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `ratelimitbucket.Hooks(f(g(h())))`.
+func (c *RateLimitBucketClient) Use(hooks ...Hook) {
+	c.hooks.RateLimitBucket = append(c.hooks.RateLimitBucket, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `ratelimitbucket.Intercept(f(g(h())))`.
+func (c *RateLimitBucketClient) Intercept(interceptors ...Interceptor) {
+	c.inters.RateLimitBucket = append(c.inters.RateLimitBucket, interceptors...)
+}
+
+// Create returns a builder for creating a RateLimitBucket entity.
+func (c *RateLimitBucketClient) Create() *RateLimitBucketCreate {
+	mutation := newRateLimitBucketMutation(c.config, OpCreate)
+	return &RateLimitBucketCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of RateLimitBucket entities.
+func (c *RateLimitBucketClient) CreateBulk(builders ...*RateLimitBucketCreate) *RateLimitBucketCreateBulk {
+	return &RateLimitBucketCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *RateLimitBucketClient) MapCreateBulk(slice any, setFunc func(*RateLimitBucketCreate, int)) *RateLimitBucketCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &RateLimitBucketCreateBulk{err: fmt.Errorf("calling to RateLimitBucketClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*RateLimitBucketCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &RateLimitBucketCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for RateLimitBucket.
+func (c *RateLimitBucketClient) Update() *RateLimitBucketUpdate {
+	mutation := newRateLimitBucketMutation(c.config, OpUpdate)
+	return &RateLimitBucketUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *RateLimitBucketClient) UpdateOne(_m *RateLimitBucket) *RateLimitBucketUpdateOne {
+	mutation := newRateLimitBucketMutation(c.config, OpUpdateOne, withRateLimitBucket(_m))
+	return &RateLimitBucketUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *RateLimitBucketClient) UpdateOneID(id uuid.UUID) *RateLimitBucketUpdateOne {
+	mutation := newRateLimitBucketMutation(c.config, OpUpdateOne, withRateLimitBucketID(id))
+	return &RateLimitBucketUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for RateLimitBucket.
+func (c *RateLimitBucketClient) Delete() *RateLimitBucketDelete {
+	mutation := newRateLimitBucketMutation(c.config, OpDelete)
+	return &RateLimitBucketDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *RateLimitBucketClient) DeleteOne(_m *RateLimitBucket) *RateLimitBucketDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *RateLimitBucketClient) DeleteOneID(id uuid.UUID) *RateLimitBucketDeleteOne {
+	builder := c.Delete().Where(ratelimitbucket.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &RateLimitBucketDeleteOne{builder}
+}
+
+// Query returns a query builder for RateLimitBucket.
+func (c *RateLimitBucketClient) Query() *RateLimitBucketQuery {
+	return &RateLimitBucketQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeRateLimitBucket},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a RateLimitBucket entity by its id.
+func (c *RateLimitBucketClient) Get(ctx context.Context, id uuid.UUID) (*RateLimitBucket, error) {
+	return c.Query().Where(ratelimitbucket.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *RateLimitBucketClient) GetX(ctx context.Context, id uuid.UUID) *RateLimitBucket {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *RateLimitBucketClient) Hooks() []Hook {
+	return c.hooks.RateLimitBucket
+}
+
+// Interceptors returns the client interceptors.
+func (c *RateLimitBucketClient) Interceptors() []Interceptor {
+	return c.inters.RateLimitBucket
+}
+
+func (c *RateLimitBucketClient) mutate(ctx context.Context, m *RateLimitBucketMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&RateLimitBucketCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&RateLimitBucketUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&RateLimitBucketUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&RateLimitBucketDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown RateLimitBucket mutation op: %q", m.Op())
+	}
+}
+
+// RecipeIngredientClient is a client for the RecipeIngredient schema.
+type RecipeIngredientClient struct {
+	config
+}
+
+// NewRecipeIngredientClient returns a client for the RecipeIngredient from the given config.
+func NewRecipeIngredientClient(c config) *RecipeIngredientClient {
+	return &RecipeIngredientClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `recipeingredient.Hooks(f(g(h())))`.
+func (c *RecipeIngredientClient) Use(hooks ...Hook) {
+	c.hooks.RecipeIngredient = append(c.hooks.RecipeIngredient, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `recipeingredient.Intercept(f(g(h())))`.
+func (c *RecipeIngredientClient) Intercept(interceptors ...Interceptor) {
+	c.inters.RecipeIngredient = append(c.inters.RecipeIngredient, interceptors...)
+}
+
+// Create returns a builder for creating a RecipeIngredient entity.
+func (c *RecipeIngredientClient) Create() *RecipeIngredientCreate {
+	mutation := newRecipeIngredientMutation(c.config, OpCreate)
+	return &RecipeIngredientCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of RecipeIngredient entities.
+func (c *RecipeIngredientClient) CreateBulk(builders ...*RecipeIngredientCreate) *RecipeIngredientCreateBulk {
+	return &RecipeIngredientCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *RecipeIngredientClient) MapCreateBulk(slice any, setFunc func(*RecipeIngredientCreate, int)) *RecipeIngredientCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &RecipeIngredientCreateBulk{err: fmt.Errorf("calling to RecipeIngredientClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*RecipeIngredientCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &RecipeIngredientCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for RecipeIngredient.
+func (c *RecipeIngredientClient) Update() *RecipeIngredientUpdate {
+	mutation := newRecipeIngredientMutation(c.config, OpUpdate)
+	return &RecipeIngredientUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *RecipeIngredientClient) UpdateOne(_m *RecipeIngredient) *RecipeIngredientUpdateOne {
+	mutation := newRecipeIngredientMutation(c.config, OpUpdateOne, withRecipeIngredient(_m))
+	return &RecipeIngredientUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *RecipeIngredientClient) UpdateOneID(id uuid.UUID) *RecipeIngredientUpdateOne {
+	mutation := newRecipeIngredientMutation(c.config, OpUpdateOne, withRecipeIngredientID(id))
+	return &RecipeIngredientUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for RecipeIngredient.
+func (c *RecipeIngredientClient) Delete() *RecipeIngredientDelete {
+	mutation := newRecipeIngredientMutation(c.config, OpDelete)
+	return &RecipeIngredientDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *RecipeIngredientClient) DeleteOne(_m *RecipeIngredient) *RecipeIngredientDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *RecipeIngredientClient) DeleteOneID(id uuid.UUID) *RecipeIngredientDeleteOne {
+	builder := c.Delete().Where(recipeingredient.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &RecipeIngredientDeleteOne{builder}
+}
+
+// Query returns a query builder for RecipeIngredient.
+func (c *RecipeIngredientClient) Query() *RecipeIngredientQuery {
+	return &RecipeIngredientQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeRecipeIngredient},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a RecipeIngredient entity by its id.
+func (c *RecipeIngredientClient) Get(ctx context.Context, id uuid.UUID) (*RecipeIngredient, error) {
+	return c.Query().Where(recipeingredient.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *RecipeIngredientClient) GetX(ctx context.Context, id uuid.UUID) *RecipeIngredient {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryIngredient queries the ingredient edge of a RecipeIngredient.
+func (c *RecipeIngredientClient) QueryIngredient(_m *RecipeIngredient) *IngredientQuery {
+	query := (&IngredientClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(recipeingredient.Table, recipeingredient.FieldID, id),
+			sqlgraph.To(ingredient.Table, ingredient.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, recipeingredient.IngredientTable, recipeingredient.IngredientColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryMenuItem queries the menu_item edge of a RecipeIngredient.
+func (c *RecipeIngredientClient) QueryMenuItem(_m *RecipeIngredient) *MenuItemQuery {
+	query := (&MenuItemClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(recipeingredient.Table, recipeingredient.FieldID, id),
+			sqlgraph.To(menuitem.Table, menuitem.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, recipeingredient.MenuItemTable, recipeingredient.MenuItemColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryModifierOption queries the modifier_option edge of a RecipeIngredient.
+func (c *RecipeIngredientClient) QueryModifierOption(_m *RecipeIngredient) *ModifierOptionQuery {
+	query := (&ModifierOptionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(recipeingredient.Table, recipeingredient.FieldID, id),
+			sqlgraph.To(modifieroption.Table, modifieroption.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, recipeingredient.ModifierOptionTable, recipeingredient.ModifierOptionColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *RecipeIngredientClient) Hooks() []Hook {
+	return c.hooks.RecipeIngredient
+}
+
+// Interceptors returns the client interceptors.
+func (c *RecipeIngredientClient) Interceptors() []Interceptor {
+	return c.inters.RecipeIngredient
+}
+
+func (c *RecipeIngredientClient) mutate(ctx context.Context, m *RecipeIngredientMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&RecipeIngredientCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&RecipeIngredientUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&RecipeIngredientUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&RecipeIngredientDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown RecipeIngredient mutation op: %q", m.Op())
+	}
+}
+
+// RefreshTokenClient is a client for the RefreshToken schema.
+type RefreshTokenClient struct {
+	config
+}
+
+// NewRefreshTokenClient returns a client for the RefreshToken from the given config.
+func NewRefreshTokenClient(c config) *RefreshTokenClient {
+	return &RefreshTokenClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `refreshtoken.Hooks(f(g(h())))`.
+func (c *RefreshTokenClient) Use(hooks ...Hook) {
+	c.hooks.RefreshToken = append(c.hooks.RefreshToken, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `refreshtoken.Intercept(f(g(h())))`.
+func (c *RefreshTokenClient) Intercept(interceptors ...Interceptor) {
+	c.inters.RefreshToken = append(c.inters.RefreshToken, interceptors...)
+}
+
+// Create returns a builder for creating a RefreshToken entity.
+func (c *RefreshTokenClient) Create() *RefreshTokenCreate {
+	mutation := newRefreshTokenMutation(c.config, OpCreate)
+	return &RefreshTokenCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of RefreshToken entities.
+func (c *RefreshTokenClient) CreateBulk(builders ...*RefreshTokenCreate) *RefreshTokenCreateBulk {
+	return &RefreshTokenCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *RefreshTokenClient) MapCreateBulk(slice any, setFunc func(*RefreshTokenCreate, int)) *RefreshTokenCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &RefreshTokenCreateBulk{err: fmt.Errorf("calling to RefreshTokenClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*RefreshTokenCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &RefreshTokenCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for RefreshToken.
+func (c *RefreshTokenClient) Update() *RefreshTokenUpdate {
+	mutation := newRefreshTokenMutation(c.config, OpUpdate)
+	return &RefreshTokenUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *RefreshTokenClient) UpdateOne(_m *RefreshToken) *RefreshTokenUpdateOne {
+	mutation := newRefreshTokenMutation(c.config, OpUpdateOne, withRefreshToken(_m))
+	return &RefreshTokenUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *RefreshTokenClient) UpdateOneID(id uuid.UUID) *RefreshTokenUpdateOne {
+	mutation := newRefreshTokenMutation(c.config, OpUpdateOne, withRefreshTokenID(id))
+	return &RefreshTokenUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for RefreshToken.
+func (c *RefreshTokenClient) Delete() *RefreshTokenDelete {
+	mutation := newRefreshTokenMutation(c.config, OpDelete)
+	return &RefreshTokenDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *RefreshTokenClient) DeleteOne(_m *RefreshToken) *RefreshTokenDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *RefreshTokenClient) DeleteOneID(id uuid.UUID) *RefreshTokenDeleteOne {
+	builder := c.Delete().Where(refreshtoken.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &RefreshTokenDeleteOne{builder}
+}
+
+// Query returns a query builder for RefreshToken.
+func (c *RefreshTokenClient) Query() *RefreshTokenQuery {
+	return &RefreshTokenQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeRefreshToken},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a RefreshToken entity by its id.
+func (c *RefreshTokenClient) Get(ctx context.Context, id uuid.UUID) (*RefreshToken, error) {
+	return c.Query().Where(refreshtoken.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *RefreshTokenClient) GetX(ctx context.Context, id uuid.UUID) *RefreshToken {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryUser queries the user edge of a RefreshToken.
+func (c *RefreshTokenClient) QueryUser(_m *RefreshToken) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(refreshtoken.Table, refreshtoken.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, refreshtoken.UserTable, refreshtoken.UserColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryReplacedByToken queries the replaced_by_token edge of a RefreshToken.
+func (c *RefreshTokenClient) QueryReplacedByToken(_m *RefreshToken) *RefreshTokenQuery {
+	query := (&RefreshTokenClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(refreshtoken.Table, refreshtoken.FieldID, id),
+			sqlgraph.To(refreshtoken.Table, refreshtoken.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, false, refreshtoken.ReplacedByTokenTable, refreshtoken.ReplacedByTokenColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *RefreshTokenClient) Hooks() []Hook {
+	return c.hooks.RefreshToken
+}
+
+// Interceptors returns the client interceptors.
+func (c *RefreshTokenClient) Interceptors() []Interceptor {
+	return c.inters.RefreshToken
+}
+
+func (c *RefreshTokenClient) mutate(ctx context.Context, m *RefreshTokenMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&RefreshTokenCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&RefreshTokenUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&RefreshTokenUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&RefreshTokenDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown RefreshToken mutation op: %q", m.Op())
+	}
+}
+
+// RefundClient is a client for the Refund schema.
+type RefundClient struct {
+	config
+}
+
+// NewRefundClient returns a client for the Refund from the given config.
+func NewRefundClient(c config) *RefundClient {
+	return &RefundClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `refund.Hooks(f(g(h())))`.
+func (c *RefundClient) Use(hooks ...Hook) {
+	c.hooks.Refund = append(c.hooks.Refund, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `refund.Intercept(f(g(h())))`.
+func (c *RefundClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Refund = append(c.inters.Refund, interceptors...)
+}
+
+// Create returns a builder for creating a Refund entity.
+func (c *RefundClient) Create() *RefundCreate {
+	mutation := newRefundMutation(c.config, OpCreate)
+	return &RefundCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Refund entities.
+func (c *RefundClient) CreateBulk(builders ...*RefundCreate) *RefundCreateBulk {
+	return &RefundCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *RefundClient) MapCreateBulk(slice any, setFunc func(*RefundCreate, int)) *RefundCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &RefundCreateBulk{err: fmt.Errorf("calling to RefundClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*RefundCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &RefundCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Refund.
+func (c *RefundClient) Update() *RefundUpdate {
+	mutation := newRefundMutation(c.config, OpUpdate)
+	return &RefundUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *RefundClient) UpdateOne(_m *Refund) *RefundUpdateOne {
+	mutation := newRefundMutation(c.config, OpUpdateOne, withRefund(_m))
+	return &RefundUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *RefundClient) UpdateOneID(id uuid.UUID) *RefundUpdateOne {
+	mutation := newRefundMutation(c.config, OpUpdateOne, withRefundID(id))
+	return &RefundUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Refund.
+func (c *RefundClient) Delete() *RefundDelete {
+	mutation := newRefundMutation(c.config, OpDelete)
+	return &RefundDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *RefundClient) DeleteOne(_m *Refund) *RefundDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *RefundClient) DeleteOneID(id uuid.UUID) *RefundDeleteOne {
+	builder := c.Delete().Where(refund.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &RefundDeleteOne{builder}
+}
+
+// Query returns a query builder for Refund.
+func (c *RefundClient) Query() *RefundQuery {
+	return &RefundQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeRefund},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Refund entity by its id.
+func (c *RefundClient) Get(ctx context.Context, id uuid.UUID) (*Refund, error) {
+	return c.Query().Where(refund.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *RefundClient) GetX(ctx context.Context, id uuid.UUID) *Refund {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryPayment queries the payment edge of a Refund.
+func (c *RefundClient) QueryPayment(_m *Refund) *PaymentQuery {
+	query := (&PaymentClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(refund.Table, refund.FieldID, id),
+			sqlgraph.To(payment.Table, payment.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, refund.PaymentTable, refund.PaymentColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryOrder queries the order edge of a Refund.
+func (c *RefundClient) QueryOrder(_m *Refund) *OrderQuery {
+	query := (&OrderClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(refund.Table, refund.FieldID, id),
+			sqlgraph.To(order.Table, order.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, refund.OrderTable, refund.OrderColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryRestaurant queries the restaurant edge of a Refund.
+func (c *RefundClient) QueryRestaurant(_m *Refund) *RestaurantQuery {
+	query := (&RestaurantClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(refund.Table, refund.FieldID, id),
+			sqlgraph.To(restaurant.Table, restaurant.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, refund.RestaurantTable, refund.RestaurantColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryCreatedBy queries the created_by edge of a Refund.
+func (c *RefundClient) QueryCreatedBy(_m *Refund) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(refund.Table, refund.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, refund.CreatedByTable, refund.CreatedByColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *RefundClient) Hooks() []Hook {
+	return c.hooks.Refund
+}
+
+// Interceptors returns the client interceptors.
+func (c *RefundClient) Interceptors() []Interceptor {
+	return c.inters.Refund
+}
+
+func (c *RefundClient) mutate(ctx context.Context, m *RefundMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&RefundCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&RefundUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&RefundUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&RefundDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Refund mutation op: %q", m.Op())
+	}
+}
+
+// RestaurantClient is a client for the Restaurant schema.
+type RestaurantClient struct {
+	config
+}
+
+// NewRestaurantClient returns a client for the Restaurant from the given config.
+func NewRestaurantClient(c config) *RestaurantClient {
+	return &RestaurantClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `restaurant.Hooks(f(g(h())))`.
+func (c *RestaurantClient) Use(hooks ...Hook) {
+	c.hooks.Restaurant = append(c.hooks.Restaurant, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `restaurant.Intercept(f(g(h())))`.
+func (c *RestaurantClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Restaurant = append(c.inters.Restaurant, interceptors...)
+}
+
+// Create returns a builder for creating a Restaurant entity.
+func (c *RestaurantClient) Create() *RestaurantCreate {
+	mutation := newRestaurantMutation(c.config, OpCreate)
+	return &RestaurantCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Restaurant entities.
+func (c *RestaurantClient) CreateBulk(builders ...*RestaurantCreate) *RestaurantCreateBulk {
+	return &RestaurantCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *RestaurantClient) MapCreateBulk(slice any, setFunc func(*RestaurantCreate, int)) *RestaurantCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &RestaurantCreateBulk{err: fmt.Errorf("calling to RestaurantClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*RestaurantCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &RestaurantCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Restaurant.
+func (c *RestaurantClient) Update() *RestaurantUpdate {
+	mutation := newRestaurantMutation(c.config, OpUpdate)
+	return &RestaurantUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *RestaurantClient) UpdateOne(_m *Restaurant) *RestaurantUpdateOne {
+	mutation := newRestaurantMutation(c.config, OpUpdateOne, withRestaurant(_m))
+	return &RestaurantUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *RestaurantClient) UpdateOneID(id uuid.UUID) *RestaurantUpdateOne {
+	mutation := newRestaurantMutation(c.config, OpUpdateOne, withRestaurantID(id))
+	return &RestaurantUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Restaurant.
+func (c *RestaurantClient) Delete() *RestaurantDelete {
+	mutation := newRestaurantMutation(c.config, OpDelete)
+	return &RestaurantDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *RestaurantClient) DeleteOne(_m *Restaurant) *RestaurantDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *RestaurantClient) DeleteOneID(id uuid.UUID) *RestaurantDeleteOne {
+	builder := c.Delete().Where(restaurant.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &RestaurantDeleteOne{builder}
+}
+
+// Query returns a query builder for Restaurant.
+func (c *RestaurantClient) Query() *RestaurantQuery {
+	return &RestaurantQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeRestaurant},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Restaurant entity by its id.
+func (c *RestaurantClient) Get(ctx context.Context, id uuid.UUID) (*Restaurant, error) {
+	return c.Query().Where(restaurant.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *RestaurantClient) GetX(ctx context.Context, id uuid.UUID) *Restaurant {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryUser queries the user edge of a Restaurant.
+func (c *RestaurantClient) QueryUser(_m *Restaurant) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(restaurant.Table, restaurant.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, restaurant.UserTable, restaurant.UserColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryMenuItems queries the menu_items edge of a Restaurant.
+func (c *RestaurantClient) QueryMenuItems(_m *Restaurant) *MenuItemQuery {
+	query := (&MenuItemClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(restaurant.Table, restaurant.FieldID, id),
+			sqlgraph.To(menuitem.Table, menuitem.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, restaurant.MenuItemsTable, restaurant.MenuItemsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryCategories queries the categories edge of a Restaurant.
+func (c *RestaurantClient) QueryCategories(_m *Restaurant) *CategoryQuery {
+	query := (&CategoryClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(restaurant.Table, restaurant.FieldID, id),
+			sqlgraph.To(category.Table, category.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, restaurant.CategoriesTable, restaurant.CategoriesColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryModifiers queries the modifiers edge of a Restaurant.
+func (c *RestaurantClient) QueryModifiers(_m *Restaurant) *ModifierQuery {
+	query := (&ModifierClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(restaurant.Table, restaurant.FieldID, id),
+			sqlgraph.To(modifier.Table, modifier.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, restaurant.ModifiersTable, restaurant.ModifiersColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryOrders queries the orders edge of a Restaurant.
+func (c *RestaurantClient) QueryOrders(_m *Restaurant) *OrderQuery {
+	query := (&OrderClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(restaurant.Table, restaurant.FieldID, id),
+			sqlgraph.To(order.Table, order.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, restaurant.OrdersTable, restaurant.OrdersColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryOrderStatusEvents queries the order_status_events edge of a Restaurant.
+func (c *RestaurantClient) QueryOrderStatusEvents(_m *Restaurant) *OrderStatusEventQuery {
+	query := (&OrderStatusEventClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(restaurant.Table, restaurant.FieldID, id),
+			sqlgraph.To(orderstatusevent.Table, orderstatusevent.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, restaurant.OrderStatusEventsTable, restaurant.OrderStatusEventsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryPayments queries the payments edge of a Restaurant.
+func (c *RestaurantClient) QueryPayments(_m *Restaurant) *PaymentQuery {
+	query := (&PaymentClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(restaurant.Table, restaurant.FieldID, id),
+			sqlgraph.To(payment.Table, payment.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, restaurant.PaymentsTable, restaurant.PaymentsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryRefunds queries the refunds edge of a Restaurant.
+func (c *RestaurantClient) QueryRefunds(_m *Restaurant) *RefundQuery {
+	query := (&RefundClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(restaurant.Table, restaurant.FieldID, id),
+			sqlgraph.To(refund.Table, refund.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, restaurant.RefundsTable, restaurant.RefundsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryOrderNumberSequences queries the order_number_sequences edge of a Restaurant.
+func (c *RestaurantClient) QueryOrderNumberSequences(_m *Restaurant) *OrderNumberSequenceQuery {
+	query := (&OrderNumberSequenceClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(restaurant.Table, restaurant.FieldID, id),
+			sqlgraph.To(ordernumbersequence.Table, ordernumbersequence.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, restaurant.OrderNumberSequencesTable, restaurant.OrderNumberSequencesColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryOrderEvents queries the order_events edge of a Restaurant.
+func (c *RestaurantClient) QueryOrderEvents(_m *Restaurant) *OrderEventQuery {
+	query := (&OrderEventClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(restaurant.Table, restaurant.FieldID, id),
+			sqlgraph.To(orderevent.Table, orderevent.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, restaurant.OrderEventsTable, restaurant.OrderEventsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryStations queries the stations edge of a Restaurant.
+func (c *RestaurantClient) QueryStations(_m *Restaurant) *StationQuery {
+	query := (&StationClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(restaurant.Table, restaurant.FieldID, id),
+			sqlgraph.To(station.Table, station.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, restaurant.StationsTable, restaurant.StationsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryStationTickets queries the station_tickets edge of a Restaurant.
+func (c *RestaurantClient) QueryStationTickets(_m *Restaurant) *StationTicketQuery {
+	query := (&StationTicketClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(restaurant.Table, restaurant.FieldID, id),
+			sqlgraph.To(stationticket.Table, stationticket.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, restaurant.StationTicketsTable, restaurant.StationTicketsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryTables queries the tables edge of a Restaurant.
+func (c *RestaurantClient) QueryTables(_m *Restaurant) *TableQuery {
+	query := (&TableClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(restaurant.Table, restaurant.FieldID, id),
+			sqlgraph.To(table.Table, table.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, restaurant.TablesTable, restaurant.TablesColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryTableSessions queries the table_sessions edge of a Restaurant.
+func (c *RestaurantClient) QueryTableSessions(_m *Restaurant) *TableSessionQuery {
+	query := (&TableSessionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(restaurant.Table, restaurant.FieldID, id),
+			sqlgraph.To(tablesession.Table, tablesession.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, restaurant.TableSessionsTable, restaurant.TableSessionsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryDeliveryZones queries the delivery_zones edge of a Restaurant.
+func (c *RestaurantClient) QueryDeliveryZones(_m *Restaurant) *DeliveryZoneQuery {
+	query := (&DeliveryZoneClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(restaurant.Table, restaurant.FieldID, id),
+			sqlgraph.To(deliveryzone.Table, deliveryzone.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, restaurant.DeliveryZonesTable, restaurant.DeliveryZonesColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryIngredients queries the ingredients edge of a Restaurant.
+func (c *RestaurantClient) QueryIngredients(_m *Restaurant) *IngredientQuery {
+	query := (&IngredientClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(restaurant.Table, restaurant.FieldID, id),
+			sqlgraph.To(ingredient.Table, ingredient.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, restaurant.IngredientsTable, restaurant.IngredientsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryMenus queries the menus edge of a Restaurant.
+func (c *RestaurantClient) QueryMenus(_m *Restaurant) *MenuQuery {
+	query := (&MenuClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(restaurant.Table, restaurant.FieldID, id),
+			sqlgraph.To(menu.Table, menu.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, restaurant.MenusTable, restaurant.MenusColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *RestaurantClient) Hooks() []Hook {
+	return c.hooks.Restaurant
+}
+
+// Interceptors returns the client interceptors.
+func (c *RestaurantClient) Interceptors() []Interceptor {
+	return c.inters.Restaurant
+}
+
+func (c *RestaurantClient) mutate(ctx context.Context, m *RestaurantMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&ModifierOptionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&RestaurantCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&ModifierOptionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&RestaurantUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&ModifierOptionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&RestaurantUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&ModifierOptionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&RestaurantDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown ModifierOption mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown Restaurant mutation op: %q", m.Op())
 	}
 }
 
-// OrderClient is a client for the Order schema.
-type OrderClient struct {
+// StationClient is a client for the Station schema.
+type StationClient struct {
 	config
 }
 
-// NewOrderClient returns a client for the Order from the given config.
-func NewOrderClient(c config) *OrderClient {
-	return &OrderClient{config: c}
+// NewStationClient returns a client for the Station from the given config.
+func NewStationClient(c config) *StationClient {
+	return &StationClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `order.Hooks(f(g(h())))`.
-func (c *OrderClient) Use(hooks ...Hook) {
-	c.hooks.Order = append(c.hooks.Order, hooks...)
+// A call to `Use(f, g, h)` equals to `station.Hooks(f(g(h())))`.
+func (c *StationClient) Use(hooks ...Hook) {
+	c.hooks.Station = append(c.hooks.Station, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `order.Intercept(f(g(h())))`.
-func (c *OrderClient) Intercept(interceptors ...Interceptor) {
-	c.inters.Order = append(c.inters.Order, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `station.Intercept(f(g(h())))`.
+func (c *StationClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Station = append(c.inters.Station, interceptors...)
 }
 
-// Create returns a builder for creating a Order entity.
-func (c *OrderClient) Create() *OrderCreate {
-	mutation := newOrderMutation(c.config, OpCreate)
-	return &OrderCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a Station entity.
+func (c *StationClient) Create() *StationCreate {
+	mutation := newStationMutation(c.config, OpCreate)
+	return &StationCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of Order entities.
-func (c *OrderClient) CreateBulk(builders ...*OrderCreate) *OrderCreateBulk {
-	return &OrderCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of Station entities.
+func (c *StationClient) CreateBulk(builders ...*StationCreate) *StationCreateBulk {
+	return &StationCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *OrderClient) MapCreateBulk(slice any, setFunc func(*OrderCreate, int)) *OrderCreateBulk {
+func (c *StationClient) MapCreateBulk(slice any, setFunc func(*StationCreate, int)) *StationCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &OrderCreateBulk{err: fmt.Errorf("calling to OrderClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &StationCreateBulk{err: fmt.Errorf("calling to StationClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*OrderCreate, rv.Len())
+	builders := make([]*StationCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &OrderCreateBulk{config: c.config, builders: builders}
+	return &StationCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for Order.
-func (c *OrderClient) Update() *OrderUpdate {
-	mutation := newOrderMutation(c.config, OpUpdate)
-	return &OrderUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for Station.
+func (c *StationClient) Update() *StationUpdate {
+	mutation := newStationMutation(c.config, OpUpdate)
+	return &StationUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *OrderClient) UpdateOne(_m *Order) *OrderUpdateOne {
-	mutation := newOrderMutation(c.config, OpUpdateOne, withOrder(_m))
-	return &OrderUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *StationClient) UpdateOne(_m *Station) *StationUpdateOne {
+	mutation := newStationMutation(c.config, OpUpdateOne, withStation(_m))
+	return &StationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *OrderClient) UpdateOneID(id uuid.UUID) *OrderUpdateOne {
-	mutation := newOrderMutation(c.config, OpUpdateOne, withOrderID(id))
-	return &OrderUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *StationClient) UpdateOneID(id uuid.UUID) *StationUpdateOne {
+	mutation := newStationMutation(c.config, OpUpdateOne, withStationID(id))
+	return &StationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for Order.
-func (c *OrderClient) Delete() *OrderDelete {
-	mutation := newOrderMutation(c.config, OpDelete)
-	return &OrderDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for Station.
+func (c *StationClient) Delete() *StationDelete {
+	mutation := newStationMutation(c.config, OpDelete)
+	return &StationDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *OrderClient) DeleteOne(_m *Order) *OrderDeleteOne {
+func (c *StationClient) DeleteOne(_m *Station) *StationDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *OrderClient) DeleteOneID(id uuid.UUID) *OrderDeleteOne {
-	builder := c.Delete().Where(order.ID(id))
+func (c *StationClient) DeleteOneID(id uuid.UUID) *StationDeleteOne {
+	builder := c.Delete().Where(station.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &OrderDeleteOne{builder}
+	return &StationDeleteOne{builder}
 }
 
-// Query returns a query builder for Order.
-func (c *OrderClient) Query() *OrderQuery {
-	return &OrderQuery{
+// Query returns a query builder for Station.
+func (c *StationClient) Query() *StationQuery {
+	return &StationQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeOrder},
+		ctx:    &QueryContext{Type: TypeStation},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a Order entity by its id.
-func (c *OrderClient) Get(ctx context.Context, id uuid.UUID) (*Order, error) {
-	return c.Query().Where(order.ID(id)).Only(ctx)
+// Get returns a Station entity by its id.
+func (c *StationClient) Get(ctx context.Context, id uuid.UUID) (*Station, error) {
+	return c.Query().Where(station.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *OrderClient) GetX(ctx context.Context, id uuid.UUID) *Order {
+func (c *StationClient) GetX(ctx context.Context, id uuid.UUID) *Station {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -1106,15 +5262,15 @@ func (c *OrderClient) GetX(ctx context.Context, id uuid.UUID) *Order {
 	return obj
 }
 
-// QueryRestaurant queries the restaurant edge of a Order.
-func (c *OrderClient) QueryRestaurant(_m *Order) *RestaurantQuery {
+// QueryRestaurant queries the restaurant edge of a Station.
+func (c *StationClient) QueryRestaurant(_m *Station) *RestaurantQuery {
 	query := (&RestaurantClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(order.Table, order.FieldID, id),
+			sqlgraph.From(station.Table, station.FieldID, id),
 			sqlgraph.To(restaurant.Table, restaurant.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, order.RestaurantTable, order.RestaurantColumn),
+			sqlgraph.Edge(sqlgraph.M2O, true, station.RestaurantTable, station.RestaurantColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -1122,15 +5278,47 @@ func (c *OrderClient) QueryRestaurant(_m *Order) *RestaurantQuery {
 	return query
 }
 
-// QueryOrderItems queries the order_items edge of a Order.
-func (c *OrderClient) QueryOrderItems(_m *Order) *OrderItemQuery {
-	query := (&OrderItemClient{config: c.config}).Query()
+// QueryMenuItems queries the menu_items edge of a Station.
+func (c *StationClient) QueryMenuItems(_m *Station) *MenuItemQuery {
+	query := (&MenuItemClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(order.Table, order.FieldID, id),
-			sqlgraph.To(orderitem.Table, orderitem.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, order.OrderItemsTable, order.OrderItemsColumn),
+			sqlgraph.From(station.Table, station.FieldID, id),
+			sqlgraph.To(menuitem.Table, menuitem.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, station.MenuItemsTable, station.MenuItemsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryCategories queries the categories edge of a Station.
+func (c *StationClient) QueryCategories(_m *Station) *CategoryQuery {
+	query := (&CategoryClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(station.Table, station.FieldID, id),
+			sqlgraph.To(category.Table, category.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, station.CategoriesTable, station.CategoriesColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryTickets queries the tickets edge of a Station.
+func (c *StationClient) QueryTickets(_m *Station) *StationTicketQuery {
+	query := (&StationTicketClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(station.Table, station.FieldID, id),
+			sqlgraph.To(stationticket.Table, stationticket.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, station.TicketsTable, station.TicketsColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -1139,131 +5327,131 @@ func (c *OrderClient) QueryOrderItems(_m *Order) *OrderItemQuery {
 }
 
 // Hooks returns the client hooks.
-func (c *OrderClient) Hooks() []Hook {
-	return c.hooks.Order
+func (c *StationClient) Hooks() []Hook {
+	return c.hooks.Station
 }
 
 // Interceptors returns the client interceptors.
-func (c *OrderClient) Interceptors() []Interceptor {
-	return c.inters.Order
+func (c *StationClient) Interceptors() []Interceptor {
+	return c.inters.Station
 }
 
-func (c *OrderClient) mutate(ctx context.Context, m *OrderMutation) (Value, error) {
+func (c *StationClient) mutate(ctx context.Context, m *StationMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&OrderCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&StationCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&OrderUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&StationUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&OrderUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&StationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&OrderDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&StationDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown Order mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown Station mutation op: %q", m.Op())
 	}
 }
 
-// OrderItemClient is a client for the OrderItem schema.
-type OrderItemClient struct {
+// StationTicketClient is a client for the StationTicket schema.
+type StationTicketClient struct {
 	config
 }
 
-// NewOrderItemClient returns a client for the OrderItem from the given config.
-func NewOrderItemClient(c config) *OrderItemClient {
-	return &OrderItemClient{config: c}
+// NewStationTicketClient returns a client for the StationTicket from the given config.
+func NewStationTicketClient(c config) *StationTicketClient {
+	return &StationTicketClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `orderitem.Hooks(f(g(h())))`.
-func (c *OrderItemClient) Use(hooks ...Hook) {
-	c.hooks.OrderItem = append(c.hooks.OrderItem, hooks...)
+// A call to `Use(f, g, h)` equals to `stationticket.Hooks(f(g(h())))`.
+func (c *StationTicketClient) Use(hooks ...Hook) {
+	c.hooks.StationTicket = append(c.hooks.StationTicket, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `orderitem.Intercept(f(g(h())))`.
-func (c *OrderItemClient) Intercept(interceptors ...Interceptor) {
-	c.inters.OrderItem = append(c.inters.OrderItem, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `stationticket.Intercept(f(g(h())))`.
+func (c *StationTicketClient) Intercept(interceptors ...Interceptor) {
+	c.inters.StationTicket = append(c.inters.StationTicket, interceptors...)
 }
 
-// Create returns a builder for creating a OrderItem entity.
-func (c *OrderItemClient) Create() *OrderItemCreate {
-	mutation := newOrderItemMutation(c.config, OpCreate)
-	return &OrderItemCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a StationTicket entity.
+func (c *StationTicketClient) Create() *StationTicketCreate {
+	mutation := newStationTicketMutation(c.config, OpCreate)
+	return &StationTicketCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of OrderItem entities.
-func (c *OrderItemClient) CreateBulk(builders ...*OrderItemCreate) *OrderItemCreateBulk {
-	return &OrderItemCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of StationTicket entities.
+func (c *StationTicketClient) CreateBulk(builders ...*StationTicketCreate) *StationTicketCreateBulk {
+	return &StationTicketCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *OrderItemClient) MapCreateBulk(slice any, setFunc func(*OrderItemCreate, int)) *OrderItemCreateBulk {
+func (c *StationTicketClient) MapCreateBulk(slice any, setFunc func(*StationTicketCreate, int)) *StationTicketCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &OrderItemCreateBulk{err: fmt.Errorf("calling to OrderItemClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &StationTicketCreateBulk{err: fmt.Errorf("calling to StationTicketClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*OrderItemCreate, rv.Len())
+	builders := make([]*StationTicketCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &OrderItemCreateBulk{config: c.config, builders: builders}
+	return &StationTicketCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for OrderItem.
-func (c *OrderItemClient) Update() *OrderItemUpdate {
-	mutation := newOrderItemMutation(c.config, OpUpdate)
-	return &OrderItemUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for StationTicket.
+func (c *StationTicketClient) Update() *StationTicketUpdate {
+	mutation := newStationTicketMutation(c.config, OpUpdate)
+	return &StationTicketUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *OrderItemClient) UpdateOne(_m *OrderItem) *OrderItemUpdateOne {
-	mutation := newOrderItemMutation(c.config, OpUpdateOne, withOrderItem(_m))
-	return &OrderItemUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *StationTicketClient) UpdateOne(_m *StationTicket) *StationTicketUpdateOne {
+	mutation := newStationTicketMutation(c.config, OpUpdateOne, withStationTicket(_m))
+	return &StationTicketUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *OrderItemClient) UpdateOneID(id uuid.UUID) *OrderItemUpdateOne {
-	mutation := newOrderItemMutation(c.config, OpUpdateOne, withOrderItemID(id))
-	return &OrderItemUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *StationTicketClient) UpdateOneID(id uuid.UUID) *StationTicketUpdateOne {
+	mutation := newStationTicketMutation(c.config, OpUpdateOne, withStationTicketID(id))
+	return &StationTicketUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for OrderItem.
-func (c *OrderItemClient) Delete() *OrderItemDelete {
-	mutation := newOrderItemMutation(c.config, OpDelete)
-	return &OrderItemDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for StationTicket.
+func (c *StationTicketClient) Delete() *StationTicketDelete {
+	mutation := newStationTicketMutation(c.config, OpDelete)
+	return &StationTicketDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *OrderItemClient) DeleteOne(_m *OrderItem) *OrderItemDeleteOne {
+func (c *StationTicketClient) DeleteOne(_m *StationTicket) *StationTicketDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *OrderItemClient) DeleteOneID(id uuid.UUID) *OrderItemDeleteOne {
-	builder := c.Delete().Where(orderitem.ID(id))
+func (c *StationTicketClient) DeleteOneID(id uuid.UUID) *StationTicketDeleteOne {
+	builder := c.Delete().Where(stationticket.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &OrderItemDeleteOne{builder}
+	return &StationTicketDeleteOne{builder}
 }
 
-// Query returns a query builder for OrderItem.
-func (c *OrderItemClient) Query() *OrderItemQuery {
-	return &OrderItemQuery{
+// Query returns a query builder for StationTicket.
+func (c *StationTicketClient) Query() *StationTicketQuery {
+	return &StationTicketQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeOrderItem},
+		ctx:    &QueryContext{Type: TypeStationTicket},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a OrderItem entity by its id.
-func (c *OrderItemClient) Get(ctx context.Context, id uuid.UUID) (*OrderItem, error) {
-	return c.Query().Where(orderitem.ID(id)).Only(ctx)
+// Get returns a StationTicket entity by its id.
+func (c *StationTicketClient) Get(ctx context.Context, id uuid.UUID) (*StationTicket, error) {
+	return c.Query().Where(stationticket.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *OrderItemClient) GetX(ctx context.Context, id uuid.UUID) *OrderItem {
+func (c *StationTicketClient) GetX(ctx context.Context, id uuid.UUID) *StationTicket {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -1271,15 +5459,15 @@ func (c *OrderItemClient) GetX(ctx context.Context, id uuid.UUID) *OrderItem {
 	return obj
 }
 
-// QueryOrder queries the order edge of a OrderItem.
-func (c *OrderItemClient) QueryOrder(_m *OrderItem) *OrderQuery {
+// QueryOrder queries the order edge of a StationTicket.
+func (c *StationTicketClient) QueryOrder(_m *StationTicket) *OrderQuery {
 	query := (&OrderClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(orderitem.Table, orderitem.FieldID, id),
+			sqlgraph.From(stationticket.Table, stationticket.FieldID, id),
 			sqlgraph.To(order.Table, order.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, orderitem.OrderTable, orderitem.OrderColumn),
+			sqlgraph.Edge(sqlgraph.M2O, true, stationticket.OrderTable, stationticket.OrderColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -1287,15 +5475,15 @@ func (c *OrderItemClient) QueryOrder(_m *OrderItem) *OrderQuery {
 	return query
 }
 
-// QueryMenuItem queries the menu_item edge of a OrderItem.
-func (c *OrderItemClient) QueryMenuItem(_m *OrderItem) *MenuItemQuery {
-	query := (&MenuItemClient{config: c.config}).Query()
+// QueryStation queries the station edge of a StationTicket.
+func (c *StationTicketClient) QueryStation(_m *StationTicket) *StationQuery {
+	query := (&StationClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(orderitem.Table, orderitem.FieldID, id),
-			sqlgraph.To(menuitem.Table, menuitem.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, orderitem.MenuItemTable, orderitem.MenuItemColumn),
+			sqlgraph.From(stationticket.Table, stationticket.FieldID, id),
+			sqlgraph.To(station.Table, station.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, stationticket.StationTable, stationticket.StationColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -1303,15 +5491,31 @@ func (c *OrderItemClient) QueryMenuItem(_m *OrderItem) *MenuItemQuery {
 	return query
 }
 
-// QueryOrderItemModifierOptions queries the order_item_modifier_options edge of a OrderItem.
-func (c *OrderItemClient) QueryOrderItemModifierOptions(_m *OrderItem) *OrderItemModifierOptionQuery {
-	query := (&OrderItemModifierOptionClient{config: c.config}).Query()
+// QueryRestaurant queries the restaurant edge of a StationTicket.
+func (c *StationTicketClient) QueryRestaurant(_m *StationTicket) *RestaurantQuery {
+	query := (&RestaurantClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(orderitem.Table, orderitem.FieldID, id),
-			sqlgraph.To(orderitemmodifieroption.Table, orderitemmodifieroption.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, orderitem.OrderItemModifierOptionsTable, orderitem.OrderItemModifierOptionsColumn),
+			sqlgraph.From(stationticket.Table, stationticket.FieldID, id),
+			sqlgraph.To(restaurant.Table, restaurant.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, stationticket.RestaurantTable, stationticket.RestaurantColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryOrderItems queries the order_items edge of a StationTicket.
+func (c *StationTicketClient) QueryOrderItems(_m *StationTicket) *OrderItemQuery {
+	query := (&OrderItemClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(stationticket.Table, stationticket.FieldID, id),
+			sqlgraph.To(orderitem.Table, orderitem.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, stationticket.OrderItemsTable, stationticket.OrderItemsColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -1320,131 +5524,131 @@ func (c *OrderItemClient) QueryOrderItemModifierOptions(_m *OrderItem) *OrderIte
 }
 
 // Hooks returns the client hooks.
-func (c *OrderItemClient) Hooks() []Hook {
-	return c.hooks.OrderItem
+func (c *StationTicketClient) Hooks() []Hook {
+	return c.hooks.StationTicket
 }
 
 // Interceptors returns the client interceptors.
-func (c *OrderItemClient) Interceptors() []Interceptor {
-	return c.inters.OrderItem
+func (c *StationTicketClient) Interceptors() []Interceptor {
+	return c.inters.StationTicket
 }
 
-func (c *OrderItemClient) mutate(ctx context.Context, m *OrderItemMutation) (Value, error) {
+func (c *StationTicketClient) mutate(ctx context.Context, m *StationTicketMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&OrderItemCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&StationTicketCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&OrderItemUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&StationTicketUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&OrderItemUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&StationTicketUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&OrderItemDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&StationTicketDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown OrderItem mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown StationTicket mutation op: %q", m.Op())
 	}
 }
 
-// OrderItemModifierOptionClient is a client for the OrderItemModifierOption schema.
-type OrderItemModifierOptionClient struct {
+// StockMovementClient is a client for the StockMovement schema.
+type StockMovementClient struct {
 	config
 }
 
-// NewOrderItemModifierOptionClient returns a client for the OrderItemModifierOption from the given config.
-func NewOrderItemModifierOptionClient(c config) *OrderItemModifierOptionClient {
-	return &OrderItemModifierOptionClient{config: c}
+// NewStockMovementClient returns a client for the StockMovement from the given config.
+func NewStockMovementClient(c config) *StockMovementClient {
+	return &StockMovementClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `orderitemmodifieroption.Hooks(f(g(h())))`.
-func (c *OrderItemModifierOptionClient) Use(hooks ...Hook) {
-	c.hooks.OrderItemModifierOption = append(c.hooks.OrderItemModifierOption, hooks...)
+// A call to `Use(f, g, h)` equals to `stockmovement.Hooks(f(g(h())))`.
+func (c *StockMovementClient) Use(hooks ...Hook) {
+	c.hooks.StockMovement = append(c.hooks.StockMovement, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `orderitemmodifieroption.Intercept(f(g(h())))`.
-func (c *OrderItemModifierOptionClient) Intercept(interceptors ...Interceptor) {
-	c.inters.OrderItemModifierOption = append(c.inters.OrderItemModifierOption, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `stockmovement.Intercept(f(g(h())))`.
+func (c *StockMovementClient) Intercept(interceptors ...Interceptor) {
+	c.inters.StockMovement = append(c.inters.StockMovement, interceptors...)
 }
 
-// Create returns a builder for creating a OrderItemModifierOption entity.
-func (c *OrderItemModifierOptionClient) Create() *OrderItemModifierOptionCreate {
-	mutation := newOrderItemModifierOptionMutation(c.config, OpCreate)
-	return &OrderItemModifierOptionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a StockMovement entity.
+func (c *StockMovementClient) Create() *StockMovementCreate {
+	mutation := newStockMovementMutation(c.config, OpCreate)
+	return &StockMovementCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of OrderItemModifierOption entities.
-func (c *OrderItemModifierOptionClient) CreateBulk(builders ...*OrderItemModifierOptionCreate) *OrderItemModifierOptionCreateBulk {
-	return &OrderItemModifierOptionCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of StockMovement entities.
+func (c *StockMovementClient) CreateBulk(builders ...*StockMovementCreate) *StockMovementCreateBulk {
+	return &StockMovementCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *OrderItemModifierOptionClient) MapCreateBulk(slice any, setFunc func(*OrderItemModifierOptionCreate, int)) *OrderItemModifierOptionCreateBulk {
+func (c *StockMovementClient) MapCreateBulk(slice any, setFunc func(*StockMovementCreate, int)) *StockMovementCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &OrderItemModifierOptionCreateBulk{err: fmt.Errorf("calling to OrderItemModifierOptionClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &StockMovementCreateBulk{err: fmt.Errorf("calling to StockMovementClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*OrderItemModifierOptionCreate, rv.Len())
+	builders := make([]*StockMovementCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &OrderItemModifierOptionCreateBulk{config: c.config, builders: builders}
+	return &StockMovementCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for OrderItemModifierOption.
-func (c *OrderItemModifierOptionClient) Update() *OrderItemModifierOptionUpdate {
-	mutation := newOrderItemModifierOptionMutation(c.config, OpUpdate)
-	return &OrderItemModifierOptionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for StockMovement.
+func (c *StockMovementClient) Update() *StockMovementUpdate {
+	mutation := newStockMovementMutation(c.config, OpUpdate)
+	return &StockMovementUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *OrderItemModifierOptionClient) UpdateOne(_m *OrderItemModifierOption) *OrderItemModifierOptionUpdateOne {
-	mutation := newOrderItemModifierOptionMutation(c.config, OpUpdateOne, withOrderItemModifierOption(_m))
-	return &OrderItemModifierOptionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *StockMovementClient) UpdateOne(_m *StockMovement) *StockMovementUpdateOne {
+	mutation := newStockMovementMutation(c.config, OpUpdateOne, withStockMovement(_m))
+	return &StockMovementUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *OrderItemModifierOptionClient) UpdateOneID(id int) *OrderItemModifierOptionUpdateOne {
-	mutation := newOrderItemModifierOptionMutation(c.config, OpUpdateOne, withOrderItemModifierOptionID(id))
-	return &OrderItemModifierOptionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *StockMovementClient) UpdateOneID(id uuid.UUID) *StockMovementUpdateOne {
+	mutation := newStockMovementMutation(c.config, OpUpdateOne, withStockMovementID(id))
+	return &StockMovementUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for OrderItemModifierOption.
-func (c *OrderItemModifierOptionClient) Delete() *OrderItemModifierOptionDelete {
-	mutation := newOrderItemModifierOptionMutation(c.config, OpDelete)
-	return &OrderItemModifierOptionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for StockMovement.
+func (c *StockMovementClient) Delete() *StockMovementDelete {
+	mutation := newStockMovementMutation(c.config, OpDelete)
+	return &StockMovementDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *OrderItemModifierOptionClient) DeleteOne(_m *OrderItemModifierOption) *OrderItemModifierOptionDeleteOne {
+func (c *StockMovementClient) DeleteOne(_m *StockMovement) *StockMovementDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *OrderItemModifierOptionClient) DeleteOneID(id int) *OrderItemModifierOptionDeleteOne {
-	builder := c.Delete().Where(orderitemmodifieroption.ID(id))
+func (c *StockMovementClient) DeleteOneID(id uuid.UUID) *StockMovementDeleteOne {
+	builder := c.Delete().Where(stockmovement.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &OrderItemModifierOptionDeleteOne{builder}
+	return &StockMovementDeleteOne{builder}
 }
 
-// Query returns a query builder for OrderItemModifierOption.
-func (c *OrderItemModifierOptionClient) Query() *OrderItemModifierOptionQuery {
-	return &OrderItemModifierOptionQuery{
+// Query returns a query builder for StockMovement.
+func (c *StockMovementClient) Query() *StockMovementQuery {
+	return &StockMovementQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeOrderItemModifierOption},
+		ctx:    &QueryContext{Type: TypeStockMovement},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a OrderItemModifierOption entity by its id.
-func (c *OrderItemModifierOptionClient) Get(ctx context.Context, id int) (*OrderItemModifierOption, error) {
-	return c.Query().Where(orderitemmodifieroption.ID(id)).Only(ctx)
+// Get returns a StockMovement entity by its id.
+func (c *StockMovementClient) Get(ctx context.Context, id uuid.UUID) (*StockMovement, error) {
+	return c.Query().Where(stockmovement.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *OrderItemModifierOptionClient) GetX(ctx context.Context, id int) *OrderItemModifierOption {
+func (c *StockMovementClient) GetX(ctx context.Context, id uuid.UUID) *StockMovement {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -1452,15 +5656,15 @@ func (c *OrderItemModifierOptionClient) GetX(ctx context.Context, id int) *Order
 	return obj
 }
 
-// QueryOrderItem queries the order_item edge of a OrderItemModifierOption.
-func (c *OrderItemModifierOptionClient) QueryOrderItem(_m *OrderItemModifierOption) *OrderItemQuery {
-	query := (&OrderItemClient{config: c.config}).Query()
+// QueryIngredient queries the ingredient edge of a StockMovement.
+func (c *StockMovementClient) QueryIngredient(_m *StockMovement) *IngredientQuery {
+	query := (&IngredientClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(orderitemmodifieroption.Table, orderitemmodifieroption.FieldID, id),
-			sqlgraph.To(orderitem.Table, orderitem.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, orderitemmodifieroption.OrderItemTable, orderitemmodifieroption.OrderItemColumn),
+			sqlgraph.From(stockmovement.Table, stockmovement.FieldID, id),
+			sqlgraph.To(ingredient.Table, ingredient.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, stockmovement.IngredientTable, stockmovement.IngredientColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -1468,15 +5672,15 @@ func (c *OrderItemModifierOptionClient) QueryOrderItem(_m *OrderItemModifierOpti
 	return query
 }
 
-// QueryModifierOption queries the modifier_option edge of a OrderItemModifierOption.
-func (c *OrderItemModifierOptionClient) QueryModifierOption(_m *OrderItemModifierOption) *ModifierOptionQuery {
-	query := (&ModifierOptionClient{config: c.config}).Query()
+// QueryCreatedBy queries the created_by edge of a StockMovement.
+func (c *StockMovementClient) QueryCreatedBy(_m *StockMovement) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(orderitemmodifieroption.Table, orderitemmodifieroption.FieldID, id),
-			sqlgraph.To(modifieroption.Table, modifieroption.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, orderitemmodifieroption.ModifierOptionTable, orderitemmodifieroption.ModifierOptionColumn),
+			sqlgraph.From(stockmovement.Table, stockmovement.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, stockmovement.CreatedByTable, stockmovement.CreatedByColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -1485,131 +5689,131 @@ func (c *OrderItemModifierOptionClient) QueryModifierOption(_m *OrderItemModifie
 }
 
 // Hooks returns the client hooks.
-func (c *OrderItemModifierOptionClient) Hooks() []Hook {
-	return c.hooks.OrderItemModifierOption
+func (c *StockMovementClient) Hooks() []Hook {
+	return c.hooks.StockMovement
 }
 
 // Interceptors returns the client interceptors.
-func (c *OrderItemModifierOptionClient) Interceptors() []Interceptor {
-	return c.inters.OrderItemModifierOption
+func (c *StockMovementClient) Interceptors() []Interceptor {
+	return c.inters.StockMovement
 }
 
-func (c *OrderItemModifierOptionClient) mutate(ctx context.Context, m *OrderItemModifierOptionMutation) (Value, error) {
+func (c *StockMovementClient) mutate(ctx context.Context, m *StockMovementMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&OrderItemModifierOptionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&StockMovementCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&OrderItemModifierOptionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&StockMovementUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&OrderItemModifierOptionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&StockMovementUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&OrderItemModifierOptionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&StockMovementDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown OrderItemModifierOption mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown StockMovement mutation op: %q", m.Op())
 	}
 }
 
-// RefreshTokenClient is a client for the RefreshToken schema.
-type RefreshTokenClient struct {
+// TableClient is a client for the Table schema.
+type TableClient struct {
 	config
 }
 
-// NewRefreshTokenClient returns a client for the RefreshToken from the given config.
-func NewRefreshTokenClient(c config) *RefreshTokenClient {
-	return &RefreshTokenClient{config: c}
+// NewTableClient returns a client for the Table from the given config.
+func NewTableClient(c config) *TableClient {
+	return &TableClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `refreshtoken.Hooks(f(g(h())))`.
-func (c *RefreshTokenClient) Use(hooks ...Hook) {
-	c.hooks.RefreshToken = append(c.hooks.RefreshToken, hooks...)
+// A call to `Use(f, g, h)` equals to `table.Hooks(f(g(h())))`.
+func (c *TableClient) Use(hooks ...Hook) {
+	c.hooks.Table = append(c.hooks.Table, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `refreshtoken.Intercept(f(g(h())))`.
-func (c *RefreshTokenClient) Intercept(interceptors ...Interceptor) {
-	c.inters.RefreshToken = append(c.inters.RefreshToken, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `table.Intercept(f(g(h())))`.
+func (c *TableClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Table = append(c.inters.Table, interceptors...)
 }
 
-// Create returns a builder for creating a RefreshToken entity.
-func (c *RefreshTokenClient) Create() *RefreshTokenCreate {
-	mutation := newRefreshTokenMutation(c.config, OpCreate)
-	return &RefreshTokenCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a Table entity.
+func (c *TableClient) Create() *TableCreate {
+	mutation := newTableMutation(c.config, OpCreate)
+	return &TableCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of RefreshToken entities.
-func (c *RefreshTokenClient) CreateBulk(builders ...*RefreshTokenCreate) *RefreshTokenCreateBulk {
-	return &RefreshTokenCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of Table entities.
+func (c *TableClient) CreateBulk(builders ...*TableCreate) *TableCreateBulk {
+	return &TableCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *RefreshTokenClient) MapCreateBulk(slice any, setFunc func(*RefreshTokenCreate, int)) *RefreshTokenCreateBulk {
+func (c *TableClient) MapCreateBulk(slice any, setFunc func(*TableCreate, int)) *TableCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &RefreshTokenCreateBulk{err: fmt.Errorf("calling to RefreshTokenClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &TableCreateBulk{err: fmt.Errorf("calling to TableClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*RefreshTokenCreate, rv.Len())
+	builders := make([]*TableCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &RefreshTokenCreateBulk{config: c.config, builders: builders}
+	return &TableCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for RefreshToken.
-func (c *RefreshTokenClient) Update() *RefreshTokenUpdate {
-	mutation := newRefreshTokenMutation(c.config, OpUpdate)
-	return &RefreshTokenUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for Table.
+func (c *TableClient) Update() *TableUpdate {
+	mutation := newTableMutation(c.config, OpUpdate)
+	return &TableUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *RefreshTokenClient) UpdateOne(_m *RefreshToken) *RefreshTokenUpdateOne {
-	mutation := newRefreshTokenMutation(c.config, OpUpdateOne, withRefreshToken(_m))
-	return &RefreshTokenUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *TableClient) UpdateOne(_m *Table) *TableUpdateOne {
+	mutation := newTableMutation(c.config, OpUpdateOne, withTable(_m))
+	return &TableUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *RefreshTokenClient) UpdateOneID(id uuid.UUID) *RefreshTokenUpdateOne {
-	mutation := newRefreshTokenMutation(c.config, OpUpdateOne, withRefreshTokenID(id))
-	return &RefreshTokenUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *TableClient) UpdateOneID(id uuid.UUID) *TableUpdateOne {
+	mutation := newTableMutation(c.config, OpUpdateOne, withTableID(id))
+	return &TableUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for RefreshToken.
-func (c *RefreshTokenClient) Delete() *RefreshTokenDelete {
-	mutation := newRefreshTokenMutation(c.config, OpDelete)
-	return &RefreshTokenDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for Table.
+func (c *TableClient) Delete() *TableDelete {
+	mutation := newTableMutation(c.config, OpDelete)
+	return &TableDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *RefreshTokenClient) DeleteOne(_m *RefreshToken) *RefreshTokenDeleteOne {
+func (c *TableClient) DeleteOne(_m *Table) *TableDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *RefreshTokenClient) DeleteOneID(id uuid.UUID) *RefreshTokenDeleteOne {
-	builder := c.Delete().Where(refreshtoken.ID(id))
+func (c *TableClient) DeleteOneID(id uuid.UUID) *TableDeleteOne {
+	builder := c.Delete().Where(table.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &RefreshTokenDeleteOne{builder}
+	return &TableDeleteOne{builder}
 }
 
-// Query returns a query builder for RefreshToken.
-func (c *RefreshTokenClient) Query() *RefreshTokenQuery {
-	return &RefreshTokenQuery{
+// Query returns a query builder for Table.
+func (c *TableClient) Query() *TableQuery {
+	return &TableQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeRefreshToken},
+		ctx:    &QueryContext{Type: TypeTable},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a RefreshToken entity by its id.
-func (c *RefreshTokenClient) Get(ctx context.Context, id uuid.UUID) (*RefreshToken, error) {
-	return c.Query().Where(refreshtoken.ID(id)).Only(ctx)
+// Get returns a Table entity by its id.
+func (c *TableClient) Get(ctx context.Context, id uuid.UUID) (*Table, error) {
+	return c.Query().Where(table.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *RefreshTokenClient) GetX(ctx context.Context, id uuid.UUID) *RefreshToken {
+func (c *TableClient) GetX(ctx context.Context, id uuid.UUID) *Table {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -1617,15 +5821,15 @@ func (c *RefreshTokenClient) GetX(ctx context.Context, id uuid.UUID) *RefreshTok
 	return obj
 }
 
-// QueryUser queries the user edge of a RefreshToken.
-func (c *RefreshTokenClient) QueryUser(_m *RefreshToken) *UserQuery {
-	query := (&UserClient{config: c.config}).Query()
+// QueryRestaurant queries the restaurant edge of a Table.
+func (c *TableClient) QueryRestaurant(_m *Table) *RestaurantQuery {
+	query := (&RestaurantClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(refreshtoken.Table, refreshtoken.FieldID, id),
-			sqlgraph.To(user.Table, user.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, refreshtoken.UserTable, refreshtoken.UserColumn),
+			sqlgraph.From(table.Table, table.FieldID, id),
+			sqlgraph.To(restaurant.Table, restaurant.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, table.RestaurantTable, table.RestaurantColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -1633,15 +5837,31 @@ func (c *RefreshTokenClient) QueryUser(_m *RefreshToken) *UserQuery {
 	return query
 }
 
-// QueryReplacedByToken queries the replaced_by_token edge of a RefreshToken.
-func (c *RefreshTokenClient) QueryReplacedByToken(_m *RefreshToken) *RefreshTokenQuery {
-	query := (&RefreshTokenClient{config: c.config}).Query()
+// QuerySessions queries the sessions edge of a Table.
+func (c *TableClient) QuerySessions(_m *Table) *TableSessionQuery {
+	query := (&TableSessionClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(refreshtoken.Table, refreshtoken.FieldID, id),
-			sqlgraph.To(refreshtoken.Table, refreshtoken.FieldID),
-			sqlgraph.Edge(sqlgraph.O2O, false, refreshtoken.ReplacedByTokenTable, refreshtoken.ReplacedByTokenColumn),
+			sqlgraph.From(table.Table, table.FieldID, id),
+			sqlgraph.To(tablesession.Table, tablesession.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, table.SessionsTable, table.SessionsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryOrders queries the orders edge of a Table.
+func (c *TableClient) QueryOrders(_m *Table) *OrderQuery {
+	query := (&OrderClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(table.Table, table.FieldID, id),
+			sqlgraph.To(order.Table, order.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, table.OrdersTable, table.OrdersColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -1650,131 +5870,131 @@ func (c *RefreshTokenClient) QueryReplacedByToken(_m *RefreshToken) *RefreshToke
 }
 
 // Hooks returns the client hooks.
-func (c *RefreshTokenClient) Hooks() []Hook {
-	return c.hooks.RefreshToken
+func (c *TableClient) Hooks() []Hook {
+	return c.hooks.Table
 }
 
 // Interceptors returns the client interceptors.
-func (c *RefreshTokenClient) Interceptors() []Interceptor {
-	return c.inters.RefreshToken
+func (c *TableClient) Interceptors() []Interceptor {
+	return c.inters.Table
 }
 
-func (c *RefreshTokenClient) mutate(ctx context.Context, m *RefreshTokenMutation) (Value, error) {
+func (c *TableClient) mutate(ctx context.Context, m *TableMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&RefreshTokenCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&TableCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&RefreshTokenUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&TableUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&RefreshTokenUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&TableUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&RefreshTokenDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&TableDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown RefreshToken mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown Table mutation op: %q", m.Op())
 	}
 }
 
-// RestaurantClient is a client for the Restaurant schema.
-type RestaurantClient struct {
+// TableSessionClient is a client for the TableSession schema.
+type TableSessionClient struct {
 	config
 }
 
-// NewRestaurantClient returns a client for the Restaurant from the given config.
-func NewRestaurantClient(c config) *RestaurantClient {
-	return &RestaurantClient{config: c}
+// NewTableSessionClient returns a client for the TableSession from the given config.
+func NewTableSessionClient(c config) *TableSessionClient {
+	return &TableSessionClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `restaurant.Hooks(f(g(h())))`.
-func (c *RestaurantClient) Use(hooks ...Hook) {
-	c.hooks.Restaurant = append(c.hooks.Restaurant, hooks...)
+// A call to `Use(f, g, h)` equals to `tablesession.Hooks(f(g(h())))`.
+func (c *TableSessionClient) Use(hooks ...Hook) {
+	c.hooks.TableSession = append(c.hooks.TableSession, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `restaurant.Intercept(f(g(h())))`.
-func (c *RestaurantClient) Intercept(interceptors ...Interceptor) {
-	c.inters.Restaurant = append(c.inters.Restaurant, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `tablesession.Intercept(f(g(h())))`.
+func (c *TableSessionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.TableSession = append(c.inters.TableSession, interceptors...)
 }
 
-// Create returns a builder for creating a Restaurant entity.
-func (c *RestaurantClient) Create() *RestaurantCreate {
-	mutation := newRestaurantMutation(c.config, OpCreate)
-	return &RestaurantCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a TableSession entity.
+func (c *TableSessionClient) Create() *TableSessionCreate {
+	mutation := newTableSessionMutation(c.config, OpCreate)
+	return &TableSessionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of Restaurant entities.
-func (c *RestaurantClient) CreateBulk(builders ...*RestaurantCreate) *RestaurantCreateBulk {
-	return &RestaurantCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of TableSession entities.
+func (c *TableSessionClient) CreateBulk(builders ...*TableSessionCreate) *TableSessionCreateBulk {
+	return &TableSessionCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *RestaurantClient) MapCreateBulk(slice any, setFunc func(*RestaurantCreate, int)) *RestaurantCreateBulk {
+func (c *TableSessionClient) MapCreateBulk(slice any, setFunc func(*TableSessionCreate, int)) *TableSessionCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &RestaurantCreateBulk{err: fmt.Errorf("calling to RestaurantClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &TableSessionCreateBulk{err: fmt.Errorf("calling to TableSessionClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*RestaurantCreate, rv.Len())
+	builders := make([]*TableSessionCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &RestaurantCreateBulk{config: c.config, builders: builders}
+	return &TableSessionCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for Restaurant.
-func (c *RestaurantClient) Update() *RestaurantUpdate {
-	mutation := newRestaurantMutation(c.config, OpUpdate)
-	return &RestaurantUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for TableSession.
+func (c *TableSessionClient) Update() *TableSessionUpdate {
+	mutation := newTableSessionMutation(c.config, OpUpdate)
+	return &TableSessionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *RestaurantClient) UpdateOne(_m *Restaurant) *RestaurantUpdateOne {
-	mutation := newRestaurantMutation(c.config, OpUpdateOne, withRestaurant(_m))
-	return &RestaurantUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *TableSessionClient) UpdateOne(_m *TableSession) *TableSessionUpdateOne {
+	mutation := newTableSessionMutation(c.config, OpUpdateOne, withTableSession(_m))
+	return &TableSessionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *RestaurantClient) UpdateOneID(id uuid.UUID) *RestaurantUpdateOne {
-	mutation := newRestaurantMutation(c.config, OpUpdateOne, withRestaurantID(id))
-	return &RestaurantUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *TableSessionClient) UpdateOneID(id uuid.UUID) *TableSessionUpdateOne {
+	mutation := newTableSessionMutation(c.config, OpUpdateOne, withTableSessionID(id))
+	return &TableSessionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for Restaurant.
-func (c *RestaurantClient) Delete() *RestaurantDelete {
-	mutation := newRestaurantMutation(c.config, OpDelete)
-	return &RestaurantDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for TableSession.
+func (c *TableSessionClient) Delete() *TableSessionDelete {
+	mutation := newTableSessionMutation(c.config, OpDelete)
+	return &TableSessionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *RestaurantClient) DeleteOne(_m *Restaurant) *RestaurantDeleteOne {
+func (c *TableSessionClient) DeleteOne(_m *TableSession) *TableSessionDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *RestaurantClient) DeleteOneID(id uuid.UUID) *RestaurantDeleteOne {
-	builder := c.Delete().Where(restaurant.ID(id))
+func (c *TableSessionClient) DeleteOneID(id uuid.UUID) *TableSessionDeleteOne {
+	builder := c.Delete().Where(tablesession.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &RestaurantDeleteOne{builder}
+	return &TableSessionDeleteOne{builder}
 }
 
-// Query returns a query builder for Restaurant.
-func (c *RestaurantClient) Query() *RestaurantQuery {
-	return &RestaurantQuery{
+// Query returns a query builder for TableSession.
+func (c *TableSessionClient) Query() *TableSessionQuery {
+	return &TableSessionQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeRestaurant},
+		ctx:    &QueryContext{Type: TypeTableSession},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a Restaurant entity by its id.
-func (c *RestaurantClient) Get(ctx context.Context, id uuid.UUID) (*Restaurant, error) {
-	return c.Query().Where(restaurant.ID(id)).Only(ctx)
+// Get returns a TableSession entity by its id.
+func (c *TableSessionClient) Get(ctx context.Context, id uuid.UUID) (*TableSession, error) {
+	return c.Query().Where(tablesession.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *RestaurantClient) GetX(ctx context.Context, id uuid.UUID) *Restaurant {
+func (c *TableSessionClient) GetX(ctx context.Context, id uuid.UUID) *TableSession {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -1782,31 +6002,15 @@ func (c *RestaurantClient) GetX(ctx context.Context, id uuid.UUID) *Restaurant {
 	return obj
 }
 
-// QueryUser queries the user edge of a Restaurant.
-func (c *RestaurantClient) QueryUser(_m *Restaurant) *UserQuery {
-	query := (&UserClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(restaurant.Table, restaurant.FieldID, id),
-			sqlgraph.To(user.Table, user.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, restaurant.UserTable, restaurant.UserColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryMenuItems queries the menu_items edge of a Restaurant.
-func (c *RestaurantClient) QueryMenuItems(_m *Restaurant) *MenuItemQuery {
-	query := (&MenuItemClient{config: c.config}).Query()
+// QueryTable queries the table edge of a TableSession.
+func (c *TableSessionClient) QueryTable(_m *TableSession) *TableQuery {
+	query := (&TableClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(restaurant.Table, restaurant.FieldID, id),
-			sqlgraph.To(menuitem.Table, menuitem.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, restaurant.MenuItemsTable, restaurant.MenuItemsColumn),
+			sqlgraph.From(tablesession.Table, tablesession.FieldID, id),
+			sqlgraph.To(table.Table, table.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, tablesession.TableTable, tablesession.TableColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -1814,15 +6018,15 @@ func (c *RestaurantClient) QueryMenuItems(_m *Restaurant) *MenuItemQuery {
 	return query
 }
 
-// QueryCategories queries the categories edge of a Restaurant.
-func (c *RestaurantClient) QueryCategories(_m *Restaurant) *CategoryQuery {
-	query := (&CategoryClient{config: c.config}).Query()
+// QueryRestaurant queries the restaurant edge of a TableSession.
+func (c *TableSessionClient) QueryRestaurant(_m *TableSession) *RestaurantQuery {
+	query := (&RestaurantClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(restaurant.Table, restaurant.FieldID, id),
-			sqlgraph.To(category.Table, category.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, restaurant.CategoriesTable, restaurant.CategoriesColumn),
+			sqlgraph.From(tablesession.Table, tablesession.FieldID, id),
+			sqlgraph.To(restaurant.Table, restaurant.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, tablesession.RestaurantTable, tablesession.RestaurantColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -1830,15 +6034,15 @@ func (c *RestaurantClient) QueryCategories(_m *Restaurant) *CategoryQuery {
 	return query
 }
 
-// QueryModifiers queries the modifiers edge of a Restaurant.
-func (c *RestaurantClient) QueryModifiers(_m *Restaurant) *ModifierQuery {
-	query := (&ModifierClient{config: c.config}).Query()
+// QueryClosedBy queries the closed_by edge of a TableSession.
+func (c *TableSessionClient) QueryClosedBy(_m *TableSession) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(restaurant.Table, restaurant.FieldID, id),
-			sqlgraph.To(modifier.Table, modifier.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, restaurant.ModifiersTable, restaurant.ModifiersColumn),
+			sqlgraph.From(tablesession.Table, tablesession.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, tablesession.ClosedByTable, tablesession.ClosedByColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -1846,15 +6050,15 @@ func (c *RestaurantClient) QueryModifiers(_m *Restaurant) *ModifierQuery {
 	return query
 }
 
-// QueryOrders queries the orders edge of a Restaurant.
-func (c *RestaurantClient) QueryOrders(_m *Restaurant) *OrderQuery {
+// QueryOrders queries the orders edge of a TableSession.
+func (c *TableSessionClient) QueryOrders(_m *TableSession) *OrderQuery {
 	query := (&OrderClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(restaurant.Table, restaurant.FieldID, id),
+			sqlgraph.From(tablesession.Table, tablesession.FieldID, id),
 			sqlgraph.To(order.Table, order.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, restaurant.OrdersTable, restaurant.OrdersColumn),
+			sqlgraph.Edge(sqlgraph.O2M, false, tablesession.OrdersTable, tablesession.OrdersColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -1863,27 +6067,27 @@ func (c *RestaurantClient) QueryOrders(_m *Restaurant) *OrderQuery {
 }
 
 // Hooks returns the client hooks.
-func (c *RestaurantClient) Hooks() []Hook {
-	return c.hooks.Restaurant
+func (c *TableSessionClient) Hooks() []Hook {
+	return c.hooks.TableSession
 }
 
 // Interceptors returns the client interceptors.
-func (c *RestaurantClient) Interceptors() []Interceptor {
-	return c.inters.Restaurant
+func (c *TableSessionClient) Interceptors() []Interceptor {
+	return c.inters.TableSession
 }
 
-func (c *RestaurantClient) mutate(ctx context.Context, m *RestaurantMutation) (Value, error) {
+func (c *TableSessionClient) mutate(ctx context.Context, m *TableSessionMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&RestaurantCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&TableSessionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&RestaurantUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&TableSessionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&RestaurantUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&TableSessionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&RestaurantDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&TableSessionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown Restaurant mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown TableSession mutation op: %q", m.Op())
 	}
 }
 
@@ -2043,6 +6247,102 @@ func (c *UserClient) QueryRefreshTokens(_m *User) *RefreshTokenQuery {
 	return query
 }
 
+// QueryOrderStatusEvents queries the order_status_events edge of a User.
+func (c *UserClient) QueryOrderStatusEvents(_m *User) *OrderStatusEventQuery {
+	query := (&OrderStatusEventClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(orderstatusevent.Table, orderstatusevent.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.OrderStatusEventsTable, user.OrderStatusEventsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryOrderItemChanges queries the order_item_changes edge of a User.
+func (c *UserClient) QueryOrderItemChanges(_m *User) *OrderItemChangeQuery {
+	query := (&OrderItemChangeClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(orderitemchange.Table, orderitemchange.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.OrderItemChangesTable, user.OrderItemChangesColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryPayments queries the payments edge of a User.
+func (c *UserClient) QueryPayments(_m *User) *PaymentQuery {
+	query := (&PaymentClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(payment.Table, payment.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.PaymentsTable, user.PaymentsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryRefunds queries the refunds edge of a User.
+func (c *UserClient) QueryRefunds(_m *User) *RefundQuery {
+	query := (&RefundClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(refund.Table, refund.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.RefundsTable, user.RefundsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryClosedTableSessions queries the closed_table_sessions edge of a User.
+func (c *UserClient) QueryClosedTableSessions(_m *User) *TableSessionQuery {
+	query := (&TableSessionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(tablesession.Table, tablesession.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.ClosedTableSessionsTable, user.ClosedTableSessionsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryStockMovements queries the stock_movements edge of a User.
+func (c *UserClient) QueryStockMovements(_m *User) *StockMovementQuery {
+	query := (&StockMovementClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(stockmovement.Table, stockmovement.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.StockMovementsTable, user.StockMovementsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
 func (c *UserClient) Hooks() []Hook {
 	return c.hooks.User
@@ -2220,13 +6520,19 @@ func (c *UserAuthProviderClient) mutate(ctx context.Context, m *UserAuthProvider
 // hooks and interceptors per client, for fast access.
 type (
 	hooks struct {
-		Category, MenuItem, Modifier, ModifierOption, Order, OrderItem,
-		OrderItemModifierOption, RefreshToken, Restaurant, User,
-		UserAuthProvider []ent.Hook
+		Category, DeliveryZone, IdempotencyKey, Ingredient, Menu, MenuItem,
+		MenuItemModifier, MenuItemPrice, MenuItemVariant, Modifier, ModifierOption,
+		Order, OrderEvent, OrderItem, OrderItemChange, OrderItemModifierOption,
+		OrderNumberSequence, OrderStatusEvent, Payment, RateLimitBucket,
+		RecipeIngredient, RefreshToken, Refund, Restaurant, Station, StationTicket,
+		StockMovement, Table, TableSession, User, UserAuthProvider []ent.Hook
 	}
 	inters struct {
-		Category, MenuItem, Modifier, ModifierOption, Order, OrderItem,
-		OrderItemModifierOption, RefreshToken, Restaurant, User,
-		UserAuthProvider []ent.Interceptor
+		Category, DeliveryZone, IdempotencyKey, Ingredient, Menu, MenuItem,
+		MenuItemModifier, MenuItemPrice, MenuItemVariant, Modifier, ModifierOption,
+		Order, OrderEvent, OrderItem, OrderItemChange, OrderItemModifierOption,
+		OrderNumberSequence, OrderStatusEvent, Payment, RateLimitBucket,
+		RecipeIngredient, RefreshToken, Refund, Restaurant, Station, StationTicket,
+		StockMovement, Table, TableSession, User, UserAuthProvider []ent.Interceptor
 	}
 )
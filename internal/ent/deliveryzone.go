@@ -0,0 +1,264 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/Jiruu246/rms/internal/ent/deliveryzone"
+	"github.com/Jiruu246/rms/internal/ent/restaurant"
+	"github.com/Jiruu246/rms/pkg/geo"
+	"github.com/google/uuid"
+)
+
+// DeliveryZone is the model entity for the DeliveryZone schema.
+type DeliveryZone struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// UpdateTime holds the value of the "update_time" field.
+	UpdateTime time.Time `json:"update_time,omitempty"`
+	// CreateTime holds the value of the "create_time" field.
+	CreateTime time.Time `json:"create_time,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// Kind holds the value of the "kind" field.
+	Kind deliveryzone.Kind `json:"kind,omitempty"`
+	// Radius around the restaurant location; set on RADIUS zones
+	RadiusMeters *int `json:"radius_meters,omitempty"`
+	// Vertices of the zone in order; set on POLYGON zones
+	Polygon []geo.Point `json:"polygon,omitempty"`
+	// Fee added to delivery orders in the zone, in minor units of the restaurant currency
+	DeliveryFee int64 `json:"delivery_fee,omitempty"`
+	// Smallest order subtotal the zone accepts, in minor units of the restaurant currency
+	MinOrderValue int64 `json:"min_order_value,omitempty"`
+	// IsActive holds the value of the "is_active" field.
+	IsActive bool `json:"is_active,omitempty"`
+	// RestaurantID holds the value of the "restaurant_id" field.
+	RestaurantID uuid.UUID `json:"restaurant_id,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the DeliveryZoneQuery when eager-loading is set.
+	Edges        DeliveryZoneEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// DeliveryZoneEdges holds the relations/edges for other nodes in the graph.
+type DeliveryZoneEdges struct {
+	// Restaurant holds the value of the restaurant edge.
+	Restaurant *Restaurant `json:"restaurant,omitempty"`
+	// Orders holds the value of the orders edge.
+	Orders []*Order `json:"orders,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// RestaurantOrErr returns the Restaurant value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e DeliveryZoneEdges) RestaurantOrErr() (*Restaurant, error) {
+	if e.Restaurant != nil {
+		return e.Restaurant, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: restaurant.Label}
+	}
+	return nil, &NotLoadedError{edge: "restaurant"}
+}
+
+// OrdersOrErr returns the Orders value or an error if the edge
+// was not loaded in eager-loading.
+func (e DeliveryZoneEdges) OrdersOrErr() ([]*Order, error) {
+	if e.loadedTypes[1] {
+		return e.Orders, nil
+	}
+	return nil, &NotLoadedError{edge: "orders"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*DeliveryZone) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case deliveryzone.FieldPolygon:
+			values[i] = new([]byte)
+		case deliveryzone.FieldIsActive:
+			values[i] = new(sql.NullBool)
+		case deliveryzone.FieldRadiusMeters, deliveryzone.FieldDeliveryFee, deliveryzone.FieldMinOrderValue:
+			values[i] = new(sql.NullInt64)
+		case deliveryzone.FieldName, deliveryzone.FieldKind:
+			values[i] = new(sql.NullString)
+		case deliveryzone.FieldUpdateTime, deliveryzone.FieldCreateTime:
+			values[i] = new(sql.NullTime)
+		case deliveryzone.FieldID, deliveryzone.FieldRestaurantID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the DeliveryZone fields.
+func (_m *DeliveryZone) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case deliveryzone.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case deliveryzone.FieldUpdateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field update_time", values[i])
+			} else if value.Valid {
+				_m.UpdateTime = value.Time
+			}
+		case deliveryzone.FieldCreateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field create_time", values[i])
+			} else if value.Valid {
+				_m.CreateTime = value.Time
+			}
+		case deliveryzone.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				_m.Name = value.String
+			}
+		case deliveryzone.FieldKind:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field kind", values[i])
+			} else if value.Valid {
+				_m.Kind = deliveryzone.Kind(value.String)
+			}
+		case deliveryzone.FieldRadiusMeters:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field radius_meters", values[i])
+			} else if value.Valid {
+				_m.RadiusMeters = new(int)
+				*_m.RadiusMeters = int(value.Int64)
+			}
+		case deliveryzone.FieldPolygon:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field polygon", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Polygon); err != nil {
+					return fmt.Errorf("unmarshal field polygon: %w", err)
+				}
+			}
+		case deliveryzone.FieldDeliveryFee:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field delivery_fee", values[i])
+			} else if value.Valid {
+				_m.DeliveryFee = value.Int64
+			}
+		case deliveryzone.FieldMinOrderValue:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field min_order_value", values[i])
+			} else if value.Valid {
+				_m.MinOrderValue = value.Int64
+			}
+		case deliveryzone.FieldIsActive:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field is_active", values[i])
+			} else if value.Valid {
+				_m.IsActive = value.Bool
+			}
+		case deliveryzone.FieldRestaurantID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field restaurant_id", values[i])
+			} else if value != nil {
+				_m.RestaurantID = *value
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the DeliveryZone.
+// This includes values selected through modifiers, order, etc.
+func (_m *DeliveryZone) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryRestaurant queries the "restaurant" edge of the DeliveryZone entity.
+func (_m *DeliveryZone) QueryRestaurant() *RestaurantQuery {
+	return NewDeliveryZoneClient(_m.config).QueryRestaurant(_m)
+}
+
+// QueryOrders queries the "orders" edge of the DeliveryZone entity.
+func (_m *DeliveryZone) QueryOrders() *OrderQuery {
+	return NewDeliveryZoneClient(_m.config).QueryOrders(_m)
+}
+
+// Update returns a builder for updating this DeliveryZone.
+// Note that you need to call DeliveryZone.Unwrap() before calling this method if this DeliveryZone
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *DeliveryZone) Update() *DeliveryZoneUpdateOne {
+	return NewDeliveryZoneClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the DeliveryZone entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *DeliveryZone) Unwrap() *DeliveryZone {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: DeliveryZone is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *DeliveryZone) String() string {
+	var builder strings.Builder
+	builder.WriteString("DeliveryZone(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("update_time=")
+	builder.WriteString(_m.UpdateTime.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("create_time=")
+	builder.WriteString(_m.CreateTime.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(_m.Name)
+	builder.WriteString(", ")
+	builder.WriteString("kind=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Kind))
+	builder.WriteString(", ")
+	if v := _m.RadiusMeters; v != nil {
+		builder.WriteString("radius_meters=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("polygon=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Polygon))
+	builder.WriteString(", ")
+	builder.WriteString("delivery_fee=")
+	builder.WriteString(fmt.Sprintf("%v", _m.DeliveryFee))
+	builder.WriteString(", ")
+	builder.WriteString("min_order_value=")
+	builder.WriteString(fmt.Sprintf("%v", _m.MinOrderValue))
+	builder.WriteString(", ")
+	builder.WriteString("is_active=")
+	builder.WriteString(fmt.Sprintf("%v", _m.IsActive))
+	builder.WriteString(", ")
+	builder.WriteString("restaurant_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.RestaurantID))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// DeliveryZones is a parsable slice of DeliveryZone.
+type DeliveryZones []*DeliveryZone
@@ -0,0 +1,218 @@
+// Code generated by ent, DO NOT EDIT.
+
+package deliveryzone
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the deliveryzone type in the database.
+	Label = "delivery_zone"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldUpdateTime holds the string denoting the update_time field in the database.
+	FieldUpdateTime = "update_time"
+	// FieldCreateTime holds the string denoting the create_time field in the database.
+	FieldCreateTime = "create_time"
+	// FieldName holds the string denoting the name field in the database.
+	FieldName = "name"
+	// FieldKind holds the string denoting the kind field in the database.
+	FieldKind = "kind"
+	// FieldRadiusMeters holds the string denoting the radius_meters field in the database.
+	FieldRadiusMeters = "radius_meters"
+	// FieldPolygon holds the string denoting the polygon field in the database.
+	FieldPolygon = "polygon"
+	// FieldDeliveryFee holds the string denoting the delivery_fee field in the database.
+	FieldDeliveryFee = "delivery_fee"
+	// FieldMinOrderValue holds the string denoting the min_order_value field in the database.
+	FieldMinOrderValue = "min_order_value"
+	// FieldIsActive holds the string denoting the is_active field in the database.
+	FieldIsActive = "is_active"
+	// FieldRestaurantID holds the string denoting the restaurant_id field in the database.
+	FieldRestaurantID = "restaurant_id"
+	// EdgeRestaurant holds the string denoting the restaurant edge name in mutations.
+	EdgeRestaurant = "restaurant"
+	// EdgeOrders holds the string denoting the orders edge name in mutations.
+	EdgeOrders = "orders"
+	// Table holds the table name of the deliveryzone in the database.
+	Table = "delivery_zones"
+	// RestaurantTable is the table that holds the restaurant relation/edge.
+	RestaurantTable = "delivery_zones"
+	// RestaurantInverseTable is the table name for the Restaurant entity.
+	// It exists in this package in order to avoid circular dependency with the "restaurant" package.
+	RestaurantInverseTable = "restaurants"
+	// RestaurantColumn is the table column denoting the restaurant relation/edge.
+	RestaurantColumn = "restaurant_id"
+	// OrdersTable is the table that holds the orders relation/edge.
+	OrdersTable = "orders"
+	// OrdersInverseTable is the table name for the Order entity.
+	// It exists in this package in order to avoid circular dependency with the "order" package.
+	OrdersInverseTable = "orders"
+	// OrdersColumn is the table column denoting the orders relation/edge.
+	OrdersColumn = "delivery_zone_id"
+)
+
+// Columns holds all SQL columns for deliveryzone fields.
+var Columns = []string{
+	FieldID,
+	FieldUpdateTime,
+	FieldCreateTime,
+	FieldName,
+	FieldKind,
+	FieldRadiusMeters,
+	FieldPolygon,
+	FieldDeliveryFee,
+	FieldMinOrderValue,
+	FieldIsActive,
+	FieldRestaurantID,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultUpdateTime holds the default value on creation for the "update_time" field.
+	DefaultUpdateTime func() time.Time
+	// UpdateDefaultUpdateTime holds the default value on update for the "update_time" field.
+	UpdateDefaultUpdateTime func() time.Time
+	// DefaultCreateTime holds the default value on creation for the "create_time" field.
+	DefaultCreateTime func() time.Time
+	// NameValidator is a validator for the "name" field. It is called by the builders before save.
+	NameValidator func(string) error
+	// DefaultDeliveryFee holds the default value on creation for the "delivery_fee" field.
+	DefaultDeliveryFee int64
+	// DeliveryFeeValidator is a validator for the "delivery_fee" field. It is called by the builders before save.
+	DeliveryFeeValidator func(int64) error
+	// DefaultMinOrderValue holds the default value on creation for the "min_order_value" field.
+	DefaultMinOrderValue int64
+	// MinOrderValueValidator is a validator for the "min_order_value" field. It is called by the builders before save.
+	MinOrderValueValidator func(int64) error
+	// DefaultIsActive holds the default value on creation for the "is_active" field.
+	DefaultIsActive bool
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// Kind defines the type for the "kind" enum field.
+type Kind string
+
+// Kind values.
+const (
+	KindRADIUS  Kind = "RADIUS"
+	KindPOLYGON Kind = "POLYGON"
+)
+
+func (k Kind) String() string {
+	return string(k)
+}
+
+// KindValidator is a validator for the "kind" field enum values. It is called by the builders before save.
+func KindValidator(k Kind) error {
+	switch k {
+	case KindRADIUS, KindPOLYGON:
+		return nil
+	default:
+		return fmt.Errorf("deliveryzone: invalid enum value for kind field: %q", k)
+	}
+}
+
+// OrderOption defines the ordering options for the DeliveryZone queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByUpdateTime orders the results by the update_time field.
+func ByUpdateTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdateTime, opts...).ToFunc()
+}
+
+// ByCreateTime orders the results by the create_time field.
+func ByCreateTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreateTime, opts...).ToFunc()
+}
+
+// ByName orders the results by the name field.
+func ByName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldName, opts...).ToFunc()
+}
+
+// ByKind orders the results by the kind field.
+func ByKind(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldKind, opts...).ToFunc()
+}
+
+// ByRadiusMeters orders the results by the radius_meters field.
+func ByRadiusMeters(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRadiusMeters, opts...).ToFunc()
+}
+
+// ByDeliveryFee orders the results by the delivery_fee field.
+func ByDeliveryFee(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeliveryFee, opts...).ToFunc()
+}
+
+// ByMinOrderValue orders the results by the min_order_value field.
+func ByMinOrderValue(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMinOrderValue, opts...).ToFunc()
+}
+
+// ByIsActive orders the results by the is_active field.
+func ByIsActive(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldIsActive, opts...).ToFunc()
+}
+
+// ByRestaurantID orders the results by the restaurant_id field.
+func ByRestaurantID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRestaurantID, opts...).ToFunc()
+}
+
+// ByRestaurantField orders the results by restaurant field.
+func ByRestaurantField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newRestaurantStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByOrdersCount orders the results by orders count.
+func ByOrdersCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newOrdersStep(), opts...)
+	}
+}
+
+// ByOrders orders the results by orders terms.
+func ByOrders(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newOrdersStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+func newRestaurantStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(RestaurantInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, RestaurantTable, RestaurantColumn),
+	)
+}
+func newOrdersStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(OrdersInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, OrdersTable, OrdersColumn),
+	)
+}
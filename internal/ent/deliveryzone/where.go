@@ -0,0 +1,493 @@
+// Code generated by ent, DO NOT EDIT.
+
+package deliveryzone
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/Jiruu246/rms/internal/ent/predicate"
+	"github.com/google/uuid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.DeliveryZone {
+	return predicate.DeliveryZone(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.DeliveryZone {
+	return predicate.DeliveryZone(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.DeliveryZone {
+	return predicate.DeliveryZone(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.DeliveryZone {
+	return predicate.DeliveryZone(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.DeliveryZone {
+	return predicate.DeliveryZone(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.DeliveryZone {
+	return predicate.DeliveryZone(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.DeliveryZone {
+	return predicate.DeliveryZone(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.DeliveryZone {
+	return predicate.DeliveryZone(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.DeliveryZone {
+	return predicate.DeliveryZone(sql.FieldLTE(FieldID, id))
+}
+
+// UpdateTime applies equality check predicate on the "update_time" field. It's identical to UpdateTimeEQ.
+func UpdateTime(v time.Time) predicate.DeliveryZone {
+	return predicate.DeliveryZone(sql.FieldEQ(FieldUpdateTime, v))
+}
+
+// CreateTime applies equality check predicate on the "create_time" field. It's identical to CreateTimeEQ.
+func CreateTime(v time.Time) predicate.DeliveryZone {
+	return predicate.DeliveryZone(sql.FieldEQ(FieldCreateTime, v))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.DeliveryZone {
+	return predicate.DeliveryZone(sql.FieldEQ(FieldName, v))
+}
+
+// RadiusMeters applies equality check predicate on the "radius_meters" field. It's identical to RadiusMetersEQ.
+func RadiusMeters(v int) predicate.DeliveryZone {
+	return predicate.DeliveryZone(sql.FieldEQ(FieldRadiusMeters, v))
+}
+
+// DeliveryFee applies equality check predicate on the "delivery_fee" field. It's identical to DeliveryFeeEQ.
+func DeliveryFee(v int64) predicate.DeliveryZone {
+	return predicate.DeliveryZone(sql.FieldEQ(FieldDeliveryFee, v))
+}
+
+// MinOrderValue applies equality check predicate on the "min_order_value" field. It's identical to MinOrderValueEQ.
+func MinOrderValue(v int64) predicate.DeliveryZone {
+	return predicate.DeliveryZone(sql.FieldEQ(FieldMinOrderValue, v))
+}
+
+// IsActive applies equality check predicate on the "is_active" field. It's identical to IsActiveEQ.
+func IsActive(v bool) predicate.DeliveryZone {
+	return predicate.DeliveryZone(sql.FieldEQ(FieldIsActive, v))
+}
+
+// RestaurantID applies equality check predicate on the "restaurant_id" field. It's identical to RestaurantIDEQ.
+func RestaurantID(v uuid.UUID) predicate.DeliveryZone {
+	return predicate.DeliveryZone(sql.FieldEQ(FieldRestaurantID, v))
+}
+
+// UpdateTimeEQ applies the EQ predicate on the "update_time" field.
+func UpdateTimeEQ(v time.Time) predicate.DeliveryZone {
+	return predicate.DeliveryZone(sql.FieldEQ(FieldUpdateTime, v))
+}
+
+// UpdateTimeNEQ applies the NEQ predicate on the "update_time" field.
+func UpdateTimeNEQ(v time.Time) predicate.DeliveryZone {
+	return predicate.DeliveryZone(sql.FieldNEQ(FieldUpdateTime, v))
+}
+
+// UpdateTimeIn applies the In predicate on the "update_time" field.
+func UpdateTimeIn(vs ...time.Time) predicate.DeliveryZone {
+	return predicate.DeliveryZone(sql.FieldIn(FieldUpdateTime, vs...))
+}
+
+// UpdateTimeNotIn applies the NotIn predicate on the "update_time" field.
+func UpdateTimeNotIn(vs ...time.Time) predicate.DeliveryZone {
+	return predicate.DeliveryZone(sql.FieldNotIn(FieldUpdateTime, vs...))
+}
+
+// UpdateTimeGT applies the GT predicate on the "update_time" field.
+func UpdateTimeGT(v time.Time) predicate.DeliveryZone {
+	return predicate.DeliveryZone(sql.FieldGT(FieldUpdateTime, v))
+}
+
+// UpdateTimeGTE applies the GTE predicate on the "update_time" field.
+func UpdateTimeGTE(v time.Time) predicate.DeliveryZone {
+	return predicate.DeliveryZone(sql.FieldGTE(FieldUpdateTime, v))
+}
+
+// UpdateTimeLT applies the LT predicate on the "update_time" field.
+func UpdateTimeLT(v time.Time) predicate.DeliveryZone {
+	return predicate.DeliveryZone(sql.FieldLT(FieldUpdateTime, v))
+}
+
+// UpdateTimeLTE applies the LTE predicate on the "update_time" field.
+func UpdateTimeLTE(v time.Time) predicate.DeliveryZone {
+	return predicate.DeliveryZone(sql.FieldLTE(FieldUpdateTime, v))
+}
+
+// CreateTimeEQ applies the EQ predicate on the "create_time" field.
+func CreateTimeEQ(v time.Time) predicate.DeliveryZone {
+	return predicate.DeliveryZone(sql.FieldEQ(FieldCreateTime, v))
+}
+
+// CreateTimeNEQ applies the NEQ predicate on the "create_time" field.
+func CreateTimeNEQ(v time.Time) predicate.DeliveryZone {
+	return predicate.DeliveryZone(sql.FieldNEQ(FieldCreateTime, v))
+}
+
+// CreateTimeIn applies the In predicate on the "create_time" field.
+func CreateTimeIn(vs ...time.Time) predicate.DeliveryZone {
+	return predicate.DeliveryZone(sql.FieldIn(FieldCreateTime, vs...))
+}
+
+// CreateTimeNotIn applies the NotIn predicate on the "create_time" field.
+func CreateTimeNotIn(vs ...time.Time) predicate.DeliveryZone {
+	return predicate.DeliveryZone(sql.FieldNotIn(FieldCreateTime, vs...))
+}
+
+// CreateTimeGT applies the GT predicate on the "create_time" field.
+func CreateTimeGT(v time.Time) predicate.DeliveryZone {
+	return predicate.DeliveryZone(sql.FieldGT(FieldCreateTime, v))
+}
+
+// CreateTimeGTE applies the GTE predicate on the "create_time" field.
+func CreateTimeGTE(v time.Time) predicate.DeliveryZone {
+	return predicate.DeliveryZone(sql.FieldGTE(FieldCreateTime, v))
+}
+
+// CreateTimeLT applies the LT predicate on the "create_time" field.
+func CreateTimeLT(v time.Time) predicate.DeliveryZone {
+	return predicate.DeliveryZone(sql.FieldLT(FieldCreateTime, v))
+}
+
+// CreateTimeLTE applies the LTE predicate on the "create_time" field.
+func CreateTimeLTE(v time.Time) predicate.DeliveryZone {
+	return predicate.DeliveryZone(sql.FieldLTE(FieldCreateTime, v))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.DeliveryZone {
+	return predicate.DeliveryZone(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.DeliveryZone {
+	return predicate.DeliveryZone(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.DeliveryZone {
+	return predicate.DeliveryZone(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.DeliveryZone {
+	return predicate.DeliveryZone(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.DeliveryZone {
+	return predicate.DeliveryZone(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.DeliveryZone {
+	return predicate.DeliveryZone(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.DeliveryZone {
+	return predicate.DeliveryZone(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.DeliveryZone {
+	return predicate.DeliveryZone(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.DeliveryZone {
+	return predicate.DeliveryZone(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.DeliveryZone {
+	return predicate.DeliveryZone(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.DeliveryZone {
+	return predicate.DeliveryZone(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.DeliveryZone {
+	return predicate.DeliveryZone(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.DeliveryZone {
+	return predicate.DeliveryZone(sql.FieldContainsFold(FieldName, v))
+}
+
+// KindEQ applies the EQ predicate on the "kind" field.
+func KindEQ(v Kind) predicate.DeliveryZone {
+	return predicate.DeliveryZone(sql.FieldEQ(FieldKind, v))
+}
+
+// KindNEQ applies the NEQ predicate on the "kind" field.
+func KindNEQ(v Kind) predicate.DeliveryZone {
+	return predicate.DeliveryZone(sql.FieldNEQ(FieldKind, v))
+}
+
+// KindIn applies the In predicate on the "kind" field.
+func KindIn(vs ...Kind) predicate.DeliveryZone {
+	return predicate.DeliveryZone(sql.FieldIn(FieldKind, vs...))
+}
+
+// KindNotIn applies the NotIn predicate on the "kind" field.
+func KindNotIn(vs ...Kind) predicate.DeliveryZone {
+	return predicate.DeliveryZone(sql.FieldNotIn(FieldKind, vs...))
+}
+
+// RadiusMetersEQ applies the EQ predicate on the "radius_meters" field.
+func RadiusMetersEQ(v int) predicate.DeliveryZone {
+	return predicate.DeliveryZone(sql.FieldEQ(FieldRadiusMeters, v))
+}
+
+// RadiusMetersNEQ applies the NEQ predicate on the "radius_meters" field.
+func RadiusMetersNEQ(v int) predicate.DeliveryZone {
+	return predicate.DeliveryZone(sql.FieldNEQ(FieldRadiusMeters, v))
+}
+
+// RadiusMetersIn applies the In predicate on the "radius_meters" field.
+func RadiusMetersIn(vs ...int) predicate.DeliveryZone {
+	return predicate.DeliveryZone(sql.FieldIn(FieldRadiusMeters, vs...))
+}
+
+// RadiusMetersNotIn applies the NotIn predicate on the "radius_meters" field.
+func RadiusMetersNotIn(vs ...int) predicate.DeliveryZone {
+	return predicate.DeliveryZone(sql.FieldNotIn(FieldRadiusMeters, vs...))
+}
+
+// RadiusMetersGT applies the GT predicate on the "radius_meters" field.
+func RadiusMetersGT(v int) predicate.DeliveryZone {
+	return predicate.DeliveryZone(sql.FieldGT(FieldRadiusMeters, v))
+}
+
+// RadiusMetersGTE applies the GTE predicate on the "radius_meters" field.
+func RadiusMetersGTE(v int) predicate.DeliveryZone {
+	return predicate.DeliveryZone(sql.FieldGTE(FieldRadiusMeters, v))
+}
+
+// RadiusMetersLT applies the LT predicate on the "radius_meters" field.
+func RadiusMetersLT(v int) predicate.DeliveryZone {
+	return predicate.DeliveryZone(sql.FieldLT(FieldRadiusMeters, v))
+}
+
+// RadiusMetersLTE applies the LTE predicate on the "radius_meters" field.
+func RadiusMetersLTE(v int) predicate.DeliveryZone {
+	return predicate.DeliveryZone(sql.FieldLTE(FieldRadiusMeters, v))
+}
+
+// RadiusMetersIsNil applies the IsNil predicate on the "radius_meters" field.
+func RadiusMetersIsNil() predicate.DeliveryZone {
+	return predicate.DeliveryZone(sql.FieldIsNull(FieldRadiusMeters))
+}
+
+// RadiusMetersNotNil applies the NotNil predicate on the "radius_meters" field.
+func RadiusMetersNotNil() predicate.DeliveryZone {
+	return predicate.DeliveryZone(sql.FieldNotNull(FieldRadiusMeters))
+}
+
+// PolygonIsNil applies the IsNil predicate on the "polygon" field.
+func PolygonIsNil() predicate.DeliveryZone {
+	return predicate.DeliveryZone(sql.FieldIsNull(FieldPolygon))
+}
+
+// PolygonNotNil applies the NotNil predicate on the "polygon" field.
+func PolygonNotNil() predicate.DeliveryZone {
+	return predicate.DeliveryZone(sql.FieldNotNull(FieldPolygon))
+}
+
+// DeliveryFeeEQ applies the EQ predicate on the "delivery_fee" field.
+func DeliveryFeeEQ(v int64) predicate.DeliveryZone {
+	return predicate.DeliveryZone(sql.FieldEQ(FieldDeliveryFee, v))
+}
+
+// DeliveryFeeNEQ applies the NEQ predicate on the "delivery_fee" field.
+func DeliveryFeeNEQ(v int64) predicate.DeliveryZone {
+	return predicate.DeliveryZone(sql.FieldNEQ(FieldDeliveryFee, v))
+}
+
+// DeliveryFeeIn applies the In predicate on the "delivery_fee" field.
+func DeliveryFeeIn(vs ...int64) predicate.DeliveryZone {
+	return predicate.DeliveryZone(sql.FieldIn(FieldDeliveryFee, vs...))
+}
+
+// DeliveryFeeNotIn applies the NotIn predicate on the "delivery_fee" field.
+func DeliveryFeeNotIn(vs ...int64) predicate.DeliveryZone {
+	return predicate.DeliveryZone(sql.FieldNotIn(FieldDeliveryFee, vs...))
+}
+
+// DeliveryFeeGT applies the GT predicate on the "delivery_fee" field.
+func DeliveryFeeGT(v int64) predicate.DeliveryZone {
+	return predicate.DeliveryZone(sql.FieldGT(FieldDeliveryFee, v))
+}
+
+// DeliveryFeeGTE applies the GTE predicate on the "delivery_fee" field.
+func DeliveryFeeGTE(v int64) predicate.DeliveryZone {
+	return predicate.DeliveryZone(sql.FieldGTE(FieldDeliveryFee, v))
+}
+
+// DeliveryFeeLT applies the LT predicate on the "delivery_fee" field.
+func DeliveryFeeLT(v int64) predicate.DeliveryZone {
+	return predicate.DeliveryZone(sql.FieldLT(FieldDeliveryFee, v))
+}
+
+// DeliveryFeeLTE applies the LTE predicate on the "delivery_fee" field.
+func DeliveryFeeLTE(v int64) predicate.DeliveryZone {
+	return predicate.DeliveryZone(sql.FieldLTE(FieldDeliveryFee, v))
+}
+
+// MinOrderValueEQ applies the EQ predicate on the "min_order_value" field.
+func MinOrderValueEQ(v int64) predicate.DeliveryZone {
+	return predicate.DeliveryZone(sql.FieldEQ(FieldMinOrderValue, v))
+}
+
+// MinOrderValueNEQ applies the NEQ predicate on the "min_order_value" field.
+func MinOrderValueNEQ(v int64) predicate.DeliveryZone {
+	return predicate.DeliveryZone(sql.FieldNEQ(FieldMinOrderValue, v))
+}
+
+// MinOrderValueIn applies the In predicate on the "min_order_value" field.
+func MinOrderValueIn(vs ...int64) predicate.DeliveryZone {
+	return predicate.DeliveryZone(sql.FieldIn(FieldMinOrderValue, vs...))
+}
+
+// MinOrderValueNotIn applies the NotIn predicate on the "min_order_value" field.
+func MinOrderValueNotIn(vs ...int64) predicate.DeliveryZone {
+	return predicate.DeliveryZone(sql.FieldNotIn(FieldMinOrderValue, vs...))
+}
+
+// MinOrderValueGT applies the GT predicate on the "min_order_value" field.
+func MinOrderValueGT(v int64) predicate.DeliveryZone {
+	return predicate.DeliveryZone(sql.FieldGT(FieldMinOrderValue, v))
+}
+
+// MinOrderValueGTE applies the GTE predicate on the "min_order_value" field.
+func MinOrderValueGTE(v int64) predicate.DeliveryZone {
+	return predicate.DeliveryZone(sql.FieldGTE(FieldMinOrderValue, v))
+}
+
+// MinOrderValueLT applies the LT predicate on the "min_order_value" field.
+func MinOrderValueLT(v int64) predicate.DeliveryZone {
+	return predicate.DeliveryZone(sql.FieldLT(FieldMinOrderValue, v))
+}
+
+// MinOrderValueLTE applies the LTE predicate on the "min_order_value" field.
+func MinOrderValueLTE(v int64) predicate.DeliveryZone {
+	return predicate.DeliveryZone(sql.FieldLTE(FieldMinOrderValue, v))
+}
+
+// IsActiveEQ applies the EQ predicate on the "is_active" field.
+func IsActiveEQ(v bool) predicate.DeliveryZone {
+	return predicate.DeliveryZone(sql.FieldEQ(FieldIsActive, v))
+}
+
+// IsActiveNEQ applies the NEQ predicate on the "is_active" field.
+func IsActiveNEQ(v bool) predicate.DeliveryZone {
+	return predicate.DeliveryZone(sql.FieldNEQ(FieldIsActive, v))
+}
+
+// RestaurantIDEQ applies the EQ predicate on the "restaurant_id" field.
+func RestaurantIDEQ(v uuid.UUID) predicate.DeliveryZone {
+	return predicate.DeliveryZone(sql.FieldEQ(FieldRestaurantID, v))
+}
+
+// RestaurantIDNEQ applies the NEQ predicate on the "restaurant_id" field.
+func RestaurantIDNEQ(v uuid.UUID) predicate.DeliveryZone {
+	return predicate.DeliveryZone(sql.FieldNEQ(FieldRestaurantID, v))
+}
+
+// RestaurantIDIn applies the In predicate on the "restaurant_id" field.
+func RestaurantIDIn(vs ...uuid.UUID) predicate.DeliveryZone {
+	return predicate.DeliveryZone(sql.FieldIn(FieldRestaurantID, vs...))
+}
+
+// RestaurantIDNotIn applies the NotIn predicate on the "restaurant_id" field.
+func RestaurantIDNotIn(vs ...uuid.UUID) predicate.DeliveryZone {
+	return predicate.DeliveryZone(sql.FieldNotIn(FieldRestaurantID, vs...))
+}
+
+// HasRestaurant applies the HasEdge predicate on the "restaurant" edge.
+func HasRestaurant() predicate.DeliveryZone {
+	return predicate.DeliveryZone(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, RestaurantTable, RestaurantColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasRestaurantWith applies the HasEdge predicate on the "restaurant" edge with a given conditions (other predicates).
+func HasRestaurantWith(preds ...predicate.Restaurant) predicate.DeliveryZone {
+	return predicate.DeliveryZone(func(s *sql.Selector) {
+		step := newRestaurantStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasOrders applies the HasEdge predicate on the "orders" edge.
+func HasOrders() predicate.DeliveryZone {
+	return predicate.DeliveryZone(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, OrdersTable, OrdersColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasOrdersWith applies the HasEdge predicate on the "orders" edge with a given conditions (other predicates).
+func HasOrdersWith(preds ...predicate.Order) predicate.DeliveryZone {
+	return predicate.DeliveryZone(func(s *sql.Selector) {
+		step := newOrdersStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.DeliveryZone) predicate.DeliveryZone {
+	return predicate.DeliveryZone(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.DeliveryZone) predicate.DeliveryZone {
+	return predicate.DeliveryZone(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.DeliveryZone) predicate.DeliveryZone {
+	return predicate.DeliveryZone(sql.NotPredicates(p))
+}
@@ -0,0 +1,468 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Jiruu246/rms/internal/ent/deliveryzone"
+	"github.com/Jiruu246/rms/internal/ent/order"
+	"github.com/Jiruu246/rms/internal/ent/restaurant"
+	"github.com/Jiruu246/rms/pkg/geo"
+	"github.com/google/uuid"
+)
+
+// DeliveryZoneCreate is the builder for creating a DeliveryZone entity.
+type DeliveryZoneCreate struct {
+	config
+	mutation *DeliveryZoneMutation
+	hooks    []Hook
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_c *DeliveryZoneCreate) SetUpdateTime(v time.Time) *DeliveryZoneCreate {
+	_c.mutation.SetUpdateTime(v)
+	return _c
+}
+
+// SetNillableUpdateTime sets the "update_time" field if the given value is not nil.
+func (_c *DeliveryZoneCreate) SetNillableUpdateTime(v *time.Time) *DeliveryZoneCreate {
+	if v != nil {
+		_c.SetUpdateTime(*v)
+	}
+	return _c
+}
+
+// SetCreateTime sets the "create_time" field.
+func (_c *DeliveryZoneCreate) SetCreateTime(v time.Time) *DeliveryZoneCreate {
+	_c.mutation.SetCreateTime(v)
+	return _c
+}
+
+// SetNillableCreateTime sets the "create_time" field if the given value is not nil.
+func (_c *DeliveryZoneCreate) SetNillableCreateTime(v *time.Time) *DeliveryZoneCreate {
+	if v != nil {
+		_c.SetCreateTime(*v)
+	}
+	return _c
+}
+
+// SetName sets the "name" field.
+func (_c *DeliveryZoneCreate) SetName(v string) *DeliveryZoneCreate {
+	_c.mutation.SetName(v)
+	return _c
+}
+
+// SetKind sets the "kind" field.
+func (_c *DeliveryZoneCreate) SetKind(v deliveryzone.Kind) *DeliveryZoneCreate {
+	_c.mutation.SetKind(v)
+	return _c
+}
+
+// SetRadiusMeters sets the "radius_meters" field.
+func (_c *DeliveryZoneCreate) SetRadiusMeters(v int) *DeliveryZoneCreate {
+	_c.mutation.SetRadiusMeters(v)
+	return _c
+}
+
+// SetNillableRadiusMeters sets the "radius_meters" field if the given value is not nil.
+func (_c *DeliveryZoneCreate) SetNillableRadiusMeters(v *int) *DeliveryZoneCreate {
+	if v != nil {
+		_c.SetRadiusMeters(*v)
+	}
+	return _c
+}
+
+// SetPolygon sets the "polygon" field.
+func (_c *DeliveryZoneCreate) SetPolygon(v []geo.Point) *DeliveryZoneCreate {
+	_c.mutation.SetPolygon(v)
+	return _c
+}
+
+// SetDeliveryFee sets the "delivery_fee" field.
+func (_c *DeliveryZoneCreate) SetDeliveryFee(v int64) *DeliveryZoneCreate {
+	_c.mutation.SetDeliveryFee(v)
+	return _c
+}
+
+// SetNillableDeliveryFee sets the "delivery_fee" field if the given value is not nil.
+func (_c *DeliveryZoneCreate) SetNillableDeliveryFee(v *int64) *DeliveryZoneCreate {
+	if v != nil {
+		_c.SetDeliveryFee(*v)
+	}
+	return _c
+}
+
+// SetMinOrderValue sets the "min_order_value" field.
+func (_c *DeliveryZoneCreate) SetMinOrderValue(v int64) *DeliveryZoneCreate {
+	_c.mutation.SetMinOrderValue(v)
+	return _c
+}
+
+// SetNillableMinOrderValue sets the "min_order_value" field if the given value is not nil.
+func (_c *DeliveryZoneCreate) SetNillableMinOrderValue(v *int64) *DeliveryZoneCreate {
+	if v != nil {
+		_c.SetMinOrderValue(*v)
+	}
+	return _c
+}
+
+// SetIsActive sets the "is_active" field.
+func (_c *DeliveryZoneCreate) SetIsActive(v bool) *DeliveryZoneCreate {
+	_c.mutation.SetIsActive(v)
+	return _c
+}
+
+// SetNillableIsActive sets the "is_active" field if the given value is not nil.
+func (_c *DeliveryZoneCreate) SetNillableIsActive(v *bool) *DeliveryZoneCreate {
+	if v != nil {
+		_c.SetIsActive(*v)
+	}
+	return _c
+}
+
+// SetRestaurantID sets the "restaurant_id" field.
+func (_c *DeliveryZoneCreate) SetRestaurantID(v uuid.UUID) *DeliveryZoneCreate {
+	_c.mutation.SetRestaurantID(v)
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *DeliveryZoneCreate) SetID(v uuid.UUID) *DeliveryZoneCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *DeliveryZoneCreate) SetNillableID(v *uuid.UUID) *DeliveryZoneCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetRestaurant sets the "restaurant" edge to the Restaurant entity.
+func (_c *DeliveryZoneCreate) SetRestaurant(v *Restaurant) *DeliveryZoneCreate {
+	return _c.SetRestaurantID(v.ID)
+}
+
+// AddOrderIDs adds the "orders" edge to the Order entity by IDs.
+func (_c *DeliveryZoneCreate) AddOrderIDs(ids ...uuid.UUID) *DeliveryZoneCreate {
+	_c.mutation.AddOrderIDs(ids...)
+	return _c
+}
+
+// AddOrders adds the "orders" edges to the Order entity.
+func (_c *DeliveryZoneCreate) AddOrders(v ...*Order) *DeliveryZoneCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddOrderIDs(ids...)
+}
+
+// Mutation returns the DeliveryZoneMutation object of the builder.
+func (_c *DeliveryZoneCreate) Mutation() *DeliveryZoneMutation {
+	return _c.mutation
+}
+
+// Save creates the DeliveryZone in the database.
+func (_c *DeliveryZoneCreate) Save(ctx context.Context) (*DeliveryZone, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *DeliveryZoneCreate) SaveX(ctx context.Context) *DeliveryZone {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *DeliveryZoneCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *DeliveryZoneCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *DeliveryZoneCreate) defaults() {
+	if _, ok := _c.mutation.UpdateTime(); !ok {
+		v := deliveryzone.DefaultUpdateTime()
+		_c.mutation.SetUpdateTime(v)
+	}
+	if _, ok := _c.mutation.CreateTime(); !ok {
+		v := deliveryzone.DefaultCreateTime()
+		_c.mutation.SetCreateTime(v)
+	}
+	if _, ok := _c.mutation.DeliveryFee(); !ok {
+		v := deliveryzone.DefaultDeliveryFee
+		_c.mutation.SetDeliveryFee(v)
+	}
+	if _, ok := _c.mutation.MinOrderValue(); !ok {
+		v := deliveryzone.DefaultMinOrderValue
+		_c.mutation.SetMinOrderValue(v)
+	}
+	if _, ok := _c.mutation.IsActive(); !ok {
+		v := deliveryzone.DefaultIsActive
+		_c.mutation.SetIsActive(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := deliveryzone.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *DeliveryZoneCreate) check() error {
+	if _, ok := _c.mutation.UpdateTime(); !ok {
+		return &ValidationError{Name: "update_time", err: errors.New(`ent: missing required field "DeliveryZone.update_time"`)}
+	}
+	if _, ok := _c.mutation.CreateTime(); !ok {
+		return &ValidationError{Name: "create_time", err: errors.New(`ent: missing required field "DeliveryZone.create_time"`)}
+	}
+	if _, ok := _c.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "DeliveryZone.name"`)}
+	}
+	if v, ok := _c.mutation.Name(); ok {
+		if err := deliveryzone.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "DeliveryZone.name": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Kind(); !ok {
+		return &ValidationError{Name: "kind", err: errors.New(`ent: missing required field "DeliveryZone.kind"`)}
+	}
+	if v, ok := _c.mutation.Kind(); ok {
+		if err := deliveryzone.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`ent: validator failed for field "DeliveryZone.kind": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.DeliveryFee(); !ok {
+		return &ValidationError{Name: "delivery_fee", err: errors.New(`ent: missing required field "DeliveryZone.delivery_fee"`)}
+	}
+	if v, ok := _c.mutation.DeliveryFee(); ok {
+		if err := deliveryzone.DeliveryFeeValidator(v); err != nil {
+			return &ValidationError{Name: "delivery_fee", err: fmt.Errorf(`ent: validator failed for field "DeliveryZone.delivery_fee": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.MinOrderValue(); !ok {
+		return &ValidationError{Name: "min_order_value", err: errors.New(`ent: missing required field "DeliveryZone.min_order_value"`)}
+	}
+	if v, ok := _c.mutation.MinOrderValue(); ok {
+		if err := deliveryzone.MinOrderValueValidator(v); err != nil {
+			return &ValidationError{Name: "min_order_value", err: fmt.Errorf(`ent: validator failed for field "DeliveryZone.min_order_value": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.IsActive(); !ok {
+		return &ValidationError{Name: "is_active", err: errors.New(`ent: missing required field "DeliveryZone.is_active"`)}
+	}
+	if _, ok := _c.mutation.RestaurantID(); !ok {
+		return &ValidationError{Name: "restaurant_id", err: errors.New(`ent: missing required field "DeliveryZone.restaurant_id"`)}
+	}
+	if len(_c.mutation.RestaurantIDs()) == 0 {
+		return &ValidationError{Name: "restaurant", err: errors.New(`ent: missing required edge "DeliveryZone.restaurant"`)}
+	}
+	return nil
+}
+
+func (_c *DeliveryZoneCreate) sqlSave(ctx context.Context) (*DeliveryZone, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *DeliveryZoneCreate) createSpec() (*DeliveryZone, *sqlgraph.CreateSpec) {
+	var (
+		_node = &DeliveryZone{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(deliveryzone.Table, sqlgraph.NewFieldSpec(deliveryzone.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.UpdateTime(); ok {
+		_spec.SetField(deliveryzone.FieldUpdateTime, field.TypeTime, value)
+		_node.UpdateTime = value
+	}
+	if value, ok := _c.mutation.CreateTime(); ok {
+		_spec.SetField(deliveryzone.FieldCreateTime, field.TypeTime, value)
+		_node.CreateTime = value
+	}
+	if value, ok := _c.mutation.Name(); ok {
+		_spec.SetField(deliveryzone.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := _c.mutation.Kind(); ok {
+		_spec.SetField(deliveryzone.FieldKind, field.TypeEnum, value)
+		_node.Kind = value
+	}
+	if value, ok := _c.mutation.RadiusMeters(); ok {
+		_spec.SetField(deliveryzone.FieldRadiusMeters, field.TypeInt, value)
+		_node.RadiusMeters = &value
+	}
+	if value, ok := _c.mutation.Polygon(); ok {
+		_spec.SetField(deliveryzone.FieldPolygon, field.TypeJSON, value)
+		_node.Polygon = value
+	}
+	if value, ok := _c.mutation.DeliveryFee(); ok {
+		_spec.SetField(deliveryzone.FieldDeliveryFee, field.TypeInt64, value)
+		_node.DeliveryFee = value
+	}
+	if value, ok := _c.mutation.MinOrderValue(); ok {
+		_spec.SetField(deliveryzone.FieldMinOrderValue, field.TypeInt64, value)
+		_node.MinOrderValue = value
+	}
+	if value, ok := _c.mutation.IsActive(); ok {
+		_spec.SetField(deliveryzone.FieldIsActive, field.TypeBool, value)
+		_node.IsActive = value
+	}
+	if nodes := _c.mutation.RestaurantIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   deliveryzone.RestaurantTable,
+			Columns: []string{deliveryzone.RestaurantColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(restaurant.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.RestaurantID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.OrdersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   deliveryzone.OrdersTable,
+			Columns: []string{deliveryzone.OrdersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(order.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// DeliveryZoneCreateBulk is the builder for creating many DeliveryZone entities in bulk.
+type DeliveryZoneCreateBulk struct {
+	config
+	err      error
+	builders []*DeliveryZoneCreate
+}
+
+// Save creates the DeliveryZone entities in the database.
+func (_c *DeliveryZoneCreateBulk) Save(ctx context.Context) ([]*DeliveryZone, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*DeliveryZone, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*DeliveryZoneMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *DeliveryZoneCreateBulk) SaveX(ctx context.Context) []*DeliveryZone {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *DeliveryZoneCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *DeliveryZoneCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,780 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/Jiruu246/rms/internal/ent/deliveryzone"
+	"github.com/Jiruu246/rms/internal/ent/order"
+	"github.com/Jiruu246/rms/internal/ent/predicate"
+	"github.com/Jiruu246/rms/pkg/geo"
+	"github.com/google/uuid"
+)
+
+// DeliveryZoneUpdate is the builder for updating DeliveryZone entities.
+type DeliveryZoneUpdate struct {
+	config
+	hooks    []Hook
+	mutation *DeliveryZoneMutation
+}
+
+// Where appends a list predicates to the DeliveryZoneUpdate builder.
+func (_u *DeliveryZoneUpdate) Where(ps ...predicate.DeliveryZone) *DeliveryZoneUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_u *DeliveryZoneUpdate) SetUpdateTime(v time.Time) *DeliveryZoneUpdate {
+	_u.mutation.SetUpdateTime(v)
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *DeliveryZoneUpdate) SetName(v string) *DeliveryZoneUpdate {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *DeliveryZoneUpdate) SetNillableName(v *string) *DeliveryZoneUpdate {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetKind sets the "kind" field.
+func (_u *DeliveryZoneUpdate) SetKind(v deliveryzone.Kind) *DeliveryZoneUpdate {
+	_u.mutation.SetKind(v)
+	return _u
+}
+
+// SetNillableKind sets the "kind" field if the given value is not nil.
+func (_u *DeliveryZoneUpdate) SetNillableKind(v *deliveryzone.Kind) *DeliveryZoneUpdate {
+	if v != nil {
+		_u.SetKind(*v)
+	}
+	return _u
+}
+
+// SetRadiusMeters sets the "radius_meters" field.
+func (_u *DeliveryZoneUpdate) SetRadiusMeters(v int) *DeliveryZoneUpdate {
+	_u.mutation.ResetRadiusMeters()
+	_u.mutation.SetRadiusMeters(v)
+	return _u
+}
+
+// SetNillableRadiusMeters sets the "radius_meters" field if the given value is not nil.
+func (_u *DeliveryZoneUpdate) SetNillableRadiusMeters(v *int) *DeliveryZoneUpdate {
+	if v != nil {
+		_u.SetRadiusMeters(*v)
+	}
+	return _u
+}
+
+// AddRadiusMeters adds value to the "radius_meters" field.
+func (_u *DeliveryZoneUpdate) AddRadiusMeters(v int) *DeliveryZoneUpdate {
+	_u.mutation.AddRadiusMeters(v)
+	return _u
+}
+
+// ClearRadiusMeters clears the value of the "radius_meters" field.
+func (_u *DeliveryZoneUpdate) ClearRadiusMeters() *DeliveryZoneUpdate {
+	_u.mutation.ClearRadiusMeters()
+	return _u
+}
+
+// SetPolygon sets the "polygon" field.
+func (_u *DeliveryZoneUpdate) SetPolygon(v []geo.Point) *DeliveryZoneUpdate {
+	_u.mutation.SetPolygon(v)
+	return _u
+}
+
+// AppendPolygon appends value to the "polygon" field.
+func (_u *DeliveryZoneUpdate) AppendPolygon(v []geo.Point) *DeliveryZoneUpdate {
+	_u.mutation.AppendPolygon(v)
+	return _u
+}
+
+// ClearPolygon clears the value of the "polygon" field.
+func (_u *DeliveryZoneUpdate) ClearPolygon() *DeliveryZoneUpdate {
+	_u.mutation.ClearPolygon()
+	return _u
+}
+
+// SetDeliveryFee sets the "delivery_fee" field.
+func (_u *DeliveryZoneUpdate) SetDeliveryFee(v int64) *DeliveryZoneUpdate {
+	_u.mutation.ResetDeliveryFee()
+	_u.mutation.SetDeliveryFee(v)
+	return _u
+}
+
+// SetNillableDeliveryFee sets the "delivery_fee" field if the given value is not nil.
+func (_u *DeliveryZoneUpdate) SetNillableDeliveryFee(v *int64) *DeliveryZoneUpdate {
+	if v != nil {
+		_u.SetDeliveryFee(*v)
+	}
+	return _u
+}
+
+// AddDeliveryFee adds value to the "delivery_fee" field.
+func (_u *DeliveryZoneUpdate) AddDeliveryFee(v int64) *DeliveryZoneUpdate {
+	_u.mutation.AddDeliveryFee(v)
+	return _u
+}
+
+// SetMinOrderValue sets the "min_order_value" field.
+func (_u *DeliveryZoneUpdate) SetMinOrderValue(v int64) *DeliveryZoneUpdate {
+	_u.mutation.ResetMinOrderValue()
+	_u.mutation.SetMinOrderValue(v)
+	return _u
+}
+
+// SetNillableMinOrderValue sets the "min_order_value" field if the given value is not nil.
+func (_u *DeliveryZoneUpdate) SetNillableMinOrderValue(v *int64) *DeliveryZoneUpdate {
+	if v != nil {
+		_u.SetMinOrderValue(*v)
+	}
+	return _u
+}
+
+// AddMinOrderValue adds value to the "min_order_value" field.
+func (_u *DeliveryZoneUpdate) AddMinOrderValue(v int64) *DeliveryZoneUpdate {
+	_u.mutation.AddMinOrderValue(v)
+	return _u
+}
+
+// SetIsActive sets the "is_active" field.
+func (_u *DeliveryZoneUpdate) SetIsActive(v bool) *DeliveryZoneUpdate {
+	_u.mutation.SetIsActive(v)
+	return _u
+}
+
+// SetNillableIsActive sets the "is_active" field if the given value is not nil.
+func (_u *DeliveryZoneUpdate) SetNillableIsActive(v *bool) *DeliveryZoneUpdate {
+	if v != nil {
+		_u.SetIsActive(*v)
+	}
+	return _u
+}
+
+// AddOrderIDs adds the "orders" edge to the Order entity by IDs.
+func (_u *DeliveryZoneUpdate) AddOrderIDs(ids ...uuid.UUID) *DeliveryZoneUpdate {
+	_u.mutation.AddOrderIDs(ids...)
+	return _u
+}
+
+// AddOrders adds the "orders" edges to the Order entity.
+func (_u *DeliveryZoneUpdate) AddOrders(v ...*Order) *DeliveryZoneUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddOrderIDs(ids...)
+}
+
+// Mutation returns the DeliveryZoneMutation object of the builder.
+func (_u *DeliveryZoneUpdate) Mutation() *DeliveryZoneMutation {
+	return _u.mutation
+}
+
+// ClearOrders clears all "orders" edges to the Order entity.
+func (_u *DeliveryZoneUpdate) ClearOrders() *DeliveryZoneUpdate {
+	_u.mutation.ClearOrders()
+	return _u
+}
+
+// RemoveOrderIDs removes the "orders" edge to Order entities by IDs.
+func (_u *DeliveryZoneUpdate) RemoveOrderIDs(ids ...uuid.UUID) *DeliveryZoneUpdate {
+	_u.mutation.RemoveOrderIDs(ids...)
+	return _u
+}
+
+// RemoveOrders removes "orders" edges to Order entities.
+func (_u *DeliveryZoneUpdate) RemoveOrders(v ...*Order) *DeliveryZoneUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveOrderIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *DeliveryZoneUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *DeliveryZoneUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *DeliveryZoneUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *DeliveryZoneUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *DeliveryZoneUpdate) defaults() {
+	if _, ok := _u.mutation.UpdateTime(); !ok {
+		v := deliveryzone.UpdateDefaultUpdateTime()
+		_u.mutation.SetUpdateTime(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *DeliveryZoneUpdate) check() error {
+	if v, ok := _u.mutation.Name(); ok {
+		if err := deliveryzone.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "DeliveryZone.name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Kind(); ok {
+		if err := deliveryzone.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`ent: validator failed for field "DeliveryZone.kind": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.DeliveryFee(); ok {
+		if err := deliveryzone.DeliveryFeeValidator(v); err != nil {
+			return &ValidationError{Name: "delivery_fee", err: fmt.Errorf(`ent: validator failed for field "DeliveryZone.delivery_fee": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.MinOrderValue(); ok {
+		if err := deliveryzone.MinOrderValueValidator(v); err != nil {
+			return &ValidationError{Name: "min_order_value", err: fmt.Errorf(`ent: validator failed for field "DeliveryZone.min_order_value": %w`, err)}
+		}
+	}
+	if _u.mutation.RestaurantCleared() && len(_u.mutation.RestaurantIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "DeliveryZone.restaurant"`)
+	}
+	return nil
+}
+
+func (_u *DeliveryZoneUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(deliveryzone.Table, deliveryzone.Columns, sqlgraph.NewFieldSpec(deliveryzone.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdateTime(); ok {
+		_spec.SetField(deliveryzone.FieldUpdateTime, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(deliveryzone.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Kind(); ok {
+		_spec.SetField(deliveryzone.FieldKind, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.RadiusMeters(); ok {
+		_spec.SetField(deliveryzone.FieldRadiusMeters, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedRadiusMeters(); ok {
+		_spec.AddField(deliveryzone.FieldRadiusMeters, field.TypeInt, value)
+	}
+	if _u.mutation.RadiusMetersCleared() {
+		_spec.ClearField(deliveryzone.FieldRadiusMeters, field.TypeInt)
+	}
+	if value, ok := _u.mutation.Polygon(); ok {
+		_spec.SetField(deliveryzone.FieldPolygon, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedPolygon(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, deliveryzone.FieldPolygon, value)
+		})
+	}
+	if _u.mutation.PolygonCleared() {
+		_spec.ClearField(deliveryzone.FieldPolygon, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.DeliveryFee(); ok {
+		_spec.SetField(deliveryzone.FieldDeliveryFee, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedDeliveryFee(); ok {
+		_spec.AddField(deliveryzone.FieldDeliveryFee, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.MinOrderValue(); ok {
+		_spec.SetField(deliveryzone.FieldMinOrderValue, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedMinOrderValue(); ok {
+		_spec.AddField(deliveryzone.FieldMinOrderValue, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.IsActive(); ok {
+		_spec.SetField(deliveryzone.FieldIsActive, field.TypeBool, value)
+	}
+	if _u.mutation.OrdersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   deliveryzone.OrdersTable,
+			Columns: []string{deliveryzone.OrdersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(order.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedOrdersIDs(); len(nodes) > 0 && !_u.mutation.OrdersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   deliveryzone.OrdersTable,
+			Columns: []string{deliveryzone.OrdersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(order.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.OrdersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   deliveryzone.OrdersTable,
+			Columns: []string{deliveryzone.OrdersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(order.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{deliveryzone.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// DeliveryZoneUpdateOne is the builder for updating a single DeliveryZone entity.
+type DeliveryZoneUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *DeliveryZoneMutation
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_u *DeliveryZoneUpdateOne) SetUpdateTime(v time.Time) *DeliveryZoneUpdateOne {
+	_u.mutation.SetUpdateTime(v)
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *DeliveryZoneUpdateOne) SetName(v string) *DeliveryZoneUpdateOne {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *DeliveryZoneUpdateOne) SetNillableName(v *string) *DeliveryZoneUpdateOne {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetKind sets the "kind" field.
+func (_u *DeliveryZoneUpdateOne) SetKind(v deliveryzone.Kind) *DeliveryZoneUpdateOne {
+	_u.mutation.SetKind(v)
+	return _u
+}
+
+// SetNillableKind sets the "kind" field if the given value is not nil.
+func (_u *DeliveryZoneUpdateOne) SetNillableKind(v *deliveryzone.Kind) *DeliveryZoneUpdateOne {
+	if v != nil {
+		_u.SetKind(*v)
+	}
+	return _u
+}
+
+// SetRadiusMeters sets the "radius_meters" field.
+func (_u *DeliveryZoneUpdateOne) SetRadiusMeters(v int) *DeliveryZoneUpdateOne {
+	_u.mutation.ResetRadiusMeters()
+	_u.mutation.SetRadiusMeters(v)
+	return _u
+}
+
+// SetNillableRadiusMeters sets the "radius_meters" field if the given value is not nil.
+func (_u *DeliveryZoneUpdateOne) SetNillableRadiusMeters(v *int) *DeliveryZoneUpdateOne {
+	if v != nil {
+		_u.SetRadiusMeters(*v)
+	}
+	return _u
+}
+
+// AddRadiusMeters adds value to the "radius_meters" field.
+func (_u *DeliveryZoneUpdateOne) AddRadiusMeters(v int) *DeliveryZoneUpdateOne {
+	_u.mutation.AddRadiusMeters(v)
+	return _u
+}
+
+// ClearRadiusMeters clears the value of the "radius_meters" field.
+func (_u *DeliveryZoneUpdateOne) ClearRadiusMeters() *DeliveryZoneUpdateOne {
+	_u.mutation.ClearRadiusMeters()
+	return _u
+}
+
+// SetPolygon sets the "polygon" field.
+func (_u *DeliveryZoneUpdateOne) SetPolygon(v []geo.Point) *DeliveryZoneUpdateOne {
+	_u.mutation.SetPolygon(v)
+	return _u
+}
+
+// AppendPolygon appends value to the "polygon" field.
+func (_u *DeliveryZoneUpdateOne) AppendPolygon(v []geo.Point) *DeliveryZoneUpdateOne {
+	_u.mutation.AppendPolygon(v)
+	return _u
+}
+
+// ClearPolygon clears the value of the "polygon" field.
+func (_u *DeliveryZoneUpdateOne) ClearPolygon() *DeliveryZoneUpdateOne {
+	_u.mutation.ClearPolygon()
+	return _u
+}
+
+// SetDeliveryFee sets the "delivery_fee" field.
+func (_u *DeliveryZoneUpdateOne) SetDeliveryFee(v int64) *DeliveryZoneUpdateOne {
+	_u.mutation.ResetDeliveryFee()
+	_u.mutation.SetDeliveryFee(v)
+	return _u
+}
+
+// SetNillableDeliveryFee sets the "delivery_fee" field if the given value is not nil.
+func (_u *DeliveryZoneUpdateOne) SetNillableDeliveryFee(v *int64) *DeliveryZoneUpdateOne {
+	if v != nil {
+		_u.SetDeliveryFee(*v)
+	}
+	return _u
+}
+
+// AddDeliveryFee adds value to the "delivery_fee" field.
+func (_u *DeliveryZoneUpdateOne) AddDeliveryFee(v int64) *DeliveryZoneUpdateOne {
+	_u.mutation.AddDeliveryFee(v)
+	return _u
+}
+
+// SetMinOrderValue sets the "min_order_value" field.
+func (_u *DeliveryZoneUpdateOne) SetMinOrderValue(v int64) *DeliveryZoneUpdateOne {
+	_u.mutation.ResetMinOrderValue()
+	_u.mutation.SetMinOrderValue(v)
+	return _u
+}
+
+// SetNillableMinOrderValue sets the "min_order_value" field if the given value is not nil.
+func (_u *DeliveryZoneUpdateOne) SetNillableMinOrderValue(v *int64) *DeliveryZoneUpdateOne {
+	if v != nil {
+		_u.SetMinOrderValue(*v)
+	}
+	return _u
+}
+
+// AddMinOrderValue adds value to the "min_order_value" field.
+func (_u *DeliveryZoneUpdateOne) AddMinOrderValue(v int64) *DeliveryZoneUpdateOne {
+	_u.mutation.AddMinOrderValue(v)
+	return _u
+}
+
+// SetIsActive sets the "is_active" field.
+func (_u *DeliveryZoneUpdateOne) SetIsActive(v bool) *DeliveryZoneUpdateOne {
+	_u.mutation.SetIsActive(v)
+	return _u
+}
+
+// SetNillableIsActive sets the "is_active" field if the given value is not nil.
+func (_u *DeliveryZoneUpdateOne) SetNillableIsActive(v *bool) *DeliveryZoneUpdateOne {
+	if v != nil {
+		_u.SetIsActive(*v)
+	}
+	return _u
+}
+
+// AddOrderIDs adds the "orders" edge to the Order entity by IDs.
+func (_u *DeliveryZoneUpdateOne) AddOrderIDs(ids ...uuid.UUID) *DeliveryZoneUpdateOne {
+	_u.mutation.AddOrderIDs(ids...)
+	return _u
+}
+
+// AddOrders adds the "orders" edges to the Order entity.
+func (_u *DeliveryZoneUpdateOne) AddOrders(v ...*Order) *DeliveryZoneUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddOrderIDs(ids...)
+}
+
+// Mutation returns the DeliveryZoneMutation object of the builder.
+func (_u *DeliveryZoneUpdateOne) Mutation() *DeliveryZoneMutation {
+	return _u.mutation
+}
+
+// ClearOrders clears all "orders" edges to the Order entity.
+func (_u *DeliveryZoneUpdateOne) ClearOrders() *DeliveryZoneUpdateOne {
+	_u.mutation.ClearOrders()
+	return _u
+}
+
+// RemoveOrderIDs removes the "orders" edge to Order entities by IDs.
+func (_u *DeliveryZoneUpdateOne) RemoveOrderIDs(ids ...uuid.UUID) *DeliveryZoneUpdateOne {
+	_u.mutation.RemoveOrderIDs(ids...)
+	return _u
+}
+
+// RemoveOrders removes "orders" edges to Order entities.
+func (_u *DeliveryZoneUpdateOne) RemoveOrders(v ...*Order) *DeliveryZoneUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveOrderIDs(ids...)
+}
+
+// Where appends a list predicates to the DeliveryZoneUpdate builder.
+func (_u *DeliveryZoneUpdateOne) Where(ps ...predicate.DeliveryZone) *DeliveryZoneUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *DeliveryZoneUpdateOne) Select(field string, fields ...string) *DeliveryZoneUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated DeliveryZone entity.
+func (_u *DeliveryZoneUpdateOne) Save(ctx context.Context) (*DeliveryZone, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *DeliveryZoneUpdateOne) SaveX(ctx context.Context) *DeliveryZone {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *DeliveryZoneUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *DeliveryZoneUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *DeliveryZoneUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdateTime(); !ok {
+		v := deliveryzone.UpdateDefaultUpdateTime()
+		_u.mutation.SetUpdateTime(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *DeliveryZoneUpdateOne) check() error {
+	if v, ok := _u.mutation.Name(); ok {
+		if err := deliveryzone.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "DeliveryZone.name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Kind(); ok {
+		if err := deliveryzone.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`ent: validator failed for field "DeliveryZone.kind": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.DeliveryFee(); ok {
+		if err := deliveryzone.DeliveryFeeValidator(v); err != nil {
+			return &ValidationError{Name: "delivery_fee", err: fmt.Errorf(`ent: validator failed for field "DeliveryZone.delivery_fee": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.MinOrderValue(); ok {
+		if err := deliveryzone.MinOrderValueValidator(v); err != nil {
+			return &ValidationError{Name: "min_order_value", err: fmt.Errorf(`ent: validator failed for field "DeliveryZone.min_order_value": %w`, err)}
+		}
+	}
+	if _u.mutation.RestaurantCleared() && len(_u.mutation.RestaurantIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "DeliveryZone.restaurant"`)
+	}
+	return nil
+}
+
+func (_u *DeliveryZoneUpdateOne) sqlSave(ctx context.Context) (_node *DeliveryZone, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(deliveryzone.Table, deliveryzone.Columns, sqlgraph.NewFieldSpec(deliveryzone.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "DeliveryZone.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, deliveryzone.FieldID)
+		for _, f := range fields {
+			if !deliveryzone.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != deliveryzone.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdateTime(); ok {
+		_spec.SetField(deliveryzone.FieldUpdateTime, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(deliveryzone.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Kind(); ok {
+		_spec.SetField(deliveryzone.FieldKind, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.RadiusMeters(); ok {
+		_spec.SetField(deliveryzone.FieldRadiusMeters, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedRadiusMeters(); ok {
+		_spec.AddField(deliveryzone.FieldRadiusMeters, field.TypeInt, value)
+	}
+	if _u.mutation.RadiusMetersCleared() {
+		_spec.ClearField(deliveryzone.FieldRadiusMeters, field.TypeInt)
+	}
+	if value, ok := _u.mutation.Polygon(); ok {
+		_spec.SetField(deliveryzone.FieldPolygon, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedPolygon(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, deliveryzone.FieldPolygon, value)
+		})
+	}
+	if _u.mutation.PolygonCleared() {
+		_spec.ClearField(deliveryzone.FieldPolygon, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.DeliveryFee(); ok {
+		_spec.SetField(deliveryzone.FieldDeliveryFee, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedDeliveryFee(); ok {
+		_spec.AddField(deliveryzone.FieldDeliveryFee, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.MinOrderValue(); ok {
+		_spec.SetField(deliveryzone.FieldMinOrderValue, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedMinOrderValue(); ok {
+		_spec.AddField(deliveryzone.FieldMinOrderValue, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.IsActive(); ok {
+		_spec.SetField(deliveryzone.FieldIsActive, field.TypeBool, value)
+	}
+	if _u.mutation.OrdersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   deliveryzone.OrdersTable,
+			Columns: []string{deliveryzone.OrdersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(order.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedOrdersIDs(); len(nodes) > 0 && !_u.mutation.OrdersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   deliveryzone.OrdersTable,
+			Columns: []string{deliveryzone.OrdersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(order.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.OrdersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   deliveryzone.OrdersTable,
+			Columns: []string{deliveryzone.OrdersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(order.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &DeliveryZone{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{deliveryzone.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
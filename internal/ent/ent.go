@@ -13,14 +13,34 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"github.com/Jiruu246/rms/internal/ent/category"
+	"github.com/Jiruu246/rms/internal/ent/deliveryzone"
+	"github.com/Jiruu246/rms/internal/ent/idempotencykey"
+	"github.com/Jiruu246/rms/internal/ent/ingredient"
+	"github.com/Jiruu246/rms/internal/ent/menu"
 	"github.com/Jiruu246/rms/internal/ent/menuitem"
+	"github.com/Jiruu246/rms/internal/ent/menuitemmodifier"
+	"github.com/Jiruu246/rms/internal/ent/menuitemprice"
+	"github.com/Jiruu246/rms/internal/ent/menuitemvariant"
 	"github.com/Jiruu246/rms/internal/ent/modifier"
 	"github.com/Jiruu246/rms/internal/ent/modifieroption"
 	"github.com/Jiruu246/rms/internal/ent/order"
+	"github.com/Jiruu246/rms/internal/ent/orderevent"
 	"github.com/Jiruu246/rms/internal/ent/orderitem"
+	"github.com/Jiruu246/rms/internal/ent/orderitemchange"
 	"github.com/Jiruu246/rms/internal/ent/orderitemmodifieroption"
+	"github.com/Jiruu246/rms/internal/ent/ordernumbersequence"
+	"github.com/Jiruu246/rms/internal/ent/orderstatusevent"
+	"github.com/Jiruu246/rms/internal/ent/payment"
+	"github.com/Jiruu246/rms/internal/ent/ratelimitbucket"
+	"github.com/Jiruu246/rms/internal/ent/recipeingredient"
 	"github.com/Jiruu246/rms/internal/ent/refreshtoken"
+	"github.com/Jiruu246/rms/internal/ent/refund"
 	"github.com/Jiruu246/rms/internal/ent/restaurant"
+	"github.com/Jiruu246/rms/internal/ent/station"
+	"github.com/Jiruu246/rms/internal/ent/stationticket"
+	"github.com/Jiruu246/rms/internal/ent/stockmovement"
+	"github.com/Jiruu246/rms/internal/ent/table"
+	"github.com/Jiruu246/rms/internal/ent/tablesession"
 	"github.com/Jiruu246/rms/internal/ent/user"
 	"github.com/Jiruu246/rms/internal/ent/userauthprovider"
 )
@@ -84,14 +104,34 @@ func checkColumn(t, c string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
 			category.Table:                category.ValidColumn,
+			deliveryzone.Table:            deliveryzone.ValidColumn,
+			idempotencykey.Table:          idempotencykey.ValidColumn,
+			ingredient.Table:              ingredient.ValidColumn,
+			menu.Table:                    menu.ValidColumn,
 			menuitem.Table:                menuitem.ValidColumn,
+			menuitemmodifier.Table:        menuitemmodifier.ValidColumn,
+			menuitemprice.Table:           menuitemprice.ValidColumn,
+			menuitemvariant.Table:         menuitemvariant.ValidColumn,
 			modifier.Table:                modifier.ValidColumn,
 			modifieroption.Table:          modifieroption.ValidColumn,
 			order.Table:                   order.ValidColumn,
+			orderevent.Table:              orderevent.ValidColumn,
 			orderitem.Table:               orderitem.ValidColumn,
+			orderitemchange.Table:         orderitemchange.ValidColumn,
 			orderitemmodifieroption.Table: orderitemmodifieroption.ValidColumn,
+			ordernumbersequence.Table:     ordernumbersequence.ValidColumn,
+			orderstatusevent.Table:        orderstatusevent.ValidColumn,
+			payment.Table:                 payment.ValidColumn,
+			ratelimitbucket.Table:         ratelimitbucket.ValidColumn,
+			recipeingredient.Table:        recipeingredient.ValidColumn,
 			refreshtoken.Table:            refreshtoken.ValidColumn,
+			refund.Table:                  refund.ValidColumn,
 			restaurant.Table:              restaurant.ValidColumn,
+			station.Table:                 station.ValidColumn,
+			stationticket.Table:           stationticket.ValidColumn,
+			stockmovement.Table:           stockmovement.ValidColumn,
+			table.Table:                   table.ValidColumn,
+			tablesession.Table:            tablesession.ValidColumn,
 			user.Table:                    user.ValidColumn,
 			userauthprovider.Table:        userauthprovider.ValidColumn,
 		})
@@ -21,6 +21,54 @@ func (f CategoryFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, er
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.CategoryMutation", m)
 }
 
+// The DeliveryZoneFunc type is an adapter to allow the use of ordinary
+// function as DeliveryZone mutator.
+type DeliveryZoneFunc func(context.Context, *ent.DeliveryZoneMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f DeliveryZoneFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.DeliveryZoneMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.DeliveryZoneMutation", m)
+}
+
+// The IdempotencyKeyFunc type is an adapter to allow the use of ordinary
+// function as IdempotencyKey mutator.
+type IdempotencyKeyFunc func(context.Context, *ent.IdempotencyKeyMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f IdempotencyKeyFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.IdempotencyKeyMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.IdempotencyKeyMutation", m)
+}
+
+// The IngredientFunc type is an adapter to allow the use of ordinary
+// function as Ingredient mutator.
+type IngredientFunc func(context.Context, *ent.IngredientMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f IngredientFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.IngredientMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.IngredientMutation", m)
+}
+
+// The MenuFunc type is an adapter to allow the use of ordinary
+// function as Menu mutator.
+type MenuFunc func(context.Context, *ent.MenuMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f MenuFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.MenuMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.MenuMutation", m)
+}
+
 // The MenuItemFunc type is an adapter to allow the use of ordinary
 // function as MenuItem mutator.
 type MenuItemFunc func(context.Context, *ent.MenuItemMutation) (ent.Value, error)
@@ -33,6 +81,42 @@ func (f MenuItemFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, er
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.MenuItemMutation", m)
 }
 
+// The MenuItemModifierFunc type is an adapter to allow the use of ordinary
+// function as MenuItemModifier mutator.
+type MenuItemModifierFunc func(context.Context, *ent.MenuItemModifierMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f MenuItemModifierFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.MenuItemModifierMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.MenuItemModifierMutation", m)
+}
+
+// The MenuItemPriceFunc type is an adapter to allow the use of ordinary
+// function as MenuItemPrice mutator.
+type MenuItemPriceFunc func(context.Context, *ent.MenuItemPriceMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f MenuItemPriceFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.MenuItemPriceMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.MenuItemPriceMutation", m)
+}
+
+// The MenuItemVariantFunc type is an adapter to allow the use of ordinary
+// function as MenuItemVariant mutator.
+type MenuItemVariantFunc func(context.Context, *ent.MenuItemVariantMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f MenuItemVariantFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.MenuItemVariantMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.MenuItemVariantMutation", m)
+}
+
 // The ModifierFunc type is an adapter to allow the use of ordinary
 // function as Modifier mutator.
 type ModifierFunc func(context.Context, *ent.ModifierMutation) (ent.Value, error)
@@ -69,6 +153,18 @@ func (f OrderFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.OrderMutation", m)
 }
 
+// The OrderEventFunc type is an adapter to allow the use of ordinary
+// function as OrderEvent mutator.
+type OrderEventFunc func(context.Context, *ent.OrderEventMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f OrderEventFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.OrderEventMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.OrderEventMutation", m)
+}
+
 // The OrderItemFunc type is an adapter to allow the use of ordinary
 // function as OrderItem mutator.
 type OrderItemFunc func(context.Context, *ent.OrderItemMutation) (ent.Value, error)
@@ -81,6 +177,18 @@ func (f OrderItemFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, e
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.OrderItemMutation", m)
 }
 
+// The OrderItemChangeFunc type is an adapter to allow the use of ordinary
+// function as OrderItemChange mutator.
+type OrderItemChangeFunc func(context.Context, *ent.OrderItemChangeMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f OrderItemChangeFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.OrderItemChangeMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.OrderItemChangeMutation", m)
+}
+
 // The OrderItemModifierOptionFunc type is an adapter to allow the use of ordinary
 // function as OrderItemModifierOption mutator.
 type OrderItemModifierOptionFunc func(context.Context, *ent.OrderItemModifierOptionMutation) (ent.Value, error)
@@ -93,6 +201,66 @@ func (f OrderItemModifierOptionFunc) Mutate(ctx context.Context, m ent.Mutation)
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.OrderItemModifierOptionMutation", m)
 }
 
+// The OrderNumberSequenceFunc type is an adapter to allow the use of ordinary
+// function as OrderNumberSequence mutator.
+type OrderNumberSequenceFunc func(context.Context, *ent.OrderNumberSequenceMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f OrderNumberSequenceFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.OrderNumberSequenceMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.OrderNumberSequenceMutation", m)
+}
+
+// The OrderStatusEventFunc type is an adapter to allow the use of ordinary
+// function as OrderStatusEvent mutator.
+type OrderStatusEventFunc func(context.Context, *ent.OrderStatusEventMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f OrderStatusEventFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.OrderStatusEventMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.OrderStatusEventMutation", m)
+}
+
+// The PaymentFunc type is an adapter to allow the use of ordinary
+// function as Payment mutator.
+type PaymentFunc func(context.Context, *ent.PaymentMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f PaymentFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.PaymentMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.PaymentMutation", m)
+}
+
+// The RateLimitBucketFunc type is an adapter to allow the use of ordinary
+// function as RateLimitBucket mutator.
+type RateLimitBucketFunc func(context.Context, *ent.RateLimitBucketMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f RateLimitBucketFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.RateLimitBucketMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.RateLimitBucketMutation", m)
+}
+
+// The RecipeIngredientFunc type is an adapter to allow the use of ordinary
+// function as RecipeIngredient mutator.
+type RecipeIngredientFunc func(context.Context, *ent.RecipeIngredientMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f RecipeIngredientFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.RecipeIngredientMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.RecipeIngredientMutation", m)
+}
+
 // The RefreshTokenFunc type is an adapter to allow the use of ordinary
 // function as RefreshToken mutator.
 type RefreshTokenFunc func(context.Context, *ent.RefreshTokenMutation) (ent.Value, error)
@@ -105,6 +273,18 @@ func (f RefreshTokenFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.RefreshTokenMutation", m)
 }
 
+// The RefundFunc type is an adapter to allow the use of ordinary
+// function as Refund mutator.
+type RefundFunc func(context.Context, *ent.RefundMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f RefundFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.RefundMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.RefundMutation", m)
+}
+
 // The RestaurantFunc type is an adapter to allow the use of ordinary
 // function as Restaurant mutator.
 type RestaurantFunc func(context.Context, *ent.RestaurantMutation) (ent.Value, error)
@@ -117,6 +297,66 @@ func (f RestaurantFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value,
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.RestaurantMutation", m)
 }
 
+// The StationFunc type is an adapter to allow the use of ordinary
+// function as Station mutator.
+type StationFunc func(context.Context, *ent.StationMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f StationFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.StationMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.StationMutation", m)
+}
+
+// The StationTicketFunc type is an adapter to allow the use of ordinary
+// function as StationTicket mutator.
+type StationTicketFunc func(context.Context, *ent.StationTicketMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f StationTicketFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.StationTicketMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.StationTicketMutation", m)
+}
+
+// The StockMovementFunc type is an adapter to allow the use of ordinary
+// function as StockMovement mutator.
+type StockMovementFunc func(context.Context, *ent.StockMovementMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f StockMovementFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.StockMovementMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.StockMovementMutation", m)
+}
+
+// The TableFunc type is an adapter to allow the use of ordinary
+// function as Table mutator.
+type TableFunc func(context.Context, *ent.TableMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f TableFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.TableMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.TableMutation", m)
+}
+
+// The TableSessionFunc type is an adapter to allow the use of ordinary
+// function as TableSession mutator.
+type TableSessionFunc func(context.Context, *ent.TableSessionMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f TableSessionFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.TableSessionMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.TableSessionMutation", m)
+}
+
 // The UserFunc type is an adapter to allow the use of ordinary
 // function as User mutator.
 type UserFunc func(context.Context, *ent.UserMutation) (ent.Value, error)
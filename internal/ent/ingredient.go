@@ -0,0 +1,225 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/Jiruu246/rms/internal/ent/ingredient"
+	"github.com/Jiruu246/rms/internal/ent/restaurant"
+	"github.com/google/uuid"
+)
+
+// Ingredient is the model entity for the Ingredient schema.
+type Ingredient struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// UpdateTime holds the value of the "update_time" field.
+	UpdateTime time.Time `json:"update_time,omitempty"`
+	// CreateTime holds the value of the "create_time" field.
+	CreateTime time.Time `json:"create_time,omitempty"`
+	// Ingredient name
+	Name string `json:"name,omitempty"`
+	// Unit on_hand and recipe quantities are counted in
+	Unit ingredient.Unit `json:"unit,omitempty"`
+	// Quantity in stock, in unit
+	OnHand int64 `json:"on_hand,omitempty"`
+	// ID of the restaurant this ingredient belongs to
+	RestaurantID uuid.UUID `json:"restaurant_id,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the IngredientQuery when eager-loading is set.
+	Edges        IngredientEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// IngredientEdges holds the relations/edges for other nodes in the graph.
+type IngredientEdges struct {
+	// Restaurant holds the value of the restaurant edge.
+	Restaurant *Restaurant `json:"restaurant,omitempty"`
+	// RecipeLines holds the value of the recipe_lines edge.
+	RecipeLines []*RecipeIngredient `json:"recipe_lines,omitempty"`
+	// Movements holds the value of the movements edge.
+	Movements []*StockMovement `json:"movements,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [3]bool
+}
+
+// RestaurantOrErr returns the Restaurant value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e IngredientEdges) RestaurantOrErr() (*Restaurant, error) {
+	if e.Restaurant != nil {
+		return e.Restaurant, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: restaurant.Label}
+	}
+	return nil, &NotLoadedError{edge: "restaurant"}
+}
+
+// RecipeLinesOrErr returns the RecipeLines value or an error if the edge
+// was not loaded in eager-loading.
+func (e IngredientEdges) RecipeLinesOrErr() ([]*RecipeIngredient, error) {
+	if e.loadedTypes[1] {
+		return e.RecipeLines, nil
+	}
+	return nil, &NotLoadedError{edge: "recipe_lines"}
+}
+
+// MovementsOrErr returns the Movements value or an error if the edge
+// was not loaded in eager-loading.
+func (e IngredientEdges) MovementsOrErr() ([]*StockMovement, error) {
+	if e.loadedTypes[2] {
+		return e.Movements, nil
+	}
+	return nil, &NotLoadedError{edge: "movements"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Ingredient) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case ingredient.FieldOnHand:
+			values[i] = new(sql.NullInt64)
+		case ingredient.FieldName, ingredient.FieldUnit:
+			values[i] = new(sql.NullString)
+		case ingredient.FieldUpdateTime, ingredient.FieldCreateTime:
+			values[i] = new(sql.NullTime)
+		case ingredient.FieldID, ingredient.FieldRestaurantID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Ingredient fields.
+func (_m *Ingredient) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case ingredient.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case ingredient.FieldUpdateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field update_time", values[i])
+			} else if value.Valid {
+				_m.UpdateTime = value.Time
+			}
+		case ingredient.FieldCreateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field create_time", values[i])
+			} else if value.Valid {
+				_m.CreateTime = value.Time
+			}
+		case ingredient.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				_m.Name = value.String
+			}
+		case ingredient.FieldUnit:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field unit", values[i])
+			} else if value.Valid {
+				_m.Unit = ingredient.Unit(value.String)
+			}
+		case ingredient.FieldOnHand:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field on_hand", values[i])
+			} else if value.Valid {
+				_m.OnHand = value.Int64
+			}
+		case ingredient.FieldRestaurantID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field restaurant_id", values[i])
+			} else if value != nil {
+				_m.RestaurantID = *value
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Ingredient.
+// This includes values selected through modifiers, order, etc.
+func (_m *Ingredient) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryRestaurant queries the "restaurant" edge of the Ingredient entity.
+func (_m *Ingredient) QueryRestaurant() *RestaurantQuery {
+	return NewIngredientClient(_m.config).QueryRestaurant(_m)
+}
+
+// QueryRecipeLines queries the "recipe_lines" edge of the Ingredient entity.
+func (_m *Ingredient) QueryRecipeLines() *RecipeIngredientQuery {
+	return NewIngredientClient(_m.config).QueryRecipeLines(_m)
+}
+
+// QueryMovements queries the "movements" edge of the Ingredient entity.
+func (_m *Ingredient) QueryMovements() *StockMovementQuery {
+	return NewIngredientClient(_m.config).QueryMovements(_m)
+}
+
+// Update returns a builder for updating this Ingredient.
+// Note that you need to call Ingredient.Unwrap() before calling this method if this Ingredient
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *Ingredient) Update() *IngredientUpdateOne {
+	return NewIngredientClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the Ingredient entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *Ingredient) Unwrap() *Ingredient {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Ingredient is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *Ingredient) String() string {
+	var builder strings.Builder
+	builder.WriteString("Ingredient(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("update_time=")
+	builder.WriteString(_m.UpdateTime.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("create_time=")
+	builder.WriteString(_m.CreateTime.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(_m.Name)
+	builder.WriteString(", ")
+	builder.WriteString("unit=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Unit))
+	builder.WriteString(", ")
+	builder.WriteString("on_hand=")
+	builder.WriteString(fmt.Sprintf("%v", _m.OnHand))
+	builder.WriteString(", ")
+	builder.WriteString("restaurant_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.RestaurantID))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Ingredients is a parsable slice of Ingredient.
+type Ingredients []*Ingredient
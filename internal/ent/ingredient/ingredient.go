@@ -0,0 +1,216 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ingredient
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the ingredient type in the database.
+	Label = "ingredient"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldUpdateTime holds the string denoting the update_time field in the database.
+	FieldUpdateTime = "update_time"
+	// FieldCreateTime holds the string denoting the create_time field in the database.
+	FieldCreateTime = "create_time"
+	// FieldName holds the string denoting the name field in the database.
+	FieldName = "name"
+	// FieldUnit holds the string denoting the unit field in the database.
+	FieldUnit = "unit"
+	// FieldOnHand holds the string denoting the on_hand field in the database.
+	FieldOnHand = "on_hand"
+	// FieldRestaurantID holds the string denoting the restaurant_id field in the database.
+	FieldRestaurantID = "restaurant_id"
+	// EdgeRestaurant holds the string denoting the restaurant edge name in mutations.
+	EdgeRestaurant = "restaurant"
+	// EdgeRecipeLines holds the string denoting the recipe_lines edge name in mutations.
+	EdgeRecipeLines = "recipe_lines"
+	// EdgeMovements holds the string denoting the movements edge name in mutations.
+	EdgeMovements = "movements"
+	// Table holds the table name of the ingredient in the database.
+	Table = "ingredients"
+	// RestaurantTable is the table that holds the restaurant relation/edge.
+	RestaurantTable = "ingredients"
+	// RestaurantInverseTable is the table name for the Restaurant entity.
+	// It exists in this package in order to avoid circular dependency with the "restaurant" package.
+	RestaurantInverseTable = "restaurants"
+	// RestaurantColumn is the table column denoting the restaurant relation/edge.
+	RestaurantColumn = "restaurant_id"
+	// RecipeLinesTable is the table that holds the recipe_lines relation/edge.
+	RecipeLinesTable = "recipe_ingredients"
+	// RecipeLinesInverseTable is the table name for the RecipeIngredient entity.
+	// It exists in this package in order to avoid circular dependency with the "recipeingredient" package.
+	RecipeLinesInverseTable = "recipe_ingredients"
+	// RecipeLinesColumn is the table column denoting the recipe_lines relation/edge.
+	RecipeLinesColumn = "ingredient_id"
+	// MovementsTable is the table that holds the movements relation/edge.
+	MovementsTable = "stock_movements"
+	// MovementsInverseTable is the table name for the StockMovement entity.
+	// It exists in this package in order to avoid circular dependency with the "stockmovement" package.
+	MovementsInverseTable = "stock_movements"
+	// MovementsColumn is the table column denoting the movements relation/edge.
+	MovementsColumn = "ingredient_id"
+)
+
+// Columns holds all SQL columns for ingredient fields.
+var Columns = []string{
+	FieldID,
+	FieldUpdateTime,
+	FieldCreateTime,
+	FieldName,
+	FieldUnit,
+	FieldOnHand,
+	FieldRestaurantID,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultUpdateTime holds the default value on creation for the "update_time" field.
+	DefaultUpdateTime func() time.Time
+	// UpdateDefaultUpdateTime holds the default value on update for the "update_time" field.
+	UpdateDefaultUpdateTime func() time.Time
+	// DefaultCreateTime holds the default value on creation for the "create_time" field.
+	DefaultCreateTime func() time.Time
+	// NameValidator is a validator for the "name" field. It is called by the builders before save.
+	NameValidator func(string) error
+	// DefaultOnHand holds the default value on creation for the "on_hand" field.
+	DefaultOnHand int64
+	// OnHandValidator is a validator for the "on_hand" field. It is called by the builders before save.
+	OnHandValidator func(int64) error
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// Unit defines the type for the "unit" enum field.
+type Unit string
+
+// Unit values.
+const (
+	UnitG    Unit = "g"
+	UnitMl   Unit = "ml"
+	UnitEach Unit = "each"
+)
+
+func (u Unit) String() string {
+	return string(u)
+}
+
+// UnitValidator is a validator for the "unit" field enum values. It is called by the builders before save.
+func UnitValidator(u Unit) error {
+	switch u {
+	case UnitG, UnitMl, UnitEach:
+		return nil
+	default:
+		return fmt.Errorf("ingredient: invalid enum value for unit field: %q", u)
+	}
+}
+
+// OrderOption defines the ordering options for the Ingredient queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByUpdateTime orders the results by the update_time field.
+func ByUpdateTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdateTime, opts...).ToFunc()
+}
+
+// ByCreateTime orders the results by the create_time field.
+func ByCreateTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreateTime, opts...).ToFunc()
+}
+
+// ByName orders the results by the name field.
+func ByName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldName, opts...).ToFunc()
+}
+
+// ByUnit orders the results by the unit field.
+func ByUnit(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUnit, opts...).ToFunc()
+}
+
+// ByOnHand orders the results by the on_hand field.
+func ByOnHand(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOnHand, opts...).ToFunc()
+}
+
+// ByRestaurantID orders the results by the restaurant_id field.
+func ByRestaurantID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRestaurantID, opts...).ToFunc()
+}
+
+// ByRestaurantField orders the results by restaurant field.
+func ByRestaurantField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newRestaurantStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByRecipeLinesCount orders the results by recipe_lines count.
+func ByRecipeLinesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newRecipeLinesStep(), opts...)
+	}
+}
+
+// ByRecipeLines orders the results by recipe_lines terms.
+func ByRecipeLines(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newRecipeLinesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByMovementsCount orders the results by movements count.
+func ByMovementsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newMovementsStep(), opts...)
+	}
+}
+
+// ByMovements orders the results by movements terms.
+func ByMovements(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newMovementsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+func newRestaurantStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(RestaurantInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, RestaurantTable, RestaurantColumn),
+	)
+}
+func newRecipeLinesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(RecipeLinesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, RecipeLinesTable, RecipeLinesColumn),
+	)
+}
+func newMovementsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(MovementsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, MovementsTable, MovementsColumn),
+	)
+}
@@ -0,0 +1,391 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ingredient
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/Jiruu246/rms/internal/ent/predicate"
+	"github.com/google/uuid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.Ingredient {
+	return predicate.Ingredient(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.Ingredient {
+	return predicate.Ingredient(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.Ingredient {
+	return predicate.Ingredient(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.Ingredient {
+	return predicate.Ingredient(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.Ingredient {
+	return predicate.Ingredient(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.Ingredient {
+	return predicate.Ingredient(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.Ingredient {
+	return predicate.Ingredient(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.Ingredient {
+	return predicate.Ingredient(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.Ingredient {
+	return predicate.Ingredient(sql.FieldLTE(FieldID, id))
+}
+
+// UpdateTime applies equality check predicate on the "update_time" field. It's identical to UpdateTimeEQ.
+func UpdateTime(v time.Time) predicate.Ingredient {
+	return predicate.Ingredient(sql.FieldEQ(FieldUpdateTime, v))
+}
+
+// CreateTime applies equality check predicate on the "create_time" field. It's identical to CreateTimeEQ.
+func CreateTime(v time.Time) predicate.Ingredient {
+	return predicate.Ingredient(sql.FieldEQ(FieldCreateTime, v))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.Ingredient {
+	return predicate.Ingredient(sql.FieldEQ(FieldName, v))
+}
+
+// OnHand applies equality check predicate on the "on_hand" field. It's identical to OnHandEQ.
+func OnHand(v int64) predicate.Ingredient {
+	return predicate.Ingredient(sql.FieldEQ(FieldOnHand, v))
+}
+
+// RestaurantID applies equality check predicate on the "restaurant_id" field. It's identical to RestaurantIDEQ.
+func RestaurantID(v uuid.UUID) predicate.Ingredient {
+	return predicate.Ingredient(sql.FieldEQ(FieldRestaurantID, v))
+}
+
+// UpdateTimeEQ applies the EQ predicate on the "update_time" field.
+func UpdateTimeEQ(v time.Time) predicate.Ingredient {
+	return predicate.Ingredient(sql.FieldEQ(FieldUpdateTime, v))
+}
+
+// UpdateTimeNEQ applies the NEQ predicate on the "update_time" field.
+func UpdateTimeNEQ(v time.Time) predicate.Ingredient {
+	return predicate.Ingredient(sql.FieldNEQ(FieldUpdateTime, v))
+}
+
+// UpdateTimeIn applies the In predicate on the "update_time" field.
+func UpdateTimeIn(vs ...time.Time) predicate.Ingredient {
+	return predicate.Ingredient(sql.FieldIn(FieldUpdateTime, vs...))
+}
+
+// UpdateTimeNotIn applies the NotIn predicate on the "update_time" field.
+func UpdateTimeNotIn(vs ...time.Time) predicate.Ingredient {
+	return predicate.Ingredient(sql.FieldNotIn(FieldUpdateTime, vs...))
+}
+
+// UpdateTimeGT applies the GT predicate on the "update_time" field.
+func UpdateTimeGT(v time.Time) predicate.Ingredient {
+	return predicate.Ingredient(sql.FieldGT(FieldUpdateTime, v))
+}
+
+// UpdateTimeGTE applies the GTE predicate on the "update_time" field.
+func UpdateTimeGTE(v time.Time) predicate.Ingredient {
+	return predicate.Ingredient(sql.FieldGTE(FieldUpdateTime, v))
+}
+
+// UpdateTimeLT applies the LT predicate on the "update_time" field.
+func UpdateTimeLT(v time.Time) predicate.Ingredient {
+	return predicate.Ingredient(sql.FieldLT(FieldUpdateTime, v))
+}
+
+// UpdateTimeLTE applies the LTE predicate on the "update_time" field.
+func UpdateTimeLTE(v time.Time) predicate.Ingredient {
+	return predicate.Ingredient(sql.FieldLTE(FieldUpdateTime, v))
+}
+
+// CreateTimeEQ applies the EQ predicate on the "create_time" field.
+func CreateTimeEQ(v time.Time) predicate.Ingredient {
+	return predicate.Ingredient(sql.FieldEQ(FieldCreateTime, v))
+}
+
+// CreateTimeNEQ applies the NEQ predicate on the "create_time" field.
+func CreateTimeNEQ(v time.Time) predicate.Ingredient {
+	return predicate.Ingredient(sql.FieldNEQ(FieldCreateTime, v))
+}
+
+// CreateTimeIn applies the In predicate on the "create_time" field.
+func CreateTimeIn(vs ...time.Time) predicate.Ingredient {
+	return predicate.Ingredient(sql.FieldIn(FieldCreateTime, vs...))
+}
+
+// CreateTimeNotIn applies the NotIn predicate on the "create_time" field.
+func CreateTimeNotIn(vs ...time.Time) predicate.Ingredient {
+	return predicate.Ingredient(sql.FieldNotIn(FieldCreateTime, vs...))
+}
+
+// CreateTimeGT applies the GT predicate on the "create_time" field.
+func CreateTimeGT(v time.Time) predicate.Ingredient {
+	return predicate.Ingredient(sql.FieldGT(FieldCreateTime, v))
+}
+
+// CreateTimeGTE applies the GTE predicate on the "create_time" field.
+func CreateTimeGTE(v time.Time) predicate.Ingredient {
+	return predicate.Ingredient(sql.FieldGTE(FieldCreateTime, v))
+}
+
+// CreateTimeLT applies the LT predicate on the "create_time" field.
+func CreateTimeLT(v time.Time) predicate.Ingredient {
+	return predicate.Ingredient(sql.FieldLT(FieldCreateTime, v))
+}
+
+// CreateTimeLTE applies the LTE predicate on the "create_time" field.
+func CreateTimeLTE(v time.Time) predicate.Ingredient {
+	return predicate.Ingredient(sql.FieldLTE(FieldCreateTime, v))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.Ingredient {
+	return predicate.Ingredient(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.Ingredient {
+	return predicate.Ingredient(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.Ingredient {
+	return predicate.Ingredient(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.Ingredient {
+	return predicate.Ingredient(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.Ingredient {
+	return predicate.Ingredient(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.Ingredient {
+	return predicate.Ingredient(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.Ingredient {
+	return predicate.Ingredient(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.Ingredient {
+	return predicate.Ingredient(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.Ingredient {
+	return predicate.Ingredient(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.Ingredient {
+	return predicate.Ingredient(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.Ingredient {
+	return predicate.Ingredient(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.Ingredient {
+	return predicate.Ingredient(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.Ingredient {
+	return predicate.Ingredient(sql.FieldContainsFold(FieldName, v))
+}
+
+// UnitEQ applies the EQ predicate on the "unit" field.
+func UnitEQ(v Unit) predicate.Ingredient {
+	return predicate.Ingredient(sql.FieldEQ(FieldUnit, v))
+}
+
+// UnitNEQ applies the NEQ predicate on the "unit" field.
+func UnitNEQ(v Unit) predicate.Ingredient {
+	return predicate.Ingredient(sql.FieldNEQ(FieldUnit, v))
+}
+
+// UnitIn applies the In predicate on the "unit" field.
+func UnitIn(vs ...Unit) predicate.Ingredient {
+	return predicate.Ingredient(sql.FieldIn(FieldUnit, vs...))
+}
+
+// UnitNotIn applies the NotIn predicate on the "unit" field.
+func UnitNotIn(vs ...Unit) predicate.Ingredient {
+	return predicate.Ingredient(sql.FieldNotIn(FieldUnit, vs...))
+}
+
+// OnHandEQ applies the EQ predicate on the "on_hand" field.
+func OnHandEQ(v int64) predicate.Ingredient {
+	return predicate.Ingredient(sql.FieldEQ(FieldOnHand, v))
+}
+
+// OnHandNEQ applies the NEQ predicate on the "on_hand" field.
+func OnHandNEQ(v int64) predicate.Ingredient {
+	return predicate.Ingredient(sql.FieldNEQ(FieldOnHand, v))
+}
+
+// OnHandIn applies the In predicate on the "on_hand" field.
+func OnHandIn(vs ...int64) predicate.Ingredient {
+	return predicate.Ingredient(sql.FieldIn(FieldOnHand, vs...))
+}
+
+// OnHandNotIn applies the NotIn predicate on the "on_hand" field.
+func OnHandNotIn(vs ...int64) predicate.Ingredient {
+	return predicate.Ingredient(sql.FieldNotIn(FieldOnHand, vs...))
+}
+
+// OnHandGT applies the GT predicate on the "on_hand" field.
+func OnHandGT(v int64) predicate.Ingredient {
+	return predicate.Ingredient(sql.FieldGT(FieldOnHand, v))
+}
+
+// OnHandGTE applies the GTE predicate on the "on_hand" field.
+func OnHandGTE(v int64) predicate.Ingredient {
+	return predicate.Ingredient(sql.FieldGTE(FieldOnHand, v))
+}
+
+// OnHandLT applies the LT predicate on the "on_hand" field.
+func OnHandLT(v int64) predicate.Ingredient {
+	return predicate.Ingredient(sql.FieldLT(FieldOnHand, v))
+}
+
+// OnHandLTE applies the LTE predicate on the "on_hand" field.
+func OnHandLTE(v int64) predicate.Ingredient {
+	return predicate.Ingredient(sql.FieldLTE(FieldOnHand, v))
+}
+
+// RestaurantIDEQ applies the EQ predicate on the "restaurant_id" field.
+func RestaurantIDEQ(v uuid.UUID) predicate.Ingredient {
+	return predicate.Ingredient(sql.FieldEQ(FieldRestaurantID, v))
+}
+
+// RestaurantIDNEQ applies the NEQ predicate on the "restaurant_id" field.
+func RestaurantIDNEQ(v uuid.UUID) predicate.Ingredient {
+	return predicate.Ingredient(sql.FieldNEQ(FieldRestaurantID, v))
+}
+
+// RestaurantIDIn applies the In predicate on the "restaurant_id" field.
+func RestaurantIDIn(vs ...uuid.UUID) predicate.Ingredient {
+	return predicate.Ingredient(sql.FieldIn(FieldRestaurantID, vs...))
+}
+
+// RestaurantIDNotIn applies the NotIn predicate on the "restaurant_id" field.
+func RestaurantIDNotIn(vs ...uuid.UUID) predicate.Ingredient {
+	return predicate.Ingredient(sql.FieldNotIn(FieldRestaurantID, vs...))
+}
+
+// HasRestaurant applies the HasEdge predicate on the "restaurant" edge.
+func HasRestaurant() predicate.Ingredient {
+	return predicate.Ingredient(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, RestaurantTable, RestaurantColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasRestaurantWith applies the HasEdge predicate on the "restaurant" edge with a given conditions (other predicates).
+func HasRestaurantWith(preds ...predicate.Restaurant) predicate.Ingredient {
+	return predicate.Ingredient(func(s *sql.Selector) {
+		step := newRestaurantStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasRecipeLines applies the HasEdge predicate on the "recipe_lines" edge.
+func HasRecipeLines() predicate.Ingredient {
+	return predicate.Ingredient(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, RecipeLinesTable, RecipeLinesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasRecipeLinesWith applies the HasEdge predicate on the "recipe_lines" edge with a given conditions (other predicates).
+func HasRecipeLinesWith(preds ...predicate.RecipeIngredient) predicate.Ingredient {
+	return predicate.Ingredient(func(s *sql.Selector) {
+		step := newRecipeLinesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasMovements applies the HasEdge predicate on the "movements" edge.
+func HasMovements() predicate.Ingredient {
+	return predicate.Ingredient(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, MovementsTable, MovementsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasMovementsWith applies the HasEdge predicate on the "movements" edge with a given conditions (other predicates).
+func HasMovementsWith(preds ...predicate.StockMovement) predicate.Ingredient {
+	return predicate.Ingredient(func(s *sql.Selector) {
+		step := newMovementsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Ingredient) predicate.Ingredient {
+	return predicate.Ingredient(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Ingredient) predicate.Ingredient {
+	return predicate.Ingredient(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Ingredient) predicate.Ingredient {
+	return predicate.Ingredient(sql.NotPredicates(p))
+}
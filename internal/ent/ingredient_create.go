@@ -0,0 +1,416 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Jiruu246/rms/internal/ent/ingredient"
+	"github.com/Jiruu246/rms/internal/ent/recipeingredient"
+	"github.com/Jiruu246/rms/internal/ent/restaurant"
+	"github.com/Jiruu246/rms/internal/ent/stockmovement"
+	"github.com/google/uuid"
+)
+
+// IngredientCreate is the builder for creating a Ingredient entity.
+type IngredientCreate struct {
+	config
+	mutation *IngredientMutation
+	hooks    []Hook
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_c *IngredientCreate) SetUpdateTime(v time.Time) *IngredientCreate {
+	_c.mutation.SetUpdateTime(v)
+	return _c
+}
+
+// SetNillableUpdateTime sets the "update_time" field if the given value is not nil.
+func (_c *IngredientCreate) SetNillableUpdateTime(v *time.Time) *IngredientCreate {
+	if v != nil {
+		_c.SetUpdateTime(*v)
+	}
+	return _c
+}
+
+// SetCreateTime sets the "create_time" field.
+func (_c *IngredientCreate) SetCreateTime(v time.Time) *IngredientCreate {
+	_c.mutation.SetCreateTime(v)
+	return _c
+}
+
+// SetNillableCreateTime sets the "create_time" field if the given value is not nil.
+func (_c *IngredientCreate) SetNillableCreateTime(v *time.Time) *IngredientCreate {
+	if v != nil {
+		_c.SetCreateTime(*v)
+	}
+	return _c
+}
+
+// SetName sets the "name" field.
+func (_c *IngredientCreate) SetName(v string) *IngredientCreate {
+	_c.mutation.SetName(v)
+	return _c
+}
+
+// SetUnit sets the "unit" field.
+func (_c *IngredientCreate) SetUnit(v ingredient.Unit) *IngredientCreate {
+	_c.mutation.SetUnit(v)
+	return _c
+}
+
+// SetOnHand sets the "on_hand" field.
+func (_c *IngredientCreate) SetOnHand(v int64) *IngredientCreate {
+	_c.mutation.SetOnHand(v)
+	return _c
+}
+
+// SetNillableOnHand sets the "on_hand" field if the given value is not nil.
+func (_c *IngredientCreate) SetNillableOnHand(v *int64) *IngredientCreate {
+	if v != nil {
+		_c.SetOnHand(*v)
+	}
+	return _c
+}
+
+// SetRestaurantID sets the "restaurant_id" field.
+func (_c *IngredientCreate) SetRestaurantID(v uuid.UUID) *IngredientCreate {
+	_c.mutation.SetRestaurantID(v)
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *IngredientCreate) SetID(v uuid.UUID) *IngredientCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *IngredientCreate) SetNillableID(v *uuid.UUID) *IngredientCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetRestaurant sets the "restaurant" edge to the Restaurant entity.
+func (_c *IngredientCreate) SetRestaurant(v *Restaurant) *IngredientCreate {
+	return _c.SetRestaurantID(v.ID)
+}
+
+// AddRecipeLineIDs adds the "recipe_lines" edge to the RecipeIngredient entity by IDs.
+func (_c *IngredientCreate) AddRecipeLineIDs(ids ...uuid.UUID) *IngredientCreate {
+	_c.mutation.AddRecipeLineIDs(ids...)
+	return _c
+}
+
+// AddRecipeLines adds the "recipe_lines" edges to the RecipeIngredient entity.
+func (_c *IngredientCreate) AddRecipeLines(v ...*RecipeIngredient) *IngredientCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddRecipeLineIDs(ids...)
+}
+
+// AddMovementIDs adds the "movements" edge to the StockMovement entity by IDs.
+func (_c *IngredientCreate) AddMovementIDs(ids ...uuid.UUID) *IngredientCreate {
+	_c.mutation.AddMovementIDs(ids...)
+	return _c
+}
+
+// AddMovements adds the "movements" edges to the StockMovement entity.
+func (_c *IngredientCreate) AddMovements(v ...*StockMovement) *IngredientCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddMovementIDs(ids...)
+}
+
+// Mutation returns the IngredientMutation object of the builder.
+func (_c *IngredientCreate) Mutation() *IngredientMutation {
+	return _c.mutation
+}
+
+// Save creates the Ingredient in the database.
+func (_c *IngredientCreate) Save(ctx context.Context) (*Ingredient, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *IngredientCreate) SaveX(ctx context.Context) *Ingredient {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *IngredientCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *IngredientCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *IngredientCreate) defaults() {
+	if _, ok := _c.mutation.UpdateTime(); !ok {
+		v := ingredient.DefaultUpdateTime()
+		_c.mutation.SetUpdateTime(v)
+	}
+	if _, ok := _c.mutation.CreateTime(); !ok {
+		v := ingredient.DefaultCreateTime()
+		_c.mutation.SetCreateTime(v)
+	}
+	if _, ok := _c.mutation.OnHand(); !ok {
+		v := ingredient.DefaultOnHand
+		_c.mutation.SetOnHand(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := ingredient.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *IngredientCreate) check() error {
+	if _, ok := _c.mutation.UpdateTime(); !ok {
+		return &ValidationError{Name: "update_time", err: errors.New(`ent: missing required field "Ingredient.update_time"`)}
+	}
+	if _, ok := _c.mutation.CreateTime(); !ok {
+		return &ValidationError{Name: "create_time", err: errors.New(`ent: missing required field "Ingredient.create_time"`)}
+	}
+	if _, ok := _c.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "Ingredient.name"`)}
+	}
+	if v, ok := _c.mutation.Name(); ok {
+		if err := ingredient.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Ingredient.name": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Unit(); !ok {
+		return &ValidationError{Name: "unit", err: errors.New(`ent: missing required field "Ingredient.unit"`)}
+	}
+	if v, ok := _c.mutation.Unit(); ok {
+		if err := ingredient.UnitValidator(v); err != nil {
+			return &ValidationError{Name: "unit", err: fmt.Errorf(`ent: validator failed for field "Ingredient.unit": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.OnHand(); !ok {
+		return &ValidationError{Name: "on_hand", err: errors.New(`ent: missing required field "Ingredient.on_hand"`)}
+	}
+	if v, ok := _c.mutation.OnHand(); ok {
+		if err := ingredient.OnHandValidator(v); err != nil {
+			return &ValidationError{Name: "on_hand", err: fmt.Errorf(`ent: validator failed for field "Ingredient.on_hand": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.RestaurantID(); !ok {
+		return &ValidationError{Name: "restaurant_id", err: errors.New(`ent: missing required field "Ingredient.restaurant_id"`)}
+	}
+	if len(_c.mutation.RestaurantIDs()) == 0 {
+		return &ValidationError{Name: "restaurant", err: errors.New(`ent: missing required edge "Ingredient.restaurant"`)}
+	}
+	return nil
+}
+
+func (_c *IngredientCreate) sqlSave(ctx context.Context) (*Ingredient, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *IngredientCreate) createSpec() (*Ingredient, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Ingredient{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(ingredient.Table, sqlgraph.NewFieldSpec(ingredient.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.UpdateTime(); ok {
+		_spec.SetField(ingredient.FieldUpdateTime, field.TypeTime, value)
+		_node.UpdateTime = value
+	}
+	if value, ok := _c.mutation.CreateTime(); ok {
+		_spec.SetField(ingredient.FieldCreateTime, field.TypeTime, value)
+		_node.CreateTime = value
+	}
+	if value, ok := _c.mutation.Name(); ok {
+		_spec.SetField(ingredient.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := _c.mutation.Unit(); ok {
+		_spec.SetField(ingredient.FieldUnit, field.TypeEnum, value)
+		_node.Unit = value
+	}
+	if value, ok := _c.mutation.OnHand(); ok {
+		_spec.SetField(ingredient.FieldOnHand, field.TypeInt64, value)
+		_node.OnHand = value
+	}
+	if nodes := _c.mutation.RestaurantIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   ingredient.RestaurantTable,
+			Columns: []string{ingredient.RestaurantColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(restaurant.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.RestaurantID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.RecipeLinesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   ingredient.RecipeLinesTable,
+			Columns: []string{ingredient.RecipeLinesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(recipeingredient.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.MovementsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   ingredient.MovementsTable,
+			Columns: []string{ingredient.MovementsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(stockmovement.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// IngredientCreateBulk is the builder for creating many Ingredient entities in bulk.
+type IngredientCreateBulk struct {
+	config
+	err      error
+	builders []*IngredientCreate
+}
+
+// Save creates the Ingredient entities in the database.
+func (_c *IngredientCreateBulk) Save(ctx context.Context) ([]*Ingredient, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*Ingredient, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*IngredientMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *IngredientCreateBulk) SaveX(ctx context.Context) []*Ingredient {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *IngredientCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *IngredientCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,669 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Jiruu246/rms/internal/ent/ingredient"
+	"github.com/Jiruu246/rms/internal/ent/predicate"
+	"github.com/Jiruu246/rms/internal/ent/recipeingredient"
+	"github.com/Jiruu246/rms/internal/ent/stockmovement"
+	"github.com/google/uuid"
+)
+
+// IngredientUpdate is the builder for updating Ingredient entities.
+type IngredientUpdate struct {
+	config
+	hooks    []Hook
+	mutation *IngredientMutation
+}
+
+// Where appends a list predicates to the IngredientUpdate builder.
+func (_u *IngredientUpdate) Where(ps ...predicate.Ingredient) *IngredientUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_u *IngredientUpdate) SetUpdateTime(v time.Time) *IngredientUpdate {
+	_u.mutation.SetUpdateTime(v)
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *IngredientUpdate) SetName(v string) *IngredientUpdate {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *IngredientUpdate) SetNillableName(v *string) *IngredientUpdate {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetOnHand sets the "on_hand" field.
+func (_u *IngredientUpdate) SetOnHand(v int64) *IngredientUpdate {
+	_u.mutation.ResetOnHand()
+	_u.mutation.SetOnHand(v)
+	return _u
+}
+
+// SetNillableOnHand sets the "on_hand" field if the given value is not nil.
+func (_u *IngredientUpdate) SetNillableOnHand(v *int64) *IngredientUpdate {
+	if v != nil {
+		_u.SetOnHand(*v)
+	}
+	return _u
+}
+
+// AddOnHand adds value to the "on_hand" field.
+func (_u *IngredientUpdate) AddOnHand(v int64) *IngredientUpdate {
+	_u.mutation.AddOnHand(v)
+	return _u
+}
+
+// AddRecipeLineIDs adds the "recipe_lines" edge to the RecipeIngredient entity by IDs.
+func (_u *IngredientUpdate) AddRecipeLineIDs(ids ...uuid.UUID) *IngredientUpdate {
+	_u.mutation.AddRecipeLineIDs(ids...)
+	return _u
+}
+
+// AddRecipeLines adds the "recipe_lines" edges to the RecipeIngredient entity.
+func (_u *IngredientUpdate) AddRecipeLines(v ...*RecipeIngredient) *IngredientUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddRecipeLineIDs(ids...)
+}
+
+// AddMovementIDs adds the "movements" edge to the StockMovement entity by IDs.
+func (_u *IngredientUpdate) AddMovementIDs(ids ...uuid.UUID) *IngredientUpdate {
+	_u.mutation.AddMovementIDs(ids...)
+	return _u
+}
+
+// AddMovements adds the "movements" edges to the StockMovement entity.
+func (_u *IngredientUpdate) AddMovements(v ...*StockMovement) *IngredientUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddMovementIDs(ids...)
+}
+
+// Mutation returns the IngredientMutation object of the builder.
+func (_u *IngredientUpdate) Mutation() *IngredientMutation {
+	return _u.mutation
+}
+
+// ClearRecipeLines clears all "recipe_lines" edges to the RecipeIngredient entity.
+func (_u *IngredientUpdate) ClearRecipeLines() *IngredientUpdate {
+	_u.mutation.ClearRecipeLines()
+	return _u
+}
+
+// RemoveRecipeLineIDs removes the "recipe_lines" edge to RecipeIngredient entities by IDs.
+func (_u *IngredientUpdate) RemoveRecipeLineIDs(ids ...uuid.UUID) *IngredientUpdate {
+	_u.mutation.RemoveRecipeLineIDs(ids...)
+	return _u
+}
+
+// RemoveRecipeLines removes "recipe_lines" edges to RecipeIngredient entities.
+func (_u *IngredientUpdate) RemoveRecipeLines(v ...*RecipeIngredient) *IngredientUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveRecipeLineIDs(ids...)
+}
+
+// ClearMovements clears all "movements" edges to the StockMovement entity.
+func (_u *IngredientUpdate) ClearMovements() *IngredientUpdate {
+	_u.mutation.ClearMovements()
+	return _u
+}
+
+// RemoveMovementIDs removes the "movements" edge to StockMovement entities by IDs.
+func (_u *IngredientUpdate) RemoveMovementIDs(ids ...uuid.UUID) *IngredientUpdate {
+	_u.mutation.RemoveMovementIDs(ids...)
+	return _u
+}
+
+// RemoveMovements removes "movements" edges to StockMovement entities.
+func (_u *IngredientUpdate) RemoveMovements(v ...*StockMovement) *IngredientUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveMovementIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *IngredientUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *IngredientUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *IngredientUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *IngredientUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *IngredientUpdate) defaults() {
+	if _, ok := _u.mutation.UpdateTime(); !ok {
+		v := ingredient.UpdateDefaultUpdateTime()
+		_u.mutation.SetUpdateTime(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *IngredientUpdate) check() error {
+	if v, ok := _u.mutation.Name(); ok {
+		if err := ingredient.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Ingredient.name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.OnHand(); ok {
+		if err := ingredient.OnHandValidator(v); err != nil {
+			return &ValidationError{Name: "on_hand", err: fmt.Errorf(`ent: validator failed for field "Ingredient.on_hand": %w`, err)}
+		}
+	}
+	if _u.mutation.RestaurantCleared() && len(_u.mutation.RestaurantIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Ingredient.restaurant"`)
+	}
+	return nil
+}
+
+func (_u *IngredientUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(ingredient.Table, ingredient.Columns, sqlgraph.NewFieldSpec(ingredient.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdateTime(); ok {
+		_spec.SetField(ingredient.FieldUpdateTime, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(ingredient.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.OnHand(); ok {
+		_spec.SetField(ingredient.FieldOnHand, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedOnHand(); ok {
+		_spec.AddField(ingredient.FieldOnHand, field.TypeInt64, value)
+	}
+	if _u.mutation.RecipeLinesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   ingredient.RecipeLinesTable,
+			Columns: []string{ingredient.RecipeLinesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(recipeingredient.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedRecipeLinesIDs(); len(nodes) > 0 && !_u.mutation.RecipeLinesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   ingredient.RecipeLinesTable,
+			Columns: []string{ingredient.RecipeLinesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(recipeingredient.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RecipeLinesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   ingredient.RecipeLinesTable,
+			Columns: []string{ingredient.RecipeLinesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(recipeingredient.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.MovementsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   ingredient.MovementsTable,
+			Columns: []string{ingredient.MovementsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(stockmovement.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedMovementsIDs(); len(nodes) > 0 && !_u.mutation.MovementsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   ingredient.MovementsTable,
+			Columns: []string{ingredient.MovementsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(stockmovement.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.MovementsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   ingredient.MovementsTable,
+			Columns: []string{ingredient.MovementsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(stockmovement.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{ingredient.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// IngredientUpdateOne is the builder for updating a single Ingredient entity.
+type IngredientUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *IngredientMutation
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_u *IngredientUpdateOne) SetUpdateTime(v time.Time) *IngredientUpdateOne {
+	_u.mutation.SetUpdateTime(v)
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *IngredientUpdateOne) SetName(v string) *IngredientUpdateOne {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *IngredientUpdateOne) SetNillableName(v *string) *IngredientUpdateOne {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetOnHand sets the "on_hand" field.
+func (_u *IngredientUpdateOne) SetOnHand(v int64) *IngredientUpdateOne {
+	_u.mutation.ResetOnHand()
+	_u.mutation.SetOnHand(v)
+	return _u
+}
+
+// SetNillableOnHand sets the "on_hand" field if the given value is not nil.
+func (_u *IngredientUpdateOne) SetNillableOnHand(v *int64) *IngredientUpdateOne {
+	if v != nil {
+		_u.SetOnHand(*v)
+	}
+	return _u
+}
+
+// AddOnHand adds value to the "on_hand" field.
+func (_u *IngredientUpdateOne) AddOnHand(v int64) *IngredientUpdateOne {
+	_u.mutation.AddOnHand(v)
+	return _u
+}
+
+// AddRecipeLineIDs adds the "recipe_lines" edge to the RecipeIngredient entity by IDs.
+func (_u *IngredientUpdateOne) AddRecipeLineIDs(ids ...uuid.UUID) *IngredientUpdateOne {
+	_u.mutation.AddRecipeLineIDs(ids...)
+	return _u
+}
+
+// AddRecipeLines adds the "recipe_lines" edges to the RecipeIngredient entity.
+func (_u *IngredientUpdateOne) AddRecipeLines(v ...*RecipeIngredient) *IngredientUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddRecipeLineIDs(ids...)
+}
+
+// AddMovementIDs adds the "movements" edge to the StockMovement entity by IDs.
+func (_u *IngredientUpdateOne) AddMovementIDs(ids ...uuid.UUID) *IngredientUpdateOne {
+	_u.mutation.AddMovementIDs(ids...)
+	return _u
+}
+
+// AddMovements adds the "movements" edges to the StockMovement entity.
+func (_u *IngredientUpdateOne) AddMovements(v ...*StockMovement) *IngredientUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddMovementIDs(ids...)
+}
+
+// Mutation returns the IngredientMutation object of the builder.
+func (_u *IngredientUpdateOne) Mutation() *IngredientMutation {
+	return _u.mutation
+}
+
+// ClearRecipeLines clears all "recipe_lines" edges to the RecipeIngredient entity.
+func (_u *IngredientUpdateOne) ClearRecipeLines() *IngredientUpdateOne {
+	_u.mutation.ClearRecipeLines()
+	return _u
+}
+
+// RemoveRecipeLineIDs removes the "recipe_lines" edge to RecipeIngredient entities by IDs.
+func (_u *IngredientUpdateOne) RemoveRecipeLineIDs(ids ...uuid.UUID) *IngredientUpdateOne {
+	_u.mutation.RemoveRecipeLineIDs(ids...)
+	return _u
+}
+
+// RemoveRecipeLines removes "recipe_lines" edges to RecipeIngredient entities.
+func (_u *IngredientUpdateOne) RemoveRecipeLines(v ...*RecipeIngredient) *IngredientUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveRecipeLineIDs(ids...)
+}
+
+// ClearMovements clears all "movements" edges to the StockMovement entity.
+func (_u *IngredientUpdateOne) ClearMovements() *IngredientUpdateOne {
+	_u.mutation.ClearMovements()
+	return _u
+}
+
+// RemoveMovementIDs removes the "movements" edge to StockMovement entities by IDs.
+func (_u *IngredientUpdateOne) RemoveMovementIDs(ids ...uuid.UUID) *IngredientUpdateOne {
+	_u.mutation.RemoveMovementIDs(ids...)
+	return _u
+}
+
+// RemoveMovements removes "movements" edges to StockMovement entities.
+func (_u *IngredientUpdateOne) RemoveMovements(v ...*StockMovement) *IngredientUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveMovementIDs(ids...)
+}
+
+// Where appends a list predicates to the IngredientUpdate builder.
+func (_u *IngredientUpdateOne) Where(ps ...predicate.Ingredient) *IngredientUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *IngredientUpdateOne) Select(field string, fields ...string) *IngredientUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated Ingredient entity.
+func (_u *IngredientUpdateOne) Save(ctx context.Context) (*Ingredient, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *IngredientUpdateOne) SaveX(ctx context.Context) *Ingredient {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *IngredientUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *IngredientUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *IngredientUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdateTime(); !ok {
+		v := ingredient.UpdateDefaultUpdateTime()
+		_u.mutation.SetUpdateTime(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *IngredientUpdateOne) check() error {
+	if v, ok := _u.mutation.Name(); ok {
+		if err := ingredient.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Ingredient.name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.OnHand(); ok {
+		if err := ingredient.OnHandValidator(v); err != nil {
+			return &ValidationError{Name: "on_hand", err: fmt.Errorf(`ent: validator failed for field "Ingredient.on_hand": %w`, err)}
+		}
+	}
+	if _u.mutation.RestaurantCleared() && len(_u.mutation.RestaurantIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Ingredient.restaurant"`)
+	}
+	return nil
+}
+
+func (_u *IngredientUpdateOne) sqlSave(ctx context.Context) (_node *Ingredient, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(ingredient.Table, ingredient.Columns, sqlgraph.NewFieldSpec(ingredient.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Ingredient.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, ingredient.FieldID)
+		for _, f := range fields {
+			if !ingredient.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != ingredient.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdateTime(); ok {
+		_spec.SetField(ingredient.FieldUpdateTime, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(ingredient.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.OnHand(); ok {
+		_spec.SetField(ingredient.FieldOnHand, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedOnHand(); ok {
+		_spec.AddField(ingredient.FieldOnHand, field.TypeInt64, value)
+	}
+	if _u.mutation.RecipeLinesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   ingredient.RecipeLinesTable,
+			Columns: []string{ingredient.RecipeLinesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(recipeingredient.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedRecipeLinesIDs(); len(nodes) > 0 && !_u.mutation.RecipeLinesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   ingredient.RecipeLinesTable,
+			Columns: []string{ingredient.RecipeLinesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(recipeingredient.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RecipeLinesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   ingredient.RecipeLinesTable,
+			Columns: []string{ingredient.RecipeLinesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(recipeingredient.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.MovementsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   ingredient.MovementsTable,
+			Columns: []string{ingredient.MovementsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(stockmovement.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedMovementsIDs(); len(nodes) > 0 && !_u.mutation.MovementsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   ingredient.MovementsTable,
+			Columns: []string{ingredient.MovementsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(stockmovement.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.MovementsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   ingredient.MovementsTable,
+			Columns: []string{ingredient.MovementsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(stockmovement.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Ingredient{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{ingredient.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
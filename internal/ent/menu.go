@@ -0,0 +1,268 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/Jiruu246/rms/internal/ent/menu"
+	"github.com/Jiruu246/rms/internal/ent/restaurant"
+	"github.com/Jiruu246/rms/pkg/hours"
+	"github.com/google/uuid"
+)
+
+// Menu is the model entity for the Menu schema.
+type Menu struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// UpdateTime holds the value of the "update_time" field.
+	UpdateTime time.Time `json:"update_time,omitempty"`
+	// CreateTime holds the value of the "create_time" field.
+	CreateTime time.Time `json:"create_time,omitempty"`
+	// Menu name
+	Name string `json:"name,omitempty"`
+	// Menu description
+	Description string `json:"description,omitempty"`
+	// Days and times the menu is served, in the restaurant timezone; unset or empty means at all times
+	Schedule *hours.Schedule `json:"schedule,omitempty"`
+	// Order types the menu applies to; empty means all of them
+	OrderTypes []string `json:"order_types,omitempty"`
+	// Display order for sorting
+	DisplayOrder int `json:"display_order,omitempty"`
+	// Whether the menu is served at all
+	IsActive bool `json:"is_active,omitempty"`
+	// ID of the restaurant this menu belongs to
+	RestaurantID uuid.UUID `json:"restaurant_id,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the MenuQuery when eager-loading is set.
+	Edges        MenuEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// MenuEdges holds the relations/edges for other nodes in the graph.
+type MenuEdges struct {
+	// Restaurant holds the value of the restaurant edge.
+	Restaurant *Restaurant `json:"restaurant,omitempty"`
+	// Categories holds the value of the categories edge.
+	Categories []*Category `json:"categories,omitempty"`
+	// ItemPrices holds the value of the item_prices edge.
+	ItemPrices []*MenuItemPrice `json:"item_prices,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [3]bool
+}
+
+// RestaurantOrErr returns the Restaurant value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e MenuEdges) RestaurantOrErr() (*Restaurant, error) {
+	if e.Restaurant != nil {
+		return e.Restaurant, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: restaurant.Label}
+	}
+	return nil, &NotLoadedError{edge: "restaurant"}
+}
+
+// CategoriesOrErr returns the Categories value or an error if the edge
+// was not loaded in eager-loading.
+func (e MenuEdges) CategoriesOrErr() ([]*Category, error) {
+	if e.loadedTypes[1] {
+		return e.Categories, nil
+	}
+	return nil, &NotLoadedError{edge: "categories"}
+}
+
+// ItemPricesOrErr returns the ItemPrices value or an error if the edge
+// was not loaded in eager-loading.
+func (e MenuEdges) ItemPricesOrErr() ([]*MenuItemPrice, error) {
+	if e.loadedTypes[2] {
+		return e.ItemPrices, nil
+	}
+	return nil, &NotLoadedError{edge: "item_prices"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Menu) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case menu.FieldSchedule, menu.FieldOrderTypes:
+			values[i] = new([]byte)
+		case menu.FieldIsActive:
+			values[i] = new(sql.NullBool)
+		case menu.FieldDisplayOrder:
+			values[i] = new(sql.NullInt64)
+		case menu.FieldName, menu.FieldDescription:
+			values[i] = new(sql.NullString)
+		case menu.FieldUpdateTime, menu.FieldCreateTime:
+			values[i] = new(sql.NullTime)
+		case menu.FieldID, menu.FieldRestaurantID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Menu fields.
+func (_m *Menu) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case menu.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case menu.FieldUpdateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field update_time", values[i])
+			} else if value.Valid {
+				_m.UpdateTime = value.Time
+			}
+		case menu.FieldCreateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field create_time", values[i])
+			} else if value.Valid {
+				_m.CreateTime = value.Time
+			}
+		case menu.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				_m.Name = value.String
+			}
+		case menu.FieldDescription:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field description", values[i])
+			} else if value.Valid {
+				_m.Description = value.String
+			}
+		case menu.FieldSchedule:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field schedule", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Schedule); err != nil {
+					return fmt.Errorf("unmarshal field schedule: %w", err)
+				}
+			}
+		case menu.FieldOrderTypes:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field order_types", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.OrderTypes); err != nil {
+					return fmt.Errorf("unmarshal field order_types: %w", err)
+				}
+			}
+		case menu.FieldDisplayOrder:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field display_order", values[i])
+			} else if value.Valid {
+				_m.DisplayOrder = int(value.Int64)
+			}
+		case menu.FieldIsActive:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field is_active", values[i])
+			} else if value.Valid {
+				_m.IsActive = value.Bool
+			}
+		case menu.FieldRestaurantID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field restaurant_id", values[i])
+			} else if value != nil {
+				_m.RestaurantID = *value
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Menu.
+// This includes values selected through modifiers, order, etc.
+func (_m *Menu) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryRestaurant queries the "restaurant" edge of the Menu entity.
+func (_m *Menu) QueryRestaurant() *RestaurantQuery {
+	return NewMenuClient(_m.config).QueryRestaurant(_m)
+}
+
+// QueryCategories queries the "categories" edge of the Menu entity.
+func (_m *Menu) QueryCategories() *CategoryQuery {
+	return NewMenuClient(_m.config).QueryCategories(_m)
+}
+
+// QueryItemPrices queries the "item_prices" edge of the Menu entity.
+func (_m *Menu) QueryItemPrices() *MenuItemPriceQuery {
+	return NewMenuClient(_m.config).QueryItemPrices(_m)
+}
+
+// Update returns a builder for updating this Menu.
+// Note that you need to call Menu.Unwrap() before calling this method if this Menu
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *Menu) Update() *MenuUpdateOne {
+	return NewMenuClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the Menu entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *Menu) Unwrap() *Menu {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Menu is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *Menu) String() string {
+	var builder strings.Builder
+	builder.WriteString("Menu(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("update_time=")
+	builder.WriteString(_m.UpdateTime.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("create_time=")
+	builder.WriteString(_m.CreateTime.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(_m.Name)
+	builder.WriteString(", ")
+	builder.WriteString("description=")
+	builder.WriteString(_m.Description)
+	builder.WriteString(", ")
+	builder.WriteString("schedule=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Schedule))
+	builder.WriteString(", ")
+	builder.WriteString("order_types=")
+	builder.WriteString(fmt.Sprintf("%v", _m.OrderTypes))
+	builder.WriteString(", ")
+	builder.WriteString("display_order=")
+	builder.WriteString(fmt.Sprintf("%v", _m.DisplayOrder))
+	builder.WriteString(", ")
+	builder.WriteString("is_active=")
+	builder.WriteString(fmt.Sprintf("%v", _m.IsActive))
+	builder.WriteString(", ")
+	builder.WriteString("restaurant_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.RestaurantID))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Menus is a parsable slice of Menu.
+type Menus []*Menu
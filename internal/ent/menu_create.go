@@ -0,0 +1,479 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Jiruu246/rms/internal/ent/category"
+	"github.com/Jiruu246/rms/internal/ent/menu"
+	"github.com/Jiruu246/rms/internal/ent/menuitemprice"
+	"github.com/Jiruu246/rms/internal/ent/restaurant"
+	"github.com/Jiruu246/rms/pkg/hours"
+	"github.com/google/uuid"
+)
+
+// MenuCreate is the builder for creating a Menu entity.
+type MenuCreate struct {
+	config
+	mutation *MenuMutation
+	hooks    []Hook
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_c *MenuCreate) SetUpdateTime(v time.Time) *MenuCreate {
+	_c.mutation.SetUpdateTime(v)
+	return _c
+}
+
+// SetNillableUpdateTime sets the "update_time" field if the given value is not nil.
+func (_c *MenuCreate) SetNillableUpdateTime(v *time.Time) *MenuCreate {
+	if v != nil {
+		_c.SetUpdateTime(*v)
+	}
+	return _c
+}
+
+// SetCreateTime sets the "create_time" field.
+func (_c *MenuCreate) SetCreateTime(v time.Time) *MenuCreate {
+	_c.mutation.SetCreateTime(v)
+	return _c
+}
+
+// SetNillableCreateTime sets the "create_time" field if the given value is not nil.
+func (_c *MenuCreate) SetNillableCreateTime(v *time.Time) *MenuCreate {
+	if v != nil {
+		_c.SetCreateTime(*v)
+	}
+	return _c
+}
+
+// SetName sets the "name" field.
+func (_c *MenuCreate) SetName(v string) *MenuCreate {
+	_c.mutation.SetName(v)
+	return _c
+}
+
+// SetDescription sets the "description" field.
+func (_c *MenuCreate) SetDescription(v string) *MenuCreate {
+	_c.mutation.SetDescription(v)
+	return _c
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_c *MenuCreate) SetNillableDescription(v *string) *MenuCreate {
+	if v != nil {
+		_c.SetDescription(*v)
+	}
+	return _c
+}
+
+// SetSchedule sets the "schedule" field.
+func (_c *MenuCreate) SetSchedule(v *hours.Schedule) *MenuCreate {
+	_c.mutation.SetSchedule(v)
+	return _c
+}
+
+// SetOrderTypes sets the "order_types" field.
+func (_c *MenuCreate) SetOrderTypes(v []string) *MenuCreate {
+	_c.mutation.SetOrderTypes(v)
+	return _c
+}
+
+// SetDisplayOrder sets the "display_order" field.
+func (_c *MenuCreate) SetDisplayOrder(v int) *MenuCreate {
+	_c.mutation.SetDisplayOrder(v)
+	return _c
+}
+
+// SetNillableDisplayOrder sets the "display_order" field if the given value is not nil.
+func (_c *MenuCreate) SetNillableDisplayOrder(v *int) *MenuCreate {
+	if v != nil {
+		_c.SetDisplayOrder(*v)
+	}
+	return _c
+}
+
+// SetIsActive sets the "is_active" field.
+func (_c *MenuCreate) SetIsActive(v bool) *MenuCreate {
+	_c.mutation.SetIsActive(v)
+	return _c
+}
+
+// SetNillableIsActive sets the "is_active" field if the given value is not nil.
+func (_c *MenuCreate) SetNillableIsActive(v *bool) *MenuCreate {
+	if v != nil {
+		_c.SetIsActive(*v)
+	}
+	return _c
+}
+
+// SetRestaurantID sets the "restaurant_id" field.
+func (_c *MenuCreate) SetRestaurantID(v uuid.UUID) *MenuCreate {
+	_c.mutation.SetRestaurantID(v)
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *MenuCreate) SetID(v uuid.UUID) *MenuCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *MenuCreate) SetNillableID(v *uuid.UUID) *MenuCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetRestaurant sets the "restaurant" edge to the Restaurant entity.
+func (_c *MenuCreate) SetRestaurant(v *Restaurant) *MenuCreate {
+	return _c.SetRestaurantID(v.ID)
+}
+
+// AddCategoryIDs adds the "categories" edge to the Category entity by IDs.
+func (_c *MenuCreate) AddCategoryIDs(ids ...uuid.UUID) *MenuCreate {
+	_c.mutation.AddCategoryIDs(ids...)
+	return _c
+}
+
+// AddCategories adds the "categories" edges to the Category entity.
+func (_c *MenuCreate) AddCategories(v ...*Category) *MenuCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddCategoryIDs(ids...)
+}
+
+// AddItemPriceIDs adds the "item_prices" edge to the MenuItemPrice entity by IDs.
+func (_c *MenuCreate) AddItemPriceIDs(ids ...uuid.UUID) *MenuCreate {
+	_c.mutation.AddItemPriceIDs(ids...)
+	return _c
+}
+
+// AddItemPrices adds the "item_prices" edges to the MenuItemPrice entity.
+func (_c *MenuCreate) AddItemPrices(v ...*MenuItemPrice) *MenuCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddItemPriceIDs(ids...)
+}
+
+// Mutation returns the MenuMutation object of the builder.
+func (_c *MenuCreate) Mutation() *MenuMutation {
+	return _c.mutation
+}
+
+// Save creates the Menu in the database.
+func (_c *MenuCreate) Save(ctx context.Context) (*Menu, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *MenuCreate) SaveX(ctx context.Context) *Menu {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *MenuCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *MenuCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *MenuCreate) defaults() {
+	if _, ok := _c.mutation.UpdateTime(); !ok {
+		v := menu.DefaultUpdateTime()
+		_c.mutation.SetUpdateTime(v)
+	}
+	if _, ok := _c.mutation.CreateTime(); !ok {
+		v := menu.DefaultCreateTime()
+		_c.mutation.SetCreateTime(v)
+	}
+	if _, ok := _c.mutation.Description(); !ok {
+		v := menu.DefaultDescription
+		_c.mutation.SetDescription(v)
+	}
+	if _, ok := _c.mutation.DisplayOrder(); !ok {
+		v := menu.DefaultDisplayOrder
+		_c.mutation.SetDisplayOrder(v)
+	}
+	if _, ok := _c.mutation.IsActive(); !ok {
+		v := menu.DefaultIsActive
+		_c.mutation.SetIsActive(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := menu.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *MenuCreate) check() error {
+	if _, ok := _c.mutation.UpdateTime(); !ok {
+		return &ValidationError{Name: "update_time", err: errors.New(`ent: missing required field "Menu.update_time"`)}
+	}
+	if _, ok := _c.mutation.CreateTime(); !ok {
+		return &ValidationError{Name: "create_time", err: errors.New(`ent: missing required field "Menu.create_time"`)}
+	}
+	if _, ok := _c.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "Menu.name"`)}
+	}
+	if v, ok := _c.mutation.Name(); ok {
+		if err := menu.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Menu.name": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Description(); !ok {
+		return &ValidationError{Name: "description", err: errors.New(`ent: missing required field "Menu.description"`)}
+	}
+	if v, ok := _c.mutation.Description(); ok {
+		if err := menu.DescriptionValidator(v); err != nil {
+			return &ValidationError{Name: "description", err: fmt.Errorf(`ent: validator failed for field "Menu.description": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.Schedule(); ok {
+		if err := v.Validate(); err != nil {
+			return &ValidationError{Name: "schedule", err: fmt.Errorf(`ent: validator failed for field "Menu.schedule": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.DisplayOrder(); !ok {
+		return &ValidationError{Name: "display_order", err: errors.New(`ent: missing required field "Menu.display_order"`)}
+	}
+	if v, ok := _c.mutation.DisplayOrder(); ok {
+		if err := menu.DisplayOrderValidator(v); err != nil {
+			return &ValidationError{Name: "display_order", err: fmt.Errorf(`ent: validator failed for field "Menu.display_order": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.IsActive(); !ok {
+		return &ValidationError{Name: "is_active", err: errors.New(`ent: missing required field "Menu.is_active"`)}
+	}
+	if _, ok := _c.mutation.RestaurantID(); !ok {
+		return &ValidationError{Name: "restaurant_id", err: errors.New(`ent: missing required field "Menu.restaurant_id"`)}
+	}
+	if len(_c.mutation.RestaurantIDs()) == 0 {
+		return &ValidationError{Name: "restaurant", err: errors.New(`ent: missing required edge "Menu.restaurant"`)}
+	}
+	return nil
+}
+
+func (_c *MenuCreate) sqlSave(ctx context.Context) (*Menu, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *MenuCreate) createSpec() (*Menu, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Menu{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(menu.Table, sqlgraph.NewFieldSpec(menu.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.UpdateTime(); ok {
+		_spec.SetField(menu.FieldUpdateTime, field.TypeTime, value)
+		_node.UpdateTime = value
+	}
+	if value, ok := _c.mutation.CreateTime(); ok {
+		_spec.SetField(menu.FieldCreateTime, field.TypeTime, value)
+		_node.CreateTime = value
+	}
+	if value, ok := _c.mutation.Name(); ok {
+		_spec.SetField(menu.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := _c.mutation.Description(); ok {
+		_spec.SetField(menu.FieldDescription, field.TypeString, value)
+		_node.Description = value
+	}
+	if value, ok := _c.mutation.Schedule(); ok {
+		_spec.SetField(menu.FieldSchedule, field.TypeJSON, value)
+		_node.Schedule = value
+	}
+	if value, ok := _c.mutation.OrderTypes(); ok {
+		_spec.SetField(menu.FieldOrderTypes, field.TypeJSON, value)
+		_node.OrderTypes = value
+	}
+	if value, ok := _c.mutation.DisplayOrder(); ok {
+		_spec.SetField(menu.FieldDisplayOrder, field.TypeInt, value)
+		_node.DisplayOrder = value
+	}
+	if value, ok := _c.mutation.IsActive(); ok {
+		_spec.SetField(menu.FieldIsActive, field.TypeBool, value)
+		_node.IsActive = value
+	}
+	if nodes := _c.mutation.RestaurantIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   menu.RestaurantTable,
+			Columns: []string{menu.RestaurantColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(restaurant.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.RestaurantID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.CategoriesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   menu.CategoriesTable,
+			Columns: menu.CategoriesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(category.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.ItemPricesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   menu.ItemPricesTable,
+			Columns: []string{menu.ItemPricesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(menuitemprice.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// MenuCreateBulk is the builder for creating many Menu entities in bulk.
+type MenuCreateBulk struct {
+	config
+	err      error
+	builders []*MenuCreate
+}
+
+// Save creates the Menu entities in the database.
+func (_c *MenuCreateBulk) Save(ctx context.Context) ([]*Menu, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*Menu, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*MenuMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *MenuCreateBulk) SaveX(ctx context.Context) []*Menu {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *MenuCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *MenuCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,853 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/Jiruu246/rms/internal/ent/category"
+	"github.com/Jiruu246/rms/internal/ent/menu"
+	"github.com/Jiruu246/rms/internal/ent/menuitemprice"
+	"github.com/Jiruu246/rms/internal/ent/predicate"
+	"github.com/Jiruu246/rms/pkg/hours"
+	"github.com/google/uuid"
+)
+
+// MenuUpdate is the builder for updating Menu entities.
+type MenuUpdate struct {
+	config
+	hooks    []Hook
+	mutation *MenuMutation
+}
+
+// Where appends a list predicates to the MenuUpdate builder.
+func (_u *MenuUpdate) Where(ps ...predicate.Menu) *MenuUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_u *MenuUpdate) SetUpdateTime(v time.Time) *MenuUpdate {
+	_u.mutation.SetUpdateTime(v)
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *MenuUpdate) SetName(v string) *MenuUpdate {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *MenuUpdate) SetNillableName(v *string) *MenuUpdate {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetDescription sets the "description" field.
+func (_u *MenuUpdate) SetDescription(v string) *MenuUpdate {
+	_u.mutation.SetDescription(v)
+	return _u
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_u *MenuUpdate) SetNillableDescription(v *string) *MenuUpdate {
+	if v != nil {
+		_u.SetDescription(*v)
+	}
+	return _u
+}
+
+// SetSchedule sets the "schedule" field.
+func (_u *MenuUpdate) SetSchedule(v *hours.Schedule) *MenuUpdate {
+	_u.mutation.SetSchedule(v)
+	return _u
+}
+
+// ClearSchedule clears the value of the "schedule" field.
+func (_u *MenuUpdate) ClearSchedule() *MenuUpdate {
+	_u.mutation.ClearSchedule()
+	return _u
+}
+
+// SetOrderTypes sets the "order_types" field.
+func (_u *MenuUpdate) SetOrderTypes(v []string) *MenuUpdate {
+	_u.mutation.SetOrderTypes(v)
+	return _u
+}
+
+// AppendOrderTypes appends value to the "order_types" field.
+func (_u *MenuUpdate) AppendOrderTypes(v []string) *MenuUpdate {
+	_u.mutation.AppendOrderTypes(v)
+	return _u
+}
+
+// ClearOrderTypes clears the value of the "order_types" field.
+func (_u *MenuUpdate) ClearOrderTypes() *MenuUpdate {
+	_u.mutation.ClearOrderTypes()
+	return _u
+}
+
+// SetDisplayOrder sets the "display_order" field.
+func (_u *MenuUpdate) SetDisplayOrder(v int) *MenuUpdate {
+	_u.mutation.ResetDisplayOrder()
+	_u.mutation.SetDisplayOrder(v)
+	return _u
+}
+
+// SetNillableDisplayOrder sets the "display_order" field if the given value is not nil.
+func (_u *MenuUpdate) SetNillableDisplayOrder(v *int) *MenuUpdate {
+	if v != nil {
+		_u.SetDisplayOrder(*v)
+	}
+	return _u
+}
+
+// AddDisplayOrder adds value to the "display_order" field.
+func (_u *MenuUpdate) AddDisplayOrder(v int) *MenuUpdate {
+	_u.mutation.AddDisplayOrder(v)
+	return _u
+}
+
+// SetIsActive sets the "is_active" field.
+func (_u *MenuUpdate) SetIsActive(v bool) *MenuUpdate {
+	_u.mutation.SetIsActive(v)
+	return _u
+}
+
+// SetNillableIsActive sets the "is_active" field if the given value is not nil.
+func (_u *MenuUpdate) SetNillableIsActive(v *bool) *MenuUpdate {
+	if v != nil {
+		_u.SetIsActive(*v)
+	}
+	return _u
+}
+
+// AddCategoryIDs adds the "categories" edge to the Category entity by IDs.
+func (_u *MenuUpdate) AddCategoryIDs(ids ...uuid.UUID) *MenuUpdate {
+	_u.mutation.AddCategoryIDs(ids...)
+	return _u
+}
+
+// AddCategories adds the "categories" edges to the Category entity.
+func (_u *MenuUpdate) AddCategories(v ...*Category) *MenuUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddCategoryIDs(ids...)
+}
+
+// AddItemPriceIDs adds the "item_prices" edge to the MenuItemPrice entity by IDs.
+func (_u *MenuUpdate) AddItemPriceIDs(ids ...uuid.UUID) *MenuUpdate {
+	_u.mutation.AddItemPriceIDs(ids...)
+	return _u
+}
+
+// AddItemPrices adds the "item_prices" edges to the MenuItemPrice entity.
+func (_u *MenuUpdate) AddItemPrices(v ...*MenuItemPrice) *MenuUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddItemPriceIDs(ids...)
+}
+
+// Mutation returns the MenuMutation object of the builder.
+func (_u *MenuUpdate) Mutation() *MenuMutation {
+	return _u.mutation
+}
+
+// ClearCategories clears all "categories" edges to the Category entity.
+func (_u *MenuUpdate) ClearCategories() *MenuUpdate {
+	_u.mutation.ClearCategories()
+	return _u
+}
+
+// RemoveCategoryIDs removes the "categories" edge to Category entities by IDs.
+func (_u *MenuUpdate) RemoveCategoryIDs(ids ...uuid.UUID) *MenuUpdate {
+	_u.mutation.RemoveCategoryIDs(ids...)
+	return _u
+}
+
+// RemoveCategories removes "categories" edges to Category entities.
+func (_u *MenuUpdate) RemoveCategories(v ...*Category) *MenuUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveCategoryIDs(ids...)
+}
+
+// ClearItemPrices clears all "item_prices" edges to the MenuItemPrice entity.
+func (_u *MenuUpdate) ClearItemPrices() *MenuUpdate {
+	_u.mutation.ClearItemPrices()
+	return _u
+}
+
+// RemoveItemPriceIDs removes the "item_prices" edge to MenuItemPrice entities by IDs.
+func (_u *MenuUpdate) RemoveItemPriceIDs(ids ...uuid.UUID) *MenuUpdate {
+	_u.mutation.RemoveItemPriceIDs(ids...)
+	return _u
+}
+
+// RemoveItemPrices removes "item_prices" edges to MenuItemPrice entities.
+func (_u *MenuUpdate) RemoveItemPrices(v ...*MenuItemPrice) *MenuUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveItemPriceIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *MenuUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *MenuUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *MenuUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *MenuUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *MenuUpdate) defaults() {
+	if _, ok := _u.mutation.UpdateTime(); !ok {
+		v := menu.UpdateDefaultUpdateTime()
+		_u.mutation.SetUpdateTime(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *MenuUpdate) check() error {
+	if v, ok := _u.mutation.Name(); ok {
+		if err := menu.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Menu.name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Description(); ok {
+		if err := menu.DescriptionValidator(v); err != nil {
+			return &ValidationError{Name: "description", err: fmt.Errorf(`ent: validator failed for field "Menu.description": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Schedule(); ok {
+		if err := v.Validate(); err != nil {
+			return &ValidationError{Name: "schedule", err: fmt.Errorf(`ent: validator failed for field "Menu.schedule": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.DisplayOrder(); ok {
+		if err := menu.DisplayOrderValidator(v); err != nil {
+			return &ValidationError{Name: "display_order", err: fmt.Errorf(`ent: validator failed for field "Menu.display_order": %w`, err)}
+		}
+	}
+	if _u.mutation.RestaurantCleared() && len(_u.mutation.RestaurantIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Menu.restaurant"`)
+	}
+	return nil
+}
+
+func (_u *MenuUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(menu.Table, menu.Columns, sqlgraph.NewFieldSpec(menu.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdateTime(); ok {
+		_spec.SetField(menu.FieldUpdateTime, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(menu.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Description(); ok {
+		_spec.SetField(menu.FieldDescription, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Schedule(); ok {
+		_spec.SetField(menu.FieldSchedule, field.TypeJSON, value)
+	}
+	if _u.mutation.ScheduleCleared() {
+		_spec.ClearField(menu.FieldSchedule, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.OrderTypes(); ok {
+		_spec.SetField(menu.FieldOrderTypes, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedOrderTypes(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, menu.FieldOrderTypes, value)
+		})
+	}
+	if _u.mutation.OrderTypesCleared() {
+		_spec.ClearField(menu.FieldOrderTypes, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.DisplayOrder(); ok {
+		_spec.SetField(menu.FieldDisplayOrder, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedDisplayOrder(); ok {
+		_spec.AddField(menu.FieldDisplayOrder, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.IsActive(); ok {
+		_spec.SetField(menu.FieldIsActive, field.TypeBool, value)
+	}
+	if _u.mutation.CategoriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   menu.CategoriesTable,
+			Columns: menu.CategoriesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(category.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedCategoriesIDs(); len(nodes) > 0 && !_u.mutation.CategoriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   menu.CategoriesTable,
+			Columns: menu.CategoriesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(category.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.CategoriesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   menu.CategoriesTable,
+			Columns: menu.CategoriesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(category.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ItemPricesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   menu.ItemPricesTable,
+			Columns: []string{menu.ItemPricesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(menuitemprice.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedItemPricesIDs(); len(nodes) > 0 && !_u.mutation.ItemPricesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   menu.ItemPricesTable,
+			Columns: []string{menu.ItemPricesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(menuitemprice.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ItemPricesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   menu.ItemPricesTable,
+			Columns: []string{menu.ItemPricesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(menuitemprice.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{menu.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// MenuUpdateOne is the builder for updating a single Menu entity.
+type MenuUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *MenuMutation
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_u *MenuUpdateOne) SetUpdateTime(v time.Time) *MenuUpdateOne {
+	_u.mutation.SetUpdateTime(v)
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *MenuUpdateOne) SetName(v string) *MenuUpdateOne {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *MenuUpdateOne) SetNillableName(v *string) *MenuUpdateOne {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetDescription sets the "description" field.
+func (_u *MenuUpdateOne) SetDescription(v string) *MenuUpdateOne {
+	_u.mutation.SetDescription(v)
+	return _u
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_u *MenuUpdateOne) SetNillableDescription(v *string) *MenuUpdateOne {
+	if v != nil {
+		_u.SetDescription(*v)
+	}
+	return _u
+}
+
+// SetSchedule sets the "schedule" field.
+func (_u *MenuUpdateOne) SetSchedule(v *hours.Schedule) *MenuUpdateOne {
+	_u.mutation.SetSchedule(v)
+	return _u
+}
+
+// ClearSchedule clears the value of the "schedule" field.
+func (_u *MenuUpdateOne) ClearSchedule() *MenuUpdateOne {
+	_u.mutation.ClearSchedule()
+	return _u
+}
+
+// SetOrderTypes sets the "order_types" field.
+func (_u *MenuUpdateOne) SetOrderTypes(v []string) *MenuUpdateOne {
+	_u.mutation.SetOrderTypes(v)
+	return _u
+}
+
+// AppendOrderTypes appends value to the "order_types" field.
+func (_u *MenuUpdateOne) AppendOrderTypes(v []string) *MenuUpdateOne {
+	_u.mutation.AppendOrderTypes(v)
+	return _u
+}
+
+// ClearOrderTypes clears the value of the "order_types" field.
+func (_u *MenuUpdateOne) ClearOrderTypes() *MenuUpdateOne {
+	_u.mutation.ClearOrderTypes()
+	return _u
+}
+
+// SetDisplayOrder sets the "display_order" field.
+func (_u *MenuUpdateOne) SetDisplayOrder(v int) *MenuUpdateOne {
+	_u.mutation.ResetDisplayOrder()
+	_u.mutation.SetDisplayOrder(v)
+	return _u
+}
+
+// SetNillableDisplayOrder sets the "display_order" field if the given value is not nil.
+func (_u *MenuUpdateOne) SetNillableDisplayOrder(v *int) *MenuUpdateOne {
+	if v != nil {
+		_u.SetDisplayOrder(*v)
+	}
+	return _u
+}
+
+// AddDisplayOrder adds value to the "display_order" field.
+func (_u *MenuUpdateOne) AddDisplayOrder(v int) *MenuUpdateOne {
+	_u.mutation.AddDisplayOrder(v)
+	return _u
+}
+
+// SetIsActive sets the "is_active" field.
+func (_u *MenuUpdateOne) SetIsActive(v bool) *MenuUpdateOne {
+	_u.mutation.SetIsActive(v)
+	return _u
+}
+
+// SetNillableIsActive sets the "is_active" field if the given value is not nil.
+func (_u *MenuUpdateOne) SetNillableIsActive(v *bool) *MenuUpdateOne {
+	if v != nil {
+		_u.SetIsActive(*v)
+	}
+	return _u
+}
+
+// AddCategoryIDs adds the "categories" edge to the Category entity by IDs.
+func (_u *MenuUpdateOne) AddCategoryIDs(ids ...uuid.UUID) *MenuUpdateOne {
+	_u.mutation.AddCategoryIDs(ids...)
+	return _u
+}
+
+// AddCategories adds the "categories" edges to the Category entity.
+func (_u *MenuUpdateOne) AddCategories(v ...*Category) *MenuUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddCategoryIDs(ids...)
+}
+
+// AddItemPriceIDs adds the "item_prices" edge to the MenuItemPrice entity by IDs.
+func (_u *MenuUpdateOne) AddItemPriceIDs(ids ...uuid.UUID) *MenuUpdateOne {
+	_u.mutation.AddItemPriceIDs(ids...)
+	return _u
+}
+
+// AddItemPrices adds the "item_prices" edges to the MenuItemPrice entity.
+func (_u *MenuUpdateOne) AddItemPrices(v ...*MenuItemPrice) *MenuUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddItemPriceIDs(ids...)
+}
+
+// Mutation returns the MenuMutation object of the builder.
+func (_u *MenuUpdateOne) Mutation() *MenuMutation {
+	return _u.mutation
+}
+
+// ClearCategories clears all "categories" edges to the Category entity.
+func (_u *MenuUpdateOne) ClearCategories() *MenuUpdateOne {
+	_u.mutation.ClearCategories()
+	return _u
+}
+
+// RemoveCategoryIDs removes the "categories" edge to Category entities by IDs.
+func (_u *MenuUpdateOne) RemoveCategoryIDs(ids ...uuid.UUID) *MenuUpdateOne {
+	_u.mutation.RemoveCategoryIDs(ids...)
+	return _u
+}
+
+// RemoveCategories removes "categories" edges to Category entities.
+func (_u *MenuUpdateOne) RemoveCategories(v ...*Category) *MenuUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveCategoryIDs(ids...)
+}
+
+// ClearItemPrices clears all "item_prices" edges to the MenuItemPrice entity.
+func (_u *MenuUpdateOne) ClearItemPrices() *MenuUpdateOne {
+	_u.mutation.ClearItemPrices()
+	return _u
+}
+
+// RemoveItemPriceIDs removes the "item_prices" edge to MenuItemPrice entities by IDs.
+func (_u *MenuUpdateOne) RemoveItemPriceIDs(ids ...uuid.UUID) *MenuUpdateOne {
+	_u.mutation.RemoveItemPriceIDs(ids...)
+	return _u
+}
+
+// RemoveItemPrices removes "item_prices" edges to MenuItemPrice entities.
+func (_u *MenuUpdateOne) RemoveItemPrices(v ...*MenuItemPrice) *MenuUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveItemPriceIDs(ids...)
+}
+
+// Where appends a list predicates to the MenuUpdate builder.
+func (_u *MenuUpdateOne) Where(ps ...predicate.Menu) *MenuUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *MenuUpdateOne) Select(field string, fields ...string) *MenuUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated Menu entity.
+func (_u *MenuUpdateOne) Save(ctx context.Context) (*Menu, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *MenuUpdateOne) SaveX(ctx context.Context) *Menu {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *MenuUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *MenuUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *MenuUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdateTime(); !ok {
+		v := menu.UpdateDefaultUpdateTime()
+		_u.mutation.SetUpdateTime(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *MenuUpdateOne) check() error {
+	if v, ok := _u.mutation.Name(); ok {
+		if err := menu.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Menu.name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Description(); ok {
+		if err := menu.DescriptionValidator(v); err != nil {
+			return &ValidationError{Name: "description", err: fmt.Errorf(`ent: validator failed for field "Menu.description": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Schedule(); ok {
+		if err := v.Validate(); err != nil {
+			return &ValidationError{Name: "schedule", err: fmt.Errorf(`ent: validator failed for field "Menu.schedule": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.DisplayOrder(); ok {
+		if err := menu.DisplayOrderValidator(v); err != nil {
+			return &ValidationError{Name: "display_order", err: fmt.Errorf(`ent: validator failed for field "Menu.display_order": %w`, err)}
+		}
+	}
+	if _u.mutation.RestaurantCleared() && len(_u.mutation.RestaurantIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Menu.restaurant"`)
+	}
+	return nil
+}
+
+func (_u *MenuUpdateOne) sqlSave(ctx context.Context) (_node *Menu, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(menu.Table, menu.Columns, sqlgraph.NewFieldSpec(menu.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Menu.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, menu.FieldID)
+		for _, f := range fields {
+			if !menu.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != menu.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdateTime(); ok {
+		_spec.SetField(menu.FieldUpdateTime, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(menu.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Description(); ok {
+		_spec.SetField(menu.FieldDescription, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Schedule(); ok {
+		_spec.SetField(menu.FieldSchedule, field.TypeJSON, value)
+	}
+	if _u.mutation.ScheduleCleared() {
+		_spec.ClearField(menu.FieldSchedule, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.OrderTypes(); ok {
+		_spec.SetField(menu.FieldOrderTypes, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedOrderTypes(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, menu.FieldOrderTypes, value)
+		})
+	}
+	if _u.mutation.OrderTypesCleared() {
+		_spec.ClearField(menu.FieldOrderTypes, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.DisplayOrder(); ok {
+		_spec.SetField(menu.FieldDisplayOrder, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedDisplayOrder(); ok {
+		_spec.AddField(menu.FieldDisplayOrder, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.IsActive(); ok {
+		_spec.SetField(menu.FieldIsActive, field.TypeBool, value)
+	}
+	if _u.mutation.CategoriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   menu.CategoriesTable,
+			Columns: menu.CategoriesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(category.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedCategoriesIDs(); len(nodes) > 0 && !_u.mutation.CategoriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   menu.CategoriesTable,
+			Columns: menu.CategoriesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(category.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.CategoriesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   menu.CategoriesTable,
+			Columns: menu.CategoriesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(category.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ItemPricesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   menu.ItemPricesTable,
+			Columns: []string{menu.ItemPricesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(menuitemprice.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedItemPricesIDs(); len(nodes) > 0 && !_u.mutation.ItemPricesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   menu.ItemPricesTable,
+			Columns: []string{menu.ItemPricesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(menuitemprice.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ItemPricesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   menu.ItemPricesTable,
+			Columns: []string{menu.ItemPricesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(menuitemprice.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Menu{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{menu.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -3,6 +3,7 @@
 package ent
 
 import (
+	"encoding/json"
 	"fmt"
 	"strings"
 	"time"
@@ -12,6 +13,7 @@ import (
 	"github.com/Jiruu246/rms/internal/ent/category"
 	"github.com/Jiruu246/rms/internal/ent/menuitem"
 	"github.com/Jiruu246/rms/internal/ent/restaurant"
+	"github.com/Jiruu246/rms/internal/ent/station"
 	"github.com/google/uuid"
 )
 
@@ -26,21 +28,30 @@ type MenuItem struct {
 	Name string `json:"name,omitempty"`
 	// Menu item description
 	Description string `json:"description,omitempty"`
-	// Menu item price
-	Price float64 `json:"price,omitempty"`
+	// Menu item price in minor units of the restaurant currency
+	Price int64 `json:"price,omitempty"`
 	// URL of the menu item image
 	ImageURL string `json:"image_url,omitempty"`
+	// URL of a small version of the uploaded image
+	ThumbnailURL string `json:"thumbnail_url,omitempty"`
+	// Blob store keys of the uploaded image and its thumbnail, deleted with the item
+	ImageKeys []string `json:"image_keys,omitempty"`
 	// Whether the menu item is available
 	IsAvailable bool `json:"is_available,omitempty"`
+	// Display order for sorting within its category
+	DisplayOrder int `json:"display_order,omitempty"`
+	// Whether is_available was turned off because an ingredient ran out; restocking turns it back on
+	OutOfStock bool `json:"out_of_stock,omitempty"`
 	// ID of the restaurant this menu item belongs to
 	RestaurantID uuid.UUID `json:"restaurant_id,omitempty"`
 	// ID of the category this menu item belongs to
 	CategoryID uuid.UUID `json:"category_id,omitempty"`
+	// ID of the station that prepares this item; overrides the category's station
+	StationID *uuid.UUID `json:"station_id,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the MenuItemQuery when eager-loading is set.
-	Edges               MenuItemEdges `json:"edges"`
-	modifier_menu_items *uuid.UUID
-	selectValues        sql.SelectValues
+	Edges        MenuItemEdges `json:"edges"`
+	selectValues sql.SelectValues
 }
 
 // MenuItemEdges holds the relations/edges for other nodes in the graph.
@@ -49,13 +60,23 @@ type MenuItemEdges struct {
 	Restaurant *Restaurant `json:"restaurant,omitempty"`
 	// Category holds the value of the category edge.
 	Category *Category `json:"category,omitempty"`
+	// Station holds the value of the station edge.
+	Station *Station `json:"station,omitempty"`
 	// Modifiers holds the value of the modifiers edge.
 	Modifiers []*Modifier `json:"modifiers,omitempty"`
 	// OrderItems holds the value of the order_items edge.
 	OrderItems []*OrderItem `json:"order_items,omitempty"`
+	// RecipeLines holds the value of the recipe_lines edge.
+	RecipeLines []*RecipeIngredient `json:"recipe_lines,omitempty"`
+	// MenuPrices holds the value of the menu_prices edge.
+	MenuPrices []*MenuItemPrice `json:"menu_prices,omitempty"`
+	// Variants holds the value of the variants edge.
+	Variants []*MenuItemVariant `json:"variants,omitempty"`
+	// ItemModifiers holds the value of the item_modifiers edge.
+	ItemModifiers []*MenuItemModifier `json:"item_modifiers,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [4]bool
+	loadedTypes [9]bool
 }
 
 // RestaurantOrErr returns the Restaurant value or an error if the edge
@@ -80,10 +101,21 @@ func (e MenuItemEdges) CategoryOrErr() (*Category, error) {
 	return nil, &NotLoadedError{edge: "category"}
 }
 
+// StationOrErr returns the Station value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e MenuItemEdges) StationOrErr() (*Station, error) {
+	if e.Station != nil {
+		return e.Station, nil
+	} else if e.loadedTypes[2] {
+		return nil, &NotFoundError{label: station.Label}
+	}
+	return nil, &NotLoadedError{edge: "station"}
+}
+
 // ModifiersOrErr returns the Modifiers value or an error if the edge
 // was not loaded in eager-loading.
 func (e MenuItemEdges) ModifiersOrErr() ([]*Modifier, error) {
-	if e.loadedTypes[2] {
+	if e.loadedTypes[3] {
 		return e.Modifiers, nil
 	}
 	return nil, &NotLoadedError{edge: "modifiers"}
@@ -92,31 +124,67 @@ func (e MenuItemEdges) ModifiersOrErr() ([]*Modifier, error) {
 // OrderItemsOrErr returns the OrderItems value or an error if the edge
 // was not loaded in eager-loading.
 func (e MenuItemEdges) OrderItemsOrErr() ([]*OrderItem, error) {
-	if e.loadedTypes[3] {
+	if e.loadedTypes[4] {
 		return e.OrderItems, nil
 	}
 	return nil, &NotLoadedError{edge: "order_items"}
 }
 
+// RecipeLinesOrErr returns the RecipeLines value or an error if the edge
+// was not loaded in eager-loading.
+func (e MenuItemEdges) RecipeLinesOrErr() ([]*RecipeIngredient, error) {
+	if e.loadedTypes[5] {
+		return e.RecipeLines, nil
+	}
+	return nil, &NotLoadedError{edge: "recipe_lines"}
+}
+
+// MenuPricesOrErr returns the MenuPrices value or an error if the edge
+// was not loaded in eager-loading.
+func (e MenuItemEdges) MenuPricesOrErr() ([]*MenuItemPrice, error) {
+	if e.loadedTypes[6] {
+		return e.MenuPrices, nil
+	}
+	return nil, &NotLoadedError{edge: "menu_prices"}
+}
+
+// VariantsOrErr returns the Variants value or an error if the edge
+// was not loaded in eager-loading.
+func (e MenuItemEdges) VariantsOrErr() ([]*MenuItemVariant, error) {
+	if e.loadedTypes[7] {
+		return e.Variants, nil
+	}
+	return nil, &NotLoadedError{edge: "variants"}
+}
+
+// ItemModifiersOrErr returns the ItemModifiers value or an error if the edge
+// was not loaded in eager-loading.
+func (e MenuItemEdges) ItemModifiersOrErr() ([]*MenuItemModifier, error) {
+	if e.loadedTypes[8] {
+		return e.ItemModifiers, nil
+	}
+	return nil, &NotLoadedError{edge: "item_modifiers"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*MenuItem) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case menuitem.FieldIsAvailable:
+		case menuitem.FieldStationID:
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case menuitem.FieldImageKeys:
+			values[i] = new([]byte)
+		case menuitem.FieldIsAvailable, menuitem.FieldOutOfStock:
 			values[i] = new(sql.NullBool)
-		case menuitem.FieldPrice:
-			values[i] = new(sql.NullFloat64)
-		case menuitem.FieldID:
+		case menuitem.FieldID, menuitem.FieldPrice, menuitem.FieldDisplayOrder:
 			values[i] = new(sql.NullInt64)
-		case menuitem.FieldName, menuitem.FieldDescription, menuitem.FieldImageURL:
+		case menuitem.FieldName, menuitem.FieldDescription, menuitem.FieldImageURL, menuitem.FieldThumbnailURL:
 			values[i] = new(sql.NullString)
 		case menuitem.FieldUpdateTime:
 			values[i] = new(sql.NullTime)
 		case menuitem.FieldRestaurantID, menuitem.FieldCategoryID:
 			values[i] = new(uuid.UUID)
-		case menuitem.ForeignKeys[0]: // modifier_menu_items
-			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
 		default:
 			values[i] = new(sql.UnknownType)
 		}
@@ -157,10 +225,10 @@ func (_m *MenuItem) assignValues(columns []string, values []any) error {
 				_m.Description = value.String
 			}
 		case menuitem.FieldPrice:
-			if value, ok := values[i].(*sql.NullFloat64); !ok {
+			if value, ok := values[i].(*sql.NullInt64); !ok {
 				return fmt.Errorf("unexpected type %T for field price", values[i])
 			} else if value.Valid {
-				_m.Price = value.Float64
+				_m.Price = value.Int64
 			}
 		case menuitem.FieldImageURL:
 			if value, ok := values[i].(*sql.NullString); !ok {
@@ -168,12 +236,38 @@ func (_m *MenuItem) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.ImageURL = value.String
 			}
+		case menuitem.FieldThumbnailURL:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field thumbnail_url", values[i])
+			} else if value.Valid {
+				_m.ThumbnailURL = value.String
+			}
+		case menuitem.FieldImageKeys:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field image_keys", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.ImageKeys); err != nil {
+					return fmt.Errorf("unmarshal field image_keys: %w", err)
+				}
+			}
 		case menuitem.FieldIsAvailable:
 			if value, ok := values[i].(*sql.NullBool); !ok {
 				return fmt.Errorf("unexpected type %T for field is_available", values[i])
 			} else if value.Valid {
 				_m.IsAvailable = value.Bool
 			}
+		case menuitem.FieldDisplayOrder:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field display_order", values[i])
+			} else if value.Valid {
+				_m.DisplayOrder = int(value.Int64)
+			}
+		case menuitem.FieldOutOfStock:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field out_of_stock", values[i])
+			} else if value.Valid {
+				_m.OutOfStock = value.Bool
+			}
 		case menuitem.FieldRestaurantID:
 			if value, ok := values[i].(*uuid.UUID); !ok {
 				return fmt.Errorf("unexpected type %T for field restaurant_id", values[i])
@@ -186,12 +280,12 @@ func (_m *MenuItem) assignValues(columns []string, values []any) error {
 			} else if value != nil {
 				_m.CategoryID = *value
 			}
-		case menuitem.ForeignKeys[0]:
+		case menuitem.FieldStationID:
 			if value, ok := values[i].(*sql.NullScanner); !ok {
-				return fmt.Errorf("unexpected type %T for field modifier_menu_items", values[i])
+				return fmt.Errorf("unexpected type %T for field station_id", values[i])
 			} else if value.Valid {
-				_m.modifier_menu_items = new(uuid.UUID)
-				*_m.modifier_menu_items = *value.S.(*uuid.UUID)
+				_m.StationID = new(uuid.UUID)
+				*_m.StationID = *value.S.(*uuid.UUID)
 			}
 		default:
 			_m.selectValues.Set(columns[i], values[i])
@@ -216,6 +310,11 @@ func (_m *MenuItem) QueryCategory() *CategoryQuery {
 	return NewMenuItemClient(_m.config).QueryCategory(_m)
 }
 
+// QueryStation queries the "station" edge of the MenuItem entity.
+func (_m *MenuItem) QueryStation() *StationQuery {
+	return NewMenuItemClient(_m.config).QueryStation(_m)
+}
+
 // QueryModifiers queries the "modifiers" edge of the MenuItem entity.
 func (_m *MenuItem) QueryModifiers() *ModifierQuery {
 	return NewMenuItemClient(_m.config).QueryModifiers(_m)
@@ -226,6 +325,26 @@ func (_m *MenuItem) QueryOrderItems() *OrderItemQuery {
 	return NewMenuItemClient(_m.config).QueryOrderItems(_m)
 }
 
+// QueryRecipeLines queries the "recipe_lines" edge of the MenuItem entity.
+func (_m *MenuItem) QueryRecipeLines() *RecipeIngredientQuery {
+	return NewMenuItemClient(_m.config).QueryRecipeLines(_m)
+}
+
+// QueryMenuPrices queries the "menu_prices" edge of the MenuItem entity.
+func (_m *MenuItem) QueryMenuPrices() *MenuItemPriceQuery {
+	return NewMenuItemClient(_m.config).QueryMenuPrices(_m)
+}
+
+// QueryVariants queries the "variants" edge of the MenuItem entity.
+func (_m *MenuItem) QueryVariants() *MenuItemVariantQuery {
+	return NewMenuItemClient(_m.config).QueryVariants(_m)
+}
+
+// QueryItemModifiers queries the "item_modifiers" edge of the MenuItem entity.
+func (_m *MenuItem) QueryItemModifiers() *MenuItemModifierQuery {
+	return NewMenuItemClient(_m.config).QueryItemModifiers(_m)
+}
+
 // Update returns a builder for updating this MenuItem.
 // Note that you need to call MenuItem.Unwrap() before calling this method if this MenuItem
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -264,14 +383,31 @@ func (_m *MenuItem) String() string {
 	builder.WriteString("image_url=")
 	builder.WriteString(_m.ImageURL)
 	builder.WriteString(", ")
+	builder.WriteString("thumbnail_url=")
+	builder.WriteString(_m.ThumbnailURL)
+	builder.WriteString(", ")
+	builder.WriteString("image_keys=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ImageKeys))
+	builder.WriteString(", ")
 	builder.WriteString("is_available=")
 	builder.WriteString(fmt.Sprintf("%v", _m.IsAvailable))
 	builder.WriteString(", ")
+	builder.WriteString("display_order=")
+	builder.WriteString(fmt.Sprintf("%v", _m.DisplayOrder))
+	builder.WriteString(", ")
+	builder.WriteString("out_of_stock=")
+	builder.WriteString(fmt.Sprintf("%v", _m.OutOfStock))
+	builder.WriteString(", ")
 	builder.WriteString("restaurant_id=")
 	builder.WriteString(fmt.Sprintf("%v", _m.RestaurantID))
 	builder.WriteString(", ")
 	builder.WriteString("category_id=")
 	builder.WriteString(fmt.Sprintf("%v", _m.CategoryID))
+	builder.WriteString(", ")
+	if v := _m.StationID; v != nil {
+		builder.WriteString("station_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
 	builder.WriteByte(')')
 	return builder.String()
 }
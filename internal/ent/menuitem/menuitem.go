@@ -24,20 +24,40 @@ const (
 	FieldPrice = "price"
 	// FieldImageURL holds the string denoting the image_url field in the database.
 	FieldImageURL = "image_url"
+	// FieldThumbnailURL holds the string denoting the thumbnail_url field in the database.
+	FieldThumbnailURL = "thumbnail_url"
+	// FieldImageKeys holds the string denoting the image_keys field in the database.
+	FieldImageKeys = "image_keys"
 	// FieldIsAvailable holds the string denoting the is_available field in the database.
 	FieldIsAvailable = "is_available"
+	// FieldDisplayOrder holds the string denoting the display_order field in the database.
+	FieldDisplayOrder = "display_order"
+	// FieldOutOfStock holds the string denoting the out_of_stock field in the database.
+	FieldOutOfStock = "out_of_stock"
 	// FieldRestaurantID holds the string denoting the restaurant_id field in the database.
 	FieldRestaurantID = "restaurant_id"
 	// FieldCategoryID holds the string denoting the category_id field in the database.
 	FieldCategoryID = "category_id"
+	// FieldStationID holds the string denoting the station_id field in the database.
+	FieldStationID = "station_id"
 	// EdgeRestaurant holds the string denoting the restaurant edge name in mutations.
 	EdgeRestaurant = "restaurant"
 	// EdgeCategory holds the string denoting the category edge name in mutations.
 	EdgeCategory = "category"
+	// EdgeStation holds the string denoting the station edge name in mutations.
+	EdgeStation = "station"
 	// EdgeModifiers holds the string denoting the modifiers edge name in mutations.
 	EdgeModifiers = "modifiers"
 	// EdgeOrderItems holds the string denoting the order_items edge name in mutations.
 	EdgeOrderItems = "order_items"
+	// EdgeRecipeLines holds the string denoting the recipe_lines edge name in mutations.
+	EdgeRecipeLines = "recipe_lines"
+	// EdgeMenuPrices holds the string denoting the menu_prices edge name in mutations.
+	EdgeMenuPrices = "menu_prices"
+	// EdgeVariants holds the string denoting the variants edge name in mutations.
+	EdgeVariants = "variants"
+	// EdgeItemModifiers holds the string denoting the item_modifiers edge name in mutations.
+	EdgeItemModifiers = "item_modifiers"
 	// Table holds the table name of the menuitem in the database.
 	Table = "menu_items"
 	// RestaurantTable is the table that holds the restaurant relation/edge.
@@ -54,13 +74,18 @@ const (
 	CategoryInverseTable = "categories"
 	// CategoryColumn is the table column denoting the category relation/edge.
 	CategoryColumn = "category_id"
-	// ModifiersTable is the table that holds the modifiers relation/edge.
-	ModifiersTable = "modifiers"
+	// StationTable is the table that holds the station relation/edge.
+	StationTable = "menu_items"
+	// StationInverseTable is the table name for the Station entity.
+	// It exists in this package in order to avoid circular dependency with the "station" package.
+	StationInverseTable = "stations"
+	// StationColumn is the table column denoting the station relation/edge.
+	StationColumn = "station_id"
+	// ModifiersTable is the table that holds the modifiers relation/edge. The primary key declared below.
+	ModifiersTable = "menu_item_modifiers"
 	// ModifiersInverseTable is the table name for the Modifier entity.
 	// It exists in this package in order to avoid circular dependency with the "modifier" package.
 	ModifiersInverseTable = "modifiers"
-	// ModifiersColumn is the table column denoting the modifiers relation/edge.
-	ModifiersColumn = "menu_item_modifiers"
 	// OrderItemsTable is the table that holds the order_items relation/edge.
 	OrderItemsTable = "order_items"
 	// OrderItemsInverseTable is the table name for the OrderItem entity.
@@ -68,6 +93,34 @@ const (
 	OrderItemsInverseTable = "order_items"
 	// OrderItemsColumn is the table column denoting the order_items relation/edge.
 	OrderItemsColumn = "menu_item_id"
+	// RecipeLinesTable is the table that holds the recipe_lines relation/edge.
+	RecipeLinesTable = "recipe_ingredients"
+	// RecipeLinesInverseTable is the table name for the RecipeIngredient entity.
+	// It exists in this package in order to avoid circular dependency with the "recipeingredient" package.
+	RecipeLinesInverseTable = "recipe_ingredients"
+	// RecipeLinesColumn is the table column denoting the recipe_lines relation/edge.
+	RecipeLinesColumn = "menu_item_id"
+	// MenuPricesTable is the table that holds the menu_prices relation/edge.
+	MenuPricesTable = "menu_item_prices"
+	// MenuPricesInverseTable is the table name for the MenuItemPrice entity.
+	// It exists in this package in order to avoid circular dependency with the "menuitemprice" package.
+	MenuPricesInverseTable = "menu_item_prices"
+	// MenuPricesColumn is the table column denoting the menu_prices relation/edge.
+	MenuPricesColumn = "menu_item_id"
+	// VariantsTable is the table that holds the variants relation/edge.
+	VariantsTable = "menu_item_variants"
+	// VariantsInverseTable is the table name for the MenuItemVariant entity.
+	// It exists in this package in order to avoid circular dependency with the "menuitemvariant" package.
+	VariantsInverseTable = "menu_item_variants"
+	// VariantsColumn is the table column denoting the variants relation/edge.
+	VariantsColumn = "menu_item_id"
+	// ItemModifiersTable is the table that holds the item_modifiers relation/edge.
+	ItemModifiersTable = "menu_item_modifiers"
+	// ItemModifiersInverseTable is the table name for the MenuItemModifier entity.
+	// It exists in this package in order to avoid circular dependency with the "menuitemmodifier" package.
+	ItemModifiersInverseTable = "menu_item_modifiers"
+	// ItemModifiersColumn is the table column denoting the item_modifiers relation/edge.
+	ItemModifiersColumn = "menu_item_id"
 )
 
 // Columns holds all SQL columns for menuitem fields.
@@ -78,16 +131,21 @@ var Columns = []string{
 	FieldDescription,
 	FieldPrice,
 	FieldImageURL,
+	FieldThumbnailURL,
+	FieldImageKeys,
 	FieldIsAvailable,
+	FieldDisplayOrder,
+	FieldOutOfStock,
 	FieldRestaurantID,
 	FieldCategoryID,
+	FieldStationID,
 }
 
-// ForeignKeys holds the SQL foreign-keys that are owned by the "menu_items"
-// table and are not defined as standalone fields in the schema.
-var ForeignKeys = []string{
-	"modifier_menu_items",
-}
+var (
+	// ModifiersPrimaryKey and ModifiersColumn2 are the table columns denoting the
+	// primary key for the modifiers relation (M2M).
+	ModifiersPrimaryKey = []string{"menu_item_id", "modifier_id"}
+)
 
 // ValidColumn reports if the column name is valid (part of the table columns).
 func ValidColumn(column string) bool {
@@ -96,11 +154,6 @@ func ValidColumn(column string) bool {
 			return true
 		}
 	}
-	for i := range ForeignKeys {
-		if column == ForeignKeys[i] {
-			return true
-		}
-	}
 	return false
 }
 
@@ -116,9 +169,15 @@ var (
 	// DescriptionValidator is a validator for the "description" field. It is called by the builders before save.
 	DescriptionValidator func(string) error
 	// PriceValidator is a validator for the "price" field. It is called by the builders before save.
-	PriceValidator func(float64) error
+	PriceValidator func(int64) error
 	// DefaultIsAvailable holds the default value on creation for the "is_available" field.
 	DefaultIsAvailable bool
+	// DefaultDisplayOrder holds the default value on creation for the "display_order" field.
+	DefaultDisplayOrder int
+	// DisplayOrderValidator is a validator for the "display_order" field. It is called by the builders before save.
+	DisplayOrderValidator func(int) error
+	// DefaultOutOfStock holds the default value on creation for the "out_of_stock" field.
+	DefaultOutOfStock bool
 	// IDValidator is a validator for the "id" field. It is called by the builders before save.
 	IDValidator func(int64) error
 )
@@ -156,11 +215,26 @@ func ByImageURL(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldImageURL, opts...).ToFunc()
 }
 
+// ByThumbnailURL orders the results by the thumbnail_url field.
+func ByThumbnailURL(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldThumbnailURL, opts...).ToFunc()
+}
+
 // ByIsAvailable orders the results by the is_available field.
 func ByIsAvailable(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldIsAvailable, opts...).ToFunc()
 }
 
+// ByDisplayOrder orders the results by the display_order field.
+func ByDisplayOrder(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDisplayOrder, opts...).ToFunc()
+}
+
+// ByOutOfStock orders the results by the out_of_stock field.
+func ByOutOfStock(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOutOfStock, opts...).ToFunc()
+}
+
 // ByRestaurantID orders the results by the restaurant_id field.
 func ByRestaurantID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldRestaurantID, opts...).ToFunc()
@@ -171,6 +245,11 @@ func ByCategoryID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCategoryID, opts...).ToFunc()
 }
 
+// ByStationID orders the results by the station_id field.
+func ByStationID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStationID, opts...).ToFunc()
+}
+
 // ByRestaurantField orders the results by restaurant field.
 func ByRestaurantField(field string, opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -185,6 +264,13 @@ func ByCategoryField(field string, opts ...sql.OrderTermOption) OrderOption {
 	}
 }
 
+// ByStationField orders the results by station field.
+func ByStationField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newStationStep(), sql.OrderByField(field, opts...))
+	}
+}
+
 // ByModifiersCount orders the results by modifiers count.
 func ByModifiersCount(opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -212,6 +298,62 @@ func ByOrderItems(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newOrderItemsStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
+
+// ByRecipeLinesCount orders the results by recipe_lines count.
+func ByRecipeLinesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newRecipeLinesStep(), opts...)
+	}
+}
+
+// ByRecipeLines orders the results by recipe_lines terms.
+func ByRecipeLines(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newRecipeLinesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByMenuPricesCount orders the results by menu_prices count.
+func ByMenuPricesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newMenuPricesStep(), opts...)
+	}
+}
+
+// ByMenuPrices orders the results by menu_prices terms.
+func ByMenuPrices(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newMenuPricesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByVariantsCount orders the results by variants count.
+func ByVariantsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newVariantsStep(), opts...)
+	}
+}
+
+// ByVariants orders the results by variants terms.
+func ByVariants(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newVariantsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByItemModifiersCount orders the results by item_modifiers count.
+func ByItemModifiersCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newItemModifiersStep(), opts...)
+	}
+}
+
+// ByItemModifiers orders the results by item_modifiers terms.
+func ByItemModifiers(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newItemModifiersStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newRestaurantStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -226,11 +368,18 @@ func newCategoryStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.M2O, true, CategoryTable, CategoryColumn),
 	)
 }
+func newStationStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(StationInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, StationTable, StationColumn),
+	)
+}
 func newModifiersStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
 		sqlgraph.To(ModifiersInverseTable, FieldID),
-		sqlgraph.Edge(sqlgraph.O2M, false, ModifiersTable, ModifiersColumn),
+		sqlgraph.Edge(sqlgraph.M2M, false, ModifiersTable, ModifiersPrimaryKey...),
 	)
 }
 func newOrderItemsStep() *sqlgraph.Step {
@@ -240,3 +389,31 @@ func newOrderItemsStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, false, OrderItemsTable, OrderItemsColumn),
 	)
 }
+func newRecipeLinesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(RecipeLinesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, RecipeLinesTable, RecipeLinesColumn),
+	)
+}
+func newMenuPricesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(MenuPricesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, MenuPricesTable, MenuPricesColumn),
+	)
+}
+func newVariantsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(VariantsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, VariantsTable, VariantsColumn),
+	)
+}
+func newItemModifiersStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ItemModifiersInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, true, ItemModifiersTable, ItemModifiersColumn),
+	)
+}
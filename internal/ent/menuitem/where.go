@@ -72,7 +72,7 @@ func Description(v string) predicate.MenuItem {
 }
 
 // Price applies equality check predicate on the "price" field. It's identical to PriceEQ.
-func Price(v float64) predicate.MenuItem {
+func Price(v int64) predicate.MenuItem {
 	return predicate.MenuItem(sql.FieldEQ(FieldPrice, v))
 }
 
@@ -81,11 +81,26 @@ func ImageURL(v string) predicate.MenuItem {
 	return predicate.MenuItem(sql.FieldEQ(FieldImageURL, v))
 }
 
+// ThumbnailURL applies equality check predicate on the "thumbnail_url" field. It's identical to ThumbnailURLEQ.
+func ThumbnailURL(v string) predicate.MenuItem {
+	return predicate.MenuItem(sql.FieldEQ(FieldThumbnailURL, v))
+}
+
 // IsAvailable applies equality check predicate on the "is_available" field. It's identical to IsAvailableEQ.
 func IsAvailable(v bool) predicate.MenuItem {
 	return predicate.MenuItem(sql.FieldEQ(FieldIsAvailable, v))
 }
 
+// DisplayOrder applies equality check predicate on the "display_order" field. It's identical to DisplayOrderEQ.
+func DisplayOrder(v int) predicate.MenuItem {
+	return predicate.MenuItem(sql.FieldEQ(FieldDisplayOrder, v))
+}
+
+// OutOfStock applies equality check predicate on the "out_of_stock" field. It's identical to OutOfStockEQ.
+func OutOfStock(v bool) predicate.MenuItem {
+	return predicate.MenuItem(sql.FieldEQ(FieldOutOfStock, v))
+}
+
 // RestaurantID applies equality check predicate on the "restaurant_id" field. It's identical to RestaurantIDEQ.
 func RestaurantID(v uuid.UUID) predicate.MenuItem {
 	return predicate.MenuItem(sql.FieldEQ(FieldRestaurantID, v))
@@ -96,6 +111,11 @@ func CategoryID(v uuid.UUID) predicate.MenuItem {
 	return predicate.MenuItem(sql.FieldEQ(FieldCategoryID, v))
 }
 
+// StationID applies equality check predicate on the "station_id" field. It's identical to StationIDEQ.
+func StationID(v uuid.UUID) predicate.MenuItem {
+	return predicate.MenuItem(sql.FieldEQ(FieldStationID, v))
+}
+
 // UpdateTimeEQ applies the EQ predicate on the "update_time" field.
 func UpdateTimeEQ(v time.Time) predicate.MenuItem {
 	return predicate.MenuItem(sql.FieldEQ(FieldUpdateTime, v))
@@ -267,42 +287,42 @@ func DescriptionContainsFold(v string) predicate.MenuItem {
 }
 
 // PriceEQ applies the EQ predicate on the "price" field.
-func PriceEQ(v float64) predicate.MenuItem {
+func PriceEQ(v int64) predicate.MenuItem {
 	return predicate.MenuItem(sql.FieldEQ(FieldPrice, v))
 }
 
 // PriceNEQ applies the NEQ predicate on the "price" field.
-func PriceNEQ(v float64) predicate.MenuItem {
+func PriceNEQ(v int64) predicate.MenuItem {
 	return predicate.MenuItem(sql.FieldNEQ(FieldPrice, v))
 }
 
 // PriceIn applies the In predicate on the "price" field.
-func PriceIn(vs ...float64) predicate.MenuItem {
+func PriceIn(vs ...int64) predicate.MenuItem {
 	return predicate.MenuItem(sql.FieldIn(FieldPrice, vs...))
 }
 
 // PriceNotIn applies the NotIn predicate on the "price" field.
-func PriceNotIn(vs ...float64) predicate.MenuItem {
+func PriceNotIn(vs ...int64) predicate.MenuItem {
 	return predicate.MenuItem(sql.FieldNotIn(FieldPrice, vs...))
 }
 
 // PriceGT applies the GT predicate on the "price" field.
-func PriceGT(v float64) predicate.MenuItem {
+func PriceGT(v int64) predicate.MenuItem {
 	return predicate.MenuItem(sql.FieldGT(FieldPrice, v))
 }
 
 // PriceGTE applies the GTE predicate on the "price" field.
-func PriceGTE(v float64) predicate.MenuItem {
+func PriceGTE(v int64) predicate.MenuItem {
 	return predicate.MenuItem(sql.FieldGTE(FieldPrice, v))
 }
 
 // PriceLT applies the LT predicate on the "price" field.
-func PriceLT(v float64) predicate.MenuItem {
+func PriceLT(v int64) predicate.MenuItem {
 	return predicate.MenuItem(sql.FieldLT(FieldPrice, v))
 }
 
 // PriceLTE applies the LTE predicate on the "price" field.
-func PriceLTE(v float64) predicate.MenuItem {
+func PriceLTE(v int64) predicate.MenuItem {
 	return predicate.MenuItem(sql.FieldLTE(FieldPrice, v))
 }
 
@@ -381,6 +401,91 @@ func ImageURLContainsFold(v string) predicate.MenuItem {
 	return predicate.MenuItem(sql.FieldContainsFold(FieldImageURL, v))
 }
 
+// ThumbnailURLEQ applies the EQ predicate on the "thumbnail_url" field.
+func ThumbnailURLEQ(v string) predicate.MenuItem {
+	return predicate.MenuItem(sql.FieldEQ(FieldThumbnailURL, v))
+}
+
+// ThumbnailURLNEQ applies the NEQ predicate on the "thumbnail_url" field.
+func ThumbnailURLNEQ(v string) predicate.MenuItem {
+	return predicate.MenuItem(sql.FieldNEQ(FieldThumbnailURL, v))
+}
+
+// ThumbnailURLIn applies the In predicate on the "thumbnail_url" field.
+func ThumbnailURLIn(vs ...string) predicate.MenuItem {
+	return predicate.MenuItem(sql.FieldIn(FieldThumbnailURL, vs...))
+}
+
+// ThumbnailURLNotIn applies the NotIn predicate on the "thumbnail_url" field.
+func ThumbnailURLNotIn(vs ...string) predicate.MenuItem {
+	return predicate.MenuItem(sql.FieldNotIn(FieldThumbnailURL, vs...))
+}
+
+// ThumbnailURLGT applies the GT predicate on the "thumbnail_url" field.
+func ThumbnailURLGT(v string) predicate.MenuItem {
+	return predicate.MenuItem(sql.FieldGT(FieldThumbnailURL, v))
+}
+
+// ThumbnailURLGTE applies the GTE predicate on the "thumbnail_url" field.
+func ThumbnailURLGTE(v string) predicate.MenuItem {
+	return predicate.MenuItem(sql.FieldGTE(FieldThumbnailURL, v))
+}
+
+// ThumbnailURLLT applies the LT predicate on the "thumbnail_url" field.
+func ThumbnailURLLT(v string) predicate.MenuItem {
+	return predicate.MenuItem(sql.FieldLT(FieldThumbnailURL, v))
+}
+
+// ThumbnailURLLTE applies the LTE predicate on the "thumbnail_url" field.
+func ThumbnailURLLTE(v string) predicate.MenuItem {
+	return predicate.MenuItem(sql.FieldLTE(FieldThumbnailURL, v))
+}
+
+// ThumbnailURLContains applies the Contains predicate on the "thumbnail_url" field.
+func ThumbnailURLContains(v string) predicate.MenuItem {
+	return predicate.MenuItem(sql.FieldContains(FieldThumbnailURL, v))
+}
+
+// ThumbnailURLHasPrefix applies the HasPrefix predicate on the "thumbnail_url" field.
+func ThumbnailURLHasPrefix(v string) predicate.MenuItem {
+	return predicate.MenuItem(sql.FieldHasPrefix(FieldThumbnailURL, v))
+}
+
+// ThumbnailURLHasSuffix applies the HasSuffix predicate on the "thumbnail_url" field.
+func ThumbnailURLHasSuffix(v string) predicate.MenuItem {
+	return predicate.MenuItem(sql.FieldHasSuffix(FieldThumbnailURL, v))
+}
+
+// ThumbnailURLIsNil applies the IsNil predicate on the "thumbnail_url" field.
+func ThumbnailURLIsNil() predicate.MenuItem {
+	return predicate.MenuItem(sql.FieldIsNull(FieldThumbnailURL))
+}
+
+// ThumbnailURLNotNil applies the NotNil predicate on the "thumbnail_url" field.
+func ThumbnailURLNotNil() predicate.MenuItem {
+	return predicate.MenuItem(sql.FieldNotNull(FieldThumbnailURL))
+}
+
+// ThumbnailURLEqualFold applies the EqualFold predicate on the "thumbnail_url" field.
+func ThumbnailURLEqualFold(v string) predicate.MenuItem {
+	return predicate.MenuItem(sql.FieldEqualFold(FieldThumbnailURL, v))
+}
+
+// ThumbnailURLContainsFold applies the ContainsFold predicate on the "thumbnail_url" field.
+func ThumbnailURLContainsFold(v string) predicate.MenuItem {
+	return predicate.MenuItem(sql.FieldContainsFold(FieldThumbnailURL, v))
+}
+
+// ImageKeysIsNil applies the IsNil predicate on the "image_keys" field.
+func ImageKeysIsNil() predicate.MenuItem {
+	return predicate.MenuItem(sql.FieldIsNull(FieldImageKeys))
+}
+
+// ImageKeysNotNil applies the NotNil predicate on the "image_keys" field.
+func ImageKeysNotNil() predicate.MenuItem {
+	return predicate.MenuItem(sql.FieldNotNull(FieldImageKeys))
+}
+
 // IsAvailableEQ applies the EQ predicate on the "is_available" field.
 func IsAvailableEQ(v bool) predicate.MenuItem {
 	return predicate.MenuItem(sql.FieldEQ(FieldIsAvailable, v))
@@ -391,6 +496,56 @@ func IsAvailableNEQ(v bool) predicate.MenuItem {
 	return predicate.MenuItem(sql.FieldNEQ(FieldIsAvailable, v))
 }
 
+// DisplayOrderEQ applies the EQ predicate on the "display_order" field.
+func DisplayOrderEQ(v int) predicate.MenuItem {
+	return predicate.MenuItem(sql.FieldEQ(FieldDisplayOrder, v))
+}
+
+// DisplayOrderNEQ applies the NEQ predicate on the "display_order" field.
+func DisplayOrderNEQ(v int) predicate.MenuItem {
+	return predicate.MenuItem(sql.FieldNEQ(FieldDisplayOrder, v))
+}
+
+// DisplayOrderIn applies the In predicate on the "display_order" field.
+func DisplayOrderIn(vs ...int) predicate.MenuItem {
+	return predicate.MenuItem(sql.FieldIn(FieldDisplayOrder, vs...))
+}
+
+// DisplayOrderNotIn applies the NotIn predicate on the "display_order" field.
+func DisplayOrderNotIn(vs ...int) predicate.MenuItem {
+	return predicate.MenuItem(sql.FieldNotIn(FieldDisplayOrder, vs...))
+}
+
+// DisplayOrderGT applies the GT predicate on the "display_order" field.
+func DisplayOrderGT(v int) predicate.MenuItem {
+	return predicate.MenuItem(sql.FieldGT(FieldDisplayOrder, v))
+}
+
+// DisplayOrderGTE applies the GTE predicate on the "display_order" field.
+func DisplayOrderGTE(v int) predicate.MenuItem {
+	return predicate.MenuItem(sql.FieldGTE(FieldDisplayOrder, v))
+}
+
+// DisplayOrderLT applies the LT predicate on the "display_order" field.
+func DisplayOrderLT(v int) predicate.MenuItem {
+	return predicate.MenuItem(sql.FieldLT(FieldDisplayOrder, v))
+}
+
+// DisplayOrderLTE applies the LTE predicate on the "display_order" field.
+func DisplayOrderLTE(v int) predicate.MenuItem {
+	return predicate.MenuItem(sql.FieldLTE(FieldDisplayOrder, v))
+}
+
+// OutOfStockEQ applies the EQ predicate on the "out_of_stock" field.
+func OutOfStockEQ(v bool) predicate.MenuItem {
+	return predicate.MenuItem(sql.FieldEQ(FieldOutOfStock, v))
+}
+
+// OutOfStockNEQ applies the NEQ predicate on the "out_of_stock" field.
+func OutOfStockNEQ(v bool) predicate.MenuItem {
+	return predicate.MenuItem(sql.FieldNEQ(FieldOutOfStock, v))
+}
+
 // RestaurantIDEQ applies the EQ predicate on the "restaurant_id" field.
 func RestaurantIDEQ(v uuid.UUID) predicate.MenuItem {
 	return predicate.MenuItem(sql.FieldEQ(FieldRestaurantID, v))
@@ -441,6 +596,36 @@ func CategoryIDNotNil() predicate.MenuItem {
 	return predicate.MenuItem(sql.FieldNotNull(FieldCategoryID))
 }
 
+// StationIDEQ applies the EQ predicate on the "station_id" field.
+func StationIDEQ(v uuid.UUID) predicate.MenuItem {
+	return predicate.MenuItem(sql.FieldEQ(FieldStationID, v))
+}
+
+// StationIDNEQ applies the NEQ predicate on the "station_id" field.
+func StationIDNEQ(v uuid.UUID) predicate.MenuItem {
+	return predicate.MenuItem(sql.FieldNEQ(FieldStationID, v))
+}
+
+// StationIDIn applies the In predicate on the "station_id" field.
+func StationIDIn(vs ...uuid.UUID) predicate.MenuItem {
+	return predicate.MenuItem(sql.FieldIn(FieldStationID, vs...))
+}
+
+// StationIDNotIn applies the NotIn predicate on the "station_id" field.
+func StationIDNotIn(vs ...uuid.UUID) predicate.MenuItem {
+	return predicate.MenuItem(sql.FieldNotIn(FieldStationID, vs...))
+}
+
+// StationIDIsNil applies the IsNil predicate on the "station_id" field.
+func StationIDIsNil() predicate.MenuItem {
+	return predicate.MenuItem(sql.FieldIsNull(FieldStationID))
+}
+
+// StationIDNotNil applies the NotNil predicate on the "station_id" field.
+func StationIDNotNil() predicate.MenuItem {
+	return predicate.MenuItem(sql.FieldNotNull(FieldStationID))
+}
+
 // HasRestaurant applies the HasEdge predicate on the "restaurant" edge.
 func HasRestaurant() predicate.MenuItem {
 	return predicate.MenuItem(func(s *sql.Selector) {
@@ -487,12 +672,35 @@ func HasCategoryWith(preds ...predicate.Category) predicate.MenuItem {
 	})
 }
 
+// HasStation applies the HasEdge predicate on the "station" edge.
+func HasStation() predicate.MenuItem {
+	return predicate.MenuItem(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, StationTable, StationColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasStationWith applies the HasEdge predicate on the "station" edge with a given conditions (other predicates).
+func HasStationWith(preds ...predicate.Station) predicate.MenuItem {
+	return predicate.MenuItem(func(s *sql.Selector) {
+		step := newStationStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // HasModifiers applies the HasEdge predicate on the "modifiers" edge.
 func HasModifiers() predicate.MenuItem {
 	return predicate.MenuItem(func(s *sql.Selector) {
 		step := sqlgraph.NewStep(
 			sqlgraph.From(Table, FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, ModifiersTable, ModifiersColumn),
+			sqlgraph.Edge(sqlgraph.M2M, false, ModifiersTable, ModifiersPrimaryKey...),
 		)
 		sqlgraph.HasNeighbors(s, step)
 	})
@@ -533,6 +741,98 @@ func HasOrderItemsWith(preds ...predicate.OrderItem) predicate.MenuItem {
 	})
 }
 
+// HasRecipeLines applies the HasEdge predicate on the "recipe_lines" edge.
+func HasRecipeLines() predicate.MenuItem {
+	return predicate.MenuItem(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, RecipeLinesTable, RecipeLinesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasRecipeLinesWith applies the HasEdge predicate on the "recipe_lines" edge with a given conditions (other predicates).
+func HasRecipeLinesWith(preds ...predicate.RecipeIngredient) predicate.MenuItem {
+	return predicate.MenuItem(func(s *sql.Selector) {
+		step := newRecipeLinesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasMenuPrices applies the HasEdge predicate on the "menu_prices" edge.
+func HasMenuPrices() predicate.MenuItem {
+	return predicate.MenuItem(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, MenuPricesTable, MenuPricesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasMenuPricesWith applies the HasEdge predicate on the "menu_prices" edge with a given conditions (other predicates).
+func HasMenuPricesWith(preds ...predicate.MenuItemPrice) predicate.MenuItem {
+	return predicate.MenuItem(func(s *sql.Selector) {
+		step := newMenuPricesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasVariants applies the HasEdge predicate on the "variants" edge.
+func HasVariants() predicate.MenuItem {
+	return predicate.MenuItem(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, VariantsTable, VariantsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasVariantsWith applies the HasEdge predicate on the "variants" edge with a given conditions (other predicates).
+func HasVariantsWith(preds ...predicate.MenuItemVariant) predicate.MenuItem {
+	return predicate.MenuItem(func(s *sql.Selector) {
+		step := newVariantsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasItemModifiers applies the HasEdge predicate on the "item_modifiers" edge.
+func HasItemModifiers() predicate.MenuItem {
+	return predicate.MenuItem(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, ItemModifiersTable, ItemModifiersColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasItemModifiersWith applies the HasEdge predicate on the "item_modifiers" edge with a given conditions (other predicates).
+func HasItemModifiersWith(preds ...predicate.MenuItemModifier) predicate.MenuItem {
+	return predicate.MenuItem(func(s *sql.Selector) {
+		step := newItemModifiersStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.MenuItem) predicate.MenuItem {
 	return predicate.MenuItem(sql.AndPredicates(predicates...))
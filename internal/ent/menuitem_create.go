@@ -12,9 +12,14 @@ import (
 	"entgo.io/ent/schema/field"
 	"github.com/Jiruu246/rms/internal/ent/category"
 	"github.com/Jiruu246/rms/internal/ent/menuitem"
+	"github.com/Jiruu246/rms/internal/ent/menuitemmodifier"
+	"github.com/Jiruu246/rms/internal/ent/menuitemprice"
+	"github.com/Jiruu246/rms/internal/ent/menuitemvariant"
 	"github.com/Jiruu246/rms/internal/ent/modifier"
 	"github.com/Jiruu246/rms/internal/ent/orderitem"
+	"github.com/Jiruu246/rms/internal/ent/recipeingredient"
 	"github.com/Jiruu246/rms/internal/ent/restaurant"
+	"github.com/Jiruu246/rms/internal/ent/station"
 	"github.com/google/uuid"
 )
 
@@ -60,7 +65,7 @@ func (_c *MenuItemCreate) SetNillableDescription(v *string) *MenuItemCreate {
 }
 
 // SetPrice sets the "price" field.
-func (_c *MenuItemCreate) SetPrice(v float64) *MenuItemCreate {
+func (_c *MenuItemCreate) SetPrice(v int64) *MenuItemCreate {
 	_c.mutation.SetPrice(v)
 	return _c
 }
@@ -79,6 +84,26 @@ func (_c *MenuItemCreate) SetNillableImageURL(v *string) *MenuItemCreate {
 	return _c
 }
 
+// SetThumbnailURL sets the "thumbnail_url" field.
+func (_c *MenuItemCreate) SetThumbnailURL(v string) *MenuItemCreate {
+	_c.mutation.SetThumbnailURL(v)
+	return _c
+}
+
+// SetNillableThumbnailURL sets the "thumbnail_url" field if the given value is not nil.
+func (_c *MenuItemCreate) SetNillableThumbnailURL(v *string) *MenuItemCreate {
+	if v != nil {
+		_c.SetThumbnailURL(*v)
+	}
+	return _c
+}
+
+// SetImageKeys sets the "image_keys" field.
+func (_c *MenuItemCreate) SetImageKeys(v []string) *MenuItemCreate {
+	_c.mutation.SetImageKeys(v)
+	return _c
+}
+
 // SetIsAvailable sets the "is_available" field.
 func (_c *MenuItemCreate) SetIsAvailable(v bool) *MenuItemCreate {
 	_c.mutation.SetIsAvailable(v)
@@ -93,6 +118,34 @@ func (_c *MenuItemCreate) SetNillableIsAvailable(v *bool) *MenuItemCreate {
 	return _c
 }
 
+// SetDisplayOrder sets the "display_order" field.
+func (_c *MenuItemCreate) SetDisplayOrder(v int) *MenuItemCreate {
+	_c.mutation.SetDisplayOrder(v)
+	return _c
+}
+
+// SetNillableDisplayOrder sets the "display_order" field if the given value is not nil.
+func (_c *MenuItemCreate) SetNillableDisplayOrder(v *int) *MenuItemCreate {
+	if v != nil {
+		_c.SetDisplayOrder(*v)
+	}
+	return _c
+}
+
+// SetOutOfStock sets the "out_of_stock" field.
+func (_c *MenuItemCreate) SetOutOfStock(v bool) *MenuItemCreate {
+	_c.mutation.SetOutOfStock(v)
+	return _c
+}
+
+// SetNillableOutOfStock sets the "out_of_stock" field if the given value is not nil.
+func (_c *MenuItemCreate) SetNillableOutOfStock(v *bool) *MenuItemCreate {
+	if v != nil {
+		_c.SetOutOfStock(*v)
+	}
+	return _c
+}
+
 // SetRestaurantID sets the "restaurant_id" field.
 func (_c *MenuItemCreate) SetRestaurantID(v uuid.UUID) *MenuItemCreate {
 	_c.mutation.SetRestaurantID(v)
@@ -113,6 +166,20 @@ func (_c *MenuItemCreate) SetNillableCategoryID(v *uuid.UUID) *MenuItemCreate {
 	return _c
 }
 
+// SetStationID sets the "station_id" field.
+func (_c *MenuItemCreate) SetStationID(v uuid.UUID) *MenuItemCreate {
+	_c.mutation.SetStationID(v)
+	return _c
+}
+
+// SetNillableStationID sets the "station_id" field if the given value is not nil.
+func (_c *MenuItemCreate) SetNillableStationID(v *uuid.UUID) *MenuItemCreate {
+	if v != nil {
+		_c.SetStationID(*v)
+	}
+	return _c
+}
+
 // SetID sets the "id" field.
 func (_c *MenuItemCreate) SetID(v int64) *MenuItemCreate {
 	_c.mutation.SetID(v)
@@ -129,6 +196,11 @@ func (_c *MenuItemCreate) SetCategory(v *Category) *MenuItemCreate {
 	return _c.SetCategoryID(v.ID)
 }
 
+// SetStation sets the "station" edge to the Station entity.
+func (_c *MenuItemCreate) SetStation(v *Station) *MenuItemCreate {
+	return _c.SetStationID(v.ID)
+}
+
 // AddModifierIDs adds the "modifiers" edge to the Modifier entity by IDs.
 func (_c *MenuItemCreate) AddModifierIDs(ids ...uuid.UUID) *MenuItemCreate {
 	_c.mutation.AddModifierIDs(ids...)
@@ -159,6 +231,66 @@ func (_c *MenuItemCreate) AddOrderItems(v ...*OrderItem) *MenuItemCreate {
 	return _c.AddOrderItemIDs(ids...)
 }
 
+// AddRecipeLineIDs adds the "recipe_lines" edge to the RecipeIngredient entity by IDs.
+func (_c *MenuItemCreate) AddRecipeLineIDs(ids ...uuid.UUID) *MenuItemCreate {
+	_c.mutation.AddRecipeLineIDs(ids...)
+	return _c
+}
+
+// AddRecipeLines adds the "recipe_lines" edges to the RecipeIngredient entity.
+func (_c *MenuItemCreate) AddRecipeLines(v ...*RecipeIngredient) *MenuItemCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddRecipeLineIDs(ids...)
+}
+
+// AddMenuPriceIDs adds the "menu_prices" edge to the MenuItemPrice entity by IDs.
+func (_c *MenuItemCreate) AddMenuPriceIDs(ids ...uuid.UUID) *MenuItemCreate {
+	_c.mutation.AddMenuPriceIDs(ids...)
+	return _c
+}
+
+// AddMenuPrices adds the "menu_prices" edges to the MenuItemPrice entity.
+func (_c *MenuItemCreate) AddMenuPrices(v ...*MenuItemPrice) *MenuItemCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddMenuPriceIDs(ids...)
+}
+
+// AddVariantIDs adds the "variants" edge to the MenuItemVariant entity by IDs.
+func (_c *MenuItemCreate) AddVariantIDs(ids ...uuid.UUID) *MenuItemCreate {
+	_c.mutation.AddVariantIDs(ids...)
+	return _c
+}
+
+// AddVariants adds the "variants" edges to the MenuItemVariant entity.
+func (_c *MenuItemCreate) AddVariants(v ...*MenuItemVariant) *MenuItemCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddVariantIDs(ids...)
+}
+
+// AddItemModifierIDs adds the "item_modifiers" edge to the MenuItemModifier entity by IDs.
+func (_c *MenuItemCreate) AddItemModifierIDs(ids ...uuid.UUID) *MenuItemCreate {
+	_c.mutation.AddItemModifierIDs(ids...)
+	return _c
+}
+
+// AddItemModifiers adds the "item_modifiers" edges to the MenuItemModifier entity.
+func (_c *MenuItemCreate) AddItemModifiers(v ...*MenuItemModifier) *MenuItemCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddItemModifierIDs(ids...)
+}
+
 // Mutation returns the MenuItemMutation object of the builder.
 func (_c *MenuItemCreate) Mutation() *MenuItemMutation {
 	return _c.mutation
@@ -206,6 +338,14 @@ func (_c *MenuItemCreate) defaults() {
 		v := menuitem.DefaultIsAvailable
 		_c.mutation.SetIsAvailable(v)
 	}
+	if _, ok := _c.mutation.DisplayOrder(); !ok {
+		v := menuitem.DefaultDisplayOrder
+		_c.mutation.SetDisplayOrder(v)
+	}
+	if _, ok := _c.mutation.OutOfStock(); !ok {
+		v := menuitem.DefaultOutOfStock
+		_c.mutation.SetOutOfStock(v)
+	}
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -240,6 +380,17 @@ func (_c *MenuItemCreate) check() error {
 	if _, ok := _c.mutation.IsAvailable(); !ok {
 		return &ValidationError{Name: "is_available", err: errors.New(`ent: missing required field "MenuItem.is_available"`)}
 	}
+	if _, ok := _c.mutation.DisplayOrder(); !ok {
+		return &ValidationError{Name: "display_order", err: errors.New(`ent: missing required field "MenuItem.display_order"`)}
+	}
+	if v, ok := _c.mutation.DisplayOrder(); ok {
+		if err := menuitem.DisplayOrderValidator(v); err != nil {
+			return &ValidationError{Name: "display_order", err: fmt.Errorf(`ent: validator failed for field "MenuItem.display_order": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.OutOfStock(); !ok {
+		return &ValidationError{Name: "out_of_stock", err: errors.New(`ent: missing required field "MenuItem.out_of_stock"`)}
+	}
 	if _, ok := _c.mutation.RestaurantID(); !ok {
 		return &ValidationError{Name: "restaurant_id", err: errors.New(`ent: missing required field "MenuItem.restaurant_id"`)}
 	}
@@ -296,17 +447,33 @@ func (_c *MenuItemCreate) createSpec() (*MenuItem, *sqlgraph.CreateSpec) {
 		_node.Description = value
 	}
 	if value, ok := _c.mutation.Price(); ok {
-		_spec.SetField(menuitem.FieldPrice, field.TypeFloat64, value)
+		_spec.SetField(menuitem.FieldPrice, field.TypeInt64, value)
 		_node.Price = value
 	}
 	if value, ok := _c.mutation.ImageURL(); ok {
 		_spec.SetField(menuitem.FieldImageURL, field.TypeString, value)
 		_node.ImageURL = value
 	}
+	if value, ok := _c.mutation.ThumbnailURL(); ok {
+		_spec.SetField(menuitem.FieldThumbnailURL, field.TypeString, value)
+		_node.ThumbnailURL = value
+	}
+	if value, ok := _c.mutation.ImageKeys(); ok {
+		_spec.SetField(menuitem.FieldImageKeys, field.TypeJSON, value)
+		_node.ImageKeys = value
+	}
 	if value, ok := _c.mutation.IsAvailable(); ok {
 		_spec.SetField(menuitem.FieldIsAvailable, field.TypeBool, value)
 		_node.IsAvailable = value
 	}
+	if value, ok := _c.mutation.DisplayOrder(); ok {
+		_spec.SetField(menuitem.FieldDisplayOrder, field.TypeInt, value)
+		_node.DisplayOrder = value
+	}
+	if value, ok := _c.mutation.OutOfStock(); ok {
+		_spec.SetField(menuitem.FieldOutOfStock, field.TypeBool, value)
+		_node.OutOfStock = value
+	}
 	if nodes := _c.mutation.RestaurantIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -341,12 +508,29 @@ func (_c *MenuItemCreate) createSpec() (*MenuItem, *sqlgraph.CreateSpec) {
 		_node.CategoryID = nodes[0]
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.StationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   menuitem.StationTable,
+			Columns: []string{menuitem.StationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(station.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.StationID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	if nodes := _c.mutation.ModifiersIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
+			Rel:     sqlgraph.M2M,
 			Inverse: false,
 			Table:   menuitem.ModifiersTable,
-			Columns: []string{menuitem.ModifiersColumn},
+			Columns: menuitem.ModifiersPrimaryKey,
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
 				IDSpec: sqlgraph.NewFieldSpec(modifier.FieldID, field.TypeUUID),
@@ -355,6 +539,13 @@ func (_c *MenuItemCreate) createSpec() (*MenuItem, *sqlgraph.CreateSpec) {
 		for _, k := range nodes {
 			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
+		createE := &MenuItemModifierCreate{config: _c.config, mutation: newMenuItemModifierMutation(_c.config, OpCreate)}
+		createE.defaults()
+		_, specE := createE.createSpec()
+		edge.Target.Fields = specE.Fields
+		if specE.ID.Value != nil {
+			edge.Target.Fields = append(edge.Target.Fields, specE.ID)
+		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
 	if nodes := _c.mutation.OrderItemsIDs(); len(nodes) > 0 {
@@ -373,6 +564,70 @@ func (_c *MenuItemCreate) createSpec() (*MenuItem, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.RecipeLinesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   menuitem.RecipeLinesTable,
+			Columns: []string{menuitem.RecipeLinesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(recipeingredient.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.MenuPricesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   menuitem.MenuPricesTable,
+			Columns: []string{menuitem.MenuPricesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(menuitemprice.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.VariantsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   menuitem.VariantsTable,
+			Columns: []string{menuitem.VariantsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(menuitemvariant.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.ItemModifiersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   menuitem.ItemModifiersTable,
+			Columns: []string{menuitem.ItemModifiersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(menuitemmodifier.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -14,25 +14,34 @@ import (
 	"entgo.io/ent/schema/field"
 	"github.com/Jiruu246/rms/internal/ent/category"
 	"github.com/Jiruu246/rms/internal/ent/menuitem"
+	"github.com/Jiruu246/rms/internal/ent/menuitemmodifier"
+	"github.com/Jiruu246/rms/internal/ent/menuitemprice"
+	"github.com/Jiruu246/rms/internal/ent/menuitemvariant"
 	"github.com/Jiruu246/rms/internal/ent/modifier"
 	"github.com/Jiruu246/rms/internal/ent/orderitem"
 	"github.com/Jiruu246/rms/internal/ent/predicate"
+	"github.com/Jiruu246/rms/internal/ent/recipeingredient"
 	"github.com/Jiruu246/rms/internal/ent/restaurant"
+	"github.com/Jiruu246/rms/internal/ent/station"
 	"github.com/google/uuid"
 )
 
 // MenuItemQuery is the builder for querying MenuItem entities.
 type MenuItemQuery struct {
 	config
-	ctx            *QueryContext
-	order          []menuitem.OrderOption
-	inters         []Interceptor
-	predicates     []predicate.MenuItem
-	withRestaurant *RestaurantQuery
-	withCategory   *CategoryQuery
-	withModifiers  *ModifierQuery
-	withOrderItems *OrderItemQuery
-	withFKs        bool
+	ctx               *QueryContext
+	order             []menuitem.OrderOption
+	inters            []Interceptor
+	predicates        []predicate.MenuItem
+	withRestaurant    *RestaurantQuery
+	withCategory      *CategoryQuery
+	withStation       *StationQuery
+	withModifiers     *ModifierQuery
+	withOrderItems    *OrderItemQuery
+	withRecipeLines   *RecipeIngredientQuery
+	withMenuPrices    *MenuItemPriceQuery
+	withVariants      *MenuItemVariantQuery
+	withItemModifiers *MenuItemModifierQuery
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -113,6 +122,28 @@ func (_q *MenuItemQuery) QueryCategory() *CategoryQuery {
 	return query
 }
 
+// QueryStation chains the current query on the "station" edge.
+func (_q *MenuItemQuery) QueryStation() *StationQuery {
+	query := (&StationClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(menuitem.Table, menuitem.FieldID, selector),
+			sqlgraph.To(station.Table, station.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, menuitem.StationTable, menuitem.StationColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // QueryModifiers chains the current query on the "modifiers" edge.
 func (_q *MenuItemQuery) QueryModifiers() *ModifierQuery {
 	query := (&ModifierClient{config: _q.config}).Query()
@@ -127,7 +158,7 @@ func (_q *MenuItemQuery) QueryModifiers() *ModifierQuery {
 		step := sqlgraph.NewStep(
 			sqlgraph.From(menuitem.Table, menuitem.FieldID, selector),
 			sqlgraph.To(modifier.Table, modifier.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, menuitem.ModifiersTable, menuitem.ModifiersColumn),
+			sqlgraph.Edge(sqlgraph.M2M, false, menuitem.ModifiersTable, menuitem.ModifiersPrimaryKey...),
 		)
 		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
 		return fromU, nil
@@ -157,6 +188,94 @@ func (_q *MenuItemQuery) QueryOrderItems() *OrderItemQuery {
 	return query
 }
 
+// QueryRecipeLines chains the current query on the "recipe_lines" edge.
+func (_q *MenuItemQuery) QueryRecipeLines() *RecipeIngredientQuery {
+	query := (&RecipeIngredientClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(menuitem.Table, menuitem.FieldID, selector),
+			sqlgraph.To(recipeingredient.Table, recipeingredient.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, menuitem.RecipeLinesTable, menuitem.RecipeLinesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryMenuPrices chains the current query on the "menu_prices" edge.
+func (_q *MenuItemQuery) QueryMenuPrices() *MenuItemPriceQuery {
+	query := (&MenuItemPriceClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(menuitem.Table, menuitem.FieldID, selector),
+			sqlgraph.To(menuitemprice.Table, menuitemprice.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, menuitem.MenuPricesTable, menuitem.MenuPricesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryVariants chains the current query on the "variants" edge.
+func (_q *MenuItemQuery) QueryVariants() *MenuItemVariantQuery {
+	query := (&MenuItemVariantClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(menuitem.Table, menuitem.FieldID, selector),
+			sqlgraph.To(menuitemvariant.Table, menuitemvariant.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, menuitem.VariantsTable, menuitem.VariantsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryItemModifiers chains the current query on the "item_modifiers" edge.
+func (_q *MenuItemQuery) QueryItemModifiers() *MenuItemModifierQuery {
+	query := (&MenuItemModifierClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(menuitem.Table, menuitem.FieldID, selector),
+			sqlgraph.To(menuitemmodifier.Table, menuitemmodifier.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, menuitem.ItemModifiersTable, menuitem.ItemModifiersColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first MenuItem entity from the query.
 // Returns a *NotFoundError when no MenuItem was found.
 func (_q *MenuItemQuery) First(ctx context.Context) (*MenuItem, error) {
@@ -344,15 +463,20 @@ func (_q *MenuItemQuery) Clone() *MenuItemQuery {
 		return nil
 	}
 	return &MenuItemQuery{
-		config:         _q.config,
-		ctx:            _q.ctx.Clone(),
-		order:          append([]menuitem.OrderOption{}, _q.order...),
-		inters:         append([]Interceptor{}, _q.inters...),
-		predicates:     append([]predicate.MenuItem{}, _q.predicates...),
-		withRestaurant: _q.withRestaurant.Clone(),
-		withCategory:   _q.withCategory.Clone(),
-		withModifiers:  _q.withModifiers.Clone(),
-		withOrderItems: _q.withOrderItems.Clone(),
+		config:            _q.config,
+		ctx:               _q.ctx.Clone(),
+		order:             append([]menuitem.OrderOption{}, _q.order...),
+		inters:            append([]Interceptor{}, _q.inters...),
+		predicates:        append([]predicate.MenuItem{}, _q.predicates...),
+		withRestaurant:    _q.withRestaurant.Clone(),
+		withCategory:      _q.withCategory.Clone(),
+		withStation:       _q.withStation.Clone(),
+		withModifiers:     _q.withModifiers.Clone(),
+		withOrderItems:    _q.withOrderItems.Clone(),
+		withRecipeLines:   _q.withRecipeLines.Clone(),
+		withMenuPrices:    _q.withMenuPrices.Clone(),
+		withVariants:      _q.withVariants.Clone(),
+		withItemModifiers: _q.withItemModifiers.Clone(),
 		// clone intermediate query.
 		sql:  _q.sql.Clone(),
 		path: _q.path,
@@ -381,6 +505,17 @@ func (_q *MenuItemQuery) WithCategory(opts ...func(*CategoryQuery)) *MenuItemQue
 	return _q
 }
 
+// WithStation tells the query-builder to eager-load the nodes that are connected to
+// the "station" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *MenuItemQuery) WithStation(opts ...func(*StationQuery)) *MenuItemQuery {
+	query := (&StationClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withStation = query
+	return _q
+}
+
 // WithModifiers tells the query-builder to eager-load the nodes that are connected to
 // the "modifiers" edge. The optional arguments are used to configure the query builder of the edge.
 func (_q *MenuItemQuery) WithModifiers(opts ...func(*ModifierQuery)) *MenuItemQuery {
@@ -403,6 +538,50 @@ func (_q *MenuItemQuery) WithOrderItems(opts ...func(*OrderItemQuery)) *MenuItem
 	return _q
 }
 
+// WithRecipeLines tells the query-builder to eager-load the nodes that are connected to
+// the "recipe_lines" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *MenuItemQuery) WithRecipeLines(opts ...func(*RecipeIngredientQuery)) *MenuItemQuery {
+	query := (&RecipeIngredientClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withRecipeLines = query
+	return _q
+}
+
+// WithMenuPrices tells the query-builder to eager-load the nodes that are connected to
+// the "menu_prices" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *MenuItemQuery) WithMenuPrices(opts ...func(*MenuItemPriceQuery)) *MenuItemQuery {
+	query := (&MenuItemPriceClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withMenuPrices = query
+	return _q
+}
+
+// WithVariants tells the query-builder to eager-load the nodes that are connected to
+// the "variants" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *MenuItemQuery) WithVariants(opts ...func(*MenuItemVariantQuery)) *MenuItemQuery {
+	query := (&MenuItemVariantClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withVariants = query
+	return _q
+}
+
+// WithItemModifiers tells the query-builder to eager-load the nodes that are connected to
+// the "item_modifiers" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *MenuItemQuery) WithItemModifiers(opts ...func(*MenuItemModifierQuery)) *MenuItemQuery {
+	query := (&MenuItemModifierClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withItemModifiers = query
+	return _q
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -480,18 +659,19 @@ func (_q *MenuItemQuery) prepareQuery(ctx context.Context) error {
 func (_q *MenuItemQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*MenuItem, error) {
 	var (
 		nodes       = []*MenuItem{}
-		withFKs     = _q.withFKs
 		_spec       = _q.querySpec()
-		loadedTypes = [4]bool{
+		loadedTypes = [9]bool{
 			_q.withRestaurant != nil,
 			_q.withCategory != nil,
+			_q.withStation != nil,
 			_q.withModifiers != nil,
 			_q.withOrderItems != nil,
+			_q.withRecipeLines != nil,
+			_q.withMenuPrices != nil,
+			_q.withVariants != nil,
+			_q.withItemModifiers != nil,
 		}
 	)
-	if withFKs {
-		_spec.Node.Columns = append(_spec.Node.Columns, menuitem.ForeignKeys...)
-	}
 	_spec.ScanValues = func(columns []string) ([]any, error) {
 		return (*MenuItem).scanValues(nil, columns)
 	}
@@ -522,6 +702,12 @@ func (_q *MenuItemQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Men
 			return nil, err
 		}
 	}
+	if query := _q.withStation; query != nil {
+		if err := _q.loadStation(ctx, query, nodes, nil,
+			func(n *MenuItem, e *Station) { n.Edges.Station = e }); err != nil {
+			return nil, err
+		}
+	}
 	if query := _q.withModifiers; query != nil {
 		if err := _q.loadModifiers(ctx, query, nodes,
 			func(n *MenuItem) { n.Edges.Modifiers = []*Modifier{} },
@@ -536,6 +722,34 @@ func (_q *MenuItemQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Men
 			return nil, err
 		}
 	}
+	if query := _q.withRecipeLines; query != nil {
+		if err := _q.loadRecipeLines(ctx, query, nodes,
+			func(n *MenuItem) { n.Edges.RecipeLines = []*RecipeIngredient{} },
+			func(n *MenuItem, e *RecipeIngredient) { n.Edges.RecipeLines = append(n.Edges.RecipeLines, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withMenuPrices; query != nil {
+		if err := _q.loadMenuPrices(ctx, query, nodes,
+			func(n *MenuItem) { n.Edges.MenuPrices = []*MenuItemPrice{} },
+			func(n *MenuItem, e *MenuItemPrice) { n.Edges.MenuPrices = append(n.Edges.MenuPrices, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withVariants; query != nil {
+		if err := _q.loadVariants(ctx, query, nodes,
+			func(n *MenuItem) { n.Edges.Variants = []*MenuItemVariant{} },
+			func(n *MenuItem, e *MenuItemVariant) { n.Edges.Variants = append(n.Edges.Variants, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withItemModifiers; query != nil {
+		if err := _q.loadItemModifiers(ctx, query, nodes,
+			func(n *MenuItem) { n.Edges.ItemModifiers = []*MenuItemModifier{} },
+			func(n *MenuItem, e *MenuItemModifier) { n.Edges.ItemModifiers = append(n.Edges.ItemModifiers, e) }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -597,7 +811,130 @@ func (_q *MenuItemQuery) loadCategory(ctx context.Context, query *CategoryQuery,
 	}
 	return nil
 }
+func (_q *MenuItemQuery) loadStation(ctx context.Context, query *StationQuery, nodes []*MenuItem, init func(*MenuItem), assign func(*MenuItem, *Station)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*MenuItem)
+	for i := range nodes {
+		if nodes[i].StationID == nil {
+			continue
+		}
+		fk := *nodes[i].StationID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(station.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "station_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
 func (_q *MenuItemQuery) loadModifiers(ctx context.Context, query *ModifierQuery, nodes []*MenuItem, init func(*MenuItem), assign func(*MenuItem, *Modifier)) error {
+	edgeIDs := make([]driver.Value, len(nodes))
+	byID := make(map[int64]*MenuItem)
+	nids := make(map[uuid.UUID]map[*MenuItem]struct{})
+	for i, node := range nodes {
+		edgeIDs[i] = node.ID
+		byID[node.ID] = node
+		if init != nil {
+			init(node)
+		}
+	}
+	query.Where(func(s *sql.Selector) {
+		joinT := sql.Table(menuitem.ModifiersTable)
+		s.Join(joinT).On(s.C(modifier.FieldID), joinT.C(menuitem.ModifiersPrimaryKey[1]))
+		s.Where(sql.InValues(joinT.C(menuitem.ModifiersPrimaryKey[0]), edgeIDs...))
+		columns := s.SelectedColumns()
+		s.Select(joinT.C(menuitem.ModifiersPrimaryKey[0]))
+		s.AppendSelect(columns...)
+		s.SetDistinct(false)
+	})
+	if err := query.prepareQuery(ctx); err != nil {
+		return err
+	}
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		return query.sqlAll(ctx, func(_ context.Context, spec *sqlgraph.QuerySpec) {
+			assign := spec.Assign
+			values := spec.ScanValues
+			spec.ScanValues = func(columns []string) ([]any, error) {
+				values, err := values(columns[1:])
+				if err != nil {
+					return nil, err
+				}
+				return append([]any{new(sql.NullInt64)}, values...), nil
+			}
+			spec.Assign = func(columns []string, values []any) error {
+				outValue := values[0].(*sql.NullInt64).Int64
+				inValue := *values[1].(*uuid.UUID)
+				if nids[inValue] == nil {
+					nids[inValue] = map[*MenuItem]struct{}{byID[outValue]: {}}
+					return assign(columns[1:], values[1:])
+				}
+				nids[inValue][byID[outValue]] = struct{}{}
+				return nil
+			}
+		})
+	})
+	neighbors, err := withInterceptors[[]*Modifier](ctx, query, qr, query.inters)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected "modifiers" node returned %v`, n.ID)
+		}
+		for kn := range nodes {
+			assign(kn, n)
+		}
+	}
+	return nil
+}
+func (_q *MenuItemQuery) loadOrderItems(ctx context.Context, query *OrderItemQuery, nodes []*MenuItem, init func(*MenuItem), assign func(*MenuItem, *OrderItem)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int64]*MenuItem)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(orderitem.FieldMenuItemID)
+	}
+	query.Where(predicate.OrderItem(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(menuitem.OrderItemsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.MenuItemID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "menu_item_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *MenuItemQuery) loadRecipeLines(ctx context.Context, query *RecipeIngredientQuery, nodes []*MenuItem, init func(*MenuItem), assign func(*MenuItem, *RecipeIngredient)) error {
 	fks := make([]driver.Value, 0, len(nodes))
 	nodeids := make(map[int64]*MenuItem)
 	for i := range nodes {
@@ -607,28 +944,30 @@ func (_q *MenuItemQuery) loadModifiers(ctx context.Context, query *ModifierQuery
 			init(nodes[i])
 		}
 	}
-	query.withFKs = true
-	query.Where(predicate.Modifier(func(s *sql.Selector) {
-		s.Where(sql.InValues(s.C(menuitem.ModifiersColumn), fks...))
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(recipeingredient.FieldMenuItemID)
+	}
+	query.Where(predicate.RecipeIngredient(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(menuitem.RecipeLinesColumn), fks...))
 	}))
 	neighbors, err := query.All(ctx)
 	if err != nil {
 		return err
 	}
 	for _, n := range neighbors {
-		fk := n.menu_item_modifiers
+		fk := n.MenuItemID
 		if fk == nil {
-			return fmt.Errorf(`foreign-key "menu_item_modifiers" is nil for node %v`, n.ID)
+			return fmt.Errorf(`foreign-key "menu_item_id" is nil for node %v`, n.ID)
 		}
 		node, ok := nodeids[*fk]
 		if !ok {
-			return fmt.Errorf(`unexpected referenced foreign-key "menu_item_modifiers" returned %v for node %v`, *fk, n.ID)
+			return fmt.Errorf(`unexpected referenced foreign-key "menu_item_id" returned %v for node %v`, *fk, n.ID)
 		}
 		assign(node, n)
 	}
 	return nil
 }
-func (_q *MenuItemQuery) loadOrderItems(ctx context.Context, query *OrderItemQuery, nodes []*MenuItem, init func(*MenuItem), assign func(*MenuItem, *OrderItem)) error {
+func (_q *MenuItemQuery) loadMenuPrices(ctx context.Context, query *MenuItemPriceQuery, nodes []*MenuItem, init func(*MenuItem), assign func(*MenuItem, *MenuItemPrice)) error {
 	fks := make([]driver.Value, 0, len(nodes))
 	nodeids := make(map[int64]*MenuItem)
 	for i := range nodes {
@@ -639,10 +978,70 @@ func (_q *MenuItemQuery) loadOrderItems(ctx context.Context, query *OrderItemQue
 		}
 	}
 	if len(query.ctx.Fields) > 0 {
-		query.ctx.AppendFieldOnce(orderitem.FieldMenuItemID)
+		query.ctx.AppendFieldOnce(menuitemprice.FieldMenuItemID)
 	}
-	query.Where(predicate.OrderItem(func(s *sql.Selector) {
-		s.Where(sql.InValues(s.C(menuitem.OrderItemsColumn), fks...))
+	query.Where(predicate.MenuItemPrice(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(menuitem.MenuPricesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.MenuItemID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "menu_item_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *MenuItemQuery) loadVariants(ctx context.Context, query *MenuItemVariantQuery, nodes []*MenuItem, init func(*MenuItem), assign func(*MenuItem, *MenuItemVariant)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int64]*MenuItem)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(menuitemvariant.FieldMenuItemID)
+	}
+	query.Where(predicate.MenuItemVariant(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(menuitem.VariantsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.MenuItemID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "menu_item_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *MenuItemQuery) loadItemModifiers(ctx context.Context, query *MenuItemModifierQuery, nodes []*MenuItem, init func(*MenuItem), assign func(*MenuItem, *MenuItemModifier)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int64]*MenuItem)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(menuitemmodifier.FieldMenuItemID)
+	}
+	query.Where(predicate.MenuItemModifier(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(menuitem.ItemModifiersColumn), fks...))
 	}))
 	neighbors, err := query.All(ctx)
 	if err != nil {
@@ -690,6 +1089,9 @@ func (_q *MenuItemQuery) querySpec() *sqlgraph.QuerySpec {
 		if _q.withCategory != nil {
 			_spec.Node.AddColumnOnce(menuitem.FieldCategoryID)
 		}
+		if _q.withStation != nil {
+			_spec.Node.AddColumnOnce(menuitem.FieldStationID)
+		}
 	}
 	if ps := _q.predicates; len(ps) > 0 {
 		_spec.Predicate = func(selector *sql.Selector) {
@@ -10,13 +10,19 @@ import (
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
 	"entgo.io/ent/schema/field"
 	"github.com/Jiruu246/rms/internal/ent/category"
 	"github.com/Jiruu246/rms/internal/ent/menuitem"
+	"github.com/Jiruu246/rms/internal/ent/menuitemmodifier"
+	"github.com/Jiruu246/rms/internal/ent/menuitemprice"
+	"github.com/Jiruu246/rms/internal/ent/menuitemvariant"
 	"github.com/Jiruu246/rms/internal/ent/modifier"
 	"github.com/Jiruu246/rms/internal/ent/orderitem"
 	"github.com/Jiruu246/rms/internal/ent/predicate"
+	"github.com/Jiruu246/rms/internal/ent/recipeingredient"
 	"github.com/Jiruu246/rms/internal/ent/restaurant"
+	"github.com/Jiruu246/rms/internal/ent/station"
 	"github.com/google/uuid"
 )
 
@@ -68,14 +74,14 @@ func (_u *MenuItemUpdate) SetNillableDescription(v *string) *MenuItemUpdate {
 }
 
 // SetPrice sets the "price" field.
-func (_u *MenuItemUpdate) SetPrice(v float64) *MenuItemUpdate {
+func (_u *MenuItemUpdate) SetPrice(v int64) *MenuItemUpdate {
 	_u.mutation.ResetPrice()
 	_u.mutation.SetPrice(v)
 	return _u
 }
 
 // SetNillablePrice sets the "price" field if the given value is not nil.
-func (_u *MenuItemUpdate) SetNillablePrice(v *float64) *MenuItemUpdate {
+func (_u *MenuItemUpdate) SetNillablePrice(v *int64) *MenuItemUpdate {
 	if v != nil {
 		_u.SetPrice(*v)
 	}
@@ -83,7 +89,7 @@ func (_u *MenuItemUpdate) SetNillablePrice(v *float64) *MenuItemUpdate {
 }
 
 // AddPrice adds value to the "price" field.
-func (_u *MenuItemUpdate) AddPrice(v float64) *MenuItemUpdate {
+func (_u *MenuItemUpdate) AddPrice(v int64) *MenuItemUpdate {
 	_u.mutation.AddPrice(v)
 	return _u
 }
@@ -108,6 +114,44 @@ func (_u *MenuItemUpdate) ClearImageURL() *MenuItemUpdate {
 	return _u
 }
 
+// SetThumbnailURL sets the "thumbnail_url" field.
+func (_u *MenuItemUpdate) SetThumbnailURL(v string) *MenuItemUpdate {
+	_u.mutation.SetThumbnailURL(v)
+	return _u
+}
+
+// SetNillableThumbnailURL sets the "thumbnail_url" field if the given value is not nil.
+func (_u *MenuItemUpdate) SetNillableThumbnailURL(v *string) *MenuItemUpdate {
+	if v != nil {
+		_u.SetThumbnailURL(*v)
+	}
+	return _u
+}
+
+// ClearThumbnailURL clears the value of the "thumbnail_url" field.
+func (_u *MenuItemUpdate) ClearThumbnailURL() *MenuItemUpdate {
+	_u.mutation.ClearThumbnailURL()
+	return _u
+}
+
+// SetImageKeys sets the "image_keys" field.
+func (_u *MenuItemUpdate) SetImageKeys(v []string) *MenuItemUpdate {
+	_u.mutation.SetImageKeys(v)
+	return _u
+}
+
+// AppendImageKeys appends value to the "image_keys" field.
+func (_u *MenuItemUpdate) AppendImageKeys(v []string) *MenuItemUpdate {
+	_u.mutation.AppendImageKeys(v)
+	return _u
+}
+
+// ClearImageKeys clears the value of the "image_keys" field.
+func (_u *MenuItemUpdate) ClearImageKeys() *MenuItemUpdate {
+	_u.mutation.ClearImageKeys()
+	return _u
+}
+
 // SetIsAvailable sets the "is_available" field.
 func (_u *MenuItemUpdate) SetIsAvailable(v bool) *MenuItemUpdate {
 	_u.mutation.SetIsAvailable(v)
@@ -122,6 +166,41 @@ func (_u *MenuItemUpdate) SetNillableIsAvailable(v *bool) *MenuItemUpdate {
 	return _u
 }
 
+// SetDisplayOrder sets the "display_order" field.
+func (_u *MenuItemUpdate) SetDisplayOrder(v int) *MenuItemUpdate {
+	_u.mutation.ResetDisplayOrder()
+	_u.mutation.SetDisplayOrder(v)
+	return _u
+}
+
+// SetNillableDisplayOrder sets the "display_order" field if the given value is not nil.
+func (_u *MenuItemUpdate) SetNillableDisplayOrder(v *int) *MenuItemUpdate {
+	if v != nil {
+		_u.SetDisplayOrder(*v)
+	}
+	return _u
+}
+
+// AddDisplayOrder adds value to the "display_order" field.
+func (_u *MenuItemUpdate) AddDisplayOrder(v int) *MenuItemUpdate {
+	_u.mutation.AddDisplayOrder(v)
+	return _u
+}
+
+// SetOutOfStock sets the "out_of_stock" field.
+func (_u *MenuItemUpdate) SetOutOfStock(v bool) *MenuItemUpdate {
+	_u.mutation.SetOutOfStock(v)
+	return _u
+}
+
+// SetNillableOutOfStock sets the "out_of_stock" field if the given value is not nil.
+func (_u *MenuItemUpdate) SetNillableOutOfStock(v *bool) *MenuItemUpdate {
+	if v != nil {
+		_u.SetOutOfStock(*v)
+	}
+	return _u
+}
+
 // SetRestaurantID sets the "restaurant_id" field.
 func (_u *MenuItemUpdate) SetRestaurantID(v uuid.UUID) *MenuItemUpdate {
 	_u.mutation.SetRestaurantID(v)
@@ -156,6 +235,26 @@ func (_u *MenuItemUpdate) ClearCategoryID() *MenuItemUpdate {
 	return _u
 }
 
+// SetStationID sets the "station_id" field.
+func (_u *MenuItemUpdate) SetStationID(v uuid.UUID) *MenuItemUpdate {
+	_u.mutation.SetStationID(v)
+	return _u
+}
+
+// SetNillableStationID sets the "station_id" field if the given value is not nil.
+func (_u *MenuItemUpdate) SetNillableStationID(v *uuid.UUID) *MenuItemUpdate {
+	if v != nil {
+		_u.SetStationID(*v)
+	}
+	return _u
+}
+
+// ClearStationID clears the value of the "station_id" field.
+func (_u *MenuItemUpdate) ClearStationID() *MenuItemUpdate {
+	_u.mutation.ClearStationID()
+	return _u
+}
+
 // SetRestaurant sets the "restaurant" edge to the Restaurant entity.
 func (_u *MenuItemUpdate) SetRestaurant(v *Restaurant) *MenuItemUpdate {
 	return _u.SetRestaurantID(v.ID)
@@ -166,6 +265,11 @@ func (_u *MenuItemUpdate) SetCategory(v *Category) *MenuItemUpdate {
 	return _u.SetCategoryID(v.ID)
 }
 
+// SetStation sets the "station" edge to the Station entity.
+func (_u *MenuItemUpdate) SetStation(v *Station) *MenuItemUpdate {
+	return _u.SetStationID(v.ID)
+}
+
 // AddModifierIDs adds the "modifiers" edge to the Modifier entity by IDs.
 func (_u *MenuItemUpdate) AddModifierIDs(ids ...uuid.UUID) *MenuItemUpdate {
 	_u.mutation.AddModifierIDs(ids...)
@@ -196,6 +300,66 @@ func (_u *MenuItemUpdate) AddOrderItems(v ...*OrderItem) *MenuItemUpdate {
 	return _u.AddOrderItemIDs(ids...)
 }
 
+// AddRecipeLineIDs adds the "recipe_lines" edge to the RecipeIngredient entity by IDs.
+func (_u *MenuItemUpdate) AddRecipeLineIDs(ids ...uuid.UUID) *MenuItemUpdate {
+	_u.mutation.AddRecipeLineIDs(ids...)
+	return _u
+}
+
+// AddRecipeLines adds the "recipe_lines" edges to the RecipeIngredient entity.
+func (_u *MenuItemUpdate) AddRecipeLines(v ...*RecipeIngredient) *MenuItemUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddRecipeLineIDs(ids...)
+}
+
+// AddMenuPriceIDs adds the "menu_prices" edge to the MenuItemPrice entity by IDs.
+func (_u *MenuItemUpdate) AddMenuPriceIDs(ids ...uuid.UUID) *MenuItemUpdate {
+	_u.mutation.AddMenuPriceIDs(ids...)
+	return _u
+}
+
+// AddMenuPrices adds the "menu_prices" edges to the MenuItemPrice entity.
+func (_u *MenuItemUpdate) AddMenuPrices(v ...*MenuItemPrice) *MenuItemUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddMenuPriceIDs(ids...)
+}
+
+// AddVariantIDs adds the "variants" edge to the MenuItemVariant entity by IDs.
+func (_u *MenuItemUpdate) AddVariantIDs(ids ...uuid.UUID) *MenuItemUpdate {
+	_u.mutation.AddVariantIDs(ids...)
+	return _u
+}
+
+// AddVariants adds the "variants" edges to the MenuItemVariant entity.
+func (_u *MenuItemUpdate) AddVariants(v ...*MenuItemVariant) *MenuItemUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddVariantIDs(ids...)
+}
+
+// AddItemModifierIDs adds the "item_modifiers" edge to the MenuItemModifier entity by IDs.
+func (_u *MenuItemUpdate) AddItemModifierIDs(ids ...uuid.UUID) *MenuItemUpdate {
+	_u.mutation.AddItemModifierIDs(ids...)
+	return _u
+}
+
+// AddItemModifiers adds the "item_modifiers" edges to the MenuItemModifier entity.
+func (_u *MenuItemUpdate) AddItemModifiers(v ...*MenuItemModifier) *MenuItemUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddItemModifierIDs(ids...)
+}
+
 // Mutation returns the MenuItemMutation object of the builder.
 func (_u *MenuItemUpdate) Mutation() *MenuItemMutation {
 	return _u.mutation
@@ -213,6 +377,12 @@ func (_u *MenuItemUpdate) ClearCategory() *MenuItemUpdate {
 	return _u
 }
 
+// ClearStation clears the "station" edge to the Station entity.
+func (_u *MenuItemUpdate) ClearStation() *MenuItemUpdate {
+	_u.mutation.ClearStation()
+	return _u
+}
+
 // ClearModifiers clears all "modifiers" edges to the Modifier entity.
 func (_u *MenuItemUpdate) ClearModifiers() *MenuItemUpdate {
 	_u.mutation.ClearModifiers()
@@ -255,6 +425,90 @@ func (_u *MenuItemUpdate) RemoveOrderItems(v ...*OrderItem) *MenuItemUpdate {
 	return _u.RemoveOrderItemIDs(ids...)
 }
 
+// ClearRecipeLines clears all "recipe_lines" edges to the RecipeIngredient entity.
+func (_u *MenuItemUpdate) ClearRecipeLines() *MenuItemUpdate {
+	_u.mutation.ClearRecipeLines()
+	return _u
+}
+
+// RemoveRecipeLineIDs removes the "recipe_lines" edge to RecipeIngredient entities by IDs.
+func (_u *MenuItemUpdate) RemoveRecipeLineIDs(ids ...uuid.UUID) *MenuItemUpdate {
+	_u.mutation.RemoveRecipeLineIDs(ids...)
+	return _u
+}
+
+// RemoveRecipeLines removes "recipe_lines" edges to RecipeIngredient entities.
+func (_u *MenuItemUpdate) RemoveRecipeLines(v ...*RecipeIngredient) *MenuItemUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveRecipeLineIDs(ids...)
+}
+
+// ClearMenuPrices clears all "menu_prices" edges to the MenuItemPrice entity.
+func (_u *MenuItemUpdate) ClearMenuPrices() *MenuItemUpdate {
+	_u.mutation.ClearMenuPrices()
+	return _u
+}
+
+// RemoveMenuPriceIDs removes the "menu_prices" edge to MenuItemPrice entities by IDs.
+func (_u *MenuItemUpdate) RemoveMenuPriceIDs(ids ...uuid.UUID) *MenuItemUpdate {
+	_u.mutation.RemoveMenuPriceIDs(ids...)
+	return _u
+}
+
+// RemoveMenuPrices removes "menu_prices" edges to MenuItemPrice entities.
+func (_u *MenuItemUpdate) RemoveMenuPrices(v ...*MenuItemPrice) *MenuItemUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveMenuPriceIDs(ids...)
+}
+
+// ClearVariants clears all "variants" edges to the MenuItemVariant entity.
+func (_u *MenuItemUpdate) ClearVariants() *MenuItemUpdate {
+	_u.mutation.ClearVariants()
+	return _u
+}
+
+// RemoveVariantIDs removes the "variants" edge to MenuItemVariant entities by IDs.
+func (_u *MenuItemUpdate) RemoveVariantIDs(ids ...uuid.UUID) *MenuItemUpdate {
+	_u.mutation.RemoveVariantIDs(ids...)
+	return _u
+}
+
+// RemoveVariants removes "variants" edges to MenuItemVariant entities.
+func (_u *MenuItemUpdate) RemoveVariants(v ...*MenuItemVariant) *MenuItemUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveVariantIDs(ids...)
+}
+
+// ClearItemModifiers clears all "item_modifiers" edges to the MenuItemModifier entity.
+func (_u *MenuItemUpdate) ClearItemModifiers() *MenuItemUpdate {
+	_u.mutation.ClearItemModifiers()
+	return _u
+}
+
+// RemoveItemModifierIDs removes the "item_modifiers" edge to MenuItemModifier entities by IDs.
+func (_u *MenuItemUpdate) RemoveItemModifierIDs(ids ...uuid.UUID) *MenuItemUpdate {
+	_u.mutation.RemoveItemModifierIDs(ids...)
+	return _u
+}
+
+// RemoveItemModifiers removes "item_modifiers" edges to MenuItemModifier entities.
+func (_u *MenuItemUpdate) RemoveItemModifiers(v ...*MenuItemModifier) *MenuItemUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveItemModifierIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (_u *MenuItemUpdate) Save(ctx context.Context) (int, error) {
 	_u.defaults()
@@ -308,6 +562,11 @@ func (_u *MenuItemUpdate) check() error {
 			return &ValidationError{Name: "price", err: fmt.Errorf(`ent: validator failed for field "MenuItem.price": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.DisplayOrder(); ok {
+		if err := menuitem.DisplayOrderValidator(v); err != nil {
+			return &ValidationError{Name: "display_order", err: fmt.Errorf(`ent: validator failed for field "MenuItem.display_order": %w`, err)}
+		}
+	}
 	if _u.mutation.RestaurantCleared() && len(_u.mutation.RestaurantIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "MenuItem.restaurant"`)
 	}
@@ -336,10 +595,10 @@ func (_u *MenuItemUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		_spec.SetField(menuitem.FieldDescription, field.TypeString, value)
 	}
 	if value, ok := _u.mutation.Price(); ok {
-		_spec.SetField(menuitem.FieldPrice, field.TypeFloat64, value)
+		_spec.SetField(menuitem.FieldPrice, field.TypeInt64, value)
 	}
 	if value, ok := _u.mutation.AddedPrice(); ok {
-		_spec.AddField(menuitem.FieldPrice, field.TypeFloat64, value)
+		_spec.AddField(menuitem.FieldPrice, field.TypeInt64, value)
 	}
 	if value, ok := _u.mutation.ImageURL(); ok {
 		_spec.SetField(menuitem.FieldImageURL, field.TypeString, value)
@@ -347,9 +606,35 @@ func (_u *MenuItemUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if _u.mutation.ImageURLCleared() {
 		_spec.ClearField(menuitem.FieldImageURL, field.TypeString)
 	}
+	if value, ok := _u.mutation.ThumbnailURL(); ok {
+		_spec.SetField(menuitem.FieldThumbnailURL, field.TypeString, value)
+	}
+	if _u.mutation.ThumbnailURLCleared() {
+		_spec.ClearField(menuitem.FieldThumbnailURL, field.TypeString)
+	}
+	if value, ok := _u.mutation.ImageKeys(); ok {
+		_spec.SetField(menuitem.FieldImageKeys, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedImageKeys(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, menuitem.FieldImageKeys, value)
+		})
+	}
+	if _u.mutation.ImageKeysCleared() {
+		_spec.ClearField(menuitem.FieldImageKeys, field.TypeJSON)
+	}
 	if value, ok := _u.mutation.IsAvailable(); ok {
 		_spec.SetField(menuitem.FieldIsAvailable, field.TypeBool, value)
 	}
+	if value, ok := _u.mutation.DisplayOrder(); ok {
+		_spec.SetField(menuitem.FieldDisplayOrder, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedDisplayOrder(); ok {
+		_spec.AddField(menuitem.FieldDisplayOrder, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.OutOfStock(); ok {
+		_spec.SetField(menuitem.FieldOutOfStock, field.TypeBool, value)
+	}
 	if _u.mutation.RestaurantCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -408,25 +693,61 @@ func (_u *MenuItemUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.StationCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   menuitem.StationTable,
+			Columns: []string{menuitem.StationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(station.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.StationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   menuitem.StationTable,
+			Columns: []string{menuitem.StationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(station.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if _u.mutation.ModifiersCleared() {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
+			Rel:     sqlgraph.M2M,
 			Inverse: false,
 			Table:   menuitem.ModifiersTable,
-			Columns: []string{menuitem.ModifiersColumn},
+			Columns: menuitem.ModifiersPrimaryKey,
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
 				IDSpec: sqlgraph.NewFieldSpec(modifier.FieldID, field.TypeUUID),
 			},
 		}
+		createE := &MenuItemModifierCreate{config: _u.config, mutation: newMenuItemModifierMutation(_u.config, OpCreate)}
+		createE.defaults()
+		_, specE := createE.createSpec()
+		edge.Target.Fields = specE.Fields
+		if specE.ID.Value != nil {
+			edge.Target.Fields = append(edge.Target.Fields, specE.ID)
+		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
 	if nodes := _u.mutation.RemovedModifiersIDs(); len(nodes) > 0 && !_u.mutation.ModifiersCleared() {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
+			Rel:     sqlgraph.M2M,
 			Inverse: false,
 			Table:   menuitem.ModifiersTable,
-			Columns: []string{menuitem.ModifiersColumn},
+			Columns: menuitem.ModifiersPrimaryKey,
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
 				IDSpec: sqlgraph.NewFieldSpec(modifier.FieldID, field.TypeUUID),
@@ -435,14 +756,21 @@ func (_u *MenuItemUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		for _, k := range nodes {
 			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
+		createE := &MenuItemModifierCreate{config: _u.config, mutation: newMenuItemModifierMutation(_u.config, OpCreate)}
+		createE.defaults()
+		_, specE := createE.createSpec()
+		edge.Target.Fields = specE.Fields
+		if specE.ID.Value != nil {
+			edge.Target.Fields = append(edge.Target.Fields, specE.ID)
+		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
 	if nodes := _u.mutation.ModifiersIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
+			Rel:     sqlgraph.M2M,
 			Inverse: false,
 			Table:   menuitem.ModifiersTable,
-			Columns: []string{menuitem.ModifiersColumn},
+			Columns: menuitem.ModifiersPrimaryKey,
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
 				IDSpec: sqlgraph.NewFieldSpec(modifier.FieldID, field.TypeUUID),
@@ -451,6 +779,13 @@ func (_u *MenuItemUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		for _, k := range nodes {
 			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
+		createE := &MenuItemModifierCreate{config: _u.config, mutation: newMenuItemModifierMutation(_u.config, OpCreate)}
+		createE.defaults()
+		_, specE := createE.createSpec()
+		edge.Target.Fields = specE.Fields
+		if specE.ID.Value != nil {
+			edge.Target.Fields = append(edge.Target.Fields, specE.ID)
+		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
 	if _u.mutation.OrderItemsCleared() {
@@ -498,69 +833,249 @@ func (_u *MenuItemUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
-		if _, ok := err.(*sqlgraph.NotFoundError); ok {
-			err = &NotFoundError{menuitem.Label}
-		} else if sqlgraph.IsConstraintError(err) {
-			err = &ConstraintError{msg: err.Error(), wrap: err}
+	if _u.mutation.RecipeLinesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   menuitem.RecipeLinesTable,
+			Columns: []string{menuitem.RecipeLinesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(recipeingredient.FieldID, field.TypeUUID),
+			},
 		}
-		return 0, err
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	_u.mutation.done = true
-	return _node, nil
-}
-
-// MenuItemUpdateOne is the builder for updating a single MenuItem entity.
-type MenuItemUpdateOne struct {
-	config
-	fields   []string
-	hooks    []Hook
-	mutation *MenuItemMutation
-}
-
-// SetUpdateTime sets the "update_time" field.
-func (_u *MenuItemUpdateOne) SetUpdateTime(v time.Time) *MenuItemUpdateOne {
-	_u.mutation.SetUpdateTime(v)
-	return _u
-}
-
-// SetName sets the "name" field.
-func (_u *MenuItemUpdateOne) SetName(v string) *MenuItemUpdateOne {
-	_u.mutation.SetName(v)
-	return _u
-}
-
-// SetNillableName sets the "name" field if the given value is not nil.
-func (_u *MenuItemUpdateOne) SetNillableName(v *string) *MenuItemUpdateOne {
-	if v != nil {
-		_u.SetName(*v)
+	if nodes := _u.mutation.RemovedRecipeLinesIDs(); len(nodes) > 0 && !_u.mutation.RecipeLinesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   menuitem.RecipeLinesTable,
+			Columns: []string{menuitem.RecipeLinesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(recipeingredient.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return _u
-}
-
-// SetDescription sets the "description" field.
-func (_u *MenuItemUpdateOne) SetDescription(v string) *MenuItemUpdateOne {
-	_u.mutation.SetDescription(v)
-	return _u
-}
-
-// SetNillableDescription sets the "description" field if the given value is not nil.
-func (_u *MenuItemUpdateOne) SetNillableDescription(v *string) *MenuItemUpdateOne {
-	if v != nil {
-		_u.SetDescription(*v)
+	if nodes := _u.mutation.RecipeLinesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   menuitem.RecipeLinesTable,
+			Columns: []string{menuitem.RecipeLinesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(recipeingredient.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	return _u
-}
-
-// SetPrice sets the "price" field.
-func (_u *MenuItemUpdateOne) SetPrice(v float64) *MenuItemUpdateOne {
+	if _u.mutation.MenuPricesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   menuitem.MenuPricesTable,
+			Columns: []string{menuitem.MenuPricesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(menuitemprice.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedMenuPricesIDs(); len(nodes) > 0 && !_u.mutation.MenuPricesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   menuitem.MenuPricesTable,
+			Columns: []string{menuitem.MenuPricesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(menuitemprice.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.MenuPricesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   menuitem.MenuPricesTable,
+			Columns: []string{menuitem.MenuPricesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(menuitemprice.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.VariantsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   menuitem.VariantsTable,
+			Columns: []string{menuitem.VariantsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(menuitemvariant.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedVariantsIDs(); len(nodes) > 0 && !_u.mutation.VariantsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   menuitem.VariantsTable,
+			Columns: []string{menuitem.VariantsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(menuitemvariant.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.VariantsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   menuitem.VariantsTable,
+			Columns: []string{menuitem.VariantsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(menuitemvariant.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ItemModifiersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   menuitem.ItemModifiersTable,
+			Columns: []string{menuitem.ItemModifiersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(menuitemmodifier.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedItemModifiersIDs(); len(nodes) > 0 && !_u.mutation.ItemModifiersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   menuitem.ItemModifiersTable,
+			Columns: []string{menuitem.ItemModifiersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(menuitemmodifier.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ItemModifiersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   menuitem.ItemModifiersTable,
+			Columns: []string{menuitem.ItemModifiersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(menuitemmodifier.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{menuitem.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// MenuItemUpdateOne is the builder for updating a single MenuItem entity.
+type MenuItemUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *MenuItemMutation
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_u *MenuItemUpdateOne) SetUpdateTime(v time.Time) *MenuItemUpdateOne {
+	_u.mutation.SetUpdateTime(v)
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *MenuItemUpdateOne) SetName(v string) *MenuItemUpdateOne {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *MenuItemUpdateOne) SetNillableName(v *string) *MenuItemUpdateOne {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetDescription sets the "description" field.
+func (_u *MenuItemUpdateOne) SetDescription(v string) *MenuItemUpdateOne {
+	_u.mutation.SetDescription(v)
+	return _u
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_u *MenuItemUpdateOne) SetNillableDescription(v *string) *MenuItemUpdateOne {
+	if v != nil {
+		_u.SetDescription(*v)
+	}
+	return _u
+}
+
+// SetPrice sets the "price" field.
+func (_u *MenuItemUpdateOne) SetPrice(v int64) *MenuItemUpdateOne {
 	_u.mutation.ResetPrice()
 	_u.mutation.SetPrice(v)
 	return _u
 }
 
 // SetNillablePrice sets the "price" field if the given value is not nil.
-func (_u *MenuItemUpdateOne) SetNillablePrice(v *float64) *MenuItemUpdateOne {
+func (_u *MenuItemUpdateOne) SetNillablePrice(v *int64) *MenuItemUpdateOne {
 	if v != nil {
 		_u.SetPrice(*v)
 	}
@@ -568,7 +1083,7 @@ func (_u *MenuItemUpdateOne) SetNillablePrice(v *float64) *MenuItemUpdateOne {
 }
 
 // AddPrice adds value to the "price" field.
-func (_u *MenuItemUpdateOne) AddPrice(v float64) *MenuItemUpdateOne {
+func (_u *MenuItemUpdateOne) AddPrice(v int64) *MenuItemUpdateOne {
 	_u.mutation.AddPrice(v)
 	return _u
 }
@@ -593,6 +1108,44 @@ func (_u *MenuItemUpdateOne) ClearImageURL() *MenuItemUpdateOne {
 	return _u
 }
 
+// SetThumbnailURL sets the "thumbnail_url" field.
+func (_u *MenuItemUpdateOne) SetThumbnailURL(v string) *MenuItemUpdateOne {
+	_u.mutation.SetThumbnailURL(v)
+	return _u
+}
+
+// SetNillableThumbnailURL sets the "thumbnail_url" field if the given value is not nil.
+func (_u *MenuItemUpdateOne) SetNillableThumbnailURL(v *string) *MenuItemUpdateOne {
+	if v != nil {
+		_u.SetThumbnailURL(*v)
+	}
+	return _u
+}
+
+// ClearThumbnailURL clears the value of the "thumbnail_url" field.
+func (_u *MenuItemUpdateOne) ClearThumbnailURL() *MenuItemUpdateOne {
+	_u.mutation.ClearThumbnailURL()
+	return _u
+}
+
+// SetImageKeys sets the "image_keys" field.
+func (_u *MenuItemUpdateOne) SetImageKeys(v []string) *MenuItemUpdateOne {
+	_u.mutation.SetImageKeys(v)
+	return _u
+}
+
+// AppendImageKeys appends value to the "image_keys" field.
+func (_u *MenuItemUpdateOne) AppendImageKeys(v []string) *MenuItemUpdateOne {
+	_u.mutation.AppendImageKeys(v)
+	return _u
+}
+
+// ClearImageKeys clears the value of the "image_keys" field.
+func (_u *MenuItemUpdateOne) ClearImageKeys() *MenuItemUpdateOne {
+	_u.mutation.ClearImageKeys()
+	return _u
+}
+
 // SetIsAvailable sets the "is_available" field.
 func (_u *MenuItemUpdateOne) SetIsAvailable(v bool) *MenuItemUpdateOne {
 	_u.mutation.SetIsAvailable(v)
@@ -607,6 +1160,41 @@ func (_u *MenuItemUpdateOne) SetNillableIsAvailable(v *bool) *MenuItemUpdateOne
 	return _u
 }
 
+// SetDisplayOrder sets the "display_order" field.
+func (_u *MenuItemUpdateOne) SetDisplayOrder(v int) *MenuItemUpdateOne {
+	_u.mutation.ResetDisplayOrder()
+	_u.mutation.SetDisplayOrder(v)
+	return _u
+}
+
+// SetNillableDisplayOrder sets the "display_order" field if the given value is not nil.
+func (_u *MenuItemUpdateOne) SetNillableDisplayOrder(v *int) *MenuItemUpdateOne {
+	if v != nil {
+		_u.SetDisplayOrder(*v)
+	}
+	return _u
+}
+
+// AddDisplayOrder adds value to the "display_order" field.
+func (_u *MenuItemUpdateOne) AddDisplayOrder(v int) *MenuItemUpdateOne {
+	_u.mutation.AddDisplayOrder(v)
+	return _u
+}
+
+// SetOutOfStock sets the "out_of_stock" field.
+func (_u *MenuItemUpdateOne) SetOutOfStock(v bool) *MenuItemUpdateOne {
+	_u.mutation.SetOutOfStock(v)
+	return _u
+}
+
+// SetNillableOutOfStock sets the "out_of_stock" field if the given value is not nil.
+func (_u *MenuItemUpdateOne) SetNillableOutOfStock(v *bool) *MenuItemUpdateOne {
+	if v != nil {
+		_u.SetOutOfStock(*v)
+	}
+	return _u
+}
+
 // SetRestaurantID sets the "restaurant_id" field.
 func (_u *MenuItemUpdateOne) SetRestaurantID(v uuid.UUID) *MenuItemUpdateOne {
 	_u.mutation.SetRestaurantID(v)
@@ -641,6 +1229,26 @@ func (_u *MenuItemUpdateOne) ClearCategoryID() *MenuItemUpdateOne {
 	return _u
 }
 
+// SetStationID sets the "station_id" field.
+func (_u *MenuItemUpdateOne) SetStationID(v uuid.UUID) *MenuItemUpdateOne {
+	_u.mutation.SetStationID(v)
+	return _u
+}
+
+// SetNillableStationID sets the "station_id" field if the given value is not nil.
+func (_u *MenuItemUpdateOne) SetNillableStationID(v *uuid.UUID) *MenuItemUpdateOne {
+	if v != nil {
+		_u.SetStationID(*v)
+	}
+	return _u
+}
+
+// ClearStationID clears the value of the "station_id" field.
+func (_u *MenuItemUpdateOne) ClearStationID() *MenuItemUpdateOne {
+	_u.mutation.ClearStationID()
+	return _u
+}
+
 // SetRestaurant sets the "restaurant" edge to the Restaurant entity.
 func (_u *MenuItemUpdateOne) SetRestaurant(v *Restaurant) *MenuItemUpdateOne {
 	return _u.SetRestaurantID(v.ID)
@@ -651,6 +1259,11 @@ func (_u *MenuItemUpdateOne) SetCategory(v *Category) *MenuItemUpdateOne {
 	return _u.SetCategoryID(v.ID)
 }
 
+// SetStation sets the "station" edge to the Station entity.
+func (_u *MenuItemUpdateOne) SetStation(v *Station) *MenuItemUpdateOne {
+	return _u.SetStationID(v.ID)
+}
+
 // AddModifierIDs adds the "modifiers" edge to the Modifier entity by IDs.
 func (_u *MenuItemUpdateOne) AddModifierIDs(ids ...uuid.UUID) *MenuItemUpdateOne {
 	_u.mutation.AddModifierIDs(ids...)
@@ -681,6 +1294,66 @@ func (_u *MenuItemUpdateOne) AddOrderItems(v ...*OrderItem) *MenuItemUpdateOne {
 	return _u.AddOrderItemIDs(ids...)
 }
 
+// AddRecipeLineIDs adds the "recipe_lines" edge to the RecipeIngredient entity by IDs.
+func (_u *MenuItemUpdateOne) AddRecipeLineIDs(ids ...uuid.UUID) *MenuItemUpdateOne {
+	_u.mutation.AddRecipeLineIDs(ids...)
+	return _u
+}
+
+// AddRecipeLines adds the "recipe_lines" edges to the RecipeIngredient entity.
+func (_u *MenuItemUpdateOne) AddRecipeLines(v ...*RecipeIngredient) *MenuItemUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddRecipeLineIDs(ids...)
+}
+
+// AddMenuPriceIDs adds the "menu_prices" edge to the MenuItemPrice entity by IDs.
+func (_u *MenuItemUpdateOne) AddMenuPriceIDs(ids ...uuid.UUID) *MenuItemUpdateOne {
+	_u.mutation.AddMenuPriceIDs(ids...)
+	return _u
+}
+
+// AddMenuPrices adds the "menu_prices" edges to the MenuItemPrice entity.
+func (_u *MenuItemUpdateOne) AddMenuPrices(v ...*MenuItemPrice) *MenuItemUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddMenuPriceIDs(ids...)
+}
+
+// AddVariantIDs adds the "variants" edge to the MenuItemVariant entity by IDs.
+func (_u *MenuItemUpdateOne) AddVariantIDs(ids ...uuid.UUID) *MenuItemUpdateOne {
+	_u.mutation.AddVariantIDs(ids...)
+	return _u
+}
+
+// AddVariants adds the "variants" edges to the MenuItemVariant entity.
+func (_u *MenuItemUpdateOne) AddVariants(v ...*MenuItemVariant) *MenuItemUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddVariantIDs(ids...)
+}
+
+// AddItemModifierIDs adds the "item_modifiers" edge to the MenuItemModifier entity by IDs.
+func (_u *MenuItemUpdateOne) AddItemModifierIDs(ids ...uuid.UUID) *MenuItemUpdateOne {
+	_u.mutation.AddItemModifierIDs(ids...)
+	return _u
+}
+
+// AddItemModifiers adds the "item_modifiers" edges to the MenuItemModifier entity.
+func (_u *MenuItemUpdateOne) AddItemModifiers(v ...*MenuItemModifier) *MenuItemUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddItemModifierIDs(ids...)
+}
+
 // Mutation returns the MenuItemMutation object of the builder.
 func (_u *MenuItemUpdateOne) Mutation() *MenuItemMutation {
 	return _u.mutation
@@ -698,6 +1371,12 @@ func (_u *MenuItemUpdateOne) ClearCategory() *MenuItemUpdateOne {
 	return _u
 }
 
+// ClearStation clears the "station" edge to the Station entity.
+func (_u *MenuItemUpdateOne) ClearStation() *MenuItemUpdateOne {
+	_u.mutation.ClearStation()
+	return _u
+}
+
 // ClearModifiers clears all "modifiers" edges to the Modifier entity.
 func (_u *MenuItemUpdateOne) ClearModifiers() *MenuItemUpdateOne {
 	_u.mutation.ClearModifiers()
@@ -740,6 +1419,90 @@ func (_u *MenuItemUpdateOne) RemoveOrderItems(v ...*OrderItem) *MenuItemUpdateOn
 	return _u.RemoveOrderItemIDs(ids...)
 }
 
+// ClearRecipeLines clears all "recipe_lines" edges to the RecipeIngredient entity.
+func (_u *MenuItemUpdateOne) ClearRecipeLines() *MenuItemUpdateOne {
+	_u.mutation.ClearRecipeLines()
+	return _u
+}
+
+// RemoveRecipeLineIDs removes the "recipe_lines" edge to RecipeIngredient entities by IDs.
+func (_u *MenuItemUpdateOne) RemoveRecipeLineIDs(ids ...uuid.UUID) *MenuItemUpdateOne {
+	_u.mutation.RemoveRecipeLineIDs(ids...)
+	return _u
+}
+
+// RemoveRecipeLines removes "recipe_lines" edges to RecipeIngredient entities.
+func (_u *MenuItemUpdateOne) RemoveRecipeLines(v ...*RecipeIngredient) *MenuItemUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveRecipeLineIDs(ids...)
+}
+
+// ClearMenuPrices clears all "menu_prices" edges to the MenuItemPrice entity.
+func (_u *MenuItemUpdateOne) ClearMenuPrices() *MenuItemUpdateOne {
+	_u.mutation.ClearMenuPrices()
+	return _u
+}
+
+// RemoveMenuPriceIDs removes the "menu_prices" edge to MenuItemPrice entities by IDs.
+func (_u *MenuItemUpdateOne) RemoveMenuPriceIDs(ids ...uuid.UUID) *MenuItemUpdateOne {
+	_u.mutation.RemoveMenuPriceIDs(ids...)
+	return _u
+}
+
+// RemoveMenuPrices removes "menu_prices" edges to MenuItemPrice entities.
+func (_u *MenuItemUpdateOne) RemoveMenuPrices(v ...*MenuItemPrice) *MenuItemUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveMenuPriceIDs(ids...)
+}
+
+// ClearVariants clears all "variants" edges to the MenuItemVariant entity.
+func (_u *MenuItemUpdateOne) ClearVariants() *MenuItemUpdateOne {
+	_u.mutation.ClearVariants()
+	return _u
+}
+
+// RemoveVariantIDs removes the "variants" edge to MenuItemVariant entities by IDs.
+func (_u *MenuItemUpdateOne) RemoveVariantIDs(ids ...uuid.UUID) *MenuItemUpdateOne {
+	_u.mutation.RemoveVariantIDs(ids...)
+	return _u
+}
+
+// RemoveVariants removes "variants" edges to MenuItemVariant entities.
+func (_u *MenuItemUpdateOne) RemoveVariants(v ...*MenuItemVariant) *MenuItemUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveVariantIDs(ids...)
+}
+
+// ClearItemModifiers clears all "item_modifiers" edges to the MenuItemModifier entity.
+func (_u *MenuItemUpdateOne) ClearItemModifiers() *MenuItemUpdateOne {
+	_u.mutation.ClearItemModifiers()
+	return _u
+}
+
+// RemoveItemModifierIDs removes the "item_modifiers" edge to MenuItemModifier entities by IDs.
+func (_u *MenuItemUpdateOne) RemoveItemModifierIDs(ids ...uuid.UUID) *MenuItemUpdateOne {
+	_u.mutation.RemoveItemModifierIDs(ids...)
+	return _u
+}
+
+// RemoveItemModifiers removes "item_modifiers" edges to MenuItemModifier entities.
+func (_u *MenuItemUpdateOne) RemoveItemModifiers(v ...*MenuItemModifier) *MenuItemUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveItemModifierIDs(ids...)
+}
+
 // Where appends a list predicates to the MenuItemUpdate builder.
 func (_u *MenuItemUpdateOne) Where(ps ...predicate.MenuItem) *MenuItemUpdateOne {
 	_u.mutation.Where(ps...)
@@ -806,6 +1569,11 @@ func (_u *MenuItemUpdateOne) check() error {
 			return &ValidationError{Name: "price", err: fmt.Errorf(`ent: validator failed for field "MenuItem.price": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.DisplayOrder(); ok {
+		if err := menuitem.DisplayOrderValidator(v); err != nil {
+			return &ValidationError{Name: "display_order", err: fmt.Errorf(`ent: validator failed for field "MenuItem.display_order": %w`, err)}
+		}
+	}
 	if _u.mutation.RestaurantCleared() && len(_u.mutation.RestaurantIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "MenuItem.restaurant"`)
 	}
@@ -851,10 +1619,10 @@ func (_u *MenuItemUpdateOne) sqlSave(ctx context.Context) (_node *MenuItem, err
 		_spec.SetField(menuitem.FieldDescription, field.TypeString, value)
 	}
 	if value, ok := _u.mutation.Price(); ok {
-		_spec.SetField(menuitem.FieldPrice, field.TypeFloat64, value)
+		_spec.SetField(menuitem.FieldPrice, field.TypeInt64, value)
 	}
 	if value, ok := _u.mutation.AddedPrice(); ok {
-		_spec.AddField(menuitem.FieldPrice, field.TypeFloat64, value)
+		_spec.AddField(menuitem.FieldPrice, field.TypeInt64, value)
 	}
 	if value, ok := _u.mutation.ImageURL(); ok {
 		_spec.SetField(menuitem.FieldImageURL, field.TypeString, value)
@@ -862,9 +1630,35 @@ func (_u *MenuItemUpdateOne) sqlSave(ctx context.Context) (_node *MenuItem, err
 	if _u.mutation.ImageURLCleared() {
 		_spec.ClearField(menuitem.FieldImageURL, field.TypeString)
 	}
+	if value, ok := _u.mutation.ThumbnailURL(); ok {
+		_spec.SetField(menuitem.FieldThumbnailURL, field.TypeString, value)
+	}
+	if _u.mutation.ThumbnailURLCleared() {
+		_spec.ClearField(menuitem.FieldThumbnailURL, field.TypeString)
+	}
+	if value, ok := _u.mutation.ImageKeys(); ok {
+		_spec.SetField(menuitem.FieldImageKeys, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedImageKeys(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, menuitem.FieldImageKeys, value)
+		})
+	}
+	if _u.mutation.ImageKeysCleared() {
+		_spec.ClearField(menuitem.FieldImageKeys, field.TypeJSON)
+	}
 	if value, ok := _u.mutation.IsAvailable(); ok {
 		_spec.SetField(menuitem.FieldIsAvailable, field.TypeBool, value)
 	}
+	if value, ok := _u.mutation.DisplayOrder(); ok {
+		_spec.SetField(menuitem.FieldDisplayOrder, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedDisplayOrder(); ok {
+		_spec.AddField(menuitem.FieldDisplayOrder, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.OutOfStock(); ok {
+		_spec.SetField(menuitem.FieldOutOfStock, field.TypeBool, value)
+	}
 	if _u.mutation.RestaurantCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -923,25 +1717,61 @@ func (_u *MenuItemUpdateOne) sqlSave(ctx context.Context) (_node *MenuItem, err
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.StationCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   menuitem.StationTable,
+			Columns: []string{menuitem.StationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(station.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.StationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   menuitem.StationTable,
+			Columns: []string{menuitem.StationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(station.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if _u.mutation.ModifiersCleared() {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
+			Rel:     sqlgraph.M2M,
 			Inverse: false,
 			Table:   menuitem.ModifiersTable,
-			Columns: []string{menuitem.ModifiersColumn},
+			Columns: menuitem.ModifiersPrimaryKey,
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
 				IDSpec: sqlgraph.NewFieldSpec(modifier.FieldID, field.TypeUUID),
 			},
 		}
+		createE := &MenuItemModifierCreate{config: _u.config, mutation: newMenuItemModifierMutation(_u.config, OpCreate)}
+		createE.defaults()
+		_, specE := createE.createSpec()
+		edge.Target.Fields = specE.Fields
+		if specE.ID.Value != nil {
+			edge.Target.Fields = append(edge.Target.Fields, specE.ID)
+		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
 	if nodes := _u.mutation.RemovedModifiersIDs(); len(nodes) > 0 && !_u.mutation.ModifiersCleared() {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
+			Rel:     sqlgraph.M2M,
 			Inverse: false,
 			Table:   menuitem.ModifiersTable,
-			Columns: []string{menuitem.ModifiersColumn},
+			Columns: menuitem.ModifiersPrimaryKey,
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
 				IDSpec: sqlgraph.NewFieldSpec(modifier.FieldID, field.TypeUUID),
@@ -950,14 +1780,21 @@ func (_u *MenuItemUpdateOne) sqlSave(ctx context.Context) (_node *MenuItem, err
 		for _, k := range nodes {
 			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
+		createE := &MenuItemModifierCreate{config: _u.config, mutation: newMenuItemModifierMutation(_u.config, OpCreate)}
+		createE.defaults()
+		_, specE := createE.createSpec()
+		edge.Target.Fields = specE.Fields
+		if specE.ID.Value != nil {
+			edge.Target.Fields = append(edge.Target.Fields, specE.ID)
+		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
 	if nodes := _u.mutation.ModifiersIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
+			Rel:     sqlgraph.M2M,
 			Inverse: false,
 			Table:   menuitem.ModifiersTable,
-			Columns: []string{menuitem.ModifiersColumn},
+			Columns: menuitem.ModifiersPrimaryKey,
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
 				IDSpec: sqlgraph.NewFieldSpec(modifier.FieldID, field.TypeUUID),
@@ -966,6 +1803,13 @@ func (_u *MenuItemUpdateOne) sqlSave(ctx context.Context) (_node *MenuItem, err
 		for _, k := range nodes {
 			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
+		createE := &MenuItemModifierCreate{config: _u.config, mutation: newMenuItemModifierMutation(_u.config, OpCreate)}
+		createE.defaults()
+		_, specE := createE.createSpec()
+		edge.Target.Fields = specE.Fields
+		if specE.ID.Value != nil {
+			edge.Target.Fields = append(edge.Target.Fields, specE.ID)
+		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
 	if _u.mutation.OrderItemsCleared() {
@@ -1013,6 +1857,186 @@ func (_u *MenuItemUpdateOne) sqlSave(ctx context.Context) (_node *MenuItem, err
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.RecipeLinesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   menuitem.RecipeLinesTable,
+			Columns: []string{menuitem.RecipeLinesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(recipeingredient.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedRecipeLinesIDs(); len(nodes) > 0 && !_u.mutation.RecipeLinesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   menuitem.RecipeLinesTable,
+			Columns: []string{menuitem.RecipeLinesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(recipeingredient.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RecipeLinesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   menuitem.RecipeLinesTable,
+			Columns: []string{menuitem.RecipeLinesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(recipeingredient.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.MenuPricesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   menuitem.MenuPricesTable,
+			Columns: []string{menuitem.MenuPricesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(menuitemprice.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedMenuPricesIDs(); len(nodes) > 0 && !_u.mutation.MenuPricesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   menuitem.MenuPricesTable,
+			Columns: []string{menuitem.MenuPricesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(menuitemprice.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.MenuPricesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   menuitem.MenuPricesTable,
+			Columns: []string{menuitem.MenuPricesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(menuitemprice.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.VariantsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   menuitem.VariantsTable,
+			Columns: []string{menuitem.VariantsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(menuitemvariant.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedVariantsIDs(); len(nodes) > 0 && !_u.mutation.VariantsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   menuitem.VariantsTable,
+			Columns: []string{menuitem.VariantsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(menuitemvariant.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.VariantsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   menuitem.VariantsTable,
+			Columns: []string{menuitem.VariantsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(menuitemvariant.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ItemModifiersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   menuitem.ItemModifiersTable,
+			Columns: []string{menuitem.ItemModifiersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(menuitemmodifier.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedItemModifiersIDs(); len(nodes) > 0 && !_u.mutation.ItemModifiersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   menuitem.ItemModifiersTable,
+			Columns: []string{menuitem.ItemModifiersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(menuitemmodifier.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ItemModifiersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   menuitem.ItemModifiersTable,
+			Columns: []string{menuitem.ItemModifiersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(menuitemmodifier.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &MenuItem{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -0,0 +1,234 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/Jiruu246/rms/internal/ent/menuitem"
+	"github.com/Jiruu246/rms/internal/ent/menuitemmodifier"
+	"github.com/Jiruu246/rms/internal/ent/modifier"
+	"github.com/google/uuid"
+)
+
+// MenuItemModifier is the model entity for the MenuItemModifier schema.
+type MenuItemModifier struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// UpdateTime holds the value of the "update_time" field.
+	UpdateTime time.Time `json:"update_time,omitempty"`
+	// ID of the menu item the modifier is attached to
+	MenuItemID int64 `json:"menu_item_id,omitempty"`
+	// ID of the attached modifier
+	ModifierID uuid.UUID `json:"modifier_id,omitempty"`
+	// Display order among the item's modifiers
+	DisplayOrder int `json:"display_order,omitempty"`
+	// Whether the modifier is required on this item, instead of its own required
+	Required *bool `json:"required,omitempty"`
+	// Maximum number of selections on this item, instead of the modifier's max
+	Max *int `json:"max,omitempty"`
+	// Prices of the modifier's options on this item, by option ID, instead of their price
+	OptionPrices map[uuid.UUID]int64 `json:"option_prices,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the MenuItemModifierQuery when eager-loading is set.
+	Edges        MenuItemModifierEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// MenuItemModifierEdges holds the relations/edges for other nodes in the graph.
+type MenuItemModifierEdges struct {
+	// MenuItem holds the value of the menu_item edge.
+	MenuItem *MenuItem `json:"menu_item,omitempty"`
+	// Modifier holds the value of the modifier edge.
+	Modifier *Modifier `json:"modifier,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// MenuItemOrErr returns the MenuItem value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e MenuItemModifierEdges) MenuItemOrErr() (*MenuItem, error) {
+	if e.MenuItem != nil {
+		return e.MenuItem, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: menuitem.Label}
+	}
+	return nil, &NotLoadedError{edge: "menu_item"}
+}
+
+// ModifierOrErr returns the Modifier value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e MenuItemModifierEdges) ModifierOrErr() (*Modifier, error) {
+	if e.Modifier != nil {
+		return e.Modifier, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: modifier.Label}
+	}
+	return nil, &NotLoadedError{edge: "modifier"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*MenuItemModifier) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case menuitemmodifier.FieldOptionPrices:
+			values[i] = new([]byte)
+		case menuitemmodifier.FieldRequired:
+			values[i] = new(sql.NullBool)
+		case menuitemmodifier.FieldMenuItemID, menuitemmodifier.FieldDisplayOrder, menuitemmodifier.FieldMax:
+			values[i] = new(sql.NullInt64)
+		case menuitemmodifier.FieldUpdateTime:
+			values[i] = new(sql.NullTime)
+		case menuitemmodifier.FieldID, menuitemmodifier.FieldModifierID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the MenuItemModifier fields.
+func (_m *MenuItemModifier) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case menuitemmodifier.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case menuitemmodifier.FieldUpdateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field update_time", values[i])
+			} else if value.Valid {
+				_m.UpdateTime = value.Time
+			}
+		case menuitemmodifier.FieldMenuItemID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field menu_item_id", values[i])
+			} else if value.Valid {
+				_m.MenuItemID = value.Int64
+			}
+		case menuitemmodifier.FieldModifierID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field modifier_id", values[i])
+			} else if value != nil {
+				_m.ModifierID = *value
+			}
+		case menuitemmodifier.FieldDisplayOrder:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field display_order", values[i])
+			} else if value.Valid {
+				_m.DisplayOrder = int(value.Int64)
+			}
+		case menuitemmodifier.FieldRequired:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field required", values[i])
+			} else if value.Valid {
+				_m.Required = new(bool)
+				*_m.Required = value.Bool
+			}
+		case menuitemmodifier.FieldMax:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field max", values[i])
+			} else if value.Valid {
+				_m.Max = new(int)
+				*_m.Max = int(value.Int64)
+			}
+		case menuitemmodifier.FieldOptionPrices:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field option_prices", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.OptionPrices); err != nil {
+					return fmt.Errorf("unmarshal field option_prices: %w", err)
+				}
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the MenuItemModifier.
+// This includes values selected through modifiers, order, etc.
+func (_m *MenuItemModifier) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryMenuItem queries the "menu_item" edge of the MenuItemModifier entity.
+func (_m *MenuItemModifier) QueryMenuItem() *MenuItemQuery {
+	return NewMenuItemModifierClient(_m.config).QueryMenuItem(_m)
+}
+
+// QueryModifier queries the "modifier" edge of the MenuItemModifier entity.
+func (_m *MenuItemModifier) QueryModifier() *ModifierQuery {
+	return NewMenuItemModifierClient(_m.config).QueryModifier(_m)
+}
+
+// Update returns a builder for updating this MenuItemModifier.
+// Note that you need to call MenuItemModifier.Unwrap() before calling this method if this MenuItemModifier
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *MenuItemModifier) Update() *MenuItemModifierUpdateOne {
+	return NewMenuItemModifierClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the MenuItemModifier entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *MenuItemModifier) Unwrap() *MenuItemModifier {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: MenuItemModifier is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *MenuItemModifier) String() string {
+	var builder strings.Builder
+	builder.WriteString("MenuItemModifier(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("update_time=")
+	builder.WriteString(_m.UpdateTime.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("menu_item_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.MenuItemID))
+	builder.WriteString(", ")
+	builder.WriteString("modifier_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ModifierID))
+	builder.WriteString(", ")
+	builder.WriteString("display_order=")
+	builder.WriteString(fmt.Sprintf("%v", _m.DisplayOrder))
+	builder.WriteString(", ")
+	if v := _m.Required; v != nil {
+		builder.WriteString("required=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.Max; v != nil {
+		builder.WriteString("max=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("option_prices=")
+	builder.WriteString(fmt.Sprintf("%v", _m.OptionPrices))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// MenuItemModifiers is a parsable slice of MenuItemModifier.
+type MenuItemModifiers []*MenuItemModifier
@@ -0,0 +1,155 @@
+// Code generated by ent, DO NOT EDIT.
+
+package menuitemmodifier
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the menuitemmodifier type in the database.
+	Label = "menu_item_modifier"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldUpdateTime holds the string denoting the update_time field in the database.
+	FieldUpdateTime = "update_time"
+	// FieldMenuItemID holds the string denoting the menu_item_id field in the database.
+	FieldMenuItemID = "menu_item_id"
+	// FieldModifierID holds the string denoting the modifier_id field in the database.
+	FieldModifierID = "modifier_id"
+	// FieldDisplayOrder holds the string denoting the display_order field in the database.
+	FieldDisplayOrder = "display_order"
+	// FieldRequired holds the string denoting the required field in the database.
+	FieldRequired = "required"
+	// FieldMax holds the string denoting the max field in the database.
+	FieldMax = "max"
+	// FieldOptionPrices holds the string denoting the option_prices field in the database.
+	FieldOptionPrices = "option_prices"
+	// EdgeMenuItem holds the string denoting the menu_item edge name in mutations.
+	EdgeMenuItem = "menu_item"
+	// EdgeModifier holds the string denoting the modifier edge name in mutations.
+	EdgeModifier = "modifier"
+	// Table holds the table name of the menuitemmodifier in the database.
+	Table = "menu_item_modifiers"
+	// MenuItemTable is the table that holds the menu_item relation/edge.
+	MenuItemTable = "menu_item_modifiers"
+	// MenuItemInverseTable is the table name for the MenuItem entity.
+	// It exists in this package in order to avoid circular dependency with the "menuitem" package.
+	MenuItemInverseTable = "menu_items"
+	// MenuItemColumn is the table column denoting the menu_item relation/edge.
+	MenuItemColumn = "menu_item_id"
+	// ModifierTable is the table that holds the modifier relation/edge.
+	ModifierTable = "menu_item_modifiers"
+	// ModifierInverseTable is the table name for the Modifier entity.
+	// It exists in this package in order to avoid circular dependency with the "modifier" package.
+	ModifierInverseTable = "modifiers"
+	// ModifierColumn is the table column denoting the modifier relation/edge.
+	ModifierColumn = "modifier_id"
+)
+
+// Columns holds all SQL columns for menuitemmodifier fields.
+var Columns = []string{
+	FieldID,
+	FieldUpdateTime,
+	FieldMenuItemID,
+	FieldModifierID,
+	FieldDisplayOrder,
+	FieldRequired,
+	FieldMax,
+	FieldOptionPrices,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultUpdateTime holds the default value on creation for the "update_time" field.
+	DefaultUpdateTime func() time.Time
+	// UpdateDefaultUpdateTime holds the default value on update for the "update_time" field.
+	UpdateDefaultUpdateTime func() time.Time
+	// DefaultDisplayOrder holds the default value on creation for the "display_order" field.
+	DefaultDisplayOrder int
+	// DisplayOrderValidator is a validator for the "display_order" field. It is called by the builders before save.
+	DisplayOrderValidator func(int) error
+	// MaxValidator is a validator for the "max" field. It is called by the builders before save.
+	MaxValidator func(int) error
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// OrderOption defines the ordering options for the MenuItemModifier queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByUpdateTime orders the results by the update_time field.
+func ByUpdateTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdateTime, opts...).ToFunc()
+}
+
+// ByMenuItemID orders the results by the menu_item_id field.
+func ByMenuItemID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMenuItemID, opts...).ToFunc()
+}
+
+// ByModifierID orders the results by the modifier_id field.
+func ByModifierID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldModifierID, opts...).ToFunc()
+}
+
+// ByDisplayOrder orders the results by the display_order field.
+func ByDisplayOrder(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDisplayOrder, opts...).ToFunc()
+}
+
+// ByRequired orders the results by the required field.
+func ByRequired(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRequired, opts...).ToFunc()
+}
+
+// ByMax orders the results by the max field.
+func ByMax(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMax, opts...).ToFunc()
+}
+
+// ByMenuItemField orders the results by menu_item field.
+func ByMenuItemField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newMenuItemStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByModifierField orders the results by modifier field.
+func ByModifierField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newModifierStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newMenuItemStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(MenuItemInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, false, MenuItemTable, MenuItemColumn),
+	)
+}
+func newModifierStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ModifierInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, false, ModifierTable, ModifierColumn),
+	)
+}
@@ -0,0 +1,348 @@
+// Code generated by ent, DO NOT EDIT.
+
+package menuitemmodifier
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/Jiruu246/rms/internal/ent/predicate"
+	"github.com/google/uuid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.MenuItemModifier {
+	return predicate.MenuItemModifier(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.MenuItemModifier {
+	return predicate.MenuItemModifier(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.MenuItemModifier {
+	return predicate.MenuItemModifier(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.MenuItemModifier {
+	return predicate.MenuItemModifier(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.MenuItemModifier {
+	return predicate.MenuItemModifier(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.MenuItemModifier {
+	return predicate.MenuItemModifier(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.MenuItemModifier {
+	return predicate.MenuItemModifier(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.MenuItemModifier {
+	return predicate.MenuItemModifier(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.MenuItemModifier {
+	return predicate.MenuItemModifier(sql.FieldLTE(FieldID, id))
+}
+
+// UpdateTime applies equality check predicate on the "update_time" field. It's identical to UpdateTimeEQ.
+func UpdateTime(v time.Time) predicate.MenuItemModifier {
+	return predicate.MenuItemModifier(sql.FieldEQ(FieldUpdateTime, v))
+}
+
+// MenuItemID applies equality check predicate on the "menu_item_id" field. It's identical to MenuItemIDEQ.
+func MenuItemID(v int64) predicate.MenuItemModifier {
+	return predicate.MenuItemModifier(sql.FieldEQ(FieldMenuItemID, v))
+}
+
+// ModifierID applies equality check predicate on the "modifier_id" field. It's identical to ModifierIDEQ.
+func ModifierID(v uuid.UUID) predicate.MenuItemModifier {
+	return predicate.MenuItemModifier(sql.FieldEQ(FieldModifierID, v))
+}
+
+// DisplayOrder applies equality check predicate on the "display_order" field. It's identical to DisplayOrderEQ.
+func DisplayOrder(v int) predicate.MenuItemModifier {
+	return predicate.MenuItemModifier(sql.FieldEQ(FieldDisplayOrder, v))
+}
+
+// Required applies equality check predicate on the "required" field. It's identical to RequiredEQ.
+func Required(v bool) predicate.MenuItemModifier {
+	return predicate.MenuItemModifier(sql.FieldEQ(FieldRequired, v))
+}
+
+// Max applies equality check predicate on the "max" field. It's identical to MaxEQ.
+func Max(v int) predicate.MenuItemModifier {
+	return predicate.MenuItemModifier(sql.FieldEQ(FieldMax, v))
+}
+
+// UpdateTimeEQ applies the EQ predicate on the "update_time" field.
+func UpdateTimeEQ(v time.Time) predicate.MenuItemModifier {
+	return predicate.MenuItemModifier(sql.FieldEQ(FieldUpdateTime, v))
+}
+
+// UpdateTimeNEQ applies the NEQ predicate on the "update_time" field.
+func UpdateTimeNEQ(v time.Time) predicate.MenuItemModifier {
+	return predicate.MenuItemModifier(sql.FieldNEQ(FieldUpdateTime, v))
+}
+
+// UpdateTimeIn applies the In predicate on the "update_time" field.
+func UpdateTimeIn(vs ...time.Time) predicate.MenuItemModifier {
+	return predicate.MenuItemModifier(sql.FieldIn(FieldUpdateTime, vs...))
+}
+
+// UpdateTimeNotIn applies the NotIn predicate on the "update_time" field.
+func UpdateTimeNotIn(vs ...time.Time) predicate.MenuItemModifier {
+	return predicate.MenuItemModifier(sql.FieldNotIn(FieldUpdateTime, vs...))
+}
+
+// UpdateTimeGT applies the GT predicate on the "update_time" field.
+func UpdateTimeGT(v time.Time) predicate.MenuItemModifier {
+	return predicate.MenuItemModifier(sql.FieldGT(FieldUpdateTime, v))
+}
+
+// UpdateTimeGTE applies the GTE predicate on the "update_time" field.
+func UpdateTimeGTE(v time.Time) predicate.MenuItemModifier {
+	return predicate.MenuItemModifier(sql.FieldGTE(FieldUpdateTime, v))
+}
+
+// UpdateTimeLT applies the LT predicate on the "update_time" field.
+func UpdateTimeLT(v time.Time) predicate.MenuItemModifier {
+	return predicate.MenuItemModifier(sql.FieldLT(FieldUpdateTime, v))
+}
+
+// UpdateTimeLTE applies the LTE predicate on the "update_time" field.
+func UpdateTimeLTE(v time.Time) predicate.MenuItemModifier {
+	return predicate.MenuItemModifier(sql.FieldLTE(FieldUpdateTime, v))
+}
+
+// MenuItemIDEQ applies the EQ predicate on the "menu_item_id" field.
+func MenuItemIDEQ(v int64) predicate.MenuItemModifier {
+	return predicate.MenuItemModifier(sql.FieldEQ(FieldMenuItemID, v))
+}
+
+// MenuItemIDNEQ applies the NEQ predicate on the "menu_item_id" field.
+func MenuItemIDNEQ(v int64) predicate.MenuItemModifier {
+	return predicate.MenuItemModifier(sql.FieldNEQ(FieldMenuItemID, v))
+}
+
+// MenuItemIDIn applies the In predicate on the "menu_item_id" field.
+func MenuItemIDIn(vs ...int64) predicate.MenuItemModifier {
+	return predicate.MenuItemModifier(sql.FieldIn(FieldMenuItemID, vs...))
+}
+
+// MenuItemIDNotIn applies the NotIn predicate on the "menu_item_id" field.
+func MenuItemIDNotIn(vs ...int64) predicate.MenuItemModifier {
+	return predicate.MenuItemModifier(sql.FieldNotIn(FieldMenuItemID, vs...))
+}
+
+// ModifierIDEQ applies the EQ predicate on the "modifier_id" field.
+func ModifierIDEQ(v uuid.UUID) predicate.MenuItemModifier {
+	return predicate.MenuItemModifier(sql.FieldEQ(FieldModifierID, v))
+}
+
+// ModifierIDNEQ applies the NEQ predicate on the "modifier_id" field.
+func ModifierIDNEQ(v uuid.UUID) predicate.MenuItemModifier {
+	return predicate.MenuItemModifier(sql.FieldNEQ(FieldModifierID, v))
+}
+
+// ModifierIDIn applies the In predicate on the "modifier_id" field.
+func ModifierIDIn(vs ...uuid.UUID) predicate.MenuItemModifier {
+	return predicate.MenuItemModifier(sql.FieldIn(FieldModifierID, vs...))
+}
+
+// ModifierIDNotIn applies the NotIn predicate on the "modifier_id" field.
+func ModifierIDNotIn(vs ...uuid.UUID) predicate.MenuItemModifier {
+	return predicate.MenuItemModifier(sql.FieldNotIn(FieldModifierID, vs...))
+}
+
+// DisplayOrderEQ applies the EQ predicate on the "display_order" field.
+func DisplayOrderEQ(v int) predicate.MenuItemModifier {
+	return predicate.MenuItemModifier(sql.FieldEQ(FieldDisplayOrder, v))
+}
+
+// DisplayOrderNEQ applies the NEQ predicate on the "display_order" field.
+func DisplayOrderNEQ(v int) predicate.MenuItemModifier {
+	return predicate.MenuItemModifier(sql.FieldNEQ(FieldDisplayOrder, v))
+}
+
+// DisplayOrderIn applies the In predicate on the "display_order" field.
+func DisplayOrderIn(vs ...int) predicate.MenuItemModifier {
+	return predicate.MenuItemModifier(sql.FieldIn(FieldDisplayOrder, vs...))
+}
+
+// DisplayOrderNotIn applies the NotIn predicate on the "display_order" field.
+func DisplayOrderNotIn(vs ...int) predicate.MenuItemModifier {
+	return predicate.MenuItemModifier(sql.FieldNotIn(FieldDisplayOrder, vs...))
+}
+
+// DisplayOrderGT applies the GT predicate on the "display_order" field.
+func DisplayOrderGT(v int) predicate.MenuItemModifier {
+	return predicate.MenuItemModifier(sql.FieldGT(FieldDisplayOrder, v))
+}
+
+// DisplayOrderGTE applies the GTE predicate on the "display_order" field.
+func DisplayOrderGTE(v int) predicate.MenuItemModifier {
+	return predicate.MenuItemModifier(sql.FieldGTE(FieldDisplayOrder, v))
+}
+
+// DisplayOrderLT applies the LT predicate on the "display_order" field.
+func DisplayOrderLT(v int) predicate.MenuItemModifier {
+	return predicate.MenuItemModifier(sql.FieldLT(FieldDisplayOrder, v))
+}
+
+// DisplayOrderLTE applies the LTE predicate on the "display_order" field.
+func DisplayOrderLTE(v int) predicate.MenuItemModifier {
+	return predicate.MenuItemModifier(sql.FieldLTE(FieldDisplayOrder, v))
+}
+
+// RequiredEQ applies the EQ predicate on the "required" field.
+func RequiredEQ(v bool) predicate.MenuItemModifier {
+	return predicate.MenuItemModifier(sql.FieldEQ(FieldRequired, v))
+}
+
+// RequiredNEQ applies the NEQ predicate on the "required" field.
+func RequiredNEQ(v bool) predicate.MenuItemModifier {
+	return predicate.MenuItemModifier(sql.FieldNEQ(FieldRequired, v))
+}
+
+// RequiredIsNil applies the IsNil predicate on the "required" field.
+func RequiredIsNil() predicate.MenuItemModifier {
+	return predicate.MenuItemModifier(sql.FieldIsNull(FieldRequired))
+}
+
+// RequiredNotNil applies the NotNil predicate on the "required" field.
+func RequiredNotNil() predicate.MenuItemModifier {
+	return predicate.MenuItemModifier(sql.FieldNotNull(FieldRequired))
+}
+
+// MaxEQ applies the EQ predicate on the "max" field.
+func MaxEQ(v int) predicate.MenuItemModifier {
+	return predicate.MenuItemModifier(sql.FieldEQ(FieldMax, v))
+}
+
+// MaxNEQ applies the NEQ predicate on the "max" field.
+func MaxNEQ(v int) predicate.MenuItemModifier {
+	return predicate.MenuItemModifier(sql.FieldNEQ(FieldMax, v))
+}
+
+// MaxIn applies the In predicate on the "max" field.
+func MaxIn(vs ...int) predicate.MenuItemModifier {
+	return predicate.MenuItemModifier(sql.FieldIn(FieldMax, vs...))
+}
+
+// MaxNotIn applies the NotIn predicate on the "max" field.
+func MaxNotIn(vs ...int) predicate.MenuItemModifier {
+	return predicate.MenuItemModifier(sql.FieldNotIn(FieldMax, vs...))
+}
+
+// MaxGT applies the GT predicate on the "max" field.
+func MaxGT(v int) predicate.MenuItemModifier {
+	return predicate.MenuItemModifier(sql.FieldGT(FieldMax, v))
+}
+
+// MaxGTE applies the GTE predicate on the "max" field.
+func MaxGTE(v int) predicate.MenuItemModifier {
+	return predicate.MenuItemModifier(sql.FieldGTE(FieldMax, v))
+}
+
+// MaxLT applies the LT predicate on the "max" field.
+func MaxLT(v int) predicate.MenuItemModifier {
+	return predicate.MenuItemModifier(sql.FieldLT(FieldMax, v))
+}
+
+// MaxLTE applies the LTE predicate on the "max" field.
+func MaxLTE(v int) predicate.MenuItemModifier {
+	return predicate.MenuItemModifier(sql.FieldLTE(FieldMax, v))
+}
+
+// MaxIsNil applies the IsNil predicate on the "max" field.
+func MaxIsNil() predicate.MenuItemModifier {
+	return predicate.MenuItemModifier(sql.FieldIsNull(FieldMax))
+}
+
+// MaxNotNil applies the NotNil predicate on the "max" field.
+func MaxNotNil() predicate.MenuItemModifier {
+	return predicate.MenuItemModifier(sql.FieldNotNull(FieldMax))
+}
+
+// OptionPricesIsNil applies the IsNil predicate on the "option_prices" field.
+func OptionPricesIsNil() predicate.MenuItemModifier {
+	return predicate.MenuItemModifier(sql.FieldIsNull(FieldOptionPrices))
+}
+
+// OptionPricesNotNil applies the NotNil predicate on the "option_prices" field.
+func OptionPricesNotNil() predicate.MenuItemModifier {
+	return predicate.MenuItemModifier(sql.FieldNotNull(FieldOptionPrices))
+}
+
+// HasMenuItem applies the HasEdge predicate on the "menu_item" edge.
+func HasMenuItem() predicate.MenuItemModifier {
+	return predicate.MenuItemModifier(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, MenuItemTable, MenuItemColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasMenuItemWith applies the HasEdge predicate on the "menu_item" edge with a given conditions (other predicates).
+func HasMenuItemWith(preds ...predicate.MenuItem) predicate.MenuItemModifier {
+	return predicate.MenuItemModifier(func(s *sql.Selector) {
+		step := newMenuItemStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasModifier applies the HasEdge predicate on the "modifier" edge.
+func HasModifier() predicate.MenuItemModifier {
+	return predicate.MenuItemModifier(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, ModifierTable, ModifierColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasModifierWith applies the HasEdge predicate on the "modifier" edge with a given conditions (other predicates).
+func HasModifierWith(preds ...predicate.Modifier) predicate.MenuItemModifier {
+	return predicate.MenuItemModifier(func(s *sql.Selector) {
+		step := newModifierStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.MenuItemModifier) predicate.MenuItemModifier {
+	return predicate.MenuItemModifier(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.MenuItemModifier) predicate.MenuItemModifier {
+	return predicate.MenuItemModifier(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.MenuItemModifier) predicate.MenuItemModifier {
+	return predicate.MenuItemModifier(sql.NotPredicates(p))
+}
@@ -0,0 +1,377 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Jiruu246/rms/internal/ent/menuitem"
+	"github.com/Jiruu246/rms/internal/ent/menuitemmodifier"
+	"github.com/Jiruu246/rms/internal/ent/modifier"
+	"github.com/google/uuid"
+)
+
+// MenuItemModifierCreate is the builder for creating a MenuItemModifier entity.
+type MenuItemModifierCreate struct {
+	config
+	mutation *MenuItemModifierMutation
+	hooks    []Hook
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_c *MenuItemModifierCreate) SetUpdateTime(v time.Time) *MenuItemModifierCreate {
+	_c.mutation.SetUpdateTime(v)
+	return _c
+}
+
+// SetNillableUpdateTime sets the "update_time" field if the given value is not nil.
+func (_c *MenuItemModifierCreate) SetNillableUpdateTime(v *time.Time) *MenuItemModifierCreate {
+	if v != nil {
+		_c.SetUpdateTime(*v)
+	}
+	return _c
+}
+
+// SetMenuItemID sets the "menu_item_id" field.
+func (_c *MenuItemModifierCreate) SetMenuItemID(v int64) *MenuItemModifierCreate {
+	_c.mutation.SetMenuItemID(v)
+	return _c
+}
+
+// SetModifierID sets the "modifier_id" field.
+func (_c *MenuItemModifierCreate) SetModifierID(v uuid.UUID) *MenuItemModifierCreate {
+	_c.mutation.SetModifierID(v)
+	return _c
+}
+
+// SetDisplayOrder sets the "display_order" field.
+func (_c *MenuItemModifierCreate) SetDisplayOrder(v int) *MenuItemModifierCreate {
+	_c.mutation.SetDisplayOrder(v)
+	return _c
+}
+
+// SetNillableDisplayOrder sets the "display_order" field if the given value is not nil.
+func (_c *MenuItemModifierCreate) SetNillableDisplayOrder(v *int) *MenuItemModifierCreate {
+	if v != nil {
+		_c.SetDisplayOrder(*v)
+	}
+	return _c
+}
+
+// SetRequired sets the "required" field.
+func (_c *MenuItemModifierCreate) SetRequired(v bool) *MenuItemModifierCreate {
+	_c.mutation.SetRequired(v)
+	return _c
+}
+
+// SetNillableRequired sets the "required" field if the given value is not nil.
+func (_c *MenuItemModifierCreate) SetNillableRequired(v *bool) *MenuItemModifierCreate {
+	if v != nil {
+		_c.SetRequired(*v)
+	}
+	return _c
+}
+
+// SetMax sets the "max" field.
+func (_c *MenuItemModifierCreate) SetMax(v int) *MenuItemModifierCreate {
+	_c.mutation.SetMax(v)
+	return _c
+}
+
+// SetNillableMax sets the "max" field if the given value is not nil.
+func (_c *MenuItemModifierCreate) SetNillableMax(v *int) *MenuItemModifierCreate {
+	if v != nil {
+		_c.SetMax(*v)
+	}
+	return _c
+}
+
+// SetOptionPrices sets the "option_prices" field.
+func (_c *MenuItemModifierCreate) SetOptionPrices(v map[uuid.UUID]int64) *MenuItemModifierCreate {
+	_c.mutation.SetOptionPrices(v)
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *MenuItemModifierCreate) SetID(v uuid.UUID) *MenuItemModifierCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *MenuItemModifierCreate) SetNillableID(v *uuid.UUID) *MenuItemModifierCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetMenuItem sets the "menu_item" edge to the MenuItem entity.
+func (_c *MenuItemModifierCreate) SetMenuItem(v *MenuItem) *MenuItemModifierCreate {
+	return _c.SetMenuItemID(v.ID)
+}
+
+// SetModifier sets the "modifier" edge to the Modifier entity.
+func (_c *MenuItemModifierCreate) SetModifier(v *Modifier) *MenuItemModifierCreate {
+	return _c.SetModifierID(v.ID)
+}
+
+// Mutation returns the MenuItemModifierMutation object of the builder.
+func (_c *MenuItemModifierCreate) Mutation() *MenuItemModifierMutation {
+	return _c.mutation
+}
+
+// Save creates the MenuItemModifier in the database.
+func (_c *MenuItemModifierCreate) Save(ctx context.Context) (*MenuItemModifier, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *MenuItemModifierCreate) SaveX(ctx context.Context) *MenuItemModifier {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *MenuItemModifierCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *MenuItemModifierCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *MenuItemModifierCreate) defaults() {
+	if _, ok := _c.mutation.UpdateTime(); !ok {
+		v := menuitemmodifier.DefaultUpdateTime()
+		_c.mutation.SetUpdateTime(v)
+	}
+	if _, ok := _c.mutation.DisplayOrder(); !ok {
+		v := menuitemmodifier.DefaultDisplayOrder
+		_c.mutation.SetDisplayOrder(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := menuitemmodifier.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *MenuItemModifierCreate) check() error {
+	if _, ok := _c.mutation.UpdateTime(); !ok {
+		return &ValidationError{Name: "update_time", err: errors.New(`ent: missing required field "MenuItemModifier.update_time"`)}
+	}
+	if _, ok := _c.mutation.MenuItemID(); !ok {
+		return &ValidationError{Name: "menu_item_id", err: errors.New(`ent: missing required field "MenuItemModifier.menu_item_id"`)}
+	}
+	if _, ok := _c.mutation.ModifierID(); !ok {
+		return &ValidationError{Name: "modifier_id", err: errors.New(`ent: missing required field "MenuItemModifier.modifier_id"`)}
+	}
+	if _, ok := _c.mutation.DisplayOrder(); !ok {
+		return &ValidationError{Name: "display_order", err: errors.New(`ent: missing required field "MenuItemModifier.display_order"`)}
+	}
+	if v, ok := _c.mutation.DisplayOrder(); ok {
+		if err := menuitemmodifier.DisplayOrderValidator(v); err != nil {
+			return &ValidationError{Name: "display_order", err: fmt.Errorf(`ent: validator failed for field "MenuItemModifier.display_order": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.Max(); ok {
+		if err := menuitemmodifier.MaxValidator(v); err != nil {
+			return &ValidationError{Name: "max", err: fmt.Errorf(`ent: validator failed for field "MenuItemModifier.max": %w`, err)}
+		}
+	}
+	if len(_c.mutation.MenuItemIDs()) == 0 {
+		return &ValidationError{Name: "menu_item", err: errors.New(`ent: missing required edge "MenuItemModifier.menu_item"`)}
+	}
+	if len(_c.mutation.ModifierIDs()) == 0 {
+		return &ValidationError{Name: "modifier", err: errors.New(`ent: missing required edge "MenuItemModifier.modifier"`)}
+	}
+	return nil
+}
+
+func (_c *MenuItemModifierCreate) sqlSave(ctx context.Context) (*MenuItemModifier, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *MenuItemModifierCreate) createSpec() (*MenuItemModifier, *sqlgraph.CreateSpec) {
+	var (
+		_node = &MenuItemModifier{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(menuitemmodifier.Table, sqlgraph.NewFieldSpec(menuitemmodifier.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.UpdateTime(); ok {
+		_spec.SetField(menuitemmodifier.FieldUpdateTime, field.TypeTime, value)
+		_node.UpdateTime = value
+	}
+	if value, ok := _c.mutation.DisplayOrder(); ok {
+		_spec.SetField(menuitemmodifier.FieldDisplayOrder, field.TypeInt, value)
+		_node.DisplayOrder = value
+	}
+	if value, ok := _c.mutation.Required(); ok {
+		_spec.SetField(menuitemmodifier.FieldRequired, field.TypeBool, value)
+		_node.Required = &value
+	}
+	if value, ok := _c.mutation.Max(); ok {
+		_spec.SetField(menuitemmodifier.FieldMax, field.TypeInt, value)
+		_node.Max = &value
+	}
+	if value, ok := _c.mutation.OptionPrices(); ok {
+		_spec.SetField(menuitemmodifier.FieldOptionPrices, field.TypeJSON, value)
+		_node.OptionPrices = value
+	}
+	if nodes := _c.mutation.MenuItemIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   menuitemmodifier.MenuItemTable,
+			Columns: []string{menuitemmodifier.MenuItemColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(menuitem.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.MenuItemID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.ModifierIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   menuitemmodifier.ModifierTable,
+			Columns: []string{menuitemmodifier.ModifierColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(modifier.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ModifierID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// MenuItemModifierCreateBulk is the builder for creating many MenuItemModifier entities in bulk.
+type MenuItemModifierCreateBulk struct {
+	config
+	err      error
+	builders []*MenuItemModifierCreate
+}
+
+// Save creates the MenuItemModifier entities in the database.
+func (_c *MenuItemModifierCreateBulk) Save(ctx context.Context) ([]*MenuItemModifier, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*MenuItemModifier, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*MenuItemModifierMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *MenuItemModifierCreateBulk) SaveX(ctx context.Context) []*MenuItemModifier {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *MenuItemModifierCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *MenuItemModifierCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
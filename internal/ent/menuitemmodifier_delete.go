@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Jiruu246/rms/internal/ent/menuitemmodifier"
+	"github.com/Jiruu246/rms/internal/ent/predicate"
+)
+
+// MenuItemModifierDelete is the builder for deleting a MenuItemModifier entity.
+type MenuItemModifierDelete struct {
+	config
+	hooks    []Hook
+	mutation *MenuItemModifierMutation
+}
+
+// Where appends a list predicates to the MenuItemModifierDelete builder.
+func (_d *MenuItemModifierDelete) Where(ps ...predicate.MenuItemModifier) *MenuItemModifierDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *MenuItemModifierDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *MenuItemModifierDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *MenuItemModifierDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(menuitemmodifier.Table, sqlgraph.NewFieldSpec(menuitemmodifier.FieldID, field.TypeUUID))
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// MenuItemModifierDeleteOne is the builder for deleting a single MenuItemModifier entity.
+type MenuItemModifierDeleteOne struct {
+	_d *MenuItemModifierDelete
+}
+
+// Where appends a list predicates to the MenuItemModifierDelete builder.
+func (_d *MenuItemModifierDeleteOne) Where(ps ...predicate.MenuItemModifier) *MenuItemModifierDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *MenuItemModifierDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{menuitemmodifier.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *MenuItemModifierDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
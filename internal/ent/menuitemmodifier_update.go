@@ -0,0 +1,475 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Jiruu246/rms/internal/ent/menuitemmodifier"
+	"github.com/Jiruu246/rms/internal/ent/predicate"
+	"github.com/google/uuid"
+)
+
+// MenuItemModifierUpdate is the builder for updating MenuItemModifier entities.
+type MenuItemModifierUpdate struct {
+	config
+	hooks    []Hook
+	mutation *MenuItemModifierMutation
+}
+
+// Where appends a list predicates to the MenuItemModifierUpdate builder.
+func (_u *MenuItemModifierUpdate) Where(ps ...predicate.MenuItemModifier) *MenuItemModifierUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_u *MenuItemModifierUpdate) SetUpdateTime(v time.Time) *MenuItemModifierUpdate {
+	_u.mutation.SetUpdateTime(v)
+	return _u
+}
+
+// SetDisplayOrder sets the "display_order" field.
+func (_u *MenuItemModifierUpdate) SetDisplayOrder(v int) *MenuItemModifierUpdate {
+	_u.mutation.ResetDisplayOrder()
+	_u.mutation.SetDisplayOrder(v)
+	return _u
+}
+
+// SetNillableDisplayOrder sets the "display_order" field if the given value is not nil.
+func (_u *MenuItemModifierUpdate) SetNillableDisplayOrder(v *int) *MenuItemModifierUpdate {
+	if v != nil {
+		_u.SetDisplayOrder(*v)
+	}
+	return _u
+}
+
+// AddDisplayOrder adds value to the "display_order" field.
+func (_u *MenuItemModifierUpdate) AddDisplayOrder(v int) *MenuItemModifierUpdate {
+	_u.mutation.AddDisplayOrder(v)
+	return _u
+}
+
+// SetRequired sets the "required" field.
+func (_u *MenuItemModifierUpdate) SetRequired(v bool) *MenuItemModifierUpdate {
+	_u.mutation.SetRequired(v)
+	return _u
+}
+
+// SetNillableRequired sets the "required" field if the given value is not nil.
+func (_u *MenuItemModifierUpdate) SetNillableRequired(v *bool) *MenuItemModifierUpdate {
+	if v != nil {
+		_u.SetRequired(*v)
+	}
+	return _u
+}
+
+// ClearRequired clears the value of the "required" field.
+func (_u *MenuItemModifierUpdate) ClearRequired() *MenuItemModifierUpdate {
+	_u.mutation.ClearRequired()
+	return _u
+}
+
+// SetMax sets the "max" field.
+func (_u *MenuItemModifierUpdate) SetMax(v int) *MenuItemModifierUpdate {
+	_u.mutation.ResetMax()
+	_u.mutation.SetMax(v)
+	return _u
+}
+
+// SetNillableMax sets the "max" field if the given value is not nil.
+func (_u *MenuItemModifierUpdate) SetNillableMax(v *int) *MenuItemModifierUpdate {
+	if v != nil {
+		_u.SetMax(*v)
+	}
+	return _u
+}
+
+// AddMax adds value to the "max" field.
+func (_u *MenuItemModifierUpdate) AddMax(v int) *MenuItemModifierUpdate {
+	_u.mutation.AddMax(v)
+	return _u
+}
+
+// ClearMax clears the value of the "max" field.
+func (_u *MenuItemModifierUpdate) ClearMax() *MenuItemModifierUpdate {
+	_u.mutation.ClearMax()
+	return _u
+}
+
+// SetOptionPrices sets the "option_prices" field.
+func (_u *MenuItemModifierUpdate) SetOptionPrices(v map[uuid.UUID]int64) *MenuItemModifierUpdate {
+	_u.mutation.SetOptionPrices(v)
+	return _u
+}
+
+// ClearOptionPrices clears the value of the "option_prices" field.
+func (_u *MenuItemModifierUpdate) ClearOptionPrices() *MenuItemModifierUpdate {
+	_u.mutation.ClearOptionPrices()
+	return _u
+}
+
+// Mutation returns the MenuItemModifierMutation object of the builder.
+func (_u *MenuItemModifierUpdate) Mutation() *MenuItemModifierMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *MenuItemModifierUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *MenuItemModifierUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *MenuItemModifierUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *MenuItemModifierUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *MenuItemModifierUpdate) defaults() {
+	if _, ok := _u.mutation.UpdateTime(); !ok {
+		v := menuitemmodifier.UpdateDefaultUpdateTime()
+		_u.mutation.SetUpdateTime(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *MenuItemModifierUpdate) check() error {
+	if v, ok := _u.mutation.DisplayOrder(); ok {
+		if err := menuitemmodifier.DisplayOrderValidator(v); err != nil {
+			return &ValidationError{Name: "display_order", err: fmt.Errorf(`ent: validator failed for field "MenuItemModifier.display_order": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Max(); ok {
+		if err := menuitemmodifier.MaxValidator(v); err != nil {
+			return &ValidationError{Name: "max", err: fmt.Errorf(`ent: validator failed for field "MenuItemModifier.max": %w`, err)}
+		}
+	}
+	if _u.mutation.MenuItemCleared() && len(_u.mutation.MenuItemIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "MenuItemModifier.menu_item"`)
+	}
+	if _u.mutation.ModifierCleared() && len(_u.mutation.ModifierIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "MenuItemModifier.modifier"`)
+	}
+	return nil
+}
+
+func (_u *MenuItemModifierUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(menuitemmodifier.Table, menuitemmodifier.Columns, sqlgraph.NewFieldSpec(menuitemmodifier.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdateTime(); ok {
+		_spec.SetField(menuitemmodifier.FieldUpdateTime, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.DisplayOrder(); ok {
+		_spec.SetField(menuitemmodifier.FieldDisplayOrder, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedDisplayOrder(); ok {
+		_spec.AddField(menuitemmodifier.FieldDisplayOrder, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Required(); ok {
+		_spec.SetField(menuitemmodifier.FieldRequired, field.TypeBool, value)
+	}
+	if _u.mutation.RequiredCleared() {
+		_spec.ClearField(menuitemmodifier.FieldRequired, field.TypeBool)
+	}
+	if value, ok := _u.mutation.Max(); ok {
+		_spec.SetField(menuitemmodifier.FieldMax, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedMax(); ok {
+		_spec.AddField(menuitemmodifier.FieldMax, field.TypeInt, value)
+	}
+	if _u.mutation.MaxCleared() {
+		_spec.ClearField(menuitemmodifier.FieldMax, field.TypeInt)
+	}
+	if value, ok := _u.mutation.OptionPrices(); ok {
+		_spec.SetField(menuitemmodifier.FieldOptionPrices, field.TypeJSON, value)
+	}
+	if _u.mutation.OptionPricesCleared() {
+		_spec.ClearField(menuitemmodifier.FieldOptionPrices, field.TypeJSON)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{menuitemmodifier.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// MenuItemModifierUpdateOne is the builder for updating a single MenuItemModifier entity.
+type MenuItemModifierUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *MenuItemModifierMutation
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_u *MenuItemModifierUpdateOne) SetUpdateTime(v time.Time) *MenuItemModifierUpdateOne {
+	_u.mutation.SetUpdateTime(v)
+	return _u
+}
+
+// SetDisplayOrder sets the "display_order" field.
+func (_u *MenuItemModifierUpdateOne) SetDisplayOrder(v int) *MenuItemModifierUpdateOne {
+	_u.mutation.ResetDisplayOrder()
+	_u.mutation.SetDisplayOrder(v)
+	return _u
+}
+
+// SetNillableDisplayOrder sets the "display_order" field if the given value is not nil.
+func (_u *MenuItemModifierUpdateOne) SetNillableDisplayOrder(v *int) *MenuItemModifierUpdateOne {
+	if v != nil {
+		_u.SetDisplayOrder(*v)
+	}
+	return _u
+}
+
+// AddDisplayOrder adds value to the "display_order" field.
+func (_u *MenuItemModifierUpdateOne) AddDisplayOrder(v int) *MenuItemModifierUpdateOne {
+	_u.mutation.AddDisplayOrder(v)
+	return _u
+}
+
+// SetRequired sets the "required" field.
+func (_u *MenuItemModifierUpdateOne) SetRequired(v bool) *MenuItemModifierUpdateOne {
+	_u.mutation.SetRequired(v)
+	return _u
+}
+
+// SetNillableRequired sets the "required" field if the given value is not nil.
+func (_u *MenuItemModifierUpdateOne) SetNillableRequired(v *bool) *MenuItemModifierUpdateOne {
+	if v != nil {
+		_u.SetRequired(*v)
+	}
+	return _u
+}
+
+// ClearRequired clears the value of the "required" field.
+func (_u *MenuItemModifierUpdateOne) ClearRequired() *MenuItemModifierUpdateOne {
+	_u.mutation.ClearRequired()
+	return _u
+}
+
+// SetMax sets the "max" field.
+func (_u *MenuItemModifierUpdateOne) SetMax(v int) *MenuItemModifierUpdateOne {
+	_u.mutation.ResetMax()
+	_u.mutation.SetMax(v)
+	return _u
+}
+
+// SetNillableMax sets the "max" field if the given value is not nil.
+func (_u *MenuItemModifierUpdateOne) SetNillableMax(v *int) *MenuItemModifierUpdateOne {
+	if v != nil {
+		_u.SetMax(*v)
+	}
+	return _u
+}
+
+// AddMax adds value to the "max" field.
+func (_u *MenuItemModifierUpdateOne) AddMax(v int) *MenuItemModifierUpdateOne {
+	_u.mutation.AddMax(v)
+	return _u
+}
+
+// ClearMax clears the value of the "max" field.
+func (_u *MenuItemModifierUpdateOne) ClearMax() *MenuItemModifierUpdateOne {
+	_u.mutation.ClearMax()
+	return _u
+}
+
+// SetOptionPrices sets the "option_prices" field.
+func (_u *MenuItemModifierUpdateOne) SetOptionPrices(v map[uuid.UUID]int64) *MenuItemModifierUpdateOne {
+	_u.mutation.SetOptionPrices(v)
+	return _u
+}
+
+// ClearOptionPrices clears the value of the "option_prices" field.
+func (_u *MenuItemModifierUpdateOne) ClearOptionPrices() *MenuItemModifierUpdateOne {
+	_u.mutation.ClearOptionPrices()
+	return _u
+}
+
+// Mutation returns the MenuItemModifierMutation object of the builder.
+func (_u *MenuItemModifierUpdateOne) Mutation() *MenuItemModifierMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the MenuItemModifierUpdate builder.
+func (_u *MenuItemModifierUpdateOne) Where(ps ...predicate.MenuItemModifier) *MenuItemModifierUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *MenuItemModifierUpdateOne) Select(field string, fields ...string) *MenuItemModifierUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated MenuItemModifier entity.
+func (_u *MenuItemModifierUpdateOne) Save(ctx context.Context) (*MenuItemModifier, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *MenuItemModifierUpdateOne) SaveX(ctx context.Context) *MenuItemModifier {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *MenuItemModifierUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *MenuItemModifierUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *MenuItemModifierUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdateTime(); !ok {
+		v := menuitemmodifier.UpdateDefaultUpdateTime()
+		_u.mutation.SetUpdateTime(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *MenuItemModifierUpdateOne) check() error {
+	if v, ok := _u.mutation.DisplayOrder(); ok {
+		if err := menuitemmodifier.DisplayOrderValidator(v); err != nil {
+			return &ValidationError{Name: "display_order", err: fmt.Errorf(`ent: validator failed for field "MenuItemModifier.display_order": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Max(); ok {
+		if err := menuitemmodifier.MaxValidator(v); err != nil {
+			return &ValidationError{Name: "max", err: fmt.Errorf(`ent: validator failed for field "MenuItemModifier.max": %w`, err)}
+		}
+	}
+	if _u.mutation.MenuItemCleared() && len(_u.mutation.MenuItemIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "MenuItemModifier.menu_item"`)
+	}
+	if _u.mutation.ModifierCleared() && len(_u.mutation.ModifierIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "MenuItemModifier.modifier"`)
+	}
+	return nil
+}
+
+func (_u *MenuItemModifierUpdateOne) sqlSave(ctx context.Context) (_node *MenuItemModifier, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(menuitemmodifier.Table, menuitemmodifier.Columns, sqlgraph.NewFieldSpec(menuitemmodifier.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "MenuItemModifier.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, menuitemmodifier.FieldID)
+		for _, f := range fields {
+			if !menuitemmodifier.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != menuitemmodifier.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdateTime(); ok {
+		_spec.SetField(menuitemmodifier.FieldUpdateTime, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.DisplayOrder(); ok {
+		_spec.SetField(menuitemmodifier.FieldDisplayOrder, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedDisplayOrder(); ok {
+		_spec.AddField(menuitemmodifier.FieldDisplayOrder, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Required(); ok {
+		_spec.SetField(menuitemmodifier.FieldRequired, field.TypeBool, value)
+	}
+	if _u.mutation.RequiredCleared() {
+		_spec.ClearField(menuitemmodifier.FieldRequired, field.TypeBool)
+	}
+	if value, ok := _u.mutation.Max(); ok {
+		_spec.SetField(menuitemmodifier.FieldMax, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedMax(); ok {
+		_spec.AddField(menuitemmodifier.FieldMax, field.TypeInt, value)
+	}
+	if _u.mutation.MaxCleared() {
+		_spec.ClearField(menuitemmodifier.FieldMax, field.TypeInt)
+	}
+	if value, ok := _u.mutation.OptionPrices(); ok {
+		_spec.SetField(menuitemmodifier.FieldOptionPrices, field.TypeJSON, value)
+	}
+	if _u.mutation.OptionPricesCleared() {
+		_spec.ClearField(menuitemmodifier.FieldOptionPrices, field.TypeJSON)
+	}
+	_node = &MenuItemModifier{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{menuitemmodifier.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
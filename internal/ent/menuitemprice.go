@@ -0,0 +1,174 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/Jiruu246/rms/internal/ent/menu"
+	"github.com/Jiruu246/rms/internal/ent/menuitem"
+	"github.com/Jiruu246/rms/internal/ent/menuitemprice"
+	"github.com/google/uuid"
+)
+
+// MenuItemPrice is the model entity for the MenuItemPrice schema.
+type MenuItemPrice struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// Price of the item on the menu, in minor units of the restaurant currency
+	Price int64 `json:"price,omitempty"`
+	// ID of the menu the price applies on
+	MenuID uuid.UUID `json:"menu_id,omitempty"`
+	// ID of the menu item priced
+	MenuItemID int64 `json:"menu_item_id,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the MenuItemPriceQuery when eager-loading is set.
+	Edges        MenuItemPriceEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// MenuItemPriceEdges holds the relations/edges for other nodes in the graph.
+type MenuItemPriceEdges struct {
+	// Menu holds the value of the menu edge.
+	Menu *Menu `json:"menu,omitempty"`
+	// MenuItem holds the value of the menu_item edge.
+	MenuItem *MenuItem `json:"menu_item,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// MenuOrErr returns the Menu value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e MenuItemPriceEdges) MenuOrErr() (*Menu, error) {
+	if e.Menu != nil {
+		return e.Menu, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: menu.Label}
+	}
+	return nil, &NotLoadedError{edge: "menu"}
+}
+
+// MenuItemOrErr returns the MenuItem value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e MenuItemPriceEdges) MenuItemOrErr() (*MenuItem, error) {
+	if e.MenuItem != nil {
+		return e.MenuItem, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: menuitem.Label}
+	}
+	return nil, &NotLoadedError{edge: "menu_item"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*MenuItemPrice) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case menuitemprice.FieldPrice, menuitemprice.FieldMenuItemID:
+			values[i] = new(sql.NullInt64)
+		case menuitemprice.FieldID, menuitemprice.FieldMenuID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the MenuItemPrice fields.
+func (_m *MenuItemPrice) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case menuitemprice.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case menuitemprice.FieldPrice:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field price", values[i])
+			} else if value.Valid {
+				_m.Price = value.Int64
+			}
+		case menuitemprice.FieldMenuID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field menu_id", values[i])
+			} else if value != nil {
+				_m.MenuID = *value
+			}
+		case menuitemprice.FieldMenuItemID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field menu_item_id", values[i])
+			} else if value.Valid {
+				_m.MenuItemID = value.Int64
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the MenuItemPrice.
+// This includes values selected through modifiers, order, etc.
+func (_m *MenuItemPrice) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryMenu queries the "menu" edge of the MenuItemPrice entity.
+func (_m *MenuItemPrice) QueryMenu() *MenuQuery {
+	return NewMenuItemPriceClient(_m.config).QueryMenu(_m)
+}
+
+// QueryMenuItem queries the "menu_item" edge of the MenuItemPrice entity.
+func (_m *MenuItemPrice) QueryMenuItem() *MenuItemQuery {
+	return NewMenuItemPriceClient(_m.config).QueryMenuItem(_m)
+}
+
+// Update returns a builder for updating this MenuItemPrice.
+// Note that you need to call MenuItemPrice.Unwrap() before calling this method if this MenuItemPrice
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *MenuItemPrice) Update() *MenuItemPriceUpdateOne {
+	return NewMenuItemPriceClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the MenuItemPrice entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *MenuItemPrice) Unwrap() *MenuItemPrice {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: MenuItemPrice is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *MenuItemPrice) String() string {
+	var builder strings.Builder
+	builder.WriteString("MenuItemPrice(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("price=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Price))
+	builder.WriteString(", ")
+	builder.WriteString("menu_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.MenuID))
+	builder.WriteString(", ")
+	builder.WriteString("menu_item_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.MenuItemID))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// MenuItemPrices is a parsable slice of MenuItemPrice.
+type MenuItemPrices []*MenuItemPrice
@@ -0,0 +1,118 @@
+// Code generated by ent, DO NOT EDIT.
+
+package menuitemprice
+
+import (
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the menuitemprice type in the database.
+	Label = "menu_item_price"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldPrice holds the string denoting the price field in the database.
+	FieldPrice = "price"
+	// FieldMenuID holds the string denoting the menu_id field in the database.
+	FieldMenuID = "menu_id"
+	// FieldMenuItemID holds the string denoting the menu_item_id field in the database.
+	FieldMenuItemID = "menu_item_id"
+	// EdgeMenu holds the string denoting the menu edge name in mutations.
+	EdgeMenu = "menu"
+	// EdgeMenuItem holds the string denoting the menu_item edge name in mutations.
+	EdgeMenuItem = "menu_item"
+	// Table holds the table name of the menuitemprice in the database.
+	Table = "menu_item_prices"
+	// MenuTable is the table that holds the menu relation/edge.
+	MenuTable = "menu_item_prices"
+	// MenuInverseTable is the table name for the Menu entity.
+	// It exists in this package in order to avoid circular dependency with the "menu" package.
+	MenuInverseTable = "menus"
+	// MenuColumn is the table column denoting the menu relation/edge.
+	MenuColumn = "menu_id"
+	// MenuItemTable is the table that holds the menu_item relation/edge.
+	MenuItemTable = "menu_item_prices"
+	// MenuItemInverseTable is the table name for the MenuItem entity.
+	// It exists in this package in order to avoid circular dependency with the "menuitem" package.
+	MenuItemInverseTable = "menu_items"
+	// MenuItemColumn is the table column denoting the menu_item relation/edge.
+	MenuItemColumn = "menu_item_id"
+)
+
+// Columns holds all SQL columns for menuitemprice fields.
+var Columns = []string{
+	FieldID,
+	FieldPrice,
+	FieldMenuID,
+	FieldMenuItemID,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// PriceValidator is a validator for the "price" field. It is called by the builders before save.
+	PriceValidator func(int64) error
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// OrderOption defines the ordering options for the MenuItemPrice queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByPrice orders the results by the price field.
+func ByPrice(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPrice, opts...).ToFunc()
+}
+
+// ByMenuID orders the results by the menu_id field.
+func ByMenuID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMenuID, opts...).ToFunc()
+}
+
+// ByMenuItemID orders the results by the menu_item_id field.
+func ByMenuItemID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMenuItemID, opts...).ToFunc()
+}
+
+// ByMenuField orders the results by menu field.
+func ByMenuField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newMenuStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByMenuItemField orders the results by menu_item field.
+func ByMenuItemField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newMenuItemStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newMenuStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(MenuInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, MenuTable, MenuColumn),
+	)
+}
+func newMenuItemStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(MenuItemInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, MenuItemTable, MenuItemColumn),
+	)
+}
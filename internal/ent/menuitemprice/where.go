@@ -0,0 +1,211 @@
+// Code generated by ent, DO NOT EDIT.
+
+package menuitemprice
+
+import (
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/Jiruu246/rms/internal/ent/predicate"
+	"github.com/google/uuid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.MenuItemPrice {
+	return predicate.MenuItemPrice(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.MenuItemPrice {
+	return predicate.MenuItemPrice(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.MenuItemPrice {
+	return predicate.MenuItemPrice(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.MenuItemPrice {
+	return predicate.MenuItemPrice(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.MenuItemPrice {
+	return predicate.MenuItemPrice(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.MenuItemPrice {
+	return predicate.MenuItemPrice(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.MenuItemPrice {
+	return predicate.MenuItemPrice(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.MenuItemPrice {
+	return predicate.MenuItemPrice(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.MenuItemPrice {
+	return predicate.MenuItemPrice(sql.FieldLTE(FieldID, id))
+}
+
+// Price applies equality check predicate on the "price" field. It's identical to PriceEQ.
+func Price(v int64) predicate.MenuItemPrice {
+	return predicate.MenuItemPrice(sql.FieldEQ(FieldPrice, v))
+}
+
+// MenuID applies equality check predicate on the "menu_id" field. It's identical to MenuIDEQ.
+func MenuID(v uuid.UUID) predicate.MenuItemPrice {
+	return predicate.MenuItemPrice(sql.FieldEQ(FieldMenuID, v))
+}
+
+// MenuItemID applies equality check predicate on the "menu_item_id" field. It's identical to MenuItemIDEQ.
+func MenuItemID(v int64) predicate.MenuItemPrice {
+	return predicate.MenuItemPrice(sql.FieldEQ(FieldMenuItemID, v))
+}
+
+// PriceEQ applies the EQ predicate on the "price" field.
+func PriceEQ(v int64) predicate.MenuItemPrice {
+	return predicate.MenuItemPrice(sql.FieldEQ(FieldPrice, v))
+}
+
+// PriceNEQ applies the NEQ predicate on the "price" field.
+func PriceNEQ(v int64) predicate.MenuItemPrice {
+	return predicate.MenuItemPrice(sql.FieldNEQ(FieldPrice, v))
+}
+
+// PriceIn applies the In predicate on the "price" field.
+func PriceIn(vs ...int64) predicate.MenuItemPrice {
+	return predicate.MenuItemPrice(sql.FieldIn(FieldPrice, vs...))
+}
+
+// PriceNotIn applies the NotIn predicate on the "price" field.
+func PriceNotIn(vs ...int64) predicate.MenuItemPrice {
+	return predicate.MenuItemPrice(sql.FieldNotIn(FieldPrice, vs...))
+}
+
+// PriceGT applies the GT predicate on the "price" field.
+func PriceGT(v int64) predicate.MenuItemPrice {
+	return predicate.MenuItemPrice(sql.FieldGT(FieldPrice, v))
+}
+
+// PriceGTE applies the GTE predicate on the "price" field.
+func PriceGTE(v int64) predicate.MenuItemPrice {
+	return predicate.MenuItemPrice(sql.FieldGTE(FieldPrice, v))
+}
+
+// PriceLT applies the LT predicate on the "price" field.
+func PriceLT(v int64) predicate.MenuItemPrice {
+	return predicate.MenuItemPrice(sql.FieldLT(FieldPrice, v))
+}
+
+// PriceLTE applies the LTE predicate on the "price" field.
+func PriceLTE(v int64) predicate.MenuItemPrice {
+	return predicate.MenuItemPrice(sql.FieldLTE(FieldPrice, v))
+}
+
+// MenuIDEQ applies the EQ predicate on the "menu_id" field.
+func MenuIDEQ(v uuid.UUID) predicate.MenuItemPrice {
+	return predicate.MenuItemPrice(sql.FieldEQ(FieldMenuID, v))
+}
+
+// MenuIDNEQ applies the NEQ predicate on the "menu_id" field.
+func MenuIDNEQ(v uuid.UUID) predicate.MenuItemPrice {
+	return predicate.MenuItemPrice(sql.FieldNEQ(FieldMenuID, v))
+}
+
+// MenuIDIn applies the In predicate on the "menu_id" field.
+func MenuIDIn(vs ...uuid.UUID) predicate.MenuItemPrice {
+	return predicate.MenuItemPrice(sql.FieldIn(FieldMenuID, vs...))
+}
+
+// MenuIDNotIn applies the NotIn predicate on the "menu_id" field.
+func MenuIDNotIn(vs ...uuid.UUID) predicate.MenuItemPrice {
+	return predicate.MenuItemPrice(sql.FieldNotIn(FieldMenuID, vs...))
+}
+
+// MenuItemIDEQ applies the EQ predicate on the "menu_item_id" field.
+func MenuItemIDEQ(v int64) predicate.MenuItemPrice {
+	return predicate.MenuItemPrice(sql.FieldEQ(FieldMenuItemID, v))
+}
+
+// MenuItemIDNEQ applies the NEQ predicate on the "menu_item_id" field.
+func MenuItemIDNEQ(v int64) predicate.MenuItemPrice {
+	return predicate.MenuItemPrice(sql.FieldNEQ(FieldMenuItemID, v))
+}
+
+// MenuItemIDIn applies the In predicate on the "menu_item_id" field.
+func MenuItemIDIn(vs ...int64) predicate.MenuItemPrice {
+	return predicate.MenuItemPrice(sql.FieldIn(FieldMenuItemID, vs...))
+}
+
+// MenuItemIDNotIn applies the NotIn predicate on the "menu_item_id" field.
+func MenuItemIDNotIn(vs ...int64) predicate.MenuItemPrice {
+	return predicate.MenuItemPrice(sql.FieldNotIn(FieldMenuItemID, vs...))
+}
+
+// HasMenu applies the HasEdge predicate on the "menu" edge.
+func HasMenu() predicate.MenuItemPrice {
+	return predicate.MenuItemPrice(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, MenuTable, MenuColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasMenuWith applies the HasEdge predicate on the "menu" edge with a given conditions (other predicates).
+func HasMenuWith(preds ...predicate.Menu) predicate.MenuItemPrice {
+	return predicate.MenuItemPrice(func(s *sql.Selector) {
+		step := newMenuStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasMenuItem applies the HasEdge predicate on the "menu_item" edge.
+func HasMenuItem() predicate.MenuItemPrice {
+	return predicate.MenuItemPrice(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, MenuItemTable, MenuItemColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasMenuItemWith applies the HasEdge predicate on the "menu_item" edge with a given conditions (other predicates).
+func HasMenuItemWith(preds ...predicate.MenuItem) predicate.MenuItemPrice {
+	return predicate.MenuItemPrice(func(s *sql.Selector) {
+		step := newMenuItemStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.MenuItemPrice) predicate.MenuItemPrice {
+	return predicate.MenuItemPrice(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.MenuItemPrice) predicate.MenuItemPrice {
+	return predicate.MenuItemPrice(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.MenuItemPrice) predicate.MenuItemPrice {
+	return predicate.MenuItemPrice(sql.NotPredicates(p))
+}
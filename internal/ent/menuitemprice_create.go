@@ -0,0 +1,288 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Jiruu246/rms/internal/ent/menu"
+	"github.com/Jiruu246/rms/internal/ent/menuitem"
+	"github.com/Jiruu246/rms/internal/ent/menuitemprice"
+	"github.com/google/uuid"
+)
+
+// MenuItemPriceCreate is the builder for creating a MenuItemPrice entity.
+type MenuItemPriceCreate struct {
+	config
+	mutation *MenuItemPriceMutation
+	hooks    []Hook
+}
+
+// SetPrice sets the "price" field.
+func (_c *MenuItemPriceCreate) SetPrice(v int64) *MenuItemPriceCreate {
+	_c.mutation.SetPrice(v)
+	return _c
+}
+
+// SetMenuID sets the "menu_id" field.
+func (_c *MenuItemPriceCreate) SetMenuID(v uuid.UUID) *MenuItemPriceCreate {
+	_c.mutation.SetMenuID(v)
+	return _c
+}
+
+// SetMenuItemID sets the "menu_item_id" field.
+func (_c *MenuItemPriceCreate) SetMenuItemID(v int64) *MenuItemPriceCreate {
+	_c.mutation.SetMenuItemID(v)
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *MenuItemPriceCreate) SetID(v uuid.UUID) *MenuItemPriceCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *MenuItemPriceCreate) SetNillableID(v *uuid.UUID) *MenuItemPriceCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetMenu sets the "menu" edge to the Menu entity.
+func (_c *MenuItemPriceCreate) SetMenu(v *Menu) *MenuItemPriceCreate {
+	return _c.SetMenuID(v.ID)
+}
+
+// SetMenuItem sets the "menu_item" edge to the MenuItem entity.
+func (_c *MenuItemPriceCreate) SetMenuItem(v *MenuItem) *MenuItemPriceCreate {
+	return _c.SetMenuItemID(v.ID)
+}
+
+// Mutation returns the MenuItemPriceMutation object of the builder.
+func (_c *MenuItemPriceCreate) Mutation() *MenuItemPriceMutation {
+	return _c.mutation
+}
+
+// Save creates the MenuItemPrice in the database.
+func (_c *MenuItemPriceCreate) Save(ctx context.Context) (*MenuItemPrice, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *MenuItemPriceCreate) SaveX(ctx context.Context) *MenuItemPrice {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *MenuItemPriceCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *MenuItemPriceCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *MenuItemPriceCreate) defaults() {
+	if _, ok := _c.mutation.ID(); !ok {
+		v := menuitemprice.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *MenuItemPriceCreate) check() error {
+	if _, ok := _c.mutation.Price(); !ok {
+		return &ValidationError{Name: "price", err: errors.New(`ent: missing required field "MenuItemPrice.price"`)}
+	}
+	if v, ok := _c.mutation.Price(); ok {
+		if err := menuitemprice.PriceValidator(v); err != nil {
+			return &ValidationError{Name: "price", err: fmt.Errorf(`ent: validator failed for field "MenuItemPrice.price": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.MenuID(); !ok {
+		return &ValidationError{Name: "menu_id", err: errors.New(`ent: missing required field "MenuItemPrice.menu_id"`)}
+	}
+	if _, ok := _c.mutation.MenuItemID(); !ok {
+		return &ValidationError{Name: "menu_item_id", err: errors.New(`ent: missing required field "MenuItemPrice.menu_item_id"`)}
+	}
+	if len(_c.mutation.MenuIDs()) == 0 {
+		return &ValidationError{Name: "menu", err: errors.New(`ent: missing required edge "MenuItemPrice.menu"`)}
+	}
+	if len(_c.mutation.MenuItemIDs()) == 0 {
+		return &ValidationError{Name: "menu_item", err: errors.New(`ent: missing required edge "MenuItemPrice.menu_item"`)}
+	}
+	return nil
+}
+
+func (_c *MenuItemPriceCreate) sqlSave(ctx context.Context) (*MenuItemPrice, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *MenuItemPriceCreate) createSpec() (*MenuItemPrice, *sqlgraph.CreateSpec) {
+	var (
+		_node = &MenuItemPrice{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(menuitemprice.Table, sqlgraph.NewFieldSpec(menuitemprice.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.Price(); ok {
+		_spec.SetField(menuitemprice.FieldPrice, field.TypeInt64, value)
+		_node.Price = value
+	}
+	if nodes := _c.mutation.MenuIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   menuitemprice.MenuTable,
+			Columns: []string{menuitemprice.MenuColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(menu.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.MenuID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.MenuItemIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   menuitemprice.MenuItemTable,
+			Columns: []string{menuitemprice.MenuItemColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(menuitem.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.MenuItemID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// MenuItemPriceCreateBulk is the builder for creating many MenuItemPrice entities in bulk.
+type MenuItemPriceCreateBulk struct {
+	config
+	err      error
+	builders []*MenuItemPriceCreate
+}
+
+// Save creates the MenuItemPrice entities in the database.
+func (_c *MenuItemPriceCreateBulk) Save(ctx context.Context) ([]*MenuItemPrice, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*MenuItemPrice, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*MenuItemPriceMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *MenuItemPriceCreateBulk) SaveX(ctx context.Context) []*MenuItemPrice {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *MenuItemPriceCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *MenuItemPriceCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,267 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Jiruu246/rms/internal/ent/menuitemprice"
+	"github.com/Jiruu246/rms/internal/ent/predicate"
+)
+
+// MenuItemPriceUpdate is the builder for updating MenuItemPrice entities.
+type MenuItemPriceUpdate struct {
+	config
+	hooks    []Hook
+	mutation *MenuItemPriceMutation
+}
+
+// Where appends a list predicates to the MenuItemPriceUpdate builder.
+func (_u *MenuItemPriceUpdate) Where(ps ...predicate.MenuItemPrice) *MenuItemPriceUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetPrice sets the "price" field.
+func (_u *MenuItemPriceUpdate) SetPrice(v int64) *MenuItemPriceUpdate {
+	_u.mutation.ResetPrice()
+	_u.mutation.SetPrice(v)
+	return _u
+}
+
+// SetNillablePrice sets the "price" field if the given value is not nil.
+func (_u *MenuItemPriceUpdate) SetNillablePrice(v *int64) *MenuItemPriceUpdate {
+	if v != nil {
+		_u.SetPrice(*v)
+	}
+	return _u
+}
+
+// AddPrice adds value to the "price" field.
+func (_u *MenuItemPriceUpdate) AddPrice(v int64) *MenuItemPriceUpdate {
+	_u.mutation.AddPrice(v)
+	return _u
+}
+
+// Mutation returns the MenuItemPriceMutation object of the builder.
+func (_u *MenuItemPriceUpdate) Mutation() *MenuItemPriceMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *MenuItemPriceUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *MenuItemPriceUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *MenuItemPriceUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *MenuItemPriceUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *MenuItemPriceUpdate) check() error {
+	if v, ok := _u.mutation.Price(); ok {
+		if err := menuitemprice.PriceValidator(v); err != nil {
+			return &ValidationError{Name: "price", err: fmt.Errorf(`ent: validator failed for field "MenuItemPrice.price": %w`, err)}
+		}
+	}
+	if _u.mutation.MenuCleared() && len(_u.mutation.MenuIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "MenuItemPrice.menu"`)
+	}
+	if _u.mutation.MenuItemCleared() && len(_u.mutation.MenuItemIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "MenuItemPrice.menu_item"`)
+	}
+	return nil
+}
+
+func (_u *MenuItemPriceUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(menuitemprice.Table, menuitemprice.Columns, sqlgraph.NewFieldSpec(menuitemprice.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Price(); ok {
+		_spec.SetField(menuitemprice.FieldPrice, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedPrice(); ok {
+		_spec.AddField(menuitemprice.FieldPrice, field.TypeInt64, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{menuitemprice.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// MenuItemPriceUpdateOne is the builder for updating a single MenuItemPrice entity.
+type MenuItemPriceUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *MenuItemPriceMutation
+}
+
+// SetPrice sets the "price" field.
+func (_u *MenuItemPriceUpdateOne) SetPrice(v int64) *MenuItemPriceUpdateOne {
+	_u.mutation.ResetPrice()
+	_u.mutation.SetPrice(v)
+	return _u
+}
+
+// SetNillablePrice sets the "price" field if the given value is not nil.
+func (_u *MenuItemPriceUpdateOne) SetNillablePrice(v *int64) *MenuItemPriceUpdateOne {
+	if v != nil {
+		_u.SetPrice(*v)
+	}
+	return _u
+}
+
+// AddPrice adds value to the "price" field.
+func (_u *MenuItemPriceUpdateOne) AddPrice(v int64) *MenuItemPriceUpdateOne {
+	_u.mutation.AddPrice(v)
+	return _u
+}
+
+// Mutation returns the MenuItemPriceMutation object of the builder.
+func (_u *MenuItemPriceUpdateOne) Mutation() *MenuItemPriceMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the MenuItemPriceUpdate builder.
+func (_u *MenuItemPriceUpdateOne) Where(ps ...predicate.MenuItemPrice) *MenuItemPriceUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *MenuItemPriceUpdateOne) Select(field string, fields ...string) *MenuItemPriceUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated MenuItemPrice entity.
+func (_u *MenuItemPriceUpdateOne) Save(ctx context.Context) (*MenuItemPrice, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *MenuItemPriceUpdateOne) SaveX(ctx context.Context) *MenuItemPrice {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *MenuItemPriceUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *MenuItemPriceUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *MenuItemPriceUpdateOne) check() error {
+	if v, ok := _u.mutation.Price(); ok {
+		if err := menuitemprice.PriceValidator(v); err != nil {
+			return &ValidationError{Name: "price", err: fmt.Errorf(`ent: validator failed for field "MenuItemPrice.price": %w`, err)}
+		}
+	}
+	if _u.mutation.MenuCleared() && len(_u.mutation.MenuIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "MenuItemPrice.menu"`)
+	}
+	if _u.mutation.MenuItemCleared() && len(_u.mutation.MenuItemIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "MenuItemPrice.menu_item"`)
+	}
+	return nil
+}
+
+func (_u *MenuItemPriceUpdateOne) sqlSave(ctx context.Context) (_node *MenuItemPrice, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(menuitemprice.Table, menuitemprice.Columns, sqlgraph.NewFieldSpec(menuitemprice.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "MenuItemPrice.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, menuitemprice.FieldID)
+		for _, f := range fields {
+			if !menuitemprice.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != menuitemprice.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Price(); ok {
+		_spec.SetField(menuitemprice.FieldPrice, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedPrice(); ok {
+		_spec.AddField(menuitemprice.FieldPrice, field.TypeInt64, value)
+	}
+	_node = &MenuItemPrice{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{menuitemprice.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,222 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/Jiruu246/rms/internal/ent/menuitem"
+	"github.com/Jiruu246/rms/internal/ent/menuitemvariant"
+	"github.com/google/uuid"
+)
+
+// MenuItemVariant is the model entity for the MenuItemVariant schema.
+type MenuItemVariant struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// UpdateTime holds the value of the "update_time" field.
+	UpdateTime time.Time `json:"update_time,omitempty"`
+	// Variant name, e.g. Large; unique within the menu item
+	Name string `json:"name,omitempty"`
+	// Price of the variant in minor units of the restaurant currency; replaces the item's price
+	Price int64 `json:"price,omitempty"`
+	// Stock keeping unit code of the variant, for reporting
+	Sku string `json:"sku,omitempty"`
+	// Whether the variant can be ordered
+	IsAvailable bool `json:"is_available,omitempty"`
+	// Display order among the item's variants
+	DisplayOrder int `json:"display_order,omitempty"`
+	// ID of the menu item this is a variant of
+	MenuItemID int64 `json:"menu_item_id,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the MenuItemVariantQuery when eager-loading is set.
+	Edges        MenuItemVariantEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// MenuItemVariantEdges holds the relations/edges for other nodes in the graph.
+type MenuItemVariantEdges struct {
+	// MenuItem holds the value of the menu_item edge.
+	MenuItem *MenuItem `json:"menu_item,omitempty"`
+	// OrderItems holds the value of the order_items edge.
+	OrderItems []*OrderItem `json:"order_items,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// MenuItemOrErr returns the MenuItem value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e MenuItemVariantEdges) MenuItemOrErr() (*MenuItem, error) {
+	if e.MenuItem != nil {
+		return e.MenuItem, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: menuitem.Label}
+	}
+	return nil, &NotLoadedError{edge: "menu_item"}
+}
+
+// OrderItemsOrErr returns the OrderItems value or an error if the edge
+// was not loaded in eager-loading.
+func (e MenuItemVariantEdges) OrderItemsOrErr() ([]*OrderItem, error) {
+	if e.loadedTypes[1] {
+		return e.OrderItems, nil
+	}
+	return nil, &NotLoadedError{edge: "order_items"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*MenuItemVariant) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case menuitemvariant.FieldIsAvailable:
+			values[i] = new(sql.NullBool)
+		case menuitemvariant.FieldPrice, menuitemvariant.FieldDisplayOrder, menuitemvariant.FieldMenuItemID:
+			values[i] = new(sql.NullInt64)
+		case menuitemvariant.FieldName, menuitemvariant.FieldSku:
+			values[i] = new(sql.NullString)
+		case menuitemvariant.FieldUpdateTime:
+			values[i] = new(sql.NullTime)
+		case menuitemvariant.FieldID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the MenuItemVariant fields.
+func (_m *MenuItemVariant) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case menuitemvariant.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case menuitemvariant.FieldUpdateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field update_time", values[i])
+			} else if value.Valid {
+				_m.UpdateTime = value.Time
+			}
+		case menuitemvariant.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				_m.Name = value.String
+			}
+		case menuitemvariant.FieldPrice:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field price", values[i])
+			} else if value.Valid {
+				_m.Price = value.Int64
+			}
+		case menuitemvariant.FieldSku:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field sku", values[i])
+			} else if value.Valid {
+				_m.Sku = value.String
+			}
+		case menuitemvariant.FieldIsAvailable:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field is_available", values[i])
+			} else if value.Valid {
+				_m.IsAvailable = value.Bool
+			}
+		case menuitemvariant.FieldDisplayOrder:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field display_order", values[i])
+			} else if value.Valid {
+				_m.DisplayOrder = int(value.Int64)
+			}
+		case menuitemvariant.FieldMenuItemID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field menu_item_id", values[i])
+			} else if value.Valid {
+				_m.MenuItemID = value.Int64
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the MenuItemVariant.
+// This includes values selected through modifiers, order, etc.
+func (_m *MenuItemVariant) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryMenuItem queries the "menu_item" edge of the MenuItemVariant entity.
+func (_m *MenuItemVariant) QueryMenuItem() *MenuItemQuery {
+	return NewMenuItemVariantClient(_m.config).QueryMenuItem(_m)
+}
+
+// QueryOrderItems queries the "order_items" edge of the MenuItemVariant entity.
+func (_m *MenuItemVariant) QueryOrderItems() *OrderItemQuery {
+	return NewMenuItemVariantClient(_m.config).QueryOrderItems(_m)
+}
+
+// Update returns a builder for updating this MenuItemVariant.
+// Note that you need to call MenuItemVariant.Unwrap() before calling this method if this MenuItemVariant
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *MenuItemVariant) Update() *MenuItemVariantUpdateOne {
+	return NewMenuItemVariantClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the MenuItemVariant entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *MenuItemVariant) Unwrap() *MenuItemVariant {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: MenuItemVariant is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *MenuItemVariant) String() string {
+	var builder strings.Builder
+	builder.WriteString("MenuItemVariant(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("update_time=")
+	builder.WriteString(_m.UpdateTime.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(_m.Name)
+	builder.WriteString(", ")
+	builder.WriteString("price=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Price))
+	builder.WriteString(", ")
+	builder.WriteString("sku=")
+	builder.WriteString(_m.Sku)
+	builder.WriteString(", ")
+	builder.WriteString("is_available=")
+	builder.WriteString(fmt.Sprintf("%v", _m.IsAvailable))
+	builder.WriteString(", ")
+	builder.WriteString("display_order=")
+	builder.WriteString(fmt.Sprintf("%v", _m.DisplayOrder))
+	builder.WriteString(", ")
+	builder.WriteString("menu_item_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.MenuItemID))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// MenuItemVariants is a parsable slice of MenuItemVariant.
+type MenuItemVariants []*MenuItemVariant
@@ -0,0 +1,433 @@
+// Code generated by ent, DO NOT EDIT.
+
+package menuitemvariant
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/Jiruu246/rms/internal/ent/predicate"
+	"github.com/google/uuid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.MenuItemVariant {
+	return predicate.MenuItemVariant(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.MenuItemVariant {
+	return predicate.MenuItemVariant(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.MenuItemVariant {
+	return predicate.MenuItemVariant(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.MenuItemVariant {
+	return predicate.MenuItemVariant(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.MenuItemVariant {
+	return predicate.MenuItemVariant(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.MenuItemVariant {
+	return predicate.MenuItemVariant(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.MenuItemVariant {
+	return predicate.MenuItemVariant(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.MenuItemVariant {
+	return predicate.MenuItemVariant(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.MenuItemVariant {
+	return predicate.MenuItemVariant(sql.FieldLTE(FieldID, id))
+}
+
+// UpdateTime applies equality check predicate on the "update_time" field. It's identical to UpdateTimeEQ.
+func UpdateTime(v time.Time) predicate.MenuItemVariant {
+	return predicate.MenuItemVariant(sql.FieldEQ(FieldUpdateTime, v))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.MenuItemVariant {
+	return predicate.MenuItemVariant(sql.FieldEQ(FieldName, v))
+}
+
+// Price applies equality check predicate on the "price" field. It's identical to PriceEQ.
+func Price(v int64) predicate.MenuItemVariant {
+	return predicate.MenuItemVariant(sql.FieldEQ(FieldPrice, v))
+}
+
+// Sku applies equality check predicate on the "sku" field. It's identical to SkuEQ.
+func Sku(v string) predicate.MenuItemVariant {
+	return predicate.MenuItemVariant(sql.FieldEQ(FieldSku, v))
+}
+
+// IsAvailable applies equality check predicate on the "is_available" field. It's identical to IsAvailableEQ.
+func IsAvailable(v bool) predicate.MenuItemVariant {
+	return predicate.MenuItemVariant(sql.FieldEQ(FieldIsAvailable, v))
+}
+
+// DisplayOrder applies equality check predicate on the "display_order" field. It's identical to DisplayOrderEQ.
+func DisplayOrder(v int) predicate.MenuItemVariant {
+	return predicate.MenuItemVariant(sql.FieldEQ(FieldDisplayOrder, v))
+}
+
+// MenuItemID applies equality check predicate on the "menu_item_id" field. It's identical to MenuItemIDEQ.
+func MenuItemID(v int64) predicate.MenuItemVariant {
+	return predicate.MenuItemVariant(sql.FieldEQ(FieldMenuItemID, v))
+}
+
+// UpdateTimeEQ applies the EQ predicate on the "update_time" field.
+func UpdateTimeEQ(v time.Time) predicate.MenuItemVariant {
+	return predicate.MenuItemVariant(sql.FieldEQ(FieldUpdateTime, v))
+}
+
+// UpdateTimeNEQ applies the NEQ predicate on the "update_time" field.
+func UpdateTimeNEQ(v time.Time) predicate.MenuItemVariant {
+	return predicate.MenuItemVariant(sql.FieldNEQ(FieldUpdateTime, v))
+}
+
+// UpdateTimeIn applies the In predicate on the "update_time" field.
+func UpdateTimeIn(vs ...time.Time) predicate.MenuItemVariant {
+	return predicate.MenuItemVariant(sql.FieldIn(FieldUpdateTime, vs...))
+}
+
+// UpdateTimeNotIn applies the NotIn predicate on the "update_time" field.
+func UpdateTimeNotIn(vs ...time.Time) predicate.MenuItemVariant {
+	return predicate.MenuItemVariant(sql.FieldNotIn(FieldUpdateTime, vs...))
+}
+
+// UpdateTimeGT applies the GT predicate on the "update_time" field.
+func UpdateTimeGT(v time.Time) predicate.MenuItemVariant {
+	return predicate.MenuItemVariant(sql.FieldGT(FieldUpdateTime, v))
+}
+
+// UpdateTimeGTE applies the GTE predicate on the "update_time" field.
+func UpdateTimeGTE(v time.Time) predicate.MenuItemVariant {
+	return predicate.MenuItemVariant(sql.FieldGTE(FieldUpdateTime, v))
+}
+
+// UpdateTimeLT applies the LT predicate on the "update_time" field.
+func UpdateTimeLT(v time.Time) predicate.MenuItemVariant {
+	return predicate.MenuItemVariant(sql.FieldLT(FieldUpdateTime, v))
+}
+
+// UpdateTimeLTE applies the LTE predicate on the "update_time" field.
+func UpdateTimeLTE(v time.Time) predicate.MenuItemVariant {
+	return predicate.MenuItemVariant(sql.FieldLTE(FieldUpdateTime, v))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.MenuItemVariant {
+	return predicate.MenuItemVariant(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.MenuItemVariant {
+	return predicate.MenuItemVariant(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.MenuItemVariant {
+	return predicate.MenuItemVariant(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.MenuItemVariant {
+	return predicate.MenuItemVariant(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.MenuItemVariant {
+	return predicate.MenuItemVariant(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.MenuItemVariant {
+	return predicate.MenuItemVariant(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.MenuItemVariant {
+	return predicate.MenuItemVariant(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.MenuItemVariant {
+	return predicate.MenuItemVariant(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.MenuItemVariant {
+	return predicate.MenuItemVariant(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.MenuItemVariant {
+	return predicate.MenuItemVariant(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.MenuItemVariant {
+	return predicate.MenuItemVariant(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.MenuItemVariant {
+	return predicate.MenuItemVariant(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.MenuItemVariant {
+	return predicate.MenuItemVariant(sql.FieldContainsFold(FieldName, v))
+}
+
+// PriceEQ applies the EQ predicate on the "price" field.
+func PriceEQ(v int64) predicate.MenuItemVariant {
+	return predicate.MenuItemVariant(sql.FieldEQ(FieldPrice, v))
+}
+
+// PriceNEQ applies the NEQ predicate on the "price" field.
+func PriceNEQ(v int64) predicate.MenuItemVariant {
+	return predicate.MenuItemVariant(sql.FieldNEQ(FieldPrice, v))
+}
+
+// PriceIn applies the In predicate on the "price" field.
+func PriceIn(vs ...int64) predicate.MenuItemVariant {
+	return predicate.MenuItemVariant(sql.FieldIn(FieldPrice, vs...))
+}
+
+// PriceNotIn applies the NotIn predicate on the "price" field.
+func PriceNotIn(vs ...int64) predicate.MenuItemVariant {
+	return predicate.MenuItemVariant(sql.FieldNotIn(FieldPrice, vs...))
+}
+
+// PriceGT applies the GT predicate on the "price" field.
+func PriceGT(v int64) predicate.MenuItemVariant {
+	return predicate.MenuItemVariant(sql.FieldGT(FieldPrice, v))
+}
+
+// PriceGTE applies the GTE predicate on the "price" field.
+func PriceGTE(v int64) predicate.MenuItemVariant {
+	return predicate.MenuItemVariant(sql.FieldGTE(FieldPrice, v))
+}
+
+// PriceLT applies the LT predicate on the "price" field.
+func PriceLT(v int64) predicate.MenuItemVariant {
+	return predicate.MenuItemVariant(sql.FieldLT(FieldPrice, v))
+}
+
+// PriceLTE applies the LTE predicate on the "price" field.
+func PriceLTE(v int64) predicate.MenuItemVariant {
+	return predicate.MenuItemVariant(sql.FieldLTE(FieldPrice, v))
+}
+
+// SkuEQ applies the EQ predicate on the "sku" field.
+func SkuEQ(v string) predicate.MenuItemVariant {
+	return predicate.MenuItemVariant(sql.FieldEQ(FieldSku, v))
+}
+
+// SkuNEQ applies the NEQ predicate on the "sku" field.
+func SkuNEQ(v string) predicate.MenuItemVariant {
+	return predicate.MenuItemVariant(sql.FieldNEQ(FieldSku, v))
+}
+
+// SkuIn applies the In predicate on the "sku" field.
+func SkuIn(vs ...string) predicate.MenuItemVariant {
+	return predicate.MenuItemVariant(sql.FieldIn(FieldSku, vs...))
+}
+
+// SkuNotIn applies the NotIn predicate on the "sku" field.
+func SkuNotIn(vs ...string) predicate.MenuItemVariant {
+	return predicate.MenuItemVariant(sql.FieldNotIn(FieldSku, vs...))
+}
+
+// SkuGT applies the GT predicate on the "sku" field.
+func SkuGT(v string) predicate.MenuItemVariant {
+	return predicate.MenuItemVariant(sql.FieldGT(FieldSku, v))
+}
+
+// SkuGTE applies the GTE predicate on the "sku" field.
+func SkuGTE(v string) predicate.MenuItemVariant {
+	return predicate.MenuItemVariant(sql.FieldGTE(FieldSku, v))
+}
+
+// SkuLT applies the LT predicate on the "sku" field.
+func SkuLT(v string) predicate.MenuItemVariant {
+	return predicate.MenuItemVariant(sql.FieldLT(FieldSku, v))
+}
+
+// SkuLTE applies the LTE predicate on the "sku" field.
+func SkuLTE(v string) predicate.MenuItemVariant {
+	return predicate.MenuItemVariant(sql.FieldLTE(FieldSku, v))
+}
+
+// SkuContains applies the Contains predicate on the "sku" field.
+func SkuContains(v string) predicate.MenuItemVariant {
+	return predicate.MenuItemVariant(sql.FieldContains(FieldSku, v))
+}
+
+// SkuHasPrefix applies the HasPrefix predicate on the "sku" field.
+func SkuHasPrefix(v string) predicate.MenuItemVariant {
+	return predicate.MenuItemVariant(sql.FieldHasPrefix(FieldSku, v))
+}
+
+// SkuHasSuffix applies the HasSuffix predicate on the "sku" field.
+func SkuHasSuffix(v string) predicate.MenuItemVariant {
+	return predicate.MenuItemVariant(sql.FieldHasSuffix(FieldSku, v))
+}
+
+// SkuEqualFold applies the EqualFold predicate on the "sku" field.
+func SkuEqualFold(v string) predicate.MenuItemVariant {
+	return predicate.MenuItemVariant(sql.FieldEqualFold(FieldSku, v))
+}
+
+// SkuContainsFold applies the ContainsFold predicate on the "sku" field.
+func SkuContainsFold(v string) predicate.MenuItemVariant {
+	return predicate.MenuItemVariant(sql.FieldContainsFold(FieldSku, v))
+}
+
+// IsAvailableEQ applies the EQ predicate on the "is_available" field.
+func IsAvailableEQ(v bool) predicate.MenuItemVariant {
+	return predicate.MenuItemVariant(sql.FieldEQ(FieldIsAvailable, v))
+}
+
+// IsAvailableNEQ applies the NEQ predicate on the "is_available" field.
+func IsAvailableNEQ(v bool) predicate.MenuItemVariant {
+	return predicate.MenuItemVariant(sql.FieldNEQ(FieldIsAvailable, v))
+}
+
+// DisplayOrderEQ applies the EQ predicate on the "display_order" field.
+func DisplayOrderEQ(v int) predicate.MenuItemVariant {
+	return predicate.MenuItemVariant(sql.FieldEQ(FieldDisplayOrder, v))
+}
+
+// DisplayOrderNEQ applies the NEQ predicate on the "display_order" field.
+func DisplayOrderNEQ(v int) predicate.MenuItemVariant {
+	return predicate.MenuItemVariant(sql.FieldNEQ(FieldDisplayOrder, v))
+}
+
+// DisplayOrderIn applies the In predicate on the "display_order" field.
+func DisplayOrderIn(vs ...int) predicate.MenuItemVariant {
+	return predicate.MenuItemVariant(sql.FieldIn(FieldDisplayOrder, vs...))
+}
+
+// DisplayOrderNotIn applies the NotIn predicate on the "display_order" field.
+func DisplayOrderNotIn(vs ...int) predicate.MenuItemVariant {
+	return predicate.MenuItemVariant(sql.FieldNotIn(FieldDisplayOrder, vs...))
+}
+
+// DisplayOrderGT applies the GT predicate on the "display_order" field.
+func DisplayOrderGT(v int) predicate.MenuItemVariant {
+	return predicate.MenuItemVariant(sql.FieldGT(FieldDisplayOrder, v))
+}
+
+// DisplayOrderGTE applies the GTE predicate on the "display_order" field.
+func DisplayOrderGTE(v int) predicate.MenuItemVariant {
+	return predicate.MenuItemVariant(sql.FieldGTE(FieldDisplayOrder, v))
+}
+
+// DisplayOrderLT applies the LT predicate on the "display_order" field.
+func DisplayOrderLT(v int) predicate.MenuItemVariant {
+	return predicate.MenuItemVariant(sql.FieldLT(FieldDisplayOrder, v))
+}
+
+// DisplayOrderLTE applies the LTE predicate on the "display_order" field.
+func DisplayOrderLTE(v int) predicate.MenuItemVariant {
+	return predicate.MenuItemVariant(sql.FieldLTE(FieldDisplayOrder, v))
+}
+
+// MenuItemIDEQ applies the EQ predicate on the "menu_item_id" field.
+func MenuItemIDEQ(v int64) predicate.MenuItemVariant {
+	return predicate.MenuItemVariant(sql.FieldEQ(FieldMenuItemID, v))
+}
+
+// MenuItemIDNEQ applies the NEQ predicate on the "menu_item_id" field.
+func MenuItemIDNEQ(v int64) predicate.MenuItemVariant {
+	return predicate.MenuItemVariant(sql.FieldNEQ(FieldMenuItemID, v))
+}
+
+// MenuItemIDIn applies the In predicate on the "menu_item_id" field.
+func MenuItemIDIn(vs ...int64) predicate.MenuItemVariant {
+	return predicate.MenuItemVariant(sql.FieldIn(FieldMenuItemID, vs...))
+}
+
+// MenuItemIDNotIn applies the NotIn predicate on the "menu_item_id" field.
+func MenuItemIDNotIn(vs ...int64) predicate.MenuItemVariant {
+	return predicate.MenuItemVariant(sql.FieldNotIn(FieldMenuItemID, vs...))
+}
+
+// HasMenuItem applies the HasEdge predicate on the "menu_item" edge.
+func HasMenuItem() predicate.MenuItemVariant {
+	return predicate.MenuItemVariant(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, MenuItemTable, MenuItemColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasMenuItemWith applies the HasEdge predicate on the "menu_item" edge with a given conditions (other predicates).
+func HasMenuItemWith(preds ...predicate.MenuItem) predicate.MenuItemVariant {
+	return predicate.MenuItemVariant(func(s *sql.Selector) {
+		step := newMenuItemStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasOrderItems applies the HasEdge predicate on the "order_items" edge.
+func HasOrderItems() predicate.MenuItemVariant {
+	return predicate.MenuItemVariant(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, OrderItemsTable, OrderItemsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasOrderItemsWith applies the HasEdge predicate on the "order_items" edge with a given conditions (other predicates).
+func HasOrderItemsWith(preds ...predicate.OrderItem) predicate.MenuItemVariant {
+	return predicate.MenuItemVariant(func(s *sql.Selector) {
+		step := newOrderItemsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.MenuItemVariant) predicate.MenuItemVariant {
+	return predicate.MenuItemVariant(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.MenuItemVariant) predicate.MenuItemVariant {
+	return predicate.MenuItemVariant(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.MenuItemVariant) predicate.MenuItemVariant {
+	return predicate.MenuItemVariant(sql.NotPredicates(p))
+}
@@ -0,0 +1,414 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Jiruu246/rms/internal/ent/menuitem"
+	"github.com/Jiruu246/rms/internal/ent/menuitemvariant"
+	"github.com/Jiruu246/rms/internal/ent/orderitem"
+	"github.com/google/uuid"
+)
+
+// MenuItemVariantCreate is the builder for creating a MenuItemVariant entity.
+type MenuItemVariantCreate struct {
+	config
+	mutation *MenuItemVariantMutation
+	hooks    []Hook
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_c *MenuItemVariantCreate) SetUpdateTime(v time.Time) *MenuItemVariantCreate {
+	_c.mutation.SetUpdateTime(v)
+	return _c
+}
+
+// SetNillableUpdateTime sets the "update_time" field if the given value is not nil.
+func (_c *MenuItemVariantCreate) SetNillableUpdateTime(v *time.Time) *MenuItemVariantCreate {
+	if v != nil {
+		_c.SetUpdateTime(*v)
+	}
+	return _c
+}
+
+// SetName sets the "name" field.
+func (_c *MenuItemVariantCreate) SetName(v string) *MenuItemVariantCreate {
+	_c.mutation.SetName(v)
+	return _c
+}
+
+// SetPrice sets the "price" field.
+func (_c *MenuItemVariantCreate) SetPrice(v int64) *MenuItemVariantCreate {
+	_c.mutation.SetPrice(v)
+	return _c
+}
+
+// SetSku sets the "sku" field.
+func (_c *MenuItemVariantCreate) SetSku(v string) *MenuItemVariantCreate {
+	_c.mutation.SetSku(v)
+	return _c
+}
+
+// SetNillableSku sets the "sku" field if the given value is not nil.
+func (_c *MenuItemVariantCreate) SetNillableSku(v *string) *MenuItemVariantCreate {
+	if v != nil {
+		_c.SetSku(*v)
+	}
+	return _c
+}
+
+// SetIsAvailable sets the "is_available" field.
+func (_c *MenuItemVariantCreate) SetIsAvailable(v bool) *MenuItemVariantCreate {
+	_c.mutation.SetIsAvailable(v)
+	return _c
+}
+
+// SetNillableIsAvailable sets the "is_available" field if the given value is not nil.
+func (_c *MenuItemVariantCreate) SetNillableIsAvailable(v *bool) *MenuItemVariantCreate {
+	if v != nil {
+		_c.SetIsAvailable(*v)
+	}
+	return _c
+}
+
+// SetDisplayOrder sets the "display_order" field.
+func (_c *MenuItemVariantCreate) SetDisplayOrder(v int) *MenuItemVariantCreate {
+	_c.mutation.SetDisplayOrder(v)
+	return _c
+}
+
+// SetNillableDisplayOrder sets the "display_order" field if the given value is not nil.
+func (_c *MenuItemVariantCreate) SetNillableDisplayOrder(v *int) *MenuItemVariantCreate {
+	if v != nil {
+		_c.SetDisplayOrder(*v)
+	}
+	return _c
+}
+
+// SetMenuItemID sets the "menu_item_id" field.
+func (_c *MenuItemVariantCreate) SetMenuItemID(v int64) *MenuItemVariantCreate {
+	_c.mutation.SetMenuItemID(v)
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *MenuItemVariantCreate) SetID(v uuid.UUID) *MenuItemVariantCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *MenuItemVariantCreate) SetNillableID(v *uuid.UUID) *MenuItemVariantCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetMenuItem sets the "menu_item" edge to the MenuItem entity.
+func (_c *MenuItemVariantCreate) SetMenuItem(v *MenuItem) *MenuItemVariantCreate {
+	return _c.SetMenuItemID(v.ID)
+}
+
+// AddOrderItemIDs adds the "order_items" edge to the OrderItem entity by IDs.
+func (_c *MenuItemVariantCreate) AddOrderItemIDs(ids ...uuid.UUID) *MenuItemVariantCreate {
+	_c.mutation.AddOrderItemIDs(ids...)
+	return _c
+}
+
+// AddOrderItems adds the "order_items" edges to the OrderItem entity.
+func (_c *MenuItemVariantCreate) AddOrderItems(v ...*OrderItem) *MenuItemVariantCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddOrderItemIDs(ids...)
+}
+
+// Mutation returns the MenuItemVariantMutation object of the builder.
+func (_c *MenuItemVariantCreate) Mutation() *MenuItemVariantMutation {
+	return _c.mutation
+}
+
+// Save creates the MenuItemVariant in the database.
+func (_c *MenuItemVariantCreate) Save(ctx context.Context) (*MenuItemVariant, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *MenuItemVariantCreate) SaveX(ctx context.Context) *MenuItemVariant {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *MenuItemVariantCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *MenuItemVariantCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *MenuItemVariantCreate) defaults() {
+	if _, ok := _c.mutation.UpdateTime(); !ok {
+		v := menuitemvariant.DefaultUpdateTime()
+		_c.mutation.SetUpdateTime(v)
+	}
+	if _, ok := _c.mutation.Sku(); !ok {
+		v := menuitemvariant.DefaultSku
+		_c.mutation.SetSku(v)
+	}
+	if _, ok := _c.mutation.IsAvailable(); !ok {
+		v := menuitemvariant.DefaultIsAvailable
+		_c.mutation.SetIsAvailable(v)
+	}
+	if _, ok := _c.mutation.DisplayOrder(); !ok {
+		v := menuitemvariant.DefaultDisplayOrder
+		_c.mutation.SetDisplayOrder(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := menuitemvariant.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *MenuItemVariantCreate) check() error {
+	if _, ok := _c.mutation.UpdateTime(); !ok {
+		return &ValidationError{Name: "update_time", err: errors.New(`ent: missing required field "MenuItemVariant.update_time"`)}
+	}
+	if _, ok := _c.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "MenuItemVariant.name"`)}
+	}
+	if v, ok := _c.mutation.Name(); ok {
+		if err := menuitemvariant.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "MenuItemVariant.name": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Price(); !ok {
+		return &ValidationError{Name: "price", err: errors.New(`ent: missing required field "MenuItemVariant.price"`)}
+	}
+	if v, ok := _c.mutation.Price(); ok {
+		if err := menuitemvariant.PriceValidator(v); err != nil {
+			return &ValidationError{Name: "price", err: fmt.Errorf(`ent: validator failed for field "MenuItemVariant.price": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Sku(); !ok {
+		return &ValidationError{Name: "sku", err: errors.New(`ent: missing required field "MenuItemVariant.sku"`)}
+	}
+	if v, ok := _c.mutation.Sku(); ok {
+		if err := menuitemvariant.SkuValidator(v); err != nil {
+			return &ValidationError{Name: "sku", err: fmt.Errorf(`ent: validator failed for field "MenuItemVariant.sku": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.IsAvailable(); !ok {
+		return &ValidationError{Name: "is_available", err: errors.New(`ent: missing required field "MenuItemVariant.is_available"`)}
+	}
+	if _, ok := _c.mutation.DisplayOrder(); !ok {
+		return &ValidationError{Name: "display_order", err: errors.New(`ent: missing required field "MenuItemVariant.display_order"`)}
+	}
+	if v, ok := _c.mutation.DisplayOrder(); ok {
+		if err := menuitemvariant.DisplayOrderValidator(v); err != nil {
+			return &ValidationError{Name: "display_order", err: fmt.Errorf(`ent: validator failed for field "MenuItemVariant.display_order": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.MenuItemID(); !ok {
+		return &ValidationError{Name: "menu_item_id", err: errors.New(`ent: missing required field "MenuItemVariant.menu_item_id"`)}
+	}
+	if len(_c.mutation.MenuItemIDs()) == 0 {
+		return &ValidationError{Name: "menu_item", err: errors.New(`ent: missing required edge "MenuItemVariant.menu_item"`)}
+	}
+	return nil
+}
+
+func (_c *MenuItemVariantCreate) sqlSave(ctx context.Context) (*MenuItemVariant, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *MenuItemVariantCreate) createSpec() (*MenuItemVariant, *sqlgraph.CreateSpec) {
+	var (
+		_node = &MenuItemVariant{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(menuitemvariant.Table, sqlgraph.NewFieldSpec(menuitemvariant.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.UpdateTime(); ok {
+		_spec.SetField(menuitemvariant.FieldUpdateTime, field.TypeTime, value)
+		_node.UpdateTime = value
+	}
+	if value, ok := _c.mutation.Name(); ok {
+		_spec.SetField(menuitemvariant.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := _c.mutation.Price(); ok {
+		_spec.SetField(menuitemvariant.FieldPrice, field.TypeInt64, value)
+		_node.Price = value
+	}
+	if value, ok := _c.mutation.Sku(); ok {
+		_spec.SetField(menuitemvariant.FieldSku, field.TypeString, value)
+		_node.Sku = value
+	}
+	if value, ok := _c.mutation.IsAvailable(); ok {
+		_spec.SetField(menuitemvariant.FieldIsAvailable, field.TypeBool, value)
+		_node.IsAvailable = value
+	}
+	if value, ok := _c.mutation.DisplayOrder(); ok {
+		_spec.SetField(menuitemvariant.FieldDisplayOrder, field.TypeInt, value)
+		_node.DisplayOrder = value
+	}
+	if nodes := _c.mutation.MenuItemIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   menuitemvariant.MenuItemTable,
+			Columns: []string{menuitemvariant.MenuItemColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(menuitem.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.MenuItemID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.OrderItemsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   menuitemvariant.OrderItemsTable,
+			Columns: []string{menuitemvariant.OrderItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(orderitem.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// MenuItemVariantCreateBulk is the builder for creating many MenuItemVariant entities in bulk.
+type MenuItemVariantCreateBulk struct {
+	config
+	err      error
+	builders []*MenuItemVariantCreate
+}
+
+// Save creates the MenuItemVariant entities in the database.
+func (_c *MenuItemVariantCreateBulk) Save(ctx context.Context) ([]*MenuItemVariant, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*MenuItemVariant, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*MenuItemVariantMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *MenuItemVariantCreateBulk) SaveX(ctx context.Context) []*MenuItemVariant {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *MenuItemVariantCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *MenuItemVariantCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
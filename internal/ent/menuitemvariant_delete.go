@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Jiruu246/rms/internal/ent/menuitemvariant"
+	"github.com/Jiruu246/rms/internal/ent/predicate"
+)
+
+// MenuItemVariantDelete is the builder for deleting a MenuItemVariant entity.
+type MenuItemVariantDelete struct {
+	config
+	hooks    []Hook
+	mutation *MenuItemVariantMutation
+}
+
+// Where appends a list predicates to the MenuItemVariantDelete builder.
+func (_d *MenuItemVariantDelete) Where(ps ...predicate.MenuItemVariant) *MenuItemVariantDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *MenuItemVariantDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *MenuItemVariantDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *MenuItemVariantDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(menuitemvariant.Table, sqlgraph.NewFieldSpec(menuitemvariant.FieldID, field.TypeUUID))
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// MenuItemVariantDeleteOne is the builder for deleting a single MenuItemVariant entity.
+type MenuItemVariantDeleteOne struct {
+	_d *MenuItemVariantDelete
+}
+
+// Where appends a list predicates to the MenuItemVariantDelete builder.
+func (_d *MenuItemVariantDeleteOne) Where(ps ...predicate.MenuItemVariant) *MenuItemVariantDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *MenuItemVariantDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{menuitemvariant.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *MenuItemVariantDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,685 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"database/sql/driver"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Jiruu246/rms/internal/ent/menuitem"
+	"github.com/Jiruu246/rms/internal/ent/menuitemvariant"
+	"github.com/Jiruu246/rms/internal/ent/orderitem"
+	"github.com/Jiruu246/rms/internal/ent/predicate"
+	"github.com/google/uuid"
+)
+
+// MenuItemVariantQuery is the builder for querying MenuItemVariant entities.
+type MenuItemVariantQuery struct {
+	config
+	ctx            *QueryContext
+	order          []menuitemvariant.OrderOption
+	inters         []Interceptor
+	predicates     []predicate.MenuItemVariant
+	withMenuItem   *MenuItemQuery
+	withOrderItems *OrderItemQuery
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the MenuItemVariantQuery builder.
+func (_q *MenuItemVariantQuery) Where(ps ...predicate.MenuItemVariant) *MenuItemVariantQuery {
+	_q.predicates = append(_q.predicates, ps...)
+	return _q
+}
+
+// Limit the number of records to be returned by this query.
+func (_q *MenuItemVariantQuery) Limit(limit int) *MenuItemVariantQuery {
+	_q.ctx.Limit = &limit
+	return _q
+}
+
+// Offset to start from.
+func (_q *MenuItemVariantQuery) Offset(offset int) *MenuItemVariantQuery {
+	_q.ctx.Offset = &offset
+	return _q
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (_q *MenuItemVariantQuery) Unique(unique bool) *MenuItemVariantQuery {
+	_q.ctx.Unique = &unique
+	return _q
+}
+
+// Order specifies how the records should be ordered.
+func (_q *MenuItemVariantQuery) Order(o ...menuitemvariant.OrderOption) *MenuItemVariantQuery {
+	_q.order = append(_q.order, o...)
+	return _q
+}
+
+// QueryMenuItem chains the current query on the "menu_item" edge.
+func (_q *MenuItemVariantQuery) QueryMenuItem() *MenuItemQuery {
+	query := (&MenuItemClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(menuitemvariant.Table, menuitemvariant.FieldID, selector),
+			sqlgraph.To(menuitem.Table, menuitem.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, menuitemvariant.MenuItemTable, menuitemvariant.MenuItemColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryOrderItems chains the current query on the "order_items" edge.
+func (_q *MenuItemVariantQuery) QueryOrderItems() *OrderItemQuery {
+	query := (&OrderItemClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(menuitemvariant.Table, menuitemvariant.FieldID, selector),
+			sqlgraph.To(orderitem.Table, orderitem.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, menuitemvariant.OrderItemsTable, menuitemvariant.OrderItemsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first MenuItemVariant entity from the query.
+// Returns a *NotFoundError when no MenuItemVariant was found.
+func (_q *MenuItemVariantQuery) First(ctx context.Context) (*MenuItemVariant, error) {
+	nodes, err := _q.Limit(1).All(setContextOp(ctx, _q.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{menuitemvariant.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (_q *MenuItemVariantQuery) FirstX(ctx context.Context) *MenuItemVariant {
+	node, err := _q.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first MenuItemVariant ID from the query.
+// Returns a *NotFoundError when no MenuItemVariant ID was found.
+func (_q *MenuItemVariantQuery) FirstID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = _q.Limit(1).IDs(setContextOp(ctx, _q.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{menuitemvariant.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (_q *MenuItemVariantQuery) FirstIDX(ctx context.Context) uuid.UUID {
+	id, err := _q.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single MenuItemVariant entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one MenuItemVariant entity is found.
+// Returns a *NotFoundError when no MenuItemVariant entities are found.
+func (_q *MenuItemVariantQuery) Only(ctx context.Context) (*MenuItemVariant, error) {
+	nodes, err := _q.Limit(2).All(setContextOp(ctx, _q.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{menuitemvariant.Label}
+	default:
+		return nil, &NotSingularError{menuitemvariant.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (_q *MenuItemVariantQuery) OnlyX(ctx context.Context) *MenuItemVariant {
+	node, err := _q.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only MenuItemVariant ID in the query.
+// Returns a *NotSingularError when more than one MenuItemVariant ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (_q *MenuItemVariantQuery) OnlyID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = _q.Limit(2).IDs(setContextOp(ctx, _q.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{menuitemvariant.Label}
+	default:
+		err = &NotSingularError{menuitemvariant.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (_q *MenuItemVariantQuery) OnlyIDX(ctx context.Context) uuid.UUID {
+	id, err := _q.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of MenuItemVariants.
+func (_q *MenuItemVariantQuery) All(ctx context.Context) ([]*MenuItemVariant, error) {
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryAll)
+	if err := _q.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*MenuItemVariant, *MenuItemVariantQuery]()
+	return withInterceptors[[]*MenuItemVariant](ctx, _q, qr, _q.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (_q *MenuItemVariantQuery) AllX(ctx context.Context) []*MenuItemVariant {
+	nodes, err := _q.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of MenuItemVariant IDs.
+func (_q *MenuItemVariantQuery) IDs(ctx context.Context) (ids []uuid.UUID, err error) {
+	if _q.ctx.Unique == nil && _q.path != nil {
+		_q.Unique(true)
+	}
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryIDs)
+	if err = _q.Select(menuitemvariant.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (_q *MenuItemVariantQuery) IDsX(ctx context.Context) []uuid.UUID {
+	ids, err := _q.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (_q *MenuItemVariantQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryCount)
+	if err := _q.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, _q, querierCount[*MenuItemVariantQuery](), _q.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (_q *MenuItemVariantQuery) CountX(ctx context.Context) int {
+	count, err := _q.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (_q *MenuItemVariantQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryExist)
+	switch _, err := _q.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (_q *MenuItemVariantQuery) ExistX(ctx context.Context) bool {
+	exist, err := _q.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the MenuItemVariantQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (_q *MenuItemVariantQuery) Clone() *MenuItemVariantQuery {
+	if _q == nil {
+		return nil
+	}
+	return &MenuItemVariantQuery{
+		config:         _q.config,
+		ctx:            _q.ctx.Clone(),
+		order:          append([]menuitemvariant.OrderOption{}, _q.order...),
+		inters:         append([]Interceptor{}, _q.inters...),
+		predicates:     append([]predicate.MenuItemVariant{}, _q.predicates...),
+		withMenuItem:   _q.withMenuItem.Clone(),
+		withOrderItems: _q.withOrderItems.Clone(),
+		// clone intermediate query.
+		sql:  _q.sql.Clone(),
+		path: _q.path,
+	}
+}
+
+// WithMenuItem tells the query-builder to eager-load the nodes that are connected to
+// the "menu_item" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *MenuItemVariantQuery) WithMenuItem(opts ...func(*MenuItemQuery)) *MenuItemVariantQuery {
+	query := (&MenuItemClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withMenuItem = query
+	return _q
+}
+
+// WithOrderItems tells the query-builder to eager-load the nodes that are connected to
+// the "order_items" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *MenuItemVariantQuery) WithOrderItems(opts ...func(*OrderItemQuery)) *MenuItemVariantQuery {
+	query := (&OrderItemClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withOrderItems = query
+	return _q
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		UpdateTime time.Time `json:"update_time,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.MenuItemVariant.Query().
+//		GroupBy(menuitemvariant.FieldUpdateTime).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (_q *MenuItemVariantQuery) GroupBy(field string, fields ...string) *MenuItemVariantGroupBy {
+	_q.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &MenuItemVariantGroupBy{build: _q}
+	grbuild.flds = &_q.ctx.Fields
+	grbuild.label = menuitemvariant.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		UpdateTime time.Time `json:"update_time,omitempty"`
+//	}
+//
+//	client.MenuItemVariant.Query().
+//		Select(menuitemvariant.FieldUpdateTime).
+//		Scan(ctx, &v)
+func (_q *MenuItemVariantQuery) Select(fields ...string) *MenuItemVariantSelect {
+	_q.ctx.Fields = append(_q.ctx.Fields, fields...)
+	sbuild := &MenuItemVariantSelect{MenuItemVariantQuery: _q}
+	sbuild.label = menuitemvariant.Label
+	sbuild.flds, sbuild.scan = &_q.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a MenuItemVariantSelect configured with the given aggregations.
+func (_q *MenuItemVariantQuery) Aggregate(fns ...AggregateFunc) *MenuItemVariantSelect {
+	return _q.Select().Aggregate(fns...)
+}
+
+func (_q *MenuItemVariantQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range _q.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, _q); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range _q.ctx.Fields {
+		if !menuitemvariant.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if _q.path != nil {
+		prev, err := _q.path(ctx)
+		if err != nil {
+			return err
+		}
+		_q.sql = prev
+	}
+	return nil
+}
+
+func (_q *MenuItemVariantQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*MenuItemVariant, error) {
+	var (
+		nodes       = []*MenuItemVariant{}
+		_spec       = _q.querySpec()
+		loadedTypes = [2]bool{
+			_q.withMenuItem != nil,
+			_q.withOrderItems != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*MenuItemVariant).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &MenuItemVariant{config: _q.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, _q.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := _q.withMenuItem; query != nil {
+		if err := _q.loadMenuItem(ctx, query, nodes, nil,
+			func(n *MenuItemVariant, e *MenuItem) { n.Edges.MenuItem = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withOrderItems; query != nil {
+		if err := _q.loadOrderItems(ctx, query, nodes,
+			func(n *MenuItemVariant) { n.Edges.OrderItems = []*OrderItem{} },
+			func(n *MenuItemVariant, e *OrderItem) { n.Edges.OrderItems = append(n.Edges.OrderItems, e) }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (_q *MenuItemVariantQuery) loadMenuItem(ctx context.Context, query *MenuItemQuery, nodes []*MenuItemVariant, init func(*MenuItemVariant), assign func(*MenuItemVariant, *MenuItem)) error {
+	ids := make([]int64, 0, len(nodes))
+	nodeids := make(map[int64][]*MenuItemVariant)
+	for i := range nodes {
+		fk := nodes[i].MenuItemID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(menuitem.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "menu_item_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (_q *MenuItemVariantQuery) loadOrderItems(ctx context.Context, query *OrderItemQuery, nodes []*MenuItemVariant, init func(*MenuItemVariant), assign func(*MenuItemVariant, *OrderItem)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*MenuItemVariant)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(orderitem.FieldVariantID)
+	}
+	query.Where(predicate.OrderItem(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(menuitemvariant.OrderItemsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.VariantID
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "variant_id" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "variant_id" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+
+func (_q *MenuItemVariantQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := _q.querySpec()
+	_spec.Node.Columns = _q.ctx.Fields
+	if len(_q.ctx.Fields) > 0 {
+		_spec.Unique = _q.ctx.Unique != nil && *_q.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, _q.driver, _spec)
+}
+
+func (_q *MenuItemVariantQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(menuitemvariant.Table, menuitemvariant.Columns, sqlgraph.NewFieldSpec(menuitemvariant.FieldID, field.TypeUUID))
+	_spec.From = _q.sql
+	if unique := _q.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if _q.path != nil {
+		_spec.Unique = true
+	}
+	if fields := _q.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, menuitemvariant.FieldID)
+		for i := range fields {
+			if fields[i] != menuitemvariant.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+		if _q.withMenuItem != nil {
+			_spec.Node.AddColumnOnce(menuitemvariant.FieldMenuItemID)
+		}
+	}
+	if ps := _q.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := _q.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := _q.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := _q.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (_q *MenuItemVariantQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(_q.driver.Dialect())
+	t1 := builder.Table(menuitemvariant.Table)
+	columns := _q.ctx.Fields
+	if len(columns) == 0 {
+		columns = menuitemvariant.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if _q.sql != nil {
+		selector = _q.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if _q.ctx.Unique != nil && *_q.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range _q.predicates {
+		p(selector)
+	}
+	for _, p := range _q.order {
+		p(selector)
+	}
+	if offset := _q.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := _q.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// MenuItemVariantGroupBy is the group-by builder for MenuItemVariant entities.
+type MenuItemVariantGroupBy struct {
+	selector
+	build *MenuItemVariantQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (_g *MenuItemVariantGroupBy) Aggregate(fns ...AggregateFunc) *MenuItemVariantGroupBy {
+	_g.fns = append(_g.fns, fns...)
+	return _g
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (_g *MenuItemVariantGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, _g.build.ctx, ent.OpQueryGroupBy)
+	if err := _g.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*MenuItemVariantQuery, *MenuItemVariantGroupBy](ctx, _g.build, _g, _g.build.inters, v)
+}
+
+func (_g *MenuItemVariantGroupBy) sqlScan(ctx context.Context, root *MenuItemVariantQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(_g.fns))
+	for _, fn := range _g.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*_g.flds)+len(_g.fns))
+		for _, f := range *_g.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*_g.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := _g.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// MenuItemVariantSelect is the builder for selecting fields of MenuItemVariant entities.
+type MenuItemVariantSelect struct {
+	*MenuItemVariantQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (_s *MenuItemVariantSelect) Aggregate(fns ...AggregateFunc) *MenuItemVariantSelect {
+	_s.fns = append(_s.fns, fns...)
+	return _s
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (_s *MenuItemVariantSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, _s.ctx, ent.OpQuerySelect)
+	if err := _s.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*MenuItemVariantQuery, *MenuItemVariantSelect](ctx, _s.MenuItemVariantQuery, _s, _s.inters, v)
+}
+
+func (_s *MenuItemVariantSelect) sqlScan(ctx context.Context, root *MenuItemVariantQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(_s.fns))
+	for _, fn := range _s.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*_s.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := _s.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,648 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Jiruu246/rms/internal/ent/menuitemvariant"
+	"github.com/Jiruu246/rms/internal/ent/orderitem"
+	"github.com/Jiruu246/rms/internal/ent/predicate"
+	"github.com/google/uuid"
+)
+
+// MenuItemVariantUpdate is the builder for updating MenuItemVariant entities.
+type MenuItemVariantUpdate struct {
+	config
+	hooks    []Hook
+	mutation *MenuItemVariantMutation
+}
+
+// Where appends a list predicates to the MenuItemVariantUpdate builder.
+func (_u *MenuItemVariantUpdate) Where(ps ...predicate.MenuItemVariant) *MenuItemVariantUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_u *MenuItemVariantUpdate) SetUpdateTime(v time.Time) *MenuItemVariantUpdate {
+	_u.mutation.SetUpdateTime(v)
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *MenuItemVariantUpdate) SetName(v string) *MenuItemVariantUpdate {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *MenuItemVariantUpdate) SetNillableName(v *string) *MenuItemVariantUpdate {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetPrice sets the "price" field.
+func (_u *MenuItemVariantUpdate) SetPrice(v int64) *MenuItemVariantUpdate {
+	_u.mutation.ResetPrice()
+	_u.mutation.SetPrice(v)
+	return _u
+}
+
+// SetNillablePrice sets the "price" field if the given value is not nil.
+func (_u *MenuItemVariantUpdate) SetNillablePrice(v *int64) *MenuItemVariantUpdate {
+	if v != nil {
+		_u.SetPrice(*v)
+	}
+	return _u
+}
+
+// AddPrice adds value to the "price" field.
+func (_u *MenuItemVariantUpdate) AddPrice(v int64) *MenuItemVariantUpdate {
+	_u.mutation.AddPrice(v)
+	return _u
+}
+
+// SetSku sets the "sku" field.
+func (_u *MenuItemVariantUpdate) SetSku(v string) *MenuItemVariantUpdate {
+	_u.mutation.SetSku(v)
+	return _u
+}
+
+// SetNillableSku sets the "sku" field if the given value is not nil.
+func (_u *MenuItemVariantUpdate) SetNillableSku(v *string) *MenuItemVariantUpdate {
+	if v != nil {
+		_u.SetSku(*v)
+	}
+	return _u
+}
+
+// SetIsAvailable sets the "is_available" field.
+func (_u *MenuItemVariantUpdate) SetIsAvailable(v bool) *MenuItemVariantUpdate {
+	_u.mutation.SetIsAvailable(v)
+	return _u
+}
+
+// SetNillableIsAvailable sets the "is_available" field if the given value is not nil.
+func (_u *MenuItemVariantUpdate) SetNillableIsAvailable(v *bool) *MenuItemVariantUpdate {
+	if v != nil {
+		_u.SetIsAvailable(*v)
+	}
+	return _u
+}
+
+// SetDisplayOrder sets the "display_order" field.
+func (_u *MenuItemVariantUpdate) SetDisplayOrder(v int) *MenuItemVariantUpdate {
+	_u.mutation.ResetDisplayOrder()
+	_u.mutation.SetDisplayOrder(v)
+	return _u
+}
+
+// SetNillableDisplayOrder sets the "display_order" field if the given value is not nil.
+func (_u *MenuItemVariantUpdate) SetNillableDisplayOrder(v *int) *MenuItemVariantUpdate {
+	if v != nil {
+		_u.SetDisplayOrder(*v)
+	}
+	return _u
+}
+
+// AddDisplayOrder adds value to the "display_order" field.
+func (_u *MenuItemVariantUpdate) AddDisplayOrder(v int) *MenuItemVariantUpdate {
+	_u.mutation.AddDisplayOrder(v)
+	return _u
+}
+
+// AddOrderItemIDs adds the "order_items" edge to the OrderItem entity by IDs.
+func (_u *MenuItemVariantUpdate) AddOrderItemIDs(ids ...uuid.UUID) *MenuItemVariantUpdate {
+	_u.mutation.AddOrderItemIDs(ids...)
+	return _u
+}
+
+// AddOrderItems adds the "order_items" edges to the OrderItem entity.
+func (_u *MenuItemVariantUpdate) AddOrderItems(v ...*OrderItem) *MenuItemVariantUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddOrderItemIDs(ids...)
+}
+
+// Mutation returns the MenuItemVariantMutation object of the builder.
+func (_u *MenuItemVariantUpdate) Mutation() *MenuItemVariantMutation {
+	return _u.mutation
+}
+
+// ClearOrderItems clears all "order_items" edges to the OrderItem entity.
+func (_u *MenuItemVariantUpdate) ClearOrderItems() *MenuItemVariantUpdate {
+	_u.mutation.ClearOrderItems()
+	return _u
+}
+
+// RemoveOrderItemIDs removes the "order_items" edge to OrderItem entities by IDs.
+func (_u *MenuItemVariantUpdate) RemoveOrderItemIDs(ids ...uuid.UUID) *MenuItemVariantUpdate {
+	_u.mutation.RemoveOrderItemIDs(ids...)
+	return _u
+}
+
+// RemoveOrderItems removes "order_items" edges to OrderItem entities.
+func (_u *MenuItemVariantUpdate) RemoveOrderItems(v ...*OrderItem) *MenuItemVariantUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveOrderItemIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *MenuItemVariantUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *MenuItemVariantUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *MenuItemVariantUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *MenuItemVariantUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *MenuItemVariantUpdate) defaults() {
+	if _, ok := _u.mutation.UpdateTime(); !ok {
+		v := menuitemvariant.UpdateDefaultUpdateTime()
+		_u.mutation.SetUpdateTime(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *MenuItemVariantUpdate) check() error {
+	if v, ok := _u.mutation.Name(); ok {
+		if err := menuitemvariant.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "MenuItemVariant.name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Price(); ok {
+		if err := menuitemvariant.PriceValidator(v); err != nil {
+			return &ValidationError{Name: "price", err: fmt.Errorf(`ent: validator failed for field "MenuItemVariant.price": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Sku(); ok {
+		if err := menuitemvariant.SkuValidator(v); err != nil {
+			return &ValidationError{Name: "sku", err: fmt.Errorf(`ent: validator failed for field "MenuItemVariant.sku": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.DisplayOrder(); ok {
+		if err := menuitemvariant.DisplayOrderValidator(v); err != nil {
+			return &ValidationError{Name: "display_order", err: fmt.Errorf(`ent: validator failed for field "MenuItemVariant.display_order": %w`, err)}
+		}
+	}
+	if _u.mutation.MenuItemCleared() && len(_u.mutation.MenuItemIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "MenuItemVariant.menu_item"`)
+	}
+	return nil
+}
+
+func (_u *MenuItemVariantUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(menuitemvariant.Table, menuitemvariant.Columns, sqlgraph.NewFieldSpec(menuitemvariant.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdateTime(); ok {
+		_spec.SetField(menuitemvariant.FieldUpdateTime, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(menuitemvariant.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Price(); ok {
+		_spec.SetField(menuitemvariant.FieldPrice, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedPrice(); ok {
+		_spec.AddField(menuitemvariant.FieldPrice, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.Sku(); ok {
+		_spec.SetField(menuitemvariant.FieldSku, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.IsAvailable(); ok {
+		_spec.SetField(menuitemvariant.FieldIsAvailable, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.DisplayOrder(); ok {
+		_spec.SetField(menuitemvariant.FieldDisplayOrder, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedDisplayOrder(); ok {
+		_spec.AddField(menuitemvariant.FieldDisplayOrder, field.TypeInt, value)
+	}
+	if _u.mutation.OrderItemsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   menuitemvariant.OrderItemsTable,
+			Columns: []string{menuitemvariant.OrderItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(orderitem.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedOrderItemsIDs(); len(nodes) > 0 && !_u.mutation.OrderItemsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   menuitemvariant.OrderItemsTable,
+			Columns: []string{menuitemvariant.OrderItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(orderitem.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.OrderItemsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   menuitemvariant.OrderItemsTable,
+			Columns: []string{menuitemvariant.OrderItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(orderitem.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{menuitemvariant.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// MenuItemVariantUpdateOne is the builder for updating a single MenuItemVariant entity.
+type MenuItemVariantUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *MenuItemVariantMutation
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_u *MenuItemVariantUpdateOne) SetUpdateTime(v time.Time) *MenuItemVariantUpdateOne {
+	_u.mutation.SetUpdateTime(v)
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *MenuItemVariantUpdateOne) SetName(v string) *MenuItemVariantUpdateOne {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *MenuItemVariantUpdateOne) SetNillableName(v *string) *MenuItemVariantUpdateOne {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetPrice sets the "price" field.
+func (_u *MenuItemVariantUpdateOne) SetPrice(v int64) *MenuItemVariantUpdateOne {
+	_u.mutation.ResetPrice()
+	_u.mutation.SetPrice(v)
+	return _u
+}
+
+// SetNillablePrice sets the "price" field if the given value is not nil.
+func (_u *MenuItemVariantUpdateOne) SetNillablePrice(v *int64) *MenuItemVariantUpdateOne {
+	if v != nil {
+		_u.SetPrice(*v)
+	}
+	return _u
+}
+
+// AddPrice adds value to the "price" field.
+func (_u *MenuItemVariantUpdateOne) AddPrice(v int64) *MenuItemVariantUpdateOne {
+	_u.mutation.AddPrice(v)
+	return _u
+}
+
+// SetSku sets the "sku" field.
+func (_u *MenuItemVariantUpdateOne) SetSku(v string) *MenuItemVariantUpdateOne {
+	_u.mutation.SetSku(v)
+	return _u
+}
+
+// SetNillableSku sets the "sku" field if the given value is not nil.
+func (_u *MenuItemVariantUpdateOne) SetNillableSku(v *string) *MenuItemVariantUpdateOne {
+	if v != nil {
+		_u.SetSku(*v)
+	}
+	return _u
+}
+
+// SetIsAvailable sets the "is_available" field.
+func (_u *MenuItemVariantUpdateOne) SetIsAvailable(v bool) *MenuItemVariantUpdateOne {
+	_u.mutation.SetIsAvailable(v)
+	return _u
+}
+
+// SetNillableIsAvailable sets the "is_available" field if the given value is not nil.
+func (_u *MenuItemVariantUpdateOne) SetNillableIsAvailable(v *bool) *MenuItemVariantUpdateOne {
+	if v != nil {
+		_u.SetIsAvailable(*v)
+	}
+	return _u
+}
+
+// SetDisplayOrder sets the "display_order" field.
+func (_u *MenuItemVariantUpdateOne) SetDisplayOrder(v int) *MenuItemVariantUpdateOne {
+	_u.mutation.ResetDisplayOrder()
+	_u.mutation.SetDisplayOrder(v)
+	return _u
+}
+
+// SetNillableDisplayOrder sets the "display_order" field if the given value is not nil.
+func (_u *MenuItemVariantUpdateOne) SetNillableDisplayOrder(v *int) *MenuItemVariantUpdateOne {
+	if v != nil {
+		_u.SetDisplayOrder(*v)
+	}
+	return _u
+}
+
+// AddDisplayOrder adds value to the "display_order" field.
+func (_u *MenuItemVariantUpdateOne) AddDisplayOrder(v int) *MenuItemVariantUpdateOne {
+	_u.mutation.AddDisplayOrder(v)
+	return _u
+}
+
+// AddOrderItemIDs adds the "order_items" edge to the OrderItem entity by IDs.
+func (_u *MenuItemVariantUpdateOne) AddOrderItemIDs(ids ...uuid.UUID) *MenuItemVariantUpdateOne {
+	_u.mutation.AddOrderItemIDs(ids...)
+	return _u
+}
+
+// AddOrderItems adds the "order_items" edges to the OrderItem entity.
+func (_u *MenuItemVariantUpdateOne) AddOrderItems(v ...*OrderItem) *MenuItemVariantUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddOrderItemIDs(ids...)
+}
+
+// Mutation returns the MenuItemVariantMutation object of the builder.
+func (_u *MenuItemVariantUpdateOne) Mutation() *MenuItemVariantMutation {
+	return _u.mutation
+}
+
+// ClearOrderItems clears all "order_items" edges to the OrderItem entity.
+func (_u *MenuItemVariantUpdateOne) ClearOrderItems() *MenuItemVariantUpdateOne {
+	_u.mutation.ClearOrderItems()
+	return _u
+}
+
+// RemoveOrderItemIDs removes the "order_items" edge to OrderItem entities by IDs.
+func (_u *MenuItemVariantUpdateOne) RemoveOrderItemIDs(ids ...uuid.UUID) *MenuItemVariantUpdateOne {
+	_u.mutation.RemoveOrderItemIDs(ids...)
+	return _u
+}
+
+// RemoveOrderItems removes "order_items" edges to OrderItem entities.
+func (_u *MenuItemVariantUpdateOne) RemoveOrderItems(v ...*OrderItem) *MenuItemVariantUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveOrderItemIDs(ids...)
+}
+
+// Where appends a list predicates to the MenuItemVariantUpdate builder.
+func (_u *MenuItemVariantUpdateOne) Where(ps ...predicate.MenuItemVariant) *MenuItemVariantUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *MenuItemVariantUpdateOne) Select(field string, fields ...string) *MenuItemVariantUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated MenuItemVariant entity.
+func (_u *MenuItemVariantUpdateOne) Save(ctx context.Context) (*MenuItemVariant, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *MenuItemVariantUpdateOne) SaveX(ctx context.Context) *MenuItemVariant {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *MenuItemVariantUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *MenuItemVariantUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *MenuItemVariantUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdateTime(); !ok {
+		v := menuitemvariant.UpdateDefaultUpdateTime()
+		_u.mutation.SetUpdateTime(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *MenuItemVariantUpdateOne) check() error {
+	if v, ok := _u.mutation.Name(); ok {
+		if err := menuitemvariant.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "MenuItemVariant.name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Price(); ok {
+		if err := menuitemvariant.PriceValidator(v); err != nil {
+			return &ValidationError{Name: "price", err: fmt.Errorf(`ent: validator failed for field "MenuItemVariant.price": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Sku(); ok {
+		if err := menuitemvariant.SkuValidator(v); err != nil {
+			return &ValidationError{Name: "sku", err: fmt.Errorf(`ent: validator failed for field "MenuItemVariant.sku": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.DisplayOrder(); ok {
+		if err := menuitemvariant.DisplayOrderValidator(v); err != nil {
+			return &ValidationError{Name: "display_order", err: fmt.Errorf(`ent: validator failed for field "MenuItemVariant.display_order": %w`, err)}
+		}
+	}
+	if _u.mutation.MenuItemCleared() && len(_u.mutation.MenuItemIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "MenuItemVariant.menu_item"`)
+	}
+	return nil
+}
+
+func (_u *MenuItemVariantUpdateOne) sqlSave(ctx context.Context) (_node *MenuItemVariant, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(menuitemvariant.Table, menuitemvariant.Columns, sqlgraph.NewFieldSpec(menuitemvariant.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "MenuItemVariant.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, menuitemvariant.FieldID)
+		for _, f := range fields {
+			if !menuitemvariant.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != menuitemvariant.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdateTime(); ok {
+		_spec.SetField(menuitemvariant.FieldUpdateTime, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(menuitemvariant.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Price(); ok {
+		_spec.SetField(menuitemvariant.FieldPrice, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedPrice(); ok {
+		_spec.AddField(menuitemvariant.FieldPrice, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.Sku(); ok {
+		_spec.SetField(menuitemvariant.FieldSku, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.IsAvailable(); ok {
+		_spec.SetField(menuitemvariant.FieldIsAvailable, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.DisplayOrder(); ok {
+		_spec.SetField(menuitemvariant.FieldDisplayOrder, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedDisplayOrder(); ok {
+		_spec.AddField(menuitemvariant.FieldDisplayOrder, field.TypeInt, value)
+	}
+	if _u.mutation.OrderItemsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   menuitemvariant.OrderItemsTable,
+			Columns: []string{menuitemvariant.OrderItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(orderitem.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedOrderItemsIDs(); len(nodes) > 0 && !_u.mutation.OrderItemsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   menuitemvariant.OrderItemsTable,
+			Columns: []string{menuitemvariant.OrderItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(orderitem.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.OrderItemsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   menuitemvariant.OrderItemsTable,
+			Columns: []string{menuitemvariant.OrderItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(orderitem.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &MenuItemVariant{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{menuitemvariant.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -3,6 +3,7 @@
 package migrate
 
 import (
+	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/dialect/sql/schema"
 	"entgo.io/ent/schema/field"
 )
@@ -18,6 +19,7 @@ var (
 		{Name: "display_order", Type: field.TypeInt, Default: 0},
 		{Name: "is_active", Type: field.TypeBool, Default: true},
 		{Name: "restaurant_id", Type: field.TypeUUID},
+		{Name: "station_id", Type: field.TypeUUID, Nullable: true},
 	}
 	// CategoriesTable holds the schema information for the "categories" table.
 	CategoriesTable = &schema.Table{
@@ -31,6 +33,12 @@ var (
 				RefColumns: []*schema.Column{RestaurantsColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
+			{
+				Symbol:     "categories_stations_categories",
+				Columns:    []*schema.Column{CategoriesColumns[8]},
+				RefColumns: []*schema.Column{StationsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
 		},
 		Indexes: []*schema.Index{
 			{
@@ -40,18 +48,152 @@ var (
 			},
 		},
 	}
+	// DeliveryZonesColumns holds the columns for the "delivery_zones" table.
+	DeliveryZonesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "update_time", Type: field.TypeTime},
+		{Name: "create_time", Type: field.TypeTime},
+		{Name: "name", Type: field.TypeString, Size: 100},
+		{Name: "kind", Type: field.TypeEnum, Enums: []string{"RADIUS", "POLYGON"}},
+		{Name: "radius_meters", Type: field.TypeInt, Nullable: true},
+		{Name: "polygon", Type: field.TypeJSON, Nullable: true},
+		{Name: "delivery_fee", Type: field.TypeInt64, Default: 0},
+		{Name: "min_order_value", Type: field.TypeInt64, Default: 0},
+		{Name: "is_active", Type: field.TypeBool, Default: true},
+		{Name: "restaurant_id", Type: field.TypeUUID},
+	}
+	// DeliveryZonesTable holds the schema information for the "delivery_zones" table.
+	DeliveryZonesTable = &schema.Table{
+		Name:       "delivery_zones",
+		Columns:    DeliveryZonesColumns,
+		PrimaryKey: []*schema.Column{DeliveryZonesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "delivery_zones_restaurants_delivery_zones",
+				Columns:    []*schema.Column{DeliveryZonesColumns[10]},
+				RefColumns: []*schema.Column{RestaurantsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "deliveryzone_restaurant_id_name",
+				Unique:  true,
+				Columns: []*schema.Column{DeliveryZonesColumns[10], DeliveryZonesColumns[3]},
+			},
+		},
+	}
+	// IdempotencyKeysColumns holds the columns for the "idempotency_keys" table.
+	IdempotencyKeysColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "create_time", Type: field.TypeTime},
+		{Name: "scope", Type: field.TypeString, Default: ""},
+		{Name: "key", Type: field.TypeString, Size: 255},
+		{Name: "fingerprint", Type: field.TypeString},
+		{Name: "status_code", Type: field.TypeInt, Nullable: true},
+		{Name: "content_type", Type: field.TypeString, Nullable: true},
+		{Name: "response_body", Type: field.TypeBytes, Nullable: true},
+		{Name: "expires_at", Type: field.TypeTime},
+	}
+	// IdempotencyKeysTable holds the schema information for the "idempotency_keys" table.
+	IdempotencyKeysTable = &schema.Table{
+		Name:       "idempotency_keys",
+		Columns:    IdempotencyKeysColumns,
+		PrimaryKey: []*schema.Column{IdempotencyKeysColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "idempotencykey_scope_key",
+				Unique:  true,
+				Columns: []*schema.Column{IdempotencyKeysColumns[2], IdempotencyKeysColumns[3]},
+			},
+			{
+				Name:    "idempotencykey_expires_at",
+				Unique:  false,
+				Columns: []*schema.Column{IdempotencyKeysColumns[8]},
+			},
+		},
+	}
+	// IngredientsColumns holds the columns for the "ingredients" table.
+	IngredientsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "update_time", Type: field.TypeTime},
+		{Name: "create_time", Type: field.TypeTime},
+		{Name: "name", Type: field.TypeString, Size: 255},
+		{Name: "unit", Type: field.TypeEnum, Enums: []string{"g", "ml", "each"}},
+		{Name: "on_hand", Type: field.TypeInt64, Default: 0},
+		{Name: "restaurant_id", Type: field.TypeUUID},
+	}
+	// IngredientsTable holds the schema information for the "ingredients" table.
+	IngredientsTable = &schema.Table{
+		Name:       "ingredients",
+		Columns:    IngredientsColumns,
+		PrimaryKey: []*schema.Column{IngredientsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "ingredients_restaurants_ingredients",
+				Columns:    []*schema.Column{IngredientsColumns[6]},
+				RefColumns: []*schema.Column{RestaurantsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "ingredient_restaurant_id_name",
+				Unique:  true,
+				Columns: []*schema.Column{IngredientsColumns[6], IngredientsColumns[3]},
+			},
+		},
+	}
+	// MenusColumns holds the columns for the "menus" table.
+	MenusColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "update_time", Type: field.TypeTime},
+		{Name: "create_time", Type: field.TypeTime},
+		{Name: "name", Type: field.TypeString, Size: 255},
+		{Name: "description", Type: field.TypeString, Size: 1000, Default: ""},
+		{Name: "schedule", Type: field.TypeJSON, Nullable: true},
+		{Name: "order_types", Type: field.TypeJSON, Nullable: true},
+		{Name: "display_order", Type: field.TypeInt, Default: 0},
+		{Name: "is_active", Type: field.TypeBool, Default: true},
+		{Name: "restaurant_id", Type: field.TypeUUID},
+	}
+	// MenusTable holds the schema information for the "menus" table.
+	MenusTable = &schema.Table{
+		Name:       "menus",
+		Columns:    MenusColumns,
+		PrimaryKey: []*schema.Column{MenusColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "menus_restaurants_menus",
+				Columns:    []*schema.Column{MenusColumns[9]},
+				RefColumns: []*schema.Column{RestaurantsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "menu_restaurant_id_name",
+				Unique:  true,
+				Columns: []*schema.Column{MenusColumns[9], MenusColumns[3]},
+			},
+		},
+	}
 	// MenuItemsColumns holds the columns for the "menu_items" table.
 	MenuItemsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt64, Increment: true},
 		{Name: "update_time", Type: field.TypeTime},
 		{Name: "name", Type: field.TypeString, Size: 255},
 		{Name: "description", Type: field.TypeString, Size: 1000, Default: ""},
-		{Name: "price", Type: field.TypeFloat64},
+		{Name: "price", Type: field.TypeInt64},
 		{Name: "image_url", Type: field.TypeString, Nullable: true},
+		{Name: "thumbnail_url", Type: field.TypeString, Nullable: true},
+		{Name: "image_keys", Type: field.TypeJSON, Nullable: true},
 		{Name: "is_available", Type: field.TypeBool, Default: true},
+		{Name: "display_order", Type: field.TypeInt, Default: 0},
+		{Name: "out_of_stock", Type: field.TypeBool, Default: false},
 		{Name: "category_id", Type: field.TypeUUID, Nullable: true},
-		{Name: "modifier_menu_items", Type: field.TypeUUID, Nullable: true},
 		{Name: "restaurant_id", Type: field.TypeUUID},
+		{Name: "station_id", Type: field.TypeUUID, Nullable: true},
 	}
 	// MenuItemsTable holds the schema information for the "menu_items" table.
 	MenuItemsTable = &schema.Table{
@@ -61,22 +203,136 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "menu_items_categories_menu_items",
-				Columns:    []*schema.Column{MenuItemsColumns[7]},
+				Columns:    []*schema.Column{MenuItemsColumns[11]},
 				RefColumns: []*schema.Column{CategoriesColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
-			{
-				Symbol:     "menu_items_modifiers_menu_items",
-				Columns:    []*schema.Column{MenuItemsColumns[8]},
-				RefColumns: []*schema.Column{ModifiersColumns[0]},
-				OnDelete:   schema.SetNull,
-			},
 			{
 				Symbol:     "menu_items_restaurants_menu_items",
-				Columns:    []*schema.Column{MenuItemsColumns[9]},
+				Columns:    []*schema.Column{MenuItemsColumns[12]},
 				RefColumns: []*schema.Column{RestaurantsColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
+			{
+				Symbol:     "menu_items_stations_menu_items",
+				Columns:    []*schema.Column{MenuItemsColumns[13]},
+				RefColumns: []*schema.Column{StationsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+	}
+	// MenuItemModifiersColumns holds the columns for the "menu_item_modifiers" table.
+	MenuItemModifiersColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "update_time", Type: field.TypeTime},
+		{Name: "display_order", Type: field.TypeInt, Default: 0},
+		{Name: "required", Type: field.TypeBool, Nullable: true},
+		{Name: "max", Type: field.TypeInt, Nullable: true},
+		{Name: "option_prices", Type: field.TypeJSON, Nullable: true},
+		{Name: "menu_item_id", Type: field.TypeInt64},
+		{Name: "modifier_id", Type: field.TypeUUID},
+	}
+	// MenuItemModifiersTable holds the schema information for the "menu_item_modifiers" table.
+	MenuItemModifiersTable = &schema.Table{
+		Name:       "menu_item_modifiers",
+		Columns:    MenuItemModifiersColumns,
+		PrimaryKey: []*schema.Column{MenuItemModifiersColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "menu_item_modifiers_menu_items_menu_item",
+				Columns:    []*schema.Column{MenuItemModifiersColumns[6]},
+				RefColumns: []*schema.Column{MenuItemsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "menu_item_modifiers_modifiers_modifier",
+				Columns:    []*schema.Column{MenuItemModifiersColumns[7]},
+				RefColumns: []*schema.Column{ModifiersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "menuitemmodifier_menu_item_id_modifier_id",
+				Unique:  true,
+				Columns: []*schema.Column{MenuItemModifiersColumns[6], MenuItemModifiersColumns[7]},
+			},
+			{
+				Name:    "menuitemmodifier_modifier_id",
+				Unique:  false,
+				Columns: []*schema.Column{MenuItemModifiersColumns[7]},
+			},
+		},
+	}
+	// MenuItemPricesColumns holds the columns for the "menu_item_prices" table.
+	MenuItemPricesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "price", Type: field.TypeInt64},
+		{Name: "menu_id", Type: field.TypeUUID},
+		{Name: "menu_item_id", Type: field.TypeInt64},
+	}
+	// MenuItemPricesTable holds the schema information for the "menu_item_prices" table.
+	MenuItemPricesTable = &schema.Table{
+		Name:       "menu_item_prices",
+		Columns:    MenuItemPricesColumns,
+		PrimaryKey: []*schema.Column{MenuItemPricesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "menu_item_prices_menus_item_prices",
+				Columns:    []*schema.Column{MenuItemPricesColumns[2]},
+				RefColumns: []*schema.Column{MenusColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "menu_item_prices_menu_items_menu_prices",
+				Columns:    []*schema.Column{MenuItemPricesColumns[3]},
+				RefColumns: []*schema.Column{MenuItemsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "menuitemprice_menu_id_menu_item_id",
+				Unique:  true,
+				Columns: []*schema.Column{MenuItemPricesColumns[2], MenuItemPricesColumns[3]},
+			},
+			{
+				Name:    "menuitemprice_menu_item_id",
+				Unique:  false,
+				Columns: []*schema.Column{MenuItemPricesColumns[3]},
+			},
+		},
+	}
+	// MenuItemVariantsColumns holds the columns for the "menu_item_variants" table.
+	MenuItemVariantsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "update_time", Type: field.TypeTime},
+		{Name: "name", Type: field.TypeString, Size: 255},
+		{Name: "price", Type: field.TypeInt64},
+		{Name: "sku", Type: field.TypeString, Size: 64, Default: ""},
+		{Name: "is_available", Type: field.TypeBool, Default: true},
+		{Name: "display_order", Type: field.TypeInt, Default: 0},
+		{Name: "menu_item_id", Type: field.TypeInt64},
+	}
+	// MenuItemVariantsTable holds the schema information for the "menu_item_variants" table.
+	MenuItemVariantsTable = &schema.Table{
+		Name:       "menu_item_variants",
+		Columns:    MenuItemVariantsColumns,
+		PrimaryKey: []*schema.Column{MenuItemVariantsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "menu_item_variants_menu_items_variants",
+				Columns:    []*schema.Column{MenuItemVariantsColumns[7]},
+				RefColumns: []*schema.Column{MenuItemsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "menuitemvariant_menu_item_id",
+				Unique:  false,
+				Columns: []*schema.Column{MenuItemVariantsColumns[7]},
+			},
 		},
 	}
 	// ModifiersColumns holds the columns for the "modifiers" table.
@@ -87,7 +343,8 @@ var (
 		{Name: "required", Type: field.TypeBool, Default: false},
 		{Name: "multi_select", Type: field.TypeBool, Default: false},
 		{Name: "max", Type: field.TypeInt, Default: 1},
-		{Name: "menu_item_modifiers", Type: field.TypeInt64, Nullable: true},
+		{Name: "min", Type: field.TypeInt, Default: 0},
+		{Name: "free_quantity", Type: field.TypeInt, Default: 0},
 		{Name: "restaurant_id", Type: field.TypeUUID},
 	}
 	// ModifiersTable holds the schema information for the "modifiers" table.
@@ -96,15 +353,9 @@ var (
 		Columns:    ModifiersColumns,
 		PrimaryKey: []*schema.Column{ModifiersColumns[0]},
 		ForeignKeys: []*schema.ForeignKey{
-			{
-				Symbol:     "modifiers_menu_items_modifiers",
-				Columns:    []*schema.Column{ModifiersColumns[6]},
-				RefColumns: []*schema.Column{MenuItemsColumns[0]},
-				OnDelete:   schema.SetNull,
-			},
 			{
 				Symbol:     "modifiers_restaurants_modifiers",
-				Columns:    []*schema.Column{ModifiersColumns[7]},
+				Columns:    []*schema.Column{ModifiersColumns[8]},
 				RefColumns: []*schema.Column{RestaurantsColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
@@ -115,10 +366,16 @@ var (
 		{Name: "id", Type: field.TypeUUID},
 		{Name: "update_time", Type: field.TypeTime},
 		{Name: "name", Type: field.TypeString, Size: 255},
-		{Name: "price", Type: field.TypeFloat64, Default: 0},
+		{Name: "price", Type: field.TypeInt64, Default: 0},
+		{Name: "variant_prices", Type: field.TypeJSON, Nullable: true},
 		{Name: "image_url", Type: field.TypeString, Nullable: true},
+		{Name: "thumbnail_url", Type: field.TypeString, Nullable: true},
+		{Name: "image_keys", Type: field.TypeJSON, Nullable: true},
 		{Name: "available", Type: field.TypeBool, Default: true},
+		{Name: "out_of_stock", Type: field.TypeBool, Default: false},
 		{Name: "pre_select", Type: field.TypeBool, Default: false},
+		{Name: "display_order", Type: field.TypeInt, Default: 0},
+		{Name: "max_quantity", Type: field.TypeInt, Default: 0},
 		{Name: "modifier_id", Type: field.TypeUUID},
 	}
 	// ModifierOptionsTable holds the schema information for the "modifier_options" table.
@@ -129,7 +386,7 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "modifier_options_modifiers_modifier_options",
-				Columns:    []*schema.Column{ModifierOptionsColumns[7]},
+				Columns:    []*schema.Column{ModifierOptionsColumns[13]},
 				RefColumns: []*schema.Column{ModifiersColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
@@ -139,10 +396,35 @@ var (
 	OrdersColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID},
 		{Name: "update_time", Type: field.TypeTime},
+		{Name: "order_number", Type: field.TypeInt, Nullable: true},
+		{Name: "order_number_period", Type: field.TypeString, Default: ""},
 		{Name: "order_type", Type: field.TypeEnum, Enums: []string{"DINE_IN", "TAKEOUT", "DELIVERY"}},
-		{Name: "order_status", Type: field.TypeEnum, Enums: []string{"OPEN", "CONFIRMED", "COMPLETED", "CANCELLED"}, Default: "OPEN"},
+		{Name: "order_status", Type: field.TypeEnum, Enums: []string{"OPEN", "CONFIRMED", "READY", "COMPLETED", "CANCELLED"}, Default: "OPEN"},
 		{Name: "payment_status", Type: field.TypeEnum, Enums: []string{"UNPAID", "PENDING", "PAID", "REFUNDED"}, Default: "UNPAID"},
+		{Name: "currency", Type: field.TypeString},
+		{Name: "subtotal", Type: field.TypeInt64, Default: 0},
+		{Name: "modifiers_total", Type: field.TypeInt64, Default: 0},
+		{Name: "tax_total", Type: field.TypeInt64, Default: 0},
+		{Name: "tax_rate_bps", Type: field.TypeInt, Nullable: true},
+		{Name: "total", Type: field.TypeInt64, Default: 0},
+		{Name: "delivery_fee", Type: field.TypeInt64, Default: 0},
+		{Name: "amount_paid", Type: field.TypeInt64, Default: 0},
+		{Name: "amount_refunded", Type: field.TypeInt64, Default: 0},
+		{Name: "delivery_address", Type: field.TypeString, Default: ""},
+		{Name: "delivery_latitude", Type: field.TypeFloat64, Nullable: true},
+		{Name: "delivery_longitude", Type: field.TypeFloat64, Nullable: true},
+		{Name: "delivery_contact_name", Type: field.TypeString, Default: ""},
+		{Name: "delivery_contact_phone", Type: field.TypeString, Default: ""},
+		{Name: "delivery_instructions", Type: field.TypeString, Default: ""},
+		{Name: "delivery_min_order_value", Type: field.TypeInt64, Default: 0},
+		{Name: "scheduled_for", Type: field.TypeTime, Nullable: true},
+		{Name: "release_at", Type: field.TypeTime, Nullable: true},
+		{Name: "released_at", Type: field.TypeTime, Nullable: true},
+		{Name: "items_version", Type: field.TypeInt, Default: 0},
+		{Name: "delivery_zone_id", Type: field.TypeUUID, Nullable: true},
 		{Name: "restaurant_id", Type: field.TypeUUID},
+		{Name: "table_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "table_session_id", Type: field.TypeUUID, Nullable: true},
 	}
 	// OrdersTable holds the schema information for the "orders" table.
 	OrdersTable = &schema.Table{
@@ -150,12 +432,87 @@ var (
 		Columns:    OrdersColumns,
 		PrimaryKey: []*schema.Column{OrdersColumns[0]},
 		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "orders_delivery_zones_orders",
+				Columns:    []*schema.Column{OrdersColumns[27]},
+				RefColumns: []*schema.Column{DeliveryZonesColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
 			{
 				Symbol:     "orders_restaurants_orders",
-				Columns:    []*schema.Column{OrdersColumns[5]},
+				Columns:    []*schema.Column{OrdersColumns[28]},
 				RefColumns: []*schema.Column{RestaurantsColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
+			{
+				Symbol:     "orders_tables_orders",
+				Columns:    []*schema.Column{OrdersColumns[29]},
+				RefColumns: []*schema.Column{TablesColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "orders_table_sessions_orders",
+				Columns:    []*schema.Column{OrdersColumns[30]},
+				RefColumns: []*schema.Column{TableSessionsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "order_restaurant_id_order_number_period_order_number",
+				Unique:  true,
+				Columns: []*schema.Column{OrdersColumns[28], OrdersColumns[3], OrdersColumns[2]},
+			},
+			{
+				Name:    "order_restaurant_id_scheduled_for",
+				Unique:  false,
+				Columns: []*schema.Column{OrdersColumns[28], OrdersColumns[23]},
+			},
+			{
+				Name:    "order_release_at",
+				Unique:  false,
+				Columns: []*schema.Column{OrdersColumns[24]},
+				Annotation: &entsql.IndexAnnotation{
+					Where: "released_at IS NULL",
+				},
+			},
+		},
+	}
+	// OrderEventsColumns holds the columns for the "order_events" table.
+	OrderEventsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "create_time", Type: field.TypeTime},
+		{Name: "seq", Type: field.TypeInt64},
+		{Name: "type", Type: field.TypeEnum, Enums: []string{"order.created", "order.updated", "order.status_changed", "order.deleted", "order.released", "ticket.created", "ticket.status_changed", "ticket.updated"}},
+		{Name: "order_id", Type: field.TypeUUID},
+		{Name: "payload", Type: field.TypeJSON, Nullable: true},
+		{Name: "station_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "restaurant_id", Type: field.TypeUUID},
+	}
+	// OrderEventsTable holds the schema information for the "order_events" table.
+	OrderEventsTable = &schema.Table{
+		Name:       "order_events",
+		Columns:    OrderEventsColumns,
+		PrimaryKey: []*schema.Column{OrderEventsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "order_events_restaurants_order_events",
+				Columns:    []*schema.Column{OrderEventsColumns[7]},
+				RefColumns: []*schema.Column{RestaurantsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "orderevent_restaurant_id_seq",
+				Unique:  true,
+				Columns: []*schema.Column{OrderEventsColumns[7], OrderEventsColumns[2]},
+			},
+			{
+				Name:    "orderevent_station_id_seq",
+				Unique:  false,
+				Columns: []*schema.Column{OrderEventsColumns[6], OrderEventsColumns[2]},
+			},
 		},
 	}
 	// OrderItemsColumns holds the columns for the "order_items" table.
@@ -164,9 +521,17 @@ var (
 		{Name: "quantity", Type: field.TypeInt, Default: 1},
 		{Name: "special_instructions", Type: field.TypeString, Nullable: true},
 		{Name: "item_name", Type: field.TypeString},
-		{Name: "item_price", Type: field.TypeFloat64},
+		{Name: "item_price", Type: field.TypeInt64},
+		{Name: "modifiers_total", Type: field.TypeInt64, Default: 0},
+		{Name: "line_total", Type: field.TypeInt64, Default: 0},
+		{Name: "refunded_quantity", Type: field.TypeInt, Default: 0},
+		{Name: "variant_name", Type: field.TypeString, Default: ""},
+		{Name: "voided_at", Type: field.TypeTime, Nullable: true},
+		{Name: "void_reason", Type: field.TypeString, Size: 1000, Default: ""},
 		{Name: "menu_item_id", Type: field.TypeInt64},
+		{Name: "variant_id", Type: field.TypeUUID, Nullable: true},
 		{Name: "order_id", Type: field.TypeUUID},
+		{Name: "station_ticket_id", Type: field.TypeUUID, Nullable: true},
 	}
 	// OrderItemsTable holds the schema information for the "order_items" table.
 	OrderItemsTable = &schema.Table{
@@ -176,16 +541,75 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "order_items_menu_items_order_items",
-				Columns:    []*schema.Column{OrderItemsColumns[5]},
+				Columns:    []*schema.Column{OrderItemsColumns[11]},
 				RefColumns: []*schema.Column{MenuItemsColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
+			{
+				Symbol:     "order_items_menu_item_variants_order_items",
+				Columns:    []*schema.Column{OrderItemsColumns[12]},
+				RefColumns: []*schema.Column{MenuItemVariantsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
 			{
 				Symbol:     "order_items_orders_order_items",
-				Columns:    []*schema.Column{OrderItemsColumns[6]},
+				Columns:    []*schema.Column{OrderItemsColumns[13]},
 				RefColumns: []*schema.Column{OrdersColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
+			{
+				Symbol:     "order_items_station_tickets_order_items",
+				Columns:    []*schema.Column{OrderItemsColumns[14]},
+				RefColumns: []*schema.Column{StationTicketsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+	}
+	// OrderItemChangesColumns holds the columns for the "order_item_changes" table.
+	OrderItemChangesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "create_time", Type: field.TypeTime},
+		{Name: "action", Type: field.TypeEnum, Enums: []string{"ADDED", "UPDATED", "VOIDED"}},
+		{Name: "quantity_before", Type: field.TypeInt, Nullable: true},
+		{Name: "quantity_after", Type: field.TypeInt},
+		{Name: "instructions_before", Type: field.TypeString, Default: ""},
+		{Name: "instructions_after", Type: field.TypeString, Default: ""},
+		{Name: "reason", Type: field.TypeString, Size: 1000, Default: ""},
+		{Name: "order_id", Type: field.TypeUUID},
+		{Name: "order_item_id", Type: field.TypeUUID},
+		{Name: "changed_by_id", Type: field.TypeUUID, Nullable: true},
+	}
+	// OrderItemChangesTable holds the schema information for the "order_item_changes" table.
+	OrderItemChangesTable = &schema.Table{
+		Name:       "order_item_changes",
+		Columns:    OrderItemChangesColumns,
+		PrimaryKey: []*schema.Column{OrderItemChangesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "order_item_changes_orders_item_changes",
+				Columns:    []*schema.Column{OrderItemChangesColumns[8]},
+				RefColumns: []*schema.Column{OrdersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "order_item_changes_order_items_changes",
+				Columns:    []*schema.Column{OrderItemChangesColumns[9]},
+				RefColumns: []*schema.Column{OrderItemsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "order_item_changes_users_order_item_changes",
+				Columns:    []*schema.Column{OrderItemChangesColumns[10]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "orderitemchange_order_id_create_time",
+				Unique:  false,
+				Columns: []*schema.Column{OrderItemChangesColumns[8], OrderItemChangesColumns[1]},
+			},
 		},
 	}
 	// OrderItemModifierOptionsColumns holds the columns for the "order_item_modifier_options" table.
@@ -193,9 +617,11 @@ var (
 		{Name: "id", Type: field.TypeInt, Increment: true},
 		{Name: "quantity", Type: field.TypeInt, Default: 1},
 		{Name: "option_name", Type: field.TypeString},
-		{Name: "option_price", Type: field.TypeFloat64},
+		{Name: "option_price", Type: field.TypeInt64},
+		{Name: "free_quantity", Type: field.TypeInt, Default: 0},
 		{Name: "modifier_option_id", Type: field.TypeUUID},
 		{Name: "order_item_id", Type: field.TypeUUID},
+		{Name: "parent_id", Type: field.TypeInt, Nullable: true},
 	}
 	// OrderItemModifierOptionsTable holds the schema information for the "order_item_modifier_options" table.
 	OrderItemModifierOptionsTable = &schema.Table{
@@ -205,22 +631,222 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "order_item_modifier_options_modifier_options_order_item_modifier_options",
-				Columns:    []*schema.Column{OrderItemModifierOptionsColumns[4]},
+				Columns:    []*schema.Column{OrderItemModifierOptionsColumns[5]},
 				RefColumns: []*schema.Column{ModifierOptionsColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
 			{
 				Symbol:     "order_item_modifier_options_order_items_order_item_modifier_options",
-				Columns:    []*schema.Column{OrderItemModifierOptionsColumns[5]},
+				Columns:    []*schema.Column{OrderItemModifierOptionsColumns[6]},
 				RefColumns: []*schema.Column{OrderItemsColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
+			{
+				Symbol:     "order_item_modifier_options_order_item_modifier_options_children",
+				Columns:    []*schema.Column{OrderItemModifierOptionsColumns[7]},
+				RefColumns: []*schema.Column{OrderItemModifierOptionsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "orderitemmodifieroption_order_item_id",
+				Unique:  false,
+				Columns: []*schema.Column{OrderItemModifierOptionsColumns[6]},
+			},
+		},
+	}
+	// OrderNumberSequencesColumns holds the columns for the "order_number_sequences" table.
+	OrderNumberSequencesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "period", Type: field.TypeString, Default: ""},
+		{Name: "last_value", Type: field.TypeInt, Default: 0},
+		{Name: "restaurant_id", Type: field.TypeUUID},
+	}
+	// OrderNumberSequencesTable holds the schema information for the "order_number_sequences" table.
+	OrderNumberSequencesTable = &schema.Table{
+		Name:       "order_number_sequences",
+		Columns:    OrderNumberSequencesColumns,
+		PrimaryKey: []*schema.Column{OrderNumberSequencesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "order_number_sequences_restaurants_order_number_sequences",
+				Columns:    []*schema.Column{OrderNumberSequencesColumns[3]},
+				RefColumns: []*schema.Column{RestaurantsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
 		},
 		Indexes: []*schema.Index{
 			{
-				Name:    "orderitemmodifieroption_order_item_id_modifier_option_id",
+				Name:    "ordernumbersequence_restaurant_id_period",
 				Unique:  true,
-				Columns: []*schema.Column{OrderItemModifierOptionsColumns[5], OrderItemModifierOptionsColumns[4]},
+				Columns: []*schema.Column{OrderNumberSequencesColumns[3], OrderNumberSequencesColumns[1]},
+			},
+		},
+	}
+	// OrderStatusEventsColumns holds the columns for the "order_status_events" table.
+	OrderStatusEventsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "create_time", Type: field.TypeTime},
+		{Name: "from_status", Type: field.TypeEnum, Nullable: true, Enums: []string{"OPEN", "CONFIRMED", "READY", "COMPLETED", "CANCELLED"}},
+		{Name: "to_status", Type: field.TypeEnum, Enums: []string{"OPEN", "CONFIRMED", "READY", "COMPLETED", "CANCELLED"}},
+		{Name: "reason", Type: field.TypeString, Size: 1000, Default: ""},
+		{Name: "order_id", Type: field.TypeUUID},
+		{Name: "restaurant_id", Type: field.TypeUUID},
+		{Name: "changed_by_id", Type: field.TypeUUID, Nullable: true},
+	}
+	// OrderStatusEventsTable holds the schema information for the "order_status_events" table.
+	OrderStatusEventsTable = &schema.Table{
+		Name:       "order_status_events",
+		Columns:    OrderStatusEventsColumns,
+		PrimaryKey: []*schema.Column{OrderStatusEventsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "order_status_events_orders_status_events",
+				Columns:    []*schema.Column{OrderStatusEventsColumns[5]},
+				RefColumns: []*schema.Column{OrdersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "order_status_events_restaurants_order_status_events",
+				Columns:    []*schema.Column{OrderStatusEventsColumns[6]},
+				RefColumns: []*schema.Column{RestaurantsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "order_status_events_users_order_status_events",
+				Columns:    []*schema.Column{OrderStatusEventsColumns[7]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "orderstatusevent_order_id_create_time",
+				Unique:  false,
+				Columns: []*schema.Column{OrderStatusEventsColumns[5], OrderStatusEventsColumns[1]},
+			},
+		},
+	}
+	// PaymentsColumns holds the columns for the "payments" table.
+	PaymentsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "create_time", Type: field.TypeTime},
+		{Name: "update_time", Type: field.TypeTime},
+		{Name: "method", Type: field.TypeEnum, Enums: []string{"CASH", "CARD"}},
+		{Name: "provider", Type: field.TypeString},
+		{Name: "provider_reference", Type: field.TypeString, Default: ""},
+		{Name: "amount", Type: field.TypeInt64},
+		{Name: "currency", Type: field.TypeString},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"PENDING", "SUCCEEDED", "FAILED"}, Default: "PENDING"},
+		{Name: "refunded_amount", Type: field.TypeInt64, Default: 0},
+		{Name: "failure_reason", Type: field.TypeString, Default: ""},
+		{Name: "order_id", Type: field.TypeUUID},
+		{Name: "restaurant_id", Type: field.TypeUUID},
+		{Name: "created_by_id", Type: field.TypeUUID, Nullable: true},
+	}
+	// PaymentsTable holds the schema information for the "payments" table.
+	PaymentsTable = &schema.Table{
+		Name:       "payments",
+		Columns:    PaymentsColumns,
+		PrimaryKey: []*schema.Column{PaymentsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "payments_orders_payments",
+				Columns:    []*schema.Column{PaymentsColumns[11]},
+				RefColumns: []*schema.Column{OrdersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "payments_restaurants_payments",
+				Columns:    []*schema.Column{PaymentsColumns[12]},
+				RefColumns: []*schema.Column{RestaurantsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "payments_users_payments",
+				Columns:    []*schema.Column{PaymentsColumns[13]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "payment_order_id_create_time",
+				Unique:  false,
+				Columns: []*schema.Column{PaymentsColumns[11], PaymentsColumns[1]},
+			},
+		},
+	}
+	// RateLimitBucketsColumns holds the columns for the "rate_limit_buckets" table.
+	RateLimitBucketsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "key", Type: field.TypeString, Unique: true},
+		{Name: "tokens", Type: field.TypeFloat64},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "full_at", Type: field.TypeTime},
+	}
+	// RateLimitBucketsTable holds the schema information for the "rate_limit_buckets" table.
+	RateLimitBucketsTable = &schema.Table{
+		Name:       "rate_limit_buckets",
+		Columns:    RateLimitBucketsColumns,
+		PrimaryKey: []*schema.Column{RateLimitBucketsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "ratelimitbucket_full_at",
+				Unique:  false,
+				Columns: []*schema.Column{RateLimitBucketsColumns[4]},
+			},
+		},
+	}
+	// RecipeIngredientsColumns holds the columns for the "recipe_ingredients" table.
+	RecipeIngredientsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "quantity", Type: field.TypeInt64},
+		{Name: "ingredient_id", Type: field.TypeUUID},
+		{Name: "menu_item_id", Type: field.TypeInt64, Nullable: true},
+		{Name: "modifier_option_id", Type: field.TypeUUID, Nullable: true},
+	}
+	// RecipeIngredientsTable holds the schema information for the "recipe_ingredients" table.
+	RecipeIngredientsTable = &schema.Table{
+		Name:       "recipe_ingredients",
+		Columns:    RecipeIngredientsColumns,
+		PrimaryKey: []*schema.Column{RecipeIngredientsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "recipe_ingredients_ingredients_recipe_lines",
+				Columns:    []*schema.Column{RecipeIngredientsColumns[2]},
+				RefColumns: []*schema.Column{IngredientsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "recipe_ingredients_menu_items_recipe_lines",
+				Columns:    []*schema.Column{RecipeIngredientsColumns[3]},
+				RefColumns: []*schema.Column{MenuItemsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "recipe_ingredients_modifier_options_recipe_lines",
+				Columns:    []*schema.Column{RecipeIngredientsColumns[4]},
+				RefColumns: []*schema.Column{ModifierOptionsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "recipeingredient_menu_item_id_ingredient_id",
+				Unique:  true,
+				Columns: []*schema.Column{RecipeIngredientsColumns[3], RecipeIngredientsColumns[2]},
+			},
+			{
+				Name:    "recipeingredient_modifier_option_id_ingredient_id",
+				Unique:  true,
+				Columns: []*schema.Column{RecipeIngredientsColumns[4], RecipeIngredientsColumns[2]},
+			},
+			{
+				Name:    "recipeingredient_ingredient_id",
+				Unique:  false,
+				Columns: []*schema.Column{RecipeIngredientsColumns[2]},
 			},
 		},
 	}
@@ -273,6 +899,63 @@ var (
 			},
 		},
 	}
+	// RefundsColumns holds the columns for the "refunds" table.
+	RefundsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "create_time", Type: field.TypeTime},
+		{Name: "update_time", Type: field.TypeTime},
+		{Name: "amount", Type: field.TypeInt64},
+		{Name: "currency", Type: field.TypeString},
+		{Name: "reason", Type: field.TypeEnum, Enums: []string{"CUSTOMER_REQUEST", "ORDER_ERROR", "QUALITY_ISSUE", "DUPLICATE", "OTHER"}},
+		{Name: "note", Type: field.TypeString, Default: ""},
+		{Name: "items", Type: field.TypeJSON, Nullable: true},
+		{Name: "provider_reference", Type: field.TypeString, Default: ""},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"PENDING", "SUCCEEDED", "FAILED"}, Default: "PENDING"},
+		{Name: "failure_reason", Type: field.TypeString, Default: ""},
+		{Name: "order_id", Type: field.TypeUUID},
+		{Name: "payment_id", Type: field.TypeUUID},
+		{Name: "restaurant_id", Type: field.TypeUUID},
+		{Name: "created_by_id", Type: field.TypeUUID, Nullable: true},
+	}
+	// RefundsTable holds the schema information for the "refunds" table.
+	RefundsTable = &schema.Table{
+		Name:       "refunds",
+		Columns:    RefundsColumns,
+		PrimaryKey: []*schema.Column{RefundsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "refunds_orders_refunds",
+				Columns:    []*schema.Column{RefundsColumns[11]},
+				RefColumns: []*schema.Column{OrdersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "refunds_payments_refunds",
+				Columns:    []*schema.Column{RefundsColumns[12]},
+				RefColumns: []*schema.Column{PaymentsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "refunds_restaurants_refunds",
+				Columns:    []*schema.Column{RefundsColumns[13]},
+				RefColumns: []*schema.Column{RestaurantsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "refunds_users_refunds",
+				Columns:    []*schema.Column{RefundsColumns[14]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "refund_order_id_create_time",
+				Unique:  false,
+				Columns: []*schema.Column{RefundsColumns[11], RefundsColumns[1]},
+			},
+		},
+	}
 	// RestaurantsColumns holds the columns for the "restaurants" table.
 	RestaurantsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID, Unique: true},
@@ -286,11 +969,22 @@ var (
 		{Name: "state", Type: field.TypeString},
 		{Name: "zip_code", Type: field.TypeString},
 		{Name: "country", Type: field.TypeString},
+		{Name: "latitude", Type: field.TypeFloat64, Nullable: true},
+		{Name: "longitude", Type: field.TypeFloat64, Nullable: true},
 		{Name: "logo_url", Type: field.TypeString, Nullable: true},
+		{Name: "logo_thumbnail_url", Type: field.TypeString, Nullable: true},
+		{Name: "logo_keys", Type: field.TypeJSON, Nullable: true},
 		{Name: "cover_image_url", Type: field.TypeString, Nullable: true},
 		{Name: "status", Type: field.TypeEnum, Enums: []string{"active", "inactive", "closed"}, Default: "active"},
 		{Name: "operating_hours", Type: field.TypeJSON, Nullable: true},
 		{Name: "currency", Type: field.TypeString},
+		{Name: "tax_rate_bps", Type: field.TypeInt, Default: 0},
+		{Name: "timezone", Type: field.TypeString, Default: "UTC"},
+		{Name: "slot_minutes", Type: field.TypeInt, Default: 15},
+		{Name: "slot_capacity", Type: field.TypeInt, Default: 0},
+		{Name: "kitchen_lead_minutes", Type: field.TypeInt, Default: 20},
+		{Name: "order_number_reset", Type: field.TypeEnum, Enums: []string{"never", "daily"}, Default: "never"},
+		{Name: "order_event_seq", Type: field.TypeInt64, Default: 0},
 		{Name: "user_id", Type: field.TypeUUID},
 	}
 	// RestaurantsTable holds the schema information for the "restaurants" table.
@@ -301,11 +995,202 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "restaurants_users_restaurants",
-				Columns:    []*schema.Column{RestaurantsColumns[16]},
+				Columns:    []*schema.Column{RestaurantsColumns[27]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+	}
+	// StationsColumns holds the columns for the "stations" table.
+	StationsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "update_time", Type: field.TypeTime},
+		{Name: "create_time", Type: field.TypeTime},
+		{Name: "name", Type: field.TypeString, Size: 255},
+		{Name: "display_order", Type: field.TypeInt, Default: 0},
+		{Name: "restaurant_id", Type: field.TypeUUID},
+	}
+	// StationsTable holds the schema information for the "stations" table.
+	StationsTable = &schema.Table{
+		Name:       "stations",
+		Columns:    StationsColumns,
+		PrimaryKey: []*schema.Column{StationsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "stations_restaurants_stations",
+				Columns:    []*schema.Column{StationsColumns[5]},
+				RefColumns: []*schema.Column{RestaurantsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "station_restaurant_id_name",
+				Unique:  true,
+				Columns: []*schema.Column{StationsColumns[5], StationsColumns[3]},
+			},
+		},
+	}
+	// StationTicketsColumns holds the columns for the "station_tickets" table.
+	StationTicketsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "update_time", Type: field.TypeTime},
+		{Name: "create_time", Type: field.TypeTime},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"QUEUED", "IN_PROGRESS", "READY"}, Default: "QUEUED"},
+		{Name: "order_id", Type: field.TypeUUID},
+		{Name: "restaurant_id", Type: field.TypeUUID},
+		{Name: "station_id", Type: field.TypeUUID},
+	}
+	// StationTicketsTable holds the schema information for the "station_tickets" table.
+	StationTicketsTable = &schema.Table{
+		Name:       "station_tickets",
+		Columns:    StationTicketsColumns,
+		PrimaryKey: []*schema.Column{StationTicketsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "station_tickets_orders_station_tickets",
+				Columns:    []*schema.Column{StationTicketsColumns[4]},
+				RefColumns: []*schema.Column{OrdersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "station_tickets_restaurants_station_tickets",
+				Columns:    []*schema.Column{StationTicketsColumns[5]},
+				RefColumns: []*schema.Column{RestaurantsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "station_tickets_stations_tickets",
+				Columns:    []*schema.Column{StationTicketsColumns[6]},
+				RefColumns: []*schema.Column{StationsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "stationticket_station_id_status_create_time",
+				Unique:  false,
+				Columns: []*schema.Column{StationTicketsColumns[6], StationTicketsColumns[3], StationTicketsColumns[2]},
+			},
+		},
+	}
+	// StockMovementsColumns holds the columns for the "stock_movements" table.
+	StockMovementsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "create_time", Type: field.TypeTime},
+		{Name: "kind", Type: field.TypeEnum, Enums: []string{"SALE", "DELIVERY", "WASTE", "COUNT"}},
+		{Name: "quantity", Type: field.TypeInt64},
+		{Name: "on_hand_after", Type: field.TypeInt64},
+		{Name: "note", Type: field.TypeString, Size: 1000, Default: ""},
+		{Name: "order_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "ingredient_id", Type: field.TypeUUID},
+		{Name: "created_by_id", Type: field.TypeUUID, Nullable: true},
+	}
+	// StockMovementsTable holds the schema information for the "stock_movements" table.
+	StockMovementsTable = &schema.Table{
+		Name:       "stock_movements",
+		Columns:    StockMovementsColumns,
+		PrimaryKey: []*schema.Column{StockMovementsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "stock_movements_ingredients_movements",
+				Columns:    []*schema.Column{StockMovementsColumns[7]},
+				RefColumns: []*schema.Column{IngredientsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "stock_movements_users_stock_movements",
+				Columns:    []*schema.Column{StockMovementsColumns[8]},
 				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "stockmovement_ingredient_id_create_time",
+				Unique:  false,
+				Columns: []*schema.Column{StockMovementsColumns[7], StockMovementsColumns[1]},
+			},
+		},
+	}
+	// TablesColumns holds the columns for the "tables" table.
+	TablesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "update_time", Type: field.TypeTime},
+		{Name: "create_time", Type: field.TypeTime},
+		{Name: "name", Type: field.TypeString, Size: 100},
+		{Name: "section", Type: field.TypeString, Size: 100, Default: ""},
+		{Name: "seats", Type: field.TypeInt, Default: 2},
+		{Name: "qr_token", Type: field.TypeString, Unique: true},
+		{Name: "is_active", Type: field.TypeBool, Default: true},
+		{Name: "restaurant_id", Type: field.TypeUUID},
+	}
+	// TablesTable holds the schema information for the "tables" table.
+	TablesTable = &schema.Table{
+		Name:       "tables",
+		Columns:    TablesColumns,
+		PrimaryKey: []*schema.Column{TablesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "tables_restaurants_tables",
+				Columns:    []*schema.Column{TablesColumns[8]},
+				RefColumns: []*schema.Column{RestaurantsColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
 		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "table_restaurant_id_name",
+				Unique:  true,
+				Columns: []*schema.Column{TablesColumns[8], TablesColumns[3]},
+			},
+		},
+	}
+	// TableSessionsColumns holds the columns for the "table_sessions" table.
+	TableSessionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "create_time", Type: field.TypeTime},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"OPEN", "CLOSED"}, Default: "OPEN"},
+		{Name: "closed_at", Type: field.TypeTime, Nullable: true},
+		{Name: "restaurant_id", Type: field.TypeUUID},
+		{Name: "table_id", Type: field.TypeUUID},
+		{Name: "closed_by_id", Type: field.TypeUUID, Nullable: true},
+	}
+	// TableSessionsTable holds the schema information for the "table_sessions" table.
+	TableSessionsTable = &schema.Table{
+		Name:       "table_sessions",
+		Columns:    TableSessionsColumns,
+		PrimaryKey: []*schema.Column{TableSessionsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "table_sessions_restaurants_table_sessions",
+				Columns:    []*schema.Column{TableSessionsColumns[4]},
+				RefColumns: []*schema.Column{RestaurantsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "table_sessions_tables_sessions",
+				Columns:    []*schema.Column{TableSessionsColumns[5]},
+				RefColumns: []*schema.Column{TablesColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "table_sessions_users_closed_table_sessions",
+				Columns:    []*schema.Column{TableSessionsColumns[6]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "tablesession_table_id",
+				Unique:  true,
+				Columns: []*schema.Column{TableSessionsColumns[5]},
+				Annotation: &entsql.IndexAnnotation{
+					Where: "status = 'OPEN'",
+				},
+			},
+		},
 	}
 	// UsersColumns holds the columns for the "users" table.
 	UsersColumns = []*schema.Column{
@@ -346,37 +1231,155 @@ var (
 			},
 		},
 	}
+	// MenuCategoriesColumns holds the columns for the "menu_categories" table.
+	MenuCategoriesColumns = []*schema.Column{
+		{Name: "menu_id", Type: field.TypeUUID},
+		{Name: "category_id", Type: field.TypeUUID},
+	}
+	// MenuCategoriesTable holds the schema information for the "menu_categories" table.
+	MenuCategoriesTable = &schema.Table{
+		Name:       "menu_categories",
+		Columns:    MenuCategoriesColumns,
+		PrimaryKey: []*schema.Column{MenuCategoriesColumns[0], MenuCategoriesColumns[1]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "menu_categories_menu_id",
+				Columns:    []*schema.Column{MenuCategoriesColumns[0]},
+				RefColumns: []*schema.Column{MenusColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "menu_categories_category_id",
+				Columns:    []*schema.Column{MenuCategoriesColumns[1]},
+				RefColumns: []*schema.Column{CategoriesColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+	}
+	// ModifierOptionChildModifiersColumns holds the columns for the "modifier_option_child_modifiers" table.
+	ModifierOptionChildModifiersColumns = []*schema.Column{
+		{Name: "modifier_option_id", Type: field.TypeUUID},
+		{Name: "modifier_id", Type: field.TypeUUID},
+	}
+	// ModifierOptionChildModifiersTable holds the schema information for the "modifier_option_child_modifiers" table.
+	ModifierOptionChildModifiersTable = &schema.Table{
+		Name:       "modifier_option_child_modifiers",
+		Columns:    ModifierOptionChildModifiersColumns,
+		PrimaryKey: []*schema.Column{ModifierOptionChildModifiersColumns[0], ModifierOptionChildModifiersColumns[1]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "modifier_option_child_modifiers_modifier_option_id",
+				Columns:    []*schema.Column{ModifierOptionChildModifiersColumns[0]},
+				RefColumns: []*schema.Column{ModifierOptionsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "modifier_option_child_modifiers_modifier_id",
+				Columns:    []*schema.Column{ModifierOptionChildModifiersColumns[1]},
+				RefColumns: []*schema.Column{ModifiersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+	}
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
 		CategoriesTable,
+		DeliveryZonesTable,
+		IdempotencyKeysTable,
+		IngredientsTable,
+		MenusTable,
 		MenuItemsTable,
+		MenuItemModifiersTable,
+		MenuItemPricesTable,
+		MenuItemVariantsTable,
 		ModifiersTable,
 		ModifierOptionsTable,
 		OrdersTable,
+		OrderEventsTable,
 		OrderItemsTable,
+		OrderItemChangesTable,
 		OrderItemModifierOptionsTable,
+		OrderNumberSequencesTable,
+		OrderStatusEventsTable,
+		PaymentsTable,
+		RateLimitBucketsTable,
+		RecipeIngredientsTable,
 		RefreshTokensTable,
+		RefundsTable,
 		RestaurantsTable,
+		StationsTable,
+		StationTicketsTable,
+		StockMovementsTable,
+		TablesTable,
+		TableSessionsTable,
 		UsersTable,
 		UserAuthProvidersTable,
+		MenuCategoriesTable,
+		ModifierOptionChildModifiersTable,
 	}
 )
 
 func init() {
 	CategoriesTable.ForeignKeys[0].RefTable = RestaurantsTable
+	CategoriesTable.ForeignKeys[1].RefTable = StationsTable
+	DeliveryZonesTable.ForeignKeys[0].RefTable = RestaurantsTable
+	IngredientsTable.ForeignKeys[0].RefTable = RestaurantsTable
+	MenusTable.ForeignKeys[0].RefTable = RestaurantsTable
 	MenuItemsTable.ForeignKeys[0].RefTable = CategoriesTable
-	MenuItemsTable.ForeignKeys[1].RefTable = ModifiersTable
-	MenuItemsTable.ForeignKeys[2].RefTable = RestaurantsTable
-	ModifiersTable.ForeignKeys[0].RefTable = MenuItemsTable
-	ModifiersTable.ForeignKeys[1].RefTable = RestaurantsTable
+	MenuItemsTable.ForeignKeys[1].RefTable = RestaurantsTable
+	MenuItemsTable.ForeignKeys[2].RefTable = StationsTable
+	MenuItemModifiersTable.ForeignKeys[0].RefTable = MenuItemsTable
+	MenuItemModifiersTable.ForeignKeys[1].RefTable = ModifiersTable
+	MenuItemPricesTable.ForeignKeys[0].RefTable = MenusTable
+	MenuItemPricesTable.ForeignKeys[1].RefTable = MenuItemsTable
+	MenuItemVariantsTable.ForeignKeys[0].RefTable = MenuItemsTable
+	ModifiersTable.ForeignKeys[0].RefTable = RestaurantsTable
 	ModifierOptionsTable.ForeignKeys[0].RefTable = ModifiersTable
-	OrdersTable.ForeignKeys[0].RefTable = RestaurantsTable
+	OrdersTable.ForeignKeys[0].RefTable = DeliveryZonesTable
+	OrdersTable.ForeignKeys[1].RefTable = RestaurantsTable
+	OrdersTable.ForeignKeys[2].RefTable = TablesTable
+	OrdersTable.ForeignKeys[3].RefTable = TableSessionsTable
+	OrderEventsTable.ForeignKeys[0].RefTable = RestaurantsTable
 	OrderItemsTable.ForeignKeys[0].RefTable = MenuItemsTable
-	OrderItemsTable.ForeignKeys[1].RefTable = OrdersTable
+	OrderItemsTable.ForeignKeys[1].RefTable = MenuItemVariantsTable
+	OrderItemsTable.ForeignKeys[2].RefTable = OrdersTable
+	OrderItemsTable.ForeignKeys[3].RefTable = StationTicketsTable
+	OrderItemChangesTable.ForeignKeys[0].RefTable = OrdersTable
+	OrderItemChangesTable.ForeignKeys[1].RefTable = OrderItemsTable
+	OrderItemChangesTable.ForeignKeys[2].RefTable = UsersTable
 	OrderItemModifierOptionsTable.ForeignKeys[0].RefTable = ModifierOptionsTable
 	OrderItemModifierOptionsTable.ForeignKeys[1].RefTable = OrderItemsTable
+	OrderItemModifierOptionsTable.ForeignKeys[2].RefTable = OrderItemModifierOptionsTable
+	OrderNumberSequencesTable.ForeignKeys[0].RefTable = RestaurantsTable
+	OrderStatusEventsTable.ForeignKeys[0].RefTable = OrdersTable
+	OrderStatusEventsTable.ForeignKeys[1].RefTable = RestaurantsTable
+	OrderStatusEventsTable.ForeignKeys[2].RefTable = UsersTable
+	PaymentsTable.ForeignKeys[0].RefTable = OrdersTable
+	PaymentsTable.ForeignKeys[1].RefTable = RestaurantsTable
+	PaymentsTable.ForeignKeys[2].RefTable = UsersTable
+	RecipeIngredientsTable.ForeignKeys[0].RefTable = IngredientsTable
+	RecipeIngredientsTable.ForeignKeys[1].RefTable = MenuItemsTable
+	RecipeIngredientsTable.ForeignKeys[2].RefTable = ModifierOptionsTable
 	RefreshTokensTable.ForeignKeys[0].RefTable = RefreshTokensTable
 	RefreshTokensTable.ForeignKeys[1].RefTable = UsersTable
+	RefundsTable.ForeignKeys[0].RefTable = OrdersTable
+	RefundsTable.ForeignKeys[1].RefTable = PaymentsTable
+	RefundsTable.ForeignKeys[2].RefTable = RestaurantsTable
+	RefundsTable.ForeignKeys[3].RefTable = UsersTable
 	RestaurantsTable.ForeignKeys[0].RefTable = UsersTable
+	StationsTable.ForeignKeys[0].RefTable = RestaurantsTable
+	StationTicketsTable.ForeignKeys[0].RefTable = OrdersTable
+	StationTicketsTable.ForeignKeys[1].RefTable = RestaurantsTable
+	StationTicketsTable.ForeignKeys[2].RefTable = StationsTable
+	StockMovementsTable.ForeignKeys[0].RefTable = IngredientsTable
+	StockMovementsTable.ForeignKeys[1].RefTable = UsersTable
+	TablesTable.ForeignKeys[0].RefTable = RestaurantsTable
+	TableSessionsTable.ForeignKeys[0].RefTable = RestaurantsTable
+	TableSessionsTable.ForeignKeys[1].RefTable = TablesTable
+	TableSessionsTable.ForeignKeys[2].RefTable = UsersTable
 	UserAuthProvidersTable.ForeignKeys[0].RefTable = UsersTable
+	MenuCategoriesTable.ForeignKeys[0].RefTable = MenusTable
+	MenuCategoriesTable.ForeignKeys[1].RefTable = CategoriesTable
+	ModifierOptionChildModifiersTable.ForeignKeys[0].RefTable = ModifierOptionsTable
+	ModifierOptionChildModifiersTable.ForeignKeys[1].RefTable = ModifiersTable
 }
@@ -29,13 +29,16 @@ type Modifier struct {
 	MultiSelect bool `json:"multi_select,omitempty"`
 	// Maximum number of selections allowed
 	Max int `json:"max,omitempty"`
+	// Minimum number of selections; required groups need at least one
+	Min int `json:"min,omitempty"`
+	// Number of selections included in the item's price; the cheapest are free
+	FreeQuantity int `json:"free_quantity,omitempty"`
 	// ID of the restaurant this modifier belongs to
 	RestaurantID uuid.UUID `json:"restaurant_id,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the ModifierQuery when eager-loading is set.
-	Edges               ModifierEdges `json:"edges"`
-	menu_item_modifiers *int64
-	selectValues        sql.SelectValues
+	Edges        ModifierEdges `json:"edges"`
+	selectValues sql.SelectValues
 }
 
 // ModifierEdges holds the relations/edges for other nodes in the graph.
@@ -46,9 +49,13 @@ type ModifierEdges struct {
 	ModifierOptions []*ModifierOption `json:"modifier_options,omitempty"`
 	// MenuItems holds the value of the menu_items edge.
 	MenuItems []*MenuItem `json:"menu_items,omitempty"`
+	// ParentOptions holds the value of the parent_options edge.
+	ParentOptions []*ModifierOption `json:"parent_options,omitempty"`
+	// ItemModifiers holds the value of the item_modifiers edge.
+	ItemModifiers []*MenuItemModifier `json:"item_modifiers,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [3]bool
+	loadedTypes [5]bool
 }
 
 // RestaurantOrErr returns the Restaurant value or an error if the edge
@@ -80,6 +87,24 @@ func (e ModifierEdges) MenuItemsOrErr() ([]*MenuItem, error) {
 	return nil, &NotLoadedError{edge: "menu_items"}
 }
 
+// ParentOptionsOrErr returns the ParentOptions value or an error if the edge
+// was not loaded in eager-loading.
+func (e ModifierEdges) ParentOptionsOrErr() ([]*ModifierOption, error) {
+	if e.loadedTypes[3] {
+		return e.ParentOptions, nil
+	}
+	return nil, &NotLoadedError{edge: "parent_options"}
+}
+
+// ItemModifiersOrErr returns the ItemModifiers value or an error if the edge
+// was not loaded in eager-loading.
+func (e ModifierEdges) ItemModifiersOrErr() ([]*MenuItemModifier, error) {
+	if e.loadedTypes[4] {
+		return e.ItemModifiers, nil
+	}
+	return nil, &NotLoadedError{edge: "item_modifiers"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Modifier) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
@@ -87,7 +112,7 @@ func (*Modifier) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case modifier.FieldRequired, modifier.FieldMultiSelect:
 			values[i] = new(sql.NullBool)
-		case modifier.FieldMax:
+		case modifier.FieldMax, modifier.FieldMin, modifier.FieldFreeQuantity:
 			values[i] = new(sql.NullInt64)
 		case modifier.FieldName:
 			values[i] = new(sql.NullString)
@@ -95,8 +120,6 @@ func (*Modifier) scanValues(columns []string) ([]any, error) {
 			values[i] = new(sql.NullTime)
 		case modifier.FieldID, modifier.FieldRestaurantID:
 			values[i] = new(uuid.UUID)
-		case modifier.ForeignKeys[0]: // menu_item_modifiers
-			values[i] = new(sql.NullInt64)
 		default:
 			values[i] = new(sql.UnknownType)
 		}
@@ -148,19 +171,24 @@ func (_m *Modifier) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.Max = int(value.Int64)
 			}
+		case modifier.FieldMin:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field min", values[i])
+			} else if value.Valid {
+				_m.Min = int(value.Int64)
+			}
+		case modifier.FieldFreeQuantity:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field free_quantity", values[i])
+			} else if value.Valid {
+				_m.FreeQuantity = int(value.Int64)
+			}
 		case modifier.FieldRestaurantID:
 			if value, ok := values[i].(*uuid.UUID); !ok {
 				return fmt.Errorf("unexpected type %T for field restaurant_id", values[i])
 			} else if value != nil {
 				_m.RestaurantID = *value
 			}
-		case modifier.ForeignKeys[0]:
-			if value, ok := values[i].(*sql.NullInt64); !ok {
-				return fmt.Errorf("unexpected type %T for edge-field menu_item_modifiers", value)
-			} else if value.Valid {
-				_m.menu_item_modifiers = new(int64)
-				*_m.menu_item_modifiers = int64(value.Int64)
-			}
 		default:
 			_m.selectValues.Set(columns[i], values[i])
 		}
@@ -189,6 +217,16 @@ func (_m *Modifier) QueryMenuItems() *MenuItemQuery {
 	return NewModifierClient(_m.config).QueryMenuItems(_m)
 }
 
+// QueryParentOptions queries the "parent_options" edge of the Modifier entity.
+func (_m *Modifier) QueryParentOptions() *ModifierOptionQuery {
+	return NewModifierClient(_m.config).QueryParentOptions(_m)
+}
+
+// QueryItemModifiers queries the "item_modifiers" edge of the Modifier entity.
+func (_m *Modifier) QueryItemModifiers() *MenuItemModifierQuery {
+	return NewModifierClient(_m.config).QueryItemModifiers(_m)
+}
+
 // Update returns a builder for updating this Modifier.
 // Note that you need to call Modifier.Unwrap() before calling this method if this Modifier
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -227,6 +265,12 @@ func (_m *Modifier) String() string {
 	builder.WriteString("max=")
 	builder.WriteString(fmt.Sprintf("%v", _m.Max))
 	builder.WriteString(", ")
+	builder.WriteString("min=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Min))
+	builder.WriteString(", ")
+	builder.WriteString("free_quantity=")
+	builder.WriteString(fmt.Sprintf("%v", _m.FreeQuantity))
+	builder.WriteString(", ")
 	builder.WriteString("restaurant_id=")
 	builder.WriteString(fmt.Sprintf("%v", _m.RestaurantID))
 	builder.WriteByte(')')
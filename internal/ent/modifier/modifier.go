@@ -25,6 +25,10 @@ const (
 	FieldMultiSelect = "multi_select"
 	// FieldMax holds the string denoting the max field in the database.
 	FieldMax = "max"
+	// FieldMin holds the string denoting the min field in the database.
+	FieldMin = "min"
+	// FieldFreeQuantity holds the string denoting the free_quantity field in the database.
+	FieldFreeQuantity = "free_quantity"
 	// FieldRestaurantID holds the string denoting the restaurant_id field in the database.
 	FieldRestaurantID = "restaurant_id"
 	// EdgeRestaurant holds the string denoting the restaurant edge name in mutations.
@@ -33,6 +37,10 @@ const (
 	EdgeModifierOptions = "modifier_options"
 	// EdgeMenuItems holds the string denoting the menu_items edge name in mutations.
 	EdgeMenuItems = "menu_items"
+	// EdgeParentOptions holds the string denoting the parent_options edge name in mutations.
+	EdgeParentOptions = "parent_options"
+	// EdgeItemModifiers holds the string denoting the item_modifiers edge name in mutations.
+	EdgeItemModifiers = "item_modifiers"
 	// Table holds the table name of the modifier in the database.
 	Table = "modifiers"
 	// RestaurantTable is the table that holds the restaurant relation/edge.
@@ -49,13 +57,23 @@ const (
 	ModifierOptionsInverseTable = "modifier_options"
 	// ModifierOptionsColumn is the table column denoting the modifier_options relation/edge.
 	ModifierOptionsColumn = "modifier_id"
-	// MenuItemsTable is the table that holds the menu_items relation/edge.
-	MenuItemsTable = "menu_items"
+	// MenuItemsTable is the table that holds the menu_items relation/edge. The primary key declared below.
+	MenuItemsTable = "menu_item_modifiers"
 	// MenuItemsInverseTable is the table name for the MenuItem entity.
 	// It exists in this package in order to avoid circular dependency with the "menuitem" package.
 	MenuItemsInverseTable = "menu_items"
-	// MenuItemsColumn is the table column denoting the menu_items relation/edge.
-	MenuItemsColumn = "modifier_menu_items"
+	// ParentOptionsTable is the table that holds the parent_options relation/edge. The primary key declared below.
+	ParentOptionsTable = "modifier_option_child_modifiers"
+	// ParentOptionsInverseTable is the table name for the ModifierOption entity.
+	// It exists in this package in order to avoid circular dependency with the "modifieroption" package.
+	ParentOptionsInverseTable = "modifier_options"
+	// ItemModifiersTable is the table that holds the item_modifiers relation/edge.
+	ItemModifiersTable = "menu_item_modifiers"
+	// ItemModifiersInverseTable is the table name for the MenuItemModifier entity.
+	// It exists in this package in order to avoid circular dependency with the "menuitemmodifier" package.
+	ItemModifiersInverseTable = "menu_item_modifiers"
+	// ItemModifiersColumn is the table column denoting the item_modifiers relation/edge.
+	ItemModifiersColumn = "modifier_id"
 )
 
 // Columns holds all SQL columns for modifier fields.
@@ -66,14 +84,19 @@ var Columns = []string{
 	FieldRequired,
 	FieldMultiSelect,
 	FieldMax,
+	FieldMin,
+	FieldFreeQuantity,
 	FieldRestaurantID,
 }
 
-// ForeignKeys holds the SQL foreign-keys that are owned by the "modifiers"
-// table and are not defined as standalone fields in the schema.
-var ForeignKeys = []string{
-	"menu_item_modifiers",
-}
+var (
+	// MenuItemsPrimaryKey and MenuItemsColumn2 are the table columns denoting the
+	// primary key for the menu_items relation (M2M).
+	MenuItemsPrimaryKey = []string{"menu_item_id", "modifier_id"}
+	// ParentOptionsPrimaryKey and ParentOptionsColumn2 are the table columns denoting the
+	// primary key for the parent_options relation (M2M).
+	ParentOptionsPrimaryKey = []string{"modifier_option_id", "modifier_id"}
+)
 
 // ValidColumn reports if the column name is valid (part of the table columns).
 func ValidColumn(column string) bool {
@@ -82,11 +105,6 @@ func ValidColumn(column string) bool {
 			return true
 		}
 	}
-	for i := range ForeignKeys {
-		if column == ForeignKeys[i] {
-			return true
-		}
-	}
 	return false
 }
 
@@ -105,6 +123,14 @@ var (
 	DefaultMax int
 	// MaxValidator is a validator for the "max" field. It is called by the builders before save.
 	MaxValidator func(int) error
+	// DefaultMin holds the default value on creation for the "min" field.
+	DefaultMin int
+	// MinValidator is a validator for the "min" field. It is called by the builders before save.
+	MinValidator func(int) error
+	// DefaultFreeQuantity holds the default value on creation for the "free_quantity" field.
+	DefaultFreeQuantity int
+	// FreeQuantityValidator is a validator for the "free_quantity" field. It is called by the builders before save.
+	FreeQuantityValidator func(int) error
 	// DefaultID holds the default value on creation for the "id" field.
 	DefaultID func() uuid.UUID
 )
@@ -142,6 +168,16 @@ func ByMax(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldMax, opts...).ToFunc()
 }
 
+// ByMin orders the results by the min field.
+func ByMin(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMin, opts...).ToFunc()
+}
+
+// ByFreeQuantity orders the results by the free_quantity field.
+func ByFreeQuantity(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFreeQuantity, opts...).ToFunc()
+}
+
 // ByRestaurantID orders the results by the restaurant_id field.
 func ByRestaurantID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldRestaurantID, opts...).ToFunc()
@@ -181,6 +217,34 @@ func ByMenuItems(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newMenuItemsStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
+
+// ByParentOptionsCount orders the results by parent_options count.
+func ByParentOptionsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newParentOptionsStep(), opts...)
+	}
+}
+
+// ByParentOptions orders the results by parent_options terms.
+func ByParentOptions(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newParentOptionsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByItemModifiersCount orders the results by item_modifiers count.
+func ByItemModifiersCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newItemModifiersStep(), opts...)
+	}
+}
+
+// ByItemModifiers orders the results by item_modifiers terms.
+func ByItemModifiers(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newItemModifiersStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newRestaurantStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -199,6 +263,20 @@ func newMenuItemsStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
 		sqlgraph.To(MenuItemsInverseTable, FieldID),
-		sqlgraph.Edge(sqlgraph.O2M, false, MenuItemsTable, MenuItemsColumn),
+		sqlgraph.Edge(sqlgraph.M2M, true, MenuItemsTable, MenuItemsPrimaryKey...),
+	)
+}
+func newParentOptionsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ParentOptionsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2M, true, ParentOptionsTable, ParentOptionsPrimaryKey...),
+	)
+}
+func newItemModifiersStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ItemModifiersInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, true, ItemModifiersTable, ItemModifiersColumn),
 	)
 }
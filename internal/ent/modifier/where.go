@@ -81,6 +81,16 @@ func Max(v int) predicate.Modifier {
 	return predicate.Modifier(sql.FieldEQ(FieldMax, v))
 }
 
+// Min applies equality check predicate on the "min" field. It's identical to MinEQ.
+func Min(v int) predicate.Modifier {
+	return predicate.Modifier(sql.FieldEQ(FieldMin, v))
+}
+
+// FreeQuantity applies equality check predicate on the "free_quantity" field. It's identical to FreeQuantityEQ.
+func FreeQuantity(v int) predicate.Modifier {
+	return predicate.Modifier(sql.FieldEQ(FieldFreeQuantity, v))
+}
+
 // RestaurantID applies equality check predicate on the "restaurant_id" field. It's identical to RestaurantIDEQ.
 func RestaurantID(v uuid.UUID) predicate.Modifier {
 	return predicate.Modifier(sql.FieldEQ(FieldRestaurantID, v))
@@ -251,6 +261,86 @@ func MaxLTE(v int) predicate.Modifier {
 	return predicate.Modifier(sql.FieldLTE(FieldMax, v))
 }
 
+// MinEQ applies the EQ predicate on the "min" field.
+func MinEQ(v int) predicate.Modifier {
+	return predicate.Modifier(sql.FieldEQ(FieldMin, v))
+}
+
+// MinNEQ applies the NEQ predicate on the "min" field.
+func MinNEQ(v int) predicate.Modifier {
+	return predicate.Modifier(sql.FieldNEQ(FieldMin, v))
+}
+
+// MinIn applies the In predicate on the "min" field.
+func MinIn(vs ...int) predicate.Modifier {
+	return predicate.Modifier(sql.FieldIn(FieldMin, vs...))
+}
+
+// MinNotIn applies the NotIn predicate on the "min" field.
+func MinNotIn(vs ...int) predicate.Modifier {
+	return predicate.Modifier(sql.FieldNotIn(FieldMin, vs...))
+}
+
+// MinGT applies the GT predicate on the "min" field.
+func MinGT(v int) predicate.Modifier {
+	return predicate.Modifier(sql.FieldGT(FieldMin, v))
+}
+
+// MinGTE applies the GTE predicate on the "min" field.
+func MinGTE(v int) predicate.Modifier {
+	return predicate.Modifier(sql.FieldGTE(FieldMin, v))
+}
+
+// MinLT applies the LT predicate on the "min" field.
+func MinLT(v int) predicate.Modifier {
+	return predicate.Modifier(sql.FieldLT(FieldMin, v))
+}
+
+// MinLTE applies the LTE predicate on the "min" field.
+func MinLTE(v int) predicate.Modifier {
+	return predicate.Modifier(sql.FieldLTE(FieldMin, v))
+}
+
+// FreeQuantityEQ applies the EQ predicate on the "free_quantity" field.
+func FreeQuantityEQ(v int) predicate.Modifier {
+	return predicate.Modifier(sql.FieldEQ(FieldFreeQuantity, v))
+}
+
+// FreeQuantityNEQ applies the NEQ predicate on the "free_quantity" field.
+func FreeQuantityNEQ(v int) predicate.Modifier {
+	return predicate.Modifier(sql.FieldNEQ(FieldFreeQuantity, v))
+}
+
+// FreeQuantityIn applies the In predicate on the "free_quantity" field.
+func FreeQuantityIn(vs ...int) predicate.Modifier {
+	return predicate.Modifier(sql.FieldIn(FieldFreeQuantity, vs...))
+}
+
+// FreeQuantityNotIn applies the NotIn predicate on the "free_quantity" field.
+func FreeQuantityNotIn(vs ...int) predicate.Modifier {
+	return predicate.Modifier(sql.FieldNotIn(FieldFreeQuantity, vs...))
+}
+
+// FreeQuantityGT applies the GT predicate on the "free_quantity" field.
+func FreeQuantityGT(v int) predicate.Modifier {
+	return predicate.Modifier(sql.FieldGT(FieldFreeQuantity, v))
+}
+
+// FreeQuantityGTE applies the GTE predicate on the "free_quantity" field.
+func FreeQuantityGTE(v int) predicate.Modifier {
+	return predicate.Modifier(sql.FieldGTE(FieldFreeQuantity, v))
+}
+
+// FreeQuantityLT applies the LT predicate on the "free_quantity" field.
+func FreeQuantityLT(v int) predicate.Modifier {
+	return predicate.Modifier(sql.FieldLT(FieldFreeQuantity, v))
+}
+
+// FreeQuantityLTE applies the LTE predicate on the "free_quantity" field.
+func FreeQuantityLTE(v int) predicate.Modifier {
+	return predicate.Modifier(sql.FieldLTE(FieldFreeQuantity, v))
+}
+
 // RestaurantIDEQ applies the EQ predicate on the "restaurant_id" field.
 func RestaurantIDEQ(v uuid.UUID) predicate.Modifier {
 	return predicate.Modifier(sql.FieldEQ(FieldRestaurantID, v))
@@ -322,7 +412,7 @@ func HasMenuItems() predicate.Modifier {
 	return predicate.Modifier(func(s *sql.Selector) {
 		step := sqlgraph.NewStep(
 			sqlgraph.From(Table, FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, MenuItemsTable, MenuItemsColumn),
+			sqlgraph.Edge(sqlgraph.M2M, true, MenuItemsTable, MenuItemsPrimaryKey...),
 		)
 		sqlgraph.HasNeighbors(s, step)
 	})
@@ -340,6 +430,52 @@ func HasMenuItemsWith(preds ...predicate.MenuItem) predicate.Modifier {
 	})
 }
 
+// HasParentOptions applies the HasEdge predicate on the "parent_options" edge.
+func HasParentOptions() predicate.Modifier {
+	return predicate.Modifier(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, ParentOptionsTable, ParentOptionsPrimaryKey...),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasParentOptionsWith applies the HasEdge predicate on the "parent_options" edge with a given conditions (other predicates).
+func HasParentOptionsWith(preds ...predicate.ModifierOption) predicate.Modifier {
+	return predicate.Modifier(func(s *sql.Selector) {
+		step := newParentOptionsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasItemModifiers applies the HasEdge predicate on the "item_modifiers" edge.
+func HasItemModifiers() predicate.Modifier {
+	return predicate.Modifier(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, ItemModifiersTable, ItemModifiersColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasItemModifiersWith applies the HasEdge predicate on the "item_modifiers" edge with a given conditions (other predicates).
+func HasItemModifiersWith(preds ...predicate.MenuItemModifier) predicate.Modifier {
+	return predicate.Modifier(func(s *sql.Selector) {
+		step := newItemModifiersStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Modifier) predicate.Modifier {
 	return predicate.Modifier(sql.AndPredicates(predicates...))
@@ -11,6 +11,7 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/Jiruu246/rms/internal/ent/menuitem"
+	"github.com/Jiruu246/rms/internal/ent/menuitemmodifier"
 	"github.com/Jiruu246/rms/internal/ent/modifier"
 	"github.com/Jiruu246/rms/internal/ent/modifieroption"
 	"github.com/Jiruu246/rms/internal/ent/restaurant"
@@ -86,6 +87,34 @@ func (_c *ModifierCreate) SetNillableMax(v *int) *ModifierCreate {
 	return _c
 }
 
+// SetMin sets the "min" field.
+func (_c *ModifierCreate) SetMin(v int) *ModifierCreate {
+	_c.mutation.SetMin(v)
+	return _c
+}
+
+// SetNillableMin sets the "min" field if the given value is not nil.
+func (_c *ModifierCreate) SetNillableMin(v *int) *ModifierCreate {
+	if v != nil {
+		_c.SetMin(*v)
+	}
+	return _c
+}
+
+// SetFreeQuantity sets the "free_quantity" field.
+func (_c *ModifierCreate) SetFreeQuantity(v int) *ModifierCreate {
+	_c.mutation.SetFreeQuantity(v)
+	return _c
+}
+
+// SetNillableFreeQuantity sets the "free_quantity" field if the given value is not nil.
+func (_c *ModifierCreate) SetNillableFreeQuantity(v *int) *ModifierCreate {
+	if v != nil {
+		_c.SetFreeQuantity(*v)
+	}
+	return _c
+}
+
 // SetRestaurantID sets the "restaurant_id" field.
 func (_c *ModifierCreate) SetRestaurantID(v uuid.UUID) *ModifierCreate {
 	_c.mutation.SetRestaurantID(v)
@@ -141,6 +170,36 @@ func (_c *ModifierCreate) AddMenuItems(v ...*MenuItem) *ModifierCreate {
 	return _c.AddMenuItemIDs(ids...)
 }
 
+// AddParentOptionIDs adds the "parent_options" edge to the ModifierOption entity by IDs.
+func (_c *ModifierCreate) AddParentOptionIDs(ids ...uuid.UUID) *ModifierCreate {
+	_c.mutation.AddParentOptionIDs(ids...)
+	return _c
+}
+
+// AddParentOptions adds the "parent_options" edges to the ModifierOption entity.
+func (_c *ModifierCreate) AddParentOptions(v ...*ModifierOption) *ModifierCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddParentOptionIDs(ids...)
+}
+
+// AddItemModifierIDs adds the "item_modifiers" edge to the MenuItemModifier entity by IDs.
+func (_c *ModifierCreate) AddItemModifierIDs(ids ...uuid.UUID) *ModifierCreate {
+	_c.mutation.AddItemModifierIDs(ids...)
+	return _c
+}
+
+// AddItemModifiers adds the "item_modifiers" edges to the MenuItemModifier entity.
+func (_c *ModifierCreate) AddItemModifiers(v ...*MenuItemModifier) *ModifierCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddItemModifierIDs(ids...)
+}
+
 // Mutation returns the ModifierMutation object of the builder.
 func (_c *ModifierCreate) Mutation() *ModifierMutation {
 	return _c.mutation
@@ -192,6 +251,14 @@ func (_c *ModifierCreate) defaults() {
 		v := modifier.DefaultMax
 		_c.mutation.SetMax(v)
 	}
+	if _, ok := _c.mutation.Min(); !ok {
+		v := modifier.DefaultMin
+		_c.mutation.SetMin(v)
+	}
+	if _, ok := _c.mutation.FreeQuantity(); !ok {
+		v := modifier.DefaultFreeQuantity
+		_c.mutation.SetFreeQuantity(v)
+	}
 	if _, ok := _c.mutation.ID(); !ok {
 		v := modifier.DefaultID()
 		_c.mutation.SetID(v)
@@ -225,6 +292,22 @@ func (_c *ModifierCreate) check() error {
 			return &ValidationError{Name: "max", err: fmt.Errorf(`ent: validator failed for field "Modifier.max": %w`, err)}
 		}
 	}
+	if _, ok := _c.mutation.Min(); !ok {
+		return &ValidationError{Name: "min", err: errors.New(`ent: missing required field "Modifier.min"`)}
+	}
+	if v, ok := _c.mutation.Min(); ok {
+		if err := modifier.MinValidator(v); err != nil {
+			return &ValidationError{Name: "min", err: fmt.Errorf(`ent: validator failed for field "Modifier.min": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.FreeQuantity(); !ok {
+		return &ValidationError{Name: "free_quantity", err: errors.New(`ent: missing required field "Modifier.free_quantity"`)}
+	}
+	if v, ok := _c.mutation.FreeQuantity(); ok {
+		if err := modifier.FreeQuantityValidator(v); err != nil {
+			return &ValidationError{Name: "free_quantity", err: fmt.Errorf(`ent: validator failed for field "Modifier.free_quantity": %w`, err)}
+		}
+	}
 	if _, ok := _c.mutation.RestaurantID(); !ok {
 		return &ValidationError{Name: "restaurant_id", err: errors.New(`ent: missing required field "Modifier.restaurant_id"`)}
 	}
@@ -286,6 +369,14 @@ func (_c *ModifierCreate) createSpec() (*Modifier, *sqlgraph.CreateSpec) {
 		_spec.SetField(modifier.FieldMax, field.TypeInt, value)
 		_node.Max = value
 	}
+	if value, ok := _c.mutation.Min(); ok {
+		_spec.SetField(modifier.FieldMin, field.TypeInt, value)
+		_node.Min = value
+	}
+	if value, ok := _c.mutation.FreeQuantity(); ok {
+		_spec.SetField(modifier.FieldFreeQuantity, field.TypeInt, value)
+		_node.FreeQuantity = value
+	}
 	if nodes := _c.mutation.RestaurantIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -321,10 +412,10 @@ func (_c *ModifierCreate) createSpec() (*Modifier, *sqlgraph.CreateSpec) {
 	}
 	if nodes := _c.mutation.MenuItemsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
-			Inverse: false,
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
 			Table:   modifier.MenuItemsTable,
-			Columns: []string{modifier.MenuItemsColumn},
+			Columns: modifier.MenuItemsPrimaryKey,
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
 				IDSpec: sqlgraph.NewFieldSpec(menuitem.FieldID, field.TypeInt64),
@@ -333,6 +424,45 @@ func (_c *ModifierCreate) createSpec() (*Modifier, *sqlgraph.CreateSpec) {
 		for _, k := range nodes {
 			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
+		createE := &MenuItemModifierCreate{config: _c.config, mutation: newMenuItemModifierMutation(_c.config, OpCreate)}
+		createE.defaults()
+		_, specE := createE.createSpec()
+		edge.Target.Fields = specE.Fields
+		if specE.ID.Value != nil {
+			edge.Target.Fields = append(edge.Target.Fields, specE.ID)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.ParentOptionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   modifier.ParentOptionsTable,
+			Columns: modifier.ParentOptionsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(modifieroption.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.ItemModifiersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   modifier.ItemModifiersTable,
+			Columns: []string{modifier.ItemModifiersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(menuitemmodifier.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
 	return _node, _spec
@@ -13,6 +13,7 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/Jiruu246/rms/internal/ent/menuitem"
+	"github.com/Jiruu246/rms/internal/ent/menuitemmodifier"
 	"github.com/Jiruu246/rms/internal/ent/modifier"
 	"github.com/Jiruu246/rms/internal/ent/modifieroption"
 	"github.com/Jiruu246/rms/internal/ent/predicate"
@@ -30,7 +31,8 @@ type ModifierQuery struct {
 	withRestaurant      *RestaurantQuery
 	withModifierOptions *ModifierOptionQuery
 	withMenuItems       *MenuItemQuery
-	withFKs             bool
+	withParentOptions   *ModifierOptionQuery
+	withItemModifiers   *MenuItemModifierQuery
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -125,7 +127,51 @@ func (_q *ModifierQuery) QueryMenuItems() *MenuItemQuery {
 		step := sqlgraph.NewStep(
 			sqlgraph.From(modifier.Table, modifier.FieldID, selector),
 			sqlgraph.To(menuitem.Table, menuitem.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, modifier.MenuItemsTable, modifier.MenuItemsColumn),
+			sqlgraph.Edge(sqlgraph.M2M, true, modifier.MenuItemsTable, modifier.MenuItemsPrimaryKey...),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryParentOptions chains the current query on the "parent_options" edge.
+func (_q *ModifierQuery) QueryParentOptions() *ModifierOptionQuery {
+	query := (&ModifierOptionClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(modifier.Table, modifier.FieldID, selector),
+			sqlgraph.To(modifieroption.Table, modifieroption.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, modifier.ParentOptionsTable, modifier.ParentOptionsPrimaryKey...),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryItemModifiers chains the current query on the "item_modifiers" edge.
+func (_q *ModifierQuery) QueryItemModifiers() *MenuItemModifierQuery {
+	query := (&MenuItemModifierClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(modifier.Table, modifier.FieldID, selector),
+			sqlgraph.To(menuitemmodifier.Table, menuitemmodifier.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, modifier.ItemModifiersTable, modifier.ItemModifiersColumn),
 		)
 		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
 		return fromU, nil
@@ -328,6 +374,8 @@ func (_q *ModifierQuery) Clone() *ModifierQuery {
 		withRestaurant:      _q.withRestaurant.Clone(),
 		withModifierOptions: _q.withModifierOptions.Clone(),
 		withMenuItems:       _q.withMenuItems.Clone(),
+		withParentOptions:   _q.withParentOptions.Clone(),
+		withItemModifiers:   _q.withItemModifiers.Clone(),
 		// clone intermediate query.
 		sql:  _q.sql.Clone(),
 		path: _q.path,
@@ -367,6 +415,28 @@ func (_q *ModifierQuery) WithMenuItems(opts ...func(*MenuItemQuery)) *ModifierQu
 	return _q
 }
 
+// WithParentOptions tells the query-builder to eager-load the nodes that are connected to
+// the "parent_options" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *ModifierQuery) WithParentOptions(opts ...func(*ModifierOptionQuery)) *ModifierQuery {
+	query := (&ModifierOptionClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withParentOptions = query
+	return _q
+}
+
+// WithItemModifiers tells the query-builder to eager-load the nodes that are connected to
+// the "item_modifiers" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *ModifierQuery) WithItemModifiers(opts ...func(*MenuItemModifierQuery)) *ModifierQuery {
+	query := (&MenuItemModifierClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withItemModifiers = query
+	return _q
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -444,17 +514,15 @@ func (_q *ModifierQuery) prepareQuery(ctx context.Context) error {
 func (_q *ModifierQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Modifier, error) {
 	var (
 		nodes       = []*Modifier{}
-		withFKs     = _q.withFKs
 		_spec       = _q.querySpec()
-		loadedTypes = [3]bool{
+		loadedTypes = [5]bool{
 			_q.withRestaurant != nil,
 			_q.withModifierOptions != nil,
 			_q.withMenuItems != nil,
+			_q.withParentOptions != nil,
+			_q.withItemModifiers != nil,
 		}
 	)
-	if withFKs {
-		_spec.Node.Columns = append(_spec.Node.Columns, modifier.ForeignKeys...)
-	}
 	_spec.ScanValues = func(columns []string) ([]any, error) {
 		return (*Modifier).scanValues(nil, columns)
 	}
@@ -493,6 +561,20 @@ func (_q *ModifierQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Mod
 			return nil, err
 		}
 	}
+	if query := _q.withParentOptions; query != nil {
+		if err := _q.loadParentOptions(ctx, query, nodes,
+			func(n *Modifier) { n.Edges.ParentOptions = []*ModifierOption{} },
+			func(n *Modifier, e *ModifierOption) { n.Edges.ParentOptions = append(n.Edges.ParentOptions, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withItemModifiers; query != nil {
+		if err := _q.loadItemModifiers(ctx, query, nodes,
+			func(n *Modifier) { n.Edges.ItemModifiers = []*MenuItemModifier{} },
+			func(n *Modifier, e *MenuItemModifier) { n.Edges.ItemModifiers = append(n.Edges.ItemModifiers, e) }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -556,6 +638,128 @@ func (_q *ModifierQuery) loadModifierOptions(ctx context.Context, query *Modifie
 	return nil
 }
 func (_q *ModifierQuery) loadMenuItems(ctx context.Context, query *MenuItemQuery, nodes []*Modifier, init func(*Modifier), assign func(*Modifier, *MenuItem)) error {
+	edgeIDs := make([]driver.Value, len(nodes))
+	byID := make(map[uuid.UUID]*Modifier)
+	nids := make(map[int64]map[*Modifier]struct{})
+	for i, node := range nodes {
+		edgeIDs[i] = node.ID
+		byID[node.ID] = node
+		if init != nil {
+			init(node)
+		}
+	}
+	query.Where(func(s *sql.Selector) {
+		joinT := sql.Table(modifier.MenuItemsTable)
+		s.Join(joinT).On(s.C(menuitem.FieldID), joinT.C(modifier.MenuItemsPrimaryKey[0]))
+		s.Where(sql.InValues(joinT.C(modifier.MenuItemsPrimaryKey[1]), edgeIDs...))
+		columns := s.SelectedColumns()
+		s.Select(joinT.C(modifier.MenuItemsPrimaryKey[1]))
+		s.AppendSelect(columns...)
+		s.SetDistinct(false)
+	})
+	if err := query.prepareQuery(ctx); err != nil {
+		return err
+	}
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		return query.sqlAll(ctx, func(_ context.Context, spec *sqlgraph.QuerySpec) {
+			assign := spec.Assign
+			values := spec.ScanValues
+			spec.ScanValues = func(columns []string) ([]any, error) {
+				values, err := values(columns[1:])
+				if err != nil {
+					return nil, err
+				}
+				return append([]any{new(uuid.UUID)}, values...), nil
+			}
+			spec.Assign = func(columns []string, values []any) error {
+				outValue := *values[0].(*uuid.UUID)
+				inValue := values[1].(*sql.NullInt64).Int64
+				if nids[inValue] == nil {
+					nids[inValue] = map[*Modifier]struct{}{byID[outValue]: {}}
+					return assign(columns[1:], values[1:])
+				}
+				nids[inValue][byID[outValue]] = struct{}{}
+				return nil
+			}
+		})
+	})
+	neighbors, err := withInterceptors[[]*MenuItem](ctx, query, qr, query.inters)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected "menu_items" node returned %v`, n.ID)
+		}
+		for kn := range nodes {
+			assign(kn, n)
+		}
+	}
+	return nil
+}
+func (_q *ModifierQuery) loadParentOptions(ctx context.Context, query *ModifierOptionQuery, nodes []*Modifier, init func(*Modifier), assign func(*Modifier, *ModifierOption)) error {
+	edgeIDs := make([]driver.Value, len(nodes))
+	byID := make(map[uuid.UUID]*Modifier)
+	nids := make(map[uuid.UUID]map[*Modifier]struct{})
+	for i, node := range nodes {
+		edgeIDs[i] = node.ID
+		byID[node.ID] = node
+		if init != nil {
+			init(node)
+		}
+	}
+	query.Where(func(s *sql.Selector) {
+		joinT := sql.Table(modifier.ParentOptionsTable)
+		s.Join(joinT).On(s.C(modifieroption.FieldID), joinT.C(modifier.ParentOptionsPrimaryKey[0]))
+		s.Where(sql.InValues(joinT.C(modifier.ParentOptionsPrimaryKey[1]), edgeIDs...))
+		columns := s.SelectedColumns()
+		s.Select(joinT.C(modifier.ParentOptionsPrimaryKey[1]))
+		s.AppendSelect(columns...)
+		s.SetDistinct(false)
+	})
+	if err := query.prepareQuery(ctx); err != nil {
+		return err
+	}
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		return query.sqlAll(ctx, func(_ context.Context, spec *sqlgraph.QuerySpec) {
+			assign := spec.Assign
+			values := spec.ScanValues
+			spec.ScanValues = func(columns []string) ([]any, error) {
+				values, err := values(columns[1:])
+				if err != nil {
+					return nil, err
+				}
+				return append([]any{new(uuid.UUID)}, values...), nil
+			}
+			spec.Assign = func(columns []string, values []any) error {
+				outValue := *values[0].(*uuid.UUID)
+				inValue := *values[1].(*uuid.UUID)
+				if nids[inValue] == nil {
+					nids[inValue] = map[*Modifier]struct{}{byID[outValue]: {}}
+					return assign(columns[1:], values[1:])
+				}
+				nids[inValue][byID[outValue]] = struct{}{}
+				return nil
+			}
+		})
+	})
+	neighbors, err := withInterceptors[[]*ModifierOption](ctx, query, qr, query.inters)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected "parent_options" node returned %v`, n.ID)
+		}
+		for kn := range nodes {
+			assign(kn, n)
+		}
+	}
+	return nil
+}
+func (_q *ModifierQuery) loadItemModifiers(ctx context.Context, query *MenuItemModifierQuery, nodes []*Modifier, init func(*Modifier), assign func(*Modifier, *MenuItemModifier)) error {
 	fks := make([]driver.Value, 0, len(nodes))
 	nodeids := make(map[uuid.UUID]*Modifier)
 	for i := range nodes {
@@ -565,22 +769,21 @@ func (_q *ModifierQuery) loadMenuItems(ctx context.Context, query *MenuItemQuery
 			init(nodes[i])
 		}
 	}
-	query.withFKs = true
-	query.Where(predicate.MenuItem(func(s *sql.Selector) {
-		s.Where(sql.InValues(s.C(modifier.MenuItemsColumn), fks...))
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(menuitemmodifier.FieldModifierID)
+	}
+	query.Where(predicate.MenuItemModifier(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(modifier.ItemModifiersColumn), fks...))
 	}))
 	neighbors, err := query.All(ctx)
 	if err != nil {
 		return err
 	}
 	for _, n := range neighbors {
-		fk := n.modifier_menu_items
-		if fk == nil {
-			return fmt.Errorf(`foreign-key "modifier_menu_items" is nil for node %v`, n.ID)
-		}
-		node, ok := nodeids[*fk]
+		fk := n.ModifierID
+		node, ok := nodeids[fk]
 		if !ok {
-			return fmt.Errorf(`unexpected referenced foreign-key "modifier_menu_items" returned %v for node %v`, *fk, n.ID)
+			return fmt.Errorf(`unexpected referenced foreign-key "modifier_id" returned %v for node %v`, fk, n.ID)
 		}
 		assign(node, n)
 	}
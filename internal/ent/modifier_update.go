@@ -12,6 +12,7 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/Jiruu246/rms/internal/ent/menuitem"
+	"github.com/Jiruu246/rms/internal/ent/menuitemmodifier"
 	"github.com/Jiruu246/rms/internal/ent/modifier"
 	"github.com/Jiruu246/rms/internal/ent/modifieroption"
 	"github.com/Jiruu246/rms/internal/ent/predicate"
@@ -101,6 +102,48 @@ func (_u *ModifierUpdate) AddMax(v int) *ModifierUpdate {
 	return _u
 }
 
+// SetMin sets the "min" field.
+func (_u *ModifierUpdate) SetMin(v int) *ModifierUpdate {
+	_u.mutation.ResetMin()
+	_u.mutation.SetMin(v)
+	return _u
+}
+
+// SetNillableMin sets the "min" field if the given value is not nil.
+func (_u *ModifierUpdate) SetNillableMin(v *int) *ModifierUpdate {
+	if v != nil {
+		_u.SetMin(*v)
+	}
+	return _u
+}
+
+// AddMin adds value to the "min" field.
+func (_u *ModifierUpdate) AddMin(v int) *ModifierUpdate {
+	_u.mutation.AddMin(v)
+	return _u
+}
+
+// SetFreeQuantity sets the "free_quantity" field.
+func (_u *ModifierUpdate) SetFreeQuantity(v int) *ModifierUpdate {
+	_u.mutation.ResetFreeQuantity()
+	_u.mutation.SetFreeQuantity(v)
+	return _u
+}
+
+// SetNillableFreeQuantity sets the "free_quantity" field if the given value is not nil.
+func (_u *ModifierUpdate) SetNillableFreeQuantity(v *int) *ModifierUpdate {
+	if v != nil {
+		_u.SetFreeQuantity(*v)
+	}
+	return _u
+}
+
+// AddFreeQuantity adds value to the "free_quantity" field.
+func (_u *ModifierUpdate) AddFreeQuantity(v int) *ModifierUpdate {
+	_u.mutation.AddFreeQuantity(v)
+	return _u
+}
+
 // SetRestaurantID sets the "restaurant_id" field.
 func (_u *ModifierUpdate) SetRestaurantID(v uuid.UUID) *ModifierUpdate {
 	_u.mutation.SetRestaurantID(v)
@@ -150,6 +193,36 @@ func (_u *ModifierUpdate) AddMenuItems(v ...*MenuItem) *ModifierUpdate {
 	return _u.AddMenuItemIDs(ids...)
 }
 
+// AddParentOptionIDs adds the "parent_options" edge to the ModifierOption entity by IDs.
+func (_u *ModifierUpdate) AddParentOptionIDs(ids ...uuid.UUID) *ModifierUpdate {
+	_u.mutation.AddParentOptionIDs(ids...)
+	return _u
+}
+
+// AddParentOptions adds the "parent_options" edges to the ModifierOption entity.
+func (_u *ModifierUpdate) AddParentOptions(v ...*ModifierOption) *ModifierUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddParentOptionIDs(ids...)
+}
+
+// AddItemModifierIDs adds the "item_modifiers" edge to the MenuItemModifier entity by IDs.
+func (_u *ModifierUpdate) AddItemModifierIDs(ids ...uuid.UUID) *ModifierUpdate {
+	_u.mutation.AddItemModifierIDs(ids...)
+	return _u
+}
+
+// AddItemModifiers adds the "item_modifiers" edges to the MenuItemModifier entity.
+func (_u *ModifierUpdate) AddItemModifiers(v ...*MenuItemModifier) *ModifierUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddItemModifierIDs(ids...)
+}
+
 // Mutation returns the ModifierMutation object of the builder.
 func (_u *ModifierUpdate) Mutation() *ModifierMutation {
 	return _u.mutation
@@ -203,6 +276,48 @@ func (_u *ModifierUpdate) RemoveMenuItems(v ...*MenuItem) *ModifierUpdate {
 	return _u.RemoveMenuItemIDs(ids...)
 }
 
+// ClearParentOptions clears all "parent_options" edges to the ModifierOption entity.
+func (_u *ModifierUpdate) ClearParentOptions() *ModifierUpdate {
+	_u.mutation.ClearParentOptions()
+	return _u
+}
+
+// RemoveParentOptionIDs removes the "parent_options" edge to ModifierOption entities by IDs.
+func (_u *ModifierUpdate) RemoveParentOptionIDs(ids ...uuid.UUID) *ModifierUpdate {
+	_u.mutation.RemoveParentOptionIDs(ids...)
+	return _u
+}
+
+// RemoveParentOptions removes "parent_options" edges to ModifierOption entities.
+func (_u *ModifierUpdate) RemoveParentOptions(v ...*ModifierOption) *ModifierUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveParentOptionIDs(ids...)
+}
+
+// ClearItemModifiers clears all "item_modifiers" edges to the MenuItemModifier entity.
+func (_u *ModifierUpdate) ClearItemModifiers() *ModifierUpdate {
+	_u.mutation.ClearItemModifiers()
+	return _u
+}
+
+// RemoveItemModifierIDs removes the "item_modifiers" edge to MenuItemModifier entities by IDs.
+func (_u *ModifierUpdate) RemoveItemModifierIDs(ids ...uuid.UUID) *ModifierUpdate {
+	_u.mutation.RemoveItemModifierIDs(ids...)
+	return _u
+}
+
+// RemoveItemModifiers removes "item_modifiers" edges to MenuItemModifier entities.
+func (_u *ModifierUpdate) RemoveItemModifiers(v ...*MenuItemModifier) *ModifierUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveItemModifierIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (_u *ModifierUpdate) Save(ctx context.Context) (int, error) {
 	_u.defaults()
@@ -251,6 +366,16 @@ func (_u *ModifierUpdate) check() error {
 			return &ValidationError{Name: "max", err: fmt.Errorf(`ent: validator failed for field "Modifier.max": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.Min(); ok {
+		if err := modifier.MinValidator(v); err != nil {
+			return &ValidationError{Name: "min", err: fmt.Errorf(`ent: validator failed for field "Modifier.min": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.FreeQuantity(); ok {
+		if err := modifier.FreeQuantityValidator(v); err != nil {
+			return &ValidationError{Name: "free_quantity", err: fmt.Errorf(`ent: validator failed for field "Modifier.free_quantity": %w`, err)}
+		}
+	}
 	if _u.mutation.RestaurantCleared() && len(_u.mutation.RestaurantIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "Modifier.restaurant"`)
 	}
@@ -287,6 +412,18 @@ func (_u *ModifierUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if value, ok := _u.mutation.AddedMax(); ok {
 		_spec.AddField(modifier.FieldMax, field.TypeInt, value)
 	}
+	if value, ok := _u.mutation.Min(); ok {
+		_spec.SetField(modifier.FieldMin, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedMin(); ok {
+		_spec.AddField(modifier.FieldMin, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.FreeQuantity(); ok {
+		_spec.SetField(modifier.FieldFreeQuantity, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedFreeQuantity(); ok {
+		_spec.AddField(modifier.FieldFreeQuantity, field.TypeInt, value)
+	}
 	if _u.mutation.RestaurantCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -363,23 +500,30 @@ func (_u *ModifierUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	}
 	if _u.mutation.MenuItemsCleared() {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
-			Inverse: false,
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
 			Table:   modifier.MenuItemsTable,
-			Columns: []string{modifier.MenuItemsColumn},
+			Columns: modifier.MenuItemsPrimaryKey,
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
 				IDSpec: sqlgraph.NewFieldSpec(menuitem.FieldID, field.TypeInt64),
 			},
 		}
+		createE := &MenuItemModifierCreate{config: _u.config, mutation: newMenuItemModifierMutation(_u.config, OpCreate)}
+		createE.defaults()
+		_, specE := createE.createSpec()
+		edge.Target.Fields = specE.Fields
+		if specE.ID.Value != nil {
+			edge.Target.Fields = append(edge.Target.Fields, specE.ID)
+		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
 	if nodes := _u.mutation.RemovedMenuItemsIDs(); len(nodes) > 0 && !_u.mutation.MenuItemsCleared() {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
-			Inverse: false,
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
 			Table:   modifier.MenuItemsTable,
-			Columns: []string{modifier.MenuItemsColumn},
+			Columns: modifier.MenuItemsPrimaryKey,
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
 				IDSpec: sqlgraph.NewFieldSpec(menuitem.FieldID, field.TypeInt64),
@@ -388,14 +532,21 @@ func (_u *ModifierUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		for _, k := range nodes {
 			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
+		createE := &MenuItemModifierCreate{config: _u.config, mutation: newMenuItemModifierMutation(_u.config, OpCreate)}
+		createE.defaults()
+		_, specE := createE.createSpec()
+		edge.Target.Fields = specE.Fields
+		if specE.ID.Value != nil {
+			edge.Target.Fields = append(edge.Target.Fields, specE.ID)
+		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
 	if nodes := _u.mutation.MenuItemsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
-			Inverse: false,
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
 			Table:   modifier.MenuItemsTable,
-			Columns: []string{modifier.MenuItemsColumn},
+			Columns: modifier.MenuItemsPrimaryKey,
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
 				IDSpec: sqlgraph.NewFieldSpec(menuitem.FieldID, field.TypeInt64),
@@ -404,6 +555,103 @@ func (_u *ModifierUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		for _, k := range nodes {
 			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
+		createE := &MenuItemModifierCreate{config: _u.config, mutation: newMenuItemModifierMutation(_u.config, OpCreate)}
+		createE.defaults()
+		_, specE := createE.createSpec()
+		edge.Target.Fields = specE.Fields
+		if specE.ID.Value != nil {
+			edge.Target.Fields = append(edge.Target.Fields, specE.ID)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ParentOptionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   modifier.ParentOptionsTable,
+			Columns: modifier.ParentOptionsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(modifieroption.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedParentOptionsIDs(); len(nodes) > 0 && !_u.mutation.ParentOptionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   modifier.ParentOptionsTable,
+			Columns: modifier.ParentOptionsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(modifieroption.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ParentOptionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   modifier.ParentOptionsTable,
+			Columns: modifier.ParentOptionsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(modifieroption.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ItemModifiersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   modifier.ItemModifiersTable,
+			Columns: []string{modifier.ItemModifiersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(menuitemmodifier.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedItemModifiersIDs(); len(nodes) > 0 && !_u.mutation.ItemModifiersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   modifier.ItemModifiersTable,
+			Columns: []string{modifier.ItemModifiersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(menuitemmodifier.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ItemModifiersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   modifier.ItemModifiersTable,
+			Columns: []string{modifier.ItemModifiersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(menuitemmodifier.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
@@ -495,6 +743,48 @@ func (_u *ModifierUpdateOne) AddMax(v int) *ModifierUpdateOne {
 	return _u
 }
 
+// SetMin sets the "min" field.
+func (_u *ModifierUpdateOne) SetMin(v int) *ModifierUpdateOne {
+	_u.mutation.ResetMin()
+	_u.mutation.SetMin(v)
+	return _u
+}
+
+// SetNillableMin sets the "min" field if the given value is not nil.
+func (_u *ModifierUpdateOne) SetNillableMin(v *int) *ModifierUpdateOne {
+	if v != nil {
+		_u.SetMin(*v)
+	}
+	return _u
+}
+
+// AddMin adds value to the "min" field.
+func (_u *ModifierUpdateOne) AddMin(v int) *ModifierUpdateOne {
+	_u.mutation.AddMin(v)
+	return _u
+}
+
+// SetFreeQuantity sets the "free_quantity" field.
+func (_u *ModifierUpdateOne) SetFreeQuantity(v int) *ModifierUpdateOne {
+	_u.mutation.ResetFreeQuantity()
+	_u.mutation.SetFreeQuantity(v)
+	return _u
+}
+
+// SetNillableFreeQuantity sets the "free_quantity" field if the given value is not nil.
+func (_u *ModifierUpdateOne) SetNillableFreeQuantity(v *int) *ModifierUpdateOne {
+	if v != nil {
+		_u.SetFreeQuantity(*v)
+	}
+	return _u
+}
+
+// AddFreeQuantity adds value to the "free_quantity" field.
+func (_u *ModifierUpdateOne) AddFreeQuantity(v int) *ModifierUpdateOne {
+	_u.mutation.AddFreeQuantity(v)
+	return _u
+}
+
 // SetRestaurantID sets the "restaurant_id" field.
 func (_u *ModifierUpdateOne) SetRestaurantID(v uuid.UUID) *ModifierUpdateOne {
 	_u.mutation.SetRestaurantID(v)
@@ -544,6 +834,36 @@ func (_u *ModifierUpdateOne) AddMenuItems(v ...*MenuItem) *ModifierUpdateOne {
 	return _u.AddMenuItemIDs(ids...)
 }
 
+// AddParentOptionIDs adds the "parent_options" edge to the ModifierOption entity by IDs.
+func (_u *ModifierUpdateOne) AddParentOptionIDs(ids ...uuid.UUID) *ModifierUpdateOne {
+	_u.mutation.AddParentOptionIDs(ids...)
+	return _u
+}
+
+// AddParentOptions adds the "parent_options" edges to the ModifierOption entity.
+func (_u *ModifierUpdateOne) AddParentOptions(v ...*ModifierOption) *ModifierUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddParentOptionIDs(ids...)
+}
+
+// AddItemModifierIDs adds the "item_modifiers" edge to the MenuItemModifier entity by IDs.
+func (_u *ModifierUpdateOne) AddItemModifierIDs(ids ...uuid.UUID) *ModifierUpdateOne {
+	_u.mutation.AddItemModifierIDs(ids...)
+	return _u
+}
+
+// AddItemModifiers adds the "item_modifiers" edges to the MenuItemModifier entity.
+func (_u *ModifierUpdateOne) AddItemModifiers(v ...*MenuItemModifier) *ModifierUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddItemModifierIDs(ids...)
+}
+
 // Mutation returns the ModifierMutation object of the builder.
 func (_u *ModifierUpdateOne) Mutation() *ModifierMutation {
 	return _u.mutation
@@ -597,6 +917,48 @@ func (_u *ModifierUpdateOne) RemoveMenuItems(v ...*MenuItem) *ModifierUpdateOne
 	return _u.RemoveMenuItemIDs(ids...)
 }
 
+// ClearParentOptions clears all "parent_options" edges to the ModifierOption entity.
+func (_u *ModifierUpdateOne) ClearParentOptions() *ModifierUpdateOne {
+	_u.mutation.ClearParentOptions()
+	return _u
+}
+
+// RemoveParentOptionIDs removes the "parent_options" edge to ModifierOption entities by IDs.
+func (_u *ModifierUpdateOne) RemoveParentOptionIDs(ids ...uuid.UUID) *ModifierUpdateOne {
+	_u.mutation.RemoveParentOptionIDs(ids...)
+	return _u
+}
+
+// RemoveParentOptions removes "parent_options" edges to ModifierOption entities.
+func (_u *ModifierUpdateOne) RemoveParentOptions(v ...*ModifierOption) *ModifierUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveParentOptionIDs(ids...)
+}
+
+// ClearItemModifiers clears all "item_modifiers" edges to the MenuItemModifier entity.
+func (_u *ModifierUpdateOne) ClearItemModifiers() *ModifierUpdateOne {
+	_u.mutation.ClearItemModifiers()
+	return _u
+}
+
+// RemoveItemModifierIDs removes the "item_modifiers" edge to MenuItemModifier entities by IDs.
+func (_u *ModifierUpdateOne) RemoveItemModifierIDs(ids ...uuid.UUID) *ModifierUpdateOne {
+	_u.mutation.RemoveItemModifierIDs(ids...)
+	return _u
+}
+
+// RemoveItemModifiers removes "item_modifiers" edges to MenuItemModifier entities.
+func (_u *ModifierUpdateOne) RemoveItemModifiers(v ...*MenuItemModifier) *ModifierUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveItemModifierIDs(ids...)
+}
+
 // Where appends a list predicates to the ModifierUpdate builder.
 func (_u *ModifierUpdateOne) Where(ps ...predicate.Modifier) *ModifierUpdateOne {
 	_u.mutation.Where(ps...)
@@ -658,6 +1020,16 @@ func (_u *ModifierUpdateOne) check() error {
 			return &ValidationError{Name: "max", err: fmt.Errorf(`ent: validator failed for field "Modifier.max": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.Min(); ok {
+		if err := modifier.MinValidator(v); err != nil {
+			return &ValidationError{Name: "min", err: fmt.Errorf(`ent: validator failed for field "Modifier.min": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.FreeQuantity(); ok {
+		if err := modifier.FreeQuantityValidator(v); err != nil {
+			return &ValidationError{Name: "free_quantity", err: fmt.Errorf(`ent: validator failed for field "Modifier.free_quantity": %w`, err)}
+		}
+	}
 	if _u.mutation.RestaurantCleared() && len(_u.mutation.RestaurantIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "Modifier.restaurant"`)
 	}
@@ -711,6 +1083,18 @@ func (_u *ModifierUpdateOne) sqlSave(ctx context.Context) (_node *Modifier, err
 	if value, ok := _u.mutation.AddedMax(); ok {
 		_spec.AddField(modifier.FieldMax, field.TypeInt, value)
 	}
+	if value, ok := _u.mutation.Min(); ok {
+		_spec.SetField(modifier.FieldMin, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedMin(); ok {
+		_spec.AddField(modifier.FieldMin, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.FreeQuantity(); ok {
+		_spec.SetField(modifier.FieldFreeQuantity, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedFreeQuantity(); ok {
+		_spec.AddField(modifier.FieldFreeQuantity, field.TypeInt, value)
+	}
 	if _u.mutation.RestaurantCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -787,23 +1171,30 @@ func (_u *ModifierUpdateOne) sqlSave(ctx context.Context) (_node *Modifier, err
 	}
 	if _u.mutation.MenuItemsCleared() {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
-			Inverse: false,
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
 			Table:   modifier.MenuItemsTable,
-			Columns: []string{modifier.MenuItemsColumn},
+			Columns: modifier.MenuItemsPrimaryKey,
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
 				IDSpec: sqlgraph.NewFieldSpec(menuitem.FieldID, field.TypeInt64),
 			},
 		}
+		createE := &MenuItemModifierCreate{config: _u.config, mutation: newMenuItemModifierMutation(_u.config, OpCreate)}
+		createE.defaults()
+		_, specE := createE.createSpec()
+		edge.Target.Fields = specE.Fields
+		if specE.ID.Value != nil {
+			edge.Target.Fields = append(edge.Target.Fields, specE.ID)
+		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
 	if nodes := _u.mutation.RemovedMenuItemsIDs(); len(nodes) > 0 && !_u.mutation.MenuItemsCleared() {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
-			Inverse: false,
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
 			Table:   modifier.MenuItemsTable,
-			Columns: []string{modifier.MenuItemsColumn},
+			Columns: modifier.MenuItemsPrimaryKey,
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
 				IDSpec: sqlgraph.NewFieldSpec(menuitem.FieldID, field.TypeInt64),
@@ -812,14 +1203,21 @@ func (_u *ModifierUpdateOne) sqlSave(ctx context.Context) (_node *Modifier, err
 		for _, k := range nodes {
 			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
+		createE := &MenuItemModifierCreate{config: _u.config, mutation: newMenuItemModifierMutation(_u.config, OpCreate)}
+		createE.defaults()
+		_, specE := createE.createSpec()
+		edge.Target.Fields = specE.Fields
+		if specE.ID.Value != nil {
+			edge.Target.Fields = append(edge.Target.Fields, specE.ID)
+		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
 	if nodes := _u.mutation.MenuItemsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
-			Inverse: false,
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
 			Table:   modifier.MenuItemsTable,
-			Columns: []string{modifier.MenuItemsColumn},
+			Columns: modifier.MenuItemsPrimaryKey,
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
 				IDSpec: sqlgraph.NewFieldSpec(menuitem.FieldID, field.TypeInt64),
@@ -828,6 +1226,103 @@ func (_u *ModifierUpdateOne) sqlSave(ctx context.Context) (_node *Modifier, err
 		for _, k := range nodes {
 			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
+		createE := &MenuItemModifierCreate{config: _u.config, mutation: newMenuItemModifierMutation(_u.config, OpCreate)}
+		createE.defaults()
+		_, specE := createE.createSpec()
+		edge.Target.Fields = specE.Fields
+		if specE.ID.Value != nil {
+			edge.Target.Fields = append(edge.Target.Fields, specE.ID)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ParentOptionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   modifier.ParentOptionsTable,
+			Columns: modifier.ParentOptionsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(modifieroption.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedParentOptionsIDs(); len(nodes) > 0 && !_u.mutation.ParentOptionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   modifier.ParentOptionsTable,
+			Columns: modifier.ParentOptionsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(modifieroption.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ParentOptionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   modifier.ParentOptionsTable,
+			Columns: modifier.ParentOptionsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(modifieroption.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ItemModifiersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   modifier.ItemModifiersTable,
+			Columns: []string{modifier.ItemModifiersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(menuitemmodifier.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedItemModifiersIDs(); len(nodes) > 0 && !_u.mutation.ItemModifiersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   modifier.ItemModifiersTable,
+			Columns: []string{modifier.ItemModifiersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(menuitemmodifier.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ItemModifiersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   modifier.ItemModifiersTable,
+			Columns: []string{modifier.ItemModifiersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(menuitemmodifier.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
 	_node = &Modifier{config: _u.config}
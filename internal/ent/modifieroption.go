@@ -3,6 +3,7 @@
 package ent
 
 import (
+	"encoding/json"
 	"fmt"
 	"strings"
 	"time"
@@ -23,14 +24,26 @@ type ModifierOption struct {
 	UpdateTime time.Time `json:"update_time,omitempty"`
 	// Modifier option name
 	Name string `json:"name,omitempty"`
-	// Price of the modifier option
-	Price float64 `json:"price,omitempty"`
+	// Price of the modifier option in minor units of the restaurant currency
+	Price int64 `json:"price,omitempty"`
+	// Prices of the option with menu item variants of a given name, e.g. Large, instead of price
+	VariantPrices map[string]int64 `json:"variant_prices,omitempty"`
 	// Image URL for the modifier option
 	ImageURL string `json:"image_url,omitempty"`
+	// URL of a small version of the uploaded image
+	ThumbnailURL string `json:"thumbnail_url,omitempty"`
+	// Blob store keys of the uploaded image and its thumbnail, deleted with the option
+	ImageKeys []string `json:"image_keys,omitempty"`
 	// Whether the modifier option is available
 	Available bool `json:"available,omitempty"`
+	// Whether available was turned off because an ingredient ran out; restocking turns it back on
+	OutOfStock bool `json:"out_of_stock,omitempty"`
 	// Whether the modifier option is pre-selected
 	PreSelect bool `json:"pre_select,omitempty"`
+	// Display order for sorting within its modifier
+	DisplayOrder int `json:"display_order,omitempty"`
+	// Most times the option can be chosen in one selection; 0 leaves it to the modifier's max
+	MaxQuantity int `json:"max_quantity,omitempty"`
 	// ID of the modifier this option belongs to
 	ModifierID uuid.UUID `json:"modifier_id,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
@@ -45,9 +58,13 @@ type ModifierOptionEdges struct {
 	Modifier *Modifier `json:"modifier,omitempty"`
 	// OrderItemModifierOptions holds the value of the order_item_modifier_options edge.
 	OrderItemModifierOptions []*OrderItemModifierOption `json:"order_item_modifier_options,omitempty"`
+	// ChildModifiers holds the value of the child_modifiers edge.
+	ChildModifiers []*Modifier `json:"child_modifiers,omitempty"`
+	// RecipeLines holds the value of the recipe_lines edge.
+	RecipeLines []*RecipeIngredient `json:"recipe_lines,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [2]bool
+	loadedTypes [4]bool
 }
 
 // ModifierOrErr returns the Modifier value or an error if the edge
@@ -70,16 +87,36 @@ func (e ModifierOptionEdges) OrderItemModifierOptionsOrErr() ([]*OrderItemModifi
 	return nil, &NotLoadedError{edge: "order_item_modifier_options"}
 }
 
+// ChildModifiersOrErr returns the ChildModifiers value or an error if the edge
+// was not loaded in eager-loading.
+func (e ModifierOptionEdges) ChildModifiersOrErr() ([]*Modifier, error) {
+	if e.loadedTypes[2] {
+		return e.ChildModifiers, nil
+	}
+	return nil, &NotLoadedError{edge: "child_modifiers"}
+}
+
+// RecipeLinesOrErr returns the RecipeLines value or an error if the edge
+// was not loaded in eager-loading.
+func (e ModifierOptionEdges) RecipeLinesOrErr() ([]*RecipeIngredient, error) {
+	if e.loadedTypes[3] {
+		return e.RecipeLines, nil
+	}
+	return nil, &NotLoadedError{edge: "recipe_lines"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*ModifierOption) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case modifieroption.FieldAvailable, modifieroption.FieldPreSelect:
+		case modifieroption.FieldVariantPrices, modifieroption.FieldImageKeys:
+			values[i] = new([]byte)
+		case modifieroption.FieldAvailable, modifieroption.FieldOutOfStock, modifieroption.FieldPreSelect:
 			values[i] = new(sql.NullBool)
-		case modifieroption.FieldPrice:
-			values[i] = new(sql.NullFloat64)
-		case modifieroption.FieldName, modifieroption.FieldImageURL:
+		case modifieroption.FieldPrice, modifieroption.FieldDisplayOrder, modifieroption.FieldMaxQuantity:
+			values[i] = new(sql.NullInt64)
+		case modifieroption.FieldName, modifieroption.FieldImageURL, modifieroption.FieldThumbnailURL:
 			values[i] = new(sql.NullString)
 		case modifieroption.FieldUpdateTime:
 			values[i] = new(sql.NullTime)
@@ -119,10 +156,18 @@ func (_m *ModifierOption) assignValues(columns []string, values []any) error {
 				_m.Name = value.String
 			}
 		case modifieroption.FieldPrice:
-			if value, ok := values[i].(*sql.NullFloat64); !ok {
+			if value, ok := values[i].(*sql.NullInt64); !ok {
 				return fmt.Errorf("unexpected type %T for field price", values[i])
 			} else if value.Valid {
-				_m.Price = value.Float64
+				_m.Price = value.Int64
+			}
+		case modifieroption.FieldVariantPrices:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field variant_prices", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.VariantPrices); err != nil {
+					return fmt.Errorf("unmarshal field variant_prices: %w", err)
+				}
 			}
 		case modifieroption.FieldImageURL:
 			if value, ok := values[i].(*sql.NullString); !ok {
@@ -130,18 +175,50 @@ func (_m *ModifierOption) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.ImageURL = value.String
 			}
+		case modifieroption.FieldThumbnailURL:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field thumbnail_url", values[i])
+			} else if value.Valid {
+				_m.ThumbnailURL = value.String
+			}
+		case modifieroption.FieldImageKeys:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field image_keys", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.ImageKeys); err != nil {
+					return fmt.Errorf("unmarshal field image_keys: %w", err)
+				}
+			}
 		case modifieroption.FieldAvailable:
 			if value, ok := values[i].(*sql.NullBool); !ok {
 				return fmt.Errorf("unexpected type %T for field available", values[i])
 			} else if value.Valid {
 				_m.Available = value.Bool
 			}
+		case modifieroption.FieldOutOfStock:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field out_of_stock", values[i])
+			} else if value.Valid {
+				_m.OutOfStock = value.Bool
+			}
 		case modifieroption.FieldPreSelect:
 			if value, ok := values[i].(*sql.NullBool); !ok {
 				return fmt.Errorf("unexpected type %T for field pre_select", values[i])
 			} else if value.Valid {
 				_m.PreSelect = value.Bool
 			}
+		case modifieroption.FieldDisplayOrder:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field display_order", values[i])
+			} else if value.Valid {
+				_m.DisplayOrder = int(value.Int64)
+			}
+		case modifieroption.FieldMaxQuantity:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field max_quantity", values[i])
+			} else if value.Valid {
+				_m.MaxQuantity = int(value.Int64)
+			}
 		case modifieroption.FieldModifierID:
 			if value, ok := values[i].(*uuid.UUID); !ok {
 				return fmt.Errorf("unexpected type %T for field modifier_id", values[i])
@@ -171,6 +248,16 @@ func (_m *ModifierOption) QueryOrderItemModifierOptions() *OrderItemModifierOpti
 	return NewModifierOptionClient(_m.config).QueryOrderItemModifierOptions(_m)
 }
 
+// QueryChildModifiers queries the "child_modifiers" edge of the ModifierOption entity.
+func (_m *ModifierOption) QueryChildModifiers() *ModifierQuery {
+	return NewModifierOptionClient(_m.config).QueryChildModifiers(_m)
+}
+
+// QueryRecipeLines queries the "recipe_lines" edge of the ModifierOption entity.
+func (_m *ModifierOption) QueryRecipeLines() *RecipeIngredientQuery {
+	return NewModifierOptionClient(_m.config).QueryRecipeLines(_m)
+}
+
 // Update returns a builder for updating this ModifierOption.
 // Note that you need to call ModifierOption.Unwrap() before calling this method if this ModifierOption
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -203,15 +290,33 @@ func (_m *ModifierOption) String() string {
 	builder.WriteString("price=")
 	builder.WriteString(fmt.Sprintf("%v", _m.Price))
 	builder.WriteString(", ")
+	builder.WriteString("variant_prices=")
+	builder.WriteString(fmt.Sprintf("%v", _m.VariantPrices))
+	builder.WriteString(", ")
 	builder.WriteString("image_url=")
 	builder.WriteString(_m.ImageURL)
 	builder.WriteString(", ")
+	builder.WriteString("thumbnail_url=")
+	builder.WriteString(_m.ThumbnailURL)
+	builder.WriteString(", ")
+	builder.WriteString("image_keys=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ImageKeys))
+	builder.WriteString(", ")
 	builder.WriteString("available=")
 	builder.WriteString(fmt.Sprintf("%v", _m.Available))
 	builder.WriteString(", ")
+	builder.WriteString("out_of_stock=")
+	builder.WriteString(fmt.Sprintf("%v", _m.OutOfStock))
+	builder.WriteString(", ")
 	builder.WriteString("pre_select=")
 	builder.WriteString(fmt.Sprintf("%v", _m.PreSelect))
 	builder.WriteString(", ")
+	builder.WriteString("display_order=")
+	builder.WriteString(fmt.Sprintf("%v", _m.DisplayOrder))
+	builder.WriteString(", ")
+	builder.WriteString("max_quantity=")
+	builder.WriteString(fmt.Sprintf("%v", _m.MaxQuantity))
+	builder.WriteString(", ")
 	builder.WriteString("modifier_id=")
 	builder.WriteString(fmt.Sprintf("%v", _m.ModifierID))
 	builder.WriteByte(')')
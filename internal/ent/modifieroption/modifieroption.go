@@ -21,18 +21,34 @@ const (
 	FieldName = "name"
 	// FieldPrice holds the string denoting the price field in the database.
 	FieldPrice = "price"
+	// FieldVariantPrices holds the string denoting the variant_prices field in the database.
+	FieldVariantPrices = "variant_prices"
 	// FieldImageURL holds the string denoting the image_url field in the database.
 	FieldImageURL = "image_url"
+	// FieldThumbnailURL holds the string denoting the thumbnail_url field in the database.
+	FieldThumbnailURL = "thumbnail_url"
+	// FieldImageKeys holds the string denoting the image_keys field in the database.
+	FieldImageKeys = "image_keys"
 	// FieldAvailable holds the string denoting the available field in the database.
 	FieldAvailable = "available"
+	// FieldOutOfStock holds the string denoting the out_of_stock field in the database.
+	FieldOutOfStock = "out_of_stock"
 	// FieldPreSelect holds the string denoting the pre_select field in the database.
 	FieldPreSelect = "pre_select"
+	// FieldDisplayOrder holds the string denoting the display_order field in the database.
+	FieldDisplayOrder = "display_order"
+	// FieldMaxQuantity holds the string denoting the max_quantity field in the database.
+	FieldMaxQuantity = "max_quantity"
 	// FieldModifierID holds the string denoting the modifier_id field in the database.
 	FieldModifierID = "modifier_id"
 	// EdgeModifier holds the string denoting the modifier edge name in mutations.
 	EdgeModifier = "modifier"
 	// EdgeOrderItemModifierOptions holds the string denoting the order_item_modifier_options edge name in mutations.
 	EdgeOrderItemModifierOptions = "order_item_modifier_options"
+	// EdgeChildModifiers holds the string denoting the child_modifiers edge name in mutations.
+	EdgeChildModifiers = "child_modifiers"
+	// EdgeRecipeLines holds the string denoting the recipe_lines edge name in mutations.
+	EdgeRecipeLines = "recipe_lines"
 	// Table holds the table name of the modifieroption in the database.
 	Table = "modifier_options"
 	// ModifierTable is the table that holds the modifier relation/edge.
@@ -49,6 +65,18 @@ const (
 	OrderItemModifierOptionsInverseTable = "order_item_modifier_options"
 	// OrderItemModifierOptionsColumn is the table column denoting the order_item_modifier_options relation/edge.
 	OrderItemModifierOptionsColumn = "modifier_option_id"
+	// ChildModifiersTable is the table that holds the child_modifiers relation/edge. The primary key declared below.
+	ChildModifiersTable = "modifier_option_child_modifiers"
+	// ChildModifiersInverseTable is the table name for the Modifier entity.
+	// It exists in this package in order to avoid circular dependency with the "modifier" package.
+	ChildModifiersInverseTable = "modifiers"
+	// RecipeLinesTable is the table that holds the recipe_lines relation/edge.
+	RecipeLinesTable = "recipe_ingredients"
+	// RecipeLinesInverseTable is the table name for the RecipeIngredient entity.
+	// It exists in this package in order to avoid circular dependency with the "recipeingredient" package.
+	RecipeLinesInverseTable = "recipe_ingredients"
+	// RecipeLinesColumn is the table column denoting the recipe_lines relation/edge.
+	RecipeLinesColumn = "modifier_option_id"
 )
 
 // Columns holds all SQL columns for modifieroption fields.
@@ -57,12 +85,24 @@ var Columns = []string{
 	FieldUpdateTime,
 	FieldName,
 	FieldPrice,
+	FieldVariantPrices,
 	FieldImageURL,
+	FieldThumbnailURL,
+	FieldImageKeys,
 	FieldAvailable,
+	FieldOutOfStock,
 	FieldPreSelect,
+	FieldDisplayOrder,
+	FieldMaxQuantity,
 	FieldModifierID,
 }
 
+var (
+	// ChildModifiersPrimaryKey and ChildModifiersColumn2 are the table columns denoting the
+	// primary key for the child_modifiers relation (M2M).
+	ChildModifiersPrimaryKey = []string{"modifier_option_id", "modifier_id"}
+)
+
 // ValidColumn reports if the column name is valid (part of the table columns).
 func ValidColumn(column string) bool {
 	for i := range Columns {
@@ -81,11 +121,23 @@ var (
 	// NameValidator is a validator for the "name" field. It is called by the builders before save.
 	NameValidator func(string) error
 	// DefaultPrice holds the default value on creation for the "price" field.
-	DefaultPrice float64
+	DefaultPrice int64
+	// PriceValidator is a validator for the "price" field. It is called by the builders before save.
+	PriceValidator func(int64) error
 	// DefaultAvailable holds the default value on creation for the "available" field.
 	DefaultAvailable bool
+	// DefaultOutOfStock holds the default value on creation for the "out_of_stock" field.
+	DefaultOutOfStock bool
 	// DefaultPreSelect holds the default value on creation for the "pre_select" field.
 	DefaultPreSelect bool
+	// DefaultDisplayOrder holds the default value on creation for the "display_order" field.
+	DefaultDisplayOrder int
+	// DisplayOrderValidator is a validator for the "display_order" field. It is called by the builders before save.
+	DisplayOrderValidator func(int) error
+	// DefaultMaxQuantity holds the default value on creation for the "max_quantity" field.
+	DefaultMaxQuantity int
+	// MaxQuantityValidator is a validator for the "max_quantity" field. It is called by the builders before save.
+	MaxQuantityValidator func(int) error
 	// DefaultID holds the default value on creation for the "id" field.
 	DefaultID func() uuid.UUID
 )
@@ -118,16 +170,36 @@ func ByImageURL(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldImageURL, opts...).ToFunc()
 }
 
+// ByThumbnailURL orders the results by the thumbnail_url field.
+func ByThumbnailURL(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldThumbnailURL, opts...).ToFunc()
+}
+
 // ByAvailable orders the results by the available field.
 func ByAvailable(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldAvailable, opts...).ToFunc()
 }
 
+// ByOutOfStock orders the results by the out_of_stock field.
+func ByOutOfStock(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOutOfStock, opts...).ToFunc()
+}
+
 // ByPreSelect orders the results by the pre_select field.
 func ByPreSelect(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldPreSelect, opts...).ToFunc()
 }
 
+// ByDisplayOrder orders the results by the display_order field.
+func ByDisplayOrder(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDisplayOrder, opts...).ToFunc()
+}
+
+// ByMaxQuantity orders the results by the max_quantity field.
+func ByMaxQuantity(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMaxQuantity, opts...).ToFunc()
+}
+
 // ByModifierID orders the results by the modifier_id field.
 func ByModifierID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldModifierID, opts...).ToFunc()
@@ -153,6 +225,34 @@ func ByOrderItemModifierOptions(term sql.OrderTerm, terms ...sql.OrderTerm) Orde
 		sqlgraph.OrderByNeighborTerms(s, newOrderItemModifierOptionsStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
+
+// ByChildModifiersCount orders the results by child_modifiers count.
+func ByChildModifiersCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newChildModifiersStep(), opts...)
+	}
+}
+
+// ByChildModifiers orders the results by child_modifiers terms.
+func ByChildModifiers(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newChildModifiersStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByRecipeLinesCount orders the results by recipe_lines count.
+func ByRecipeLinesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newRecipeLinesStep(), opts...)
+	}
+}
+
+// ByRecipeLines orders the results by recipe_lines terms.
+func ByRecipeLines(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newRecipeLinesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newModifierStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -167,3 +267,17 @@ func newOrderItemModifierOptionsStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, false, OrderItemModifierOptionsTable, OrderItemModifierOptionsColumn),
 	)
 }
+func newChildModifiersStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ChildModifiersInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2M, false, ChildModifiersTable, ChildModifiersPrimaryKey...),
+	)
+}
+func newRecipeLinesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(RecipeLinesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, RecipeLinesTable, RecipeLinesColumn),
+	)
+}
@@ -67,7 +67,7 @@ func Name(v string) predicate.ModifierOption {
 }
 
 // Price applies equality check predicate on the "price" field. It's identical to PriceEQ.
-func Price(v float64) predicate.ModifierOption {
+func Price(v int64) predicate.ModifierOption {
 	return predicate.ModifierOption(sql.FieldEQ(FieldPrice, v))
 }
 
@@ -76,16 +76,36 @@ func ImageURL(v string) predicate.ModifierOption {
 	return predicate.ModifierOption(sql.FieldEQ(FieldImageURL, v))
 }
 
+// ThumbnailURL applies equality check predicate on the "thumbnail_url" field. It's identical to ThumbnailURLEQ.
+func ThumbnailURL(v string) predicate.ModifierOption {
+	return predicate.ModifierOption(sql.FieldEQ(FieldThumbnailURL, v))
+}
+
 // Available applies equality check predicate on the "available" field. It's identical to AvailableEQ.
 func Available(v bool) predicate.ModifierOption {
 	return predicate.ModifierOption(sql.FieldEQ(FieldAvailable, v))
 }
 
+// OutOfStock applies equality check predicate on the "out_of_stock" field. It's identical to OutOfStockEQ.
+func OutOfStock(v bool) predicate.ModifierOption {
+	return predicate.ModifierOption(sql.FieldEQ(FieldOutOfStock, v))
+}
+
 // PreSelect applies equality check predicate on the "pre_select" field. It's identical to PreSelectEQ.
 func PreSelect(v bool) predicate.ModifierOption {
 	return predicate.ModifierOption(sql.FieldEQ(FieldPreSelect, v))
 }
 
+// DisplayOrder applies equality check predicate on the "display_order" field. It's identical to DisplayOrderEQ.
+func DisplayOrder(v int) predicate.ModifierOption {
+	return predicate.ModifierOption(sql.FieldEQ(FieldDisplayOrder, v))
+}
+
+// MaxQuantity applies equality check predicate on the "max_quantity" field. It's identical to MaxQuantityEQ.
+func MaxQuantity(v int) predicate.ModifierOption {
+	return predicate.ModifierOption(sql.FieldEQ(FieldMaxQuantity, v))
+}
+
 // ModifierID applies equality check predicate on the "modifier_id" field. It's identical to ModifierIDEQ.
 func ModifierID(v uuid.UUID) predicate.ModifierOption {
 	return predicate.ModifierOption(sql.FieldEQ(FieldModifierID, v))
@@ -197,45 +217,55 @@ func NameContainsFold(v string) predicate.ModifierOption {
 }
 
 // PriceEQ applies the EQ predicate on the "price" field.
-func PriceEQ(v float64) predicate.ModifierOption {
+func PriceEQ(v int64) predicate.ModifierOption {
 	return predicate.ModifierOption(sql.FieldEQ(FieldPrice, v))
 }
 
 // PriceNEQ applies the NEQ predicate on the "price" field.
-func PriceNEQ(v float64) predicate.ModifierOption {
+func PriceNEQ(v int64) predicate.ModifierOption {
 	return predicate.ModifierOption(sql.FieldNEQ(FieldPrice, v))
 }
 
 // PriceIn applies the In predicate on the "price" field.
-func PriceIn(vs ...float64) predicate.ModifierOption {
+func PriceIn(vs ...int64) predicate.ModifierOption {
 	return predicate.ModifierOption(sql.FieldIn(FieldPrice, vs...))
 }
 
 // PriceNotIn applies the NotIn predicate on the "price" field.
-func PriceNotIn(vs ...float64) predicate.ModifierOption {
+func PriceNotIn(vs ...int64) predicate.ModifierOption {
 	return predicate.ModifierOption(sql.FieldNotIn(FieldPrice, vs...))
 }
 
 // PriceGT applies the GT predicate on the "price" field.
-func PriceGT(v float64) predicate.ModifierOption {
+func PriceGT(v int64) predicate.ModifierOption {
 	return predicate.ModifierOption(sql.FieldGT(FieldPrice, v))
 }
 
 // PriceGTE applies the GTE predicate on the "price" field.
-func PriceGTE(v float64) predicate.ModifierOption {
+func PriceGTE(v int64) predicate.ModifierOption {
 	return predicate.ModifierOption(sql.FieldGTE(FieldPrice, v))
 }
 
 // PriceLT applies the LT predicate on the "price" field.
-func PriceLT(v float64) predicate.ModifierOption {
+func PriceLT(v int64) predicate.ModifierOption {
 	return predicate.ModifierOption(sql.FieldLT(FieldPrice, v))
 }
 
 // PriceLTE applies the LTE predicate on the "price" field.
-func PriceLTE(v float64) predicate.ModifierOption {
+func PriceLTE(v int64) predicate.ModifierOption {
 	return predicate.ModifierOption(sql.FieldLTE(FieldPrice, v))
 }
 
+// VariantPricesIsNil applies the IsNil predicate on the "variant_prices" field.
+func VariantPricesIsNil() predicate.ModifierOption {
+	return predicate.ModifierOption(sql.FieldIsNull(FieldVariantPrices))
+}
+
+// VariantPricesNotNil applies the NotNil predicate on the "variant_prices" field.
+func VariantPricesNotNil() predicate.ModifierOption {
+	return predicate.ModifierOption(sql.FieldNotNull(FieldVariantPrices))
+}
+
 // ImageURLEQ applies the EQ predicate on the "image_url" field.
 func ImageURLEQ(v string) predicate.ModifierOption {
 	return predicate.ModifierOption(sql.FieldEQ(FieldImageURL, v))
@@ -311,6 +341,91 @@ func ImageURLContainsFold(v string) predicate.ModifierOption {
 	return predicate.ModifierOption(sql.FieldContainsFold(FieldImageURL, v))
 }
 
+// ThumbnailURLEQ applies the EQ predicate on the "thumbnail_url" field.
+func ThumbnailURLEQ(v string) predicate.ModifierOption {
+	return predicate.ModifierOption(sql.FieldEQ(FieldThumbnailURL, v))
+}
+
+// ThumbnailURLNEQ applies the NEQ predicate on the "thumbnail_url" field.
+func ThumbnailURLNEQ(v string) predicate.ModifierOption {
+	return predicate.ModifierOption(sql.FieldNEQ(FieldThumbnailURL, v))
+}
+
+// ThumbnailURLIn applies the In predicate on the "thumbnail_url" field.
+func ThumbnailURLIn(vs ...string) predicate.ModifierOption {
+	return predicate.ModifierOption(sql.FieldIn(FieldThumbnailURL, vs...))
+}
+
+// ThumbnailURLNotIn applies the NotIn predicate on the "thumbnail_url" field.
+func ThumbnailURLNotIn(vs ...string) predicate.ModifierOption {
+	return predicate.ModifierOption(sql.FieldNotIn(FieldThumbnailURL, vs...))
+}
+
+// ThumbnailURLGT applies the GT predicate on the "thumbnail_url" field.
+func ThumbnailURLGT(v string) predicate.ModifierOption {
+	return predicate.ModifierOption(sql.FieldGT(FieldThumbnailURL, v))
+}
+
+// ThumbnailURLGTE applies the GTE predicate on the "thumbnail_url" field.
+func ThumbnailURLGTE(v string) predicate.ModifierOption {
+	return predicate.ModifierOption(sql.FieldGTE(FieldThumbnailURL, v))
+}
+
+// ThumbnailURLLT applies the LT predicate on the "thumbnail_url" field.
+func ThumbnailURLLT(v string) predicate.ModifierOption {
+	return predicate.ModifierOption(sql.FieldLT(FieldThumbnailURL, v))
+}
+
+// ThumbnailURLLTE applies the LTE predicate on the "thumbnail_url" field.
+func ThumbnailURLLTE(v string) predicate.ModifierOption {
+	return predicate.ModifierOption(sql.FieldLTE(FieldThumbnailURL, v))
+}
+
+// ThumbnailURLContains applies the Contains predicate on the "thumbnail_url" field.
+func ThumbnailURLContains(v string) predicate.ModifierOption {
+	return predicate.ModifierOption(sql.FieldContains(FieldThumbnailURL, v))
+}
+
+// ThumbnailURLHasPrefix applies the HasPrefix predicate on the "thumbnail_url" field.
+func ThumbnailURLHasPrefix(v string) predicate.ModifierOption {
+	return predicate.ModifierOption(sql.FieldHasPrefix(FieldThumbnailURL, v))
+}
+
+// ThumbnailURLHasSuffix applies the HasSuffix predicate on the "thumbnail_url" field.
+func ThumbnailURLHasSuffix(v string) predicate.ModifierOption {
+	return predicate.ModifierOption(sql.FieldHasSuffix(FieldThumbnailURL, v))
+}
+
+// ThumbnailURLIsNil applies the IsNil predicate on the "thumbnail_url" field.
+func ThumbnailURLIsNil() predicate.ModifierOption {
+	return predicate.ModifierOption(sql.FieldIsNull(FieldThumbnailURL))
+}
+
+// ThumbnailURLNotNil applies the NotNil predicate on the "thumbnail_url" field.
+func ThumbnailURLNotNil() predicate.ModifierOption {
+	return predicate.ModifierOption(sql.FieldNotNull(FieldThumbnailURL))
+}
+
+// ThumbnailURLEqualFold applies the EqualFold predicate on the "thumbnail_url" field.
+func ThumbnailURLEqualFold(v string) predicate.ModifierOption {
+	return predicate.ModifierOption(sql.FieldEqualFold(FieldThumbnailURL, v))
+}
+
+// ThumbnailURLContainsFold applies the ContainsFold predicate on the "thumbnail_url" field.
+func ThumbnailURLContainsFold(v string) predicate.ModifierOption {
+	return predicate.ModifierOption(sql.FieldContainsFold(FieldThumbnailURL, v))
+}
+
+// ImageKeysIsNil applies the IsNil predicate on the "image_keys" field.
+func ImageKeysIsNil() predicate.ModifierOption {
+	return predicate.ModifierOption(sql.FieldIsNull(FieldImageKeys))
+}
+
+// ImageKeysNotNil applies the NotNil predicate on the "image_keys" field.
+func ImageKeysNotNil() predicate.ModifierOption {
+	return predicate.ModifierOption(sql.FieldNotNull(FieldImageKeys))
+}
+
 // AvailableEQ applies the EQ predicate on the "available" field.
 func AvailableEQ(v bool) predicate.ModifierOption {
 	return predicate.ModifierOption(sql.FieldEQ(FieldAvailable, v))
@@ -321,6 +436,16 @@ func AvailableNEQ(v bool) predicate.ModifierOption {
 	return predicate.ModifierOption(sql.FieldNEQ(FieldAvailable, v))
 }
 
+// OutOfStockEQ applies the EQ predicate on the "out_of_stock" field.
+func OutOfStockEQ(v bool) predicate.ModifierOption {
+	return predicate.ModifierOption(sql.FieldEQ(FieldOutOfStock, v))
+}
+
+// OutOfStockNEQ applies the NEQ predicate on the "out_of_stock" field.
+func OutOfStockNEQ(v bool) predicate.ModifierOption {
+	return predicate.ModifierOption(sql.FieldNEQ(FieldOutOfStock, v))
+}
+
 // PreSelectEQ applies the EQ predicate on the "pre_select" field.
 func PreSelectEQ(v bool) predicate.ModifierOption {
 	return predicate.ModifierOption(sql.FieldEQ(FieldPreSelect, v))
@@ -331,6 +456,86 @@ func PreSelectNEQ(v bool) predicate.ModifierOption {
 	return predicate.ModifierOption(sql.FieldNEQ(FieldPreSelect, v))
 }
 
+// DisplayOrderEQ applies the EQ predicate on the "display_order" field.
+func DisplayOrderEQ(v int) predicate.ModifierOption {
+	return predicate.ModifierOption(sql.FieldEQ(FieldDisplayOrder, v))
+}
+
+// DisplayOrderNEQ applies the NEQ predicate on the "display_order" field.
+func DisplayOrderNEQ(v int) predicate.ModifierOption {
+	return predicate.ModifierOption(sql.FieldNEQ(FieldDisplayOrder, v))
+}
+
+// DisplayOrderIn applies the In predicate on the "display_order" field.
+func DisplayOrderIn(vs ...int) predicate.ModifierOption {
+	return predicate.ModifierOption(sql.FieldIn(FieldDisplayOrder, vs...))
+}
+
+// DisplayOrderNotIn applies the NotIn predicate on the "display_order" field.
+func DisplayOrderNotIn(vs ...int) predicate.ModifierOption {
+	return predicate.ModifierOption(sql.FieldNotIn(FieldDisplayOrder, vs...))
+}
+
+// DisplayOrderGT applies the GT predicate on the "display_order" field.
+func DisplayOrderGT(v int) predicate.ModifierOption {
+	return predicate.ModifierOption(sql.FieldGT(FieldDisplayOrder, v))
+}
+
+// DisplayOrderGTE applies the GTE predicate on the "display_order" field.
+func DisplayOrderGTE(v int) predicate.ModifierOption {
+	return predicate.ModifierOption(sql.FieldGTE(FieldDisplayOrder, v))
+}
+
+// DisplayOrderLT applies the LT predicate on the "display_order" field.
+func DisplayOrderLT(v int) predicate.ModifierOption {
+	return predicate.ModifierOption(sql.FieldLT(FieldDisplayOrder, v))
+}
+
+// DisplayOrderLTE applies the LTE predicate on the "display_order" field.
+func DisplayOrderLTE(v int) predicate.ModifierOption {
+	return predicate.ModifierOption(sql.FieldLTE(FieldDisplayOrder, v))
+}
+
+// MaxQuantityEQ applies the EQ predicate on the "max_quantity" field.
+func MaxQuantityEQ(v int) predicate.ModifierOption {
+	return predicate.ModifierOption(sql.FieldEQ(FieldMaxQuantity, v))
+}
+
+// MaxQuantityNEQ applies the NEQ predicate on the "max_quantity" field.
+func MaxQuantityNEQ(v int) predicate.ModifierOption {
+	return predicate.ModifierOption(sql.FieldNEQ(FieldMaxQuantity, v))
+}
+
+// MaxQuantityIn applies the In predicate on the "max_quantity" field.
+func MaxQuantityIn(vs ...int) predicate.ModifierOption {
+	return predicate.ModifierOption(sql.FieldIn(FieldMaxQuantity, vs...))
+}
+
+// MaxQuantityNotIn applies the NotIn predicate on the "max_quantity" field.
+func MaxQuantityNotIn(vs ...int) predicate.ModifierOption {
+	return predicate.ModifierOption(sql.FieldNotIn(FieldMaxQuantity, vs...))
+}
+
+// MaxQuantityGT applies the GT predicate on the "max_quantity" field.
+func MaxQuantityGT(v int) predicate.ModifierOption {
+	return predicate.ModifierOption(sql.FieldGT(FieldMaxQuantity, v))
+}
+
+// MaxQuantityGTE applies the GTE predicate on the "max_quantity" field.
+func MaxQuantityGTE(v int) predicate.ModifierOption {
+	return predicate.ModifierOption(sql.FieldGTE(FieldMaxQuantity, v))
+}
+
+// MaxQuantityLT applies the LT predicate on the "max_quantity" field.
+func MaxQuantityLT(v int) predicate.ModifierOption {
+	return predicate.ModifierOption(sql.FieldLT(FieldMaxQuantity, v))
+}
+
+// MaxQuantityLTE applies the LTE predicate on the "max_quantity" field.
+func MaxQuantityLTE(v int) predicate.ModifierOption {
+	return predicate.ModifierOption(sql.FieldLTE(FieldMaxQuantity, v))
+}
+
 // ModifierIDEQ applies the EQ predicate on the "modifier_id" field.
 func ModifierIDEQ(v uuid.UUID) predicate.ModifierOption {
 	return predicate.ModifierOption(sql.FieldEQ(FieldModifierID, v))
@@ -397,6 +602,52 @@ func HasOrderItemModifierOptionsWith(preds ...predicate.OrderItemModifierOption)
 	})
 }
 
+// HasChildModifiers applies the HasEdge predicate on the "child_modifiers" edge.
+func HasChildModifiers() predicate.ModifierOption {
+	return predicate.ModifierOption(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, false, ChildModifiersTable, ChildModifiersPrimaryKey...),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasChildModifiersWith applies the HasEdge predicate on the "child_modifiers" edge with a given conditions (other predicates).
+func HasChildModifiersWith(preds ...predicate.Modifier) predicate.ModifierOption {
+	return predicate.ModifierOption(func(s *sql.Selector) {
+		step := newChildModifiersStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasRecipeLines applies the HasEdge predicate on the "recipe_lines" edge.
+func HasRecipeLines() predicate.ModifierOption {
+	return predicate.ModifierOption(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, RecipeLinesTable, RecipeLinesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasRecipeLinesWith applies the HasEdge predicate on the "recipe_lines" edge with a given conditions (other predicates).
+func HasRecipeLinesWith(preds ...predicate.RecipeIngredient) predicate.ModifierOption {
+	return predicate.ModifierOption(func(s *sql.Selector) {
+		step := newRecipeLinesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.ModifierOption) predicate.ModifierOption {
 	return predicate.ModifierOption(sql.AndPredicates(predicates...))
@@ -13,6 +13,7 @@ import (
 	"github.com/Jiruu246/rms/internal/ent/modifier"
 	"github.com/Jiruu246/rms/internal/ent/modifieroption"
 	"github.com/Jiruu246/rms/internal/ent/orderitemmodifieroption"
+	"github.com/Jiruu246/rms/internal/ent/recipeingredient"
 	"github.com/google/uuid"
 )
 
@@ -44,19 +45,25 @@ func (_c *ModifierOptionCreate) SetName(v string) *ModifierOptionCreate {
 }
 
 // SetPrice sets the "price" field.
-func (_c *ModifierOptionCreate) SetPrice(v float64) *ModifierOptionCreate {
+func (_c *ModifierOptionCreate) SetPrice(v int64) *ModifierOptionCreate {
 	_c.mutation.SetPrice(v)
 	return _c
 }
 
 // SetNillablePrice sets the "price" field if the given value is not nil.
-func (_c *ModifierOptionCreate) SetNillablePrice(v *float64) *ModifierOptionCreate {
+func (_c *ModifierOptionCreate) SetNillablePrice(v *int64) *ModifierOptionCreate {
 	if v != nil {
 		_c.SetPrice(*v)
 	}
 	return _c
 }
 
+// SetVariantPrices sets the "variant_prices" field.
+func (_c *ModifierOptionCreate) SetVariantPrices(v map[string]int64) *ModifierOptionCreate {
+	_c.mutation.SetVariantPrices(v)
+	return _c
+}
+
 // SetImageURL sets the "image_url" field.
 func (_c *ModifierOptionCreate) SetImageURL(v string) *ModifierOptionCreate {
 	_c.mutation.SetImageURL(v)
@@ -71,6 +78,26 @@ func (_c *ModifierOptionCreate) SetNillableImageURL(v *string) *ModifierOptionCr
 	return _c
 }
 
+// SetThumbnailURL sets the "thumbnail_url" field.
+func (_c *ModifierOptionCreate) SetThumbnailURL(v string) *ModifierOptionCreate {
+	_c.mutation.SetThumbnailURL(v)
+	return _c
+}
+
+// SetNillableThumbnailURL sets the "thumbnail_url" field if the given value is not nil.
+func (_c *ModifierOptionCreate) SetNillableThumbnailURL(v *string) *ModifierOptionCreate {
+	if v != nil {
+		_c.SetThumbnailURL(*v)
+	}
+	return _c
+}
+
+// SetImageKeys sets the "image_keys" field.
+func (_c *ModifierOptionCreate) SetImageKeys(v []string) *ModifierOptionCreate {
+	_c.mutation.SetImageKeys(v)
+	return _c
+}
+
 // SetAvailable sets the "available" field.
 func (_c *ModifierOptionCreate) SetAvailable(v bool) *ModifierOptionCreate {
 	_c.mutation.SetAvailable(v)
@@ -85,6 +112,20 @@ func (_c *ModifierOptionCreate) SetNillableAvailable(v *bool) *ModifierOptionCre
 	return _c
 }
 
+// SetOutOfStock sets the "out_of_stock" field.
+func (_c *ModifierOptionCreate) SetOutOfStock(v bool) *ModifierOptionCreate {
+	_c.mutation.SetOutOfStock(v)
+	return _c
+}
+
+// SetNillableOutOfStock sets the "out_of_stock" field if the given value is not nil.
+func (_c *ModifierOptionCreate) SetNillableOutOfStock(v *bool) *ModifierOptionCreate {
+	if v != nil {
+		_c.SetOutOfStock(*v)
+	}
+	return _c
+}
+
 // SetPreSelect sets the "pre_select" field.
 func (_c *ModifierOptionCreate) SetPreSelect(v bool) *ModifierOptionCreate {
 	_c.mutation.SetPreSelect(v)
@@ -99,6 +140,34 @@ func (_c *ModifierOptionCreate) SetNillablePreSelect(v *bool) *ModifierOptionCre
 	return _c
 }
 
+// SetDisplayOrder sets the "display_order" field.
+func (_c *ModifierOptionCreate) SetDisplayOrder(v int) *ModifierOptionCreate {
+	_c.mutation.SetDisplayOrder(v)
+	return _c
+}
+
+// SetNillableDisplayOrder sets the "display_order" field if the given value is not nil.
+func (_c *ModifierOptionCreate) SetNillableDisplayOrder(v *int) *ModifierOptionCreate {
+	if v != nil {
+		_c.SetDisplayOrder(*v)
+	}
+	return _c
+}
+
+// SetMaxQuantity sets the "max_quantity" field.
+func (_c *ModifierOptionCreate) SetMaxQuantity(v int) *ModifierOptionCreate {
+	_c.mutation.SetMaxQuantity(v)
+	return _c
+}
+
+// SetNillableMaxQuantity sets the "max_quantity" field if the given value is not nil.
+func (_c *ModifierOptionCreate) SetNillableMaxQuantity(v *int) *ModifierOptionCreate {
+	if v != nil {
+		_c.SetMaxQuantity(*v)
+	}
+	return _c
+}
+
 // SetModifierID sets the "modifier_id" field.
 func (_c *ModifierOptionCreate) SetModifierID(v uuid.UUID) *ModifierOptionCreate {
 	_c.mutation.SetModifierID(v)
@@ -139,6 +208,36 @@ func (_c *ModifierOptionCreate) AddOrderItemModifierOptions(v ...*OrderItemModif
 	return _c.AddOrderItemModifierOptionIDs(ids...)
 }
 
+// AddChildModifierIDs adds the "child_modifiers" edge to the Modifier entity by IDs.
+func (_c *ModifierOptionCreate) AddChildModifierIDs(ids ...uuid.UUID) *ModifierOptionCreate {
+	_c.mutation.AddChildModifierIDs(ids...)
+	return _c
+}
+
+// AddChildModifiers adds the "child_modifiers" edges to the Modifier entity.
+func (_c *ModifierOptionCreate) AddChildModifiers(v ...*Modifier) *ModifierOptionCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddChildModifierIDs(ids...)
+}
+
+// AddRecipeLineIDs adds the "recipe_lines" edge to the RecipeIngredient entity by IDs.
+func (_c *ModifierOptionCreate) AddRecipeLineIDs(ids ...uuid.UUID) *ModifierOptionCreate {
+	_c.mutation.AddRecipeLineIDs(ids...)
+	return _c
+}
+
+// AddRecipeLines adds the "recipe_lines" edges to the RecipeIngredient entity.
+func (_c *ModifierOptionCreate) AddRecipeLines(v ...*RecipeIngredient) *ModifierOptionCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddRecipeLineIDs(ids...)
+}
+
 // Mutation returns the ModifierOptionMutation object of the builder.
 func (_c *ModifierOptionCreate) Mutation() *ModifierOptionMutation {
 	return _c.mutation
@@ -186,10 +285,22 @@ func (_c *ModifierOptionCreate) defaults() {
 		v := modifieroption.DefaultAvailable
 		_c.mutation.SetAvailable(v)
 	}
+	if _, ok := _c.mutation.OutOfStock(); !ok {
+		v := modifieroption.DefaultOutOfStock
+		_c.mutation.SetOutOfStock(v)
+	}
 	if _, ok := _c.mutation.PreSelect(); !ok {
 		v := modifieroption.DefaultPreSelect
 		_c.mutation.SetPreSelect(v)
 	}
+	if _, ok := _c.mutation.DisplayOrder(); !ok {
+		v := modifieroption.DefaultDisplayOrder
+		_c.mutation.SetDisplayOrder(v)
+	}
+	if _, ok := _c.mutation.MaxQuantity(); !ok {
+		v := modifieroption.DefaultMaxQuantity
+		_c.mutation.SetMaxQuantity(v)
+	}
 	if _, ok := _c.mutation.ID(); !ok {
 		v := modifieroption.DefaultID()
 		_c.mutation.SetID(v)
@@ -212,12 +323,36 @@ func (_c *ModifierOptionCreate) check() error {
 	if _, ok := _c.mutation.Price(); !ok {
 		return &ValidationError{Name: "price", err: errors.New(`ent: missing required field "ModifierOption.price"`)}
 	}
+	if v, ok := _c.mutation.Price(); ok {
+		if err := modifieroption.PriceValidator(v); err != nil {
+			return &ValidationError{Name: "price", err: fmt.Errorf(`ent: validator failed for field "ModifierOption.price": %w`, err)}
+		}
+	}
 	if _, ok := _c.mutation.Available(); !ok {
 		return &ValidationError{Name: "available", err: errors.New(`ent: missing required field "ModifierOption.available"`)}
 	}
+	if _, ok := _c.mutation.OutOfStock(); !ok {
+		return &ValidationError{Name: "out_of_stock", err: errors.New(`ent: missing required field "ModifierOption.out_of_stock"`)}
+	}
 	if _, ok := _c.mutation.PreSelect(); !ok {
 		return &ValidationError{Name: "pre_select", err: errors.New(`ent: missing required field "ModifierOption.pre_select"`)}
 	}
+	if _, ok := _c.mutation.DisplayOrder(); !ok {
+		return &ValidationError{Name: "display_order", err: errors.New(`ent: missing required field "ModifierOption.display_order"`)}
+	}
+	if v, ok := _c.mutation.DisplayOrder(); ok {
+		if err := modifieroption.DisplayOrderValidator(v); err != nil {
+			return &ValidationError{Name: "display_order", err: fmt.Errorf(`ent: validator failed for field "ModifierOption.display_order": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.MaxQuantity(); !ok {
+		return &ValidationError{Name: "max_quantity", err: errors.New(`ent: missing required field "ModifierOption.max_quantity"`)}
+	}
+	if v, ok := _c.mutation.MaxQuantity(); ok {
+		if err := modifieroption.MaxQuantityValidator(v); err != nil {
+			return &ValidationError{Name: "max_quantity", err: fmt.Errorf(`ent: validator failed for field "ModifierOption.max_quantity": %w`, err)}
+		}
+	}
 	if _, ok := _c.mutation.ModifierID(); !ok {
 		return &ValidationError{Name: "modifier_id", err: errors.New(`ent: missing required field "ModifierOption.modifier_id"`)}
 	}
@@ -268,21 +403,45 @@ func (_c *ModifierOptionCreate) createSpec() (*ModifierOption, *sqlgraph.CreateS
 		_node.Name = value
 	}
 	if value, ok := _c.mutation.Price(); ok {
-		_spec.SetField(modifieroption.FieldPrice, field.TypeFloat64, value)
+		_spec.SetField(modifieroption.FieldPrice, field.TypeInt64, value)
 		_node.Price = value
 	}
+	if value, ok := _c.mutation.VariantPrices(); ok {
+		_spec.SetField(modifieroption.FieldVariantPrices, field.TypeJSON, value)
+		_node.VariantPrices = value
+	}
 	if value, ok := _c.mutation.ImageURL(); ok {
 		_spec.SetField(modifieroption.FieldImageURL, field.TypeString, value)
 		_node.ImageURL = value
 	}
+	if value, ok := _c.mutation.ThumbnailURL(); ok {
+		_spec.SetField(modifieroption.FieldThumbnailURL, field.TypeString, value)
+		_node.ThumbnailURL = value
+	}
+	if value, ok := _c.mutation.ImageKeys(); ok {
+		_spec.SetField(modifieroption.FieldImageKeys, field.TypeJSON, value)
+		_node.ImageKeys = value
+	}
 	if value, ok := _c.mutation.Available(); ok {
 		_spec.SetField(modifieroption.FieldAvailable, field.TypeBool, value)
 		_node.Available = value
 	}
+	if value, ok := _c.mutation.OutOfStock(); ok {
+		_spec.SetField(modifieroption.FieldOutOfStock, field.TypeBool, value)
+		_node.OutOfStock = value
+	}
 	if value, ok := _c.mutation.PreSelect(); ok {
 		_spec.SetField(modifieroption.FieldPreSelect, field.TypeBool, value)
 		_node.PreSelect = value
 	}
+	if value, ok := _c.mutation.DisplayOrder(); ok {
+		_spec.SetField(modifieroption.FieldDisplayOrder, field.TypeInt, value)
+		_node.DisplayOrder = value
+	}
+	if value, ok := _c.mutation.MaxQuantity(); ok {
+		_spec.SetField(modifieroption.FieldMaxQuantity, field.TypeInt, value)
+		_node.MaxQuantity = value
+	}
 	if nodes := _c.mutation.ModifierIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -316,6 +475,38 @@ func (_c *ModifierOptionCreate) createSpec() (*ModifierOption, *sqlgraph.CreateS
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.ChildModifiersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   modifieroption.ChildModifiersTable,
+			Columns: modifieroption.ChildModifiersPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(modifier.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.RecipeLinesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   modifieroption.RecipeLinesTable,
+			Columns: []string{modifieroption.RecipeLinesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(recipeingredient.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
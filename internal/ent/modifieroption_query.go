@@ -16,6 +16,7 @@ import (
 	"github.com/Jiruu246/rms/internal/ent/modifieroption"
 	"github.com/Jiruu246/rms/internal/ent/orderitemmodifieroption"
 	"github.com/Jiruu246/rms/internal/ent/predicate"
+	"github.com/Jiruu246/rms/internal/ent/recipeingredient"
 	"github.com/google/uuid"
 )
 
@@ -28,6 +29,8 @@ type ModifierOptionQuery struct {
 	predicates                   []predicate.ModifierOption
 	withModifier                 *ModifierQuery
 	withOrderItemModifierOptions *OrderItemModifierOptionQuery
+	withChildModifiers           *ModifierQuery
+	withRecipeLines              *RecipeIngredientQuery
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -108,6 +111,50 @@ func (_q *ModifierOptionQuery) QueryOrderItemModifierOptions() *OrderItemModifie
 	return query
 }
 
+// QueryChildModifiers chains the current query on the "child_modifiers" edge.
+func (_q *ModifierOptionQuery) QueryChildModifiers() *ModifierQuery {
+	query := (&ModifierClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(modifieroption.Table, modifieroption.FieldID, selector),
+			sqlgraph.To(modifier.Table, modifier.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, false, modifieroption.ChildModifiersTable, modifieroption.ChildModifiersPrimaryKey...),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryRecipeLines chains the current query on the "recipe_lines" edge.
+func (_q *ModifierOptionQuery) QueryRecipeLines() *RecipeIngredientQuery {
+	query := (&RecipeIngredientClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(modifieroption.Table, modifieroption.FieldID, selector),
+			sqlgraph.To(recipeingredient.Table, recipeingredient.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, modifieroption.RecipeLinesTable, modifieroption.RecipeLinesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first ModifierOption entity from the query.
 // Returns a *NotFoundError when no ModifierOption was found.
 func (_q *ModifierOptionQuery) First(ctx context.Context) (*ModifierOption, error) {
@@ -302,6 +349,8 @@ func (_q *ModifierOptionQuery) Clone() *ModifierOptionQuery {
 		predicates:                   append([]predicate.ModifierOption{}, _q.predicates...),
 		withModifier:                 _q.withModifier.Clone(),
 		withOrderItemModifierOptions: _q.withOrderItemModifierOptions.Clone(),
+		withChildModifiers:           _q.withChildModifiers.Clone(),
+		withRecipeLines:              _q.withRecipeLines.Clone(),
 		// clone intermediate query.
 		sql:  _q.sql.Clone(),
 		path: _q.path,
@@ -330,6 +379,28 @@ func (_q *ModifierOptionQuery) WithOrderItemModifierOptions(opts ...func(*OrderI
 	return _q
 }
 
+// WithChildModifiers tells the query-builder to eager-load the nodes that are connected to
+// the "child_modifiers" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *ModifierOptionQuery) WithChildModifiers(opts ...func(*ModifierQuery)) *ModifierOptionQuery {
+	query := (&ModifierClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withChildModifiers = query
+	return _q
+}
+
+// WithRecipeLines tells the query-builder to eager-load the nodes that are connected to
+// the "recipe_lines" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *ModifierOptionQuery) WithRecipeLines(opts ...func(*RecipeIngredientQuery)) *ModifierOptionQuery {
+	query := (&RecipeIngredientClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withRecipeLines = query
+	return _q
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -408,9 +479,11 @@ func (_q *ModifierOptionQuery) sqlAll(ctx context.Context, hooks ...queryHook) (
 	var (
 		nodes       = []*ModifierOption{}
 		_spec       = _q.querySpec()
-		loadedTypes = [2]bool{
+		loadedTypes = [4]bool{
 			_q.withModifier != nil,
 			_q.withOrderItemModifierOptions != nil,
+			_q.withChildModifiers != nil,
+			_q.withRecipeLines != nil,
 		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
@@ -446,6 +519,20 @@ func (_q *ModifierOptionQuery) sqlAll(ctx context.Context, hooks ...queryHook) (
 			return nil, err
 		}
 	}
+	if query := _q.withChildModifiers; query != nil {
+		if err := _q.loadChildModifiers(ctx, query, nodes,
+			func(n *ModifierOption) { n.Edges.ChildModifiers = []*Modifier{} },
+			func(n *ModifierOption, e *Modifier) { n.Edges.ChildModifiers = append(n.Edges.ChildModifiers, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withRecipeLines; query != nil {
+		if err := _q.loadRecipeLines(ctx, query, nodes,
+			func(n *ModifierOption) { n.Edges.RecipeLines = []*RecipeIngredient{} },
+			func(n *ModifierOption, e *RecipeIngredient) { n.Edges.RecipeLines = append(n.Edges.RecipeLines, e) }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -508,6 +595,100 @@ func (_q *ModifierOptionQuery) loadOrderItemModifierOptions(ctx context.Context,
 	}
 	return nil
 }
+func (_q *ModifierOptionQuery) loadChildModifiers(ctx context.Context, query *ModifierQuery, nodes []*ModifierOption, init func(*ModifierOption), assign func(*ModifierOption, *Modifier)) error {
+	edgeIDs := make([]driver.Value, len(nodes))
+	byID := make(map[uuid.UUID]*ModifierOption)
+	nids := make(map[uuid.UUID]map[*ModifierOption]struct{})
+	for i, node := range nodes {
+		edgeIDs[i] = node.ID
+		byID[node.ID] = node
+		if init != nil {
+			init(node)
+		}
+	}
+	query.Where(func(s *sql.Selector) {
+		joinT := sql.Table(modifieroption.ChildModifiersTable)
+		s.Join(joinT).On(s.C(modifier.FieldID), joinT.C(modifieroption.ChildModifiersPrimaryKey[1]))
+		s.Where(sql.InValues(joinT.C(modifieroption.ChildModifiersPrimaryKey[0]), edgeIDs...))
+		columns := s.SelectedColumns()
+		s.Select(joinT.C(modifieroption.ChildModifiersPrimaryKey[0]))
+		s.AppendSelect(columns...)
+		s.SetDistinct(false)
+	})
+	if err := query.prepareQuery(ctx); err != nil {
+		return err
+	}
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		return query.sqlAll(ctx, func(_ context.Context, spec *sqlgraph.QuerySpec) {
+			assign := spec.Assign
+			values := spec.ScanValues
+			spec.ScanValues = func(columns []string) ([]any, error) {
+				values, err := values(columns[1:])
+				if err != nil {
+					return nil, err
+				}
+				return append([]any{new(uuid.UUID)}, values...), nil
+			}
+			spec.Assign = func(columns []string, values []any) error {
+				outValue := *values[0].(*uuid.UUID)
+				inValue := *values[1].(*uuid.UUID)
+				if nids[inValue] == nil {
+					nids[inValue] = map[*ModifierOption]struct{}{byID[outValue]: {}}
+					return assign(columns[1:], values[1:])
+				}
+				nids[inValue][byID[outValue]] = struct{}{}
+				return nil
+			}
+		})
+	})
+	neighbors, err := withInterceptors[[]*Modifier](ctx, query, qr, query.inters)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected "child_modifiers" node returned %v`, n.ID)
+		}
+		for kn := range nodes {
+			assign(kn, n)
+		}
+	}
+	return nil
+}
+func (_q *ModifierOptionQuery) loadRecipeLines(ctx context.Context, query *RecipeIngredientQuery, nodes []*ModifierOption, init func(*ModifierOption), assign func(*ModifierOption, *RecipeIngredient)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*ModifierOption)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(recipeingredient.FieldModifierOptionID)
+	}
+	query.Where(predicate.RecipeIngredient(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(modifieroption.RecipeLinesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.ModifierOptionID
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "modifier_option_id" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "modifier_option_id" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (_q *ModifierOptionQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := _q.querySpec()
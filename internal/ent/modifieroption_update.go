@@ -10,11 +10,13 @@ import (
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
 	"entgo.io/ent/schema/field"
 	"github.com/Jiruu246/rms/internal/ent/modifier"
 	"github.com/Jiruu246/rms/internal/ent/modifieroption"
 	"github.com/Jiruu246/rms/internal/ent/orderitemmodifieroption"
 	"github.com/Jiruu246/rms/internal/ent/predicate"
+	"github.com/Jiruu246/rms/internal/ent/recipeingredient"
 	"github.com/google/uuid"
 )
 
@@ -52,14 +54,14 @@ func (_u *ModifierOptionUpdate) SetNillableName(v *string) *ModifierOptionUpdate
 }
 
 // SetPrice sets the "price" field.
-func (_u *ModifierOptionUpdate) SetPrice(v float64) *ModifierOptionUpdate {
+func (_u *ModifierOptionUpdate) SetPrice(v int64) *ModifierOptionUpdate {
 	_u.mutation.ResetPrice()
 	_u.mutation.SetPrice(v)
 	return _u
 }
 
 // SetNillablePrice sets the "price" field if the given value is not nil.
-func (_u *ModifierOptionUpdate) SetNillablePrice(v *float64) *ModifierOptionUpdate {
+func (_u *ModifierOptionUpdate) SetNillablePrice(v *int64) *ModifierOptionUpdate {
 	if v != nil {
 		_u.SetPrice(*v)
 	}
@@ -67,11 +69,23 @@ func (_u *ModifierOptionUpdate) SetNillablePrice(v *float64) *ModifierOptionUpda
 }
 
 // AddPrice adds value to the "price" field.
-func (_u *ModifierOptionUpdate) AddPrice(v float64) *ModifierOptionUpdate {
+func (_u *ModifierOptionUpdate) AddPrice(v int64) *ModifierOptionUpdate {
 	_u.mutation.AddPrice(v)
 	return _u
 }
 
+// SetVariantPrices sets the "variant_prices" field.
+func (_u *ModifierOptionUpdate) SetVariantPrices(v map[string]int64) *ModifierOptionUpdate {
+	_u.mutation.SetVariantPrices(v)
+	return _u
+}
+
+// ClearVariantPrices clears the value of the "variant_prices" field.
+func (_u *ModifierOptionUpdate) ClearVariantPrices() *ModifierOptionUpdate {
+	_u.mutation.ClearVariantPrices()
+	return _u
+}
+
 // SetImageURL sets the "image_url" field.
 func (_u *ModifierOptionUpdate) SetImageURL(v string) *ModifierOptionUpdate {
 	_u.mutation.SetImageURL(v)
@@ -92,6 +106,44 @@ func (_u *ModifierOptionUpdate) ClearImageURL() *ModifierOptionUpdate {
 	return _u
 }
 
+// SetThumbnailURL sets the "thumbnail_url" field.
+func (_u *ModifierOptionUpdate) SetThumbnailURL(v string) *ModifierOptionUpdate {
+	_u.mutation.SetThumbnailURL(v)
+	return _u
+}
+
+// SetNillableThumbnailURL sets the "thumbnail_url" field if the given value is not nil.
+func (_u *ModifierOptionUpdate) SetNillableThumbnailURL(v *string) *ModifierOptionUpdate {
+	if v != nil {
+		_u.SetThumbnailURL(*v)
+	}
+	return _u
+}
+
+// ClearThumbnailURL clears the value of the "thumbnail_url" field.
+func (_u *ModifierOptionUpdate) ClearThumbnailURL() *ModifierOptionUpdate {
+	_u.mutation.ClearThumbnailURL()
+	return _u
+}
+
+// SetImageKeys sets the "image_keys" field.
+func (_u *ModifierOptionUpdate) SetImageKeys(v []string) *ModifierOptionUpdate {
+	_u.mutation.SetImageKeys(v)
+	return _u
+}
+
+// AppendImageKeys appends value to the "image_keys" field.
+func (_u *ModifierOptionUpdate) AppendImageKeys(v []string) *ModifierOptionUpdate {
+	_u.mutation.AppendImageKeys(v)
+	return _u
+}
+
+// ClearImageKeys clears the value of the "image_keys" field.
+func (_u *ModifierOptionUpdate) ClearImageKeys() *ModifierOptionUpdate {
+	_u.mutation.ClearImageKeys()
+	return _u
+}
+
 // SetAvailable sets the "available" field.
 func (_u *ModifierOptionUpdate) SetAvailable(v bool) *ModifierOptionUpdate {
 	_u.mutation.SetAvailable(v)
@@ -106,6 +158,20 @@ func (_u *ModifierOptionUpdate) SetNillableAvailable(v *bool) *ModifierOptionUpd
 	return _u
 }
 
+// SetOutOfStock sets the "out_of_stock" field.
+func (_u *ModifierOptionUpdate) SetOutOfStock(v bool) *ModifierOptionUpdate {
+	_u.mutation.SetOutOfStock(v)
+	return _u
+}
+
+// SetNillableOutOfStock sets the "out_of_stock" field if the given value is not nil.
+func (_u *ModifierOptionUpdate) SetNillableOutOfStock(v *bool) *ModifierOptionUpdate {
+	if v != nil {
+		_u.SetOutOfStock(*v)
+	}
+	return _u
+}
+
 // SetPreSelect sets the "pre_select" field.
 func (_u *ModifierOptionUpdate) SetPreSelect(v bool) *ModifierOptionUpdate {
 	_u.mutation.SetPreSelect(v)
@@ -120,6 +186,48 @@ func (_u *ModifierOptionUpdate) SetNillablePreSelect(v *bool) *ModifierOptionUpd
 	return _u
 }
 
+// SetDisplayOrder sets the "display_order" field.
+func (_u *ModifierOptionUpdate) SetDisplayOrder(v int) *ModifierOptionUpdate {
+	_u.mutation.ResetDisplayOrder()
+	_u.mutation.SetDisplayOrder(v)
+	return _u
+}
+
+// SetNillableDisplayOrder sets the "display_order" field if the given value is not nil.
+func (_u *ModifierOptionUpdate) SetNillableDisplayOrder(v *int) *ModifierOptionUpdate {
+	if v != nil {
+		_u.SetDisplayOrder(*v)
+	}
+	return _u
+}
+
+// AddDisplayOrder adds value to the "display_order" field.
+func (_u *ModifierOptionUpdate) AddDisplayOrder(v int) *ModifierOptionUpdate {
+	_u.mutation.AddDisplayOrder(v)
+	return _u
+}
+
+// SetMaxQuantity sets the "max_quantity" field.
+func (_u *ModifierOptionUpdate) SetMaxQuantity(v int) *ModifierOptionUpdate {
+	_u.mutation.ResetMaxQuantity()
+	_u.mutation.SetMaxQuantity(v)
+	return _u
+}
+
+// SetNillableMaxQuantity sets the "max_quantity" field if the given value is not nil.
+func (_u *ModifierOptionUpdate) SetNillableMaxQuantity(v *int) *ModifierOptionUpdate {
+	if v != nil {
+		_u.SetMaxQuantity(*v)
+	}
+	return _u
+}
+
+// AddMaxQuantity adds value to the "max_quantity" field.
+func (_u *ModifierOptionUpdate) AddMaxQuantity(v int) *ModifierOptionUpdate {
+	_u.mutation.AddMaxQuantity(v)
+	return _u
+}
+
 // SetModifierID sets the "modifier_id" field.
 func (_u *ModifierOptionUpdate) SetModifierID(v uuid.UUID) *ModifierOptionUpdate {
 	_u.mutation.SetModifierID(v)
@@ -154,6 +262,36 @@ func (_u *ModifierOptionUpdate) AddOrderItemModifierOptions(v ...*OrderItemModif
 	return _u.AddOrderItemModifierOptionIDs(ids...)
 }
 
+// AddChildModifierIDs adds the "child_modifiers" edge to the Modifier entity by IDs.
+func (_u *ModifierOptionUpdate) AddChildModifierIDs(ids ...uuid.UUID) *ModifierOptionUpdate {
+	_u.mutation.AddChildModifierIDs(ids...)
+	return _u
+}
+
+// AddChildModifiers adds the "child_modifiers" edges to the Modifier entity.
+func (_u *ModifierOptionUpdate) AddChildModifiers(v ...*Modifier) *ModifierOptionUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddChildModifierIDs(ids...)
+}
+
+// AddRecipeLineIDs adds the "recipe_lines" edge to the RecipeIngredient entity by IDs.
+func (_u *ModifierOptionUpdate) AddRecipeLineIDs(ids ...uuid.UUID) *ModifierOptionUpdate {
+	_u.mutation.AddRecipeLineIDs(ids...)
+	return _u
+}
+
+// AddRecipeLines adds the "recipe_lines" edges to the RecipeIngredient entity.
+func (_u *ModifierOptionUpdate) AddRecipeLines(v ...*RecipeIngredient) *ModifierOptionUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddRecipeLineIDs(ids...)
+}
+
 // Mutation returns the ModifierOptionMutation object of the builder.
 func (_u *ModifierOptionUpdate) Mutation() *ModifierOptionMutation {
 	return _u.mutation
@@ -186,6 +324,48 @@ func (_u *ModifierOptionUpdate) RemoveOrderItemModifierOptions(v ...*OrderItemMo
 	return _u.RemoveOrderItemModifierOptionIDs(ids...)
 }
 
+// ClearChildModifiers clears all "child_modifiers" edges to the Modifier entity.
+func (_u *ModifierOptionUpdate) ClearChildModifiers() *ModifierOptionUpdate {
+	_u.mutation.ClearChildModifiers()
+	return _u
+}
+
+// RemoveChildModifierIDs removes the "child_modifiers" edge to Modifier entities by IDs.
+func (_u *ModifierOptionUpdate) RemoveChildModifierIDs(ids ...uuid.UUID) *ModifierOptionUpdate {
+	_u.mutation.RemoveChildModifierIDs(ids...)
+	return _u
+}
+
+// RemoveChildModifiers removes "child_modifiers" edges to Modifier entities.
+func (_u *ModifierOptionUpdate) RemoveChildModifiers(v ...*Modifier) *ModifierOptionUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveChildModifierIDs(ids...)
+}
+
+// ClearRecipeLines clears all "recipe_lines" edges to the RecipeIngredient entity.
+func (_u *ModifierOptionUpdate) ClearRecipeLines() *ModifierOptionUpdate {
+	_u.mutation.ClearRecipeLines()
+	return _u
+}
+
+// RemoveRecipeLineIDs removes the "recipe_lines" edge to RecipeIngredient entities by IDs.
+func (_u *ModifierOptionUpdate) RemoveRecipeLineIDs(ids ...uuid.UUID) *ModifierOptionUpdate {
+	_u.mutation.RemoveRecipeLineIDs(ids...)
+	return _u
+}
+
+// RemoveRecipeLines removes "recipe_lines" edges to RecipeIngredient entities.
+func (_u *ModifierOptionUpdate) RemoveRecipeLines(v ...*RecipeIngredient) *ModifierOptionUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveRecipeLineIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (_u *ModifierOptionUpdate) Save(ctx context.Context) (int, error) {
 	_u.defaults()
@@ -229,6 +409,21 @@ func (_u *ModifierOptionUpdate) check() error {
 			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "ModifierOption.name": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.Price(); ok {
+		if err := modifieroption.PriceValidator(v); err != nil {
+			return &ValidationError{Name: "price", err: fmt.Errorf(`ent: validator failed for field "ModifierOption.price": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.DisplayOrder(); ok {
+		if err := modifieroption.DisplayOrderValidator(v); err != nil {
+			return &ValidationError{Name: "display_order", err: fmt.Errorf(`ent: validator failed for field "ModifierOption.display_order": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.MaxQuantity(); ok {
+		if err := modifieroption.MaxQuantityValidator(v); err != nil {
+			return &ValidationError{Name: "max_quantity", err: fmt.Errorf(`ent: validator failed for field "ModifierOption.max_quantity": %w`, err)}
+		}
+	}
 	if _u.mutation.ModifierCleared() && len(_u.mutation.ModifierIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "ModifierOption.modifier"`)
 	}
@@ -254,10 +449,16 @@ func (_u *ModifierOptionUpdate) sqlSave(ctx context.Context) (_node int, err err
 		_spec.SetField(modifieroption.FieldName, field.TypeString, value)
 	}
 	if value, ok := _u.mutation.Price(); ok {
-		_spec.SetField(modifieroption.FieldPrice, field.TypeFloat64, value)
+		_spec.SetField(modifieroption.FieldPrice, field.TypeInt64, value)
 	}
 	if value, ok := _u.mutation.AddedPrice(); ok {
-		_spec.AddField(modifieroption.FieldPrice, field.TypeFloat64, value)
+		_spec.AddField(modifieroption.FieldPrice, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.VariantPrices(); ok {
+		_spec.SetField(modifieroption.FieldVariantPrices, field.TypeJSON, value)
+	}
+	if _u.mutation.VariantPricesCleared() {
+		_spec.ClearField(modifieroption.FieldVariantPrices, field.TypeJSON)
 	}
 	if value, ok := _u.mutation.ImageURL(); ok {
 		_spec.SetField(modifieroption.FieldImageURL, field.TypeString, value)
@@ -265,12 +466,44 @@ func (_u *ModifierOptionUpdate) sqlSave(ctx context.Context) (_node int, err err
 	if _u.mutation.ImageURLCleared() {
 		_spec.ClearField(modifieroption.FieldImageURL, field.TypeString)
 	}
+	if value, ok := _u.mutation.ThumbnailURL(); ok {
+		_spec.SetField(modifieroption.FieldThumbnailURL, field.TypeString, value)
+	}
+	if _u.mutation.ThumbnailURLCleared() {
+		_spec.ClearField(modifieroption.FieldThumbnailURL, field.TypeString)
+	}
+	if value, ok := _u.mutation.ImageKeys(); ok {
+		_spec.SetField(modifieroption.FieldImageKeys, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedImageKeys(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, modifieroption.FieldImageKeys, value)
+		})
+	}
+	if _u.mutation.ImageKeysCleared() {
+		_spec.ClearField(modifieroption.FieldImageKeys, field.TypeJSON)
+	}
 	if value, ok := _u.mutation.Available(); ok {
 		_spec.SetField(modifieroption.FieldAvailable, field.TypeBool, value)
 	}
+	if value, ok := _u.mutation.OutOfStock(); ok {
+		_spec.SetField(modifieroption.FieldOutOfStock, field.TypeBool, value)
+	}
 	if value, ok := _u.mutation.PreSelect(); ok {
 		_spec.SetField(modifieroption.FieldPreSelect, field.TypeBool, value)
 	}
+	if value, ok := _u.mutation.DisplayOrder(); ok {
+		_spec.SetField(modifieroption.FieldDisplayOrder, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedDisplayOrder(); ok {
+		_spec.AddField(modifieroption.FieldDisplayOrder, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.MaxQuantity(); ok {
+		_spec.SetField(modifieroption.FieldMaxQuantity, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedMaxQuantity(); ok {
+		_spec.AddField(modifieroption.FieldMaxQuantity, field.TypeInt, value)
+	}
 	if _u.mutation.ModifierCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -345,6 +578,96 @@ func (_u *ModifierOptionUpdate) sqlSave(ctx context.Context) (_node int, err err
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.ChildModifiersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   modifieroption.ChildModifiersTable,
+			Columns: modifieroption.ChildModifiersPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(modifier.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedChildModifiersIDs(); len(nodes) > 0 && !_u.mutation.ChildModifiersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   modifieroption.ChildModifiersTable,
+			Columns: modifieroption.ChildModifiersPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(modifier.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ChildModifiersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   modifieroption.ChildModifiersTable,
+			Columns: modifieroption.ChildModifiersPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(modifier.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.RecipeLinesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   modifieroption.RecipeLinesTable,
+			Columns: []string{modifieroption.RecipeLinesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(recipeingredient.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedRecipeLinesIDs(); len(nodes) > 0 && !_u.mutation.RecipeLinesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   modifieroption.RecipeLinesTable,
+			Columns: []string{modifieroption.RecipeLinesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(recipeingredient.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RecipeLinesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   modifieroption.RecipeLinesTable,
+			Columns: []string{modifieroption.RecipeLinesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(recipeingredient.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{modifieroption.Label}
@@ -386,14 +709,14 @@ func (_u *ModifierOptionUpdateOne) SetNillableName(v *string) *ModifierOptionUpd
 }
 
 // SetPrice sets the "price" field.
-func (_u *ModifierOptionUpdateOne) SetPrice(v float64) *ModifierOptionUpdateOne {
+func (_u *ModifierOptionUpdateOne) SetPrice(v int64) *ModifierOptionUpdateOne {
 	_u.mutation.ResetPrice()
 	_u.mutation.SetPrice(v)
 	return _u
 }
 
 // SetNillablePrice sets the "price" field if the given value is not nil.
-func (_u *ModifierOptionUpdateOne) SetNillablePrice(v *float64) *ModifierOptionUpdateOne {
+func (_u *ModifierOptionUpdateOne) SetNillablePrice(v *int64) *ModifierOptionUpdateOne {
 	if v != nil {
 		_u.SetPrice(*v)
 	}
@@ -401,11 +724,23 @@ func (_u *ModifierOptionUpdateOne) SetNillablePrice(v *float64) *ModifierOptionU
 }
 
 // AddPrice adds value to the "price" field.
-func (_u *ModifierOptionUpdateOne) AddPrice(v float64) *ModifierOptionUpdateOne {
+func (_u *ModifierOptionUpdateOne) AddPrice(v int64) *ModifierOptionUpdateOne {
 	_u.mutation.AddPrice(v)
 	return _u
 }
 
+// SetVariantPrices sets the "variant_prices" field.
+func (_u *ModifierOptionUpdateOne) SetVariantPrices(v map[string]int64) *ModifierOptionUpdateOne {
+	_u.mutation.SetVariantPrices(v)
+	return _u
+}
+
+// ClearVariantPrices clears the value of the "variant_prices" field.
+func (_u *ModifierOptionUpdateOne) ClearVariantPrices() *ModifierOptionUpdateOne {
+	_u.mutation.ClearVariantPrices()
+	return _u
+}
+
 // SetImageURL sets the "image_url" field.
 func (_u *ModifierOptionUpdateOne) SetImageURL(v string) *ModifierOptionUpdateOne {
 	_u.mutation.SetImageURL(v)
@@ -426,6 +761,44 @@ func (_u *ModifierOptionUpdateOne) ClearImageURL() *ModifierOptionUpdateOne {
 	return _u
 }
 
+// SetThumbnailURL sets the "thumbnail_url" field.
+func (_u *ModifierOptionUpdateOne) SetThumbnailURL(v string) *ModifierOptionUpdateOne {
+	_u.mutation.SetThumbnailURL(v)
+	return _u
+}
+
+// SetNillableThumbnailURL sets the "thumbnail_url" field if the given value is not nil.
+func (_u *ModifierOptionUpdateOne) SetNillableThumbnailURL(v *string) *ModifierOptionUpdateOne {
+	if v != nil {
+		_u.SetThumbnailURL(*v)
+	}
+	return _u
+}
+
+// ClearThumbnailURL clears the value of the "thumbnail_url" field.
+func (_u *ModifierOptionUpdateOne) ClearThumbnailURL() *ModifierOptionUpdateOne {
+	_u.mutation.ClearThumbnailURL()
+	return _u
+}
+
+// SetImageKeys sets the "image_keys" field.
+func (_u *ModifierOptionUpdateOne) SetImageKeys(v []string) *ModifierOptionUpdateOne {
+	_u.mutation.SetImageKeys(v)
+	return _u
+}
+
+// AppendImageKeys appends value to the "image_keys" field.
+func (_u *ModifierOptionUpdateOne) AppendImageKeys(v []string) *ModifierOptionUpdateOne {
+	_u.mutation.AppendImageKeys(v)
+	return _u
+}
+
+// ClearImageKeys clears the value of the "image_keys" field.
+func (_u *ModifierOptionUpdateOne) ClearImageKeys() *ModifierOptionUpdateOne {
+	_u.mutation.ClearImageKeys()
+	return _u
+}
+
 // SetAvailable sets the "available" field.
 func (_u *ModifierOptionUpdateOne) SetAvailable(v bool) *ModifierOptionUpdateOne {
 	_u.mutation.SetAvailable(v)
@@ -440,6 +813,20 @@ func (_u *ModifierOptionUpdateOne) SetNillableAvailable(v *bool) *ModifierOption
 	return _u
 }
 
+// SetOutOfStock sets the "out_of_stock" field.
+func (_u *ModifierOptionUpdateOne) SetOutOfStock(v bool) *ModifierOptionUpdateOne {
+	_u.mutation.SetOutOfStock(v)
+	return _u
+}
+
+// SetNillableOutOfStock sets the "out_of_stock" field if the given value is not nil.
+func (_u *ModifierOptionUpdateOne) SetNillableOutOfStock(v *bool) *ModifierOptionUpdateOne {
+	if v != nil {
+		_u.SetOutOfStock(*v)
+	}
+	return _u
+}
+
 // SetPreSelect sets the "pre_select" field.
 func (_u *ModifierOptionUpdateOne) SetPreSelect(v bool) *ModifierOptionUpdateOne {
 	_u.mutation.SetPreSelect(v)
@@ -454,6 +841,48 @@ func (_u *ModifierOptionUpdateOne) SetNillablePreSelect(v *bool) *ModifierOption
 	return _u
 }
 
+// SetDisplayOrder sets the "display_order" field.
+func (_u *ModifierOptionUpdateOne) SetDisplayOrder(v int) *ModifierOptionUpdateOne {
+	_u.mutation.ResetDisplayOrder()
+	_u.mutation.SetDisplayOrder(v)
+	return _u
+}
+
+// SetNillableDisplayOrder sets the "display_order" field if the given value is not nil.
+func (_u *ModifierOptionUpdateOne) SetNillableDisplayOrder(v *int) *ModifierOptionUpdateOne {
+	if v != nil {
+		_u.SetDisplayOrder(*v)
+	}
+	return _u
+}
+
+// AddDisplayOrder adds value to the "display_order" field.
+func (_u *ModifierOptionUpdateOne) AddDisplayOrder(v int) *ModifierOptionUpdateOne {
+	_u.mutation.AddDisplayOrder(v)
+	return _u
+}
+
+// SetMaxQuantity sets the "max_quantity" field.
+func (_u *ModifierOptionUpdateOne) SetMaxQuantity(v int) *ModifierOptionUpdateOne {
+	_u.mutation.ResetMaxQuantity()
+	_u.mutation.SetMaxQuantity(v)
+	return _u
+}
+
+// SetNillableMaxQuantity sets the "max_quantity" field if the given value is not nil.
+func (_u *ModifierOptionUpdateOne) SetNillableMaxQuantity(v *int) *ModifierOptionUpdateOne {
+	if v != nil {
+		_u.SetMaxQuantity(*v)
+	}
+	return _u
+}
+
+// AddMaxQuantity adds value to the "max_quantity" field.
+func (_u *ModifierOptionUpdateOne) AddMaxQuantity(v int) *ModifierOptionUpdateOne {
+	_u.mutation.AddMaxQuantity(v)
+	return _u
+}
+
 // SetModifierID sets the "modifier_id" field.
 func (_u *ModifierOptionUpdateOne) SetModifierID(v uuid.UUID) *ModifierOptionUpdateOne {
 	_u.mutation.SetModifierID(v)
@@ -488,6 +917,36 @@ func (_u *ModifierOptionUpdateOne) AddOrderItemModifierOptions(v ...*OrderItemMo
 	return _u.AddOrderItemModifierOptionIDs(ids...)
 }
 
+// AddChildModifierIDs adds the "child_modifiers" edge to the Modifier entity by IDs.
+func (_u *ModifierOptionUpdateOne) AddChildModifierIDs(ids ...uuid.UUID) *ModifierOptionUpdateOne {
+	_u.mutation.AddChildModifierIDs(ids...)
+	return _u
+}
+
+// AddChildModifiers adds the "child_modifiers" edges to the Modifier entity.
+func (_u *ModifierOptionUpdateOne) AddChildModifiers(v ...*Modifier) *ModifierOptionUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddChildModifierIDs(ids...)
+}
+
+// AddRecipeLineIDs adds the "recipe_lines" edge to the RecipeIngredient entity by IDs.
+func (_u *ModifierOptionUpdateOne) AddRecipeLineIDs(ids ...uuid.UUID) *ModifierOptionUpdateOne {
+	_u.mutation.AddRecipeLineIDs(ids...)
+	return _u
+}
+
+// AddRecipeLines adds the "recipe_lines" edges to the RecipeIngredient entity.
+func (_u *ModifierOptionUpdateOne) AddRecipeLines(v ...*RecipeIngredient) *ModifierOptionUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddRecipeLineIDs(ids...)
+}
+
 // Mutation returns the ModifierOptionMutation object of the builder.
 func (_u *ModifierOptionUpdateOne) Mutation() *ModifierOptionMutation {
 	return _u.mutation
@@ -520,6 +979,48 @@ func (_u *ModifierOptionUpdateOne) RemoveOrderItemModifierOptions(v ...*OrderIte
 	return _u.RemoveOrderItemModifierOptionIDs(ids...)
 }
 
+// ClearChildModifiers clears all "child_modifiers" edges to the Modifier entity.
+func (_u *ModifierOptionUpdateOne) ClearChildModifiers() *ModifierOptionUpdateOne {
+	_u.mutation.ClearChildModifiers()
+	return _u
+}
+
+// RemoveChildModifierIDs removes the "child_modifiers" edge to Modifier entities by IDs.
+func (_u *ModifierOptionUpdateOne) RemoveChildModifierIDs(ids ...uuid.UUID) *ModifierOptionUpdateOne {
+	_u.mutation.RemoveChildModifierIDs(ids...)
+	return _u
+}
+
+// RemoveChildModifiers removes "child_modifiers" edges to Modifier entities.
+func (_u *ModifierOptionUpdateOne) RemoveChildModifiers(v ...*Modifier) *ModifierOptionUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveChildModifierIDs(ids...)
+}
+
+// ClearRecipeLines clears all "recipe_lines" edges to the RecipeIngredient entity.
+func (_u *ModifierOptionUpdateOne) ClearRecipeLines() *ModifierOptionUpdateOne {
+	_u.mutation.ClearRecipeLines()
+	return _u
+}
+
+// RemoveRecipeLineIDs removes the "recipe_lines" edge to RecipeIngredient entities by IDs.
+func (_u *ModifierOptionUpdateOne) RemoveRecipeLineIDs(ids ...uuid.UUID) *ModifierOptionUpdateOne {
+	_u.mutation.RemoveRecipeLineIDs(ids...)
+	return _u
+}
+
+// RemoveRecipeLines removes "recipe_lines" edges to RecipeIngredient entities.
+func (_u *ModifierOptionUpdateOne) RemoveRecipeLines(v ...*RecipeIngredient) *ModifierOptionUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveRecipeLineIDs(ids...)
+}
+
 // Where appends a list predicates to the ModifierOptionUpdate builder.
 func (_u *ModifierOptionUpdateOne) Where(ps ...predicate.ModifierOption) *ModifierOptionUpdateOne {
 	_u.mutation.Where(ps...)
@@ -576,6 +1077,21 @@ func (_u *ModifierOptionUpdateOne) check() error {
 			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "ModifierOption.name": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.Price(); ok {
+		if err := modifieroption.PriceValidator(v); err != nil {
+			return &ValidationError{Name: "price", err: fmt.Errorf(`ent: validator failed for field "ModifierOption.price": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.DisplayOrder(); ok {
+		if err := modifieroption.DisplayOrderValidator(v); err != nil {
+			return &ValidationError{Name: "display_order", err: fmt.Errorf(`ent: validator failed for field "ModifierOption.display_order": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.MaxQuantity(); ok {
+		if err := modifieroption.MaxQuantityValidator(v); err != nil {
+			return &ValidationError{Name: "max_quantity", err: fmt.Errorf(`ent: validator failed for field "ModifierOption.max_quantity": %w`, err)}
+		}
+	}
 	if _u.mutation.ModifierCleared() && len(_u.mutation.ModifierIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "ModifierOption.modifier"`)
 	}
@@ -618,10 +1134,16 @@ func (_u *ModifierOptionUpdateOne) sqlSave(ctx context.Context) (_node *Modifier
 		_spec.SetField(modifieroption.FieldName, field.TypeString, value)
 	}
 	if value, ok := _u.mutation.Price(); ok {
-		_spec.SetField(modifieroption.FieldPrice, field.TypeFloat64, value)
+		_spec.SetField(modifieroption.FieldPrice, field.TypeInt64, value)
 	}
 	if value, ok := _u.mutation.AddedPrice(); ok {
-		_spec.AddField(modifieroption.FieldPrice, field.TypeFloat64, value)
+		_spec.AddField(modifieroption.FieldPrice, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.VariantPrices(); ok {
+		_spec.SetField(modifieroption.FieldVariantPrices, field.TypeJSON, value)
+	}
+	if _u.mutation.VariantPricesCleared() {
+		_spec.ClearField(modifieroption.FieldVariantPrices, field.TypeJSON)
 	}
 	if value, ok := _u.mutation.ImageURL(); ok {
 		_spec.SetField(modifieroption.FieldImageURL, field.TypeString, value)
@@ -629,12 +1151,44 @@ func (_u *ModifierOptionUpdateOne) sqlSave(ctx context.Context) (_node *Modifier
 	if _u.mutation.ImageURLCleared() {
 		_spec.ClearField(modifieroption.FieldImageURL, field.TypeString)
 	}
+	if value, ok := _u.mutation.ThumbnailURL(); ok {
+		_spec.SetField(modifieroption.FieldThumbnailURL, field.TypeString, value)
+	}
+	if _u.mutation.ThumbnailURLCleared() {
+		_spec.ClearField(modifieroption.FieldThumbnailURL, field.TypeString)
+	}
+	if value, ok := _u.mutation.ImageKeys(); ok {
+		_spec.SetField(modifieroption.FieldImageKeys, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedImageKeys(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, modifieroption.FieldImageKeys, value)
+		})
+	}
+	if _u.mutation.ImageKeysCleared() {
+		_spec.ClearField(modifieroption.FieldImageKeys, field.TypeJSON)
+	}
 	if value, ok := _u.mutation.Available(); ok {
 		_spec.SetField(modifieroption.FieldAvailable, field.TypeBool, value)
 	}
+	if value, ok := _u.mutation.OutOfStock(); ok {
+		_spec.SetField(modifieroption.FieldOutOfStock, field.TypeBool, value)
+	}
 	if value, ok := _u.mutation.PreSelect(); ok {
 		_spec.SetField(modifieroption.FieldPreSelect, field.TypeBool, value)
 	}
+	if value, ok := _u.mutation.DisplayOrder(); ok {
+		_spec.SetField(modifieroption.FieldDisplayOrder, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedDisplayOrder(); ok {
+		_spec.AddField(modifieroption.FieldDisplayOrder, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.MaxQuantity(); ok {
+		_spec.SetField(modifieroption.FieldMaxQuantity, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedMaxQuantity(); ok {
+		_spec.AddField(modifieroption.FieldMaxQuantity, field.TypeInt, value)
+	}
 	if _u.mutation.ModifierCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -709,6 +1263,96 @@ func (_u *ModifierOptionUpdateOne) sqlSave(ctx context.Context) (_node *Modifier
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.ChildModifiersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   modifieroption.ChildModifiersTable,
+			Columns: modifieroption.ChildModifiersPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(modifier.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedChildModifiersIDs(); len(nodes) > 0 && !_u.mutation.ChildModifiersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   modifieroption.ChildModifiersTable,
+			Columns: modifieroption.ChildModifiersPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(modifier.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ChildModifiersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   modifieroption.ChildModifiersTable,
+			Columns: modifieroption.ChildModifiersPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(modifier.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.RecipeLinesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   modifieroption.RecipeLinesTable,
+			Columns: []string{modifieroption.RecipeLinesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(recipeingredient.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedRecipeLinesIDs(); len(nodes) > 0 && !_u.mutation.RecipeLinesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   modifieroption.RecipeLinesTable,
+			Columns: []string{modifieroption.RecipeLinesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(recipeingredient.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RecipeLinesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   modifieroption.RecipeLinesTable,
+			Columns: []string{modifieroption.RecipeLinesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(recipeingredient.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &ModifierOption{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
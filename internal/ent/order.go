@@ -9,8 +9,11 @@ import (
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
+	"github.com/Jiruu246/rms/internal/ent/deliveryzone"
 	"github.com/Jiruu246/rms/internal/ent/order"
 	"github.com/Jiruu246/rms/internal/ent/restaurant"
+	"github.com/Jiruu246/rms/internal/ent/table"
+	"github.com/Jiruu246/rms/internal/ent/tablesession"
 	"github.com/google/uuid"
 )
 
@@ -21,14 +24,64 @@ type Order struct {
 	ID uuid.UUID `json:"id,omitempty"`
 	// UpdateTime holds the value of the "update_time" field.
 	UpdateTime time.Time `json:"update_time,omitempty"`
+	// Human-readable number called out in the kitchen; allocated from the restaurant's sequence when the order is placed
+	OrderNumber *int `json:"order_number,omitempty"`
+	// Period of the sequence order_number was drawn from; see OrderNumberSequence
+	OrderNumberPeriod string `json:"order_number_period,omitempty"`
 	// OrderType holds the value of the "order_type" field.
 	OrderType order.OrderType `json:"order_type,omitempty"`
 	// OrderStatus holds the value of the "order_status" field.
 	OrderStatus order.OrderStatus `json:"order_status,omitempty"`
 	// PaymentStatus holds the value of the "payment_status" field.
 	PaymentStatus order.PaymentStatus `json:"payment_status,omitempty"`
+	// ISO 4217 code of the restaurant currency when the order was placed; all amounts on the order are in its minor units
+	Currency string `json:"currency,omitempty"`
+	// Sum of all line totals, before tax, in minor units of currency
+	Subtotal int64 `json:"subtotal,omitempty"`
+	// Portion of the subtotal contributed by modifier options
+	ModifiersTotal int64 `json:"modifiers_total,omitempty"`
+	// Tax charged on the subtotal
+	TaxTotal int64 `json:"tax_total,omitempty"`
+	// Restaurant tax rate, in basis points, the order was placed at; item changes are repriced at it. Nil on orders placed before it was recorded
+	TaxRateBps *int `json:"tax_rate_bps,omitempty"`
+	// Grand total: subtotal plus tax plus delivery fee
+	Total int64 `json:"total,omitempty"`
+	// Delivery fee of the order's delivery zone; not taxed
+	DeliveryFee int64 `json:"delivery_fee,omitempty"`
+	// Sum of PENDING and SUCCEEDED payments; guards against capturing more than total
+	AmountPaid int64 `json:"amount_paid,omitempty"`
+	// Sum of PENDING and SUCCEEDED refunds
+	AmountRefunded int64 `json:"amount_refunded,omitempty"`
 	// ID of the restaurant this order belongs to
 	RestaurantID uuid.UUID `json:"restaurant_id,omitempty"`
+	// Table a dine-in order was placed at
+	TableID *uuid.UUID `json:"table_id,omitempty"`
+	// Table session (bill) the order rolls up into; set together with table_id
+	TableSessionID *uuid.UUID `json:"table_session_id,omitempty"`
+	// Where a DELIVERY order goes, as entered by the customer
+	DeliveryAddress string `json:"delivery_address,omitempty"`
+	// DeliveryLatitude holds the value of the "delivery_latitude" field.
+	DeliveryLatitude *float64 `json:"delivery_latitude,omitempty"`
+	// DeliveryLongitude holds the value of the "delivery_longitude" field.
+	DeliveryLongitude *float64 `json:"delivery_longitude,omitempty"`
+	// DeliveryContactName holds the value of the "delivery_contact_name" field.
+	DeliveryContactName string `json:"delivery_contact_name,omitempty"`
+	// DeliveryContactPhone holds the value of the "delivery_contact_phone" field.
+	DeliveryContactPhone string `json:"delivery_contact_phone,omitempty"`
+	// DeliveryInstructions holds the value of the "delivery_instructions" field.
+	DeliveryInstructions string `json:"delivery_instructions,omitempty"`
+	// Zone whose fee and minimum the DELIVERY order was priced with
+	DeliveryZoneID *uuid.UUID `json:"delivery_zone_id,omitempty"`
+	// Minimum subtotal of the delivery zone when the order was placed; item changes may not take the subtotal below it
+	DeliveryMinOrderValue int64 `json:"delivery_min_order_value,omitempty"`
+	// Requested pickup or delivery time of a scheduled order; nil for ASAP orders
+	ScheduledFor *time.Time `json:"scheduled_for,omitempty"`
+	// When a scheduled order is due to be sent to the kitchen: scheduled_for minus the restaurant's kitchen lead time
+	ReleaseAt *time.Time `json:"release_at,omitempty"`
+	// When the order's station tickets were created; nil while a scheduled order is held
+	ReleasedAt *time.Time `json:"released_at,omitempty"`
+	// Bumped by every change to the order's items after it was placed; item edits are guarded on it so concurrent edits don't price off stale lines
+	ItemsVersion int `json:"items_version,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the OrderQuery when eager-loading is set.
 	Edges        OrderEdges `json:"edges"`
@@ -41,9 +94,25 @@ type OrderEdges struct {
 	Restaurant *Restaurant `json:"restaurant,omitempty"`
 	// OrderItems holds the value of the order_items edge.
 	OrderItems []*OrderItem `json:"order_items,omitempty"`
+	// StatusEvents holds the value of the status_events edge.
+	StatusEvents []*OrderStatusEvent `json:"status_events,omitempty"`
+	// ItemChanges holds the value of the item_changes edge.
+	ItemChanges []*OrderItemChange `json:"item_changes,omitempty"`
+	// Payments holds the value of the payments edge.
+	Payments []*Payment `json:"payments,omitempty"`
+	// Refunds holds the value of the refunds edge.
+	Refunds []*Refund `json:"refunds,omitempty"`
+	// StationTickets holds the value of the station_tickets edge.
+	StationTickets []*StationTicket `json:"station_tickets,omitempty"`
+	// Table holds the value of the table edge.
+	Table *Table `json:"table,omitempty"`
+	// TableSession holds the value of the table_session edge.
+	TableSession *TableSession `json:"table_session,omitempty"`
+	// DeliveryZone holds the value of the delivery_zone edge.
+	DeliveryZone *DeliveryZone `json:"delivery_zone,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [2]bool
+	loadedTypes [10]bool
 }
 
 // RestaurantOrErr returns the Restaurant value or an error if the edge
@@ -66,14 +135,98 @@ func (e OrderEdges) OrderItemsOrErr() ([]*OrderItem, error) {
 	return nil, &NotLoadedError{edge: "order_items"}
 }
 
+// StatusEventsOrErr returns the StatusEvents value or an error if the edge
+// was not loaded in eager-loading.
+func (e OrderEdges) StatusEventsOrErr() ([]*OrderStatusEvent, error) {
+	if e.loadedTypes[2] {
+		return e.StatusEvents, nil
+	}
+	return nil, &NotLoadedError{edge: "status_events"}
+}
+
+// ItemChangesOrErr returns the ItemChanges value or an error if the edge
+// was not loaded in eager-loading.
+func (e OrderEdges) ItemChangesOrErr() ([]*OrderItemChange, error) {
+	if e.loadedTypes[3] {
+		return e.ItemChanges, nil
+	}
+	return nil, &NotLoadedError{edge: "item_changes"}
+}
+
+// PaymentsOrErr returns the Payments value or an error if the edge
+// was not loaded in eager-loading.
+func (e OrderEdges) PaymentsOrErr() ([]*Payment, error) {
+	if e.loadedTypes[4] {
+		return e.Payments, nil
+	}
+	return nil, &NotLoadedError{edge: "payments"}
+}
+
+// RefundsOrErr returns the Refunds value or an error if the edge
+// was not loaded in eager-loading.
+func (e OrderEdges) RefundsOrErr() ([]*Refund, error) {
+	if e.loadedTypes[5] {
+		return e.Refunds, nil
+	}
+	return nil, &NotLoadedError{edge: "refunds"}
+}
+
+// StationTicketsOrErr returns the StationTickets value or an error if the edge
+// was not loaded in eager-loading.
+func (e OrderEdges) StationTicketsOrErr() ([]*StationTicket, error) {
+	if e.loadedTypes[6] {
+		return e.StationTickets, nil
+	}
+	return nil, &NotLoadedError{edge: "station_tickets"}
+}
+
+// TableOrErr returns the Table value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e OrderEdges) TableOrErr() (*Table, error) {
+	if e.Table != nil {
+		return e.Table, nil
+	} else if e.loadedTypes[7] {
+		return nil, &NotFoundError{label: table.Label}
+	}
+	return nil, &NotLoadedError{edge: "table"}
+}
+
+// TableSessionOrErr returns the TableSession value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e OrderEdges) TableSessionOrErr() (*TableSession, error) {
+	if e.TableSession != nil {
+		return e.TableSession, nil
+	} else if e.loadedTypes[8] {
+		return nil, &NotFoundError{label: tablesession.Label}
+	}
+	return nil, &NotLoadedError{edge: "table_session"}
+}
+
+// DeliveryZoneOrErr returns the DeliveryZone value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e OrderEdges) DeliveryZoneOrErr() (*DeliveryZone, error) {
+	if e.DeliveryZone != nil {
+		return e.DeliveryZone, nil
+	} else if e.loadedTypes[9] {
+		return nil, &NotFoundError{label: deliveryzone.Label}
+	}
+	return nil, &NotLoadedError{edge: "delivery_zone"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Order) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case order.FieldOrderType, order.FieldOrderStatus, order.FieldPaymentStatus:
+		case order.FieldTableID, order.FieldTableSessionID, order.FieldDeliveryZoneID:
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case order.FieldDeliveryLatitude, order.FieldDeliveryLongitude:
+			values[i] = new(sql.NullFloat64)
+		case order.FieldOrderNumber, order.FieldSubtotal, order.FieldModifiersTotal, order.FieldTaxTotal, order.FieldTaxRateBps, order.FieldTotal, order.FieldDeliveryFee, order.FieldAmountPaid, order.FieldAmountRefunded, order.FieldDeliveryMinOrderValue, order.FieldItemsVersion:
+			values[i] = new(sql.NullInt64)
+		case order.FieldOrderNumberPeriod, order.FieldOrderType, order.FieldOrderStatus, order.FieldPaymentStatus, order.FieldCurrency, order.FieldDeliveryAddress, order.FieldDeliveryContactName, order.FieldDeliveryContactPhone, order.FieldDeliveryInstructions:
 			values[i] = new(sql.NullString)
-		case order.FieldUpdateTime:
+		case order.FieldUpdateTime, order.FieldScheduledFor, order.FieldReleaseAt, order.FieldReleasedAt:
 			values[i] = new(sql.NullTime)
 		case order.FieldID, order.FieldRestaurantID:
 			values[i] = new(uuid.UUID)
@@ -104,6 +257,19 @@ func (_m *Order) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.UpdateTime = value.Time
 			}
+		case order.FieldOrderNumber:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field order_number", values[i])
+			} else if value.Valid {
+				_m.OrderNumber = new(int)
+				*_m.OrderNumber = int(value.Int64)
+			}
+		case order.FieldOrderNumberPeriod:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field order_number_period", values[i])
+			} else if value.Valid {
+				_m.OrderNumberPeriod = value.String
+			}
 		case order.FieldOrderType:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field order_type", values[i])
@@ -122,12 +288,159 @@ func (_m *Order) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.PaymentStatus = order.PaymentStatus(value.String)
 			}
+		case order.FieldCurrency:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field currency", values[i])
+			} else if value.Valid {
+				_m.Currency = value.String
+			}
+		case order.FieldSubtotal:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field subtotal", values[i])
+			} else if value.Valid {
+				_m.Subtotal = value.Int64
+			}
+		case order.FieldModifiersTotal:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field modifiers_total", values[i])
+			} else if value.Valid {
+				_m.ModifiersTotal = value.Int64
+			}
+		case order.FieldTaxTotal:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field tax_total", values[i])
+			} else if value.Valid {
+				_m.TaxTotal = value.Int64
+			}
+		case order.FieldTaxRateBps:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field tax_rate_bps", values[i])
+			} else if value.Valid {
+				_m.TaxRateBps = new(int)
+				*_m.TaxRateBps = int(value.Int64)
+			}
+		case order.FieldTotal:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field total", values[i])
+			} else if value.Valid {
+				_m.Total = value.Int64
+			}
+		case order.FieldDeliveryFee:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field delivery_fee", values[i])
+			} else if value.Valid {
+				_m.DeliveryFee = value.Int64
+			}
+		case order.FieldAmountPaid:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field amount_paid", values[i])
+			} else if value.Valid {
+				_m.AmountPaid = value.Int64
+			}
+		case order.FieldAmountRefunded:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field amount_refunded", values[i])
+			} else if value.Valid {
+				_m.AmountRefunded = value.Int64
+			}
 		case order.FieldRestaurantID:
 			if value, ok := values[i].(*uuid.UUID); !ok {
 				return fmt.Errorf("unexpected type %T for field restaurant_id", values[i])
 			} else if value != nil {
 				_m.RestaurantID = *value
 			}
+		case order.FieldTableID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field table_id", values[i])
+			} else if value.Valid {
+				_m.TableID = new(uuid.UUID)
+				*_m.TableID = *value.S.(*uuid.UUID)
+			}
+		case order.FieldTableSessionID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field table_session_id", values[i])
+			} else if value.Valid {
+				_m.TableSessionID = new(uuid.UUID)
+				*_m.TableSessionID = *value.S.(*uuid.UUID)
+			}
+		case order.FieldDeliveryAddress:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field delivery_address", values[i])
+			} else if value.Valid {
+				_m.DeliveryAddress = value.String
+			}
+		case order.FieldDeliveryLatitude:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field delivery_latitude", values[i])
+			} else if value.Valid {
+				_m.DeliveryLatitude = new(float64)
+				*_m.DeliveryLatitude = value.Float64
+			}
+		case order.FieldDeliveryLongitude:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field delivery_longitude", values[i])
+			} else if value.Valid {
+				_m.DeliveryLongitude = new(float64)
+				*_m.DeliveryLongitude = value.Float64
+			}
+		case order.FieldDeliveryContactName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field delivery_contact_name", values[i])
+			} else if value.Valid {
+				_m.DeliveryContactName = value.String
+			}
+		case order.FieldDeliveryContactPhone:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field delivery_contact_phone", values[i])
+			} else if value.Valid {
+				_m.DeliveryContactPhone = value.String
+			}
+		case order.FieldDeliveryInstructions:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field delivery_instructions", values[i])
+			} else if value.Valid {
+				_m.DeliveryInstructions = value.String
+			}
+		case order.FieldDeliveryZoneID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field delivery_zone_id", values[i])
+			} else if value.Valid {
+				_m.DeliveryZoneID = new(uuid.UUID)
+				*_m.DeliveryZoneID = *value.S.(*uuid.UUID)
+			}
+		case order.FieldDeliveryMinOrderValue:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field delivery_min_order_value", values[i])
+			} else if value.Valid {
+				_m.DeliveryMinOrderValue = value.Int64
+			}
+		case order.FieldScheduledFor:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field scheduled_for", values[i])
+			} else if value.Valid {
+				_m.ScheduledFor = new(time.Time)
+				*_m.ScheduledFor = value.Time
+			}
+		case order.FieldReleaseAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field release_at", values[i])
+			} else if value.Valid {
+				_m.ReleaseAt = new(time.Time)
+				*_m.ReleaseAt = value.Time
+			}
+		case order.FieldReleasedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field released_at", values[i])
+			} else if value.Valid {
+				_m.ReleasedAt = new(time.Time)
+				*_m.ReleasedAt = value.Time
+			}
+		case order.FieldItemsVersion:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field items_version", values[i])
+			} else if value.Valid {
+				_m.ItemsVersion = int(value.Int64)
+			}
 		default:
 			_m.selectValues.Set(columns[i], values[i])
 		}
@@ -151,6 +464,46 @@ func (_m *Order) QueryOrderItems() *OrderItemQuery {
 	return NewOrderClient(_m.config).QueryOrderItems(_m)
 }
 
+// QueryStatusEvents queries the "status_events" edge of the Order entity.
+func (_m *Order) QueryStatusEvents() *OrderStatusEventQuery {
+	return NewOrderClient(_m.config).QueryStatusEvents(_m)
+}
+
+// QueryItemChanges queries the "item_changes" edge of the Order entity.
+func (_m *Order) QueryItemChanges() *OrderItemChangeQuery {
+	return NewOrderClient(_m.config).QueryItemChanges(_m)
+}
+
+// QueryPayments queries the "payments" edge of the Order entity.
+func (_m *Order) QueryPayments() *PaymentQuery {
+	return NewOrderClient(_m.config).QueryPayments(_m)
+}
+
+// QueryRefunds queries the "refunds" edge of the Order entity.
+func (_m *Order) QueryRefunds() *RefundQuery {
+	return NewOrderClient(_m.config).QueryRefunds(_m)
+}
+
+// QueryStationTickets queries the "station_tickets" edge of the Order entity.
+func (_m *Order) QueryStationTickets() *StationTicketQuery {
+	return NewOrderClient(_m.config).QueryStationTickets(_m)
+}
+
+// QueryTable queries the "table" edge of the Order entity.
+func (_m *Order) QueryTable() *TableQuery {
+	return NewOrderClient(_m.config).QueryTable(_m)
+}
+
+// QueryTableSession queries the "table_session" edge of the Order entity.
+func (_m *Order) QueryTableSession() *TableSessionQuery {
+	return NewOrderClient(_m.config).QueryTableSession(_m)
+}
+
+// QueryDeliveryZone queries the "delivery_zone" edge of the Order entity.
+func (_m *Order) QueryDeliveryZone() *DeliveryZoneQuery {
+	return NewOrderClient(_m.config).QueryDeliveryZone(_m)
+}
+
 // Update returns a builder for updating this Order.
 // Note that you need to call Order.Unwrap() before calling this method if this Order
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -177,6 +530,14 @@ func (_m *Order) String() string {
 	builder.WriteString("update_time=")
 	builder.WriteString(_m.UpdateTime.Format(time.ANSIC))
 	builder.WriteString(", ")
+	if v := _m.OrderNumber; v != nil {
+		builder.WriteString("order_number=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("order_number_period=")
+	builder.WriteString(_m.OrderNumberPeriod)
+	builder.WriteString(", ")
 	builder.WriteString("order_type=")
 	builder.WriteString(fmt.Sprintf("%v", _m.OrderType))
 	builder.WriteString(", ")
@@ -186,8 +547,95 @@ func (_m *Order) String() string {
 	builder.WriteString("payment_status=")
 	builder.WriteString(fmt.Sprintf("%v", _m.PaymentStatus))
 	builder.WriteString(", ")
+	builder.WriteString("currency=")
+	builder.WriteString(_m.Currency)
+	builder.WriteString(", ")
+	builder.WriteString("subtotal=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Subtotal))
+	builder.WriteString(", ")
+	builder.WriteString("modifiers_total=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ModifiersTotal))
+	builder.WriteString(", ")
+	builder.WriteString("tax_total=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TaxTotal))
+	builder.WriteString(", ")
+	if v := _m.TaxRateBps; v != nil {
+		builder.WriteString("tax_rate_bps=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("total=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Total))
+	builder.WriteString(", ")
+	builder.WriteString("delivery_fee=")
+	builder.WriteString(fmt.Sprintf("%v", _m.DeliveryFee))
+	builder.WriteString(", ")
+	builder.WriteString("amount_paid=")
+	builder.WriteString(fmt.Sprintf("%v", _m.AmountPaid))
+	builder.WriteString(", ")
+	builder.WriteString("amount_refunded=")
+	builder.WriteString(fmt.Sprintf("%v", _m.AmountRefunded))
+	builder.WriteString(", ")
 	builder.WriteString("restaurant_id=")
 	builder.WriteString(fmt.Sprintf("%v", _m.RestaurantID))
+	builder.WriteString(", ")
+	if v := _m.TableID; v != nil {
+		builder.WriteString("table_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.TableSessionID; v != nil {
+		builder.WriteString("table_session_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("delivery_address=")
+	builder.WriteString(_m.DeliveryAddress)
+	builder.WriteString(", ")
+	if v := _m.DeliveryLatitude; v != nil {
+		builder.WriteString("delivery_latitude=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.DeliveryLongitude; v != nil {
+		builder.WriteString("delivery_longitude=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("delivery_contact_name=")
+	builder.WriteString(_m.DeliveryContactName)
+	builder.WriteString(", ")
+	builder.WriteString("delivery_contact_phone=")
+	builder.WriteString(_m.DeliveryContactPhone)
+	builder.WriteString(", ")
+	builder.WriteString("delivery_instructions=")
+	builder.WriteString(_m.DeliveryInstructions)
+	builder.WriteString(", ")
+	if v := _m.DeliveryZoneID; v != nil {
+		builder.WriteString("delivery_zone_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("delivery_min_order_value=")
+	builder.WriteString(fmt.Sprintf("%v", _m.DeliveryMinOrderValue))
+	builder.WriteString(", ")
+	if v := _m.ScheduledFor; v != nil {
+		builder.WriteString("scheduled_for=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.ReleaseAt; v != nil {
+		builder.WriteString("release_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.ReleasedAt; v != nil {
+		builder.WriteString("released_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("items_version=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ItemsVersion))
 	builder.WriteByte(')')
 	return builder.String()
 }
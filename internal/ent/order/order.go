@@ -18,18 +18,84 @@ const (
 	FieldID = "id"
 	// FieldUpdateTime holds the string denoting the update_time field in the database.
 	FieldUpdateTime = "update_time"
+	// FieldOrderNumber holds the string denoting the order_number field in the database.
+	FieldOrderNumber = "order_number"
+	// FieldOrderNumberPeriod holds the string denoting the order_number_period field in the database.
+	FieldOrderNumberPeriod = "order_number_period"
 	// FieldOrderType holds the string denoting the order_type field in the database.
 	FieldOrderType = "order_type"
 	// FieldOrderStatus holds the string denoting the order_status field in the database.
 	FieldOrderStatus = "order_status"
 	// FieldPaymentStatus holds the string denoting the payment_status field in the database.
 	FieldPaymentStatus = "payment_status"
+	// FieldCurrency holds the string denoting the currency field in the database.
+	FieldCurrency = "currency"
+	// FieldSubtotal holds the string denoting the subtotal field in the database.
+	FieldSubtotal = "subtotal"
+	// FieldModifiersTotal holds the string denoting the modifiers_total field in the database.
+	FieldModifiersTotal = "modifiers_total"
+	// FieldTaxTotal holds the string denoting the tax_total field in the database.
+	FieldTaxTotal = "tax_total"
+	// FieldTaxRateBps holds the string denoting the tax_rate_bps field in the database.
+	FieldTaxRateBps = "tax_rate_bps"
+	// FieldTotal holds the string denoting the total field in the database.
+	FieldTotal = "total"
+	// FieldDeliveryFee holds the string denoting the delivery_fee field in the database.
+	FieldDeliveryFee = "delivery_fee"
+	// FieldAmountPaid holds the string denoting the amount_paid field in the database.
+	FieldAmountPaid = "amount_paid"
+	// FieldAmountRefunded holds the string denoting the amount_refunded field in the database.
+	FieldAmountRefunded = "amount_refunded"
 	// FieldRestaurantID holds the string denoting the restaurant_id field in the database.
 	FieldRestaurantID = "restaurant_id"
+	// FieldTableID holds the string denoting the table_id field in the database.
+	FieldTableID = "table_id"
+	// FieldTableSessionID holds the string denoting the table_session_id field in the database.
+	FieldTableSessionID = "table_session_id"
+	// FieldDeliveryAddress holds the string denoting the delivery_address field in the database.
+	FieldDeliveryAddress = "delivery_address"
+	// FieldDeliveryLatitude holds the string denoting the delivery_latitude field in the database.
+	FieldDeliveryLatitude = "delivery_latitude"
+	// FieldDeliveryLongitude holds the string denoting the delivery_longitude field in the database.
+	FieldDeliveryLongitude = "delivery_longitude"
+	// FieldDeliveryContactName holds the string denoting the delivery_contact_name field in the database.
+	FieldDeliveryContactName = "delivery_contact_name"
+	// FieldDeliveryContactPhone holds the string denoting the delivery_contact_phone field in the database.
+	FieldDeliveryContactPhone = "delivery_contact_phone"
+	// FieldDeliveryInstructions holds the string denoting the delivery_instructions field in the database.
+	FieldDeliveryInstructions = "delivery_instructions"
+	// FieldDeliveryZoneID holds the string denoting the delivery_zone_id field in the database.
+	FieldDeliveryZoneID = "delivery_zone_id"
+	// FieldDeliveryMinOrderValue holds the string denoting the delivery_min_order_value field in the database.
+	FieldDeliveryMinOrderValue = "delivery_min_order_value"
+	// FieldScheduledFor holds the string denoting the scheduled_for field in the database.
+	FieldScheduledFor = "scheduled_for"
+	// FieldReleaseAt holds the string denoting the release_at field in the database.
+	FieldReleaseAt = "release_at"
+	// FieldReleasedAt holds the string denoting the released_at field in the database.
+	FieldReleasedAt = "released_at"
+	// FieldItemsVersion holds the string denoting the items_version field in the database.
+	FieldItemsVersion = "items_version"
 	// EdgeRestaurant holds the string denoting the restaurant edge name in mutations.
 	EdgeRestaurant = "restaurant"
 	// EdgeOrderItems holds the string denoting the order_items edge name in mutations.
 	EdgeOrderItems = "order_items"
+	// EdgeStatusEvents holds the string denoting the status_events edge name in mutations.
+	EdgeStatusEvents = "status_events"
+	// EdgeItemChanges holds the string denoting the item_changes edge name in mutations.
+	EdgeItemChanges = "item_changes"
+	// EdgePayments holds the string denoting the payments edge name in mutations.
+	EdgePayments = "payments"
+	// EdgeRefunds holds the string denoting the refunds edge name in mutations.
+	EdgeRefunds = "refunds"
+	// EdgeStationTickets holds the string denoting the station_tickets edge name in mutations.
+	EdgeStationTickets = "station_tickets"
+	// EdgeTable holds the string denoting the table edge name in mutations.
+	EdgeTable = "table"
+	// EdgeTableSession holds the string denoting the table_session edge name in mutations.
+	EdgeTableSession = "table_session"
+	// EdgeDeliveryZone holds the string denoting the delivery_zone edge name in mutations.
+	EdgeDeliveryZone = "delivery_zone"
 	// Table holds the table name of the order in the database.
 	Table = "orders"
 	// RestaurantTable is the table that holds the restaurant relation/edge.
@@ -46,16 +112,97 @@ const (
 	OrderItemsInverseTable = "order_items"
 	// OrderItemsColumn is the table column denoting the order_items relation/edge.
 	OrderItemsColumn = "order_id"
+	// StatusEventsTable is the table that holds the status_events relation/edge.
+	StatusEventsTable = "order_status_events"
+	// StatusEventsInverseTable is the table name for the OrderStatusEvent entity.
+	// It exists in this package in order to avoid circular dependency with the "orderstatusevent" package.
+	StatusEventsInverseTable = "order_status_events"
+	// StatusEventsColumn is the table column denoting the status_events relation/edge.
+	StatusEventsColumn = "order_id"
+	// ItemChangesTable is the table that holds the item_changes relation/edge.
+	ItemChangesTable = "order_item_changes"
+	// ItemChangesInverseTable is the table name for the OrderItemChange entity.
+	// It exists in this package in order to avoid circular dependency with the "orderitemchange" package.
+	ItemChangesInverseTable = "order_item_changes"
+	// ItemChangesColumn is the table column denoting the item_changes relation/edge.
+	ItemChangesColumn = "order_id"
+	// PaymentsTable is the table that holds the payments relation/edge.
+	PaymentsTable = "payments"
+	// PaymentsInverseTable is the table name for the Payment entity.
+	// It exists in this package in order to avoid circular dependency with the "payment" package.
+	PaymentsInverseTable = "payments"
+	// PaymentsColumn is the table column denoting the payments relation/edge.
+	PaymentsColumn = "order_id"
+	// RefundsTable is the table that holds the refunds relation/edge.
+	RefundsTable = "refunds"
+	// RefundsInverseTable is the table name for the Refund entity.
+	// It exists in this package in order to avoid circular dependency with the "refund" package.
+	RefundsInverseTable = "refunds"
+	// RefundsColumn is the table column denoting the refunds relation/edge.
+	RefundsColumn = "order_id"
+	// StationTicketsTable is the table that holds the station_tickets relation/edge.
+	StationTicketsTable = "station_tickets"
+	// StationTicketsInverseTable is the table name for the StationTicket entity.
+	// It exists in this package in order to avoid circular dependency with the "stationticket" package.
+	StationTicketsInverseTable = "station_tickets"
+	// StationTicketsColumn is the table column denoting the station_tickets relation/edge.
+	StationTicketsColumn = "order_id"
+	// TableTable is the table that holds the table relation/edge.
+	TableTable = "orders"
+	// TableInverseTable is the table name for the Table entity.
+	// It exists in this package in order to avoid circular dependency with the "table" package.
+	TableInverseTable = "tables"
+	// TableColumn is the table column denoting the table relation/edge.
+	TableColumn = "table_id"
+	// TableSessionTable is the table that holds the table_session relation/edge.
+	TableSessionTable = "orders"
+	// TableSessionInverseTable is the table name for the TableSession entity.
+	// It exists in this package in order to avoid circular dependency with the "tablesession" package.
+	TableSessionInverseTable = "table_sessions"
+	// TableSessionColumn is the table column denoting the table_session relation/edge.
+	TableSessionColumn = "table_session_id"
+	// DeliveryZoneTable is the table that holds the delivery_zone relation/edge.
+	DeliveryZoneTable = "orders"
+	// DeliveryZoneInverseTable is the table name for the DeliveryZone entity.
+	// It exists in this package in order to avoid circular dependency with the "deliveryzone" package.
+	DeliveryZoneInverseTable = "delivery_zones"
+	// DeliveryZoneColumn is the table column denoting the delivery_zone relation/edge.
+	DeliveryZoneColumn = "delivery_zone_id"
 )
 
 // Columns holds all SQL columns for order fields.
 var Columns = []string{
 	FieldID,
 	FieldUpdateTime,
+	FieldOrderNumber,
+	FieldOrderNumberPeriod,
 	FieldOrderType,
 	FieldOrderStatus,
 	FieldPaymentStatus,
+	FieldCurrency,
+	FieldSubtotal,
+	FieldModifiersTotal,
+	FieldTaxTotal,
+	FieldTaxRateBps,
+	FieldTotal,
+	FieldDeliveryFee,
+	FieldAmountPaid,
+	FieldAmountRefunded,
 	FieldRestaurantID,
+	FieldTableID,
+	FieldTableSessionID,
+	FieldDeliveryAddress,
+	FieldDeliveryLatitude,
+	FieldDeliveryLongitude,
+	FieldDeliveryContactName,
+	FieldDeliveryContactPhone,
+	FieldDeliveryInstructions,
+	FieldDeliveryZoneID,
+	FieldDeliveryMinOrderValue,
+	FieldScheduledFor,
+	FieldReleaseAt,
+	FieldReleasedAt,
+	FieldItemsVersion,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -73,6 +220,50 @@ var (
 	DefaultUpdateTime func() time.Time
 	// UpdateDefaultUpdateTime holds the default value on update for the "update_time" field.
 	UpdateDefaultUpdateTime func() time.Time
+	// DefaultOrderNumberPeriod holds the default value on creation for the "order_number_period" field.
+	DefaultOrderNumberPeriod string
+	// DefaultSubtotal holds the default value on creation for the "subtotal" field.
+	DefaultSubtotal int64
+	// SubtotalValidator is a validator for the "subtotal" field. It is called by the builders before save.
+	SubtotalValidator func(int64) error
+	// DefaultModifiersTotal holds the default value on creation for the "modifiers_total" field.
+	DefaultModifiersTotal int64
+	// ModifiersTotalValidator is a validator for the "modifiers_total" field. It is called by the builders before save.
+	ModifiersTotalValidator func(int64) error
+	// DefaultTaxTotal holds the default value on creation for the "tax_total" field.
+	DefaultTaxTotal int64
+	// TaxTotalValidator is a validator for the "tax_total" field. It is called by the builders before save.
+	TaxTotalValidator func(int64) error
+	// DefaultTotal holds the default value on creation for the "total" field.
+	DefaultTotal int64
+	// TotalValidator is a validator for the "total" field. It is called by the builders before save.
+	TotalValidator func(int64) error
+	// DefaultDeliveryFee holds the default value on creation for the "delivery_fee" field.
+	DefaultDeliveryFee int64
+	// DeliveryFeeValidator is a validator for the "delivery_fee" field. It is called by the builders before save.
+	DeliveryFeeValidator func(int64) error
+	// DefaultAmountPaid holds the default value on creation for the "amount_paid" field.
+	DefaultAmountPaid int64
+	// AmountPaidValidator is a validator for the "amount_paid" field. It is called by the builders before save.
+	AmountPaidValidator func(int64) error
+	// DefaultAmountRefunded holds the default value on creation for the "amount_refunded" field.
+	DefaultAmountRefunded int64
+	// AmountRefundedValidator is a validator for the "amount_refunded" field. It is called by the builders before save.
+	AmountRefundedValidator func(int64) error
+	// DefaultDeliveryAddress holds the default value on creation for the "delivery_address" field.
+	DefaultDeliveryAddress string
+	// DefaultDeliveryContactName holds the default value on creation for the "delivery_contact_name" field.
+	DefaultDeliveryContactName string
+	// DefaultDeliveryContactPhone holds the default value on creation for the "delivery_contact_phone" field.
+	DefaultDeliveryContactPhone string
+	// DefaultDeliveryInstructions holds the default value on creation for the "delivery_instructions" field.
+	DefaultDeliveryInstructions string
+	// DefaultDeliveryMinOrderValue holds the default value on creation for the "delivery_min_order_value" field.
+	DefaultDeliveryMinOrderValue int64
+	// DeliveryMinOrderValueValidator is a validator for the "delivery_min_order_value" field. It is called by the builders before save.
+	DeliveryMinOrderValueValidator func(int64) error
+	// DefaultItemsVersion holds the default value on creation for the "items_version" field.
+	DefaultItemsVersion int
 	// DefaultID holds the default value on creation for the "id" field.
 	DefaultID func() uuid.UUID
 )
@@ -111,6 +302,7 @@ const DefaultOrderStatus = OrderStatusOPEN
 const (
 	OrderStatusOPEN      OrderStatus = "OPEN"
 	OrderStatusCONFIRMED OrderStatus = "CONFIRMED"
+	OrderStatusREADY     OrderStatus = "READY"
 	OrderStatusCOMPLETED OrderStatus = "COMPLETED"
 	OrderStatusCANCELLED OrderStatus = "CANCELLED"
 )
@@ -122,7 +314,7 @@ func (os OrderStatus) String() string {
 // OrderStatusValidator is a validator for the "order_status" field enum values. It is called by the builders before save.
 func OrderStatusValidator(os OrderStatus) error {
 	switch os {
-	case OrderStatusOPEN, OrderStatusCONFIRMED, OrderStatusCOMPLETED, OrderStatusCANCELLED:
+	case OrderStatusOPEN, OrderStatusCONFIRMED, OrderStatusREADY, OrderStatusCOMPLETED, OrderStatusCANCELLED:
 		return nil
 	default:
 		return fmt.Errorf("order: invalid enum value for order_status field: %q", os)
@@ -170,6 +362,16 @@ func ByUpdateTime(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldUpdateTime, opts...).ToFunc()
 }
 
+// ByOrderNumber orders the results by the order_number field.
+func ByOrderNumber(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOrderNumber, opts...).ToFunc()
+}
+
+// ByOrderNumberPeriod orders the results by the order_number_period field.
+func ByOrderNumberPeriod(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOrderNumberPeriod, opts...).ToFunc()
+}
+
 // ByOrderType orders the results by the order_type field.
 func ByOrderType(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldOrderType, opts...).ToFunc()
@@ -185,11 +387,126 @@ func ByPaymentStatus(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldPaymentStatus, opts...).ToFunc()
 }
 
+// ByCurrency orders the results by the currency field.
+func ByCurrency(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCurrency, opts...).ToFunc()
+}
+
+// BySubtotal orders the results by the subtotal field.
+func BySubtotal(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSubtotal, opts...).ToFunc()
+}
+
+// ByModifiersTotal orders the results by the modifiers_total field.
+func ByModifiersTotal(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldModifiersTotal, opts...).ToFunc()
+}
+
+// ByTaxTotal orders the results by the tax_total field.
+func ByTaxTotal(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTaxTotal, opts...).ToFunc()
+}
+
+// ByTaxRateBps orders the results by the tax_rate_bps field.
+func ByTaxRateBps(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTaxRateBps, opts...).ToFunc()
+}
+
+// ByTotal orders the results by the total field.
+func ByTotal(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTotal, opts...).ToFunc()
+}
+
+// ByDeliveryFee orders the results by the delivery_fee field.
+func ByDeliveryFee(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeliveryFee, opts...).ToFunc()
+}
+
+// ByAmountPaid orders the results by the amount_paid field.
+func ByAmountPaid(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAmountPaid, opts...).ToFunc()
+}
+
+// ByAmountRefunded orders the results by the amount_refunded field.
+func ByAmountRefunded(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAmountRefunded, opts...).ToFunc()
+}
+
 // ByRestaurantID orders the results by the restaurant_id field.
 func ByRestaurantID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldRestaurantID, opts...).ToFunc()
 }
 
+// ByTableID orders the results by the table_id field.
+func ByTableID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTableID, opts...).ToFunc()
+}
+
+// ByTableSessionID orders the results by the table_session_id field.
+func ByTableSessionID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTableSessionID, opts...).ToFunc()
+}
+
+// ByDeliveryAddress orders the results by the delivery_address field.
+func ByDeliveryAddress(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeliveryAddress, opts...).ToFunc()
+}
+
+// ByDeliveryLatitude orders the results by the delivery_latitude field.
+func ByDeliveryLatitude(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeliveryLatitude, opts...).ToFunc()
+}
+
+// ByDeliveryLongitude orders the results by the delivery_longitude field.
+func ByDeliveryLongitude(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeliveryLongitude, opts...).ToFunc()
+}
+
+// ByDeliveryContactName orders the results by the delivery_contact_name field.
+func ByDeliveryContactName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeliveryContactName, opts...).ToFunc()
+}
+
+// ByDeliveryContactPhone orders the results by the delivery_contact_phone field.
+func ByDeliveryContactPhone(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeliveryContactPhone, opts...).ToFunc()
+}
+
+// ByDeliveryInstructions orders the results by the delivery_instructions field.
+func ByDeliveryInstructions(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeliveryInstructions, opts...).ToFunc()
+}
+
+// ByDeliveryZoneID orders the results by the delivery_zone_id field.
+func ByDeliveryZoneID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeliveryZoneID, opts...).ToFunc()
+}
+
+// ByDeliveryMinOrderValue orders the results by the delivery_min_order_value field.
+func ByDeliveryMinOrderValue(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeliveryMinOrderValue, opts...).ToFunc()
+}
+
+// ByScheduledFor orders the results by the scheduled_for field.
+func ByScheduledFor(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldScheduledFor, opts...).ToFunc()
+}
+
+// ByReleaseAt orders the results by the release_at field.
+func ByReleaseAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReleaseAt, opts...).ToFunc()
+}
+
+// ByReleasedAt orders the results by the released_at field.
+func ByReleasedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReleasedAt, opts...).ToFunc()
+}
+
+// ByItemsVersion orders the results by the items_version field.
+func ByItemsVersion(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldItemsVersion, opts...).ToFunc()
+}
+
 // ByRestaurantField orders the results by restaurant field.
 func ByRestaurantField(field string, opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -210,6 +527,97 @@ func ByOrderItems(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newOrderItemsStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
+
+// ByStatusEventsCount orders the results by status_events count.
+func ByStatusEventsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newStatusEventsStep(), opts...)
+	}
+}
+
+// ByStatusEvents orders the results by status_events terms.
+func ByStatusEvents(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newStatusEventsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByItemChangesCount orders the results by item_changes count.
+func ByItemChangesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newItemChangesStep(), opts...)
+	}
+}
+
+// ByItemChanges orders the results by item_changes terms.
+func ByItemChanges(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newItemChangesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByPaymentsCount orders the results by payments count.
+func ByPaymentsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newPaymentsStep(), opts...)
+	}
+}
+
+// ByPayments orders the results by payments terms.
+func ByPayments(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newPaymentsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByRefundsCount orders the results by refunds count.
+func ByRefundsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newRefundsStep(), opts...)
+	}
+}
+
+// ByRefunds orders the results by refunds terms.
+func ByRefunds(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newRefundsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByStationTicketsCount orders the results by station_tickets count.
+func ByStationTicketsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newStationTicketsStep(), opts...)
+	}
+}
+
+// ByStationTickets orders the results by station_tickets terms.
+func ByStationTickets(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newStationTicketsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByTableField orders the results by table field.
+func ByTableField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newTableStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByTableSessionField orders the results by table_session field.
+func ByTableSessionField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newTableSessionStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByDeliveryZoneField orders the results by delivery_zone field.
+func ByDeliveryZoneField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newDeliveryZoneStep(), sql.OrderByField(field, opts...))
+	}
+}
 func newRestaurantStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -224,3 +632,59 @@ func newOrderItemsStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, false, OrderItemsTable, OrderItemsColumn),
 	)
 }
+func newStatusEventsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(StatusEventsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, StatusEventsTable, StatusEventsColumn),
+	)
+}
+func newItemChangesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ItemChangesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, ItemChangesTable, ItemChangesColumn),
+	)
+}
+func newPaymentsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(PaymentsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, PaymentsTable, PaymentsColumn),
+	)
+}
+func newRefundsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(RefundsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, RefundsTable, RefundsColumn),
+	)
+}
+func newStationTicketsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(StationTicketsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, StationTicketsTable, StationTicketsColumn),
+	)
+}
+func newTableStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(TableInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, TableTable, TableColumn),
+	)
+}
+func newTableSessionStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(TableSessionInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, TableSessionTable, TableSessionColumn),
+	)
+}
+func newDeliveryZoneStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(DeliveryZoneInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, DeliveryZoneTable, DeliveryZoneColumn),
+	)
+}
@@ -61,11 +61,136 @@ func UpdateTime(v time.Time) predicate.Order {
 	return predicate.Order(sql.FieldEQ(FieldUpdateTime, v))
 }
 
+// OrderNumber applies equality check predicate on the "order_number" field. It's identical to OrderNumberEQ.
+func OrderNumber(v int) predicate.Order {
+	return predicate.Order(sql.FieldEQ(FieldOrderNumber, v))
+}
+
+// OrderNumberPeriod applies equality check predicate on the "order_number_period" field. It's identical to OrderNumberPeriodEQ.
+func OrderNumberPeriod(v string) predicate.Order {
+	return predicate.Order(sql.FieldEQ(FieldOrderNumberPeriod, v))
+}
+
+// Currency applies equality check predicate on the "currency" field. It's identical to CurrencyEQ.
+func Currency(v string) predicate.Order {
+	return predicate.Order(sql.FieldEQ(FieldCurrency, v))
+}
+
+// Subtotal applies equality check predicate on the "subtotal" field. It's identical to SubtotalEQ.
+func Subtotal(v int64) predicate.Order {
+	return predicate.Order(sql.FieldEQ(FieldSubtotal, v))
+}
+
+// ModifiersTotal applies equality check predicate on the "modifiers_total" field. It's identical to ModifiersTotalEQ.
+func ModifiersTotal(v int64) predicate.Order {
+	return predicate.Order(sql.FieldEQ(FieldModifiersTotal, v))
+}
+
+// TaxTotal applies equality check predicate on the "tax_total" field. It's identical to TaxTotalEQ.
+func TaxTotal(v int64) predicate.Order {
+	return predicate.Order(sql.FieldEQ(FieldTaxTotal, v))
+}
+
+// TaxRateBps applies equality check predicate on the "tax_rate_bps" field. It's identical to TaxRateBpsEQ.
+func TaxRateBps(v int) predicate.Order {
+	return predicate.Order(sql.FieldEQ(FieldTaxRateBps, v))
+}
+
+// Total applies equality check predicate on the "total" field. It's identical to TotalEQ.
+func Total(v int64) predicate.Order {
+	return predicate.Order(sql.FieldEQ(FieldTotal, v))
+}
+
+// DeliveryFee applies equality check predicate on the "delivery_fee" field. It's identical to DeliveryFeeEQ.
+func DeliveryFee(v int64) predicate.Order {
+	return predicate.Order(sql.FieldEQ(FieldDeliveryFee, v))
+}
+
+// AmountPaid applies equality check predicate on the "amount_paid" field. It's identical to AmountPaidEQ.
+func AmountPaid(v int64) predicate.Order {
+	return predicate.Order(sql.FieldEQ(FieldAmountPaid, v))
+}
+
+// AmountRefunded applies equality check predicate on the "amount_refunded" field. It's identical to AmountRefundedEQ.
+func AmountRefunded(v int64) predicate.Order {
+	return predicate.Order(sql.FieldEQ(FieldAmountRefunded, v))
+}
+
 // RestaurantID applies equality check predicate on the "restaurant_id" field. It's identical to RestaurantIDEQ.
 func RestaurantID(v uuid.UUID) predicate.Order {
 	return predicate.Order(sql.FieldEQ(FieldRestaurantID, v))
 }
 
+// TableID applies equality check predicate on the "table_id" field. It's identical to TableIDEQ.
+func TableID(v uuid.UUID) predicate.Order {
+	return predicate.Order(sql.FieldEQ(FieldTableID, v))
+}
+
+// TableSessionID applies equality check predicate on the "table_session_id" field. It's identical to TableSessionIDEQ.
+func TableSessionID(v uuid.UUID) predicate.Order {
+	return predicate.Order(sql.FieldEQ(FieldTableSessionID, v))
+}
+
+// DeliveryAddress applies equality check predicate on the "delivery_address" field. It's identical to DeliveryAddressEQ.
+func DeliveryAddress(v string) predicate.Order {
+	return predicate.Order(sql.FieldEQ(FieldDeliveryAddress, v))
+}
+
+// DeliveryLatitude applies equality check predicate on the "delivery_latitude" field. It's identical to DeliveryLatitudeEQ.
+func DeliveryLatitude(v float64) predicate.Order {
+	return predicate.Order(sql.FieldEQ(FieldDeliveryLatitude, v))
+}
+
+// DeliveryLongitude applies equality check predicate on the "delivery_longitude" field. It's identical to DeliveryLongitudeEQ.
+func DeliveryLongitude(v float64) predicate.Order {
+	return predicate.Order(sql.FieldEQ(FieldDeliveryLongitude, v))
+}
+
+// DeliveryContactName applies equality check predicate on the "delivery_contact_name" field. It's identical to DeliveryContactNameEQ.
+func DeliveryContactName(v string) predicate.Order {
+	return predicate.Order(sql.FieldEQ(FieldDeliveryContactName, v))
+}
+
+// DeliveryContactPhone applies equality check predicate on the "delivery_contact_phone" field. It's identical to DeliveryContactPhoneEQ.
+func DeliveryContactPhone(v string) predicate.Order {
+	return predicate.Order(sql.FieldEQ(FieldDeliveryContactPhone, v))
+}
+
+// DeliveryInstructions applies equality check predicate on the "delivery_instructions" field. It's identical to DeliveryInstructionsEQ.
+func DeliveryInstructions(v string) predicate.Order {
+	return predicate.Order(sql.FieldEQ(FieldDeliveryInstructions, v))
+}
+
+// DeliveryZoneID applies equality check predicate on the "delivery_zone_id" field. It's identical to DeliveryZoneIDEQ.
+func DeliveryZoneID(v uuid.UUID) predicate.Order {
+	return predicate.Order(sql.FieldEQ(FieldDeliveryZoneID, v))
+}
+
+// DeliveryMinOrderValue applies equality check predicate on the "delivery_min_order_value" field. It's identical to DeliveryMinOrderValueEQ.
+func DeliveryMinOrderValue(v int64) predicate.Order {
+	return predicate.Order(sql.FieldEQ(FieldDeliveryMinOrderValue, v))
+}
+
+// ScheduledFor applies equality check predicate on the "scheduled_for" field. It's identical to ScheduledForEQ.
+func ScheduledFor(v time.Time) predicate.Order {
+	return predicate.Order(sql.FieldEQ(FieldScheduledFor, v))
+}
+
+// ReleaseAt applies equality check predicate on the "release_at" field. It's identical to ReleaseAtEQ.
+func ReleaseAt(v time.Time) predicate.Order {
+	return predicate.Order(sql.FieldEQ(FieldReleaseAt, v))
+}
+
+// ReleasedAt applies equality check predicate on the "released_at" field. It's identical to ReleasedAtEQ.
+func ReleasedAt(v time.Time) predicate.Order {
+	return predicate.Order(sql.FieldEQ(FieldReleasedAt, v))
+}
+
+// ItemsVersion applies equality check predicate on the "items_version" field. It's identical to ItemsVersionEQ.
+func ItemsVersion(v int) predicate.Order {
+	return predicate.Order(sql.FieldEQ(FieldItemsVersion, v))
+}
+
 // UpdateTimeEQ applies the EQ predicate on the "update_time" field.
 func UpdateTimeEQ(v time.Time) predicate.Order {
 	return predicate.Order(sql.FieldEQ(FieldUpdateTime, v))
@@ -106,6 +231,121 @@ func UpdateTimeLTE(v time.Time) predicate.Order {
 	return predicate.Order(sql.FieldLTE(FieldUpdateTime, v))
 }
 
+// OrderNumberEQ applies the EQ predicate on the "order_number" field.
+func OrderNumberEQ(v int) predicate.Order {
+	return predicate.Order(sql.FieldEQ(FieldOrderNumber, v))
+}
+
+// OrderNumberNEQ applies the NEQ predicate on the "order_number" field.
+func OrderNumberNEQ(v int) predicate.Order {
+	return predicate.Order(sql.FieldNEQ(FieldOrderNumber, v))
+}
+
+// OrderNumberIn applies the In predicate on the "order_number" field.
+func OrderNumberIn(vs ...int) predicate.Order {
+	return predicate.Order(sql.FieldIn(FieldOrderNumber, vs...))
+}
+
+// OrderNumberNotIn applies the NotIn predicate on the "order_number" field.
+func OrderNumberNotIn(vs ...int) predicate.Order {
+	return predicate.Order(sql.FieldNotIn(FieldOrderNumber, vs...))
+}
+
+// OrderNumberGT applies the GT predicate on the "order_number" field.
+func OrderNumberGT(v int) predicate.Order {
+	return predicate.Order(sql.FieldGT(FieldOrderNumber, v))
+}
+
+// OrderNumberGTE applies the GTE predicate on the "order_number" field.
+func OrderNumberGTE(v int) predicate.Order {
+	return predicate.Order(sql.FieldGTE(FieldOrderNumber, v))
+}
+
+// OrderNumberLT applies the LT predicate on the "order_number" field.
+func OrderNumberLT(v int) predicate.Order {
+	return predicate.Order(sql.FieldLT(FieldOrderNumber, v))
+}
+
+// OrderNumberLTE applies the LTE predicate on the "order_number" field.
+func OrderNumberLTE(v int) predicate.Order {
+	return predicate.Order(sql.FieldLTE(FieldOrderNumber, v))
+}
+
+// OrderNumberIsNil applies the IsNil predicate on the "order_number" field.
+func OrderNumberIsNil() predicate.Order {
+	return predicate.Order(sql.FieldIsNull(FieldOrderNumber))
+}
+
+// OrderNumberNotNil applies the NotNil predicate on the "order_number" field.
+func OrderNumberNotNil() predicate.Order {
+	return predicate.Order(sql.FieldNotNull(FieldOrderNumber))
+}
+
+// OrderNumberPeriodEQ applies the EQ predicate on the "order_number_period" field.
+func OrderNumberPeriodEQ(v string) predicate.Order {
+	return predicate.Order(sql.FieldEQ(FieldOrderNumberPeriod, v))
+}
+
+// OrderNumberPeriodNEQ applies the NEQ predicate on the "order_number_period" field.
+func OrderNumberPeriodNEQ(v string) predicate.Order {
+	return predicate.Order(sql.FieldNEQ(FieldOrderNumberPeriod, v))
+}
+
+// OrderNumberPeriodIn applies the In predicate on the "order_number_period" field.
+func OrderNumberPeriodIn(vs ...string) predicate.Order {
+	return predicate.Order(sql.FieldIn(FieldOrderNumberPeriod, vs...))
+}
+
+// OrderNumberPeriodNotIn applies the NotIn predicate on the "order_number_period" field.
+func OrderNumberPeriodNotIn(vs ...string) predicate.Order {
+	return predicate.Order(sql.FieldNotIn(FieldOrderNumberPeriod, vs...))
+}
+
+// OrderNumberPeriodGT applies the GT predicate on the "order_number_period" field.
+func OrderNumberPeriodGT(v string) predicate.Order {
+	return predicate.Order(sql.FieldGT(FieldOrderNumberPeriod, v))
+}
+
+// OrderNumberPeriodGTE applies the GTE predicate on the "order_number_period" field.
+func OrderNumberPeriodGTE(v string) predicate.Order {
+	return predicate.Order(sql.FieldGTE(FieldOrderNumberPeriod, v))
+}
+
+// OrderNumberPeriodLT applies the LT predicate on the "order_number_period" field.
+func OrderNumberPeriodLT(v string) predicate.Order {
+	return predicate.Order(sql.FieldLT(FieldOrderNumberPeriod, v))
+}
+
+// OrderNumberPeriodLTE applies the LTE predicate on the "order_number_period" field.
+func OrderNumberPeriodLTE(v string) predicate.Order {
+	return predicate.Order(sql.FieldLTE(FieldOrderNumberPeriod, v))
+}
+
+// OrderNumberPeriodContains applies the Contains predicate on the "order_number_period" field.
+func OrderNumberPeriodContains(v string) predicate.Order {
+	return predicate.Order(sql.FieldContains(FieldOrderNumberPeriod, v))
+}
+
+// OrderNumberPeriodHasPrefix applies the HasPrefix predicate on the "order_number_period" field.
+func OrderNumberPeriodHasPrefix(v string) predicate.Order {
+	return predicate.Order(sql.FieldHasPrefix(FieldOrderNumberPeriod, v))
+}
+
+// OrderNumberPeriodHasSuffix applies the HasSuffix predicate on the "order_number_period" field.
+func OrderNumberPeriodHasSuffix(v string) predicate.Order {
+	return predicate.Order(sql.FieldHasSuffix(FieldOrderNumberPeriod, v))
+}
+
+// OrderNumberPeriodEqualFold applies the EqualFold predicate on the "order_number_period" field.
+func OrderNumberPeriodEqualFold(v string) predicate.Order {
+	return predicate.Order(sql.FieldEqualFold(FieldOrderNumberPeriod, v))
+}
+
+// OrderNumberPeriodContainsFold applies the ContainsFold predicate on the "order_number_period" field.
+func OrderNumberPeriodContainsFold(v string) predicate.Order {
+	return predicate.Order(sql.FieldContainsFold(FieldOrderNumberPeriod, v))
+}
+
 // OrderTypeEQ applies the EQ predicate on the "order_type" field.
 func OrderTypeEQ(v OrderType) predicate.Order {
 	return predicate.Order(sql.FieldEQ(FieldOrderType, v))
@@ -166,6 +406,401 @@ func PaymentStatusNotIn(vs ...PaymentStatus) predicate.Order {
 	return predicate.Order(sql.FieldNotIn(FieldPaymentStatus, vs...))
 }
 
+// CurrencyEQ applies the EQ predicate on the "currency" field.
+func CurrencyEQ(v string) predicate.Order {
+	return predicate.Order(sql.FieldEQ(FieldCurrency, v))
+}
+
+// CurrencyNEQ applies the NEQ predicate on the "currency" field.
+func CurrencyNEQ(v string) predicate.Order {
+	return predicate.Order(sql.FieldNEQ(FieldCurrency, v))
+}
+
+// CurrencyIn applies the In predicate on the "currency" field.
+func CurrencyIn(vs ...string) predicate.Order {
+	return predicate.Order(sql.FieldIn(FieldCurrency, vs...))
+}
+
+// CurrencyNotIn applies the NotIn predicate on the "currency" field.
+func CurrencyNotIn(vs ...string) predicate.Order {
+	return predicate.Order(sql.FieldNotIn(FieldCurrency, vs...))
+}
+
+// CurrencyGT applies the GT predicate on the "currency" field.
+func CurrencyGT(v string) predicate.Order {
+	return predicate.Order(sql.FieldGT(FieldCurrency, v))
+}
+
+// CurrencyGTE applies the GTE predicate on the "currency" field.
+func CurrencyGTE(v string) predicate.Order {
+	return predicate.Order(sql.FieldGTE(FieldCurrency, v))
+}
+
+// CurrencyLT applies the LT predicate on the "currency" field.
+func CurrencyLT(v string) predicate.Order {
+	return predicate.Order(sql.FieldLT(FieldCurrency, v))
+}
+
+// CurrencyLTE applies the LTE predicate on the "currency" field.
+func CurrencyLTE(v string) predicate.Order {
+	return predicate.Order(sql.FieldLTE(FieldCurrency, v))
+}
+
+// CurrencyContains applies the Contains predicate on the "currency" field.
+func CurrencyContains(v string) predicate.Order {
+	return predicate.Order(sql.FieldContains(FieldCurrency, v))
+}
+
+// CurrencyHasPrefix applies the HasPrefix predicate on the "currency" field.
+func CurrencyHasPrefix(v string) predicate.Order {
+	return predicate.Order(sql.FieldHasPrefix(FieldCurrency, v))
+}
+
+// CurrencyHasSuffix applies the HasSuffix predicate on the "currency" field.
+func CurrencyHasSuffix(v string) predicate.Order {
+	return predicate.Order(sql.FieldHasSuffix(FieldCurrency, v))
+}
+
+// CurrencyEqualFold applies the EqualFold predicate on the "currency" field.
+func CurrencyEqualFold(v string) predicate.Order {
+	return predicate.Order(sql.FieldEqualFold(FieldCurrency, v))
+}
+
+// CurrencyContainsFold applies the ContainsFold predicate on the "currency" field.
+func CurrencyContainsFold(v string) predicate.Order {
+	return predicate.Order(sql.FieldContainsFold(FieldCurrency, v))
+}
+
+// SubtotalEQ applies the EQ predicate on the "subtotal" field.
+func SubtotalEQ(v int64) predicate.Order {
+	return predicate.Order(sql.FieldEQ(FieldSubtotal, v))
+}
+
+// SubtotalNEQ applies the NEQ predicate on the "subtotal" field.
+func SubtotalNEQ(v int64) predicate.Order {
+	return predicate.Order(sql.FieldNEQ(FieldSubtotal, v))
+}
+
+// SubtotalIn applies the In predicate on the "subtotal" field.
+func SubtotalIn(vs ...int64) predicate.Order {
+	return predicate.Order(sql.FieldIn(FieldSubtotal, vs...))
+}
+
+// SubtotalNotIn applies the NotIn predicate on the "subtotal" field.
+func SubtotalNotIn(vs ...int64) predicate.Order {
+	return predicate.Order(sql.FieldNotIn(FieldSubtotal, vs...))
+}
+
+// SubtotalGT applies the GT predicate on the "subtotal" field.
+func SubtotalGT(v int64) predicate.Order {
+	return predicate.Order(sql.FieldGT(FieldSubtotal, v))
+}
+
+// SubtotalGTE applies the GTE predicate on the "subtotal" field.
+func SubtotalGTE(v int64) predicate.Order {
+	return predicate.Order(sql.FieldGTE(FieldSubtotal, v))
+}
+
+// SubtotalLT applies the LT predicate on the "subtotal" field.
+func SubtotalLT(v int64) predicate.Order {
+	return predicate.Order(sql.FieldLT(FieldSubtotal, v))
+}
+
+// SubtotalLTE applies the LTE predicate on the "subtotal" field.
+func SubtotalLTE(v int64) predicate.Order {
+	return predicate.Order(sql.FieldLTE(FieldSubtotal, v))
+}
+
+// ModifiersTotalEQ applies the EQ predicate on the "modifiers_total" field.
+func ModifiersTotalEQ(v int64) predicate.Order {
+	return predicate.Order(sql.FieldEQ(FieldModifiersTotal, v))
+}
+
+// ModifiersTotalNEQ applies the NEQ predicate on the "modifiers_total" field.
+func ModifiersTotalNEQ(v int64) predicate.Order {
+	return predicate.Order(sql.FieldNEQ(FieldModifiersTotal, v))
+}
+
+// ModifiersTotalIn applies the In predicate on the "modifiers_total" field.
+func ModifiersTotalIn(vs ...int64) predicate.Order {
+	return predicate.Order(sql.FieldIn(FieldModifiersTotal, vs...))
+}
+
+// ModifiersTotalNotIn applies the NotIn predicate on the "modifiers_total" field.
+func ModifiersTotalNotIn(vs ...int64) predicate.Order {
+	return predicate.Order(sql.FieldNotIn(FieldModifiersTotal, vs...))
+}
+
+// ModifiersTotalGT applies the GT predicate on the "modifiers_total" field.
+func ModifiersTotalGT(v int64) predicate.Order {
+	return predicate.Order(sql.FieldGT(FieldModifiersTotal, v))
+}
+
+// ModifiersTotalGTE applies the GTE predicate on the "modifiers_total" field.
+func ModifiersTotalGTE(v int64) predicate.Order {
+	return predicate.Order(sql.FieldGTE(FieldModifiersTotal, v))
+}
+
+// ModifiersTotalLT applies the LT predicate on the "modifiers_total" field.
+func ModifiersTotalLT(v int64) predicate.Order {
+	return predicate.Order(sql.FieldLT(FieldModifiersTotal, v))
+}
+
+// ModifiersTotalLTE applies the LTE predicate on the "modifiers_total" field.
+func ModifiersTotalLTE(v int64) predicate.Order {
+	return predicate.Order(sql.FieldLTE(FieldModifiersTotal, v))
+}
+
+// TaxTotalEQ applies the EQ predicate on the "tax_total" field.
+func TaxTotalEQ(v int64) predicate.Order {
+	return predicate.Order(sql.FieldEQ(FieldTaxTotal, v))
+}
+
+// TaxTotalNEQ applies the NEQ predicate on the "tax_total" field.
+func TaxTotalNEQ(v int64) predicate.Order {
+	return predicate.Order(sql.FieldNEQ(FieldTaxTotal, v))
+}
+
+// TaxTotalIn applies the In predicate on the "tax_total" field.
+func TaxTotalIn(vs ...int64) predicate.Order {
+	return predicate.Order(sql.FieldIn(FieldTaxTotal, vs...))
+}
+
+// TaxTotalNotIn applies the NotIn predicate on the "tax_total" field.
+func TaxTotalNotIn(vs ...int64) predicate.Order {
+	return predicate.Order(sql.FieldNotIn(FieldTaxTotal, vs...))
+}
+
+// TaxTotalGT applies the GT predicate on the "tax_total" field.
+func TaxTotalGT(v int64) predicate.Order {
+	return predicate.Order(sql.FieldGT(FieldTaxTotal, v))
+}
+
+// TaxTotalGTE applies the GTE predicate on the "tax_total" field.
+func TaxTotalGTE(v int64) predicate.Order {
+	return predicate.Order(sql.FieldGTE(FieldTaxTotal, v))
+}
+
+// TaxTotalLT applies the LT predicate on the "tax_total" field.
+func TaxTotalLT(v int64) predicate.Order {
+	return predicate.Order(sql.FieldLT(FieldTaxTotal, v))
+}
+
+// TaxTotalLTE applies the LTE predicate on the "tax_total" field.
+func TaxTotalLTE(v int64) predicate.Order {
+	return predicate.Order(sql.FieldLTE(FieldTaxTotal, v))
+}
+
+// TaxRateBpsEQ applies the EQ predicate on the "tax_rate_bps" field.
+func TaxRateBpsEQ(v int) predicate.Order {
+	return predicate.Order(sql.FieldEQ(FieldTaxRateBps, v))
+}
+
+// TaxRateBpsNEQ applies the NEQ predicate on the "tax_rate_bps" field.
+func TaxRateBpsNEQ(v int) predicate.Order {
+	return predicate.Order(sql.FieldNEQ(FieldTaxRateBps, v))
+}
+
+// TaxRateBpsIn applies the In predicate on the "tax_rate_bps" field.
+func TaxRateBpsIn(vs ...int) predicate.Order {
+	return predicate.Order(sql.FieldIn(FieldTaxRateBps, vs...))
+}
+
+// TaxRateBpsNotIn applies the NotIn predicate on the "tax_rate_bps" field.
+func TaxRateBpsNotIn(vs ...int) predicate.Order {
+	return predicate.Order(sql.FieldNotIn(FieldTaxRateBps, vs...))
+}
+
+// TaxRateBpsGT applies the GT predicate on the "tax_rate_bps" field.
+func TaxRateBpsGT(v int) predicate.Order {
+	return predicate.Order(sql.FieldGT(FieldTaxRateBps, v))
+}
+
+// TaxRateBpsGTE applies the GTE predicate on the "tax_rate_bps" field.
+func TaxRateBpsGTE(v int) predicate.Order {
+	return predicate.Order(sql.FieldGTE(FieldTaxRateBps, v))
+}
+
+// TaxRateBpsLT applies the LT predicate on the "tax_rate_bps" field.
+func TaxRateBpsLT(v int) predicate.Order {
+	return predicate.Order(sql.FieldLT(FieldTaxRateBps, v))
+}
+
+// TaxRateBpsLTE applies the LTE predicate on the "tax_rate_bps" field.
+func TaxRateBpsLTE(v int) predicate.Order {
+	return predicate.Order(sql.FieldLTE(FieldTaxRateBps, v))
+}
+
+// TaxRateBpsIsNil applies the IsNil predicate on the "tax_rate_bps" field.
+func TaxRateBpsIsNil() predicate.Order {
+	return predicate.Order(sql.FieldIsNull(FieldTaxRateBps))
+}
+
+// TaxRateBpsNotNil applies the NotNil predicate on the "tax_rate_bps" field.
+func TaxRateBpsNotNil() predicate.Order {
+	return predicate.Order(sql.FieldNotNull(FieldTaxRateBps))
+}
+
+// TotalEQ applies the EQ predicate on the "total" field.
+func TotalEQ(v int64) predicate.Order {
+	return predicate.Order(sql.FieldEQ(FieldTotal, v))
+}
+
+// TotalNEQ applies the NEQ predicate on the "total" field.
+func TotalNEQ(v int64) predicate.Order {
+	return predicate.Order(sql.FieldNEQ(FieldTotal, v))
+}
+
+// TotalIn applies the In predicate on the "total" field.
+func TotalIn(vs ...int64) predicate.Order {
+	return predicate.Order(sql.FieldIn(FieldTotal, vs...))
+}
+
+// TotalNotIn applies the NotIn predicate on the "total" field.
+func TotalNotIn(vs ...int64) predicate.Order {
+	return predicate.Order(sql.FieldNotIn(FieldTotal, vs...))
+}
+
+// TotalGT applies the GT predicate on the "total" field.
+func TotalGT(v int64) predicate.Order {
+	return predicate.Order(sql.FieldGT(FieldTotal, v))
+}
+
+// TotalGTE applies the GTE predicate on the "total" field.
+func TotalGTE(v int64) predicate.Order {
+	return predicate.Order(sql.FieldGTE(FieldTotal, v))
+}
+
+// TotalLT applies the LT predicate on the "total" field.
+func TotalLT(v int64) predicate.Order {
+	return predicate.Order(sql.FieldLT(FieldTotal, v))
+}
+
+// TotalLTE applies the LTE predicate on the "total" field.
+func TotalLTE(v int64) predicate.Order {
+	return predicate.Order(sql.FieldLTE(FieldTotal, v))
+}
+
+// DeliveryFeeEQ applies the EQ predicate on the "delivery_fee" field.
+func DeliveryFeeEQ(v int64) predicate.Order {
+	return predicate.Order(sql.FieldEQ(FieldDeliveryFee, v))
+}
+
+// DeliveryFeeNEQ applies the NEQ predicate on the "delivery_fee" field.
+func DeliveryFeeNEQ(v int64) predicate.Order {
+	return predicate.Order(sql.FieldNEQ(FieldDeliveryFee, v))
+}
+
+// DeliveryFeeIn applies the In predicate on the "delivery_fee" field.
+func DeliveryFeeIn(vs ...int64) predicate.Order {
+	return predicate.Order(sql.FieldIn(FieldDeliveryFee, vs...))
+}
+
+// DeliveryFeeNotIn applies the NotIn predicate on the "delivery_fee" field.
+func DeliveryFeeNotIn(vs ...int64) predicate.Order {
+	return predicate.Order(sql.FieldNotIn(FieldDeliveryFee, vs...))
+}
+
+// DeliveryFeeGT applies the GT predicate on the "delivery_fee" field.
+func DeliveryFeeGT(v int64) predicate.Order {
+	return predicate.Order(sql.FieldGT(FieldDeliveryFee, v))
+}
+
+// DeliveryFeeGTE applies the GTE predicate on the "delivery_fee" field.
+func DeliveryFeeGTE(v int64) predicate.Order {
+	return predicate.Order(sql.FieldGTE(FieldDeliveryFee, v))
+}
+
+// DeliveryFeeLT applies the LT predicate on the "delivery_fee" field.
+func DeliveryFeeLT(v int64) predicate.Order {
+	return predicate.Order(sql.FieldLT(FieldDeliveryFee, v))
+}
+
+// DeliveryFeeLTE applies the LTE predicate on the "delivery_fee" field.
+func DeliveryFeeLTE(v int64) predicate.Order {
+	return predicate.Order(sql.FieldLTE(FieldDeliveryFee, v))
+}
+
+// AmountPaidEQ applies the EQ predicate on the "amount_paid" field.
+func AmountPaidEQ(v int64) predicate.Order {
+	return predicate.Order(sql.FieldEQ(FieldAmountPaid, v))
+}
+
+// AmountPaidNEQ applies the NEQ predicate on the "amount_paid" field.
+func AmountPaidNEQ(v int64) predicate.Order {
+	return predicate.Order(sql.FieldNEQ(FieldAmountPaid, v))
+}
+
+// AmountPaidIn applies the In predicate on the "amount_paid" field.
+func AmountPaidIn(vs ...int64) predicate.Order {
+	return predicate.Order(sql.FieldIn(FieldAmountPaid, vs...))
+}
+
+// AmountPaidNotIn applies the NotIn predicate on the "amount_paid" field.
+func AmountPaidNotIn(vs ...int64) predicate.Order {
+	return predicate.Order(sql.FieldNotIn(FieldAmountPaid, vs...))
+}
+
+// AmountPaidGT applies the GT predicate on the "amount_paid" field.
+func AmountPaidGT(v int64) predicate.Order {
+	return predicate.Order(sql.FieldGT(FieldAmountPaid, v))
+}
+
+// AmountPaidGTE applies the GTE predicate on the "amount_paid" field.
+func AmountPaidGTE(v int64) predicate.Order {
+	return predicate.Order(sql.FieldGTE(FieldAmountPaid, v))
+}
+
+// AmountPaidLT applies the LT predicate on the "amount_paid" field.
+func AmountPaidLT(v int64) predicate.Order {
+	return predicate.Order(sql.FieldLT(FieldAmountPaid, v))
+}
+
+// AmountPaidLTE applies the LTE predicate on the "amount_paid" field.
+func AmountPaidLTE(v int64) predicate.Order {
+	return predicate.Order(sql.FieldLTE(FieldAmountPaid, v))
+}
+
+// AmountRefundedEQ applies the EQ predicate on the "amount_refunded" field.
+func AmountRefundedEQ(v int64) predicate.Order {
+	return predicate.Order(sql.FieldEQ(FieldAmountRefunded, v))
+}
+
+// AmountRefundedNEQ applies the NEQ predicate on the "amount_refunded" field.
+func AmountRefundedNEQ(v int64) predicate.Order {
+	return predicate.Order(sql.FieldNEQ(FieldAmountRefunded, v))
+}
+
+// AmountRefundedIn applies the In predicate on the "amount_refunded" field.
+func AmountRefundedIn(vs ...int64) predicate.Order {
+	return predicate.Order(sql.FieldIn(FieldAmountRefunded, vs...))
+}
+
+// AmountRefundedNotIn applies the NotIn predicate on the "amount_refunded" field.
+func AmountRefundedNotIn(vs ...int64) predicate.Order {
+	return predicate.Order(sql.FieldNotIn(FieldAmountRefunded, vs...))
+}
+
+// AmountRefundedGT applies the GT predicate on the "amount_refunded" field.
+func AmountRefundedGT(v int64) predicate.Order {
+	return predicate.Order(sql.FieldGT(FieldAmountRefunded, v))
+}
+
+// AmountRefundedGTE applies the GTE predicate on the "amount_refunded" field.
+func AmountRefundedGTE(v int64) predicate.Order {
+	return predicate.Order(sql.FieldGTE(FieldAmountRefunded, v))
+}
+
+// AmountRefundedLT applies the LT predicate on the "amount_refunded" field.
+func AmountRefundedLT(v int64) predicate.Order {
+	return predicate.Order(sql.FieldLT(FieldAmountRefunded, v))
+}
+
+// AmountRefundedLTE applies the LTE predicate on the "amount_refunded" field.
+func AmountRefundedLTE(v int64) predicate.Order {
+	return predicate.Order(sql.FieldLTE(FieldAmountRefunded, v))
+}
+
 // RestaurantIDEQ applies the EQ predicate on the "restaurant_id" field.
 func RestaurantIDEQ(v uuid.UUID) predicate.Order {
 	return predicate.Order(sql.FieldEQ(FieldRestaurantID, v))
@@ -186,8 +821,688 @@ func RestaurantIDNotIn(vs ...uuid.UUID) predicate.Order {
 	return predicate.Order(sql.FieldNotIn(FieldRestaurantID, vs...))
 }
 
-// HasRestaurant applies the HasEdge predicate on the "restaurant" edge.
-func HasRestaurant() predicate.Order {
+// TableIDEQ applies the EQ predicate on the "table_id" field.
+func TableIDEQ(v uuid.UUID) predicate.Order {
+	return predicate.Order(sql.FieldEQ(FieldTableID, v))
+}
+
+// TableIDNEQ applies the NEQ predicate on the "table_id" field.
+func TableIDNEQ(v uuid.UUID) predicate.Order {
+	return predicate.Order(sql.FieldNEQ(FieldTableID, v))
+}
+
+// TableIDIn applies the In predicate on the "table_id" field.
+func TableIDIn(vs ...uuid.UUID) predicate.Order {
+	return predicate.Order(sql.FieldIn(FieldTableID, vs...))
+}
+
+// TableIDNotIn applies the NotIn predicate on the "table_id" field.
+func TableIDNotIn(vs ...uuid.UUID) predicate.Order {
+	return predicate.Order(sql.FieldNotIn(FieldTableID, vs...))
+}
+
+// TableIDIsNil applies the IsNil predicate on the "table_id" field.
+func TableIDIsNil() predicate.Order {
+	return predicate.Order(sql.FieldIsNull(FieldTableID))
+}
+
+// TableIDNotNil applies the NotNil predicate on the "table_id" field.
+func TableIDNotNil() predicate.Order {
+	return predicate.Order(sql.FieldNotNull(FieldTableID))
+}
+
+// TableSessionIDEQ applies the EQ predicate on the "table_session_id" field.
+func TableSessionIDEQ(v uuid.UUID) predicate.Order {
+	return predicate.Order(sql.FieldEQ(FieldTableSessionID, v))
+}
+
+// TableSessionIDNEQ applies the NEQ predicate on the "table_session_id" field.
+func TableSessionIDNEQ(v uuid.UUID) predicate.Order {
+	return predicate.Order(sql.FieldNEQ(FieldTableSessionID, v))
+}
+
+// TableSessionIDIn applies the In predicate on the "table_session_id" field.
+func TableSessionIDIn(vs ...uuid.UUID) predicate.Order {
+	return predicate.Order(sql.FieldIn(FieldTableSessionID, vs...))
+}
+
+// TableSessionIDNotIn applies the NotIn predicate on the "table_session_id" field.
+func TableSessionIDNotIn(vs ...uuid.UUID) predicate.Order {
+	return predicate.Order(sql.FieldNotIn(FieldTableSessionID, vs...))
+}
+
+// TableSessionIDIsNil applies the IsNil predicate on the "table_session_id" field.
+func TableSessionIDIsNil() predicate.Order {
+	return predicate.Order(sql.FieldIsNull(FieldTableSessionID))
+}
+
+// TableSessionIDNotNil applies the NotNil predicate on the "table_session_id" field.
+func TableSessionIDNotNil() predicate.Order {
+	return predicate.Order(sql.FieldNotNull(FieldTableSessionID))
+}
+
+// DeliveryAddressEQ applies the EQ predicate on the "delivery_address" field.
+func DeliveryAddressEQ(v string) predicate.Order {
+	return predicate.Order(sql.FieldEQ(FieldDeliveryAddress, v))
+}
+
+// DeliveryAddressNEQ applies the NEQ predicate on the "delivery_address" field.
+func DeliveryAddressNEQ(v string) predicate.Order {
+	return predicate.Order(sql.FieldNEQ(FieldDeliveryAddress, v))
+}
+
+// DeliveryAddressIn applies the In predicate on the "delivery_address" field.
+func DeliveryAddressIn(vs ...string) predicate.Order {
+	return predicate.Order(sql.FieldIn(FieldDeliveryAddress, vs...))
+}
+
+// DeliveryAddressNotIn applies the NotIn predicate on the "delivery_address" field.
+func DeliveryAddressNotIn(vs ...string) predicate.Order {
+	return predicate.Order(sql.FieldNotIn(FieldDeliveryAddress, vs...))
+}
+
+// DeliveryAddressGT applies the GT predicate on the "delivery_address" field.
+func DeliveryAddressGT(v string) predicate.Order {
+	return predicate.Order(sql.FieldGT(FieldDeliveryAddress, v))
+}
+
+// DeliveryAddressGTE applies the GTE predicate on the "delivery_address" field.
+func DeliveryAddressGTE(v string) predicate.Order {
+	return predicate.Order(sql.FieldGTE(FieldDeliveryAddress, v))
+}
+
+// DeliveryAddressLT applies the LT predicate on the "delivery_address" field.
+func DeliveryAddressLT(v string) predicate.Order {
+	return predicate.Order(sql.FieldLT(FieldDeliveryAddress, v))
+}
+
+// DeliveryAddressLTE applies the LTE predicate on the "delivery_address" field.
+func DeliveryAddressLTE(v string) predicate.Order {
+	return predicate.Order(sql.FieldLTE(FieldDeliveryAddress, v))
+}
+
+// DeliveryAddressContains applies the Contains predicate on the "delivery_address" field.
+func DeliveryAddressContains(v string) predicate.Order {
+	return predicate.Order(sql.FieldContains(FieldDeliveryAddress, v))
+}
+
+// DeliveryAddressHasPrefix applies the HasPrefix predicate on the "delivery_address" field.
+func DeliveryAddressHasPrefix(v string) predicate.Order {
+	return predicate.Order(sql.FieldHasPrefix(FieldDeliveryAddress, v))
+}
+
+// DeliveryAddressHasSuffix applies the HasSuffix predicate on the "delivery_address" field.
+func DeliveryAddressHasSuffix(v string) predicate.Order {
+	return predicate.Order(sql.FieldHasSuffix(FieldDeliveryAddress, v))
+}
+
+// DeliveryAddressEqualFold applies the EqualFold predicate on the "delivery_address" field.
+func DeliveryAddressEqualFold(v string) predicate.Order {
+	return predicate.Order(sql.FieldEqualFold(FieldDeliveryAddress, v))
+}
+
+// DeliveryAddressContainsFold applies the ContainsFold predicate on the "delivery_address" field.
+func DeliveryAddressContainsFold(v string) predicate.Order {
+	return predicate.Order(sql.FieldContainsFold(FieldDeliveryAddress, v))
+}
+
+// DeliveryLatitudeEQ applies the EQ predicate on the "delivery_latitude" field.
+func DeliveryLatitudeEQ(v float64) predicate.Order {
+	return predicate.Order(sql.FieldEQ(FieldDeliveryLatitude, v))
+}
+
+// DeliveryLatitudeNEQ applies the NEQ predicate on the "delivery_latitude" field.
+func DeliveryLatitudeNEQ(v float64) predicate.Order {
+	return predicate.Order(sql.FieldNEQ(FieldDeliveryLatitude, v))
+}
+
+// DeliveryLatitudeIn applies the In predicate on the "delivery_latitude" field.
+func DeliveryLatitudeIn(vs ...float64) predicate.Order {
+	return predicate.Order(sql.FieldIn(FieldDeliveryLatitude, vs...))
+}
+
+// DeliveryLatitudeNotIn applies the NotIn predicate on the "delivery_latitude" field.
+func DeliveryLatitudeNotIn(vs ...float64) predicate.Order {
+	return predicate.Order(sql.FieldNotIn(FieldDeliveryLatitude, vs...))
+}
+
+// DeliveryLatitudeGT applies the GT predicate on the "delivery_latitude" field.
+func DeliveryLatitudeGT(v float64) predicate.Order {
+	return predicate.Order(sql.FieldGT(FieldDeliveryLatitude, v))
+}
+
+// DeliveryLatitudeGTE applies the GTE predicate on the "delivery_latitude" field.
+func DeliveryLatitudeGTE(v float64) predicate.Order {
+	return predicate.Order(sql.FieldGTE(FieldDeliveryLatitude, v))
+}
+
+// DeliveryLatitudeLT applies the LT predicate on the "delivery_latitude" field.
+func DeliveryLatitudeLT(v float64) predicate.Order {
+	return predicate.Order(sql.FieldLT(FieldDeliveryLatitude, v))
+}
+
+// DeliveryLatitudeLTE applies the LTE predicate on the "delivery_latitude" field.
+func DeliveryLatitudeLTE(v float64) predicate.Order {
+	return predicate.Order(sql.FieldLTE(FieldDeliveryLatitude, v))
+}
+
+// DeliveryLatitudeIsNil applies the IsNil predicate on the "delivery_latitude" field.
+func DeliveryLatitudeIsNil() predicate.Order {
+	return predicate.Order(sql.FieldIsNull(FieldDeliveryLatitude))
+}
+
+// DeliveryLatitudeNotNil applies the NotNil predicate on the "delivery_latitude" field.
+func DeliveryLatitudeNotNil() predicate.Order {
+	return predicate.Order(sql.FieldNotNull(FieldDeliveryLatitude))
+}
+
+// DeliveryLongitudeEQ applies the EQ predicate on the "delivery_longitude" field.
+func DeliveryLongitudeEQ(v float64) predicate.Order {
+	return predicate.Order(sql.FieldEQ(FieldDeliveryLongitude, v))
+}
+
+// DeliveryLongitudeNEQ applies the NEQ predicate on the "delivery_longitude" field.
+func DeliveryLongitudeNEQ(v float64) predicate.Order {
+	return predicate.Order(sql.FieldNEQ(FieldDeliveryLongitude, v))
+}
+
+// DeliveryLongitudeIn applies the In predicate on the "delivery_longitude" field.
+func DeliveryLongitudeIn(vs ...float64) predicate.Order {
+	return predicate.Order(sql.FieldIn(FieldDeliveryLongitude, vs...))
+}
+
+// DeliveryLongitudeNotIn applies the NotIn predicate on the "delivery_longitude" field.
+func DeliveryLongitudeNotIn(vs ...float64) predicate.Order {
+	return predicate.Order(sql.FieldNotIn(FieldDeliveryLongitude, vs...))
+}
+
+// DeliveryLongitudeGT applies the GT predicate on the "delivery_longitude" field.
+func DeliveryLongitudeGT(v float64) predicate.Order {
+	return predicate.Order(sql.FieldGT(FieldDeliveryLongitude, v))
+}
+
+// DeliveryLongitudeGTE applies the GTE predicate on the "delivery_longitude" field.
+func DeliveryLongitudeGTE(v float64) predicate.Order {
+	return predicate.Order(sql.FieldGTE(FieldDeliveryLongitude, v))
+}
+
+// DeliveryLongitudeLT applies the LT predicate on the "delivery_longitude" field.
+func DeliveryLongitudeLT(v float64) predicate.Order {
+	return predicate.Order(sql.FieldLT(FieldDeliveryLongitude, v))
+}
+
+// DeliveryLongitudeLTE applies the LTE predicate on the "delivery_longitude" field.
+func DeliveryLongitudeLTE(v float64) predicate.Order {
+	return predicate.Order(sql.FieldLTE(FieldDeliveryLongitude, v))
+}
+
+// DeliveryLongitudeIsNil applies the IsNil predicate on the "delivery_longitude" field.
+func DeliveryLongitudeIsNil() predicate.Order {
+	return predicate.Order(sql.FieldIsNull(FieldDeliveryLongitude))
+}
+
+// DeliveryLongitudeNotNil applies the NotNil predicate on the "delivery_longitude" field.
+func DeliveryLongitudeNotNil() predicate.Order {
+	return predicate.Order(sql.FieldNotNull(FieldDeliveryLongitude))
+}
+
+// DeliveryContactNameEQ applies the EQ predicate on the "delivery_contact_name" field.
+func DeliveryContactNameEQ(v string) predicate.Order {
+	return predicate.Order(sql.FieldEQ(FieldDeliveryContactName, v))
+}
+
+// DeliveryContactNameNEQ applies the NEQ predicate on the "delivery_contact_name" field.
+func DeliveryContactNameNEQ(v string) predicate.Order {
+	return predicate.Order(sql.FieldNEQ(FieldDeliveryContactName, v))
+}
+
+// DeliveryContactNameIn applies the In predicate on the "delivery_contact_name" field.
+func DeliveryContactNameIn(vs ...string) predicate.Order {
+	return predicate.Order(sql.FieldIn(FieldDeliveryContactName, vs...))
+}
+
+// DeliveryContactNameNotIn applies the NotIn predicate on the "delivery_contact_name" field.
+func DeliveryContactNameNotIn(vs ...string) predicate.Order {
+	return predicate.Order(sql.FieldNotIn(FieldDeliveryContactName, vs...))
+}
+
+// DeliveryContactNameGT applies the GT predicate on the "delivery_contact_name" field.
+func DeliveryContactNameGT(v string) predicate.Order {
+	return predicate.Order(sql.FieldGT(FieldDeliveryContactName, v))
+}
+
+// DeliveryContactNameGTE applies the GTE predicate on the "delivery_contact_name" field.
+func DeliveryContactNameGTE(v string) predicate.Order {
+	return predicate.Order(sql.FieldGTE(FieldDeliveryContactName, v))
+}
+
+// DeliveryContactNameLT applies the LT predicate on the "delivery_contact_name" field.
+func DeliveryContactNameLT(v string) predicate.Order {
+	return predicate.Order(sql.FieldLT(FieldDeliveryContactName, v))
+}
+
+// DeliveryContactNameLTE applies the LTE predicate on the "delivery_contact_name" field.
+func DeliveryContactNameLTE(v string) predicate.Order {
+	return predicate.Order(sql.FieldLTE(FieldDeliveryContactName, v))
+}
+
+// DeliveryContactNameContains applies the Contains predicate on the "delivery_contact_name" field.
+func DeliveryContactNameContains(v string) predicate.Order {
+	return predicate.Order(sql.FieldContains(FieldDeliveryContactName, v))
+}
+
+// DeliveryContactNameHasPrefix applies the HasPrefix predicate on the "delivery_contact_name" field.
+func DeliveryContactNameHasPrefix(v string) predicate.Order {
+	return predicate.Order(sql.FieldHasPrefix(FieldDeliveryContactName, v))
+}
+
+// DeliveryContactNameHasSuffix applies the HasSuffix predicate on the "delivery_contact_name" field.
+func DeliveryContactNameHasSuffix(v string) predicate.Order {
+	return predicate.Order(sql.FieldHasSuffix(FieldDeliveryContactName, v))
+}
+
+// DeliveryContactNameEqualFold applies the EqualFold predicate on the "delivery_contact_name" field.
+func DeliveryContactNameEqualFold(v string) predicate.Order {
+	return predicate.Order(sql.FieldEqualFold(FieldDeliveryContactName, v))
+}
+
+// DeliveryContactNameContainsFold applies the ContainsFold predicate on the "delivery_contact_name" field.
+func DeliveryContactNameContainsFold(v string) predicate.Order {
+	return predicate.Order(sql.FieldContainsFold(FieldDeliveryContactName, v))
+}
+
+// DeliveryContactPhoneEQ applies the EQ predicate on the "delivery_contact_phone" field.
+func DeliveryContactPhoneEQ(v string) predicate.Order {
+	return predicate.Order(sql.FieldEQ(FieldDeliveryContactPhone, v))
+}
+
+// DeliveryContactPhoneNEQ applies the NEQ predicate on the "delivery_contact_phone" field.
+func DeliveryContactPhoneNEQ(v string) predicate.Order {
+	return predicate.Order(sql.FieldNEQ(FieldDeliveryContactPhone, v))
+}
+
+// DeliveryContactPhoneIn applies the In predicate on the "delivery_contact_phone" field.
+func DeliveryContactPhoneIn(vs ...string) predicate.Order {
+	return predicate.Order(sql.FieldIn(FieldDeliveryContactPhone, vs...))
+}
+
+// DeliveryContactPhoneNotIn applies the NotIn predicate on the "delivery_contact_phone" field.
+func DeliveryContactPhoneNotIn(vs ...string) predicate.Order {
+	return predicate.Order(sql.FieldNotIn(FieldDeliveryContactPhone, vs...))
+}
+
+// DeliveryContactPhoneGT applies the GT predicate on the "delivery_contact_phone" field.
+func DeliveryContactPhoneGT(v string) predicate.Order {
+	return predicate.Order(sql.FieldGT(FieldDeliveryContactPhone, v))
+}
+
+// DeliveryContactPhoneGTE applies the GTE predicate on the "delivery_contact_phone" field.
+func DeliveryContactPhoneGTE(v string) predicate.Order {
+	return predicate.Order(sql.FieldGTE(FieldDeliveryContactPhone, v))
+}
+
+// DeliveryContactPhoneLT applies the LT predicate on the "delivery_contact_phone" field.
+func DeliveryContactPhoneLT(v string) predicate.Order {
+	return predicate.Order(sql.FieldLT(FieldDeliveryContactPhone, v))
+}
+
+// DeliveryContactPhoneLTE applies the LTE predicate on the "delivery_contact_phone" field.
+func DeliveryContactPhoneLTE(v string) predicate.Order {
+	return predicate.Order(sql.FieldLTE(FieldDeliveryContactPhone, v))
+}
+
+// DeliveryContactPhoneContains applies the Contains predicate on the "delivery_contact_phone" field.
+func DeliveryContactPhoneContains(v string) predicate.Order {
+	return predicate.Order(sql.FieldContains(FieldDeliveryContactPhone, v))
+}
+
+// DeliveryContactPhoneHasPrefix applies the HasPrefix predicate on the "delivery_contact_phone" field.
+func DeliveryContactPhoneHasPrefix(v string) predicate.Order {
+	return predicate.Order(sql.FieldHasPrefix(FieldDeliveryContactPhone, v))
+}
+
+// DeliveryContactPhoneHasSuffix applies the HasSuffix predicate on the "delivery_contact_phone" field.
+func DeliveryContactPhoneHasSuffix(v string) predicate.Order {
+	return predicate.Order(sql.FieldHasSuffix(FieldDeliveryContactPhone, v))
+}
+
+// DeliveryContactPhoneEqualFold applies the EqualFold predicate on the "delivery_contact_phone" field.
+func DeliveryContactPhoneEqualFold(v string) predicate.Order {
+	return predicate.Order(sql.FieldEqualFold(FieldDeliveryContactPhone, v))
+}
+
+// DeliveryContactPhoneContainsFold applies the ContainsFold predicate on the "delivery_contact_phone" field.
+func DeliveryContactPhoneContainsFold(v string) predicate.Order {
+	return predicate.Order(sql.FieldContainsFold(FieldDeliveryContactPhone, v))
+}
+
+// DeliveryInstructionsEQ applies the EQ predicate on the "delivery_instructions" field.
+func DeliveryInstructionsEQ(v string) predicate.Order {
+	return predicate.Order(sql.FieldEQ(FieldDeliveryInstructions, v))
+}
+
+// DeliveryInstructionsNEQ applies the NEQ predicate on the "delivery_instructions" field.
+func DeliveryInstructionsNEQ(v string) predicate.Order {
+	return predicate.Order(sql.FieldNEQ(FieldDeliveryInstructions, v))
+}
+
+// DeliveryInstructionsIn applies the In predicate on the "delivery_instructions" field.
+func DeliveryInstructionsIn(vs ...string) predicate.Order {
+	return predicate.Order(sql.FieldIn(FieldDeliveryInstructions, vs...))
+}
+
+// DeliveryInstructionsNotIn applies the NotIn predicate on the "delivery_instructions" field.
+func DeliveryInstructionsNotIn(vs ...string) predicate.Order {
+	return predicate.Order(sql.FieldNotIn(FieldDeliveryInstructions, vs...))
+}
+
+// DeliveryInstructionsGT applies the GT predicate on the "delivery_instructions" field.
+func DeliveryInstructionsGT(v string) predicate.Order {
+	return predicate.Order(sql.FieldGT(FieldDeliveryInstructions, v))
+}
+
+// DeliveryInstructionsGTE applies the GTE predicate on the "delivery_instructions" field.
+func DeliveryInstructionsGTE(v string) predicate.Order {
+	return predicate.Order(sql.FieldGTE(FieldDeliveryInstructions, v))
+}
+
+// DeliveryInstructionsLT applies the LT predicate on the "delivery_instructions" field.
+func DeliveryInstructionsLT(v string) predicate.Order {
+	return predicate.Order(sql.FieldLT(FieldDeliveryInstructions, v))
+}
+
+// DeliveryInstructionsLTE applies the LTE predicate on the "delivery_instructions" field.
+func DeliveryInstructionsLTE(v string) predicate.Order {
+	return predicate.Order(sql.FieldLTE(FieldDeliveryInstructions, v))
+}
+
+// DeliveryInstructionsContains applies the Contains predicate on the "delivery_instructions" field.
+func DeliveryInstructionsContains(v string) predicate.Order {
+	return predicate.Order(sql.FieldContains(FieldDeliveryInstructions, v))
+}
+
+// DeliveryInstructionsHasPrefix applies the HasPrefix predicate on the "delivery_instructions" field.
+func DeliveryInstructionsHasPrefix(v string) predicate.Order {
+	return predicate.Order(sql.FieldHasPrefix(FieldDeliveryInstructions, v))
+}
+
+// DeliveryInstructionsHasSuffix applies the HasSuffix predicate on the "delivery_instructions" field.
+func DeliveryInstructionsHasSuffix(v string) predicate.Order {
+	return predicate.Order(sql.FieldHasSuffix(FieldDeliveryInstructions, v))
+}
+
+// DeliveryInstructionsEqualFold applies the EqualFold predicate on the "delivery_instructions" field.
+func DeliveryInstructionsEqualFold(v string) predicate.Order {
+	return predicate.Order(sql.FieldEqualFold(FieldDeliveryInstructions, v))
+}
+
+// DeliveryInstructionsContainsFold applies the ContainsFold predicate on the "delivery_instructions" field.
+func DeliveryInstructionsContainsFold(v string) predicate.Order {
+	return predicate.Order(sql.FieldContainsFold(FieldDeliveryInstructions, v))
+}
+
+// DeliveryZoneIDEQ applies the EQ predicate on the "delivery_zone_id" field.
+func DeliveryZoneIDEQ(v uuid.UUID) predicate.Order {
+	return predicate.Order(sql.FieldEQ(FieldDeliveryZoneID, v))
+}
+
+// DeliveryZoneIDNEQ applies the NEQ predicate on the "delivery_zone_id" field.
+func DeliveryZoneIDNEQ(v uuid.UUID) predicate.Order {
+	return predicate.Order(sql.FieldNEQ(FieldDeliveryZoneID, v))
+}
+
+// DeliveryZoneIDIn applies the In predicate on the "delivery_zone_id" field.
+func DeliveryZoneIDIn(vs ...uuid.UUID) predicate.Order {
+	return predicate.Order(sql.FieldIn(FieldDeliveryZoneID, vs...))
+}
+
+// DeliveryZoneIDNotIn applies the NotIn predicate on the "delivery_zone_id" field.
+func DeliveryZoneIDNotIn(vs ...uuid.UUID) predicate.Order {
+	return predicate.Order(sql.FieldNotIn(FieldDeliveryZoneID, vs...))
+}
+
+// DeliveryZoneIDIsNil applies the IsNil predicate on the "delivery_zone_id" field.
+func DeliveryZoneIDIsNil() predicate.Order {
+	return predicate.Order(sql.FieldIsNull(FieldDeliveryZoneID))
+}
+
+// DeliveryZoneIDNotNil applies the NotNil predicate on the "delivery_zone_id" field.
+func DeliveryZoneIDNotNil() predicate.Order {
+	return predicate.Order(sql.FieldNotNull(FieldDeliveryZoneID))
+}
+
+// DeliveryMinOrderValueEQ applies the EQ predicate on the "delivery_min_order_value" field.
+func DeliveryMinOrderValueEQ(v int64) predicate.Order {
+	return predicate.Order(sql.FieldEQ(FieldDeliveryMinOrderValue, v))
+}
+
+// DeliveryMinOrderValueNEQ applies the NEQ predicate on the "delivery_min_order_value" field.
+func DeliveryMinOrderValueNEQ(v int64) predicate.Order {
+	return predicate.Order(sql.FieldNEQ(FieldDeliveryMinOrderValue, v))
+}
+
+// DeliveryMinOrderValueIn applies the In predicate on the "delivery_min_order_value" field.
+func DeliveryMinOrderValueIn(vs ...int64) predicate.Order {
+	return predicate.Order(sql.FieldIn(FieldDeliveryMinOrderValue, vs...))
+}
+
+// DeliveryMinOrderValueNotIn applies the NotIn predicate on the "delivery_min_order_value" field.
+func DeliveryMinOrderValueNotIn(vs ...int64) predicate.Order {
+	return predicate.Order(sql.FieldNotIn(FieldDeliveryMinOrderValue, vs...))
+}
+
+// DeliveryMinOrderValueGT applies the GT predicate on the "delivery_min_order_value" field.
+func DeliveryMinOrderValueGT(v int64) predicate.Order {
+	return predicate.Order(sql.FieldGT(FieldDeliveryMinOrderValue, v))
+}
+
+// DeliveryMinOrderValueGTE applies the GTE predicate on the "delivery_min_order_value" field.
+func DeliveryMinOrderValueGTE(v int64) predicate.Order {
+	return predicate.Order(sql.FieldGTE(FieldDeliveryMinOrderValue, v))
+}
+
+// DeliveryMinOrderValueLT applies the LT predicate on the "delivery_min_order_value" field.
+func DeliveryMinOrderValueLT(v int64) predicate.Order {
+	return predicate.Order(sql.FieldLT(FieldDeliveryMinOrderValue, v))
+}
+
+// DeliveryMinOrderValueLTE applies the LTE predicate on the "delivery_min_order_value" field.
+func DeliveryMinOrderValueLTE(v int64) predicate.Order {
+	return predicate.Order(sql.FieldLTE(FieldDeliveryMinOrderValue, v))
+}
+
+// ScheduledForEQ applies the EQ predicate on the "scheduled_for" field.
+func ScheduledForEQ(v time.Time) predicate.Order {
+	return predicate.Order(sql.FieldEQ(FieldScheduledFor, v))
+}
+
+// ScheduledForNEQ applies the NEQ predicate on the "scheduled_for" field.
+func ScheduledForNEQ(v time.Time) predicate.Order {
+	return predicate.Order(sql.FieldNEQ(FieldScheduledFor, v))
+}
+
+// ScheduledForIn applies the In predicate on the "scheduled_for" field.
+func ScheduledForIn(vs ...time.Time) predicate.Order {
+	return predicate.Order(sql.FieldIn(FieldScheduledFor, vs...))
+}
+
+// ScheduledForNotIn applies the NotIn predicate on the "scheduled_for" field.
+func ScheduledForNotIn(vs ...time.Time) predicate.Order {
+	return predicate.Order(sql.FieldNotIn(FieldScheduledFor, vs...))
+}
+
+// ScheduledForGT applies the GT predicate on the "scheduled_for" field.
+func ScheduledForGT(v time.Time) predicate.Order {
+	return predicate.Order(sql.FieldGT(FieldScheduledFor, v))
+}
+
+// ScheduledForGTE applies the GTE predicate on the "scheduled_for" field.
+func ScheduledForGTE(v time.Time) predicate.Order {
+	return predicate.Order(sql.FieldGTE(FieldScheduledFor, v))
+}
+
+// ScheduledForLT applies the LT predicate on the "scheduled_for" field.
+func ScheduledForLT(v time.Time) predicate.Order {
+	return predicate.Order(sql.FieldLT(FieldScheduledFor, v))
+}
+
+// ScheduledForLTE applies the LTE predicate on the "scheduled_for" field.
+func ScheduledForLTE(v time.Time) predicate.Order {
+	return predicate.Order(sql.FieldLTE(FieldScheduledFor, v))
+}
+
+// ScheduledForIsNil applies the IsNil predicate on the "scheduled_for" field.
+func ScheduledForIsNil() predicate.Order {
+	return predicate.Order(sql.FieldIsNull(FieldScheduledFor))
+}
+
+// ScheduledForNotNil applies the NotNil predicate on the "scheduled_for" field.
+func ScheduledForNotNil() predicate.Order {
+	return predicate.Order(sql.FieldNotNull(FieldScheduledFor))
+}
+
+// ReleaseAtEQ applies the EQ predicate on the "release_at" field.
+func ReleaseAtEQ(v time.Time) predicate.Order {
+	return predicate.Order(sql.FieldEQ(FieldReleaseAt, v))
+}
+
+// ReleaseAtNEQ applies the NEQ predicate on the "release_at" field.
+func ReleaseAtNEQ(v time.Time) predicate.Order {
+	return predicate.Order(sql.FieldNEQ(FieldReleaseAt, v))
+}
+
+// ReleaseAtIn applies the In predicate on the "release_at" field.
+func ReleaseAtIn(vs ...time.Time) predicate.Order {
+	return predicate.Order(sql.FieldIn(FieldReleaseAt, vs...))
+}
+
+// ReleaseAtNotIn applies the NotIn predicate on the "release_at" field.
+func ReleaseAtNotIn(vs ...time.Time) predicate.Order {
+	return predicate.Order(sql.FieldNotIn(FieldReleaseAt, vs...))
+}
+
+// ReleaseAtGT applies the GT predicate on the "release_at" field.
+func ReleaseAtGT(v time.Time) predicate.Order {
+	return predicate.Order(sql.FieldGT(FieldReleaseAt, v))
+}
+
+// ReleaseAtGTE applies the GTE predicate on the "release_at" field.
+func ReleaseAtGTE(v time.Time) predicate.Order {
+	return predicate.Order(sql.FieldGTE(FieldReleaseAt, v))
+}
+
+// ReleaseAtLT applies the LT predicate on the "release_at" field.
+func ReleaseAtLT(v time.Time) predicate.Order {
+	return predicate.Order(sql.FieldLT(FieldReleaseAt, v))
+}
+
+// ReleaseAtLTE applies the LTE predicate on the "release_at" field.
+func ReleaseAtLTE(v time.Time) predicate.Order {
+	return predicate.Order(sql.FieldLTE(FieldReleaseAt, v))
+}
+
+// ReleaseAtIsNil applies the IsNil predicate on the "release_at" field.
+func ReleaseAtIsNil() predicate.Order {
+	return predicate.Order(sql.FieldIsNull(FieldReleaseAt))
+}
+
+// ReleaseAtNotNil applies the NotNil predicate on the "release_at" field.
+func ReleaseAtNotNil() predicate.Order {
+	return predicate.Order(sql.FieldNotNull(FieldReleaseAt))
+}
+
+// ReleasedAtEQ applies the EQ predicate on the "released_at" field.
+func ReleasedAtEQ(v time.Time) predicate.Order {
+	return predicate.Order(sql.FieldEQ(FieldReleasedAt, v))
+}
+
+// ReleasedAtNEQ applies the NEQ predicate on the "released_at" field.
+func ReleasedAtNEQ(v time.Time) predicate.Order {
+	return predicate.Order(sql.FieldNEQ(FieldReleasedAt, v))
+}
+
+// ReleasedAtIn applies the In predicate on the "released_at" field.
+func ReleasedAtIn(vs ...time.Time) predicate.Order {
+	return predicate.Order(sql.FieldIn(FieldReleasedAt, vs...))
+}
+
+// ReleasedAtNotIn applies the NotIn predicate on the "released_at" field.
+func ReleasedAtNotIn(vs ...time.Time) predicate.Order {
+	return predicate.Order(sql.FieldNotIn(FieldReleasedAt, vs...))
+}
+
+// ReleasedAtGT applies the GT predicate on the "released_at" field.
+func ReleasedAtGT(v time.Time) predicate.Order {
+	return predicate.Order(sql.FieldGT(FieldReleasedAt, v))
+}
+
+// ReleasedAtGTE applies the GTE predicate on the "released_at" field.
+func ReleasedAtGTE(v time.Time) predicate.Order {
+	return predicate.Order(sql.FieldGTE(FieldReleasedAt, v))
+}
+
+// ReleasedAtLT applies the LT predicate on the "released_at" field.
+func ReleasedAtLT(v time.Time) predicate.Order {
+	return predicate.Order(sql.FieldLT(FieldReleasedAt, v))
+}
+
+// ReleasedAtLTE applies the LTE predicate on the "released_at" field.
+func ReleasedAtLTE(v time.Time) predicate.Order {
+	return predicate.Order(sql.FieldLTE(FieldReleasedAt, v))
+}
+
+// ReleasedAtIsNil applies the IsNil predicate on the "released_at" field.
+func ReleasedAtIsNil() predicate.Order {
+	return predicate.Order(sql.FieldIsNull(FieldReleasedAt))
+}
+
+// ReleasedAtNotNil applies the NotNil predicate on the "released_at" field.
+func ReleasedAtNotNil() predicate.Order {
+	return predicate.Order(sql.FieldNotNull(FieldReleasedAt))
+}
+
+// ItemsVersionEQ applies the EQ predicate on the "items_version" field.
+func ItemsVersionEQ(v int) predicate.Order {
+	return predicate.Order(sql.FieldEQ(FieldItemsVersion, v))
+}
+
+// ItemsVersionNEQ applies the NEQ predicate on the "items_version" field.
+func ItemsVersionNEQ(v int) predicate.Order {
+	return predicate.Order(sql.FieldNEQ(FieldItemsVersion, v))
+}
+
+// ItemsVersionIn applies the In predicate on the "items_version" field.
+func ItemsVersionIn(vs ...int) predicate.Order {
+	return predicate.Order(sql.FieldIn(FieldItemsVersion, vs...))
+}
+
+// ItemsVersionNotIn applies the NotIn predicate on the "items_version" field.
+func ItemsVersionNotIn(vs ...int) predicate.Order {
+	return predicate.Order(sql.FieldNotIn(FieldItemsVersion, vs...))
+}
+
+// ItemsVersionGT applies the GT predicate on the "items_version" field.
+func ItemsVersionGT(v int) predicate.Order {
+	return predicate.Order(sql.FieldGT(FieldItemsVersion, v))
+}
+
+// ItemsVersionGTE applies the GTE predicate on the "items_version" field.
+func ItemsVersionGTE(v int) predicate.Order {
+	return predicate.Order(sql.FieldGTE(FieldItemsVersion, v))
+}
+
+// ItemsVersionLT applies the LT predicate on the "items_version" field.
+func ItemsVersionLT(v int) predicate.Order {
+	return predicate.Order(sql.FieldLT(FieldItemsVersion, v))
+}
+
+// ItemsVersionLTE applies the LTE predicate on the "items_version" field.
+func ItemsVersionLTE(v int) predicate.Order {
+	return predicate.Order(sql.FieldLTE(FieldItemsVersion, v))
+}
+
+// HasRestaurant applies the HasEdge predicate on the "restaurant" edge.
+func HasRestaurant() predicate.Order {
 	return predicate.Order(func(s *sql.Selector) {
 		step := sqlgraph.NewStep(
 			sqlgraph.From(Table, FieldID),
@@ -232,6 +1547,190 @@ func HasOrderItemsWith(preds ...predicate.OrderItem) predicate.Order {
 	})
 }
 
+// HasStatusEvents applies the HasEdge predicate on the "status_events" edge.
+func HasStatusEvents() predicate.Order {
+	return predicate.Order(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, StatusEventsTable, StatusEventsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasStatusEventsWith applies the HasEdge predicate on the "status_events" edge with a given conditions (other predicates).
+func HasStatusEventsWith(preds ...predicate.OrderStatusEvent) predicate.Order {
+	return predicate.Order(func(s *sql.Selector) {
+		step := newStatusEventsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasItemChanges applies the HasEdge predicate on the "item_changes" edge.
+func HasItemChanges() predicate.Order {
+	return predicate.Order(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ItemChangesTable, ItemChangesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasItemChangesWith applies the HasEdge predicate on the "item_changes" edge with a given conditions (other predicates).
+func HasItemChangesWith(preds ...predicate.OrderItemChange) predicate.Order {
+	return predicate.Order(func(s *sql.Selector) {
+		step := newItemChangesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasPayments applies the HasEdge predicate on the "payments" edge.
+func HasPayments() predicate.Order {
+	return predicate.Order(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, PaymentsTable, PaymentsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasPaymentsWith applies the HasEdge predicate on the "payments" edge with a given conditions (other predicates).
+func HasPaymentsWith(preds ...predicate.Payment) predicate.Order {
+	return predicate.Order(func(s *sql.Selector) {
+		step := newPaymentsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasRefunds applies the HasEdge predicate on the "refunds" edge.
+func HasRefunds() predicate.Order {
+	return predicate.Order(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, RefundsTable, RefundsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasRefundsWith applies the HasEdge predicate on the "refunds" edge with a given conditions (other predicates).
+func HasRefundsWith(preds ...predicate.Refund) predicate.Order {
+	return predicate.Order(func(s *sql.Selector) {
+		step := newRefundsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasStationTickets applies the HasEdge predicate on the "station_tickets" edge.
+func HasStationTickets() predicate.Order {
+	return predicate.Order(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, StationTicketsTable, StationTicketsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasStationTicketsWith applies the HasEdge predicate on the "station_tickets" edge with a given conditions (other predicates).
+func HasStationTicketsWith(preds ...predicate.StationTicket) predicate.Order {
+	return predicate.Order(func(s *sql.Selector) {
+		step := newStationTicketsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasTable applies the HasEdge predicate on the "table" edge.
+func HasTable() predicate.Order {
+	return predicate.Order(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, TableTable, TableColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasTableWith applies the HasEdge predicate on the "table" edge with a given conditions (other predicates).
+func HasTableWith(preds ...predicate.Table) predicate.Order {
+	return predicate.Order(func(s *sql.Selector) {
+		step := newTableStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasTableSession applies the HasEdge predicate on the "table_session" edge.
+func HasTableSession() predicate.Order {
+	return predicate.Order(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, TableSessionTable, TableSessionColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasTableSessionWith applies the HasEdge predicate on the "table_session" edge with a given conditions (other predicates).
+func HasTableSessionWith(preds ...predicate.TableSession) predicate.Order {
+	return predicate.Order(func(s *sql.Selector) {
+		step := newTableSessionStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasDeliveryZone applies the HasEdge predicate on the "delivery_zone" edge.
+func HasDeliveryZone() predicate.Order {
+	return predicate.Order(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, DeliveryZoneTable, DeliveryZoneColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasDeliveryZoneWith applies the HasEdge predicate on the "delivery_zone" edge with a given conditions (other predicates).
+func HasDeliveryZoneWith(preds ...predicate.DeliveryZone) predicate.Order {
+	return predicate.Order(func(s *sql.Selector) {
+		step := newDeliveryZoneStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Order) predicate.Order {
 	return predicate.Order(sql.AndPredicates(predicates...))
@@ -10,9 +10,17 @@ import (
 
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/Jiruu246/rms/internal/ent/deliveryzone"
 	"github.com/Jiruu246/rms/internal/ent/order"
 	"github.com/Jiruu246/rms/internal/ent/orderitem"
+	"github.com/Jiruu246/rms/internal/ent/orderitemchange"
+	"github.com/Jiruu246/rms/internal/ent/orderstatusevent"
+	"github.com/Jiruu246/rms/internal/ent/payment"
+	"github.com/Jiruu246/rms/internal/ent/refund"
 	"github.com/Jiruu246/rms/internal/ent/restaurant"
+	"github.com/Jiruu246/rms/internal/ent/stationticket"
+	"github.com/Jiruu246/rms/internal/ent/table"
+	"github.com/Jiruu246/rms/internal/ent/tablesession"
 	"github.com/google/uuid"
 )
 
@@ -37,6 +45,34 @@ func (_c *OrderCreate) SetNillableUpdateTime(v *time.Time) *OrderCreate {
 	return _c
 }
 
+// SetOrderNumber sets the "order_number" field.
+func (_c *OrderCreate) SetOrderNumber(v int) *OrderCreate {
+	_c.mutation.SetOrderNumber(v)
+	return _c
+}
+
+// SetNillableOrderNumber sets the "order_number" field if the given value is not nil.
+func (_c *OrderCreate) SetNillableOrderNumber(v *int) *OrderCreate {
+	if v != nil {
+		_c.SetOrderNumber(*v)
+	}
+	return _c
+}
+
+// SetOrderNumberPeriod sets the "order_number_period" field.
+func (_c *OrderCreate) SetOrderNumberPeriod(v string) *OrderCreate {
+	_c.mutation.SetOrderNumberPeriod(v)
+	return _c
+}
+
+// SetNillableOrderNumberPeriod sets the "order_number_period" field if the given value is not nil.
+func (_c *OrderCreate) SetNillableOrderNumberPeriod(v *string) *OrderCreate {
+	if v != nil {
+		_c.SetOrderNumberPeriod(*v)
+	}
+	return _c
+}
+
 // SetOrderType sets the "order_type" field.
 func (_c *OrderCreate) SetOrderType(v order.OrderType) *OrderCreate {
 	_c.mutation.SetOrderType(v)
@@ -71,12 +107,326 @@ func (_c *OrderCreate) SetNillablePaymentStatus(v *order.PaymentStatus) *OrderCr
 	return _c
 }
 
+// SetCurrency sets the "currency" field.
+func (_c *OrderCreate) SetCurrency(v string) *OrderCreate {
+	_c.mutation.SetCurrency(v)
+	return _c
+}
+
+// SetSubtotal sets the "subtotal" field.
+func (_c *OrderCreate) SetSubtotal(v int64) *OrderCreate {
+	_c.mutation.SetSubtotal(v)
+	return _c
+}
+
+// SetNillableSubtotal sets the "subtotal" field if the given value is not nil.
+func (_c *OrderCreate) SetNillableSubtotal(v *int64) *OrderCreate {
+	if v != nil {
+		_c.SetSubtotal(*v)
+	}
+	return _c
+}
+
+// SetModifiersTotal sets the "modifiers_total" field.
+func (_c *OrderCreate) SetModifiersTotal(v int64) *OrderCreate {
+	_c.mutation.SetModifiersTotal(v)
+	return _c
+}
+
+// SetNillableModifiersTotal sets the "modifiers_total" field if the given value is not nil.
+func (_c *OrderCreate) SetNillableModifiersTotal(v *int64) *OrderCreate {
+	if v != nil {
+		_c.SetModifiersTotal(*v)
+	}
+	return _c
+}
+
+// SetTaxTotal sets the "tax_total" field.
+func (_c *OrderCreate) SetTaxTotal(v int64) *OrderCreate {
+	_c.mutation.SetTaxTotal(v)
+	return _c
+}
+
+// SetNillableTaxTotal sets the "tax_total" field if the given value is not nil.
+func (_c *OrderCreate) SetNillableTaxTotal(v *int64) *OrderCreate {
+	if v != nil {
+		_c.SetTaxTotal(*v)
+	}
+	return _c
+}
+
+// SetTaxRateBps sets the "tax_rate_bps" field.
+func (_c *OrderCreate) SetTaxRateBps(v int) *OrderCreate {
+	_c.mutation.SetTaxRateBps(v)
+	return _c
+}
+
+// SetNillableTaxRateBps sets the "tax_rate_bps" field if the given value is not nil.
+func (_c *OrderCreate) SetNillableTaxRateBps(v *int) *OrderCreate {
+	if v != nil {
+		_c.SetTaxRateBps(*v)
+	}
+	return _c
+}
+
+// SetTotal sets the "total" field.
+func (_c *OrderCreate) SetTotal(v int64) *OrderCreate {
+	_c.mutation.SetTotal(v)
+	return _c
+}
+
+// SetNillableTotal sets the "total" field if the given value is not nil.
+func (_c *OrderCreate) SetNillableTotal(v *int64) *OrderCreate {
+	if v != nil {
+		_c.SetTotal(*v)
+	}
+	return _c
+}
+
+// SetDeliveryFee sets the "delivery_fee" field.
+func (_c *OrderCreate) SetDeliveryFee(v int64) *OrderCreate {
+	_c.mutation.SetDeliveryFee(v)
+	return _c
+}
+
+// SetNillableDeliveryFee sets the "delivery_fee" field if the given value is not nil.
+func (_c *OrderCreate) SetNillableDeliveryFee(v *int64) *OrderCreate {
+	if v != nil {
+		_c.SetDeliveryFee(*v)
+	}
+	return _c
+}
+
+// SetAmountPaid sets the "amount_paid" field.
+func (_c *OrderCreate) SetAmountPaid(v int64) *OrderCreate {
+	_c.mutation.SetAmountPaid(v)
+	return _c
+}
+
+// SetNillableAmountPaid sets the "amount_paid" field if the given value is not nil.
+func (_c *OrderCreate) SetNillableAmountPaid(v *int64) *OrderCreate {
+	if v != nil {
+		_c.SetAmountPaid(*v)
+	}
+	return _c
+}
+
+// SetAmountRefunded sets the "amount_refunded" field.
+func (_c *OrderCreate) SetAmountRefunded(v int64) *OrderCreate {
+	_c.mutation.SetAmountRefunded(v)
+	return _c
+}
+
+// SetNillableAmountRefunded sets the "amount_refunded" field if the given value is not nil.
+func (_c *OrderCreate) SetNillableAmountRefunded(v *int64) *OrderCreate {
+	if v != nil {
+		_c.SetAmountRefunded(*v)
+	}
+	return _c
+}
+
 // SetRestaurantID sets the "restaurant_id" field.
 func (_c *OrderCreate) SetRestaurantID(v uuid.UUID) *OrderCreate {
 	_c.mutation.SetRestaurantID(v)
 	return _c
 }
 
+// SetTableID sets the "table_id" field.
+func (_c *OrderCreate) SetTableID(v uuid.UUID) *OrderCreate {
+	_c.mutation.SetTableID(v)
+	return _c
+}
+
+// SetNillableTableID sets the "table_id" field if the given value is not nil.
+func (_c *OrderCreate) SetNillableTableID(v *uuid.UUID) *OrderCreate {
+	if v != nil {
+		_c.SetTableID(*v)
+	}
+	return _c
+}
+
+// SetTableSessionID sets the "table_session_id" field.
+func (_c *OrderCreate) SetTableSessionID(v uuid.UUID) *OrderCreate {
+	_c.mutation.SetTableSessionID(v)
+	return _c
+}
+
+// SetNillableTableSessionID sets the "table_session_id" field if the given value is not nil.
+func (_c *OrderCreate) SetNillableTableSessionID(v *uuid.UUID) *OrderCreate {
+	if v != nil {
+		_c.SetTableSessionID(*v)
+	}
+	return _c
+}
+
+// SetDeliveryAddress sets the "delivery_address" field.
+func (_c *OrderCreate) SetDeliveryAddress(v string) *OrderCreate {
+	_c.mutation.SetDeliveryAddress(v)
+	return _c
+}
+
+// SetNillableDeliveryAddress sets the "delivery_address" field if the given value is not nil.
+func (_c *OrderCreate) SetNillableDeliveryAddress(v *string) *OrderCreate {
+	if v != nil {
+		_c.SetDeliveryAddress(*v)
+	}
+	return _c
+}
+
+// SetDeliveryLatitude sets the "delivery_latitude" field.
+func (_c *OrderCreate) SetDeliveryLatitude(v float64) *OrderCreate {
+	_c.mutation.SetDeliveryLatitude(v)
+	return _c
+}
+
+// SetNillableDeliveryLatitude sets the "delivery_latitude" field if the given value is not nil.
+func (_c *OrderCreate) SetNillableDeliveryLatitude(v *float64) *OrderCreate {
+	if v != nil {
+		_c.SetDeliveryLatitude(*v)
+	}
+	return _c
+}
+
+// SetDeliveryLongitude sets the "delivery_longitude" field.
+func (_c *OrderCreate) SetDeliveryLongitude(v float64) *OrderCreate {
+	_c.mutation.SetDeliveryLongitude(v)
+	return _c
+}
+
+// SetNillableDeliveryLongitude sets the "delivery_longitude" field if the given value is not nil.
+func (_c *OrderCreate) SetNillableDeliveryLongitude(v *float64) *OrderCreate {
+	if v != nil {
+		_c.SetDeliveryLongitude(*v)
+	}
+	return _c
+}
+
+// SetDeliveryContactName sets the "delivery_contact_name" field.
+func (_c *OrderCreate) SetDeliveryContactName(v string) *OrderCreate {
+	_c.mutation.SetDeliveryContactName(v)
+	return _c
+}
+
+// SetNillableDeliveryContactName sets the "delivery_contact_name" field if the given value is not nil.
+func (_c *OrderCreate) SetNillableDeliveryContactName(v *string) *OrderCreate {
+	if v != nil {
+		_c.SetDeliveryContactName(*v)
+	}
+	return _c
+}
+
+// SetDeliveryContactPhone sets the "delivery_contact_phone" field.
+func (_c *OrderCreate) SetDeliveryContactPhone(v string) *OrderCreate {
+	_c.mutation.SetDeliveryContactPhone(v)
+	return _c
+}
+
+// SetNillableDeliveryContactPhone sets the "delivery_contact_phone" field if the given value is not nil.
+func (_c *OrderCreate) SetNillableDeliveryContactPhone(v *string) *OrderCreate {
+	if v != nil {
+		_c.SetDeliveryContactPhone(*v)
+	}
+	return _c
+}
+
+// SetDeliveryInstructions sets the "delivery_instructions" field.
+func (_c *OrderCreate) SetDeliveryInstructions(v string) *OrderCreate {
+	_c.mutation.SetDeliveryInstructions(v)
+	return _c
+}
+
+// SetNillableDeliveryInstructions sets the "delivery_instructions" field if the given value is not nil.
+func (_c *OrderCreate) SetNillableDeliveryInstructions(v *string) *OrderCreate {
+	if v != nil {
+		_c.SetDeliveryInstructions(*v)
+	}
+	return _c
+}
+
+// SetDeliveryZoneID sets the "delivery_zone_id" field.
+func (_c *OrderCreate) SetDeliveryZoneID(v uuid.UUID) *OrderCreate {
+	_c.mutation.SetDeliveryZoneID(v)
+	return _c
+}
+
+// SetNillableDeliveryZoneID sets the "delivery_zone_id" field if the given value is not nil.
+func (_c *OrderCreate) SetNillableDeliveryZoneID(v *uuid.UUID) *OrderCreate {
+	if v != nil {
+		_c.SetDeliveryZoneID(*v)
+	}
+	return _c
+}
+
+// SetDeliveryMinOrderValue sets the "delivery_min_order_value" field.
+func (_c *OrderCreate) SetDeliveryMinOrderValue(v int64) *OrderCreate {
+	_c.mutation.SetDeliveryMinOrderValue(v)
+	return _c
+}
+
+// SetNillableDeliveryMinOrderValue sets the "delivery_min_order_value" field if the given value is not nil.
+func (_c *OrderCreate) SetNillableDeliveryMinOrderValue(v *int64) *OrderCreate {
+	if v != nil {
+		_c.SetDeliveryMinOrderValue(*v)
+	}
+	return _c
+}
+
+// SetScheduledFor sets the "scheduled_for" field.
+func (_c *OrderCreate) SetScheduledFor(v time.Time) *OrderCreate {
+	_c.mutation.SetScheduledFor(v)
+	return _c
+}
+
+// SetNillableScheduledFor sets the "scheduled_for" field if the given value is not nil.
+func (_c *OrderCreate) SetNillableScheduledFor(v *time.Time) *OrderCreate {
+	if v != nil {
+		_c.SetScheduledFor(*v)
+	}
+	return _c
+}
+
+// SetReleaseAt sets the "release_at" field.
+func (_c *OrderCreate) SetReleaseAt(v time.Time) *OrderCreate {
+	_c.mutation.SetReleaseAt(v)
+	return _c
+}
+
+// SetNillableReleaseAt sets the "release_at" field if the given value is not nil.
+func (_c *OrderCreate) SetNillableReleaseAt(v *time.Time) *OrderCreate {
+	if v != nil {
+		_c.SetReleaseAt(*v)
+	}
+	return _c
+}
+
+// SetReleasedAt sets the "released_at" field.
+func (_c *OrderCreate) SetReleasedAt(v time.Time) *OrderCreate {
+	_c.mutation.SetReleasedAt(v)
+	return _c
+}
+
+// SetNillableReleasedAt sets the "released_at" field if the given value is not nil.
+func (_c *OrderCreate) SetNillableReleasedAt(v *time.Time) *OrderCreate {
+	if v != nil {
+		_c.SetReleasedAt(*v)
+	}
+	return _c
+}
+
+// SetItemsVersion sets the "items_version" field.
+func (_c *OrderCreate) SetItemsVersion(v int) *OrderCreate {
+	_c.mutation.SetItemsVersion(v)
+	return _c
+}
+
+// SetNillableItemsVersion sets the "items_version" field if the given value is not nil.
+func (_c *OrderCreate) SetNillableItemsVersion(v *int) *OrderCreate {
+	if v != nil {
+		_c.SetItemsVersion(*v)
+	}
+	return _c
+}
+
 // SetID sets the "id" field.
 func (_c *OrderCreate) SetID(v uuid.UUID) *OrderCreate {
 	_c.mutation.SetID(v)
@@ -111,6 +461,96 @@ func (_c *OrderCreate) AddOrderItems(v ...*OrderItem) *OrderCreate {
 	return _c.AddOrderItemIDs(ids...)
 }
 
+// AddStatusEventIDs adds the "status_events" edge to the OrderStatusEvent entity by IDs.
+func (_c *OrderCreate) AddStatusEventIDs(ids ...uuid.UUID) *OrderCreate {
+	_c.mutation.AddStatusEventIDs(ids...)
+	return _c
+}
+
+// AddStatusEvents adds the "status_events" edges to the OrderStatusEvent entity.
+func (_c *OrderCreate) AddStatusEvents(v ...*OrderStatusEvent) *OrderCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddStatusEventIDs(ids...)
+}
+
+// AddItemChangeIDs adds the "item_changes" edge to the OrderItemChange entity by IDs.
+func (_c *OrderCreate) AddItemChangeIDs(ids ...uuid.UUID) *OrderCreate {
+	_c.mutation.AddItemChangeIDs(ids...)
+	return _c
+}
+
+// AddItemChanges adds the "item_changes" edges to the OrderItemChange entity.
+func (_c *OrderCreate) AddItemChanges(v ...*OrderItemChange) *OrderCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddItemChangeIDs(ids...)
+}
+
+// AddPaymentIDs adds the "payments" edge to the Payment entity by IDs.
+func (_c *OrderCreate) AddPaymentIDs(ids ...uuid.UUID) *OrderCreate {
+	_c.mutation.AddPaymentIDs(ids...)
+	return _c
+}
+
+// AddPayments adds the "payments" edges to the Payment entity.
+func (_c *OrderCreate) AddPayments(v ...*Payment) *OrderCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddPaymentIDs(ids...)
+}
+
+// AddRefundIDs adds the "refunds" edge to the Refund entity by IDs.
+func (_c *OrderCreate) AddRefundIDs(ids ...uuid.UUID) *OrderCreate {
+	_c.mutation.AddRefundIDs(ids...)
+	return _c
+}
+
+// AddRefunds adds the "refunds" edges to the Refund entity.
+func (_c *OrderCreate) AddRefunds(v ...*Refund) *OrderCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddRefundIDs(ids...)
+}
+
+// AddStationTicketIDs adds the "station_tickets" edge to the StationTicket entity by IDs.
+func (_c *OrderCreate) AddStationTicketIDs(ids ...uuid.UUID) *OrderCreate {
+	_c.mutation.AddStationTicketIDs(ids...)
+	return _c
+}
+
+// AddStationTickets adds the "station_tickets" edges to the StationTicket entity.
+func (_c *OrderCreate) AddStationTickets(v ...*StationTicket) *OrderCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddStationTicketIDs(ids...)
+}
+
+// SetTable sets the "table" edge to the Table entity.
+func (_c *OrderCreate) SetTable(v *Table) *OrderCreate {
+	return _c.SetTableID(v.ID)
+}
+
+// SetTableSession sets the "table_session" edge to the TableSession entity.
+func (_c *OrderCreate) SetTableSession(v *TableSession) *OrderCreate {
+	return _c.SetTableSessionID(v.ID)
+}
+
+// SetDeliveryZone sets the "delivery_zone" edge to the DeliveryZone entity.
+func (_c *OrderCreate) SetDeliveryZone(v *DeliveryZone) *OrderCreate {
+	return _c.SetDeliveryZoneID(v.ID)
+}
+
 // Mutation returns the OrderMutation object of the builder.
 func (_c *OrderCreate) Mutation() *OrderMutation {
 	return _c.mutation
@@ -150,6 +590,10 @@ func (_c *OrderCreate) defaults() {
 		v := order.DefaultUpdateTime()
 		_c.mutation.SetUpdateTime(v)
 	}
+	if _, ok := _c.mutation.OrderNumberPeriod(); !ok {
+		v := order.DefaultOrderNumberPeriod
+		_c.mutation.SetOrderNumberPeriod(v)
+	}
 	if _, ok := _c.mutation.OrderStatus(); !ok {
 		v := order.DefaultOrderStatus
 		_c.mutation.SetOrderStatus(v)
@@ -158,6 +602,58 @@ func (_c *OrderCreate) defaults() {
 		v := order.DefaultPaymentStatus
 		_c.mutation.SetPaymentStatus(v)
 	}
+	if _, ok := _c.mutation.Subtotal(); !ok {
+		v := order.DefaultSubtotal
+		_c.mutation.SetSubtotal(v)
+	}
+	if _, ok := _c.mutation.ModifiersTotal(); !ok {
+		v := order.DefaultModifiersTotal
+		_c.mutation.SetModifiersTotal(v)
+	}
+	if _, ok := _c.mutation.TaxTotal(); !ok {
+		v := order.DefaultTaxTotal
+		_c.mutation.SetTaxTotal(v)
+	}
+	if _, ok := _c.mutation.Total(); !ok {
+		v := order.DefaultTotal
+		_c.mutation.SetTotal(v)
+	}
+	if _, ok := _c.mutation.DeliveryFee(); !ok {
+		v := order.DefaultDeliveryFee
+		_c.mutation.SetDeliveryFee(v)
+	}
+	if _, ok := _c.mutation.AmountPaid(); !ok {
+		v := order.DefaultAmountPaid
+		_c.mutation.SetAmountPaid(v)
+	}
+	if _, ok := _c.mutation.AmountRefunded(); !ok {
+		v := order.DefaultAmountRefunded
+		_c.mutation.SetAmountRefunded(v)
+	}
+	if _, ok := _c.mutation.DeliveryAddress(); !ok {
+		v := order.DefaultDeliveryAddress
+		_c.mutation.SetDeliveryAddress(v)
+	}
+	if _, ok := _c.mutation.DeliveryContactName(); !ok {
+		v := order.DefaultDeliveryContactName
+		_c.mutation.SetDeliveryContactName(v)
+	}
+	if _, ok := _c.mutation.DeliveryContactPhone(); !ok {
+		v := order.DefaultDeliveryContactPhone
+		_c.mutation.SetDeliveryContactPhone(v)
+	}
+	if _, ok := _c.mutation.DeliveryInstructions(); !ok {
+		v := order.DefaultDeliveryInstructions
+		_c.mutation.SetDeliveryInstructions(v)
+	}
+	if _, ok := _c.mutation.DeliveryMinOrderValue(); !ok {
+		v := order.DefaultDeliveryMinOrderValue
+		_c.mutation.SetDeliveryMinOrderValue(v)
+	}
+	if _, ok := _c.mutation.ItemsVersion(); !ok {
+		v := order.DefaultItemsVersion
+		_c.mutation.SetItemsVersion(v)
+	}
 	if _, ok := _c.mutation.ID(); !ok {
 		v := order.DefaultID()
 		_c.mutation.SetID(v)
@@ -169,6 +665,9 @@ func (_c *OrderCreate) check() error {
 	if _, ok := _c.mutation.UpdateTime(); !ok {
 		return &ValidationError{Name: "update_time", err: errors.New(`ent: missing required field "Order.update_time"`)}
 	}
+	if _, ok := _c.mutation.OrderNumberPeriod(); !ok {
+		return &ValidationError{Name: "order_number_period", err: errors.New(`ent: missing required field "Order.order_number_period"`)}
+	}
 	if _, ok := _c.mutation.OrderType(); !ok {
 		return &ValidationError{Name: "order_type", err: errors.New(`ent: missing required field "Order.order_type"`)}
 	}
@@ -193,9 +692,91 @@ func (_c *OrderCreate) check() error {
 			return &ValidationError{Name: "payment_status", err: fmt.Errorf(`ent: validator failed for field "Order.payment_status": %w`, err)}
 		}
 	}
+	if _, ok := _c.mutation.Currency(); !ok {
+		return &ValidationError{Name: "currency", err: errors.New(`ent: missing required field "Order.currency"`)}
+	}
+	if _, ok := _c.mutation.Subtotal(); !ok {
+		return &ValidationError{Name: "subtotal", err: errors.New(`ent: missing required field "Order.subtotal"`)}
+	}
+	if v, ok := _c.mutation.Subtotal(); ok {
+		if err := order.SubtotalValidator(v); err != nil {
+			return &ValidationError{Name: "subtotal", err: fmt.Errorf(`ent: validator failed for field "Order.subtotal": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.ModifiersTotal(); !ok {
+		return &ValidationError{Name: "modifiers_total", err: errors.New(`ent: missing required field "Order.modifiers_total"`)}
+	}
+	if v, ok := _c.mutation.ModifiersTotal(); ok {
+		if err := order.ModifiersTotalValidator(v); err != nil {
+			return &ValidationError{Name: "modifiers_total", err: fmt.Errorf(`ent: validator failed for field "Order.modifiers_total": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.TaxTotal(); !ok {
+		return &ValidationError{Name: "tax_total", err: errors.New(`ent: missing required field "Order.tax_total"`)}
+	}
+	if v, ok := _c.mutation.TaxTotal(); ok {
+		if err := order.TaxTotalValidator(v); err != nil {
+			return &ValidationError{Name: "tax_total", err: fmt.Errorf(`ent: validator failed for field "Order.tax_total": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Total(); !ok {
+		return &ValidationError{Name: "total", err: errors.New(`ent: missing required field "Order.total"`)}
+	}
+	if v, ok := _c.mutation.Total(); ok {
+		if err := order.TotalValidator(v); err != nil {
+			return &ValidationError{Name: "total", err: fmt.Errorf(`ent: validator failed for field "Order.total": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.DeliveryFee(); !ok {
+		return &ValidationError{Name: "delivery_fee", err: errors.New(`ent: missing required field "Order.delivery_fee"`)}
+	}
+	if v, ok := _c.mutation.DeliveryFee(); ok {
+		if err := order.DeliveryFeeValidator(v); err != nil {
+			return &ValidationError{Name: "delivery_fee", err: fmt.Errorf(`ent: validator failed for field "Order.delivery_fee": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.AmountPaid(); !ok {
+		return &ValidationError{Name: "amount_paid", err: errors.New(`ent: missing required field "Order.amount_paid"`)}
+	}
+	if v, ok := _c.mutation.AmountPaid(); ok {
+		if err := order.AmountPaidValidator(v); err != nil {
+			return &ValidationError{Name: "amount_paid", err: fmt.Errorf(`ent: validator failed for field "Order.amount_paid": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.AmountRefunded(); !ok {
+		return &ValidationError{Name: "amount_refunded", err: errors.New(`ent: missing required field "Order.amount_refunded"`)}
+	}
+	if v, ok := _c.mutation.AmountRefunded(); ok {
+		if err := order.AmountRefundedValidator(v); err != nil {
+			return &ValidationError{Name: "amount_refunded", err: fmt.Errorf(`ent: validator failed for field "Order.amount_refunded": %w`, err)}
+		}
+	}
 	if _, ok := _c.mutation.RestaurantID(); !ok {
 		return &ValidationError{Name: "restaurant_id", err: errors.New(`ent: missing required field "Order.restaurant_id"`)}
 	}
+	if _, ok := _c.mutation.DeliveryAddress(); !ok {
+		return &ValidationError{Name: "delivery_address", err: errors.New(`ent: missing required field "Order.delivery_address"`)}
+	}
+	if _, ok := _c.mutation.DeliveryContactName(); !ok {
+		return &ValidationError{Name: "delivery_contact_name", err: errors.New(`ent: missing required field "Order.delivery_contact_name"`)}
+	}
+	if _, ok := _c.mutation.DeliveryContactPhone(); !ok {
+		return &ValidationError{Name: "delivery_contact_phone", err: errors.New(`ent: missing required field "Order.delivery_contact_phone"`)}
+	}
+	if _, ok := _c.mutation.DeliveryInstructions(); !ok {
+		return &ValidationError{Name: "delivery_instructions", err: errors.New(`ent: missing required field "Order.delivery_instructions"`)}
+	}
+	if _, ok := _c.mutation.DeliveryMinOrderValue(); !ok {
+		return &ValidationError{Name: "delivery_min_order_value", err: errors.New(`ent: missing required field "Order.delivery_min_order_value"`)}
+	}
+	if v, ok := _c.mutation.DeliveryMinOrderValue(); ok {
+		if err := order.DeliveryMinOrderValueValidator(v); err != nil {
+			return &ValidationError{Name: "delivery_min_order_value", err: fmt.Errorf(`ent: validator failed for field "Order.delivery_min_order_value": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.ItemsVersion(); !ok {
+		return &ValidationError{Name: "items_version", err: errors.New(`ent: missing required field "Order.items_version"`)}
+	}
 	if len(_c.mutation.RestaurantIDs()) == 0 {
 		return &ValidationError{Name: "restaurant", err: errors.New(`ent: missing required edge "Order.restaurant"`)}
 	}
@@ -238,6 +819,14 @@ func (_c *OrderCreate) createSpec() (*Order, *sqlgraph.CreateSpec) {
 		_spec.SetField(order.FieldUpdateTime, field.TypeTime, value)
 		_node.UpdateTime = value
 	}
+	if value, ok := _c.mutation.OrderNumber(); ok {
+		_spec.SetField(order.FieldOrderNumber, field.TypeInt, value)
+		_node.OrderNumber = &value
+	}
+	if value, ok := _c.mutation.OrderNumberPeriod(); ok {
+		_spec.SetField(order.FieldOrderNumberPeriod, field.TypeString, value)
+		_node.OrderNumberPeriod = value
+	}
 	if value, ok := _c.mutation.OrderType(); ok {
 		_spec.SetField(order.FieldOrderType, field.TypeEnum, value)
 		_node.OrderType = value
@@ -250,6 +839,86 @@ func (_c *OrderCreate) createSpec() (*Order, *sqlgraph.CreateSpec) {
 		_spec.SetField(order.FieldPaymentStatus, field.TypeEnum, value)
 		_node.PaymentStatus = value
 	}
+	if value, ok := _c.mutation.Currency(); ok {
+		_spec.SetField(order.FieldCurrency, field.TypeString, value)
+		_node.Currency = value
+	}
+	if value, ok := _c.mutation.Subtotal(); ok {
+		_spec.SetField(order.FieldSubtotal, field.TypeInt64, value)
+		_node.Subtotal = value
+	}
+	if value, ok := _c.mutation.ModifiersTotal(); ok {
+		_spec.SetField(order.FieldModifiersTotal, field.TypeInt64, value)
+		_node.ModifiersTotal = value
+	}
+	if value, ok := _c.mutation.TaxTotal(); ok {
+		_spec.SetField(order.FieldTaxTotal, field.TypeInt64, value)
+		_node.TaxTotal = value
+	}
+	if value, ok := _c.mutation.TaxRateBps(); ok {
+		_spec.SetField(order.FieldTaxRateBps, field.TypeInt, value)
+		_node.TaxRateBps = &value
+	}
+	if value, ok := _c.mutation.Total(); ok {
+		_spec.SetField(order.FieldTotal, field.TypeInt64, value)
+		_node.Total = value
+	}
+	if value, ok := _c.mutation.DeliveryFee(); ok {
+		_spec.SetField(order.FieldDeliveryFee, field.TypeInt64, value)
+		_node.DeliveryFee = value
+	}
+	if value, ok := _c.mutation.AmountPaid(); ok {
+		_spec.SetField(order.FieldAmountPaid, field.TypeInt64, value)
+		_node.AmountPaid = value
+	}
+	if value, ok := _c.mutation.AmountRefunded(); ok {
+		_spec.SetField(order.FieldAmountRefunded, field.TypeInt64, value)
+		_node.AmountRefunded = value
+	}
+	if value, ok := _c.mutation.DeliveryAddress(); ok {
+		_spec.SetField(order.FieldDeliveryAddress, field.TypeString, value)
+		_node.DeliveryAddress = value
+	}
+	if value, ok := _c.mutation.DeliveryLatitude(); ok {
+		_spec.SetField(order.FieldDeliveryLatitude, field.TypeFloat64, value)
+		_node.DeliveryLatitude = &value
+	}
+	if value, ok := _c.mutation.DeliveryLongitude(); ok {
+		_spec.SetField(order.FieldDeliveryLongitude, field.TypeFloat64, value)
+		_node.DeliveryLongitude = &value
+	}
+	if value, ok := _c.mutation.DeliveryContactName(); ok {
+		_spec.SetField(order.FieldDeliveryContactName, field.TypeString, value)
+		_node.DeliveryContactName = value
+	}
+	if value, ok := _c.mutation.DeliveryContactPhone(); ok {
+		_spec.SetField(order.FieldDeliveryContactPhone, field.TypeString, value)
+		_node.DeliveryContactPhone = value
+	}
+	if value, ok := _c.mutation.DeliveryInstructions(); ok {
+		_spec.SetField(order.FieldDeliveryInstructions, field.TypeString, value)
+		_node.DeliveryInstructions = value
+	}
+	if value, ok := _c.mutation.DeliveryMinOrderValue(); ok {
+		_spec.SetField(order.FieldDeliveryMinOrderValue, field.TypeInt64, value)
+		_node.DeliveryMinOrderValue = value
+	}
+	if value, ok := _c.mutation.ScheduledFor(); ok {
+		_spec.SetField(order.FieldScheduledFor, field.TypeTime, value)
+		_node.ScheduledFor = &value
+	}
+	if value, ok := _c.mutation.ReleaseAt(); ok {
+		_spec.SetField(order.FieldReleaseAt, field.TypeTime, value)
+		_node.ReleaseAt = &value
+	}
+	if value, ok := _c.mutation.ReleasedAt(); ok {
+		_spec.SetField(order.FieldReleasedAt, field.TypeTime, value)
+		_node.ReleasedAt = &value
+	}
+	if value, ok := _c.mutation.ItemsVersion(); ok {
+		_spec.SetField(order.FieldItemsVersion, field.TypeInt, value)
+		_node.ItemsVersion = value
+	}
 	if nodes := _c.mutation.RestaurantIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -283,6 +952,137 @@ func (_c *OrderCreate) createSpec() (*Order, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.StatusEventsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   order.StatusEventsTable,
+			Columns: []string{order.StatusEventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(orderstatusevent.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.ItemChangesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   order.ItemChangesTable,
+			Columns: []string{order.ItemChangesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(orderitemchange.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.PaymentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   order.PaymentsTable,
+			Columns: []string{order.PaymentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(payment.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.RefundsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   order.RefundsTable,
+			Columns: []string{order.RefundsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(refund.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.StationTicketsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   order.StationTicketsTable,
+			Columns: []string{order.StationTicketsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(stationticket.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.TableIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   order.TableTable,
+			Columns: []string{order.TableColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(table.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.TableID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.TableSessionIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   order.TableSessionTable,
+			Columns: []string{order.TableSessionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tablesession.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.TableSessionID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.DeliveryZoneIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   order.DeliveryZoneTable,
+			Columns: []string{order.DeliveryZoneColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(deliveryzone.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.DeliveryZoneID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
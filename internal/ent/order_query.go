@@ -12,22 +12,38 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/Jiruu246/rms/internal/ent/deliveryzone"
 	"github.com/Jiruu246/rms/internal/ent/order"
 	"github.com/Jiruu246/rms/internal/ent/orderitem"
+	"github.com/Jiruu246/rms/internal/ent/orderitemchange"
+	"github.com/Jiruu246/rms/internal/ent/orderstatusevent"
+	"github.com/Jiruu246/rms/internal/ent/payment"
 	"github.com/Jiruu246/rms/internal/ent/predicate"
+	"github.com/Jiruu246/rms/internal/ent/refund"
 	"github.com/Jiruu246/rms/internal/ent/restaurant"
+	"github.com/Jiruu246/rms/internal/ent/stationticket"
+	"github.com/Jiruu246/rms/internal/ent/table"
+	"github.com/Jiruu246/rms/internal/ent/tablesession"
 	"github.com/google/uuid"
 )
 
 // OrderQuery is the builder for querying Order entities.
 type OrderQuery struct {
 	config
-	ctx            *QueryContext
-	order          []order.OrderOption
-	inters         []Interceptor
-	predicates     []predicate.Order
-	withRestaurant *RestaurantQuery
-	withOrderItems *OrderItemQuery
+	ctx                *QueryContext
+	order              []order.OrderOption
+	inters             []Interceptor
+	predicates         []predicate.Order
+	withRestaurant     *RestaurantQuery
+	withOrderItems     *OrderItemQuery
+	withStatusEvents   *OrderStatusEventQuery
+	withItemChanges    *OrderItemChangeQuery
+	withPayments       *PaymentQuery
+	withRefunds        *RefundQuery
+	withStationTickets *StationTicketQuery
+	withTable          *TableQuery
+	withTableSession   *TableSessionQuery
+	withDeliveryZone   *DeliveryZoneQuery
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -108,6 +124,182 @@ func (_q *OrderQuery) QueryOrderItems() *OrderItemQuery {
 	return query
 }
 
+// QueryStatusEvents chains the current query on the "status_events" edge.
+func (_q *OrderQuery) QueryStatusEvents() *OrderStatusEventQuery {
+	query := (&OrderStatusEventClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(order.Table, order.FieldID, selector),
+			sqlgraph.To(orderstatusevent.Table, orderstatusevent.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, order.StatusEventsTable, order.StatusEventsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryItemChanges chains the current query on the "item_changes" edge.
+func (_q *OrderQuery) QueryItemChanges() *OrderItemChangeQuery {
+	query := (&OrderItemChangeClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(order.Table, order.FieldID, selector),
+			sqlgraph.To(orderitemchange.Table, orderitemchange.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, order.ItemChangesTable, order.ItemChangesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryPayments chains the current query on the "payments" edge.
+func (_q *OrderQuery) QueryPayments() *PaymentQuery {
+	query := (&PaymentClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(order.Table, order.FieldID, selector),
+			sqlgraph.To(payment.Table, payment.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, order.PaymentsTable, order.PaymentsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryRefunds chains the current query on the "refunds" edge.
+func (_q *OrderQuery) QueryRefunds() *RefundQuery {
+	query := (&RefundClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(order.Table, order.FieldID, selector),
+			sqlgraph.To(refund.Table, refund.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, order.RefundsTable, order.RefundsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryStationTickets chains the current query on the "station_tickets" edge.
+func (_q *OrderQuery) QueryStationTickets() *StationTicketQuery {
+	query := (&StationTicketClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(order.Table, order.FieldID, selector),
+			sqlgraph.To(stationticket.Table, stationticket.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, order.StationTicketsTable, order.StationTicketsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryTable chains the current query on the "table" edge.
+func (_q *OrderQuery) QueryTable() *TableQuery {
+	query := (&TableClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(order.Table, order.FieldID, selector),
+			sqlgraph.To(table.Table, table.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, order.TableTable, order.TableColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryTableSession chains the current query on the "table_session" edge.
+func (_q *OrderQuery) QueryTableSession() *TableSessionQuery {
+	query := (&TableSessionClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(order.Table, order.FieldID, selector),
+			sqlgraph.To(tablesession.Table, tablesession.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, order.TableSessionTable, order.TableSessionColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryDeliveryZone chains the current query on the "delivery_zone" edge.
+func (_q *OrderQuery) QueryDeliveryZone() *DeliveryZoneQuery {
+	query := (&DeliveryZoneClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(order.Table, order.FieldID, selector),
+			sqlgraph.To(deliveryzone.Table, deliveryzone.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, order.DeliveryZoneTable, order.DeliveryZoneColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Order entity from the query.
 // Returns a *NotFoundError when no Order was found.
 func (_q *OrderQuery) First(ctx context.Context) (*Order, error) {
@@ -295,13 +487,21 @@ func (_q *OrderQuery) Clone() *OrderQuery {
 		return nil
 	}
 	return &OrderQuery{
-		config:         _q.config,
-		ctx:            _q.ctx.Clone(),
-		order:          append([]order.OrderOption{}, _q.order...),
-		inters:         append([]Interceptor{}, _q.inters...),
-		predicates:     append([]predicate.Order{}, _q.predicates...),
-		withRestaurant: _q.withRestaurant.Clone(),
-		withOrderItems: _q.withOrderItems.Clone(),
+		config:             _q.config,
+		ctx:                _q.ctx.Clone(),
+		order:              append([]order.OrderOption{}, _q.order...),
+		inters:             append([]Interceptor{}, _q.inters...),
+		predicates:         append([]predicate.Order{}, _q.predicates...),
+		withRestaurant:     _q.withRestaurant.Clone(),
+		withOrderItems:     _q.withOrderItems.Clone(),
+		withStatusEvents:   _q.withStatusEvents.Clone(),
+		withItemChanges:    _q.withItemChanges.Clone(),
+		withPayments:       _q.withPayments.Clone(),
+		withRefunds:        _q.withRefunds.Clone(),
+		withStationTickets: _q.withStationTickets.Clone(),
+		withTable:          _q.withTable.Clone(),
+		withTableSession:   _q.withTableSession.Clone(),
+		withDeliveryZone:   _q.withDeliveryZone.Clone(),
 		// clone intermediate query.
 		sql:  _q.sql.Clone(),
 		path: _q.path,
@@ -330,6 +530,94 @@ func (_q *OrderQuery) WithOrderItems(opts ...func(*OrderItemQuery)) *OrderQuery
 	return _q
 }
 
+// WithStatusEvents tells the query-builder to eager-load the nodes that are connected to
+// the "status_events" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *OrderQuery) WithStatusEvents(opts ...func(*OrderStatusEventQuery)) *OrderQuery {
+	query := (&OrderStatusEventClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withStatusEvents = query
+	return _q
+}
+
+// WithItemChanges tells the query-builder to eager-load the nodes that are connected to
+// the "item_changes" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *OrderQuery) WithItemChanges(opts ...func(*OrderItemChangeQuery)) *OrderQuery {
+	query := (&OrderItemChangeClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withItemChanges = query
+	return _q
+}
+
+// WithPayments tells the query-builder to eager-load the nodes that are connected to
+// the "payments" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *OrderQuery) WithPayments(opts ...func(*PaymentQuery)) *OrderQuery {
+	query := (&PaymentClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withPayments = query
+	return _q
+}
+
+// WithRefunds tells the query-builder to eager-load the nodes that are connected to
+// the "refunds" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *OrderQuery) WithRefunds(opts ...func(*RefundQuery)) *OrderQuery {
+	query := (&RefundClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withRefunds = query
+	return _q
+}
+
+// WithStationTickets tells the query-builder to eager-load the nodes that are connected to
+// the "station_tickets" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *OrderQuery) WithStationTickets(opts ...func(*StationTicketQuery)) *OrderQuery {
+	query := (&StationTicketClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withStationTickets = query
+	return _q
+}
+
+// WithTable tells the query-builder to eager-load the nodes that are connected to
+// the "table" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *OrderQuery) WithTable(opts ...func(*TableQuery)) *OrderQuery {
+	query := (&TableClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withTable = query
+	return _q
+}
+
+// WithTableSession tells the query-builder to eager-load the nodes that are connected to
+// the "table_session" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *OrderQuery) WithTableSession(opts ...func(*TableSessionQuery)) *OrderQuery {
+	query := (&TableSessionClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withTableSession = query
+	return _q
+}
+
+// WithDeliveryZone tells the query-builder to eager-load the nodes that are connected to
+// the "delivery_zone" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *OrderQuery) WithDeliveryZone(opts ...func(*DeliveryZoneQuery)) *OrderQuery {
+	query := (&DeliveryZoneClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withDeliveryZone = query
+	return _q
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -408,9 +696,17 @@ func (_q *OrderQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Order,
 	var (
 		nodes       = []*Order{}
 		_spec       = _q.querySpec()
-		loadedTypes = [2]bool{
+		loadedTypes = [10]bool{
 			_q.withRestaurant != nil,
 			_q.withOrderItems != nil,
+			_q.withStatusEvents != nil,
+			_q.withItemChanges != nil,
+			_q.withPayments != nil,
+			_q.withRefunds != nil,
+			_q.withStationTickets != nil,
+			_q.withTable != nil,
+			_q.withTableSession != nil,
+			_q.withDeliveryZone != nil,
 		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
@@ -444,6 +740,59 @@ func (_q *OrderQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Order,
 			return nil, err
 		}
 	}
+	if query := _q.withStatusEvents; query != nil {
+		if err := _q.loadStatusEvents(ctx, query, nodes,
+			func(n *Order) { n.Edges.StatusEvents = []*OrderStatusEvent{} },
+			func(n *Order, e *OrderStatusEvent) { n.Edges.StatusEvents = append(n.Edges.StatusEvents, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withItemChanges; query != nil {
+		if err := _q.loadItemChanges(ctx, query, nodes,
+			func(n *Order) { n.Edges.ItemChanges = []*OrderItemChange{} },
+			func(n *Order, e *OrderItemChange) { n.Edges.ItemChanges = append(n.Edges.ItemChanges, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withPayments; query != nil {
+		if err := _q.loadPayments(ctx, query, nodes,
+			func(n *Order) { n.Edges.Payments = []*Payment{} },
+			func(n *Order, e *Payment) { n.Edges.Payments = append(n.Edges.Payments, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withRefunds; query != nil {
+		if err := _q.loadRefunds(ctx, query, nodes,
+			func(n *Order) { n.Edges.Refunds = []*Refund{} },
+			func(n *Order, e *Refund) { n.Edges.Refunds = append(n.Edges.Refunds, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withStationTickets; query != nil {
+		if err := _q.loadStationTickets(ctx, query, nodes,
+			func(n *Order) { n.Edges.StationTickets = []*StationTicket{} },
+			func(n *Order, e *StationTicket) { n.Edges.StationTickets = append(n.Edges.StationTickets, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withTable; query != nil {
+		if err := _q.loadTable(ctx, query, nodes, nil,
+			func(n *Order, e *Table) { n.Edges.Table = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withTableSession; query != nil {
+		if err := _q.loadTableSession(ctx, query, nodes, nil,
+			func(n *Order, e *TableSession) { n.Edges.TableSession = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withDeliveryZone; query != nil {
+		if err := _q.loadDeliveryZone(ctx, query, nodes, nil,
+			func(n *Order, e *DeliveryZone) { n.Edges.DeliveryZone = e }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -506,6 +855,252 @@ func (_q *OrderQuery) loadOrderItems(ctx context.Context, query *OrderItemQuery,
 	}
 	return nil
 }
+func (_q *OrderQuery) loadStatusEvents(ctx context.Context, query *OrderStatusEventQuery, nodes []*Order, init func(*Order), assign func(*Order, *OrderStatusEvent)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Order)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(orderstatusevent.FieldOrderID)
+	}
+	query.Where(predicate.OrderStatusEvent(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(order.StatusEventsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.OrderID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "order_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *OrderQuery) loadItemChanges(ctx context.Context, query *OrderItemChangeQuery, nodes []*Order, init func(*Order), assign func(*Order, *OrderItemChange)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Order)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(orderitemchange.FieldOrderID)
+	}
+	query.Where(predicate.OrderItemChange(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(order.ItemChangesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.OrderID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "order_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *OrderQuery) loadPayments(ctx context.Context, query *PaymentQuery, nodes []*Order, init func(*Order), assign func(*Order, *Payment)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Order)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(payment.FieldOrderID)
+	}
+	query.Where(predicate.Payment(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(order.PaymentsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.OrderID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "order_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *OrderQuery) loadRefunds(ctx context.Context, query *RefundQuery, nodes []*Order, init func(*Order), assign func(*Order, *Refund)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Order)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(refund.FieldOrderID)
+	}
+	query.Where(predicate.Refund(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(order.RefundsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.OrderID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "order_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *OrderQuery) loadStationTickets(ctx context.Context, query *StationTicketQuery, nodes []*Order, init func(*Order), assign func(*Order, *StationTicket)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Order)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(stationticket.FieldOrderID)
+	}
+	query.Where(predicate.StationTicket(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(order.StationTicketsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.OrderID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "order_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *OrderQuery) loadTable(ctx context.Context, query *TableQuery, nodes []*Order, init func(*Order), assign func(*Order, *Table)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*Order)
+	for i := range nodes {
+		if nodes[i].TableID == nil {
+			continue
+		}
+		fk := *nodes[i].TableID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(table.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "table_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (_q *OrderQuery) loadTableSession(ctx context.Context, query *TableSessionQuery, nodes []*Order, init func(*Order), assign func(*Order, *TableSession)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*Order)
+	for i := range nodes {
+		if nodes[i].TableSessionID == nil {
+			continue
+		}
+		fk := *nodes[i].TableSessionID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(tablesession.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "table_session_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (_q *OrderQuery) loadDeliveryZone(ctx context.Context, query *DeliveryZoneQuery, nodes []*Order, init func(*Order), assign func(*Order, *DeliveryZone)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*Order)
+	for i := range nodes {
+		if nodes[i].DeliveryZoneID == nil {
+			continue
+		}
+		fk := *nodes[i].DeliveryZoneID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(deliveryzone.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "delivery_zone_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
 
 func (_q *OrderQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := _q.querySpec()
@@ -535,6 +1130,15 @@ func (_q *OrderQuery) querySpec() *sqlgraph.QuerySpec {
 		if _q.withRestaurant != nil {
 			_spec.Node.AddColumnOnce(order.FieldRestaurantID)
 		}
+		if _q.withTable != nil {
+			_spec.Node.AddColumnOnce(order.FieldTableID)
+		}
+		if _q.withTableSession != nil {
+			_spec.Node.AddColumnOnce(order.FieldTableSessionID)
+		}
+		if _q.withDeliveryZone != nil {
+			_spec.Node.AddColumnOnce(order.FieldDeliveryZoneID)
+		}
 	}
 	if ps := _q.predicates; len(ps) > 0 {
 		_spec.Predicate = func(selector *sql.Selector) {
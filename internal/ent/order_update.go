@@ -11,10 +11,18 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/Jiruu246/rms/internal/ent/deliveryzone"
 	"github.com/Jiruu246/rms/internal/ent/order"
 	"github.com/Jiruu246/rms/internal/ent/orderitem"
+	"github.com/Jiruu246/rms/internal/ent/orderitemchange"
+	"github.com/Jiruu246/rms/internal/ent/orderstatusevent"
+	"github.com/Jiruu246/rms/internal/ent/payment"
 	"github.com/Jiruu246/rms/internal/ent/predicate"
+	"github.com/Jiruu246/rms/internal/ent/refund"
 	"github.com/Jiruu246/rms/internal/ent/restaurant"
+	"github.com/Jiruu246/rms/internal/ent/stationticket"
+	"github.com/Jiruu246/rms/internal/ent/table"
+	"github.com/Jiruu246/rms/internal/ent/tablesession"
 	"github.com/google/uuid"
 )
 
@@ -79,6 +87,132 @@ func (_u *OrderUpdate) SetNillablePaymentStatus(v *order.PaymentStatus) *OrderUp
 	return _u
 }
 
+// SetSubtotal sets the "subtotal" field.
+func (_u *OrderUpdate) SetSubtotal(v int64) *OrderUpdate {
+	_u.mutation.ResetSubtotal()
+	_u.mutation.SetSubtotal(v)
+	return _u
+}
+
+// SetNillableSubtotal sets the "subtotal" field if the given value is not nil.
+func (_u *OrderUpdate) SetNillableSubtotal(v *int64) *OrderUpdate {
+	if v != nil {
+		_u.SetSubtotal(*v)
+	}
+	return _u
+}
+
+// AddSubtotal adds value to the "subtotal" field.
+func (_u *OrderUpdate) AddSubtotal(v int64) *OrderUpdate {
+	_u.mutation.AddSubtotal(v)
+	return _u
+}
+
+// SetModifiersTotal sets the "modifiers_total" field.
+func (_u *OrderUpdate) SetModifiersTotal(v int64) *OrderUpdate {
+	_u.mutation.ResetModifiersTotal()
+	_u.mutation.SetModifiersTotal(v)
+	return _u
+}
+
+// SetNillableModifiersTotal sets the "modifiers_total" field if the given value is not nil.
+func (_u *OrderUpdate) SetNillableModifiersTotal(v *int64) *OrderUpdate {
+	if v != nil {
+		_u.SetModifiersTotal(*v)
+	}
+	return _u
+}
+
+// AddModifiersTotal adds value to the "modifiers_total" field.
+func (_u *OrderUpdate) AddModifiersTotal(v int64) *OrderUpdate {
+	_u.mutation.AddModifiersTotal(v)
+	return _u
+}
+
+// SetTaxTotal sets the "tax_total" field.
+func (_u *OrderUpdate) SetTaxTotal(v int64) *OrderUpdate {
+	_u.mutation.ResetTaxTotal()
+	_u.mutation.SetTaxTotal(v)
+	return _u
+}
+
+// SetNillableTaxTotal sets the "tax_total" field if the given value is not nil.
+func (_u *OrderUpdate) SetNillableTaxTotal(v *int64) *OrderUpdate {
+	if v != nil {
+		_u.SetTaxTotal(*v)
+	}
+	return _u
+}
+
+// AddTaxTotal adds value to the "tax_total" field.
+func (_u *OrderUpdate) AddTaxTotal(v int64) *OrderUpdate {
+	_u.mutation.AddTaxTotal(v)
+	return _u
+}
+
+// SetTotal sets the "total" field.
+func (_u *OrderUpdate) SetTotal(v int64) *OrderUpdate {
+	_u.mutation.ResetTotal()
+	_u.mutation.SetTotal(v)
+	return _u
+}
+
+// SetNillableTotal sets the "total" field if the given value is not nil.
+func (_u *OrderUpdate) SetNillableTotal(v *int64) *OrderUpdate {
+	if v != nil {
+		_u.SetTotal(*v)
+	}
+	return _u
+}
+
+// AddTotal adds value to the "total" field.
+func (_u *OrderUpdate) AddTotal(v int64) *OrderUpdate {
+	_u.mutation.AddTotal(v)
+	return _u
+}
+
+// SetAmountPaid sets the "amount_paid" field.
+func (_u *OrderUpdate) SetAmountPaid(v int64) *OrderUpdate {
+	_u.mutation.ResetAmountPaid()
+	_u.mutation.SetAmountPaid(v)
+	return _u
+}
+
+// SetNillableAmountPaid sets the "amount_paid" field if the given value is not nil.
+func (_u *OrderUpdate) SetNillableAmountPaid(v *int64) *OrderUpdate {
+	if v != nil {
+		_u.SetAmountPaid(*v)
+	}
+	return _u
+}
+
+// AddAmountPaid adds value to the "amount_paid" field.
+func (_u *OrderUpdate) AddAmountPaid(v int64) *OrderUpdate {
+	_u.mutation.AddAmountPaid(v)
+	return _u
+}
+
+// SetAmountRefunded sets the "amount_refunded" field.
+func (_u *OrderUpdate) SetAmountRefunded(v int64) *OrderUpdate {
+	_u.mutation.ResetAmountRefunded()
+	_u.mutation.SetAmountRefunded(v)
+	return _u
+}
+
+// SetNillableAmountRefunded sets the "amount_refunded" field if the given value is not nil.
+func (_u *OrderUpdate) SetNillableAmountRefunded(v *int64) *OrderUpdate {
+	if v != nil {
+		_u.SetAmountRefunded(*v)
+	}
+	return _u
+}
+
+// AddAmountRefunded adds value to the "amount_refunded" field.
+func (_u *OrderUpdate) AddAmountRefunded(v int64) *OrderUpdate {
+	_u.mutation.AddAmountRefunded(v)
+	return _u
+}
+
 // SetRestaurantID sets the "restaurant_id" field.
 func (_u *OrderUpdate) SetRestaurantID(v uuid.UUID) *OrderUpdate {
 	_u.mutation.SetRestaurantID(v)
@@ -93,6 +227,107 @@ func (_u *OrderUpdate) SetNillableRestaurantID(v *uuid.UUID) *OrderUpdate {
 	return _u
 }
 
+// SetTableID sets the "table_id" field.
+func (_u *OrderUpdate) SetTableID(v uuid.UUID) *OrderUpdate {
+	_u.mutation.SetTableID(v)
+	return _u
+}
+
+// SetNillableTableID sets the "table_id" field if the given value is not nil.
+func (_u *OrderUpdate) SetNillableTableID(v *uuid.UUID) *OrderUpdate {
+	if v != nil {
+		_u.SetTableID(*v)
+	}
+	return _u
+}
+
+// ClearTableID clears the value of the "table_id" field.
+func (_u *OrderUpdate) ClearTableID() *OrderUpdate {
+	_u.mutation.ClearTableID()
+	return _u
+}
+
+// SetTableSessionID sets the "table_session_id" field.
+func (_u *OrderUpdate) SetTableSessionID(v uuid.UUID) *OrderUpdate {
+	_u.mutation.SetTableSessionID(v)
+	return _u
+}
+
+// SetNillableTableSessionID sets the "table_session_id" field if the given value is not nil.
+func (_u *OrderUpdate) SetNillableTableSessionID(v *uuid.UUID) *OrderUpdate {
+	if v != nil {
+		_u.SetTableSessionID(*v)
+	}
+	return _u
+}
+
+// ClearTableSessionID clears the value of the "table_session_id" field.
+func (_u *OrderUpdate) ClearTableSessionID() *OrderUpdate {
+	_u.mutation.ClearTableSessionID()
+	return _u
+}
+
+// SetDeliveryZoneID sets the "delivery_zone_id" field.
+func (_u *OrderUpdate) SetDeliveryZoneID(v uuid.UUID) *OrderUpdate {
+	_u.mutation.SetDeliveryZoneID(v)
+	return _u
+}
+
+// SetNillableDeliveryZoneID sets the "delivery_zone_id" field if the given value is not nil.
+func (_u *OrderUpdate) SetNillableDeliveryZoneID(v *uuid.UUID) *OrderUpdate {
+	if v != nil {
+		_u.SetDeliveryZoneID(*v)
+	}
+	return _u
+}
+
+// ClearDeliveryZoneID clears the value of the "delivery_zone_id" field.
+func (_u *OrderUpdate) ClearDeliveryZoneID() *OrderUpdate {
+	_u.mutation.ClearDeliveryZoneID()
+	return _u
+}
+
+// SetReleasedAt sets the "released_at" field.
+func (_u *OrderUpdate) SetReleasedAt(v time.Time) *OrderUpdate {
+	_u.mutation.SetReleasedAt(v)
+	return _u
+}
+
+// SetNillableReleasedAt sets the "released_at" field if the given value is not nil.
+func (_u *OrderUpdate) SetNillableReleasedAt(v *time.Time) *OrderUpdate {
+	if v != nil {
+		_u.SetReleasedAt(*v)
+	}
+	return _u
+}
+
+// ClearReleasedAt clears the value of the "released_at" field.
+func (_u *OrderUpdate) ClearReleasedAt() *OrderUpdate {
+	_u.mutation.ClearReleasedAt()
+	return _u
+}
+
+// SetItemsVersion sets the "items_version" field.
+func (_u *OrderUpdate) SetItemsVersion(v int) *OrderUpdate {
+	_u.mutation.ResetItemsVersion()
+	_u.mutation.SetItemsVersion(v)
+	return _u
+}
+
+// SetNillableItemsVersion sets the "items_version" field if the given value is not nil.
+func (_u *OrderUpdate) SetNillableItemsVersion(v *int) *OrderUpdate {
+	if v != nil {
+		_u.SetItemsVersion(*v)
+	}
+	return _u
+}
+
+// AddItemsVersion adds value to the "items_version" field.
+func (_u *OrderUpdate) AddItemsVersion(v int) *OrderUpdate {
+	_u.mutation.AddItemsVersion(v)
+	return _u
+}
+
 // SetRestaurant sets the "restaurant" edge to the Restaurant entity.
 func (_u *OrderUpdate) SetRestaurant(v *Restaurant) *OrderUpdate {
 	return _u.SetRestaurantID(v.ID)
@@ -113,6 +348,96 @@ func (_u *OrderUpdate) AddOrderItems(v ...*OrderItem) *OrderUpdate {
 	return _u.AddOrderItemIDs(ids...)
 }
 
+// AddStatusEventIDs adds the "status_events" edge to the OrderStatusEvent entity by IDs.
+func (_u *OrderUpdate) AddStatusEventIDs(ids ...uuid.UUID) *OrderUpdate {
+	_u.mutation.AddStatusEventIDs(ids...)
+	return _u
+}
+
+// AddStatusEvents adds the "status_events" edges to the OrderStatusEvent entity.
+func (_u *OrderUpdate) AddStatusEvents(v ...*OrderStatusEvent) *OrderUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddStatusEventIDs(ids...)
+}
+
+// AddItemChangeIDs adds the "item_changes" edge to the OrderItemChange entity by IDs.
+func (_u *OrderUpdate) AddItemChangeIDs(ids ...uuid.UUID) *OrderUpdate {
+	_u.mutation.AddItemChangeIDs(ids...)
+	return _u
+}
+
+// AddItemChanges adds the "item_changes" edges to the OrderItemChange entity.
+func (_u *OrderUpdate) AddItemChanges(v ...*OrderItemChange) *OrderUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddItemChangeIDs(ids...)
+}
+
+// AddPaymentIDs adds the "payments" edge to the Payment entity by IDs.
+func (_u *OrderUpdate) AddPaymentIDs(ids ...uuid.UUID) *OrderUpdate {
+	_u.mutation.AddPaymentIDs(ids...)
+	return _u
+}
+
+// AddPayments adds the "payments" edges to the Payment entity.
+func (_u *OrderUpdate) AddPayments(v ...*Payment) *OrderUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddPaymentIDs(ids...)
+}
+
+// AddRefundIDs adds the "refunds" edge to the Refund entity by IDs.
+func (_u *OrderUpdate) AddRefundIDs(ids ...uuid.UUID) *OrderUpdate {
+	_u.mutation.AddRefundIDs(ids...)
+	return _u
+}
+
+// AddRefunds adds the "refunds" edges to the Refund entity.
+func (_u *OrderUpdate) AddRefunds(v ...*Refund) *OrderUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddRefundIDs(ids...)
+}
+
+// AddStationTicketIDs adds the "station_tickets" edge to the StationTicket entity by IDs.
+func (_u *OrderUpdate) AddStationTicketIDs(ids ...uuid.UUID) *OrderUpdate {
+	_u.mutation.AddStationTicketIDs(ids...)
+	return _u
+}
+
+// AddStationTickets adds the "station_tickets" edges to the StationTicket entity.
+func (_u *OrderUpdate) AddStationTickets(v ...*StationTicket) *OrderUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddStationTicketIDs(ids...)
+}
+
+// SetTable sets the "table" edge to the Table entity.
+func (_u *OrderUpdate) SetTable(v *Table) *OrderUpdate {
+	return _u.SetTableID(v.ID)
+}
+
+// SetTableSession sets the "table_session" edge to the TableSession entity.
+func (_u *OrderUpdate) SetTableSession(v *TableSession) *OrderUpdate {
+	return _u.SetTableSessionID(v.ID)
+}
+
+// SetDeliveryZone sets the "delivery_zone" edge to the DeliveryZone entity.
+func (_u *OrderUpdate) SetDeliveryZone(v *DeliveryZone) *OrderUpdate {
+	return _u.SetDeliveryZoneID(v.ID)
+}
+
 // Mutation returns the OrderMutation object of the builder.
 func (_u *OrderUpdate) Mutation() *OrderMutation {
 	return _u.mutation
@@ -145,6 +470,129 @@ func (_u *OrderUpdate) RemoveOrderItems(v ...*OrderItem) *OrderUpdate {
 	return _u.RemoveOrderItemIDs(ids...)
 }
 
+// ClearStatusEvents clears all "status_events" edges to the OrderStatusEvent entity.
+func (_u *OrderUpdate) ClearStatusEvents() *OrderUpdate {
+	_u.mutation.ClearStatusEvents()
+	return _u
+}
+
+// RemoveStatusEventIDs removes the "status_events" edge to OrderStatusEvent entities by IDs.
+func (_u *OrderUpdate) RemoveStatusEventIDs(ids ...uuid.UUID) *OrderUpdate {
+	_u.mutation.RemoveStatusEventIDs(ids...)
+	return _u
+}
+
+// RemoveStatusEvents removes "status_events" edges to OrderStatusEvent entities.
+func (_u *OrderUpdate) RemoveStatusEvents(v ...*OrderStatusEvent) *OrderUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveStatusEventIDs(ids...)
+}
+
+// ClearItemChanges clears all "item_changes" edges to the OrderItemChange entity.
+func (_u *OrderUpdate) ClearItemChanges() *OrderUpdate {
+	_u.mutation.ClearItemChanges()
+	return _u
+}
+
+// RemoveItemChangeIDs removes the "item_changes" edge to OrderItemChange entities by IDs.
+func (_u *OrderUpdate) RemoveItemChangeIDs(ids ...uuid.UUID) *OrderUpdate {
+	_u.mutation.RemoveItemChangeIDs(ids...)
+	return _u
+}
+
+// RemoveItemChanges removes "item_changes" edges to OrderItemChange entities.
+func (_u *OrderUpdate) RemoveItemChanges(v ...*OrderItemChange) *OrderUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveItemChangeIDs(ids...)
+}
+
+// ClearPayments clears all "payments" edges to the Payment entity.
+func (_u *OrderUpdate) ClearPayments() *OrderUpdate {
+	_u.mutation.ClearPayments()
+	return _u
+}
+
+// RemovePaymentIDs removes the "payments" edge to Payment entities by IDs.
+func (_u *OrderUpdate) RemovePaymentIDs(ids ...uuid.UUID) *OrderUpdate {
+	_u.mutation.RemovePaymentIDs(ids...)
+	return _u
+}
+
+// RemovePayments removes "payments" edges to Payment entities.
+func (_u *OrderUpdate) RemovePayments(v ...*Payment) *OrderUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemovePaymentIDs(ids...)
+}
+
+// ClearRefunds clears all "refunds" edges to the Refund entity.
+func (_u *OrderUpdate) ClearRefunds() *OrderUpdate {
+	_u.mutation.ClearRefunds()
+	return _u
+}
+
+// RemoveRefundIDs removes the "refunds" edge to Refund entities by IDs.
+func (_u *OrderUpdate) RemoveRefundIDs(ids ...uuid.UUID) *OrderUpdate {
+	_u.mutation.RemoveRefundIDs(ids...)
+	return _u
+}
+
+// RemoveRefunds removes "refunds" edges to Refund entities.
+func (_u *OrderUpdate) RemoveRefunds(v ...*Refund) *OrderUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveRefundIDs(ids...)
+}
+
+// ClearStationTickets clears all "station_tickets" edges to the StationTicket entity.
+func (_u *OrderUpdate) ClearStationTickets() *OrderUpdate {
+	_u.mutation.ClearStationTickets()
+	return _u
+}
+
+// RemoveStationTicketIDs removes the "station_tickets" edge to StationTicket entities by IDs.
+func (_u *OrderUpdate) RemoveStationTicketIDs(ids ...uuid.UUID) *OrderUpdate {
+	_u.mutation.RemoveStationTicketIDs(ids...)
+	return _u
+}
+
+// RemoveStationTickets removes "station_tickets" edges to StationTicket entities.
+func (_u *OrderUpdate) RemoveStationTickets(v ...*StationTicket) *OrderUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveStationTicketIDs(ids...)
+}
+
+// ClearTable clears the "table" edge to the Table entity.
+func (_u *OrderUpdate) ClearTable() *OrderUpdate {
+	_u.mutation.ClearTable()
+	return _u
+}
+
+// ClearTableSession clears the "table_session" edge to the TableSession entity.
+func (_u *OrderUpdate) ClearTableSession() *OrderUpdate {
+	_u.mutation.ClearTableSession()
+	return _u
+}
+
+// ClearDeliveryZone clears the "delivery_zone" edge to the DeliveryZone entity.
+func (_u *OrderUpdate) ClearDeliveryZone() *OrderUpdate {
+	_u.mutation.ClearDeliveryZone()
+	return _u
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (_u *OrderUpdate) Save(ctx context.Context) (int, error) {
 	_u.defaults()
@@ -198,6 +646,36 @@ func (_u *OrderUpdate) check() error {
 			return &ValidationError{Name: "payment_status", err: fmt.Errorf(`ent: validator failed for field "Order.payment_status": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.Subtotal(); ok {
+		if err := order.SubtotalValidator(v); err != nil {
+			return &ValidationError{Name: "subtotal", err: fmt.Errorf(`ent: validator failed for field "Order.subtotal": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ModifiersTotal(); ok {
+		if err := order.ModifiersTotalValidator(v); err != nil {
+			return &ValidationError{Name: "modifiers_total", err: fmt.Errorf(`ent: validator failed for field "Order.modifiers_total": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.TaxTotal(); ok {
+		if err := order.TaxTotalValidator(v); err != nil {
+			return &ValidationError{Name: "tax_total", err: fmt.Errorf(`ent: validator failed for field "Order.tax_total": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Total(); ok {
+		if err := order.TotalValidator(v); err != nil {
+			return &ValidationError{Name: "total", err: fmt.Errorf(`ent: validator failed for field "Order.total": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.AmountPaid(); ok {
+		if err := order.AmountPaidValidator(v); err != nil {
+			return &ValidationError{Name: "amount_paid", err: fmt.Errorf(`ent: validator failed for field "Order.amount_paid": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.AmountRefunded(); ok {
+		if err := order.AmountRefundedValidator(v); err != nil {
+			return &ValidationError{Name: "amount_refunded", err: fmt.Errorf(`ent: validator failed for field "Order.amount_refunded": %w`, err)}
+		}
+	}
 	if _u.mutation.RestaurantCleared() && len(_u.mutation.RestaurantIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "Order.restaurant"`)
 	}
@@ -219,6 +697,9 @@ func (_u *OrderUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if value, ok := _u.mutation.UpdateTime(); ok {
 		_spec.SetField(order.FieldUpdateTime, field.TypeTime, value)
 	}
+	if _u.mutation.OrderNumberCleared() {
+		_spec.ClearField(order.FieldOrderNumber, field.TypeInt)
+	}
 	if value, ok := _u.mutation.OrderType(); ok {
 		_spec.SetField(order.FieldOrderType, field.TypeEnum, value)
 	}
@@ -228,8 +709,71 @@ func (_u *OrderUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if value, ok := _u.mutation.PaymentStatus(); ok {
 		_spec.SetField(order.FieldPaymentStatus, field.TypeEnum, value)
 	}
-	if _u.mutation.RestaurantCleared() {
-		edge := &sqlgraph.EdgeSpec{
+	if value, ok := _u.mutation.Subtotal(); ok {
+		_spec.SetField(order.FieldSubtotal, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedSubtotal(); ok {
+		_spec.AddField(order.FieldSubtotal, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.ModifiersTotal(); ok {
+		_spec.SetField(order.FieldModifiersTotal, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedModifiersTotal(); ok {
+		_spec.AddField(order.FieldModifiersTotal, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.TaxTotal(); ok {
+		_spec.SetField(order.FieldTaxTotal, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedTaxTotal(); ok {
+		_spec.AddField(order.FieldTaxTotal, field.TypeInt64, value)
+	}
+	if _u.mutation.TaxRateBpsCleared() {
+		_spec.ClearField(order.FieldTaxRateBps, field.TypeInt)
+	}
+	if value, ok := _u.mutation.Total(); ok {
+		_spec.SetField(order.FieldTotal, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedTotal(); ok {
+		_spec.AddField(order.FieldTotal, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AmountPaid(); ok {
+		_spec.SetField(order.FieldAmountPaid, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedAmountPaid(); ok {
+		_spec.AddField(order.FieldAmountPaid, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AmountRefunded(); ok {
+		_spec.SetField(order.FieldAmountRefunded, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedAmountRefunded(); ok {
+		_spec.AddField(order.FieldAmountRefunded, field.TypeInt64, value)
+	}
+	if _u.mutation.DeliveryLatitudeCleared() {
+		_spec.ClearField(order.FieldDeliveryLatitude, field.TypeFloat64)
+	}
+	if _u.mutation.DeliveryLongitudeCleared() {
+		_spec.ClearField(order.FieldDeliveryLongitude, field.TypeFloat64)
+	}
+	if _u.mutation.ScheduledForCleared() {
+		_spec.ClearField(order.FieldScheduledFor, field.TypeTime)
+	}
+	if _u.mutation.ReleaseAtCleared() {
+		_spec.ClearField(order.FieldReleaseAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.ReleasedAt(); ok {
+		_spec.SetField(order.FieldReleasedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ReleasedAtCleared() {
+		_spec.ClearField(order.FieldReleasedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.ItemsVersion(); ok {
+		_spec.SetField(order.FieldItemsVersion, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedItemsVersion(); ok {
+		_spec.AddField(order.FieldItemsVersion, field.TypeInt, value)
+	}
+	if _u.mutation.RestaurantCleared() {
+		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
 			Inverse: true,
 			Table:   order.RestaurantTable,
@@ -302,6 +846,318 @@ func (_u *OrderUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.StatusEventsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   order.StatusEventsTable,
+			Columns: []string{order.StatusEventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(orderstatusevent.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedStatusEventsIDs(); len(nodes) > 0 && !_u.mutation.StatusEventsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   order.StatusEventsTable,
+			Columns: []string{order.StatusEventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(orderstatusevent.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.StatusEventsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   order.StatusEventsTable,
+			Columns: []string{order.StatusEventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(orderstatusevent.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ItemChangesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   order.ItemChangesTable,
+			Columns: []string{order.ItemChangesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(orderitemchange.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedItemChangesIDs(); len(nodes) > 0 && !_u.mutation.ItemChangesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   order.ItemChangesTable,
+			Columns: []string{order.ItemChangesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(orderitemchange.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ItemChangesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   order.ItemChangesTable,
+			Columns: []string{order.ItemChangesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(orderitemchange.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.PaymentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   order.PaymentsTable,
+			Columns: []string{order.PaymentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(payment.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedPaymentsIDs(); len(nodes) > 0 && !_u.mutation.PaymentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   order.PaymentsTable,
+			Columns: []string{order.PaymentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(payment.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.PaymentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   order.PaymentsTable,
+			Columns: []string{order.PaymentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(payment.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.RefundsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   order.RefundsTable,
+			Columns: []string{order.RefundsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(refund.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedRefundsIDs(); len(nodes) > 0 && !_u.mutation.RefundsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   order.RefundsTable,
+			Columns: []string{order.RefundsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(refund.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RefundsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   order.RefundsTable,
+			Columns: []string{order.RefundsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(refund.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.StationTicketsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   order.StationTicketsTable,
+			Columns: []string{order.StationTicketsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(stationticket.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedStationTicketsIDs(); len(nodes) > 0 && !_u.mutation.StationTicketsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   order.StationTicketsTable,
+			Columns: []string{order.StationTicketsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(stationticket.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.StationTicketsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   order.StationTicketsTable,
+			Columns: []string{order.StationTicketsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(stationticket.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.TableCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   order.TableTable,
+			Columns: []string{order.TableColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(table.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TableIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   order.TableTable,
+			Columns: []string{order.TableColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(table.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.TableSessionCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   order.TableSessionTable,
+			Columns: []string{order.TableSessionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tablesession.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TableSessionIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   order.TableSessionTable,
+			Columns: []string{order.TableSessionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tablesession.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.DeliveryZoneCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   order.DeliveryZoneTable,
+			Columns: []string{order.DeliveryZoneColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(deliveryzone.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.DeliveryZoneIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   order.DeliveryZoneTable,
+			Columns: []string{order.DeliveryZoneColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(deliveryzone.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{order.Label}
@@ -370,20 +1226,247 @@ func (_u *OrderUpdateOne) SetNillablePaymentStatus(v *order.PaymentStatus) *Orde
 	return _u
 }
 
-// SetRestaurantID sets the "restaurant_id" field.
-func (_u *OrderUpdateOne) SetRestaurantID(v uuid.UUID) *OrderUpdateOne {
-	_u.mutation.SetRestaurantID(v)
+// SetSubtotal sets the "subtotal" field.
+func (_u *OrderUpdateOne) SetSubtotal(v int64) *OrderUpdateOne {
+	_u.mutation.ResetSubtotal()
+	_u.mutation.SetSubtotal(v)
 	return _u
 }
 
-// SetNillableRestaurantID sets the "restaurant_id" field if the given value is not nil.
-func (_u *OrderUpdateOne) SetNillableRestaurantID(v *uuid.UUID) *OrderUpdateOne {
+// SetNillableSubtotal sets the "subtotal" field if the given value is not nil.
+func (_u *OrderUpdateOne) SetNillableSubtotal(v *int64) *OrderUpdateOne {
+	if v != nil {
+		_u.SetSubtotal(*v)
+	}
+	return _u
+}
+
+// AddSubtotal adds value to the "subtotal" field.
+func (_u *OrderUpdateOne) AddSubtotal(v int64) *OrderUpdateOne {
+	_u.mutation.AddSubtotal(v)
+	return _u
+}
+
+// SetModifiersTotal sets the "modifiers_total" field.
+func (_u *OrderUpdateOne) SetModifiersTotal(v int64) *OrderUpdateOne {
+	_u.mutation.ResetModifiersTotal()
+	_u.mutation.SetModifiersTotal(v)
+	return _u
+}
+
+// SetNillableModifiersTotal sets the "modifiers_total" field if the given value is not nil.
+func (_u *OrderUpdateOne) SetNillableModifiersTotal(v *int64) *OrderUpdateOne {
+	if v != nil {
+		_u.SetModifiersTotal(*v)
+	}
+	return _u
+}
+
+// AddModifiersTotal adds value to the "modifiers_total" field.
+func (_u *OrderUpdateOne) AddModifiersTotal(v int64) *OrderUpdateOne {
+	_u.mutation.AddModifiersTotal(v)
+	return _u
+}
+
+// SetTaxTotal sets the "tax_total" field.
+func (_u *OrderUpdateOne) SetTaxTotal(v int64) *OrderUpdateOne {
+	_u.mutation.ResetTaxTotal()
+	_u.mutation.SetTaxTotal(v)
+	return _u
+}
+
+// SetNillableTaxTotal sets the "tax_total" field if the given value is not nil.
+func (_u *OrderUpdateOne) SetNillableTaxTotal(v *int64) *OrderUpdateOne {
+	if v != nil {
+		_u.SetTaxTotal(*v)
+	}
+	return _u
+}
+
+// AddTaxTotal adds value to the "tax_total" field.
+func (_u *OrderUpdateOne) AddTaxTotal(v int64) *OrderUpdateOne {
+	_u.mutation.AddTaxTotal(v)
+	return _u
+}
+
+// SetTotal sets the "total" field.
+func (_u *OrderUpdateOne) SetTotal(v int64) *OrderUpdateOne {
+	_u.mutation.ResetTotal()
+	_u.mutation.SetTotal(v)
+	return _u
+}
+
+// SetNillableTotal sets the "total" field if the given value is not nil.
+func (_u *OrderUpdateOne) SetNillableTotal(v *int64) *OrderUpdateOne {
+	if v != nil {
+		_u.SetTotal(*v)
+	}
+	return _u
+}
+
+// AddTotal adds value to the "total" field.
+func (_u *OrderUpdateOne) AddTotal(v int64) *OrderUpdateOne {
+	_u.mutation.AddTotal(v)
+	return _u
+}
+
+// SetAmountPaid sets the "amount_paid" field.
+func (_u *OrderUpdateOne) SetAmountPaid(v int64) *OrderUpdateOne {
+	_u.mutation.ResetAmountPaid()
+	_u.mutation.SetAmountPaid(v)
+	return _u
+}
+
+// SetNillableAmountPaid sets the "amount_paid" field if the given value is not nil.
+func (_u *OrderUpdateOne) SetNillableAmountPaid(v *int64) *OrderUpdateOne {
+	if v != nil {
+		_u.SetAmountPaid(*v)
+	}
+	return _u
+}
+
+// AddAmountPaid adds value to the "amount_paid" field.
+func (_u *OrderUpdateOne) AddAmountPaid(v int64) *OrderUpdateOne {
+	_u.mutation.AddAmountPaid(v)
+	return _u
+}
+
+// SetAmountRefunded sets the "amount_refunded" field.
+func (_u *OrderUpdateOne) SetAmountRefunded(v int64) *OrderUpdateOne {
+	_u.mutation.ResetAmountRefunded()
+	_u.mutation.SetAmountRefunded(v)
+	return _u
+}
+
+// SetNillableAmountRefunded sets the "amount_refunded" field if the given value is not nil.
+func (_u *OrderUpdateOne) SetNillableAmountRefunded(v *int64) *OrderUpdateOne {
+	if v != nil {
+		_u.SetAmountRefunded(*v)
+	}
+	return _u
+}
+
+// AddAmountRefunded adds value to the "amount_refunded" field.
+func (_u *OrderUpdateOne) AddAmountRefunded(v int64) *OrderUpdateOne {
+	_u.mutation.AddAmountRefunded(v)
+	return _u
+}
+
+// SetRestaurantID sets the "restaurant_id" field.
+func (_u *OrderUpdateOne) SetRestaurantID(v uuid.UUID) *OrderUpdateOne {
+	_u.mutation.SetRestaurantID(v)
+	return _u
+}
+
+// SetNillableRestaurantID sets the "restaurant_id" field if the given value is not nil.
+func (_u *OrderUpdateOne) SetNillableRestaurantID(v *uuid.UUID) *OrderUpdateOne {
 	if v != nil {
 		_u.SetRestaurantID(*v)
 	}
 	return _u
 }
 
+// SetTableID sets the "table_id" field.
+func (_u *OrderUpdateOne) SetTableID(v uuid.UUID) *OrderUpdateOne {
+	_u.mutation.SetTableID(v)
+	return _u
+}
+
+// SetNillableTableID sets the "table_id" field if the given value is not nil.
+func (_u *OrderUpdateOne) SetNillableTableID(v *uuid.UUID) *OrderUpdateOne {
+	if v != nil {
+		_u.SetTableID(*v)
+	}
+	return _u
+}
+
+// ClearTableID clears the value of the "table_id" field.
+func (_u *OrderUpdateOne) ClearTableID() *OrderUpdateOne {
+	_u.mutation.ClearTableID()
+	return _u
+}
+
+// SetTableSessionID sets the "table_session_id" field.
+func (_u *OrderUpdateOne) SetTableSessionID(v uuid.UUID) *OrderUpdateOne {
+	_u.mutation.SetTableSessionID(v)
+	return _u
+}
+
+// SetNillableTableSessionID sets the "table_session_id" field if the given value is not nil.
+func (_u *OrderUpdateOne) SetNillableTableSessionID(v *uuid.UUID) *OrderUpdateOne {
+	if v != nil {
+		_u.SetTableSessionID(*v)
+	}
+	return _u
+}
+
+// ClearTableSessionID clears the value of the "table_session_id" field.
+func (_u *OrderUpdateOne) ClearTableSessionID() *OrderUpdateOne {
+	_u.mutation.ClearTableSessionID()
+	return _u
+}
+
+// SetDeliveryZoneID sets the "delivery_zone_id" field.
+func (_u *OrderUpdateOne) SetDeliveryZoneID(v uuid.UUID) *OrderUpdateOne {
+	_u.mutation.SetDeliveryZoneID(v)
+	return _u
+}
+
+// SetNillableDeliveryZoneID sets the "delivery_zone_id" field if the given value is not nil.
+func (_u *OrderUpdateOne) SetNillableDeliveryZoneID(v *uuid.UUID) *OrderUpdateOne {
+	if v != nil {
+		_u.SetDeliveryZoneID(*v)
+	}
+	return _u
+}
+
+// ClearDeliveryZoneID clears the value of the "delivery_zone_id" field.
+func (_u *OrderUpdateOne) ClearDeliveryZoneID() *OrderUpdateOne {
+	_u.mutation.ClearDeliveryZoneID()
+	return _u
+}
+
+// SetReleasedAt sets the "released_at" field.
+func (_u *OrderUpdateOne) SetReleasedAt(v time.Time) *OrderUpdateOne {
+	_u.mutation.SetReleasedAt(v)
+	return _u
+}
+
+// SetNillableReleasedAt sets the "released_at" field if the given value is not nil.
+func (_u *OrderUpdateOne) SetNillableReleasedAt(v *time.Time) *OrderUpdateOne {
+	if v != nil {
+		_u.SetReleasedAt(*v)
+	}
+	return _u
+}
+
+// ClearReleasedAt clears the value of the "released_at" field.
+func (_u *OrderUpdateOne) ClearReleasedAt() *OrderUpdateOne {
+	_u.mutation.ClearReleasedAt()
+	return _u
+}
+
+// SetItemsVersion sets the "items_version" field.
+func (_u *OrderUpdateOne) SetItemsVersion(v int) *OrderUpdateOne {
+	_u.mutation.ResetItemsVersion()
+	_u.mutation.SetItemsVersion(v)
+	return _u
+}
+
+// SetNillableItemsVersion sets the "items_version" field if the given value is not nil.
+func (_u *OrderUpdateOne) SetNillableItemsVersion(v *int) *OrderUpdateOne {
+	if v != nil {
+		_u.SetItemsVersion(*v)
+	}
+	return _u
+}
+
+// AddItemsVersion adds value to the "items_version" field.
+func (_u *OrderUpdateOne) AddItemsVersion(v int) *OrderUpdateOne {
+	_u.mutation.AddItemsVersion(v)
+	return _u
+}
+
 // SetRestaurant sets the "restaurant" edge to the Restaurant entity.
 func (_u *OrderUpdateOne) SetRestaurant(v *Restaurant) *OrderUpdateOne {
 	return _u.SetRestaurantID(v.ID)
@@ -404,6 +1487,96 @@ func (_u *OrderUpdateOne) AddOrderItems(v ...*OrderItem) *OrderUpdateOne {
 	return _u.AddOrderItemIDs(ids...)
 }
 
+// AddStatusEventIDs adds the "status_events" edge to the OrderStatusEvent entity by IDs.
+func (_u *OrderUpdateOne) AddStatusEventIDs(ids ...uuid.UUID) *OrderUpdateOne {
+	_u.mutation.AddStatusEventIDs(ids...)
+	return _u
+}
+
+// AddStatusEvents adds the "status_events" edges to the OrderStatusEvent entity.
+func (_u *OrderUpdateOne) AddStatusEvents(v ...*OrderStatusEvent) *OrderUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddStatusEventIDs(ids...)
+}
+
+// AddItemChangeIDs adds the "item_changes" edge to the OrderItemChange entity by IDs.
+func (_u *OrderUpdateOne) AddItemChangeIDs(ids ...uuid.UUID) *OrderUpdateOne {
+	_u.mutation.AddItemChangeIDs(ids...)
+	return _u
+}
+
+// AddItemChanges adds the "item_changes" edges to the OrderItemChange entity.
+func (_u *OrderUpdateOne) AddItemChanges(v ...*OrderItemChange) *OrderUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddItemChangeIDs(ids...)
+}
+
+// AddPaymentIDs adds the "payments" edge to the Payment entity by IDs.
+func (_u *OrderUpdateOne) AddPaymentIDs(ids ...uuid.UUID) *OrderUpdateOne {
+	_u.mutation.AddPaymentIDs(ids...)
+	return _u
+}
+
+// AddPayments adds the "payments" edges to the Payment entity.
+func (_u *OrderUpdateOne) AddPayments(v ...*Payment) *OrderUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddPaymentIDs(ids...)
+}
+
+// AddRefundIDs adds the "refunds" edge to the Refund entity by IDs.
+func (_u *OrderUpdateOne) AddRefundIDs(ids ...uuid.UUID) *OrderUpdateOne {
+	_u.mutation.AddRefundIDs(ids...)
+	return _u
+}
+
+// AddRefunds adds the "refunds" edges to the Refund entity.
+func (_u *OrderUpdateOne) AddRefunds(v ...*Refund) *OrderUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddRefundIDs(ids...)
+}
+
+// AddStationTicketIDs adds the "station_tickets" edge to the StationTicket entity by IDs.
+func (_u *OrderUpdateOne) AddStationTicketIDs(ids ...uuid.UUID) *OrderUpdateOne {
+	_u.mutation.AddStationTicketIDs(ids...)
+	return _u
+}
+
+// AddStationTickets adds the "station_tickets" edges to the StationTicket entity.
+func (_u *OrderUpdateOne) AddStationTickets(v ...*StationTicket) *OrderUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddStationTicketIDs(ids...)
+}
+
+// SetTable sets the "table" edge to the Table entity.
+func (_u *OrderUpdateOne) SetTable(v *Table) *OrderUpdateOne {
+	return _u.SetTableID(v.ID)
+}
+
+// SetTableSession sets the "table_session" edge to the TableSession entity.
+func (_u *OrderUpdateOne) SetTableSession(v *TableSession) *OrderUpdateOne {
+	return _u.SetTableSessionID(v.ID)
+}
+
+// SetDeliveryZone sets the "delivery_zone" edge to the DeliveryZone entity.
+func (_u *OrderUpdateOne) SetDeliveryZone(v *DeliveryZone) *OrderUpdateOne {
+	return _u.SetDeliveryZoneID(v.ID)
+}
+
 // Mutation returns the OrderMutation object of the builder.
 func (_u *OrderUpdateOne) Mutation() *OrderMutation {
 	return _u.mutation
@@ -436,6 +1609,129 @@ func (_u *OrderUpdateOne) RemoveOrderItems(v ...*OrderItem) *OrderUpdateOne {
 	return _u.RemoveOrderItemIDs(ids...)
 }
 
+// ClearStatusEvents clears all "status_events" edges to the OrderStatusEvent entity.
+func (_u *OrderUpdateOne) ClearStatusEvents() *OrderUpdateOne {
+	_u.mutation.ClearStatusEvents()
+	return _u
+}
+
+// RemoveStatusEventIDs removes the "status_events" edge to OrderStatusEvent entities by IDs.
+func (_u *OrderUpdateOne) RemoveStatusEventIDs(ids ...uuid.UUID) *OrderUpdateOne {
+	_u.mutation.RemoveStatusEventIDs(ids...)
+	return _u
+}
+
+// RemoveStatusEvents removes "status_events" edges to OrderStatusEvent entities.
+func (_u *OrderUpdateOne) RemoveStatusEvents(v ...*OrderStatusEvent) *OrderUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveStatusEventIDs(ids...)
+}
+
+// ClearItemChanges clears all "item_changes" edges to the OrderItemChange entity.
+func (_u *OrderUpdateOne) ClearItemChanges() *OrderUpdateOne {
+	_u.mutation.ClearItemChanges()
+	return _u
+}
+
+// RemoveItemChangeIDs removes the "item_changes" edge to OrderItemChange entities by IDs.
+func (_u *OrderUpdateOne) RemoveItemChangeIDs(ids ...uuid.UUID) *OrderUpdateOne {
+	_u.mutation.RemoveItemChangeIDs(ids...)
+	return _u
+}
+
+// RemoveItemChanges removes "item_changes" edges to OrderItemChange entities.
+func (_u *OrderUpdateOne) RemoveItemChanges(v ...*OrderItemChange) *OrderUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveItemChangeIDs(ids...)
+}
+
+// ClearPayments clears all "payments" edges to the Payment entity.
+func (_u *OrderUpdateOne) ClearPayments() *OrderUpdateOne {
+	_u.mutation.ClearPayments()
+	return _u
+}
+
+// RemovePaymentIDs removes the "payments" edge to Payment entities by IDs.
+func (_u *OrderUpdateOne) RemovePaymentIDs(ids ...uuid.UUID) *OrderUpdateOne {
+	_u.mutation.RemovePaymentIDs(ids...)
+	return _u
+}
+
+// RemovePayments removes "payments" edges to Payment entities.
+func (_u *OrderUpdateOne) RemovePayments(v ...*Payment) *OrderUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemovePaymentIDs(ids...)
+}
+
+// ClearRefunds clears all "refunds" edges to the Refund entity.
+func (_u *OrderUpdateOne) ClearRefunds() *OrderUpdateOne {
+	_u.mutation.ClearRefunds()
+	return _u
+}
+
+// RemoveRefundIDs removes the "refunds" edge to Refund entities by IDs.
+func (_u *OrderUpdateOne) RemoveRefundIDs(ids ...uuid.UUID) *OrderUpdateOne {
+	_u.mutation.RemoveRefundIDs(ids...)
+	return _u
+}
+
+// RemoveRefunds removes "refunds" edges to Refund entities.
+func (_u *OrderUpdateOne) RemoveRefunds(v ...*Refund) *OrderUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveRefundIDs(ids...)
+}
+
+// ClearStationTickets clears all "station_tickets" edges to the StationTicket entity.
+func (_u *OrderUpdateOne) ClearStationTickets() *OrderUpdateOne {
+	_u.mutation.ClearStationTickets()
+	return _u
+}
+
+// RemoveStationTicketIDs removes the "station_tickets" edge to StationTicket entities by IDs.
+func (_u *OrderUpdateOne) RemoveStationTicketIDs(ids ...uuid.UUID) *OrderUpdateOne {
+	_u.mutation.RemoveStationTicketIDs(ids...)
+	return _u
+}
+
+// RemoveStationTickets removes "station_tickets" edges to StationTicket entities.
+func (_u *OrderUpdateOne) RemoveStationTickets(v ...*StationTicket) *OrderUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveStationTicketIDs(ids...)
+}
+
+// ClearTable clears the "table" edge to the Table entity.
+func (_u *OrderUpdateOne) ClearTable() *OrderUpdateOne {
+	_u.mutation.ClearTable()
+	return _u
+}
+
+// ClearTableSession clears the "table_session" edge to the TableSession entity.
+func (_u *OrderUpdateOne) ClearTableSession() *OrderUpdateOne {
+	_u.mutation.ClearTableSession()
+	return _u
+}
+
+// ClearDeliveryZone clears the "delivery_zone" edge to the DeliveryZone entity.
+func (_u *OrderUpdateOne) ClearDeliveryZone() *OrderUpdateOne {
+	_u.mutation.ClearDeliveryZone()
+	return _u
+}
+
 // Where appends a list predicates to the OrderUpdate builder.
 func (_u *OrderUpdateOne) Where(ps ...predicate.Order) *OrderUpdateOne {
 	_u.mutation.Where(ps...)
@@ -502,6 +1798,36 @@ func (_u *OrderUpdateOne) check() error {
 			return &ValidationError{Name: "payment_status", err: fmt.Errorf(`ent: validator failed for field "Order.payment_status": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.Subtotal(); ok {
+		if err := order.SubtotalValidator(v); err != nil {
+			return &ValidationError{Name: "subtotal", err: fmt.Errorf(`ent: validator failed for field "Order.subtotal": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ModifiersTotal(); ok {
+		if err := order.ModifiersTotalValidator(v); err != nil {
+			return &ValidationError{Name: "modifiers_total", err: fmt.Errorf(`ent: validator failed for field "Order.modifiers_total": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.TaxTotal(); ok {
+		if err := order.TaxTotalValidator(v); err != nil {
+			return &ValidationError{Name: "tax_total", err: fmt.Errorf(`ent: validator failed for field "Order.tax_total": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Total(); ok {
+		if err := order.TotalValidator(v); err != nil {
+			return &ValidationError{Name: "total", err: fmt.Errorf(`ent: validator failed for field "Order.total": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.AmountPaid(); ok {
+		if err := order.AmountPaidValidator(v); err != nil {
+			return &ValidationError{Name: "amount_paid", err: fmt.Errorf(`ent: validator failed for field "Order.amount_paid": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.AmountRefunded(); ok {
+		if err := order.AmountRefundedValidator(v); err != nil {
+			return &ValidationError{Name: "amount_refunded", err: fmt.Errorf(`ent: validator failed for field "Order.amount_refunded": %w`, err)}
+		}
+	}
 	if _u.mutation.RestaurantCleared() && len(_u.mutation.RestaurantIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "Order.restaurant"`)
 	}
@@ -540,6 +1866,9 @@ func (_u *OrderUpdateOne) sqlSave(ctx context.Context) (_node *Order, err error)
 	if value, ok := _u.mutation.UpdateTime(); ok {
 		_spec.SetField(order.FieldUpdateTime, field.TypeTime, value)
 	}
+	if _u.mutation.OrderNumberCleared() {
+		_spec.ClearField(order.FieldOrderNumber, field.TypeInt)
+	}
 	if value, ok := _u.mutation.OrderType(); ok {
 		_spec.SetField(order.FieldOrderType, field.TypeEnum, value)
 	}
@@ -549,6 +1878,69 @@ func (_u *OrderUpdateOne) sqlSave(ctx context.Context) (_node *Order, err error)
 	if value, ok := _u.mutation.PaymentStatus(); ok {
 		_spec.SetField(order.FieldPaymentStatus, field.TypeEnum, value)
 	}
+	if value, ok := _u.mutation.Subtotal(); ok {
+		_spec.SetField(order.FieldSubtotal, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedSubtotal(); ok {
+		_spec.AddField(order.FieldSubtotal, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.ModifiersTotal(); ok {
+		_spec.SetField(order.FieldModifiersTotal, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedModifiersTotal(); ok {
+		_spec.AddField(order.FieldModifiersTotal, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.TaxTotal(); ok {
+		_spec.SetField(order.FieldTaxTotal, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedTaxTotal(); ok {
+		_spec.AddField(order.FieldTaxTotal, field.TypeInt64, value)
+	}
+	if _u.mutation.TaxRateBpsCleared() {
+		_spec.ClearField(order.FieldTaxRateBps, field.TypeInt)
+	}
+	if value, ok := _u.mutation.Total(); ok {
+		_spec.SetField(order.FieldTotal, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedTotal(); ok {
+		_spec.AddField(order.FieldTotal, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AmountPaid(); ok {
+		_spec.SetField(order.FieldAmountPaid, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedAmountPaid(); ok {
+		_spec.AddField(order.FieldAmountPaid, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AmountRefunded(); ok {
+		_spec.SetField(order.FieldAmountRefunded, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedAmountRefunded(); ok {
+		_spec.AddField(order.FieldAmountRefunded, field.TypeInt64, value)
+	}
+	if _u.mutation.DeliveryLatitudeCleared() {
+		_spec.ClearField(order.FieldDeliveryLatitude, field.TypeFloat64)
+	}
+	if _u.mutation.DeliveryLongitudeCleared() {
+		_spec.ClearField(order.FieldDeliveryLongitude, field.TypeFloat64)
+	}
+	if _u.mutation.ScheduledForCleared() {
+		_spec.ClearField(order.FieldScheduledFor, field.TypeTime)
+	}
+	if _u.mutation.ReleaseAtCleared() {
+		_spec.ClearField(order.FieldReleaseAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.ReleasedAt(); ok {
+		_spec.SetField(order.FieldReleasedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ReleasedAtCleared() {
+		_spec.ClearField(order.FieldReleasedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.ItemsVersion(); ok {
+		_spec.SetField(order.FieldItemsVersion, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedItemsVersion(); ok {
+		_spec.AddField(order.FieldItemsVersion, field.TypeInt, value)
+	}
 	if _u.mutation.RestaurantCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -623,6 +2015,318 @@ func (_u *OrderUpdateOne) sqlSave(ctx context.Context) (_node *Order, err error)
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.StatusEventsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   order.StatusEventsTable,
+			Columns: []string{order.StatusEventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(orderstatusevent.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedStatusEventsIDs(); len(nodes) > 0 && !_u.mutation.StatusEventsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   order.StatusEventsTable,
+			Columns: []string{order.StatusEventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(orderstatusevent.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.StatusEventsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   order.StatusEventsTable,
+			Columns: []string{order.StatusEventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(orderstatusevent.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ItemChangesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   order.ItemChangesTable,
+			Columns: []string{order.ItemChangesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(orderitemchange.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedItemChangesIDs(); len(nodes) > 0 && !_u.mutation.ItemChangesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   order.ItemChangesTable,
+			Columns: []string{order.ItemChangesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(orderitemchange.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ItemChangesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   order.ItemChangesTable,
+			Columns: []string{order.ItemChangesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(orderitemchange.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.PaymentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   order.PaymentsTable,
+			Columns: []string{order.PaymentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(payment.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedPaymentsIDs(); len(nodes) > 0 && !_u.mutation.PaymentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   order.PaymentsTable,
+			Columns: []string{order.PaymentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(payment.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.PaymentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   order.PaymentsTable,
+			Columns: []string{order.PaymentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(payment.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.RefundsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   order.RefundsTable,
+			Columns: []string{order.RefundsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(refund.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedRefundsIDs(); len(nodes) > 0 && !_u.mutation.RefundsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   order.RefundsTable,
+			Columns: []string{order.RefundsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(refund.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RefundsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   order.RefundsTable,
+			Columns: []string{order.RefundsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(refund.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.StationTicketsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   order.StationTicketsTable,
+			Columns: []string{order.StationTicketsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(stationticket.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedStationTicketsIDs(); len(nodes) > 0 && !_u.mutation.StationTicketsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   order.StationTicketsTable,
+			Columns: []string{order.StationTicketsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(stationticket.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.StationTicketsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   order.StationTicketsTable,
+			Columns: []string{order.StationTicketsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(stationticket.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.TableCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   order.TableTable,
+			Columns: []string{order.TableColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(table.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TableIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   order.TableTable,
+			Columns: []string{order.TableColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(table.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.TableSessionCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   order.TableSessionTable,
+			Columns: []string{order.TableSessionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tablesession.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TableSessionIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   order.TableSessionTable,
+			Columns: []string{order.TableSessionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tablesession.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.DeliveryZoneCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   order.DeliveryZoneTable,
+			Columns: []string{order.DeliveryZoneColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(deliveryzone.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.DeliveryZoneIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   order.DeliveryZoneTable,
+			Columns: []string{order.DeliveryZoneColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(deliveryzone.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &Order{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -0,0 +1,214 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/Jiruu246/rms/internal/ent/orderevent"
+	"github.com/Jiruu246/rms/internal/ent/restaurant"
+	"github.com/google/uuid"
+)
+
+// OrderEvent is the model entity for the OrderEvent schema.
+type OrderEvent struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// CreateTime holds the value of the "create_time" field.
+	CreateTime time.Time `json:"create_time,omitempty"`
+	// Position in the restaurant's feed; used as the SSE event id
+	Seq int64 `json:"seq,omitempty"`
+	// Type holds the value of the "type" field.
+	Type orderevent.Type `json:"type,omitempty"`
+	// Not a foreign key, so that events outlive deleted orders
+	OrderID uuid.UUID `json:"order_id,omitempty"`
+	// The order (or, for ticket events, the ticket) as it was after the change, as returned by the API; empty for order.deleted
+	Payload json.RawMessage `json:"payload,omitempty"`
+	// Station of the ticket, for ticket events; what station feeds filter on
+	StationID *uuid.UUID `json:"station_id,omitempty"`
+	// RestaurantID holds the value of the "restaurant_id" field.
+	RestaurantID uuid.UUID `json:"restaurant_id,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the OrderEventQuery when eager-loading is set.
+	Edges        OrderEventEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// OrderEventEdges holds the relations/edges for other nodes in the graph.
+type OrderEventEdges struct {
+	// Restaurant holds the value of the restaurant edge.
+	Restaurant *Restaurant `json:"restaurant,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// RestaurantOrErr returns the Restaurant value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e OrderEventEdges) RestaurantOrErr() (*Restaurant, error) {
+	if e.Restaurant != nil {
+		return e.Restaurant, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: restaurant.Label}
+	}
+	return nil, &NotLoadedError{edge: "restaurant"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*OrderEvent) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case orderevent.FieldStationID:
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case orderevent.FieldPayload:
+			values[i] = new([]byte)
+		case orderevent.FieldSeq:
+			values[i] = new(sql.NullInt64)
+		case orderevent.FieldType:
+			values[i] = new(sql.NullString)
+		case orderevent.FieldCreateTime:
+			values[i] = new(sql.NullTime)
+		case orderevent.FieldID, orderevent.FieldOrderID, orderevent.FieldRestaurantID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the OrderEvent fields.
+func (_m *OrderEvent) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case orderevent.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case orderevent.FieldCreateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field create_time", values[i])
+			} else if value.Valid {
+				_m.CreateTime = value.Time
+			}
+		case orderevent.FieldSeq:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field seq", values[i])
+			} else if value.Valid {
+				_m.Seq = value.Int64
+			}
+		case orderevent.FieldType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field type", values[i])
+			} else if value.Valid {
+				_m.Type = orderevent.Type(value.String)
+			}
+		case orderevent.FieldOrderID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field order_id", values[i])
+			} else if value != nil {
+				_m.OrderID = *value
+			}
+		case orderevent.FieldPayload:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field payload", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Payload); err != nil {
+					return fmt.Errorf("unmarshal field payload: %w", err)
+				}
+			}
+		case orderevent.FieldStationID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field station_id", values[i])
+			} else if value.Valid {
+				_m.StationID = new(uuid.UUID)
+				*_m.StationID = *value.S.(*uuid.UUID)
+			}
+		case orderevent.FieldRestaurantID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field restaurant_id", values[i])
+			} else if value != nil {
+				_m.RestaurantID = *value
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the OrderEvent.
+// This includes values selected through modifiers, order, etc.
+func (_m *OrderEvent) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryRestaurant queries the "restaurant" edge of the OrderEvent entity.
+func (_m *OrderEvent) QueryRestaurant() *RestaurantQuery {
+	return NewOrderEventClient(_m.config).QueryRestaurant(_m)
+}
+
+// Update returns a builder for updating this OrderEvent.
+// Note that you need to call OrderEvent.Unwrap() before calling this method if this OrderEvent
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *OrderEvent) Update() *OrderEventUpdateOne {
+	return NewOrderEventClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the OrderEvent entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *OrderEvent) Unwrap() *OrderEvent {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: OrderEvent is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *OrderEvent) String() string {
+	var builder strings.Builder
+	builder.WriteString("OrderEvent(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("create_time=")
+	builder.WriteString(_m.CreateTime.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("seq=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Seq))
+	builder.WriteString(", ")
+	builder.WriteString("type=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Type))
+	builder.WriteString(", ")
+	builder.WriteString("order_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.OrderID))
+	builder.WriteString(", ")
+	builder.WriteString("payload=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Payload))
+	builder.WriteString(", ")
+	if v := _m.StationID; v != nil {
+		builder.WriteString("station_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("restaurant_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.RestaurantID))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// OrderEvents is a parsable slice of OrderEvent.
+type OrderEvents []*OrderEvent
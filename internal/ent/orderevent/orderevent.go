@@ -0,0 +1,156 @@
+// Code generated by ent, DO NOT EDIT.
+
+package orderevent
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the orderevent type in the database.
+	Label = "order_event"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreateTime holds the string denoting the create_time field in the database.
+	FieldCreateTime = "create_time"
+	// FieldSeq holds the string denoting the seq field in the database.
+	FieldSeq = "seq"
+	// FieldType holds the string denoting the type field in the database.
+	FieldType = "type"
+	// FieldOrderID holds the string denoting the order_id field in the database.
+	FieldOrderID = "order_id"
+	// FieldPayload holds the string denoting the payload field in the database.
+	FieldPayload = "payload"
+	// FieldStationID holds the string denoting the station_id field in the database.
+	FieldStationID = "station_id"
+	// FieldRestaurantID holds the string denoting the restaurant_id field in the database.
+	FieldRestaurantID = "restaurant_id"
+	// EdgeRestaurant holds the string denoting the restaurant edge name in mutations.
+	EdgeRestaurant = "restaurant"
+	// Table holds the table name of the orderevent in the database.
+	Table = "order_events"
+	// RestaurantTable is the table that holds the restaurant relation/edge.
+	RestaurantTable = "order_events"
+	// RestaurantInverseTable is the table name for the Restaurant entity.
+	// It exists in this package in order to avoid circular dependency with the "restaurant" package.
+	RestaurantInverseTable = "restaurants"
+	// RestaurantColumn is the table column denoting the restaurant relation/edge.
+	RestaurantColumn = "restaurant_id"
+)
+
+// Columns holds all SQL columns for orderevent fields.
+var Columns = []string{
+	FieldID,
+	FieldCreateTime,
+	FieldSeq,
+	FieldType,
+	FieldOrderID,
+	FieldPayload,
+	FieldStationID,
+	FieldRestaurantID,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreateTime holds the default value on creation for the "create_time" field.
+	DefaultCreateTime func() time.Time
+	// SeqValidator is a validator for the "seq" field. It is called by the builders before save.
+	SeqValidator func(int64) error
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// Type defines the type for the "type" enum field.
+type Type string
+
+// Type values.
+const (
+	TypeCreated             Type = "order.created"
+	TypeUpdated             Type = "order.updated"
+	TypeStatusChanged       Type = "order.status_changed"
+	TypeDeleted             Type = "order.deleted"
+	TypeReleased            Type = "order.released"
+	TypeTicketCreated       Type = "ticket.created"
+	TypeTicketStatusChanged Type = "ticket.status_changed"
+	TypeTicketUpdated       Type = "ticket.updated"
+)
+
+func (_type Type) String() string {
+	return string(_type)
+}
+
+// TypeValidator is a validator for the "type" field enum values. It is called by the builders before save.
+func TypeValidator(_type Type) error {
+	switch _type {
+	case TypeCreated, TypeUpdated, TypeStatusChanged, TypeDeleted, TypeReleased, TypeTicketCreated, TypeTicketStatusChanged, TypeTicketUpdated:
+		return nil
+	default:
+		return fmt.Errorf("orderevent: invalid enum value for type field: %q", _type)
+	}
+}
+
+// OrderOption defines the ordering options for the OrderEvent queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreateTime orders the results by the create_time field.
+func ByCreateTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreateTime, opts...).ToFunc()
+}
+
+// BySeq orders the results by the seq field.
+func BySeq(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSeq, opts...).ToFunc()
+}
+
+// ByType orders the results by the type field.
+func ByType(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldType, opts...).ToFunc()
+}
+
+// ByOrderID orders the results by the order_id field.
+func ByOrderID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOrderID, opts...).ToFunc()
+}
+
+// ByStationID orders the results by the station_id field.
+func ByStationID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStationID, opts...).ToFunc()
+}
+
+// ByRestaurantID orders the results by the restaurant_id field.
+func ByRestaurantID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRestaurantID, opts...).ToFunc()
+}
+
+// ByRestaurantField orders the results by restaurant field.
+func ByRestaurantField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newRestaurantStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newRestaurantStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(RestaurantInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, RestaurantTable, RestaurantColumn),
+	)
+}
@@ -0,0 +1,340 @@
+// Code generated by ent, DO NOT EDIT.
+
+package orderevent
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/Jiruu246/rms/internal/ent/predicate"
+	"github.com/google/uuid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.OrderEvent {
+	return predicate.OrderEvent(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.OrderEvent {
+	return predicate.OrderEvent(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.OrderEvent {
+	return predicate.OrderEvent(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.OrderEvent {
+	return predicate.OrderEvent(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.OrderEvent {
+	return predicate.OrderEvent(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.OrderEvent {
+	return predicate.OrderEvent(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.OrderEvent {
+	return predicate.OrderEvent(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.OrderEvent {
+	return predicate.OrderEvent(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.OrderEvent {
+	return predicate.OrderEvent(sql.FieldLTE(FieldID, id))
+}
+
+// CreateTime applies equality check predicate on the "create_time" field. It's identical to CreateTimeEQ.
+func CreateTime(v time.Time) predicate.OrderEvent {
+	return predicate.OrderEvent(sql.FieldEQ(FieldCreateTime, v))
+}
+
+// Seq applies equality check predicate on the "seq" field. It's identical to SeqEQ.
+func Seq(v int64) predicate.OrderEvent {
+	return predicate.OrderEvent(sql.FieldEQ(FieldSeq, v))
+}
+
+// OrderID applies equality check predicate on the "order_id" field. It's identical to OrderIDEQ.
+func OrderID(v uuid.UUID) predicate.OrderEvent {
+	return predicate.OrderEvent(sql.FieldEQ(FieldOrderID, v))
+}
+
+// StationID applies equality check predicate on the "station_id" field. It's identical to StationIDEQ.
+func StationID(v uuid.UUID) predicate.OrderEvent {
+	return predicate.OrderEvent(sql.FieldEQ(FieldStationID, v))
+}
+
+// RestaurantID applies equality check predicate on the "restaurant_id" field. It's identical to RestaurantIDEQ.
+func RestaurantID(v uuid.UUID) predicate.OrderEvent {
+	return predicate.OrderEvent(sql.FieldEQ(FieldRestaurantID, v))
+}
+
+// CreateTimeEQ applies the EQ predicate on the "create_time" field.
+func CreateTimeEQ(v time.Time) predicate.OrderEvent {
+	return predicate.OrderEvent(sql.FieldEQ(FieldCreateTime, v))
+}
+
+// CreateTimeNEQ applies the NEQ predicate on the "create_time" field.
+func CreateTimeNEQ(v time.Time) predicate.OrderEvent {
+	return predicate.OrderEvent(sql.FieldNEQ(FieldCreateTime, v))
+}
+
+// CreateTimeIn applies the In predicate on the "create_time" field.
+func CreateTimeIn(vs ...time.Time) predicate.OrderEvent {
+	return predicate.OrderEvent(sql.FieldIn(FieldCreateTime, vs...))
+}
+
+// CreateTimeNotIn applies the NotIn predicate on the "create_time" field.
+func CreateTimeNotIn(vs ...time.Time) predicate.OrderEvent {
+	return predicate.OrderEvent(sql.FieldNotIn(FieldCreateTime, vs...))
+}
+
+// CreateTimeGT applies the GT predicate on the "create_time" field.
+func CreateTimeGT(v time.Time) predicate.OrderEvent {
+	return predicate.OrderEvent(sql.FieldGT(FieldCreateTime, v))
+}
+
+// CreateTimeGTE applies the GTE predicate on the "create_time" field.
+func CreateTimeGTE(v time.Time) predicate.OrderEvent {
+	return predicate.OrderEvent(sql.FieldGTE(FieldCreateTime, v))
+}
+
+// CreateTimeLT applies the LT predicate on the "create_time" field.
+func CreateTimeLT(v time.Time) predicate.OrderEvent {
+	return predicate.OrderEvent(sql.FieldLT(FieldCreateTime, v))
+}
+
+// CreateTimeLTE applies the LTE predicate on the "create_time" field.
+func CreateTimeLTE(v time.Time) predicate.OrderEvent {
+	return predicate.OrderEvent(sql.FieldLTE(FieldCreateTime, v))
+}
+
+// SeqEQ applies the EQ predicate on the "seq" field.
+func SeqEQ(v int64) predicate.OrderEvent {
+	return predicate.OrderEvent(sql.FieldEQ(FieldSeq, v))
+}
+
+// SeqNEQ applies the NEQ predicate on the "seq" field.
+func SeqNEQ(v int64) predicate.OrderEvent {
+	return predicate.OrderEvent(sql.FieldNEQ(FieldSeq, v))
+}
+
+// SeqIn applies the In predicate on the "seq" field.
+func SeqIn(vs ...int64) predicate.OrderEvent {
+	return predicate.OrderEvent(sql.FieldIn(FieldSeq, vs...))
+}
+
+// SeqNotIn applies the NotIn predicate on the "seq" field.
+func SeqNotIn(vs ...int64) predicate.OrderEvent {
+	return predicate.OrderEvent(sql.FieldNotIn(FieldSeq, vs...))
+}
+
+// SeqGT applies the GT predicate on the "seq" field.
+func SeqGT(v int64) predicate.OrderEvent {
+	return predicate.OrderEvent(sql.FieldGT(FieldSeq, v))
+}
+
+// SeqGTE applies the GTE predicate on the "seq" field.
+func SeqGTE(v int64) predicate.OrderEvent {
+	return predicate.OrderEvent(sql.FieldGTE(FieldSeq, v))
+}
+
+// SeqLT applies the LT predicate on the "seq" field.
+func SeqLT(v int64) predicate.OrderEvent {
+	return predicate.OrderEvent(sql.FieldLT(FieldSeq, v))
+}
+
+// SeqLTE applies the LTE predicate on the "seq" field.
+func SeqLTE(v int64) predicate.OrderEvent {
+	return predicate.OrderEvent(sql.FieldLTE(FieldSeq, v))
+}
+
+// TypeEQ applies the EQ predicate on the "type" field.
+func TypeEQ(v Type) predicate.OrderEvent {
+	return predicate.OrderEvent(sql.FieldEQ(FieldType, v))
+}
+
+// TypeNEQ applies the NEQ predicate on the "type" field.
+func TypeNEQ(v Type) predicate.OrderEvent {
+	return predicate.OrderEvent(sql.FieldNEQ(FieldType, v))
+}
+
+// TypeIn applies the In predicate on the "type" field.
+func TypeIn(vs ...Type) predicate.OrderEvent {
+	return predicate.OrderEvent(sql.FieldIn(FieldType, vs...))
+}
+
+// TypeNotIn applies the NotIn predicate on the "type" field.
+func TypeNotIn(vs ...Type) predicate.OrderEvent {
+	return predicate.OrderEvent(sql.FieldNotIn(FieldType, vs...))
+}
+
+// OrderIDEQ applies the EQ predicate on the "order_id" field.
+func OrderIDEQ(v uuid.UUID) predicate.OrderEvent {
+	return predicate.OrderEvent(sql.FieldEQ(FieldOrderID, v))
+}
+
+// OrderIDNEQ applies the NEQ predicate on the "order_id" field.
+func OrderIDNEQ(v uuid.UUID) predicate.OrderEvent {
+	return predicate.OrderEvent(sql.FieldNEQ(FieldOrderID, v))
+}
+
+// OrderIDIn applies the In predicate on the "order_id" field.
+func OrderIDIn(vs ...uuid.UUID) predicate.OrderEvent {
+	return predicate.OrderEvent(sql.FieldIn(FieldOrderID, vs...))
+}
+
+// OrderIDNotIn applies the NotIn predicate on the "order_id" field.
+func OrderIDNotIn(vs ...uuid.UUID) predicate.OrderEvent {
+	return predicate.OrderEvent(sql.FieldNotIn(FieldOrderID, vs...))
+}
+
+// OrderIDGT applies the GT predicate on the "order_id" field.
+func OrderIDGT(v uuid.UUID) predicate.OrderEvent {
+	return predicate.OrderEvent(sql.FieldGT(FieldOrderID, v))
+}
+
+// OrderIDGTE applies the GTE predicate on the "order_id" field.
+func OrderIDGTE(v uuid.UUID) predicate.OrderEvent {
+	return predicate.OrderEvent(sql.FieldGTE(FieldOrderID, v))
+}
+
+// OrderIDLT applies the LT predicate on the "order_id" field.
+func OrderIDLT(v uuid.UUID) predicate.OrderEvent {
+	return predicate.OrderEvent(sql.FieldLT(FieldOrderID, v))
+}
+
+// OrderIDLTE applies the LTE predicate on the "order_id" field.
+func OrderIDLTE(v uuid.UUID) predicate.OrderEvent {
+	return predicate.OrderEvent(sql.FieldLTE(FieldOrderID, v))
+}
+
+// PayloadIsNil applies the IsNil predicate on the "payload" field.
+func PayloadIsNil() predicate.OrderEvent {
+	return predicate.OrderEvent(sql.FieldIsNull(FieldPayload))
+}
+
+// PayloadNotNil applies the NotNil predicate on the "payload" field.
+func PayloadNotNil() predicate.OrderEvent {
+	return predicate.OrderEvent(sql.FieldNotNull(FieldPayload))
+}
+
+// StationIDEQ applies the EQ predicate on the "station_id" field.
+func StationIDEQ(v uuid.UUID) predicate.OrderEvent {
+	return predicate.OrderEvent(sql.FieldEQ(FieldStationID, v))
+}
+
+// StationIDNEQ applies the NEQ predicate on the "station_id" field.
+func StationIDNEQ(v uuid.UUID) predicate.OrderEvent {
+	return predicate.OrderEvent(sql.FieldNEQ(FieldStationID, v))
+}
+
+// StationIDIn applies the In predicate on the "station_id" field.
+func StationIDIn(vs ...uuid.UUID) predicate.OrderEvent {
+	return predicate.OrderEvent(sql.FieldIn(FieldStationID, vs...))
+}
+
+// StationIDNotIn applies the NotIn predicate on the "station_id" field.
+func StationIDNotIn(vs ...uuid.UUID) predicate.OrderEvent {
+	return predicate.OrderEvent(sql.FieldNotIn(FieldStationID, vs...))
+}
+
+// StationIDGT applies the GT predicate on the "station_id" field.
+func StationIDGT(v uuid.UUID) predicate.OrderEvent {
+	return predicate.OrderEvent(sql.FieldGT(FieldStationID, v))
+}
+
+// StationIDGTE applies the GTE predicate on the "station_id" field.
+func StationIDGTE(v uuid.UUID) predicate.OrderEvent {
+	return predicate.OrderEvent(sql.FieldGTE(FieldStationID, v))
+}
+
+// StationIDLT applies the LT predicate on the "station_id" field.
+func StationIDLT(v uuid.UUID) predicate.OrderEvent {
+	return predicate.OrderEvent(sql.FieldLT(FieldStationID, v))
+}
+
+// StationIDLTE applies the LTE predicate on the "station_id" field.
+func StationIDLTE(v uuid.UUID) predicate.OrderEvent {
+	return predicate.OrderEvent(sql.FieldLTE(FieldStationID, v))
+}
+
+// StationIDIsNil applies the IsNil predicate on the "station_id" field.
+func StationIDIsNil() predicate.OrderEvent {
+	return predicate.OrderEvent(sql.FieldIsNull(FieldStationID))
+}
+
+// StationIDNotNil applies the NotNil predicate on the "station_id" field.
+func StationIDNotNil() predicate.OrderEvent {
+	return predicate.OrderEvent(sql.FieldNotNull(FieldStationID))
+}
+
+// RestaurantIDEQ applies the EQ predicate on the "restaurant_id" field.
+func RestaurantIDEQ(v uuid.UUID) predicate.OrderEvent {
+	return predicate.OrderEvent(sql.FieldEQ(FieldRestaurantID, v))
+}
+
+// RestaurantIDNEQ applies the NEQ predicate on the "restaurant_id" field.
+func RestaurantIDNEQ(v uuid.UUID) predicate.OrderEvent {
+	return predicate.OrderEvent(sql.FieldNEQ(FieldRestaurantID, v))
+}
+
+// RestaurantIDIn applies the In predicate on the "restaurant_id" field.
+func RestaurantIDIn(vs ...uuid.UUID) predicate.OrderEvent {
+	return predicate.OrderEvent(sql.FieldIn(FieldRestaurantID, vs...))
+}
+
+// RestaurantIDNotIn applies the NotIn predicate on the "restaurant_id" field.
+func RestaurantIDNotIn(vs ...uuid.UUID) predicate.OrderEvent {
+	return predicate.OrderEvent(sql.FieldNotIn(FieldRestaurantID, vs...))
+}
+
+// HasRestaurant applies the HasEdge predicate on the "restaurant" edge.
+func HasRestaurant() predicate.OrderEvent {
+	return predicate.OrderEvent(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, RestaurantTable, RestaurantColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasRestaurantWith applies the HasEdge predicate on the "restaurant" edge with a given conditions (other predicates).
+func HasRestaurantWith(preds ...predicate.Restaurant) predicate.OrderEvent {
+	return predicate.OrderEvent(func(s *sql.Selector) {
+		step := newRestaurantStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.OrderEvent) predicate.OrderEvent {
+	return predicate.OrderEvent(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.OrderEvent) predicate.OrderEvent {
+	return predicate.OrderEvent(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.OrderEvent) predicate.OrderEvent {
+	return predicate.OrderEvent(sql.NotPredicates(p))
+}
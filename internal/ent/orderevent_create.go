@@ -0,0 +1,339 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Jiruu246/rms/internal/ent/orderevent"
+	"github.com/Jiruu246/rms/internal/ent/restaurant"
+	"github.com/google/uuid"
+)
+
+// OrderEventCreate is the builder for creating a OrderEvent entity.
+type OrderEventCreate struct {
+	config
+	mutation *OrderEventMutation
+	hooks    []Hook
+}
+
+// SetCreateTime sets the "create_time" field.
+func (_c *OrderEventCreate) SetCreateTime(v time.Time) *OrderEventCreate {
+	_c.mutation.SetCreateTime(v)
+	return _c
+}
+
+// SetNillableCreateTime sets the "create_time" field if the given value is not nil.
+func (_c *OrderEventCreate) SetNillableCreateTime(v *time.Time) *OrderEventCreate {
+	if v != nil {
+		_c.SetCreateTime(*v)
+	}
+	return _c
+}
+
+// SetSeq sets the "seq" field.
+func (_c *OrderEventCreate) SetSeq(v int64) *OrderEventCreate {
+	_c.mutation.SetSeq(v)
+	return _c
+}
+
+// SetType sets the "type" field.
+func (_c *OrderEventCreate) SetType(v orderevent.Type) *OrderEventCreate {
+	_c.mutation.SetType(v)
+	return _c
+}
+
+// SetOrderID sets the "order_id" field.
+func (_c *OrderEventCreate) SetOrderID(v uuid.UUID) *OrderEventCreate {
+	_c.mutation.SetOrderID(v)
+	return _c
+}
+
+// SetPayload sets the "payload" field.
+func (_c *OrderEventCreate) SetPayload(v json.RawMessage) *OrderEventCreate {
+	_c.mutation.SetPayload(v)
+	return _c
+}
+
+// SetStationID sets the "station_id" field.
+func (_c *OrderEventCreate) SetStationID(v uuid.UUID) *OrderEventCreate {
+	_c.mutation.SetStationID(v)
+	return _c
+}
+
+// SetNillableStationID sets the "station_id" field if the given value is not nil.
+func (_c *OrderEventCreate) SetNillableStationID(v *uuid.UUID) *OrderEventCreate {
+	if v != nil {
+		_c.SetStationID(*v)
+	}
+	return _c
+}
+
+// SetRestaurantID sets the "restaurant_id" field.
+func (_c *OrderEventCreate) SetRestaurantID(v uuid.UUID) *OrderEventCreate {
+	_c.mutation.SetRestaurantID(v)
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *OrderEventCreate) SetID(v uuid.UUID) *OrderEventCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *OrderEventCreate) SetNillableID(v *uuid.UUID) *OrderEventCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetRestaurant sets the "restaurant" edge to the Restaurant entity.
+func (_c *OrderEventCreate) SetRestaurant(v *Restaurant) *OrderEventCreate {
+	return _c.SetRestaurantID(v.ID)
+}
+
+// Mutation returns the OrderEventMutation object of the builder.
+func (_c *OrderEventCreate) Mutation() *OrderEventMutation {
+	return _c.mutation
+}
+
+// Save creates the OrderEvent in the database.
+func (_c *OrderEventCreate) Save(ctx context.Context) (*OrderEvent, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *OrderEventCreate) SaveX(ctx context.Context) *OrderEvent {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *OrderEventCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *OrderEventCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *OrderEventCreate) defaults() {
+	if _, ok := _c.mutation.CreateTime(); !ok {
+		v := orderevent.DefaultCreateTime()
+		_c.mutation.SetCreateTime(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := orderevent.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *OrderEventCreate) check() error {
+	if _, ok := _c.mutation.CreateTime(); !ok {
+		return &ValidationError{Name: "create_time", err: errors.New(`ent: missing required field "OrderEvent.create_time"`)}
+	}
+	if _, ok := _c.mutation.Seq(); !ok {
+		return &ValidationError{Name: "seq", err: errors.New(`ent: missing required field "OrderEvent.seq"`)}
+	}
+	if v, ok := _c.mutation.Seq(); ok {
+		if err := orderevent.SeqValidator(v); err != nil {
+			return &ValidationError{Name: "seq", err: fmt.Errorf(`ent: validator failed for field "OrderEvent.seq": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.GetType(); !ok {
+		return &ValidationError{Name: "type", err: errors.New(`ent: missing required field "OrderEvent.type"`)}
+	}
+	if v, ok := _c.mutation.GetType(); ok {
+		if err := orderevent.TypeValidator(v); err != nil {
+			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "OrderEvent.type": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.OrderID(); !ok {
+		return &ValidationError{Name: "order_id", err: errors.New(`ent: missing required field "OrderEvent.order_id"`)}
+	}
+	if _, ok := _c.mutation.RestaurantID(); !ok {
+		return &ValidationError{Name: "restaurant_id", err: errors.New(`ent: missing required field "OrderEvent.restaurant_id"`)}
+	}
+	if len(_c.mutation.RestaurantIDs()) == 0 {
+		return &ValidationError{Name: "restaurant", err: errors.New(`ent: missing required edge "OrderEvent.restaurant"`)}
+	}
+	return nil
+}
+
+func (_c *OrderEventCreate) sqlSave(ctx context.Context) (*OrderEvent, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *OrderEventCreate) createSpec() (*OrderEvent, *sqlgraph.CreateSpec) {
+	var (
+		_node = &OrderEvent{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(orderevent.Table, sqlgraph.NewFieldSpec(orderevent.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.CreateTime(); ok {
+		_spec.SetField(orderevent.FieldCreateTime, field.TypeTime, value)
+		_node.CreateTime = value
+	}
+	if value, ok := _c.mutation.Seq(); ok {
+		_spec.SetField(orderevent.FieldSeq, field.TypeInt64, value)
+		_node.Seq = value
+	}
+	if value, ok := _c.mutation.GetType(); ok {
+		_spec.SetField(orderevent.FieldType, field.TypeEnum, value)
+		_node.Type = value
+	}
+	if value, ok := _c.mutation.OrderID(); ok {
+		_spec.SetField(orderevent.FieldOrderID, field.TypeUUID, value)
+		_node.OrderID = value
+	}
+	if value, ok := _c.mutation.Payload(); ok {
+		_spec.SetField(orderevent.FieldPayload, field.TypeJSON, value)
+		_node.Payload = value
+	}
+	if value, ok := _c.mutation.StationID(); ok {
+		_spec.SetField(orderevent.FieldStationID, field.TypeUUID, value)
+		_node.StationID = &value
+	}
+	if nodes := _c.mutation.RestaurantIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   orderevent.RestaurantTable,
+			Columns: []string{orderevent.RestaurantColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(restaurant.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.RestaurantID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OrderEventCreateBulk is the builder for creating many OrderEvent entities in bulk.
+type OrderEventCreateBulk struct {
+	config
+	err      error
+	builders []*OrderEventCreate
+}
+
+// Save creates the OrderEvent entities in the database.
+func (_c *OrderEventCreateBulk) Save(ctx context.Context) ([]*OrderEvent, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*OrderEvent, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*OrderEventMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *OrderEventCreateBulk) SaveX(ctx context.Context) []*OrderEvent {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *OrderEventCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *OrderEventCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
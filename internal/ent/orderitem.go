@@ -5,12 +5,15 @@ package ent
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"github.com/Jiruu246/rms/internal/ent/menuitem"
+	"github.com/Jiruu246/rms/internal/ent/menuitemvariant"
 	"github.com/Jiruu246/rms/internal/ent/order"
 	"github.com/Jiruu246/rms/internal/ent/orderitem"
+	"github.com/Jiruu246/rms/internal/ent/stationticket"
 	"github.com/google/uuid"
 )
 
@@ -25,12 +28,28 @@ type OrderItem struct {
 	SpecialInstructions string `json:"special_instructions,omitempty"`
 	// Snapshot of the menu item name at the time of order
 	ItemName string `json:"item_name,omitempty"`
-	// Snapshot of the menu item price at the time of order
-	ItemPrice float64 `json:"item_price,omitempty"`
+	// Snapshot of the menu item price at the time of order, in minor units of the order currency
+	ItemPrice int64 `json:"item_price,omitempty"`
+	// Total of the selected modifier options across the whole line (already multiplied by quantity)
+	ModifiersTotal int64 `json:"modifiers_total,omitempty"`
+	// (item_price * quantity) + modifiers_total
+	LineTotal int64 `json:"line_total,omitempty"`
+	// Units of this line covered by item refunds; never more than quantity
+	RefundedQuantity int `json:"refunded_quantity,omitempty"`
 	// ID of the menu item
 	MenuItemID int64 `json:"menu_item_id,omitempty"`
+	// ID of the menu item variant ordered; cleared if the variant is deleted
+	VariantID *uuid.UUID `json:"variant_id,omitempty"`
+	// Snapshot of the variant name at the time of order; empty for items without variants
+	VariantName string `json:"variant_name,omitempty"`
 	// ID of the order this item belongs to
 	OrderID uuid.UUID `json:"order_id,omitempty"`
+	// ID of the station ticket this item was routed to; nil if its menu item has no station
+	StationTicketID *uuid.UUID `json:"station_ticket_id,omitempty"`
+	// When the line was voided; voided lines stay on the order and its ticket but are left out of its totals
+	VoidedAt *time.Time `json:"voided_at,omitempty"`
+	// Reason given for the void; required once the order has been sent to the kitchen
+	VoidReason string `json:"void_reason,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the OrderItemQuery when eager-loading is set.
 	Edges        OrderItemEdges `json:"edges"`
@@ -43,11 +62,17 @@ type OrderItemEdges struct {
 	Order *Order `json:"order,omitempty"`
 	// MenuItem holds the value of the menu_item edge.
 	MenuItem *MenuItem `json:"menu_item,omitempty"`
+	// Variant holds the value of the variant edge.
+	Variant *MenuItemVariant `json:"variant,omitempty"`
 	// OrderItemModifierOptions holds the value of the order_item_modifier_options edge.
 	OrderItemModifierOptions []*OrderItemModifierOption `json:"order_item_modifier_options,omitempty"`
+	// StationTicket holds the value of the station_ticket edge.
+	StationTicket *StationTicket `json:"station_ticket,omitempty"`
+	// Changes holds the value of the changes edge.
+	Changes []*OrderItemChange `json:"changes,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [3]bool
+	loadedTypes [6]bool
 }
 
 // OrderOrErr returns the Order value or an error if the edge
@@ -72,26 +97,59 @@ func (e OrderItemEdges) MenuItemOrErr() (*MenuItem, error) {
 	return nil, &NotLoadedError{edge: "menu_item"}
 }
 
+// VariantOrErr returns the Variant value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e OrderItemEdges) VariantOrErr() (*MenuItemVariant, error) {
+	if e.Variant != nil {
+		return e.Variant, nil
+	} else if e.loadedTypes[2] {
+		return nil, &NotFoundError{label: menuitemvariant.Label}
+	}
+	return nil, &NotLoadedError{edge: "variant"}
+}
+
 // OrderItemModifierOptionsOrErr returns the OrderItemModifierOptions value or an error if the edge
 // was not loaded in eager-loading.
 func (e OrderItemEdges) OrderItemModifierOptionsOrErr() ([]*OrderItemModifierOption, error) {
-	if e.loadedTypes[2] {
+	if e.loadedTypes[3] {
 		return e.OrderItemModifierOptions, nil
 	}
 	return nil, &NotLoadedError{edge: "order_item_modifier_options"}
 }
 
+// StationTicketOrErr returns the StationTicket value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e OrderItemEdges) StationTicketOrErr() (*StationTicket, error) {
+	if e.StationTicket != nil {
+		return e.StationTicket, nil
+	} else if e.loadedTypes[4] {
+		return nil, &NotFoundError{label: stationticket.Label}
+	}
+	return nil, &NotLoadedError{edge: "station_ticket"}
+}
+
+// ChangesOrErr returns the Changes value or an error if the edge
+// was not loaded in eager-loading.
+func (e OrderItemEdges) ChangesOrErr() ([]*OrderItemChange, error) {
+	if e.loadedTypes[5] {
+		return e.Changes, nil
+	}
+	return nil, &NotLoadedError{edge: "changes"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*OrderItem) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case orderitem.FieldItemPrice:
-			values[i] = new(sql.NullFloat64)
-		case orderitem.FieldQuantity, orderitem.FieldMenuItemID:
+		case orderitem.FieldVariantID, orderitem.FieldStationTicketID:
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case orderitem.FieldQuantity, orderitem.FieldItemPrice, orderitem.FieldModifiersTotal, orderitem.FieldLineTotal, orderitem.FieldRefundedQuantity, orderitem.FieldMenuItemID:
 			values[i] = new(sql.NullInt64)
-		case orderitem.FieldSpecialInstructions, orderitem.FieldItemName:
+		case orderitem.FieldSpecialInstructions, orderitem.FieldItemName, orderitem.FieldVariantName, orderitem.FieldVoidReason:
 			values[i] = new(sql.NullString)
+		case orderitem.FieldVoidedAt:
+			values[i] = new(sql.NullTime)
 		case orderitem.FieldID, orderitem.FieldOrderID:
 			values[i] = new(uuid.UUID)
 		default:
@@ -134,10 +192,28 @@ func (_m *OrderItem) assignValues(columns []string, values []any) error {
 				_m.ItemName = value.String
 			}
 		case orderitem.FieldItemPrice:
-			if value, ok := values[i].(*sql.NullFloat64); !ok {
+			if value, ok := values[i].(*sql.NullInt64); !ok {
 				return fmt.Errorf("unexpected type %T for field item_price", values[i])
 			} else if value.Valid {
-				_m.ItemPrice = value.Float64
+				_m.ItemPrice = value.Int64
+			}
+		case orderitem.FieldModifiersTotal:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field modifiers_total", values[i])
+			} else if value.Valid {
+				_m.ModifiersTotal = value.Int64
+			}
+		case orderitem.FieldLineTotal:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field line_total", values[i])
+			} else if value.Valid {
+				_m.LineTotal = value.Int64
+			}
+		case orderitem.FieldRefundedQuantity:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field refunded_quantity", values[i])
+			} else if value.Valid {
+				_m.RefundedQuantity = int(value.Int64)
 			}
 		case orderitem.FieldMenuItemID:
 			if value, ok := values[i].(*sql.NullInt64); !ok {
@@ -145,12 +221,45 @@ func (_m *OrderItem) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.MenuItemID = value.Int64
 			}
+		case orderitem.FieldVariantID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field variant_id", values[i])
+			} else if value.Valid {
+				_m.VariantID = new(uuid.UUID)
+				*_m.VariantID = *value.S.(*uuid.UUID)
+			}
+		case orderitem.FieldVariantName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field variant_name", values[i])
+			} else if value.Valid {
+				_m.VariantName = value.String
+			}
 		case orderitem.FieldOrderID:
 			if value, ok := values[i].(*uuid.UUID); !ok {
 				return fmt.Errorf("unexpected type %T for field order_id", values[i])
 			} else if value != nil {
 				_m.OrderID = *value
 			}
+		case orderitem.FieldStationTicketID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field station_ticket_id", values[i])
+			} else if value.Valid {
+				_m.StationTicketID = new(uuid.UUID)
+				*_m.StationTicketID = *value.S.(*uuid.UUID)
+			}
+		case orderitem.FieldVoidedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field voided_at", values[i])
+			} else if value.Valid {
+				_m.VoidedAt = new(time.Time)
+				*_m.VoidedAt = value.Time
+			}
+		case orderitem.FieldVoidReason:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field void_reason", values[i])
+			} else if value.Valid {
+				_m.VoidReason = value.String
+			}
 		default:
 			_m.selectValues.Set(columns[i], values[i])
 		}
@@ -174,11 +283,26 @@ func (_m *OrderItem) QueryMenuItem() *MenuItemQuery {
 	return NewOrderItemClient(_m.config).QueryMenuItem(_m)
 }
 
+// QueryVariant queries the "variant" edge of the OrderItem entity.
+func (_m *OrderItem) QueryVariant() *MenuItemVariantQuery {
+	return NewOrderItemClient(_m.config).QueryVariant(_m)
+}
+
 // QueryOrderItemModifierOptions queries the "order_item_modifier_options" edge of the OrderItem entity.
 func (_m *OrderItem) QueryOrderItemModifierOptions() *OrderItemModifierOptionQuery {
 	return NewOrderItemClient(_m.config).QueryOrderItemModifierOptions(_m)
 }
 
+// QueryStationTicket queries the "station_ticket" edge of the OrderItem entity.
+func (_m *OrderItem) QueryStationTicket() *StationTicketQuery {
+	return NewOrderItemClient(_m.config).QueryStationTicket(_m)
+}
+
+// QueryChanges queries the "changes" edge of the OrderItem entity.
+func (_m *OrderItem) QueryChanges() *OrderItemChangeQuery {
+	return NewOrderItemClient(_m.config).QueryChanges(_m)
+}
+
 // Update returns a builder for updating this OrderItem.
 // Note that you need to call OrderItem.Unwrap() before calling this method if this OrderItem
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -214,11 +338,41 @@ func (_m *OrderItem) String() string {
 	builder.WriteString("item_price=")
 	builder.WriteString(fmt.Sprintf("%v", _m.ItemPrice))
 	builder.WriteString(", ")
+	builder.WriteString("modifiers_total=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ModifiersTotal))
+	builder.WriteString(", ")
+	builder.WriteString("line_total=")
+	builder.WriteString(fmt.Sprintf("%v", _m.LineTotal))
+	builder.WriteString(", ")
+	builder.WriteString("refunded_quantity=")
+	builder.WriteString(fmt.Sprintf("%v", _m.RefundedQuantity))
+	builder.WriteString(", ")
 	builder.WriteString("menu_item_id=")
 	builder.WriteString(fmt.Sprintf("%v", _m.MenuItemID))
 	builder.WriteString(", ")
+	if v := _m.VariantID; v != nil {
+		builder.WriteString("variant_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("variant_name=")
+	builder.WriteString(_m.VariantName)
+	builder.WriteString(", ")
 	builder.WriteString("order_id=")
 	builder.WriteString(fmt.Sprintf("%v", _m.OrderID))
+	builder.WriteString(", ")
+	if v := _m.StationTicketID; v != nil {
+		builder.WriteString("station_ticket_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.VoidedAt; v != nil {
+		builder.WriteString("voided_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("void_reason=")
+	builder.WriteString(_m.VoidReason)
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -21,16 +21,38 @@ const (
 	FieldItemName = "item_name"
 	// FieldItemPrice holds the string denoting the item_price field in the database.
 	FieldItemPrice = "item_price"
+	// FieldModifiersTotal holds the string denoting the modifiers_total field in the database.
+	FieldModifiersTotal = "modifiers_total"
+	// FieldLineTotal holds the string denoting the line_total field in the database.
+	FieldLineTotal = "line_total"
+	// FieldRefundedQuantity holds the string denoting the refunded_quantity field in the database.
+	FieldRefundedQuantity = "refunded_quantity"
 	// FieldMenuItemID holds the string denoting the menu_item_id field in the database.
 	FieldMenuItemID = "menu_item_id"
+	// FieldVariantID holds the string denoting the variant_id field in the database.
+	FieldVariantID = "variant_id"
+	// FieldVariantName holds the string denoting the variant_name field in the database.
+	FieldVariantName = "variant_name"
 	// FieldOrderID holds the string denoting the order_id field in the database.
 	FieldOrderID = "order_id"
+	// FieldStationTicketID holds the string denoting the station_ticket_id field in the database.
+	FieldStationTicketID = "station_ticket_id"
+	// FieldVoidedAt holds the string denoting the voided_at field in the database.
+	FieldVoidedAt = "voided_at"
+	// FieldVoidReason holds the string denoting the void_reason field in the database.
+	FieldVoidReason = "void_reason"
 	// EdgeOrder holds the string denoting the order edge name in mutations.
 	EdgeOrder = "order"
 	// EdgeMenuItem holds the string denoting the menu_item edge name in mutations.
 	EdgeMenuItem = "menu_item"
+	// EdgeVariant holds the string denoting the variant edge name in mutations.
+	EdgeVariant = "variant"
 	// EdgeOrderItemModifierOptions holds the string denoting the order_item_modifier_options edge name in mutations.
 	EdgeOrderItemModifierOptions = "order_item_modifier_options"
+	// EdgeStationTicket holds the string denoting the station_ticket edge name in mutations.
+	EdgeStationTicket = "station_ticket"
+	// EdgeChanges holds the string denoting the changes edge name in mutations.
+	EdgeChanges = "changes"
 	// Table holds the table name of the orderitem in the database.
 	Table = "order_items"
 	// OrderTable is the table that holds the order relation/edge.
@@ -47,6 +69,13 @@ const (
 	MenuItemInverseTable = "menu_items"
 	// MenuItemColumn is the table column denoting the menu_item relation/edge.
 	MenuItemColumn = "menu_item_id"
+	// VariantTable is the table that holds the variant relation/edge.
+	VariantTable = "order_items"
+	// VariantInverseTable is the table name for the MenuItemVariant entity.
+	// It exists in this package in order to avoid circular dependency with the "menuitemvariant" package.
+	VariantInverseTable = "menu_item_variants"
+	// VariantColumn is the table column denoting the variant relation/edge.
+	VariantColumn = "variant_id"
 	// OrderItemModifierOptionsTable is the table that holds the order_item_modifier_options relation/edge.
 	OrderItemModifierOptionsTable = "order_item_modifier_options"
 	// OrderItemModifierOptionsInverseTable is the table name for the OrderItemModifierOption entity.
@@ -54,6 +83,20 @@ const (
 	OrderItemModifierOptionsInverseTable = "order_item_modifier_options"
 	// OrderItemModifierOptionsColumn is the table column denoting the order_item_modifier_options relation/edge.
 	OrderItemModifierOptionsColumn = "order_item_id"
+	// StationTicketTable is the table that holds the station_ticket relation/edge.
+	StationTicketTable = "order_items"
+	// StationTicketInverseTable is the table name for the StationTicket entity.
+	// It exists in this package in order to avoid circular dependency with the "stationticket" package.
+	StationTicketInverseTable = "station_tickets"
+	// StationTicketColumn is the table column denoting the station_ticket relation/edge.
+	StationTicketColumn = "station_ticket_id"
+	// ChangesTable is the table that holds the changes relation/edge.
+	ChangesTable = "order_item_changes"
+	// ChangesInverseTable is the table name for the OrderItemChange entity.
+	// It exists in this package in order to avoid circular dependency with the "orderitemchange" package.
+	ChangesInverseTable = "order_item_changes"
+	// ChangesColumn is the table column denoting the changes relation/edge.
+	ChangesColumn = "order_item_id"
 )
 
 // Columns holds all SQL columns for orderitem fields.
@@ -63,8 +106,16 @@ var Columns = []string{
 	FieldSpecialInstructions,
 	FieldItemName,
 	FieldItemPrice,
+	FieldModifiersTotal,
+	FieldLineTotal,
+	FieldRefundedQuantity,
 	FieldMenuItemID,
+	FieldVariantID,
+	FieldVariantName,
 	FieldOrderID,
+	FieldStationTicketID,
+	FieldVoidedAt,
+	FieldVoidReason,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -84,6 +135,24 @@ var (
 	QuantityValidator func(int) error
 	// ItemNameValidator is a validator for the "item_name" field. It is called by the builders before save.
 	ItemNameValidator func(string) error
+	// DefaultModifiersTotal holds the default value on creation for the "modifiers_total" field.
+	DefaultModifiersTotal int64
+	// ModifiersTotalValidator is a validator for the "modifiers_total" field. It is called by the builders before save.
+	ModifiersTotalValidator func(int64) error
+	// DefaultLineTotal holds the default value on creation for the "line_total" field.
+	DefaultLineTotal int64
+	// LineTotalValidator is a validator for the "line_total" field. It is called by the builders before save.
+	LineTotalValidator func(int64) error
+	// DefaultRefundedQuantity holds the default value on creation for the "refunded_quantity" field.
+	DefaultRefundedQuantity int
+	// RefundedQuantityValidator is a validator for the "refunded_quantity" field. It is called by the builders before save.
+	RefundedQuantityValidator func(int) error
+	// DefaultVariantName holds the default value on creation for the "variant_name" field.
+	DefaultVariantName string
+	// DefaultVoidReason holds the default value on creation for the "void_reason" field.
+	DefaultVoidReason string
+	// VoidReasonValidator is a validator for the "void_reason" field. It is called by the builders before save.
+	VoidReasonValidator func(string) error
 	// DefaultID holds the default value on creation for the "id" field.
 	DefaultID func() uuid.UUID
 )
@@ -116,16 +185,56 @@ func ByItemPrice(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldItemPrice, opts...).ToFunc()
 }
 
+// ByModifiersTotal orders the results by the modifiers_total field.
+func ByModifiersTotal(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldModifiersTotal, opts...).ToFunc()
+}
+
+// ByLineTotal orders the results by the line_total field.
+func ByLineTotal(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLineTotal, opts...).ToFunc()
+}
+
+// ByRefundedQuantity orders the results by the refunded_quantity field.
+func ByRefundedQuantity(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRefundedQuantity, opts...).ToFunc()
+}
+
 // ByMenuItemID orders the results by the menu_item_id field.
 func ByMenuItemID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldMenuItemID, opts...).ToFunc()
 }
 
+// ByVariantID orders the results by the variant_id field.
+func ByVariantID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldVariantID, opts...).ToFunc()
+}
+
+// ByVariantName orders the results by the variant_name field.
+func ByVariantName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldVariantName, opts...).ToFunc()
+}
+
 // ByOrderID orders the results by the order_id field.
 func ByOrderID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldOrderID, opts...).ToFunc()
 }
 
+// ByStationTicketID orders the results by the station_ticket_id field.
+func ByStationTicketID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStationTicketID, opts...).ToFunc()
+}
+
+// ByVoidedAt orders the results by the voided_at field.
+func ByVoidedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldVoidedAt, opts...).ToFunc()
+}
+
+// ByVoidReason orders the results by the void_reason field.
+func ByVoidReason(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldVoidReason, opts...).ToFunc()
+}
+
 // ByOrderField orders the results by order field.
 func ByOrderField(field string, opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -140,6 +249,13 @@ func ByMenuItemField(field string, opts ...sql.OrderTermOption) OrderOption {
 	}
 }
 
+// ByVariantField orders the results by variant field.
+func ByVariantField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newVariantStep(), sql.OrderByField(field, opts...))
+	}
+}
+
 // ByOrderItemModifierOptionsCount orders the results by order_item_modifier_options count.
 func ByOrderItemModifierOptionsCount(opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -153,6 +269,27 @@ func ByOrderItemModifierOptions(term sql.OrderTerm, terms ...sql.OrderTerm) Orde
 		sqlgraph.OrderByNeighborTerms(s, newOrderItemModifierOptionsStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
+
+// ByStationTicketField orders the results by station_ticket field.
+func ByStationTicketField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newStationTicketStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByChangesCount orders the results by changes count.
+func ByChangesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newChangesStep(), opts...)
+	}
+}
+
+// ByChanges orders the results by changes terms.
+func ByChanges(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newChangesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newOrderStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -167,6 +304,13 @@ func newMenuItemStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.M2O, true, MenuItemTable, MenuItemColumn),
 	)
 }
+func newVariantStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(VariantInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, VariantTable, VariantColumn),
+	)
+}
 func newOrderItemModifierOptionsStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -174,3 +318,17 @@ func newOrderItemModifierOptionsStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, false, OrderItemModifierOptionsTable, OrderItemModifierOptionsColumn),
 	)
 }
+func newStationTicketStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(StationTicketInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, StationTicketTable, StationTicketColumn),
+	)
+}
+func newChangesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ChangesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, ChangesTable, ChangesColumn),
+	)
+}
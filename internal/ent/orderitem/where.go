@@ -3,6 +3,8 @@
 package orderitem
 
 import (
+	"time"
+
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"github.com/Jiruu246/rms/internal/ent/predicate"
@@ -70,20 +72,60 @@ func ItemName(v string) predicate.OrderItem {
 }
 
 // ItemPrice applies equality check predicate on the "item_price" field. It's identical to ItemPriceEQ.
-func ItemPrice(v float64) predicate.OrderItem {
+func ItemPrice(v int64) predicate.OrderItem {
 	return predicate.OrderItem(sql.FieldEQ(FieldItemPrice, v))
 }
 
+// ModifiersTotal applies equality check predicate on the "modifiers_total" field. It's identical to ModifiersTotalEQ.
+func ModifiersTotal(v int64) predicate.OrderItem {
+	return predicate.OrderItem(sql.FieldEQ(FieldModifiersTotal, v))
+}
+
+// LineTotal applies equality check predicate on the "line_total" field. It's identical to LineTotalEQ.
+func LineTotal(v int64) predicate.OrderItem {
+	return predicate.OrderItem(sql.FieldEQ(FieldLineTotal, v))
+}
+
+// RefundedQuantity applies equality check predicate on the "refunded_quantity" field. It's identical to RefundedQuantityEQ.
+func RefundedQuantity(v int) predicate.OrderItem {
+	return predicate.OrderItem(sql.FieldEQ(FieldRefundedQuantity, v))
+}
+
 // MenuItemID applies equality check predicate on the "menu_item_id" field. It's identical to MenuItemIDEQ.
 func MenuItemID(v int64) predicate.OrderItem {
 	return predicate.OrderItem(sql.FieldEQ(FieldMenuItemID, v))
 }
 
+// VariantID applies equality check predicate on the "variant_id" field. It's identical to VariantIDEQ.
+func VariantID(v uuid.UUID) predicate.OrderItem {
+	return predicate.OrderItem(sql.FieldEQ(FieldVariantID, v))
+}
+
+// VariantName applies equality check predicate on the "variant_name" field. It's identical to VariantNameEQ.
+func VariantName(v string) predicate.OrderItem {
+	return predicate.OrderItem(sql.FieldEQ(FieldVariantName, v))
+}
+
 // OrderID applies equality check predicate on the "order_id" field. It's identical to OrderIDEQ.
 func OrderID(v uuid.UUID) predicate.OrderItem {
 	return predicate.OrderItem(sql.FieldEQ(FieldOrderID, v))
 }
 
+// StationTicketID applies equality check predicate on the "station_ticket_id" field. It's identical to StationTicketIDEQ.
+func StationTicketID(v uuid.UUID) predicate.OrderItem {
+	return predicate.OrderItem(sql.FieldEQ(FieldStationTicketID, v))
+}
+
+// VoidedAt applies equality check predicate on the "voided_at" field. It's identical to VoidedAtEQ.
+func VoidedAt(v time.Time) predicate.OrderItem {
+	return predicate.OrderItem(sql.FieldEQ(FieldVoidedAt, v))
+}
+
+// VoidReason applies equality check predicate on the "void_reason" field. It's identical to VoidReasonEQ.
+func VoidReason(v string) predicate.OrderItem {
+	return predicate.OrderItem(sql.FieldEQ(FieldVoidReason, v))
+}
+
 // QuantityEQ applies the EQ predicate on the "quantity" field.
 func QuantityEQ(v int) predicate.OrderItem {
 	return predicate.OrderItem(sql.FieldEQ(FieldQuantity, v))
@@ -265,45 +307,165 @@ func ItemNameContainsFold(v string) predicate.OrderItem {
 }
 
 // ItemPriceEQ applies the EQ predicate on the "item_price" field.
-func ItemPriceEQ(v float64) predicate.OrderItem {
+func ItemPriceEQ(v int64) predicate.OrderItem {
 	return predicate.OrderItem(sql.FieldEQ(FieldItemPrice, v))
 }
 
 // ItemPriceNEQ applies the NEQ predicate on the "item_price" field.
-func ItemPriceNEQ(v float64) predicate.OrderItem {
+func ItemPriceNEQ(v int64) predicate.OrderItem {
 	return predicate.OrderItem(sql.FieldNEQ(FieldItemPrice, v))
 }
 
 // ItemPriceIn applies the In predicate on the "item_price" field.
-func ItemPriceIn(vs ...float64) predicate.OrderItem {
+func ItemPriceIn(vs ...int64) predicate.OrderItem {
 	return predicate.OrderItem(sql.FieldIn(FieldItemPrice, vs...))
 }
 
 // ItemPriceNotIn applies the NotIn predicate on the "item_price" field.
-func ItemPriceNotIn(vs ...float64) predicate.OrderItem {
+func ItemPriceNotIn(vs ...int64) predicate.OrderItem {
 	return predicate.OrderItem(sql.FieldNotIn(FieldItemPrice, vs...))
 }
 
 // ItemPriceGT applies the GT predicate on the "item_price" field.
-func ItemPriceGT(v float64) predicate.OrderItem {
+func ItemPriceGT(v int64) predicate.OrderItem {
 	return predicate.OrderItem(sql.FieldGT(FieldItemPrice, v))
 }
 
 // ItemPriceGTE applies the GTE predicate on the "item_price" field.
-func ItemPriceGTE(v float64) predicate.OrderItem {
+func ItemPriceGTE(v int64) predicate.OrderItem {
 	return predicate.OrderItem(sql.FieldGTE(FieldItemPrice, v))
 }
 
 // ItemPriceLT applies the LT predicate on the "item_price" field.
-func ItemPriceLT(v float64) predicate.OrderItem {
+func ItemPriceLT(v int64) predicate.OrderItem {
 	return predicate.OrderItem(sql.FieldLT(FieldItemPrice, v))
 }
 
 // ItemPriceLTE applies the LTE predicate on the "item_price" field.
-func ItemPriceLTE(v float64) predicate.OrderItem {
+func ItemPriceLTE(v int64) predicate.OrderItem {
 	return predicate.OrderItem(sql.FieldLTE(FieldItemPrice, v))
 }
 
+// ModifiersTotalEQ applies the EQ predicate on the "modifiers_total" field.
+func ModifiersTotalEQ(v int64) predicate.OrderItem {
+	return predicate.OrderItem(sql.FieldEQ(FieldModifiersTotal, v))
+}
+
+// ModifiersTotalNEQ applies the NEQ predicate on the "modifiers_total" field.
+func ModifiersTotalNEQ(v int64) predicate.OrderItem {
+	return predicate.OrderItem(sql.FieldNEQ(FieldModifiersTotal, v))
+}
+
+// ModifiersTotalIn applies the In predicate on the "modifiers_total" field.
+func ModifiersTotalIn(vs ...int64) predicate.OrderItem {
+	return predicate.OrderItem(sql.FieldIn(FieldModifiersTotal, vs...))
+}
+
+// ModifiersTotalNotIn applies the NotIn predicate on the "modifiers_total" field.
+func ModifiersTotalNotIn(vs ...int64) predicate.OrderItem {
+	return predicate.OrderItem(sql.FieldNotIn(FieldModifiersTotal, vs...))
+}
+
+// ModifiersTotalGT applies the GT predicate on the "modifiers_total" field.
+func ModifiersTotalGT(v int64) predicate.OrderItem {
+	return predicate.OrderItem(sql.FieldGT(FieldModifiersTotal, v))
+}
+
+// ModifiersTotalGTE applies the GTE predicate on the "modifiers_total" field.
+func ModifiersTotalGTE(v int64) predicate.OrderItem {
+	return predicate.OrderItem(sql.FieldGTE(FieldModifiersTotal, v))
+}
+
+// ModifiersTotalLT applies the LT predicate on the "modifiers_total" field.
+func ModifiersTotalLT(v int64) predicate.OrderItem {
+	return predicate.OrderItem(sql.FieldLT(FieldModifiersTotal, v))
+}
+
+// ModifiersTotalLTE applies the LTE predicate on the "modifiers_total" field.
+func ModifiersTotalLTE(v int64) predicate.OrderItem {
+	return predicate.OrderItem(sql.FieldLTE(FieldModifiersTotal, v))
+}
+
+// LineTotalEQ applies the EQ predicate on the "line_total" field.
+func LineTotalEQ(v int64) predicate.OrderItem {
+	return predicate.OrderItem(sql.FieldEQ(FieldLineTotal, v))
+}
+
+// LineTotalNEQ applies the NEQ predicate on the "line_total" field.
+func LineTotalNEQ(v int64) predicate.OrderItem {
+	return predicate.OrderItem(sql.FieldNEQ(FieldLineTotal, v))
+}
+
+// LineTotalIn applies the In predicate on the "line_total" field.
+func LineTotalIn(vs ...int64) predicate.OrderItem {
+	return predicate.OrderItem(sql.FieldIn(FieldLineTotal, vs...))
+}
+
+// LineTotalNotIn applies the NotIn predicate on the "line_total" field.
+func LineTotalNotIn(vs ...int64) predicate.OrderItem {
+	return predicate.OrderItem(sql.FieldNotIn(FieldLineTotal, vs...))
+}
+
+// LineTotalGT applies the GT predicate on the "line_total" field.
+func LineTotalGT(v int64) predicate.OrderItem {
+	return predicate.OrderItem(sql.FieldGT(FieldLineTotal, v))
+}
+
+// LineTotalGTE applies the GTE predicate on the "line_total" field.
+func LineTotalGTE(v int64) predicate.OrderItem {
+	return predicate.OrderItem(sql.FieldGTE(FieldLineTotal, v))
+}
+
+// LineTotalLT applies the LT predicate on the "line_total" field.
+func LineTotalLT(v int64) predicate.OrderItem {
+	return predicate.OrderItem(sql.FieldLT(FieldLineTotal, v))
+}
+
+// LineTotalLTE applies the LTE predicate on the "line_total" field.
+func LineTotalLTE(v int64) predicate.OrderItem {
+	return predicate.OrderItem(sql.FieldLTE(FieldLineTotal, v))
+}
+
+// RefundedQuantityEQ applies the EQ predicate on the "refunded_quantity" field.
+func RefundedQuantityEQ(v int) predicate.OrderItem {
+	return predicate.OrderItem(sql.FieldEQ(FieldRefundedQuantity, v))
+}
+
+// RefundedQuantityNEQ applies the NEQ predicate on the "refunded_quantity" field.
+func RefundedQuantityNEQ(v int) predicate.OrderItem {
+	return predicate.OrderItem(sql.FieldNEQ(FieldRefundedQuantity, v))
+}
+
+// RefundedQuantityIn applies the In predicate on the "refunded_quantity" field.
+func RefundedQuantityIn(vs ...int) predicate.OrderItem {
+	return predicate.OrderItem(sql.FieldIn(FieldRefundedQuantity, vs...))
+}
+
+// RefundedQuantityNotIn applies the NotIn predicate on the "refunded_quantity" field.
+func RefundedQuantityNotIn(vs ...int) predicate.OrderItem {
+	return predicate.OrderItem(sql.FieldNotIn(FieldRefundedQuantity, vs...))
+}
+
+// RefundedQuantityGT applies the GT predicate on the "refunded_quantity" field.
+func RefundedQuantityGT(v int) predicate.OrderItem {
+	return predicate.OrderItem(sql.FieldGT(FieldRefundedQuantity, v))
+}
+
+// RefundedQuantityGTE applies the GTE predicate on the "refunded_quantity" field.
+func RefundedQuantityGTE(v int) predicate.OrderItem {
+	return predicate.OrderItem(sql.FieldGTE(FieldRefundedQuantity, v))
+}
+
+// RefundedQuantityLT applies the LT predicate on the "refunded_quantity" field.
+func RefundedQuantityLT(v int) predicate.OrderItem {
+	return predicate.OrderItem(sql.FieldLT(FieldRefundedQuantity, v))
+}
+
+// RefundedQuantityLTE applies the LTE predicate on the "refunded_quantity" field.
+func RefundedQuantityLTE(v int) predicate.OrderItem {
+	return predicate.OrderItem(sql.FieldLTE(FieldRefundedQuantity, v))
+}
+
 // MenuItemIDEQ applies the EQ predicate on the "menu_item_id" field.
 func MenuItemIDEQ(v int64) predicate.OrderItem {
 	return predicate.OrderItem(sql.FieldEQ(FieldMenuItemID, v))
@@ -324,6 +486,101 @@ func MenuItemIDNotIn(vs ...int64) predicate.OrderItem {
 	return predicate.OrderItem(sql.FieldNotIn(FieldMenuItemID, vs...))
 }
 
+// VariantIDEQ applies the EQ predicate on the "variant_id" field.
+func VariantIDEQ(v uuid.UUID) predicate.OrderItem {
+	return predicate.OrderItem(sql.FieldEQ(FieldVariantID, v))
+}
+
+// VariantIDNEQ applies the NEQ predicate on the "variant_id" field.
+func VariantIDNEQ(v uuid.UUID) predicate.OrderItem {
+	return predicate.OrderItem(sql.FieldNEQ(FieldVariantID, v))
+}
+
+// VariantIDIn applies the In predicate on the "variant_id" field.
+func VariantIDIn(vs ...uuid.UUID) predicate.OrderItem {
+	return predicate.OrderItem(sql.FieldIn(FieldVariantID, vs...))
+}
+
+// VariantIDNotIn applies the NotIn predicate on the "variant_id" field.
+func VariantIDNotIn(vs ...uuid.UUID) predicate.OrderItem {
+	return predicate.OrderItem(sql.FieldNotIn(FieldVariantID, vs...))
+}
+
+// VariantIDIsNil applies the IsNil predicate on the "variant_id" field.
+func VariantIDIsNil() predicate.OrderItem {
+	return predicate.OrderItem(sql.FieldIsNull(FieldVariantID))
+}
+
+// VariantIDNotNil applies the NotNil predicate on the "variant_id" field.
+func VariantIDNotNil() predicate.OrderItem {
+	return predicate.OrderItem(sql.FieldNotNull(FieldVariantID))
+}
+
+// VariantNameEQ applies the EQ predicate on the "variant_name" field.
+func VariantNameEQ(v string) predicate.OrderItem {
+	return predicate.OrderItem(sql.FieldEQ(FieldVariantName, v))
+}
+
+// VariantNameNEQ applies the NEQ predicate on the "variant_name" field.
+func VariantNameNEQ(v string) predicate.OrderItem {
+	return predicate.OrderItem(sql.FieldNEQ(FieldVariantName, v))
+}
+
+// VariantNameIn applies the In predicate on the "variant_name" field.
+func VariantNameIn(vs ...string) predicate.OrderItem {
+	return predicate.OrderItem(sql.FieldIn(FieldVariantName, vs...))
+}
+
+// VariantNameNotIn applies the NotIn predicate on the "variant_name" field.
+func VariantNameNotIn(vs ...string) predicate.OrderItem {
+	return predicate.OrderItem(sql.FieldNotIn(FieldVariantName, vs...))
+}
+
+// VariantNameGT applies the GT predicate on the "variant_name" field.
+func VariantNameGT(v string) predicate.OrderItem {
+	return predicate.OrderItem(sql.FieldGT(FieldVariantName, v))
+}
+
+// VariantNameGTE applies the GTE predicate on the "variant_name" field.
+func VariantNameGTE(v string) predicate.OrderItem {
+	return predicate.OrderItem(sql.FieldGTE(FieldVariantName, v))
+}
+
+// VariantNameLT applies the LT predicate on the "variant_name" field.
+func VariantNameLT(v string) predicate.OrderItem {
+	return predicate.OrderItem(sql.FieldLT(FieldVariantName, v))
+}
+
+// VariantNameLTE applies the LTE predicate on the "variant_name" field.
+func VariantNameLTE(v string) predicate.OrderItem {
+	return predicate.OrderItem(sql.FieldLTE(FieldVariantName, v))
+}
+
+// VariantNameContains applies the Contains predicate on the "variant_name" field.
+func VariantNameContains(v string) predicate.OrderItem {
+	return predicate.OrderItem(sql.FieldContains(FieldVariantName, v))
+}
+
+// VariantNameHasPrefix applies the HasPrefix predicate on the "variant_name" field.
+func VariantNameHasPrefix(v string) predicate.OrderItem {
+	return predicate.OrderItem(sql.FieldHasPrefix(FieldVariantName, v))
+}
+
+// VariantNameHasSuffix applies the HasSuffix predicate on the "variant_name" field.
+func VariantNameHasSuffix(v string) predicate.OrderItem {
+	return predicate.OrderItem(sql.FieldHasSuffix(FieldVariantName, v))
+}
+
+// VariantNameEqualFold applies the EqualFold predicate on the "variant_name" field.
+func VariantNameEqualFold(v string) predicate.OrderItem {
+	return predicate.OrderItem(sql.FieldEqualFold(FieldVariantName, v))
+}
+
+// VariantNameContainsFold applies the ContainsFold predicate on the "variant_name" field.
+func VariantNameContainsFold(v string) predicate.OrderItem {
+	return predicate.OrderItem(sql.FieldContainsFold(FieldVariantName, v))
+}
+
 // OrderIDEQ applies the EQ predicate on the "order_id" field.
 func OrderIDEQ(v uuid.UUID) predicate.OrderItem {
 	return predicate.OrderItem(sql.FieldEQ(FieldOrderID, v))
@@ -344,6 +601,151 @@ func OrderIDNotIn(vs ...uuid.UUID) predicate.OrderItem {
 	return predicate.OrderItem(sql.FieldNotIn(FieldOrderID, vs...))
 }
 
+// StationTicketIDEQ applies the EQ predicate on the "station_ticket_id" field.
+func StationTicketIDEQ(v uuid.UUID) predicate.OrderItem {
+	return predicate.OrderItem(sql.FieldEQ(FieldStationTicketID, v))
+}
+
+// StationTicketIDNEQ applies the NEQ predicate on the "station_ticket_id" field.
+func StationTicketIDNEQ(v uuid.UUID) predicate.OrderItem {
+	return predicate.OrderItem(sql.FieldNEQ(FieldStationTicketID, v))
+}
+
+// StationTicketIDIn applies the In predicate on the "station_ticket_id" field.
+func StationTicketIDIn(vs ...uuid.UUID) predicate.OrderItem {
+	return predicate.OrderItem(sql.FieldIn(FieldStationTicketID, vs...))
+}
+
+// StationTicketIDNotIn applies the NotIn predicate on the "station_ticket_id" field.
+func StationTicketIDNotIn(vs ...uuid.UUID) predicate.OrderItem {
+	return predicate.OrderItem(sql.FieldNotIn(FieldStationTicketID, vs...))
+}
+
+// StationTicketIDIsNil applies the IsNil predicate on the "station_ticket_id" field.
+func StationTicketIDIsNil() predicate.OrderItem {
+	return predicate.OrderItem(sql.FieldIsNull(FieldStationTicketID))
+}
+
+// StationTicketIDNotNil applies the NotNil predicate on the "station_ticket_id" field.
+func StationTicketIDNotNil() predicate.OrderItem {
+	return predicate.OrderItem(sql.FieldNotNull(FieldStationTicketID))
+}
+
+// VoidedAtEQ applies the EQ predicate on the "voided_at" field.
+func VoidedAtEQ(v time.Time) predicate.OrderItem {
+	return predicate.OrderItem(sql.FieldEQ(FieldVoidedAt, v))
+}
+
+// VoidedAtNEQ applies the NEQ predicate on the "voided_at" field.
+func VoidedAtNEQ(v time.Time) predicate.OrderItem {
+	return predicate.OrderItem(sql.FieldNEQ(FieldVoidedAt, v))
+}
+
+// VoidedAtIn applies the In predicate on the "voided_at" field.
+func VoidedAtIn(vs ...time.Time) predicate.OrderItem {
+	return predicate.OrderItem(sql.FieldIn(FieldVoidedAt, vs...))
+}
+
+// VoidedAtNotIn applies the NotIn predicate on the "voided_at" field.
+func VoidedAtNotIn(vs ...time.Time) predicate.OrderItem {
+	return predicate.OrderItem(sql.FieldNotIn(FieldVoidedAt, vs...))
+}
+
+// VoidedAtGT applies the GT predicate on the "voided_at" field.
+func VoidedAtGT(v time.Time) predicate.OrderItem {
+	return predicate.OrderItem(sql.FieldGT(FieldVoidedAt, v))
+}
+
+// VoidedAtGTE applies the GTE predicate on the "voided_at" field.
+func VoidedAtGTE(v time.Time) predicate.OrderItem {
+	return predicate.OrderItem(sql.FieldGTE(FieldVoidedAt, v))
+}
+
+// VoidedAtLT applies the LT predicate on the "voided_at" field.
+func VoidedAtLT(v time.Time) predicate.OrderItem {
+	return predicate.OrderItem(sql.FieldLT(FieldVoidedAt, v))
+}
+
+// VoidedAtLTE applies the LTE predicate on the "voided_at" field.
+func VoidedAtLTE(v time.Time) predicate.OrderItem {
+	return predicate.OrderItem(sql.FieldLTE(FieldVoidedAt, v))
+}
+
+// VoidedAtIsNil applies the IsNil predicate on the "voided_at" field.
+func VoidedAtIsNil() predicate.OrderItem {
+	return predicate.OrderItem(sql.FieldIsNull(FieldVoidedAt))
+}
+
+// VoidedAtNotNil applies the NotNil predicate on the "voided_at" field.
+func VoidedAtNotNil() predicate.OrderItem {
+	return predicate.OrderItem(sql.FieldNotNull(FieldVoidedAt))
+}
+
+// VoidReasonEQ applies the EQ predicate on the "void_reason" field.
+func VoidReasonEQ(v string) predicate.OrderItem {
+	return predicate.OrderItem(sql.FieldEQ(FieldVoidReason, v))
+}
+
+// VoidReasonNEQ applies the NEQ predicate on the "void_reason" field.
+func VoidReasonNEQ(v string) predicate.OrderItem {
+	return predicate.OrderItem(sql.FieldNEQ(FieldVoidReason, v))
+}
+
+// VoidReasonIn applies the In predicate on the "void_reason" field.
+func VoidReasonIn(vs ...string) predicate.OrderItem {
+	return predicate.OrderItem(sql.FieldIn(FieldVoidReason, vs...))
+}
+
+// VoidReasonNotIn applies the NotIn predicate on the "void_reason" field.
+func VoidReasonNotIn(vs ...string) predicate.OrderItem {
+	return predicate.OrderItem(sql.FieldNotIn(FieldVoidReason, vs...))
+}
+
+// VoidReasonGT applies the GT predicate on the "void_reason" field.
+func VoidReasonGT(v string) predicate.OrderItem {
+	return predicate.OrderItem(sql.FieldGT(FieldVoidReason, v))
+}
+
+// VoidReasonGTE applies the GTE predicate on the "void_reason" field.
+func VoidReasonGTE(v string) predicate.OrderItem {
+	return predicate.OrderItem(sql.FieldGTE(FieldVoidReason, v))
+}
+
+// VoidReasonLT applies the LT predicate on the "void_reason" field.
+func VoidReasonLT(v string) predicate.OrderItem {
+	return predicate.OrderItem(sql.FieldLT(FieldVoidReason, v))
+}
+
+// VoidReasonLTE applies the LTE predicate on the "void_reason" field.
+func VoidReasonLTE(v string) predicate.OrderItem {
+	return predicate.OrderItem(sql.FieldLTE(FieldVoidReason, v))
+}
+
+// VoidReasonContains applies the Contains predicate on the "void_reason" field.
+func VoidReasonContains(v string) predicate.OrderItem {
+	return predicate.OrderItem(sql.FieldContains(FieldVoidReason, v))
+}
+
+// VoidReasonHasPrefix applies the HasPrefix predicate on the "void_reason" field.
+func VoidReasonHasPrefix(v string) predicate.OrderItem {
+	return predicate.OrderItem(sql.FieldHasPrefix(FieldVoidReason, v))
+}
+
+// VoidReasonHasSuffix applies the HasSuffix predicate on the "void_reason" field.
+func VoidReasonHasSuffix(v string) predicate.OrderItem {
+	return predicate.OrderItem(sql.FieldHasSuffix(FieldVoidReason, v))
+}
+
+// VoidReasonEqualFold applies the EqualFold predicate on the "void_reason" field.
+func VoidReasonEqualFold(v string) predicate.OrderItem {
+	return predicate.OrderItem(sql.FieldEqualFold(FieldVoidReason, v))
+}
+
+// VoidReasonContainsFold applies the ContainsFold predicate on the "void_reason" field.
+func VoidReasonContainsFold(v string) predicate.OrderItem {
+	return predicate.OrderItem(sql.FieldContainsFold(FieldVoidReason, v))
+}
+
 // HasOrder applies the HasEdge predicate on the "order" edge.
 func HasOrder() predicate.OrderItem {
 	return predicate.OrderItem(func(s *sql.Selector) {
@@ -390,6 +792,29 @@ func HasMenuItemWith(preds ...predicate.MenuItem) predicate.OrderItem {
 	})
 }
 
+// HasVariant applies the HasEdge predicate on the "variant" edge.
+func HasVariant() predicate.OrderItem {
+	return predicate.OrderItem(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, VariantTable, VariantColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasVariantWith applies the HasEdge predicate on the "variant" edge with a given conditions (other predicates).
+func HasVariantWith(preds ...predicate.MenuItemVariant) predicate.OrderItem {
+	return predicate.OrderItem(func(s *sql.Selector) {
+		step := newVariantStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // HasOrderItemModifierOptions applies the HasEdge predicate on the "order_item_modifier_options" edge.
 func HasOrderItemModifierOptions() predicate.OrderItem {
 	return predicate.OrderItem(func(s *sql.Selector) {
@@ -413,6 +838,52 @@ func HasOrderItemModifierOptionsWith(preds ...predicate.OrderItemModifierOption)
 	})
 }
 
+// HasStationTicket applies the HasEdge predicate on the "station_ticket" edge.
+func HasStationTicket() predicate.OrderItem {
+	return predicate.OrderItem(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, StationTicketTable, StationTicketColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasStationTicketWith applies the HasEdge predicate on the "station_ticket" edge with a given conditions (other predicates).
+func HasStationTicketWith(preds ...predicate.StationTicket) predicate.OrderItem {
+	return predicate.OrderItem(func(s *sql.Selector) {
+		step := newStationTicketStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasChanges applies the HasEdge predicate on the "changes" edge.
+func HasChanges() predicate.OrderItem {
+	return predicate.OrderItem(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ChangesTable, ChangesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasChangesWith applies the HasEdge predicate on the "changes" edge with a given conditions (other predicates).
+func HasChangesWith(preds ...predicate.OrderItemChange) predicate.OrderItem {
+	return predicate.OrderItem(func(s *sql.Selector) {
+		step := newChangesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.OrderItem) predicate.OrderItem {
 	return predicate.OrderItem(sql.AndPredicates(predicates...))
@@ -6,13 +6,17 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/Jiruu246/rms/internal/ent/menuitem"
+	"github.com/Jiruu246/rms/internal/ent/menuitemvariant"
 	"github.com/Jiruu246/rms/internal/ent/order"
 	"github.com/Jiruu246/rms/internal/ent/orderitem"
+	"github.com/Jiruu246/rms/internal/ent/orderitemchange"
 	"github.com/Jiruu246/rms/internal/ent/orderitemmodifieroption"
+	"github.com/Jiruu246/rms/internal/ent/stationticket"
 	"github.com/google/uuid"
 )
 
@@ -58,23 +62,135 @@ func (_c *OrderItemCreate) SetItemName(v string) *OrderItemCreate {
 }
 
 // SetItemPrice sets the "item_price" field.
-func (_c *OrderItemCreate) SetItemPrice(v float64) *OrderItemCreate {
+func (_c *OrderItemCreate) SetItemPrice(v int64) *OrderItemCreate {
 	_c.mutation.SetItemPrice(v)
 	return _c
 }
 
+// SetModifiersTotal sets the "modifiers_total" field.
+func (_c *OrderItemCreate) SetModifiersTotal(v int64) *OrderItemCreate {
+	_c.mutation.SetModifiersTotal(v)
+	return _c
+}
+
+// SetNillableModifiersTotal sets the "modifiers_total" field if the given value is not nil.
+func (_c *OrderItemCreate) SetNillableModifiersTotal(v *int64) *OrderItemCreate {
+	if v != nil {
+		_c.SetModifiersTotal(*v)
+	}
+	return _c
+}
+
+// SetLineTotal sets the "line_total" field.
+func (_c *OrderItemCreate) SetLineTotal(v int64) *OrderItemCreate {
+	_c.mutation.SetLineTotal(v)
+	return _c
+}
+
+// SetNillableLineTotal sets the "line_total" field if the given value is not nil.
+func (_c *OrderItemCreate) SetNillableLineTotal(v *int64) *OrderItemCreate {
+	if v != nil {
+		_c.SetLineTotal(*v)
+	}
+	return _c
+}
+
+// SetRefundedQuantity sets the "refunded_quantity" field.
+func (_c *OrderItemCreate) SetRefundedQuantity(v int) *OrderItemCreate {
+	_c.mutation.SetRefundedQuantity(v)
+	return _c
+}
+
+// SetNillableRefundedQuantity sets the "refunded_quantity" field if the given value is not nil.
+func (_c *OrderItemCreate) SetNillableRefundedQuantity(v *int) *OrderItemCreate {
+	if v != nil {
+		_c.SetRefundedQuantity(*v)
+	}
+	return _c
+}
+
 // SetMenuItemID sets the "menu_item_id" field.
 func (_c *OrderItemCreate) SetMenuItemID(v int64) *OrderItemCreate {
 	_c.mutation.SetMenuItemID(v)
 	return _c
 }
 
+// SetVariantID sets the "variant_id" field.
+func (_c *OrderItemCreate) SetVariantID(v uuid.UUID) *OrderItemCreate {
+	_c.mutation.SetVariantID(v)
+	return _c
+}
+
+// SetNillableVariantID sets the "variant_id" field if the given value is not nil.
+func (_c *OrderItemCreate) SetNillableVariantID(v *uuid.UUID) *OrderItemCreate {
+	if v != nil {
+		_c.SetVariantID(*v)
+	}
+	return _c
+}
+
+// SetVariantName sets the "variant_name" field.
+func (_c *OrderItemCreate) SetVariantName(v string) *OrderItemCreate {
+	_c.mutation.SetVariantName(v)
+	return _c
+}
+
+// SetNillableVariantName sets the "variant_name" field if the given value is not nil.
+func (_c *OrderItemCreate) SetNillableVariantName(v *string) *OrderItemCreate {
+	if v != nil {
+		_c.SetVariantName(*v)
+	}
+	return _c
+}
+
 // SetOrderID sets the "order_id" field.
 func (_c *OrderItemCreate) SetOrderID(v uuid.UUID) *OrderItemCreate {
 	_c.mutation.SetOrderID(v)
 	return _c
 }
 
+// SetStationTicketID sets the "station_ticket_id" field.
+func (_c *OrderItemCreate) SetStationTicketID(v uuid.UUID) *OrderItemCreate {
+	_c.mutation.SetStationTicketID(v)
+	return _c
+}
+
+// SetNillableStationTicketID sets the "station_ticket_id" field if the given value is not nil.
+func (_c *OrderItemCreate) SetNillableStationTicketID(v *uuid.UUID) *OrderItemCreate {
+	if v != nil {
+		_c.SetStationTicketID(*v)
+	}
+	return _c
+}
+
+// SetVoidedAt sets the "voided_at" field.
+func (_c *OrderItemCreate) SetVoidedAt(v time.Time) *OrderItemCreate {
+	_c.mutation.SetVoidedAt(v)
+	return _c
+}
+
+// SetNillableVoidedAt sets the "voided_at" field if the given value is not nil.
+func (_c *OrderItemCreate) SetNillableVoidedAt(v *time.Time) *OrderItemCreate {
+	if v != nil {
+		_c.SetVoidedAt(*v)
+	}
+	return _c
+}
+
+// SetVoidReason sets the "void_reason" field.
+func (_c *OrderItemCreate) SetVoidReason(v string) *OrderItemCreate {
+	_c.mutation.SetVoidReason(v)
+	return _c
+}
+
+// SetNillableVoidReason sets the "void_reason" field if the given value is not nil.
+func (_c *OrderItemCreate) SetNillableVoidReason(v *string) *OrderItemCreate {
+	if v != nil {
+		_c.SetVoidReason(*v)
+	}
+	return _c
+}
+
 // SetID sets the "id" field.
 func (_c *OrderItemCreate) SetID(v uuid.UUID) *OrderItemCreate {
 	_c.mutation.SetID(v)
@@ -99,6 +215,11 @@ func (_c *OrderItemCreate) SetMenuItem(v *MenuItem) *OrderItemCreate {
 	return _c.SetMenuItemID(v.ID)
 }
 
+// SetVariant sets the "variant" edge to the MenuItemVariant entity.
+func (_c *OrderItemCreate) SetVariant(v *MenuItemVariant) *OrderItemCreate {
+	return _c.SetVariantID(v.ID)
+}
+
 // AddOrderItemModifierOptionIDs adds the "order_item_modifier_options" edge to the OrderItemModifierOption entity by IDs.
 func (_c *OrderItemCreate) AddOrderItemModifierOptionIDs(ids ...int) *OrderItemCreate {
 	_c.mutation.AddOrderItemModifierOptionIDs(ids...)
@@ -114,6 +235,26 @@ func (_c *OrderItemCreate) AddOrderItemModifierOptions(v ...*OrderItemModifierOp
 	return _c.AddOrderItemModifierOptionIDs(ids...)
 }
 
+// SetStationTicket sets the "station_ticket" edge to the StationTicket entity.
+func (_c *OrderItemCreate) SetStationTicket(v *StationTicket) *OrderItemCreate {
+	return _c.SetStationTicketID(v.ID)
+}
+
+// AddChangeIDs adds the "changes" edge to the OrderItemChange entity by IDs.
+func (_c *OrderItemCreate) AddChangeIDs(ids ...uuid.UUID) *OrderItemCreate {
+	_c.mutation.AddChangeIDs(ids...)
+	return _c
+}
+
+// AddChanges adds the "changes" edges to the OrderItemChange entity.
+func (_c *OrderItemCreate) AddChanges(v ...*OrderItemChange) *OrderItemCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddChangeIDs(ids...)
+}
+
 // Mutation returns the OrderItemMutation object of the builder.
 func (_c *OrderItemCreate) Mutation() *OrderItemMutation {
 	return _c.mutation
@@ -153,6 +294,26 @@ func (_c *OrderItemCreate) defaults() {
 		v := orderitem.DefaultQuantity
 		_c.mutation.SetQuantity(v)
 	}
+	if _, ok := _c.mutation.ModifiersTotal(); !ok {
+		v := orderitem.DefaultModifiersTotal
+		_c.mutation.SetModifiersTotal(v)
+	}
+	if _, ok := _c.mutation.LineTotal(); !ok {
+		v := orderitem.DefaultLineTotal
+		_c.mutation.SetLineTotal(v)
+	}
+	if _, ok := _c.mutation.RefundedQuantity(); !ok {
+		v := orderitem.DefaultRefundedQuantity
+		_c.mutation.SetRefundedQuantity(v)
+	}
+	if _, ok := _c.mutation.VariantName(); !ok {
+		v := orderitem.DefaultVariantName
+		_c.mutation.SetVariantName(v)
+	}
+	if _, ok := _c.mutation.VoidReason(); !ok {
+		v := orderitem.DefaultVoidReason
+		_c.mutation.SetVoidReason(v)
+	}
 	if _, ok := _c.mutation.ID(); !ok {
 		v := orderitem.DefaultID()
 		_c.mutation.SetID(v)
@@ -180,12 +341,47 @@ func (_c *OrderItemCreate) check() error {
 	if _, ok := _c.mutation.ItemPrice(); !ok {
 		return &ValidationError{Name: "item_price", err: errors.New(`ent: missing required field "OrderItem.item_price"`)}
 	}
+	if _, ok := _c.mutation.ModifiersTotal(); !ok {
+		return &ValidationError{Name: "modifiers_total", err: errors.New(`ent: missing required field "OrderItem.modifiers_total"`)}
+	}
+	if v, ok := _c.mutation.ModifiersTotal(); ok {
+		if err := orderitem.ModifiersTotalValidator(v); err != nil {
+			return &ValidationError{Name: "modifiers_total", err: fmt.Errorf(`ent: validator failed for field "OrderItem.modifiers_total": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.LineTotal(); !ok {
+		return &ValidationError{Name: "line_total", err: errors.New(`ent: missing required field "OrderItem.line_total"`)}
+	}
+	if v, ok := _c.mutation.LineTotal(); ok {
+		if err := orderitem.LineTotalValidator(v); err != nil {
+			return &ValidationError{Name: "line_total", err: fmt.Errorf(`ent: validator failed for field "OrderItem.line_total": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.RefundedQuantity(); !ok {
+		return &ValidationError{Name: "refunded_quantity", err: errors.New(`ent: missing required field "OrderItem.refunded_quantity"`)}
+	}
+	if v, ok := _c.mutation.RefundedQuantity(); ok {
+		if err := orderitem.RefundedQuantityValidator(v); err != nil {
+			return &ValidationError{Name: "refunded_quantity", err: fmt.Errorf(`ent: validator failed for field "OrderItem.refunded_quantity": %w`, err)}
+		}
+	}
 	if _, ok := _c.mutation.MenuItemID(); !ok {
 		return &ValidationError{Name: "menu_item_id", err: errors.New(`ent: missing required field "OrderItem.menu_item_id"`)}
 	}
+	if _, ok := _c.mutation.VariantName(); !ok {
+		return &ValidationError{Name: "variant_name", err: errors.New(`ent: missing required field "OrderItem.variant_name"`)}
+	}
 	if _, ok := _c.mutation.OrderID(); !ok {
 		return &ValidationError{Name: "order_id", err: errors.New(`ent: missing required field "OrderItem.order_id"`)}
 	}
+	if _, ok := _c.mutation.VoidReason(); !ok {
+		return &ValidationError{Name: "void_reason", err: errors.New(`ent: missing required field "OrderItem.void_reason"`)}
+	}
+	if v, ok := _c.mutation.VoidReason(); ok {
+		if err := orderitem.VoidReasonValidator(v); err != nil {
+			return &ValidationError{Name: "void_reason", err: fmt.Errorf(`ent: validator failed for field "OrderItem.void_reason": %w`, err)}
+		}
+	}
 	if len(_c.mutation.OrderIDs()) == 0 {
 		return &ValidationError{Name: "order", err: errors.New(`ent: missing required edge "OrderItem.order"`)}
 	}
@@ -240,9 +436,33 @@ func (_c *OrderItemCreate) createSpec() (*OrderItem, *sqlgraph.CreateSpec) {
 		_node.ItemName = value
 	}
 	if value, ok := _c.mutation.ItemPrice(); ok {
-		_spec.SetField(orderitem.FieldItemPrice, field.TypeFloat64, value)
+		_spec.SetField(orderitem.FieldItemPrice, field.TypeInt64, value)
 		_node.ItemPrice = value
 	}
+	if value, ok := _c.mutation.ModifiersTotal(); ok {
+		_spec.SetField(orderitem.FieldModifiersTotal, field.TypeInt64, value)
+		_node.ModifiersTotal = value
+	}
+	if value, ok := _c.mutation.LineTotal(); ok {
+		_spec.SetField(orderitem.FieldLineTotal, field.TypeInt64, value)
+		_node.LineTotal = value
+	}
+	if value, ok := _c.mutation.RefundedQuantity(); ok {
+		_spec.SetField(orderitem.FieldRefundedQuantity, field.TypeInt, value)
+		_node.RefundedQuantity = value
+	}
+	if value, ok := _c.mutation.VariantName(); ok {
+		_spec.SetField(orderitem.FieldVariantName, field.TypeString, value)
+		_node.VariantName = value
+	}
+	if value, ok := _c.mutation.VoidedAt(); ok {
+		_spec.SetField(orderitem.FieldVoidedAt, field.TypeTime, value)
+		_node.VoidedAt = &value
+	}
+	if value, ok := _c.mutation.VoidReason(); ok {
+		_spec.SetField(orderitem.FieldVoidReason, field.TypeString, value)
+		_node.VoidReason = value
+	}
 	if nodes := _c.mutation.OrderIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -277,6 +497,23 @@ func (_c *OrderItemCreate) createSpec() (*OrderItem, *sqlgraph.CreateSpec) {
 		_node.MenuItemID = nodes[0]
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.VariantIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   orderitem.VariantTable,
+			Columns: []string{orderitem.VariantColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(menuitemvariant.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.VariantID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	if nodes := _c.mutation.OrderItemModifierOptionsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -293,6 +530,39 @@ func (_c *OrderItemCreate) createSpec() (*OrderItem, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.StationTicketIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   orderitem.StationTicketTable,
+			Columns: []string{orderitem.StationTicketColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(stationticket.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.StationTicketID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.ChangesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   orderitem.ChangesTable,
+			Columns: []string{orderitem.ChangesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(orderitemchange.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -13,10 +13,13 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/Jiruu246/rms/internal/ent/menuitem"
+	"github.com/Jiruu246/rms/internal/ent/menuitemvariant"
 	"github.com/Jiruu246/rms/internal/ent/order"
 	"github.com/Jiruu246/rms/internal/ent/orderitem"
+	"github.com/Jiruu246/rms/internal/ent/orderitemchange"
 	"github.com/Jiruu246/rms/internal/ent/orderitemmodifieroption"
 	"github.com/Jiruu246/rms/internal/ent/predicate"
+	"github.com/Jiruu246/rms/internal/ent/stationticket"
 	"github.com/google/uuid"
 )
 
@@ -29,7 +32,10 @@ type OrderItemQuery struct {
 	predicates                   []predicate.OrderItem
 	withOrder                    *OrderQuery
 	withMenuItem                 *MenuItemQuery
+	withVariant                  *MenuItemVariantQuery
 	withOrderItemModifierOptions *OrderItemModifierOptionQuery
+	withStationTicket            *StationTicketQuery
+	withChanges                  *OrderItemChangeQuery
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -110,6 +116,28 @@ func (_q *OrderItemQuery) QueryMenuItem() *MenuItemQuery {
 	return query
 }
 
+// QueryVariant chains the current query on the "variant" edge.
+func (_q *OrderItemQuery) QueryVariant() *MenuItemVariantQuery {
+	query := (&MenuItemVariantClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(orderitem.Table, orderitem.FieldID, selector),
+			sqlgraph.To(menuitemvariant.Table, menuitemvariant.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, orderitem.VariantTable, orderitem.VariantColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // QueryOrderItemModifierOptions chains the current query on the "order_item_modifier_options" edge.
 func (_q *OrderItemQuery) QueryOrderItemModifierOptions() *OrderItemModifierOptionQuery {
 	query := (&OrderItemModifierOptionClient{config: _q.config}).Query()
@@ -132,6 +160,50 @@ func (_q *OrderItemQuery) QueryOrderItemModifierOptions() *OrderItemModifierOpti
 	return query
 }
 
+// QueryStationTicket chains the current query on the "station_ticket" edge.
+func (_q *OrderItemQuery) QueryStationTicket() *StationTicketQuery {
+	query := (&StationTicketClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(orderitem.Table, orderitem.FieldID, selector),
+			sqlgraph.To(stationticket.Table, stationticket.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, orderitem.StationTicketTable, orderitem.StationTicketColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryChanges chains the current query on the "changes" edge.
+func (_q *OrderItemQuery) QueryChanges() *OrderItemChangeQuery {
+	query := (&OrderItemChangeClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(orderitem.Table, orderitem.FieldID, selector),
+			sqlgraph.To(orderitemchange.Table, orderitemchange.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, orderitem.ChangesTable, orderitem.ChangesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first OrderItem entity from the query.
 // Returns a *NotFoundError when no OrderItem was found.
 func (_q *OrderItemQuery) First(ctx context.Context) (*OrderItem, error) {
@@ -326,7 +398,10 @@ func (_q *OrderItemQuery) Clone() *OrderItemQuery {
 		predicates:                   append([]predicate.OrderItem{}, _q.predicates...),
 		withOrder:                    _q.withOrder.Clone(),
 		withMenuItem:                 _q.withMenuItem.Clone(),
+		withVariant:                  _q.withVariant.Clone(),
 		withOrderItemModifierOptions: _q.withOrderItemModifierOptions.Clone(),
+		withStationTicket:            _q.withStationTicket.Clone(),
+		withChanges:                  _q.withChanges.Clone(),
 		// clone intermediate query.
 		sql:  _q.sql.Clone(),
 		path: _q.path,
@@ -355,6 +430,17 @@ func (_q *OrderItemQuery) WithMenuItem(opts ...func(*MenuItemQuery)) *OrderItemQ
 	return _q
 }
 
+// WithVariant tells the query-builder to eager-load the nodes that are connected to
+// the "variant" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *OrderItemQuery) WithVariant(opts ...func(*MenuItemVariantQuery)) *OrderItemQuery {
+	query := (&MenuItemVariantClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withVariant = query
+	return _q
+}
+
 // WithOrderItemModifierOptions tells the query-builder to eager-load the nodes that are connected to
 // the "order_item_modifier_options" edge. The optional arguments are used to configure the query builder of the edge.
 func (_q *OrderItemQuery) WithOrderItemModifierOptions(opts ...func(*OrderItemModifierOptionQuery)) *OrderItemQuery {
@@ -366,6 +452,28 @@ func (_q *OrderItemQuery) WithOrderItemModifierOptions(opts ...func(*OrderItemMo
 	return _q
 }
 
+// WithStationTicket tells the query-builder to eager-load the nodes that are connected to
+// the "station_ticket" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *OrderItemQuery) WithStationTicket(opts ...func(*StationTicketQuery)) *OrderItemQuery {
+	query := (&StationTicketClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withStationTicket = query
+	return _q
+}
+
+// WithChanges tells the query-builder to eager-load the nodes that are connected to
+// the "changes" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *OrderItemQuery) WithChanges(opts ...func(*OrderItemChangeQuery)) *OrderItemQuery {
+	query := (&OrderItemChangeClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withChanges = query
+	return _q
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -444,10 +552,13 @@ func (_q *OrderItemQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Or
 	var (
 		nodes       = []*OrderItem{}
 		_spec       = _q.querySpec()
-		loadedTypes = [3]bool{
+		loadedTypes = [6]bool{
 			_q.withOrder != nil,
 			_q.withMenuItem != nil,
+			_q.withVariant != nil,
 			_q.withOrderItemModifierOptions != nil,
+			_q.withStationTicket != nil,
+			_q.withChanges != nil,
 		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
@@ -480,6 +591,12 @@ func (_q *OrderItemQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Or
 			return nil, err
 		}
 	}
+	if query := _q.withVariant; query != nil {
+		if err := _q.loadVariant(ctx, query, nodes, nil,
+			func(n *OrderItem, e *MenuItemVariant) { n.Edges.Variant = e }); err != nil {
+			return nil, err
+		}
+	}
 	if query := _q.withOrderItemModifierOptions; query != nil {
 		if err := _q.loadOrderItemModifierOptions(ctx, query, nodes,
 			func(n *OrderItem) { n.Edges.OrderItemModifierOptions = []*OrderItemModifierOption{} },
@@ -489,6 +606,19 @@ func (_q *OrderItemQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Or
 			return nil, err
 		}
 	}
+	if query := _q.withStationTicket; query != nil {
+		if err := _q.loadStationTicket(ctx, query, nodes, nil,
+			func(n *OrderItem, e *StationTicket) { n.Edges.StationTicket = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withChanges; query != nil {
+		if err := _q.loadChanges(ctx, query, nodes,
+			func(n *OrderItem) { n.Edges.Changes = []*OrderItemChange{} },
+			func(n *OrderItem, e *OrderItemChange) { n.Edges.Changes = append(n.Edges.Changes, e) }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -550,6 +680,38 @@ func (_q *OrderItemQuery) loadMenuItem(ctx context.Context, query *MenuItemQuery
 	}
 	return nil
 }
+func (_q *OrderItemQuery) loadVariant(ctx context.Context, query *MenuItemVariantQuery, nodes []*OrderItem, init func(*OrderItem), assign func(*OrderItem, *MenuItemVariant)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*OrderItem)
+	for i := range nodes {
+		if nodes[i].VariantID == nil {
+			continue
+		}
+		fk := *nodes[i].VariantID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(menuitemvariant.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "variant_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
 func (_q *OrderItemQuery) loadOrderItemModifierOptions(ctx context.Context, query *OrderItemModifierOptionQuery, nodes []*OrderItem, init func(*OrderItem), assign func(*OrderItem, *OrderItemModifierOption)) error {
 	fks := make([]driver.Value, 0, len(nodes))
 	nodeids := make(map[uuid.UUID]*OrderItem)
@@ -580,6 +742,68 @@ func (_q *OrderItemQuery) loadOrderItemModifierOptions(ctx context.Context, quer
 	}
 	return nil
 }
+func (_q *OrderItemQuery) loadStationTicket(ctx context.Context, query *StationTicketQuery, nodes []*OrderItem, init func(*OrderItem), assign func(*OrderItem, *StationTicket)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*OrderItem)
+	for i := range nodes {
+		if nodes[i].StationTicketID == nil {
+			continue
+		}
+		fk := *nodes[i].StationTicketID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(stationticket.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "station_ticket_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (_q *OrderItemQuery) loadChanges(ctx context.Context, query *OrderItemChangeQuery, nodes []*OrderItem, init func(*OrderItem), assign func(*OrderItem, *OrderItemChange)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*OrderItem)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(orderitemchange.FieldOrderItemID)
+	}
+	query.Where(predicate.OrderItemChange(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(orderitem.ChangesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.OrderItemID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "order_item_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (_q *OrderItemQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := _q.querySpec()
@@ -612,6 +836,12 @@ func (_q *OrderItemQuery) querySpec() *sqlgraph.QuerySpec {
 		if _q.withMenuItem != nil {
 			_spec.Node.AddColumnOnce(orderitem.FieldMenuItemID)
 		}
+		if _q.withVariant != nil {
+			_spec.Node.AddColumnOnce(orderitem.FieldVariantID)
+		}
+		if _q.withStationTicket != nil {
+			_spec.Node.AddColumnOnce(orderitem.FieldStationTicketID)
+		}
 	}
 	if ps := _q.predicates; len(ps) > 0 {
 		_spec.Predicate = func(selector *sql.Selector) {
@@ -6,15 +6,19 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/Jiruu246/rms/internal/ent/menuitem"
+	"github.com/Jiruu246/rms/internal/ent/menuitemvariant"
 	"github.com/Jiruu246/rms/internal/ent/order"
 	"github.com/Jiruu246/rms/internal/ent/orderitem"
+	"github.com/Jiruu246/rms/internal/ent/orderitemchange"
 	"github.com/Jiruu246/rms/internal/ent/orderitemmodifieroption"
 	"github.com/Jiruu246/rms/internal/ent/predicate"
+	"github.com/Jiruu246/rms/internal/ent/stationticket"
 	"github.com/google/uuid"
 )
 
@@ -87,14 +91,14 @@ func (_u *OrderItemUpdate) SetNillableItemName(v *string) *OrderItemUpdate {
 }
 
 // SetItemPrice sets the "item_price" field.
-func (_u *OrderItemUpdate) SetItemPrice(v float64) *OrderItemUpdate {
+func (_u *OrderItemUpdate) SetItemPrice(v int64) *OrderItemUpdate {
 	_u.mutation.ResetItemPrice()
 	_u.mutation.SetItemPrice(v)
 	return _u
 }
 
 // SetNillableItemPrice sets the "item_price" field if the given value is not nil.
-func (_u *OrderItemUpdate) SetNillableItemPrice(v *float64) *OrderItemUpdate {
+func (_u *OrderItemUpdate) SetNillableItemPrice(v *int64) *OrderItemUpdate {
 	if v != nil {
 		_u.SetItemPrice(*v)
 	}
@@ -102,11 +106,74 @@ func (_u *OrderItemUpdate) SetNillableItemPrice(v *float64) *OrderItemUpdate {
 }
 
 // AddItemPrice adds value to the "item_price" field.
-func (_u *OrderItemUpdate) AddItemPrice(v float64) *OrderItemUpdate {
+func (_u *OrderItemUpdate) AddItemPrice(v int64) *OrderItemUpdate {
 	_u.mutation.AddItemPrice(v)
 	return _u
 }
 
+// SetModifiersTotal sets the "modifiers_total" field.
+func (_u *OrderItemUpdate) SetModifiersTotal(v int64) *OrderItemUpdate {
+	_u.mutation.ResetModifiersTotal()
+	_u.mutation.SetModifiersTotal(v)
+	return _u
+}
+
+// SetNillableModifiersTotal sets the "modifiers_total" field if the given value is not nil.
+func (_u *OrderItemUpdate) SetNillableModifiersTotal(v *int64) *OrderItemUpdate {
+	if v != nil {
+		_u.SetModifiersTotal(*v)
+	}
+	return _u
+}
+
+// AddModifiersTotal adds value to the "modifiers_total" field.
+func (_u *OrderItemUpdate) AddModifiersTotal(v int64) *OrderItemUpdate {
+	_u.mutation.AddModifiersTotal(v)
+	return _u
+}
+
+// SetLineTotal sets the "line_total" field.
+func (_u *OrderItemUpdate) SetLineTotal(v int64) *OrderItemUpdate {
+	_u.mutation.ResetLineTotal()
+	_u.mutation.SetLineTotal(v)
+	return _u
+}
+
+// SetNillableLineTotal sets the "line_total" field if the given value is not nil.
+func (_u *OrderItemUpdate) SetNillableLineTotal(v *int64) *OrderItemUpdate {
+	if v != nil {
+		_u.SetLineTotal(*v)
+	}
+	return _u
+}
+
+// AddLineTotal adds value to the "line_total" field.
+func (_u *OrderItemUpdate) AddLineTotal(v int64) *OrderItemUpdate {
+	_u.mutation.AddLineTotal(v)
+	return _u
+}
+
+// SetRefundedQuantity sets the "refunded_quantity" field.
+func (_u *OrderItemUpdate) SetRefundedQuantity(v int) *OrderItemUpdate {
+	_u.mutation.ResetRefundedQuantity()
+	_u.mutation.SetRefundedQuantity(v)
+	return _u
+}
+
+// SetNillableRefundedQuantity sets the "refunded_quantity" field if the given value is not nil.
+func (_u *OrderItemUpdate) SetNillableRefundedQuantity(v *int) *OrderItemUpdate {
+	if v != nil {
+		_u.SetRefundedQuantity(*v)
+	}
+	return _u
+}
+
+// AddRefundedQuantity adds value to the "refunded_quantity" field.
+func (_u *OrderItemUpdate) AddRefundedQuantity(v int) *OrderItemUpdate {
+	_u.mutation.AddRefundedQuantity(v)
+	return _u
+}
+
 // SetMenuItemID sets the "menu_item_id" field.
 func (_u *OrderItemUpdate) SetMenuItemID(v int64) *OrderItemUpdate {
 	_u.mutation.SetMenuItemID(v)
@@ -121,6 +188,40 @@ func (_u *OrderItemUpdate) SetNillableMenuItemID(v *int64) *OrderItemUpdate {
 	return _u
 }
 
+// SetVariantID sets the "variant_id" field.
+func (_u *OrderItemUpdate) SetVariantID(v uuid.UUID) *OrderItemUpdate {
+	_u.mutation.SetVariantID(v)
+	return _u
+}
+
+// SetNillableVariantID sets the "variant_id" field if the given value is not nil.
+func (_u *OrderItemUpdate) SetNillableVariantID(v *uuid.UUID) *OrderItemUpdate {
+	if v != nil {
+		_u.SetVariantID(*v)
+	}
+	return _u
+}
+
+// ClearVariantID clears the value of the "variant_id" field.
+func (_u *OrderItemUpdate) ClearVariantID() *OrderItemUpdate {
+	_u.mutation.ClearVariantID()
+	return _u
+}
+
+// SetVariantName sets the "variant_name" field.
+func (_u *OrderItemUpdate) SetVariantName(v string) *OrderItemUpdate {
+	_u.mutation.SetVariantName(v)
+	return _u
+}
+
+// SetNillableVariantName sets the "variant_name" field if the given value is not nil.
+func (_u *OrderItemUpdate) SetNillableVariantName(v *string) *OrderItemUpdate {
+	if v != nil {
+		_u.SetVariantName(*v)
+	}
+	return _u
+}
+
 // SetOrderID sets the "order_id" field.
 func (_u *OrderItemUpdate) SetOrderID(v uuid.UUID) *OrderItemUpdate {
 	_u.mutation.SetOrderID(v)
@@ -135,6 +236,60 @@ func (_u *OrderItemUpdate) SetNillableOrderID(v *uuid.UUID) *OrderItemUpdate {
 	return _u
 }
 
+// SetStationTicketID sets the "station_ticket_id" field.
+func (_u *OrderItemUpdate) SetStationTicketID(v uuid.UUID) *OrderItemUpdate {
+	_u.mutation.SetStationTicketID(v)
+	return _u
+}
+
+// SetNillableStationTicketID sets the "station_ticket_id" field if the given value is not nil.
+func (_u *OrderItemUpdate) SetNillableStationTicketID(v *uuid.UUID) *OrderItemUpdate {
+	if v != nil {
+		_u.SetStationTicketID(*v)
+	}
+	return _u
+}
+
+// ClearStationTicketID clears the value of the "station_ticket_id" field.
+func (_u *OrderItemUpdate) ClearStationTicketID() *OrderItemUpdate {
+	_u.mutation.ClearStationTicketID()
+	return _u
+}
+
+// SetVoidedAt sets the "voided_at" field.
+func (_u *OrderItemUpdate) SetVoidedAt(v time.Time) *OrderItemUpdate {
+	_u.mutation.SetVoidedAt(v)
+	return _u
+}
+
+// SetNillableVoidedAt sets the "voided_at" field if the given value is not nil.
+func (_u *OrderItemUpdate) SetNillableVoidedAt(v *time.Time) *OrderItemUpdate {
+	if v != nil {
+		_u.SetVoidedAt(*v)
+	}
+	return _u
+}
+
+// ClearVoidedAt clears the value of the "voided_at" field.
+func (_u *OrderItemUpdate) ClearVoidedAt() *OrderItemUpdate {
+	_u.mutation.ClearVoidedAt()
+	return _u
+}
+
+// SetVoidReason sets the "void_reason" field.
+func (_u *OrderItemUpdate) SetVoidReason(v string) *OrderItemUpdate {
+	_u.mutation.SetVoidReason(v)
+	return _u
+}
+
+// SetNillableVoidReason sets the "void_reason" field if the given value is not nil.
+func (_u *OrderItemUpdate) SetNillableVoidReason(v *string) *OrderItemUpdate {
+	if v != nil {
+		_u.SetVoidReason(*v)
+	}
+	return _u
+}
+
 // SetOrder sets the "order" edge to the Order entity.
 func (_u *OrderItemUpdate) SetOrder(v *Order) *OrderItemUpdate {
 	return _u.SetOrderID(v.ID)
@@ -145,6 +300,11 @@ func (_u *OrderItemUpdate) SetMenuItem(v *MenuItem) *OrderItemUpdate {
 	return _u.SetMenuItemID(v.ID)
 }
 
+// SetVariant sets the "variant" edge to the MenuItemVariant entity.
+func (_u *OrderItemUpdate) SetVariant(v *MenuItemVariant) *OrderItemUpdate {
+	return _u.SetVariantID(v.ID)
+}
+
 // AddOrderItemModifierOptionIDs adds the "order_item_modifier_options" edge to the OrderItemModifierOption entity by IDs.
 func (_u *OrderItemUpdate) AddOrderItemModifierOptionIDs(ids ...int) *OrderItemUpdate {
 	_u.mutation.AddOrderItemModifierOptionIDs(ids...)
@@ -160,6 +320,26 @@ func (_u *OrderItemUpdate) AddOrderItemModifierOptions(v ...*OrderItemModifierOp
 	return _u.AddOrderItemModifierOptionIDs(ids...)
 }
 
+// SetStationTicket sets the "station_ticket" edge to the StationTicket entity.
+func (_u *OrderItemUpdate) SetStationTicket(v *StationTicket) *OrderItemUpdate {
+	return _u.SetStationTicketID(v.ID)
+}
+
+// AddChangeIDs adds the "changes" edge to the OrderItemChange entity by IDs.
+func (_u *OrderItemUpdate) AddChangeIDs(ids ...uuid.UUID) *OrderItemUpdate {
+	_u.mutation.AddChangeIDs(ids...)
+	return _u
+}
+
+// AddChanges adds the "changes" edges to the OrderItemChange entity.
+func (_u *OrderItemUpdate) AddChanges(v ...*OrderItemChange) *OrderItemUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddChangeIDs(ids...)
+}
+
 // Mutation returns the OrderItemMutation object of the builder.
 func (_u *OrderItemUpdate) Mutation() *OrderItemMutation {
 	return _u.mutation
@@ -177,6 +357,12 @@ func (_u *OrderItemUpdate) ClearMenuItem() *OrderItemUpdate {
 	return _u
 }
 
+// ClearVariant clears the "variant" edge to the MenuItemVariant entity.
+func (_u *OrderItemUpdate) ClearVariant() *OrderItemUpdate {
+	_u.mutation.ClearVariant()
+	return _u
+}
+
 // ClearOrderItemModifierOptions clears all "order_item_modifier_options" edges to the OrderItemModifierOption entity.
 func (_u *OrderItemUpdate) ClearOrderItemModifierOptions() *OrderItemUpdate {
 	_u.mutation.ClearOrderItemModifierOptions()
@@ -198,6 +384,33 @@ func (_u *OrderItemUpdate) RemoveOrderItemModifierOptions(v ...*OrderItemModifie
 	return _u.RemoveOrderItemModifierOptionIDs(ids...)
 }
 
+// ClearStationTicket clears the "station_ticket" edge to the StationTicket entity.
+func (_u *OrderItemUpdate) ClearStationTicket() *OrderItemUpdate {
+	_u.mutation.ClearStationTicket()
+	return _u
+}
+
+// ClearChanges clears all "changes" edges to the OrderItemChange entity.
+func (_u *OrderItemUpdate) ClearChanges() *OrderItemUpdate {
+	_u.mutation.ClearChanges()
+	return _u
+}
+
+// RemoveChangeIDs removes the "changes" edge to OrderItemChange entities by IDs.
+func (_u *OrderItemUpdate) RemoveChangeIDs(ids ...uuid.UUID) *OrderItemUpdate {
+	_u.mutation.RemoveChangeIDs(ids...)
+	return _u
+}
+
+// RemoveChanges removes "changes" edges to OrderItemChange entities.
+func (_u *OrderItemUpdate) RemoveChanges(v ...*OrderItemChange) *OrderItemUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveChangeIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (_u *OrderItemUpdate) Save(ctx context.Context) (int, error) {
 	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
@@ -237,6 +450,26 @@ func (_u *OrderItemUpdate) check() error {
 			return &ValidationError{Name: "item_name", err: fmt.Errorf(`ent: validator failed for field "OrderItem.item_name": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.ModifiersTotal(); ok {
+		if err := orderitem.ModifiersTotalValidator(v); err != nil {
+			return &ValidationError{Name: "modifiers_total", err: fmt.Errorf(`ent: validator failed for field "OrderItem.modifiers_total": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.LineTotal(); ok {
+		if err := orderitem.LineTotalValidator(v); err != nil {
+			return &ValidationError{Name: "line_total", err: fmt.Errorf(`ent: validator failed for field "OrderItem.line_total": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.RefundedQuantity(); ok {
+		if err := orderitem.RefundedQuantityValidator(v); err != nil {
+			return &ValidationError{Name: "refunded_quantity", err: fmt.Errorf(`ent: validator failed for field "OrderItem.refunded_quantity": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.VoidReason(); ok {
+		if err := orderitem.VoidReasonValidator(v); err != nil {
+			return &ValidationError{Name: "void_reason", err: fmt.Errorf(`ent: validator failed for field "OrderItem.void_reason": %w`, err)}
+		}
+	}
 	if _u.mutation.OrderCleared() && len(_u.mutation.OrderIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "OrderItem.order"`)
 	}
@@ -274,10 +507,40 @@ func (_u *OrderItemUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		_spec.SetField(orderitem.FieldItemName, field.TypeString, value)
 	}
 	if value, ok := _u.mutation.ItemPrice(); ok {
-		_spec.SetField(orderitem.FieldItemPrice, field.TypeFloat64, value)
+		_spec.SetField(orderitem.FieldItemPrice, field.TypeInt64, value)
 	}
 	if value, ok := _u.mutation.AddedItemPrice(); ok {
-		_spec.AddField(orderitem.FieldItemPrice, field.TypeFloat64, value)
+		_spec.AddField(orderitem.FieldItemPrice, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.ModifiersTotal(); ok {
+		_spec.SetField(orderitem.FieldModifiersTotal, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedModifiersTotal(); ok {
+		_spec.AddField(orderitem.FieldModifiersTotal, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.LineTotal(); ok {
+		_spec.SetField(orderitem.FieldLineTotal, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedLineTotal(); ok {
+		_spec.AddField(orderitem.FieldLineTotal, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.RefundedQuantity(); ok {
+		_spec.SetField(orderitem.FieldRefundedQuantity, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedRefundedQuantity(); ok {
+		_spec.AddField(orderitem.FieldRefundedQuantity, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.VariantName(); ok {
+		_spec.SetField(orderitem.FieldVariantName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.VoidedAt(); ok {
+		_spec.SetField(orderitem.FieldVoidedAt, field.TypeTime, value)
+	}
+	if _u.mutation.VoidedAtCleared() {
+		_spec.ClearField(orderitem.FieldVoidedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.VoidReason(); ok {
+		_spec.SetField(orderitem.FieldVoidReason, field.TypeString, value)
 	}
 	if _u.mutation.OrderCleared() {
 		edge := &sqlgraph.EdgeSpec{
@@ -337,6 +600,35 @@ func (_u *OrderItemUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.VariantCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   orderitem.VariantTable,
+			Columns: []string{orderitem.VariantColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(menuitemvariant.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.VariantIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   orderitem.VariantTable,
+			Columns: []string{orderitem.VariantColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(menuitemvariant.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if _u.mutation.OrderItemModifierOptionsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -382,6 +674,80 @@ func (_u *OrderItemUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.StationTicketCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   orderitem.StationTicketTable,
+			Columns: []string{orderitem.StationTicketColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(stationticket.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.StationTicketIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   orderitem.StationTicketTable,
+			Columns: []string{orderitem.StationTicketColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(stationticket.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ChangesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   orderitem.ChangesTable,
+			Columns: []string{orderitem.ChangesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(orderitemchange.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedChangesIDs(); len(nodes) > 0 && !_u.mutation.ChangesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   orderitem.ChangesTable,
+			Columns: []string{orderitem.ChangesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(orderitemchange.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ChangesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   orderitem.ChangesTable,
+			Columns: []string{orderitem.ChangesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(orderitemchange.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{orderitem.Label}
@@ -458,14 +824,14 @@ func (_u *OrderItemUpdateOne) SetNillableItemName(v *string) *OrderItemUpdateOne
 }
 
 // SetItemPrice sets the "item_price" field.
-func (_u *OrderItemUpdateOne) SetItemPrice(v float64) *OrderItemUpdateOne {
+func (_u *OrderItemUpdateOne) SetItemPrice(v int64) *OrderItemUpdateOne {
 	_u.mutation.ResetItemPrice()
 	_u.mutation.SetItemPrice(v)
 	return _u
 }
 
 // SetNillableItemPrice sets the "item_price" field if the given value is not nil.
-func (_u *OrderItemUpdateOne) SetNillableItemPrice(v *float64) *OrderItemUpdateOne {
+func (_u *OrderItemUpdateOne) SetNillableItemPrice(v *int64) *OrderItemUpdateOne {
 	if v != nil {
 		_u.SetItemPrice(*v)
 	}
@@ -473,11 +839,74 @@ func (_u *OrderItemUpdateOne) SetNillableItemPrice(v *float64) *OrderItemUpdateO
 }
 
 // AddItemPrice adds value to the "item_price" field.
-func (_u *OrderItemUpdateOne) AddItemPrice(v float64) *OrderItemUpdateOne {
+func (_u *OrderItemUpdateOne) AddItemPrice(v int64) *OrderItemUpdateOne {
 	_u.mutation.AddItemPrice(v)
 	return _u
 }
 
+// SetModifiersTotal sets the "modifiers_total" field.
+func (_u *OrderItemUpdateOne) SetModifiersTotal(v int64) *OrderItemUpdateOne {
+	_u.mutation.ResetModifiersTotal()
+	_u.mutation.SetModifiersTotal(v)
+	return _u
+}
+
+// SetNillableModifiersTotal sets the "modifiers_total" field if the given value is not nil.
+func (_u *OrderItemUpdateOne) SetNillableModifiersTotal(v *int64) *OrderItemUpdateOne {
+	if v != nil {
+		_u.SetModifiersTotal(*v)
+	}
+	return _u
+}
+
+// AddModifiersTotal adds value to the "modifiers_total" field.
+func (_u *OrderItemUpdateOne) AddModifiersTotal(v int64) *OrderItemUpdateOne {
+	_u.mutation.AddModifiersTotal(v)
+	return _u
+}
+
+// SetLineTotal sets the "line_total" field.
+func (_u *OrderItemUpdateOne) SetLineTotal(v int64) *OrderItemUpdateOne {
+	_u.mutation.ResetLineTotal()
+	_u.mutation.SetLineTotal(v)
+	return _u
+}
+
+// SetNillableLineTotal sets the "line_total" field if the given value is not nil.
+func (_u *OrderItemUpdateOne) SetNillableLineTotal(v *int64) *OrderItemUpdateOne {
+	if v != nil {
+		_u.SetLineTotal(*v)
+	}
+	return _u
+}
+
+// AddLineTotal adds value to the "line_total" field.
+func (_u *OrderItemUpdateOne) AddLineTotal(v int64) *OrderItemUpdateOne {
+	_u.mutation.AddLineTotal(v)
+	return _u
+}
+
+// SetRefundedQuantity sets the "refunded_quantity" field.
+func (_u *OrderItemUpdateOne) SetRefundedQuantity(v int) *OrderItemUpdateOne {
+	_u.mutation.ResetRefundedQuantity()
+	_u.mutation.SetRefundedQuantity(v)
+	return _u
+}
+
+// SetNillableRefundedQuantity sets the "refunded_quantity" field if the given value is not nil.
+func (_u *OrderItemUpdateOne) SetNillableRefundedQuantity(v *int) *OrderItemUpdateOne {
+	if v != nil {
+		_u.SetRefundedQuantity(*v)
+	}
+	return _u
+}
+
+// AddRefundedQuantity adds value to the "refunded_quantity" field.
+func (_u *OrderItemUpdateOne) AddRefundedQuantity(v int) *OrderItemUpdateOne {
+	_u.mutation.AddRefundedQuantity(v)
+	return _u
+}
+
 // SetMenuItemID sets the "menu_item_id" field.
 func (_u *OrderItemUpdateOne) SetMenuItemID(v int64) *OrderItemUpdateOne {
 	_u.mutation.SetMenuItemID(v)
@@ -492,6 +921,40 @@ func (_u *OrderItemUpdateOne) SetNillableMenuItemID(v *int64) *OrderItemUpdateOn
 	return _u
 }
 
+// SetVariantID sets the "variant_id" field.
+func (_u *OrderItemUpdateOne) SetVariantID(v uuid.UUID) *OrderItemUpdateOne {
+	_u.mutation.SetVariantID(v)
+	return _u
+}
+
+// SetNillableVariantID sets the "variant_id" field if the given value is not nil.
+func (_u *OrderItemUpdateOne) SetNillableVariantID(v *uuid.UUID) *OrderItemUpdateOne {
+	if v != nil {
+		_u.SetVariantID(*v)
+	}
+	return _u
+}
+
+// ClearVariantID clears the value of the "variant_id" field.
+func (_u *OrderItemUpdateOne) ClearVariantID() *OrderItemUpdateOne {
+	_u.mutation.ClearVariantID()
+	return _u
+}
+
+// SetVariantName sets the "variant_name" field.
+func (_u *OrderItemUpdateOne) SetVariantName(v string) *OrderItemUpdateOne {
+	_u.mutation.SetVariantName(v)
+	return _u
+}
+
+// SetNillableVariantName sets the "variant_name" field if the given value is not nil.
+func (_u *OrderItemUpdateOne) SetNillableVariantName(v *string) *OrderItemUpdateOne {
+	if v != nil {
+		_u.SetVariantName(*v)
+	}
+	return _u
+}
+
 // SetOrderID sets the "order_id" field.
 func (_u *OrderItemUpdateOne) SetOrderID(v uuid.UUID) *OrderItemUpdateOne {
 	_u.mutation.SetOrderID(v)
@@ -506,6 +969,60 @@ func (_u *OrderItemUpdateOne) SetNillableOrderID(v *uuid.UUID) *OrderItemUpdateO
 	return _u
 }
 
+// SetStationTicketID sets the "station_ticket_id" field.
+func (_u *OrderItemUpdateOne) SetStationTicketID(v uuid.UUID) *OrderItemUpdateOne {
+	_u.mutation.SetStationTicketID(v)
+	return _u
+}
+
+// SetNillableStationTicketID sets the "station_ticket_id" field if the given value is not nil.
+func (_u *OrderItemUpdateOne) SetNillableStationTicketID(v *uuid.UUID) *OrderItemUpdateOne {
+	if v != nil {
+		_u.SetStationTicketID(*v)
+	}
+	return _u
+}
+
+// ClearStationTicketID clears the value of the "station_ticket_id" field.
+func (_u *OrderItemUpdateOne) ClearStationTicketID() *OrderItemUpdateOne {
+	_u.mutation.ClearStationTicketID()
+	return _u
+}
+
+// SetVoidedAt sets the "voided_at" field.
+func (_u *OrderItemUpdateOne) SetVoidedAt(v time.Time) *OrderItemUpdateOne {
+	_u.mutation.SetVoidedAt(v)
+	return _u
+}
+
+// SetNillableVoidedAt sets the "voided_at" field if the given value is not nil.
+func (_u *OrderItemUpdateOne) SetNillableVoidedAt(v *time.Time) *OrderItemUpdateOne {
+	if v != nil {
+		_u.SetVoidedAt(*v)
+	}
+	return _u
+}
+
+// ClearVoidedAt clears the value of the "voided_at" field.
+func (_u *OrderItemUpdateOne) ClearVoidedAt() *OrderItemUpdateOne {
+	_u.mutation.ClearVoidedAt()
+	return _u
+}
+
+// SetVoidReason sets the "void_reason" field.
+func (_u *OrderItemUpdateOne) SetVoidReason(v string) *OrderItemUpdateOne {
+	_u.mutation.SetVoidReason(v)
+	return _u
+}
+
+// SetNillableVoidReason sets the "void_reason" field if the given value is not nil.
+func (_u *OrderItemUpdateOne) SetNillableVoidReason(v *string) *OrderItemUpdateOne {
+	if v != nil {
+		_u.SetVoidReason(*v)
+	}
+	return _u
+}
+
 // SetOrder sets the "order" edge to the Order entity.
 func (_u *OrderItemUpdateOne) SetOrder(v *Order) *OrderItemUpdateOne {
 	return _u.SetOrderID(v.ID)
@@ -516,6 +1033,11 @@ func (_u *OrderItemUpdateOne) SetMenuItem(v *MenuItem) *OrderItemUpdateOne {
 	return _u.SetMenuItemID(v.ID)
 }
 
+// SetVariant sets the "variant" edge to the MenuItemVariant entity.
+func (_u *OrderItemUpdateOne) SetVariant(v *MenuItemVariant) *OrderItemUpdateOne {
+	return _u.SetVariantID(v.ID)
+}
+
 // AddOrderItemModifierOptionIDs adds the "order_item_modifier_options" edge to the OrderItemModifierOption entity by IDs.
 func (_u *OrderItemUpdateOne) AddOrderItemModifierOptionIDs(ids ...int) *OrderItemUpdateOne {
 	_u.mutation.AddOrderItemModifierOptionIDs(ids...)
@@ -531,6 +1053,26 @@ func (_u *OrderItemUpdateOne) AddOrderItemModifierOptions(v ...*OrderItemModifie
 	return _u.AddOrderItemModifierOptionIDs(ids...)
 }
 
+// SetStationTicket sets the "station_ticket" edge to the StationTicket entity.
+func (_u *OrderItemUpdateOne) SetStationTicket(v *StationTicket) *OrderItemUpdateOne {
+	return _u.SetStationTicketID(v.ID)
+}
+
+// AddChangeIDs adds the "changes" edge to the OrderItemChange entity by IDs.
+func (_u *OrderItemUpdateOne) AddChangeIDs(ids ...uuid.UUID) *OrderItemUpdateOne {
+	_u.mutation.AddChangeIDs(ids...)
+	return _u
+}
+
+// AddChanges adds the "changes" edges to the OrderItemChange entity.
+func (_u *OrderItemUpdateOne) AddChanges(v ...*OrderItemChange) *OrderItemUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddChangeIDs(ids...)
+}
+
 // Mutation returns the OrderItemMutation object of the builder.
 func (_u *OrderItemUpdateOne) Mutation() *OrderItemMutation {
 	return _u.mutation
@@ -548,6 +1090,12 @@ func (_u *OrderItemUpdateOne) ClearMenuItem() *OrderItemUpdateOne {
 	return _u
 }
 
+// ClearVariant clears the "variant" edge to the MenuItemVariant entity.
+func (_u *OrderItemUpdateOne) ClearVariant() *OrderItemUpdateOne {
+	_u.mutation.ClearVariant()
+	return _u
+}
+
 // ClearOrderItemModifierOptions clears all "order_item_modifier_options" edges to the OrderItemModifierOption entity.
 func (_u *OrderItemUpdateOne) ClearOrderItemModifierOptions() *OrderItemUpdateOne {
 	_u.mutation.ClearOrderItemModifierOptions()
@@ -569,6 +1117,33 @@ func (_u *OrderItemUpdateOne) RemoveOrderItemModifierOptions(v ...*OrderItemModi
 	return _u.RemoveOrderItemModifierOptionIDs(ids...)
 }
 
+// ClearStationTicket clears the "station_ticket" edge to the StationTicket entity.
+func (_u *OrderItemUpdateOne) ClearStationTicket() *OrderItemUpdateOne {
+	_u.mutation.ClearStationTicket()
+	return _u
+}
+
+// ClearChanges clears all "changes" edges to the OrderItemChange entity.
+func (_u *OrderItemUpdateOne) ClearChanges() *OrderItemUpdateOne {
+	_u.mutation.ClearChanges()
+	return _u
+}
+
+// RemoveChangeIDs removes the "changes" edge to OrderItemChange entities by IDs.
+func (_u *OrderItemUpdateOne) RemoveChangeIDs(ids ...uuid.UUID) *OrderItemUpdateOne {
+	_u.mutation.RemoveChangeIDs(ids...)
+	return _u
+}
+
+// RemoveChanges removes "changes" edges to OrderItemChange entities.
+func (_u *OrderItemUpdateOne) RemoveChanges(v ...*OrderItemChange) *OrderItemUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveChangeIDs(ids...)
+}
+
 // Where appends a list predicates to the OrderItemUpdate builder.
 func (_u *OrderItemUpdateOne) Where(ps ...predicate.OrderItem) *OrderItemUpdateOne {
 	_u.mutation.Where(ps...)
@@ -621,6 +1196,26 @@ func (_u *OrderItemUpdateOne) check() error {
 			return &ValidationError{Name: "item_name", err: fmt.Errorf(`ent: validator failed for field "OrderItem.item_name": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.ModifiersTotal(); ok {
+		if err := orderitem.ModifiersTotalValidator(v); err != nil {
+			return &ValidationError{Name: "modifiers_total", err: fmt.Errorf(`ent: validator failed for field "OrderItem.modifiers_total": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.LineTotal(); ok {
+		if err := orderitem.LineTotalValidator(v); err != nil {
+			return &ValidationError{Name: "line_total", err: fmt.Errorf(`ent: validator failed for field "OrderItem.line_total": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.RefundedQuantity(); ok {
+		if err := orderitem.RefundedQuantityValidator(v); err != nil {
+			return &ValidationError{Name: "refunded_quantity", err: fmt.Errorf(`ent: validator failed for field "OrderItem.refunded_quantity": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.VoidReason(); ok {
+		if err := orderitem.VoidReasonValidator(v); err != nil {
+			return &ValidationError{Name: "void_reason", err: fmt.Errorf(`ent: validator failed for field "OrderItem.void_reason": %w`, err)}
+		}
+	}
 	if _u.mutation.OrderCleared() && len(_u.mutation.OrderIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "OrderItem.order"`)
 	}
@@ -675,10 +1270,40 @@ func (_u *OrderItemUpdateOne) sqlSave(ctx context.Context) (_node *OrderItem, er
 		_spec.SetField(orderitem.FieldItemName, field.TypeString, value)
 	}
 	if value, ok := _u.mutation.ItemPrice(); ok {
-		_spec.SetField(orderitem.FieldItemPrice, field.TypeFloat64, value)
+		_spec.SetField(orderitem.FieldItemPrice, field.TypeInt64, value)
 	}
 	if value, ok := _u.mutation.AddedItemPrice(); ok {
-		_spec.AddField(orderitem.FieldItemPrice, field.TypeFloat64, value)
+		_spec.AddField(orderitem.FieldItemPrice, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.ModifiersTotal(); ok {
+		_spec.SetField(orderitem.FieldModifiersTotal, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedModifiersTotal(); ok {
+		_spec.AddField(orderitem.FieldModifiersTotal, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.LineTotal(); ok {
+		_spec.SetField(orderitem.FieldLineTotal, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedLineTotal(); ok {
+		_spec.AddField(orderitem.FieldLineTotal, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.RefundedQuantity(); ok {
+		_spec.SetField(orderitem.FieldRefundedQuantity, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedRefundedQuantity(); ok {
+		_spec.AddField(orderitem.FieldRefundedQuantity, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.VariantName(); ok {
+		_spec.SetField(orderitem.FieldVariantName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.VoidedAt(); ok {
+		_spec.SetField(orderitem.FieldVoidedAt, field.TypeTime, value)
+	}
+	if _u.mutation.VoidedAtCleared() {
+		_spec.ClearField(orderitem.FieldVoidedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.VoidReason(); ok {
+		_spec.SetField(orderitem.FieldVoidReason, field.TypeString, value)
 	}
 	if _u.mutation.OrderCleared() {
 		edge := &sqlgraph.EdgeSpec{
@@ -738,6 +1363,35 @@ func (_u *OrderItemUpdateOne) sqlSave(ctx context.Context) (_node *OrderItem, er
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.VariantCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   orderitem.VariantTable,
+			Columns: []string{orderitem.VariantColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(menuitemvariant.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.VariantIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   orderitem.VariantTable,
+			Columns: []string{orderitem.VariantColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(menuitemvariant.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if _u.mutation.OrderItemModifierOptionsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -783,6 +1437,80 @@ func (_u *OrderItemUpdateOne) sqlSave(ctx context.Context) (_node *OrderItem, er
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.StationTicketCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   orderitem.StationTicketTable,
+			Columns: []string{orderitem.StationTicketColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(stationticket.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.StationTicketIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   orderitem.StationTicketTable,
+			Columns: []string{orderitem.StationTicketColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(stationticket.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ChangesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   orderitem.ChangesTable,
+			Columns: []string{orderitem.ChangesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(orderitemchange.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedChangesIDs(); len(nodes) > 0 && !_u.mutation.ChangesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   orderitem.ChangesTable,
+			Columns: []string{orderitem.ChangesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(orderitemchange.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ChangesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   orderitem.ChangesTable,
+			Columns: []string{orderitem.ChangesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(orderitemchange.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &OrderItem{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
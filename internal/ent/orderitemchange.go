@@ -0,0 +1,283 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/Jiruu246/rms/internal/ent/order"
+	"github.com/Jiruu246/rms/internal/ent/orderitem"
+	"github.com/Jiruu246/rms/internal/ent/orderitemchange"
+	"github.com/Jiruu246/rms/internal/ent/user"
+	"github.com/google/uuid"
+)
+
+// OrderItemChange is the model entity for the OrderItemChange schema.
+type OrderItemChange struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// CreateTime holds the value of the "create_time" field.
+	CreateTime time.Time `json:"create_time,omitempty"`
+	// Action holds the value of the "action" field.
+	Action orderitemchange.Action `json:"action,omitempty"`
+	// Quantity before the change; nil for added lines
+	QuantityBefore *int `json:"quantity_before,omitempty"`
+	// Quantity after the change; 0 for voided lines
+	QuantityAfter int `json:"quantity_after,omitempty"`
+	// InstructionsBefore holds the value of the "instructions_before" field.
+	InstructionsBefore string `json:"instructions_before,omitempty"`
+	// InstructionsAfter holds the value of the "instructions_after" field.
+	InstructionsAfter string `json:"instructions_after,omitempty"`
+	// Free-text reason supplied with the change
+	Reason string `json:"reason,omitempty"`
+	// ID of the user who made the change
+	ChangedByID *uuid.UUID `json:"changed_by_id,omitempty"`
+	// OrderID holds the value of the "order_id" field.
+	OrderID uuid.UUID `json:"order_id,omitempty"`
+	// OrderItemID holds the value of the "order_item_id" field.
+	OrderItemID uuid.UUID `json:"order_item_id,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the OrderItemChangeQuery when eager-loading is set.
+	Edges        OrderItemChangeEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// OrderItemChangeEdges holds the relations/edges for other nodes in the graph.
+type OrderItemChangeEdges struct {
+	// Order holds the value of the order edge.
+	Order *Order `json:"order,omitempty"`
+	// OrderItem holds the value of the order_item edge.
+	OrderItem *OrderItem `json:"order_item,omitempty"`
+	// ChangedBy holds the value of the changed_by edge.
+	ChangedBy *User `json:"changed_by,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [3]bool
+}
+
+// OrderOrErr returns the Order value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e OrderItemChangeEdges) OrderOrErr() (*Order, error) {
+	if e.Order != nil {
+		return e.Order, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: order.Label}
+	}
+	return nil, &NotLoadedError{edge: "order"}
+}
+
+// OrderItemOrErr returns the OrderItem value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e OrderItemChangeEdges) OrderItemOrErr() (*OrderItem, error) {
+	if e.OrderItem != nil {
+		return e.OrderItem, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: orderitem.Label}
+	}
+	return nil, &NotLoadedError{edge: "order_item"}
+}
+
+// ChangedByOrErr returns the ChangedBy value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e OrderItemChangeEdges) ChangedByOrErr() (*User, error) {
+	if e.ChangedBy != nil {
+		return e.ChangedBy, nil
+	} else if e.loadedTypes[2] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "changed_by"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*OrderItemChange) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case orderitemchange.FieldChangedByID:
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case orderitemchange.FieldQuantityBefore, orderitemchange.FieldQuantityAfter:
+			values[i] = new(sql.NullInt64)
+		case orderitemchange.FieldAction, orderitemchange.FieldInstructionsBefore, orderitemchange.FieldInstructionsAfter, orderitemchange.FieldReason:
+			values[i] = new(sql.NullString)
+		case orderitemchange.FieldCreateTime:
+			values[i] = new(sql.NullTime)
+		case orderitemchange.FieldID, orderitemchange.FieldOrderID, orderitemchange.FieldOrderItemID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the OrderItemChange fields.
+func (_m *OrderItemChange) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case orderitemchange.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case orderitemchange.FieldCreateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field create_time", values[i])
+			} else if value.Valid {
+				_m.CreateTime = value.Time
+			}
+		case orderitemchange.FieldAction:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field action", values[i])
+			} else if value.Valid {
+				_m.Action = orderitemchange.Action(value.String)
+			}
+		case orderitemchange.FieldQuantityBefore:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field quantity_before", values[i])
+			} else if value.Valid {
+				_m.QuantityBefore = new(int)
+				*_m.QuantityBefore = int(value.Int64)
+			}
+		case orderitemchange.FieldQuantityAfter:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field quantity_after", values[i])
+			} else if value.Valid {
+				_m.QuantityAfter = int(value.Int64)
+			}
+		case orderitemchange.FieldInstructionsBefore:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field instructions_before", values[i])
+			} else if value.Valid {
+				_m.InstructionsBefore = value.String
+			}
+		case orderitemchange.FieldInstructionsAfter:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field instructions_after", values[i])
+			} else if value.Valid {
+				_m.InstructionsAfter = value.String
+			}
+		case orderitemchange.FieldReason:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field reason", values[i])
+			} else if value.Valid {
+				_m.Reason = value.String
+			}
+		case orderitemchange.FieldChangedByID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field changed_by_id", values[i])
+			} else if value.Valid {
+				_m.ChangedByID = new(uuid.UUID)
+				*_m.ChangedByID = *value.S.(*uuid.UUID)
+			}
+		case orderitemchange.FieldOrderID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field order_id", values[i])
+			} else if value != nil {
+				_m.OrderID = *value
+			}
+		case orderitemchange.FieldOrderItemID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field order_item_id", values[i])
+			} else if value != nil {
+				_m.OrderItemID = *value
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the OrderItemChange.
+// This includes values selected through modifiers, order, etc.
+func (_m *OrderItemChange) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryOrder queries the "order" edge of the OrderItemChange entity.
+func (_m *OrderItemChange) QueryOrder() *OrderQuery {
+	return NewOrderItemChangeClient(_m.config).QueryOrder(_m)
+}
+
+// QueryOrderItem queries the "order_item" edge of the OrderItemChange entity.
+func (_m *OrderItemChange) QueryOrderItem() *OrderItemQuery {
+	return NewOrderItemChangeClient(_m.config).QueryOrderItem(_m)
+}
+
+// QueryChangedBy queries the "changed_by" edge of the OrderItemChange entity.
+func (_m *OrderItemChange) QueryChangedBy() *UserQuery {
+	return NewOrderItemChangeClient(_m.config).QueryChangedBy(_m)
+}
+
+// Update returns a builder for updating this OrderItemChange.
+// Note that you need to call OrderItemChange.Unwrap() before calling this method if this OrderItemChange
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *OrderItemChange) Update() *OrderItemChangeUpdateOne {
+	return NewOrderItemChangeClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the OrderItemChange entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *OrderItemChange) Unwrap() *OrderItemChange {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: OrderItemChange is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *OrderItemChange) String() string {
+	var builder strings.Builder
+	builder.WriteString("OrderItemChange(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("create_time=")
+	builder.WriteString(_m.CreateTime.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("action=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Action))
+	builder.WriteString(", ")
+	if v := _m.QuantityBefore; v != nil {
+		builder.WriteString("quantity_before=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("quantity_after=")
+	builder.WriteString(fmt.Sprintf("%v", _m.QuantityAfter))
+	builder.WriteString(", ")
+	builder.WriteString("instructions_before=")
+	builder.WriteString(_m.InstructionsBefore)
+	builder.WriteString(", ")
+	builder.WriteString("instructions_after=")
+	builder.WriteString(_m.InstructionsAfter)
+	builder.WriteString(", ")
+	builder.WriteString("reason=")
+	builder.WriteString(_m.Reason)
+	builder.WriteString(", ")
+	if v := _m.ChangedByID; v != nil {
+		builder.WriteString("changed_by_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("order_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.OrderID))
+	builder.WriteString(", ")
+	builder.WriteString("order_item_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.OrderItemID))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// OrderItemChanges is a parsable slice of OrderItemChange.
+type OrderItemChanges []*OrderItemChange
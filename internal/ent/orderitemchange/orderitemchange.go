@@ -0,0 +1,234 @@
+// Code generated by ent, DO NOT EDIT.
+
+package orderitemchange
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the orderitemchange type in the database.
+	Label = "order_item_change"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreateTime holds the string denoting the create_time field in the database.
+	FieldCreateTime = "create_time"
+	// FieldAction holds the string denoting the action field in the database.
+	FieldAction = "action"
+	// FieldQuantityBefore holds the string denoting the quantity_before field in the database.
+	FieldQuantityBefore = "quantity_before"
+	// FieldQuantityAfter holds the string denoting the quantity_after field in the database.
+	FieldQuantityAfter = "quantity_after"
+	// FieldInstructionsBefore holds the string denoting the instructions_before field in the database.
+	FieldInstructionsBefore = "instructions_before"
+	// FieldInstructionsAfter holds the string denoting the instructions_after field in the database.
+	FieldInstructionsAfter = "instructions_after"
+	// FieldReason holds the string denoting the reason field in the database.
+	FieldReason = "reason"
+	// FieldChangedByID holds the string denoting the changed_by_id field in the database.
+	FieldChangedByID = "changed_by_id"
+	// FieldOrderID holds the string denoting the order_id field in the database.
+	FieldOrderID = "order_id"
+	// FieldOrderItemID holds the string denoting the order_item_id field in the database.
+	FieldOrderItemID = "order_item_id"
+	// EdgeOrder holds the string denoting the order edge name in mutations.
+	EdgeOrder = "order"
+	// EdgeOrderItem holds the string denoting the order_item edge name in mutations.
+	EdgeOrderItem = "order_item"
+	// EdgeChangedBy holds the string denoting the changed_by edge name in mutations.
+	EdgeChangedBy = "changed_by"
+	// Table holds the table name of the orderitemchange in the database.
+	Table = "order_item_changes"
+	// OrderTable is the table that holds the order relation/edge.
+	OrderTable = "order_item_changes"
+	// OrderInverseTable is the table name for the Order entity.
+	// It exists in this package in order to avoid circular dependency with the "order" package.
+	OrderInverseTable = "orders"
+	// OrderColumn is the table column denoting the order relation/edge.
+	OrderColumn = "order_id"
+	// OrderItemTable is the table that holds the order_item relation/edge.
+	OrderItemTable = "order_item_changes"
+	// OrderItemInverseTable is the table name for the OrderItem entity.
+	// It exists in this package in order to avoid circular dependency with the "orderitem" package.
+	OrderItemInverseTable = "order_items"
+	// OrderItemColumn is the table column denoting the order_item relation/edge.
+	OrderItemColumn = "order_item_id"
+	// ChangedByTable is the table that holds the changed_by relation/edge.
+	ChangedByTable = "order_item_changes"
+	// ChangedByInverseTable is the table name for the User entity.
+	// It exists in this package in order to avoid circular dependency with the "user" package.
+	ChangedByInverseTable = "users"
+	// ChangedByColumn is the table column denoting the changed_by relation/edge.
+	ChangedByColumn = "changed_by_id"
+)
+
+// Columns holds all SQL columns for orderitemchange fields.
+var Columns = []string{
+	FieldID,
+	FieldCreateTime,
+	FieldAction,
+	FieldQuantityBefore,
+	FieldQuantityAfter,
+	FieldInstructionsBefore,
+	FieldInstructionsAfter,
+	FieldReason,
+	FieldChangedByID,
+	FieldOrderID,
+	FieldOrderItemID,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreateTime holds the default value on creation for the "create_time" field.
+	DefaultCreateTime func() time.Time
+	// QuantityAfterValidator is a validator for the "quantity_after" field. It is called by the builders before save.
+	QuantityAfterValidator func(int) error
+	// DefaultInstructionsBefore holds the default value on creation for the "instructions_before" field.
+	DefaultInstructionsBefore string
+	// DefaultInstructionsAfter holds the default value on creation for the "instructions_after" field.
+	DefaultInstructionsAfter string
+	// DefaultReason holds the default value on creation for the "reason" field.
+	DefaultReason string
+	// ReasonValidator is a validator for the "reason" field. It is called by the builders before save.
+	ReasonValidator func(string) error
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// Action defines the type for the "action" enum field.
+type Action string
+
+// Action values.
+const (
+	ActionADDED   Action = "ADDED"
+	ActionUPDATED Action = "UPDATED"
+	ActionVOIDED  Action = "VOIDED"
+)
+
+func (a Action) String() string {
+	return string(a)
+}
+
+// ActionValidator is a validator for the "action" field enum values. It is called by the builders before save.
+func ActionValidator(a Action) error {
+	switch a {
+	case ActionADDED, ActionUPDATED, ActionVOIDED:
+		return nil
+	default:
+		return fmt.Errorf("orderitemchange: invalid enum value for action field: %q", a)
+	}
+}
+
+// OrderOption defines the ordering options for the OrderItemChange queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreateTime orders the results by the create_time field.
+func ByCreateTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreateTime, opts...).ToFunc()
+}
+
+// ByAction orders the results by the action field.
+func ByAction(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAction, opts...).ToFunc()
+}
+
+// ByQuantityBefore orders the results by the quantity_before field.
+func ByQuantityBefore(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldQuantityBefore, opts...).ToFunc()
+}
+
+// ByQuantityAfter orders the results by the quantity_after field.
+func ByQuantityAfter(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldQuantityAfter, opts...).ToFunc()
+}
+
+// ByInstructionsBefore orders the results by the instructions_before field.
+func ByInstructionsBefore(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldInstructionsBefore, opts...).ToFunc()
+}
+
+// ByInstructionsAfter orders the results by the instructions_after field.
+func ByInstructionsAfter(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldInstructionsAfter, opts...).ToFunc()
+}
+
+// ByReason orders the results by the reason field.
+func ByReason(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReason, opts...).ToFunc()
+}
+
+// ByChangedByID orders the results by the changed_by_id field.
+func ByChangedByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldChangedByID, opts...).ToFunc()
+}
+
+// ByOrderID orders the results by the order_id field.
+func ByOrderID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOrderID, opts...).ToFunc()
+}
+
+// ByOrderItemID orders the results by the order_item_id field.
+func ByOrderItemID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOrderItemID, opts...).ToFunc()
+}
+
+// ByOrderField orders the results by order field.
+func ByOrderField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newOrderStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByOrderItemField orders the results by order_item field.
+func ByOrderItemField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newOrderItemStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByChangedByField orders the results by changed_by field.
+func ByChangedByField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newChangedByStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newOrderStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(OrderInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, OrderTable, OrderColumn),
+	)
+}
+func newOrderItemStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(OrderItemInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, OrderItemTable, OrderItemColumn),
+	)
+}
+func newChangedByStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ChangedByInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, ChangedByTable, ChangedByColumn),
+	)
+}
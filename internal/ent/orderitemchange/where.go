@@ -0,0 +1,601 @@
+// Code generated by ent, DO NOT EDIT.
+
+package orderitemchange
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/Jiruu246/rms/internal/ent/predicate"
+	"github.com/google/uuid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.OrderItemChange {
+	return predicate.OrderItemChange(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.OrderItemChange {
+	return predicate.OrderItemChange(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.OrderItemChange {
+	return predicate.OrderItemChange(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.OrderItemChange {
+	return predicate.OrderItemChange(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.OrderItemChange {
+	return predicate.OrderItemChange(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.OrderItemChange {
+	return predicate.OrderItemChange(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.OrderItemChange {
+	return predicate.OrderItemChange(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.OrderItemChange {
+	return predicate.OrderItemChange(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.OrderItemChange {
+	return predicate.OrderItemChange(sql.FieldLTE(FieldID, id))
+}
+
+// CreateTime applies equality check predicate on the "create_time" field. It's identical to CreateTimeEQ.
+func CreateTime(v time.Time) predicate.OrderItemChange {
+	return predicate.OrderItemChange(sql.FieldEQ(FieldCreateTime, v))
+}
+
+// QuantityBefore applies equality check predicate on the "quantity_before" field. It's identical to QuantityBeforeEQ.
+func QuantityBefore(v int) predicate.OrderItemChange {
+	return predicate.OrderItemChange(sql.FieldEQ(FieldQuantityBefore, v))
+}
+
+// QuantityAfter applies equality check predicate on the "quantity_after" field. It's identical to QuantityAfterEQ.
+func QuantityAfter(v int) predicate.OrderItemChange {
+	return predicate.OrderItemChange(sql.FieldEQ(FieldQuantityAfter, v))
+}
+
+// InstructionsBefore applies equality check predicate on the "instructions_before" field. It's identical to InstructionsBeforeEQ.
+func InstructionsBefore(v string) predicate.OrderItemChange {
+	return predicate.OrderItemChange(sql.FieldEQ(FieldInstructionsBefore, v))
+}
+
+// InstructionsAfter applies equality check predicate on the "instructions_after" field. It's identical to InstructionsAfterEQ.
+func InstructionsAfter(v string) predicate.OrderItemChange {
+	return predicate.OrderItemChange(sql.FieldEQ(FieldInstructionsAfter, v))
+}
+
+// Reason applies equality check predicate on the "reason" field. It's identical to ReasonEQ.
+func Reason(v string) predicate.OrderItemChange {
+	return predicate.OrderItemChange(sql.FieldEQ(FieldReason, v))
+}
+
+// ChangedByID applies equality check predicate on the "changed_by_id" field. It's identical to ChangedByIDEQ.
+func ChangedByID(v uuid.UUID) predicate.OrderItemChange {
+	return predicate.OrderItemChange(sql.FieldEQ(FieldChangedByID, v))
+}
+
+// OrderID applies equality check predicate on the "order_id" field. It's identical to OrderIDEQ.
+func OrderID(v uuid.UUID) predicate.OrderItemChange {
+	return predicate.OrderItemChange(sql.FieldEQ(FieldOrderID, v))
+}
+
+// OrderItemID applies equality check predicate on the "order_item_id" field. It's identical to OrderItemIDEQ.
+func OrderItemID(v uuid.UUID) predicate.OrderItemChange {
+	return predicate.OrderItemChange(sql.FieldEQ(FieldOrderItemID, v))
+}
+
+// CreateTimeEQ applies the EQ predicate on the "create_time" field.
+func CreateTimeEQ(v time.Time) predicate.OrderItemChange {
+	return predicate.OrderItemChange(sql.FieldEQ(FieldCreateTime, v))
+}
+
+// CreateTimeNEQ applies the NEQ predicate on the "create_time" field.
+func CreateTimeNEQ(v time.Time) predicate.OrderItemChange {
+	return predicate.OrderItemChange(sql.FieldNEQ(FieldCreateTime, v))
+}
+
+// CreateTimeIn applies the In predicate on the "create_time" field.
+func CreateTimeIn(vs ...time.Time) predicate.OrderItemChange {
+	return predicate.OrderItemChange(sql.FieldIn(FieldCreateTime, vs...))
+}
+
+// CreateTimeNotIn applies the NotIn predicate on the "create_time" field.
+func CreateTimeNotIn(vs ...time.Time) predicate.OrderItemChange {
+	return predicate.OrderItemChange(sql.FieldNotIn(FieldCreateTime, vs...))
+}
+
+// CreateTimeGT applies the GT predicate on the "create_time" field.
+func CreateTimeGT(v time.Time) predicate.OrderItemChange {
+	return predicate.OrderItemChange(sql.FieldGT(FieldCreateTime, v))
+}
+
+// CreateTimeGTE applies the GTE predicate on the "create_time" field.
+func CreateTimeGTE(v time.Time) predicate.OrderItemChange {
+	return predicate.OrderItemChange(sql.FieldGTE(FieldCreateTime, v))
+}
+
+// CreateTimeLT applies the LT predicate on the "create_time" field.
+func CreateTimeLT(v time.Time) predicate.OrderItemChange {
+	return predicate.OrderItemChange(sql.FieldLT(FieldCreateTime, v))
+}
+
+// CreateTimeLTE applies the LTE predicate on the "create_time" field.
+func CreateTimeLTE(v time.Time) predicate.OrderItemChange {
+	return predicate.OrderItemChange(sql.FieldLTE(FieldCreateTime, v))
+}
+
+// ActionEQ applies the EQ predicate on the "action" field.
+func ActionEQ(v Action) predicate.OrderItemChange {
+	return predicate.OrderItemChange(sql.FieldEQ(FieldAction, v))
+}
+
+// ActionNEQ applies the NEQ predicate on the "action" field.
+func ActionNEQ(v Action) predicate.OrderItemChange {
+	return predicate.OrderItemChange(sql.FieldNEQ(FieldAction, v))
+}
+
+// ActionIn applies the In predicate on the "action" field.
+func ActionIn(vs ...Action) predicate.OrderItemChange {
+	return predicate.OrderItemChange(sql.FieldIn(FieldAction, vs...))
+}
+
+// ActionNotIn applies the NotIn predicate on the "action" field.
+func ActionNotIn(vs ...Action) predicate.OrderItemChange {
+	return predicate.OrderItemChange(sql.FieldNotIn(FieldAction, vs...))
+}
+
+// QuantityBeforeEQ applies the EQ predicate on the "quantity_before" field.
+func QuantityBeforeEQ(v int) predicate.OrderItemChange {
+	return predicate.OrderItemChange(sql.FieldEQ(FieldQuantityBefore, v))
+}
+
+// QuantityBeforeNEQ applies the NEQ predicate on the "quantity_before" field.
+func QuantityBeforeNEQ(v int) predicate.OrderItemChange {
+	return predicate.OrderItemChange(sql.FieldNEQ(FieldQuantityBefore, v))
+}
+
+// QuantityBeforeIn applies the In predicate on the "quantity_before" field.
+func QuantityBeforeIn(vs ...int) predicate.OrderItemChange {
+	return predicate.OrderItemChange(sql.FieldIn(FieldQuantityBefore, vs...))
+}
+
+// QuantityBeforeNotIn applies the NotIn predicate on the "quantity_before" field.
+func QuantityBeforeNotIn(vs ...int) predicate.OrderItemChange {
+	return predicate.OrderItemChange(sql.FieldNotIn(FieldQuantityBefore, vs...))
+}
+
+// QuantityBeforeGT applies the GT predicate on the "quantity_before" field.
+func QuantityBeforeGT(v int) predicate.OrderItemChange {
+	return predicate.OrderItemChange(sql.FieldGT(FieldQuantityBefore, v))
+}
+
+// QuantityBeforeGTE applies the GTE predicate on the "quantity_before" field.
+func QuantityBeforeGTE(v int) predicate.OrderItemChange {
+	return predicate.OrderItemChange(sql.FieldGTE(FieldQuantityBefore, v))
+}
+
+// QuantityBeforeLT applies the LT predicate on the "quantity_before" field.
+func QuantityBeforeLT(v int) predicate.OrderItemChange {
+	return predicate.OrderItemChange(sql.FieldLT(FieldQuantityBefore, v))
+}
+
+// QuantityBeforeLTE applies the LTE predicate on the "quantity_before" field.
+func QuantityBeforeLTE(v int) predicate.OrderItemChange {
+	return predicate.OrderItemChange(sql.FieldLTE(FieldQuantityBefore, v))
+}
+
+// QuantityBeforeIsNil applies the IsNil predicate on the "quantity_before" field.
+func QuantityBeforeIsNil() predicate.OrderItemChange {
+	return predicate.OrderItemChange(sql.FieldIsNull(FieldQuantityBefore))
+}
+
+// QuantityBeforeNotNil applies the NotNil predicate on the "quantity_before" field.
+func QuantityBeforeNotNil() predicate.OrderItemChange {
+	return predicate.OrderItemChange(sql.FieldNotNull(FieldQuantityBefore))
+}
+
+// QuantityAfterEQ applies the EQ predicate on the "quantity_after" field.
+func QuantityAfterEQ(v int) predicate.OrderItemChange {
+	return predicate.OrderItemChange(sql.FieldEQ(FieldQuantityAfter, v))
+}
+
+// QuantityAfterNEQ applies the NEQ predicate on the "quantity_after" field.
+func QuantityAfterNEQ(v int) predicate.OrderItemChange {
+	return predicate.OrderItemChange(sql.FieldNEQ(FieldQuantityAfter, v))
+}
+
+// QuantityAfterIn applies the In predicate on the "quantity_after" field.
+func QuantityAfterIn(vs ...int) predicate.OrderItemChange {
+	return predicate.OrderItemChange(sql.FieldIn(FieldQuantityAfter, vs...))
+}
+
+// QuantityAfterNotIn applies the NotIn predicate on the "quantity_after" field.
+func QuantityAfterNotIn(vs ...int) predicate.OrderItemChange {
+	return predicate.OrderItemChange(sql.FieldNotIn(FieldQuantityAfter, vs...))
+}
+
+// QuantityAfterGT applies the GT predicate on the "quantity_after" field.
+func QuantityAfterGT(v int) predicate.OrderItemChange {
+	return predicate.OrderItemChange(sql.FieldGT(FieldQuantityAfter, v))
+}
+
+// QuantityAfterGTE applies the GTE predicate on the "quantity_after" field.
+func QuantityAfterGTE(v int) predicate.OrderItemChange {
+	return predicate.OrderItemChange(sql.FieldGTE(FieldQuantityAfter, v))
+}
+
+// QuantityAfterLT applies the LT predicate on the "quantity_after" field.
+func QuantityAfterLT(v int) predicate.OrderItemChange {
+	return predicate.OrderItemChange(sql.FieldLT(FieldQuantityAfter, v))
+}
+
+// QuantityAfterLTE applies the LTE predicate on the "quantity_after" field.
+func QuantityAfterLTE(v int) predicate.OrderItemChange {
+	return predicate.OrderItemChange(sql.FieldLTE(FieldQuantityAfter, v))
+}
+
+// InstructionsBeforeEQ applies the EQ predicate on the "instructions_before" field.
+func InstructionsBeforeEQ(v string) predicate.OrderItemChange {
+	return predicate.OrderItemChange(sql.FieldEQ(FieldInstructionsBefore, v))
+}
+
+// InstructionsBeforeNEQ applies the NEQ predicate on the "instructions_before" field.
+func InstructionsBeforeNEQ(v string) predicate.OrderItemChange {
+	return predicate.OrderItemChange(sql.FieldNEQ(FieldInstructionsBefore, v))
+}
+
+// InstructionsBeforeIn applies the In predicate on the "instructions_before" field.
+func InstructionsBeforeIn(vs ...string) predicate.OrderItemChange {
+	return predicate.OrderItemChange(sql.FieldIn(FieldInstructionsBefore, vs...))
+}
+
+// InstructionsBeforeNotIn applies the NotIn predicate on the "instructions_before" field.
+func InstructionsBeforeNotIn(vs ...string) predicate.OrderItemChange {
+	return predicate.OrderItemChange(sql.FieldNotIn(FieldInstructionsBefore, vs...))
+}
+
+// InstructionsBeforeGT applies the GT predicate on the "instructions_before" field.
+func InstructionsBeforeGT(v string) predicate.OrderItemChange {
+	return predicate.OrderItemChange(sql.FieldGT(FieldInstructionsBefore, v))
+}
+
+// InstructionsBeforeGTE applies the GTE predicate on the "instructions_before" field.
+func InstructionsBeforeGTE(v string) predicate.OrderItemChange {
+	return predicate.OrderItemChange(sql.FieldGTE(FieldInstructionsBefore, v))
+}
+
+// InstructionsBeforeLT applies the LT predicate on the "instructions_before" field.
+func InstructionsBeforeLT(v string) predicate.OrderItemChange {
+	return predicate.OrderItemChange(sql.FieldLT(FieldInstructionsBefore, v))
+}
+
+// InstructionsBeforeLTE applies the LTE predicate on the "instructions_before" field.
+func InstructionsBeforeLTE(v string) predicate.OrderItemChange {
+	return predicate.OrderItemChange(sql.FieldLTE(FieldInstructionsBefore, v))
+}
+
+// InstructionsBeforeContains applies the Contains predicate on the "instructions_before" field.
+func InstructionsBeforeContains(v string) predicate.OrderItemChange {
+	return predicate.OrderItemChange(sql.FieldContains(FieldInstructionsBefore, v))
+}
+
+// InstructionsBeforeHasPrefix applies the HasPrefix predicate on the "instructions_before" field.
+func InstructionsBeforeHasPrefix(v string) predicate.OrderItemChange {
+	return predicate.OrderItemChange(sql.FieldHasPrefix(FieldInstructionsBefore, v))
+}
+
+// InstructionsBeforeHasSuffix applies the HasSuffix predicate on the "instructions_before" field.
+func InstructionsBeforeHasSuffix(v string) predicate.OrderItemChange {
+	return predicate.OrderItemChange(sql.FieldHasSuffix(FieldInstructionsBefore, v))
+}
+
+// InstructionsBeforeEqualFold applies the EqualFold predicate on the "instructions_before" field.
+func InstructionsBeforeEqualFold(v string) predicate.OrderItemChange {
+	return predicate.OrderItemChange(sql.FieldEqualFold(FieldInstructionsBefore, v))
+}
+
+// InstructionsBeforeContainsFold applies the ContainsFold predicate on the "instructions_before" field.
+func InstructionsBeforeContainsFold(v string) predicate.OrderItemChange {
+	return predicate.OrderItemChange(sql.FieldContainsFold(FieldInstructionsBefore, v))
+}
+
+// InstructionsAfterEQ applies the EQ predicate on the "instructions_after" field.
+func InstructionsAfterEQ(v string) predicate.OrderItemChange {
+	return predicate.OrderItemChange(sql.FieldEQ(FieldInstructionsAfter, v))
+}
+
+// InstructionsAfterNEQ applies the NEQ predicate on the "instructions_after" field.
+func InstructionsAfterNEQ(v string) predicate.OrderItemChange {
+	return predicate.OrderItemChange(sql.FieldNEQ(FieldInstructionsAfter, v))
+}
+
+// InstructionsAfterIn applies the In predicate on the "instructions_after" field.
+func InstructionsAfterIn(vs ...string) predicate.OrderItemChange {
+	return predicate.OrderItemChange(sql.FieldIn(FieldInstructionsAfter, vs...))
+}
+
+// InstructionsAfterNotIn applies the NotIn predicate on the "instructions_after" field.
+func InstructionsAfterNotIn(vs ...string) predicate.OrderItemChange {
+	return predicate.OrderItemChange(sql.FieldNotIn(FieldInstructionsAfter, vs...))
+}
+
+// InstructionsAfterGT applies the GT predicate on the "instructions_after" field.
+func InstructionsAfterGT(v string) predicate.OrderItemChange {
+	return predicate.OrderItemChange(sql.FieldGT(FieldInstructionsAfter, v))
+}
+
+// InstructionsAfterGTE applies the GTE predicate on the "instructions_after" field.
+func InstructionsAfterGTE(v string) predicate.OrderItemChange {
+	return predicate.OrderItemChange(sql.FieldGTE(FieldInstructionsAfter, v))
+}
+
+// InstructionsAfterLT applies the LT predicate on the "instructions_after" field.
+func InstructionsAfterLT(v string) predicate.OrderItemChange {
+	return predicate.OrderItemChange(sql.FieldLT(FieldInstructionsAfter, v))
+}
+
+// InstructionsAfterLTE applies the LTE predicate on the "instructions_after" field.
+func InstructionsAfterLTE(v string) predicate.OrderItemChange {
+	return predicate.OrderItemChange(sql.FieldLTE(FieldInstructionsAfter, v))
+}
+
+// InstructionsAfterContains applies the Contains predicate on the "instructions_after" field.
+func InstructionsAfterContains(v string) predicate.OrderItemChange {
+	return predicate.OrderItemChange(sql.FieldContains(FieldInstructionsAfter, v))
+}
+
+// InstructionsAfterHasPrefix applies the HasPrefix predicate on the "instructions_after" field.
+func InstructionsAfterHasPrefix(v string) predicate.OrderItemChange {
+	return predicate.OrderItemChange(sql.FieldHasPrefix(FieldInstructionsAfter, v))
+}
+
+// InstructionsAfterHasSuffix applies the HasSuffix predicate on the "instructions_after" field.
+func InstructionsAfterHasSuffix(v string) predicate.OrderItemChange {
+	return predicate.OrderItemChange(sql.FieldHasSuffix(FieldInstructionsAfter, v))
+}
+
+// InstructionsAfterEqualFold applies the EqualFold predicate on the "instructions_after" field.
+func InstructionsAfterEqualFold(v string) predicate.OrderItemChange {
+	return predicate.OrderItemChange(sql.FieldEqualFold(FieldInstructionsAfter, v))
+}
+
+// InstructionsAfterContainsFold applies the ContainsFold predicate on the "instructions_after" field.
+func InstructionsAfterContainsFold(v string) predicate.OrderItemChange {
+	return predicate.OrderItemChange(sql.FieldContainsFold(FieldInstructionsAfter, v))
+}
+
+// ReasonEQ applies the EQ predicate on the "reason" field.
+func ReasonEQ(v string) predicate.OrderItemChange {
+	return predicate.OrderItemChange(sql.FieldEQ(FieldReason, v))
+}
+
+// ReasonNEQ applies the NEQ predicate on the "reason" field.
+func ReasonNEQ(v string) predicate.OrderItemChange {
+	return predicate.OrderItemChange(sql.FieldNEQ(FieldReason, v))
+}
+
+// ReasonIn applies the In predicate on the "reason" field.
+func ReasonIn(vs ...string) predicate.OrderItemChange {
+	return predicate.OrderItemChange(sql.FieldIn(FieldReason, vs...))
+}
+
+// ReasonNotIn applies the NotIn predicate on the "reason" field.
+func ReasonNotIn(vs ...string) predicate.OrderItemChange {
+	return predicate.OrderItemChange(sql.FieldNotIn(FieldReason, vs...))
+}
+
+// ReasonGT applies the GT predicate on the "reason" field.
+func ReasonGT(v string) predicate.OrderItemChange {
+	return predicate.OrderItemChange(sql.FieldGT(FieldReason, v))
+}
+
+// ReasonGTE applies the GTE predicate on the "reason" field.
+func ReasonGTE(v string) predicate.OrderItemChange {
+	return predicate.OrderItemChange(sql.FieldGTE(FieldReason, v))
+}
+
+// ReasonLT applies the LT predicate on the "reason" field.
+func ReasonLT(v string) predicate.OrderItemChange {
+	return predicate.OrderItemChange(sql.FieldLT(FieldReason, v))
+}
+
+// ReasonLTE applies the LTE predicate on the "reason" field.
+func ReasonLTE(v string) predicate.OrderItemChange {
+	return predicate.OrderItemChange(sql.FieldLTE(FieldReason, v))
+}
+
+// ReasonContains applies the Contains predicate on the "reason" field.
+func ReasonContains(v string) predicate.OrderItemChange {
+	return predicate.OrderItemChange(sql.FieldContains(FieldReason, v))
+}
+
+// ReasonHasPrefix applies the HasPrefix predicate on the "reason" field.
+func ReasonHasPrefix(v string) predicate.OrderItemChange {
+	return predicate.OrderItemChange(sql.FieldHasPrefix(FieldReason, v))
+}
+
+// ReasonHasSuffix applies the HasSuffix predicate on the "reason" field.
+func ReasonHasSuffix(v string) predicate.OrderItemChange {
+	return predicate.OrderItemChange(sql.FieldHasSuffix(FieldReason, v))
+}
+
+// ReasonEqualFold applies the EqualFold predicate on the "reason" field.
+func ReasonEqualFold(v string) predicate.OrderItemChange {
+	return predicate.OrderItemChange(sql.FieldEqualFold(FieldReason, v))
+}
+
+// ReasonContainsFold applies the ContainsFold predicate on the "reason" field.
+func ReasonContainsFold(v string) predicate.OrderItemChange {
+	return predicate.OrderItemChange(sql.FieldContainsFold(FieldReason, v))
+}
+
+// ChangedByIDEQ applies the EQ predicate on the "changed_by_id" field.
+func ChangedByIDEQ(v uuid.UUID) predicate.OrderItemChange {
+	return predicate.OrderItemChange(sql.FieldEQ(FieldChangedByID, v))
+}
+
+// ChangedByIDNEQ applies the NEQ predicate on the "changed_by_id" field.
+func ChangedByIDNEQ(v uuid.UUID) predicate.OrderItemChange {
+	return predicate.OrderItemChange(sql.FieldNEQ(FieldChangedByID, v))
+}
+
+// ChangedByIDIn applies the In predicate on the "changed_by_id" field.
+func ChangedByIDIn(vs ...uuid.UUID) predicate.OrderItemChange {
+	return predicate.OrderItemChange(sql.FieldIn(FieldChangedByID, vs...))
+}
+
+// ChangedByIDNotIn applies the NotIn predicate on the "changed_by_id" field.
+func ChangedByIDNotIn(vs ...uuid.UUID) predicate.OrderItemChange {
+	return predicate.OrderItemChange(sql.FieldNotIn(FieldChangedByID, vs...))
+}
+
+// ChangedByIDIsNil applies the IsNil predicate on the "changed_by_id" field.
+func ChangedByIDIsNil() predicate.OrderItemChange {
+	return predicate.OrderItemChange(sql.FieldIsNull(FieldChangedByID))
+}
+
+// ChangedByIDNotNil applies the NotNil predicate on the "changed_by_id" field.
+func ChangedByIDNotNil() predicate.OrderItemChange {
+	return predicate.OrderItemChange(sql.FieldNotNull(FieldChangedByID))
+}
+
+// OrderIDEQ applies the EQ predicate on the "order_id" field.
+func OrderIDEQ(v uuid.UUID) predicate.OrderItemChange {
+	return predicate.OrderItemChange(sql.FieldEQ(FieldOrderID, v))
+}
+
+// OrderIDNEQ applies the NEQ predicate on the "order_id" field.
+func OrderIDNEQ(v uuid.UUID) predicate.OrderItemChange {
+	return predicate.OrderItemChange(sql.FieldNEQ(FieldOrderID, v))
+}
+
+// OrderIDIn applies the In predicate on the "order_id" field.
+func OrderIDIn(vs ...uuid.UUID) predicate.OrderItemChange {
+	return predicate.OrderItemChange(sql.FieldIn(FieldOrderID, vs...))
+}
+
+// OrderIDNotIn applies the NotIn predicate on the "order_id" field.
+func OrderIDNotIn(vs ...uuid.UUID) predicate.OrderItemChange {
+	return predicate.OrderItemChange(sql.FieldNotIn(FieldOrderID, vs...))
+}
+
+// OrderItemIDEQ applies the EQ predicate on the "order_item_id" field.
+func OrderItemIDEQ(v uuid.UUID) predicate.OrderItemChange {
+	return predicate.OrderItemChange(sql.FieldEQ(FieldOrderItemID, v))
+}
+
+// OrderItemIDNEQ applies the NEQ predicate on the "order_item_id" field.
+func OrderItemIDNEQ(v uuid.UUID) predicate.OrderItemChange {
+	return predicate.OrderItemChange(sql.FieldNEQ(FieldOrderItemID, v))
+}
+
+// OrderItemIDIn applies the In predicate on the "order_item_id" field.
+func OrderItemIDIn(vs ...uuid.UUID) predicate.OrderItemChange {
+	return predicate.OrderItemChange(sql.FieldIn(FieldOrderItemID, vs...))
+}
+
+// OrderItemIDNotIn applies the NotIn predicate on the "order_item_id" field.
+func OrderItemIDNotIn(vs ...uuid.UUID) predicate.OrderItemChange {
+	return predicate.OrderItemChange(sql.FieldNotIn(FieldOrderItemID, vs...))
+}
+
+// HasOrder applies the HasEdge predicate on the "order" edge.
+func HasOrder() predicate.OrderItemChange {
+	return predicate.OrderItemChange(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, OrderTable, OrderColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasOrderWith applies the HasEdge predicate on the "order" edge with a given conditions (other predicates).
+func HasOrderWith(preds ...predicate.Order) predicate.OrderItemChange {
+	return predicate.OrderItemChange(func(s *sql.Selector) {
+		step := newOrderStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasOrderItem applies the HasEdge predicate on the "order_item" edge.
+func HasOrderItem() predicate.OrderItemChange {
+	return predicate.OrderItemChange(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, OrderItemTable, OrderItemColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasOrderItemWith applies the HasEdge predicate on the "order_item" edge with a given conditions (other predicates).
+func HasOrderItemWith(preds ...predicate.OrderItem) predicate.OrderItemChange {
+	return predicate.OrderItemChange(func(s *sql.Selector) {
+		step := newOrderItemStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasChangedBy applies the HasEdge predicate on the "changed_by" edge.
+func HasChangedBy() predicate.OrderItemChange {
+	return predicate.OrderItemChange(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, ChangedByTable, ChangedByColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasChangedByWith applies the HasEdge predicate on the "changed_by" edge with a given conditions (other predicates).
+func HasChangedByWith(preds ...predicate.User) predicate.OrderItemChange {
+	return predicate.OrderItemChange(func(s *sql.Selector) {
+		step := newChangedByStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.OrderItemChange) predicate.OrderItemChange {
+	return predicate.OrderItemChange(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.OrderItemChange) predicate.OrderItemChange {
+	return predicate.OrderItemChange(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.OrderItemChange) predicate.OrderItemChange {
+	return predicate.OrderItemChange(sql.NotPredicates(p))
+}
@@ -0,0 +1,467 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Jiruu246/rms/internal/ent/order"
+	"github.com/Jiruu246/rms/internal/ent/orderitem"
+	"github.com/Jiruu246/rms/internal/ent/orderitemchange"
+	"github.com/Jiruu246/rms/internal/ent/user"
+	"github.com/google/uuid"
+)
+
+// OrderItemChangeCreate is the builder for creating a OrderItemChange entity.
+type OrderItemChangeCreate struct {
+	config
+	mutation *OrderItemChangeMutation
+	hooks    []Hook
+}
+
+// SetCreateTime sets the "create_time" field.
+func (_c *OrderItemChangeCreate) SetCreateTime(v time.Time) *OrderItemChangeCreate {
+	_c.mutation.SetCreateTime(v)
+	return _c
+}
+
+// SetNillableCreateTime sets the "create_time" field if the given value is not nil.
+func (_c *OrderItemChangeCreate) SetNillableCreateTime(v *time.Time) *OrderItemChangeCreate {
+	if v != nil {
+		_c.SetCreateTime(*v)
+	}
+	return _c
+}
+
+// SetAction sets the "action" field.
+func (_c *OrderItemChangeCreate) SetAction(v orderitemchange.Action) *OrderItemChangeCreate {
+	_c.mutation.SetAction(v)
+	return _c
+}
+
+// SetQuantityBefore sets the "quantity_before" field.
+func (_c *OrderItemChangeCreate) SetQuantityBefore(v int) *OrderItemChangeCreate {
+	_c.mutation.SetQuantityBefore(v)
+	return _c
+}
+
+// SetNillableQuantityBefore sets the "quantity_before" field if the given value is not nil.
+func (_c *OrderItemChangeCreate) SetNillableQuantityBefore(v *int) *OrderItemChangeCreate {
+	if v != nil {
+		_c.SetQuantityBefore(*v)
+	}
+	return _c
+}
+
+// SetQuantityAfter sets the "quantity_after" field.
+func (_c *OrderItemChangeCreate) SetQuantityAfter(v int) *OrderItemChangeCreate {
+	_c.mutation.SetQuantityAfter(v)
+	return _c
+}
+
+// SetInstructionsBefore sets the "instructions_before" field.
+func (_c *OrderItemChangeCreate) SetInstructionsBefore(v string) *OrderItemChangeCreate {
+	_c.mutation.SetInstructionsBefore(v)
+	return _c
+}
+
+// SetNillableInstructionsBefore sets the "instructions_before" field if the given value is not nil.
+func (_c *OrderItemChangeCreate) SetNillableInstructionsBefore(v *string) *OrderItemChangeCreate {
+	if v != nil {
+		_c.SetInstructionsBefore(*v)
+	}
+	return _c
+}
+
+// SetInstructionsAfter sets the "instructions_after" field.
+func (_c *OrderItemChangeCreate) SetInstructionsAfter(v string) *OrderItemChangeCreate {
+	_c.mutation.SetInstructionsAfter(v)
+	return _c
+}
+
+// SetNillableInstructionsAfter sets the "instructions_after" field if the given value is not nil.
+func (_c *OrderItemChangeCreate) SetNillableInstructionsAfter(v *string) *OrderItemChangeCreate {
+	if v != nil {
+		_c.SetInstructionsAfter(*v)
+	}
+	return _c
+}
+
+// SetReason sets the "reason" field.
+func (_c *OrderItemChangeCreate) SetReason(v string) *OrderItemChangeCreate {
+	_c.mutation.SetReason(v)
+	return _c
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (_c *OrderItemChangeCreate) SetNillableReason(v *string) *OrderItemChangeCreate {
+	if v != nil {
+		_c.SetReason(*v)
+	}
+	return _c
+}
+
+// SetChangedByID sets the "changed_by_id" field.
+func (_c *OrderItemChangeCreate) SetChangedByID(v uuid.UUID) *OrderItemChangeCreate {
+	_c.mutation.SetChangedByID(v)
+	return _c
+}
+
+// SetNillableChangedByID sets the "changed_by_id" field if the given value is not nil.
+func (_c *OrderItemChangeCreate) SetNillableChangedByID(v *uuid.UUID) *OrderItemChangeCreate {
+	if v != nil {
+		_c.SetChangedByID(*v)
+	}
+	return _c
+}
+
+// SetOrderID sets the "order_id" field.
+func (_c *OrderItemChangeCreate) SetOrderID(v uuid.UUID) *OrderItemChangeCreate {
+	_c.mutation.SetOrderID(v)
+	return _c
+}
+
+// SetOrderItemID sets the "order_item_id" field.
+func (_c *OrderItemChangeCreate) SetOrderItemID(v uuid.UUID) *OrderItemChangeCreate {
+	_c.mutation.SetOrderItemID(v)
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *OrderItemChangeCreate) SetID(v uuid.UUID) *OrderItemChangeCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *OrderItemChangeCreate) SetNillableID(v *uuid.UUID) *OrderItemChangeCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetOrder sets the "order" edge to the Order entity.
+func (_c *OrderItemChangeCreate) SetOrder(v *Order) *OrderItemChangeCreate {
+	return _c.SetOrderID(v.ID)
+}
+
+// SetOrderItem sets the "order_item" edge to the OrderItem entity.
+func (_c *OrderItemChangeCreate) SetOrderItem(v *OrderItem) *OrderItemChangeCreate {
+	return _c.SetOrderItemID(v.ID)
+}
+
+// SetChangedBy sets the "changed_by" edge to the User entity.
+func (_c *OrderItemChangeCreate) SetChangedBy(v *User) *OrderItemChangeCreate {
+	return _c.SetChangedByID(v.ID)
+}
+
+// Mutation returns the OrderItemChangeMutation object of the builder.
+func (_c *OrderItemChangeCreate) Mutation() *OrderItemChangeMutation {
+	return _c.mutation
+}
+
+// Save creates the OrderItemChange in the database.
+func (_c *OrderItemChangeCreate) Save(ctx context.Context) (*OrderItemChange, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *OrderItemChangeCreate) SaveX(ctx context.Context) *OrderItemChange {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *OrderItemChangeCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *OrderItemChangeCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *OrderItemChangeCreate) defaults() {
+	if _, ok := _c.mutation.CreateTime(); !ok {
+		v := orderitemchange.DefaultCreateTime()
+		_c.mutation.SetCreateTime(v)
+	}
+	if _, ok := _c.mutation.InstructionsBefore(); !ok {
+		v := orderitemchange.DefaultInstructionsBefore
+		_c.mutation.SetInstructionsBefore(v)
+	}
+	if _, ok := _c.mutation.InstructionsAfter(); !ok {
+		v := orderitemchange.DefaultInstructionsAfter
+		_c.mutation.SetInstructionsAfter(v)
+	}
+	if _, ok := _c.mutation.Reason(); !ok {
+		v := orderitemchange.DefaultReason
+		_c.mutation.SetReason(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := orderitemchange.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *OrderItemChangeCreate) check() error {
+	if _, ok := _c.mutation.CreateTime(); !ok {
+		return &ValidationError{Name: "create_time", err: errors.New(`ent: missing required field "OrderItemChange.create_time"`)}
+	}
+	if _, ok := _c.mutation.Action(); !ok {
+		return &ValidationError{Name: "action", err: errors.New(`ent: missing required field "OrderItemChange.action"`)}
+	}
+	if v, ok := _c.mutation.Action(); ok {
+		if err := orderitemchange.ActionValidator(v); err != nil {
+			return &ValidationError{Name: "action", err: fmt.Errorf(`ent: validator failed for field "OrderItemChange.action": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.QuantityAfter(); !ok {
+		return &ValidationError{Name: "quantity_after", err: errors.New(`ent: missing required field "OrderItemChange.quantity_after"`)}
+	}
+	if v, ok := _c.mutation.QuantityAfter(); ok {
+		if err := orderitemchange.QuantityAfterValidator(v); err != nil {
+			return &ValidationError{Name: "quantity_after", err: fmt.Errorf(`ent: validator failed for field "OrderItemChange.quantity_after": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.InstructionsBefore(); !ok {
+		return &ValidationError{Name: "instructions_before", err: errors.New(`ent: missing required field "OrderItemChange.instructions_before"`)}
+	}
+	if _, ok := _c.mutation.InstructionsAfter(); !ok {
+		return &ValidationError{Name: "instructions_after", err: errors.New(`ent: missing required field "OrderItemChange.instructions_after"`)}
+	}
+	if _, ok := _c.mutation.Reason(); !ok {
+		return &ValidationError{Name: "reason", err: errors.New(`ent: missing required field "OrderItemChange.reason"`)}
+	}
+	if v, ok := _c.mutation.Reason(); ok {
+		if err := orderitemchange.ReasonValidator(v); err != nil {
+			return &ValidationError{Name: "reason", err: fmt.Errorf(`ent: validator failed for field "OrderItemChange.reason": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.OrderID(); !ok {
+		return &ValidationError{Name: "order_id", err: errors.New(`ent: missing required field "OrderItemChange.order_id"`)}
+	}
+	if _, ok := _c.mutation.OrderItemID(); !ok {
+		return &ValidationError{Name: "order_item_id", err: errors.New(`ent: missing required field "OrderItemChange.order_item_id"`)}
+	}
+	if len(_c.mutation.OrderIDs()) == 0 {
+		return &ValidationError{Name: "order", err: errors.New(`ent: missing required edge "OrderItemChange.order"`)}
+	}
+	if len(_c.mutation.OrderItemIDs()) == 0 {
+		return &ValidationError{Name: "order_item", err: errors.New(`ent: missing required edge "OrderItemChange.order_item"`)}
+	}
+	return nil
+}
+
+func (_c *OrderItemChangeCreate) sqlSave(ctx context.Context) (*OrderItemChange, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *OrderItemChangeCreate) createSpec() (*OrderItemChange, *sqlgraph.CreateSpec) {
+	var (
+		_node = &OrderItemChange{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(orderitemchange.Table, sqlgraph.NewFieldSpec(orderitemchange.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.CreateTime(); ok {
+		_spec.SetField(orderitemchange.FieldCreateTime, field.TypeTime, value)
+		_node.CreateTime = value
+	}
+	if value, ok := _c.mutation.Action(); ok {
+		_spec.SetField(orderitemchange.FieldAction, field.TypeEnum, value)
+		_node.Action = value
+	}
+	if value, ok := _c.mutation.QuantityBefore(); ok {
+		_spec.SetField(orderitemchange.FieldQuantityBefore, field.TypeInt, value)
+		_node.QuantityBefore = &value
+	}
+	if value, ok := _c.mutation.QuantityAfter(); ok {
+		_spec.SetField(orderitemchange.FieldQuantityAfter, field.TypeInt, value)
+		_node.QuantityAfter = value
+	}
+	if value, ok := _c.mutation.InstructionsBefore(); ok {
+		_spec.SetField(orderitemchange.FieldInstructionsBefore, field.TypeString, value)
+		_node.InstructionsBefore = value
+	}
+	if value, ok := _c.mutation.InstructionsAfter(); ok {
+		_spec.SetField(orderitemchange.FieldInstructionsAfter, field.TypeString, value)
+		_node.InstructionsAfter = value
+	}
+	if value, ok := _c.mutation.Reason(); ok {
+		_spec.SetField(orderitemchange.FieldReason, field.TypeString, value)
+		_node.Reason = value
+	}
+	if nodes := _c.mutation.OrderIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   orderitemchange.OrderTable,
+			Columns: []string{orderitemchange.OrderColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(order.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.OrderID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.OrderItemIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   orderitemchange.OrderItemTable,
+			Columns: []string{orderitemchange.OrderItemColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(orderitem.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.OrderItemID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.ChangedByIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   orderitemchange.ChangedByTable,
+			Columns: []string{orderitemchange.ChangedByColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ChangedByID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OrderItemChangeCreateBulk is the builder for creating many OrderItemChange entities in bulk.
+type OrderItemChangeCreateBulk struct {
+	config
+	err      error
+	builders []*OrderItemChangeCreate
+}
+
+// Save creates the OrderItemChange entities in the database.
+func (_c *OrderItemChangeCreateBulk) Save(ctx context.Context) ([]*OrderItemChange, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*OrderItemChange, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*OrderItemChangeMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *OrderItemChangeCreateBulk) SaveX(ctx context.Context) []*OrderItemChange {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *OrderItemChangeCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *OrderItemChangeCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,209 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Jiruu246/rms/internal/ent/orderitemchange"
+	"github.com/Jiruu246/rms/internal/ent/predicate"
+)
+
+// OrderItemChangeUpdate is the builder for updating OrderItemChange entities.
+type OrderItemChangeUpdate struct {
+	config
+	hooks    []Hook
+	mutation *OrderItemChangeMutation
+}
+
+// Where appends a list predicates to the OrderItemChangeUpdate builder.
+func (_u *OrderItemChangeUpdate) Where(ps ...predicate.OrderItemChange) *OrderItemChangeUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Mutation returns the OrderItemChangeMutation object of the builder.
+func (_u *OrderItemChangeUpdate) Mutation() *OrderItemChangeMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *OrderItemChangeUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *OrderItemChangeUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *OrderItemChangeUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *OrderItemChangeUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *OrderItemChangeUpdate) check() error {
+	if _u.mutation.OrderCleared() && len(_u.mutation.OrderIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "OrderItemChange.order"`)
+	}
+	if _u.mutation.OrderItemCleared() && len(_u.mutation.OrderItemIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "OrderItemChange.order_item"`)
+	}
+	return nil
+}
+
+func (_u *OrderItemChangeUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(orderitemchange.Table, orderitemchange.Columns, sqlgraph.NewFieldSpec(orderitemchange.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if _u.mutation.QuantityBeforeCleared() {
+		_spec.ClearField(orderitemchange.FieldQuantityBefore, field.TypeInt)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{orderitemchange.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// OrderItemChangeUpdateOne is the builder for updating a single OrderItemChange entity.
+type OrderItemChangeUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *OrderItemChangeMutation
+}
+
+// Mutation returns the OrderItemChangeMutation object of the builder.
+func (_u *OrderItemChangeUpdateOne) Mutation() *OrderItemChangeMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the OrderItemChangeUpdate builder.
+func (_u *OrderItemChangeUpdateOne) Where(ps ...predicate.OrderItemChange) *OrderItemChangeUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *OrderItemChangeUpdateOne) Select(field string, fields ...string) *OrderItemChangeUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated OrderItemChange entity.
+func (_u *OrderItemChangeUpdateOne) Save(ctx context.Context) (*OrderItemChange, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *OrderItemChangeUpdateOne) SaveX(ctx context.Context) *OrderItemChange {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *OrderItemChangeUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *OrderItemChangeUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *OrderItemChangeUpdateOne) check() error {
+	if _u.mutation.OrderCleared() && len(_u.mutation.OrderIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "OrderItemChange.order"`)
+	}
+	if _u.mutation.OrderItemCleared() && len(_u.mutation.OrderItemIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "OrderItemChange.order_item"`)
+	}
+	return nil
+}
+
+func (_u *OrderItemChangeUpdateOne) sqlSave(ctx context.Context) (_node *OrderItemChange, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(orderitemchange.Table, orderitemchange.Columns, sqlgraph.NewFieldSpec(orderitemchange.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "OrderItemChange.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, orderitemchange.FieldID)
+		for _, f := range fields {
+			if !orderitemchange.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != orderitemchange.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if _u.mutation.QuantityBeforeCleared() {
+		_spec.ClearField(orderitemchange.FieldQuantityBefore, field.TypeInt)
+	}
+	_node = &OrderItemChange{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{orderitemchange.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
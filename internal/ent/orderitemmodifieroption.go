@@ -27,8 +27,12 @@ type OrderItemModifierOption struct {
 	Quantity int `json:"quantity,omitempty"`
 	// Snapshot of the modifier option name at the time of order
 	OptionName string `json:"option_name,omitempty"`
-	// Snapshot of the modifier option price at the time of order
-	OptionPrice float64 `json:"option_price,omitempty"`
+	// Snapshot of the modifier option price at the time of order, in minor units of the order currency
+	OptionPrice int64 `json:"option_price,omitempty"`
+	// How many of quantity were free under the modifier's free allowance
+	FreeQuantity int `json:"free_quantity,omitempty"`
+	// The selected option whose child modifier group this option was chosen in
+	ParentID *int `json:"parent_id,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the OrderItemModifierOptionQuery when eager-loading is set.
 	Edges        OrderItemModifierOptionEdges `json:"edges"`
@@ -41,9 +45,13 @@ type OrderItemModifierOptionEdges struct {
 	OrderItem *OrderItem `json:"order_item,omitempty"`
 	// ModifierOption holds the value of the modifier_option edge.
 	ModifierOption *ModifierOption `json:"modifier_option,omitempty"`
+	// Parent holds the value of the parent edge.
+	Parent *OrderItemModifierOption `json:"parent,omitempty"`
+	// Children holds the value of the children edge.
+	Children []*OrderItemModifierOption `json:"children,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [2]bool
+	loadedTypes [4]bool
 }
 
 // OrderItemOrErr returns the OrderItem value or an error if the edge
@@ -68,14 +76,32 @@ func (e OrderItemModifierOptionEdges) ModifierOptionOrErr() (*ModifierOption, er
 	return nil, &NotLoadedError{edge: "modifier_option"}
 }
 
+// ParentOrErr returns the Parent value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e OrderItemModifierOptionEdges) ParentOrErr() (*OrderItemModifierOption, error) {
+	if e.Parent != nil {
+		return e.Parent, nil
+	} else if e.loadedTypes[2] {
+		return nil, &NotFoundError{label: orderitemmodifieroption.Label}
+	}
+	return nil, &NotLoadedError{edge: "parent"}
+}
+
+// ChildrenOrErr returns the Children value or an error if the edge
+// was not loaded in eager-loading.
+func (e OrderItemModifierOptionEdges) ChildrenOrErr() ([]*OrderItemModifierOption, error) {
+	if e.loadedTypes[3] {
+		return e.Children, nil
+	}
+	return nil, &NotLoadedError{edge: "children"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*OrderItemModifierOption) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case orderitemmodifieroption.FieldOptionPrice:
-			values[i] = new(sql.NullFloat64)
-		case orderitemmodifieroption.FieldID, orderitemmodifieroption.FieldQuantity:
+		case orderitemmodifieroption.FieldID, orderitemmodifieroption.FieldQuantity, orderitemmodifieroption.FieldOptionPrice, orderitemmodifieroption.FieldFreeQuantity, orderitemmodifieroption.FieldParentID:
 			values[i] = new(sql.NullInt64)
 		case orderitemmodifieroption.FieldOptionName:
 			values[i] = new(sql.NullString)
@@ -127,10 +153,23 @@ func (_m *OrderItemModifierOption) assignValues(columns []string, values []any)
 				_m.OptionName = value.String
 			}
 		case orderitemmodifieroption.FieldOptionPrice:
-			if value, ok := values[i].(*sql.NullFloat64); !ok {
+			if value, ok := values[i].(*sql.NullInt64); !ok {
 				return fmt.Errorf("unexpected type %T for field option_price", values[i])
 			} else if value.Valid {
-				_m.OptionPrice = value.Float64
+				_m.OptionPrice = value.Int64
+			}
+		case orderitemmodifieroption.FieldFreeQuantity:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field free_quantity", values[i])
+			} else if value.Valid {
+				_m.FreeQuantity = int(value.Int64)
+			}
+		case orderitemmodifieroption.FieldParentID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field parent_id", values[i])
+			} else if value.Valid {
+				_m.ParentID = new(int)
+				*_m.ParentID = int(value.Int64)
 			}
 		default:
 			_m.selectValues.Set(columns[i], values[i])
@@ -155,6 +194,16 @@ func (_m *OrderItemModifierOption) QueryModifierOption() *ModifierOptionQuery {
 	return NewOrderItemModifierOptionClient(_m.config).QueryModifierOption(_m)
 }
 
+// QueryParent queries the "parent" edge of the OrderItemModifierOption entity.
+func (_m *OrderItemModifierOption) QueryParent() *OrderItemModifierOptionQuery {
+	return NewOrderItemModifierOptionClient(_m.config).QueryParent(_m)
+}
+
+// QueryChildren queries the "children" edge of the OrderItemModifierOption entity.
+func (_m *OrderItemModifierOption) QueryChildren() *OrderItemModifierOptionQuery {
+	return NewOrderItemModifierOptionClient(_m.config).QueryChildren(_m)
+}
+
 // Update returns a builder for updating this OrderItemModifierOption.
 // Note that you need to call OrderItemModifierOption.Unwrap() before calling this method if this OrderItemModifierOption
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -192,6 +241,14 @@ func (_m *OrderItemModifierOption) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("option_price=")
 	builder.WriteString(fmt.Sprintf("%v", _m.OptionPrice))
+	builder.WriteString(", ")
+	builder.WriteString("free_quantity=")
+	builder.WriteString(fmt.Sprintf("%v", _m.FreeQuantity))
+	builder.WriteString(", ")
+	if v := _m.ParentID; v != nil {
+		builder.WriteString("parent_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
 	builder.WriteByte(')')
 	return builder.String()
 }
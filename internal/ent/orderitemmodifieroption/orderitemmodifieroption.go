@@ -22,10 +22,18 @@ const (
 	FieldOptionName = "option_name"
 	// FieldOptionPrice holds the string denoting the option_price field in the database.
 	FieldOptionPrice = "option_price"
+	// FieldFreeQuantity holds the string denoting the free_quantity field in the database.
+	FieldFreeQuantity = "free_quantity"
+	// FieldParentID holds the string denoting the parent_id field in the database.
+	FieldParentID = "parent_id"
 	// EdgeOrderItem holds the string denoting the order_item edge name in mutations.
 	EdgeOrderItem = "order_item"
 	// EdgeModifierOption holds the string denoting the modifier_option edge name in mutations.
 	EdgeModifierOption = "modifier_option"
+	// EdgeParent holds the string denoting the parent edge name in mutations.
+	EdgeParent = "parent"
+	// EdgeChildren holds the string denoting the children edge name in mutations.
+	EdgeChildren = "children"
 	// Table holds the table name of the orderitemmodifieroption in the database.
 	Table = "order_item_modifier_options"
 	// OrderItemTable is the table that holds the order_item relation/edge.
@@ -42,6 +50,14 @@ const (
 	ModifierOptionInverseTable = "modifier_options"
 	// ModifierOptionColumn is the table column denoting the modifier_option relation/edge.
 	ModifierOptionColumn = "modifier_option_id"
+	// ParentTable is the table that holds the parent relation/edge.
+	ParentTable = "order_item_modifier_options"
+	// ParentColumn is the table column denoting the parent relation/edge.
+	ParentColumn = "parent_id"
+	// ChildrenTable is the table that holds the children relation/edge.
+	ChildrenTable = "order_item_modifier_options"
+	// ChildrenColumn is the table column denoting the children relation/edge.
+	ChildrenColumn = "parent_id"
 )
 
 // Columns holds all SQL columns for orderitemmodifieroption fields.
@@ -52,6 +68,8 @@ var Columns = []string{
 	FieldQuantity,
 	FieldOptionName,
 	FieldOptionPrice,
+	FieldFreeQuantity,
+	FieldParentID,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -71,6 +89,10 @@ var (
 	QuantityValidator func(int) error
 	// OptionNameValidator is a validator for the "option_name" field. It is called by the builders before save.
 	OptionNameValidator func(string) error
+	// DefaultFreeQuantity holds the default value on creation for the "free_quantity" field.
+	DefaultFreeQuantity int
+	// FreeQuantityValidator is a validator for the "free_quantity" field. It is called by the builders before save.
+	FreeQuantityValidator func(int) error
 )
 
 // OrderOption defines the ordering options for the OrderItemModifierOption queries.
@@ -106,6 +128,16 @@ func ByOptionPrice(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldOptionPrice, opts...).ToFunc()
 }
 
+// ByFreeQuantity orders the results by the free_quantity field.
+func ByFreeQuantity(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFreeQuantity, opts...).ToFunc()
+}
+
+// ByParentID orders the results by the parent_id field.
+func ByParentID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldParentID, opts...).ToFunc()
+}
+
 // ByOrderItemField orders the results by order_item field.
 func ByOrderItemField(field string, opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -119,6 +151,27 @@ func ByModifierOptionField(field string, opts ...sql.OrderTermOption) OrderOptio
 		sqlgraph.OrderByNeighborTerms(s, newModifierOptionStep(), sql.OrderByField(field, opts...))
 	}
 }
+
+// ByParentField orders the results by parent field.
+func ByParentField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newParentStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByChildrenCount orders the results by children count.
+func ByChildrenCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newChildrenStep(), opts...)
+	}
+}
+
+// ByChildren orders the results by children terms.
+func ByChildren(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newChildrenStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newOrderItemStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -133,3 +186,17 @@ func newModifierOptionStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.M2O, true, ModifierOptionTable, ModifierOptionColumn),
 	)
 }
+func newParentStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(Table, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, ParentTable, ParentColumn),
+	)
+}
+func newChildrenStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(Table, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, ChildrenTable, ChildrenColumn),
+	)
+}
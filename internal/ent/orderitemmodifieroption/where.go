@@ -75,10 +75,20 @@ func OptionName(v string) predicate.OrderItemModifierOption {
 }
 
 // OptionPrice applies equality check predicate on the "option_price" field. It's identical to OptionPriceEQ.
-func OptionPrice(v float64) predicate.OrderItemModifierOption {
+func OptionPrice(v int64) predicate.OrderItemModifierOption {
 	return predicate.OrderItemModifierOption(sql.FieldEQ(FieldOptionPrice, v))
 }
 
+// FreeQuantity applies equality check predicate on the "free_quantity" field. It's identical to FreeQuantityEQ.
+func FreeQuantity(v int) predicate.OrderItemModifierOption {
+	return predicate.OrderItemModifierOption(sql.FieldEQ(FieldFreeQuantity, v))
+}
+
+// ParentID applies equality check predicate on the "parent_id" field. It's identical to ParentIDEQ.
+func ParentID(v int) predicate.OrderItemModifierOption {
+	return predicate.OrderItemModifierOption(sql.FieldEQ(FieldParentID, v))
+}
+
 // OrderItemIDEQ applies the EQ predicate on the "order_item_id" field.
 func OrderItemIDEQ(v uuid.UUID) predicate.OrderItemModifierOption {
 	return predicate.OrderItemModifierOption(sql.FieldEQ(FieldOrderItemID, v))
@@ -225,45 +235,115 @@ func OptionNameContainsFold(v string) predicate.OrderItemModifierOption {
 }
 
 // OptionPriceEQ applies the EQ predicate on the "option_price" field.
-func OptionPriceEQ(v float64) predicate.OrderItemModifierOption {
+func OptionPriceEQ(v int64) predicate.OrderItemModifierOption {
 	return predicate.OrderItemModifierOption(sql.FieldEQ(FieldOptionPrice, v))
 }
 
 // OptionPriceNEQ applies the NEQ predicate on the "option_price" field.
-func OptionPriceNEQ(v float64) predicate.OrderItemModifierOption {
+func OptionPriceNEQ(v int64) predicate.OrderItemModifierOption {
 	return predicate.OrderItemModifierOption(sql.FieldNEQ(FieldOptionPrice, v))
 }
 
 // OptionPriceIn applies the In predicate on the "option_price" field.
-func OptionPriceIn(vs ...float64) predicate.OrderItemModifierOption {
+func OptionPriceIn(vs ...int64) predicate.OrderItemModifierOption {
 	return predicate.OrderItemModifierOption(sql.FieldIn(FieldOptionPrice, vs...))
 }
 
 // OptionPriceNotIn applies the NotIn predicate on the "option_price" field.
-func OptionPriceNotIn(vs ...float64) predicate.OrderItemModifierOption {
+func OptionPriceNotIn(vs ...int64) predicate.OrderItemModifierOption {
 	return predicate.OrderItemModifierOption(sql.FieldNotIn(FieldOptionPrice, vs...))
 }
 
 // OptionPriceGT applies the GT predicate on the "option_price" field.
-func OptionPriceGT(v float64) predicate.OrderItemModifierOption {
+func OptionPriceGT(v int64) predicate.OrderItemModifierOption {
 	return predicate.OrderItemModifierOption(sql.FieldGT(FieldOptionPrice, v))
 }
 
 // OptionPriceGTE applies the GTE predicate on the "option_price" field.
-func OptionPriceGTE(v float64) predicate.OrderItemModifierOption {
+func OptionPriceGTE(v int64) predicate.OrderItemModifierOption {
 	return predicate.OrderItemModifierOption(sql.FieldGTE(FieldOptionPrice, v))
 }
 
 // OptionPriceLT applies the LT predicate on the "option_price" field.
-func OptionPriceLT(v float64) predicate.OrderItemModifierOption {
+func OptionPriceLT(v int64) predicate.OrderItemModifierOption {
 	return predicate.OrderItemModifierOption(sql.FieldLT(FieldOptionPrice, v))
 }
 
 // OptionPriceLTE applies the LTE predicate on the "option_price" field.
-func OptionPriceLTE(v float64) predicate.OrderItemModifierOption {
+func OptionPriceLTE(v int64) predicate.OrderItemModifierOption {
 	return predicate.OrderItemModifierOption(sql.FieldLTE(FieldOptionPrice, v))
 }
 
+// FreeQuantityEQ applies the EQ predicate on the "free_quantity" field.
+func FreeQuantityEQ(v int) predicate.OrderItemModifierOption {
+	return predicate.OrderItemModifierOption(sql.FieldEQ(FieldFreeQuantity, v))
+}
+
+// FreeQuantityNEQ applies the NEQ predicate on the "free_quantity" field.
+func FreeQuantityNEQ(v int) predicate.OrderItemModifierOption {
+	return predicate.OrderItemModifierOption(sql.FieldNEQ(FieldFreeQuantity, v))
+}
+
+// FreeQuantityIn applies the In predicate on the "free_quantity" field.
+func FreeQuantityIn(vs ...int) predicate.OrderItemModifierOption {
+	return predicate.OrderItemModifierOption(sql.FieldIn(FieldFreeQuantity, vs...))
+}
+
+// FreeQuantityNotIn applies the NotIn predicate on the "free_quantity" field.
+func FreeQuantityNotIn(vs ...int) predicate.OrderItemModifierOption {
+	return predicate.OrderItemModifierOption(sql.FieldNotIn(FieldFreeQuantity, vs...))
+}
+
+// FreeQuantityGT applies the GT predicate on the "free_quantity" field.
+func FreeQuantityGT(v int) predicate.OrderItemModifierOption {
+	return predicate.OrderItemModifierOption(sql.FieldGT(FieldFreeQuantity, v))
+}
+
+// FreeQuantityGTE applies the GTE predicate on the "free_quantity" field.
+func FreeQuantityGTE(v int) predicate.OrderItemModifierOption {
+	return predicate.OrderItemModifierOption(sql.FieldGTE(FieldFreeQuantity, v))
+}
+
+// FreeQuantityLT applies the LT predicate on the "free_quantity" field.
+func FreeQuantityLT(v int) predicate.OrderItemModifierOption {
+	return predicate.OrderItemModifierOption(sql.FieldLT(FieldFreeQuantity, v))
+}
+
+// FreeQuantityLTE applies the LTE predicate on the "free_quantity" field.
+func FreeQuantityLTE(v int) predicate.OrderItemModifierOption {
+	return predicate.OrderItemModifierOption(sql.FieldLTE(FieldFreeQuantity, v))
+}
+
+// ParentIDEQ applies the EQ predicate on the "parent_id" field.
+func ParentIDEQ(v int) predicate.OrderItemModifierOption {
+	return predicate.OrderItemModifierOption(sql.FieldEQ(FieldParentID, v))
+}
+
+// ParentIDNEQ applies the NEQ predicate on the "parent_id" field.
+func ParentIDNEQ(v int) predicate.OrderItemModifierOption {
+	return predicate.OrderItemModifierOption(sql.FieldNEQ(FieldParentID, v))
+}
+
+// ParentIDIn applies the In predicate on the "parent_id" field.
+func ParentIDIn(vs ...int) predicate.OrderItemModifierOption {
+	return predicate.OrderItemModifierOption(sql.FieldIn(FieldParentID, vs...))
+}
+
+// ParentIDNotIn applies the NotIn predicate on the "parent_id" field.
+func ParentIDNotIn(vs ...int) predicate.OrderItemModifierOption {
+	return predicate.OrderItemModifierOption(sql.FieldNotIn(FieldParentID, vs...))
+}
+
+// ParentIDIsNil applies the IsNil predicate on the "parent_id" field.
+func ParentIDIsNil() predicate.OrderItemModifierOption {
+	return predicate.OrderItemModifierOption(sql.FieldIsNull(FieldParentID))
+}
+
+// ParentIDNotNil applies the NotNil predicate on the "parent_id" field.
+func ParentIDNotNil() predicate.OrderItemModifierOption {
+	return predicate.OrderItemModifierOption(sql.FieldNotNull(FieldParentID))
+}
+
 // HasOrderItem applies the HasEdge predicate on the "order_item" edge.
 func HasOrderItem() predicate.OrderItemModifierOption {
 	return predicate.OrderItemModifierOption(func(s *sql.Selector) {
@@ -310,6 +390,52 @@ func HasModifierOptionWith(preds ...predicate.ModifierOption) predicate.OrderIte
 	})
 }
 
+// HasParent applies the HasEdge predicate on the "parent" edge.
+func HasParent() predicate.OrderItemModifierOption {
+	return predicate.OrderItemModifierOption(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, ParentTable, ParentColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasParentWith applies the HasEdge predicate on the "parent" edge with a given conditions (other predicates).
+func HasParentWith(preds ...predicate.OrderItemModifierOption) predicate.OrderItemModifierOption {
+	return predicate.OrderItemModifierOption(func(s *sql.Selector) {
+		step := newParentStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasChildren applies the HasEdge predicate on the "children" edge.
+func HasChildren() predicate.OrderItemModifierOption {
+	return predicate.OrderItemModifierOption(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ChildrenTable, ChildrenColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasChildrenWith applies the HasEdge predicate on the "children" edge with a given conditions (other predicates).
+func HasChildrenWith(preds ...predicate.OrderItemModifierOption) predicate.OrderItemModifierOption {
+	return predicate.OrderItemModifierOption(func(s *sql.Selector) {
+		step := newChildrenStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.OrderItemModifierOption) predicate.OrderItemModifierOption {
 	return predicate.OrderItemModifierOption(sql.AndPredicates(predicates...))
@@ -55,11 +55,39 @@ func (_c *OrderItemModifierOptionCreate) SetOptionName(v string) *OrderItemModif
 }
 
 // SetOptionPrice sets the "option_price" field.
-func (_c *OrderItemModifierOptionCreate) SetOptionPrice(v float64) *OrderItemModifierOptionCreate {
+func (_c *OrderItemModifierOptionCreate) SetOptionPrice(v int64) *OrderItemModifierOptionCreate {
 	_c.mutation.SetOptionPrice(v)
 	return _c
 }
 
+// SetFreeQuantity sets the "free_quantity" field.
+func (_c *OrderItemModifierOptionCreate) SetFreeQuantity(v int) *OrderItemModifierOptionCreate {
+	_c.mutation.SetFreeQuantity(v)
+	return _c
+}
+
+// SetNillableFreeQuantity sets the "free_quantity" field if the given value is not nil.
+func (_c *OrderItemModifierOptionCreate) SetNillableFreeQuantity(v *int) *OrderItemModifierOptionCreate {
+	if v != nil {
+		_c.SetFreeQuantity(*v)
+	}
+	return _c
+}
+
+// SetParentID sets the "parent_id" field.
+func (_c *OrderItemModifierOptionCreate) SetParentID(v int) *OrderItemModifierOptionCreate {
+	_c.mutation.SetParentID(v)
+	return _c
+}
+
+// SetNillableParentID sets the "parent_id" field if the given value is not nil.
+func (_c *OrderItemModifierOptionCreate) SetNillableParentID(v *int) *OrderItemModifierOptionCreate {
+	if v != nil {
+		_c.SetParentID(*v)
+	}
+	return _c
+}
+
 // SetOrderItem sets the "order_item" edge to the OrderItem entity.
 func (_c *OrderItemModifierOptionCreate) SetOrderItem(v *OrderItem) *OrderItemModifierOptionCreate {
 	return _c.SetOrderItemID(v.ID)
@@ -70,6 +98,26 @@ func (_c *OrderItemModifierOptionCreate) SetModifierOption(v *ModifierOption) *O
 	return _c.SetModifierOptionID(v.ID)
 }
 
+// SetParent sets the "parent" edge to the OrderItemModifierOption entity.
+func (_c *OrderItemModifierOptionCreate) SetParent(v *OrderItemModifierOption) *OrderItemModifierOptionCreate {
+	return _c.SetParentID(v.ID)
+}
+
+// AddChildIDs adds the "children" edge to the OrderItemModifierOption entity by IDs.
+func (_c *OrderItemModifierOptionCreate) AddChildIDs(ids ...int) *OrderItemModifierOptionCreate {
+	_c.mutation.AddChildIDs(ids...)
+	return _c
+}
+
+// AddChildren adds the "children" edges to the OrderItemModifierOption entity.
+func (_c *OrderItemModifierOptionCreate) AddChildren(v ...*OrderItemModifierOption) *OrderItemModifierOptionCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddChildIDs(ids...)
+}
+
 // Mutation returns the OrderItemModifierOptionMutation object of the builder.
 func (_c *OrderItemModifierOptionCreate) Mutation() *OrderItemModifierOptionMutation {
 	return _c.mutation
@@ -109,6 +157,10 @@ func (_c *OrderItemModifierOptionCreate) defaults() {
 		v := orderitemmodifieroption.DefaultQuantity
 		_c.mutation.SetQuantity(v)
 	}
+	if _, ok := _c.mutation.FreeQuantity(); !ok {
+		v := orderitemmodifieroption.DefaultFreeQuantity
+		_c.mutation.SetFreeQuantity(v)
+	}
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -138,6 +190,14 @@ func (_c *OrderItemModifierOptionCreate) check() error {
 	if _, ok := _c.mutation.OptionPrice(); !ok {
 		return &ValidationError{Name: "option_price", err: errors.New(`ent: missing required field "OrderItemModifierOption.option_price"`)}
 	}
+	if _, ok := _c.mutation.FreeQuantity(); !ok {
+		return &ValidationError{Name: "free_quantity", err: errors.New(`ent: missing required field "OrderItemModifierOption.free_quantity"`)}
+	}
+	if v, ok := _c.mutation.FreeQuantity(); ok {
+		if err := orderitemmodifieroption.FreeQuantityValidator(v); err != nil {
+			return &ValidationError{Name: "free_quantity", err: fmt.Errorf(`ent: validator failed for field "OrderItemModifierOption.free_quantity": %w`, err)}
+		}
+	}
 	if len(_c.mutation.OrderItemIDs()) == 0 {
 		return &ValidationError{Name: "order_item", err: errors.New(`ent: missing required edge "OrderItemModifierOption.order_item"`)}
 	}
@@ -179,9 +239,13 @@ func (_c *OrderItemModifierOptionCreate) createSpec() (*OrderItemModifierOption,
 		_node.OptionName = value
 	}
 	if value, ok := _c.mutation.OptionPrice(); ok {
-		_spec.SetField(orderitemmodifieroption.FieldOptionPrice, field.TypeFloat64, value)
+		_spec.SetField(orderitemmodifieroption.FieldOptionPrice, field.TypeInt64, value)
 		_node.OptionPrice = value
 	}
+	if value, ok := _c.mutation.FreeQuantity(); ok {
+		_spec.SetField(orderitemmodifieroption.FieldFreeQuantity, field.TypeInt, value)
+		_node.FreeQuantity = value
+	}
 	if nodes := _c.mutation.OrderItemIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -216,6 +280,39 @@ func (_c *OrderItemModifierOptionCreate) createSpec() (*OrderItemModifierOption,
 		_node.ModifierOptionID = nodes[0]
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.ParentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   orderitemmodifieroption.ParentTable,
+			Columns: []string{orderitemmodifieroption.ParentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(orderitemmodifieroption.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ParentID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.ChildrenIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   orderitemmodifieroption.ChildrenTable,
+			Columns: []string{orderitemmodifieroption.ChildrenColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(orderitemmodifieroption.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -4,6 +4,7 @@ package ent
 
 import (
 	"context"
+	"database/sql/driver"
 	"fmt"
 	"math"
 
@@ -27,6 +28,8 @@ type OrderItemModifierOptionQuery struct {
 	predicates         []predicate.OrderItemModifierOption
 	withOrderItem      *OrderItemQuery
 	withModifierOption *ModifierOptionQuery
+	withParent         *OrderItemModifierOptionQuery
+	withChildren       *OrderItemModifierOptionQuery
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -107,6 +110,50 @@ func (_q *OrderItemModifierOptionQuery) QueryModifierOption() *ModifierOptionQue
 	return query
 }
 
+// QueryParent chains the current query on the "parent" edge.
+func (_q *OrderItemModifierOptionQuery) QueryParent() *OrderItemModifierOptionQuery {
+	query := (&OrderItemModifierOptionClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(orderitemmodifieroption.Table, orderitemmodifieroption.FieldID, selector),
+			sqlgraph.To(orderitemmodifieroption.Table, orderitemmodifieroption.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, orderitemmodifieroption.ParentTable, orderitemmodifieroption.ParentColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryChildren chains the current query on the "children" edge.
+func (_q *OrderItemModifierOptionQuery) QueryChildren() *OrderItemModifierOptionQuery {
+	query := (&OrderItemModifierOptionClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(orderitemmodifieroption.Table, orderitemmodifieroption.FieldID, selector),
+			sqlgraph.To(orderitemmodifieroption.Table, orderitemmodifieroption.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, orderitemmodifieroption.ChildrenTable, orderitemmodifieroption.ChildrenColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first OrderItemModifierOption entity from the query.
 // Returns a *NotFoundError when no OrderItemModifierOption was found.
 func (_q *OrderItemModifierOptionQuery) First(ctx context.Context) (*OrderItemModifierOption, error) {
@@ -301,6 +348,8 @@ func (_q *OrderItemModifierOptionQuery) Clone() *OrderItemModifierOptionQuery {
 		predicates:         append([]predicate.OrderItemModifierOption{}, _q.predicates...),
 		withOrderItem:      _q.withOrderItem.Clone(),
 		withModifierOption: _q.withModifierOption.Clone(),
+		withParent:         _q.withParent.Clone(),
+		withChildren:       _q.withChildren.Clone(),
 		// clone intermediate query.
 		sql:  _q.sql.Clone(),
 		path: _q.path,
@@ -329,6 +378,28 @@ func (_q *OrderItemModifierOptionQuery) WithModifierOption(opts ...func(*Modifie
 	return _q
 }
 
+// WithParent tells the query-builder to eager-load the nodes that are connected to
+// the "parent" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *OrderItemModifierOptionQuery) WithParent(opts ...func(*OrderItemModifierOptionQuery)) *OrderItemModifierOptionQuery {
+	query := (&OrderItemModifierOptionClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withParent = query
+	return _q
+}
+
+// WithChildren tells the query-builder to eager-load the nodes that are connected to
+// the "children" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *OrderItemModifierOptionQuery) WithChildren(opts ...func(*OrderItemModifierOptionQuery)) *OrderItemModifierOptionQuery {
+	query := (&OrderItemModifierOptionClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withChildren = query
+	return _q
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -407,9 +478,11 @@ func (_q *OrderItemModifierOptionQuery) sqlAll(ctx context.Context, hooks ...que
 	var (
 		nodes       = []*OrderItemModifierOption{}
 		_spec       = _q.querySpec()
-		loadedTypes = [2]bool{
+		loadedTypes = [4]bool{
 			_q.withOrderItem != nil,
 			_q.withModifierOption != nil,
+			_q.withParent != nil,
+			_q.withChildren != nil,
 		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
@@ -442,6 +515,21 @@ func (_q *OrderItemModifierOptionQuery) sqlAll(ctx context.Context, hooks ...que
 			return nil, err
 		}
 	}
+	if query := _q.withParent; query != nil {
+		if err := _q.loadParent(ctx, query, nodes, nil,
+			func(n *OrderItemModifierOption, e *OrderItemModifierOption) { n.Edges.Parent = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withChildren; query != nil {
+		if err := _q.loadChildren(ctx, query, nodes,
+			func(n *OrderItemModifierOption) { n.Edges.Children = []*OrderItemModifierOption{} },
+			func(n *OrderItemModifierOption, e *OrderItemModifierOption) {
+				n.Edges.Children = append(n.Edges.Children, e)
+			}); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -503,6 +591,71 @@ func (_q *OrderItemModifierOptionQuery) loadModifierOption(ctx context.Context,
 	}
 	return nil
 }
+func (_q *OrderItemModifierOptionQuery) loadParent(ctx context.Context, query *OrderItemModifierOptionQuery, nodes []*OrderItemModifierOption, init func(*OrderItemModifierOption), assign func(*OrderItemModifierOption, *OrderItemModifierOption)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*OrderItemModifierOption)
+	for i := range nodes {
+		if nodes[i].ParentID == nil {
+			continue
+		}
+		fk := *nodes[i].ParentID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(orderitemmodifieroption.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "parent_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (_q *OrderItemModifierOptionQuery) loadChildren(ctx context.Context, query *OrderItemModifierOptionQuery, nodes []*OrderItemModifierOption, init func(*OrderItemModifierOption), assign func(*OrderItemModifierOption, *OrderItemModifierOption)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*OrderItemModifierOption)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(orderitemmodifieroption.FieldParentID)
+	}
+	query.Where(predicate.OrderItemModifierOption(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(orderitemmodifieroption.ChildrenColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.ParentID
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "parent_id" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "parent_id" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (_q *OrderItemModifierOptionQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := _q.querySpec()
@@ -535,6 +688,9 @@ func (_q *OrderItemModifierOptionQuery) querySpec() *sqlgraph.QuerySpec {
 		if _q.withModifierOption != nil {
 			_spec.Node.AddColumnOnce(orderitemmodifieroption.FieldModifierOptionID)
 		}
+		if _q.withParent != nil {
+			_spec.Node.AddColumnOnce(orderitemmodifieroption.FieldParentID)
+		}
 	}
 	if ps := _q.predicates; len(ps) > 0 {
 		_spec.Predicate = func(selector *sql.Selector) {
@@ -94,14 +94,14 @@ func (_u *OrderItemModifierOptionUpdate) SetNillableOptionName(v *string) *Order
 }
 
 // SetOptionPrice sets the "option_price" field.
-func (_u *OrderItemModifierOptionUpdate) SetOptionPrice(v float64) *OrderItemModifierOptionUpdate {
+func (_u *OrderItemModifierOptionUpdate) SetOptionPrice(v int64) *OrderItemModifierOptionUpdate {
 	_u.mutation.ResetOptionPrice()
 	_u.mutation.SetOptionPrice(v)
 	return _u
 }
 
 // SetNillableOptionPrice sets the "option_price" field if the given value is not nil.
-func (_u *OrderItemModifierOptionUpdate) SetNillableOptionPrice(v *float64) *OrderItemModifierOptionUpdate {
+func (_u *OrderItemModifierOptionUpdate) SetNillableOptionPrice(v *int64) *OrderItemModifierOptionUpdate {
 	if v != nil {
 		_u.SetOptionPrice(*v)
 	}
@@ -109,11 +109,52 @@ func (_u *OrderItemModifierOptionUpdate) SetNillableOptionPrice(v *float64) *Ord
 }
 
 // AddOptionPrice adds value to the "option_price" field.
-func (_u *OrderItemModifierOptionUpdate) AddOptionPrice(v float64) *OrderItemModifierOptionUpdate {
+func (_u *OrderItemModifierOptionUpdate) AddOptionPrice(v int64) *OrderItemModifierOptionUpdate {
 	_u.mutation.AddOptionPrice(v)
 	return _u
 }
 
+// SetFreeQuantity sets the "free_quantity" field.
+func (_u *OrderItemModifierOptionUpdate) SetFreeQuantity(v int) *OrderItemModifierOptionUpdate {
+	_u.mutation.ResetFreeQuantity()
+	_u.mutation.SetFreeQuantity(v)
+	return _u
+}
+
+// SetNillableFreeQuantity sets the "free_quantity" field if the given value is not nil.
+func (_u *OrderItemModifierOptionUpdate) SetNillableFreeQuantity(v *int) *OrderItemModifierOptionUpdate {
+	if v != nil {
+		_u.SetFreeQuantity(*v)
+	}
+	return _u
+}
+
+// AddFreeQuantity adds value to the "free_quantity" field.
+func (_u *OrderItemModifierOptionUpdate) AddFreeQuantity(v int) *OrderItemModifierOptionUpdate {
+	_u.mutation.AddFreeQuantity(v)
+	return _u
+}
+
+// SetParentID sets the "parent_id" field.
+func (_u *OrderItemModifierOptionUpdate) SetParentID(v int) *OrderItemModifierOptionUpdate {
+	_u.mutation.SetParentID(v)
+	return _u
+}
+
+// SetNillableParentID sets the "parent_id" field if the given value is not nil.
+func (_u *OrderItemModifierOptionUpdate) SetNillableParentID(v *int) *OrderItemModifierOptionUpdate {
+	if v != nil {
+		_u.SetParentID(*v)
+	}
+	return _u
+}
+
+// ClearParentID clears the value of the "parent_id" field.
+func (_u *OrderItemModifierOptionUpdate) ClearParentID() *OrderItemModifierOptionUpdate {
+	_u.mutation.ClearParentID()
+	return _u
+}
+
 // SetOrderItem sets the "order_item" edge to the OrderItem entity.
 func (_u *OrderItemModifierOptionUpdate) SetOrderItem(v *OrderItem) *OrderItemModifierOptionUpdate {
 	return _u.SetOrderItemID(v.ID)
@@ -124,6 +165,26 @@ func (_u *OrderItemModifierOptionUpdate) SetModifierOption(v *ModifierOption) *O
 	return _u.SetModifierOptionID(v.ID)
 }
 
+// SetParent sets the "parent" edge to the OrderItemModifierOption entity.
+func (_u *OrderItemModifierOptionUpdate) SetParent(v *OrderItemModifierOption) *OrderItemModifierOptionUpdate {
+	return _u.SetParentID(v.ID)
+}
+
+// AddChildIDs adds the "children" edge to the OrderItemModifierOption entity by IDs.
+func (_u *OrderItemModifierOptionUpdate) AddChildIDs(ids ...int) *OrderItemModifierOptionUpdate {
+	_u.mutation.AddChildIDs(ids...)
+	return _u
+}
+
+// AddChildren adds the "children" edges to the OrderItemModifierOption entity.
+func (_u *OrderItemModifierOptionUpdate) AddChildren(v ...*OrderItemModifierOption) *OrderItemModifierOptionUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddChildIDs(ids...)
+}
+
 // Mutation returns the OrderItemModifierOptionMutation object of the builder.
 func (_u *OrderItemModifierOptionUpdate) Mutation() *OrderItemModifierOptionMutation {
 	return _u.mutation
@@ -141,6 +202,33 @@ func (_u *OrderItemModifierOptionUpdate) ClearModifierOption() *OrderItemModifie
 	return _u
 }
 
+// ClearParent clears the "parent" edge to the OrderItemModifierOption entity.
+func (_u *OrderItemModifierOptionUpdate) ClearParent() *OrderItemModifierOptionUpdate {
+	_u.mutation.ClearParent()
+	return _u
+}
+
+// ClearChildren clears all "children" edges to the OrderItemModifierOption entity.
+func (_u *OrderItemModifierOptionUpdate) ClearChildren() *OrderItemModifierOptionUpdate {
+	_u.mutation.ClearChildren()
+	return _u
+}
+
+// RemoveChildIDs removes the "children" edge to OrderItemModifierOption entities by IDs.
+func (_u *OrderItemModifierOptionUpdate) RemoveChildIDs(ids ...int) *OrderItemModifierOptionUpdate {
+	_u.mutation.RemoveChildIDs(ids...)
+	return _u
+}
+
+// RemoveChildren removes "children" edges to OrderItemModifierOption entities.
+func (_u *OrderItemModifierOptionUpdate) RemoveChildren(v ...*OrderItemModifierOption) *OrderItemModifierOptionUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveChildIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (_u *OrderItemModifierOptionUpdate) Save(ctx context.Context) (int, error) {
 	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
@@ -180,6 +268,11 @@ func (_u *OrderItemModifierOptionUpdate) check() error {
 			return &ValidationError{Name: "option_name", err: fmt.Errorf(`ent: validator failed for field "OrderItemModifierOption.option_name": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.FreeQuantity(); ok {
+		if err := orderitemmodifieroption.FreeQuantityValidator(v); err != nil {
+			return &ValidationError{Name: "free_quantity", err: fmt.Errorf(`ent: validator failed for field "OrderItemModifierOption.free_quantity": %w`, err)}
+		}
+	}
 	if _u.mutation.OrderItemCleared() && len(_u.mutation.OrderItemIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "OrderItemModifierOption.order_item"`)
 	}
@@ -211,10 +304,16 @@ func (_u *OrderItemModifierOptionUpdate) sqlSave(ctx context.Context) (_node int
 		_spec.SetField(orderitemmodifieroption.FieldOptionName, field.TypeString, value)
 	}
 	if value, ok := _u.mutation.OptionPrice(); ok {
-		_spec.SetField(orderitemmodifieroption.FieldOptionPrice, field.TypeFloat64, value)
+		_spec.SetField(orderitemmodifieroption.FieldOptionPrice, field.TypeInt64, value)
 	}
 	if value, ok := _u.mutation.AddedOptionPrice(); ok {
-		_spec.AddField(orderitemmodifieroption.FieldOptionPrice, field.TypeFloat64, value)
+		_spec.AddField(orderitemmodifieroption.FieldOptionPrice, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.FreeQuantity(); ok {
+		_spec.SetField(orderitemmodifieroption.FieldFreeQuantity, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedFreeQuantity(); ok {
+		_spec.AddField(orderitemmodifieroption.FieldFreeQuantity, field.TypeInt, value)
 	}
 	if _u.mutation.OrderItemCleared() {
 		edge := &sqlgraph.EdgeSpec{
@@ -274,6 +373,80 @@ func (_u *OrderItemModifierOptionUpdate) sqlSave(ctx context.Context) (_node int
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.ParentCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   orderitemmodifieroption.ParentTable,
+			Columns: []string{orderitemmodifieroption.ParentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(orderitemmodifieroption.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ParentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   orderitemmodifieroption.ParentTable,
+			Columns: []string{orderitemmodifieroption.ParentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(orderitemmodifieroption.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ChildrenCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   orderitemmodifieroption.ChildrenTable,
+			Columns: []string{orderitemmodifieroption.ChildrenColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(orderitemmodifieroption.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedChildrenIDs(); len(nodes) > 0 && !_u.mutation.ChildrenCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   orderitemmodifieroption.ChildrenTable,
+			Columns: []string{orderitemmodifieroption.ChildrenColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(orderitemmodifieroption.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ChildrenIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   orderitemmodifieroption.ChildrenTable,
+			Columns: []string{orderitemmodifieroption.ChildrenColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(orderitemmodifieroption.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{orderitemmodifieroption.Label}
@@ -358,14 +531,14 @@ func (_u *OrderItemModifierOptionUpdateOne) SetNillableOptionName(v *string) *Or
 }
 
 // SetOptionPrice sets the "option_price" field.
-func (_u *OrderItemModifierOptionUpdateOne) SetOptionPrice(v float64) *OrderItemModifierOptionUpdateOne {
+func (_u *OrderItemModifierOptionUpdateOne) SetOptionPrice(v int64) *OrderItemModifierOptionUpdateOne {
 	_u.mutation.ResetOptionPrice()
 	_u.mutation.SetOptionPrice(v)
 	return _u
 }
 
 // SetNillableOptionPrice sets the "option_price" field if the given value is not nil.
-func (_u *OrderItemModifierOptionUpdateOne) SetNillableOptionPrice(v *float64) *OrderItemModifierOptionUpdateOne {
+func (_u *OrderItemModifierOptionUpdateOne) SetNillableOptionPrice(v *int64) *OrderItemModifierOptionUpdateOne {
 	if v != nil {
 		_u.SetOptionPrice(*v)
 	}
@@ -373,11 +546,52 @@ func (_u *OrderItemModifierOptionUpdateOne) SetNillableOptionPrice(v *float64) *
 }
 
 // AddOptionPrice adds value to the "option_price" field.
-func (_u *OrderItemModifierOptionUpdateOne) AddOptionPrice(v float64) *OrderItemModifierOptionUpdateOne {
+func (_u *OrderItemModifierOptionUpdateOne) AddOptionPrice(v int64) *OrderItemModifierOptionUpdateOne {
 	_u.mutation.AddOptionPrice(v)
 	return _u
 }
 
+// SetFreeQuantity sets the "free_quantity" field.
+func (_u *OrderItemModifierOptionUpdateOne) SetFreeQuantity(v int) *OrderItemModifierOptionUpdateOne {
+	_u.mutation.ResetFreeQuantity()
+	_u.mutation.SetFreeQuantity(v)
+	return _u
+}
+
+// SetNillableFreeQuantity sets the "free_quantity" field if the given value is not nil.
+func (_u *OrderItemModifierOptionUpdateOne) SetNillableFreeQuantity(v *int) *OrderItemModifierOptionUpdateOne {
+	if v != nil {
+		_u.SetFreeQuantity(*v)
+	}
+	return _u
+}
+
+// AddFreeQuantity adds value to the "free_quantity" field.
+func (_u *OrderItemModifierOptionUpdateOne) AddFreeQuantity(v int) *OrderItemModifierOptionUpdateOne {
+	_u.mutation.AddFreeQuantity(v)
+	return _u
+}
+
+// SetParentID sets the "parent_id" field.
+func (_u *OrderItemModifierOptionUpdateOne) SetParentID(v int) *OrderItemModifierOptionUpdateOne {
+	_u.mutation.SetParentID(v)
+	return _u
+}
+
+// SetNillableParentID sets the "parent_id" field if the given value is not nil.
+func (_u *OrderItemModifierOptionUpdateOne) SetNillableParentID(v *int) *OrderItemModifierOptionUpdateOne {
+	if v != nil {
+		_u.SetParentID(*v)
+	}
+	return _u
+}
+
+// ClearParentID clears the value of the "parent_id" field.
+func (_u *OrderItemModifierOptionUpdateOne) ClearParentID() *OrderItemModifierOptionUpdateOne {
+	_u.mutation.ClearParentID()
+	return _u
+}
+
 // SetOrderItem sets the "order_item" edge to the OrderItem entity.
 func (_u *OrderItemModifierOptionUpdateOne) SetOrderItem(v *OrderItem) *OrderItemModifierOptionUpdateOne {
 	return _u.SetOrderItemID(v.ID)
@@ -388,6 +602,26 @@ func (_u *OrderItemModifierOptionUpdateOne) SetModifierOption(v *ModifierOption)
 	return _u.SetModifierOptionID(v.ID)
 }
 
+// SetParent sets the "parent" edge to the OrderItemModifierOption entity.
+func (_u *OrderItemModifierOptionUpdateOne) SetParent(v *OrderItemModifierOption) *OrderItemModifierOptionUpdateOne {
+	return _u.SetParentID(v.ID)
+}
+
+// AddChildIDs adds the "children" edge to the OrderItemModifierOption entity by IDs.
+func (_u *OrderItemModifierOptionUpdateOne) AddChildIDs(ids ...int) *OrderItemModifierOptionUpdateOne {
+	_u.mutation.AddChildIDs(ids...)
+	return _u
+}
+
+// AddChildren adds the "children" edges to the OrderItemModifierOption entity.
+func (_u *OrderItemModifierOptionUpdateOne) AddChildren(v ...*OrderItemModifierOption) *OrderItemModifierOptionUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddChildIDs(ids...)
+}
+
 // Mutation returns the OrderItemModifierOptionMutation object of the builder.
 func (_u *OrderItemModifierOptionUpdateOne) Mutation() *OrderItemModifierOptionMutation {
 	return _u.mutation
@@ -405,6 +639,33 @@ func (_u *OrderItemModifierOptionUpdateOne) ClearModifierOption() *OrderItemModi
 	return _u
 }
 
+// ClearParent clears the "parent" edge to the OrderItemModifierOption entity.
+func (_u *OrderItemModifierOptionUpdateOne) ClearParent() *OrderItemModifierOptionUpdateOne {
+	_u.mutation.ClearParent()
+	return _u
+}
+
+// ClearChildren clears all "children" edges to the OrderItemModifierOption entity.
+func (_u *OrderItemModifierOptionUpdateOne) ClearChildren() *OrderItemModifierOptionUpdateOne {
+	_u.mutation.ClearChildren()
+	return _u
+}
+
+// RemoveChildIDs removes the "children" edge to OrderItemModifierOption entities by IDs.
+func (_u *OrderItemModifierOptionUpdateOne) RemoveChildIDs(ids ...int) *OrderItemModifierOptionUpdateOne {
+	_u.mutation.RemoveChildIDs(ids...)
+	return _u
+}
+
+// RemoveChildren removes "children" edges to OrderItemModifierOption entities.
+func (_u *OrderItemModifierOptionUpdateOne) RemoveChildren(v ...*OrderItemModifierOption) *OrderItemModifierOptionUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveChildIDs(ids...)
+}
+
 // Where appends a list predicates to the OrderItemModifierOptionUpdate builder.
 func (_u *OrderItemModifierOptionUpdateOne) Where(ps ...predicate.OrderItemModifierOption) *OrderItemModifierOptionUpdateOne {
 	_u.mutation.Where(ps...)
@@ -457,6 +718,11 @@ func (_u *OrderItemModifierOptionUpdateOne) check() error {
 			return &ValidationError{Name: "option_name", err: fmt.Errorf(`ent: validator failed for field "OrderItemModifierOption.option_name": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.FreeQuantity(); ok {
+		if err := orderitemmodifieroption.FreeQuantityValidator(v); err != nil {
+			return &ValidationError{Name: "free_quantity", err: fmt.Errorf(`ent: validator failed for field "OrderItemModifierOption.free_quantity": %w`, err)}
+		}
+	}
 	if _u.mutation.OrderItemCleared() && len(_u.mutation.OrderItemIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "OrderItemModifierOption.order_item"`)
 	}
@@ -505,10 +771,16 @@ func (_u *OrderItemModifierOptionUpdateOne) sqlSave(ctx context.Context) (_node
 		_spec.SetField(orderitemmodifieroption.FieldOptionName, field.TypeString, value)
 	}
 	if value, ok := _u.mutation.OptionPrice(); ok {
-		_spec.SetField(orderitemmodifieroption.FieldOptionPrice, field.TypeFloat64, value)
+		_spec.SetField(orderitemmodifieroption.FieldOptionPrice, field.TypeInt64, value)
 	}
 	if value, ok := _u.mutation.AddedOptionPrice(); ok {
-		_spec.AddField(orderitemmodifieroption.FieldOptionPrice, field.TypeFloat64, value)
+		_spec.AddField(orderitemmodifieroption.FieldOptionPrice, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.FreeQuantity(); ok {
+		_spec.SetField(orderitemmodifieroption.FieldFreeQuantity, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedFreeQuantity(); ok {
+		_spec.AddField(orderitemmodifieroption.FieldFreeQuantity, field.TypeInt, value)
 	}
 	if _u.mutation.OrderItemCleared() {
 		edge := &sqlgraph.EdgeSpec{
@@ -568,6 +840,80 @@ func (_u *OrderItemModifierOptionUpdateOne) sqlSave(ctx context.Context) (_node
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.ParentCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   orderitemmodifieroption.ParentTable,
+			Columns: []string{orderitemmodifieroption.ParentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(orderitemmodifieroption.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ParentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   orderitemmodifieroption.ParentTable,
+			Columns: []string{orderitemmodifieroption.ParentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(orderitemmodifieroption.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ChildrenCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   orderitemmodifieroption.ChildrenTable,
+			Columns: []string{orderitemmodifieroption.ChildrenColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(orderitemmodifieroption.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedChildrenIDs(); len(nodes) > 0 && !_u.mutation.ChildrenCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   orderitemmodifieroption.ChildrenTable,
+			Columns: []string{orderitemmodifieroption.ChildrenColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(orderitemmodifieroption.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ChildrenIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   orderitemmodifieroption.ChildrenTable,
+			Columns: []string{orderitemmodifieroption.ChildrenColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(orderitemmodifieroption.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &OrderItemModifierOption{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
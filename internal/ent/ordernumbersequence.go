@@ -0,0 +1,157 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/Jiruu246/rms/internal/ent/ordernumbersequence"
+	"github.com/Jiruu246/rms/internal/ent/restaurant"
+	"github.com/google/uuid"
+)
+
+// OrderNumberSequence is the model entity for the OrderNumberSequence schema.
+type OrderNumberSequence struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// Empty for a sequence that never resets, otherwise the restaurant-local date (YYYY-MM-DD)
+	Period string `json:"period,omitempty"`
+	// Last order number handed out
+	LastValue int `json:"last_value,omitempty"`
+	// RestaurantID holds the value of the "restaurant_id" field.
+	RestaurantID uuid.UUID `json:"restaurant_id,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the OrderNumberSequenceQuery when eager-loading is set.
+	Edges        OrderNumberSequenceEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// OrderNumberSequenceEdges holds the relations/edges for other nodes in the graph.
+type OrderNumberSequenceEdges struct {
+	// Restaurant holds the value of the restaurant edge.
+	Restaurant *Restaurant `json:"restaurant,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// RestaurantOrErr returns the Restaurant value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e OrderNumberSequenceEdges) RestaurantOrErr() (*Restaurant, error) {
+	if e.Restaurant != nil {
+		return e.Restaurant, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: restaurant.Label}
+	}
+	return nil, &NotLoadedError{edge: "restaurant"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*OrderNumberSequence) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case ordernumbersequence.FieldLastValue:
+			values[i] = new(sql.NullInt64)
+		case ordernumbersequence.FieldPeriod:
+			values[i] = new(sql.NullString)
+		case ordernumbersequence.FieldID, ordernumbersequence.FieldRestaurantID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the OrderNumberSequence fields.
+func (_m *OrderNumberSequence) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case ordernumbersequence.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case ordernumbersequence.FieldPeriod:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field period", values[i])
+			} else if value.Valid {
+				_m.Period = value.String
+			}
+		case ordernumbersequence.FieldLastValue:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field last_value", values[i])
+			} else if value.Valid {
+				_m.LastValue = int(value.Int64)
+			}
+		case ordernumbersequence.FieldRestaurantID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field restaurant_id", values[i])
+			} else if value != nil {
+				_m.RestaurantID = *value
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the OrderNumberSequence.
+// This includes values selected through modifiers, order, etc.
+func (_m *OrderNumberSequence) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryRestaurant queries the "restaurant" edge of the OrderNumberSequence entity.
+func (_m *OrderNumberSequence) QueryRestaurant() *RestaurantQuery {
+	return NewOrderNumberSequenceClient(_m.config).QueryRestaurant(_m)
+}
+
+// Update returns a builder for updating this OrderNumberSequence.
+// Note that you need to call OrderNumberSequence.Unwrap() before calling this method if this OrderNumberSequence
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *OrderNumberSequence) Update() *OrderNumberSequenceUpdateOne {
+	return NewOrderNumberSequenceClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the OrderNumberSequence entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *OrderNumberSequence) Unwrap() *OrderNumberSequence {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: OrderNumberSequence is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *OrderNumberSequence) String() string {
+	var builder strings.Builder
+	builder.WriteString("OrderNumberSequence(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("period=")
+	builder.WriteString(_m.Period)
+	builder.WriteString(", ")
+	builder.WriteString("last_value=")
+	builder.WriteString(fmt.Sprintf("%v", _m.LastValue))
+	builder.WriteString(", ")
+	builder.WriteString("restaurant_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.RestaurantID))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// OrderNumberSequences is a parsable slice of OrderNumberSequence.
+type OrderNumberSequences []*OrderNumberSequence
@@ -0,0 +1,99 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ordernumbersequence
+
+import (
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the ordernumbersequence type in the database.
+	Label = "order_number_sequence"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldPeriod holds the string denoting the period field in the database.
+	FieldPeriod = "period"
+	// FieldLastValue holds the string denoting the last_value field in the database.
+	FieldLastValue = "last_value"
+	// FieldRestaurantID holds the string denoting the restaurant_id field in the database.
+	FieldRestaurantID = "restaurant_id"
+	// EdgeRestaurant holds the string denoting the restaurant edge name in mutations.
+	EdgeRestaurant = "restaurant"
+	// Table holds the table name of the ordernumbersequence in the database.
+	Table = "order_number_sequences"
+	// RestaurantTable is the table that holds the restaurant relation/edge.
+	RestaurantTable = "order_number_sequences"
+	// RestaurantInverseTable is the table name for the Restaurant entity.
+	// It exists in this package in order to avoid circular dependency with the "restaurant" package.
+	RestaurantInverseTable = "restaurants"
+	// RestaurantColumn is the table column denoting the restaurant relation/edge.
+	RestaurantColumn = "restaurant_id"
+)
+
+// Columns holds all SQL columns for ordernumbersequence fields.
+var Columns = []string{
+	FieldID,
+	FieldPeriod,
+	FieldLastValue,
+	FieldRestaurantID,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultPeriod holds the default value on creation for the "period" field.
+	DefaultPeriod string
+	// DefaultLastValue holds the default value on creation for the "last_value" field.
+	DefaultLastValue int
+	// LastValueValidator is a validator for the "last_value" field. It is called by the builders before save.
+	LastValueValidator func(int) error
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// OrderOption defines the ordering options for the OrderNumberSequence queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByPeriod orders the results by the period field.
+func ByPeriod(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPeriod, opts...).ToFunc()
+}
+
+// ByLastValue orders the results by the last_value field.
+func ByLastValue(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLastValue, opts...).ToFunc()
+}
+
+// ByRestaurantID orders the results by the restaurant_id field.
+func ByRestaurantID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRestaurantID, opts...).ToFunc()
+}
+
+// ByRestaurantField orders the results by restaurant field.
+func ByRestaurantField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newRestaurantStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newRestaurantStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(RestaurantInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, RestaurantTable, RestaurantColumn),
+	)
+}
@@ -0,0 +1,233 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ordernumbersequence
+
+import (
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/Jiruu246/rms/internal/ent/predicate"
+	"github.com/google/uuid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.OrderNumberSequence {
+	return predicate.OrderNumberSequence(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.OrderNumberSequence {
+	return predicate.OrderNumberSequence(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.OrderNumberSequence {
+	return predicate.OrderNumberSequence(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.OrderNumberSequence {
+	return predicate.OrderNumberSequence(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.OrderNumberSequence {
+	return predicate.OrderNumberSequence(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.OrderNumberSequence {
+	return predicate.OrderNumberSequence(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.OrderNumberSequence {
+	return predicate.OrderNumberSequence(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.OrderNumberSequence {
+	return predicate.OrderNumberSequence(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.OrderNumberSequence {
+	return predicate.OrderNumberSequence(sql.FieldLTE(FieldID, id))
+}
+
+// Period applies equality check predicate on the "period" field. It's identical to PeriodEQ.
+func Period(v string) predicate.OrderNumberSequence {
+	return predicate.OrderNumberSequence(sql.FieldEQ(FieldPeriod, v))
+}
+
+// LastValue applies equality check predicate on the "last_value" field. It's identical to LastValueEQ.
+func LastValue(v int) predicate.OrderNumberSequence {
+	return predicate.OrderNumberSequence(sql.FieldEQ(FieldLastValue, v))
+}
+
+// RestaurantID applies equality check predicate on the "restaurant_id" field. It's identical to RestaurantIDEQ.
+func RestaurantID(v uuid.UUID) predicate.OrderNumberSequence {
+	return predicate.OrderNumberSequence(sql.FieldEQ(FieldRestaurantID, v))
+}
+
+// PeriodEQ applies the EQ predicate on the "period" field.
+func PeriodEQ(v string) predicate.OrderNumberSequence {
+	return predicate.OrderNumberSequence(sql.FieldEQ(FieldPeriod, v))
+}
+
+// PeriodNEQ applies the NEQ predicate on the "period" field.
+func PeriodNEQ(v string) predicate.OrderNumberSequence {
+	return predicate.OrderNumberSequence(sql.FieldNEQ(FieldPeriod, v))
+}
+
+// PeriodIn applies the In predicate on the "period" field.
+func PeriodIn(vs ...string) predicate.OrderNumberSequence {
+	return predicate.OrderNumberSequence(sql.FieldIn(FieldPeriod, vs...))
+}
+
+// PeriodNotIn applies the NotIn predicate on the "period" field.
+func PeriodNotIn(vs ...string) predicate.OrderNumberSequence {
+	return predicate.OrderNumberSequence(sql.FieldNotIn(FieldPeriod, vs...))
+}
+
+// PeriodGT applies the GT predicate on the "period" field.
+func PeriodGT(v string) predicate.OrderNumberSequence {
+	return predicate.OrderNumberSequence(sql.FieldGT(FieldPeriod, v))
+}
+
+// PeriodGTE applies the GTE predicate on the "period" field.
+func PeriodGTE(v string) predicate.OrderNumberSequence {
+	return predicate.OrderNumberSequence(sql.FieldGTE(FieldPeriod, v))
+}
+
+// PeriodLT applies the LT predicate on the "period" field.
+func PeriodLT(v string) predicate.OrderNumberSequence {
+	return predicate.OrderNumberSequence(sql.FieldLT(FieldPeriod, v))
+}
+
+// PeriodLTE applies the LTE predicate on the "period" field.
+func PeriodLTE(v string) predicate.OrderNumberSequence {
+	return predicate.OrderNumberSequence(sql.FieldLTE(FieldPeriod, v))
+}
+
+// PeriodContains applies the Contains predicate on the "period" field.
+func PeriodContains(v string) predicate.OrderNumberSequence {
+	return predicate.OrderNumberSequence(sql.FieldContains(FieldPeriod, v))
+}
+
+// PeriodHasPrefix applies the HasPrefix predicate on the "period" field.
+func PeriodHasPrefix(v string) predicate.OrderNumberSequence {
+	return predicate.OrderNumberSequence(sql.FieldHasPrefix(FieldPeriod, v))
+}
+
+// PeriodHasSuffix applies the HasSuffix predicate on the "period" field.
+func PeriodHasSuffix(v string) predicate.OrderNumberSequence {
+	return predicate.OrderNumberSequence(sql.FieldHasSuffix(FieldPeriod, v))
+}
+
+// PeriodEqualFold applies the EqualFold predicate on the "period" field.
+func PeriodEqualFold(v string) predicate.OrderNumberSequence {
+	return predicate.OrderNumberSequence(sql.FieldEqualFold(FieldPeriod, v))
+}
+
+// PeriodContainsFold applies the ContainsFold predicate on the "period" field.
+func PeriodContainsFold(v string) predicate.OrderNumberSequence {
+	return predicate.OrderNumberSequence(sql.FieldContainsFold(FieldPeriod, v))
+}
+
+// LastValueEQ applies the EQ predicate on the "last_value" field.
+func LastValueEQ(v int) predicate.OrderNumberSequence {
+	return predicate.OrderNumberSequence(sql.FieldEQ(FieldLastValue, v))
+}
+
+// LastValueNEQ applies the NEQ predicate on the "last_value" field.
+func LastValueNEQ(v int) predicate.OrderNumberSequence {
+	return predicate.OrderNumberSequence(sql.FieldNEQ(FieldLastValue, v))
+}
+
+// LastValueIn applies the In predicate on the "last_value" field.
+func LastValueIn(vs ...int) predicate.OrderNumberSequence {
+	return predicate.OrderNumberSequence(sql.FieldIn(FieldLastValue, vs...))
+}
+
+// LastValueNotIn applies the NotIn predicate on the "last_value" field.
+func LastValueNotIn(vs ...int) predicate.OrderNumberSequence {
+	return predicate.OrderNumberSequence(sql.FieldNotIn(FieldLastValue, vs...))
+}
+
+// LastValueGT applies the GT predicate on the "last_value" field.
+func LastValueGT(v int) predicate.OrderNumberSequence {
+	return predicate.OrderNumberSequence(sql.FieldGT(FieldLastValue, v))
+}
+
+// LastValueGTE applies the GTE predicate on the "last_value" field.
+func LastValueGTE(v int) predicate.OrderNumberSequence {
+	return predicate.OrderNumberSequence(sql.FieldGTE(FieldLastValue, v))
+}
+
+// LastValueLT applies the LT predicate on the "last_value" field.
+func LastValueLT(v int) predicate.OrderNumberSequence {
+	return predicate.OrderNumberSequence(sql.FieldLT(FieldLastValue, v))
+}
+
+// LastValueLTE applies the LTE predicate on the "last_value" field.
+func LastValueLTE(v int) predicate.OrderNumberSequence {
+	return predicate.OrderNumberSequence(sql.FieldLTE(FieldLastValue, v))
+}
+
+// RestaurantIDEQ applies the EQ predicate on the "restaurant_id" field.
+func RestaurantIDEQ(v uuid.UUID) predicate.OrderNumberSequence {
+	return predicate.OrderNumberSequence(sql.FieldEQ(FieldRestaurantID, v))
+}
+
+// RestaurantIDNEQ applies the NEQ predicate on the "restaurant_id" field.
+func RestaurantIDNEQ(v uuid.UUID) predicate.OrderNumberSequence {
+	return predicate.OrderNumberSequence(sql.FieldNEQ(FieldRestaurantID, v))
+}
+
+// RestaurantIDIn applies the In predicate on the "restaurant_id" field.
+func RestaurantIDIn(vs ...uuid.UUID) predicate.OrderNumberSequence {
+	return predicate.OrderNumberSequence(sql.FieldIn(FieldRestaurantID, vs...))
+}
+
+// RestaurantIDNotIn applies the NotIn predicate on the "restaurant_id" field.
+func RestaurantIDNotIn(vs ...uuid.UUID) predicate.OrderNumberSequence {
+	return predicate.OrderNumberSequence(sql.FieldNotIn(FieldRestaurantID, vs...))
+}
+
+// HasRestaurant applies the HasEdge predicate on the "restaurant" edge.
+func HasRestaurant() predicate.OrderNumberSequence {
+	return predicate.OrderNumberSequence(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, RestaurantTable, RestaurantColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasRestaurantWith applies the HasEdge predicate on the "restaurant" edge with a given conditions (other predicates).
+func HasRestaurantWith(preds ...predicate.Restaurant) predicate.OrderNumberSequence {
+	return predicate.OrderNumberSequence(func(s *sql.Selector) {
+		step := newRestaurantStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.OrderNumberSequence) predicate.OrderNumberSequence {
+	return predicate.OrderNumberSequence(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.OrderNumberSequence) predicate.OrderNumberSequence {
+	return predicate.OrderNumberSequence(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.OrderNumberSequence) predicate.OrderNumberSequence {
+	return predicate.OrderNumberSequence(sql.NotPredicates(p))
+}
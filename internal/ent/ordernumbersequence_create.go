@@ -0,0 +1,290 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Jiruu246/rms/internal/ent/ordernumbersequence"
+	"github.com/Jiruu246/rms/internal/ent/restaurant"
+	"github.com/google/uuid"
+)
+
+// OrderNumberSequenceCreate is the builder for creating a OrderNumberSequence entity.
+type OrderNumberSequenceCreate struct {
+	config
+	mutation *OrderNumberSequenceMutation
+	hooks    []Hook
+}
+
+// SetPeriod sets the "period" field.
+func (_c *OrderNumberSequenceCreate) SetPeriod(v string) *OrderNumberSequenceCreate {
+	_c.mutation.SetPeriod(v)
+	return _c
+}
+
+// SetNillablePeriod sets the "period" field if the given value is not nil.
+func (_c *OrderNumberSequenceCreate) SetNillablePeriod(v *string) *OrderNumberSequenceCreate {
+	if v != nil {
+		_c.SetPeriod(*v)
+	}
+	return _c
+}
+
+// SetLastValue sets the "last_value" field.
+func (_c *OrderNumberSequenceCreate) SetLastValue(v int) *OrderNumberSequenceCreate {
+	_c.mutation.SetLastValue(v)
+	return _c
+}
+
+// SetNillableLastValue sets the "last_value" field if the given value is not nil.
+func (_c *OrderNumberSequenceCreate) SetNillableLastValue(v *int) *OrderNumberSequenceCreate {
+	if v != nil {
+		_c.SetLastValue(*v)
+	}
+	return _c
+}
+
+// SetRestaurantID sets the "restaurant_id" field.
+func (_c *OrderNumberSequenceCreate) SetRestaurantID(v uuid.UUID) *OrderNumberSequenceCreate {
+	_c.mutation.SetRestaurantID(v)
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *OrderNumberSequenceCreate) SetID(v uuid.UUID) *OrderNumberSequenceCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *OrderNumberSequenceCreate) SetNillableID(v *uuid.UUID) *OrderNumberSequenceCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetRestaurant sets the "restaurant" edge to the Restaurant entity.
+func (_c *OrderNumberSequenceCreate) SetRestaurant(v *Restaurant) *OrderNumberSequenceCreate {
+	return _c.SetRestaurantID(v.ID)
+}
+
+// Mutation returns the OrderNumberSequenceMutation object of the builder.
+func (_c *OrderNumberSequenceCreate) Mutation() *OrderNumberSequenceMutation {
+	return _c.mutation
+}
+
+// Save creates the OrderNumberSequence in the database.
+func (_c *OrderNumberSequenceCreate) Save(ctx context.Context) (*OrderNumberSequence, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *OrderNumberSequenceCreate) SaveX(ctx context.Context) *OrderNumberSequence {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *OrderNumberSequenceCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *OrderNumberSequenceCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *OrderNumberSequenceCreate) defaults() {
+	if _, ok := _c.mutation.Period(); !ok {
+		v := ordernumbersequence.DefaultPeriod
+		_c.mutation.SetPeriod(v)
+	}
+	if _, ok := _c.mutation.LastValue(); !ok {
+		v := ordernumbersequence.DefaultLastValue
+		_c.mutation.SetLastValue(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := ordernumbersequence.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *OrderNumberSequenceCreate) check() error {
+	if _, ok := _c.mutation.Period(); !ok {
+		return &ValidationError{Name: "period", err: errors.New(`ent: missing required field "OrderNumberSequence.period"`)}
+	}
+	if _, ok := _c.mutation.LastValue(); !ok {
+		return &ValidationError{Name: "last_value", err: errors.New(`ent: missing required field "OrderNumberSequence.last_value"`)}
+	}
+	if v, ok := _c.mutation.LastValue(); ok {
+		if err := ordernumbersequence.LastValueValidator(v); err != nil {
+			return &ValidationError{Name: "last_value", err: fmt.Errorf(`ent: validator failed for field "OrderNumberSequence.last_value": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.RestaurantID(); !ok {
+		return &ValidationError{Name: "restaurant_id", err: errors.New(`ent: missing required field "OrderNumberSequence.restaurant_id"`)}
+	}
+	if len(_c.mutation.RestaurantIDs()) == 0 {
+		return &ValidationError{Name: "restaurant", err: errors.New(`ent: missing required edge "OrderNumberSequence.restaurant"`)}
+	}
+	return nil
+}
+
+func (_c *OrderNumberSequenceCreate) sqlSave(ctx context.Context) (*OrderNumberSequence, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *OrderNumberSequenceCreate) createSpec() (*OrderNumberSequence, *sqlgraph.CreateSpec) {
+	var (
+		_node = &OrderNumberSequence{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(ordernumbersequence.Table, sqlgraph.NewFieldSpec(ordernumbersequence.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.Period(); ok {
+		_spec.SetField(ordernumbersequence.FieldPeriod, field.TypeString, value)
+		_node.Period = value
+	}
+	if value, ok := _c.mutation.LastValue(); ok {
+		_spec.SetField(ordernumbersequence.FieldLastValue, field.TypeInt, value)
+		_node.LastValue = value
+	}
+	if nodes := _c.mutation.RestaurantIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   ordernumbersequence.RestaurantTable,
+			Columns: []string{ordernumbersequence.RestaurantColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(restaurant.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.RestaurantID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OrderNumberSequenceCreateBulk is the builder for creating many OrderNumberSequence entities in bulk.
+type OrderNumberSequenceCreateBulk struct {
+	config
+	err      error
+	builders []*OrderNumberSequenceCreate
+}
+
+// Save creates the OrderNumberSequence entities in the database.
+func (_c *OrderNumberSequenceCreateBulk) Save(ctx context.Context) ([]*OrderNumberSequence, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*OrderNumberSequence, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*OrderNumberSequenceMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *OrderNumberSequenceCreateBulk) SaveX(ctx context.Context) []*OrderNumberSequence {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *OrderNumberSequenceCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *OrderNumberSequenceCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Jiruu246/rms/internal/ent/ordernumbersequence"
+	"github.com/Jiruu246/rms/internal/ent/predicate"
+)
+
+// OrderNumberSequenceDelete is the builder for deleting a OrderNumberSequence entity.
+type OrderNumberSequenceDelete struct {
+	config
+	hooks    []Hook
+	mutation *OrderNumberSequenceMutation
+}
+
+// Where appends a list predicates to the OrderNumberSequenceDelete builder.
+func (_d *OrderNumberSequenceDelete) Where(ps ...predicate.OrderNumberSequence) *OrderNumberSequenceDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *OrderNumberSequenceDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *OrderNumberSequenceDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *OrderNumberSequenceDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(ordernumbersequence.Table, sqlgraph.NewFieldSpec(ordernumbersequence.FieldID, field.TypeUUID))
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// OrderNumberSequenceDeleteOne is the builder for deleting a single OrderNumberSequence entity.
+type OrderNumberSequenceDeleteOne struct {
+	_d *OrderNumberSequenceDelete
+}
+
+// Where appends a list predicates to the OrderNumberSequenceDelete builder.
+func (_d *OrderNumberSequenceDeleteOne) Where(ps ...predicate.OrderNumberSequence) *OrderNumberSequenceDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *OrderNumberSequenceDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{ordernumbersequence.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *OrderNumberSequenceDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,261 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Jiruu246/rms/internal/ent/ordernumbersequence"
+	"github.com/Jiruu246/rms/internal/ent/predicate"
+)
+
+// OrderNumberSequenceUpdate is the builder for updating OrderNumberSequence entities.
+type OrderNumberSequenceUpdate struct {
+	config
+	hooks    []Hook
+	mutation *OrderNumberSequenceMutation
+}
+
+// Where appends a list predicates to the OrderNumberSequenceUpdate builder.
+func (_u *OrderNumberSequenceUpdate) Where(ps ...predicate.OrderNumberSequence) *OrderNumberSequenceUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetLastValue sets the "last_value" field.
+func (_u *OrderNumberSequenceUpdate) SetLastValue(v int) *OrderNumberSequenceUpdate {
+	_u.mutation.ResetLastValue()
+	_u.mutation.SetLastValue(v)
+	return _u
+}
+
+// SetNillableLastValue sets the "last_value" field if the given value is not nil.
+func (_u *OrderNumberSequenceUpdate) SetNillableLastValue(v *int) *OrderNumberSequenceUpdate {
+	if v != nil {
+		_u.SetLastValue(*v)
+	}
+	return _u
+}
+
+// AddLastValue adds value to the "last_value" field.
+func (_u *OrderNumberSequenceUpdate) AddLastValue(v int) *OrderNumberSequenceUpdate {
+	_u.mutation.AddLastValue(v)
+	return _u
+}
+
+// Mutation returns the OrderNumberSequenceMutation object of the builder.
+func (_u *OrderNumberSequenceUpdate) Mutation() *OrderNumberSequenceMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *OrderNumberSequenceUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *OrderNumberSequenceUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *OrderNumberSequenceUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *OrderNumberSequenceUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *OrderNumberSequenceUpdate) check() error {
+	if v, ok := _u.mutation.LastValue(); ok {
+		if err := ordernumbersequence.LastValueValidator(v); err != nil {
+			return &ValidationError{Name: "last_value", err: fmt.Errorf(`ent: validator failed for field "OrderNumberSequence.last_value": %w`, err)}
+		}
+	}
+	if _u.mutation.RestaurantCleared() && len(_u.mutation.RestaurantIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "OrderNumberSequence.restaurant"`)
+	}
+	return nil
+}
+
+func (_u *OrderNumberSequenceUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(ordernumbersequence.Table, ordernumbersequence.Columns, sqlgraph.NewFieldSpec(ordernumbersequence.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.LastValue(); ok {
+		_spec.SetField(ordernumbersequence.FieldLastValue, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedLastValue(); ok {
+		_spec.AddField(ordernumbersequence.FieldLastValue, field.TypeInt, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{ordernumbersequence.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// OrderNumberSequenceUpdateOne is the builder for updating a single OrderNumberSequence entity.
+type OrderNumberSequenceUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *OrderNumberSequenceMutation
+}
+
+// SetLastValue sets the "last_value" field.
+func (_u *OrderNumberSequenceUpdateOne) SetLastValue(v int) *OrderNumberSequenceUpdateOne {
+	_u.mutation.ResetLastValue()
+	_u.mutation.SetLastValue(v)
+	return _u
+}
+
+// SetNillableLastValue sets the "last_value" field if the given value is not nil.
+func (_u *OrderNumberSequenceUpdateOne) SetNillableLastValue(v *int) *OrderNumberSequenceUpdateOne {
+	if v != nil {
+		_u.SetLastValue(*v)
+	}
+	return _u
+}
+
+// AddLastValue adds value to the "last_value" field.
+func (_u *OrderNumberSequenceUpdateOne) AddLastValue(v int) *OrderNumberSequenceUpdateOne {
+	_u.mutation.AddLastValue(v)
+	return _u
+}
+
+// Mutation returns the OrderNumberSequenceMutation object of the builder.
+func (_u *OrderNumberSequenceUpdateOne) Mutation() *OrderNumberSequenceMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the OrderNumberSequenceUpdate builder.
+func (_u *OrderNumberSequenceUpdateOne) Where(ps ...predicate.OrderNumberSequence) *OrderNumberSequenceUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *OrderNumberSequenceUpdateOne) Select(field string, fields ...string) *OrderNumberSequenceUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated OrderNumberSequence entity.
+func (_u *OrderNumberSequenceUpdateOne) Save(ctx context.Context) (*OrderNumberSequence, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *OrderNumberSequenceUpdateOne) SaveX(ctx context.Context) *OrderNumberSequence {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *OrderNumberSequenceUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *OrderNumberSequenceUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *OrderNumberSequenceUpdateOne) check() error {
+	if v, ok := _u.mutation.LastValue(); ok {
+		if err := ordernumbersequence.LastValueValidator(v); err != nil {
+			return &ValidationError{Name: "last_value", err: fmt.Errorf(`ent: validator failed for field "OrderNumberSequence.last_value": %w`, err)}
+		}
+	}
+	if _u.mutation.RestaurantCleared() && len(_u.mutation.RestaurantIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "OrderNumberSequence.restaurant"`)
+	}
+	return nil
+}
+
+func (_u *OrderNumberSequenceUpdateOne) sqlSave(ctx context.Context) (_node *OrderNumberSequence, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(ordernumbersequence.Table, ordernumbersequence.Columns, sqlgraph.NewFieldSpec(ordernumbersequence.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "OrderNumberSequence.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, ordernumbersequence.FieldID)
+		for _, f := range fields {
+			if !ordernumbersequence.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != ordernumbersequence.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.LastValue(); ok {
+		_spec.SetField(ordernumbersequence.FieldLastValue, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedLastValue(); ok {
+		_spec.AddField(ordernumbersequence.FieldLastValue, field.TypeInt, value)
+	}
+	_node = &OrderNumberSequence{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{ordernumbersequence.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,248 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/Jiruu246/rms/internal/ent/order"
+	"github.com/Jiruu246/rms/internal/ent/orderstatusevent"
+	"github.com/Jiruu246/rms/internal/ent/restaurant"
+	"github.com/Jiruu246/rms/internal/ent/user"
+	"github.com/google/uuid"
+)
+
+// OrderStatusEvent is the model entity for the OrderStatusEvent schema.
+type OrderStatusEvent struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// CreateTime holds the value of the "create_time" field.
+	CreateTime time.Time `json:"create_time,omitempty"`
+	// Status before the transition; nil for the event recorded at order creation
+	FromStatus *orderstatusevent.FromStatus `json:"from_status,omitempty"`
+	// Status after the transition
+	ToStatus orderstatusevent.ToStatus `json:"to_status,omitempty"`
+	// Free-text reason supplied with the transition
+	Reason string `json:"reason,omitempty"`
+	// ID of the user who made the change; nil for unauthenticated (public) orders
+	ChangedByID *uuid.UUID `json:"changed_by_id,omitempty"`
+	// ID of the order this event belongs to
+	OrderID uuid.UUID `json:"order_id,omitempty"`
+	// ID of the restaurant the order belongs to
+	RestaurantID uuid.UUID `json:"restaurant_id,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the OrderStatusEventQuery when eager-loading is set.
+	Edges        OrderStatusEventEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// OrderStatusEventEdges holds the relations/edges for other nodes in the graph.
+type OrderStatusEventEdges struct {
+	// Order holds the value of the order edge.
+	Order *Order `json:"order,omitempty"`
+	// Restaurant holds the value of the restaurant edge.
+	Restaurant *Restaurant `json:"restaurant,omitempty"`
+	// ChangedBy holds the value of the changed_by edge.
+	ChangedBy *User `json:"changed_by,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [3]bool
+}
+
+// OrderOrErr returns the Order value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e OrderStatusEventEdges) OrderOrErr() (*Order, error) {
+	if e.Order != nil {
+		return e.Order, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: order.Label}
+	}
+	return nil, &NotLoadedError{edge: "order"}
+}
+
+// RestaurantOrErr returns the Restaurant value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e OrderStatusEventEdges) RestaurantOrErr() (*Restaurant, error) {
+	if e.Restaurant != nil {
+		return e.Restaurant, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: restaurant.Label}
+	}
+	return nil, &NotLoadedError{edge: "restaurant"}
+}
+
+// ChangedByOrErr returns the ChangedBy value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e OrderStatusEventEdges) ChangedByOrErr() (*User, error) {
+	if e.ChangedBy != nil {
+		return e.ChangedBy, nil
+	} else if e.loadedTypes[2] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "changed_by"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*OrderStatusEvent) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case orderstatusevent.FieldChangedByID:
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case orderstatusevent.FieldFromStatus, orderstatusevent.FieldToStatus, orderstatusevent.FieldReason:
+			values[i] = new(sql.NullString)
+		case orderstatusevent.FieldCreateTime:
+			values[i] = new(sql.NullTime)
+		case orderstatusevent.FieldID, orderstatusevent.FieldOrderID, orderstatusevent.FieldRestaurantID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the OrderStatusEvent fields.
+func (_m *OrderStatusEvent) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case orderstatusevent.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case orderstatusevent.FieldCreateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field create_time", values[i])
+			} else if value.Valid {
+				_m.CreateTime = value.Time
+			}
+		case orderstatusevent.FieldFromStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field from_status", values[i])
+			} else if value.Valid {
+				_m.FromStatus = new(orderstatusevent.FromStatus)
+				*_m.FromStatus = orderstatusevent.FromStatus(value.String)
+			}
+		case orderstatusevent.FieldToStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field to_status", values[i])
+			} else if value.Valid {
+				_m.ToStatus = orderstatusevent.ToStatus(value.String)
+			}
+		case orderstatusevent.FieldReason:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field reason", values[i])
+			} else if value.Valid {
+				_m.Reason = value.String
+			}
+		case orderstatusevent.FieldChangedByID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field changed_by_id", values[i])
+			} else if value.Valid {
+				_m.ChangedByID = new(uuid.UUID)
+				*_m.ChangedByID = *value.S.(*uuid.UUID)
+			}
+		case orderstatusevent.FieldOrderID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field order_id", values[i])
+			} else if value != nil {
+				_m.OrderID = *value
+			}
+		case orderstatusevent.FieldRestaurantID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field restaurant_id", values[i])
+			} else if value != nil {
+				_m.RestaurantID = *value
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the OrderStatusEvent.
+// This includes values selected through modifiers, order, etc.
+func (_m *OrderStatusEvent) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryOrder queries the "order" edge of the OrderStatusEvent entity.
+func (_m *OrderStatusEvent) QueryOrder() *OrderQuery {
+	return NewOrderStatusEventClient(_m.config).QueryOrder(_m)
+}
+
+// QueryRestaurant queries the "restaurant" edge of the OrderStatusEvent entity.
+func (_m *OrderStatusEvent) QueryRestaurant() *RestaurantQuery {
+	return NewOrderStatusEventClient(_m.config).QueryRestaurant(_m)
+}
+
+// QueryChangedBy queries the "changed_by" edge of the OrderStatusEvent entity.
+func (_m *OrderStatusEvent) QueryChangedBy() *UserQuery {
+	return NewOrderStatusEventClient(_m.config).QueryChangedBy(_m)
+}
+
+// Update returns a builder for updating this OrderStatusEvent.
+// Note that you need to call OrderStatusEvent.Unwrap() before calling this method if this OrderStatusEvent
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *OrderStatusEvent) Update() *OrderStatusEventUpdateOne {
+	return NewOrderStatusEventClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the OrderStatusEvent entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *OrderStatusEvent) Unwrap() *OrderStatusEvent {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: OrderStatusEvent is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *OrderStatusEvent) String() string {
+	var builder strings.Builder
+	builder.WriteString("OrderStatusEvent(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("create_time=")
+	builder.WriteString(_m.CreateTime.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := _m.FromStatus; v != nil {
+		builder.WriteString("from_status=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("to_status=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ToStatus))
+	builder.WriteString(", ")
+	builder.WriteString("reason=")
+	builder.WriteString(_m.Reason)
+	builder.WriteString(", ")
+	if v := _m.ChangedByID; v != nil {
+		builder.WriteString("changed_by_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("order_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.OrderID))
+	builder.WriteString(", ")
+	builder.WriteString("restaurant_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.RestaurantID))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// OrderStatusEvents is a parsable slice of OrderStatusEvent.
+type OrderStatusEvents []*OrderStatusEvent
@@ -0,0 +1,404 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Jiruu246/rms/internal/ent/order"
+	"github.com/Jiruu246/rms/internal/ent/orderstatusevent"
+	"github.com/Jiruu246/rms/internal/ent/restaurant"
+	"github.com/Jiruu246/rms/internal/ent/user"
+	"github.com/google/uuid"
+)
+
+// OrderStatusEventCreate is the builder for creating a OrderStatusEvent entity.
+type OrderStatusEventCreate struct {
+	config
+	mutation *OrderStatusEventMutation
+	hooks    []Hook
+}
+
+// SetCreateTime sets the "create_time" field.
+func (_c *OrderStatusEventCreate) SetCreateTime(v time.Time) *OrderStatusEventCreate {
+	_c.mutation.SetCreateTime(v)
+	return _c
+}
+
+// SetNillableCreateTime sets the "create_time" field if the given value is not nil.
+func (_c *OrderStatusEventCreate) SetNillableCreateTime(v *time.Time) *OrderStatusEventCreate {
+	if v != nil {
+		_c.SetCreateTime(*v)
+	}
+	return _c
+}
+
+// SetFromStatus sets the "from_status" field.
+func (_c *OrderStatusEventCreate) SetFromStatus(v orderstatusevent.FromStatus) *OrderStatusEventCreate {
+	_c.mutation.SetFromStatus(v)
+	return _c
+}
+
+// SetNillableFromStatus sets the "from_status" field if the given value is not nil.
+func (_c *OrderStatusEventCreate) SetNillableFromStatus(v *orderstatusevent.FromStatus) *OrderStatusEventCreate {
+	if v != nil {
+		_c.SetFromStatus(*v)
+	}
+	return _c
+}
+
+// SetToStatus sets the "to_status" field.
+func (_c *OrderStatusEventCreate) SetToStatus(v orderstatusevent.ToStatus) *OrderStatusEventCreate {
+	_c.mutation.SetToStatus(v)
+	return _c
+}
+
+// SetReason sets the "reason" field.
+func (_c *OrderStatusEventCreate) SetReason(v string) *OrderStatusEventCreate {
+	_c.mutation.SetReason(v)
+	return _c
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (_c *OrderStatusEventCreate) SetNillableReason(v *string) *OrderStatusEventCreate {
+	if v != nil {
+		_c.SetReason(*v)
+	}
+	return _c
+}
+
+// SetChangedByID sets the "changed_by_id" field.
+func (_c *OrderStatusEventCreate) SetChangedByID(v uuid.UUID) *OrderStatusEventCreate {
+	_c.mutation.SetChangedByID(v)
+	return _c
+}
+
+// SetNillableChangedByID sets the "changed_by_id" field if the given value is not nil.
+func (_c *OrderStatusEventCreate) SetNillableChangedByID(v *uuid.UUID) *OrderStatusEventCreate {
+	if v != nil {
+		_c.SetChangedByID(*v)
+	}
+	return _c
+}
+
+// SetOrderID sets the "order_id" field.
+func (_c *OrderStatusEventCreate) SetOrderID(v uuid.UUID) *OrderStatusEventCreate {
+	_c.mutation.SetOrderID(v)
+	return _c
+}
+
+// SetRestaurantID sets the "restaurant_id" field.
+func (_c *OrderStatusEventCreate) SetRestaurantID(v uuid.UUID) *OrderStatusEventCreate {
+	_c.mutation.SetRestaurantID(v)
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *OrderStatusEventCreate) SetID(v uuid.UUID) *OrderStatusEventCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *OrderStatusEventCreate) SetNillableID(v *uuid.UUID) *OrderStatusEventCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetOrder sets the "order" edge to the Order entity.
+func (_c *OrderStatusEventCreate) SetOrder(v *Order) *OrderStatusEventCreate {
+	return _c.SetOrderID(v.ID)
+}
+
+// SetRestaurant sets the "restaurant" edge to the Restaurant entity.
+func (_c *OrderStatusEventCreate) SetRestaurant(v *Restaurant) *OrderStatusEventCreate {
+	return _c.SetRestaurantID(v.ID)
+}
+
+// SetChangedBy sets the "changed_by" edge to the User entity.
+func (_c *OrderStatusEventCreate) SetChangedBy(v *User) *OrderStatusEventCreate {
+	return _c.SetChangedByID(v.ID)
+}
+
+// Mutation returns the OrderStatusEventMutation object of the builder.
+func (_c *OrderStatusEventCreate) Mutation() *OrderStatusEventMutation {
+	return _c.mutation
+}
+
+// Save creates the OrderStatusEvent in the database.
+func (_c *OrderStatusEventCreate) Save(ctx context.Context) (*OrderStatusEvent, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *OrderStatusEventCreate) SaveX(ctx context.Context) *OrderStatusEvent {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *OrderStatusEventCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *OrderStatusEventCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *OrderStatusEventCreate) defaults() {
+	if _, ok := _c.mutation.CreateTime(); !ok {
+		v := orderstatusevent.DefaultCreateTime()
+		_c.mutation.SetCreateTime(v)
+	}
+	if _, ok := _c.mutation.Reason(); !ok {
+		v := orderstatusevent.DefaultReason
+		_c.mutation.SetReason(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := orderstatusevent.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *OrderStatusEventCreate) check() error {
+	if _, ok := _c.mutation.CreateTime(); !ok {
+		return &ValidationError{Name: "create_time", err: errors.New(`ent: missing required field "OrderStatusEvent.create_time"`)}
+	}
+	if v, ok := _c.mutation.FromStatus(); ok {
+		if err := orderstatusevent.FromStatusValidator(v); err != nil {
+			return &ValidationError{Name: "from_status", err: fmt.Errorf(`ent: validator failed for field "OrderStatusEvent.from_status": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.ToStatus(); !ok {
+		return &ValidationError{Name: "to_status", err: errors.New(`ent: missing required field "OrderStatusEvent.to_status"`)}
+	}
+	if v, ok := _c.mutation.ToStatus(); ok {
+		if err := orderstatusevent.ToStatusValidator(v); err != nil {
+			return &ValidationError{Name: "to_status", err: fmt.Errorf(`ent: validator failed for field "OrderStatusEvent.to_status": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Reason(); !ok {
+		return &ValidationError{Name: "reason", err: errors.New(`ent: missing required field "OrderStatusEvent.reason"`)}
+	}
+	if v, ok := _c.mutation.Reason(); ok {
+		if err := orderstatusevent.ReasonValidator(v); err != nil {
+			return &ValidationError{Name: "reason", err: fmt.Errorf(`ent: validator failed for field "OrderStatusEvent.reason": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.OrderID(); !ok {
+		return &ValidationError{Name: "order_id", err: errors.New(`ent: missing required field "OrderStatusEvent.order_id"`)}
+	}
+	if _, ok := _c.mutation.RestaurantID(); !ok {
+		return &ValidationError{Name: "restaurant_id", err: errors.New(`ent: missing required field "OrderStatusEvent.restaurant_id"`)}
+	}
+	if len(_c.mutation.OrderIDs()) == 0 {
+		return &ValidationError{Name: "order", err: errors.New(`ent: missing required edge "OrderStatusEvent.order"`)}
+	}
+	if len(_c.mutation.RestaurantIDs()) == 0 {
+		return &ValidationError{Name: "restaurant", err: errors.New(`ent: missing required edge "OrderStatusEvent.restaurant"`)}
+	}
+	return nil
+}
+
+func (_c *OrderStatusEventCreate) sqlSave(ctx context.Context) (*OrderStatusEvent, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *OrderStatusEventCreate) createSpec() (*OrderStatusEvent, *sqlgraph.CreateSpec) {
+	var (
+		_node = &OrderStatusEvent{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(orderstatusevent.Table, sqlgraph.NewFieldSpec(orderstatusevent.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.CreateTime(); ok {
+		_spec.SetField(orderstatusevent.FieldCreateTime, field.TypeTime, value)
+		_node.CreateTime = value
+	}
+	if value, ok := _c.mutation.FromStatus(); ok {
+		_spec.SetField(orderstatusevent.FieldFromStatus, field.TypeEnum, value)
+		_node.FromStatus = &value
+	}
+	if value, ok := _c.mutation.ToStatus(); ok {
+		_spec.SetField(orderstatusevent.FieldToStatus, field.TypeEnum, value)
+		_node.ToStatus = value
+	}
+	if value, ok := _c.mutation.Reason(); ok {
+		_spec.SetField(orderstatusevent.FieldReason, field.TypeString, value)
+		_node.Reason = value
+	}
+	if nodes := _c.mutation.OrderIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   orderstatusevent.OrderTable,
+			Columns: []string{orderstatusevent.OrderColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(order.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.OrderID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.RestaurantIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   orderstatusevent.RestaurantTable,
+			Columns: []string{orderstatusevent.RestaurantColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(restaurant.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.RestaurantID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.ChangedByIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   orderstatusevent.ChangedByTable,
+			Columns: []string{orderstatusevent.ChangedByColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ChangedByID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OrderStatusEventCreateBulk is the builder for creating many OrderStatusEvent entities in bulk.
+type OrderStatusEventCreateBulk struct {
+	config
+	err      error
+	builders []*OrderStatusEventCreate
+}
+
+// Save creates the OrderStatusEvent entities in the database.
+func (_c *OrderStatusEventCreateBulk) Save(ctx context.Context) ([]*OrderStatusEvent, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*OrderStatusEvent, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*OrderStatusEventMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *OrderStatusEventCreateBulk) SaveX(ctx context.Context) []*OrderStatusEvent {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *OrderStatusEventCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *OrderStatusEventCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -9,9 +9,30 @@ import (
 // Category is the predicate function for category builders.
 type Category func(*sql.Selector)
 
+// DeliveryZone is the predicate function for deliveryzone builders.
+type DeliveryZone func(*sql.Selector)
+
+// IdempotencyKey is the predicate function for idempotencykey builders.
+type IdempotencyKey func(*sql.Selector)
+
+// Ingredient is the predicate function for ingredient builders.
+type Ingredient func(*sql.Selector)
+
+// Menu is the predicate function for menu builders.
+type Menu func(*sql.Selector)
+
 // MenuItem is the predicate function for menuitem builders.
 type MenuItem func(*sql.Selector)
 
+// MenuItemModifier is the predicate function for menuitemmodifier builders.
+type MenuItemModifier func(*sql.Selector)
+
+// MenuItemPrice is the predicate function for menuitemprice builders.
+type MenuItemPrice func(*sql.Selector)
+
+// MenuItemVariant is the predicate function for menuitemvariant builders.
+type MenuItemVariant func(*sql.Selector)
+
 // Modifier is the predicate function for modifier builders.
 type Modifier func(*sql.Selector)
 
@@ -21,18 +42,57 @@ type ModifierOption func(*sql.Selector)
 // Order is the predicate function for order builders.
 type Order func(*sql.Selector)
 
+// OrderEvent is the predicate function for orderevent builders.
+type OrderEvent func(*sql.Selector)
+
 // OrderItem is the predicate function for orderitem builders.
 type OrderItem func(*sql.Selector)
 
+// OrderItemChange is the predicate function for orderitemchange builders.
+type OrderItemChange func(*sql.Selector)
+
 // OrderItemModifierOption is the predicate function for orderitemmodifieroption builders.
 type OrderItemModifierOption func(*sql.Selector)
 
+// OrderNumberSequence is the predicate function for ordernumbersequence builders.
+type OrderNumberSequence func(*sql.Selector)
+
+// OrderStatusEvent is the predicate function for orderstatusevent builders.
+type OrderStatusEvent func(*sql.Selector)
+
+// Payment is the predicate function for payment builders.
+type Payment func(*sql.Selector)
+
+// RateLimitBucket is the predicate function for ratelimitbucket builders.
+type RateLimitBucket func(*sql.Selector)
+
+// RecipeIngredient is the predicate function for recipeingredient builders.
+type RecipeIngredient func(*sql.Selector)
+
 // RefreshToken is the predicate function for refreshtoken builders.
 type RefreshToken func(*sql.Selector)
 
+// Refund is the predicate function for refund builders.
+type Refund func(*sql.Selector)
+
 // Restaurant is the predicate function for restaurant builders.
 type Restaurant func(*sql.Selector)
 
+// Station is the predicate function for station builders.
+type Station func(*sql.Selector)
+
+// StationTicket is the predicate function for stationticket builders.
+type StationTicket func(*sql.Selector)
+
+// StockMovement is the predicate function for stockmovement builders.
+type StockMovement func(*sql.Selector)
+
+// Table is the predicate function for table builders.
+type Table func(*sql.Selector)
+
+// TableSession is the predicate function for tablesession builders.
+type TableSession func(*sql.Selector)
+
 // User is the predicate function for user builders.
 type User func(*sql.Selector)
 
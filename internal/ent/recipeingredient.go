@@ -0,0 +1,212 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/Jiruu246/rms/internal/ent/ingredient"
+	"github.com/Jiruu246/rms/internal/ent/menuitem"
+	"github.com/Jiruu246/rms/internal/ent/modifieroption"
+	"github.com/Jiruu246/rms/internal/ent/recipeingredient"
+	"github.com/google/uuid"
+)
+
+// RecipeIngredient is the model entity for the RecipeIngredient schema.
+type RecipeIngredient struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// Quantity of the ingredient used, in its unit
+	Quantity int64 `json:"quantity,omitempty"`
+	// ID of the ingredient used
+	IngredientID uuid.UUID `json:"ingredient_id,omitempty"`
+	// ID of the menu item whose recipe this line is part of
+	MenuItemID *int64 `json:"menu_item_id,omitempty"`
+	// ID of the modifier option whose recipe this line is part of
+	ModifierOptionID *uuid.UUID `json:"modifier_option_id,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the RecipeIngredientQuery when eager-loading is set.
+	Edges        RecipeIngredientEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// RecipeIngredientEdges holds the relations/edges for other nodes in the graph.
+type RecipeIngredientEdges struct {
+	// Ingredient holds the value of the ingredient edge.
+	Ingredient *Ingredient `json:"ingredient,omitempty"`
+	// MenuItem holds the value of the menu_item edge.
+	MenuItem *MenuItem `json:"menu_item,omitempty"`
+	// ModifierOption holds the value of the modifier_option edge.
+	ModifierOption *ModifierOption `json:"modifier_option,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [3]bool
+}
+
+// IngredientOrErr returns the Ingredient value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e RecipeIngredientEdges) IngredientOrErr() (*Ingredient, error) {
+	if e.Ingredient != nil {
+		return e.Ingredient, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: ingredient.Label}
+	}
+	return nil, &NotLoadedError{edge: "ingredient"}
+}
+
+// MenuItemOrErr returns the MenuItem value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e RecipeIngredientEdges) MenuItemOrErr() (*MenuItem, error) {
+	if e.MenuItem != nil {
+		return e.MenuItem, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: menuitem.Label}
+	}
+	return nil, &NotLoadedError{edge: "menu_item"}
+}
+
+// ModifierOptionOrErr returns the ModifierOption value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e RecipeIngredientEdges) ModifierOptionOrErr() (*ModifierOption, error) {
+	if e.ModifierOption != nil {
+		return e.ModifierOption, nil
+	} else if e.loadedTypes[2] {
+		return nil, &NotFoundError{label: modifieroption.Label}
+	}
+	return nil, &NotLoadedError{edge: "modifier_option"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*RecipeIngredient) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case recipeingredient.FieldModifierOptionID:
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case recipeingredient.FieldQuantity, recipeingredient.FieldMenuItemID:
+			values[i] = new(sql.NullInt64)
+		case recipeingredient.FieldID, recipeingredient.FieldIngredientID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the RecipeIngredient fields.
+func (_m *RecipeIngredient) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case recipeingredient.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case recipeingredient.FieldQuantity:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field quantity", values[i])
+			} else if value.Valid {
+				_m.Quantity = value.Int64
+			}
+		case recipeingredient.FieldIngredientID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field ingredient_id", values[i])
+			} else if value != nil {
+				_m.IngredientID = *value
+			}
+		case recipeingredient.FieldMenuItemID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field menu_item_id", values[i])
+			} else if value.Valid {
+				_m.MenuItemID = new(int64)
+				*_m.MenuItemID = value.Int64
+			}
+		case recipeingredient.FieldModifierOptionID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field modifier_option_id", values[i])
+			} else if value.Valid {
+				_m.ModifierOptionID = new(uuid.UUID)
+				*_m.ModifierOptionID = *value.S.(*uuid.UUID)
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the RecipeIngredient.
+// This includes values selected through modifiers, order, etc.
+func (_m *RecipeIngredient) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryIngredient queries the "ingredient" edge of the RecipeIngredient entity.
+func (_m *RecipeIngredient) QueryIngredient() *IngredientQuery {
+	return NewRecipeIngredientClient(_m.config).QueryIngredient(_m)
+}
+
+// QueryMenuItem queries the "menu_item" edge of the RecipeIngredient entity.
+func (_m *RecipeIngredient) QueryMenuItem() *MenuItemQuery {
+	return NewRecipeIngredientClient(_m.config).QueryMenuItem(_m)
+}
+
+// QueryModifierOption queries the "modifier_option" edge of the RecipeIngredient entity.
+func (_m *RecipeIngredient) QueryModifierOption() *ModifierOptionQuery {
+	return NewRecipeIngredientClient(_m.config).QueryModifierOption(_m)
+}
+
+// Update returns a builder for updating this RecipeIngredient.
+// Note that you need to call RecipeIngredient.Unwrap() before calling this method if this RecipeIngredient
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *RecipeIngredient) Update() *RecipeIngredientUpdateOne {
+	return NewRecipeIngredientClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the RecipeIngredient entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *RecipeIngredient) Unwrap() *RecipeIngredient {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: RecipeIngredient is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *RecipeIngredient) String() string {
+	var builder strings.Builder
+	builder.WriteString("RecipeIngredient(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("quantity=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Quantity))
+	builder.WriteString(", ")
+	builder.WriteString("ingredient_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.IngredientID))
+	builder.WriteString(", ")
+	if v := _m.MenuItemID; v != nil {
+		builder.WriteString("menu_item_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.ModifierOptionID; v != nil {
+		builder.WriteString("modifier_option_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// RecipeIngredients is a parsable slice of RecipeIngredient.
+type RecipeIngredients []*RecipeIngredient
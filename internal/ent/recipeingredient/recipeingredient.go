@@ -0,0 +1,149 @@
+// Code generated by ent, DO NOT EDIT.
+
+package recipeingredient
+
+import (
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the recipeingredient type in the database.
+	Label = "recipe_ingredient"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldQuantity holds the string denoting the quantity field in the database.
+	FieldQuantity = "quantity"
+	// FieldIngredientID holds the string denoting the ingredient_id field in the database.
+	FieldIngredientID = "ingredient_id"
+	// FieldMenuItemID holds the string denoting the menu_item_id field in the database.
+	FieldMenuItemID = "menu_item_id"
+	// FieldModifierOptionID holds the string denoting the modifier_option_id field in the database.
+	FieldModifierOptionID = "modifier_option_id"
+	// EdgeIngredient holds the string denoting the ingredient edge name in mutations.
+	EdgeIngredient = "ingredient"
+	// EdgeMenuItem holds the string denoting the menu_item edge name in mutations.
+	EdgeMenuItem = "menu_item"
+	// EdgeModifierOption holds the string denoting the modifier_option edge name in mutations.
+	EdgeModifierOption = "modifier_option"
+	// Table holds the table name of the recipeingredient in the database.
+	Table = "recipe_ingredients"
+	// IngredientTable is the table that holds the ingredient relation/edge.
+	IngredientTable = "recipe_ingredients"
+	// IngredientInverseTable is the table name for the Ingredient entity.
+	// It exists in this package in order to avoid circular dependency with the "ingredient" package.
+	IngredientInverseTable = "ingredients"
+	// IngredientColumn is the table column denoting the ingredient relation/edge.
+	IngredientColumn = "ingredient_id"
+	// MenuItemTable is the table that holds the menu_item relation/edge.
+	MenuItemTable = "recipe_ingredients"
+	// MenuItemInverseTable is the table name for the MenuItem entity.
+	// It exists in this package in order to avoid circular dependency with the "menuitem" package.
+	MenuItemInverseTable = "menu_items"
+	// MenuItemColumn is the table column denoting the menu_item relation/edge.
+	MenuItemColumn = "menu_item_id"
+	// ModifierOptionTable is the table that holds the modifier_option relation/edge.
+	ModifierOptionTable = "recipe_ingredients"
+	// ModifierOptionInverseTable is the table name for the ModifierOption entity.
+	// It exists in this package in order to avoid circular dependency with the "modifieroption" package.
+	ModifierOptionInverseTable = "modifier_options"
+	// ModifierOptionColumn is the table column denoting the modifier_option relation/edge.
+	ModifierOptionColumn = "modifier_option_id"
+)
+
+// Columns holds all SQL columns for recipeingredient fields.
+var Columns = []string{
+	FieldID,
+	FieldQuantity,
+	FieldIngredientID,
+	FieldMenuItemID,
+	FieldModifierOptionID,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// QuantityValidator is a validator for the "quantity" field. It is called by the builders before save.
+	QuantityValidator func(int64) error
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// OrderOption defines the ordering options for the RecipeIngredient queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByQuantity orders the results by the quantity field.
+func ByQuantity(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldQuantity, opts...).ToFunc()
+}
+
+// ByIngredientID orders the results by the ingredient_id field.
+func ByIngredientID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldIngredientID, opts...).ToFunc()
+}
+
+// ByMenuItemID orders the results by the menu_item_id field.
+func ByMenuItemID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMenuItemID, opts...).ToFunc()
+}
+
+// ByModifierOptionID orders the results by the modifier_option_id field.
+func ByModifierOptionID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldModifierOptionID, opts...).ToFunc()
+}
+
+// ByIngredientField orders the results by ingredient field.
+func ByIngredientField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newIngredientStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByMenuItemField orders the results by menu_item field.
+func ByMenuItemField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newMenuItemStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByModifierOptionField orders the results by modifier_option field.
+func ByModifierOptionField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newModifierOptionStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newIngredientStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(IngredientInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, IngredientTable, IngredientColumn),
+	)
+}
+func newMenuItemStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(MenuItemInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, MenuItemTable, MenuItemColumn),
+	)
+}
+func newModifierOptionStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ModifierOptionInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, ModifierOptionTable, ModifierOptionColumn),
+	)
+}
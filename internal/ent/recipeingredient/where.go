@@ -0,0 +1,279 @@
+// Code generated by ent, DO NOT EDIT.
+
+package recipeingredient
+
+import (
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/Jiruu246/rms/internal/ent/predicate"
+	"github.com/google/uuid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.RecipeIngredient {
+	return predicate.RecipeIngredient(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.RecipeIngredient {
+	return predicate.RecipeIngredient(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.RecipeIngredient {
+	return predicate.RecipeIngredient(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.RecipeIngredient {
+	return predicate.RecipeIngredient(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.RecipeIngredient {
+	return predicate.RecipeIngredient(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.RecipeIngredient {
+	return predicate.RecipeIngredient(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.RecipeIngredient {
+	return predicate.RecipeIngredient(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.RecipeIngredient {
+	return predicate.RecipeIngredient(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.RecipeIngredient {
+	return predicate.RecipeIngredient(sql.FieldLTE(FieldID, id))
+}
+
+// Quantity applies equality check predicate on the "quantity" field. It's identical to QuantityEQ.
+func Quantity(v int64) predicate.RecipeIngredient {
+	return predicate.RecipeIngredient(sql.FieldEQ(FieldQuantity, v))
+}
+
+// IngredientID applies equality check predicate on the "ingredient_id" field. It's identical to IngredientIDEQ.
+func IngredientID(v uuid.UUID) predicate.RecipeIngredient {
+	return predicate.RecipeIngredient(sql.FieldEQ(FieldIngredientID, v))
+}
+
+// MenuItemID applies equality check predicate on the "menu_item_id" field. It's identical to MenuItemIDEQ.
+func MenuItemID(v int64) predicate.RecipeIngredient {
+	return predicate.RecipeIngredient(sql.FieldEQ(FieldMenuItemID, v))
+}
+
+// ModifierOptionID applies equality check predicate on the "modifier_option_id" field. It's identical to ModifierOptionIDEQ.
+func ModifierOptionID(v uuid.UUID) predicate.RecipeIngredient {
+	return predicate.RecipeIngredient(sql.FieldEQ(FieldModifierOptionID, v))
+}
+
+// QuantityEQ applies the EQ predicate on the "quantity" field.
+func QuantityEQ(v int64) predicate.RecipeIngredient {
+	return predicate.RecipeIngredient(sql.FieldEQ(FieldQuantity, v))
+}
+
+// QuantityNEQ applies the NEQ predicate on the "quantity" field.
+func QuantityNEQ(v int64) predicate.RecipeIngredient {
+	return predicate.RecipeIngredient(sql.FieldNEQ(FieldQuantity, v))
+}
+
+// QuantityIn applies the In predicate on the "quantity" field.
+func QuantityIn(vs ...int64) predicate.RecipeIngredient {
+	return predicate.RecipeIngredient(sql.FieldIn(FieldQuantity, vs...))
+}
+
+// QuantityNotIn applies the NotIn predicate on the "quantity" field.
+func QuantityNotIn(vs ...int64) predicate.RecipeIngredient {
+	return predicate.RecipeIngredient(sql.FieldNotIn(FieldQuantity, vs...))
+}
+
+// QuantityGT applies the GT predicate on the "quantity" field.
+func QuantityGT(v int64) predicate.RecipeIngredient {
+	return predicate.RecipeIngredient(sql.FieldGT(FieldQuantity, v))
+}
+
+// QuantityGTE applies the GTE predicate on the "quantity" field.
+func QuantityGTE(v int64) predicate.RecipeIngredient {
+	return predicate.RecipeIngredient(sql.FieldGTE(FieldQuantity, v))
+}
+
+// QuantityLT applies the LT predicate on the "quantity" field.
+func QuantityLT(v int64) predicate.RecipeIngredient {
+	return predicate.RecipeIngredient(sql.FieldLT(FieldQuantity, v))
+}
+
+// QuantityLTE applies the LTE predicate on the "quantity" field.
+func QuantityLTE(v int64) predicate.RecipeIngredient {
+	return predicate.RecipeIngredient(sql.FieldLTE(FieldQuantity, v))
+}
+
+// IngredientIDEQ applies the EQ predicate on the "ingredient_id" field.
+func IngredientIDEQ(v uuid.UUID) predicate.RecipeIngredient {
+	return predicate.RecipeIngredient(sql.FieldEQ(FieldIngredientID, v))
+}
+
+// IngredientIDNEQ applies the NEQ predicate on the "ingredient_id" field.
+func IngredientIDNEQ(v uuid.UUID) predicate.RecipeIngredient {
+	return predicate.RecipeIngredient(sql.FieldNEQ(FieldIngredientID, v))
+}
+
+// IngredientIDIn applies the In predicate on the "ingredient_id" field.
+func IngredientIDIn(vs ...uuid.UUID) predicate.RecipeIngredient {
+	return predicate.RecipeIngredient(sql.FieldIn(FieldIngredientID, vs...))
+}
+
+// IngredientIDNotIn applies the NotIn predicate on the "ingredient_id" field.
+func IngredientIDNotIn(vs ...uuid.UUID) predicate.RecipeIngredient {
+	return predicate.RecipeIngredient(sql.FieldNotIn(FieldIngredientID, vs...))
+}
+
+// MenuItemIDEQ applies the EQ predicate on the "menu_item_id" field.
+func MenuItemIDEQ(v int64) predicate.RecipeIngredient {
+	return predicate.RecipeIngredient(sql.FieldEQ(FieldMenuItemID, v))
+}
+
+// MenuItemIDNEQ applies the NEQ predicate on the "menu_item_id" field.
+func MenuItemIDNEQ(v int64) predicate.RecipeIngredient {
+	return predicate.RecipeIngredient(sql.FieldNEQ(FieldMenuItemID, v))
+}
+
+// MenuItemIDIn applies the In predicate on the "menu_item_id" field.
+func MenuItemIDIn(vs ...int64) predicate.RecipeIngredient {
+	return predicate.RecipeIngredient(sql.FieldIn(FieldMenuItemID, vs...))
+}
+
+// MenuItemIDNotIn applies the NotIn predicate on the "menu_item_id" field.
+func MenuItemIDNotIn(vs ...int64) predicate.RecipeIngredient {
+	return predicate.RecipeIngredient(sql.FieldNotIn(FieldMenuItemID, vs...))
+}
+
+// MenuItemIDIsNil applies the IsNil predicate on the "menu_item_id" field.
+func MenuItemIDIsNil() predicate.RecipeIngredient {
+	return predicate.RecipeIngredient(sql.FieldIsNull(FieldMenuItemID))
+}
+
+// MenuItemIDNotNil applies the NotNil predicate on the "menu_item_id" field.
+func MenuItemIDNotNil() predicate.RecipeIngredient {
+	return predicate.RecipeIngredient(sql.FieldNotNull(FieldMenuItemID))
+}
+
+// ModifierOptionIDEQ applies the EQ predicate on the "modifier_option_id" field.
+func ModifierOptionIDEQ(v uuid.UUID) predicate.RecipeIngredient {
+	return predicate.RecipeIngredient(sql.FieldEQ(FieldModifierOptionID, v))
+}
+
+// ModifierOptionIDNEQ applies the NEQ predicate on the "modifier_option_id" field.
+func ModifierOptionIDNEQ(v uuid.UUID) predicate.RecipeIngredient {
+	return predicate.RecipeIngredient(sql.FieldNEQ(FieldModifierOptionID, v))
+}
+
+// ModifierOptionIDIn applies the In predicate on the "modifier_option_id" field.
+func ModifierOptionIDIn(vs ...uuid.UUID) predicate.RecipeIngredient {
+	return predicate.RecipeIngredient(sql.FieldIn(FieldModifierOptionID, vs...))
+}
+
+// ModifierOptionIDNotIn applies the NotIn predicate on the "modifier_option_id" field.
+func ModifierOptionIDNotIn(vs ...uuid.UUID) predicate.RecipeIngredient {
+	return predicate.RecipeIngredient(sql.FieldNotIn(FieldModifierOptionID, vs...))
+}
+
+// ModifierOptionIDIsNil applies the IsNil predicate on the "modifier_option_id" field.
+func ModifierOptionIDIsNil() predicate.RecipeIngredient {
+	return predicate.RecipeIngredient(sql.FieldIsNull(FieldModifierOptionID))
+}
+
+// ModifierOptionIDNotNil applies the NotNil predicate on the "modifier_option_id" field.
+func ModifierOptionIDNotNil() predicate.RecipeIngredient {
+	return predicate.RecipeIngredient(sql.FieldNotNull(FieldModifierOptionID))
+}
+
+// HasIngredient applies the HasEdge predicate on the "ingredient" edge.
+func HasIngredient() predicate.RecipeIngredient {
+	return predicate.RecipeIngredient(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, IngredientTable, IngredientColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasIngredientWith applies the HasEdge predicate on the "ingredient" edge with a given conditions (other predicates).
+func HasIngredientWith(preds ...predicate.Ingredient) predicate.RecipeIngredient {
+	return predicate.RecipeIngredient(func(s *sql.Selector) {
+		step := newIngredientStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasMenuItem applies the HasEdge predicate on the "menu_item" edge.
+func HasMenuItem() predicate.RecipeIngredient {
+	return predicate.RecipeIngredient(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, MenuItemTable, MenuItemColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasMenuItemWith applies the HasEdge predicate on the "menu_item" edge with a given conditions (other predicates).
+func HasMenuItemWith(preds ...predicate.MenuItem) predicate.RecipeIngredient {
+	return predicate.RecipeIngredient(func(s *sql.Selector) {
+		step := newMenuItemStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasModifierOption applies the HasEdge predicate on the "modifier_option" edge.
+func HasModifierOption() predicate.RecipeIngredient {
+	return predicate.RecipeIngredient(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, ModifierOptionTable, ModifierOptionColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasModifierOptionWith applies the HasEdge predicate on the "modifier_option" edge with a given conditions (other predicates).
+func HasModifierOptionWith(preds ...predicate.ModifierOption) predicate.RecipeIngredient {
+	return predicate.RecipeIngredient(func(s *sql.Selector) {
+		step := newModifierOptionStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.RecipeIngredient) predicate.RecipeIngredient {
+	return predicate.RecipeIngredient(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.RecipeIngredient) predicate.RecipeIngredient {
+	return predicate.RecipeIngredient(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.RecipeIngredient) predicate.RecipeIngredient {
+	return predicate.RecipeIngredient(sql.NotPredicates(p))
+}
@@ -0,0 +1,327 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Jiruu246/rms/internal/ent/ingredient"
+	"github.com/Jiruu246/rms/internal/ent/menuitem"
+	"github.com/Jiruu246/rms/internal/ent/modifieroption"
+	"github.com/Jiruu246/rms/internal/ent/recipeingredient"
+	"github.com/google/uuid"
+)
+
+// RecipeIngredientCreate is the builder for creating a RecipeIngredient entity.
+type RecipeIngredientCreate struct {
+	config
+	mutation *RecipeIngredientMutation
+	hooks    []Hook
+}
+
+// SetQuantity sets the "quantity" field.
+func (_c *RecipeIngredientCreate) SetQuantity(v int64) *RecipeIngredientCreate {
+	_c.mutation.SetQuantity(v)
+	return _c
+}
+
+// SetIngredientID sets the "ingredient_id" field.
+func (_c *RecipeIngredientCreate) SetIngredientID(v uuid.UUID) *RecipeIngredientCreate {
+	_c.mutation.SetIngredientID(v)
+	return _c
+}
+
+// SetMenuItemID sets the "menu_item_id" field.
+func (_c *RecipeIngredientCreate) SetMenuItemID(v int64) *RecipeIngredientCreate {
+	_c.mutation.SetMenuItemID(v)
+	return _c
+}
+
+// SetNillableMenuItemID sets the "menu_item_id" field if the given value is not nil.
+func (_c *RecipeIngredientCreate) SetNillableMenuItemID(v *int64) *RecipeIngredientCreate {
+	if v != nil {
+		_c.SetMenuItemID(*v)
+	}
+	return _c
+}
+
+// SetModifierOptionID sets the "modifier_option_id" field.
+func (_c *RecipeIngredientCreate) SetModifierOptionID(v uuid.UUID) *RecipeIngredientCreate {
+	_c.mutation.SetModifierOptionID(v)
+	return _c
+}
+
+// SetNillableModifierOptionID sets the "modifier_option_id" field if the given value is not nil.
+func (_c *RecipeIngredientCreate) SetNillableModifierOptionID(v *uuid.UUID) *RecipeIngredientCreate {
+	if v != nil {
+		_c.SetModifierOptionID(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *RecipeIngredientCreate) SetID(v uuid.UUID) *RecipeIngredientCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *RecipeIngredientCreate) SetNillableID(v *uuid.UUID) *RecipeIngredientCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetIngredient sets the "ingredient" edge to the Ingredient entity.
+func (_c *RecipeIngredientCreate) SetIngredient(v *Ingredient) *RecipeIngredientCreate {
+	return _c.SetIngredientID(v.ID)
+}
+
+// SetMenuItem sets the "menu_item" edge to the MenuItem entity.
+func (_c *RecipeIngredientCreate) SetMenuItem(v *MenuItem) *RecipeIngredientCreate {
+	return _c.SetMenuItemID(v.ID)
+}
+
+// SetModifierOption sets the "modifier_option" edge to the ModifierOption entity.
+func (_c *RecipeIngredientCreate) SetModifierOption(v *ModifierOption) *RecipeIngredientCreate {
+	return _c.SetModifierOptionID(v.ID)
+}
+
+// Mutation returns the RecipeIngredientMutation object of the builder.
+func (_c *RecipeIngredientCreate) Mutation() *RecipeIngredientMutation {
+	return _c.mutation
+}
+
+// Save creates the RecipeIngredient in the database.
+func (_c *RecipeIngredientCreate) Save(ctx context.Context) (*RecipeIngredient, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *RecipeIngredientCreate) SaveX(ctx context.Context) *RecipeIngredient {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *RecipeIngredientCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *RecipeIngredientCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *RecipeIngredientCreate) defaults() {
+	if _, ok := _c.mutation.ID(); !ok {
+		v := recipeingredient.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *RecipeIngredientCreate) check() error {
+	if _, ok := _c.mutation.Quantity(); !ok {
+		return &ValidationError{Name: "quantity", err: errors.New(`ent: missing required field "RecipeIngredient.quantity"`)}
+	}
+	if v, ok := _c.mutation.Quantity(); ok {
+		if err := recipeingredient.QuantityValidator(v); err != nil {
+			return &ValidationError{Name: "quantity", err: fmt.Errorf(`ent: validator failed for field "RecipeIngredient.quantity": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.IngredientID(); !ok {
+		return &ValidationError{Name: "ingredient_id", err: errors.New(`ent: missing required field "RecipeIngredient.ingredient_id"`)}
+	}
+	if len(_c.mutation.IngredientIDs()) == 0 {
+		return &ValidationError{Name: "ingredient", err: errors.New(`ent: missing required edge "RecipeIngredient.ingredient"`)}
+	}
+	return nil
+}
+
+func (_c *RecipeIngredientCreate) sqlSave(ctx context.Context) (*RecipeIngredient, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *RecipeIngredientCreate) createSpec() (*RecipeIngredient, *sqlgraph.CreateSpec) {
+	var (
+		_node = &RecipeIngredient{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(recipeingredient.Table, sqlgraph.NewFieldSpec(recipeingredient.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.Quantity(); ok {
+		_spec.SetField(recipeingredient.FieldQuantity, field.TypeInt64, value)
+		_node.Quantity = value
+	}
+	if nodes := _c.mutation.IngredientIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   recipeingredient.IngredientTable,
+			Columns: []string{recipeingredient.IngredientColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ingredient.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.IngredientID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.MenuItemIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   recipeingredient.MenuItemTable,
+			Columns: []string{recipeingredient.MenuItemColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(menuitem.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.MenuItemID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.ModifierOptionIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   recipeingredient.ModifierOptionTable,
+			Columns: []string{recipeingredient.ModifierOptionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(modifieroption.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ModifierOptionID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// RecipeIngredientCreateBulk is the builder for creating many RecipeIngredient entities in bulk.
+type RecipeIngredientCreateBulk struct {
+	config
+	err      error
+	builders []*RecipeIngredientCreate
+}
+
+// Save creates the RecipeIngredient entities in the database.
+func (_c *RecipeIngredientCreateBulk) Save(ctx context.Context) ([]*RecipeIngredient, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*RecipeIngredient, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*RecipeIngredientMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *RecipeIngredientCreateBulk) SaveX(ctx context.Context) []*RecipeIngredient {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *RecipeIngredientCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *RecipeIngredientCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,261 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Jiruu246/rms/internal/ent/predicate"
+	"github.com/Jiruu246/rms/internal/ent/recipeingredient"
+)
+
+// RecipeIngredientUpdate is the builder for updating RecipeIngredient entities.
+type RecipeIngredientUpdate struct {
+	config
+	hooks    []Hook
+	mutation *RecipeIngredientMutation
+}
+
+// Where appends a list predicates to the RecipeIngredientUpdate builder.
+func (_u *RecipeIngredientUpdate) Where(ps ...predicate.RecipeIngredient) *RecipeIngredientUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetQuantity sets the "quantity" field.
+func (_u *RecipeIngredientUpdate) SetQuantity(v int64) *RecipeIngredientUpdate {
+	_u.mutation.ResetQuantity()
+	_u.mutation.SetQuantity(v)
+	return _u
+}
+
+// SetNillableQuantity sets the "quantity" field if the given value is not nil.
+func (_u *RecipeIngredientUpdate) SetNillableQuantity(v *int64) *RecipeIngredientUpdate {
+	if v != nil {
+		_u.SetQuantity(*v)
+	}
+	return _u
+}
+
+// AddQuantity adds value to the "quantity" field.
+func (_u *RecipeIngredientUpdate) AddQuantity(v int64) *RecipeIngredientUpdate {
+	_u.mutation.AddQuantity(v)
+	return _u
+}
+
+// Mutation returns the RecipeIngredientMutation object of the builder.
+func (_u *RecipeIngredientUpdate) Mutation() *RecipeIngredientMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *RecipeIngredientUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *RecipeIngredientUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *RecipeIngredientUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *RecipeIngredientUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *RecipeIngredientUpdate) check() error {
+	if v, ok := _u.mutation.Quantity(); ok {
+		if err := recipeingredient.QuantityValidator(v); err != nil {
+			return &ValidationError{Name: "quantity", err: fmt.Errorf(`ent: validator failed for field "RecipeIngredient.quantity": %w`, err)}
+		}
+	}
+	if _u.mutation.IngredientCleared() && len(_u.mutation.IngredientIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "RecipeIngredient.ingredient"`)
+	}
+	return nil
+}
+
+func (_u *RecipeIngredientUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(recipeingredient.Table, recipeingredient.Columns, sqlgraph.NewFieldSpec(recipeingredient.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Quantity(); ok {
+		_spec.SetField(recipeingredient.FieldQuantity, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedQuantity(); ok {
+		_spec.AddField(recipeingredient.FieldQuantity, field.TypeInt64, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{recipeingredient.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// RecipeIngredientUpdateOne is the builder for updating a single RecipeIngredient entity.
+type RecipeIngredientUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *RecipeIngredientMutation
+}
+
+// SetQuantity sets the "quantity" field.
+func (_u *RecipeIngredientUpdateOne) SetQuantity(v int64) *RecipeIngredientUpdateOne {
+	_u.mutation.ResetQuantity()
+	_u.mutation.SetQuantity(v)
+	return _u
+}
+
+// SetNillableQuantity sets the "quantity" field if the given value is not nil.
+func (_u *RecipeIngredientUpdateOne) SetNillableQuantity(v *int64) *RecipeIngredientUpdateOne {
+	if v != nil {
+		_u.SetQuantity(*v)
+	}
+	return _u
+}
+
+// AddQuantity adds value to the "quantity" field.
+func (_u *RecipeIngredientUpdateOne) AddQuantity(v int64) *RecipeIngredientUpdateOne {
+	_u.mutation.AddQuantity(v)
+	return _u
+}
+
+// Mutation returns the RecipeIngredientMutation object of the builder.
+func (_u *RecipeIngredientUpdateOne) Mutation() *RecipeIngredientMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the RecipeIngredientUpdate builder.
+func (_u *RecipeIngredientUpdateOne) Where(ps ...predicate.RecipeIngredient) *RecipeIngredientUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *RecipeIngredientUpdateOne) Select(field string, fields ...string) *RecipeIngredientUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated RecipeIngredient entity.
+func (_u *RecipeIngredientUpdateOne) Save(ctx context.Context) (*RecipeIngredient, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *RecipeIngredientUpdateOne) SaveX(ctx context.Context) *RecipeIngredient {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *RecipeIngredientUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *RecipeIngredientUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *RecipeIngredientUpdateOne) check() error {
+	if v, ok := _u.mutation.Quantity(); ok {
+		if err := recipeingredient.QuantityValidator(v); err != nil {
+			return &ValidationError{Name: "quantity", err: fmt.Errorf(`ent: validator failed for field "RecipeIngredient.quantity": %w`, err)}
+		}
+	}
+	if _u.mutation.IngredientCleared() && len(_u.mutation.IngredientIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "RecipeIngredient.ingredient"`)
+	}
+	return nil
+}
+
+func (_u *RecipeIngredientUpdateOne) sqlSave(ctx context.Context) (_node *RecipeIngredient, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(recipeingredient.Table, recipeingredient.Columns, sqlgraph.NewFieldSpec(recipeingredient.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "RecipeIngredient.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, recipeingredient.FieldID)
+		for _, f := range fields {
+			if !recipeingredient.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != recipeingredient.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Quantity(); ok {
+		_spec.SetField(recipeingredient.FieldQuantity, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedQuantity(); ok {
+		_spec.AddField(recipeingredient.FieldQuantity, field.TypeInt64, value)
+	}
+	_node = &RecipeIngredient{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{recipeingredient.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
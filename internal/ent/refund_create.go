@@ -0,0 +1,558 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Jiruu246/rms/internal/ent/order"
+	"github.com/Jiruu246/rms/internal/ent/payment"
+	"github.com/Jiruu246/rms/internal/ent/refund"
+	"github.com/Jiruu246/rms/internal/ent/restaurant"
+	"github.com/Jiruu246/rms/internal/ent/schema"
+	"github.com/Jiruu246/rms/internal/ent/user"
+	"github.com/google/uuid"
+)
+
+// RefundCreate is the builder for creating a Refund entity.
+type RefundCreate struct {
+	config
+	mutation *RefundMutation
+	hooks    []Hook
+}
+
+// SetCreateTime sets the "create_time" field.
+func (_c *RefundCreate) SetCreateTime(v time.Time) *RefundCreate {
+	_c.mutation.SetCreateTime(v)
+	return _c
+}
+
+// SetNillableCreateTime sets the "create_time" field if the given value is not nil.
+func (_c *RefundCreate) SetNillableCreateTime(v *time.Time) *RefundCreate {
+	if v != nil {
+		_c.SetCreateTime(*v)
+	}
+	return _c
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_c *RefundCreate) SetUpdateTime(v time.Time) *RefundCreate {
+	_c.mutation.SetUpdateTime(v)
+	return _c
+}
+
+// SetNillableUpdateTime sets the "update_time" field if the given value is not nil.
+func (_c *RefundCreate) SetNillableUpdateTime(v *time.Time) *RefundCreate {
+	if v != nil {
+		_c.SetUpdateTime(*v)
+	}
+	return _c
+}
+
+// SetAmount sets the "amount" field.
+func (_c *RefundCreate) SetAmount(v int64) *RefundCreate {
+	_c.mutation.SetAmount(v)
+	return _c
+}
+
+// SetCurrency sets the "currency" field.
+func (_c *RefundCreate) SetCurrency(v string) *RefundCreate {
+	_c.mutation.SetCurrency(v)
+	return _c
+}
+
+// SetReason sets the "reason" field.
+func (_c *RefundCreate) SetReason(v refund.Reason) *RefundCreate {
+	_c.mutation.SetReason(v)
+	return _c
+}
+
+// SetNote sets the "note" field.
+func (_c *RefundCreate) SetNote(v string) *RefundCreate {
+	_c.mutation.SetNote(v)
+	return _c
+}
+
+// SetNillableNote sets the "note" field if the given value is not nil.
+func (_c *RefundCreate) SetNillableNote(v *string) *RefundCreate {
+	if v != nil {
+		_c.SetNote(*v)
+	}
+	return _c
+}
+
+// SetItems sets the "items" field.
+func (_c *RefundCreate) SetItems(v []schema.RefundedItem) *RefundCreate {
+	_c.mutation.SetItems(v)
+	return _c
+}
+
+// SetProviderReference sets the "provider_reference" field.
+func (_c *RefundCreate) SetProviderReference(v string) *RefundCreate {
+	_c.mutation.SetProviderReference(v)
+	return _c
+}
+
+// SetNillableProviderReference sets the "provider_reference" field if the given value is not nil.
+func (_c *RefundCreate) SetNillableProviderReference(v *string) *RefundCreate {
+	if v != nil {
+		_c.SetProviderReference(*v)
+	}
+	return _c
+}
+
+// SetStatus sets the "status" field.
+func (_c *RefundCreate) SetStatus(v refund.Status) *RefundCreate {
+	_c.mutation.SetStatus(v)
+	return _c
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_c *RefundCreate) SetNillableStatus(v *refund.Status) *RefundCreate {
+	if v != nil {
+		_c.SetStatus(*v)
+	}
+	return _c
+}
+
+// SetFailureReason sets the "failure_reason" field.
+func (_c *RefundCreate) SetFailureReason(v string) *RefundCreate {
+	_c.mutation.SetFailureReason(v)
+	return _c
+}
+
+// SetNillableFailureReason sets the "failure_reason" field if the given value is not nil.
+func (_c *RefundCreate) SetNillableFailureReason(v *string) *RefundCreate {
+	if v != nil {
+		_c.SetFailureReason(*v)
+	}
+	return _c
+}
+
+// SetPaymentID sets the "payment_id" field.
+func (_c *RefundCreate) SetPaymentID(v uuid.UUID) *RefundCreate {
+	_c.mutation.SetPaymentID(v)
+	return _c
+}
+
+// SetOrderID sets the "order_id" field.
+func (_c *RefundCreate) SetOrderID(v uuid.UUID) *RefundCreate {
+	_c.mutation.SetOrderID(v)
+	return _c
+}
+
+// SetRestaurantID sets the "restaurant_id" field.
+func (_c *RefundCreate) SetRestaurantID(v uuid.UUID) *RefundCreate {
+	_c.mutation.SetRestaurantID(v)
+	return _c
+}
+
+// SetCreatedByID sets the "created_by_id" field.
+func (_c *RefundCreate) SetCreatedByID(v uuid.UUID) *RefundCreate {
+	_c.mutation.SetCreatedByID(v)
+	return _c
+}
+
+// SetNillableCreatedByID sets the "created_by_id" field if the given value is not nil.
+func (_c *RefundCreate) SetNillableCreatedByID(v *uuid.UUID) *RefundCreate {
+	if v != nil {
+		_c.SetCreatedByID(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *RefundCreate) SetID(v uuid.UUID) *RefundCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *RefundCreate) SetNillableID(v *uuid.UUID) *RefundCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetPayment sets the "payment" edge to the Payment entity.
+func (_c *RefundCreate) SetPayment(v *Payment) *RefundCreate {
+	return _c.SetPaymentID(v.ID)
+}
+
+// SetOrder sets the "order" edge to the Order entity.
+func (_c *RefundCreate) SetOrder(v *Order) *RefundCreate {
+	return _c.SetOrderID(v.ID)
+}
+
+// SetRestaurant sets the "restaurant" edge to the Restaurant entity.
+func (_c *RefundCreate) SetRestaurant(v *Restaurant) *RefundCreate {
+	return _c.SetRestaurantID(v.ID)
+}
+
+// SetCreatedBy sets the "created_by" edge to the User entity.
+func (_c *RefundCreate) SetCreatedBy(v *User) *RefundCreate {
+	return _c.SetCreatedByID(v.ID)
+}
+
+// Mutation returns the RefundMutation object of the builder.
+func (_c *RefundCreate) Mutation() *RefundMutation {
+	return _c.mutation
+}
+
+// Save creates the Refund in the database.
+func (_c *RefundCreate) Save(ctx context.Context) (*Refund, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *RefundCreate) SaveX(ctx context.Context) *Refund {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *RefundCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *RefundCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *RefundCreate) defaults() {
+	if _, ok := _c.mutation.CreateTime(); !ok {
+		v := refund.DefaultCreateTime()
+		_c.mutation.SetCreateTime(v)
+	}
+	if _, ok := _c.mutation.UpdateTime(); !ok {
+		v := refund.DefaultUpdateTime()
+		_c.mutation.SetUpdateTime(v)
+	}
+	if _, ok := _c.mutation.Note(); !ok {
+		v := refund.DefaultNote
+		_c.mutation.SetNote(v)
+	}
+	if _, ok := _c.mutation.ProviderReference(); !ok {
+		v := refund.DefaultProviderReference
+		_c.mutation.SetProviderReference(v)
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		v := refund.DefaultStatus
+		_c.mutation.SetStatus(v)
+	}
+	if _, ok := _c.mutation.FailureReason(); !ok {
+		v := refund.DefaultFailureReason
+		_c.mutation.SetFailureReason(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := refund.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *RefundCreate) check() error {
+	if _, ok := _c.mutation.CreateTime(); !ok {
+		return &ValidationError{Name: "create_time", err: errors.New(`ent: missing required field "Refund.create_time"`)}
+	}
+	if _, ok := _c.mutation.UpdateTime(); !ok {
+		return &ValidationError{Name: "update_time", err: errors.New(`ent: missing required field "Refund.update_time"`)}
+	}
+	if _, ok := _c.mutation.Amount(); !ok {
+		return &ValidationError{Name: "amount", err: errors.New(`ent: missing required field "Refund.amount"`)}
+	}
+	if v, ok := _c.mutation.Amount(); ok {
+		if err := refund.AmountValidator(v); err != nil {
+			return &ValidationError{Name: "amount", err: fmt.Errorf(`ent: validator failed for field "Refund.amount": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Currency(); !ok {
+		return &ValidationError{Name: "currency", err: errors.New(`ent: missing required field "Refund.currency"`)}
+	}
+	if _, ok := _c.mutation.Reason(); !ok {
+		return &ValidationError{Name: "reason", err: errors.New(`ent: missing required field "Refund.reason"`)}
+	}
+	if v, ok := _c.mutation.Reason(); ok {
+		if err := refund.ReasonValidator(v); err != nil {
+			return &ValidationError{Name: "reason", err: fmt.Errorf(`ent: validator failed for field "Refund.reason": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Note(); !ok {
+		return &ValidationError{Name: "note", err: errors.New(`ent: missing required field "Refund.note"`)}
+	}
+	if _, ok := _c.mutation.ProviderReference(); !ok {
+		return &ValidationError{Name: "provider_reference", err: errors.New(`ent: missing required field "Refund.provider_reference"`)}
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "Refund.status"`)}
+	}
+	if v, ok := _c.mutation.Status(); ok {
+		if err := refund.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Refund.status": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.FailureReason(); !ok {
+		return &ValidationError{Name: "failure_reason", err: errors.New(`ent: missing required field "Refund.failure_reason"`)}
+	}
+	if _, ok := _c.mutation.PaymentID(); !ok {
+		return &ValidationError{Name: "payment_id", err: errors.New(`ent: missing required field "Refund.payment_id"`)}
+	}
+	if _, ok := _c.mutation.OrderID(); !ok {
+		return &ValidationError{Name: "order_id", err: errors.New(`ent: missing required field "Refund.order_id"`)}
+	}
+	if _, ok := _c.mutation.RestaurantID(); !ok {
+		return &ValidationError{Name: "restaurant_id", err: errors.New(`ent: missing required field "Refund.restaurant_id"`)}
+	}
+	if len(_c.mutation.PaymentIDs()) == 0 {
+		return &ValidationError{Name: "payment", err: errors.New(`ent: missing required edge "Refund.payment"`)}
+	}
+	if len(_c.mutation.OrderIDs()) == 0 {
+		return &ValidationError{Name: "order", err: errors.New(`ent: missing required edge "Refund.order"`)}
+	}
+	if len(_c.mutation.RestaurantIDs()) == 0 {
+		return &ValidationError{Name: "restaurant", err: errors.New(`ent: missing required edge "Refund.restaurant"`)}
+	}
+	return nil
+}
+
+func (_c *RefundCreate) sqlSave(ctx context.Context) (*Refund, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *RefundCreate) createSpec() (*Refund, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Refund{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(refund.Table, sqlgraph.NewFieldSpec(refund.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.CreateTime(); ok {
+		_spec.SetField(refund.FieldCreateTime, field.TypeTime, value)
+		_node.CreateTime = value
+	}
+	if value, ok := _c.mutation.UpdateTime(); ok {
+		_spec.SetField(refund.FieldUpdateTime, field.TypeTime, value)
+		_node.UpdateTime = value
+	}
+	if value, ok := _c.mutation.Amount(); ok {
+		_spec.SetField(refund.FieldAmount, field.TypeInt64, value)
+		_node.Amount = value
+	}
+	if value, ok := _c.mutation.Currency(); ok {
+		_spec.SetField(refund.FieldCurrency, field.TypeString, value)
+		_node.Currency = value
+	}
+	if value, ok := _c.mutation.Reason(); ok {
+		_spec.SetField(refund.FieldReason, field.TypeEnum, value)
+		_node.Reason = value
+	}
+	if value, ok := _c.mutation.Note(); ok {
+		_spec.SetField(refund.FieldNote, field.TypeString, value)
+		_node.Note = value
+	}
+	if value, ok := _c.mutation.Items(); ok {
+		_spec.SetField(refund.FieldItems, field.TypeJSON, value)
+		_node.Items = value
+	}
+	if value, ok := _c.mutation.ProviderReference(); ok {
+		_spec.SetField(refund.FieldProviderReference, field.TypeString, value)
+		_node.ProviderReference = value
+	}
+	if value, ok := _c.mutation.Status(); ok {
+		_spec.SetField(refund.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := _c.mutation.FailureReason(); ok {
+		_spec.SetField(refund.FieldFailureReason, field.TypeString, value)
+		_node.FailureReason = value
+	}
+	if nodes := _c.mutation.PaymentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   refund.PaymentTable,
+			Columns: []string{refund.PaymentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(payment.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.PaymentID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.OrderIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   refund.OrderTable,
+			Columns: []string{refund.OrderColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(order.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.OrderID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.RestaurantIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   refund.RestaurantTable,
+			Columns: []string{refund.RestaurantColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(restaurant.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.RestaurantID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.CreatedByIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   refund.CreatedByTable,
+			Columns: []string{refund.CreatedByColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.CreatedByID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// RefundCreateBulk is the builder for creating many Refund entities in bulk.
+type RefundCreateBulk struct {
+	config
+	err      error
+	builders []*RefundCreate
+}
+
+// Save creates the Refund entities in the database.
+func (_c *RefundCreateBulk) Save(ctx context.Context) ([]*Refund, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*Refund, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*RefundMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *RefundCreateBulk) SaveX(ctx context.Context) []*Refund {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *RefundCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *RefundCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
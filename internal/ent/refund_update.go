@@ -0,0 +1,364 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Jiruu246/rms/internal/ent/predicate"
+	"github.com/Jiruu246/rms/internal/ent/refund"
+)
+
+// RefundUpdate is the builder for updating Refund entities.
+type RefundUpdate struct {
+	config
+	hooks    []Hook
+	mutation *RefundMutation
+}
+
+// Where appends a list predicates to the RefundUpdate builder.
+func (_u *RefundUpdate) Where(ps ...predicate.Refund) *RefundUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_u *RefundUpdate) SetUpdateTime(v time.Time) *RefundUpdate {
+	_u.mutation.SetUpdateTime(v)
+	return _u
+}
+
+// SetProviderReference sets the "provider_reference" field.
+func (_u *RefundUpdate) SetProviderReference(v string) *RefundUpdate {
+	_u.mutation.SetProviderReference(v)
+	return _u
+}
+
+// SetNillableProviderReference sets the "provider_reference" field if the given value is not nil.
+func (_u *RefundUpdate) SetNillableProviderReference(v *string) *RefundUpdate {
+	if v != nil {
+		_u.SetProviderReference(*v)
+	}
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *RefundUpdate) SetStatus(v refund.Status) *RefundUpdate {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *RefundUpdate) SetNillableStatus(v *refund.Status) *RefundUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetFailureReason sets the "failure_reason" field.
+func (_u *RefundUpdate) SetFailureReason(v string) *RefundUpdate {
+	_u.mutation.SetFailureReason(v)
+	return _u
+}
+
+// SetNillableFailureReason sets the "failure_reason" field if the given value is not nil.
+func (_u *RefundUpdate) SetNillableFailureReason(v *string) *RefundUpdate {
+	if v != nil {
+		_u.SetFailureReason(*v)
+	}
+	return _u
+}
+
+// Mutation returns the RefundMutation object of the builder.
+func (_u *RefundUpdate) Mutation() *RefundMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *RefundUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *RefundUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *RefundUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *RefundUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *RefundUpdate) defaults() {
+	if _, ok := _u.mutation.UpdateTime(); !ok {
+		v := refund.UpdateDefaultUpdateTime()
+		_u.mutation.SetUpdateTime(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *RefundUpdate) check() error {
+	if v, ok := _u.mutation.Status(); ok {
+		if err := refund.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Refund.status": %w`, err)}
+		}
+	}
+	if _u.mutation.PaymentCleared() && len(_u.mutation.PaymentIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Refund.payment"`)
+	}
+	if _u.mutation.OrderCleared() && len(_u.mutation.OrderIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Refund.order"`)
+	}
+	if _u.mutation.RestaurantCleared() && len(_u.mutation.RestaurantIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Refund.restaurant"`)
+	}
+	return nil
+}
+
+func (_u *RefundUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(refund.Table, refund.Columns, sqlgraph.NewFieldSpec(refund.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdateTime(); ok {
+		_spec.SetField(refund.FieldUpdateTime, field.TypeTime, value)
+	}
+	if _u.mutation.ItemsCleared() {
+		_spec.ClearField(refund.FieldItems, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.ProviderReference(); ok {
+		_spec.SetField(refund.FieldProviderReference, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(refund.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.FailureReason(); ok {
+		_spec.SetField(refund.FieldFailureReason, field.TypeString, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{refund.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// RefundUpdateOne is the builder for updating a single Refund entity.
+type RefundUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *RefundMutation
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_u *RefundUpdateOne) SetUpdateTime(v time.Time) *RefundUpdateOne {
+	_u.mutation.SetUpdateTime(v)
+	return _u
+}
+
+// SetProviderReference sets the "provider_reference" field.
+func (_u *RefundUpdateOne) SetProviderReference(v string) *RefundUpdateOne {
+	_u.mutation.SetProviderReference(v)
+	return _u
+}
+
+// SetNillableProviderReference sets the "provider_reference" field if the given value is not nil.
+func (_u *RefundUpdateOne) SetNillableProviderReference(v *string) *RefundUpdateOne {
+	if v != nil {
+		_u.SetProviderReference(*v)
+	}
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *RefundUpdateOne) SetStatus(v refund.Status) *RefundUpdateOne {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *RefundUpdateOne) SetNillableStatus(v *refund.Status) *RefundUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetFailureReason sets the "failure_reason" field.
+func (_u *RefundUpdateOne) SetFailureReason(v string) *RefundUpdateOne {
+	_u.mutation.SetFailureReason(v)
+	return _u
+}
+
+// SetNillableFailureReason sets the "failure_reason" field if the given value is not nil.
+func (_u *RefundUpdateOne) SetNillableFailureReason(v *string) *RefundUpdateOne {
+	if v != nil {
+		_u.SetFailureReason(*v)
+	}
+	return _u
+}
+
+// Mutation returns the RefundMutation object of the builder.
+func (_u *RefundUpdateOne) Mutation() *RefundMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the RefundUpdate builder.
+func (_u *RefundUpdateOne) Where(ps ...predicate.Refund) *RefundUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *RefundUpdateOne) Select(field string, fields ...string) *RefundUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated Refund entity.
+func (_u *RefundUpdateOne) Save(ctx context.Context) (*Refund, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *RefundUpdateOne) SaveX(ctx context.Context) *Refund {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *RefundUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *RefundUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *RefundUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdateTime(); !ok {
+		v := refund.UpdateDefaultUpdateTime()
+		_u.mutation.SetUpdateTime(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *RefundUpdateOne) check() error {
+	if v, ok := _u.mutation.Status(); ok {
+		if err := refund.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Refund.status": %w`, err)}
+		}
+	}
+	if _u.mutation.PaymentCleared() && len(_u.mutation.PaymentIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Refund.payment"`)
+	}
+	if _u.mutation.OrderCleared() && len(_u.mutation.OrderIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Refund.order"`)
+	}
+	if _u.mutation.RestaurantCleared() && len(_u.mutation.RestaurantIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Refund.restaurant"`)
+	}
+	return nil
+}
+
+func (_u *RefundUpdateOne) sqlSave(ctx context.Context) (_node *Refund, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(refund.Table, refund.Columns, sqlgraph.NewFieldSpec(refund.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Refund.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, refund.FieldID)
+		for _, f := range fields {
+			if !refund.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != refund.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdateTime(); ok {
+		_spec.SetField(refund.FieldUpdateTime, field.TypeTime, value)
+	}
+	if _u.mutation.ItemsCleared() {
+		_spec.ClearField(refund.FieldItems, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.ProviderReference(); ok {
+		_spec.SetField(refund.FieldProviderReference, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(refund.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.FailureReason(); ok {
+		_spec.SetField(refund.FieldFailureReason, field.TypeString, value)
+	}
+	_node = &Refund{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{refund.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
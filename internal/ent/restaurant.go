@@ -12,6 +12,7 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"github.com/Jiruu246/rms/internal/ent/restaurant"
 	"github.com/Jiruu246/rms/internal/ent/user"
+	"github.com/Jiruu246/rms/pkg/hours"
 	"github.com/google/uuid"
 )
 
@@ -40,16 +41,38 @@ type Restaurant struct {
 	ZipCode string `json:"zip_code,omitempty"`
 	// Country holds the value of the "country" field.
 	Country string `json:"country,omitempty"`
+	// Location of the restaurant; the center of RADIUS delivery zones
+	Latitude *float64 `json:"latitude,omitempty"`
+	// Longitude holds the value of the "longitude" field.
+	Longitude *float64 `json:"longitude,omitempty"`
 	// LogoURL holds the value of the "logo_url" field.
 	LogoURL string `json:"logo_url,omitempty"`
+	// LogoThumbnailURL holds the value of the "logo_thumbnail_url" field.
+	LogoThumbnailURL string `json:"logo_thumbnail_url,omitempty"`
+	// Blob store keys of the uploaded logo and its thumbnail, deleted with the restaurant
+	LogoKeys []string `json:"logo_keys,omitempty"`
 	// CoverImageURL holds the value of the "cover_image_url" field.
 	CoverImageURL string `json:"cover_image_url,omitempty"`
 	// Status holds the value of the "status" field.
 	Status restaurant.Status `json:"status,omitempty"`
-	// OperatingHours holds the value of the "operating_hours" field.
-	OperatingHours map[string]interface{} `json:"operating_hours,omitempty"`
-	// Currency holds the value of the "currency" field.
+	// Weekly opening hours and holiday exceptions, in timezone; unset means ordering is not restricted by time
+	OperatingHours *hours.Schedule `json:"operating_hours,omitempty"`
+	// ISO 4217 code; all prices under the restaurant are stored in its minor units
 	Currency string `json:"currency,omitempty"`
+	// Sales tax rate in basis points (1/100 of a percent), applied to order subtotals
+	TaxRateBps int `json:"tax_rate_bps,omitempty"`
+	// IANA time zone name; decides the local business date for daily order numbering
+	Timezone string `json:"timezone,omitempty"`
+	// Length of the pickup/delivery time slots scheduled orders are booked into
+	SlotMinutes int `json:"slot_minutes,omitempty"`
+	// Most scheduled orders per time slot; 0 means no limit
+	SlotCapacity int `json:"slot_capacity,omitempty"`
+	// How long before its scheduled time an order is released to the kitchen
+	KitchenLeadMinutes int `json:"kitchen_lead_minutes,omitempty"`
+	// Whether order numbers restart at 1 every local day
+	OrderNumberReset restaurant.OrderNumberReset `json:"order_number_reset,omitempty"`
+	// Last seq handed out to an OrderEvent of this restaurant
+	OrderEventSeq int64 `json:"order_event_seq,omitempty"`
 	// UserID holds the value of the "user_id" field.
 	UserID uuid.UUID `json:"user_id,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
@@ -70,9 +93,33 @@ type RestaurantEdges struct {
 	Modifiers []*Modifier `json:"modifiers,omitempty"`
 	// Orders holds the value of the orders edge.
 	Orders []*Order `json:"orders,omitempty"`
+	// OrderStatusEvents holds the value of the order_status_events edge.
+	OrderStatusEvents []*OrderStatusEvent `json:"order_status_events,omitempty"`
+	// Payments holds the value of the payments edge.
+	Payments []*Payment `json:"payments,omitempty"`
+	// Refunds holds the value of the refunds edge.
+	Refunds []*Refund `json:"refunds,omitempty"`
+	// OrderNumberSequences holds the value of the order_number_sequences edge.
+	OrderNumberSequences []*OrderNumberSequence `json:"order_number_sequences,omitempty"`
+	// OrderEvents holds the value of the order_events edge.
+	OrderEvents []*OrderEvent `json:"order_events,omitempty"`
+	// Stations holds the value of the stations edge.
+	Stations []*Station `json:"stations,omitempty"`
+	// StationTickets holds the value of the station_tickets edge.
+	StationTickets []*StationTicket `json:"station_tickets,omitempty"`
+	// Tables holds the value of the tables edge.
+	Tables []*Table `json:"tables,omitempty"`
+	// TableSessions holds the value of the table_sessions edge.
+	TableSessions []*TableSession `json:"table_sessions,omitempty"`
+	// DeliveryZones holds the value of the delivery_zones edge.
+	DeliveryZones []*DeliveryZone `json:"delivery_zones,omitempty"`
+	// Ingredients holds the value of the ingredients edge.
+	Ingredients []*Ingredient `json:"ingredients,omitempty"`
+	// Menus holds the value of the menus edge.
+	Menus []*Menu `json:"menus,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [5]bool
+	loadedTypes [17]bool
 }
 
 // UserOrErr returns the User value or an error if the edge
@@ -122,14 +169,126 @@ func (e RestaurantEdges) OrdersOrErr() ([]*Order, error) {
 	return nil, &NotLoadedError{edge: "orders"}
 }
 
+// OrderStatusEventsOrErr returns the OrderStatusEvents value or an error if the edge
+// was not loaded in eager-loading.
+func (e RestaurantEdges) OrderStatusEventsOrErr() ([]*OrderStatusEvent, error) {
+	if e.loadedTypes[5] {
+		return e.OrderStatusEvents, nil
+	}
+	return nil, &NotLoadedError{edge: "order_status_events"}
+}
+
+// PaymentsOrErr returns the Payments value or an error if the edge
+// was not loaded in eager-loading.
+func (e RestaurantEdges) PaymentsOrErr() ([]*Payment, error) {
+	if e.loadedTypes[6] {
+		return e.Payments, nil
+	}
+	return nil, &NotLoadedError{edge: "payments"}
+}
+
+// RefundsOrErr returns the Refunds value or an error if the edge
+// was not loaded in eager-loading.
+func (e RestaurantEdges) RefundsOrErr() ([]*Refund, error) {
+	if e.loadedTypes[7] {
+		return e.Refunds, nil
+	}
+	return nil, &NotLoadedError{edge: "refunds"}
+}
+
+// OrderNumberSequencesOrErr returns the OrderNumberSequences value or an error if the edge
+// was not loaded in eager-loading.
+func (e RestaurantEdges) OrderNumberSequencesOrErr() ([]*OrderNumberSequence, error) {
+	if e.loadedTypes[8] {
+		return e.OrderNumberSequences, nil
+	}
+	return nil, &NotLoadedError{edge: "order_number_sequences"}
+}
+
+// OrderEventsOrErr returns the OrderEvents value or an error if the edge
+// was not loaded in eager-loading.
+func (e RestaurantEdges) OrderEventsOrErr() ([]*OrderEvent, error) {
+	if e.loadedTypes[9] {
+		return e.OrderEvents, nil
+	}
+	return nil, &NotLoadedError{edge: "order_events"}
+}
+
+// StationsOrErr returns the Stations value or an error if the edge
+// was not loaded in eager-loading.
+func (e RestaurantEdges) StationsOrErr() ([]*Station, error) {
+	if e.loadedTypes[10] {
+		return e.Stations, nil
+	}
+	return nil, &NotLoadedError{edge: "stations"}
+}
+
+// StationTicketsOrErr returns the StationTickets value or an error if the edge
+// was not loaded in eager-loading.
+func (e RestaurantEdges) StationTicketsOrErr() ([]*StationTicket, error) {
+	if e.loadedTypes[11] {
+		return e.StationTickets, nil
+	}
+	return nil, &NotLoadedError{edge: "station_tickets"}
+}
+
+// TablesOrErr returns the Tables value or an error if the edge
+// was not loaded in eager-loading.
+func (e RestaurantEdges) TablesOrErr() ([]*Table, error) {
+	if e.loadedTypes[12] {
+		return e.Tables, nil
+	}
+	return nil, &NotLoadedError{edge: "tables"}
+}
+
+// TableSessionsOrErr returns the TableSessions value or an error if the edge
+// was not loaded in eager-loading.
+func (e RestaurantEdges) TableSessionsOrErr() ([]*TableSession, error) {
+	if e.loadedTypes[13] {
+		return e.TableSessions, nil
+	}
+	return nil, &NotLoadedError{edge: "table_sessions"}
+}
+
+// DeliveryZonesOrErr returns the DeliveryZones value or an error if the edge
+// was not loaded in eager-loading.
+func (e RestaurantEdges) DeliveryZonesOrErr() ([]*DeliveryZone, error) {
+	if e.loadedTypes[14] {
+		return e.DeliveryZones, nil
+	}
+	return nil, &NotLoadedError{edge: "delivery_zones"}
+}
+
+// IngredientsOrErr returns the Ingredients value or an error if the edge
+// was not loaded in eager-loading.
+func (e RestaurantEdges) IngredientsOrErr() ([]*Ingredient, error) {
+	if e.loadedTypes[15] {
+		return e.Ingredients, nil
+	}
+	return nil, &NotLoadedError{edge: "ingredients"}
+}
+
+// MenusOrErr returns the Menus value or an error if the edge
+// was not loaded in eager-loading.
+func (e RestaurantEdges) MenusOrErr() ([]*Menu, error) {
+	if e.loadedTypes[16] {
+		return e.Menus, nil
+	}
+	return nil, &NotLoadedError{edge: "menus"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Restaurant) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case restaurant.FieldOperatingHours:
+		case restaurant.FieldLogoKeys, restaurant.FieldOperatingHours:
 			values[i] = new([]byte)
-		case restaurant.FieldName, restaurant.FieldDescription, restaurant.FieldPhone, restaurant.FieldEmail, restaurant.FieldAddress, restaurant.FieldCity, restaurant.FieldState, restaurant.FieldZipCode, restaurant.FieldCountry, restaurant.FieldLogoURL, restaurant.FieldCoverImageURL, restaurant.FieldStatus, restaurant.FieldCurrency:
+		case restaurant.FieldLatitude, restaurant.FieldLongitude:
+			values[i] = new(sql.NullFloat64)
+		case restaurant.FieldTaxRateBps, restaurant.FieldSlotMinutes, restaurant.FieldSlotCapacity, restaurant.FieldKitchenLeadMinutes, restaurant.FieldOrderEventSeq:
+			values[i] = new(sql.NullInt64)
+		case restaurant.FieldName, restaurant.FieldDescription, restaurant.FieldPhone, restaurant.FieldEmail, restaurant.FieldAddress, restaurant.FieldCity, restaurant.FieldState, restaurant.FieldZipCode, restaurant.FieldCountry, restaurant.FieldLogoURL, restaurant.FieldLogoThumbnailURL, restaurant.FieldCoverImageURL, restaurant.FieldStatus, restaurant.FieldCurrency, restaurant.FieldTimezone, restaurant.FieldOrderNumberReset:
 			values[i] = new(sql.NullString)
 		case restaurant.FieldUpdateTime:
 			values[i] = new(sql.NullTime)
@@ -216,12 +375,40 @@ func (_m *Restaurant) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.Country = value.String
 			}
+		case restaurant.FieldLatitude:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field latitude", values[i])
+			} else if value.Valid {
+				_m.Latitude = new(float64)
+				*_m.Latitude = value.Float64
+			}
+		case restaurant.FieldLongitude:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field longitude", values[i])
+			} else if value.Valid {
+				_m.Longitude = new(float64)
+				*_m.Longitude = value.Float64
+			}
 		case restaurant.FieldLogoURL:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field logo_url", values[i])
 			} else if value.Valid {
 				_m.LogoURL = value.String
 			}
+		case restaurant.FieldLogoThumbnailURL:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field logo_thumbnail_url", values[i])
+			} else if value.Valid {
+				_m.LogoThumbnailURL = value.String
+			}
+		case restaurant.FieldLogoKeys:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field logo_keys", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.LogoKeys); err != nil {
+					return fmt.Errorf("unmarshal field logo_keys: %w", err)
+				}
+			}
 		case restaurant.FieldCoverImageURL:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field cover_image_url", values[i])
@@ -248,6 +435,48 @@ func (_m *Restaurant) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.Currency = value.String
 			}
+		case restaurant.FieldTaxRateBps:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field tax_rate_bps", values[i])
+			} else if value.Valid {
+				_m.TaxRateBps = int(value.Int64)
+			}
+		case restaurant.FieldTimezone:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field timezone", values[i])
+			} else if value.Valid {
+				_m.Timezone = value.String
+			}
+		case restaurant.FieldSlotMinutes:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field slot_minutes", values[i])
+			} else if value.Valid {
+				_m.SlotMinutes = int(value.Int64)
+			}
+		case restaurant.FieldSlotCapacity:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field slot_capacity", values[i])
+			} else if value.Valid {
+				_m.SlotCapacity = int(value.Int64)
+			}
+		case restaurant.FieldKitchenLeadMinutes:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field kitchen_lead_minutes", values[i])
+			} else if value.Valid {
+				_m.KitchenLeadMinutes = int(value.Int64)
+			}
+		case restaurant.FieldOrderNumberReset:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field order_number_reset", values[i])
+			} else if value.Valid {
+				_m.OrderNumberReset = restaurant.OrderNumberReset(value.String)
+			}
+		case restaurant.FieldOrderEventSeq:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field order_event_seq", values[i])
+			} else if value.Valid {
+				_m.OrderEventSeq = value.Int64
+			}
 		case restaurant.FieldUserID:
 			if value, ok := values[i].(*uuid.UUID); !ok {
 				return fmt.Errorf("unexpected type %T for field user_id", values[i])
@@ -292,6 +521,66 @@ func (_m *Restaurant) QueryOrders() *OrderQuery {
 	return NewRestaurantClient(_m.config).QueryOrders(_m)
 }
 
+// QueryOrderStatusEvents queries the "order_status_events" edge of the Restaurant entity.
+func (_m *Restaurant) QueryOrderStatusEvents() *OrderStatusEventQuery {
+	return NewRestaurantClient(_m.config).QueryOrderStatusEvents(_m)
+}
+
+// QueryPayments queries the "payments" edge of the Restaurant entity.
+func (_m *Restaurant) QueryPayments() *PaymentQuery {
+	return NewRestaurantClient(_m.config).QueryPayments(_m)
+}
+
+// QueryRefunds queries the "refunds" edge of the Restaurant entity.
+func (_m *Restaurant) QueryRefunds() *RefundQuery {
+	return NewRestaurantClient(_m.config).QueryRefunds(_m)
+}
+
+// QueryOrderNumberSequences queries the "order_number_sequences" edge of the Restaurant entity.
+func (_m *Restaurant) QueryOrderNumberSequences() *OrderNumberSequenceQuery {
+	return NewRestaurantClient(_m.config).QueryOrderNumberSequences(_m)
+}
+
+// QueryOrderEvents queries the "order_events" edge of the Restaurant entity.
+func (_m *Restaurant) QueryOrderEvents() *OrderEventQuery {
+	return NewRestaurantClient(_m.config).QueryOrderEvents(_m)
+}
+
+// QueryStations queries the "stations" edge of the Restaurant entity.
+func (_m *Restaurant) QueryStations() *StationQuery {
+	return NewRestaurantClient(_m.config).QueryStations(_m)
+}
+
+// QueryStationTickets queries the "station_tickets" edge of the Restaurant entity.
+func (_m *Restaurant) QueryStationTickets() *StationTicketQuery {
+	return NewRestaurantClient(_m.config).QueryStationTickets(_m)
+}
+
+// QueryTables queries the "tables" edge of the Restaurant entity.
+func (_m *Restaurant) QueryTables() *TableQuery {
+	return NewRestaurantClient(_m.config).QueryTables(_m)
+}
+
+// QueryTableSessions queries the "table_sessions" edge of the Restaurant entity.
+func (_m *Restaurant) QueryTableSessions() *TableSessionQuery {
+	return NewRestaurantClient(_m.config).QueryTableSessions(_m)
+}
+
+// QueryDeliveryZones queries the "delivery_zones" edge of the Restaurant entity.
+func (_m *Restaurant) QueryDeliveryZones() *DeliveryZoneQuery {
+	return NewRestaurantClient(_m.config).QueryDeliveryZones(_m)
+}
+
+// QueryIngredients queries the "ingredients" edge of the Restaurant entity.
+func (_m *Restaurant) QueryIngredients() *IngredientQuery {
+	return NewRestaurantClient(_m.config).QueryIngredients(_m)
+}
+
+// QueryMenus queries the "menus" edge of the Restaurant entity.
+func (_m *Restaurant) QueryMenus() *MenuQuery {
+	return NewRestaurantClient(_m.config).QueryMenus(_m)
+}
+
 // Update returns a builder for updating this Restaurant.
 // Note that you need to call Restaurant.Unwrap() before calling this method if this Restaurant
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -345,9 +634,25 @@ func (_m *Restaurant) String() string {
 	builder.WriteString("country=")
 	builder.WriteString(_m.Country)
 	builder.WriteString(", ")
+	if v := _m.Latitude; v != nil {
+		builder.WriteString("latitude=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.Longitude; v != nil {
+		builder.WriteString("longitude=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
 	builder.WriteString("logo_url=")
 	builder.WriteString(_m.LogoURL)
 	builder.WriteString(", ")
+	builder.WriteString("logo_thumbnail_url=")
+	builder.WriteString(_m.LogoThumbnailURL)
+	builder.WriteString(", ")
+	builder.WriteString("logo_keys=")
+	builder.WriteString(fmt.Sprintf("%v", _m.LogoKeys))
+	builder.WriteString(", ")
 	builder.WriteString("cover_image_url=")
 	builder.WriteString(_m.CoverImageURL)
 	builder.WriteString(", ")
@@ -360,6 +665,27 @@ func (_m *Restaurant) String() string {
 	builder.WriteString("currency=")
 	builder.WriteString(_m.Currency)
 	builder.WriteString(", ")
+	builder.WriteString("tax_rate_bps=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TaxRateBps))
+	builder.WriteString(", ")
+	builder.WriteString("timezone=")
+	builder.WriteString(_m.Timezone)
+	builder.WriteString(", ")
+	builder.WriteString("slot_minutes=")
+	builder.WriteString(fmt.Sprintf("%v", _m.SlotMinutes))
+	builder.WriteString(", ")
+	builder.WriteString("slot_capacity=")
+	builder.WriteString(fmt.Sprintf("%v", _m.SlotCapacity))
+	builder.WriteString(", ")
+	builder.WriteString("kitchen_lead_minutes=")
+	builder.WriteString(fmt.Sprintf("%v", _m.KitchenLeadMinutes))
+	builder.WriteString(", ")
+	builder.WriteString("order_number_reset=")
+	builder.WriteString(fmt.Sprintf("%v", _m.OrderNumberReset))
+	builder.WriteString(", ")
+	builder.WriteString("order_event_seq=")
+	builder.WriteString(fmt.Sprintf("%v", _m.OrderEventSeq))
+	builder.WriteString(", ")
 	builder.WriteString("user_id=")
 	builder.WriteString(fmt.Sprintf("%v", _m.UserID))
 	builder.WriteByte(')')
@@ -36,8 +36,16 @@ const (
 	FieldZipCode = "zip_code"
 	// FieldCountry holds the string denoting the country field in the database.
 	FieldCountry = "country"
+	// FieldLatitude holds the string denoting the latitude field in the database.
+	FieldLatitude = "latitude"
+	// FieldLongitude holds the string denoting the longitude field in the database.
+	FieldLongitude = "longitude"
 	// FieldLogoURL holds the string denoting the logo_url field in the database.
 	FieldLogoURL = "logo_url"
+	// FieldLogoThumbnailURL holds the string denoting the logo_thumbnail_url field in the database.
+	FieldLogoThumbnailURL = "logo_thumbnail_url"
+	// FieldLogoKeys holds the string denoting the logo_keys field in the database.
+	FieldLogoKeys = "logo_keys"
 	// FieldCoverImageURL holds the string denoting the cover_image_url field in the database.
 	FieldCoverImageURL = "cover_image_url"
 	// FieldStatus holds the string denoting the status field in the database.
@@ -46,6 +54,20 @@ const (
 	FieldOperatingHours = "operating_hours"
 	// FieldCurrency holds the string denoting the currency field in the database.
 	FieldCurrency = "currency"
+	// FieldTaxRateBps holds the string denoting the tax_rate_bps field in the database.
+	FieldTaxRateBps = "tax_rate_bps"
+	// FieldTimezone holds the string denoting the timezone field in the database.
+	FieldTimezone = "timezone"
+	// FieldSlotMinutes holds the string denoting the slot_minutes field in the database.
+	FieldSlotMinutes = "slot_minutes"
+	// FieldSlotCapacity holds the string denoting the slot_capacity field in the database.
+	FieldSlotCapacity = "slot_capacity"
+	// FieldKitchenLeadMinutes holds the string denoting the kitchen_lead_minutes field in the database.
+	FieldKitchenLeadMinutes = "kitchen_lead_minutes"
+	// FieldOrderNumberReset holds the string denoting the order_number_reset field in the database.
+	FieldOrderNumberReset = "order_number_reset"
+	// FieldOrderEventSeq holds the string denoting the order_event_seq field in the database.
+	FieldOrderEventSeq = "order_event_seq"
 	// FieldUserID holds the string denoting the user_id field in the database.
 	FieldUserID = "user_id"
 	// EdgeUser holds the string denoting the user edge name in mutations.
@@ -58,6 +80,30 @@ const (
 	EdgeModifiers = "modifiers"
 	// EdgeOrders holds the string denoting the orders edge name in mutations.
 	EdgeOrders = "orders"
+	// EdgeOrderStatusEvents holds the string denoting the order_status_events edge name in mutations.
+	EdgeOrderStatusEvents = "order_status_events"
+	// EdgePayments holds the string denoting the payments edge name in mutations.
+	EdgePayments = "payments"
+	// EdgeRefunds holds the string denoting the refunds edge name in mutations.
+	EdgeRefunds = "refunds"
+	// EdgeOrderNumberSequences holds the string denoting the order_number_sequences edge name in mutations.
+	EdgeOrderNumberSequences = "order_number_sequences"
+	// EdgeOrderEvents holds the string denoting the order_events edge name in mutations.
+	EdgeOrderEvents = "order_events"
+	// EdgeStations holds the string denoting the stations edge name in mutations.
+	EdgeStations = "stations"
+	// EdgeStationTickets holds the string denoting the station_tickets edge name in mutations.
+	EdgeStationTickets = "station_tickets"
+	// EdgeTables holds the string denoting the tables edge name in mutations.
+	EdgeTables = "tables"
+	// EdgeTableSessions holds the string denoting the table_sessions edge name in mutations.
+	EdgeTableSessions = "table_sessions"
+	// EdgeDeliveryZones holds the string denoting the delivery_zones edge name in mutations.
+	EdgeDeliveryZones = "delivery_zones"
+	// EdgeIngredients holds the string denoting the ingredients edge name in mutations.
+	EdgeIngredients = "ingredients"
+	// EdgeMenus holds the string denoting the menus edge name in mutations.
+	EdgeMenus = "menus"
 	// Table holds the table name of the restaurant in the database.
 	Table = "restaurants"
 	// UserTable is the table that holds the user relation/edge.
@@ -95,6 +141,90 @@ const (
 	OrdersInverseTable = "orders"
 	// OrdersColumn is the table column denoting the orders relation/edge.
 	OrdersColumn = "restaurant_id"
+	// OrderStatusEventsTable is the table that holds the order_status_events relation/edge.
+	OrderStatusEventsTable = "order_status_events"
+	// OrderStatusEventsInverseTable is the table name for the OrderStatusEvent entity.
+	// It exists in this package in order to avoid circular dependency with the "orderstatusevent" package.
+	OrderStatusEventsInverseTable = "order_status_events"
+	// OrderStatusEventsColumn is the table column denoting the order_status_events relation/edge.
+	OrderStatusEventsColumn = "restaurant_id"
+	// PaymentsTable is the table that holds the payments relation/edge.
+	PaymentsTable = "payments"
+	// PaymentsInverseTable is the table name for the Payment entity.
+	// It exists in this package in order to avoid circular dependency with the "payment" package.
+	PaymentsInverseTable = "payments"
+	// PaymentsColumn is the table column denoting the payments relation/edge.
+	PaymentsColumn = "restaurant_id"
+	// RefundsTable is the table that holds the refunds relation/edge.
+	RefundsTable = "refunds"
+	// RefundsInverseTable is the table name for the Refund entity.
+	// It exists in this package in order to avoid circular dependency with the "refund" package.
+	RefundsInverseTable = "refunds"
+	// RefundsColumn is the table column denoting the refunds relation/edge.
+	RefundsColumn = "restaurant_id"
+	// OrderNumberSequencesTable is the table that holds the order_number_sequences relation/edge.
+	OrderNumberSequencesTable = "order_number_sequences"
+	// OrderNumberSequencesInverseTable is the table name for the OrderNumberSequence entity.
+	// It exists in this package in order to avoid circular dependency with the "ordernumbersequence" package.
+	OrderNumberSequencesInverseTable = "order_number_sequences"
+	// OrderNumberSequencesColumn is the table column denoting the order_number_sequences relation/edge.
+	OrderNumberSequencesColumn = "restaurant_id"
+	// OrderEventsTable is the table that holds the order_events relation/edge.
+	OrderEventsTable = "order_events"
+	// OrderEventsInverseTable is the table name for the OrderEvent entity.
+	// It exists in this package in order to avoid circular dependency with the "orderevent" package.
+	OrderEventsInverseTable = "order_events"
+	// OrderEventsColumn is the table column denoting the order_events relation/edge.
+	OrderEventsColumn = "restaurant_id"
+	// StationsTable is the table that holds the stations relation/edge.
+	StationsTable = "stations"
+	// StationsInverseTable is the table name for the Station entity.
+	// It exists in this package in order to avoid circular dependency with the "station" package.
+	StationsInverseTable = "stations"
+	// StationsColumn is the table column denoting the stations relation/edge.
+	StationsColumn = "restaurant_id"
+	// StationTicketsTable is the table that holds the station_tickets relation/edge.
+	StationTicketsTable = "station_tickets"
+	// StationTicketsInverseTable is the table name for the StationTicket entity.
+	// It exists in this package in order to avoid circular dependency with the "stationticket" package.
+	StationTicketsInverseTable = "station_tickets"
+	// StationTicketsColumn is the table column denoting the station_tickets relation/edge.
+	StationTicketsColumn = "restaurant_id"
+	// TablesTable is the table that holds the tables relation/edge.
+	TablesTable = "tables"
+	// TablesInverseTable is the table name for the Table entity.
+	// It exists in this package in order to avoid circular dependency with the "table" package.
+	TablesInverseTable = "tables"
+	// TablesColumn is the table column denoting the tables relation/edge.
+	TablesColumn = "restaurant_id"
+	// TableSessionsTable is the table that holds the table_sessions relation/edge.
+	TableSessionsTable = "table_sessions"
+	// TableSessionsInverseTable is the table name for the TableSession entity.
+	// It exists in this package in order to avoid circular dependency with the "tablesession" package.
+	TableSessionsInverseTable = "table_sessions"
+	// TableSessionsColumn is the table column denoting the table_sessions relation/edge.
+	TableSessionsColumn = "restaurant_id"
+	// DeliveryZonesTable is the table that holds the delivery_zones relation/edge.
+	DeliveryZonesTable = "delivery_zones"
+	// DeliveryZonesInverseTable is the table name for the DeliveryZone entity.
+	// It exists in this package in order to avoid circular dependency with the "deliveryzone" package.
+	DeliveryZonesInverseTable = "delivery_zones"
+	// DeliveryZonesColumn is the table column denoting the delivery_zones relation/edge.
+	DeliveryZonesColumn = "restaurant_id"
+	// IngredientsTable is the table that holds the ingredients relation/edge.
+	IngredientsTable = "ingredients"
+	// IngredientsInverseTable is the table name for the Ingredient entity.
+	// It exists in this package in order to avoid circular dependency with the "ingredient" package.
+	IngredientsInverseTable = "ingredients"
+	// IngredientsColumn is the table column denoting the ingredients relation/edge.
+	IngredientsColumn = "restaurant_id"
+	// MenusTable is the table that holds the menus relation/edge.
+	MenusTable = "menus"
+	// MenusInverseTable is the table name for the Menu entity.
+	// It exists in this package in order to avoid circular dependency with the "menu" package.
+	MenusInverseTable = "menus"
+	// MenusColumn is the table column denoting the menus relation/edge.
+	MenusColumn = "restaurant_id"
 )
 
 // Columns holds all SQL columns for restaurant fields.
@@ -110,11 +240,22 @@ var Columns = []string{
 	FieldState,
 	FieldZipCode,
 	FieldCountry,
+	FieldLatitude,
+	FieldLongitude,
 	FieldLogoURL,
+	FieldLogoThumbnailURL,
+	FieldLogoKeys,
 	FieldCoverImageURL,
 	FieldStatus,
 	FieldOperatingHours,
 	FieldCurrency,
+	FieldTaxRateBps,
+	FieldTimezone,
+	FieldSlotMinutes,
+	FieldSlotCapacity,
+	FieldKitchenLeadMinutes,
+	FieldOrderNumberReset,
+	FieldOrderEventSeq,
 	FieldUserID,
 }
 
@@ -149,6 +290,28 @@ var (
 	ZipCodeValidator func(string) error
 	// CountryValidator is a validator for the "country" field. It is called by the builders before save.
 	CountryValidator func(string) error
+	// DefaultTaxRateBps holds the default value on creation for the "tax_rate_bps" field.
+	DefaultTaxRateBps int
+	// TaxRateBpsValidator is a validator for the "tax_rate_bps" field. It is called by the builders before save.
+	TaxRateBpsValidator func(int) error
+	// DefaultTimezone holds the default value on creation for the "timezone" field.
+	DefaultTimezone string
+	// DefaultSlotMinutes holds the default value on creation for the "slot_minutes" field.
+	DefaultSlotMinutes int
+	// SlotMinutesValidator is a validator for the "slot_minutes" field. It is called by the builders before save.
+	SlotMinutesValidator func(int) error
+	// DefaultSlotCapacity holds the default value on creation for the "slot_capacity" field.
+	DefaultSlotCapacity int
+	// SlotCapacityValidator is a validator for the "slot_capacity" field. It is called by the builders before save.
+	SlotCapacityValidator func(int) error
+	// DefaultKitchenLeadMinutes holds the default value on creation for the "kitchen_lead_minutes" field.
+	DefaultKitchenLeadMinutes int
+	// KitchenLeadMinutesValidator is a validator for the "kitchen_lead_minutes" field. It is called by the builders before save.
+	KitchenLeadMinutesValidator func(int) error
+	// DefaultOrderEventSeq holds the default value on creation for the "order_event_seq" field.
+	DefaultOrderEventSeq int64
+	// OrderEventSeqValidator is a validator for the "order_event_seq" field. It is called by the builders before save.
+	OrderEventSeqValidator func(int64) error
 	// DefaultID holds the default value on creation for the "id" field.
 	DefaultID func() uuid.UUID
 )
@@ -180,6 +343,32 @@ func StatusValidator(s Status) error {
 	}
 }
 
+// OrderNumberReset defines the type for the "order_number_reset" enum field.
+type OrderNumberReset string
+
+// OrderNumberResetNever is the default value of the OrderNumberReset enum.
+const DefaultOrderNumberReset = OrderNumberResetNever
+
+// OrderNumberReset values.
+const (
+	OrderNumberResetNever OrderNumberReset = "never"
+	OrderNumberResetDaily OrderNumberReset = "daily"
+)
+
+func (onr OrderNumberReset) String() string {
+	return string(onr)
+}
+
+// OrderNumberResetValidator is a validator for the "order_number_reset" field enum values. It is called by the builders before save.
+func OrderNumberResetValidator(onr OrderNumberReset) error {
+	switch onr {
+	case OrderNumberResetNever, OrderNumberResetDaily:
+		return nil
+	default:
+		return fmt.Errorf("restaurant: invalid enum value for order_number_reset field: %q", onr)
+	}
+}
+
 // OrderOption defines the ordering options for the Restaurant queries.
 type OrderOption func(*sql.Selector)
 
@@ -238,11 +427,26 @@ func ByCountry(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCountry, opts...).ToFunc()
 }
 
+// ByLatitude orders the results by the latitude field.
+func ByLatitude(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLatitude, opts...).ToFunc()
+}
+
+// ByLongitude orders the results by the longitude field.
+func ByLongitude(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLongitude, opts...).ToFunc()
+}
+
 // ByLogoURL orders the results by the logo_url field.
 func ByLogoURL(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldLogoURL, opts...).ToFunc()
 }
 
+// ByLogoThumbnailURL orders the results by the logo_thumbnail_url field.
+func ByLogoThumbnailURL(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLogoThumbnailURL, opts...).ToFunc()
+}
+
 // ByCoverImageURL orders the results by the cover_image_url field.
 func ByCoverImageURL(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCoverImageURL, opts...).ToFunc()
@@ -258,6 +462,41 @@ func ByCurrency(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCurrency, opts...).ToFunc()
 }
 
+// ByTaxRateBps orders the results by the tax_rate_bps field.
+func ByTaxRateBps(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTaxRateBps, opts...).ToFunc()
+}
+
+// ByTimezone orders the results by the timezone field.
+func ByTimezone(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTimezone, opts...).ToFunc()
+}
+
+// BySlotMinutes orders the results by the slot_minutes field.
+func BySlotMinutes(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSlotMinutes, opts...).ToFunc()
+}
+
+// BySlotCapacity orders the results by the slot_capacity field.
+func BySlotCapacity(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSlotCapacity, opts...).ToFunc()
+}
+
+// ByKitchenLeadMinutes orders the results by the kitchen_lead_minutes field.
+func ByKitchenLeadMinutes(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldKitchenLeadMinutes, opts...).ToFunc()
+}
+
+// ByOrderNumberReset orders the results by the order_number_reset field.
+func ByOrderNumberReset(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOrderNumberReset, opts...).ToFunc()
+}
+
+// ByOrderEventSeq orders the results by the order_event_seq field.
+func ByOrderEventSeq(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOrderEventSeq, opts...).ToFunc()
+}
+
 // ByUserID orders the results by the user_id field.
 func ByUserID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldUserID, opts...).ToFunc()
@@ -325,6 +564,174 @@ func ByOrders(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newOrdersStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
+
+// ByOrderStatusEventsCount orders the results by order_status_events count.
+func ByOrderStatusEventsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newOrderStatusEventsStep(), opts...)
+	}
+}
+
+// ByOrderStatusEvents orders the results by order_status_events terms.
+func ByOrderStatusEvents(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newOrderStatusEventsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByPaymentsCount orders the results by payments count.
+func ByPaymentsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newPaymentsStep(), opts...)
+	}
+}
+
+// ByPayments orders the results by payments terms.
+func ByPayments(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newPaymentsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByRefundsCount orders the results by refunds count.
+func ByRefundsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newRefundsStep(), opts...)
+	}
+}
+
+// ByRefunds orders the results by refunds terms.
+func ByRefunds(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newRefundsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByOrderNumberSequencesCount orders the results by order_number_sequences count.
+func ByOrderNumberSequencesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newOrderNumberSequencesStep(), opts...)
+	}
+}
+
+// ByOrderNumberSequences orders the results by order_number_sequences terms.
+func ByOrderNumberSequences(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newOrderNumberSequencesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByOrderEventsCount orders the results by order_events count.
+func ByOrderEventsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newOrderEventsStep(), opts...)
+	}
+}
+
+// ByOrderEvents orders the results by order_events terms.
+func ByOrderEvents(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newOrderEventsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByStationsCount orders the results by stations count.
+func ByStationsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newStationsStep(), opts...)
+	}
+}
+
+// ByStations orders the results by stations terms.
+func ByStations(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newStationsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByStationTicketsCount orders the results by station_tickets count.
+func ByStationTicketsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newStationTicketsStep(), opts...)
+	}
+}
+
+// ByStationTickets orders the results by station_tickets terms.
+func ByStationTickets(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newStationTicketsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByTablesCount orders the results by tables count.
+func ByTablesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newTablesStep(), opts...)
+	}
+}
+
+// ByTables orders the results by tables terms.
+func ByTables(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newTablesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByTableSessionsCount orders the results by table_sessions count.
+func ByTableSessionsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newTableSessionsStep(), opts...)
+	}
+}
+
+// ByTableSessions orders the results by table_sessions terms.
+func ByTableSessions(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newTableSessionsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByDeliveryZonesCount orders the results by delivery_zones count.
+func ByDeliveryZonesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newDeliveryZonesStep(), opts...)
+	}
+}
+
+// ByDeliveryZones orders the results by delivery_zones terms.
+func ByDeliveryZones(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newDeliveryZonesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByIngredientsCount orders the results by ingredients count.
+func ByIngredientsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newIngredientsStep(), opts...)
+	}
+}
+
+// ByIngredients orders the results by ingredients terms.
+func ByIngredients(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newIngredientsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByMenusCount orders the results by menus count.
+func ByMenusCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newMenusStep(), opts...)
+	}
+}
+
+// ByMenus orders the results by menus terms.
+func ByMenus(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newMenusStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newUserStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -360,3 +767,87 @@ func newOrdersStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, false, OrdersTable, OrdersColumn),
 	)
 }
+func newOrderStatusEventsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(OrderStatusEventsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, OrderStatusEventsTable, OrderStatusEventsColumn),
+	)
+}
+func newPaymentsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(PaymentsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, PaymentsTable, PaymentsColumn),
+	)
+}
+func newRefundsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(RefundsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, RefundsTable, RefundsColumn),
+	)
+}
+func newOrderNumberSequencesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(OrderNumberSequencesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, OrderNumberSequencesTable, OrderNumberSequencesColumn),
+	)
+}
+func newOrderEventsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(OrderEventsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, OrderEventsTable, OrderEventsColumn),
+	)
+}
+func newStationsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(StationsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, StationsTable, StationsColumn),
+	)
+}
+func newStationTicketsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(StationTicketsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, StationTicketsTable, StationTicketsColumn),
+	)
+}
+func newTablesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(TablesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, TablesTable, TablesColumn),
+	)
+}
+func newTableSessionsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(TableSessionsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, TableSessionsTable, TableSessionsColumn),
+	)
+}
+func newDeliveryZonesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(DeliveryZonesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, DeliveryZonesTable, DeliveryZonesColumn),
+	)
+}
+func newIngredientsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(IngredientsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, IngredientsTable, IngredientsColumn),
+	)
+}
+func newMenusStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(MenusInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, MenusTable, MenusColumn),
+	)
+}
@@ -106,11 +106,26 @@ func Country(v string) predicate.Restaurant {
 	return predicate.Restaurant(sql.FieldEQ(FieldCountry, v))
 }
 
+// Latitude applies equality check predicate on the "latitude" field. It's identical to LatitudeEQ.
+func Latitude(v float64) predicate.Restaurant {
+	return predicate.Restaurant(sql.FieldEQ(FieldLatitude, v))
+}
+
+// Longitude applies equality check predicate on the "longitude" field. It's identical to LongitudeEQ.
+func Longitude(v float64) predicate.Restaurant {
+	return predicate.Restaurant(sql.FieldEQ(FieldLongitude, v))
+}
+
 // LogoURL applies equality check predicate on the "logo_url" field. It's identical to LogoURLEQ.
 func LogoURL(v string) predicate.Restaurant {
 	return predicate.Restaurant(sql.FieldEQ(FieldLogoURL, v))
 }
 
+// LogoThumbnailURL applies equality check predicate on the "logo_thumbnail_url" field. It's identical to LogoThumbnailURLEQ.
+func LogoThumbnailURL(v string) predicate.Restaurant {
+	return predicate.Restaurant(sql.FieldEQ(FieldLogoThumbnailURL, v))
+}
+
 // CoverImageURL applies equality check predicate on the "cover_image_url" field. It's identical to CoverImageURLEQ.
 func CoverImageURL(v string) predicate.Restaurant {
 	return predicate.Restaurant(sql.FieldEQ(FieldCoverImageURL, v))
@@ -121,6 +136,36 @@ func Currency(v string) predicate.Restaurant {
 	return predicate.Restaurant(sql.FieldEQ(FieldCurrency, v))
 }
 
+// TaxRateBps applies equality check predicate on the "tax_rate_bps" field. It's identical to TaxRateBpsEQ.
+func TaxRateBps(v int) predicate.Restaurant {
+	return predicate.Restaurant(sql.FieldEQ(FieldTaxRateBps, v))
+}
+
+// Timezone applies equality check predicate on the "timezone" field. It's identical to TimezoneEQ.
+func Timezone(v string) predicate.Restaurant {
+	return predicate.Restaurant(sql.FieldEQ(FieldTimezone, v))
+}
+
+// SlotMinutes applies equality check predicate on the "slot_minutes" field. It's identical to SlotMinutesEQ.
+func SlotMinutes(v int) predicate.Restaurant {
+	return predicate.Restaurant(sql.FieldEQ(FieldSlotMinutes, v))
+}
+
+// SlotCapacity applies equality check predicate on the "slot_capacity" field. It's identical to SlotCapacityEQ.
+func SlotCapacity(v int) predicate.Restaurant {
+	return predicate.Restaurant(sql.FieldEQ(FieldSlotCapacity, v))
+}
+
+// KitchenLeadMinutes applies equality check predicate on the "kitchen_lead_minutes" field. It's identical to KitchenLeadMinutesEQ.
+func KitchenLeadMinutes(v int) predicate.Restaurant {
+	return predicate.Restaurant(sql.FieldEQ(FieldKitchenLeadMinutes, v))
+}
+
+// OrderEventSeq applies equality check predicate on the "order_event_seq" field. It's identical to OrderEventSeqEQ.
+func OrderEventSeq(v int64) predicate.Restaurant {
+	return predicate.Restaurant(sql.FieldEQ(FieldOrderEventSeq, v))
+}
+
 // UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
 func UserID(v uuid.UUID) predicate.Restaurant {
 	return predicate.Restaurant(sql.FieldEQ(FieldUserID, v))
@@ -761,6 +806,106 @@ func CountryContainsFold(v string) predicate.Restaurant {
 	return predicate.Restaurant(sql.FieldContainsFold(FieldCountry, v))
 }
 
+// LatitudeEQ applies the EQ predicate on the "latitude" field.
+func LatitudeEQ(v float64) predicate.Restaurant {
+	return predicate.Restaurant(sql.FieldEQ(FieldLatitude, v))
+}
+
+// LatitudeNEQ applies the NEQ predicate on the "latitude" field.
+func LatitudeNEQ(v float64) predicate.Restaurant {
+	return predicate.Restaurant(sql.FieldNEQ(FieldLatitude, v))
+}
+
+// LatitudeIn applies the In predicate on the "latitude" field.
+func LatitudeIn(vs ...float64) predicate.Restaurant {
+	return predicate.Restaurant(sql.FieldIn(FieldLatitude, vs...))
+}
+
+// LatitudeNotIn applies the NotIn predicate on the "latitude" field.
+func LatitudeNotIn(vs ...float64) predicate.Restaurant {
+	return predicate.Restaurant(sql.FieldNotIn(FieldLatitude, vs...))
+}
+
+// LatitudeGT applies the GT predicate on the "latitude" field.
+func LatitudeGT(v float64) predicate.Restaurant {
+	return predicate.Restaurant(sql.FieldGT(FieldLatitude, v))
+}
+
+// LatitudeGTE applies the GTE predicate on the "latitude" field.
+func LatitudeGTE(v float64) predicate.Restaurant {
+	return predicate.Restaurant(sql.FieldGTE(FieldLatitude, v))
+}
+
+// LatitudeLT applies the LT predicate on the "latitude" field.
+func LatitudeLT(v float64) predicate.Restaurant {
+	return predicate.Restaurant(sql.FieldLT(FieldLatitude, v))
+}
+
+// LatitudeLTE applies the LTE predicate on the "latitude" field.
+func LatitudeLTE(v float64) predicate.Restaurant {
+	return predicate.Restaurant(sql.FieldLTE(FieldLatitude, v))
+}
+
+// LatitudeIsNil applies the IsNil predicate on the "latitude" field.
+func LatitudeIsNil() predicate.Restaurant {
+	return predicate.Restaurant(sql.FieldIsNull(FieldLatitude))
+}
+
+// LatitudeNotNil applies the NotNil predicate on the "latitude" field.
+func LatitudeNotNil() predicate.Restaurant {
+	return predicate.Restaurant(sql.FieldNotNull(FieldLatitude))
+}
+
+// LongitudeEQ applies the EQ predicate on the "longitude" field.
+func LongitudeEQ(v float64) predicate.Restaurant {
+	return predicate.Restaurant(sql.FieldEQ(FieldLongitude, v))
+}
+
+// LongitudeNEQ applies the NEQ predicate on the "longitude" field.
+func LongitudeNEQ(v float64) predicate.Restaurant {
+	return predicate.Restaurant(sql.FieldNEQ(FieldLongitude, v))
+}
+
+// LongitudeIn applies the In predicate on the "longitude" field.
+func LongitudeIn(vs ...float64) predicate.Restaurant {
+	return predicate.Restaurant(sql.FieldIn(FieldLongitude, vs...))
+}
+
+// LongitudeNotIn applies the NotIn predicate on the "longitude" field.
+func LongitudeNotIn(vs ...float64) predicate.Restaurant {
+	return predicate.Restaurant(sql.FieldNotIn(FieldLongitude, vs...))
+}
+
+// LongitudeGT applies the GT predicate on the "longitude" field.
+func LongitudeGT(v float64) predicate.Restaurant {
+	return predicate.Restaurant(sql.FieldGT(FieldLongitude, v))
+}
+
+// LongitudeGTE applies the GTE predicate on the "longitude" field.
+func LongitudeGTE(v float64) predicate.Restaurant {
+	return predicate.Restaurant(sql.FieldGTE(FieldLongitude, v))
+}
+
+// LongitudeLT applies the LT predicate on the "longitude" field.
+func LongitudeLT(v float64) predicate.Restaurant {
+	return predicate.Restaurant(sql.FieldLT(FieldLongitude, v))
+}
+
+// LongitudeLTE applies the LTE predicate on the "longitude" field.
+func LongitudeLTE(v float64) predicate.Restaurant {
+	return predicate.Restaurant(sql.FieldLTE(FieldLongitude, v))
+}
+
+// LongitudeIsNil applies the IsNil predicate on the "longitude" field.
+func LongitudeIsNil() predicate.Restaurant {
+	return predicate.Restaurant(sql.FieldIsNull(FieldLongitude))
+}
+
+// LongitudeNotNil applies the NotNil predicate on the "longitude" field.
+func LongitudeNotNil() predicate.Restaurant {
+	return predicate.Restaurant(sql.FieldNotNull(FieldLongitude))
+}
+
 // LogoURLEQ applies the EQ predicate on the "logo_url" field.
 func LogoURLEQ(v string) predicate.Restaurant {
 	return predicate.Restaurant(sql.FieldEQ(FieldLogoURL, v))
@@ -836,6 +981,91 @@ func LogoURLContainsFold(v string) predicate.Restaurant {
 	return predicate.Restaurant(sql.FieldContainsFold(FieldLogoURL, v))
 }
 
+// LogoThumbnailURLEQ applies the EQ predicate on the "logo_thumbnail_url" field.
+func LogoThumbnailURLEQ(v string) predicate.Restaurant {
+	return predicate.Restaurant(sql.FieldEQ(FieldLogoThumbnailURL, v))
+}
+
+// LogoThumbnailURLNEQ applies the NEQ predicate on the "logo_thumbnail_url" field.
+func LogoThumbnailURLNEQ(v string) predicate.Restaurant {
+	return predicate.Restaurant(sql.FieldNEQ(FieldLogoThumbnailURL, v))
+}
+
+// LogoThumbnailURLIn applies the In predicate on the "logo_thumbnail_url" field.
+func LogoThumbnailURLIn(vs ...string) predicate.Restaurant {
+	return predicate.Restaurant(sql.FieldIn(FieldLogoThumbnailURL, vs...))
+}
+
+// LogoThumbnailURLNotIn applies the NotIn predicate on the "logo_thumbnail_url" field.
+func LogoThumbnailURLNotIn(vs ...string) predicate.Restaurant {
+	return predicate.Restaurant(sql.FieldNotIn(FieldLogoThumbnailURL, vs...))
+}
+
+// LogoThumbnailURLGT applies the GT predicate on the "logo_thumbnail_url" field.
+func LogoThumbnailURLGT(v string) predicate.Restaurant {
+	return predicate.Restaurant(sql.FieldGT(FieldLogoThumbnailURL, v))
+}
+
+// LogoThumbnailURLGTE applies the GTE predicate on the "logo_thumbnail_url" field.
+func LogoThumbnailURLGTE(v string) predicate.Restaurant {
+	return predicate.Restaurant(sql.FieldGTE(FieldLogoThumbnailURL, v))
+}
+
+// LogoThumbnailURLLT applies the LT predicate on the "logo_thumbnail_url" field.
+func LogoThumbnailURLLT(v string) predicate.Restaurant {
+	return predicate.Restaurant(sql.FieldLT(FieldLogoThumbnailURL, v))
+}
+
+// LogoThumbnailURLLTE applies the LTE predicate on the "logo_thumbnail_url" field.
+func LogoThumbnailURLLTE(v string) predicate.Restaurant {
+	return predicate.Restaurant(sql.FieldLTE(FieldLogoThumbnailURL, v))
+}
+
+// LogoThumbnailURLContains applies the Contains predicate on the "logo_thumbnail_url" field.
+func LogoThumbnailURLContains(v string) predicate.Restaurant {
+	return predicate.Restaurant(sql.FieldContains(FieldLogoThumbnailURL, v))
+}
+
+// LogoThumbnailURLHasPrefix applies the HasPrefix predicate on the "logo_thumbnail_url" field.
+func LogoThumbnailURLHasPrefix(v string) predicate.Restaurant {
+	return predicate.Restaurant(sql.FieldHasPrefix(FieldLogoThumbnailURL, v))
+}
+
+// LogoThumbnailURLHasSuffix applies the HasSuffix predicate on the "logo_thumbnail_url" field.
+func LogoThumbnailURLHasSuffix(v string) predicate.Restaurant {
+	return predicate.Restaurant(sql.FieldHasSuffix(FieldLogoThumbnailURL, v))
+}
+
+// LogoThumbnailURLIsNil applies the IsNil predicate on the "logo_thumbnail_url" field.
+func LogoThumbnailURLIsNil() predicate.Restaurant {
+	return predicate.Restaurant(sql.FieldIsNull(FieldLogoThumbnailURL))
+}
+
+// LogoThumbnailURLNotNil applies the NotNil predicate on the "logo_thumbnail_url" field.
+func LogoThumbnailURLNotNil() predicate.Restaurant {
+	return predicate.Restaurant(sql.FieldNotNull(FieldLogoThumbnailURL))
+}
+
+// LogoThumbnailURLEqualFold applies the EqualFold predicate on the "logo_thumbnail_url" field.
+func LogoThumbnailURLEqualFold(v string) predicate.Restaurant {
+	return predicate.Restaurant(sql.FieldEqualFold(FieldLogoThumbnailURL, v))
+}
+
+// LogoThumbnailURLContainsFold applies the ContainsFold predicate on the "logo_thumbnail_url" field.
+func LogoThumbnailURLContainsFold(v string) predicate.Restaurant {
+	return predicate.Restaurant(sql.FieldContainsFold(FieldLogoThumbnailURL, v))
+}
+
+// LogoKeysIsNil applies the IsNil predicate on the "logo_keys" field.
+func LogoKeysIsNil() predicate.Restaurant {
+	return predicate.Restaurant(sql.FieldIsNull(FieldLogoKeys))
+}
+
+// LogoKeysNotNil applies the NotNil predicate on the "logo_keys" field.
+func LogoKeysNotNil() predicate.Restaurant {
+	return predicate.Restaurant(sql.FieldNotNull(FieldLogoKeys))
+}
+
 // CoverImageURLEQ applies the EQ predicate on the "cover_image_url" field.
 func CoverImageURLEQ(v string) predicate.Restaurant {
 	return predicate.Restaurant(sql.FieldEQ(FieldCoverImageURL, v))
@@ -1006,6 +1236,291 @@ func CurrencyContainsFold(v string) predicate.Restaurant {
 	return predicate.Restaurant(sql.FieldContainsFold(FieldCurrency, v))
 }
 
+// TaxRateBpsEQ applies the EQ predicate on the "tax_rate_bps" field.
+func TaxRateBpsEQ(v int) predicate.Restaurant {
+	return predicate.Restaurant(sql.FieldEQ(FieldTaxRateBps, v))
+}
+
+// TaxRateBpsNEQ applies the NEQ predicate on the "tax_rate_bps" field.
+func TaxRateBpsNEQ(v int) predicate.Restaurant {
+	return predicate.Restaurant(sql.FieldNEQ(FieldTaxRateBps, v))
+}
+
+// TaxRateBpsIn applies the In predicate on the "tax_rate_bps" field.
+func TaxRateBpsIn(vs ...int) predicate.Restaurant {
+	return predicate.Restaurant(sql.FieldIn(FieldTaxRateBps, vs...))
+}
+
+// TaxRateBpsNotIn applies the NotIn predicate on the "tax_rate_bps" field.
+func TaxRateBpsNotIn(vs ...int) predicate.Restaurant {
+	return predicate.Restaurant(sql.FieldNotIn(FieldTaxRateBps, vs...))
+}
+
+// TaxRateBpsGT applies the GT predicate on the "tax_rate_bps" field.
+func TaxRateBpsGT(v int) predicate.Restaurant {
+	return predicate.Restaurant(sql.FieldGT(FieldTaxRateBps, v))
+}
+
+// TaxRateBpsGTE applies the GTE predicate on the "tax_rate_bps" field.
+func TaxRateBpsGTE(v int) predicate.Restaurant {
+	return predicate.Restaurant(sql.FieldGTE(FieldTaxRateBps, v))
+}
+
+// TaxRateBpsLT applies the LT predicate on the "tax_rate_bps" field.
+func TaxRateBpsLT(v int) predicate.Restaurant {
+	return predicate.Restaurant(sql.FieldLT(FieldTaxRateBps, v))
+}
+
+// TaxRateBpsLTE applies the LTE predicate on the "tax_rate_bps" field.
+func TaxRateBpsLTE(v int) predicate.Restaurant {
+	return predicate.Restaurant(sql.FieldLTE(FieldTaxRateBps, v))
+}
+
+// TimezoneEQ applies the EQ predicate on the "timezone" field.
+func TimezoneEQ(v string) predicate.Restaurant {
+	return predicate.Restaurant(sql.FieldEQ(FieldTimezone, v))
+}
+
+// TimezoneNEQ applies the NEQ predicate on the "timezone" field.
+func TimezoneNEQ(v string) predicate.Restaurant {
+	return predicate.Restaurant(sql.FieldNEQ(FieldTimezone, v))
+}
+
+// TimezoneIn applies the In predicate on the "timezone" field.
+func TimezoneIn(vs ...string) predicate.Restaurant {
+	return predicate.Restaurant(sql.FieldIn(FieldTimezone, vs...))
+}
+
+// TimezoneNotIn applies the NotIn predicate on the "timezone" field.
+func TimezoneNotIn(vs ...string) predicate.Restaurant {
+	return predicate.Restaurant(sql.FieldNotIn(FieldTimezone, vs...))
+}
+
+// TimezoneGT applies the GT predicate on the "timezone" field.
+func TimezoneGT(v string) predicate.Restaurant {
+	return predicate.Restaurant(sql.FieldGT(FieldTimezone, v))
+}
+
+// TimezoneGTE applies the GTE predicate on the "timezone" field.
+func TimezoneGTE(v string) predicate.Restaurant {
+	return predicate.Restaurant(sql.FieldGTE(FieldTimezone, v))
+}
+
+// TimezoneLT applies the LT predicate on the "timezone" field.
+func TimezoneLT(v string) predicate.Restaurant {
+	return predicate.Restaurant(sql.FieldLT(FieldTimezone, v))
+}
+
+// TimezoneLTE applies the LTE predicate on the "timezone" field.
+func TimezoneLTE(v string) predicate.Restaurant {
+	return predicate.Restaurant(sql.FieldLTE(FieldTimezone, v))
+}
+
+// TimezoneContains applies the Contains predicate on the "timezone" field.
+func TimezoneContains(v string) predicate.Restaurant {
+	return predicate.Restaurant(sql.FieldContains(FieldTimezone, v))
+}
+
+// TimezoneHasPrefix applies the HasPrefix predicate on the "timezone" field.
+func TimezoneHasPrefix(v string) predicate.Restaurant {
+	return predicate.Restaurant(sql.FieldHasPrefix(FieldTimezone, v))
+}
+
+// TimezoneHasSuffix applies the HasSuffix predicate on the "timezone" field.
+func TimezoneHasSuffix(v string) predicate.Restaurant {
+	return predicate.Restaurant(sql.FieldHasSuffix(FieldTimezone, v))
+}
+
+// TimezoneEqualFold applies the EqualFold predicate on the "timezone" field.
+func TimezoneEqualFold(v string) predicate.Restaurant {
+	return predicate.Restaurant(sql.FieldEqualFold(FieldTimezone, v))
+}
+
+// TimezoneContainsFold applies the ContainsFold predicate on the "timezone" field.
+func TimezoneContainsFold(v string) predicate.Restaurant {
+	return predicate.Restaurant(sql.FieldContainsFold(FieldTimezone, v))
+}
+
+// SlotMinutesEQ applies the EQ predicate on the "slot_minutes" field.
+func SlotMinutesEQ(v int) predicate.Restaurant {
+	return predicate.Restaurant(sql.FieldEQ(FieldSlotMinutes, v))
+}
+
+// SlotMinutesNEQ applies the NEQ predicate on the "slot_minutes" field.
+func SlotMinutesNEQ(v int) predicate.Restaurant {
+	return predicate.Restaurant(sql.FieldNEQ(FieldSlotMinutes, v))
+}
+
+// SlotMinutesIn applies the In predicate on the "slot_minutes" field.
+func SlotMinutesIn(vs ...int) predicate.Restaurant {
+	return predicate.Restaurant(sql.FieldIn(FieldSlotMinutes, vs...))
+}
+
+// SlotMinutesNotIn applies the NotIn predicate on the "slot_minutes" field.
+func SlotMinutesNotIn(vs ...int) predicate.Restaurant {
+	return predicate.Restaurant(sql.FieldNotIn(FieldSlotMinutes, vs...))
+}
+
+// SlotMinutesGT applies the GT predicate on the "slot_minutes" field.
+func SlotMinutesGT(v int) predicate.Restaurant {
+	return predicate.Restaurant(sql.FieldGT(FieldSlotMinutes, v))
+}
+
+// SlotMinutesGTE applies the GTE predicate on the "slot_minutes" field.
+func SlotMinutesGTE(v int) predicate.Restaurant {
+	return predicate.Restaurant(sql.FieldGTE(FieldSlotMinutes, v))
+}
+
+// SlotMinutesLT applies the LT predicate on the "slot_minutes" field.
+func SlotMinutesLT(v int) predicate.Restaurant {
+	return predicate.Restaurant(sql.FieldLT(FieldSlotMinutes, v))
+}
+
+// SlotMinutesLTE applies the LTE predicate on the "slot_minutes" field.
+func SlotMinutesLTE(v int) predicate.Restaurant {
+	return predicate.Restaurant(sql.FieldLTE(FieldSlotMinutes, v))
+}
+
+// SlotCapacityEQ applies the EQ predicate on the "slot_capacity" field.
+func SlotCapacityEQ(v int) predicate.Restaurant {
+	return predicate.Restaurant(sql.FieldEQ(FieldSlotCapacity, v))
+}
+
+// SlotCapacityNEQ applies the NEQ predicate on the "slot_capacity" field.
+func SlotCapacityNEQ(v int) predicate.Restaurant {
+	return predicate.Restaurant(sql.FieldNEQ(FieldSlotCapacity, v))
+}
+
+// SlotCapacityIn applies the In predicate on the "slot_capacity" field.
+func SlotCapacityIn(vs ...int) predicate.Restaurant {
+	return predicate.Restaurant(sql.FieldIn(FieldSlotCapacity, vs...))
+}
+
+// SlotCapacityNotIn applies the NotIn predicate on the "slot_capacity" field.
+func SlotCapacityNotIn(vs ...int) predicate.Restaurant {
+	return predicate.Restaurant(sql.FieldNotIn(FieldSlotCapacity, vs...))
+}
+
+// SlotCapacityGT applies the GT predicate on the "slot_capacity" field.
+func SlotCapacityGT(v int) predicate.Restaurant {
+	return predicate.Restaurant(sql.FieldGT(FieldSlotCapacity, v))
+}
+
+// SlotCapacityGTE applies the GTE predicate on the "slot_capacity" field.
+func SlotCapacityGTE(v int) predicate.Restaurant {
+	return predicate.Restaurant(sql.FieldGTE(FieldSlotCapacity, v))
+}
+
+// SlotCapacityLT applies the LT predicate on the "slot_capacity" field.
+func SlotCapacityLT(v int) predicate.Restaurant {
+	return predicate.Restaurant(sql.FieldLT(FieldSlotCapacity, v))
+}
+
+// SlotCapacityLTE applies the LTE predicate on the "slot_capacity" field.
+func SlotCapacityLTE(v int) predicate.Restaurant {
+	return predicate.Restaurant(sql.FieldLTE(FieldSlotCapacity, v))
+}
+
+// KitchenLeadMinutesEQ applies the EQ predicate on the "kitchen_lead_minutes" field.
+func KitchenLeadMinutesEQ(v int) predicate.Restaurant {
+	return predicate.Restaurant(sql.FieldEQ(FieldKitchenLeadMinutes, v))
+}
+
+// KitchenLeadMinutesNEQ applies the NEQ predicate on the "kitchen_lead_minutes" field.
+func KitchenLeadMinutesNEQ(v int) predicate.Restaurant {
+	return predicate.Restaurant(sql.FieldNEQ(FieldKitchenLeadMinutes, v))
+}
+
+// KitchenLeadMinutesIn applies the In predicate on the "kitchen_lead_minutes" field.
+func KitchenLeadMinutesIn(vs ...int) predicate.Restaurant {
+	return predicate.Restaurant(sql.FieldIn(FieldKitchenLeadMinutes, vs...))
+}
+
+// KitchenLeadMinutesNotIn applies the NotIn predicate on the "kitchen_lead_minutes" field.
+func KitchenLeadMinutesNotIn(vs ...int) predicate.Restaurant {
+	return predicate.Restaurant(sql.FieldNotIn(FieldKitchenLeadMinutes, vs...))
+}
+
+// KitchenLeadMinutesGT applies the GT predicate on the "kitchen_lead_minutes" field.
+func KitchenLeadMinutesGT(v int) predicate.Restaurant {
+	return predicate.Restaurant(sql.FieldGT(FieldKitchenLeadMinutes, v))
+}
+
+// KitchenLeadMinutesGTE applies the GTE predicate on the "kitchen_lead_minutes" field.
+func KitchenLeadMinutesGTE(v int) predicate.Restaurant {
+	return predicate.Restaurant(sql.FieldGTE(FieldKitchenLeadMinutes, v))
+}
+
+// KitchenLeadMinutesLT applies the LT predicate on the "kitchen_lead_minutes" field.
+func KitchenLeadMinutesLT(v int) predicate.Restaurant {
+	return predicate.Restaurant(sql.FieldLT(FieldKitchenLeadMinutes, v))
+}
+
+// KitchenLeadMinutesLTE applies the LTE predicate on the "kitchen_lead_minutes" field.
+func KitchenLeadMinutesLTE(v int) predicate.Restaurant {
+	return predicate.Restaurant(sql.FieldLTE(FieldKitchenLeadMinutes, v))
+}
+
+// OrderNumberResetEQ applies the EQ predicate on the "order_number_reset" field.
+func OrderNumberResetEQ(v OrderNumberReset) predicate.Restaurant {
+	return predicate.Restaurant(sql.FieldEQ(FieldOrderNumberReset, v))
+}
+
+// OrderNumberResetNEQ applies the NEQ predicate on the "order_number_reset" field.
+func OrderNumberResetNEQ(v OrderNumberReset) predicate.Restaurant {
+	return predicate.Restaurant(sql.FieldNEQ(FieldOrderNumberReset, v))
+}
+
+// OrderNumberResetIn applies the In predicate on the "order_number_reset" field.
+func OrderNumberResetIn(vs ...OrderNumberReset) predicate.Restaurant {
+	return predicate.Restaurant(sql.FieldIn(FieldOrderNumberReset, vs...))
+}
+
+// OrderNumberResetNotIn applies the NotIn predicate on the "order_number_reset" field.
+func OrderNumberResetNotIn(vs ...OrderNumberReset) predicate.Restaurant {
+	return predicate.Restaurant(sql.FieldNotIn(FieldOrderNumberReset, vs...))
+}
+
+// OrderEventSeqEQ applies the EQ predicate on the "order_event_seq" field.
+func OrderEventSeqEQ(v int64) predicate.Restaurant {
+	return predicate.Restaurant(sql.FieldEQ(FieldOrderEventSeq, v))
+}
+
+// OrderEventSeqNEQ applies the NEQ predicate on the "order_event_seq" field.
+func OrderEventSeqNEQ(v int64) predicate.Restaurant {
+	return predicate.Restaurant(sql.FieldNEQ(FieldOrderEventSeq, v))
+}
+
+// OrderEventSeqIn applies the In predicate on the "order_event_seq" field.
+func OrderEventSeqIn(vs ...int64) predicate.Restaurant {
+	return predicate.Restaurant(sql.FieldIn(FieldOrderEventSeq, vs...))
+}
+
+// OrderEventSeqNotIn applies the NotIn predicate on the "order_event_seq" field.
+func OrderEventSeqNotIn(vs ...int64) predicate.Restaurant {
+	return predicate.Restaurant(sql.FieldNotIn(FieldOrderEventSeq, vs...))
+}
+
+// OrderEventSeqGT applies the GT predicate on the "order_event_seq" field.
+func OrderEventSeqGT(v int64) predicate.Restaurant {
+	return predicate.Restaurant(sql.FieldGT(FieldOrderEventSeq, v))
+}
+
+// OrderEventSeqGTE applies the GTE predicate on the "order_event_seq" field.
+func OrderEventSeqGTE(v int64) predicate.Restaurant {
+	return predicate.Restaurant(sql.FieldGTE(FieldOrderEventSeq, v))
+}
+
+// OrderEventSeqLT applies the LT predicate on the "order_event_seq" field.
+func OrderEventSeqLT(v int64) predicate.Restaurant {
+	return predicate.Restaurant(sql.FieldLT(FieldOrderEventSeq, v))
+}
+
+// OrderEventSeqLTE applies the LTE predicate on the "order_event_seq" field.
+func OrderEventSeqLTE(v int64) predicate.Restaurant {
+	return predicate.Restaurant(sql.FieldLTE(FieldOrderEventSeq, v))
+}
+
 // UserIDEQ applies the EQ predicate on the "user_id" field.
 func UserIDEQ(v uuid.UUID) predicate.Restaurant {
 	return predicate.Restaurant(sql.FieldEQ(FieldUserID, v))
@@ -1141,6 +1656,282 @@ func HasOrdersWith(preds ...predicate.Order) predicate.Restaurant {
 	})
 }
 
+// HasOrderStatusEvents applies the HasEdge predicate on the "order_status_events" edge.
+func HasOrderStatusEvents() predicate.Restaurant {
+	return predicate.Restaurant(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, OrderStatusEventsTable, OrderStatusEventsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasOrderStatusEventsWith applies the HasEdge predicate on the "order_status_events" edge with a given conditions (other predicates).
+func HasOrderStatusEventsWith(preds ...predicate.OrderStatusEvent) predicate.Restaurant {
+	return predicate.Restaurant(func(s *sql.Selector) {
+		step := newOrderStatusEventsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasPayments applies the HasEdge predicate on the "payments" edge.
+func HasPayments() predicate.Restaurant {
+	return predicate.Restaurant(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, PaymentsTable, PaymentsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasPaymentsWith applies the HasEdge predicate on the "payments" edge with a given conditions (other predicates).
+func HasPaymentsWith(preds ...predicate.Payment) predicate.Restaurant {
+	return predicate.Restaurant(func(s *sql.Selector) {
+		step := newPaymentsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasRefunds applies the HasEdge predicate on the "refunds" edge.
+func HasRefunds() predicate.Restaurant {
+	return predicate.Restaurant(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, RefundsTable, RefundsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasRefundsWith applies the HasEdge predicate on the "refunds" edge with a given conditions (other predicates).
+func HasRefundsWith(preds ...predicate.Refund) predicate.Restaurant {
+	return predicate.Restaurant(func(s *sql.Selector) {
+		step := newRefundsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasOrderNumberSequences applies the HasEdge predicate on the "order_number_sequences" edge.
+func HasOrderNumberSequences() predicate.Restaurant {
+	return predicate.Restaurant(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, OrderNumberSequencesTable, OrderNumberSequencesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasOrderNumberSequencesWith applies the HasEdge predicate on the "order_number_sequences" edge with a given conditions (other predicates).
+func HasOrderNumberSequencesWith(preds ...predicate.OrderNumberSequence) predicate.Restaurant {
+	return predicate.Restaurant(func(s *sql.Selector) {
+		step := newOrderNumberSequencesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasOrderEvents applies the HasEdge predicate on the "order_events" edge.
+func HasOrderEvents() predicate.Restaurant {
+	return predicate.Restaurant(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, OrderEventsTable, OrderEventsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasOrderEventsWith applies the HasEdge predicate on the "order_events" edge with a given conditions (other predicates).
+func HasOrderEventsWith(preds ...predicate.OrderEvent) predicate.Restaurant {
+	return predicate.Restaurant(func(s *sql.Selector) {
+		step := newOrderEventsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasStations applies the HasEdge predicate on the "stations" edge.
+func HasStations() predicate.Restaurant {
+	return predicate.Restaurant(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, StationsTable, StationsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasStationsWith applies the HasEdge predicate on the "stations" edge with a given conditions (other predicates).
+func HasStationsWith(preds ...predicate.Station) predicate.Restaurant {
+	return predicate.Restaurant(func(s *sql.Selector) {
+		step := newStationsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasStationTickets applies the HasEdge predicate on the "station_tickets" edge.
+func HasStationTickets() predicate.Restaurant {
+	return predicate.Restaurant(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, StationTicketsTable, StationTicketsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasStationTicketsWith applies the HasEdge predicate on the "station_tickets" edge with a given conditions (other predicates).
+func HasStationTicketsWith(preds ...predicate.StationTicket) predicate.Restaurant {
+	return predicate.Restaurant(func(s *sql.Selector) {
+		step := newStationTicketsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasTables applies the HasEdge predicate on the "tables" edge.
+func HasTables() predicate.Restaurant {
+	return predicate.Restaurant(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, TablesTable, TablesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasTablesWith applies the HasEdge predicate on the "tables" edge with a given conditions (other predicates).
+func HasTablesWith(preds ...predicate.Table) predicate.Restaurant {
+	return predicate.Restaurant(func(s *sql.Selector) {
+		step := newTablesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasTableSessions applies the HasEdge predicate on the "table_sessions" edge.
+func HasTableSessions() predicate.Restaurant {
+	return predicate.Restaurant(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, TableSessionsTable, TableSessionsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasTableSessionsWith applies the HasEdge predicate on the "table_sessions" edge with a given conditions (other predicates).
+func HasTableSessionsWith(preds ...predicate.TableSession) predicate.Restaurant {
+	return predicate.Restaurant(func(s *sql.Selector) {
+		step := newTableSessionsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasDeliveryZones applies the HasEdge predicate on the "delivery_zones" edge.
+func HasDeliveryZones() predicate.Restaurant {
+	return predicate.Restaurant(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, DeliveryZonesTable, DeliveryZonesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasDeliveryZonesWith applies the HasEdge predicate on the "delivery_zones" edge with a given conditions (other predicates).
+func HasDeliveryZonesWith(preds ...predicate.DeliveryZone) predicate.Restaurant {
+	return predicate.Restaurant(func(s *sql.Selector) {
+		step := newDeliveryZonesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasIngredients applies the HasEdge predicate on the "ingredients" edge.
+func HasIngredients() predicate.Restaurant {
+	return predicate.Restaurant(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, IngredientsTable, IngredientsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasIngredientsWith applies the HasEdge predicate on the "ingredients" edge with a given conditions (other predicates).
+func HasIngredientsWith(preds ...predicate.Ingredient) predicate.Restaurant {
+	return predicate.Restaurant(func(s *sql.Selector) {
+		step := newIngredientsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasMenus applies the HasEdge predicate on the "menus" edge.
+func HasMenus() predicate.Restaurant {
+	return predicate.Restaurant(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, MenusTable, MenusColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasMenusWith applies the HasEdge predicate on the "menus" edge with a given conditions (other predicates).
+func HasMenusWith(preds ...predicate.Menu) predicate.Restaurant {
+	return predicate.Restaurant(func(s *sql.Selector) {
+		step := newMenusStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Restaurant) predicate.Restaurant {
 	return predicate.Restaurant(sql.AndPredicates(predicates...))
@@ -11,11 +11,24 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/Jiruu246/rms/internal/ent/category"
+	"github.com/Jiruu246/rms/internal/ent/deliveryzone"
+	"github.com/Jiruu246/rms/internal/ent/ingredient"
+	"github.com/Jiruu246/rms/internal/ent/menu"
 	"github.com/Jiruu246/rms/internal/ent/menuitem"
 	"github.com/Jiruu246/rms/internal/ent/modifier"
 	"github.com/Jiruu246/rms/internal/ent/order"
+	"github.com/Jiruu246/rms/internal/ent/orderevent"
+	"github.com/Jiruu246/rms/internal/ent/ordernumbersequence"
+	"github.com/Jiruu246/rms/internal/ent/orderstatusevent"
+	"github.com/Jiruu246/rms/internal/ent/payment"
+	"github.com/Jiruu246/rms/internal/ent/refund"
 	"github.com/Jiruu246/rms/internal/ent/restaurant"
+	"github.com/Jiruu246/rms/internal/ent/station"
+	"github.com/Jiruu246/rms/internal/ent/stationticket"
+	"github.com/Jiruu246/rms/internal/ent/table"
+	"github.com/Jiruu246/rms/internal/ent/tablesession"
 	"github.com/Jiruu246/rms/internal/ent/user"
+	"github.com/Jiruu246/rms/pkg/hours"
 	"github.com/google/uuid"
 )
 
@@ -102,6 +115,34 @@ func (_c *RestaurantCreate) SetCountry(v string) *RestaurantCreate {
 	return _c
 }
 
+// SetLatitude sets the "latitude" field.
+func (_c *RestaurantCreate) SetLatitude(v float64) *RestaurantCreate {
+	_c.mutation.SetLatitude(v)
+	return _c
+}
+
+// SetNillableLatitude sets the "latitude" field if the given value is not nil.
+func (_c *RestaurantCreate) SetNillableLatitude(v *float64) *RestaurantCreate {
+	if v != nil {
+		_c.SetLatitude(*v)
+	}
+	return _c
+}
+
+// SetLongitude sets the "longitude" field.
+func (_c *RestaurantCreate) SetLongitude(v float64) *RestaurantCreate {
+	_c.mutation.SetLongitude(v)
+	return _c
+}
+
+// SetNillableLongitude sets the "longitude" field if the given value is not nil.
+func (_c *RestaurantCreate) SetNillableLongitude(v *float64) *RestaurantCreate {
+	if v != nil {
+		_c.SetLongitude(*v)
+	}
+	return _c
+}
+
 // SetLogoURL sets the "logo_url" field.
 func (_c *RestaurantCreate) SetLogoURL(v string) *RestaurantCreate {
 	_c.mutation.SetLogoURL(v)
@@ -116,6 +157,26 @@ func (_c *RestaurantCreate) SetNillableLogoURL(v *string) *RestaurantCreate {
 	return _c
 }
 
+// SetLogoThumbnailURL sets the "logo_thumbnail_url" field.
+func (_c *RestaurantCreate) SetLogoThumbnailURL(v string) *RestaurantCreate {
+	_c.mutation.SetLogoThumbnailURL(v)
+	return _c
+}
+
+// SetNillableLogoThumbnailURL sets the "logo_thumbnail_url" field if the given value is not nil.
+func (_c *RestaurantCreate) SetNillableLogoThumbnailURL(v *string) *RestaurantCreate {
+	if v != nil {
+		_c.SetLogoThumbnailURL(*v)
+	}
+	return _c
+}
+
+// SetLogoKeys sets the "logo_keys" field.
+func (_c *RestaurantCreate) SetLogoKeys(v []string) *RestaurantCreate {
+	_c.mutation.SetLogoKeys(v)
+	return _c
+}
+
 // SetCoverImageURL sets the "cover_image_url" field.
 func (_c *RestaurantCreate) SetCoverImageURL(v string) *RestaurantCreate {
 	_c.mutation.SetCoverImageURL(v)
@@ -145,7 +206,7 @@ func (_c *RestaurantCreate) SetNillableStatus(v *restaurant.Status) *RestaurantC
 }
 
 // SetOperatingHours sets the "operating_hours" field.
-func (_c *RestaurantCreate) SetOperatingHours(v map[string]interface{}) *RestaurantCreate {
+func (_c *RestaurantCreate) SetOperatingHours(v *hours.Schedule) *RestaurantCreate {
 	_c.mutation.SetOperatingHours(v)
 	return _c
 }
@@ -156,6 +217,104 @@ func (_c *RestaurantCreate) SetCurrency(v string) *RestaurantCreate {
 	return _c
 }
 
+// SetTaxRateBps sets the "tax_rate_bps" field.
+func (_c *RestaurantCreate) SetTaxRateBps(v int) *RestaurantCreate {
+	_c.mutation.SetTaxRateBps(v)
+	return _c
+}
+
+// SetNillableTaxRateBps sets the "tax_rate_bps" field if the given value is not nil.
+func (_c *RestaurantCreate) SetNillableTaxRateBps(v *int) *RestaurantCreate {
+	if v != nil {
+		_c.SetTaxRateBps(*v)
+	}
+	return _c
+}
+
+// SetTimezone sets the "timezone" field.
+func (_c *RestaurantCreate) SetTimezone(v string) *RestaurantCreate {
+	_c.mutation.SetTimezone(v)
+	return _c
+}
+
+// SetNillableTimezone sets the "timezone" field if the given value is not nil.
+func (_c *RestaurantCreate) SetNillableTimezone(v *string) *RestaurantCreate {
+	if v != nil {
+		_c.SetTimezone(*v)
+	}
+	return _c
+}
+
+// SetSlotMinutes sets the "slot_minutes" field.
+func (_c *RestaurantCreate) SetSlotMinutes(v int) *RestaurantCreate {
+	_c.mutation.SetSlotMinutes(v)
+	return _c
+}
+
+// SetNillableSlotMinutes sets the "slot_minutes" field if the given value is not nil.
+func (_c *RestaurantCreate) SetNillableSlotMinutes(v *int) *RestaurantCreate {
+	if v != nil {
+		_c.SetSlotMinutes(*v)
+	}
+	return _c
+}
+
+// SetSlotCapacity sets the "slot_capacity" field.
+func (_c *RestaurantCreate) SetSlotCapacity(v int) *RestaurantCreate {
+	_c.mutation.SetSlotCapacity(v)
+	return _c
+}
+
+// SetNillableSlotCapacity sets the "slot_capacity" field if the given value is not nil.
+func (_c *RestaurantCreate) SetNillableSlotCapacity(v *int) *RestaurantCreate {
+	if v != nil {
+		_c.SetSlotCapacity(*v)
+	}
+	return _c
+}
+
+// SetKitchenLeadMinutes sets the "kitchen_lead_minutes" field.
+func (_c *RestaurantCreate) SetKitchenLeadMinutes(v int) *RestaurantCreate {
+	_c.mutation.SetKitchenLeadMinutes(v)
+	return _c
+}
+
+// SetNillableKitchenLeadMinutes sets the "kitchen_lead_minutes" field if the given value is not nil.
+func (_c *RestaurantCreate) SetNillableKitchenLeadMinutes(v *int) *RestaurantCreate {
+	if v != nil {
+		_c.SetKitchenLeadMinutes(*v)
+	}
+	return _c
+}
+
+// SetOrderNumberReset sets the "order_number_reset" field.
+func (_c *RestaurantCreate) SetOrderNumberReset(v restaurant.OrderNumberReset) *RestaurantCreate {
+	_c.mutation.SetOrderNumberReset(v)
+	return _c
+}
+
+// SetNillableOrderNumberReset sets the "order_number_reset" field if the given value is not nil.
+func (_c *RestaurantCreate) SetNillableOrderNumberReset(v *restaurant.OrderNumberReset) *RestaurantCreate {
+	if v != nil {
+		_c.SetOrderNumberReset(*v)
+	}
+	return _c
+}
+
+// SetOrderEventSeq sets the "order_event_seq" field.
+func (_c *RestaurantCreate) SetOrderEventSeq(v int64) *RestaurantCreate {
+	_c.mutation.SetOrderEventSeq(v)
+	return _c
+}
+
+// SetNillableOrderEventSeq sets the "order_event_seq" field if the given value is not nil.
+func (_c *RestaurantCreate) SetNillableOrderEventSeq(v *int64) *RestaurantCreate {
+	if v != nil {
+		_c.SetOrderEventSeq(*v)
+	}
+	return _c
+}
+
 // SetUserID sets the "user_id" field.
 func (_c *RestaurantCreate) SetUserID(v uuid.UUID) *RestaurantCreate {
 	_c.mutation.SetUserID(v)
@@ -241,6 +400,186 @@ func (_c *RestaurantCreate) AddOrders(v ...*Order) *RestaurantCreate {
 	return _c.AddOrderIDs(ids...)
 }
 
+// AddOrderStatusEventIDs adds the "order_status_events" edge to the OrderStatusEvent entity by IDs.
+func (_c *RestaurantCreate) AddOrderStatusEventIDs(ids ...uuid.UUID) *RestaurantCreate {
+	_c.mutation.AddOrderStatusEventIDs(ids...)
+	return _c
+}
+
+// AddOrderStatusEvents adds the "order_status_events" edges to the OrderStatusEvent entity.
+func (_c *RestaurantCreate) AddOrderStatusEvents(v ...*OrderStatusEvent) *RestaurantCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddOrderStatusEventIDs(ids...)
+}
+
+// AddPaymentIDs adds the "payments" edge to the Payment entity by IDs.
+func (_c *RestaurantCreate) AddPaymentIDs(ids ...uuid.UUID) *RestaurantCreate {
+	_c.mutation.AddPaymentIDs(ids...)
+	return _c
+}
+
+// AddPayments adds the "payments" edges to the Payment entity.
+func (_c *RestaurantCreate) AddPayments(v ...*Payment) *RestaurantCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddPaymentIDs(ids...)
+}
+
+// AddRefundIDs adds the "refunds" edge to the Refund entity by IDs.
+func (_c *RestaurantCreate) AddRefundIDs(ids ...uuid.UUID) *RestaurantCreate {
+	_c.mutation.AddRefundIDs(ids...)
+	return _c
+}
+
+// AddRefunds adds the "refunds" edges to the Refund entity.
+func (_c *RestaurantCreate) AddRefunds(v ...*Refund) *RestaurantCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddRefundIDs(ids...)
+}
+
+// AddOrderNumberSequenceIDs adds the "order_number_sequences" edge to the OrderNumberSequence entity by IDs.
+func (_c *RestaurantCreate) AddOrderNumberSequenceIDs(ids ...uuid.UUID) *RestaurantCreate {
+	_c.mutation.AddOrderNumberSequenceIDs(ids...)
+	return _c
+}
+
+// AddOrderNumberSequences adds the "order_number_sequences" edges to the OrderNumberSequence entity.
+func (_c *RestaurantCreate) AddOrderNumberSequences(v ...*OrderNumberSequence) *RestaurantCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddOrderNumberSequenceIDs(ids...)
+}
+
+// AddOrderEventIDs adds the "order_events" edge to the OrderEvent entity by IDs.
+func (_c *RestaurantCreate) AddOrderEventIDs(ids ...uuid.UUID) *RestaurantCreate {
+	_c.mutation.AddOrderEventIDs(ids...)
+	return _c
+}
+
+// AddOrderEvents adds the "order_events" edges to the OrderEvent entity.
+func (_c *RestaurantCreate) AddOrderEvents(v ...*OrderEvent) *RestaurantCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddOrderEventIDs(ids...)
+}
+
+// AddStationIDs adds the "stations" edge to the Station entity by IDs.
+func (_c *RestaurantCreate) AddStationIDs(ids ...uuid.UUID) *RestaurantCreate {
+	_c.mutation.AddStationIDs(ids...)
+	return _c
+}
+
+// AddStations adds the "stations" edges to the Station entity.
+func (_c *RestaurantCreate) AddStations(v ...*Station) *RestaurantCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddStationIDs(ids...)
+}
+
+// AddStationTicketIDs adds the "station_tickets" edge to the StationTicket entity by IDs.
+func (_c *RestaurantCreate) AddStationTicketIDs(ids ...uuid.UUID) *RestaurantCreate {
+	_c.mutation.AddStationTicketIDs(ids...)
+	return _c
+}
+
+// AddStationTickets adds the "station_tickets" edges to the StationTicket entity.
+func (_c *RestaurantCreate) AddStationTickets(v ...*StationTicket) *RestaurantCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddStationTicketIDs(ids...)
+}
+
+// AddTableIDs adds the "tables" edge to the Table entity by IDs.
+func (_c *RestaurantCreate) AddTableIDs(ids ...uuid.UUID) *RestaurantCreate {
+	_c.mutation.AddTableIDs(ids...)
+	return _c
+}
+
+// AddTables adds the "tables" edges to the Table entity.
+func (_c *RestaurantCreate) AddTables(v ...*Table) *RestaurantCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddTableIDs(ids...)
+}
+
+// AddTableSessionIDs adds the "table_sessions" edge to the TableSession entity by IDs.
+func (_c *RestaurantCreate) AddTableSessionIDs(ids ...uuid.UUID) *RestaurantCreate {
+	_c.mutation.AddTableSessionIDs(ids...)
+	return _c
+}
+
+// AddTableSessions adds the "table_sessions" edges to the TableSession entity.
+func (_c *RestaurantCreate) AddTableSessions(v ...*TableSession) *RestaurantCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddTableSessionIDs(ids...)
+}
+
+// AddDeliveryZoneIDs adds the "delivery_zones" edge to the DeliveryZone entity by IDs.
+func (_c *RestaurantCreate) AddDeliveryZoneIDs(ids ...uuid.UUID) *RestaurantCreate {
+	_c.mutation.AddDeliveryZoneIDs(ids...)
+	return _c
+}
+
+// AddDeliveryZones adds the "delivery_zones" edges to the DeliveryZone entity.
+func (_c *RestaurantCreate) AddDeliveryZones(v ...*DeliveryZone) *RestaurantCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddDeliveryZoneIDs(ids...)
+}
+
+// AddIngredientIDs adds the "ingredients" edge to the Ingredient entity by IDs.
+func (_c *RestaurantCreate) AddIngredientIDs(ids ...uuid.UUID) *RestaurantCreate {
+	_c.mutation.AddIngredientIDs(ids...)
+	return _c
+}
+
+// AddIngredients adds the "ingredients" edges to the Ingredient entity.
+func (_c *RestaurantCreate) AddIngredients(v ...*Ingredient) *RestaurantCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddIngredientIDs(ids...)
+}
+
+// AddMenuIDs adds the "menus" edge to the Menu entity by IDs.
+func (_c *RestaurantCreate) AddMenuIDs(ids ...uuid.UUID) *RestaurantCreate {
+	_c.mutation.AddMenuIDs(ids...)
+	return _c
+}
+
+// AddMenus adds the "menus" edges to the Menu entity.
+func (_c *RestaurantCreate) AddMenus(v ...*Menu) *RestaurantCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddMenuIDs(ids...)
+}
+
 // Mutation returns the RestaurantMutation object of the builder.
 func (_c *RestaurantCreate) Mutation() *RestaurantMutation {
 	return _c.mutation
@@ -284,6 +623,34 @@ func (_c *RestaurantCreate) defaults() {
 		v := restaurant.DefaultStatus
 		_c.mutation.SetStatus(v)
 	}
+	if _, ok := _c.mutation.TaxRateBps(); !ok {
+		v := restaurant.DefaultTaxRateBps
+		_c.mutation.SetTaxRateBps(v)
+	}
+	if _, ok := _c.mutation.Timezone(); !ok {
+		v := restaurant.DefaultTimezone
+		_c.mutation.SetTimezone(v)
+	}
+	if _, ok := _c.mutation.SlotMinutes(); !ok {
+		v := restaurant.DefaultSlotMinutes
+		_c.mutation.SetSlotMinutes(v)
+	}
+	if _, ok := _c.mutation.SlotCapacity(); !ok {
+		v := restaurant.DefaultSlotCapacity
+		_c.mutation.SetSlotCapacity(v)
+	}
+	if _, ok := _c.mutation.KitchenLeadMinutes(); !ok {
+		v := restaurant.DefaultKitchenLeadMinutes
+		_c.mutation.SetKitchenLeadMinutes(v)
+	}
+	if _, ok := _c.mutation.OrderNumberReset(); !ok {
+		v := restaurant.DefaultOrderNumberReset
+		_c.mutation.SetOrderNumberReset(v)
+	}
+	if _, ok := _c.mutation.OrderEventSeq(); !ok {
+		v := restaurant.DefaultOrderEventSeq
+		_c.mutation.SetOrderEventSeq(v)
+	}
 	if _, ok := _c.mutation.ID(); !ok {
 		v := restaurant.DefaultID()
 		_c.mutation.SetID(v)
@@ -367,9 +734,65 @@ func (_c *RestaurantCreate) check() error {
 			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Restaurant.status": %w`, err)}
 		}
 	}
+	if v, ok := _c.mutation.OperatingHours(); ok {
+		if err := v.Validate(); err != nil {
+			return &ValidationError{Name: "operating_hours", err: fmt.Errorf(`ent: validator failed for field "Restaurant.operating_hours": %w`, err)}
+		}
+	}
 	if _, ok := _c.mutation.Currency(); !ok {
 		return &ValidationError{Name: "currency", err: errors.New(`ent: missing required field "Restaurant.currency"`)}
 	}
+	if _, ok := _c.mutation.TaxRateBps(); !ok {
+		return &ValidationError{Name: "tax_rate_bps", err: errors.New(`ent: missing required field "Restaurant.tax_rate_bps"`)}
+	}
+	if v, ok := _c.mutation.TaxRateBps(); ok {
+		if err := restaurant.TaxRateBpsValidator(v); err != nil {
+			return &ValidationError{Name: "tax_rate_bps", err: fmt.Errorf(`ent: validator failed for field "Restaurant.tax_rate_bps": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Timezone(); !ok {
+		return &ValidationError{Name: "timezone", err: errors.New(`ent: missing required field "Restaurant.timezone"`)}
+	}
+	if _, ok := _c.mutation.SlotMinutes(); !ok {
+		return &ValidationError{Name: "slot_minutes", err: errors.New(`ent: missing required field "Restaurant.slot_minutes"`)}
+	}
+	if v, ok := _c.mutation.SlotMinutes(); ok {
+		if err := restaurant.SlotMinutesValidator(v); err != nil {
+			return &ValidationError{Name: "slot_minutes", err: fmt.Errorf(`ent: validator failed for field "Restaurant.slot_minutes": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.SlotCapacity(); !ok {
+		return &ValidationError{Name: "slot_capacity", err: errors.New(`ent: missing required field "Restaurant.slot_capacity"`)}
+	}
+	if v, ok := _c.mutation.SlotCapacity(); ok {
+		if err := restaurant.SlotCapacityValidator(v); err != nil {
+			return &ValidationError{Name: "slot_capacity", err: fmt.Errorf(`ent: validator failed for field "Restaurant.slot_capacity": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.KitchenLeadMinutes(); !ok {
+		return &ValidationError{Name: "kitchen_lead_minutes", err: errors.New(`ent: missing required field "Restaurant.kitchen_lead_minutes"`)}
+	}
+	if v, ok := _c.mutation.KitchenLeadMinutes(); ok {
+		if err := restaurant.KitchenLeadMinutesValidator(v); err != nil {
+			return &ValidationError{Name: "kitchen_lead_minutes", err: fmt.Errorf(`ent: validator failed for field "Restaurant.kitchen_lead_minutes": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.OrderNumberReset(); !ok {
+		return &ValidationError{Name: "order_number_reset", err: errors.New(`ent: missing required field "Restaurant.order_number_reset"`)}
+	}
+	if v, ok := _c.mutation.OrderNumberReset(); ok {
+		if err := restaurant.OrderNumberResetValidator(v); err != nil {
+			return &ValidationError{Name: "order_number_reset", err: fmt.Errorf(`ent: validator failed for field "Restaurant.order_number_reset": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.OrderEventSeq(); !ok {
+		return &ValidationError{Name: "order_event_seq", err: errors.New(`ent: missing required field "Restaurant.order_event_seq"`)}
+	}
+	if v, ok := _c.mutation.OrderEventSeq(); ok {
+		if err := restaurant.OrderEventSeqValidator(v); err != nil {
+			return &ValidationError{Name: "order_event_seq", err: fmt.Errorf(`ent: validator failed for field "Restaurant.order_event_seq": %w`, err)}
+		}
+	}
 	if _, ok := _c.mutation.UserID(); !ok {
 		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "Restaurant.user_id"`)}
 	}
@@ -451,10 +874,26 @@ func (_c *RestaurantCreate) createSpec() (*Restaurant, *sqlgraph.CreateSpec) {
 		_spec.SetField(restaurant.FieldCountry, field.TypeString, value)
 		_node.Country = value
 	}
+	if value, ok := _c.mutation.Latitude(); ok {
+		_spec.SetField(restaurant.FieldLatitude, field.TypeFloat64, value)
+		_node.Latitude = &value
+	}
+	if value, ok := _c.mutation.Longitude(); ok {
+		_spec.SetField(restaurant.FieldLongitude, field.TypeFloat64, value)
+		_node.Longitude = &value
+	}
 	if value, ok := _c.mutation.LogoURL(); ok {
 		_spec.SetField(restaurant.FieldLogoURL, field.TypeString, value)
 		_node.LogoURL = value
 	}
+	if value, ok := _c.mutation.LogoThumbnailURL(); ok {
+		_spec.SetField(restaurant.FieldLogoThumbnailURL, field.TypeString, value)
+		_node.LogoThumbnailURL = value
+	}
+	if value, ok := _c.mutation.LogoKeys(); ok {
+		_spec.SetField(restaurant.FieldLogoKeys, field.TypeJSON, value)
+		_node.LogoKeys = value
+	}
 	if value, ok := _c.mutation.CoverImageURL(); ok {
 		_spec.SetField(restaurant.FieldCoverImageURL, field.TypeString, value)
 		_node.CoverImageURL = value
@@ -471,6 +910,34 @@ func (_c *RestaurantCreate) createSpec() (*Restaurant, *sqlgraph.CreateSpec) {
 		_spec.SetField(restaurant.FieldCurrency, field.TypeString, value)
 		_node.Currency = value
 	}
+	if value, ok := _c.mutation.TaxRateBps(); ok {
+		_spec.SetField(restaurant.FieldTaxRateBps, field.TypeInt, value)
+		_node.TaxRateBps = value
+	}
+	if value, ok := _c.mutation.Timezone(); ok {
+		_spec.SetField(restaurant.FieldTimezone, field.TypeString, value)
+		_node.Timezone = value
+	}
+	if value, ok := _c.mutation.SlotMinutes(); ok {
+		_spec.SetField(restaurant.FieldSlotMinutes, field.TypeInt, value)
+		_node.SlotMinutes = value
+	}
+	if value, ok := _c.mutation.SlotCapacity(); ok {
+		_spec.SetField(restaurant.FieldSlotCapacity, field.TypeInt, value)
+		_node.SlotCapacity = value
+	}
+	if value, ok := _c.mutation.KitchenLeadMinutes(); ok {
+		_spec.SetField(restaurant.FieldKitchenLeadMinutes, field.TypeInt, value)
+		_node.KitchenLeadMinutes = value
+	}
+	if value, ok := _c.mutation.OrderNumberReset(); ok {
+		_spec.SetField(restaurant.FieldOrderNumberReset, field.TypeEnum, value)
+		_node.OrderNumberReset = value
+	}
+	if value, ok := _c.mutation.OrderEventSeq(); ok {
+		_spec.SetField(restaurant.FieldOrderEventSeq, field.TypeInt64, value)
+		_node.OrderEventSeq = value
+	}
 	if nodes := _c.mutation.UserIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -552,6 +1019,198 @@ func (_c *RestaurantCreate) createSpec() (*Restaurant, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.OrderStatusEventsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   restaurant.OrderStatusEventsTable,
+			Columns: []string{restaurant.OrderStatusEventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(orderstatusevent.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.PaymentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   restaurant.PaymentsTable,
+			Columns: []string{restaurant.PaymentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(payment.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.RefundsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   restaurant.RefundsTable,
+			Columns: []string{restaurant.RefundsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(refund.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.OrderNumberSequencesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   restaurant.OrderNumberSequencesTable,
+			Columns: []string{restaurant.OrderNumberSequencesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ordernumbersequence.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.OrderEventsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   restaurant.OrderEventsTable,
+			Columns: []string{restaurant.OrderEventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(orderevent.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.StationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   restaurant.StationsTable,
+			Columns: []string{restaurant.StationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(station.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.StationTicketsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   restaurant.StationTicketsTable,
+			Columns: []string{restaurant.StationTicketsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(stationticket.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.TablesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   restaurant.TablesTable,
+			Columns: []string{restaurant.TablesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(table.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.TableSessionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   restaurant.TableSessionsTable,
+			Columns: []string{restaurant.TableSessionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tablesession.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.DeliveryZonesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   restaurant.DeliveryZonesTable,
+			Columns: []string{restaurant.DeliveryZonesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(deliveryzone.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.IngredientsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   restaurant.IngredientsTable,
+			Columns: []string{restaurant.IngredientsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ingredient.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.MenusIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   restaurant.MenusTable,
+			Columns: []string{restaurant.MenusColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(menu.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
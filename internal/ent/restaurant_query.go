@@ -13,11 +13,23 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/Jiruu246/rms/internal/ent/category"
+	"github.com/Jiruu246/rms/internal/ent/deliveryzone"
+	"github.com/Jiruu246/rms/internal/ent/ingredient"
+	"github.com/Jiruu246/rms/internal/ent/menu"
 	"github.com/Jiruu246/rms/internal/ent/menuitem"
 	"github.com/Jiruu246/rms/internal/ent/modifier"
 	"github.com/Jiruu246/rms/internal/ent/order"
+	"github.com/Jiruu246/rms/internal/ent/orderevent"
+	"github.com/Jiruu246/rms/internal/ent/ordernumbersequence"
+	"github.com/Jiruu246/rms/internal/ent/orderstatusevent"
+	"github.com/Jiruu246/rms/internal/ent/payment"
 	"github.com/Jiruu246/rms/internal/ent/predicate"
+	"github.com/Jiruu246/rms/internal/ent/refund"
 	"github.com/Jiruu246/rms/internal/ent/restaurant"
+	"github.com/Jiruu246/rms/internal/ent/station"
+	"github.com/Jiruu246/rms/internal/ent/stationticket"
+	"github.com/Jiruu246/rms/internal/ent/table"
+	"github.com/Jiruu246/rms/internal/ent/tablesession"
 	"github.com/Jiruu246/rms/internal/ent/user"
 	"github.com/google/uuid"
 )
@@ -25,15 +37,27 @@ import (
 // RestaurantQuery is the builder for querying Restaurant entities.
 type RestaurantQuery struct {
 	config
-	ctx            *QueryContext
-	order          []restaurant.OrderOption
-	inters         []Interceptor
-	predicates     []predicate.Restaurant
-	withUser       *UserQuery
-	withMenuItems  *MenuItemQuery
-	withCategories *CategoryQuery
-	withModifiers  *ModifierQuery
-	withOrders     *OrderQuery
+	ctx                      *QueryContext
+	order                    []restaurant.OrderOption
+	inters                   []Interceptor
+	predicates               []predicate.Restaurant
+	withUser                 *UserQuery
+	withMenuItems            *MenuItemQuery
+	withCategories           *CategoryQuery
+	withModifiers            *ModifierQuery
+	withOrders               *OrderQuery
+	withOrderStatusEvents    *OrderStatusEventQuery
+	withPayments             *PaymentQuery
+	withRefunds              *RefundQuery
+	withOrderNumberSequences *OrderNumberSequenceQuery
+	withOrderEvents          *OrderEventQuery
+	withStations             *StationQuery
+	withStationTickets       *StationTicketQuery
+	withTables               *TableQuery
+	withTableSessions        *TableSessionQuery
+	withDeliveryZones        *DeliveryZoneQuery
+	withIngredients          *IngredientQuery
+	withMenus                *MenuQuery
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -180,6 +204,270 @@ func (_q *RestaurantQuery) QueryOrders() *OrderQuery {
 	return query
 }
 
+// QueryOrderStatusEvents chains the current query on the "order_status_events" edge.
+func (_q *RestaurantQuery) QueryOrderStatusEvents() *OrderStatusEventQuery {
+	query := (&OrderStatusEventClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(restaurant.Table, restaurant.FieldID, selector),
+			sqlgraph.To(orderstatusevent.Table, orderstatusevent.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, restaurant.OrderStatusEventsTable, restaurant.OrderStatusEventsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryPayments chains the current query on the "payments" edge.
+func (_q *RestaurantQuery) QueryPayments() *PaymentQuery {
+	query := (&PaymentClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(restaurant.Table, restaurant.FieldID, selector),
+			sqlgraph.To(payment.Table, payment.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, restaurant.PaymentsTable, restaurant.PaymentsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryRefunds chains the current query on the "refunds" edge.
+func (_q *RestaurantQuery) QueryRefunds() *RefundQuery {
+	query := (&RefundClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(restaurant.Table, restaurant.FieldID, selector),
+			sqlgraph.To(refund.Table, refund.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, restaurant.RefundsTable, restaurant.RefundsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryOrderNumberSequences chains the current query on the "order_number_sequences" edge.
+func (_q *RestaurantQuery) QueryOrderNumberSequences() *OrderNumberSequenceQuery {
+	query := (&OrderNumberSequenceClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(restaurant.Table, restaurant.FieldID, selector),
+			sqlgraph.To(ordernumbersequence.Table, ordernumbersequence.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, restaurant.OrderNumberSequencesTable, restaurant.OrderNumberSequencesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryOrderEvents chains the current query on the "order_events" edge.
+func (_q *RestaurantQuery) QueryOrderEvents() *OrderEventQuery {
+	query := (&OrderEventClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(restaurant.Table, restaurant.FieldID, selector),
+			sqlgraph.To(orderevent.Table, orderevent.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, restaurant.OrderEventsTable, restaurant.OrderEventsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryStations chains the current query on the "stations" edge.
+func (_q *RestaurantQuery) QueryStations() *StationQuery {
+	query := (&StationClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(restaurant.Table, restaurant.FieldID, selector),
+			sqlgraph.To(station.Table, station.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, restaurant.StationsTable, restaurant.StationsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryStationTickets chains the current query on the "station_tickets" edge.
+func (_q *RestaurantQuery) QueryStationTickets() *StationTicketQuery {
+	query := (&StationTicketClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(restaurant.Table, restaurant.FieldID, selector),
+			sqlgraph.To(stationticket.Table, stationticket.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, restaurant.StationTicketsTable, restaurant.StationTicketsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryTables chains the current query on the "tables" edge.
+func (_q *RestaurantQuery) QueryTables() *TableQuery {
+	query := (&TableClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(restaurant.Table, restaurant.FieldID, selector),
+			sqlgraph.To(table.Table, table.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, restaurant.TablesTable, restaurant.TablesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryTableSessions chains the current query on the "table_sessions" edge.
+func (_q *RestaurantQuery) QueryTableSessions() *TableSessionQuery {
+	query := (&TableSessionClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(restaurant.Table, restaurant.FieldID, selector),
+			sqlgraph.To(tablesession.Table, tablesession.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, restaurant.TableSessionsTable, restaurant.TableSessionsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryDeliveryZones chains the current query on the "delivery_zones" edge.
+func (_q *RestaurantQuery) QueryDeliveryZones() *DeliveryZoneQuery {
+	query := (&DeliveryZoneClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(restaurant.Table, restaurant.FieldID, selector),
+			sqlgraph.To(deliveryzone.Table, deliveryzone.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, restaurant.DeliveryZonesTable, restaurant.DeliveryZonesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryIngredients chains the current query on the "ingredients" edge.
+func (_q *RestaurantQuery) QueryIngredients() *IngredientQuery {
+	query := (&IngredientClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(restaurant.Table, restaurant.FieldID, selector),
+			sqlgraph.To(ingredient.Table, ingredient.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, restaurant.IngredientsTable, restaurant.IngredientsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryMenus chains the current query on the "menus" edge.
+func (_q *RestaurantQuery) QueryMenus() *MenuQuery {
+	query := (&MenuClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(restaurant.Table, restaurant.FieldID, selector),
+			sqlgraph.To(menu.Table, menu.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, restaurant.MenusTable, restaurant.MenusColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Restaurant entity from the query.
 // Returns a *NotFoundError when no Restaurant was found.
 func (_q *RestaurantQuery) First(ctx context.Context) (*Restaurant, error) {
@@ -367,16 +655,28 @@ func (_q *RestaurantQuery) Clone() *RestaurantQuery {
 		return nil
 	}
 	return &RestaurantQuery{
-		config:         _q.config,
-		ctx:            _q.ctx.Clone(),
-		order:          append([]restaurant.OrderOption{}, _q.order...),
-		inters:         append([]Interceptor{}, _q.inters...),
-		predicates:     append([]predicate.Restaurant{}, _q.predicates...),
-		withUser:       _q.withUser.Clone(),
-		withMenuItems:  _q.withMenuItems.Clone(),
-		withCategories: _q.withCategories.Clone(),
-		withModifiers:  _q.withModifiers.Clone(),
-		withOrders:     _q.withOrders.Clone(),
+		config:                   _q.config,
+		ctx:                      _q.ctx.Clone(),
+		order:                    append([]restaurant.OrderOption{}, _q.order...),
+		inters:                   append([]Interceptor{}, _q.inters...),
+		predicates:               append([]predicate.Restaurant{}, _q.predicates...),
+		withUser:                 _q.withUser.Clone(),
+		withMenuItems:            _q.withMenuItems.Clone(),
+		withCategories:           _q.withCategories.Clone(),
+		withModifiers:            _q.withModifiers.Clone(),
+		withOrders:               _q.withOrders.Clone(),
+		withOrderStatusEvents:    _q.withOrderStatusEvents.Clone(),
+		withPayments:             _q.withPayments.Clone(),
+		withRefunds:              _q.withRefunds.Clone(),
+		withOrderNumberSequences: _q.withOrderNumberSequences.Clone(),
+		withOrderEvents:          _q.withOrderEvents.Clone(),
+		withStations:             _q.withStations.Clone(),
+		withStationTickets:       _q.withStationTickets.Clone(),
+		withTables:               _q.withTables.Clone(),
+		withTableSessions:        _q.withTableSessions.Clone(),
+		withDeliveryZones:        _q.withDeliveryZones.Clone(),
+		withIngredients:          _q.withIngredients.Clone(),
+		withMenus:                _q.withMenus.Clone(),
 		// clone intermediate query.
 		sql:  _q.sql.Clone(),
 		path: _q.path,
@@ -438,52 +738,184 @@ func (_q *RestaurantQuery) WithOrders(opts ...func(*OrderQuery)) *RestaurantQuer
 	return _q
 }
 
-// GroupBy is used to group vertices by one or more fields/columns.
-// It is often used with aggregate functions, like: count, max, mean, min, sum.
-//
-// Example:
-//
-//	var v []struct {
-//		UpdateTime time.Time `json:"update_time,omitempty"`
-//		Count int `json:"count,omitempty"`
-//	}
-//
-//	client.Restaurant.Query().
-//		GroupBy(restaurant.FieldUpdateTime).
-//		Aggregate(ent.Count()).
-//		Scan(ctx, &v)
-func (_q *RestaurantQuery) GroupBy(field string, fields ...string) *RestaurantGroupBy {
-	_q.ctx.Fields = append([]string{field}, fields...)
-	grbuild := &RestaurantGroupBy{build: _q}
-	grbuild.flds = &_q.ctx.Fields
-	grbuild.label = restaurant.Label
-	grbuild.scan = grbuild.Scan
-	return grbuild
-}
-
-// Select allows the selection one or more fields/columns for the given query,
-// instead of selecting all fields in the entity.
-//
-// Example:
-//
-//	var v []struct {
-//		UpdateTime time.Time `json:"update_time,omitempty"`
-//	}
-//
-//	client.Restaurant.Query().
-//		Select(restaurant.FieldUpdateTime).
-//		Scan(ctx, &v)
-func (_q *RestaurantQuery) Select(fields ...string) *RestaurantSelect {
-	_q.ctx.Fields = append(_q.ctx.Fields, fields...)
-	sbuild := &RestaurantSelect{RestaurantQuery: _q}
-	sbuild.label = restaurant.Label
-	sbuild.flds, sbuild.scan = &_q.ctx.Fields, sbuild.Scan
-	return sbuild
+// WithOrderStatusEvents tells the query-builder to eager-load the nodes that are connected to
+// the "order_status_events" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *RestaurantQuery) WithOrderStatusEvents(opts ...func(*OrderStatusEventQuery)) *RestaurantQuery {
+	query := (&OrderStatusEventClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withOrderStatusEvents = query
+	return _q
 }
 
-// Aggregate returns a RestaurantSelect configured with the given aggregations.
-func (_q *RestaurantQuery) Aggregate(fns ...AggregateFunc) *RestaurantSelect {
-	return _q.Select().Aggregate(fns...)
+// WithPayments tells the query-builder to eager-load the nodes that are connected to
+// the "payments" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *RestaurantQuery) WithPayments(opts ...func(*PaymentQuery)) *RestaurantQuery {
+	query := (&PaymentClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withPayments = query
+	return _q
+}
+
+// WithRefunds tells the query-builder to eager-load the nodes that are connected to
+// the "refunds" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *RestaurantQuery) WithRefunds(opts ...func(*RefundQuery)) *RestaurantQuery {
+	query := (&RefundClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withRefunds = query
+	return _q
+}
+
+// WithOrderNumberSequences tells the query-builder to eager-load the nodes that are connected to
+// the "order_number_sequences" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *RestaurantQuery) WithOrderNumberSequences(opts ...func(*OrderNumberSequenceQuery)) *RestaurantQuery {
+	query := (&OrderNumberSequenceClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withOrderNumberSequences = query
+	return _q
+}
+
+// WithOrderEvents tells the query-builder to eager-load the nodes that are connected to
+// the "order_events" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *RestaurantQuery) WithOrderEvents(opts ...func(*OrderEventQuery)) *RestaurantQuery {
+	query := (&OrderEventClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withOrderEvents = query
+	return _q
+}
+
+// WithStations tells the query-builder to eager-load the nodes that are connected to
+// the "stations" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *RestaurantQuery) WithStations(opts ...func(*StationQuery)) *RestaurantQuery {
+	query := (&StationClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withStations = query
+	return _q
+}
+
+// WithStationTickets tells the query-builder to eager-load the nodes that are connected to
+// the "station_tickets" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *RestaurantQuery) WithStationTickets(opts ...func(*StationTicketQuery)) *RestaurantQuery {
+	query := (&StationTicketClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withStationTickets = query
+	return _q
+}
+
+// WithTables tells the query-builder to eager-load the nodes that are connected to
+// the "tables" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *RestaurantQuery) WithTables(opts ...func(*TableQuery)) *RestaurantQuery {
+	query := (&TableClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withTables = query
+	return _q
+}
+
+// WithTableSessions tells the query-builder to eager-load the nodes that are connected to
+// the "table_sessions" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *RestaurantQuery) WithTableSessions(opts ...func(*TableSessionQuery)) *RestaurantQuery {
+	query := (&TableSessionClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withTableSessions = query
+	return _q
+}
+
+// WithDeliveryZones tells the query-builder to eager-load the nodes that are connected to
+// the "delivery_zones" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *RestaurantQuery) WithDeliveryZones(opts ...func(*DeliveryZoneQuery)) *RestaurantQuery {
+	query := (&DeliveryZoneClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withDeliveryZones = query
+	return _q
+}
+
+// WithIngredients tells the query-builder to eager-load the nodes that are connected to
+// the "ingredients" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *RestaurantQuery) WithIngredients(opts ...func(*IngredientQuery)) *RestaurantQuery {
+	query := (&IngredientClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withIngredients = query
+	return _q
+}
+
+// WithMenus tells the query-builder to eager-load the nodes that are connected to
+// the "menus" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *RestaurantQuery) WithMenus(opts ...func(*MenuQuery)) *RestaurantQuery {
+	query := (&MenuClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withMenus = query
+	return _q
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		UpdateTime time.Time `json:"update_time,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.Restaurant.Query().
+//		GroupBy(restaurant.FieldUpdateTime).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (_q *RestaurantQuery) GroupBy(field string, fields ...string) *RestaurantGroupBy {
+	_q.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &RestaurantGroupBy{build: _q}
+	grbuild.flds = &_q.ctx.Fields
+	grbuild.label = restaurant.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		UpdateTime time.Time `json:"update_time,omitempty"`
+//	}
+//
+//	client.Restaurant.Query().
+//		Select(restaurant.FieldUpdateTime).
+//		Scan(ctx, &v)
+func (_q *RestaurantQuery) Select(fields ...string) *RestaurantSelect {
+	_q.ctx.Fields = append(_q.ctx.Fields, fields...)
+	sbuild := &RestaurantSelect{RestaurantQuery: _q}
+	sbuild.label = restaurant.Label
+	sbuild.flds, sbuild.scan = &_q.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a RestaurantSelect configured with the given aggregations.
+func (_q *RestaurantQuery) Aggregate(fns ...AggregateFunc) *RestaurantSelect {
+	return _q.Select().Aggregate(fns...)
 }
 
 func (_q *RestaurantQuery) prepareQuery(ctx context.Context) error {
@@ -516,12 +948,24 @@ func (_q *RestaurantQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*R
 	var (
 		nodes       = []*Restaurant{}
 		_spec       = _q.querySpec()
-		loadedTypes = [5]bool{
+		loadedTypes = [17]bool{
 			_q.withUser != nil,
 			_q.withMenuItems != nil,
 			_q.withCategories != nil,
 			_q.withModifiers != nil,
 			_q.withOrders != nil,
+			_q.withOrderStatusEvents != nil,
+			_q.withPayments != nil,
+			_q.withRefunds != nil,
+			_q.withOrderNumberSequences != nil,
+			_q.withOrderEvents != nil,
+			_q.withStations != nil,
+			_q.withStationTickets != nil,
+			_q.withTables != nil,
+			_q.withTableSessions != nil,
+			_q.withDeliveryZones != nil,
+			_q.withIngredients != nil,
+			_q.withMenus != nil,
 		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
@@ -576,6 +1020,94 @@ func (_q *RestaurantQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*R
 			return nil, err
 		}
 	}
+	if query := _q.withOrderStatusEvents; query != nil {
+		if err := _q.loadOrderStatusEvents(ctx, query, nodes,
+			func(n *Restaurant) { n.Edges.OrderStatusEvents = []*OrderStatusEvent{} },
+			func(n *Restaurant, e *OrderStatusEvent) {
+				n.Edges.OrderStatusEvents = append(n.Edges.OrderStatusEvents, e)
+			}); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withPayments; query != nil {
+		if err := _q.loadPayments(ctx, query, nodes,
+			func(n *Restaurant) { n.Edges.Payments = []*Payment{} },
+			func(n *Restaurant, e *Payment) { n.Edges.Payments = append(n.Edges.Payments, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withRefunds; query != nil {
+		if err := _q.loadRefunds(ctx, query, nodes,
+			func(n *Restaurant) { n.Edges.Refunds = []*Refund{} },
+			func(n *Restaurant, e *Refund) { n.Edges.Refunds = append(n.Edges.Refunds, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withOrderNumberSequences; query != nil {
+		if err := _q.loadOrderNumberSequences(ctx, query, nodes,
+			func(n *Restaurant) { n.Edges.OrderNumberSequences = []*OrderNumberSequence{} },
+			func(n *Restaurant, e *OrderNumberSequence) {
+				n.Edges.OrderNumberSequences = append(n.Edges.OrderNumberSequences, e)
+			}); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withOrderEvents; query != nil {
+		if err := _q.loadOrderEvents(ctx, query, nodes,
+			func(n *Restaurant) { n.Edges.OrderEvents = []*OrderEvent{} },
+			func(n *Restaurant, e *OrderEvent) { n.Edges.OrderEvents = append(n.Edges.OrderEvents, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withStations; query != nil {
+		if err := _q.loadStations(ctx, query, nodes,
+			func(n *Restaurant) { n.Edges.Stations = []*Station{} },
+			func(n *Restaurant, e *Station) { n.Edges.Stations = append(n.Edges.Stations, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withStationTickets; query != nil {
+		if err := _q.loadStationTickets(ctx, query, nodes,
+			func(n *Restaurant) { n.Edges.StationTickets = []*StationTicket{} },
+			func(n *Restaurant, e *StationTicket) { n.Edges.StationTickets = append(n.Edges.StationTickets, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withTables; query != nil {
+		if err := _q.loadTables(ctx, query, nodes,
+			func(n *Restaurant) { n.Edges.Tables = []*Table{} },
+			func(n *Restaurant, e *Table) { n.Edges.Tables = append(n.Edges.Tables, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withTableSessions; query != nil {
+		if err := _q.loadTableSessions(ctx, query, nodes,
+			func(n *Restaurant) { n.Edges.TableSessions = []*TableSession{} },
+			func(n *Restaurant, e *TableSession) { n.Edges.TableSessions = append(n.Edges.TableSessions, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withDeliveryZones; query != nil {
+		if err := _q.loadDeliveryZones(ctx, query, nodes,
+			func(n *Restaurant) { n.Edges.DeliveryZones = []*DeliveryZone{} },
+			func(n *Restaurant, e *DeliveryZone) { n.Edges.DeliveryZones = append(n.Edges.DeliveryZones, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withIngredients; query != nil {
+		if err := _q.loadIngredients(ctx, query, nodes,
+			func(n *Restaurant) { n.Edges.Ingredients = []*Ingredient{} },
+			func(n *Restaurant, e *Ingredient) { n.Edges.Ingredients = append(n.Edges.Ingredients, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withMenus; query != nil {
+		if err := _q.loadMenus(ctx, query, nodes,
+			func(n *Restaurant) { n.Edges.Menus = []*Menu{} },
+			func(n *Restaurant, e *Menu) { n.Edges.Menus = append(n.Edges.Menus, e) }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -618,7 +1150,6 @@ func (_q *RestaurantQuery) loadMenuItems(ctx context.Context, query *MenuItemQue
 			init(nodes[i])
 		}
 	}
-	query.withFKs = true
 	if len(query.ctx.Fields) > 0 {
 		query.ctx.AppendFieldOnce(menuitem.FieldRestaurantID)
 	}
@@ -679,7 +1210,6 @@ func (_q *RestaurantQuery) loadModifiers(ctx context.Context, query *ModifierQue
 			init(nodes[i])
 		}
 	}
-	query.withFKs = true
 	if len(query.ctx.Fields) > 0 {
 		query.ctx.AppendFieldOnce(modifier.FieldRestaurantID)
 	}
@@ -730,6 +1260,366 @@ func (_q *RestaurantQuery) loadOrders(ctx context.Context, query *OrderQuery, no
 	}
 	return nil
 }
+func (_q *RestaurantQuery) loadOrderStatusEvents(ctx context.Context, query *OrderStatusEventQuery, nodes []*Restaurant, init func(*Restaurant), assign func(*Restaurant, *OrderStatusEvent)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Restaurant)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(orderstatusevent.FieldRestaurantID)
+	}
+	query.Where(predicate.OrderStatusEvent(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(restaurant.OrderStatusEventsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.RestaurantID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "restaurant_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *RestaurantQuery) loadPayments(ctx context.Context, query *PaymentQuery, nodes []*Restaurant, init func(*Restaurant), assign func(*Restaurant, *Payment)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Restaurant)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(payment.FieldRestaurantID)
+	}
+	query.Where(predicate.Payment(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(restaurant.PaymentsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.RestaurantID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "restaurant_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *RestaurantQuery) loadRefunds(ctx context.Context, query *RefundQuery, nodes []*Restaurant, init func(*Restaurant), assign func(*Restaurant, *Refund)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Restaurant)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(refund.FieldRestaurantID)
+	}
+	query.Where(predicate.Refund(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(restaurant.RefundsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.RestaurantID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "restaurant_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *RestaurantQuery) loadOrderNumberSequences(ctx context.Context, query *OrderNumberSequenceQuery, nodes []*Restaurant, init func(*Restaurant), assign func(*Restaurant, *OrderNumberSequence)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Restaurant)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(ordernumbersequence.FieldRestaurantID)
+	}
+	query.Where(predicate.OrderNumberSequence(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(restaurant.OrderNumberSequencesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.RestaurantID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "restaurant_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *RestaurantQuery) loadOrderEvents(ctx context.Context, query *OrderEventQuery, nodes []*Restaurant, init func(*Restaurant), assign func(*Restaurant, *OrderEvent)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Restaurant)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(orderevent.FieldRestaurantID)
+	}
+	query.Where(predicate.OrderEvent(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(restaurant.OrderEventsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.RestaurantID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "restaurant_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *RestaurantQuery) loadStations(ctx context.Context, query *StationQuery, nodes []*Restaurant, init func(*Restaurant), assign func(*Restaurant, *Station)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Restaurant)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(station.FieldRestaurantID)
+	}
+	query.Where(predicate.Station(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(restaurant.StationsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.RestaurantID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "restaurant_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *RestaurantQuery) loadStationTickets(ctx context.Context, query *StationTicketQuery, nodes []*Restaurant, init func(*Restaurant), assign func(*Restaurant, *StationTicket)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Restaurant)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(stationticket.FieldRestaurantID)
+	}
+	query.Where(predicate.StationTicket(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(restaurant.StationTicketsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.RestaurantID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "restaurant_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *RestaurantQuery) loadTables(ctx context.Context, query *TableQuery, nodes []*Restaurant, init func(*Restaurant), assign func(*Restaurant, *Table)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Restaurant)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(table.FieldRestaurantID)
+	}
+	query.Where(predicate.Table(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(restaurant.TablesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.RestaurantID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "restaurant_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *RestaurantQuery) loadTableSessions(ctx context.Context, query *TableSessionQuery, nodes []*Restaurant, init func(*Restaurant), assign func(*Restaurant, *TableSession)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Restaurant)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(tablesession.FieldRestaurantID)
+	}
+	query.Where(predicate.TableSession(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(restaurant.TableSessionsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.RestaurantID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "restaurant_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *RestaurantQuery) loadDeliveryZones(ctx context.Context, query *DeliveryZoneQuery, nodes []*Restaurant, init func(*Restaurant), assign func(*Restaurant, *DeliveryZone)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Restaurant)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(deliveryzone.FieldRestaurantID)
+	}
+	query.Where(predicate.DeliveryZone(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(restaurant.DeliveryZonesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.RestaurantID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "restaurant_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *RestaurantQuery) loadIngredients(ctx context.Context, query *IngredientQuery, nodes []*Restaurant, init func(*Restaurant), assign func(*Restaurant, *Ingredient)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Restaurant)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(ingredient.FieldRestaurantID)
+	}
+	query.Where(predicate.Ingredient(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(restaurant.IngredientsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.RestaurantID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "restaurant_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *RestaurantQuery) loadMenus(ctx context.Context, query *MenuQuery, nodes []*Restaurant, init func(*Restaurant), assign func(*Restaurant, *Menu)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Restaurant)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(menu.FieldRestaurantID)
+	}
+	query.Where(predicate.Menu(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(restaurant.MenusColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.RestaurantID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "restaurant_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (_q *RestaurantQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := _q.querySpec()
@@ -10,14 +10,28 @@ import (
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
 	"entgo.io/ent/schema/field"
 	"github.com/Jiruu246/rms/internal/ent/category"
+	"github.com/Jiruu246/rms/internal/ent/deliveryzone"
+	"github.com/Jiruu246/rms/internal/ent/ingredient"
+	"github.com/Jiruu246/rms/internal/ent/menu"
 	"github.com/Jiruu246/rms/internal/ent/menuitem"
 	"github.com/Jiruu246/rms/internal/ent/modifier"
 	"github.com/Jiruu246/rms/internal/ent/order"
+	"github.com/Jiruu246/rms/internal/ent/orderevent"
+	"github.com/Jiruu246/rms/internal/ent/ordernumbersequence"
+	"github.com/Jiruu246/rms/internal/ent/orderstatusevent"
+	"github.com/Jiruu246/rms/internal/ent/payment"
 	"github.com/Jiruu246/rms/internal/ent/predicate"
+	"github.com/Jiruu246/rms/internal/ent/refund"
 	"github.com/Jiruu246/rms/internal/ent/restaurant"
+	"github.com/Jiruu246/rms/internal/ent/station"
+	"github.com/Jiruu246/rms/internal/ent/stationticket"
+	"github.com/Jiruu246/rms/internal/ent/table"
+	"github.com/Jiruu246/rms/internal/ent/tablesession"
 	"github.com/Jiruu246/rms/internal/ent/user"
+	"github.com/Jiruu246/rms/pkg/hours"
 	"github.com/google/uuid"
 )
 
@@ -172,6 +186,60 @@ func (_u *RestaurantUpdate) SetNillableCountry(v *string) *RestaurantUpdate {
 	return _u
 }
 
+// SetLatitude sets the "latitude" field.
+func (_u *RestaurantUpdate) SetLatitude(v float64) *RestaurantUpdate {
+	_u.mutation.ResetLatitude()
+	_u.mutation.SetLatitude(v)
+	return _u
+}
+
+// SetNillableLatitude sets the "latitude" field if the given value is not nil.
+func (_u *RestaurantUpdate) SetNillableLatitude(v *float64) *RestaurantUpdate {
+	if v != nil {
+		_u.SetLatitude(*v)
+	}
+	return _u
+}
+
+// AddLatitude adds value to the "latitude" field.
+func (_u *RestaurantUpdate) AddLatitude(v float64) *RestaurantUpdate {
+	_u.mutation.AddLatitude(v)
+	return _u
+}
+
+// ClearLatitude clears the value of the "latitude" field.
+func (_u *RestaurantUpdate) ClearLatitude() *RestaurantUpdate {
+	_u.mutation.ClearLatitude()
+	return _u
+}
+
+// SetLongitude sets the "longitude" field.
+func (_u *RestaurantUpdate) SetLongitude(v float64) *RestaurantUpdate {
+	_u.mutation.ResetLongitude()
+	_u.mutation.SetLongitude(v)
+	return _u
+}
+
+// SetNillableLongitude sets the "longitude" field if the given value is not nil.
+func (_u *RestaurantUpdate) SetNillableLongitude(v *float64) *RestaurantUpdate {
+	if v != nil {
+		_u.SetLongitude(*v)
+	}
+	return _u
+}
+
+// AddLongitude adds value to the "longitude" field.
+func (_u *RestaurantUpdate) AddLongitude(v float64) *RestaurantUpdate {
+	_u.mutation.AddLongitude(v)
+	return _u
+}
+
+// ClearLongitude clears the value of the "longitude" field.
+func (_u *RestaurantUpdate) ClearLongitude() *RestaurantUpdate {
+	_u.mutation.ClearLongitude()
+	return _u
+}
+
 // SetLogoURL sets the "logo_url" field.
 func (_u *RestaurantUpdate) SetLogoURL(v string) *RestaurantUpdate {
 	_u.mutation.SetLogoURL(v)
@@ -192,6 +260,44 @@ func (_u *RestaurantUpdate) ClearLogoURL() *RestaurantUpdate {
 	return _u
 }
 
+// SetLogoThumbnailURL sets the "logo_thumbnail_url" field.
+func (_u *RestaurantUpdate) SetLogoThumbnailURL(v string) *RestaurantUpdate {
+	_u.mutation.SetLogoThumbnailURL(v)
+	return _u
+}
+
+// SetNillableLogoThumbnailURL sets the "logo_thumbnail_url" field if the given value is not nil.
+func (_u *RestaurantUpdate) SetNillableLogoThumbnailURL(v *string) *RestaurantUpdate {
+	if v != nil {
+		_u.SetLogoThumbnailURL(*v)
+	}
+	return _u
+}
+
+// ClearLogoThumbnailURL clears the value of the "logo_thumbnail_url" field.
+func (_u *RestaurantUpdate) ClearLogoThumbnailURL() *RestaurantUpdate {
+	_u.mutation.ClearLogoThumbnailURL()
+	return _u
+}
+
+// SetLogoKeys sets the "logo_keys" field.
+func (_u *RestaurantUpdate) SetLogoKeys(v []string) *RestaurantUpdate {
+	_u.mutation.SetLogoKeys(v)
+	return _u
+}
+
+// AppendLogoKeys appends value to the "logo_keys" field.
+func (_u *RestaurantUpdate) AppendLogoKeys(v []string) *RestaurantUpdate {
+	_u.mutation.AppendLogoKeys(v)
+	return _u
+}
+
+// ClearLogoKeys clears the value of the "logo_keys" field.
+func (_u *RestaurantUpdate) ClearLogoKeys() *RestaurantUpdate {
+	_u.mutation.ClearLogoKeys()
+	return _u
+}
+
 // SetCoverImageURL sets the "cover_image_url" field.
 func (_u *RestaurantUpdate) SetCoverImageURL(v string) *RestaurantUpdate {
 	_u.mutation.SetCoverImageURL(v)
@@ -227,7 +333,7 @@ func (_u *RestaurantUpdate) SetNillableStatus(v *restaurant.Status) *RestaurantU
 }
 
 // SetOperatingHours sets the "operating_hours" field.
-func (_u *RestaurantUpdate) SetOperatingHours(v map[string]interface{}) *RestaurantUpdate {
+func (_u *RestaurantUpdate) SetOperatingHours(v *hours.Schedule) *RestaurantUpdate {
 	_u.mutation.SetOperatingHours(v)
 	return _u
 }
@@ -252,6 +358,139 @@ func (_u *RestaurantUpdate) SetNillableCurrency(v *string) *RestaurantUpdate {
 	return _u
 }
 
+// SetTaxRateBps sets the "tax_rate_bps" field.
+func (_u *RestaurantUpdate) SetTaxRateBps(v int) *RestaurantUpdate {
+	_u.mutation.ResetTaxRateBps()
+	_u.mutation.SetTaxRateBps(v)
+	return _u
+}
+
+// SetNillableTaxRateBps sets the "tax_rate_bps" field if the given value is not nil.
+func (_u *RestaurantUpdate) SetNillableTaxRateBps(v *int) *RestaurantUpdate {
+	if v != nil {
+		_u.SetTaxRateBps(*v)
+	}
+	return _u
+}
+
+// AddTaxRateBps adds value to the "tax_rate_bps" field.
+func (_u *RestaurantUpdate) AddTaxRateBps(v int) *RestaurantUpdate {
+	_u.mutation.AddTaxRateBps(v)
+	return _u
+}
+
+// SetTimezone sets the "timezone" field.
+func (_u *RestaurantUpdate) SetTimezone(v string) *RestaurantUpdate {
+	_u.mutation.SetTimezone(v)
+	return _u
+}
+
+// SetNillableTimezone sets the "timezone" field if the given value is not nil.
+func (_u *RestaurantUpdate) SetNillableTimezone(v *string) *RestaurantUpdate {
+	if v != nil {
+		_u.SetTimezone(*v)
+	}
+	return _u
+}
+
+// SetSlotMinutes sets the "slot_minutes" field.
+func (_u *RestaurantUpdate) SetSlotMinutes(v int) *RestaurantUpdate {
+	_u.mutation.ResetSlotMinutes()
+	_u.mutation.SetSlotMinutes(v)
+	return _u
+}
+
+// SetNillableSlotMinutes sets the "slot_minutes" field if the given value is not nil.
+func (_u *RestaurantUpdate) SetNillableSlotMinutes(v *int) *RestaurantUpdate {
+	if v != nil {
+		_u.SetSlotMinutes(*v)
+	}
+	return _u
+}
+
+// AddSlotMinutes adds value to the "slot_minutes" field.
+func (_u *RestaurantUpdate) AddSlotMinutes(v int) *RestaurantUpdate {
+	_u.mutation.AddSlotMinutes(v)
+	return _u
+}
+
+// SetSlotCapacity sets the "slot_capacity" field.
+func (_u *RestaurantUpdate) SetSlotCapacity(v int) *RestaurantUpdate {
+	_u.mutation.ResetSlotCapacity()
+	_u.mutation.SetSlotCapacity(v)
+	return _u
+}
+
+// SetNillableSlotCapacity sets the "slot_capacity" field if the given value is not nil.
+func (_u *RestaurantUpdate) SetNillableSlotCapacity(v *int) *RestaurantUpdate {
+	if v != nil {
+		_u.SetSlotCapacity(*v)
+	}
+	return _u
+}
+
+// AddSlotCapacity adds value to the "slot_capacity" field.
+func (_u *RestaurantUpdate) AddSlotCapacity(v int) *RestaurantUpdate {
+	_u.mutation.AddSlotCapacity(v)
+	return _u
+}
+
+// SetKitchenLeadMinutes sets the "kitchen_lead_minutes" field.
+func (_u *RestaurantUpdate) SetKitchenLeadMinutes(v int) *RestaurantUpdate {
+	_u.mutation.ResetKitchenLeadMinutes()
+	_u.mutation.SetKitchenLeadMinutes(v)
+	return _u
+}
+
+// SetNillableKitchenLeadMinutes sets the "kitchen_lead_minutes" field if the given value is not nil.
+func (_u *RestaurantUpdate) SetNillableKitchenLeadMinutes(v *int) *RestaurantUpdate {
+	if v != nil {
+		_u.SetKitchenLeadMinutes(*v)
+	}
+	return _u
+}
+
+// AddKitchenLeadMinutes adds value to the "kitchen_lead_minutes" field.
+func (_u *RestaurantUpdate) AddKitchenLeadMinutes(v int) *RestaurantUpdate {
+	_u.mutation.AddKitchenLeadMinutes(v)
+	return _u
+}
+
+// SetOrderNumberReset sets the "order_number_reset" field.
+func (_u *RestaurantUpdate) SetOrderNumberReset(v restaurant.OrderNumberReset) *RestaurantUpdate {
+	_u.mutation.SetOrderNumberReset(v)
+	return _u
+}
+
+// SetNillableOrderNumberReset sets the "order_number_reset" field if the given value is not nil.
+func (_u *RestaurantUpdate) SetNillableOrderNumberReset(v *restaurant.OrderNumberReset) *RestaurantUpdate {
+	if v != nil {
+		_u.SetOrderNumberReset(*v)
+	}
+	return _u
+}
+
+// SetOrderEventSeq sets the "order_event_seq" field.
+func (_u *RestaurantUpdate) SetOrderEventSeq(v int64) *RestaurantUpdate {
+	_u.mutation.ResetOrderEventSeq()
+	_u.mutation.SetOrderEventSeq(v)
+	return _u
+}
+
+// SetNillableOrderEventSeq sets the "order_event_seq" field if the given value is not nil.
+func (_u *RestaurantUpdate) SetNillableOrderEventSeq(v *int64) *RestaurantUpdate {
+	if v != nil {
+		_u.SetOrderEventSeq(*v)
+	}
+	return _u
+}
+
+// AddOrderEventSeq adds value to the "order_event_seq" field.
+func (_u *RestaurantUpdate) AddOrderEventSeq(v int64) *RestaurantUpdate {
+	_u.mutation.AddOrderEventSeq(v)
+	return _u
+}
+
 // SetUserID sets the "user_id" field.
 func (_u *RestaurantUpdate) SetUserID(v uuid.UUID) *RestaurantUpdate {
 	_u.mutation.SetUserID(v)
@@ -331,6 +570,186 @@ func (_u *RestaurantUpdate) AddOrders(v ...*Order) *RestaurantUpdate {
 	return _u.AddOrderIDs(ids...)
 }
 
+// AddOrderStatusEventIDs adds the "order_status_events" edge to the OrderStatusEvent entity by IDs.
+func (_u *RestaurantUpdate) AddOrderStatusEventIDs(ids ...uuid.UUID) *RestaurantUpdate {
+	_u.mutation.AddOrderStatusEventIDs(ids...)
+	return _u
+}
+
+// AddOrderStatusEvents adds the "order_status_events" edges to the OrderStatusEvent entity.
+func (_u *RestaurantUpdate) AddOrderStatusEvents(v ...*OrderStatusEvent) *RestaurantUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddOrderStatusEventIDs(ids...)
+}
+
+// AddPaymentIDs adds the "payments" edge to the Payment entity by IDs.
+func (_u *RestaurantUpdate) AddPaymentIDs(ids ...uuid.UUID) *RestaurantUpdate {
+	_u.mutation.AddPaymentIDs(ids...)
+	return _u
+}
+
+// AddPayments adds the "payments" edges to the Payment entity.
+func (_u *RestaurantUpdate) AddPayments(v ...*Payment) *RestaurantUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddPaymentIDs(ids...)
+}
+
+// AddRefundIDs adds the "refunds" edge to the Refund entity by IDs.
+func (_u *RestaurantUpdate) AddRefundIDs(ids ...uuid.UUID) *RestaurantUpdate {
+	_u.mutation.AddRefundIDs(ids...)
+	return _u
+}
+
+// AddRefunds adds the "refunds" edges to the Refund entity.
+func (_u *RestaurantUpdate) AddRefunds(v ...*Refund) *RestaurantUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddRefundIDs(ids...)
+}
+
+// AddOrderNumberSequenceIDs adds the "order_number_sequences" edge to the OrderNumberSequence entity by IDs.
+func (_u *RestaurantUpdate) AddOrderNumberSequenceIDs(ids ...uuid.UUID) *RestaurantUpdate {
+	_u.mutation.AddOrderNumberSequenceIDs(ids...)
+	return _u
+}
+
+// AddOrderNumberSequences adds the "order_number_sequences" edges to the OrderNumberSequence entity.
+func (_u *RestaurantUpdate) AddOrderNumberSequences(v ...*OrderNumberSequence) *RestaurantUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddOrderNumberSequenceIDs(ids...)
+}
+
+// AddOrderEventIDs adds the "order_events" edge to the OrderEvent entity by IDs.
+func (_u *RestaurantUpdate) AddOrderEventIDs(ids ...uuid.UUID) *RestaurantUpdate {
+	_u.mutation.AddOrderEventIDs(ids...)
+	return _u
+}
+
+// AddOrderEvents adds the "order_events" edges to the OrderEvent entity.
+func (_u *RestaurantUpdate) AddOrderEvents(v ...*OrderEvent) *RestaurantUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddOrderEventIDs(ids...)
+}
+
+// AddStationIDs adds the "stations" edge to the Station entity by IDs.
+func (_u *RestaurantUpdate) AddStationIDs(ids ...uuid.UUID) *RestaurantUpdate {
+	_u.mutation.AddStationIDs(ids...)
+	return _u
+}
+
+// AddStations adds the "stations" edges to the Station entity.
+func (_u *RestaurantUpdate) AddStations(v ...*Station) *RestaurantUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddStationIDs(ids...)
+}
+
+// AddStationTicketIDs adds the "station_tickets" edge to the StationTicket entity by IDs.
+func (_u *RestaurantUpdate) AddStationTicketIDs(ids ...uuid.UUID) *RestaurantUpdate {
+	_u.mutation.AddStationTicketIDs(ids...)
+	return _u
+}
+
+// AddStationTickets adds the "station_tickets" edges to the StationTicket entity.
+func (_u *RestaurantUpdate) AddStationTickets(v ...*StationTicket) *RestaurantUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddStationTicketIDs(ids...)
+}
+
+// AddTableIDs adds the "tables" edge to the Table entity by IDs.
+func (_u *RestaurantUpdate) AddTableIDs(ids ...uuid.UUID) *RestaurantUpdate {
+	_u.mutation.AddTableIDs(ids...)
+	return _u
+}
+
+// AddTables adds the "tables" edges to the Table entity.
+func (_u *RestaurantUpdate) AddTables(v ...*Table) *RestaurantUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddTableIDs(ids...)
+}
+
+// AddTableSessionIDs adds the "table_sessions" edge to the TableSession entity by IDs.
+func (_u *RestaurantUpdate) AddTableSessionIDs(ids ...uuid.UUID) *RestaurantUpdate {
+	_u.mutation.AddTableSessionIDs(ids...)
+	return _u
+}
+
+// AddTableSessions adds the "table_sessions" edges to the TableSession entity.
+func (_u *RestaurantUpdate) AddTableSessions(v ...*TableSession) *RestaurantUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddTableSessionIDs(ids...)
+}
+
+// AddDeliveryZoneIDs adds the "delivery_zones" edge to the DeliveryZone entity by IDs.
+func (_u *RestaurantUpdate) AddDeliveryZoneIDs(ids ...uuid.UUID) *RestaurantUpdate {
+	_u.mutation.AddDeliveryZoneIDs(ids...)
+	return _u
+}
+
+// AddDeliveryZones adds the "delivery_zones" edges to the DeliveryZone entity.
+func (_u *RestaurantUpdate) AddDeliveryZones(v ...*DeliveryZone) *RestaurantUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddDeliveryZoneIDs(ids...)
+}
+
+// AddIngredientIDs adds the "ingredients" edge to the Ingredient entity by IDs.
+func (_u *RestaurantUpdate) AddIngredientIDs(ids ...uuid.UUID) *RestaurantUpdate {
+	_u.mutation.AddIngredientIDs(ids...)
+	return _u
+}
+
+// AddIngredients adds the "ingredients" edges to the Ingredient entity.
+func (_u *RestaurantUpdate) AddIngredients(v ...*Ingredient) *RestaurantUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddIngredientIDs(ids...)
+}
+
+// AddMenuIDs adds the "menus" edge to the Menu entity by IDs.
+func (_u *RestaurantUpdate) AddMenuIDs(ids ...uuid.UUID) *RestaurantUpdate {
+	_u.mutation.AddMenuIDs(ids...)
+	return _u
+}
+
+// AddMenus adds the "menus" edges to the Menu entity.
+func (_u *RestaurantUpdate) AddMenus(v ...*Menu) *RestaurantUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddMenuIDs(ids...)
+}
+
 // Mutation returns the RestaurantMutation object of the builder.
 func (_u *RestaurantUpdate) Mutation() *RestaurantMutation {
 	return _u.mutation
@@ -426,35 +845,287 @@ func (_u *RestaurantUpdate) RemoveOrders(v ...*Order) *RestaurantUpdate {
 	return _u.RemoveOrderIDs(ids...)
 }
 
-// Save executes the query and returns the number of nodes affected by the update operation.
-func (_u *RestaurantUpdate) Save(ctx context.Context) (int, error) {
-	_u.defaults()
-	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+// ClearOrderStatusEvents clears all "order_status_events" edges to the OrderStatusEvent entity.
+func (_u *RestaurantUpdate) ClearOrderStatusEvents() *RestaurantUpdate {
+	_u.mutation.ClearOrderStatusEvents()
+	return _u
 }
 
-// SaveX is like Save, but panics if an error occurs.
-func (_u *RestaurantUpdate) SaveX(ctx context.Context) int {
-	affected, err := _u.Save(ctx)
-	if err != nil {
-		panic(err)
+// RemoveOrderStatusEventIDs removes the "order_status_events" edge to OrderStatusEvent entities by IDs.
+func (_u *RestaurantUpdate) RemoveOrderStatusEventIDs(ids ...uuid.UUID) *RestaurantUpdate {
+	_u.mutation.RemoveOrderStatusEventIDs(ids...)
+	return _u
+}
+
+// RemoveOrderStatusEvents removes "order_status_events" edges to OrderStatusEvent entities.
+func (_u *RestaurantUpdate) RemoveOrderStatusEvents(v ...*OrderStatusEvent) *RestaurantUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
 	}
-	return affected
+	return _u.RemoveOrderStatusEventIDs(ids...)
 }
 
-// Exec executes the query.
-func (_u *RestaurantUpdate) Exec(ctx context.Context) error {
-	_, err := _u.Save(ctx)
-	return err
+// ClearPayments clears all "payments" edges to the Payment entity.
+func (_u *RestaurantUpdate) ClearPayments() *RestaurantUpdate {
+	_u.mutation.ClearPayments()
+	return _u
 }
 
-// ExecX is like Exec, but panics if an error occurs.
-func (_u *RestaurantUpdate) ExecX(ctx context.Context) {
-	if err := _u.Exec(ctx); err != nil {
-		panic(err)
-	}
+// RemovePaymentIDs removes the "payments" edge to Payment entities by IDs.
+func (_u *RestaurantUpdate) RemovePaymentIDs(ids ...uuid.UUID) *RestaurantUpdate {
+	_u.mutation.RemovePaymentIDs(ids...)
+	return _u
 }
 
-// defaults sets the default values of the builder before save.
+// RemovePayments removes "payments" edges to Payment entities.
+func (_u *RestaurantUpdate) RemovePayments(v ...*Payment) *RestaurantUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemovePaymentIDs(ids...)
+}
+
+// ClearRefunds clears all "refunds" edges to the Refund entity.
+func (_u *RestaurantUpdate) ClearRefunds() *RestaurantUpdate {
+	_u.mutation.ClearRefunds()
+	return _u
+}
+
+// RemoveRefundIDs removes the "refunds" edge to Refund entities by IDs.
+func (_u *RestaurantUpdate) RemoveRefundIDs(ids ...uuid.UUID) *RestaurantUpdate {
+	_u.mutation.RemoveRefundIDs(ids...)
+	return _u
+}
+
+// RemoveRefunds removes "refunds" edges to Refund entities.
+func (_u *RestaurantUpdate) RemoveRefunds(v ...*Refund) *RestaurantUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveRefundIDs(ids...)
+}
+
+// ClearOrderNumberSequences clears all "order_number_sequences" edges to the OrderNumberSequence entity.
+func (_u *RestaurantUpdate) ClearOrderNumberSequences() *RestaurantUpdate {
+	_u.mutation.ClearOrderNumberSequences()
+	return _u
+}
+
+// RemoveOrderNumberSequenceIDs removes the "order_number_sequences" edge to OrderNumberSequence entities by IDs.
+func (_u *RestaurantUpdate) RemoveOrderNumberSequenceIDs(ids ...uuid.UUID) *RestaurantUpdate {
+	_u.mutation.RemoveOrderNumberSequenceIDs(ids...)
+	return _u
+}
+
+// RemoveOrderNumberSequences removes "order_number_sequences" edges to OrderNumberSequence entities.
+func (_u *RestaurantUpdate) RemoveOrderNumberSequences(v ...*OrderNumberSequence) *RestaurantUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveOrderNumberSequenceIDs(ids...)
+}
+
+// ClearOrderEvents clears all "order_events" edges to the OrderEvent entity.
+func (_u *RestaurantUpdate) ClearOrderEvents() *RestaurantUpdate {
+	_u.mutation.ClearOrderEvents()
+	return _u
+}
+
+// RemoveOrderEventIDs removes the "order_events" edge to OrderEvent entities by IDs.
+func (_u *RestaurantUpdate) RemoveOrderEventIDs(ids ...uuid.UUID) *RestaurantUpdate {
+	_u.mutation.RemoveOrderEventIDs(ids...)
+	return _u
+}
+
+// RemoveOrderEvents removes "order_events" edges to OrderEvent entities.
+func (_u *RestaurantUpdate) RemoveOrderEvents(v ...*OrderEvent) *RestaurantUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveOrderEventIDs(ids...)
+}
+
+// ClearStations clears all "stations" edges to the Station entity.
+func (_u *RestaurantUpdate) ClearStations() *RestaurantUpdate {
+	_u.mutation.ClearStations()
+	return _u
+}
+
+// RemoveStationIDs removes the "stations" edge to Station entities by IDs.
+func (_u *RestaurantUpdate) RemoveStationIDs(ids ...uuid.UUID) *RestaurantUpdate {
+	_u.mutation.RemoveStationIDs(ids...)
+	return _u
+}
+
+// RemoveStations removes "stations" edges to Station entities.
+func (_u *RestaurantUpdate) RemoveStations(v ...*Station) *RestaurantUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveStationIDs(ids...)
+}
+
+// ClearStationTickets clears all "station_tickets" edges to the StationTicket entity.
+func (_u *RestaurantUpdate) ClearStationTickets() *RestaurantUpdate {
+	_u.mutation.ClearStationTickets()
+	return _u
+}
+
+// RemoveStationTicketIDs removes the "station_tickets" edge to StationTicket entities by IDs.
+func (_u *RestaurantUpdate) RemoveStationTicketIDs(ids ...uuid.UUID) *RestaurantUpdate {
+	_u.mutation.RemoveStationTicketIDs(ids...)
+	return _u
+}
+
+// RemoveStationTickets removes "station_tickets" edges to StationTicket entities.
+func (_u *RestaurantUpdate) RemoveStationTickets(v ...*StationTicket) *RestaurantUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveStationTicketIDs(ids...)
+}
+
+// ClearTables clears all "tables" edges to the Table entity.
+func (_u *RestaurantUpdate) ClearTables() *RestaurantUpdate {
+	_u.mutation.ClearTables()
+	return _u
+}
+
+// RemoveTableIDs removes the "tables" edge to Table entities by IDs.
+func (_u *RestaurantUpdate) RemoveTableIDs(ids ...uuid.UUID) *RestaurantUpdate {
+	_u.mutation.RemoveTableIDs(ids...)
+	return _u
+}
+
+// RemoveTables removes "tables" edges to Table entities.
+func (_u *RestaurantUpdate) RemoveTables(v ...*Table) *RestaurantUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveTableIDs(ids...)
+}
+
+// ClearTableSessions clears all "table_sessions" edges to the TableSession entity.
+func (_u *RestaurantUpdate) ClearTableSessions() *RestaurantUpdate {
+	_u.mutation.ClearTableSessions()
+	return _u
+}
+
+// RemoveTableSessionIDs removes the "table_sessions" edge to TableSession entities by IDs.
+func (_u *RestaurantUpdate) RemoveTableSessionIDs(ids ...uuid.UUID) *RestaurantUpdate {
+	_u.mutation.RemoveTableSessionIDs(ids...)
+	return _u
+}
+
+// RemoveTableSessions removes "table_sessions" edges to TableSession entities.
+func (_u *RestaurantUpdate) RemoveTableSessions(v ...*TableSession) *RestaurantUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveTableSessionIDs(ids...)
+}
+
+// ClearDeliveryZones clears all "delivery_zones" edges to the DeliveryZone entity.
+func (_u *RestaurantUpdate) ClearDeliveryZones() *RestaurantUpdate {
+	_u.mutation.ClearDeliveryZones()
+	return _u
+}
+
+// RemoveDeliveryZoneIDs removes the "delivery_zones" edge to DeliveryZone entities by IDs.
+func (_u *RestaurantUpdate) RemoveDeliveryZoneIDs(ids ...uuid.UUID) *RestaurantUpdate {
+	_u.mutation.RemoveDeliveryZoneIDs(ids...)
+	return _u
+}
+
+// RemoveDeliveryZones removes "delivery_zones" edges to DeliveryZone entities.
+func (_u *RestaurantUpdate) RemoveDeliveryZones(v ...*DeliveryZone) *RestaurantUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveDeliveryZoneIDs(ids...)
+}
+
+// ClearIngredients clears all "ingredients" edges to the Ingredient entity.
+func (_u *RestaurantUpdate) ClearIngredients() *RestaurantUpdate {
+	_u.mutation.ClearIngredients()
+	return _u
+}
+
+// RemoveIngredientIDs removes the "ingredients" edge to Ingredient entities by IDs.
+func (_u *RestaurantUpdate) RemoveIngredientIDs(ids ...uuid.UUID) *RestaurantUpdate {
+	_u.mutation.RemoveIngredientIDs(ids...)
+	return _u
+}
+
+// RemoveIngredients removes "ingredients" edges to Ingredient entities.
+func (_u *RestaurantUpdate) RemoveIngredients(v ...*Ingredient) *RestaurantUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveIngredientIDs(ids...)
+}
+
+// ClearMenus clears all "menus" edges to the Menu entity.
+func (_u *RestaurantUpdate) ClearMenus() *RestaurantUpdate {
+	_u.mutation.ClearMenus()
+	return _u
+}
+
+// RemoveMenuIDs removes the "menus" edge to Menu entities by IDs.
+func (_u *RestaurantUpdate) RemoveMenuIDs(ids ...uuid.UUID) *RestaurantUpdate {
+	_u.mutation.RemoveMenuIDs(ids...)
+	return _u
+}
+
+// RemoveMenus removes "menus" edges to Menu entities.
+func (_u *RestaurantUpdate) RemoveMenus(v ...*Menu) *RestaurantUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveMenuIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *RestaurantUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *RestaurantUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *RestaurantUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *RestaurantUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
 func (_u *RestaurantUpdate) defaults() {
 	if _, ok := _u.mutation.UpdateTime(); !ok {
 		v := restaurant.UpdateDefaultUpdateTime()
@@ -509,6 +1180,41 @@ func (_u *RestaurantUpdate) check() error {
 			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Restaurant.status": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.OperatingHours(); ok {
+		if err := v.Validate(); err != nil {
+			return &ValidationError{Name: "operating_hours", err: fmt.Errorf(`ent: validator failed for field "Restaurant.operating_hours": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.TaxRateBps(); ok {
+		if err := restaurant.TaxRateBpsValidator(v); err != nil {
+			return &ValidationError{Name: "tax_rate_bps", err: fmt.Errorf(`ent: validator failed for field "Restaurant.tax_rate_bps": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.SlotMinutes(); ok {
+		if err := restaurant.SlotMinutesValidator(v); err != nil {
+			return &ValidationError{Name: "slot_minutes", err: fmt.Errorf(`ent: validator failed for field "Restaurant.slot_minutes": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.SlotCapacity(); ok {
+		if err := restaurant.SlotCapacityValidator(v); err != nil {
+			return &ValidationError{Name: "slot_capacity", err: fmt.Errorf(`ent: validator failed for field "Restaurant.slot_capacity": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.KitchenLeadMinutes(); ok {
+		if err := restaurant.KitchenLeadMinutesValidator(v); err != nil {
+			return &ValidationError{Name: "kitchen_lead_minutes", err: fmt.Errorf(`ent: validator failed for field "Restaurant.kitchen_lead_minutes": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.OrderNumberReset(); ok {
+		if err := restaurant.OrderNumberResetValidator(v); err != nil {
+			return &ValidationError{Name: "order_number_reset", err: fmt.Errorf(`ent: validator failed for field "Restaurant.order_number_reset": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.OrderEventSeq(); ok {
+		if err := restaurant.OrderEventSeqValidator(v); err != nil {
+			return &ValidationError{Name: "order_event_seq", err: fmt.Errorf(`ent: validator failed for field "Restaurant.order_event_seq": %w`, err)}
+		}
+	}
 	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "Restaurant.user"`)
 	}
@@ -560,12 +1266,47 @@ func (_u *RestaurantUpdate) sqlSave(ctx context.Context) (_node int, err error)
 	if value, ok := _u.mutation.Country(); ok {
 		_spec.SetField(restaurant.FieldCountry, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.Latitude(); ok {
+		_spec.SetField(restaurant.FieldLatitude, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedLatitude(); ok {
+		_spec.AddField(restaurant.FieldLatitude, field.TypeFloat64, value)
+	}
+	if _u.mutation.LatitudeCleared() {
+		_spec.ClearField(restaurant.FieldLatitude, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.Longitude(); ok {
+		_spec.SetField(restaurant.FieldLongitude, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedLongitude(); ok {
+		_spec.AddField(restaurant.FieldLongitude, field.TypeFloat64, value)
+	}
+	if _u.mutation.LongitudeCleared() {
+		_spec.ClearField(restaurant.FieldLongitude, field.TypeFloat64)
+	}
 	if value, ok := _u.mutation.LogoURL(); ok {
 		_spec.SetField(restaurant.FieldLogoURL, field.TypeString, value)
 	}
 	if _u.mutation.LogoURLCleared() {
 		_spec.ClearField(restaurant.FieldLogoURL, field.TypeString)
 	}
+	if value, ok := _u.mutation.LogoThumbnailURL(); ok {
+		_spec.SetField(restaurant.FieldLogoThumbnailURL, field.TypeString, value)
+	}
+	if _u.mutation.LogoThumbnailURLCleared() {
+		_spec.ClearField(restaurant.FieldLogoThumbnailURL, field.TypeString)
+	}
+	if value, ok := _u.mutation.LogoKeys(); ok {
+		_spec.SetField(restaurant.FieldLogoKeys, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedLogoKeys(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, restaurant.FieldLogoKeys, value)
+		})
+	}
+	if _u.mutation.LogoKeysCleared() {
+		_spec.ClearField(restaurant.FieldLogoKeys, field.TypeJSON)
+	}
 	if value, ok := _u.mutation.CoverImageURL(); ok {
 		_spec.SetField(restaurant.FieldCoverImageURL, field.TypeString, value)
 	}
@@ -584,6 +1325,42 @@ func (_u *RestaurantUpdate) sqlSave(ctx context.Context) (_node int, err error)
 	if value, ok := _u.mutation.Currency(); ok {
 		_spec.SetField(restaurant.FieldCurrency, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.TaxRateBps(); ok {
+		_spec.SetField(restaurant.FieldTaxRateBps, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedTaxRateBps(); ok {
+		_spec.AddField(restaurant.FieldTaxRateBps, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Timezone(); ok {
+		_spec.SetField(restaurant.FieldTimezone, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.SlotMinutes(); ok {
+		_spec.SetField(restaurant.FieldSlotMinutes, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedSlotMinutes(); ok {
+		_spec.AddField(restaurant.FieldSlotMinutes, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.SlotCapacity(); ok {
+		_spec.SetField(restaurant.FieldSlotCapacity, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedSlotCapacity(); ok {
+		_spec.AddField(restaurant.FieldSlotCapacity, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.KitchenLeadMinutes(); ok {
+		_spec.SetField(restaurant.FieldKitchenLeadMinutes, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedKitchenLeadMinutes(); ok {
+		_spec.AddField(restaurant.FieldKitchenLeadMinutes, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.OrderNumberReset(); ok {
+		_spec.SetField(restaurant.FieldOrderNumberReset, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.OrderEventSeq(); ok {
+		_spec.SetField(restaurant.FieldOrderEventSeq, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedOrderEventSeq(); ok {
+		_spec.AddField(restaurant.FieldOrderEventSeq, field.TypeInt64, value)
+	}
 	if _u.mutation.UserCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -793,628 +1570,2471 @@ func (_u *RestaurantUpdate) sqlSave(ctx context.Context) (_node int, err error)
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
-		if _, ok := err.(*sqlgraph.NotFoundError); ok {
-			err = &NotFoundError{restaurant.Label}
-		} else if sqlgraph.IsConstraintError(err) {
-			err = &ConstraintError{msg: err.Error(), wrap: err}
+	if _u.mutation.OrderStatusEventsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   restaurant.OrderStatusEventsTable,
+			Columns: []string{restaurant.OrderStatusEventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(orderstatusevent.FieldID, field.TypeUUID),
+			},
 		}
-		return 0, err
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	_u.mutation.done = true
-	return _node, nil
-}
-
-// RestaurantUpdateOne is the builder for updating a single Restaurant entity.
-type RestaurantUpdateOne struct {
-	config
-	fields   []string
-	hooks    []Hook
-	mutation *RestaurantMutation
-}
-
-// SetUpdateTime sets the "update_time" field.
-func (_u *RestaurantUpdateOne) SetUpdateTime(v time.Time) *RestaurantUpdateOne {
-	_u.mutation.SetUpdateTime(v)
-	return _u
-}
-
-// SetName sets the "name" field.
-func (_u *RestaurantUpdateOne) SetName(v string) *RestaurantUpdateOne {
-	_u.mutation.SetName(v)
-	return _u
-}
-
-// SetNillableName sets the "name" field if the given value is not nil.
-func (_u *RestaurantUpdateOne) SetNillableName(v *string) *RestaurantUpdateOne {
-	if v != nil {
-		_u.SetName(*v)
+	if nodes := _u.mutation.RemovedOrderStatusEventsIDs(); len(nodes) > 0 && !_u.mutation.OrderStatusEventsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   restaurant.OrderStatusEventsTable,
+			Columns: []string{restaurant.OrderStatusEventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(orderstatusevent.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return _u
-}
-
-// SetDescription sets the "description" field.
-func (_u *RestaurantUpdateOne) SetDescription(v string) *RestaurantUpdateOne {
-	_u.mutation.SetDescription(v)
-	return _u
-}
-
-// SetNillableDescription sets the "description" field if the given value is not nil.
-func (_u *RestaurantUpdateOne) SetNillableDescription(v *string) *RestaurantUpdateOne {
-	if v != nil {
-		_u.SetDescription(*v)
-	}
-	return _u
-}
-
-// ClearDescription clears the value of the "description" field.
-func (_u *RestaurantUpdateOne) ClearDescription() *RestaurantUpdateOne {
-	_u.mutation.ClearDescription()
-	return _u
-}
-
-// SetPhone sets the "phone" field.
-func (_u *RestaurantUpdateOne) SetPhone(v string) *RestaurantUpdateOne {
-	_u.mutation.SetPhone(v)
-	return _u
-}
-
-// SetNillablePhone sets the "phone" field if the given value is not nil.
-func (_u *RestaurantUpdateOne) SetNillablePhone(v *string) *RestaurantUpdateOne {
-	if v != nil {
-		_u.SetPhone(*v)
+	if nodes := _u.mutation.OrderStatusEventsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   restaurant.OrderStatusEventsTable,
+			Columns: []string{restaurant.OrderStatusEventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(orderstatusevent.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	return _u
-}
-
-// SetEmail sets the "email" field.
-func (_u *RestaurantUpdateOne) SetEmail(v string) *RestaurantUpdateOne {
-	_u.mutation.SetEmail(v)
-	return _u
-}
-
-// SetNillableEmail sets the "email" field if the given value is not nil.
-func (_u *RestaurantUpdateOne) SetNillableEmail(v *string) *RestaurantUpdateOne {
-	if v != nil {
-		_u.SetEmail(*v)
+	if _u.mutation.PaymentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   restaurant.PaymentsTable,
+			Columns: []string{restaurant.PaymentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(payment.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return _u
-}
-
-// SetAddress sets the "address" field.
-func (_u *RestaurantUpdateOne) SetAddress(v string) *RestaurantUpdateOne {
-	_u.mutation.SetAddress(v)
-	return _u
-}
-
-// SetNillableAddress sets the "address" field if the given value is not nil.
-func (_u *RestaurantUpdateOne) SetNillableAddress(v *string) *RestaurantUpdateOne {
-	if v != nil {
-		_u.SetAddress(*v)
+	if nodes := _u.mutation.RemovedPaymentsIDs(); len(nodes) > 0 && !_u.mutation.PaymentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   restaurant.PaymentsTable,
+			Columns: []string{restaurant.PaymentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(payment.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return _u
-}
-
-// SetCity sets the "city" field.
-func (_u *RestaurantUpdateOne) SetCity(v string) *RestaurantUpdateOne {
-	_u.mutation.SetCity(v)
-	return _u
-}
-
-// SetNillableCity sets the "city" field if the given value is not nil.
-func (_u *RestaurantUpdateOne) SetNillableCity(v *string) *RestaurantUpdateOne {
-	if v != nil {
-		_u.SetCity(*v)
+	if nodes := _u.mutation.PaymentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   restaurant.PaymentsTable,
+			Columns: []string{restaurant.PaymentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(payment.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	return _u
-}
-
-// SetState sets the "state" field.
-func (_u *RestaurantUpdateOne) SetState(v string) *RestaurantUpdateOne {
-	_u.mutation.SetState(v)
-	return _u
-}
-
-// SetNillableState sets the "state" field if the given value is not nil.
-func (_u *RestaurantUpdateOne) SetNillableState(v *string) *RestaurantUpdateOne {
-	if v != nil {
-		_u.SetState(*v)
+	if _u.mutation.RefundsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   restaurant.RefundsTable,
+			Columns: []string{restaurant.RefundsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(refund.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return _u
-}
-
-// SetZipCode sets the "zip_code" field.
-func (_u *RestaurantUpdateOne) SetZipCode(v string) *RestaurantUpdateOne {
-	_u.mutation.SetZipCode(v)
-	return _u
-}
-
-// SetNillableZipCode sets the "zip_code" field if the given value is not nil.
-func (_u *RestaurantUpdateOne) SetNillableZipCode(v *string) *RestaurantUpdateOne {
-	if v != nil {
-		_u.SetZipCode(*v)
+	if nodes := _u.mutation.RemovedRefundsIDs(); len(nodes) > 0 && !_u.mutation.RefundsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   restaurant.RefundsTable,
+			Columns: []string{restaurant.RefundsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(refund.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return _u
-}
-
-// SetCountry sets the "country" field.
-func (_u *RestaurantUpdateOne) SetCountry(v string) *RestaurantUpdateOne {
-	_u.mutation.SetCountry(v)
-	return _u
-}
-
-// SetNillableCountry sets the "country" field if the given value is not nil.
-func (_u *RestaurantUpdateOne) SetNillableCountry(v *string) *RestaurantUpdateOne {
-	if v != nil {
-		_u.SetCountry(*v)
+	if nodes := _u.mutation.RefundsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   restaurant.RefundsTable,
+			Columns: []string{restaurant.RefundsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(refund.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	return _u
-}
-
-// SetLogoURL sets the "logo_url" field.
-func (_u *RestaurantUpdateOne) SetLogoURL(v string) *RestaurantUpdateOne {
-	_u.mutation.SetLogoURL(v)
-	return _u
-}
-
-// SetNillableLogoURL sets the "logo_url" field if the given value is not nil.
-func (_u *RestaurantUpdateOne) SetNillableLogoURL(v *string) *RestaurantUpdateOne {
-	if v != nil {
-		_u.SetLogoURL(*v)
+	if _u.mutation.OrderNumberSequencesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   restaurant.OrderNumberSequencesTable,
+			Columns: []string{restaurant.OrderNumberSequencesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ordernumbersequence.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return _u
-}
-
-// ClearLogoURL clears the value of the "logo_url" field.
-func (_u *RestaurantUpdateOne) ClearLogoURL() *RestaurantUpdateOne {
-	_u.mutation.ClearLogoURL()
-	return _u
-}
-
-// SetCoverImageURL sets the "cover_image_url" field.
-func (_u *RestaurantUpdateOne) SetCoverImageURL(v string) *RestaurantUpdateOne {
-	_u.mutation.SetCoverImageURL(v)
-	return _u
-}
-
-// SetNillableCoverImageURL sets the "cover_image_url" field if the given value is not nil.
-func (_u *RestaurantUpdateOne) SetNillableCoverImageURL(v *string) *RestaurantUpdateOne {
-	if v != nil {
-		_u.SetCoverImageURL(*v)
+	if nodes := _u.mutation.RemovedOrderNumberSequencesIDs(); len(nodes) > 0 && !_u.mutation.OrderNumberSequencesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   restaurant.OrderNumberSequencesTable,
+			Columns: []string{restaurant.OrderNumberSequencesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ordernumbersequence.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return _u
-}
-
-// ClearCoverImageURL clears the value of the "cover_image_url" field.
-func (_u *RestaurantUpdateOne) ClearCoverImageURL() *RestaurantUpdateOne {
-	_u.mutation.ClearCoverImageURL()
-	return _u
-}
-
-// SetStatus sets the "status" field.
-func (_u *RestaurantUpdateOne) SetStatus(v restaurant.Status) *RestaurantUpdateOne {
-	_u.mutation.SetStatus(v)
-	return _u
-}
-
-// SetNillableStatus sets the "status" field if the given value is not nil.
-func (_u *RestaurantUpdateOne) SetNillableStatus(v *restaurant.Status) *RestaurantUpdateOne {
-	if v != nil {
-		_u.SetStatus(*v)
+	if nodes := _u.mutation.OrderNumberSequencesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   restaurant.OrderNumberSequencesTable,
+			Columns: []string{restaurant.OrderNumberSequencesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ordernumbersequence.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.OrderEventsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   restaurant.OrderEventsTable,
+			Columns: []string{restaurant.OrderEventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(orderevent.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedOrderEventsIDs(); len(nodes) > 0 && !_u.mutation.OrderEventsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   restaurant.OrderEventsTable,
+			Columns: []string{restaurant.OrderEventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(orderevent.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.OrderEventsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   restaurant.OrderEventsTable,
+			Columns: []string{restaurant.OrderEventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(orderevent.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.StationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   restaurant.StationsTable,
+			Columns: []string{restaurant.StationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(station.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedStationsIDs(); len(nodes) > 0 && !_u.mutation.StationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   restaurant.StationsTable,
+			Columns: []string{restaurant.StationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(station.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.StationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   restaurant.StationsTable,
+			Columns: []string{restaurant.StationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(station.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.StationTicketsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   restaurant.StationTicketsTable,
+			Columns: []string{restaurant.StationTicketsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(stationticket.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedStationTicketsIDs(); len(nodes) > 0 && !_u.mutation.StationTicketsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   restaurant.StationTicketsTable,
+			Columns: []string{restaurant.StationTicketsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(stationticket.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.StationTicketsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   restaurant.StationTicketsTable,
+			Columns: []string{restaurant.StationTicketsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(stationticket.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.TablesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   restaurant.TablesTable,
+			Columns: []string{restaurant.TablesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(table.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedTablesIDs(); len(nodes) > 0 && !_u.mutation.TablesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   restaurant.TablesTable,
+			Columns: []string{restaurant.TablesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(table.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TablesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   restaurant.TablesTable,
+			Columns: []string{restaurant.TablesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(table.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.TableSessionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   restaurant.TableSessionsTable,
+			Columns: []string{restaurant.TableSessionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tablesession.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedTableSessionsIDs(); len(nodes) > 0 && !_u.mutation.TableSessionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   restaurant.TableSessionsTable,
+			Columns: []string{restaurant.TableSessionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tablesession.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TableSessionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   restaurant.TableSessionsTable,
+			Columns: []string{restaurant.TableSessionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tablesession.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.DeliveryZonesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   restaurant.DeliveryZonesTable,
+			Columns: []string{restaurant.DeliveryZonesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(deliveryzone.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedDeliveryZonesIDs(); len(nodes) > 0 && !_u.mutation.DeliveryZonesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   restaurant.DeliveryZonesTable,
+			Columns: []string{restaurant.DeliveryZonesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(deliveryzone.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.DeliveryZonesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   restaurant.DeliveryZonesTable,
+			Columns: []string{restaurant.DeliveryZonesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(deliveryzone.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.IngredientsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   restaurant.IngredientsTable,
+			Columns: []string{restaurant.IngredientsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ingredient.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedIngredientsIDs(); len(nodes) > 0 && !_u.mutation.IngredientsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   restaurant.IngredientsTable,
+			Columns: []string{restaurant.IngredientsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ingredient.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.IngredientsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   restaurant.IngredientsTable,
+			Columns: []string{restaurant.IngredientsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ingredient.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.MenusCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   restaurant.MenusTable,
+			Columns: []string{restaurant.MenusColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(menu.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedMenusIDs(); len(nodes) > 0 && !_u.mutation.MenusCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   restaurant.MenusTable,
+			Columns: []string{restaurant.MenusColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(menu.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.MenusIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   restaurant.MenusTable,
+			Columns: []string{restaurant.MenusColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(menu.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{restaurant.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// RestaurantUpdateOne is the builder for updating a single Restaurant entity.
+type RestaurantUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *RestaurantMutation
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_u *RestaurantUpdateOne) SetUpdateTime(v time.Time) *RestaurantUpdateOne {
+	_u.mutation.SetUpdateTime(v)
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *RestaurantUpdateOne) SetName(v string) *RestaurantUpdateOne {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *RestaurantUpdateOne) SetNillableName(v *string) *RestaurantUpdateOne {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetDescription sets the "description" field.
+func (_u *RestaurantUpdateOne) SetDescription(v string) *RestaurantUpdateOne {
+	_u.mutation.SetDescription(v)
+	return _u
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_u *RestaurantUpdateOne) SetNillableDescription(v *string) *RestaurantUpdateOne {
+	if v != nil {
+		_u.SetDescription(*v)
+	}
+	return _u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (_u *RestaurantUpdateOne) ClearDescription() *RestaurantUpdateOne {
+	_u.mutation.ClearDescription()
+	return _u
+}
+
+// SetPhone sets the "phone" field.
+func (_u *RestaurantUpdateOne) SetPhone(v string) *RestaurantUpdateOne {
+	_u.mutation.SetPhone(v)
+	return _u
+}
+
+// SetNillablePhone sets the "phone" field if the given value is not nil.
+func (_u *RestaurantUpdateOne) SetNillablePhone(v *string) *RestaurantUpdateOne {
+	if v != nil {
+		_u.SetPhone(*v)
+	}
+	return _u
+}
+
+// SetEmail sets the "email" field.
+func (_u *RestaurantUpdateOne) SetEmail(v string) *RestaurantUpdateOne {
+	_u.mutation.SetEmail(v)
+	return _u
+}
+
+// SetNillableEmail sets the "email" field if the given value is not nil.
+func (_u *RestaurantUpdateOne) SetNillableEmail(v *string) *RestaurantUpdateOne {
+	if v != nil {
+		_u.SetEmail(*v)
+	}
+	return _u
+}
+
+// SetAddress sets the "address" field.
+func (_u *RestaurantUpdateOne) SetAddress(v string) *RestaurantUpdateOne {
+	_u.mutation.SetAddress(v)
+	return _u
+}
+
+// SetNillableAddress sets the "address" field if the given value is not nil.
+func (_u *RestaurantUpdateOne) SetNillableAddress(v *string) *RestaurantUpdateOne {
+	if v != nil {
+		_u.SetAddress(*v)
+	}
+	return _u
+}
+
+// SetCity sets the "city" field.
+func (_u *RestaurantUpdateOne) SetCity(v string) *RestaurantUpdateOne {
+	_u.mutation.SetCity(v)
+	return _u
+}
+
+// SetNillableCity sets the "city" field if the given value is not nil.
+func (_u *RestaurantUpdateOne) SetNillableCity(v *string) *RestaurantUpdateOne {
+	if v != nil {
+		_u.SetCity(*v)
+	}
+	return _u
+}
+
+// SetState sets the "state" field.
+func (_u *RestaurantUpdateOne) SetState(v string) *RestaurantUpdateOne {
+	_u.mutation.SetState(v)
+	return _u
+}
+
+// SetNillableState sets the "state" field if the given value is not nil.
+func (_u *RestaurantUpdateOne) SetNillableState(v *string) *RestaurantUpdateOne {
+	if v != nil {
+		_u.SetState(*v)
+	}
+	return _u
+}
+
+// SetZipCode sets the "zip_code" field.
+func (_u *RestaurantUpdateOne) SetZipCode(v string) *RestaurantUpdateOne {
+	_u.mutation.SetZipCode(v)
+	return _u
+}
+
+// SetNillableZipCode sets the "zip_code" field if the given value is not nil.
+func (_u *RestaurantUpdateOne) SetNillableZipCode(v *string) *RestaurantUpdateOne {
+	if v != nil {
+		_u.SetZipCode(*v)
+	}
+	return _u
+}
+
+// SetCountry sets the "country" field.
+func (_u *RestaurantUpdateOne) SetCountry(v string) *RestaurantUpdateOne {
+	_u.mutation.SetCountry(v)
+	return _u
+}
+
+// SetNillableCountry sets the "country" field if the given value is not nil.
+func (_u *RestaurantUpdateOne) SetNillableCountry(v *string) *RestaurantUpdateOne {
+	if v != nil {
+		_u.SetCountry(*v)
+	}
+	return _u
+}
+
+// SetLatitude sets the "latitude" field.
+func (_u *RestaurantUpdateOne) SetLatitude(v float64) *RestaurantUpdateOne {
+	_u.mutation.ResetLatitude()
+	_u.mutation.SetLatitude(v)
+	return _u
+}
+
+// SetNillableLatitude sets the "latitude" field if the given value is not nil.
+func (_u *RestaurantUpdateOne) SetNillableLatitude(v *float64) *RestaurantUpdateOne {
+	if v != nil {
+		_u.SetLatitude(*v)
+	}
+	return _u
+}
+
+// AddLatitude adds value to the "latitude" field.
+func (_u *RestaurantUpdateOne) AddLatitude(v float64) *RestaurantUpdateOne {
+	_u.mutation.AddLatitude(v)
+	return _u
+}
+
+// ClearLatitude clears the value of the "latitude" field.
+func (_u *RestaurantUpdateOne) ClearLatitude() *RestaurantUpdateOne {
+	_u.mutation.ClearLatitude()
+	return _u
+}
+
+// SetLongitude sets the "longitude" field.
+func (_u *RestaurantUpdateOne) SetLongitude(v float64) *RestaurantUpdateOne {
+	_u.mutation.ResetLongitude()
+	_u.mutation.SetLongitude(v)
+	return _u
+}
+
+// SetNillableLongitude sets the "longitude" field if the given value is not nil.
+func (_u *RestaurantUpdateOne) SetNillableLongitude(v *float64) *RestaurantUpdateOne {
+	if v != nil {
+		_u.SetLongitude(*v)
+	}
+	return _u
+}
+
+// AddLongitude adds value to the "longitude" field.
+func (_u *RestaurantUpdateOne) AddLongitude(v float64) *RestaurantUpdateOne {
+	_u.mutation.AddLongitude(v)
+	return _u
+}
+
+// ClearLongitude clears the value of the "longitude" field.
+func (_u *RestaurantUpdateOne) ClearLongitude() *RestaurantUpdateOne {
+	_u.mutation.ClearLongitude()
+	return _u
+}
+
+// SetLogoURL sets the "logo_url" field.
+func (_u *RestaurantUpdateOne) SetLogoURL(v string) *RestaurantUpdateOne {
+	_u.mutation.SetLogoURL(v)
+	return _u
+}
+
+// SetNillableLogoURL sets the "logo_url" field if the given value is not nil.
+func (_u *RestaurantUpdateOne) SetNillableLogoURL(v *string) *RestaurantUpdateOne {
+	if v != nil {
+		_u.SetLogoURL(*v)
+	}
+	return _u
+}
+
+// ClearLogoURL clears the value of the "logo_url" field.
+func (_u *RestaurantUpdateOne) ClearLogoURL() *RestaurantUpdateOne {
+	_u.mutation.ClearLogoURL()
+	return _u
+}
+
+// SetLogoThumbnailURL sets the "logo_thumbnail_url" field.
+func (_u *RestaurantUpdateOne) SetLogoThumbnailURL(v string) *RestaurantUpdateOne {
+	_u.mutation.SetLogoThumbnailURL(v)
+	return _u
+}
+
+// SetNillableLogoThumbnailURL sets the "logo_thumbnail_url" field if the given value is not nil.
+func (_u *RestaurantUpdateOne) SetNillableLogoThumbnailURL(v *string) *RestaurantUpdateOne {
+	if v != nil {
+		_u.SetLogoThumbnailURL(*v)
+	}
+	return _u
+}
+
+// ClearLogoThumbnailURL clears the value of the "logo_thumbnail_url" field.
+func (_u *RestaurantUpdateOne) ClearLogoThumbnailURL() *RestaurantUpdateOne {
+	_u.mutation.ClearLogoThumbnailURL()
+	return _u
+}
+
+// SetLogoKeys sets the "logo_keys" field.
+func (_u *RestaurantUpdateOne) SetLogoKeys(v []string) *RestaurantUpdateOne {
+	_u.mutation.SetLogoKeys(v)
+	return _u
+}
+
+// AppendLogoKeys appends value to the "logo_keys" field.
+func (_u *RestaurantUpdateOne) AppendLogoKeys(v []string) *RestaurantUpdateOne {
+	_u.mutation.AppendLogoKeys(v)
+	return _u
+}
+
+// ClearLogoKeys clears the value of the "logo_keys" field.
+func (_u *RestaurantUpdateOne) ClearLogoKeys() *RestaurantUpdateOne {
+	_u.mutation.ClearLogoKeys()
+	return _u
+}
+
+// SetCoverImageURL sets the "cover_image_url" field.
+func (_u *RestaurantUpdateOne) SetCoverImageURL(v string) *RestaurantUpdateOne {
+	_u.mutation.SetCoverImageURL(v)
+	return _u
+}
+
+// SetNillableCoverImageURL sets the "cover_image_url" field if the given value is not nil.
+func (_u *RestaurantUpdateOne) SetNillableCoverImageURL(v *string) *RestaurantUpdateOne {
+	if v != nil {
+		_u.SetCoverImageURL(*v)
+	}
+	return _u
+}
+
+// ClearCoverImageURL clears the value of the "cover_image_url" field.
+func (_u *RestaurantUpdateOne) ClearCoverImageURL() *RestaurantUpdateOne {
+	_u.mutation.ClearCoverImageURL()
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *RestaurantUpdateOne) SetStatus(v restaurant.Status) *RestaurantUpdateOne {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *RestaurantUpdateOne) SetNillableStatus(v *restaurant.Status) *RestaurantUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetOperatingHours sets the "operating_hours" field.
+func (_u *RestaurantUpdateOne) SetOperatingHours(v *hours.Schedule) *RestaurantUpdateOne {
+	_u.mutation.SetOperatingHours(v)
+	return _u
+}
+
+// ClearOperatingHours clears the value of the "operating_hours" field.
+func (_u *RestaurantUpdateOne) ClearOperatingHours() *RestaurantUpdateOne {
+	_u.mutation.ClearOperatingHours()
+	return _u
+}
+
+// SetCurrency sets the "currency" field.
+func (_u *RestaurantUpdateOne) SetCurrency(v string) *RestaurantUpdateOne {
+	_u.mutation.SetCurrency(v)
+	return _u
+}
+
+// SetNillableCurrency sets the "currency" field if the given value is not nil.
+func (_u *RestaurantUpdateOne) SetNillableCurrency(v *string) *RestaurantUpdateOne {
+	if v != nil {
+		_u.SetCurrency(*v)
+	}
+	return _u
+}
+
+// SetTaxRateBps sets the "tax_rate_bps" field.
+func (_u *RestaurantUpdateOne) SetTaxRateBps(v int) *RestaurantUpdateOne {
+	_u.mutation.ResetTaxRateBps()
+	_u.mutation.SetTaxRateBps(v)
+	return _u
+}
+
+// SetNillableTaxRateBps sets the "tax_rate_bps" field if the given value is not nil.
+func (_u *RestaurantUpdateOne) SetNillableTaxRateBps(v *int) *RestaurantUpdateOne {
+	if v != nil {
+		_u.SetTaxRateBps(*v)
+	}
+	return _u
+}
+
+// AddTaxRateBps adds value to the "tax_rate_bps" field.
+func (_u *RestaurantUpdateOne) AddTaxRateBps(v int) *RestaurantUpdateOne {
+	_u.mutation.AddTaxRateBps(v)
+	return _u
+}
+
+// SetTimezone sets the "timezone" field.
+func (_u *RestaurantUpdateOne) SetTimezone(v string) *RestaurantUpdateOne {
+	_u.mutation.SetTimezone(v)
+	return _u
+}
+
+// SetNillableTimezone sets the "timezone" field if the given value is not nil.
+func (_u *RestaurantUpdateOne) SetNillableTimezone(v *string) *RestaurantUpdateOne {
+	if v != nil {
+		_u.SetTimezone(*v)
+	}
+	return _u
+}
+
+// SetSlotMinutes sets the "slot_minutes" field.
+func (_u *RestaurantUpdateOne) SetSlotMinutes(v int) *RestaurantUpdateOne {
+	_u.mutation.ResetSlotMinutes()
+	_u.mutation.SetSlotMinutes(v)
+	return _u
+}
+
+// SetNillableSlotMinutes sets the "slot_minutes" field if the given value is not nil.
+func (_u *RestaurantUpdateOne) SetNillableSlotMinutes(v *int) *RestaurantUpdateOne {
+	if v != nil {
+		_u.SetSlotMinutes(*v)
+	}
+	return _u
+}
+
+// AddSlotMinutes adds value to the "slot_minutes" field.
+func (_u *RestaurantUpdateOne) AddSlotMinutes(v int) *RestaurantUpdateOne {
+	_u.mutation.AddSlotMinutes(v)
+	return _u
+}
+
+// SetSlotCapacity sets the "slot_capacity" field.
+func (_u *RestaurantUpdateOne) SetSlotCapacity(v int) *RestaurantUpdateOne {
+	_u.mutation.ResetSlotCapacity()
+	_u.mutation.SetSlotCapacity(v)
+	return _u
+}
+
+// SetNillableSlotCapacity sets the "slot_capacity" field if the given value is not nil.
+func (_u *RestaurantUpdateOne) SetNillableSlotCapacity(v *int) *RestaurantUpdateOne {
+	if v != nil {
+		_u.SetSlotCapacity(*v)
+	}
+	return _u
+}
+
+// AddSlotCapacity adds value to the "slot_capacity" field.
+func (_u *RestaurantUpdateOne) AddSlotCapacity(v int) *RestaurantUpdateOne {
+	_u.mutation.AddSlotCapacity(v)
+	return _u
+}
+
+// SetKitchenLeadMinutes sets the "kitchen_lead_minutes" field.
+func (_u *RestaurantUpdateOne) SetKitchenLeadMinutes(v int) *RestaurantUpdateOne {
+	_u.mutation.ResetKitchenLeadMinutes()
+	_u.mutation.SetKitchenLeadMinutes(v)
+	return _u
+}
+
+// SetNillableKitchenLeadMinutes sets the "kitchen_lead_minutes" field if the given value is not nil.
+func (_u *RestaurantUpdateOne) SetNillableKitchenLeadMinutes(v *int) *RestaurantUpdateOne {
+	if v != nil {
+		_u.SetKitchenLeadMinutes(*v)
+	}
+	return _u
+}
+
+// AddKitchenLeadMinutes adds value to the "kitchen_lead_minutes" field.
+func (_u *RestaurantUpdateOne) AddKitchenLeadMinutes(v int) *RestaurantUpdateOne {
+	_u.mutation.AddKitchenLeadMinutes(v)
+	return _u
+}
+
+// SetOrderNumberReset sets the "order_number_reset" field.
+func (_u *RestaurantUpdateOne) SetOrderNumberReset(v restaurant.OrderNumberReset) *RestaurantUpdateOne {
+	_u.mutation.SetOrderNumberReset(v)
+	return _u
+}
+
+// SetNillableOrderNumberReset sets the "order_number_reset" field if the given value is not nil.
+func (_u *RestaurantUpdateOne) SetNillableOrderNumberReset(v *restaurant.OrderNumberReset) *RestaurantUpdateOne {
+	if v != nil {
+		_u.SetOrderNumberReset(*v)
+	}
+	return _u
+}
+
+// SetOrderEventSeq sets the "order_event_seq" field.
+func (_u *RestaurantUpdateOne) SetOrderEventSeq(v int64) *RestaurantUpdateOne {
+	_u.mutation.ResetOrderEventSeq()
+	_u.mutation.SetOrderEventSeq(v)
+	return _u
+}
+
+// SetNillableOrderEventSeq sets the "order_event_seq" field if the given value is not nil.
+func (_u *RestaurantUpdateOne) SetNillableOrderEventSeq(v *int64) *RestaurantUpdateOne {
+	if v != nil {
+		_u.SetOrderEventSeq(*v)
+	}
+	return _u
+}
+
+// AddOrderEventSeq adds value to the "order_event_seq" field.
+func (_u *RestaurantUpdateOne) AddOrderEventSeq(v int64) *RestaurantUpdateOne {
+	_u.mutation.AddOrderEventSeq(v)
+	return _u
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *RestaurantUpdateOne) SetUserID(v uuid.UUID) *RestaurantUpdateOne {
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *RestaurantUpdateOne) SetNillableUserID(v *uuid.UUID) *RestaurantUpdateOne {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_u *RestaurantUpdateOne) SetUser(v *User) *RestaurantUpdateOne {
+	return _u.SetUserID(v.ID)
+}
+
+// AddMenuItemIDs adds the "menu_items" edge to the MenuItem entity by IDs.
+func (_u *RestaurantUpdateOne) AddMenuItemIDs(ids ...int64) *RestaurantUpdateOne {
+	_u.mutation.AddMenuItemIDs(ids...)
+	return _u
+}
+
+// AddMenuItems adds the "menu_items" edges to the MenuItem entity.
+func (_u *RestaurantUpdateOne) AddMenuItems(v ...*MenuItem) *RestaurantUpdateOne {
+	ids := make([]int64, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddMenuItemIDs(ids...)
+}
+
+// AddCategoryIDs adds the "categories" edge to the Category entity by IDs.
+func (_u *RestaurantUpdateOne) AddCategoryIDs(ids ...uuid.UUID) *RestaurantUpdateOne {
+	_u.mutation.AddCategoryIDs(ids...)
+	return _u
+}
+
+// AddCategories adds the "categories" edges to the Category entity.
+func (_u *RestaurantUpdateOne) AddCategories(v ...*Category) *RestaurantUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddCategoryIDs(ids...)
+}
+
+// AddModifierIDs adds the "modifiers" edge to the Modifier entity by IDs.
+func (_u *RestaurantUpdateOne) AddModifierIDs(ids ...uuid.UUID) *RestaurantUpdateOne {
+	_u.mutation.AddModifierIDs(ids...)
+	return _u
+}
+
+// AddModifiers adds the "modifiers" edges to the Modifier entity.
+func (_u *RestaurantUpdateOne) AddModifiers(v ...*Modifier) *RestaurantUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddModifierIDs(ids...)
+}
+
+// AddOrderIDs adds the "orders" edge to the Order entity by IDs.
+func (_u *RestaurantUpdateOne) AddOrderIDs(ids ...uuid.UUID) *RestaurantUpdateOne {
+	_u.mutation.AddOrderIDs(ids...)
+	return _u
+}
+
+// AddOrders adds the "orders" edges to the Order entity.
+func (_u *RestaurantUpdateOne) AddOrders(v ...*Order) *RestaurantUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddOrderIDs(ids...)
+}
+
+// AddOrderStatusEventIDs adds the "order_status_events" edge to the OrderStatusEvent entity by IDs.
+func (_u *RestaurantUpdateOne) AddOrderStatusEventIDs(ids ...uuid.UUID) *RestaurantUpdateOne {
+	_u.mutation.AddOrderStatusEventIDs(ids...)
+	return _u
+}
+
+// AddOrderStatusEvents adds the "order_status_events" edges to the OrderStatusEvent entity.
+func (_u *RestaurantUpdateOne) AddOrderStatusEvents(v ...*OrderStatusEvent) *RestaurantUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddOrderStatusEventIDs(ids...)
+}
+
+// AddPaymentIDs adds the "payments" edge to the Payment entity by IDs.
+func (_u *RestaurantUpdateOne) AddPaymentIDs(ids ...uuid.UUID) *RestaurantUpdateOne {
+	_u.mutation.AddPaymentIDs(ids...)
+	return _u
+}
+
+// AddPayments adds the "payments" edges to the Payment entity.
+func (_u *RestaurantUpdateOne) AddPayments(v ...*Payment) *RestaurantUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddPaymentIDs(ids...)
+}
+
+// AddRefundIDs adds the "refunds" edge to the Refund entity by IDs.
+func (_u *RestaurantUpdateOne) AddRefundIDs(ids ...uuid.UUID) *RestaurantUpdateOne {
+	_u.mutation.AddRefundIDs(ids...)
+	return _u
+}
+
+// AddRefunds adds the "refunds" edges to the Refund entity.
+func (_u *RestaurantUpdateOne) AddRefunds(v ...*Refund) *RestaurantUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddRefundIDs(ids...)
+}
+
+// AddOrderNumberSequenceIDs adds the "order_number_sequences" edge to the OrderNumberSequence entity by IDs.
+func (_u *RestaurantUpdateOne) AddOrderNumberSequenceIDs(ids ...uuid.UUID) *RestaurantUpdateOne {
+	_u.mutation.AddOrderNumberSequenceIDs(ids...)
+	return _u
+}
+
+// AddOrderNumberSequences adds the "order_number_sequences" edges to the OrderNumberSequence entity.
+func (_u *RestaurantUpdateOne) AddOrderNumberSequences(v ...*OrderNumberSequence) *RestaurantUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddOrderNumberSequenceIDs(ids...)
+}
+
+// AddOrderEventIDs adds the "order_events" edge to the OrderEvent entity by IDs.
+func (_u *RestaurantUpdateOne) AddOrderEventIDs(ids ...uuid.UUID) *RestaurantUpdateOne {
+	_u.mutation.AddOrderEventIDs(ids...)
+	return _u
+}
+
+// AddOrderEvents adds the "order_events" edges to the OrderEvent entity.
+func (_u *RestaurantUpdateOne) AddOrderEvents(v ...*OrderEvent) *RestaurantUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddOrderEventIDs(ids...)
+}
+
+// AddStationIDs adds the "stations" edge to the Station entity by IDs.
+func (_u *RestaurantUpdateOne) AddStationIDs(ids ...uuid.UUID) *RestaurantUpdateOne {
+	_u.mutation.AddStationIDs(ids...)
+	return _u
+}
+
+// AddStations adds the "stations" edges to the Station entity.
+func (_u *RestaurantUpdateOne) AddStations(v ...*Station) *RestaurantUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddStationIDs(ids...)
+}
+
+// AddStationTicketIDs adds the "station_tickets" edge to the StationTicket entity by IDs.
+func (_u *RestaurantUpdateOne) AddStationTicketIDs(ids ...uuid.UUID) *RestaurantUpdateOne {
+	_u.mutation.AddStationTicketIDs(ids...)
+	return _u
+}
+
+// AddStationTickets adds the "station_tickets" edges to the StationTicket entity.
+func (_u *RestaurantUpdateOne) AddStationTickets(v ...*StationTicket) *RestaurantUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddStationTicketIDs(ids...)
+}
+
+// AddTableIDs adds the "tables" edge to the Table entity by IDs.
+func (_u *RestaurantUpdateOne) AddTableIDs(ids ...uuid.UUID) *RestaurantUpdateOne {
+	_u.mutation.AddTableIDs(ids...)
+	return _u
+}
+
+// AddTables adds the "tables" edges to the Table entity.
+func (_u *RestaurantUpdateOne) AddTables(v ...*Table) *RestaurantUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddTableIDs(ids...)
+}
+
+// AddTableSessionIDs adds the "table_sessions" edge to the TableSession entity by IDs.
+func (_u *RestaurantUpdateOne) AddTableSessionIDs(ids ...uuid.UUID) *RestaurantUpdateOne {
+	_u.mutation.AddTableSessionIDs(ids...)
+	return _u
+}
+
+// AddTableSessions adds the "table_sessions" edges to the TableSession entity.
+func (_u *RestaurantUpdateOne) AddTableSessions(v ...*TableSession) *RestaurantUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddTableSessionIDs(ids...)
+}
+
+// AddDeliveryZoneIDs adds the "delivery_zones" edge to the DeliveryZone entity by IDs.
+func (_u *RestaurantUpdateOne) AddDeliveryZoneIDs(ids ...uuid.UUID) *RestaurantUpdateOne {
+	_u.mutation.AddDeliveryZoneIDs(ids...)
+	return _u
+}
+
+// AddDeliveryZones adds the "delivery_zones" edges to the DeliveryZone entity.
+func (_u *RestaurantUpdateOne) AddDeliveryZones(v ...*DeliveryZone) *RestaurantUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddDeliveryZoneIDs(ids...)
+}
+
+// AddIngredientIDs adds the "ingredients" edge to the Ingredient entity by IDs.
+func (_u *RestaurantUpdateOne) AddIngredientIDs(ids ...uuid.UUID) *RestaurantUpdateOne {
+	_u.mutation.AddIngredientIDs(ids...)
+	return _u
+}
+
+// AddIngredients adds the "ingredients" edges to the Ingredient entity.
+func (_u *RestaurantUpdateOne) AddIngredients(v ...*Ingredient) *RestaurantUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddIngredientIDs(ids...)
+}
+
+// AddMenuIDs adds the "menus" edge to the Menu entity by IDs.
+func (_u *RestaurantUpdateOne) AddMenuIDs(ids ...uuid.UUID) *RestaurantUpdateOne {
+	_u.mutation.AddMenuIDs(ids...)
+	return _u
+}
+
+// AddMenus adds the "menus" edges to the Menu entity.
+func (_u *RestaurantUpdateOne) AddMenus(v ...*Menu) *RestaurantUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddMenuIDs(ids...)
+}
+
+// Mutation returns the RestaurantMutation object of the builder.
+func (_u *RestaurantUpdateOne) Mutation() *RestaurantMutation {
+	return _u.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (_u *RestaurantUpdateOne) ClearUser() *RestaurantUpdateOne {
+	_u.mutation.ClearUser()
+	return _u
+}
+
+// ClearMenuItems clears all "menu_items" edges to the MenuItem entity.
+func (_u *RestaurantUpdateOne) ClearMenuItems() *RestaurantUpdateOne {
+	_u.mutation.ClearMenuItems()
+	return _u
+}
+
+// RemoveMenuItemIDs removes the "menu_items" edge to MenuItem entities by IDs.
+func (_u *RestaurantUpdateOne) RemoveMenuItemIDs(ids ...int64) *RestaurantUpdateOne {
+	_u.mutation.RemoveMenuItemIDs(ids...)
+	return _u
+}
+
+// RemoveMenuItems removes "menu_items" edges to MenuItem entities.
+func (_u *RestaurantUpdateOne) RemoveMenuItems(v ...*MenuItem) *RestaurantUpdateOne {
+	ids := make([]int64, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveMenuItemIDs(ids...)
+}
+
+// ClearCategories clears all "categories" edges to the Category entity.
+func (_u *RestaurantUpdateOne) ClearCategories() *RestaurantUpdateOne {
+	_u.mutation.ClearCategories()
+	return _u
+}
+
+// RemoveCategoryIDs removes the "categories" edge to Category entities by IDs.
+func (_u *RestaurantUpdateOne) RemoveCategoryIDs(ids ...uuid.UUID) *RestaurantUpdateOne {
+	_u.mutation.RemoveCategoryIDs(ids...)
+	return _u
+}
+
+// RemoveCategories removes "categories" edges to Category entities.
+func (_u *RestaurantUpdateOne) RemoveCategories(v ...*Category) *RestaurantUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveCategoryIDs(ids...)
+}
+
+// ClearModifiers clears all "modifiers" edges to the Modifier entity.
+func (_u *RestaurantUpdateOne) ClearModifiers() *RestaurantUpdateOne {
+	_u.mutation.ClearModifiers()
+	return _u
+}
+
+// RemoveModifierIDs removes the "modifiers" edge to Modifier entities by IDs.
+func (_u *RestaurantUpdateOne) RemoveModifierIDs(ids ...uuid.UUID) *RestaurantUpdateOne {
+	_u.mutation.RemoveModifierIDs(ids...)
+	return _u
+}
+
+// RemoveModifiers removes "modifiers" edges to Modifier entities.
+func (_u *RestaurantUpdateOne) RemoveModifiers(v ...*Modifier) *RestaurantUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveModifierIDs(ids...)
+}
+
+// ClearOrders clears all "orders" edges to the Order entity.
+func (_u *RestaurantUpdateOne) ClearOrders() *RestaurantUpdateOne {
+	_u.mutation.ClearOrders()
+	return _u
+}
+
+// RemoveOrderIDs removes the "orders" edge to Order entities by IDs.
+func (_u *RestaurantUpdateOne) RemoveOrderIDs(ids ...uuid.UUID) *RestaurantUpdateOne {
+	_u.mutation.RemoveOrderIDs(ids...)
+	return _u
+}
+
+// RemoveOrders removes "orders" edges to Order entities.
+func (_u *RestaurantUpdateOne) RemoveOrders(v ...*Order) *RestaurantUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveOrderIDs(ids...)
+}
+
+// ClearOrderStatusEvents clears all "order_status_events" edges to the OrderStatusEvent entity.
+func (_u *RestaurantUpdateOne) ClearOrderStatusEvents() *RestaurantUpdateOne {
+	_u.mutation.ClearOrderStatusEvents()
+	return _u
+}
+
+// RemoveOrderStatusEventIDs removes the "order_status_events" edge to OrderStatusEvent entities by IDs.
+func (_u *RestaurantUpdateOne) RemoveOrderStatusEventIDs(ids ...uuid.UUID) *RestaurantUpdateOne {
+	_u.mutation.RemoveOrderStatusEventIDs(ids...)
+	return _u
+}
+
+// RemoveOrderStatusEvents removes "order_status_events" edges to OrderStatusEvent entities.
+func (_u *RestaurantUpdateOne) RemoveOrderStatusEvents(v ...*OrderStatusEvent) *RestaurantUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveOrderStatusEventIDs(ids...)
+}
+
+// ClearPayments clears all "payments" edges to the Payment entity.
+func (_u *RestaurantUpdateOne) ClearPayments() *RestaurantUpdateOne {
+	_u.mutation.ClearPayments()
+	return _u
+}
+
+// RemovePaymentIDs removes the "payments" edge to Payment entities by IDs.
+func (_u *RestaurantUpdateOne) RemovePaymentIDs(ids ...uuid.UUID) *RestaurantUpdateOne {
+	_u.mutation.RemovePaymentIDs(ids...)
+	return _u
+}
+
+// RemovePayments removes "payments" edges to Payment entities.
+func (_u *RestaurantUpdateOne) RemovePayments(v ...*Payment) *RestaurantUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemovePaymentIDs(ids...)
+}
+
+// ClearRefunds clears all "refunds" edges to the Refund entity.
+func (_u *RestaurantUpdateOne) ClearRefunds() *RestaurantUpdateOne {
+	_u.mutation.ClearRefunds()
+	return _u
+}
+
+// RemoveRefundIDs removes the "refunds" edge to Refund entities by IDs.
+func (_u *RestaurantUpdateOne) RemoveRefundIDs(ids ...uuid.UUID) *RestaurantUpdateOne {
+	_u.mutation.RemoveRefundIDs(ids...)
+	return _u
+}
+
+// RemoveRefunds removes "refunds" edges to Refund entities.
+func (_u *RestaurantUpdateOne) RemoveRefunds(v ...*Refund) *RestaurantUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveRefundIDs(ids...)
+}
+
+// ClearOrderNumberSequences clears all "order_number_sequences" edges to the OrderNumberSequence entity.
+func (_u *RestaurantUpdateOne) ClearOrderNumberSequences() *RestaurantUpdateOne {
+	_u.mutation.ClearOrderNumberSequences()
+	return _u
+}
+
+// RemoveOrderNumberSequenceIDs removes the "order_number_sequences" edge to OrderNumberSequence entities by IDs.
+func (_u *RestaurantUpdateOne) RemoveOrderNumberSequenceIDs(ids ...uuid.UUID) *RestaurantUpdateOne {
+	_u.mutation.RemoveOrderNumberSequenceIDs(ids...)
+	return _u
+}
+
+// RemoveOrderNumberSequences removes "order_number_sequences" edges to OrderNumberSequence entities.
+func (_u *RestaurantUpdateOne) RemoveOrderNumberSequences(v ...*OrderNumberSequence) *RestaurantUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveOrderNumberSequenceIDs(ids...)
+}
+
+// ClearOrderEvents clears all "order_events" edges to the OrderEvent entity.
+func (_u *RestaurantUpdateOne) ClearOrderEvents() *RestaurantUpdateOne {
+	_u.mutation.ClearOrderEvents()
+	return _u
+}
+
+// RemoveOrderEventIDs removes the "order_events" edge to OrderEvent entities by IDs.
+func (_u *RestaurantUpdateOne) RemoveOrderEventIDs(ids ...uuid.UUID) *RestaurantUpdateOne {
+	_u.mutation.RemoveOrderEventIDs(ids...)
+	return _u
+}
+
+// RemoveOrderEvents removes "order_events" edges to OrderEvent entities.
+func (_u *RestaurantUpdateOne) RemoveOrderEvents(v ...*OrderEvent) *RestaurantUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveOrderEventIDs(ids...)
+}
+
+// ClearStations clears all "stations" edges to the Station entity.
+func (_u *RestaurantUpdateOne) ClearStations() *RestaurantUpdateOne {
+	_u.mutation.ClearStations()
+	return _u
+}
+
+// RemoveStationIDs removes the "stations" edge to Station entities by IDs.
+func (_u *RestaurantUpdateOne) RemoveStationIDs(ids ...uuid.UUID) *RestaurantUpdateOne {
+	_u.mutation.RemoveStationIDs(ids...)
+	return _u
+}
+
+// RemoveStations removes "stations" edges to Station entities.
+func (_u *RestaurantUpdateOne) RemoveStations(v ...*Station) *RestaurantUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveStationIDs(ids...)
+}
+
+// ClearStationTickets clears all "station_tickets" edges to the StationTicket entity.
+func (_u *RestaurantUpdateOne) ClearStationTickets() *RestaurantUpdateOne {
+	_u.mutation.ClearStationTickets()
+	return _u
+}
+
+// RemoveStationTicketIDs removes the "station_tickets" edge to StationTicket entities by IDs.
+func (_u *RestaurantUpdateOne) RemoveStationTicketIDs(ids ...uuid.UUID) *RestaurantUpdateOne {
+	_u.mutation.RemoveStationTicketIDs(ids...)
+	return _u
+}
+
+// RemoveStationTickets removes "station_tickets" edges to StationTicket entities.
+func (_u *RestaurantUpdateOne) RemoveStationTickets(v ...*StationTicket) *RestaurantUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveStationTicketIDs(ids...)
+}
+
+// ClearTables clears all "tables" edges to the Table entity.
+func (_u *RestaurantUpdateOne) ClearTables() *RestaurantUpdateOne {
+	_u.mutation.ClearTables()
+	return _u
+}
+
+// RemoveTableIDs removes the "tables" edge to Table entities by IDs.
+func (_u *RestaurantUpdateOne) RemoveTableIDs(ids ...uuid.UUID) *RestaurantUpdateOne {
+	_u.mutation.RemoveTableIDs(ids...)
+	return _u
+}
+
+// RemoveTables removes "tables" edges to Table entities.
+func (_u *RestaurantUpdateOne) RemoveTables(v ...*Table) *RestaurantUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveTableIDs(ids...)
+}
+
+// ClearTableSessions clears all "table_sessions" edges to the TableSession entity.
+func (_u *RestaurantUpdateOne) ClearTableSessions() *RestaurantUpdateOne {
+	_u.mutation.ClearTableSessions()
+	return _u
+}
+
+// RemoveTableSessionIDs removes the "table_sessions" edge to TableSession entities by IDs.
+func (_u *RestaurantUpdateOne) RemoveTableSessionIDs(ids ...uuid.UUID) *RestaurantUpdateOne {
+	_u.mutation.RemoveTableSessionIDs(ids...)
+	return _u
+}
+
+// RemoveTableSessions removes "table_sessions" edges to TableSession entities.
+func (_u *RestaurantUpdateOne) RemoveTableSessions(v ...*TableSession) *RestaurantUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveTableSessionIDs(ids...)
+}
+
+// ClearDeliveryZones clears all "delivery_zones" edges to the DeliveryZone entity.
+func (_u *RestaurantUpdateOne) ClearDeliveryZones() *RestaurantUpdateOne {
+	_u.mutation.ClearDeliveryZones()
+	return _u
+}
+
+// RemoveDeliveryZoneIDs removes the "delivery_zones" edge to DeliveryZone entities by IDs.
+func (_u *RestaurantUpdateOne) RemoveDeliveryZoneIDs(ids ...uuid.UUID) *RestaurantUpdateOne {
+	_u.mutation.RemoveDeliveryZoneIDs(ids...)
+	return _u
+}
+
+// RemoveDeliveryZones removes "delivery_zones" edges to DeliveryZone entities.
+func (_u *RestaurantUpdateOne) RemoveDeliveryZones(v ...*DeliveryZone) *RestaurantUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveDeliveryZoneIDs(ids...)
+}
+
+// ClearIngredients clears all "ingredients" edges to the Ingredient entity.
+func (_u *RestaurantUpdateOne) ClearIngredients() *RestaurantUpdateOne {
+	_u.mutation.ClearIngredients()
+	return _u
+}
+
+// RemoveIngredientIDs removes the "ingredients" edge to Ingredient entities by IDs.
+func (_u *RestaurantUpdateOne) RemoveIngredientIDs(ids ...uuid.UUID) *RestaurantUpdateOne {
+	_u.mutation.RemoveIngredientIDs(ids...)
+	return _u
+}
+
+// RemoveIngredients removes "ingredients" edges to Ingredient entities.
+func (_u *RestaurantUpdateOne) RemoveIngredients(v ...*Ingredient) *RestaurantUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveIngredientIDs(ids...)
+}
+
+// ClearMenus clears all "menus" edges to the Menu entity.
+func (_u *RestaurantUpdateOne) ClearMenus() *RestaurantUpdateOne {
+	_u.mutation.ClearMenus()
+	return _u
+}
+
+// RemoveMenuIDs removes the "menus" edge to Menu entities by IDs.
+func (_u *RestaurantUpdateOne) RemoveMenuIDs(ids ...uuid.UUID) *RestaurantUpdateOne {
+	_u.mutation.RemoveMenuIDs(ids...)
+	return _u
+}
+
+// RemoveMenus removes "menus" edges to Menu entities.
+func (_u *RestaurantUpdateOne) RemoveMenus(v ...*Menu) *RestaurantUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveMenuIDs(ids...)
+}
+
+// Where appends a list predicates to the RestaurantUpdate builder.
+func (_u *RestaurantUpdateOne) Where(ps ...predicate.Restaurant) *RestaurantUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *RestaurantUpdateOne) Select(field string, fields ...string) *RestaurantUpdateOne {
+	_u.fields = append([]string{field}, fields...)
 	return _u
 }
 
-// SetOperatingHours sets the "operating_hours" field.
-func (_u *RestaurantUpdateOne) SetOperatingHours(v map[string]interface{}) *RestaurantUpdateOne {
-	_u.mutation.SetOperatingHours(v)
-	return _u
-}
-
-// ClearOperatingHours clears the value of the "operating_hours" field.
-func (_u *RestaurantUpdateOne) ClearOperatingHours() *RestaurantUpdateOne {
-	_u.mutation.ClearOperatingHours()
-	return _u
+// Save executes the query and returns the updated Restaurant entity.
+func (_u *RestaurantUpdateOne) Save(ctx context.Context) (*Restaurant, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *RestaurantUpdateOne) SaveX(ctx context.Context) *Restaurant {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *RestaurantUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
 }
 
-// SetCurrency sets the "currency" field.
-func (_u *RestaurantUpdateOne) SetCurrency(v string) *RestaurantUpdateOne {
-	_u.mutation.SetCurrency(v)
-	return _u
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *RestaurantUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
 }
 
-// SetNillableCurrency sets the "currency" field if the given value is not nil.
-func (_u *RestaurantUpdateOne) SetNillableCurrency(v *string) *RestaurantUpdateOne {
-	if v != nil {
-		_u.SetCurrency(*v)
+// defaults sets the default values of the builder before save.
+func (_u *RestaurantUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdateTime(); !ok {
+		v := restaurant.UpdateDefaultUpdateTime()
+		_u.mutation.SetUpdateTime(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *RestaurantUpdateOne) check() error {
+	if v, ok := _u.mutation.Name(); ok {
+		if err := restaurant.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Restaurant.name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Phone(); ok {
+		if err := restaurant.PhoneValidator(v); err != nil {
+			return &ValidationError{Name: "phone", err: fmt.Errorf(`ent: validator failed for field "Restaurant.phone": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Email(); ok {
+		if err := restaurant.EmailValidator(v); err != nil {
+			return &ValidationError{Name: "email", err: fmt.Errorf(`ent: validator failed for field "Restaurant.email": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Address(); ok {
+		if err := restaurant.AddressValidator(v); err != nil {
+			return &ValidationError{Name: "address", err: fmt.Errorf(`ent: validator failed for field "Restaurant.address": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.City(); ok {
+		if err := restaurant.CityValidator(v); err != nil {
+			return &ValidationError{Name: "city", err: fmt.Errorf(`ent: validator failed for field "Restaurant.city": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.State(); ok {
+		if err := restaurant.StateValidator(v); err != nil {
+			return &ValidationError{Name: "state", err: fmt.Errorf(`ent: validator failed for field "Restaurant.state": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ZipCode(); ok {
+		if err := restaurant.ZipCodeValidator(v); err != nil {
+			return &ValidationError{Name: "zip_code", err: fmt.Errorf(`ent: validator failed for field "Restaurant.zip_code": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Country(); ok {
+		if err := restaurant.CountryValidator(v); err != nil {
+			return &ValidationError{Name: "country", err: fmt.Errorf(`ent: validator failed for field "Restaurant.country": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Status(); ok {
+		if err := restaurant.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Restaurant.status": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.OperatingHours(); ok {
+		if err := v.Validate(); err != nil {
+			return &ValidationError{Name: "operating_hours", err: fmt.Errorf(`ent: validator failed for field "Restaurant.operating_hours": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.TaxRateBps(); ok {
+		if err := restaurant.TaxRateBpsValidator(v); err != nil {
+			return &ValidationError{Name: "tax_rate_bps", err: fmt.Errorf(`ent: validator failed for field "Restaurant.tax_rate_bps": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.SlotMinutes(); ok {
+		if err := restaurant.SlotMinutesValidator(v); err != nil {
+			return &ValidationError{Name: "slot_minutes", err: fmt.Errorf(`ent: validator failed for field "Restaurant.slot_minutes": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.SlotCapacity(); ok {
+		if err := restaurant.SlotCapacityValidator(v); err != nil {
+			return &ValidationError{Name: "slot_capacity", err: fmt.Errorf(`ent: validator failed for field "Restaurant.slot_capacity": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.KitchenLeadMinutes(); ok {
+		if err := restaurant.KitchenLeadMinutesValidator(v); err != nil {
+			return &ValidationError{Name: "kitchen_lead_minutes", err: fmt.Errorf(`ent: validator failed for field "Restaurant.kitchen_lead_minutes": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.OrderNumberReset(); ok {
+		if err := restaurant.OrderNumberResetValidator(v); err != nil {
+			return &ValidationError{Name: "order_number_reset", err: fmt.Errorf(`ent: validator failed for field "Restaurant.order_number_reset": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.OrderEventSeq(); ok {
+		if err := restaurant.OrderEventSeqValidator(v); err != nil {
+			return &ValidationError{Name: "order_event_seq", err: fmt.Errorf(`ent: validator failed for field "Restaurant.order_event_seq": %w`, err)}
+		}
+	}
+	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Restaurant.user"`)
+	}
+	return nil
+}
+
+func (_u *RestaurantUpdateOne) sqlSave(ctx context.Context) (_node *Restaurant, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(restaurant.Table, restaurant.Columns, sqlgraph.NewFieldSpec(restaurant.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Restaurant.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, restaurant.FieldID)
+		for _, f := range fields {
+			if !restaurant.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != restaurant.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdateTime(); ok {
+		_spec.SetField(restaurant.FieldUpdateTime, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(restaurant.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Description(); ok {
+		_spec.SetField(restaurant.FieldDescription, field.TypeString, value)
+	}
+	if _u.mutation.DescriptionCleared() {
+		_spec.ClearField(restaurant.FieldDescription, field.TypeString)
+	}
+	if value, ok := _u.mutation.Phone(); ok {
+		_spec.SetField(restaurant.FieldPhone, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Email(); ok {
+		_spec.SetField(restaurant.FieldEmail, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Address(); ok {
+		_spec.SetField(restaurant.FieldAddress, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.City(); ok {
+		_spec.SetField(restaurant.FieldCity, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.State(); ok {
+		_spec.SetField(restaurant.FieldState, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ZipCode(); ok {
+		_spec.SetField(restaurant.FieldZipCode, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Country(); ok {
+		_spec.SetField(restaurant.FieldCountry, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Latitude(); ok {
+		_spec.SetField(restaurant.FieldLatitude, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedLatitude(); ok {
+		_spec.AddField(restaurant.FieldLatitude, field.TypeFloat64, value)
+	}
+	if _u.mutation.LatitudeCleared() {
+		_spec.ClearField(restaurant.FieldLatitude, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.Longitude(); ok {
+		_spec.SetField(restaurant.FieldLongitude, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedLongitude(); ok {
+		_spec.AddField(restaurant.FieldLongitude, field.TypeFloat64, value)
+	}
+	if _u.mutation.LongitudeCleared() {
+		_spec.ClearField(restaurant.FieldLongitude, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.LogoURL(); ok {
+		_spec.SetField(restaurant.FieldLogoURL, field.TypeString, value)
+	}
+	if _u.mutation.LogoURLCleared() {
+		_spec.ClearField(restaurant.FieldLogoURL, field.TypeString)
+	}
+	if value, ok := _u.mutation.LogoThumbnailURL(); ok {
+		_spec.SetField(restaurant.FieldLogoThumbnailURL, field.TypeString, value)
+	}
+	if _u.mutation.LogoThumbnailURLCleared() {
+		_spec.ClearField(restaurant.FieldLogoThumbnailURL, field.TypeString)
+	}
+	if value, ok := _u.mutation.LogoKeys(); ok {
+		_spec.SetField(restaurant.FieldLogoKeys, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedLogoKeys(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, restaurant.FieldLogoKeys, value)
+		})
+	}
+	if _u.mutation.LogoKeysCleared() {
+		_spec.ClearField(restaurant.FieldLogoKeys, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.CoverImageURL(); ok {
+		_spec.SetField(restaurant.FieldCoverImageURL, field.TypeString, value)
+	}
+	if _u.mutation.CoverImageURLCleared() {
+		_spec.ClearField(restaurant.FieldCoverImageURL, field.TypeString)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(restaurant.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.OperatingHours(); ok {
+		_spec.SetField(restaurant.FieldOperatingHours, field.TypeJSON, value)
+	}
+	if _u.mutation.OperatingHoursCleared() {
+		_spec.ClearField(restaurant.FieldOperatingHours, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Currency(); ok {
+		_spec.SetField(restaurant.FieldCurrency, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.TaxRateBps(); ok {
+		_spec.SetField(restaurant.FieldTaxRateBps, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedTaxRateBps(); ok {
+		_spec.AddField(restaurant.FieldTaxRateBps, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Timezone(); ok {
+		_spec.SetField(restaurant.FieldTimezone, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.SlotMinutes(); ok {
+		_spec.SetField(restaurant.FieldSlotMinutes, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedSlotMinutes(); ok {
+		_spec.AddField(restaurant.FieldSlotMinutes, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.SlotCapacity(); ok {
+		_spec.SetField(restaurant.FieldSlotCapacity, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedSlotCapacity(); ok {
+		_spec.AddField(restaurant.FieldSlotCapacity, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.KitchenLeadMinutes(); ok {
+		_spec.SetField(restaurant.FieldKitchenLeadMinutes, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedKitchenLeadMinutes(); ok {
+		_spec.AddField(restaurant.FieldKitchenLeadMinutes, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.OrderNumberReset(); ok {
+		_spec.SetField(restaurant.FieldOrderNumberReset, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.OrderEventSeq(); ok {
+		_spec.SetField(restaurant.FieldOrderEventSeq, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedOrderEventSeq(); ok {
+		_spec.AddField(restaurant.FieldOrderEventSeq, field.TypeInt64, value)
+	}
+	if _u.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   restaurant.UserTable,
+			Columns: []string{restaurant.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   restaurant.UserTable,
+			Columns: []string{restaurant.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.MenuItemsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   restaurant.MenuItemsTable,
+			Columns: []string{restaurant.MenuItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(menuitem.FieldID, field.TypeInt64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedMenuItemsIDs(); len(nodes) > 0 && !_u.mutation.MenuItemsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   restaurant.MenuItemsTable,
+			Columns: []string{restaurant.MenuItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(menuitem.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return _u
-}
-
-// SetUserID sets the "user_id" field.
-func (_u *RestaurantUpdateOne) SetUserID(v uuid.UUID) *RestaurantUpdateOne {
-	_u.mutation.SetUserID(v)
-	return _u
-}
-
-// SetNillableUserID sets the "user_id" field if the given value is not nil.
-func (_u *RestaurantUpdateOne) SetNillableUserID(v *uuid.UUID) *RestaurantUpdateOne {
-	if v != nil {
-		_u.SetUserID(*v)
+	if nodes := _u.mutation.MenuItemsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   restaurant.MenuItemsTable,
+			Columns: []string{restaurant.MenuItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(menuitem.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	return _u
-}
-
-// SetUser sets the "user" edge to the User entity.
-func (_u *RestaurantUpdateOne) SetUser(v *User) *RestaurantUpdateOne {
-	return _u.SetUserID(v.ID)
-}
-
-// AddMenuItemIDs adds the "menu_items" edge to the MenuItem entity by IDs.
-func (_u *RestaurantUpdateOne) AddMenuItemIDs(ids ...int64) *RestaurantUpdateOne {
-	_u.mutation.AddMenuItemIDs(ids...)
-	return _u
-}
-
-// AddMenuItems adds the "menu_items" edges to the MenuItem entity.
-func (_u *RestaurantUpdateOne) AddMenuItems(v ...*MenuItem) *RestaurantUpdateOne {
-	ids := make([]int64, len(v))
-	for i := range v {
-		ids[i] = v[i].ID
+	if _u.mutation.CategoriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   restaurant.CategoriesTable,
+			Columns: []string{restaurant.CategoriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(category.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return _u.AddMenuItemIDs(ids...)
-}
-
-// AddCategoryIDs adds the "categories" edge to the Category entity by IDs.
-func (_u *RestaurantUpdateOne) AddCategoryIDs(ids ...uuid.UUID) *RestaurantUpdateOne {
-	_u.mutation.AddCategoryIDs(ids...)
-	return _u
-}
-
-// AddCategories adds the "categories" edges to the Category entity.
-func (_u *RestaurantUpdateOne) AddCategories(v ...*Category) *RestaurantUpdateOne {
-	ids := make([]uuid.UUID, len(v))
-	for i := range v {
-		ids[i] = v[i].ID
+	if nodes := _u.mutation.RemovedCategoriesIDs(); len(nodes) > 0 && !_u.mutation.CategoriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   restaurant.CategoriesTable,
+			Columns: []string{restaurant.CategoriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(category.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return _u.AddCategoryIDs(ids...)
-}
-
-// AddModifierIDs adds the "modifiers" edge to the Modifier entity by IDs.
-func (_u *RestaurantUpdateOne) AddModifierIDs(ids ...uuid.UUID) *RestaurantUpdateOne {
-	_u.mutation.AddModifierIDs(ids...)
-	return _u
-}
-
-// AddModifiers adds the "modifiers" edges to the Modifier entity.
-func (_u *RestaurantUpdateOne) AddModifiers(v ...*Modifier) *RestaurantUpdateOne {
-	ids := make([]uuid.UUID, len(v))
-	for i := range v {
-		ids[i] = v[i].ID
+	if nodes := _u.mutation.CategoriesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   restaurant.CategoriesTable,
+			Columns: []string{restaurant.CategoriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(category.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ModifiersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   restaurant.ModifiersTable,
+			Columns: []string{restaurant.ModifiersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(modifier.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedModifiersIDs(); len(nodes) > 0 && !_u.mutation.ModifiersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   restaurant.ModifiersTable,
+			Columns: []string{restaurant.ModifiersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(modifier.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ModifiersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   restaurant.ModifiersTable,
+			Columns: []string{restaurant.ModifiersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(modifier.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.OrdersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   restaurant.OrdersTable,
+			Columns: []string{restaurant.OrdersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(order.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedOrdersIDs(); len(nodes) > 0 && !_u.mutation.OrdersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   restaurant.OrdersTable,
+			Columns: []string{restaurant.OrdersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(order.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.OrdersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   restaurant.OrdersTable,
+			Columns: []string{restaurant.OrdersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(order.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.OrderStatusEventsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   restaurant.OrderStatusEventsTable,
+			Columns: []string{restaurant.OrderStatusEventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(orderstatusevent.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedOrderStatusEventsIDs(); len(nodes) > 0 && !_u.mutation.OrderStatusEventsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   restaurant.OrderStatusEventsTable,
+			Columns: []string{restaurant.OrderStatusEventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(orderstatusevent.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.OrderStatusEventsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   restaurant.OrderStatusEventsTable,
+			Columns: []string{restaurant.OrderStatusEventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(orderstatusevent.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.PaymentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   restaurant.PaymentsTable,
+			Columns: []string{restaurant.PaymentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(payment.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return _u.AddModifierIDs(ids...)
-}
-
-// AddOrderIDs adds the "orders" edge to the Order entity by IDs.
-func (_u *RestaurantUpdateOne) AddOrderIDs(ids ...uuid.UUID) *RestaurantUpdateOne {
-	_u.mutation.AddOrderIDs(ids...)
-	return _u
-}
-
-// AddOrders adds the "orders" edges to the Order entity.
-func (_u *RestaurantUpdateOne) AddOrders(v ...*Order) *RestaurantUpdateOne {
-	ids := make([]uuid.UUID, len(v))
-	for i := range v {
-		ids[i] = v[i].ID
+	if nodes := _u.mutation.RemovedPaymentsIDs(); len(nodes) > 0 && !_u.mutation.PaymentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   restaurant.PaymentsTable,
+			Columns: []string{restaurant.PaymentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(payment.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return _u.AddOrderIDs(ids...)
-}
-
-// Mutation returns the RestaurantMutation object of the builder.
-func (_u *RestaurantUpdateOne) Mutation() *RestaurantMutation {
-	return _u.mutation
-}
-
-// ClearUser clears the "user" edge to the User entity.
-func (_u *RestaurantUpdateOne) ClearUser() *RestaurantUpdateOne {
-	_u.mutation.ClearUser()
-	return _u
-}
-
-// ClearMenuItems clears all "menu_items" edges to the MenuItem entity.
-func (_u *RestaurantUpdateOne) ClearMenuItems() *RestaurantUpdateOne {
-	_u.mutation.ClearMenuItems()
-	return _u
-}
-
-// RemoveMenuItemIDs removes the "menu_items" edge to MenuItem entities by IDs.
-func (_u *RestaurantUpdateOne) RemoveMenuItemIDs(ids ...int64) *RestaurantUpdateOne {
-	_u.mutation.RemoveMenuItemIDs(ids...)
-	return _u
-}
-
-// RemoveMenuItems removes "menu_items" edges to MenuItem entities.
-func (_u *RestaurantUpdateOne) RemoveMenuItems(v ...*MenuItem) *RestaurantUpdateOne {
-	ids := make([]int64, len(v))
-	for i := range v {
-		ids[i] = v[i].ID
+	if nodes := _u.mutation.PaymentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   restaurant.PaymentsTable,
+			Columns: []string{restaurant.PaymentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(payment.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	return _u.RemoveMenuItemIDs(ids...)
-}
-
-// ClearCategories clears all "categories" edges to the Category entity.
-func (_u *RestaurantUpdateOne) ClearCategories() *RestaurantUpdateOne {
-	_u.mutation.ClearCategories()
-	return _u
-}
-
-// RemoveCategoryIDs removes the "categories" edge to Category entities by IDs.
-func (_u *RestaurantUpdateOne) RemoveCategoryIDs(ids ...uuid.UUID) *RestaurantUpdateOne {
-	_u.mutation.RemoveCategoryIDs(ids...)
-	return _u
-}
-
-// RemoveCategories removes "categories" edges to Category entities.
-func (_u *RestaurantUpdateOne) RemoveCategories(v ...*Category) *RestaurantUpdateOne {
-	ids := make([]uuid.UUID, len(v))
-	for i := range v {
-		ids[i] = v[i].ID
+	if _u.mutation.RefundsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   restaurant.RefundsTable,
+			Columns: []string{restaurant.RefundsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(refund.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return _u.RemoveCategoryIDs(ids...)
-}
-
-// ClearModifiers clears all "modifiers" edges to the Modifier entity.
-func (_u *RestaurantUpdateOne) ClearModifiers() *RestaurantUpdateOne {
-	_u.mutation.ClearModifiers()
-	return _u
-}
-
-// RemoveModifierIDs removes the "modifiers" edge to Modifier entities by IDs.
-func (_u *RestaurantUpdateOne) RemoveModifierIDs(ids ...uuid.UUID) *RestaurantUpdateOne {
-	_u.mutation.RemoveModifierIDs(ids...)
-	return _u
-}
-
-// RemoveModifiers removes "modifiers" edges to Modifier entities.
-func (_u *RestaurantUpdateOne) RemoveModifiers(v ...*Modifier) *RestaurantUpdateOne {
-	ids := make([]uuid.UUID, len(v))
-	for i := range v {
-		ids[i] = v[i].ID
+	if nodes := _u.mutation.RemovedRefundsIDs(); len(nodes) > 0 && !_u.mutation.RefundsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   restaurant.RefundsTable,
+			Columns: []string{restaurant.RefundsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(refund.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return _u.RemoveModifierIDs(ids...)
-}
-
-// ClearOrders clears all "orders" edges to the Order entity.
-func (_u *RestaurantUpdateOne) ClearOrders() *RestaurantUpdateOne {
-	_u.mutation.ClearOrders()
-	return _u
-}
-
-// RemoveOrderIDs removes the "orders" edge to Order entities by IDs.
-func (_u *RestaurantUpdateOne) RemoveOrderIDs(ids ...uuid.UUID) *RestaurantUpdateOne {
-	_u.mutation.RemoveOrderIDs(ids...)
-	return _u
-}
-
-// RemoveOrders removes "orders" edges to Order entities.
-func (_u *RestaurantUpdateOne) RemoveOrders(v ...*Order) *RestaurantUpdateOne {
-	ids := make([]uuid.UUID, len(v))
-	for i := range v {
-		ids[i] = v[i].ID
+	if nodes := _u.mutation.RefundsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   restaurant.RefundsTable,
+			Columns: []string{restaurant.RefundsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(refund.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	return _u.RemoveOrderIDs(ids...)
-}
-
-// Where appends a list predicates to the RestaurantUpdate builder.
-func (_u *RestaurantUpdateOne) Where(ps ...predicate.Restaurant) *RestaurantUpdateOne {
-	_u.mutation.Where(ps...)
-	return _u
-}
-
-// Select allows selecting one or more fields (columns) of the returned entity.
-// The default is selecting all fields defined in the entity schema.
-func (_u *RestaurantUpdateOne) Select(field string, fields ...string) *RestaurantUpdateOne {
-	_u.fields = append([]string{field}, fields...)
-	return _u
-}
-
-// Save executes the query and returns the updated Restaurant entity.
-func (_u *RestaurantUpdateOne) Save(ctx context.Context) (*Restaurant, error) {
-	_u.defaults()
-	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
-}
-
-// SaveX is like Save, but panics if an error occurs.
-func (_u *RestaurantUpdateOne) SaveX(ctx context.Context) *Restaurant {
-	node, err := _u.Save(ctx)
-	if err != nil {
-		panic(err)
+	if _u.mutation.OrderNumberSequencesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   restaurant.OrderNumberSequencesTable,
+			Columns: []string{restaurant.OrderNumberSequencesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ordernumbersequence.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return node
-}
-
-// Exec executes the query on the entity.
-func (_u *RestaurantUpdateOne) Exec(ctx context.Context) error {
-	_, err := _u.Save(ctx)
-	return err
-}
-
-// ExecX is like Exec, but panics if an error occurs.
-func (_u *RestaurantUpdateOne) ExecX(ctx context.Context) {
-	if err := _u.Exec(ctx); err != nil {
-		panic(err)
+	if nodes := _u.mutation.RemovedOrderNumberSequencesIDs(); len(nodes) > 0 && !_u.mutation.OrderNumberSequencesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   restaurant.OrderNumberSequencesTable,
+			Columns: []string{restaurant.OrderNumberSequencesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ordernumbersequence.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-}
-
-// defaults sets the default values of the builder before save.
-func (_u *RestaurantUpdateOne) defaults() {
-	if _, ok := _u.mutation.UpdateTime(); !ok {
-		v := restaurant.UpdateDefaultUpdateTime()
-		_u.mutation.SetUpdateTime(v)
+	if nodes := _u.mutation.OrderNumberSequencesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   restaurant.OrderNumberSequencesTable,
+			Columns: []string{restaurant.OrderNumberSequencesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ordernumbersequence.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-}
-
-// check runs all checks and user-defined validators on the builder.
-func (_u *RestaurantUpdateOne) check() error {
-	if v, ok := _u.mutation.Name(); ok {
-		if err := restaurant.NameValidator(v); err != nil {
-			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Restaurant.name": %w`, err)}
+	if _u.mutation.OrderEventsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   restaurant.OrderEventsTable,
+			Columns: []string{restaurant.OrderEventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(orderevent.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedOrderEventsIDs(); len(nodes) > 0 && !_u.mutation.OrderEventsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   restaurant.OrderEventsTable,
+			Columns: []string{restaurant.OrderEventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(orderevent.FieldID, field.TypeUUID),
+			},
 		}
-	}
-	if v, ok := _u.mutation.Phone(); ok {
-		if err := restaurant.PhoneValidator(v); err != nil {
-			return &ValidationError{Name: "phone", err: fmt.Errorf(`ent: validator failed for field "Restaurant.phone": %w`, err)}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if v, ok := _u.mutation.Email(); ok {
-		if err := restaurant.EmailValidator(v); err != nil {
-			return &ValidationError{Name: "email", err: fmt.Errorf(`ent: validator failed for field "Restaurant.email": %w`, err)}
+	if nodes := _u.mutation.OrderEventsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   restaurant.OrderEventsTable,
+			Columns: []string{restaurant.OrderEventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(orderevent.FieldID, field.TypeUUID),
+			},
 		}
-	}
-	if v, ok := _u.mutation.Address(); ok {
-		if err := restaurant.AddressValidator(v); err != nil {
-			return &ValidationError{Name: "address", err: fmt.Errorf(`ent: validator failed for field "Restaurant.address": %w`, err)}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if v, ok := _u.mutation.City(); ok {
-		if err := restaurant.CityValidator(v); err != nil {
-			return &ValidationError{Name: "city", err: fmt.Errorf(`ent: validator failed for field "Restaurant.city": %w`, err)}
+	if _u.mutation.StationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   restaurant.StationsTable,
+			Columns: []string{restaurant.StationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(station.FieldID, field.TypeUUID),
+			},
 		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if v, ok := _u.mutation.State(); ok {
-		if err := restaurant.StateValidator(v); err != nil {
-			return &ValidationError{Name: "state", err: fmt.Errorf(`ent: validator failed for field "Restaurant.state": %w`, err)}
+	if nodes := _u.mutation.RemovedStationsIDs(); len(nodes) > 0 && !_u.mutation.StationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   restaurant.StationsTable,
+			Columns: []string{restaurant.StationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(station.FieldID, field.TypeUUID),
+			},
 		}
-	}
-	if v, ok := _u.mutation.ZipCode(); ok {
-		if err := restaurant.ZipCodeValidator(v); err != nil {
-			return &ValidationError{Name: "zip_code", err: fmt.Errorf(`ent: validator failed for field "Restaurant.zip_code": %w`, err)}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if v, ok := _u.mutation.Country(); ok {
-		if err := restaurant.CountryValidator(v); err != nil {
-			return &ValidationError{Name: "country", err: fmt.Errorf(`ent: validator failed for field "Restaurant.country": %w`, err)}
+	if nodes := _u.mutation.StationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   restaurant.StationsTable,
+			Columns: []string{restaurant.StationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(station.FieldID, field.TypeUUID),
+			},
 		}
-	}
-	if v, ok := _u.mutation.Status(); ok {
-		if err := restaurant.StatusValidator(v); err != nil {
-			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Restaurant.status": %w`, err)}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
-		return errors.New(`ent: clearing a required unique edge "Restaurant.user"`)
-	}
-	return nil
-}
-
-func (_u *RestaurantUpdateOne) sqlSave(ctx context.Context) (_node *Restaurant, err error) {
-	if err := _u.check(); err != nil {
-		return _node, err
-	}
-	_spec := sqlgraph.NewUpdateSpec(restaurant.Table, restaurant.Columns, sqlgraph.NewFieldSpec(restaurant.FieldID, field.TypeUUID))
-	id, ok := _u.mutation.ID()
-	if !ok {
-		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Restaurant.id" for update`)}
-	}
-	_spec.Node.ID.Value = id
-	if fields := _u.fields; len(fields) > 0 {
-		_spec.Node.Columns = make([]string, 0, len(fields))
-		_spec.Node.Columns = append(_spec.Node.Columns, restaurant.FieldID)
-		for _, f := range fields {
-			if !restaurant.ValidColumn(f) {
-				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
-			}
-			if f != restaurant.FieldID {
-				_spec.Node.Columns = append(_spec.Node.Columns, f)
-			}
+	if _u.mutation.StationTicketsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   restaurant.StationTicketsTable,
+			Columns: []string{restaurant.StationTicketsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(stationticket.FieldID, field.TypeUUID),
+			},
 		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if ps := _u.mutation.predicates; len(ps) > 0 {
-		_spec.Predicate = func(selector *sql.Selector) {
-			for i := range ps {
-				ps[i](selector)
-			}
+	if nodes := _u.mutation.RemovedStationTicketsIDs(); len(nodes) > 0 && !_u.mutation.StationTicketsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   restaurant.StationTicketsTable,
+			Columns: []string{restaurant.StationTicketsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(stationticket.FieldID, field.TypeUUID),
+			},
 		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if value, ok := _u.mutation.UpdateTime(); ok {
-		_spec.SetField(restaurant.FieldUpdateTime, field.TypeTime, value)
-	}
-	if value, ok := _u.mutation.Name(); ok {
-		_spec.SetField(restaurant.FieldName, field.TypeString, value)
-	}
-	if value, ok := _u.mutation.Description(); ok {
-		_spec.SetField(restaurant.FieldDescription, field.TypeString, value)
-	}
-	if _u.mutation.DescriptionCleared() {
-		_spec.ClearField(restaurant.FieldDescription, field.TypeString)
-	}
-	if value, ok := _u.mutation.Phone(); ok {
-		_spec.SetField(restaurant.FieldPhone, field.TypeString, value)
-	}
-	if value, ok := _u.mutation.Email(); ok {
-		_spec.SetField(restaurant.FieldEmail, field.TypeString, value)
-	}
-	if value, ok := _u.mutation.Address(); ok {
-		_spec.SetField(restaurant.FieldAddress, field.TypeString, value)
-	}
-	if value, ok := _u.mutation.City(); ok {
-		_spec.SetField(restaurant.FieldCity, field.TypeString, value)
-	}
-	if value, ok := _u.mutation.State(); ok {
-		_spec.SetField(restaurant.FieldState, field.TypeString, value)
-	}
-	if value, ok := _u.mutation.ZipCode(); ok {
-		_spec.SetField(restaurant.FieldZipCode, field.TypeString, value)
-	}
-	if value, ok := _u.mutation.Country(); ok {
-		_spec.SetField(restaurant.FieldCountry, field.TypeString, value)
-	}
-	if value, ok := _u.mutation.LogoURL(); ok {
-		_spec.SetField(restaurant.FieldLogoURL, field.TypeString, value)
-	}
-	if _u.mutation.LogoURLCleared() {
-		_spec.ClearField(restaurant.FieldLogoURL, field.TypeString)
-	}
-	if value, ok := _u.mutation.CoverImageURL(); ok {
-		_spec.SetField(restaurant.FieldCoverImageURL, field.TypeString, value)
-	}
-	if _u.mutation.CoverImageURLCleared() {
-		_spec.ClearField(restaurant.FieldCoverImageURL, field.TypeString)
-	}
-	if value, ok := _u.mutation.Status(); ok {
-		_spec.SetField(restaurant.FieldStatus, field.TypeEnum, value)
-	}
-	if value, ok := _u.mutation.OperatingHours(); ok {
-		_spec.SetField(restaurant.FieldOperatingHours, field.TypeJSON, value)
-	}
-	if _u.mutation.OperatingHoursCleared() {
-		_spec.ClearField(restaurant.FieldOperatingHours, field.TypeJSON)
+	if nodes := _u.mutation.StationTicketsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   restaurant.StationTicketsTable,
+			Columns: []string{restaurant.StationTicketsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(stationticket.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if value, ok := _u.mutation.Currency(); ok {
-		_spec.SetField(restaurant.FieldCurrency, field.TypeString, value)
+	if _u.mutation.TablesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   restaurant.TablesTable,
+			Columns: []string{restaurant.TablesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(table.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if _u.mutation.UserCleared() {
+	if nodes := _u.mutation.RemovedTablesIDs(); len(nodes) > 0 && !_u.mutation.TablesCleared() {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.M2O,
-			Inverse: true,
-			Table:   restaurant.UserTable,
-			Columns: []string{restaurant.UserColumn},
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   restaurant.TablesTable,
+			Columns: []string{restaurant.TablesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+				IDSpec: sqlgraph.NewFieldSpec(table.FieldID, field.TypeUUID),
 			},
 		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.UserIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.TablesIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.M2O,
-			Inverse: true,
-			Table:   restaurant.UserTable,
-			Columns: []string{restaurant.UserColumn},
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   restaurant.TablesTable,
+			Columns: []string{restaurant.TablesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+				IDSpec: sqlgraph.NewFieldSpec(table.FieldID, field.TypeUUID),
 			},
 		}
 		for _, k := range nodes {
@@ -1422,28 +4042,28 @@ func (_u *RestaurantUpdateOne) sqlSave(ctx context.Context) (_node *Restaurant,
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.MenuItemsCleared() {
+	if _u.mutation.TableSessionsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   restaurant.MenuItemsTable,
-			Columns: []string{restaurant.MenuItemsColumn},
+			Table:   restaurant.TableSessionsTable,
+			Columns: []string{restaurant.TableSessionsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(menuitem.FieldID, field.TypeInt64),
+				IDSpec: sqlgraph.NewFieldSpec(tablesession.FieldID, field.TypeUUID),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RemovedMenuItemsIDs(); len(nodes) > 0 && !_u.mutation.MenuItemsCleared() {
+	if nodes := _u.mutation.RemovedTableSessionsIDs(); len(nodes) > 0 && !_u.mutation.TableSessionsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   restaurant.MenuItemsTable,
-			Columns: []string{restaurant.MenuItemsColumn},
+			Table:   restaurant.TableSessionsTable,
+			Columns: []string{restaurant.TableSessionsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(menuitem.FieldID, field.TypeInt64),
+				IDSpec: sqlgraph.NewFieldSpec(tablesession.FieldID, field.TypeUUID),
 			},
 		}
 		for _, k := range nodes {
@@ -1451,15 +4071,15 @@ func (_u *RestaurantUpdateOne) sqlSave(ctx context.Context) (_node *Restaurant,
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.MenuItemsIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.TableSessionsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   restaurant.MenuItemsTable,
-			Columns: []string{restaurant.MenuItemsColumn},
+			Table:   restaurant.TableSessionsTable,
+			Columns: []string{restaurant.TableSessionsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(menuitem.FieldID, field.TypeInt64),
+				IDSpec: sqlgraph.NewFieldSpec(tablesession.FieldID, field.TypeUUID),
 			},
 		}
 		for _, k := range nodes {
@@ -1467,28 +4087,28 @@ func (_u *RestaurantUpdateOne) sqlSave(ctx context.Context) (_node *Restaurant,
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.CategoriesCleared() {
+	if _u.mutation.DeliveryZonesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   restaurant.CategoriesTable,
-			Columns: []string{restaurant.CategoriesColumn},
+			Table:   restaurant.DeliveryZonesTable,
+			Columns: []string{restaurant.DeliveryZonesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(category.FieldID, field.TypeUUID),
+				IDSpec: sqlgraph.NewFieldSpec(deliveryzone.FieldID, field.TypeUUID),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RemovedCategoriesIDs(); len(nodes) > 0 && !_u.mutation.CategoriesCleared() {
+	if nodes := _u.mutation.RemovedDeliveryZonesIDs(); len(nodes) > 0 && !_u.mutation.DeliveryZonesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   restaurant.CategoriesTable,
-			Columns: []string{restaurant.CategoriesColumn},
+			Table:   restaurant.DeliveryZonesTable,
+			Columns: []string{restaurant.DeliveryZonesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(category.FieldID, field.TypeUUID),
+				IDSpec: sqlgraph.NewFieldSpec(deliveryzone.FieldID, field.TypeUUID),
 			},
 		}
 		for _, k := range nodes {
@@ -1496,15 +4116,15 @@ func (_u *RestaurantUpdateOne) sqlSave(ctx context.Context) (_node *Restaurant,
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.CategoriesIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.DeliveryZonesIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   restaurant.CategoriesTable,
-			Columns: []string{restaurant.CategoriesColumn},
+			Table:   restaurant.DeliveryZonesTable,
+			Columns: []string{restaurant.DeliveryZonesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(category.FieldID, field.TypeUUID),
+				IDSpec: sqlgraph.NewFieldSpec(deliveryzone.FieldID, field.TypeUUID),
 			},
 		}
 		for _, k := range nodes {
@@ -1512,28 +4132,28 @@ func (_u *RestaurantUpdateOne) sqlSave(ctx context.Context) (_node *Restaurant,
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.ModifiersCleared() {
+	if _u.mutation.IngredientsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   restaurant.ModifiersTable,
-			Columns: []string{restaurant.ModifiersColumn},
+			Table:   restaurant.IngredientsTable,
+			Columns: []string{restaurant.IngredientsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(modifier.FieldID, field.TypeUUID),
+				IDSpec: sqlgraph.NewFieldSpec(ingredient.FieldID, field.TypeUUID),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RemovedModifiersIDs(); len(nodes) > 0 && !_u.mutation.ModifiersCleared() {
+	if nodes := _u.mutation.RemovedIngredientsIDs(); len(nodes) > 0 && !_u.mutation.IngredientsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   restaurant.ModifiersTable,
-			Columns: []string{restaurant.ModifiersColumn},
+			Table:   restaurant.IngredientsTable,
+			Columns: []string{restaurant.IngredientsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(modifier.FieldID, field.TypeUUID),
+				IDSpec: sqlgraph.NewFieldSpec(ingredient.FieldID, field.TypeUUID),
 			},
 		}
 		for _, k := range nodes {
@@ -1541,15 +4161,15 @@ func (_u *RestaurantUpdateOne) sqlSave(ctx context.Context) (_node *Restaurant,
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.ModifiersIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.IngredientsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   restaurant.ModifiersTable,
-			Columns: []string{restaurant.ModifiersColumn},
+			Table:   restaurant.IngredientsTable,
+			Columns: []string{restaurant.IngredientsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(modifier.FieldID, field.TypeUUID),
+				IDSpec: sqlgraph.NewFieldSpec(ingredient.FieldID, field.TypeUUID),
 			},
 		}
 		for _, k := range nodes {
@@ -1557,28 +4177,28 @@ func (_u *RestaurantUpdateOne) sqlSave(ctx context.Context) (_node *Restaurant,
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.OrdersCleared() {
+	if _u.mutation.MenusCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   restaurant.OrdersTable,
-			Columns: []string{restaurant.OrdersColumn},
+			Table:   restaurant.MenusTable,
+			Columns: []string{restaurant.MenusColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(order.FieldID, field.TypeUUID),
+				IDSpec: sqlgraph.NewFieldSpec(menu.FieldID, field.TypeUUID),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RemovedOrdersIDs(); len(nodes) > 0 && !_u.mutation.OrdersCleared() {
+	if nodes := _u.mutation.RemovedMenusIDs(); len(nodes) > 0 && !_u.mutation.MenusCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   restaurant.OrdersTable,
-			Columns: []string{restaurant.OrdersColumn},
+			Table:   restaurant.MenusTable,
+			Columns: []string{restaurant.MenusColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(order.FieldID, field.TypeUUID),
+				IDSpec: sqlgraph.NewFieldSpec(menu.FieldID, field.TypeUUID),
 			},
 		}
 		for _, k := range nodes {
@@ -1586,15 +4206,15 @@ func (_u *RestaurantUpdateOne) sqlSave(ctx context.Context) (_node *Restaurant,
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.OrdersIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.MenusIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   restaurant.OrdersTable,
-			Columns: []string{restaurant.OrdersColumn},
+			Table:   restaurant.MenusTable,
+			Columns: []string{restaurant.MenusColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(order.FieldID, field.TypeUUID),
+				IDSpec: sqlgraph.NewFieldSpec(menu.FieldID, field.TypeUUID),
 			},
 		}
 		for _, k := range nodes {
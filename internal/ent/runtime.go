@@ -6,15 +6,35 @@ import (
 	"time"
 
 	"github.com/Jiruu246/rms/internal/ent/category"
+	"github.com/Jiruu246/rms/internal/ent/deliveryzone"
+	"github.com/Jiruu246/rms/internal/ent/idempotencykey"
+	"github.com/Jiruu246/rms/internal/ent/ingredient"
+	"github.com/Jiruu246/rms/internal/ent/menu"
 	"github.com/Jiruu246/rms/internal/ent/menuitem"
+	"github.com/Jiruu246/rms/internal/ent/menuitemmodifier"
+	"github.com/Jiruu246/rms/internal/ent/menuitemprice"
+	"github.com/Jiruu246/rms/internal/ent/menuitemvariant"
 	"github.com/Jiruu246/rms/internal/ent/modifier"
 	"github.com/Jiruu246/rms/internal/ent/modifieroption"
 	"github.com/Jiruu246/rms/internal/ent/order"
+	"github.com/Jiruu246/rms/internal/ent/orderevent"
 	"github.com/Jiruu246/rms/internal/ent/orderitem"
+	"github.com/Jiruu246/rms/internal/ent/orderitemchange"
 	"github.com/Jiruu246/rms/internal/ent/orderitemmodifieroption"
+	"github.com/Jiruu246/rms/internal/ent/ordernumbersequence"
+	"github.com/Jiruu246/rms/internal/ent/orderstatusevent"
+	"github.com/Jiruu246/rms/internal/ent/payment"
+	"github.com/Jiruu246/rms/internal/ent/ratelimitbucket"
+	"github.com/Jiruu246/rms/internal/ent/recipeingredient"
 	"github.com/Jiruu246/rms/internal/ent/refreshtoken"
+	"github.com/Jiruu246/rms/internal/ent/refund"
 	"github.com/Jiruu246/rms/internal/ent/restaurant"
 	"github.com/Jiruu246/rms/internal/ent/schema"
+	"github.com/Jiruu246/rms/internal/ent/station"
+	"github.com/Jiruu246/rms/internal/ent/stationticket"
+	"github.com/Jiruu246/rms/internal/ent/stockmovement"
+	"github.com/Jiruu246/rms/internal/ent/table"
+	"github.com/Jiruu246/rms/internal/ent/tablesession"
 	"github.com/Jiruu246/rms/internal/ent/user"
 	"github.com/Jiruu246/rms/internal/ent/userauthprovider"
 	"github.com/google/uuid"
@@ -79,6 +99,200 @@ func init() {
 	categoryDescID := categoryFields[0].Descriptor()
 	// category.DefaultID holds the default value on creation for the id field.
 	category.DefaultID = categoryDescID.Default.(func() uuid.UUID)
+	deliveryzoneMixin := schema.DeliveryZone{}.Mixin()
+	deliveryzoneMixinFields0 := deliveryzoneMixin[0].Fields()
+	_ = deliveryzoneMixinFields0
+	deliveryzoneMixinFields1 := deliveryzoneMixin[1].Fields()
+	_ = deliveryzoneMixinFields1
+	deliveryzoneFields := schema.DeliveryZone{}.Fields()
+	_ = deliveryzoneFields
+	// deliveryzoneDescUpdateTime is the schema descriptor for update_time field.
+	deliveryzoneDescUpdateTime := deliveryzoneMixinFields0[0].Descriptor()
+	// deliveryzone.DefaultUpdateTime holds the default value on creation for the update_time field.
+	deliveryzone.DefaultUpdateTime = deliveryzoneDescUpdateTime.Default.(func() time.Time)
+	// deliveryzone.UpdateDefaultUpdateTime holds the default value on update for the update_time field.
+	deliveryzone.UpdateDefaultUpdateTime = deliveryzoneDescUpdateTime.UpdateDefault.(func() time.Time)
+	// deliveryzoneDescCreateTime is the schema descriptor for create_time field.
+	deliveryzoneDescCreateTime := deliveryzoneMixinFields1[0].Descriptor()
+	// deliveryzone.DefaultCreateTime holds the default value on creation for the create_time field.
+	deliveryzone.DefaultCreateTime = deliveryzoneDescCreateTime.Default.(func() time.Time)
+	// deliveryzoneDescName is the schema descriptor for name field.
+	deliveryzoneDescName := deliveryzoneFields[1].Descriptor()
+	// deliveryzone.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	deliveryzone.NameValidator = func() func(string) error {
+		validators := deliveryzoneDescName.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(name string) error {
+			for _, fn := range fns {
+				if err := fn(name); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// deliveryzoneDescDeliveryFee is the schema descriptor for delivery_fee field.
+	deliveryzoneDescDeliveryFee := deliveryzoneFields[5].Descriptor()
+	// deliveryzone.DefaultDeliveryFee holds the default value on creation for the delivery_fee field.
+	deliveryzone.DefaultDeliveryFee = deliveryzoneDescDeliveryFee.Default.(int64)
+	// deliveryzone.DeliveryFeeValidator is a validator for the "delivery_fee" field. It is called by the builders before save.
+	deliveryzone.DeliveryFeeValidator = deliveryzoneDescDeliveryFee.Validators[0].(func(int64) error)
+	// deliveryzoneDescMinOrderValue is the schema descriptor for min_order_value field.
+	deliveryzoneDescMinOrderValue := deliveryzoneFields[6].Descriptor()
+	// deliveryzone.DefaultMinOrderValue holds the default value on creation for the min_order_value field.
+	deliveryzone.DefaultMinOrderValue = deliveryzoneDescMinOrderValue.Default.(int64)
+	// deliveryzone.MinOrderValueValidator is a validator for the "min_order_value" field. It is called by the builders before save.
+	deliveryzone.MinOrderValueValidator = deliveryzoneDescMinOrderValue.Validators[0].(func(int64) error)
+	// deliveryzoneDescIsActive is the schema descriptor for is_active field.
+	deliveryzoneDescIsActive := deliveryzoneFields[7].Descriptor()
+	// deliveryzone.DefaultIsActive holds the default value on creation for the is_active field.
+	deliveryzone.DefaultIsActive = deliveryzoneDescIsActive.Default.(bool)
+	// deliveryzoneDescID is the schema descriptor for id field.
+	deliveryzoneDescID := deliveryzoneFields[0].Descriptor()
+	// deliveryzone.DefaultID holds the default value on creation for the id field.
+	deliveryzone.DefaultID = deliveryzoneDescID.Default.(func() uuid.UUID)
+	idempotencykeyMixin := schema.IdempotencyKey{}.Mixin()
+	idempotencykeyMixinFields0 := idempotencykeyMixin[0].Fields()
+	_ = idempotencykeyMixinFields0
+	idempotencykeyFields := schema.IdempotencyKey{}.Fields()
+	_ = idempotencykeyFields
+	// idempotencykeyDescCreateTime is the schema descriptor for create_time field.
+	idempotencykeyDescCreateTime := idempotencykeyMixinFields0[0].Descriptor()
+	// idempotencykey.DefaultCreateTime holds the default value on creation for the create_time field.
+	idempotencykey.DefaultCreateTime = idempotencykeyDescCreateTime.Default.(func() time.Time)
+	// idempotencykeyDescScope is the schema descriptor for scope field.
+	idempotencykeyDescScope := idempotencykeyFields[1].Descriptor()
+	// idempotencykey.DefaultScope holds the default value on creation for the scope field.
+	idempotencykey.DefaultScope = idempotencykeyDescScope.Default.(string)
+	// idempotencykeyDescKey is the schema descriptor for key field.
+	idempotencykeyDescKey := idempotencykeyFields[2].Descriptor()
+	// idempotencykey.KeyValidator is a validator for the "key" field. It is called by the builders before save.
+	idempotencykey.KeyValidator = func() func(string) error {
+		validators := idempotencykeyDescKey.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(key string) error {
+			for _, fn := range fns {
+				if err := fn(key); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// idempotencykeyDescFingerprint is the schema descriptor for fingerprint field.
+	idempotencykeyDescFingerprint := idempotencykeyFields[3].Descriptor()
+	// idempotencykey.FingerprintValidator is a validator for the "fingerprint" field. It is called by the builders before save.
+	idempotencykey.FingerprintValidator = idempotencykeyDescFingerprint.Validators[0].(func(string) error)
+	// idempotencykeyDescID is the schema descriptor for id field.
+	idempotencykeyDescID := idempotencykeyFields[0].Descriptor()
+	// idempotencykey.DefaultID holds the default value on creation for the id field.
+	idempotencykey.DefaultID = idempotencykeyDescID.Default.(func() uuid.UUID)
+	ingredientMixin := schema.Ingredient{}.Mixin()
+	ingredientMixinFields0 := ingredientMixin[0].Fields()
+	_ = ingredientMixinFields0
+	ingredientMixinFields1 := ingredientMixin[1].Fields()
+	_ = ingredientMixinFields1
+	ingredientFields := schema.Ingredient{}.Fields()
+	_ = ingredientFields
+	// ingredientDescUpdateTime is the schema descriptor for update_time field.
+	ingredientDescUpdateTime := ingredientMixinFields0[0].Descriptor()
+	// ingredient.DefaultUpdateTime holds the default value on creation for the update_time field.
+	ingredient.DefaultUpdateTime = ingredientDescUpdateTime.Default.(func() time.Time)
+	// ingredient.UpdateDefaultUpdateTime holds the default value on update for the update_time field.
+	ingredient.UpdateDefaultUpdateTime = ingredientDescUpdateTime.UpdateDefault.(func() time.Time)
+	// ingredientDescCreateTime is the schema descriptor for create_time field.
+	ingredientDescCreateTime := ingredientMixinFields1[0].Descriptor()
+	// ingredient.DefaultCreateTime holds the default value on creation for the create_time field.
+	ingredient.DefaultCreateTime = ingredientDescCreateTime.Default.(func() time.Time)
+	// ingredientDescName is the schema descriptor for name field.
+	ingredientDescName := ingredientFields[1].Descriptor()
+	// ingredient.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	ingredient.NameValidator = func() func(string) error {
+		validators := ingredientDescName.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(name string) error {
+			for _, fn := range fns {
+				if err := fn(name); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// ingredientDescOnHand is the schema descriptor for on_hand field.
+	ingredientDescOnHand := ingredientFields[3].Descriptor()
+	// ingredient.DefaultOnHand holds the default value on creation for the on_hand field.
+	ingredient.DefaultOnHand = ingredientDescOnHand.Default.(int64)
+	// ingredient.OnHandValidator is a validator for the "on_hand" field. It is called by the builders before save.
+	ingredient.OnHandValidator = ingredientDescOnHand.Validators[0].(func(int64) error)
+	// ingredientDescID is the schema descriptor for id field.
+	ingredientDescID := ingredientFields[0].Descriptor()
+	// ingredient.DefaultID holds the default value on creation for the id field.
+	ingredient.DefaultID = ingredientDescID.Default.(func() uuid.UUID)
+	menuMixin := schema.Menu{}.Mixin()
+	menuMixinFields0 := menuMixin[0].Fields()
+	_ = menuMixinFields0
+	menuMixinFields1 := menuMixin[1].Fields()
+	_ = menuMixinFields1
+	menuFields := schema.Menu{}.Fields()
+	_ = menuFields
+	// menuDescUpdateTime is the schema descriptor for update_time field.
+	menuDescUpdateTime := menuMixinFields0[0].Descriptor()
+	// menu.DefaultUpdateTime holds the default value on creation for the update_time field.
+	menu.DefaultUpdateTime = menuDescUpdateTime.Default.(func() time.Time)
+	// menu.UpdateDefaultUpdateTime holds the default value on update for the update_time field.
+	menu.UpdateDefaultUpdateTime = menuDescUpdateTime.UpdateDefault.(func() time.Time)
+	// menuDescCreateTime is the schema descriptor for create_time field.
+	menuDescCreateTime := menuMixinFields1[0].Descriptor()
+	// menu.DefaultCreateTime holds the default value on creation for the create_time field.
+	menu.DefaultCreateTime = menuDescCreateTime.Default.(func() time.Time)
+	// menuDescName is the schema descriptor for name field.
+	menuDescName := menuFields[1].Descriptor()
+	// menu.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	menu.NameValidator = func() func(string) error {
+		validators := menuDescName.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(name string) error {
+			for _, fn := range fns {
+				if err := fn(name); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// menuDescDescription is the schema descriptor for description field.
+	menuDescDescription := menuFields[2].Descriptor()
+	// menu.DefaultDescription holds the default value on creation for the description field.
+	menu.DefaultDescription = menuDescDescription.Default.(string)
+	// menu.DescriptionValidator is a validator for the "description" field. It is called by the builders before save.
+	menu.DescriptionValidator = menuDescDescription.Validators[0].(func(string) error)
+	// menuDescDisplayOrder is the schema descriptor for display_order field.
+	menuDescDisplayOrder := menuFields[5].Descriptor()
+	// menu.DefaultDisplayOrder holds the default value on creation for the display_order field.
+	menu.DefaultDisplayOrder = menuDescDisplayOrder.Default.(int)
+	// menu.DisplayOrderValidator is a validator for the "display_order" field. It is called by the builders before save.
+	menu.DisplayOrderValidator = menuDescDisplayOrder.Validators[0].(func(int) error)
+	// menuDescIsActive is the schema descriptor for is_active field.
+	menuDescIsActive := menuFields[6].Descriptor()
+	// menu.DefaultIsActive holds the default value on creation for the is_active field.
+	menu.DefaultIsActive = menuDescIsActive.Default.(bool)
+	// menuDescID is the schema descriptor for id field.
+	menuDescID := menuFields[0].Descriptor()
+	// menu.DefaultID holds the default value on creation for the id field.
+	menu.DefaultID = menuDescID.Default.(func() uuid.UUID)
 	menuitemMixin := schema.MenuItem{}.Mixin()
 	menuitemMixinFields0 := menuitemMixin[0].Fields()
 	_ = menuitemMixinFields0
@@ -117,15 +331,113 @@ func init() {
 	// menuitemDescPrice is the schema descriptor for price field.
 	menuitemDescPrice := menuitemFields[3].Descriptor()
 	// menuitem.PriceValidator is a validator for the "price" field. It is called by the builders before save.
-	menuitem.PriceValidator = menuitemDescPrice.Validators[0].(func(float64) error)
+	menuitem.PriceValidator = menuitemDescPrice.Validators[0].(func(int64) error)
 	// menuitemDescIsAvailable is the schema descriptor for is_available field.
-	menuitemDescIsAvailable := menuitemFields[5].Descriptor()
+	menuitemDescIsAvailable := menuitemFields[7].Descriptor()
 	// menuitem.DefaultIsAvailable holds the default value on creation for the is_available field.
 	menuitem.DefaultIsAvailable = menuitemDescIsAvailable.Default.(bool)
+	// menuitemDescDisplayOrder is the schema descriptor for display_order field.
+	menuitemDescDisplayOrder := menuitemFields[8].Descriptor()
+	// menuitem.DefaultDisplayOrder holds the default value on creation for the display_order field.
+	menuitem.DefaultDisplayOrder = menuitemDescDisplayOrder.Default.(int)
+	// menuitem.DisplayOrderValidator is a validator for the "display_order" field. It is called by the builders before save.
+	menuitem.DisplayOrderValidator = menuitemDescDisplayOrder.Validators[0].(func(int) error)
+	// menuitemDescOutOfStock is the schema descriptor for out_of_stock field.
+	menuitemDescOutOfStock := menuitemFields[9].Descriptor()
+	// menuitem.DefaultOutOfStock holds the default value on creation for the out_of_stock field.
+	menuitem.DefaultOutOfStock = menuitemDescOutOfStock.Default.(bool)
 	// menuitemDescID is the schema descriptor for id field.
 	menuitemDescID := menuitemFields[0].Descriptor()
 	// menuitem.IDValidator is a validator for the "id" field. It is called by the builders before save.
 	menuitem.IDValidator = menuitemDescID.Validators[0].(func(int64) error)
+	menuitemmodifierMixin := schema.MenuItemModifier{}.Mixin()
+	menuitemmodifierMixinFields0 := menuitemmodifierMixin[0].Fields()
+	_ = menuitemmodifierMixinFields0
+	menuitemmodifierFields := schema.MenuItemModifier{}.Fields()
+	_ = menuitemmodifierFields
+	// menuitemmodifierDescUpdateTime is the schema descriptor for update_time field.
+	menuitemmodifierDescUpdateTime := menuitemmodifierMixinFields0[0].Descriptor()
+	// menuitemmodifier.DefaultUpdateTime holds the default value on creation for the update_time field.
+	menuitemmodifier.DefaultUpdateTime = menuitemmodifierDescUpdateTime.Default.(func() time.Time)
+	// menuitemmodifier.UpdateDefaultUpdateTime holds the default value on update for the update_time field.
+	menuitemmodifier.UpdateDefaultUpdateTime = menuitemmodifierDescUpdateTime.UpdateDefault.(func() time.Time)
+	// menuitemmodifierDescDisplayOrder is the schema descriptor for display_order field.
+	menuitemmodifierDescDisplayOrder := menuitemmodifierFields[3].Descriptor()
+	// menuitemmodifier.DefaultDisplayOrder holds the default value on creation for the display_order field.
+	menuitemmodifier.DefaultDisplayOrder = menuitemmodifierDescDisplayOrder.Default.(int)
+	// menuitemmodifier.DisplayOrderValidator is a validator for the "display_order" field. It is called by the builders before save.
+	menuitemmodifier.DisplayOrderValidator = menuitemmodifierDescDisplayOrder.Validators[0].(func(int) error)
+	// menuitemmodifierDescMax is the schema descriptor for max field.
+	menuitemmodifierDescMax := menuitemmodifierFields[5].Descriptor()
+	// menuitemmodifier.MaxValidator is a validator for the "max" field. It is called by the builders before save.
+	menuitemmodifier.MaxValidator = menuitemmodifierDescMax.Validators[0].(func(int) error)
+	// menuitemmodifierDescID is the schema descriptor for id field.
+	menuitemmodifierDescID := menuitemmodifierFields[0].Descriptor()
+	// menuitemmodifier.DefaultID holds the default value on creation for the id field.
+	menuitemmodifier.DefaultID = menuitemmodifierDescID.Default.(func() uuid.UUID)
+	menuitempriceFields := schema.MenuItemPrice{}.Fields()
+	_ = menuitempriceFields
+	// menuitempriceDescPrice is the schema descriptor for price field.
+	menuitempriceDescPrice := menuitempriceFields[1].Descriptor()
+	// menuitemprice.PriceValidator is a validator for the "price" field. It is called by the builders before save.
+	menuitemprice.PriceValidator = menuitempriceDescPrice.Validators[0].(func(int64) error)
+	// menuitempriceDescID is the schema descriptor for id field.
+	menuitempriceDescID := menuitempriceFields[0].Descriptor()
+	// menuitemprice.DefaultID holds the default value on creation for the id field.
+	menuitemprice.DefaultID = menuitempriceDescID.Default.(func() uuid.UUID)
+	menuitemvariantMixin := schema.MenuItemVariant{}.Mixin()
+	menuitemvariantMixinFields0 := menuitemvariantMixin[0].Fields()
+	_ = menuitemvariantMixinFields0
+	menuitemvariantFields := schema.MenuItemVariant{}.Fields()
+	_ = menuitemvariantFields
+	// menuitemvariantDescUpdateTime is the schema descriptor for update_time field.
+	menuitemvariantDescUpdateTime := menuitemvariantMixinFields0[0].Descriptor()
+	// menuitemvariant.DefaultUpdateTime holds the default value on creation for the update_time field.
+	menuitemvariant.DefaultUpdateTime = menuitemvariantDescUpdateTime.Default.(func() time.Time)
+	// menuitemvariant.UpdateDefaultUpdateTime holds the default value on update for the update_time field.
+	menuitemvariant.UpdateDefaultUpdateTime = menuitemvariantDescUpdateTime.UpdateDefault.(func() time.Time)
+	// menuitemvariantDescName is the schema descriptor for name field.
+	menuitemvariantDescName := menuitemvariantFields[1].Descriptor()
+	// menuitemvariant.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	menuitemvariant.NameValidator = func() func(string) error {
+		validators := menuitemvariantDescName.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(name string) error {
+			for _, fn := range fns {
+				if err := fn(name); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// menuitemvariantDescPrice is the schema descriptor for price field.
+	menuitemvariantDescPrice := menuitemvariantFields[2].Descriptor()
+	// menuitemvariant.PriceValidator is a validator for the "price" field. It is called by the builders before save.
+	menuitemvariant.PriceValidator = menuitemvariantDescPrice.Validators[0].(func(int64) error)
+	// menuitemvariantDescSku is the schema descriptor for sku field.
+	menuitemvariantDescSku := menuitemvariantFields[3].Descriptor()
+	// menuitemvariant.DefaultSku holds the default value on creation for the sku field.
+	menuitemvariant.DefaultSku = menuitemvariantDescSku.Default.(string)
+	// menuitemvariant.SkuValidator is a validator for the "sku" field. It is called by the builders before save.
+	menuitemvariant.SkuValidator = menuitemvariantDescSku.Validators[0].(func(string) error)
+	// menuitemvariantDescIsAvailable is the schema descriptor for is_available field.
+	menuitemvariantDescIsAvailable := menuitemvariantFields[4].Descriptor()
+	// menuitemvariant.DefaultIsAvailable holds the default value on creation for the is_available field.
+	menuitemvariant.DefaultIsAvailable = menuitemvariantDescIsAvailable.Default.(bool)
+	// menuitemvariantDescDisplayOrder is the schema descriptor for display_order field.
+	menuitemvariantDescDisplayOrder := menuitemvariantFields[5].Descriptor()
+	// menuitemvariant.DefaultDisplayOrder holds the default value on creation for the display_order field.
+	menuitemvariant.DefaultDisplayOrder = menuitemvariantDescDisplayOrder.Default.(int)
+	// menuitemvariant.DisplayOrderValidator is a validator for the "display_order" field. It is called by the builders before save.
+	menuitemvariant.DisplayOrderValidator = menuitemvariantDescDisplayOrder.Validators[0].(func(int) error)
+	// menuitemvariantDescID is the schema descriptor for id field.
+	menuitemvariantDescID := menuitemvariantFields[0].Descriptor()
+	// menuitemvariant.DefaultID holds the default value on creation for the id field.
+	menuitemvariant.DefaultID = menuitemvariantDescID.Default.(func() uuid.UUID)
 	modifierMixin := schema.Modifier{}.Mixin()
 	modifierMixinFields0 := modifierMixin[0].Fields()
 	_ = modifierMixinFields0
@@ -169,6 +481,18 @@ func init() {
 	modifier.DefaultMax = modifierDescMax.Default.(int)
 	// modifier.MaxValidator is a validator for the "max" field. It is called by the builders before save.
 	modifier.MaxValidator = modifierDescMax.Validators[0].(func(int) error)
+	// modifierDescMin is the schema descriptor for min field.
+	modifierDescMin := modifierFields[5].Descriptor()
+	// modifier.DefaultMin holds the default value on creation for the min field.
+	modifier.DefaultMin = modifierDescMin.Default.(int)
+	// modifier.MinValidator is a validator for the "min" field. It is called by the builders before save.
+	modifier.MinValidator = modifierDescMin.Validators[0].(func(int) error)
+	// modifierDescFreeQuantity is the schema descriptor for free_quantity field.
+	modifierDescFreeQuantity := modifierFields[6].Descriptor()
+	// modifier.DefaultFreeQuantity holds the default value on creation for the free_quantity field.
+	modifier.DefaultFreeQuantity = modifierDescFreeQuantity.Default.(int)
+	// modifier.FreeQuantityValidator is a validator for the "free_quantity" field. It is called by the builders before save.
+	modifier.FreeQuantityValidator = modifierDescFreeQuantity.Validators[0].(func(int) error)
 	// modifierDescID is the schema descriptor for id field.
 	modifierDescID := modifierFields[0].Descriptor()
 	// modifier.DefaultID holds the default value on creation for the id field.
@@ -205,15 +529,33 @@ func init() {
 	// modifieroptionDescPrice is the schema descriptor for price field.
 	modifieroptionDescPrice := modifieroptionFields[2].Descriptor()
 	// modifieroption.DefaultPrice holds the default value on creation for the price field.
-	modifieroption.DefaultPrice = modifieroptionDescPrice.Default.(float64)
+	modifieroption.DefaultPrice = modifieroptionDescPrice.Default.(int64)
+	// modifieroption.PriceValidator is a validator for the "price" field. It is called by the builders before save.
+	modifieroption.PriceValidator = modifieroptionDescPrice.Validators[0].(func(int64) error)
 	// modifieroptionDescAvailable is the schema descriptor for available field.
-	modifieroptionDescAvailable := modifieroptionFields[4].Descriptor()
+	modifieroptionDescAvailable := modifieroptionFields[7].Descriptor()
 	// modifieroption.DefaultAvailable holds the default value on creation for the available field.
 	modifieroption.DefaultAvailable = modifieroptionDescAvailable.Default.(bool)
+	// modifieroptionDescOutOfStock is the schema descriptor for out_of_stock field.
+	modifieroptionDescOutOfStock := modifieroptionFields[8].Descriptor()
+	// modifieroption.DefaultOutOfStock holds the default value on creation for the out_of_stock field.
+	modifieroption.DefaultOutOfStock = modifieroptionDescOutOfStock.Default.(bool)
 	// modifieroptionDescPreSelect is the schema descriptor for pre_select field.
-	modifieroptionDescPreSelect := modifieroptionFields[5].Descriptor()
+	modifieroptionDescPreSelect := modifieroptionFields[9].Descriptor()
 	// modifieroption.DefaultPreSelect holds the default value on creation for the pre_select field.
 	modifieroption.DefaultPreSelect = modifieroptionDescPreSelect.Default.(bool)
+	// modifieroptionDescDisplayOrder is the schema descriptor for display_order field.
+	modifieroptionDescDisplayOrder := modifieroptionFields[10].Descriptor()
+	// modifieroption.DefaultDisplayOrder holds the default value on creation for the display_order field.
+	modifieroption.DefaultDisplayOrder = modifieroptionDescDisplayOrder.Default.(int)
+	// modifieroption.DisplayOrderValidator is a validator for the "display_order" field. It is called by the builders before save.
+	modifieroption.DisplayOrderValidator = modifieroptionDescDisplayOrder.Validators[0].(func(int) error)
+	// modifieroptionDescMaxQuantity is the schema descriptor for max_quantity field.
+	modifieroptionDescMaxQuantity := modifieroptionFields[11].Descriptor()
+	// modifieroption.DefaultMaxQuantity holds the default value on creation for the max_quantity field.
+	modifieroption.DefaultMaxQuantity = modifieroptionDescMaxQuantity.Default.(int)
+	// modifieroption.MaxQuantityValidator is a validator for the "max_quantity" field. It is called by the builders before save.
+	modifieroption.MaxQuantityValidator = modifieroptionDescMaxQuantity.Validators[0].(func(int) error)
 	// modifieroptionDescID is the schema descriptor for id field.
 	modifieroptionDescID := modifieroptionFields[0].Descriptor()
 	// modifieroption.DefaultID holds the default value on creation for the id field.
@@ -229,10 +571,99 @@ func init() {
 	order.DefaultUpdateTime = orderDescUpdateTime.Default.(func() time.Time)
 	// order.UpdateDefaultUpdateTime holds the default value on update for the update_time field.
 	order.UpdateDefaultUpdateTime = orderDescUpdateTime.UpdateDefault.(func() time.Time)
+	// orderDescOrderNumberPeriod is the schema descriptor for order_number_period field.
+	orderDescOrderNumberPeriod := orderFields[2].Descriptor()
+	// order.DefaultOrderNumberPeriod holds the default value on creation for the order_number_period field.
+	order.DefaultOrderNumberPeriod = orderDescOrderNumberPeriod.Default.(string)
+	// orderDescSubtotal is the schema descriptor for subtotal field.
+	orderDescSubtotal := orderFields[7].Descriptor()
+	// order.DefaultSubtotal holds the default value on creation for the subtotal field.
+	order.DefaultSubtotal = orderDescSubtotal.Default.(int64)
+	// order.SubtotalValidator is a validator for the "subtotal" field. It is called by the builders before save.
+	order.SubtotalValidator = orderDescSubtotal.Validators[0].(func(int64) error)
+	// orderDescModifiersTotal is the schema descriptor for modifiers_total field.
+	orderDescModifiersTotal := orderFields[8].Descriptor()
+	// order.DefaultModifiersTotal holds the default value on creation for the modifiers_total field.
+	order.DefaultModifiersTotal = orderDescModifiersTotal.Default.(int64)
+	// order.ModifiersTotalValidator is a validator for the "modifiers_total" field. It is called by the builders before save.
+	order.ModifiersTotalValidator = orderDescModifiersTotal.Validators[0].(func(int64) error)
+	// orderDescTaxTotal is the schema descriptor for tax_total field.
+	orderDescTaxTotal := orderFields[9].Descriptor()
+	// order.DefaultTaxTotal holds the default value on creation for the tax_total field.
+	order.DefaultTaxTotal = orderDescTaxTotal.Default.(int64)
+	// order.TaxTotalValidator is a validator for the "tax_total" field. It is called by the builders before save.
+	order.TaxTotalValidator = orderDescTaxTotal.Validators[0].(func(int64) error)
+	// orderDescTotal is the schema descriptor for total field.
+	orderDescTotal := orderFields[11].Descriptor()
+	// order.DefaultTotal holds the default value on creation for the total field.
+	order.DefaultTotal = orderDescTotal.Default.(int64)
+	// order.TotalValidator is a validator for the "total" field. It is called by the builders before save.
+	order.TotalValidator = orderDescTotal.Validators[0].(func(int64) error)
+	// orderDescDeliveryFee is the schema descriptor for delivery_fee field.
+	orderDescDeliveryFee := orderFields[12].Descriptor()
+	// order.DefaultDeliveryFee holds the default value on creation for the delivery_fee field.
+	order.DefaultDeliveryFee = orderDescDeliveryFee.Default.(int64)
+	// order.DeliveryFeeValidator is a validator for the "delivery_fee" field. It is called by the builders before save.
+	order.DeliveryFeeValidator = orderDescDeliveryFee.Validators[0].(func(int64) error)
+	// orderDescAmountPaid is the schema descriptor for amount_paid field.
+	orderDescAmountPaid := orderFields[13].Descriptor()
+	// order.DefaultAmountPaid holds the default value on creation for the amount_paid field.
+	order.DefaultAmountPaid = orderDescAmountPaid.Default.(int64)
+	// order.AmountPaidValidator is a validator for the "amount_paid" field. It is called by the builders before save.
+	order.AmountPaidValidator = orderDescAmountPaid.Validators[0].(func(int64) error)
+	// orderDescAmountRefunded is the schema descriptor for amount_refunded field.
+	orderDescAmountRefunded := orderFields[14].Descriptor()
+	// order.DefaultAmountRefunded holds the default value on creation for the amount_refunded field.
+	order.DefaultAmountRefunded = orderDescAmountRefunded.Default.(int64)
+	// order.AmountRefundedValidator is a validator for the "amount_refunded" field. It is called by the builders before save.
+	order.AmountRefundedValidator = orderDescAmountRefunded.Validators[0].(func(int64) error)
+	// orderDescDeliveryAddress is the schema descriptor for delivery_address field.
+	orderDescDeliveryAddress := orderFields[18].Descriptor()
+	// order.DefaultDeliveryAddress holds the default value on creation for the delivery_address field.
+	order.DefaultDeliveryAddress = orderDescDeliveryAddress.Default.(string)
+	// orderDescDeliveryContactName is the schema descriptor for delivery_contact_name field.
+	orderDescDeliveryContactName := orderFields[21].Descriptor()
+	// order.DefaultDeliveryContactName holds the default value on creation for the delivery_contact_name field.
+	order.DefaultDeliveryContactName = orderDescDeliveryContactName.Default.(string)
+	// orderDescDeliveryContactPhone is the schema descriptor for delivery_contact_phone field.
+	orderDescDeliveryContactPhone := orderFields[22].Descriptor()
+	// order.DefaultDeliveryContactPhone holds the default value on creation for the delivery_contact_phone field.
+	order.DefaultDeliveryContactPhone = orderDescDeliveryContactPhone.Default.(string)
+	// orderDescDeliveryInstructions is the schema descriptor for delivery_instructions field.
+	orderDescDeliveryInstructions := orderFields[23].Descriptor()
+	// order.DefaultDeliveryInstructions holds the default value on creation for the delivery_instructions field.
+	order.DefaultDeliveryInstructions = orderDescDeliveryInstructions.Default.(string)
+	// orderDescDeliveryMinOrderValue is the schema descriptor for delivery_min_order_value field.
+	orderDescDeliveryMinOrderValue := orderFields[25].Descriptor()
+	// order.DefaultDeliveryMinOrderValue holds the default value on creation for the delivery_min_order_value field.
+	order.DefaultDeliveryMinOrderValue = orderDescDeliveryMinOrderValue.Default.(int64)
+	// order.DeliveryMinOrderValueValidator is a validator for the "delivery_min_order_value" field. It is called by the builders before save.
+	order.DeliveryMinOrderValueValidator = orderDescDeliveryMinOrderValue.Validators[0].(func(int64) error)
+	// orderDescItemsVersion is the schema descriptor for items_version field.
+	orderDescItemsVersion := orderFields[29].Descriptor()
+	// order.DefaultItemsVersion holds the default value on creation for the items_version field.
+	order.DefaultItemsVersion = orderDescItemsVersion.Default.(int)
 	// orderDescID is the schema descriptor for id field.
 	orderDescID := orderFields[0].Descriptor()
 	// order.DefaultID holds the default value on creation for the id field.
 	order.DefaultID = orderDescID.Default.(func() uuid.UUID)
+	ordereventMixin := schema.OrderEvent{}.Mixin()
+	ordereventMixinFields0 := ordereventMixin[0].Fields()
+	_ = ordereventMixinFields0
+	ordereventFields := schema.OrderEvent{}.Fields()
+	_ = ordereventFields
+	// ordereventDescCreateTime is the schema descriptor for create_time field.
+	ordereventDescCreateTime := ordereventMixinFields0[0].Descriptor()
+	// orderevent.DefaultCreateTime holds the default value on creation for the create_time field.
+	orderevent.DefaultCreateTime = ordereventDescCreateTime.Default.(func() time.Time)
+	// ordereventDescSeq is the schema descriptor for seq field.
+	ordereventDescSeq := ordereventFields[1].Descriptor()
+	// orderevent.SeqValidator is a validator for the "seq" field. It is called by the builders before save.
+	orderevent.SeqValidator = ordereventDescSeq.Validators[0].(func(int64) error)
+	// ordereventDescID is the schema descriptor for id field.
+	ordereventDescID := ordereventFields[0].Descriptor()
+	// orderevent.DefaultID holds the default value on creation for the id field.
+	orderevent.DefaultID = ordereventDescID.Default.(func() uuid.UUID)
 	orderitemFields := schema.OrderItem{}.Fields()
 	_ = orderitemFields
 	// orderitemDescQuantity is the schema descriptor for quantity field.
@@ -245,10 +676,69 @@ func init() {
 	orderitemDescItemName := orderitemFields[3].Descriptor()
 	// orderitem.ItemNameValidator is a validator for the "item_name" field. It is called by the builders before save.
 	orderitem.ItemNameValidator = orderitemDescItemName.Validators[0].(func(string) error)
+	// orderitemDescModifiersTotal is the schema descriptor for modifiers_total field.
+	orderitemDescModifiersTotal := orderitemFields[5].Descriptor()
+	// orderitem.DefaultModifiersTotal holds the default value on creation for the modifiers_total field.
+	orderitem.DefaultModifiersTotal = orderitemDescModifiersTotal.Default.(int64)
+	// orderitem.ModifiersTotalValidator is a validator for the "modifiers_total" field. It is called by the builders before save.
+	orderitem.ModifiersTotalValidator = orderitemDescModifiersTotal.Validators[0].(func(int64) error)
+	// orderitemDescLineTotal is the schema descriptor for line_total field.
+	orderitemDescLineTotal := orderitemFields[6].Descriptor()
+	// orderitem.DefaultLineTotal holds the default value on creation for the line_total field.
+	orderitem.DefaultLineTotal = orderitemDescLineTotal.Default.(int64)
+	// orderitem.LineTotalValidator is a validator for the "line_total" field. It is called by the builders before save.
+	orderitem.LineTotalValidator = orderitemDescLineTotal.Validators[0].(func(int64) error)
+	// orderitemDescRefundedQuantity is the schema descriptor for refunded_quantity field.
+	orderitemDescRefundedQuantity := orderitemFields[7].Descriptor()
+	// orderitem.DefaultRefundedQuantity holds the default value on creation for the refunded_quantity field.
+	orderitem.DefaultRefundedQuantity = orderitemDescRefundedQuantity.Default.(int)
+	// orderitem.RefundedQuantityValidator is a validator for the "refunded_quantity" field. It is called by the builders before save.
+	orderitem.RefundedQuantityValidator = orderitemDescRefundedQuantity.Validators[0].(func(int) error)
+	// orderitemDescVariantName is the schema descriptor for variant_name field.
+	orderitemDescVariantName := orderitemFields[10].Descriptor()
+	// orderitem.DefaultVariantName holds the default value on creation for the variant_name field.
+	orderitem.DefaultVariantName = orderitemDescVariantName.Default.(string)
+	// orderitemDescVoidReason is the schema descriptor for void_reason field.
+	orderitemDescVoidReason := orderitemFields[14].Descriptor()
+	// orderitem.DefaultVoidReason holds the default value on creation for the void_reason field.
+	orderitem.DefaultVoidReason = orderitemDescVoidReason.Default.(string)
+	// orderitem.VoidReasonValidator is a validator for the "void_reason" field. It is called by the builders before save.
+	orderitem.VoidReasonValidator = orderitemDescVoidReason.Validators[0].(func(string) error)
 	// orderitemDescID is the schema descriptor for id field.
 	orderitemDescID := orderitemFields[0].Descriptor()
 	// orderitem.DefaultID holds the default value on creation for the id field.
 	orderitem.DefaultID = orderitemDescID.Default.(func() uuid.UUID)
+	orderitemchangeMixin := schema.OrderItemChange{}.Mixin()
+	orderitemchangeMixinFields0 := orderitemchangeMixin[0].Fields()
+	_ = orderitemchangeMixinFields0
+	orderitemchangeFields := schema.OrderItemChange{}.Fields()
+	_ = orderitemchangeFields
+	// orderitemchangeDescCreateTime is the schema descriptor for create_time field.
+	orderitemchangeDescCreateTime := orderitemchangeMixinFields0[0].Descriptor()
+	// orderitemchange.DefaultCreateTime holds the default value on creation for the create_time field.
+	orderitemchange.DefaultCreateTime = orderitemchangeDescCreateTime.Default.(func() time.Time)
+	// orderitemchangeDescQuantityAfter is the schema descriptor for quantity_after field.
+	orderitemchangeDescQuantityAfter := orderitemchangeFields[3].Descriptor()
+	// orderitemchange.QuantityAfterValidator is a validator for the "quantity_after" field. It is called by the builders before save.
+	orderitemchange.QuantityAfterValidator = orderitemchangeDescQuantityAfter.Validators[0].(func(int) error)
+	// orderitemchangeDescInstructionsBefore is the schema descriptor for instructions_before field.
+	orderitemchangeDescInstructionsBefore := orderitemchangeFields[4].Descriptor()
+	// orderitemchange.DefaultInstructionsBefore holds the default value on creation for the instructions_before field.
+	orderitemchange.DefaultInstructionsBefore = orderitemchangeDescInstructionsBefore.Default.(string)
+	// orderitemchangeDescInstructionsAfter is the schema descriptor for instructions_after field.
+	orderitemchangeDescInstructionsAfter := orderitemchangeFields[5].Descriptor()
+	// orderitemchange.DefaultInstructionsAfter holds the default value on creation for the instructions_after field.
+	orderitemchange.DefaultInstructionsAfter = orderitemchangeDescInstructionsAfter.Default.(string)
+	// orderitemchangeDescReason is the schema descriptor for reason field.
+	orderitemchangeDescReason := orderitemchangeFields[6].Descriptor()
+	// orderitemchange.DefaultReason holds the default value on creation for the reason field.
+	orderitemchange.DefaultReason = orderitemchangeDescReason.Default.(string)
+	// orderitemchange.ReasonValidator is a validator for the "reason" field. It is called by the builders before save.
+	orderitemchange.ReasonValidator = orderitemchangeDescReason.Validators[0].(func(string) error)
+	// orderitemchangeDescID is the schema descriptor for id field.
+	orderitemchangeDescID := orderitemchangeFields[0].Descriptor()
+	// orderitemchange.DefaultID holds the default value on creation for the id field.
+	orderitemchange.DefaultID = orderitemchangeDescID.Default.(func() uuid.UUID)
 	orderitemmodifieroptionFields := schema.OrderItemModifierOption{}.Fields()
 	_ = orderitemmodifieroptionFields
 	// orderitemmodifieroptionDescQuantity is the schema descriptor for quantity field.
@@ -261,6 +751,112 @@ func init() {
 	orderitemmodifieroptionDescOptionName := orderitemmodifieroptionFields[3].Descriptor()
 	// orderitemmodifieroption.OptionNameValidator is a validator for the "option_name" field. It is called by the builders before save.
 	orderitemmodifieroption.OptionNameValidator = orderitemmodifieroptionDescOptionName.Validators[0].(func(string) error)
+	// orderitemmodifieroptionDescFreeQuantity is the schema descriptor for free_quantity field.
+	orderitemmodifieroptionDescFreeQuantity := orderitemmodifieroptionFields[5].Descriptor()
+	// orderitemmodifieroption.DefaultFreeQuantity holds the default value on creation for the free_quantity field.
+	orderitemmodifieroption.DefaultFreeQuantity = orderitemmodifieroptionDescFreeQuantity.Default.(int)
+	// orderitemmodifieroption.FreeQuantityValidator is a validator for the "free_quantity" field. It is called by the builders before save.
+	orderitemmodifieroption.FreeQuantityValidator = orderitemmodifieroptionDescFreeQuantity.Validators[0].(func(int) error)
+	ordernumbersequenceFields := schema.OrderNumberSequence{}.Fields()
+	_ = ordernumbersequenceFields
+	// ordernumbersequenceDescPeriod is the schema descriptor for period field.
+	ordernumbersequenceDescPeriod := ordernumbersequenceFields[1].Descriptor()
+	// ordernumbersequence.DefaultPeriod holds the default value on creation for the period field.
+	ordernumbersequence.DefaultPeriod = ordernumbersequenceDescPeriod.Default.(string)
+	// ordernumbersequenceDescLastValue is the schema descriptor for last_value field.
+	ordernumbersequenceDescLastValue := ordernumbersequenceFields[2].Descriptor()
+	// ordernumbersequence.DefaultLastValue holds the default value on creation for the last_value field.
+	ordernumbersequence.DefaultLastValue = ordernumbersequenceDescLastValue.Default.(int)
+	// ordernumbersequence.LastValueValidator is a validator for the "last_value" field. It is called by the builders before save.
+	ordernumbersequence.LastValueValidator = ordernumbersequenceDescLastValue.Validators[0].(func(int) error)
+	// ordernumbersequenceDescID is the schema descriptor for id field.
+	ordernumbersequenceDescID := ordernumbersequenceFields[0].Descriptor()
+	// ordernumbersequence.DefaultID holds the default value on creation for the id field.
+	ordernumbersequence.DefaultID = ordernumbersequenceDescID.Default.(func() uuid.UUID)
+	orderstatuseventMixin := schema.OrderStatusEvent{}.Mixin()
+	orderstatuseventMixinFields0 := orderstatuseventMixin[0].Fields()
+	_ = orderstatuseventMixinFields0
+	orderstatuseventFields := schema.OrderStatusEvent{}.Fields()
+	_ = orderstatuseventFields
+	// orderstatuseventDescCreateTime is the schema descriptor for create_time field.
+	orderstatuseventDescCreateTime := orderstatuseventMixinFields0[0].Descriptor()
+	// orderstatusevent.DefaultCreateTime holds the default value on creation for the create_time field.
+	orderstatusevent.DefaultCreateTime = orderstatuseventDescCreateTime.Default.(func() time.Time)
+	// orderstatuseventDescReason is the schema descriptor for reason field.
+	orderstatuseventDescReason := orderstatuseventFields[3].Descriptor()
+	// orderstatusevent.DefaultReason holds the default value on creation for the reason field.
+	orderstatusevent.DefaultReason = orderstatuseventDescReason.Default.(string)
+	// orderstatusevent.ReasonValidator is a validator for the "reason" field. It is called by the builders before save.
+	orderstatusevent.ReasonValidator = orderstatuseventDescReason.Validators[0].(func(string) error)
+	// orderstatuseventDescID is the schema descriptor for id field.
+	orderstatuseventDescID := orderstatuseventFields[0].Descriptor()
+	// orderstatusevent.DefaultID holds the default value on creation for the id field.
+	orderstatusevent.DefaultID = orderstatuseventDescID.Default.(func() uuid.UUID)
+	paymentMixin := schema.Payment{}.Mixin()
+	paymentMixinFields0 := paymentMixin[0].Fields()
+	_ = paymentMixinFields0
+	paymentFields := schema.Payment{}.Fields()
+	_ = paymentFields
+	// paymentDescCreateTime is the schema descriptor for create_time field.
+	paymentDescCreateTime := paymentMixinFields0[0].Descriptor()
+	// payment.DefaultCreateTime holds the default value on creation for the create_time field.
+	payment.DefaultCreateTime = paymentDescCreateTime.Default.(func() time.Time)
+	// paymentDescUpdateTime is the schema descriptor for update_time field.
+	paymentDescUpdateTime := paymentMixinFields0[1].Descriptor()
+	// payment.DefaultUpdateTime holds the default value on creation for the update_time field.
+	payment.DefaultUpdateTime = paymentDescUpdateTime.Default.(func() time.Time)
+	// payment.UpdateDefaultUpdateTime holds the default value on update for the update_time field.
+	payment.UpdateDefaultUpdateTime = paymentDescUpdateTime.UpdateDefault.(func() time.Time)
+	// paymentDescProvider is the schema descriptor for provider field.
+	paymentDescProvider := paymentFields[2].Descriptor()
+	// payment.ProviderValidator is a validator for the "provider" field. It is called by the builders before save.
+	payment.ProviderValidator = paymentDescProvider.Validators[0].(func(string) error)
+	// paymentDescProviderReference is the schema descriptor for provider_reference field.
+	paymentDescProviderReference := paymentFields[3].Descriptor()
+	// payment.DefaultProviderReference holds the default value on creation for the provider_reference field.
+	payment.DefaultProviderReference = paymentDescProviderReference.Default.(string)
+	// paymentDescAmount is the schema descriptor for amount field.
+	paymentDescAmount := paymentFields[4].Descriptor()
+	// payment.AmountValidator is a validator for the "amount" field. It is called by the builders before save.
+	payment.AmountValidator = paymentDescAmount.Validators[0].(func(int64) error)
+	// paymentDescRefundedAmount is the schema descriptor for refunded_amount field.
+	paymentDescRefundedAmount := paymentFields[7].Descriptor()
+	// payment.DefaultRefundedAmount holds the default value on creation for the refunded_amount field.
+	payment.DefaultRefundedAmount = paymentDescRefundedAmount.Default.(int64)
+	// payment.RefundedAmountValidator is a validator for the "refunded_amount" field. It is called by the builders before save.
+	payment.RefundedAmountValidator = paymentDescRefundedAmount.Validators[0].(func(int64) error)
+	// paymentDescFailureReason is the schema descriptor for failure_reason field.
+	paymentDescFailureReason := paymentFields[8].Descriptor()
+	// payment.DefaultFailureReason holds the default value on creation for the failure_reason field.
+	payment.DefaultFailureReason = paymentDescFailureReason.Default.(string)
+	// paymentDescID is the schema descriptor for id field.
+	paymentDescID := paymentFields[0].Descriptor()
+	// payment.DefaultID holds the default value on creation for the id field.
+	payment.DefaultID = paymentDescID.Default.(func() uuid.UUID)
+	ratelimitbucketFields := schema.RateLimitBucket{}.Fields()
+	_ = ratelimitbucketFields
+	// ratelimitbucketDescKey is the schema descriptor for key field.
+	ratelimitbucketDescKey := ratelimitbucketFields[1].Descriptor()
+	// ratelimitbucket.KeyValidator is a validator for the "key" field. It is called by the builders before save.
+	ratelimitbucket.KeyValidator = ratelimitbucketDescKey.Validators[0].(func(string) error)
+	// ratelimitbucketDescTokens is the schema descriptor for tokens field.
+	ratelimitbucketDescTokens := ratelimitbucketFields[2].Descriptor()
+	// ratelimitbucket.TokensValidator is a validator for the "tokens" field. It is called by the builders before save.
+	ratelimitbucket.TokensValidator = ratelimitbucketDescTokens.Validators[0].(func(float64) error)
+	// ratelimitbucketDescID is the schema descriptor for id field.
+	ratelimitbucketDescID := ratelimitbucketFields[0].Descriptor()
+	// ratelimitbucket.DefaultID holds the default value on creation for the id field.
+	ratelimitbucket.DefaultID = ratelimitbucketDescID.Default.(func() uuid.UUID)
+	recipeingredientFields := schema.RecipeIngredient{}.Fields()
+	_ = recipeingredientFields
+	// recipeingredientDescQuantity is the schema descriptor for quantity field.
+	recipeingredientDescQuantity := recipeingredientFields[1].Descriptor()
+	// recipeingredient.QuantityValidator is a validator for the "quantity" field. It is called by the builders before save.
+	recipeingredient.QuantityValidator = recipeingredientDescQuantity.Validators[0].(func(int64) error)
+	// recipeingredientDescID is the schema descriptor for id field.
+	recipeingredientDescID := recipeingredientFields[0].Descriptor()
+	// recipeingredient.DefaultID holds the default value on creation for the id field.
+	recipeingredient.DefaultID = recipeingredientDescID.Default.(func() uuid.UUID)
 	refreshtokenMixin := schema.RefreshToken{}.Mixin()
 	refreshtokenMixinFields0 := refreshtokenMixin[0].Fields()
 	_ = refreshtokenMixinFields0
@@ -284,6 +880,41 @@ func init() {
 	refreshtokenDescID := refreshtokenFields[0].Descriptor()
 	// refreshtoken.DefaultID holds the default value on creation for the id field.
 	refreshtoken.DefaultID = refreshtokenDescID.Default.(func() uuid.UUID)
+	refundMixin := schema.Refund{}.Mixin()
+	refundMixinFields0 := refundMixin[0].Fields()
+	_ = refundMixinFields0
+	refundFields := schema.Refund{}.Fields()
+	_ = refundFields
+	// refundDescCreateTime is the schema descriptor for create_time field.
+	refundDescCreateTime := refundMixinFields0[0].Descriptor()
+	// refund.DefaultCreateTime holds the default value on creation for the create_time field.
+	refund.DefaultCreateTime = refundDescCreateTime.Default.(func() time.Time)
+	// refundDescUpdateTime is the schema descriptor for update_time field.
+	refundDescUpdateTime := refundMixinFields0[1].Descriptor()
+	// refund.DefaultUpdateTime holds the default value on creation for the update_time field.
+	refund.DefaultUpdateTime = refundDescUpdateTime.Default.(func() time.Time)
+	// refund.UpdateDefaultUpdateTime holds the default value on update for the update_time field.
+	refund.UpdateDefaultUpdateTime = refundDescUpdateTime.UpdateDefault.(func() time.Time)
+	// refundDescAmount is the schema descriptor for amount field.
+	refundDescAmount := refundFields[1].Descriptor()
+	// refund.AmountValidator is a validator for the "amount" field. It is called by the builders before save.
+	refund.AmountValidator = refundDescAmount.Validators[0].(func(int64) error)
+	// refundDescNote is the schema descriptor for note field.
+	refundDescNote := refundFields[4].Descriptor()
+	// refund.DefaultNote holds the default value on creation for the note field.
+	refund.DefaultNote = refundDescNote.Default.(string)
+	// refundDescProviderReference is the schema descriptor for provider_reference field.
+	refundDescProviderReference := refundFields[6].Descriptor()
+	// refund.DefaultProviderReference holds the default value on creation for the provider_reference field.
+	refund.DefaultProviderReference = refundDescProviderReference.Default.(string)
+	// refundDescFailureReason is the schema descriptor for failure_reason field.
+	refundDescFailureReason := refundFields[8].Descriptor()
+	// refund.DefaultFailureReason holds the default value on creation for the failure_reason field.
+	refund.DefaultFailureReason = refundDescFailureReason.Default.(string)
+	// refundDescID is the schema descriptor for id field.
+	refundDescID := refundFields[0].Descriptor()
+	// refund.DefaultID holds the default value on creation for the id field.
+	refund.DefaultID = refundDescID.Default.(func() uuid.UUID)
 	restaurantMixin := schema.Restaurant{}.Mixin()
 	restaurantMixinFields0 := restaurantMixin[0].Fields()
 	_ = restaurantMixinFields0
@@ -327,10 +958,243 @@ func init() {
 	restaurantDescCountry := restaurantFields[9].Descriptor()
 	// restaurant.CountryValidator is a validator for the "country" field. It is called by the builders before save.
 	restaurant.CountryValidator = restaurantDescCountry.Validators[0].(func(string) error)
+	// restaurantDescTaxRateBps is the schema descriptor for tax_rate_bps field.
+	restaurantDescTaxRateBps := restaurantFields[19].Descriptor()
+	// restaurant.DefaultTaxRateBps holds the default value on creation for the tax_rate_bps field.
+	restaurant.DefaultTaxRateBps = restaurantDescTaxRateBps.Default.(int)
+	// restaurant.TaxRateBpsValidator is a validator for the "tax_rate_bps" field. It is called by the builders before save.
+	restaurant.TaxRateBpsValidator = func() func(int) error {
+		validators := restaurantDescTaxRateBps.Validators
+		fns := [...]func(int) error{
+			validators[0].(func(int) error),
+			validators[1].(func(int) error),
+		}
+		return func(tax_rate_bps int) error {
+			for _, fn := range fns {
+				if err := fn(tax_rate_bps); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// restaurantDescTimezone is the schema descriptor for timezone field.
+	restaurantDescTimezone := restaurantFields[20].Descriptor()
+	// restaurant.DefaultTimezone holds the default value on creation for the timezone field.
+	restaurant.DefaultTimezone = restaurantDescTimezone.Default.(string)
+	// restaurantDescSlotMinutes is the schema descriptor for slot_minutes field.
+	restaurantDescSlotMinutes := restaurantFields[21].Descriptor()
+	// restaurant.DefaultSlotMinutes holds the default value on creation for the slot_minutes field.
+	restaurant.DefaultSlotMinutes = restaurantDescSlotMinutes.Default.(int)
+	// restaurant.SlotMinutesValidator is a validator for the "slot_minutes" field. It is called by the builders before save.
+	restaurant.SlotMinutesValidator = func() func(int) error {
+		validators := restaurantDescSlotMinutes.Validators
+		fns := [...]func(int) error{
+			validators[0].(func(int) error),
+			validators[1].(func(int) error),
+		}
+		return func(slot_minutes int) error {
+			for _, fn := range fns {
+				if err := fn(slot_minutes); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// restaurantDescSlotCapacity is the schema descriptor for slot_capacity field.
+	restaurantDescSlotCapacity := restaurantFields[22].Descriptor()
+	// restaurant.DefaultSlotCapacity holds the default value on creation for the slot_capacity field.
+	restaurant.DefaultSlotCapacity = restaurantDescSlotCapacity.Default.(int)
+	// restaurant.SlotCapacityValidator is a validator for the "slot_capacity" field. It is called by the builders before save.
+	restaurant.SlotCapacityValidator = restaurantDescSlotCapacity.Validators[0].(func(int) error)
+	// restaurantDescKitchenLeadMinutes is the schema descriptor for kitchen_lead_minutes field.
+	restaurantDescKitchenLeadMinutes := restaurantFields[23].Descriptor()
+	// restaurant.DefaultKitchenLeadMinutes holds the default value on creation for the kitchen_lead_minutes field.
+	restaurant.DefaultKitchenLeadMinutes = restaurantDescKitchenLeadMinutes.Default.(int)
+	// restaurant.KitchenLeadMinutesValidator is a validator for the "kitchen_lead_minutes" field. It is called by the builders before save.
+	restaurant.KitchenLeadMinutesValidator = func() func(int) error {
+		validators := restaurantDescKitchenLeadMinutes.Validators
+		fns := [...]func(int) error{
+			validators[0].(func(int) error),
+			validators[1].(func(int) error),
+		}
+		return func(kitchen_lead_minutes int) error {
+			for _, fn := range fns {
+				if err := fn(kitchen_lead_minutes); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// restaurantDescOrderEventSeq is the schema descriptor for order_event_seq field.
+	restaurantDescOrderEventSeq := restaurantFields[25].Descriptor()
+	// restaurant.DefaultOrderEventSeq holds the default value on creation for the order_event_seq field.
+	restaurant.DefaultOrderEventSeq = restaurantDescOrderEventSeq.Default.(int64)
+	// restaurant.OrderEventSeqValidator is a validator for the "order_event_seq" field. It is called by the builders before save.
+	restaurant.OrderEventSeqValidator = restaurantDescOrderEventSeq.Validators[0].(func(int64) error)
 	// restaurantDescID is the schema descriptor for id field.
 	restaurantDescID := restaurantFields[0].Descriptor()
 	// restaurant.DefaultID holds the default value on creation for the id field.
 	restaurant.DefaultID = restaurantDescID.Default.(func() uuid.UUID)
+	stationMixin := schema.Station{}.Mixin()
+	stationMixinFields0 := stationMixin[0].Fields()
+	_ = stationMixinFields0
+	stationMixinFields1 := stationMixin[1].Fields()
+	_ = stationMixinFields1
+	stationFields := schema.Station{}.Fields()
+	_ = stationFields
+	// stationDescUpdateTime is the schema descriptor for update_time field.
+	stationDescUpdateTime := stationMixinFields0[0].Descriptor()
+	// station.DefaultUpdateTime holds the default value on creation for the update_time field.
+	station.DefaultUpdateTime = stationDescUpdateTime.Default.(func() time.Time)
+	// station.UpdateDefaultUpdateTime holds the default value on update for the update_time field.
+	station.UpdateDefaultUpdateTime = stationDescUpdateTime.UpdateDefault.(func() time.Time)
+	// stationDescCreateTime is the schema descriptor for create_time field.
+	stationDescCreateTime := stationMixinFields1[0].Descriptor()
+	// station.DefaultCreateTime holds the default value on creation for the create_time field.
+	station.DefaultCreateTime = stationDescCreateTime.Default.(func() time.Time)
+	// stationDescName is the schema descriptor for name field.
+	stationDescName := stationFields[1].Descriptor()
+	// station.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	station.NameValidator = func() func(string) error {
+		validators := stationDescName.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(name string) error {
+			for _, fn := range fns {
+				if err := fn(name); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// stationDescDisplayOrder is the schema descriptor for display_order field.
+	stationDescDisplayOrder := stationFields[2].Descriptor()
+	// station.DefaultDisplayOrder holds the default value on creation for the display_order field.
+	station.DefaultDisplayOrder = stationDescDisplayOrder.Default.(int)
+	// station.DisplayOrderValidator is a validator for the "display_order" field. It is called by the builders before save.
+	station.DisplayOrderValidator = stationDescDisplayOrder.Validators[0].(func(int) error)
+	// stationDescID is the schema descriptor for id field.
+	stationDescID := stationFields[0].Descriptor()
+	// station.DefaultID holds the default value on creation for the id field.
+	station.DefaultID = stationDescID.Default.(func() uuid.UUID)
+	stationticketMixin := schema.StationTicket{}.Mixin()
+	stationticketMixinFields0 := stationticketMixin[0].Fields()
+	_ = stationticketMixinFields0
+	stationticketMixinFields1 := stationticketMixin[1].Fields()
+	_ = stationticketMixinFields1
+	stationticketFields := schema.StationTicket{}.Fields()
+	_ = stationticketFields
+	// stationticketDescUpdateTime is the schema descriptor for update_time field.
+	stationticketDescUpdateTime := stationticketMixinFields0[0].Descriptor()
+	// stationticket.DefaultUpdateTime holds the default value on creation for the update_time field.
+	stationticket.DefaultUpdateTime = stationticketDescUpdateTime.Default.(func() time.Time)
+	// stationticket.UpdateDefaultUpdateTime holds the default value on update for the update_time field.
+	stationticket.UpdateDefaultUpdateTime = stationticketDescUpdateTime.UpdateDefault.(func() time.Time)
+	// stationticketDescCreateTime is the schema descriptor for create_time field.
+	stationticketDescCreateTime := stationticketMixinFields1[0].Descriptor()
+	// stationticket.DefaultCreateTime holds the default value on creation for the create_time field.
+	stationticket.DefaultCreateTime = stationticketDescCreateTime.Default.(func() time.Time)
+	// stationticketDescID is the schema descriptor for id field.
+	stationticketDescID := stationticketFields[0].Descriptor()
+	// stationticket.DefaultID holds the default value on creation for the id field.
+	stationticket.DefaultID = stationticketDescID.Default.(func() uuid.UUID)
+	stockmovementMixin := schema.StockMovement{}.Mixin()
+	stockmovementMixinFields0 := stockmovementMixin[0].Fields()
+	_ = stockmovementMixinFields0
+	stockmovementFields := schema.StockMovement{}.Fields()
+	_ = stockmovementFields
+	// stockmovementDescCreateTime is the schema descriptor for create_time field.
+	stockmovementDescCreateTime := stockmovementMixinFields0[0].Descriptor()
+	// stockmovement.DefaultCreateTime holds the default value on creation for the create_time field.
+	stockmovement.DefaultCreateTime = stockmovementDescCreateTime.Default.(func() time.Time)
+	// stockmovementDescNote is the schema descriptor for note field.
+	stockmovementDescNote := stockmovementFields[4].Descriptor()
+	// stockmovement.DefaultNote holds the default value on creation for the note field.
+	stockmovement.DefaultNote = stockmovementDescNote.Default.(string)
+	// stockmovement.NoteValidator is a validator for the "note" field. It is called by the builders before save.
+	stockmovement.NoteValidator = stockmovementDescNote.Validators[0].(func(string) error)
+	// stockmovementDescID is the schema descriptor for id field.
+	stockmovementDescID := stockmovementFields[0].Descriptor()
+	// stockmovement.DefaultID holds the default value on creation for the id field.
+	stockmovement.DefaultID = stockmovementDescID.Default.(func() uuid.UUID)
+	tableMixin := schema.Table{}.Mixin()
+	tableMixinFields0 := tableMixin[0].Fields()
+	_ = tableMixinFields0
+	tableMixinFields1 := tableMixin[1].Fields()
+	_ = tableMixinFields1
+	tableFields := schema.Table{}.Fields()
+	_ = tableFields
+	// tableDescUpdateTime is the schema descriptor for update_time field.
+	tableDescUpdateTime := tableMixinFields0[0].Descriptor()
+	// table.DefaultUpdateTime holds the default value on creation for the update_time field.
+	table.DefaultUpdateTime = tableDescUpdateTime.Default.(func() time.Time)
+	// table.UpdateDefaultUpdateTime holds the default value on update for the update_time field.
+	table.UpdateDefaultUpdateTime = tableDescUpdateTime.UpdateDefault.(func() time.Time)
+	// tableDescCreateTime is the schema descriptor for create_time field.
+	tableDescCreateTime := tableMixinFields1[0].Descriptor()
+	// table.DefaultCreateTime holds the default value on creation for the create_time field.
+	table.DefaultCreateTime = tableDescCreateTime.Default.(func() time.Time)
+	// tableDescName is the schema descriptor for name field.
+	tableDescName := tableFields[1].Descriptor()
+	// table.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	table.NameValidator = func() func(string) error {
+		validators := tableDescName.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(name string) error {
+			for _, fn := range fns {
+				if err := fn(name); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// tableDescSection is the schema descriptor for section field.
+	tableDescSection := tableFields[2].Descriptor()
+	// table.DefaultSection holds the default value on creation for the section field.
+	table.DefaultSection = tableDescSection.Default.(string)
+	// table.SectionValidator is a validator for the "section" field. It is called by the builders before save.
+	table.SectionValidator = tableDescSection.Validators[0].(func(string) error)
+	// tableDescSeats is the schema descriptor for seats field.
+	tableDescSeats := tableFields[3].Descriptor()
+	// table.DefaultSeats holds the default value on creation for the seats field.
+	table.DefaultSeats = tableDescSeats.Default.(int)
+	// table.SeatsValidator is a validator for the "seats" field. It is called by the builders before save.
+	table.SeatsValidator = tableDescSeats.Validators[0].(func(int) error)
+	// tableDescQrToken is the schema descriptor for qr_token field.
+	tableDescQrToken := tableFields[4].Descriptor()
+	// table.QrTokenValidator is a validator for the "qr_token" field. It is called by the builders before save.
+	table.QrTokenValidator = tableDescQrToken.Validators[0].(func(string) error)
+	// tableDescIsActive is the schema descriptor for is_active field.
+	tableDescIsActive := tableFields[5].Descriptor()
+	// table.DefaultIsActive holds the default value on creation for the is_active field.
+	table.DefaultIsActive = tableDescIsActive.Default.(bool)
+	// tableDescID is the schema descriptor for id field.
+	tableDescID := tableFields[0].Descriptor()
+	// table.DefaultID holds the default value on creation for the id field.
+	table.DefaultID = tableDescID.Default.(func() uuid.UUID)
+	tablesessionMixin := schema.TableSession{}.Mixin()
+	tablesessionMixinFields0 := tablesessionMixin[0].Fields()
+	_ = tablesessionMixinFields0
+	tablesessionFields := schema.TableSession{}.Fields()
+	_ = tablesessionFields
+	// tablesessionDescCreateTime is the schema descriptor for create_time field.
+	tablesessionDescCreateTime := tablesessionMixinFields0[0].Descriptor()
+	// tablesession.DefaultCreateTime holds the default value on creation for the create_time field.
+	tablesession.DefaultCreateTime = tablesessionDescCreateTime.Default.(func() time.Time)
+	// tablesessionDescID is the schema descriptor for id field.
+	tablesessionDescID := tablesessionFields[0].Descriptor()
+	// tablesession.DefaultID holds the default value on creation for the id field.
+	tablesession.DefaultID = tablesessionDescID.Default.(func() uuid.UUID)
 	userMixin := schema.User{}.Mixin()
 	userMixinFields0 := userMixin[0].Fields()
 	_ = userMixinFields0
@@ -42,6 +42,10 @@ func (Category) Fields() []ent.Field {
 			Comment("Whether the category is active"),
 		field.UUID("restaurant_id", uuid.UUID{}).
 			Comment("ID of the restaurant this category belongs to"),
+		field.UUID("station_id", uuid.UUID{}).
+			Optional().
+			Nillable().
+			Comment("ID of the station that prepares this category's items, unless an item names its own"),
 	}
 }
 
@@ -62,5 +66,11 @@ func (Category) Edges() []ent.Edge {
 			Required().
 			Field("restaurant_id"),
 		edge.To("menu_items", MenuItem.Type),
+		edge.From("station", Station.Type).
+			Ref("categories").
+			Unique().
+			Field("station_id"),
+		edge.From("menus", Menu.Type).
+			Ref("categories"),
 	}
 }
@@ -0,0 +1,76 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"entgo.io/ent/schema/mixin"
+	"github.com/Jiruu246/rms/pkg/geo"
+	"github.com/google/uuid"
+)
+
+// DeliveryZone is an area a restaurant delivers to, with its own delivery
+// fee and minimum order value. RADIUS zones are circles around the
+// restaurant's latitude/longitude; POLYGON zones are drawn freely.
+type DeliveryZone struct {
+	ent.Schema
+}
+
+func (DeliveryZone) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.UpdateTime{},
+		mixin.CreateTime{},
+	}
+}
+
+func (DeliveryZone) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Immutable(),
+		field.String("name").
+			NotEmpty().
+			MaxLen(100),
+		field.Enum("kind").
+			Values("RADIUS", "POLYGON"),
+		field.Int("radius_meters").
+			Optional().
+			Nillable().
+			Comment("Radius around the restaurant location; set on RADIUS zones"),
+		field.JSON("polygon", []geo.Point{}).
+			Optional().
+			Comment("Vertices of the zone in order; set on POLYGON zones"),
+		field.Int64("delivery_fee").
+			Default(0).
+			Min(0).
+			Comment("Fee added to delivery orders in the zone, in minor units of the restaurant currency"),
+		field.Int64("min_order_value").
+			Default(0).
+			Min(0).
+			Comment("Smallest order subtotal the zone accepts, in minor units of the restaurant currency"),
+		field.Bool("is_active").
+			Default(true),
+		field.UUID("restaurant_id", uuid.UUID{}).
+			Immutable(),
+	}
+}
+
+func (DeliveryZone) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("restaurant", Restaurant.Type).
+			Ref("delivery_zones").
+			Unique().
+			Required().
+			Immutable().
+			Field("restaurant_id"),
+		edge.To("orders", Order.Type),
+	}
+}
+
+func (DeliveryZone) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("restaurant_id", "name").
+			Unique(),
+	}
+}
@@ -0,0 +1,62 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"entgo.io/ent/schema/mixin"
+	"github.com/google/uuid"
+)
+
+// IdempotencyKey records a request made with an Idempotency-Key header and,
+// once it has been handled, the response to replay for retries of it. A key
+// without a response is still being handled.
+type IdempotencyKey struct {
+	ent.Schema
+}
+
+func (IdempotencyKey) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.CreateTime{},
+	}
+}
+
+func (IdempotencyKey) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Immutable(),
+		field.String("scope").
+			Default("").
+			Immutable().
+			Comment("What the key is unique within, e.g. the restaurant the request is for"),
+		field.String("key").
+			NotEmpty().
+			MaxLen(255).
+			Immutable().
+			Comment("The client's Idempotency-Key header"),
+		field.String("fingerprint").
+			NotEmpty().
+			Comment("Hash of the request's method, route and body; a retry must match it"),
+		field.Int("status_code").
+			Optional().
+			Nillable().
+			Comment("Status of the stored response; nil while the request is being handled"),
+		field.String("content_type").
+			Optional().
+			Comment("Content-Type of the stored response"),
+		field.Bytes("response_body").
+			Optional().
+			Comment("Body of the stored response"),
+		field.Time("expires_at").
+			Comment("When a stored response stops being replayed, or a request being handled is assumed to have died"),
+	}
+}
+
+func (IdempotencyKey) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("scope", "key").
+			Unique(),
+		index.Fields("expires_at"),
+	}
+}
@@ -0,0 +1,71 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"entgo.io/ent/schema/mixin"
+	"github.com/google/uuid"
+)
+
+// Ingredient is something a restaurant keeps in stock. Recipes
+// (RecipeIngredient) say how much of it menu items and modifier options use;
+// selling them draws on_hand down, and every change to on_hand is recorded
+// as a StockMovement.
+type Ingredient struct {
+	ent.Schema
+}
+
+func (Ingredient) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.UpdateTime{},
+		mixin.CreateTime{},
+	}
+}
+
+func (Ingredient) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Immutable(),
+		field.String("name").
+			NotEmpty().
+			MaxLen(255).
+			Comment("Ingredient name"),
+		field.Enum("unit").
+			Values("g", "ml", "each").
+			Immutable().
+			Comment("Unit on_hand and recipe quantities are counted in"),
+		field.Int64("on_hand").
+			Default(0).
+			Min(0).
+			Comment("Quantity in stock, in unit"),
+		field.UUID("restaurant_id", uuid.UUID{}).
+			Immutable().
+			Comment("ID of the restaurant this ingredient belongs to"),
+	}
+}
+
+func (Ingredient) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("restaurant", Restaurant.Type).
+			Ref("ingredients").
+			Unique().
+			Required().
+			Immutable().
+			Field("restaurant_id"),
+		edge.To("recipe_lines", RecipeIngredient.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("movements", StockMovement.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+	}
+}
+
+func (Ingredient) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("restaurant_id", "name").
+			Unique(),
+	}
+}
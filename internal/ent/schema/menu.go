@@ -0,0 +1,80 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"entgo.io/ent/schema/mixin"
+	"github.com/Jiruu246/rms/pkg/hours"
+	"github.com/google/uuid"
+)
+
+// Menu groups categories into what a restaurant serves at certain times
+// and for certain order types (breakfast, lunch, a delivery menu...). Once
+// a restaurant has a menu, only items on a menu active at order time can be
+// ordered, at that menu's price for them if it sets one.
+type Menu struct {
+	ent.Schema
+}
+
+func (Menu) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.UpdateTime{},
+		mixin.CreateTime{},
+	}
+}
+
+func (Menu) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Immutable(),
+		field.String("name").
+			NotEmpty().
+			MaxLen(255).
+			Comment("Menu name"),
+		field.String("description").
+			Default("").
+			MaxLen(1000).
+			Comment("Menu description"),
+		field.JSON("schedule", &hours.Schedule{}).
+			Optional().
+			Comment("Days and times the menu is served, in the restaurant timezone; unset or empty means at all times"),
+		field.Strings("order_types").
+			Optional().
+			Comment("Order types the menu applies to; empty means all of them"),
+		field.Int("display_order").
+			Default(0).
+			Min(0).
+			Comment("Display order for sorting"),
+		field.Bool("is_active").
+			Default(true).
+			Comment("Whether the menu is served at all"),
+		field.UUID("restaurant_id", uuid.UUID{}).
+			Immutable().
+			Comment("ID of the restaurant this menu belongs to"),
+	}
+}
+
+func (Menu) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("restaurant", Restaurant.Type).
+			Ref("menus").
+			Unique().
+			Required().
+			Immutable().
+			Field("restaurant_id"),
+		edge.To("categories", Category.Type),
+		edge.To("item_prices", MenuItemPrice.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+	}
+}
+
+func (Menu) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("restaurant_id", "name").
+			Unique(),
+	}
+}
@@ -2,6 +2,7 @@ package schema
 
 import (
 	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
 	"entgo.io/ent/schema/mixin"
@@ -32,20 +33,37 @@ func (MenuItem) Fields() []ent.Field {
 			Default("").
 			MaxLen(1000).
 			Comment("Menu item description"),
-		field.Float("price").
+		field.Int64("price").
 			Min(0).
-			Comment("Menu item price"),
+			Comment("Menu item price in minor units of the restaurant currency"),
 		field.String("image_url").
 			Optional().
 			Comment("URL of the menu item image"),
+		field.String("thumbnail_url").
+			Optional().
+			Comment("URL of a small version of the uploaded image"),
+		field.Strings("image_keys").
+			Optional().
+			Comment("Blob store keys of the uploaded image and its thumbnail, deleted with the item"),
 		field.Bool("is_available").
 			Default(true).
 			Comment("Whether the menu item is available"),
+		field.Int("display_order").
+			Default(0).
+			Min(0).
+			Comment("Display order for sorting within its category"),
+		field.Bool("out_of_stock").
+			Default(false).
+			Comment("Whether is_available was turned off because an ingredient ran out; restocking turns it back on"),
 		field.UUID("restaurant_id", uuid.UUID{}).
 			Comment("ID of the restaurant this menu item belongs to"),
 		field.UUID("category_id", uuid.UUID{}).
 			Optional().
 			Comment("ID of the category this menu item belongs to"),
+		field.UUID("station_id", uuid.UUID{}).
+			Optional().
+			Nillable().
+			Comment("ID of the station that prepares this item; overrides the category's station"),
 	}
 }
 
@@ -60,7 +78,18 @@ func (MenuItem) Edges() []ent.Edge {
 			Ref("menu_items").
 			Unique().
 			Field("category_id"),
-		edge.To("modifiers", Modifier.Type),
+		edge.From("station", Station.Type).
+			Ref("menu_items").
+			Unique().
+			Field("station_id"),
+		edge.To("modifiers", Modifier.Type).
+			Through("item_modifiers", MenuItemModifier.Type),
 		edge.To("order_items", OrderItem.Type),
+		edge.To("recipe_lines", RecipeIngredient.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("menu_prices", MenuItemPrice.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("variants", MenuItemVariant.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
 	}
 }
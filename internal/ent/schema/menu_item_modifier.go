@@ -0,0 +1,79 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"entgo.io/ent/schema/mixin"
+	"github.com/google/uuid"
+)
+
+// MenuItemModifier attaches a modifier group to a menu item, in the item's
+// display order, with the item's own required, max and option prices in
+// place of the group's where set.
+type MenuItemModifier struct {
+	ent.Schema
+}
+
+func (MenuItemModifier) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.UpdateTime{},
+	}
+}
+
+func (MenuItemModifier) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Immutable(),
+		field.Int64("menu_item_id").
+			Immutable().
+			Comment("ID of the menu item the modifier is attached to"),
+		field.UUID("modifier_id", uuid.UUID{}).
+			Immutable().
+			Comment("ID of the attached modifier"),
+		field.Int("display_order").
+			Default(0).
+			Min(0).
+			Comment("Display order among the item's modifiers"),
+		field.Bool("required").
+			Optional().
+			Nillable().
+			Comment("Whether the modifier is required on this item, instead of its own required"),
+		field.Int("max").
+			Optional().
+			Nillable().
+			Min(0).
+			Comment("Maximum number of selections on this item, instead of the modifier's max"),
+		field.JSON("option_prices", map[uuid.UUID]int64{}).
+			Optional().
+			Comment("Prices of the modifier's options on this item, by option ID, instead of their price"),
+	}
+}
+
+func (MenuItemModifier) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("menu_item", MenuItem.Type).
+			Unique().
+			Required().
+			Immutable().
+			Field("menu_item_id").
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("modifier", Modifier.Type).
+			Unique().
+			Required().
+			Immutable().
+			Field("modifier_id").
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+	}
+}
+
+func (MenuItemModifier) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("menu_item_id", "modifier_id").
+			Unique(),
+		index.Fields("modifier_id"),
+	}
+}
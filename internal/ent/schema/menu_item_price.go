@@ -0,0 +1,57 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// MenuItemPrice is what a menu item costs when ordered from one menu,
+// instead of its own price.
+type MenuItemPrice struct {
+	ent.Schema
+}
+
+func (MenuItemPrice) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Immutable(),
+		field.Int64("price").
+			Min(0).
+			Comment("Price of the item on the menu, in minor units of the restaurant currency"),
+		field.UUID("menu_id", uuid.UUID{}).
+			Immutable().
+			Comment("ID of the menu the price applies on"),
+		field.Int64("menu_item_id").
+			Immutable().
+			Comment("ID of the menu item priced"),
+	}
+}
+
+func (MenuItemPrice) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("menu", Menu.Type).
+			Ref("item_prices").
+			Unique().
+			Required().
+			Immutable().
+			Field("menu_id"),
+		edge.From("menu_item", MenuItem.Type).
+			Ref("menu_prices").
+			Unique().
+			Required().
+			Immutable().
+			Field("menu_item_id"),
+	}
+}
+
+func (MenuItemPrice) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("menu_id", "menu_item_id").
+			Unique(),
+		index.Fields("menu_item_id"),
+	}
+}
@@ -0,0 +1,73 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"entgo.io/ent/schema/mixin"
+	"github.com/google/uuid"
+)
+
+// MenuItemVariant is one of the sizes or versions a menu item is sold in,
+// such as a small or large latte, with its own price. An item with
+// variants is ordered as exactly one of them.
+type MenuItemVariant struct {
+	ent.Schema
+}
+
+func (MenuItemVariant) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.UpdateTime{},
+	}
+}
+
+func (MenuItemVariant) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Immutable(),
+		field.String("name").
+			NotEmpty().
+			MaxLen(255).
+			Comment("Variant name, e.g. Large; unique within the menu item"),
+		field.Int64("price").
+			Min(0).
+			Comment("Price of the variant in minor units of the restaurant currency; replaces the item's price"),
+		field.String("sku").
+			Default("").
+			MaxLen(64).
+			Comment("Stock keeping unit code of the variant, for reporting"),
+		field.Bool("is_available").
+			Default(true).
+			Comment("Whether the variant can be ordered"),
+		field.Int("display_order").
+			Default(0).
+			Min(0).
+			Comment("Display order among the item's variants"),
+		field.Int64("menu_item_id").
+			Immutable().
+			Comment("ID of the menu item this is a variant of"),
+	}
+}
+
+func (MenuItemVariant) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("menu_item", MenuItem.Type).
+			Ref("variants").
+			Unique().
+			Required().
+			Immutable().
+			Field("menu_item_id"),
+		// Order items keep their snapshot of the variant when it is deleted.
+		edge.To("order_items", OrderItem.Type).
+			Annotations(entsql.OnDelete(entsql.SetNull)),
+	}
+}
+
+func (MenuItemVariant) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("menu_item_id"),
+	}
+}
@@ -37,6 +37,14 @@ func (Modifier) Fields() []ent.Field {
 			Default(1).
 			Min(0).
 			Comment("Maximum number of selections allowed"),
+		field.Int("min").
+			Default(0).
+			Min(0).
+			Comment("Minimum number of selections; required groups need at least one"),
+		field.Int("free_quantity").
+			Default(0).
+			Min(0).
+			Comment("Number of selections included in the item's price; the cheapest are free"),
 		field.UUID("restaurant_id", uuid.UUID{}).
 			Comment("ID of the restaurant this modifier belongs to"),
 	}
@@ -50,6 +58,10 @@ func (Modifier) Edges() []ent.Edge {
 			Required().
 			Field("restaurant_id"),
 		edge.To("modifier_options", ModifierOption.Type),
-		edge.To("menu_items", MenuItem.Type),
+		edge.From("menu_items", MenuItem.Type).
+			Ref("modifiers").
+			Through("item_modifiers", MenuItemModifier.Type),
+		edge.From("parent_options", ModifierOption.Type).
+			Ref("child_modifiers"),
 	}
 }
@@ -2,6 +2,7 @@ package schema
 
 import (
 	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
 	"entgo.io/ent/schema/mixin"
@@ -27,18 +28,39 @@ func (ModifierOption) Fields() []ent.Field {
 			NotEmpty().
 			MaxLen(255).
 			Comment("Modifier option name"),
-		field.Float("price").
-			Default(0.0).
-			Comment("Price of the modifier option"),
+		field.Int64("price").
+			Default(0).
+			Min(0).
+			Comment("Price of the modifier option in minor units of the restaurant currency"),
+		field.JSON("variant_prices", map[string]int64{}).
+			Optional().
+			Comment("Prices of the option with menu item variants of a given name, e.g. Large, instead of price"),
 		field.String("image_url").
 			Optional().
 			Comment("Image URL for the modifier option"),
+		field.String("thumbnail_url").
+			Optional().
+			Comment("URL of a small version of the uploaded image"),
+		field.Strings("image_keys").
+			Optional().
+			Comment("Blob store keys of the uploaded image and its thumbnail, deleted with the option"),
 		field.Bool("available").
 			Default(true).
 			Comment("Whether the modifier option is available"),
+		field.Bool("out_of_stock").
+			Default(false).
+			Comment("Whether available was turned off because an ingredient ran out; restocking turns it back on"),
 		field.Bool("pre_select").
 			Default(false).
 			Comment("Whether the modifier option is pre-selected"),
+		field.Int("display_order").
+			Default(0).
+			Min(0).
+			Comment("Display order for sorting within its modifier"),
+		field.Int("max_quantity").
+			Default(0).
+			Min(0).
+			Comment("Most times the option can be chosen in one selection; 0 leaves it to the modifier's max"),
 		field.UUID("modifier_id", uuid.UUID{}).
 			Comment("ID of the modifier this option belongs to"),
 	}
@@ -52,5 +74,10 @@ func (ModifierOption) Edges() []ent.Edge {
 			Required().
 			Field("modifier_id"),
 		edge.To("order_item_modifier_options", OrderItemModifierOption.Type),
+		// child_modifiers are groups chosen from once this option is, e.g.
+		// a Size group under the Fries side of a combo.
+		edge.To("child_modifiers", Modifier.Type),
+		edge.To("recipe_lines", RecipeIngredient.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
 	}
 }
@@ -2,8 +2,10 @@ package schema
 
 import (
 	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
 	"entgo.io/ent/schema/mixin"
 	"github.com/google/uuid"
 )
@@ -23,16 +25,117 @@ func (Order) Fields() []ent.Field {
 		field.UUID("id", uuid.UUID{}).
 			Default(uuid.New).
 			Immutable(),
+		field.Int("order_number").
+			Optional().
+			Nillable().
+			Immutable().
+			Comment("Human-readable number called out in the kitchen; allocated from the restaurant's sequence when the order is placed"),
+		field.String("order_number_period").
+			Default("").
+			Immutable().
+			Comment("Period of the sequence order_number was drawn from; see OrderNumberSequence"),
 		field.Enum("order_type").
 			Values("DINE_IN", "TAKEOUT", "DELIVERY"),
 		field.Enum("order_status").
-			Values("OPEN", "CONFIRMED", "COMPLETED", "CANCELLED").
+			Values("OPEN", "CONFIRMED", "READY", "COMPLETED", "CANCELLED").
 			Default("OPEN"),
 		field.Enum("payment_status").
 			Values("UNPAID", "PENDING", "PAID", "REFUNDED").
 			Default("UNPAID"),
+		field.String("currency").
+			Immutable().
+			Comment("ISO 4217 code of the restaurant currency when the order was placed; all amounts on the order are in its minor units"),
+		field.Int64("subtotal").
+			Default(0).
+			Min(0).
+			Comment("Sum of all line totals, before tax, in minor units of currency"),
+		field.Int64("modifiers_total").
+			Default(0).
+			Min(0).
+			Comment("Portion of the subtotal contributed by modifier options"),
+		field.Int64("tax_total").
+			Default(0).
+			Min(0).
+			Comment("Tax charged on the subtotal"),
+		field.Int("tax_rate_bps").
+			Optional().
+			Nillable().
+			Immutable().
+			Comment("Restaurant tax rate, in basis points, the order was placed at; item changes are repriced at it. Nil on orders placed before it was recorded"),
+		field.Int64("total").
+			Default(0).
+			Min(0).
+			Comment("Grand total: subtotal plus tax plus delivery fee"),
+		field.Int64("delivery_fee").
+			Default(0).
+			Min(0).
+			Immutable().
+			Comment("Delivery fee of the order's delivery zone; not taxed"),
+		field.Int64("amount_paid").
+			Default(0).
+			Min(0).
+			Comment("Sum of PENDING and SUCCEEDED payments; guards against capturing more than total"),
+		field.Int64("amount_refunded").
+			Default(0).
+			Min(0).
+			Comment("Sum of PENDING and SUCCEEDED refunds"),
 		field.UUID("restaurant_id", uuid.UUID{}).
 			Comment("ID of the restaurant this order belongs to"),
+		field.UUID("table_id", uuid.UUID{}).
+			Optional().
+			Nillable().
+			Comment("Table a dine-in order was placed at"),
+		field.UUID("table_session_id", uuid.UUID{}).
+			Optional().
+			Nillable().
+			Comment("Table session (bill) the order rolls up into; set together with table_id"),
+		field.String("delivery_address").
+			Default("").
+			Immutable().
+			Comment("Where a DELIVERY order goes, as entered by the customer"),
+		field.Float("delivery_latitude").
+			Optional().
+			Nillable().
+			Immutable(),
+		field.Float("delivery_longitude").
+			Optional().
+			Nillable().
+			Immutable(),
+		field.String("delivery_contact_name").
+			Default("").
+			Immutable(),
+		field.String("delivery_contact_phone").
+			Default("").
+			Immutable(),
+		field.String("delivery_instructions").
+			Default("").
+			Immutable(),
+		field.UUID("delivery_zone_id", uuid.UUID{}).
+			Optional().
+			Nillable().
+			Comment("Zone whose fee and minimum the DELIVERY order was priced with"),
+		field.Int64("delivery_min_order_value").
+			Default(0).
+			Min(0).
+			Immutable().
+			Comment("Minimum subtotal of the delivery zone when the order was placed; item changes may not take the subtotal below it"),
+		field.Time("scheduled_for").
+			Optional().
+			Nillable().
+			Immutable().
+			Comment("Requested pickup or delivery time of a scheduled order; nil for ASAP orders"),
+		field.Time("release_at").
+			Optional().
+			Nillable().
+			Immutable().
+			Comment("When a scheduled order is due to be sent to the kitchen: scheduled_for minus the restaurant's kitchen lead time"),
+		field.Time("released_at").
+			Optional().
+			Nillable().
+			Comment("When the order's station tickets were created; nil while a scheduled order is held"),
+		field.Int("items_version").
+			Default(0).
+			Comment("Bumped by every change to the order's items after it was placed; item edits are guarded on it so concurrent edits don't price off stale lines"),
 	}
 }
 
@@ -44,5 +147,35 @@ func (Order) Edges() []ent.Edge {
 			Required().
 			Field("restaurant_id"),
 		edge.To("order_items", OrderItem.Type),
+		edge.To("status_events", OrderStatusEvent.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("item_changes", OrderItemChange.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("payments", Payment.Type),
+		edge.To("refunds", Refund.Type),
+		edge.To("station_tickets", StationTicket.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.From("table", Table.Type).
+			Ref("orders").
+			Unique().
+			Field("table_id"),
+		edge.From("table_session", TableSession.Type).
+			Ref("orders").
+			Unique().
+			Field("table_session_id"),
+		edge.From("delivery_zone", DeliveryZone.Type).
+			Ref("orders").
+			Unique().
+			Field("delivery_zone_id"),
+	}
+}
+
+func (Order) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("restaurant_id", "order_number_period", "order_number").
+			Unique(),
+		index.Fields("restaurant_id", "scheduled_for"),
+		index.Fields("release_at").
+			Annotations(entsql.IndexWhere("released_at IS NULL")),
 	}
 }
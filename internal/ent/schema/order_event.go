@@ -0,0 +1,84 @@
+package schema
+
+import (
+	"encoding/json"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"entgo.io/ent/schema/mixin"
+	"github.com/google/uuid"
+)
+
+// OrderEvent is an entry in a restaurant's order feed, the log kitchen
+// displays stream from. seq is allocated from Restaurant.order_event_seq in
+// the same transaction as the change it records, which makes seq order
+// match commit order within a restaurant: a reader that has seen seq N
+// never later finds an event below N appear.
+type OrderEvent struct {
+	ent.Schema
+}
+
+func (OrderEvent) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.CreateTime{},
+	}
+}
+
+func (OrderEvent) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Immutable(),
+		field.Int64("seq").
+			Positive().
+			Immutable().
+			Comment("Position in the restaurant's feed; used as the SSE event id"),
+		field.Enum("type").
+			NamedValues(
+				"Created", "order.created",
+				"Updated", "order.updated",
+				"StatusChanged", "order.status_changed",
+				"Deleted", "order.deleted",
+				"Released", "order.released",
+				"TicketCreated", "ticket.created",
+				"TicketStatusChanged", "ticket.status_changed",
+				"TicketUpdated", "ticket.updated",
+			).
+			Immutable(),
+		field.UUID("order_id", uuid.UUID{}).
+			Immutable().
+			Comment("Not a foreign key, so that events outlive deleted orders"),
+		field.JSON("payload", json.RawMessage{}).
+			Optional().
+			Immutable().
+			Comment("The order (or, for ticket events, the ticket) as it was after the change, as returned by the API; empty for order.deleted"),
+		field.UUID("station_id", uuid.UUID{}).
+			Optional().
+			Nillable().
+			Immutable().
+			Comment("Station of the ticket, for ticket events; what station feeds filter on"),
+		field.UUID("restaurant_id", uuid.UUID{}).
+			Immutable(),
+	}
+}
+
+func (OrderEvent) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("restaurant", Restaurant.Type).
+			Ref("order_events").
+			Unique().
+			Required().
+			Immutable().
+			Field("restaurant_id"),
+	}
+}
+
+func (OrderEvent) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("restaurant_id", "seq").
+			Unique(),
+		index.Fields("station_id", "seq"),
+	}
+}
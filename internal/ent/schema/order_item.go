@@ -2,6 +2,7 @@ package schema
 
 import (
 	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
 	"github.com/google/uuid"
@@ -26,12 +27,43 @@ func (OrderItem) Fields() []ent.Field {
 		field.String("item_name").
 			NotEmpty().
 			Comment("Snapshot of the menu item name at the time of order"),
-		field.Float("item_price").
-			Comment("Snapshot of the menu item price at the time of order"),
+		field.Int64("item_price").
+			Comment("Snapshot of the menu item price at the time of order, in minor units of the order currency"),
+		field.Int64("modifiers_total").
+			Default(0).
+			Min(0).
+			Comment("Total of the selected modifier options across the whole line (already multiplied by quantity)"),
+		field.Int64("line_total").
+			Default(0).
+			Min(0).
+			Comment("(item_price * quantity) + modifiers_total"),
+		field.Int("refunded_quantity").
+			Default(0).
+			Min(0).
+			Comment("Units of this line covered by item refunds; never more than quantity"),
 		field.Int64("menu_item_id").
 			Comment("ID of the menu item"),
+		field.UUID("variant_id", uuid.UUID{}).
+			Optional().
+			Nillable().
+			Comment("ID of the menu item variant ordered; cleared if the variant is deleted"),
+		field.String("variant_name").
+			Default("").
+			Comment("Snapshot of the variant name at the time of order; empty for items without variants"),
 		field.UUID("order_id", uuid.UUID{}).
 			Comment("ID of the order this item belongs to"),
+		field.UUID("station_ticket_id", uuid.UUID{}).
+			Optional().
+			Nillable().
+			Comment("ID of the station ticket this item was routed to; nil if its menu item has no station"),
+		field.Time("voided_at").
+			Optional().
+			Nillable().
+			Comment("When the line was voided; voided lines stay on the order and its ticket but are left out of its totals"),
+		field.String("void_reason").
+			Default("").
+			MaxLen(1000).
+			Comment("Reason given for the void; required once the order has been sent to the kitchen"),
 	}
 }
 
@@ -47,6 +79,16 @@ func (OrderItem) Edges() []ent.Edge {
 			Unique().
 			Required().
 			Field("menu_item_id"),
+		edge.From("variant", MenuItemVariant.Type).
+			Ref("order_items").
+			Unique().
+			Field("variant_id"),
 		edge.To("order_item_modifier_options", OrderItemModifierOption.Type),
+		edge.From("station_ticket", StationTicket.Type).
+			Ref("order_items").
+			Unique().
+			Field("station_ticket_id"),
+		edge.To("changes", OrderItemChange.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
 	}
 }
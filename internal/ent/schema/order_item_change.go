@@ -0,0 +1,93 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"entgo.io/ent/schema/mixin"
+	"github.com/google/uuid"
+)
+
+// OrderItemChange is an append-only record of a change to an order's items
+// after the order was placed: a line added, its quantity or instructions
+// edited, or the line voided. Rows are written in the same transaction as
+// the change and are never updated afterwards.
+type OrderItemChange struct {
+	ent.Schema
+}
+
+func (OrderItemChange) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.CreateTime{},
+	}
+}
+
+func (OrderItemChange) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Immutable(),
+		field.Enum("action").
+			Values("ADDED", "UPDATED", "VOIDED").
+			Immutable(),
+		field.Int("quantity_before").
+			Optional().
+			Nillable().
+			Immutable().
+			Comment("Quantity before the change; nil for added lines"),
+		field.Int("quantity_after").
+			Min(0).
+			Immutable().
+			Comment("Quantity after the change; 0 for voided lines"),
+		field.String("instructions_before").
+			Default("").
+			Immutable(),
+		field.String("instructions_after").
+			Default("").
+			Immutable(),
+		field.String("reason").
+			Default("").
+			MaxLen(1000).
+			Immutable().
+			Comment("Free-text reason supplied with the change"),
+		field.UUID("changed_by_id", uuid.UUID{}).
+			Optional().
+			Nillable().
+			Immutable().
+			Comment("ID of the user who made the change"),
+		field.UUID("order_id", uuid.UUID{}).
+			Immutable(),
+		field.UUID("order_item_id", uuid.UUID{}).
+			Immutable(),
+	}
+}
+
+func (OrderItemChange) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("order", Order.Type).
+			Ref("item_changes").
+			Unique().
+			Required().
+			Immutable().
+			Field("order_id"),
+		edge.From("order_item", OrderItem.Type).
+			Ref("changes").
+			Unique().
+			Required().
+			Immutable().
+			Field("order_item_id"),
+		edge.From("changed_by", User.Type).
+			Ref("order_item_changes").
+			Unique().
+			Immutable().
+			Field("changed_by_id"),
+	}
+}
+
+// Indexes supports reading an order's item changes in chronological order.
+func (OrderItemChange) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("order_id", "create_time"),
+	}
+}
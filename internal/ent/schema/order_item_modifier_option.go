@@ -2,6 +2,7 @@ package schema
 
 import (
 	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
 	"entgo.io/ent/schema/index"
@@ -14,10 +15,9 @@ type OrderItemModifierOption struct {
 
 func (OrderItemModifierOption) Indexes() []ent.Index {
 	return []ent.Index{
-		index.Fields(
-			"order_item_id",
-			"modifier_option_id").
-			Unique(),
+		// The same option can be chosen in several places of one item's
+		// selection tree, such as a shared Size group under two sides.
+		index.Fields("order_item_id"),
 	}
 }
 
@@ -32,8 +32,16 @@ func (OrderItemModifierOption) Fields() []ent.Field {
 		field.String("option_name").
 			NotEmpty().
 			Comment("Snapshot of the modifier option name at the time of order"),
-		field.Float("option_price").
-			Comment("Snapshot of the modifier option price at the time of order"),
+		field.Int64("option_price").
+			Comment("Snapshot of the modifier option price at the time of order, in minor units of the order currency"),
+		field.Int("free_quantity").
+			Default(0).
+			Min(0).
+			Comment("How many of quantity were free under the modifier's free allowance"),
+		field.Int("parent_id").
+			Optional().
+			Nillable().
+			Comment("The selected option whose child modifier group this option was chosen in"),
 	}
 }
 
@@ -49,5 +57,10 @@ func (OrderItemModifierOption) Edges() []ent.Edge {
 			Unique().
 			Required().
 			Field("modifier_option_id"),
+		edge.To("children", OrderItemModifierOption.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)).
+			From("parent").
+			Unique().
+			Field("parent_id"),
 	}
 }
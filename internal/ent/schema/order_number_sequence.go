@@ -0,0 +1,53 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// OrderNumberSequence is the counter order numbers are drawn from. A
+// restaurant has one per period: a single sequence with an empty period if
+// its numbers never reset, or one per local business date if they reset
+// daily.
+type OrderNumberSequence struct {
+	ent.Schema
+}
+
+func (OrderNumberSequence) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Immutable(),
+		field.String("period").
+			Default("").
+			Immutable().
+			Comment("Empty for a sequence that never resets, otherwise the restaurant-local date (YYYY-MM-DD)"),
+		field.Int("last_value").
+			Default(0).
+			Min(0).
+			Comment("Last order number handed out"),
+		field.UUID("restaurant_id", uuid.UUID{}).
+			Immutable(),
+	}
+}
+
+func (OrderNumberSequence) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("restaurant", Restaurant.Type).
+			Ref("order_number_sequences").
+			Unique().
+			Required().
+			Immutable().
+			Field("restaurant_id"),
+	}
+}
+
+func (OrderNumberSequence) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("restaurant_id", "period").
+			Unique(),
+	}
+}
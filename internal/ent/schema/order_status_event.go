@@ -0,0 +1,86 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"entgo.io/ent/schema/mixin"
+	"github.com/google/uuid"
+)
+
+// OrderStatusEvent is an append-only record of a single order_status
+// transition. Rows are written in the same transaction as the status change
+// itself and are never updated afterwards.
+type OrderStatusEvent struct {
+	ent.Schema
+}
+
+func (OrderStatusEvent) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.CreateTime{},
+	}
+}
+
+func (OrderStatusEvent) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Immutable(),
+		field.Enum("from_status").
+			Values("OPEN", "CONFIRMED", "READY", "COMPLETED", "CANCELLED").
+			Optional().
+			Nillable().
+			Immutable().
+			Comment("Status before the transition; nil for the event recorded at order creation"),
+		field.Enum("to_status").
+			Values("OPEN", "CONFIRMED", "READY", "COMPLETED", "CANCELLED").
+			Immutable().
+			Comment("Status after the transition"),
+		field.String("reason").
+			Default("").
+			MaxLen(1000).
+			Immutable().
+			Comment("Free-text reason supplied with the transition"),
+		field.UUID("changed_by_id", uuid.UUID{}).
+			Optional().
+			Nillable().
+			Immutable().
+			Comment("ID of the user who made the change; nil for unauthenticated (public) orders"),
+		field.UUID("order_id", uuid.UUID{}).
+			Immutable().
+			Comment("ID of the order this event belongs to"),
+		field.UUID("restaurant_id", uuid.UUID{}).
+			Immutable().
+			Comment("ID of the restaurant the order belongs to"),
+	}
+}
+
+func (OrderStatusEvent) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("order", Order.Type).
+			Ref("status_events").
+			Unique().
+			Required().
+			Immutable().
+			Field("order_id"),
+		edge.From("restaurant", Restaurant.Type).
+			Ref("order_status_events").
+			Unique().
+			Required().
+			Immutable().
+			Field("restaurant_id"),
+		edge.From("changed_by", User.Type).
+			Ref("order_status_events").
+			Unique().
+			Immutable().
+			Field("changed_by_id"),
+	}
+}
+
+// Indexes supports reading an order's history in chronological order.
+func (OrderStatusEvent) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("order_id", "create_time"),
+	}
+}
@@ -0,0 +1,98 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"entgo.io/ent/schema/mixin"
+	"github.com/google/uuid"
+)
+
+// Payment is a single tender captured against an order. An order can have
+// several (split tender), e.g. part cash and part card. Rows are created as
+// PENDING before the provider is called and then settled to SUCCEEDED or
+// FAILED; only SUCCEEDED payments count towards the order's balance.
+type Payment struct {
+	ent.Schema
+}
+
+func (Payment) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.Time{},
+	}
+}
+
+func (Payment) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Immutable(),
+		field.Enum("method").
+			Values("CASH", "CARD").
+			Immutable(),
+		field.String("provider").
+			NotEmpty().
+			Immutable().
+			Comment("Name of the PaymentProvider that handled the payment, e.g. cash or fake_card"),
+		field.String("provider_reference").
+			Default("").
+			Comment("Provider-side identifier of the charge, set once the provider accepts it"),
+		field.Int64("amount").
+			Positive().
+			Immutable().
+			Comment("Amount tendered, in minor units of currency"),
+		field.String("currency").
+			Immutable().
+			Comment("ISO 4217 code, copied from the order"),
+		field.Enum("status").
+			Values("PENDING", "SUCCEEDED", "FAILED").
+			Default("PENDING"),
+		field.Int64("refunded_amount").
+			Default(0).
+			Min(0).
+			Comment("Sum of PENDING and SUCCEEDED refunds drawn from this payment"),
+		field.String("failure_reason").
+			Default("").
+			Comment("Why the provider declined or errored; empty unless status is FAILED"),
+		field.UUID("order_id", uuid.UUID{}).
+			Immutable(),
+		field.UUID("restaurant_id", uuid.UUID{}).
+			Immutable().
+			Comment("Denormalized from the order so payments can be scoped by restaurant directly"),
+		field.UUID("created_by_id", uuid.UUID{}).
+			Optional().
+			Nillable().
+			Immutable().
+			Comment("ID of the user who took the payment"),
+	}
+}
+
+func (Payment) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("order", Order.Type).
+			Ref("payments").
+			Unique().
+			Required().
+			Immutable().
+			Field("order_id"),
+		edge.From("restaurant", Restaurant.Type).
+			Ref("payments").
+			Unique().
+			Required().
+			Immutable().
+			Field("restaurant_id"),
+		edge.From("created_by", User.Type).
+			Ref("payments").
+			Unique().
+			Immutable().
+			Field("created_by_id"),
+		edge.To("refunds", Refund.Type),
+	}
+}
+
+func (Payment) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("order_id", "create_time"),
+	}
+}
@@ -0,0 +1,40 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// RateLimitBucket is the token bucket of one rate-limited key, shared by
+// every server instance.
+type RateLimitBucket struct {
+	ent.Schema
+}
+
+func (RateLimitBucket) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Immutable(),
+		field.String("key").
+			NotEmpty().
+			Unique().
+			Immutable().
+			Comment("What is limited, e.g. login:email:someone@example.com"),
+		field.Float("tokens").
+			Min(0).
+			Comment("Tokens left as of updated_at"),
+		field.Time("updated_at").
+			Comment("When tokens was last refilled and taken from"),
+		field.Time("full_at").
+			Comment("When the bucket is full again; it can be deleted after that"),
+	}
+}
+
+func (RateLimitBucket) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("full_at"),
+	}
+}
@@ -0,0 +1,71 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// RecipeIngredient is one line of a recipe: how much of an ingredient one
+// unit of a menu item, or one of a modifier option, uses. Exactly one of
+// menu_item_id and modifier_option_id is set.
+type RecipeIngredient struct {
+	ent.Schema
+}
+
+func (RecipeIngredient) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Immutable(),
+		field.Int64("quantity").
+			Positive().
+			Comment("Quantity of the ingredient used, in its unit"),
+		field.UUID("ingredient_id", uuid.UUID{}).
+			Immutable().
+			Comment("ID of the ingredient used"),
+		field.Int64("menu_item_id").
+			Optional().
+			Nillable().
+			Immutable().
+			Comment("ID of the menu item whose recipe this line is part of"),
+		field.UUID("modifier_option_id", uuid.UUID{}).
+			Optional().
+			Nillable().
+			Immutable().
+			Comment("ID of the modifier option whose recipe this line is part of"),
+	}
+}
+
+func (RecipeIngredient) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("ingredient", Ingredient.Type).
+			Ref("recipe_lines").
+			Unique().
+			Required().
+			Immutable().
+			Field("ingredient_id"),
+		edge.From("menu_item", MenuItem.Type).
+			Ref("recipe_lines").
+			Unique().
+			Immutable().
+			Field("menu_item_id"),
+		edge.From("modifier_option", ModifierOption.Type).
+			Ref("recipe_lines").
+			Unique().
+			Immutable().
+			Field("modifier_option_id"),
+	}
+}
+
+func (RecipeIngredient) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("menu_item_id", "ingredient_id").
+			Unique(),
+		index.Fields("modifier_option_id", "ingredient_id").
+			Unique(),
+		index.Fields("ingredient_id"),
+	}
+}
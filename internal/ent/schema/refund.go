@@ -0,0 +1,112 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"entgo.io/ent/schema/mixin"
+	"github.com/google/uuid"
+)
+
+// RefundedItem records that Quantity units of an order item were covered by
+// a refund request.
+type RefundedItem struct {
+	OrderItemID uuid.UUID `json:"order_item_id"`
+	Quantity    int       `json:"quantity"`
+}
+
+// Refund returns money from a single payment. A refund request that is
+// larger than any one payment (e.g. a full refund of a split-tender order)
+// is recorded as one Refund per payment it draws from. Like payments, rows
+// are created as PENDING before the provider is called and then settled to
+// SUCCEEDED or FAILED.
+type Refund struct {
+	ent.Schema
+}
+
+func (Refund) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.Time{},
+	}
+}
+
+func (Refund) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Immutable(),
+		field.Int64("amount").
+			Positive().
+			Immutable().
+			Comment("Amount returned, in minor units of currency"),
+		field.String("currency").
+			Immutable().
+			Comment("ISO 4217 code, copied from the payment"),
+		field.Enum("reason").
+			Values("CUSTOMER_REQUEST", "ORDER_ERROR", "QUALITY_ISSUE", "DUPLICATE", "OTHER").
+			Immutable(),
+		field.String("note").
+			Default("").
+			Immutable(),
+		field.JSON("items", []RefundedItem{}).
+			Optional().
+			Immutable().
+			Comment("Order items the refund request covered, if it was an item refund"),
+		field.String("provider_reference").
+			Default("").
+			Comment("Provider-side identifier of the refund, set once the provider accepts it"),
+		field.Enum("status").
+			Values("PENDING", "SUCCEEDED", "FAILED").
+			Default("PENDING"),
+		field.String("failure_reason").
+			Default("").
+			Comment("Why the provider rejected or errored; empty unless status is FAILED"),
+		field.UUID("payment_id", uuid.UUID{}).
+			Immutable(),
+		field.UUID("order_id", uuid.UUID{}).
+			Immutable(),
+		field.UUID("restaurant_id", uuid.UUID{}).
+			Immutable().
+			Comment("Denormalized from the order so refunds can be scoped by restaurant directly"),
+		field.UUID("created_by_id", uuid.UUID{}).
+			Optional().
+			Nillable().
+			Immutable().
+			Comment("ID of the user who issued the refund"),
+	}
+}
+
+func (Refund) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("payment", Payment.Type).
+			Ref("refunds").
+			Unique().
+			Required().
+			Immutable().
+			Field("payment_id"),
+		edge.From("order", Order.Type).
+			Ref("refunds").
+			Unique().
+			Required().
+			Immutable().
+			Field("order_id"),
+		edge.From("restaurant", Restaurant.Type).
+			Ref("refunds").
+			Unique().
+			Required().
+			Immutable().
+			Field("restaurant_id"),
+		edge.From("created_by", User.Type).
+			Ref("refunds").
+			Unique().
+			Immutable().
+			Field("created_by_id"),
+	}
+}
+
+func (Refund) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("order_id", "create_time"),
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
 	"entgo.io/ent/schema/mixin"
+	"github.com/Jiruu246/rms/pkg/hours"
 	"github.com/google/uuid"
 )
 
@@ -32,11 +33,55 @@ func (Restaurant) Fields() []ent.Field {
 		field.String("state").NotEmpty(),
 		field.String("zip_code").NotEmpty(),
 		field.String("country").NotEmpty(),
+		field.Float("latitude").
+			Optional().
+			Nillable().
+			Comment("Location of the restaurant; the center of RADIUS delivery zones"),
+		field.Float("longitude").
+			Optional().
+			Nillable(),
 		field.String("logo_url").Optional(),
+		field.String("logo_thumbnail_url").Optional(),
+		field.Strings("logo_keys").
+			Optional().
+			Comment("Blob store keys of the uploaded logo and its thumbnail, deleted with the restaurant"),
 		field.String("cover_image_url").Optional(),
 		field.Enum("status").Values("active", "inactive", "closed").Default("active"),
-		field.JSON("operating_hours", map[string]any{}).Optional(),
-		field.String("currency"),
+		field.JSON("operating_hours", &hours.Schedule{}).
+			Optional().
+			Comment("Weekly opening hours and holiday exceptions, in timezone; unset means ordering is not restricted by time"),
+		field.String("currency").
+			Comment("ISO 4217 code; all prices under the restaurant are stored in its minor units"),
+		field.Int("tax_rate_bps").
+			Default(0).
+			Min(0).
+			Max(10000).
+			Comment("Sales tax rate in basis points (1/100 of a percent), applied to order subtotals"),
+		field.String("timezone").
+			Default("UTC").
+			Comment("IANA time zone name; decides the local business date for daily order numbering"),
+		field.Int("slot_minutes").
+			Default(15).
+			Min(5).
+			Max(240).
+			Comment("Length of the pickup/delivery time slots scheduled orders are booked into"),
+		field.Int("slot_capacity").
+			Default(0).
+			Min(0).
+			Comment("Most scheduled orders per time slot; 0 means no limit"),
+		field.Int("kitchen_lead_minutes").
+			Default(20).
+			Min(0).
+			Max(1440).
+			Comment("How long before its scheduled time an order is released to the kitchen"),
+		field.Enum("order_number_reset").
+			Values("never", "daily").
+			Default("never").
+			Comment("Whether order numbers restart at 1 every local day"),
+		field.Int64("order_event_seq").
+			Default(0).
+			Min(0).
+			Comment("Last seq handed out to an OrderEvent of this restaurant"),
 		field.UUID("user_id", uuid.UUID{}),
 	}
 }
@@ -52,5 +97,17 @@ func (Restaurant) Edges() []ent.Edge {
 		edge.To("categories", Category.Type),
 		edge.To("modifiers", Modifier.Type),
 		edge.To("orders", Order.Type),
+		edge.To("order_status_events", OrderStatusEvent.Type),
+		edge.To("payments", Payment.Type),
+		edge.To("refunds", Refund.Type),
+		edge.To("order_number_sequences", OrderNumberSequence.Type),
+		edge.To("order_events", OrderEvent.Type),
+		edge.To("stations", Station.Type),
+		edge.To("station_tickets", StationTicket.Type),
+		edge.To("tables", Table.Type),
+		edge.To("table_sessions", TableSession.Type),
+		edge.To("delivery_zones", DeliveryZone.Type),
+		edge.To("ingredients", Ingredient.Type),
+		edge.To("menus", Menu.Type),
 	}
 }
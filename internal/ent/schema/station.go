@@ -0,0 +1,66 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"entgo.io/ent/schema/mixin"
+	"github.com/google/uuid"
+)
+
+// Station is a preparation area of a restaurant's kitchen (grill, fryer,
+// bar, expo...). Menu items are routed to a station either directly or
+// through their category; see StationTicket.
+type Station struct {
+	ent.Schema
+}
+
+func (Station) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.UpdateTime{},
+		mixin.CreateTime{},
+	}
+}
+
+func (Station) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Immutable(),
+		field.String("name").
+			NotEmpty().
+			MaxLen(255).
+			Comment("Station name"),
+		field.Int("display_order").
+			Default(0).
+			Min(0).
+			Comment("Display order for sorting"),
+		field.UUID("restaurant_id", uuid.UUID{}).
+			Immutable().
+			Comment("ID of the restaurant this station belongs to"),
+	}
+}
+
+func (Station) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("restaurant", Restaurant.Type).
+			Ref("stations").
+			Unique().
+			Required().
+			Immutable().
+			Field("restaurant_id"),
+		edge.To("menu_items", MenuItem.Type),
+		edge.To("categories", Category.Type),
+		edge.To("tickets", StationTicket.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+	}
+}
+
+func (Station) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("restaurant_id", "name").
+			Unique(),
+	}
+}
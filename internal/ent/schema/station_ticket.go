@@ -0,0 +1,75 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"entgo.io/ent/schema/mixin"
+	"github.com/google/uuid"
+)
+
+// StationTicket is the part of an order one station has to prepare. Tickets
+// are created with the order, one per station that any of its items routes
+// to, and the order's items point at the ticket they were put on.
+type StationTicket struct {
+	ent.Schema
+}
+
+func (StationTicket) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.UpdateTime{},
+		mixin.CreateTime{},
+	}
+}
+
+func (StationTicket) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Immutable(),
+		field.Enum("status").
+			Values("QUEUED", "IN_PROGRESS", "READY").
+			Default("QUEUED"),
+		field.UUID("order_id", uuid.UUID{}).
+			Immutable().
+			Comment("ID of the order this ticket was split from"),
+		field.UUID("station_id", uuid.UUID{}).
+			Immutable().
+			Comment("ID of the station that prepares this ticket"),
+		field.UUID("restaurant_id", uuid.UUID{}).
+			Immutable().
+			Comment("ID of the restaurant the order belongs to"),
+	}
+}
+
+func (StationTicket) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("order", Order.Type).
+			Ref("station_tickets").
+			Unique().
+			Required().
+			Immutable().
+			Field("order_id"),
+		edge.From("station", Station.Type).
+			Ref("tickets").
+			Unique().
+			Required().
+			Immutable().
+			Field("station_id"),
+		edge.From("restaurant", Restaurant.Type).
+			Ref("station_tickets").
+			Unique().
+			Required().
+			Immutable().
+			Field("restaurant_id"),
+		edge.To("order_items", OrderItem.Type),
+	}
+}
+
+// Indexes supports a station's ticket queue, oldest first.
+func (StationTicket) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("station_id", "status", "create_time"),
+	}
+}
@@ -0,0 +1,82 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"entgo.io/ent/schema/mixin"
+	"github.com/google/uuid"
+)
+
+// StockMovement is an append-only ledger entry of a change to an
+// ingredient's on_hand: a sale, a delivery, waste, or a stock count. Rows
+// are written in the same transaction as the change itself and are never
+// updated afterwards.
+type StockMovement struct {
+	ent.Schema
+}
+
+func (StockMovement) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.CreateTime{},
+	}
+}
+
+func (StockMovement) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Immutable(),
+		field.Enum("kind").
+			Values("SALE", "DELIVERY", "WASTE", "COUNT").
+			Immutable().
+			Comment("What moved the stock"),
+		field.Int64("quantity").
+			Immutable().
+			Comment("Change to on_hand, negative when stock was used up"),
+		field.Int64("on_hand_after").
+			Immutable().
+			Comment("on_hand after the movement"),
+		field.String("note").
+			Default("").
+			MaxLen(1000).
+			Immutable().
+			Comment("Free-text note supplied with an adjustment"),
+		field.UUID("order_id", uuid.UUID{}).
+			Optional().
+			Nillable().
+			Immutable().
+			Comment("ID of the order a SALE was for; not a foreign key, so the ledger outlives deleted orders"),
+		field.UUID("created_by_id", uuid.UUID{}).
+			Optional().
+			Nillable().
+			Immutable().
+			Comment("ID of the user who made the movement; nil for unauthenticated (public) orders"),
+		field.UUID("ingredient_id", uuid.UUID{}).
+			Immutable().
+			Comment("ID of the ingredient whose stock moved"),
+	}
+}
+
+func (StockMovement) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("ingredient", Ingredient.Type).
+			Ref("movements").
+			Unique().
+			Required().
+			Immutable().
+			Field("ingredient_id"),
+		edge.From("created_by", User.Type).
+			Ref("stock_movements").
+			Unique().
+			Immutable().
+			Field("created_by_id"),
+	}
+}
+
+func (StockMovement) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("ingredient_id", "create_time"),
+	}
+}
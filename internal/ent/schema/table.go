@@ -0,0 +1,75 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"entgo.io/ent/schema/mixin"
+	"github.com/google/uuid"
+)
+
+// Table is a dine-in table. Its qr_token is printed on the table as a QR
+// code and lets customers order to it through the public order endpoint.
+type Table struct {
+	ent.Schema
+}
+
+func (Table) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.UpdateTime{},
+		mixin.CreateTime{},
+	}
+}
+
+func (Table) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Immutable(),
+		field.String("name").
+			NotEmpty().
+			MaxLen(100).
+			Comment("Table name or number as shown to staff and customers"),
+		field.String("section").
+			Default("").
+			MaxLen(100).
+			Comment("Area of the restaurant the table is in (patio, bar...)"),
+		field.Int("seats").
+			Default(2).
+			Min(1).
+			Comment("Number of seats"),
+		field.String("qr_token").
+			NotEmpty().
+			Unique().
+			Comment("Unguessable token encoded in the table's QR code; rotating it invalidates printed codes"),
+		field.Bool("is_active").
+			Default(true).
+			Comment("Whether the table takes orders"),
+		field.UUID("restaurant_id", uuid.UUID{}).
+			Immutable().
+			Comment("ID of the restaurant this table belongs to"),
+	}
+}
+
+func (Table) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("restaurant", Restaurant.Type).
+			Ref("tables").
+			Unique().
+			Required().
+			Immutable().
+			Field("restaurant_id"),
+		edge.To("sessions", TableSession.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("orders", Order.Type),
+	}
+}
+
+func (Table) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("restaurant_id", "name").
+			Unique(),
+	}
+}
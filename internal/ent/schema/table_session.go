@@ -0,0 +1,78 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"entgo.io/ent/schema/mixin"
+	"github.com/google/uuid"
+)
+
+// TableSession is one party's visit to a table: the open bill that the
+// table's dine-in orders roll up into. The first order placed at a table
+// without an OPEN session opens one; a session can only be closed once all
+// of its orders are paid.
+type TableSession struct {
+	ent.Schema
+}
+
+func (TableSession) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.CreateTime{},
+	}
+}
+
+func (TableSession) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Immutable(),
+		field.Enum("status").
+			Values("OPEN", "CLOSED").
+			Default("OPEN"),
+		field.Time("closed_at").
+			Optional().
+			Nillable(),
+		field.UUID("closed_by_id", uuid.UUID{}).
+			Optional().
+			Nillable().
+			Comment("ID of the user who closed the session"),
+		field.UUID("table_id", uuid.UUID{}).
+			Immutable(),
+		field.UUID("restaurant_id", uuid.UUID{}).
+			Immutable(),
+	}
+}
+
+func (TableSession) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("table", Table.Type).
+			Ref("sessions").
+			Unique().
+			Required().
+			Immutable().
+			Field("table_id"),
+		edge.From("restaurant", Restaurant.Type).
+			Ref("table_sessions").
+			Unique().
+			Required().
+			Immutable().
+			Field("restaurant_id"),
+		edge.From("closed_by", User.Type).
+			Ref("closed_table_sessions").
+			Unique().
+			Field("closed_by_id"),
+		edge.To("orders", Order.Type),
+	}
+}
+
+// Indexes allows at most one OPEN session per table.
+func (TableSession) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("table_id").
+			Unique().
+			Annotations(entsql.IndexWhere("status = 'OPEN'")),
+	}
+}
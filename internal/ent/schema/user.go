@@ -46,5 +46,11 @@ func (User) Edges() []ent.Edge {
 		edge.To("restaurants", Restaurant.Type),
 		edge.To("auth_providers", UserAuthProvider.Type),
 		edge.To("refresh_tokens", RefreshToken.Type),
+		edge.To("order_status_events", OrderStatusEvent.Type),
+		edge.To("order_item_changes", OrderItemChange.Type),
+		edge.To("payments", Payment.Type),
+		edge.To("refunds", Refund.Type),
+		edge.To("closed_table_sessions", TableSession.Type),
+		edge.To("stock_movements", StockMovement.Type),
 	}
 }
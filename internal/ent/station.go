@@ -0,0 +1,230 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/Jiruu246/rms/internal/ent/restaurant"
+	"github.com/Jiruu246/rms/internal/ent/station"
+	"github.com/google/uuid"
+)
+
+// Station is the model entity for the Station schema.
+type Station struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// UpdateTime holds the value of the "update_time" field.
+	UpdateTime time.Time `json:"update_time,omitempty"`
+	// CreateTime holds the value of the "create_time" field.
+	CreateTime time.Time `json:"create_time,omitempty"`
+	// Station name
+	Name string `json:"name,omitempty"`
+	// Display order for sorting
+	DisplayOrder int `json:"display_order,omitempty"`
+	// ID of the restaurant this station belongs to
+	RestaurantID uuid.UUID `json:"restaurant_id,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the StationQuery when eager-loading is set.
+	Edges        StationEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// StationEdges holds the relations/edges for other nodes in the graph.
+type StationEdges struct {
+	// Restaurant holds the value of the restaurant edge.
+	Restaurant *Restaurant `json:"restaurant,omitempty"`
+	// MenuItems holds the value of the menu_items edge.
+	MenuItems []*MenuItem `json:"menu_items,omitempty"`
+	// Categories holds the value of the categories edge.
+	Categories []*Category `json:"categories,omitempty"`
+	// Tickets holds the value of the tickets edge.
+	Tickets []*StationTicket `json:"tickets,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [4]bool
+}
+
+// RestaurantOrErr returns the Restaurant value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e StationEdges) RestaurantOrErr() (*Restaurant, error) {
+	if e.Restaurant != nil {
+		return e.Restaurant, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: restaurant.Label}
+	}
+	return nil, &NotLoadedError{edge: "restaurant"}
+}
+
+// MenuItemsOrErr returns the MenuItems value or an error if the edge
+// was not loaded in eager-loading.
+func (e StationEdges) MenuItemsOrErr() ([]*MenuItem, error) {
+	if e.loadedTypes[1] {
+		return e.MenuItems, nil
+	}
+	return nil, &NotLoadedError{edge: "menu_items"}
+}
+
+// CategoriesOrErr returns the Categories value or an error if the edge
+// was not loaded in eager-loading.
+func (e StationEdges) CategoriesOrErr() ([]*Category, error) {
+	if e.loadedTypes[2] {
+		return e.Categories, nil
+	}
+	return nil, &NotLoadedError{edge: "categories"}
+}
+
+// TicketsOrErr returns the Tickets value or an error if the edge
+// was not loaded in eager-loading.
+func (e StationEdges) TicketsOrErr() ([]*StationTicket, error) {
+	if e.loadedTypes[3] {
+		return e.Tickets, nil
+	}
+	return nil, &NotLoadedError{edge: "tickets"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Station) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case station.FieldDisplayOrder:
+			values[i] = new(sql.NullInt64)
+		case station.FieldName:
+			values[i] = new(sql.NullString)
+		case station.FieldUpdateTime, station.FieldCreateTime:
+			values[i] = new(sql.NullTime)
+		case station.FieldID, station.FieldRestaurantID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Station fields.
+func (_m *Station) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case station.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case station.FieldUpdateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field update_time", values[i])
+			} else if value.Valid {
+				_m.UpdateTime = value.Time
+			}
+		case station.FieldCreateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field create_time", values[i])
+			} else if value.Valid {
+				_m.CreateTime = value.Time
+			}
+		case station.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				_m.Name = value.String
+			}
+		case station.FieldDisplayOrder:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field display_order", values[i])
+			} else if value.Valid {
+				_m.DisplayOrder = int(value.Int64)
+			}
+		case station.FieldRestaurantID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field restaurant_id", values[i])
+			} else if value != nil {
+				_m.RestaurantID = *value
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Station.
+// This includes values selected through modifiers, order, etc.
+func (_m *Station) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryRestaurant queries the "restaurant" edge of the Station entity.
+func (_m *Station) QueryRestaurant() *RestaurantQuery {
+	return NewStationClient(_m.config).QueryRestaurant(_m)
+}
+
+// QueryMenuItems queries the "menu_items" edge of the Station entity.
+func (_m *Station) QueryMenuItems() *MenuItemQuery {
+	return NewStationClient(_m.config).QueryMenuItems(_m)
+}
+
+// QueryCategories queries the "categories" edge of the Station entity.
+func (_m *Station) QueryCategories() *CategoryQuery {
+	return NewStationClient(_m.config).QueryCategories(_m)
+}
+
+// QueryTickets queries the "tickets" edge of the Station entity.
+func (_m *Station) QueryTickets() *StationTicketQuery {
+	return NewStationClient(_m.config).QueryTickets(_m)
+}
+
+// Update returns a builder for updating this Station.
+// Note that you need to call Station.Unwrap() before calling this method if this Station
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *Station) Update() *StationUpdateOne {
+	return NewStationClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the Station entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *Station) Unwrap() *Station {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Station is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *Station) String() string {
+	var builder strings.Builder
+	builder.WriteString("Station(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("update_time=")
+	builder.WriteString(_m.UpdateTime.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("create_time=")
+	builder.WriteString(_m.CreateTime.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(_m.Name)
+	builder.WriteString(", ")
+	builder.WriteString("display_order=")
+	builder.WriteString(fmt.Sprintf("%v", _m.DisplayOrder))
+	builder.WriteString(", ")
+	builder.WriteString("restaurant_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.RestaurantID))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Stations is a parsable slice of Station.
+type Stations []*Station
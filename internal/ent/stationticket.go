@@ -0,0 +1,245 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/Jiruu246/rms/internal/ent/order"
+	"github.com/Jiruu246/rms/internal/ent/restaurant"
+	"github.com/Jiruu246/rms/internal/ent/station"
+	"github.com/Jiruu246/rms/internal/ent/stationticket"
+	"github.com/google/uuid"
+)
+
+// StationTicket is the model entity for the StationTicket schema.
+type StationTicket struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// UpdateTime holds the value of the "update_time" field.
+	UpdateTime time.Time `json:"update_time,omitempty"`
+	// CreateTime holds the value of the "create_time" field.
+	CreateTime time.Time `json:"create_time,omitempty"`
+	// Status holds the value of the "status" field.
+	Status stationticket.Status `json:"status,omitempty"`
+	// ID of the order this ticket was split from
+	OrderID uuid.UUID `json:"order_id,omitempty"`
+	// ID of the station that prepares this ticket
+	StationID uuid.UUID `json:"station_id,omitempty"`
+	// ID of the restaurant the order belongs to
+	RestaurantID uuid.UUID `json:"restaurant_id,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the StationTicketQuery when eager-loading is set.
+	Edges        StationTicketEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// StationTicketEdges holds the relations/edges for other nodes in the graph.
+type StationTicketEdges struct {
+	// Order holds the value of the order edge.
+	Order *Order `json:"order,omitempty"`
+	// Station holds the value of the station edge.
+	Station *Station `json:"station,omitempty"`
+	// Restaurant holds the value of the restaurant edge.
+	Restaurant *Restaurant `json:"restaurant,omitempty"`
+	// OrderItems holds the value of the order_items edge.
+	OrderItems []*OrderItem `json:"order_items,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [4]bool
+}
+
+// OrderOrErr returns the Order value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e StationTicketEdges) OrderOrErr() (*Order, error) {
+	if e.Order != nil {
+		return e.Order, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: order.Label}
+	}
+	return nil, &NotLoadedError{edge: "order"}
+}
+
+// StationOrErr returns the Station value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e StationTicketEdges) StationOrErr() (*Station, error) {
+	if e.Station != nil {
+		return e.Station, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: station.Label}
+	}
+	return nil, &NotLoadedError{edge: "station"}
+}
+
+// RestaurantOrErr returns the Restaurant value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e StationTicketEdges) RestaurantOrErr() (*Restaurant, error) {
+	if e.Restaurant != nil {
+		return e.Restaurant, nil
+	} else if e.loadedTypes[2] {
+		return nil, &NotFoundError{label: restaurant.Label}
+	}
+	return nil, &NotLoadedError{edge: "restaurant"}
+}
+
+// OrderItemsOrErr returns the OrderItems value or an error if the edge
+// was not loaded in eager-loading.
+func (e StationTicketEdges) OrderItemsOrErr() ([]*OrderItem, error) {
+	if e.loadedTypes[3] {
+		return e.OrderItems, nil
+	}
+	return nil, &NotLoadedError{edge: "order_items"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*StationTicket) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case stationticket.FieldStatus:
+			values[i] = new(sql.NullString)
+		case stationticket.FieldUpdateTime, stationticket.FieldCreateTime:
+			values[i] = new(sql.NullTime)
+		case stationticket.FieldID, stationticket.FieldOrderID, stationticket.FieldStationID, stationticket.FieldRestaurantID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the StationTicket fields.
+func (_m *StationTicket) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case stationticket.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case stationticket.FieldUpdateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field update_time", values[i])
+			} else if value.Valid {
+				_m.UpdateTime = value.Time
+			}
+		case stationticket.FieldCreateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field create_time", values[i])
+			} else if value.Valid {
+				_m.CreateTime = value.Time
+			}
+		case stationticket.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				_m.Status = stationticket.Status(value.String)
+			}
+		case stationticket.FieldOrderID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field order_id", values[i])
+			} else if value != nil {
+				_m.OrderID = *value
+			}
+		case stationticket.FieldStationID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field station_id", values[i])
+			} else if value != nil {
+				_m.StationID = *value
+			}
+		case stationticket.FieldRestaurantID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field restaurant_id", values[i])
+			} else if value != nil {
+				_m.RestaurantID = *value
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the StationTicket.
+// This includes values selected through modifiers, order, etc.
+func (_m *StationTicket) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryOrder queries the "order" edge of the StationTicket entity.
+func (_m *StationTicket) QueryOrder() *OrderQuery {
+	return NewStationTicketClient(_m.config).QueryOrder(_m)
+}
+
+// QueryStation queries the "station" edge of the StationTicket entity.
+func (_m *StationTicket) QueryStation() *StationQuery {
+	return NewStationTicketClient(_m.config).QueryStation(_m)
+}
+
+// QueryRestaurant queries the "restaurant" edge of the StationTicket entity.
+func (_m *StationTicket) QueryRestaurant() *RestaurantQuery {
+	return NewStationTicketClient(_m.config).QueryRestaurant(_m)
+}
+
+// QueryOrderItems queries the "order_items" edge of the StationTicket entity.
+func (_m *StationTicket) QueryOrderItems() *OrderItemQuery {
+	return NewStationTicketClient(_m.config).QueryOrderItems(_m)
+}
+
+// Update returns a builder for updating this StationTicket.
+// Note that you need to call StationTicket.Unwrap() before calling this method if this StationTicket
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *StationTicket) Update() *StationTicketUpdateOne {
+	return NewStationTicketClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the StationTicket entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *StationTicket) Unwrap() *StationTicket {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: StationTicket is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *StationTicket) String() string {
+	var builder strings.Builder
+	builder.WriteString("StationTicket(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("update_time=")
+	builder.WriteString(_m.UpdateTime.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("create_time=")
+	builder.WriteString(_m.CreateTime.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Status))
+	builder.WriteString(", ")
+	builder.WriteString("order_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.OrderID))
+	builder.WriteString(", ")
+	builder.WriteString("station_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.StationID))
+	builder.WriteString(", ")
+	builder.WriteString("restaurant_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.RestaurantID))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// StationTickets is a parsable slice of StationTicket.
+type StationTickets []*StationTicket
@@ -0,0 +1,454 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Jiruu246/rms/internal/ent/orderitem"
+	"github.com/Jiruu246/rms/internal/ent/predicate"
+	"github.com/Jiruu246/rms/internal/ent/stationticket"
+	"github.com/google/uuid"
+)
+
+// StationTicketUpdate is the builder for updating StationTicket entities.
+type StationTicketUpdate struct {
+	config
+	hooks    []Hook
+	mutation *StationTicketMutation
+}
+
+// Where appends a list predicates to the StationTicketUpdate builder.
+func (_u *StationTicketUpdate) Where(ps ...predicate.StationTicket) *StationTicketUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_u *StationTicketUpdate) SetUpdateTime(v time.Time) *StationTicketUpdate {
+	_u.mutation.SetUpdateTime(v)
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *StationTicketUpdate) SetStatus(v stationticket.Status) *StationTicketUpdate {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *StationTicketUpdate) SetNillableStatus(v *stationticket.Status) *StationTicketUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// AddOrderItemIDs adds the "order_items" edge to the OrderItem entity by IDs.
+func (_u *StationTicketUpdate) AddOrderItemIDs(ids ...uuid.UUID) *StationTicketUpdate {
+	_u.mutation.AddOrderItemIDs(ids...)
+	return _u
+}
+
+// AddOrderItems adds the "order_items" edges to the OrderItem entity.
+func (_u *StationTicketUpdate) AddOrderItems(v ...*OrderItem) *StationTicketUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddOrderItemIDs(ids...)
+}
+
+// Mutation returns the StationTicketMutation object of the builder.
+func (_u *StationTicketUpdate) Mutation() *StationTicketMutation {
+	return _u.mutation
+}
+
+// ClearOrderItems clears all "order_items" edges to the OrderItem entity.
+func (_u *StationTicketUpdate) ClearOrderItems() *StationTicketUpdate {
+	_u.mutation.ClearOrderItems()
+	return _u
+}
+
+// RemoveOrderItemIDs removes the "order_items" edge to OrderItem entities by IDs.
+func (_u *StationTicketUpdate) RemoveOrderItemIDs(ids ...uuid.UUID) *StationTicketUpdate {
+	_u.mutation.RemoveOrderItemIDs(ids...)
+	return _u
+}
+
+// RemoveOrderItems removes "order_items" edges to OrderItem entities.
+func (_u *StationTicketUpdate) RemoveOrderItems(v ...*OrderItem) *StationTicketUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveOrderItemIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *StationTicketUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *StationTicketUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *StationTicketUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *StationTicketUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *StationTicketUpdate) defaults() {
+	if _, ok := _u.mutation.UpdateTime(); !ok {
+		v := stationticket.UpdateDefaultUpdateTime()
+		_u.mutation.SetUpdateTime(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *StationTicketUpdate) check() error {
+	if v, ok := _u.mutation.Status(); ok {
+		if err := stationticket.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "StationTicket.status": %w`, err)}
+		}
+	}
+	if _u.mutation.OrderCleared() && len(_u.mutation.OrderIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "StationTicket.order"`)
+	}
+	if _u.mutation.StationCleared() && len(_u.mutation.StationIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "StationTicket.station"`)
+	}
+	if _u.mutation.RestaurantCleared() && len(_u.mutation.RestaurantIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "StationTicket.restaurant"`)
+	}
+	return nil
+}
+
+func (_u *StationTicketUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(stationticket.Table, stationticket.Columns, sqlgraph.NewFieldSpec(stationticket.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdateTime(); ok {
+		_spec.SetField(stationticket.FieldUpdateTime, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(stationticket.FieldStatus, field.TypeEnum, value)
+	}
+	if _u.mutation.OrderItemsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   stationticket.OrderItemsTable,
+			Columns: []string{stationticket.OrderItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(orderitem.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedOrderItemsIDs(); len(nodes) > 0 && !_u.mutation.OrderItemsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   stationticket.OrderItemsTable,
+			Columns: []string{stationticket.OrderItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(orderitem.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.OrderItemsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   stationticket.OrderItemsTable,
+			Columns: []string{stationticket.OrderItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(orderitem.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{stationticket.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// StationTicketUpdateOne is the builder for updating a single StationTicket entity.
+type StationTicketUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *StationTicketMutation
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_u *StationTicketUpdateOne) SetUpdateTime(v time.Time) *StationTicketUpdateOne {
+	_u.mutation.SetUpdateTime(v)
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *StationTicketUpdateOne) SetStatus(v stationticket.Status) *StationTicketUpdateOne {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *StationTicketUpdateOne) SetNillableStatus(v *stationticket.Status) *StationTicketUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// AddOrderItemIDs adds the "order_items" edge to the OrderItem entity by IDs.
+func (_u *StationTicketUpdateOne) AddOrderItemIDs(ids ...uuid.UUID) *StationTicketUpdateOne {
+	_u.mutation.AddOrderItemIDs(ids...)
+	return _u
+}
+
+// AddOrderItems adds the "order_items" edges to the OrderItem entity.
+func (_u *StationTicketUpdateOne) AddOrderItems(v ...*OrderItem) *StationTicketUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddOrderItemIDs(ids...)
+}
+
+// Mutation returns the StationTicketMutation object of the builder.
+func (_u *StationTicketUpdateOne) Mutation() *StationTicketMutation {
+	return _u.mutation
+}
+
+// ClearOrderItems clears all "order_items" edges to the OrderItem entity.
+func (_u *StationTicketUpdateOne) ClearOrderItems() *StationTicketUpdateOne {
+	_u.mutation.ClearOrderItems()
+	return _u
+}
+
+// RemoveOrderItemIDs removes the "order_items" edge to OrderItem entities by IDs.
+func (_u *StationTicketUpdateOne) RemoveOrderItemIDs(ids ...uuid.UUID) *StationTicketUpdateOne {
+	_u.mutation.RemoveOrderItemIDs(ids...)
+	return _u
+}
+
+// RemoveOrderItems removes "order_items" edges to OrderItem entities.
+func (_u *StationTicketUpdateOne) RemoveOrderItems(v ...*OrderItem) *StationTicketUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveOrderItemIDs(ids...)
+}
+
+// Where appends a list predicates to the StationTicketUpdate builder.
+func (_u *StationTicketUpdateOne) Where(ps ...predicate.StationTicket) *StationTicketUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *StationTicketUpdateOne) Select(field string, fields ...string) *StationTicketUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated StationTicket entity.
+func (_u *StationTicketUpdateOne) Save(ctx context.Context) (*StationTicket, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *StationTicketUpdateOne) SaveX(ctx context.Context) *StationTicket {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *StationTicketUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *StationTicketUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *StationTicketUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdateTime(); !ok {
+		v := stationticket.UpdateDefaultUpdateTime()
+		_u.mutation.SetUpdateTime(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *StationTicketUpdateOne) check() error {
+	if v, ok := _u.mutation.Status(); ok {
+		if err := stationticket.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "StationTicket.status": %w`, err)}
+		}
+	}
+	if _u.mutation.OrderCleared() && len(_u.mutation.OrderIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "StationTicket.order"`)
+	}
+	if _u.mutation.StationCleared() && len(_u.mutation.StationIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "StationTicket.station"`)
+	}
+	if _u.mutation.RestaurantCleared() && len(_u.mutation.RestaurantIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "StationTicket.restaurant"`)
+	}
+	return nil
+}
+
+func (_u *StationTicketUpdateOne) sqlSave(ctx context.Context) (_node *StationTicket, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(stationticket.Table, stationticket.Columns, sqlgraph.NewFieldSpec(stationticket.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "StationTicket.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, stationticket.FieldID)
+		for _, f := range fields {
+			if !stationticket.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != stationticket.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdateTime(); ok {
+		_spec.SetField(stationticket.FieldUpdateTime, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(stationticket.FieldStatus, field.TypeEnum, value)
+	}
+	if _u.mutation.OrderItemsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   stationticket.OrderItemsTable,
+			Columns: []string{stationticket.OrderItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(orderitem.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedOrderItemsIDs(); len(nodes) > 0 && !_u.mutation.OrderItemsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   stationticket.OrderItemsTable,
+			Columns: []string{stationticket.OrderItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(orderitem.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.OrderItemsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   stationticket.OrderItemsTable,
+			Columns: []string{stationticket.OrderItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(orderitem.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &StationTicket{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{stationticket.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,242 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/Jiruu246/rms/internal/ent/ingredient"
+	"github.com/Jiruu246/rms/internal/ent/stockmovement"
+	"github.com/Jiruu246/rms/internal/ent/user"
+	"github.com/google/uuid"
+)
+
+// StockMovement is the model entity for the StockMovement schema.
+type StockMovement struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// CreateTime holds the value of the "create_time" field.
+	CreateTime time.Time `json:"create_time,omitempty"`
+	// What moved the stock
+	Kind stockmovement.Kind `json:"kind,omitempty"`
+	// Change to on_hand, negative when stock was used up
+	Quantity int64 `json:"quantity,omitempty"`
+	// on_hand after the movement
+	OnHandAfter int64 `json:"on_hand_after,omitempty"`
+	// Free-text note supplied with an adjustment
+	Note string `json:"note,omitempty"`
+	// ID of the order a SALE was for; not a foreign key, so the ledger outlives deleted orders
+	OrderID *uuid.UUID `json:"order_id,omitempty"`
+	// ID of the user who made the movement; nil for unauthenticated (public) orders
+	CreatedByID *uuid.UUID `json:"created_by_id,omitempty"`
+	// ID of the ingredient whose stock moved
+	IngredientID uuid.UUID `json:"ingredient_id,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the StockMovementQuery when eager-loading is set.
+	Edges        StockMovementEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// StockMovementEdges holds the relations/edges for other nodes in the graph.
+type StockMovementEdges struct {
+	// Ingredient holds the value of the ingredient edge.
+	Ingredient *Ingredient `json:"ingredient,omitempty"`
+	// CreatedBy holds the value of the created_by edge.
+	CreatedBy *User `json:"created_by,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// IngredientOrErr returns the Ingredient value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e StockMovementEdges) IngredientOrErr() (*Ingredient, error) {
+	if e.Ingredient != nil {
+		return e.Ingredient, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: ingredient.Label}
+	}
+	return nil, &NotLoadedError{edge: "ingredient"}
+}
+
+// CreatedByOrErr returns the CreatedBy value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e StockMovementEdges) CreatedByOrErr() (*User, error) {
+	if e.CreatedBy != nil {
+		return e.CreatedBy, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "created_by"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*StockMovement) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case stockmovement.FieldOrderID, stockmovement.FieldCreatedByID:
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case stockmovement.FieldQuantity, stockmovement.FieldOnHandAfter:
+			values[i] = new(sql.NullInt64)
+		case stockmovement.FieldKind, stockmovement.FieldNote:
+			values[i] = new(sql.NullString)
+		case stockmovement.FieldCreateTime:
+			values[i] = new(sql.NullTime)
+		case stockmovement.FieldID, stockmovement.FieldIngredientID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the StockMovement fields.
+func (_m *StockMovement) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case stockmovement.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case stockmovement.FieldCreateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field create_time", values[i])
+			} else if value.Valid {
+				_m.CreateTime = value.Time
+			}
+		case stockmovement.FieldKind:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field kind", values[i])
+			} else if value.Valid {
+				_m.Kind = stockmovement.Kind(value.String)
+			}
+		case stockmovement.FieldQuantity:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field quantity", values[i])
+			} else if value.Valid {
+				_m.Quantity = value.Int64
+			}
+		case stockmovement.FieldOnHandAfter:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field on_hand_after", values[i])
+			} else if value.Valid {
+				_m.OnHandAfter = value.Int64
+			}
+		case stockmovement.FieldNote:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field note", values[i])
+			} else if value.Valid {
+				_m.Note = value.String
+			}
+		case stockmovement.FieldOrderID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field order_id", values[i])
+			} else if value.Valid {
+				_m.OrderID = new(uuid.UUID)
+				*_m.OrderID = *value.S.(*uuid.UUID)
+			}
+		case stockmovement.FieldCreatedByID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field created_by_id", values[i])
+			} else if value.Valid {
+				_m.CreatedByID = new(uuid.UUID)
+				*_m.CreatedByID = *value.S.(*uuid.UUID)
+			}
+		case stockmovement.FieldIngredientID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field ingredient_id", values[i])
+			} else if value != nil {
+				_m.IngredientID = *value
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the StockMovement.
+// This includes values selected through modifiers, order, etc.
+func (_m *StockMovement) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryIngredient queries the "ingredient" edge of the StockMovement entity.
+func (_m *StockMovement) QueryIngredient() *IngredientQuery {
+	return NewStockMovementClient(_m.config).QueryIngredient(_m)
+}
+
+// QueryCreatedBy queries the "created_by" edge of the StockMovement entity.
+func (_m *StockMovement) QueryCreatedBy() *UserQuery {
+	return NewStockMovementClient(_m.config).QueryCreatedBy(_m)
+}
+
+// Update returns a builder for updating this StockMovement.
+// Note that you need to call StockMovement.Unwrap() before calling this method if this StockMovement
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *StockMovement) Update() *StockMovementUpdateOne {
+	return NewStockMovementClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the StockMovement entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *StockMovement) Unwrap() *StockMovement {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: StockMovement is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *StockMovement) String() string {
+	var builder strings.Builder
+	builder.WriteString("StockMovement(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("create_time=")
+	builder.WriteString(_m.CreateTime.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("kind=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Kind))
+	builder.WriteString(", ")
+	builder.WriteString("quantity=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Quantity))
+	builder.WriteString(", ")
+	builder.WriteString("on_hand_after=")
+	builder.WriteString(fmt.Sprintf("%v", _m.OnHandAfter))
+	builder.WriteString(", ")
+	builder.WriteString("note=")
+	builder.WriteString(_m.Note)
+	builder.WriteString(", ")
+	if v := _m.OrderID; v != nil {
+		builder.WriteString("order_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.CreatedByID; v != nil {
+		builder.WriteString("created_by_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("ingredient_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.IngredientID))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// StockMovements is a parsable slice of StockMovement.
+type StockMovements []*StockMovement
@@ -0,0 +1,190 @@
+// Code generated by ent, DO NOT EDIT.
+
+package stockmovement
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the stockmovement type in the database.
+	Label = "stock_movement"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreateTime holds the string denoting the create_time field in the database.
+	FieldCreateTime = "create_time"
+	// FieldKind holds the string denoting the kind field in the database.
+	FieldKind = "kind"
+	// FieldQuantity holds the string denoting the quantity field in the database.
+	FieldQuantity = "quantity"
+	// FieldOnHandAfter holds the string denoting the on_hand_after field in the database.
+	FieldOnHandAfter = "on_hand_after"
+	// FieldNote holds the string denoting the note field in the database.
+	FieldNote = "note"
+	// FieldOrderID holds the string denoting the order_id field in the database.
+	FieldOrderID = "order_id"
+	// FieldCreatedByID holds the string denoting the created_by_id field in the database.
+	FieldCreatedByID = "created_by_id"
+	// FieldIngredientID holds the string denoting the ingredient_id field in the database.
+	FieldIngredientID = "ingredient_id"
+	// EdgeIngredient holds the string denoting the ingredient edge name in mutations.
+	EdgeIngredient = "ingredient"
+	// EdgeCreatedBy holds the string denoting the created_by edge name in mutations.
+	EdgeCreatedBy = "created_by"
+	// Table holds the table name of the stockmovement in the database.
+	Table = "stock_movements"
+	// IngredientTable is the table that holds the ingredient relation/edge.
+	IngredientTable = "stock_movements"
+	// IngredientInverseTable is the table name for the Ingredient entity.
+	// It exists in this package in order to avoid circular dependency with the "ingredient" package.
+	IngredientInverseTable = "ingredients"
+	// IngredientColumn is the table column denoting the ingredient relation/edge.
+	IngredientColumn = "ingredient_id"
+	// CreatedByTable is the table that holds the created_by relation/edge.
+	CreatedByTable = "stock_movements"
+	// CreatedByInverseTable is the table name for the User entity.
+	// It exists in this package in order to avoid circular dependency with the "user" package.
+	CreatedByInverseTable = "users"
+	// CreatedByColumn is the table column denoting the created_by relation/edge.
+	CreatedByColumn = "created_by_id"
+)
+
+// Columns holds all SQL columns for stockmovement fields.
+var Columns = []string{
+	FieldID,
+	FieldCreateTime,
+	FieldKind,
+	FieldQuantity,
+	FieldOnHandAfter,
+	FieldNote,
+	FieldOrderID,
+	FieldCreatedByID,
+	FieldIngredientID,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreateTime holds the default value on creation for the "create_time" field.
+	DefaultCreateTime func() time.Time
+	// DefaultNote holds the default value on creation for the "note" field.
+	DefaultNote string
+	// NoteValidator is a validator for the "note" field. It is called by the builders before save.
+	NoteValidator func(string) error
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// Kind defines the type for the "kind" enum field.
+type Kind string
+
+// Kind values.
+const (
+	KindSALE     Kind = "SALE"
+	KindDELIVERY Kind = "DELIVERY"
+	KindWASTE    Kind = "WASTE"
+	KindCOUNT    Kind = "COUNT"
+)
+
+func (k Kind) String() string {
+	return string(k)
+}
+
+// KindValidator is a validator for the "kind" field enum values. It is called by the builders before save.
+func KindValidator(k Kind) error {
+	switch k {
+	case KindSALE, KindDELIVERY, KindWASTE, KindCOUNT:
+		return nil
+	default:
+		return fmt.Errorf("stockmovement: invalid enum value for kind field: %q", k)
+	}
+}
+
+// OrderOption defines the ordering options for the StockMovement queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreateTime orders the results by the create_time field.
+func ByCreateTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreateTime, opts...).ToFunc()
+}
+
+// ByKind orders the results by the kind field.
+func ByKind(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldKind, opts...).ToFunc()
+}
+
+// ByQuantity orders the results by the quantity field.
+func ByQuantity(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldQuantity, opts...).ToFunc()
+}
+
+// ByOnHandAfter orders the results by the on_hand_after field.
+func ByOnHandAfter(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOnHandAfter, opts...).ToFunc()
+}
+
+// ByNote orders the results by the note field.
+func ByNote(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldNote, opts...).ToFunc()
+}
+
+// ByOrderID orders the results by the order_id field.
+func ByOrderID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOrderID, opts...).ToFunc()
+}
+
+// ByCreatedByID orders the results by the created_by_id field.
+func ByCreatedByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedByID, opts...).ToFunc()
+}
+
+// ByIngredientID orders the results by the ingredient_id field.
+func ByIngredientID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldIngredientID, opts...).ToFunc()
+}
+
+// ByIngredientField orders the results by ingredient field.
+func ByIngredientField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newIngredientStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByCreatedByField orders the results by created_by field.
+func ByCreatedByField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newCreatedByStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newIngredientStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(IngredientInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, IngredientTable, IngredientColumn),
+	)
+}
+func newCreatedByStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(CreatedByInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, CreatedByTable, CreatedByColumn),
+	)
+}
@@ -0,0 +1,390 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Jiruu246/rms/internal/ent/ingredient"
+	"github.com/Jiruu246/rms/internal/ent/stockmovement"
+	"github.com/Jiruu246/rms/internal/ent/user"
+	"github.com/google/uuid"
+)
+
+// StockMovementCreate is the builder for creating a StockMovement entity.
+type StockMovementCreate struct {
+	config
+	mutation *StockMovementMutation
+	hooks    []Hook
+}
+
+// SetCreateTime sets the "create_time" field.
+func (_c *StockMovementCreate) SetCreateTime(v time.Time) *StockMovementCreate {
+	_c.mutation.SetCreateTime(v)
+	return _c
+}
+
+// SetNillableCreateTime sets the "create_time" field if the given value is not nil.
+func (_c *StockMovementCreate) SetNillableCreateTime(v *time.Time) *StockMovementCreate {
+	if v != nil {
+		_c.SetCreateTime(*v)
+	}
+	return _c
+}
+
+// SetKind sets the "kind" field.
+func (_c *StockMovementCreate) SetKind(v stockmovement.Kind) *StockMovementCreate {
+	_c.mutation.SetKind(v)
+	return _c
+}
+
+// SetQuantity sets the "quantity" field.
+func (_c *StockMovementCreate) SetQuantity(v int64) *StockMovementCreate {
+	_c.mutation.SetQuantity(v)
+	return _c
+}
+
+// SetOnHandAfter sets the "on_hand_after" field.
+func (_c *StockMovementCreate) SetOnHandAfter(v int64) *StockMovementCreate {
+	_c.mutation.SetOnHandAfter(v)
+	return _c
+}
+
+// SetNote sets the "note" field.
+func (_c *StockMovementCreate) SetNote(v string) *StockMovementCreate {
+	_c.mutation.SetNote(v)
+	return _c
+}
+
+// SetNillableNote sets the "note" field if the given value is not nil.
+func (_c *StockMovementCreate) SetNillableNote(v *string) *StockMovementCreate {
+	if v != nil {
+		_c.SetNote(*v)
+	}
+	return _c
+}
+
+// SetOrderID sets the "order_id" field.
+func (_c *StockMovementCreate) SetOrderID(v uuid.UUID) *StockMovementCreate {
+	_c.mutation.SetOrderID(v)
+	return _c
+}
+
+// SetNillableOrderID sets the "order_id" field if the given value is not nil.
+func (_c *StockMovementCreate) SetNillableOrderID(v *uuid.UUID) *StockMovementCreate {
+	if v != nil {
+		_c.SetOrderID(*v)
+	}
+	return _c
+}
+
+// SetCreatedByID sets the "created_by_id" field.
+func (_c *StockMovementCreate) SetCreatedByID(v uuid.UUID) *StockMovementCreate {
+	_c.mutation.SetCreatedByID(v)
+	return _c
+}
+
+// SetNillableCreatedByID sets the "created_by_id" field if the given value is not nil.
+func (_c *StockMovementCreate) SetNillableCreatedByID(v *uuid.UUID) *StockMovementCreate {
+	if v != nil {
+		_c.SetCreatedByID(*v)
+	}
+	return _c
+}
+
+// SetIngredientID sets the "ingredient_id" field.
+func (_c *StockMovementCreate) SetIngredientID(v uuid.UUID) *StockMovementCreate {
+	_c.mutation.SetIngredientID(v)
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *StockMovementCreate) SetID(v uuid.UUID) *StockMovementCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *StockMovementCreate) SetNillableID(v *uuid.UUID) *StockMovementCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetIngredient sets the "ingredient" edge to the Ingredient entity.
+func (_c *StockMovementCreate) SetIngredient(v *Ingredient) *StockMovementCreate {
+	return _c.SetIngredientID(v.ID)
+}
+
+// SetCreatedBy sets the "created_by" edge to the User entity.
+func (_c *StockMovementCreate) SetCreatedBy(v *User) *StockMovementCreate {
+	return _c.SetCreatedByID(v.ID)
+}
+
+// Mutation returns the StockMovementMutation object of the builder.
+func (_c *StockMovementCreate) Mutation() *StockMovementMutation {
+	return _c.mutation
+}
+
+// Save creates the StockMovement in the database.
+func (_c *StockMovementCreate) Save(ctx context.Context) (*StockMovement, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *StockMovementCreate) SaveX(ctx context.Context) *StockMovement {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *StockMovementCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *StockMovementCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *StockMovementCreate) defaults() {
+	if _, ok := _c.mutation.CreateTime(); !ok {
+		v := stockmovement.DefaultCreateTime()
+		_c.mutation.SetCreateTime(v)
+	}
+	if _, ok := _c.mutation.Note(); !ok {
+		v := stockmovement.DefaultNote
+		_c.mutation.SetNote(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := stockmovement.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *StockMovementCreate) check() error {
+	if _, ok := _c.mutation.CreateTime(); !ok {
+		return &ValidationError{Name: "create_time", err: errors.New(`ent: missing required field "StockMovement.create_time"`)}
+	}
+	if _, ok := _c.mutation.Kind(); !ok {
+		return &ValidationError{Name: "kind", err: errors.New(`ent: missing required field "StockMovement.kind"`)}
+	}
+	if v, ok := _c.mutation.Kind(); ok {
+		if err := stockmovement.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`ent: validator failed for field "StockMovement.kind": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Quantity(); !ok {
+		return &ValidationError{Name: "quantity", err: errors.New(`ent: missing required field "StockMovement.quantity"`)}
+	}
+	if _, ok := _c.mutation.OnHandAfter(); !ok {
+		return &ValidationError{Name: "on_hand_after", err: errors.New(`ent: missing required field "StockMovement.on_hand_after"`)}
+	}
+	if _, ok := _c.mutation.Note(); !ok {
+		return &ValidationError{Name: "note", err: errors.New(`ent: missing required field "StockMovement.note"`)}
+	}
+	if v, ok := _c.mutation.Note(); ok {
+		if err := stockmovement.NoteValidator(v); err != nil {
+			return &ValidationError{Name: "note", err: fmt.Errorf(`ent: validator failed for field "StockMovement.note": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.IngredientID(); !ok {
+		return &ValidationError{Name: "ingredient_id", err: errors.New(`ent: missing required field "StockMovement.ingredient_id"`)}
+	}
+	if len(_c.mutation.IngredientIDs()) == 0 {
+		return &ValidationError{Name: "ingredient", err: errors.New(`ent: missing required edge "StockMovement.ingredient"`)}
+	}
+	return nil
+}
+
+func (_c *StockMovementCreate) sqlSave(ctx context.Context) (*StockMovement, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *StockMovementCreate) createSpec() (*StockMovement, *sqlgraph.CreateSpec) {
+	var (
+		_node = &StockMovement{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(stockmovement.Table, sqlgraph.NewFieldSpec(stockmovement.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.CreateTime(); ok {
+		_spec.SetField(stockmovement.FieldCreateTime, field.TypeTime, value)
+		_node.CreateTime = value
+	}
+	if value, ok := _c.mutation.Kind(); ok {
+		_spec.SetField(stockmovement.FieldKind, field.TypeEnum, value)
+		_node.Kind = value
+	}
+	if value, ok := _c.mutation.Quantity(); ok {
+		_spec.SetField(stockmovement.FieldQuantity, field.TypeInt64, value)
+		_node.Quantity = value
+	}
+	if value, ok := _c.mutation.OnHandAfter(); ok {
+		_spec.SetField(stockmovement.FieldOnHandAfter, field.TypeInt64, value)
+		_node.OnHandAfter = value
+	}
+	if value, ok := _c.mutation.Note(); ok {
+		_spec.SetField(stockmovement.FieldNote, field.TypeString, value)
+		_node.Note = value
+	}
+	if value, ok := _c.mutation.OrderID(); ok {
+		_spec.SetField(stockmovement.FieldOrderID, field.TypeUUID, value)
+		_node.OrderID = &value
+	}
+	if nodes := _c.mutation.IngredientIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   stockmovement.IngredientTable,
+			Columns: []string{stockmovement.IngredientColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ingredient.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.IngredientID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.CreatedByIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   stockmovement.CreatedByTable,
+			Columns: []string{stockmovement.CreatedByColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.CreatedByID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// StockMovementCreateBulk is the builder for creating many StockMovement entities in bulk.
+type StockMovementCreateBulk struct {
+	config
+	err      error
+	builders []*StockMovementCreate
+}
+
+// Save creates the StockMovement entities in the database.
+func (_c *StockMovementCreateBulk) Save(ctx context.Context) ([]*StockMovement, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*StockMovement, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*StockMovementMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *StockMovementCreateBulk) SaveX(ctx context.Context) []*StockMovement {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *StockMovementCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *StockMovementCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,249 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/Jiruu246/rms/internal/ent/restaurant"
+	"github.com/Jiruu246/rms/internal/ent/table"
+	"github.com/google/uuid"
+)
+
+// Table is the model entity for the Table schema.
+type Table struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// UpdateTime holds the value of the "update_time" field.
+	UpdateTime time.Time `json:"update_time,omitempty"`
+	// CreateTime holds the value of the "create_time" field.
+	CreateTime time.Time `json:"create_time,omitempty"`
+	// Table name or number as shown to staff and customers
+	Name string `json:"name,omitempty"`
+	// Area of the restaurant the table is in (patio, bar...)
+	Section string `json:"section,omitempty"`
+	// Number of seats
+	Seats int `json:"seats,omitempty"`
+	// Unguessable token encoded in the table's QR code; rotating it invalidates printed codes
+	QrToken string `json:"qr_token,omitempty"`
+	// Whether the table takes orders
+	IsActive bool `json:"is_active,omitempty"`
+	// ID of the restaurant this table belongs to
+	RestaurantID uuid.UUID `json:"restaurant_id,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the TableQuery when eager-loading is set.
+	Edges        TableEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// TableEdges holds the relations/edges for other nodes in the graph.
+type TableEdges struct {
+	// Restaurant holds the value of the restaurant edge.
+	Restaurant *Restaurant `json:"restaurant,omitempty"`
+	// Sessions holds the value of the sessions edge.
+	Sessions []*TableSession `json:"sessions,omitempty"`
+	// Orders holds the value of the orders edge.
+	Orders []*Order `json:"orders,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [3]bool
+}
+
+// RestaurantOrErr returns the Restaurant value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e TableEdges) RestaurantOrErr() (*Restaurant, error) {
+	if e.Restaurant != nil {
+		return e.Restaurant, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: restaurant.Label}
+	}
+	return nil, &NotLoadedError{edge: "restaurant"}
+}
+
+// SessionsOrErr returns the Sessions value or an error if the edge
+// was not loaded in eager-loading.
+func (e TableEdges) SessionsOrErr() ([]*TableSession, error) {
+	if e.loadedTypes[1] {
+		return e.Sessions, nil
+	}
+	return nil, &NotLoadedError{edge: "sessions"}
+}
+
+// OrdersOrErr returns the Orders value or an error if the edge
+// was not loaded in eager-loading.
+func (e TableEdges) OrdersOrErr() ([]*Order, error) {
+	if e.loadedTypes[2] {
+		return e.Orders, nil
+	}
+	return nil, &NotLoadedError{edge: "orders"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Table) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case table.FieldIsActive:
+			values[i] = new(sql.NullBool)
+		case table.FieldSeats:
+			values[i] = new(sql.NullInt64)
+		case table.FieldName, table.FieldSection, table.FieldQrToken:
+			values[i] = new(sql.NullString)
+		case table.FieldUpdateTime, table.FieldCreateTime:
+			values[i] = new(sql.NullTime)
+		case table.FieldID, table.FieldRestaurantID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Table fields.
+func (_m *Table) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case table.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case table.FieldUpdateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field update_time", values[i])
+			} else if value.Valid {
+				_m.UpdateTime = value.Time
+			}
+		case table.FieldCreateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field create_time", values[i])
+			} else if value.Valid {
+				_m.CreateTime = value.Time
+			}
+		case table.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				_m.Name = value.String
+			}
+		case table.FieldSection:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field section", values[i])
+			} else if value.Valid {
+				_m.Section = value.String
+			}
+		case table.FieldSeats:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field seats", values[i])
+			} else if value.Valid {
+				_m.Seats = int(value.Int64)
+			}
+		case table.FieldQrToken:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field qr_token", values[i])
+			} else if value.Valid {
+				_m.QrToken = value.String
+			}
+		case table.FieldIsActive:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field is_active", values[i])
+			} else if value.Valid {
+				_m.IsActive = value.Bool
+			}
+		case table.FieldRestaurantID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field restaurant_id", values[i])
+			} else if value != nil {
+				_m.RestaurantID = *value
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Table.
+// This includes values selected through modifiers, order, etc.
+func (_m *Table) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryRestaurant queries the "restaurant" edge of the Table entity.
+func (_m *Table) QueryRestaurant() *RestaurantQuery {
+	return NewTableClient(_m.config).QueryRestaurant(_m)
+}
+
+// QuerySessions queries the "sessions" edge of the Table entity.
+func (_m *Table) QuerySessions() *TableSessionQuery {
+	return NewTableClient(_m.config).QuerySessions(_m)
+}
+
+// QueryOrders queries the "orders" edge of the Table entity.
+func (_m *Table) QueryOrders() *OrderQuery {
+	return NewTableClient(_m.config).QueryOrders(_m)
+}
+
+// Update returns a builder for updating this Table.
+// Note that you need to call Table.Unwrap() before calling this method if this Table
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *Table) Update() *TableUpdateOne {
+	return NewTableClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the Table entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *Table) Unwrap() *Table {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Table is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *Table) String() string {
+	var builder strings.Builder
+	builder.WriteString("Table(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("update_time=")
+	builder.WriteString(_m.UpdateTime.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("create_time=")
+	builder.WriteString(_m.CreateTime.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(_m.Name)
+	builder.WriteString(", ")
+	builder.WriteString("section=")
+	builder.WriteString(_m.Section)
+	builder.WriteString(", ")
+	builder.WriteString("seats=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Seats))
+	builder.WriteString(", ")
+	builder.WriteString("qr_token=")
+	builder.WriteString(_m.QrToken)
+	builder.WriteString(", ")
+	builder.WriteString("is_active=")
+	builder.WriteString(fmt.Sprintf("%v", _m.IsActive))
+	builder.WriteString(", ")
+	builder.WriteString("restaurant_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.RestaurantID))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Tables is a parsable slice of Table.
+type Tables []*Table
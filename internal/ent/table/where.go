@@ -0,0 +1,526 @@
+// Code generated by ent, DO NOT EDIT.
+
+package table
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/Jiruu246/rms/internal/ent/predicate"
+	"github.com/google/uuid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.Table {
+	return predicate.Table(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.Table {
+	return predicate.Table(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.Table {
+	return predicate.Table(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.Table {
+	return predicate.Table(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.Table {
+	return predicate.Table(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.Table {
+	return predicate.Table(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.Table {
+	return predicate.Table(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.Table {
+	return predicate.Table(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.Table {
+	return predicate.Table(sql.FieldLTE(FieldID, id))
+}
+
+// UpdateTime applies equality check predicate on the "update_time" field. It's identical to UpdateTimeEQ.
+func UpdateTime(v time.Time) predicate.Table {
+	return predicate.Table(sql.FieldEQ(FieldUpdateTime, v))
+}
+
+// CreateTime applies equality check predicate on the "create_time" field. It's identical to CreateTimeEQ.
+func CreateTime(v time.Time) predicate.Table {
+	return predicate.Table(sql.FieldEQ(FieldCreateTime, v))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.Table {
+	return predicate.Table(sql.FieldEQ(FieldName, v))
+}
+
+// Section applies equality check predicate on the "section" field. It's identical to SectionEQ.
+func Section(v string) predicate.Table {
+	return predicate.Table(sql.FieldEQ(FieldSection, v))
+}
+
+// Seats applies equality check predicate on the "seats" field. It's identical to SeatsEQ.
+func Seats(v int) predicate.Table {
+	return predicate.Table(sql.FieldEQ(FieldSeats, v))
+}
+
+// QrToken applies equality check predicate on the "qr_token" field. It's identical to QrTokenEQ.
+func QrToken(v string) predicate.Table {
+	return predicate.Table(sql.FieldEQ(FieldQrToken, v))
+}
+
+// IsActive applies equality check predicate on the "is_active" field. It's identical to IsActiveEQ.
+func IsActive(v bool) predicate.Table {
+	return predicate.Table(sql.FieldEQ(FieldIsActive, v))
+}
+
+// RestaurantID applies equality check predicate on the "restaurant_id" field. It's identical to RestaurantIDEQ.
+func RestaurantID(v uuid.UUID) predicate.Table {
+	return predicate.Table(sql.FieldEQ(FieldRestaurantID, v))
+}
+
+// UpdateTimeEQ applies the EQ predicate on the "update_time" field.
+func UpdateTimeEQ(v time.Time) predicate.Table {
+	return predicate.Table(sql.FieldEQ(FieldUpdateTime, v))
+}
+
+// UpdateTimeNEQ applies the NEQ predicate on the "update_time" field.
+func UpdateTimeNEQ(v time.Time) predicate.Table {
+	return predicate.Table(sql.FieldNEQ(FieldUpdateTime, v))
+}
+
+// UpdateTimeIn applies the In predicate on the "update_time" field.
+func UpdateTimeIn(vs ...time.Time) predicate.Table {
+	return predicate.Table(sql.FieldIn(FieldUpdateTime, vs...))
+}
+
+// UpdateTimeNotIn applies the NotIn predicate on the "update_time" field.
+func UpdateTimeNotIn(vs ...time.Time) predicate.Table {
+	return predicate.Table(sql.FieldNotIn(FieldUpdateTime, vs...))
+}
+
+// UpdateTimeGT applies the GT predicate on the "update_time" field.
+func UpdateTimeGT(v time.Time) predicate.Table {
+	return predicate.Table(sql.FieldGT(FieldUpdateTime, v))
+}
+
+// UpdateTimeGTE applies the GTE predicate on the "update_time" field.
+func UpdateTimeGTE(v time.Time) predicate.Table {
+	return predicate.Table(sql.FieldGTE(FieldUpdateTime, v))
+}
+
+// UpdateTimeLT applies the LT predicate on the "update_time" field.
+func UpdateTimeLT(v time.Time) predicate.Table {
+	return predicate.Table(sql.FieldLT(FieldUpdateTime, v))
+}
+
+// UpdateTimeLTE applies the LTE predicate on the "update_time" field.
+func UpdateTimeLTE(v time.Time) predicate.Table {
+	return predicate.Table(sql.FieldLTE(FieldUpdateTime, v))
+}
+
+// CreateTimeEQ applies the EQ predicate on the "create_time" field.
+func CreateTimeEQ(v time.Time) predicate.Table {
+	return predicate.Table(sql.FieldEQ(FieldCreateTime, v))
+}
+
+// CreateTimeNEQ applies the NEQ predicate on the "create_time" field.
+func CreateTimeNEQ(v time.Time) predicate.Table {
+	return predicate.Table(sql.FieldNEQ(FieldCreateTime, v))
+}
+
+// CreateTimeIn applies the In predicate on the "create_time" field.
+func CreateTimeIn(vs ...time.Time) predicate.Table {
+	return predicate.Table(sql.FieldIn(FieldCreateTime, vs...))
+}
+
+// CreateTimeNotIn applies the NotIn predicate on the "create_time" field.
+func CreateTimeNotIn(vs ...time.Time) predicate.Table {
+	return predicate.Table(sql.FieldNotIn(FieldCreateTime, vs...))
+}
+
+// CreateTimeGT applies the GT predicate on the "create_time" field.
+func CreateTimeGT(v time.Time) predicate.Table {
+	return predicate.Table(sql.FieldGT(FieldCreateTime, v))
+}
+
+// CreateTimeGTE applies the GTE predicate on the "create_time" field.
+func CreateTimeGTE(v time.Time) predicate.Table {
+	return predicate.Table(sql.FieldGTE(FieldCreateTime, v))
+}
+
+// CreateTimeLT applies the LT predicate on the "create_time" field.
+func CreateTimeLT(v time.Time) predicate.Table {
+	return predicate.Table(sql.FieldLT(FieldCreateTime, v))
+}
+
+// CreateTimeLTE applies the LTE predicate on the "create_time" field.
+func CreateTimeLTE(v time.Time) predicate.Table {
+	return predicate.Table(sql.FieldLTE(FieldCreateTime, v))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.Table {
+	return predicate.Table(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.Table {
+	return predicate.Table(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.Table {
+	return predicate.Table(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.Table {
+	return predicate.Table(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.Table {
+	return predicate.Table(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.Table {
+	return predicate.Table(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.Table {
+	return predicate.Table(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.Table {
+	return predicate.Table(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.Table {
+	return predicate.Table(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.Table {
+	return predicate.Table(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.Table {
+	return predicate.Table(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.Table {
+	return predicate.Table(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.Table {
+	return predicate.Table(sql.FieldContainsFold(FieldName, v))
+}
+
+// SectionEQ applies the EQ predicate on the "section" field.
+func SectionEQ(v string) predicate.Table {
+	return predicate.Table(sql.FieldEQ(FieldSection, v))
+}
+
+// SectionNEQ applies the NEQ predicate on the "section" field.
+func SectionNEQ(v string) predicate.Table {
+	return predicate.Table(sql.FieldNEQ(FieldSection, v))
+}
+
+// SectionIn applies the In predicate on the "section" field.
+func SectionIn(vs ...string) predicate.Table {
+	return predicate.Table(sql.FieldIn(FieldSection, vs...))
+}
+
+// SectionNotIn applies the NotIn predicate on the "section" field.
+func SectionNotIn(vs ...string) predicate.Table {
+	return predicate.Table(sql.FieldNotIn(FieldSection, vs...))
+}
+
+// SectionGT applies the GT predicate on the "section" field.
+func SectionGT(v string) predicate.Table {
+	return predicate.Table(sql.FieldGT(FieldSection, v))
+}
+
+// SectionGTE applies the GTE predicate on the "section" field.
+func SectionGTE(v string) predicate.Table {
+	return predicate.Table(sql.FieldGTE(FieldSection, v))
+}
+
+// SectionLT applies the LT predicate on the "section" field.
+func SectionLT(v string) predicate.Table {
+	return predicate.Table(sql.FieldLT(FieldSection, v))
+}
+
+// SectionLTE applies the LTE predicate on the "section" field.
+func SectionLTE(v string) predicate.Table {
+	return predicate.Table(sql.FieldLTE(FieldSection, v))
+}
+
+// SectionContains applies the Contains predicate on the "section" field.
+func SectionContains(v string) predicate.Table {
+	return predicate.Table(sql.FieldContains(FieldSection, v))
+}
+
+// SectionHasPrefix applies the HasPrefix predicate on the "section" field.
+func SectionHasPrefix(v string) predicate.Table {
+	return predicate.Table(sql.FieldHasPrefix(FieldSection, v))
+}
+
+// SectionHasSuffix applies the HasSuffix predicate on the "section" field.
+func SectionHasSuffix(v string) predicate.Table {
+	return predicate.Table(sql.FieldHasSuffix(FieldSection, v))
+}
+
+// SectionEqualFold applies the EqualFold predicate on the "section" field.
+func SectionEqualFold(v string) predicate.Table {
+	return predicate.Table(sql.FieldEqualFold(FieldSection, v))
+}
+
+// SectionContainsFold applies the ContainsFold predicate on the "section" field.
+func SectionContainsFold(v string) predicate.Table {
+	return predicate.Table(sql.FieldContainsFold(FieldSection, v))
+}
+
+// SeatsEQ applies the EQ predicate on the "seats" field.
+func SeatsEQ(v int) predicate.Table {
+	return predicate.Table(sql.FieldEQ(FieldSeats, v))
+}
+
+// SeatsNEQ applies the NEQ predicate on the "seats" field.
+func SeatsNEQ(v int) predicate.Table {
+	return predicate.Table(sql.FieldNEQ(FieldSeats, v))
+}
+
+// SeatsIn applies the In predicate on the "seats" field.
+func SeatsIn(vs ...int) predicate.Table {
+	return predicate.Table(sql.FieldIn(FieldSeats, vs...))
+}
+
+// SeatsNotIn applies the NotIn predicate on the "seats" field.
+func SeatsNotIn(vs ...int) predicate.Table {
+	return predicate.Table(sql.FieldNotIn(FieldSeats, vs...))
+}
+
+// SeatsGT applies the GT predicate on the "seats" field.
+func SeatsGT(v int) predicate.Table {
+	return predicate.Table(sql.FieldGT(FieldSeats, v))
+}
+
+// SeatsGTE applies the GTE predicate on the "seats" field.
+func SeatsGTE(v int) predicate.Table {
+	return predicate.Table(sql.FieldGTE(FieldSeats, v))
+}
+
+// SeatsLT applies the LT predicate on the "seats" field.
+func SeatsLT(v int) predicate.Table {
+	return predicate.Table(sql.FieldLT(FieldSeats, v))
+}
+
+// SeatsLTE applies the LTE predicate on the "seats" field.
+func SeatsLTE(v int) predicate.Table {
+	return predicate.Table(sql.FieldLTE(FieldSeats, v))
+}
+
+// QrTokenEQ applies the EQ predicate on the "qr_token" field.
+func QrTokenEQ(v string) predicate.Table {
+	return predicate.Table(sql.FieldEQ(FieldQrToken, v))
+}
+
+// QrTokenNEQ applies the NEQ predicate on the "qr_token" field.
+func QrTokenNEQ(v string) predicate.Table {
+	return predicate.Table(sql.FieldNEQ(FieldQrToken, v))
+}
+
+// QrTokenIn applies the In predicate on the "qr_token" field.
+func QrTokenIn(vs ...string) predicate.Table {
+	return predicate.Table(sql.FieldIn(FieldQrToken, vs...))
+}
+
+// QrTokenNotIn applies the NotIn predicate on the "qr_token" field.
+func QrTokenNotIn(vs ...string) predicate.Table {
+	return predicate.Table(sql.FieldNotIn(FieldQrToken, vs...))
+}
+
+// QrTokenGT applies the GT predicate on the "qr_token" field.
+func QrTokenGT(v string) predicate.Table {
+	return predicate.Table(sql.FieldGT(FieldQrToken, v))
+}
+
+// QrTokenGTE applies the GTE predicate on the "qr_token" field.
+func QrTokenGTE(v string) predicate.Table {
+	return predicate.Table(sql.FieldGTE(FieldQrToken, v))
+}
+
+// QrTokenLT applies the LT predicate on the "qr_token" field.
+func QrTokenLT(v string) predicate.Table {
+	return predicate.Table(sql.FieldLT(FieldQrToken, v))
+}
+
+// QrTokenLTE applies the LTE predicate on the "qr_token" field.
+func QrTokenLTE(v string) predicate.Table {
+	return predicate.Table(sql.FieldLTE(FieldQrToken, v))
+}
+
+// QrTokenContains applies the Contains predicate on the "qr_token" field.
+func QrTokenContains(v string) predicate.Table {
+	return predicate.Table(sql.FieldContains(FieldQrToken, v))
+}
+
+// QrTokenHasPrefix applies the HasPrefix predicate on the "qr_token" field.
+func QrTokenHasPrefix(v string) predicate.Table {
+	return predicate.Table(sql.FieldHasPrefix(FieldQrToken, v))
+}
+
+// QrTokenHasSuffix applies the HasSuffix predicate on the "qr_token" field.
+func QrTokenHasSuffix(v string) predicate.Table {
+	return predicate.Table(sql.FieldHasSuffix(FieldQrToken, v))
+}
+
+// QrTokenEqualFold applies the EqualFold predicate on the "qr_token" field.
+func QrTokenEqualFold(v string) predicate.Table {
+	return predicate.Table(sql.FieldEqualFold(FieldQrToken, v))
+}
+
+// QrTokenContainsFold applies the ContainsFold predicate on the "qr_token" field.
+func QrTokenContainsFold(v string) predicate.Table {
+	return predicate.Table(sql.FieldContainsFold(FieldQrToken, v))
+}
+
+// IsActiveEQ applies the EQ predicate on the "is_active" field.
+func IsActiveEQ(v bool) predicate.Table {
+	return predicate.Table(sql.FieldEQ(FieldIsActive, v))
+}
+
+// IsActiveNEQ applies the NEQ predicate on the "is_active" field.
+func IsActiveNEQ(v bool) predicate.Table {
+	return predicate.Table(sql.FieldNEQ(FieldIsActive, v))
+}
+
+// RestaurantIDEQ applies the EQ predicate on the "restaurant_id" field.
+func RestaurantIDEQ(v uuid.UUID) predicate.Table {
+	return predicate.Table(sql.FieldEQ(FieldRestaurantID, v))
+}
+
+// RestaurantIDNEQ applies the NEQ predicate on the "restaurant_id" field.
+func RestaurantIDNEQ(v uuid.UUID) predicate.Table {
+	return predicate.Table(sql.FieldNEQ(FieldRestaurantID, v))
+}
+
+// RestaurantIDIn applies the In predicate on the "restaurant_id" field.
+func RestaurantIDIn(vs ...uuid.UUID) predicate.Table {
+	return predicate.Table(sql.FieldIn(FieldRestaurantID, vs...))
+}
+
+// RestaurantIDNotIn applies the NotIn predicate on the "restaurant_id" field.
+func RestaurantIDNotIn(vs ...uuid.UUID) predicate.Table {
+	return predicate.Table(sql.FieldNotIn(FieldRestaurantID, vs...))
+}
+
+// HasRestaurant applies the HasEdge predicate on the "restaurant" edge.
+func HasRestaurant() predicate.Table {
+	return predicate.Table(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, RestaurantTable, RestaurantColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasRestaurantWith applies the HasEdge predicate on the "restaurant" edge with a given conditions (other predicates).
+func HasRestaurantWith(preds ...predicate.Restaurant) predicate.Table {
+	return predicate.Table(func(s *sql.Selector) {
+		step := newRestaurantStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasSessions applies the HasEdge predicate on the "sessions" edge.
+func HasSessions() predicate.Table {
+	return predicate.Table(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, SessionsTable, SessionsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasSessionsWith applies the HasEdge predicate on the "sessions" edge with a given conditions (other predicates).
+func HasSessionsWith(preds ...predicate.TableSession) predicate.Table {
+	return predicate.Table(func(s *sql.Selector) {
+		step := newSessionsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasOrders applies the HasEdge predicate on the "orders" edge.
+func HasOrders() predicate.Table {
+	return predicate.Table(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, OrdersTable, OrdersColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasOrdersWith applies the HasEdge predicate on the "orders" edge with a given conditions (other predicates).
+func HasOrdersWith(preds ...predicate.Order) predicate.Table {
+	return predicate.Table(func(s *sql.Selector) {
+		step := newOrdersStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Table) predicate.Table {
+	return predicate.Table(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Table) predicate.Table {
+	return predicate.Table(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Table) predicate.Table {
+	return predicate.Table(sql.NotPredicates(p))
+}
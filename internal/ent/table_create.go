@@ -0,0 +1,471 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Jiruu246/rms/internal/ent/order"
+	"github.com/Jiruu246/rms/internal/ent/restaurant"
+	"github.com/Jiruu246/rms/internal/ent/table"
+	"github.com/Jiruu246/rms/internal/ent/tablesession"
+	"github.com/google/uuid"
+)
+
+// TableCreate is the builder for creating a Table entity.
+type TableCreate struct {
+	config
+	mutation *TableMutation
+	hooks    []Hook
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_c *TableCreate) SetUpdateTime(v time.Time) *TableCreate {
+	_c.mutation.SetUpdateTime(v)
+	return _c
+}
+
+// SetNillableUpdateTime sets the "update_time" field if the given value is not nil.
+func (_c *TableCreate) SetNillableUpdateTime(v *time.Time) *TableCreate {
+	if v != nil {
+		_c.SetUpdateTime(*v)
+	}
+	return _c
+}
+
+// SetCreateTime sets the "create_time" field.
+func (_c *TableCreate) SetCreateTime(v time.Time) *TableCreate {
+	_c.mutation.SetCreateTime(v)
+	return _c
+}
+
+// SetNillableCreateTime sets the "create_time" field if the given value is not nil.
+func (_c *TableCreate) SetNillableCreateTime(v *time.Time) *TableCreate {
+	if v != nil {
+		_c.SetCreateTime(*v)
+	}
+	return _c
+}
+
+// SetName sets the "name" field.
+func (_c *TableCreate) SetName(v string) *TableCreate {
+	_c.mutation.SetName(v)
+	return _c
+}
+
+// SetSection sets the "section" field.
+func (_c *TableCreate) SetSection(v string) *TableCreate {
+	_c.mutation.SetSection(v)
+	return _c
+}
+
+// SetNillableSection sets the "section" field if the given value is not nil.
+func (_c *TableCreate) SetNillableSection(v *string) *TableCreate {
+	if v != nil {
+		_c.SetSection(*v)
+	}
+	return _c
+}
+
+// SetSeats sets the "seats" field.
+func (_c *TableCreate) SetSeats(v int) *TableCreate {
+	_c.mutation.SetSeats(v)
+	return _c
+}
+
+// SetNillableSeats sets the "seats" field if the given value is not nil.
+func (_c *TableCreate) SetNillableSeats(v *int) *TableCreate {
+	if v != nil {
+		_c.SetSeats(*v)
+	}
+	return _c
+}
+
+// SetQrToken sets the "qr_token" field.
+func (_c *TableCreate) SetQrToken(v string) *TableCreate {
+	_c.mutation.SetQrToken(v)
+	return _c
+}
+
+// SetIsActive sets the "is_active" field.
+func (_c *TableCreate) SetIsActive(v bool) *TableCreate {
+	_c.mutation.SetIsActive(v)
+	return _c
+}
+
+// SetNillableIsActive sets the "is_active" field if the given value is not nil.
+func (_c *TableCreate) SetNillableIsActive(v *bool) *TableCreate {
+	if v != nil {
+		_c.SetIsActive(*v)
+	}
+	return _c
+}
+
+// SetRestaurantID sets the "restaurant_id" field.
+func (_c *TableCreate) SetRestaurantID(v uuid.UUID) *TableCreate {
+	_c.mutation.SetRestaurantID(v)
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *TableCreate) SetID(v uuid.UUID) *TableCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *TableCreate) SetNillableID(v *uuid.UUID) *TableCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetRestaurant sets the "restaurant" edge to the Restaurant entity.
+func (_c *TableCreate) SetRestaurant(v *Restaurant) *TableCreate {
+	return _c.SetRestaurantID(v.ID)
+}
+
+// AddSessionIDs adds the "sessions" edge to the TableSession entity by IDs.
+func (_c *TableCreate) AddSessionIDs(ids ...uuid.UUID) *TableCreate {
+	_c.mutation.AddSessionIDs(ids...)
+	return _c
+}
+
+// AddSessions adds the "sessions" edges to the TableSession entity.
+func (_c *TableCreate) AddSessions(v ...*TableSession) *TableCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddSessionIDs(ids...)
+}
+
+// AddOrderIDs adds the "orders" edge to the Order entity by IDs.
+func (_c *TableCreate) AddOrderIDs(ids ...uuid.UUID) *TableCreate {
+	_c.mutation.AddOrderIDs(ids...)
+	return _c
+}
+
+// AddOrders adds the "orders" edges to the Order entity.
+func (_c *TableCreate) AddOrders(v ...*Order) *TableCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddOrderIDs(ids...)
+}
+
+// Mutation returns the TableMutation object of the builder.
+func (_c *TableCreate) Mutation() *TableMutation {
+	return _c.mutation
+}
+
+// Save creates the Table in the database.
+func (_c *TableCreate) Save(ctx context.Context) (*Table, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *TableCreate) SaveX(ctx context.Context) *Table {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *TableCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *TableCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *TableCreate) defaults() {
+	if _, ok := _c.mutation.UpdateTime(); !ok {
+		v := table.DefaultUpdateTime()
+		_c.mutation.SetUpdateTime(v)
+	}
+	if _, ok := _c.mutation.CreateTime(); !ok {
+		v := table.DefaultCreateTime()
+		_c.mutation.SetCreateTime(v)
+	}
+	if _, ok := _c.mutation.Section(); !ok {
+		v := table.DefaultSection
+		_c.mutation.SetSection(v)
+	}
+	if _, ok := _c.mutation.Seats(); !ok {
+		v := table.DefaultSeats
+		_c.mutation.SetSeats(v)
+	}
+	if _, ok := _c.mutation.IsActive(); !ok {
+		v := table.DefaultIsActive
+		_c.mutation.SetIsActive(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := table.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *TableCreate) check() error {
+	if _, ok := _c.mutation.UpdateTime(); !ok {
+		return &ValidationError{Name: "update_time", err: errors.New(`ent: missing required field "Table.update_time"`)}
+	}
+	if _, ok := _c.mutation.CreateTime(); !ok {
+		return &ValidationError{Name: "create_time", err: errors.New(`ent: missing required field "Table.create_time"`)}
+	}
+	if _, ok := _c.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "Table.name"`)}
+	}
+	if v, ok := _c.mutation.Name(); ok {
+		if err := table.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Table.name": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Section(); !ok {
+		return &ValidationError{Name: "section", err: errors.New(`ent: missing required field "Table.section"`)}
+	}
+	if v, ok := _c.mutation.Section(); ok {
+		if err := table.SectionValidator(v); err != nil {
+			return &ValidationError{Name: "section", err: fmt.Errorf(`ent: validator failed for field "Table.section": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Seats(); !ok {
+		return &ValidationError{Name: "seats", err: errors.New(`ent: missing required field "Table.seats"`)}
+	}
+	if v, ok := _c.mutation.Seats(); ok {
+		if err := table.SeatsValidator(v); err != nil {
+			return &ValidationError{Name: "seats", err: fmt.Errorf(`ent: validator failed for field "Table.seats": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.QrToken(); !ok {
+		return &ValidationError{Name: "qr_token", err: errors.New(`ent: missing required field "Table.qr_token"`)}
+	}
+	if v, ok := _c.mutation.QrToken(); ok {
+		if err := table.QrTokenValidator(v); err != nil {
+			return &ValidationError{Name: "qr_token", err: fmt.Errorf(`ent: validator failed for field "Table.qr_token": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.IsActive(); !ok {
+		return &ValidationError{Name: "is_active", err: errors.New(`ent: missing required field "Table.is_active"`)}
+	}
+	if _, ok := _c.mutation.RestaurantID(); !ok {
+		return &ValidationError{Name: "restaurant_id", err: errors.New(`ent: missing required field "Table.restaurant_id"`)}
+	}
+	if len(_c.mutation.RestaurantIDs()) == 0 {
+		return &ValidationError{Name: "restaurant", err: errors.New(`ent: missing required edge "Table.restaurant"`)}
+	}
+	return nil
+}
+
+func (_c *TableCreate) sqlSave(ctx context.Context) (*Table, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *TableCreate) createSpec() (*Table, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Table{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(table.Table, sqlgraph.NewFieldSpec(table.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.UpdateTime(); ok {
+		_spec.SetField(table.FieldUpdateTime, field.TypeTime, value)
+		_node.UpdateTime = value
+	}
+	if value, ok := _c.mutation.CreateTime(); ok {
+		_spec.SetField(table.FieldCreateTime, field.TypeTime, value)
+		_node.CreateTime = value
+	}
+	if value, ok := _c.mutation.Name(); ok {
+		_spec.SetField(table.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := _c.mutation.Section(); ok {
+		_spec.SetField(table.FieldSection, field.TypeString, value)
+		_node.Section = value
+	}
+	if value, ok := _c.mutation.Seats(); ok {
+		_spec.SetField(table.FieldSeats, field.TypeInt, value)
+		_node.Seats = value
+	}
+	if value, ok := _c.mutation.QrToken(); ok {
+		_spec.SetField(table.FieldQrToken, field.TypeString, value)
+		_node.QrToken = value
+	}
+	if value, ok := _c.mutation.IsActive(); ok {
+		_spec.SetField(table.FieldIsActive, field.TypeBool, value)
+		_node.IsActive = value
+	}
+	if nodes := _c.mutation.RestaurantIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   table.RestaurantTable,
+			Columns: []string{table.RestaurantColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(restaurant.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.RestaurantID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.SessionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   table.SessionsTable,
+			Columns: []string{table.SessionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tablesession.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.OrdersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   table.OrdersTable,
+			Columns: []string{table.OrdersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(order.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// TableCreateBulk is the builder for creating many Table entities in bulk.
+type TableCreateBulk struct {
+	config
+	err      error
+	builders []*TableCreate
+}
+
+// Save creates the Table entities in the database.
+func (_c *TableCreateBulk) Save(ctx context.Context) ([]*Table, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*Table, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*TableMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *TableCreateBulk) SaveX(ctx context.Context) []*Table {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *TableCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *TableCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
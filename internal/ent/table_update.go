@@ -0,0 +1,791 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Jiruu246/rms/internal/ent/order"
+	"github.com/Jiruu246/rms/internal/ent/predicate"
+	"github.com/Jiruu246/rms/internal/ent/table"
+	"github.com/Jiruu246/rms/internal/ent/tablesession"
+	"github.com/google/uuid"
+)
+
+// TableUpdate is the builder for updating Table entities.
+type TableUpdate struct {
+	config
+	hooks    []Hook
+	mutation *TableMutation
+}
+
+// Where appends a list predicates to the TableUpdate builder.
+func (_u *TableUpdate) Where(ps ...predicate.Table) *TableUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_u *TableUpdate) SetUpdateTime(v time.Time) *TableUpdate {
+	_u.mutation.SetUpdateTime(v)
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *TableUpdate) SetName(v string) *TableUpdate {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *TableUpdate) SetNillableName(v *string) *TableUpdate {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetSection sets the "section" field.
+func (_u *TableUpdate) SetSection(v string) *TableUpdate {
+	_u.mutation.SetSection(v)
+	return _u
+}
+
+// SetNillableSection sets the "section" field if the given value is not nil.
+func (_u *TableUpdate) SetNillableSection(v *string) *TableUpdate {
+	if v != nil {
+		_u.SetSection(*v)
+	}
+	return _u
+}
+
+// SetSeats sets the "seats" field.
+func (_u *TableUpdate) SetSeats(v int) *TableUpdate {
+	_u.mutation.ResetSeats()
+	_u.mutation.SetSeats(v)
+	return _u
+}
+
+// SetNillableSeats sets the "seats" field if the given value is not nil.
+func (_u *TableUpdate) SetNillableSeats(v *int) *TableUpdate {
+	if v != nil {
+		_u.SetSeats(*v)
+	}
+	return _u
+}
+
+// AddSeats adds value to the "seats" field.
+func (_u *TableUpdate) AddSeats(v int) *TableUpdate {
+	_u.mutation.AddSeats(v)
+	return _u
+}
+
+// SetQrToken sets the "qr_token" field.
+func (_u *TableUpdate) SetQrToken(v string) *TableUpdate {
+	_u.mutation.SetQrToken(v)
+	return _u
+}
+
+// SetNillableQrToken sets the "qr_token" field if the given value is not nil.
+func (_u *TableUpdate) SetNillableQrToken(v *string) *TableUpdate {
+	if v != nil {
+		_u.SetQrToken(*v)
+	}
+	return _u
+}
+
+// SetIsActive sets the "is_active" field.
+func (_u *TableUpdate) SetIsActive(v bool) *TableUpdate {
+	_u.mutation.SetIsActive(v)
+	return _u
+}
+
+// SetNillableIsActive sets the "is_active" field if the given value is not nil.
+func (_u *TableUpdate) SetNillableIsActive(v *bool) *TableUpdate {
+	if v != nil {
+		_u.SetIsActive(*v)
+	}
+	return _u
+}
+
+// AddSessionIDs adds the "sessions" edge to the TableSession entity by IDs.
+func (_u *TableUpdate) AddSessionIDs(ids ...uuid.UUID) *TableUpdate {
+	_u.mutation.AddSessionIDs(ids...)
+	return _u
+}
+
+// AddSessions adds the "sessions" edges to the TableSession entity.
+func (_u *TableUpdate) AddSessions(v ...*TableSession) *TableUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddSessionIDs(ids...)
+}
+
+// AddOrderIDs adds the "orders" edge to the Order entity by IDs.
+func (_u *TableUpdate) AddOrderIDs(ids ...uuid.UUID) *TableUpdate {
+	_u.mutation.AddOrderIDs(ids...)
+	return _u
+}
+
+// AddOrders adds the "orders" edges to the Order entity.
+func (_u *TableUpdate) AddOrders(v ...*Order) *TableUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddOrderIDs(ids...)
+}
+
+// Mutation returns the TableMutation object of the builder.
+func (_u *TableUpdate) Mutation() *TableMutation {
+	return _u.mutation
+}
+
+// ClearSessions clears all "sessions" edges to the TableSession entity.
+func (_u *TableUpdate) ClearSessions() *TableUpdate {
+	_u.mutation.ClearSessions()
+	return _u
+}
+
+// RemoveSessionIDs removes the "sessions" edge to TableSession entities by IDs.
+func (_u *TableUpdate) RemoveSessionIDs(ids ...uuid.UUID) *TableUpdate {
+	_u.mutation.RemoveSessionIDs(ids...)
+	return _u
+}
+
+// RemoveSessions removes "sessions" edges to TableSession entities.
+func (_u *TableUpdate) RemoveSessions(v ...*TableSession) *TableUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveSessionIDs(ids...)
+}
+
+// ClearOrders clears all "orders" edges to the Order entity.
+func (_u *TableUpdate) ClearOrders() *TableUpdate {
+	_u.mutation.ClearOrders()
+	return _u
+}
+
+// RemoveOrderIDs removes the "orders" edge to Order entities by IDs.
+func (_u *TableUpdate) RemoveOrderIDs(ids ...uuid.UUID) *TableUpdate {
+	_u.mutation.RemoveOrderIDs(ids...)
+	return _u
+}
+
+// RemoveOrders removes "orders" edges to Order entities.
+func (_u *TableUpdate) RemoveOrders(v ...*Order) *TableUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveOrderIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *TableUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *TableUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *TableUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *TableUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *TableUpdate) defaults() {
+	if _, ok := _u.mutation.UpdateTime(); !ok {
+		v := table.UpdateDefaultUpdateTime()
+		_u.mutation.SetUpdateTime(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *TableUpdate) check() error {
+	if v, ok := _u.mutation.Name(); ok {
+		if err := table.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Table.name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Section(); ok {
+		if err := table.SectionValidator(v); err != nil {
+			return &ValidationError{Name: "section", err: fmt.Errorf(`ent: validator failed for field "Table.section": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Seats(); ok {
+		if err := table.SeatsValidator(v); err != nil {
+			return &ValidationError{Name: "seats", err: fmt.Errorf(`ent: validator failed for field "Table.seats": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.QrToken(); ok {
+		if err := table.QrTokenValidator(v); err != nil {
+			return &ValidationError{Name: "qr_token", err: fmt.Errorf(`ent: validator failed for field "Table.qr_token": %w`, err)}
+		}
+	}
+	if _u.mutation.RestaurantCleared() && len(_u.mutation.RestaurantIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Table.restaurant"`)
+	}
+	return nil
+}
+
+func (_u *TableUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(table.Table, table.Columns, sqlgraph.NewFieldSpec(table.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdateTime(); ok {
+		_spec.SetField(table.FieldUpdateTime, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(table.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Section(); ok {
+		_spec.SetField(table.FieldSection, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Seats(); ok {
+		_spec.SetField(table.FieldSeats, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedSeats(); ok {
+		_spec.AddField(table.FieldSeats, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.QrToken(); ok {
+		_spec.SetField(table.FieldQrToken, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.IsActive(); ok {
+		_spec.SetField(table.FieldIsActive, field.TypeBool, value)
+	}
+	if _u.mutation.SessionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   table.SessionsTable,
+			Columns: []string{table.SessionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tablesession.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedSessionsIDs(); len(nodes) > 0 && !_u.mutation.SessionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   table.SessionsTable,
+			Columns: []string{table.SessionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tablesession.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.SessionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   table.SessionsTable,
+			Columns: []string{table.SessionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tablesession.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.OrdersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   table.OrdersTable,
+			Columns: []string{table.OrdersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(order.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedOrdersIDs(); len(nodes) > 0 && !_u.mutation.OrdersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   table.OrdersTable,
+			Columns: []string{table.OrdersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(order.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.OrdersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   table.OrdersTable,
+			Columns: []string{table.OrdersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(order.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{table.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// TableUpdateOne is the builder for updating a single Table entity.
+type TableUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *TableMutation
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_u *TableUpdateOne) SetUpdateTime(v time.Time) *TableUpdateOne {
+	_u.mutation.SetUpdateTime(v)
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *TableUpdateOne) SetName(v string) *TableUpdateOne {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *TableUpdateOne) SetNillableName(v *string) *TableUpdateOne {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetSection sets the "section" field.
+func (_u *TableUpdateOne) SetSection(v string) *TableUpdateOne {
+	_u.mutation.SetSection(v)
+	return _u
+}
+
+// SetNillableSection sets the "section" field if the given value is not nil.
+func (_u *TableUpdateOne) SetNillableSection(v *string) *TableUpdateOne {
+	if v != nil {
+		_u.SetSection(*v)
+	}
+	return _u
+}
+
+// SetSeats sets the "seats" field.
+func (_u *TableUpdateOne) SetSeats(v int) *TableUpdateOne {
+	_u.mutation.ResetSeats()
+	_u.mutation.SetSeats(v)
+	return _u
+}
+
+// SetNillableSeats sets the "seats" field if the given value is not nil.
+func (_u *TableUpdateOne) SetNillableSeats(v *int) *TableUpdateOne {
+	if v != nil {
+		_u.SetSeats(*v)
+	}
+	return _u
+}
+
+// AddSeats adds value to the "seats" field.
+func (_u *TableUpdateOne) AddSeats(v int) *TableUpdateOne {
+	_u.mutation.AddSeats(v)
+	return _u
+}
+
+// SetQrToken sets the "qr_token" field.
+func (_u *TableUpdateOne) SetQrToken(v string) *TableUpdateOne {
+	_u.mutation.SetQrToken(v)
+	return _u
+}
+
+// SetNillableQrToken sets the "qr_token" field if the given value is not nil.
+func (_u *TableUpdateOne) SetNillableQrToken(v *string) *TableUpdateOne {
+	if v != nil {
+		_u.SetQrToken(*v)
+	}
+	return _u
+}
+
+// SetIsActive sets the "is_active" field.
+func (_u *TableUpdateOne) SetIsActive(v bool) *TableUpdateOne {
+	_u.mutation.SetIsActive(v)
+	return _u
+}
+
+// SetNillableIsActive sets the "is_active" field if the given value is not nil.
+func (_u *TableUpdateOne) SetNillableIsActive(v *bool) *TableUpdateOne {
+	if v != nil {
+		_u.SetIsActive(*v)
+	}
+	return _u
+}
+
+// AddSessionIDs adds the "sessions" edge to the TableSession entity by IDs.
+func (_u *TableUpdateOne) AddSessionIDs(ids ...uuid.UUID) *TableUpdateOne {
+	_u.mutation.AddSessionIDs(ids...)
+	return _u
+}
+
+// AddSessions adds the "sessions" edges to the TableSession entity.
+func (_u *TableUpdateOne) AddSessions(v ...*TableSession) *TableUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddSessionIDs(ids...)
+}
+
+// AddOrderIDs adds the "orders" edge to the Order entity by IDs.
+func (_u *TableUpdateOne) AddOrderIDs(ids ...uuid.UUID) *TableUpdateOne {
+	_u.mutation.AddOrderIDs(ids...)
+	return _u
+}
+
+// AddOrders adds the "orders" edges to the Order entity.
+func (_u *TableUpdateOne) AddOrders(v ...*Order) *TableUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddOrderIDs(ids...)
+}
+
+// Mutation returns the TableMutation object of the builder.
+func (_u *TableUpdateOne) Mutation() *TableMutation {
+	return _u.mutation
+}
+
+// ClearSessions clears all "sessions" edges to the TableSession entity.
+func (_u *TableUpdateOne) ClearSessions() *TableUpdateOne {
+	_u.mutation.ClearSessions()
+	return _u
+}
+
+// RemoveSessionIDs removes the "sessions" edge to TableSession entities by IDs.
+func (_u *TableUpdateOne) RemoveSessionIDs(ids ...uuid.UUID) *TableUpdateOne {
+	_u.mutation.RemoveSessionIDs(ids...)
+	return _u
+}
+
+// RemoveSessions removes "sessions" edges to TableSession entities.
+func (_u *TableUpdateOne) RemoveSessions(v ...*TableSession) *TableUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveSessionIDs(ids...)
+}
+
+// ClearOrders clears all "orders" edges to the Order entity.
+func (_u *TableUpdateOne) ClearOrders() *TableUpdateOne {
+	_u.mutation.ClearOrders()
+	return _u
+}
+
+// RemoveOrderIDs removes the "orders" edge to Order entities by IDs.
+func (_u *TableUpdateOne) RemoveOrderIDs(ids ...uuid.UUID) *TableUpdateOne {
+	_u.mutation.RemoveOrderIDs(ids...)
+	return _u
+}
+
+// RemoveOrders removes "orders" edges to Order entities.
+func (_u *TableUpdateOne) RemoveOrders(v ...*Order) *TableUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveOrderIDs(ids...)
+}
+
+// Where appends a list predicates to the TableUpdate builder.
+func (_u *TableUpdateOne) Where(ps ...predicate.Table) *TableUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *TableUpdateOne) Select(field string, fields ...string) *TableUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated Table entity.
+func (_u *TableUpdateOne) Save(ctx context.Context) (*Table, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *TableUpdateOne) SaveX(ctx context.Context) *Table {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *TableUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *TableUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *TableUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdateTime(); !ok {
+		v := table.UpdateDefaultUpdateTime()
+		_u.mutation.SetUpdateTime(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *TableUpdateOne) check() error {
+	if v, ok := _u.mutation.Name(); ok {
+		if err := table.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Table.name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Section(); ok {
+		if err := table.SectionValidator(v); err != nil {
+			return &ValidationError{Name: "section", err: fmt.Errorf(`ent: validator failed for field "Table.section": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Seats(); ok {
+		if err := table.SeatsValidator(v); err != nil {
+			return &ValidationError{Name: "seats", err: fmt.Errorf(`ent: validator failed for field "Table.seats": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.QrToken(); ok {
+		if err := table.QrTokenValidator(v); err != nil {
+			return &ValidationError{Name: "qr_token", err: fmt.Errorf(`ent: validator failed for field "Table.qr_token": %w`, err)}
+		}
+	}
+	if _u.mutation.RestaurantCleared() && len(_u.mutation.RestaurantIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Table.restaurant"`)
+	}
+	return nil
+}
+
+func (_u *TableUpdateOne) sqlSave(ctx context.Context) (_node *Table, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(table.Table, table.Columns, sqlgraph.NewFieldSpec(table.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Table.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, table.FieldID)
+		for _, f := range fields {
+			if !table.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != table.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdateTime(); ok {
+		_spec.SetField(table.FieldUpdateTime, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(table.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Section(); ok {
+		_spec.SetField(table.FieldSection, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Seats(); ok {
+		_spec.SetField(table.FieldSeats, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedSeats(); ok {
+		_spec.AddField(table.FieldSeats, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.QrToken(); ok {
+		_spec.SetField(table.FieldQrToken, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.IsActive(); ok {
+		_spec.SetField(table.FieldIsActive, field.TypeBool, value)
+	}
+	if _u.mutation.SessionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   table.SessionsTable,
+			Columns: []string{table.SessionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tablesession.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedSessionsIDs(); len(nodes) > 0 && !_u.mutation.SessionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   table.SessionsTable,
+			Columns: []string{table.SessionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tablesession.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.SessionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   table.SessionsTable,
+			Columns: []string{table.SessionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tablesession.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.OrdersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   table.OrdersTable,
+			Columns: []string{table.OrdersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(order.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedOrdersIDs(); len(nodes) > 0 && !_u.mutation.OrdersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   table.OrdersTable,
+			Columns: []string{table.OrdersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(order.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.OrdersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   table.OrdersTable,
+			Columns: []string{table.OrdersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(order.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Table{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{table.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
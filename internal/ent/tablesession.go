@@ -0,0 +1,253 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/Jiruu246/rms/internal/ent/restaurant"
+	"github.com/Jiruu246/rms/internal/ent/table"
+	"github.com/Jiruu246/rms/internal/ent/tablesession"
+	"github.com/Jiruu246/rms/internal/ent/user"
+	"github.com/google/uuid"
+)
+
+// TableSession is the model entity for the TableSession schema.
+type TableSession struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// CreateTime holds the value of the "create_time" field.
+	CreateTime time.Time `json:"create_time,omitempty"`
+	// Status holds the value of the "status" field.
+	Status tablesession.Status `json:"status,omitempty"`
+	// ClosedAt holds the value of the "closed_at" field.
+	ClosedAt *time.Time `json:"closed_at,omitempty"`
+	// ID of the user who closed the session
+	ClosedByID *uuid.UUID `json:"closed_by_id,omitempty"`
+	// TableID holds the value of the "table_id" field.
+	TableID uuid.UUID `json:"table_id,omitempty"`
+	// RestaurantID holds the value of the "restaurant_id" field.
+	RestaurantID uuid.UUID `json:"restaurant_id,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the TableSessionQuery when eager-loading is set.
+	Edges        TableSessionEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// TableSessionEdges holds the relations/edges for other nodes in the graph.
+type TableSessionEdges struct {
+	// Table holds the value of the table edge.
+	Table *Table `json:"table,omitempty"`
+	// Restaurant holds the value of the restaurant edge.
+	Restaurant *Restaurant `json:"restaurant,omitempty"`
+	// ClosedBy holds the value of the closed_by edge.
+	ClosedBy *User `json:"closed_by,omitempty"`
+	// Orders holds the value of the orders edge.
+	Orders []*Order `json:"orders,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [4]bool
+}
+
+// TableOrErr returns the Table value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e TableSessionEdges) TableOrErr() (*Table, error) {
+	if e.Table != nil {
+		return e.Table, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: table.Label}
+	}
+	return nil, &NotLoadedError{edge: "table"}
+}
+
+// RestaurantOrErr returns the Restaurant value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e TableSessionEdges) RestaurantOrErr() (*Restaurant, error) {
+	if e.Restaurant != nil {
+		return e.Restaurant, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: restaurant.Label}
+	}
+	return nil, &NotLoadedError{edge: "restaurant"}
+}
+
+// ClosedByOrErr returns the ClosedBy value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e TableSessionEdges) ClosedByOrErr() (*User, error) {
+	if e.ClosedBy != nil {
+		return e.ClosedBy, nil
+	} else if e.loadedTypes[2] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "closed_by"}
+}
+
+// OrdersOrErr returns the Orders value or an error if the edge
+// was not loaded in eager-loading.
+func (e TableSessionEdges) OrdersOrErr() ([]*Order, error) {
+	if e.loadedTypes[3] {
+		return e.Orders, nil
+	}
+	return nil, &NotLoadedError{edge: "orders"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*TableSession) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case tablesession.FieldClosedByID:
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case tablesession.FieldStatus:
+			values[i] = new(sql.NullString)
+		case tablesession.FieldCreateTime, tablesession.FieldClosedAt:
+			values[i] = new(sql.NullTime)
+		case tablesession.FieldID, tablesession.FieldTableID, tablesession.FieldRestaurantID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the TableSession fields.
+func (_m *TableSession) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case tablesession.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case tablesession.FieldCreateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field create_time", values[i])
+			} else if value.Valid {
+				_m.CreateTime = value.Time
+			}
+		case tablesession.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				_m.Status = tablesession.Status(value.String)
+			}
+		case tablesession.FieldClosedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field closed_at", values[i])
+			} else if value.Valid {
+				_m.ClosedAt = new(time.Time)
+				*_m.ClosedAt = value.Time
+			}
+		case tablesession.FieldClosedByID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field closed_by_id", values[i])
+			} else if value.Valid {
+				_m.ClosedByID = new(uuid.UUID)
+				*_m.ClosedByID = *value.S.(*uuid.UUID)
+			}
+		case tablesession.FieldTableID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field table_id", values[i])
+			} else if value != nil {
+				_m.TableID = *value
+			}
+		case tablesession.FieldRestaurantID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field restaurant_id", values[i])
+			} else if value != nil {
+				_m.RestaurantID = *value
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the TableSession.
+// This includes values selected through modifiers, order, etc.
+func (_m *TableSession) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryTable queries the "table" edge of the TableSession entity.
+func (_m *TableSession) QueryTable() *TableQuery {
+	return NewTableSessionClient(_m.config).QueryTable(_m)
+}
+
+// QueryRestaurant queries the "restaurant" edge of the TableSession entity.
+func (_m *TableSession) QueryRestaurant() *RestaurantQuery {
+	return NewTableSessionClient(_m.config).QueryRestaurant(_m)
+}
+
+// QueryClosedBy queries the "closed_by" edge of the TableSession entity.
+func (_m *TableSession) QueryClosedBy() *UserQuery {
+	return NewTableSessionClient(_m.config).QueryClosedBy(_m)
+}
+
+// QueryOrders queries the "orders" edge of the TableSession entity.
+func (_m *TableSession) QueryOrders() *OrderQuery {
+	return NewTableSessionClient(_m.config).QueryOrders(_m)
+}
+
+// Update returns a builder for updating this TableSession.
+// Note that you need to call TableSession.Unwrap() before calling this method if this TableSession
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *TableSession) Update() *TableSessionUpdateOne {
+	return NewTableSessionClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the TableSession entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *TableSession) Unwrap() *TableSession {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: TableSession is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *TableSession) String() string {
+	var builder strings.Builder
+	builder.WriteString("TableSession(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("create_time=")
+	builder.WriteString(_m.CreateTime.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Status))
+	builder.WriteString(", ")
+	if v := _m.ClosedAt; v != nil {
+		builder.WriteString("closed_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.ClosedByID; v != nil {
+		builder.WriteString("closed_by_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("table_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TableID))
+	builder.WriteString(", ")
+	builder.WriteString("restaurant_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.RestaurantID))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// TableSessions is a parsable slice of TableSession.
+type TableSessions []*TableSession
@@ -14,22 +14,62 @@ type Tx struct {
 	config
 	// Category is the client for interacting with the Category builders.
 	Category *CategoryClient
+	// DeliveryZone is the client for interacting with the DeliveryZone builders.
+	DeliveryZone *DeliveryZoneClient
+	// IdempotencyKey is the client for interacting with the IdempotencyKey builders.
+	IdempotencyKey *IdempotencyKeyClient
+	// Ingredient is the client for interacting with the Ingredient builders.
+	Ingredient *IngredientClient
+	// Menu is the client for interacting with the Menu builders.
+	Menu *MenuClient
 	// MenuItem is the client for interacting with the MenuItem builders.
 	MenuItem *MenuItemClient
+	// MenuItemModifier is the client for interacting with the MenuItemModifier builders.
+	MenuItemModifier *MenuItemModifierClient
+	// MenuItemPrice is the client for interacting with the MenuItemPrice builders.
+	MenuItemPrice *MenuItemPriceClient
+	// MenuItemVariant is the client for interacting with the MenuItemVariant builders.
+	MenuItemVariant *MenuItemVariantClient
 	// Modifier is the client for interacting with the Modifier builders.
 	Modifier *ModifierClient
 	// ModifierOption is the client for interacting with the ModifierOption builders.
 	ModifierOption *ModifierOptionClient
 	// Order is the client for interacting with the Order builders.
 	Order *OrderClient
+	// OrderEvent is the client for interacting with the OrderEvent builders.
+	OrderEvent *OrderEventClient
 	// OrderItem is the client for interacting with the OrderItem builders.
 	OrderItem *OrderItemClient
+	// OrderItemChange is the client for interacting with the OrderItemChange builders.
+	OrderItemChange *OrderItemChangeClient
 	// OrderItemModifierOption is the client for interacting with the OrderItemModifierOption builders.
 	OrderItemModifierOption *OrderItemModifierOptionClient
+	// OrderNumberSequence is the client for interacting with the OrderNumberSequence builders.
+	OrderNumberSequence *OrderNumberSequenceClient
+	// OrderStatusEvent is the client for interacting with the OrderStatusEvent builders.
+	OrderStatusEvent *OrderStatusEventClient
+	// Payment is the client for interacting with the Payment builders.
+	Payment *PaymentClient
+	// RateLimitBucket is the client for interacting with the RateLimitBucket builders.
+	RateLimitBucket *RateLimitBucketClient
+	// RecipeIngredient is the client for interacting with the RecipeIngredient builders.
+	RecipeIngredient *RecipeIngredientClient
 	// RefreshToken is the client for interacting with the RefreshToken builders.
 	RefreshToken *RefreshTokenClient
+	// Refund is the client for interacting with the Refund builders.
+	Refund *RefundClient
 	// Restaurant is the client for interacting with the Restaurant builders.
 	Restaurant *RestaurantClient
+	// Station is the client for interacting with the Station builders.
+	Station *StationClient
+	// StationTicket is the client for interacting with the StationTicket builders.
+	StationTicket *StationTicketClient
+	// StockMovement is the client for interacting with the StockMovement builders.
+	StockMovement *StockMovementClient
+	// Table is the client for interacting with the Table builders.
+	Table *TableClient
+	// TableSession is the client for interacting with the TableSession builders.
+	TableSession *TableSessionClient
 	// User is the client for interacting with the User builders.
 	User *UserClient
 	// UserAuthProvider is the client for interacting with the UserAuthProvider builders.
@@ -166,14 +206,34 @@ func (tx *Tx) Client() *Client {
 
 func (tx *Tx) init() {
 	tx.Category = NewCategoryClient(tx.config)
+	tx.DeliveryZone = NewDeliveryZoneClient(tx.config)
+	tx.IdempotencyKey = NewIdempotencyKeyClient(tx.config)
+	tx.Ingredient = NewIngredientClient(tx.config)
+	tx.Menu = NewMenuClient(tx.config)
 	tx.MenuItem = NewMenuItemClient(tx.config)
+	tx.MenuItemModifier = NewMenuItemModifierClient(tx.config)
+	tx.MenuItemPrice = NewMenuItemPriceClient(tx.config)
+	tx.MenuItemVariant = NewMenuItemVariantClient(tx.config)
 	tx.Modifier = NewModifierClient(tx.config)
 	tx.ModifierOption = NewModifierOptionClient(tx.config)
 	tx.Order = NewOrderClient(tx.config)
+	tx.OrderEvent = NewOrderEventClient(tx.config)
 	tx.OrderItem = NewOrderItemClient(tx.config)
+	tx.OrderItemChange = NewOrderItemChangeClient(tx.config)
 	tx.OrderItemModifierOption = NewOrderItemModifierOptionClient(tx.config)
+	tx.OrderNumberSequence = NewOrderNumberSequenceClient(tx.config)
+	tx.OrderStatusEvent = NewOrderStatusEventClient(tx.config)
+	tx.Payment = NewPaymentClient(tx.config)
+	tx.RateLimitBucket = NewRateLimitBucketClient(tx.config)
+	tx.RecipeIngredient = NewRecipeIngredientClient(tx.config)
 	tx.RefreshToken = NewRefreshTokenClient(tx.config)
+	tx.Refund = NewRefundClient(tx.config)
 	tx.Restaurant = NewRestaurantClient(tx.config)
+	tx.Station = NewStationClient(tx.config)
+	tx.StationTicket = NewStationTicketClient(tx.config)
+	tx.StockMovement = NewStockMovementClient(tx.config)
+	tx.Table = NewTableClient(tx.config)
+	tx.TableSession = NewTableSessionClient(tx.config)
 	tx.User = NewUserClient(tx.config)
 	tx.UserAuthProvider = NewUserAuthProviderClient(tx.config)
 }
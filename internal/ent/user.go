@@ -46,9 +46,21 @@ type UserEdges struct {
 	AuthProviders []*UserAuthProvider `json:"auth_providers,omitempty"`
 	// RefreshTokens holds the value of the refresh_tokens edge.
 	RefreshTokens []*RefreshToken `json:"refresh_tokens,omitempty"`
+	// OrderStatusEvents holds the value of the order_status_events edge.
+	OrderStatusEvents []*OrderStatusEvent `json:"order_status_events,omitempty"`
+	// OrderItemChanges holds the value of the order_item_changes edge.
+	OrderItemChanges []*OrderItemChange `json:"order_item_changes,omitempty"`
+	// Payments holds the value of the payments edge.
+	Payments []*Payment `json:"payments,omitempty"`
+	// Refunds holds the value of the refunds edge.
+	Refunds []*Refund `json:"refunds,omitempty"`
+	// ClosedTableSessions holds the value of the closed_table_sessions edge.
+	ClosedTableSessions []*TableSession `json:"closed_table_sessions,omitempty"`
+	// StockMovements holds the value of the stock_movements edge.
+	StockMovements []*StockMovement `json:"stock_movements,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [3]bool
+	loadedTypes [9]bool
 }
 
 // RestaurantsOrErr returns the Restaurants value or an error if the edge
@@ -78,6 +90,60 @@ func (e UserEdges) RefreshTokensOrErr() ([]*RefreshToken, error) {
 	return nil, &NotLoadedError{edge: "refresh_tokens"}
 }
 
+// OrderStatusEventsOrErr returns the OrderStatusEvents value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) OrderStatusEventsOrErr() ([]*OrderStatusEvent, error) {
+	if e.loadedTypes[3] {
+		return e.OrderStatusEvents, nil
+	}
+	return nil, &NotLoadedError{edge: "order_status_events"}
+}
+
+// OrderItemChangesOrErr returns the OrderItemChanges value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) OrderItemChangesOrErr() ([]*OrderItemChange, error) {
+	if e.loadedTypes[4] {
+		return e.OrderItemChanges, nil
+	}
+	return nil, &NotLoadedError{edge: "order_item_changes"}
+}
+
+// PaymentsOrErr returns the Payments value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) PaymentsOrErr() ([]*Payment, error) {
+	if e.loadedTypes[5] {
+		return e.Payments, nil
+	}
+	return nil, &NotLoadedError{edge: "payments"}
+}
+
+// RefundsOrErr returns the Refunds value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) RefundsOrErr() ([]*Refund, error) {
+	if e.loadedTypes[6] {
+		return e.Refunds, nil
+	}
+	return nil, &NotLoadedError{edge: "refunds"}
+}
+
+// ClosedTableSessionsOrErr returns the ClosedTableSessions value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) ClosedTableSessionsOrErr() ([]*TableSession, error) {
+	if e.loadedTypes[7] {
+		return e.ClosedTableSessions, nil
+	}
+	return nil, &NotLoadedError{edge: "closed_table_sessions"}
+}
+
+// StockMovementsOrErr returns the StockMovements value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) StockMovementsOrErr() ([]*StockMovement, error) {
+	if e.loadedTypes[8] {
+		return e.StockMovements, nil
+	}
+	return nil, &NotLoadedError{edge: "stock_movements"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*User) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
@@ -183,6 +249,36 @@ func (_m *User) QueryRefreshTokens() *RefreshTokenQuery {
 	return NewUserClient(_m.config).QueryRefreshTokens(_m)
 }
 
+// QueryOrderStatusEvents queries the "order_status_events" edge of the User entity.
+func (_m *User) QueryOrderStatusEvents() *OrderStatusEventQuery {
+	return NewUserClient(_m.config).QueryOrderStatusEvents(_m)
+}
+
+// QueryOrderItemChanges queries the "order_item_changes" edge of the User entity.
+func (_m *User) QueryOrderItemChanges() *OrderItemChangeQuery {
+	return NewUserClient(_m.config).QueryOrderItemChanges(_m)
+}
+
+// QueryPayments queries the "payments" edge of the User entity.
+func (_m *User) QueryPayments() *PaymentQuery {
+	return NewUserClient(_m.config).QueryPayments(_m)
+}
+
+// QueryRefunds queries the "refunds" edge of the User entity.
+func (_m *User) QueryRefunds() *RefundQuery {
+	return NewUserClient(_m.config).QueryRefunds(_m)
+}
+
+// QueryClosedTableSessions queries the "closed_table_sessions" edge of the User entity.
+func (_m *User) QueryClosedTableSessions() *TableSessionQuery {
+	return NewUserClient(_m.config).QueryClosedTableSessions(_m)
+}
+
+// QueryStockMovements queries the "stock_movements" edge of the User entity.
+func (_m *User) QueryStockMovements() *StockMovementQuery {
+	return NewUserClient(_m.config).QueryStockMovements(_m)
+}
+
 // Update returns a builder for updating this User.
 // Note that you need to call User.Unwrap() before calling this method if this User
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -35,6 +35,18 @@ const (
 	EdgeAuthProviders = "auth_providers"
 	// EdgeRefreshTokens holds the string denoting the refresh_tokens edge name in mutations.
 	EdgeRefreshTokens = "refresh_tokens"
+	// EdgeOrderStatusEvents holds the string denoting the order_status_events edge name in mutations.
+	EdgeOrderStatusEvents = "order_status_events"
+	// EdgeOrderItemChanges holds the string denoting the order_item_changes edge name in mutations.
+	EdgeOrderItemChanges = "order_item_changes"
+	// EdgePayments holds the string denoting the payments edge name in mutations.
+	EdgePayments = "payments"
+	// EdgeRefunds holds the string denoting the refunds edge name in mutations.
+	EdgeRefunds = "refunds"
+	// EdgeClosedTableSessions holds the string denoting the closed_table_sessions edge name in mutations.
+	EdgeClosedTableSessions = "closed_table_sessions"
+	// EdgeStockMovements holds the string denoting the stock_movements edge name in mutations.
+	EdgeStockMovements = "stock_movements"
 	// Table holds the table name of the user in the database.
 	Table = "users"
 	// RestaurantsTable is the table that holds the restaurants relation/edge.
@@ -58,6 +70,48 @@ const (
 	RefreshTokensInverseTable = "refresh_tokens"
 	// RefreshTokensColumn is the table column denoting the refresh_tokens relation/edge.
 	RefreshTokensColumn = "user_id"
+	// OrderStatusEventsTable is the table that holds the order_status_events relation/edge.
+	OrderStatusEventsTable = "order_status_events"
+	// OrderStatusEventsInverseTable is the table name for the OrderStatusEvent entity.
+	// It exists in this package in order to avoid circular dependency with the "orderstatusevent" package.
+	OrderStatusEventsInverseTable = "order_status_events"
+	// OrderStatusEventsColumn is the table column denoting the order_status_events relation/edge.
+	OrderStatusEventsColumn = "changed_by_id"
+	// OrderItemChangesTable is the table that holds the order_item_changes relation/edge.
+	OrderItemChangesTable = "order_item_changes"
+	// OrderItemChangesInverseTable is the table name for the OrderItemChange entity.
+	// It exists in this package in order to avoid circular dependency with the "orderitemchange" package.
+	OrderItemChangesInverseTable = "order_item_changes"
+	// OrderItemChangesColumn is the table column denoting the order_item_changes relation/edge.
+	OrderItemChangesColumn = "changed_by_id"
+	// PaymentsTable is the table that holds the payments relation/edge.
+	PaymentsTable = "payments"
+	// PaymentsInverseTable is the table name for the Payment entity.
+	// It exists in this package in order to avoid circular dependency with the "payment" package.
+	PaymentsInverseTable = "payments"
+	// PaymentsColumn is the table column denoting the payments relation/edge.
+	PaymentsColumn = "created_by_id"
+	// RefundsTable is the table that holds the refunds relation/edge.
+	RefundsTable = "refunds"
+	// RefundsInverseTable is the table name for the Refund entity.
+	// It exists in this package in order to avoid circular dependency with the "refund" package.
+	RefundsInverseTable = "refunds"
+	// RefundsColumn is the table column denoting the refunds relation/edge.
+	RefundsColumn = "created_by_id"
+	// ClosedTableSessionsTable is the table that holds the closed_table_sessions relation/edge.
+	ClosedTableSessionsTable = "table_sessions"
+	// ClosedTableSessionsInverseTable is the table name for the TableSession entity.
+	// It exists in this package in order to avoid circular dependency with the "tablesession" package.
+	ClosedTableSessionsInverseTable = "table_sessions"
+	// ClosedTableSessionsColumn is the table column denoting the closed_table_sessions relation/edge.
+	ClosedTableSessionsColumn = "closed_by_id"
+	// StockMovementsTable is the table that holds the stock_movements relation/edge.
+	StockMovementsTable = "stock_movements"
+	// StockMovementsInverseTable is the table name for the StockMovement entity.
+	// It exists in this package in order to avoid circular dependency with the "stockmovement" package.
+	StockMovementsInverseTable = "stock_movements"
+	// StockMovementsColumn is the table column denoting the stock_movements relation/edge.
+	StockMovementsColumn = "created_by_id"
 )
 
 // Columns holds all SQL columns for user fields.
@@ -183,6 +237,90 @@ func ByRefreshTokens(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newRefreshTokensStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
+
+// ByOrderStatusEventsCount orders the results by order_status_events count.
+func ByOrderStatusEventsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newOrderStatusEventsStep(), opts...)
+	}
+}
+
+// ByOrderStatusEvents orders the results by order_status_events terms.
+func ByOrderStatusEvents(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newOrderStatusEventsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByOrderItemChangesCount orders the results by order_item_changes count.
+func ByOrderItemChangesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newOrderItemChangesStep(), opts...)
+	}
+}
+
+// ByOrderItemChanges orders the results by order_item_changes terms.
+func ByOrderItemChanges(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newOrderItemChangesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByPaymentsCount orders the results by payments count.
+func ByPaymentsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newPaymentsStep(), opts...)
+	}
+}
+
+// ByPayments orders the results by payments terms.
+func ByPayments(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newPaymentsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByRefundsCount orders the results by refunds count.
+func ByRefundsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newRefundsStep(), opts...)
+	}
+}
+
+// ByRefunds orders the results by refunds terms.
+func ByRefunds(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newRefundsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByClosedTableSessionsCount orders the results by closed_table_sessions count.
+func ByClosedTableSessionsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newClosedTableSessionsStep(), opts...)
+	}
+}
+
+// ByClosedTableSessions orders the results by closed_table_sessions terms.
+func ByClosedTableSessions(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newClosedTableSessionsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByStockMovementsCount orders the results by stock_movements count.
+func ByStockMovementsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newStockMovementsStep(), opts...)
+	}
+}
+
+// ByStockMovements orders the results by stock_movements terms.
+func ByStockMovements(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newStockMovementsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newRestaurantsStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -204,3 +342,45 @@ func newRefreshTokensStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, false, RefreshTokensTable, RefreshTokensColumn),
 	)
 }
+func newOrderStatusEventsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(OrderStatusEventsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, OrderStatusEventsTable, OrderStatusEventsColumn),
+	)
+}
+func newOrderItemChangesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(OrderItemChangesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, OrderItemChangesTable, OrderItemChangesColumn),
+	)
+}
+func newPaymentsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(PaymentsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, PaymentsTable, PaymentsColumn),
+	)
+}
+func newRefundsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(RefundsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, RefundsTable, RefundsColumn),
+	)
+}
+func newClosedTableSessionsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ClosedTableSessionsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, ClosedTableSessionsTable, ClosedTableSessionsColumn),
+	)
+}
+func newStockMovementsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(StockMovementsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, StockMovementsTable, StockMovementsColumn),
+	)
+}
@@ -12,9 +12,15 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/Jiruu246/rms/internal/ent/orderitemchange"
+	"github.com/Jiruu246/rms/internal/ent/orderstatusevent"
+	"github.com/Jiruu246/rms/internal/ent/payment"
 	"github.com/Jiruu246/rms/internal/ent/predicate"
 	"github.com/Jiruu246/rms/internal/ent/refreshtoken"
+	"github.com/Jiruu246/rms/internal/ent/refund"
 	"github.com/Jiruu246/rms/internal/ent/restaurant"
+	"github.com/Jiruu246/rms/internal/ent/stockmovement"
+	"github.com/Jiruu246/rms/internal/ent/tablesession"
 	"github.com/Jiruu246/rms/internal/ent/user"
 	"github.com/Jiruu246/rms/internal/ent/userauthprovider"
 	"github.com/google/uuid"
@@ -23,13 +29,19 @@ import (
 // UserQuery is the builder for querying User entities.
 type UserQuery struct {
 	config
-	ctx               *QueryContext
-	order             []user.OrderOption
-	inters            []Interceptor
-	predicates        []predicate.User
-	withRestaurants   *RestaurantQuery
-	withAuthProviders *UserAuthProviderQuery
-	withRefreshTokens *RefreshTokenQuery
+	ctx                     *QueryContext
+	order                   []user.OrderOption
+	inters                  []Interceptor
+	predicates              []predicate.User
+	withRestaurants         *RestaurantQuery
+	withAuthProviders       *UserAuthProviderQuery
+	withRefreshTokens       *RefreshTokenQuery
+	withOrderStatusEvents   *OrderStatusEventQuery
+	withOrderItemChanges    *OrderItemChangeQuery
+	withPayments            *PaymentQuery
+	withRefunds             *RefundQuery
+	withClosedTableSessions *TableSessionQuery
+	withStockMovements      *StockMovementQuery
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -132,6 +144,138 @@ func (_q *UserQuery) QueryRefreshTokens() *RefreshTokenQuery {
 	return query
 }
 
+// QueryOrderStatusEvents chains the current query on the "order_status_events" edge.
+func (_q *UserQuery) QueryOrderStatusEvents() *OrderStatusEventQuery {
+	query := (&OrderStatusEventClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, selector),
+			sqlgraph.To(orderstatusevent.Table, orderstatusevent.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.OrderStatusEventsTable, user.OrderStatusEventsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryOrderItemChanges chains the current query on the "order_item_changes" edge.
+func (_q *UserQuery) QueryOrderItemChanges() *OrderItemChangeQuery {
+	query := (&OrderItemChangeClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, selector),
+			sqlgraph.To(orderitemchange.Table, orderitemchange.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.OrderItemChangesTable, user.OrderItemChangesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryPayments chains the current query on the "payments" edge.
+func (_q *UserQuery) QueryPayments() *PaymentQuery {
+	query := (&PaymentClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, selector),
+			sqlgraph.To(payment.Table, payment.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.PaymentsTable, user.PaymentsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryRefunds chains the current query on the "refunds" edge.
+func (_q *UserQuery) QueryRefunds() *RefundQuery {
+	query := (&RefundClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, selector),
+			sqlgraph.To(refund.Table, refund.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.RefundsTable, user.RefundsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryClosedTableSessions chains the current query on the "closed_table_sessions" edge.
+func (_q *UserQuery) QueryClosedTableSessions() *TableSessionQuery {
+	query := (&TableSessionClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, selector),
+			sqlgraph.To(tablesession.Table, tablesession.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.ClosedTableSessionsTable, user.ClosedTableSessionsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryStockMovements chains the current query on the "stock_movements" edge.
+func (_q *UserQuery) QueryStockMovements() *StockMovementQuery {
+	query := (&StockMovementClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, selector),
+			sqlgraph.To(stockmovement.Table, stockmovement.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.StockMovementsTable, user.StockMovementsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first User entity from the query.
 // Returns a *NotFoundError when no User was found.
 func (_q *UserQuery) First(ctx context.Context) (*User, error) {
@@ -319,14 +463,20 @@ func (_q *UserQuery) Clone() *UserQuery {
 		return nil
 	}
 	return &UserQuery{
-		config:            _q.config,
-		ctx:               _q.ctx.Clone(),
-		order:             append([]user.OrderOption{}, _q.order...),
-		inters:            append([]Interceptor{}, _q.inters...),
-		predicates:        append([]predicate.User{}, _q.predicates...),
-		withRestaurants:   _q.withRestaurants.Clone(),
-		withAuthProviders: _q.withAuthProviders.Clone(),
-		withRefreshTokens: _q.withRefreshTokens.Clone(),
+		config:                  _q.config,
+		ctx:                     _q.ctx.Clone(),
+		order:                   append([]user.OrderOption{}, _q.order...),
+		inters:                  append([]Interceptor{}, _q.inters...),
+		predicates:              append([]predicate.User{}, _q.predicates...),
+		withRestaurants:         _q.withRestaurants.Clone(),
+		withAuthProviders:       _q.withAuthProviders.Clone(),
+		withRefreshTokens:       _q.withRefreshTokens.Clone(),
+		withOrderStatusEvents:   _q.withOrderStatusEvents.Clone(),
+		withOrderItemChanges:    _q.withOrderItemChanges.Clone(),
+		withPayments:            _q.withPayments.Clone(),
+		withRefunds:             _q.withRefunds.Clone(),
+		withClosedTableSessions: _q.withClosedTableSessions.Clone(),
+		withStockMovements:      _q.withStockMovements.Clone(),
 		// clone intermediate query.
 		sql:  _q.sql.Clone(),
 		path: _q.path,
@@ -366,6 +516,72 @@ func (_q *UserQuery) WithRefreshTokens(opts ...func(*RefreshTokenQuery)) *UserQu
 	return _q
 }
 
+// WithOrderStatusEvents tells the query-builder to eager-load the nodes that are connected to
+// the "order_status_events" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *UserQuery) WithOrderStatusEvents(opts ...func(*OrderStatusEventQuery)) *UserQuery {
+	query := (&OrderStatusEventClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withOrderStatusEvents = query
+	return _q
+}
+
+// WithOrderItemChanges tells the query-builder to eager-load the nodes that are connected to
+// the "order_item_changes" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *UserQuery) WithOrderItemChanges(opts ...func(*OrderItemChangeQuery)) *UserQuery {
+	query := (&OrderItemChangeClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withOrderItemChanges = query
+	return _q
+}
+
+// WithPayments tells the query-builder to eager-load the nodes that are connected to
+// the "payments" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *UserQuery) WithPayments(opts ...func(*PaymentQuery)) *UserQuery {
+	query := (&PaymentClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withPayments = query
+	return _q
+}
+
+// WithRefunds tells the query-builder to eager-load the nodes that are connected to
+// the "refunds" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *UserQuery) WithRefunds(opts ...func(*RefundQuery)) *UserQuery {
+	query := (&RefundClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withRefunds = query
+	return _q
+}
+
+// WithClosedTableSessions tells the query-builder to eager-load the nodes that are connected to
+// the "closed_table_sessions" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *UserQuery) WithClosedTableSessions(opts ...func(*TableSessionQuery)) *UserQuery {
+	query := (&TableSessionClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withClosedTableSessions = query
+	return _q
+}
+
+// WithStockMovements tells the query-builder to eager-load the nodes that are connected to
+// the "stock_movements" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *UserQuery) WithStockMovements(opts ...func(*StockMovementQuery)) *UserQuery {
+	query := (&StockMovementClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withStockMovements = query
+	return _q
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -444,10 +660,16 @@ func (_q *UserQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*User, e
 	var (
 		nodes       = []*User{}
 		_spec       = _q.querySpec()
-		loadedTypes = [3]bool{
+		loadedTypes = [9]bool{
 			_q.withRestaurants != nil,
 			_q.withAuthProviders != nil,
 			_q.withRefreshTokens != nil,
+			_q.withOrderStatusEvents != nil,
+			_q.withOrderItemChanges != nil,
+			_q.withPayments != nil,
+			_q.withRefunds != nil,
+			_q.withClosedTableSessions != nil,
+			_q.withStockMovements != nil,
 		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
@@ -489,6 +711,48 @@ func (_q *UserQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*User, e
 			return nil, err
 		}
 	}
+	if query := _q.withOrderStatusEvents; query != nil {
+		if err := _q.loadOrderStatusEvents(ctx, query, nodes,
+			func(n *User) { n.Edges.OrderStatusEvents = []*OrderStatusEvent{} },
+			func(n *User, e *OrderStatusEvent) { n.Edges.OrderStatusEvents = append(n.Edges.OrderStatusEvents, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withOrderItemChanges; query != nil {
+		if err := _q.loadOrderItemChanges(ctx, query, nodes,
+			func(n *User) { n.Edges.OrderItemChanges = []*OrderItemChange{} },
+			func(n *User, e *OrderItemChange) { n.Edges.OrderItemChanges = append(n.Edges.OrderItemChanges, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withPayments; query != nil {
+		if err := _q.loadPayments(ctx, query, nodes,
+			func(n *User) { n.Edges.Payments = []*Payment{} },
+			func(n *User, e *Payment) { n.Edges.Payments = append(n.Edges.Payments, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withRefunds; query != nil {
+		if err := _q.loadRefunds(ctx, query, nodes,
+			func(n *User) { n.Edges.Refunds = []*Refund{} },
+			func(n *User, e *Refund) { n.Edges.Refunds = append(n.Edges.Refunds, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withClosedTableSessions; query != nil {
+		if err := _q.loadClosedTableSessions(ctx, query, nodes,
+			func(n *User) { n.Edges.ClosedTableSessions = []*TableSession{} },
+			func(n *User, e *TableSession) { n.Edges.ClosedTableSessions = append(n.Edges.ClosedTableSessions, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withStockMovements; query != nil {
+		if err := _q.loadStockMovements(ctx, query, nodes,
+			func(n *User) { n.Edges.StockMovements = []*StockMovement{} },
+			func(n *User, e *StockMovement) { n.Edges.StockMovements = append(n.Edges.StockMovements, e) }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -582,6 +846,204 @@ func (_q *UserQuery) loadRefreshTokens(ctx context.Context, query *RefreshTokenQ
 	}
 	return nil
 }
+func (_q *UserQuery) loadOrderStatusEvents(ctx context.Context, query *OrderStatusEventQuery, nodes []*User, init func(*User), assign func(*User, *OrderStatusEvent)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*User)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(orderstatusevent.FieldChangedByID)
+	}
+	query.Where(predicate.OrderStatusEvent(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(user.OrderStatusEventsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.ChangedByID
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "changed_by_id" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "changed_by_id" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *UserQuery) loadOrderItemChanges(ctx context.Context, query *OrderItemChangeQuery, nodes []*User, init func(*User), assign func(*User, *OrderItemChange)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*User)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(orderitemchange.FieldChangedByID)
+	}
+	query.Where(predicate.OrderItemChange(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(user.OrderItemChangesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.ChangedByID
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "changed_by_id" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "changed_by_id" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *UserQuery) loadPayments(ctx context.Context, query *PaymentQuery, nodes []*User, init func(*User), assign func(*User, *Payment)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*User)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(payment.FieldCreatedByID)
+	}
+	query.Where(predicate.Payment(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(user.PaymentsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.CreatedByID
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "created_by_id" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "created_by_id" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *UserQuery) loadRefunds(ctx context.Context, query *RefundQuery, nodes []*User, init func(*User), assign func(*User, *Refund)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*User)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(refund.FieldCreatedByID)
+	}
+	query.Where(predicate.Refund(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(user.RefundsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.CreatedByID
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "created_by_id" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "created_by_id" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *UserQuery) loadClosedTableSessions(ctx context.Context, query *TableSessionQuery, nodes []*User, init func(*User), assign func(*User, *TableSession)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*User)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(tablesession.FieldClosedByID)
+	}
+	query.Where(predicate.TableSession(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(user.ClosedTableSessionsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.ClosedByID
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "closed_by_id" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "closed_by_id" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *UserQuery) loadStockMovements(ctx context.Context, query *StockMovementQuery, nodes []*User, init func(*User), assign func(*User, *StockMovement)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*User)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(stockmovement.FieldCreatedByID)
+	}
+	query.Where(predicate.StockMovement(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(user.StockMovementsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.CreatedByID
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "created_by_id" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "created_by_id" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (_q *UserQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := _q.querySpec()
@@ -11,9 +11,15 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/Jiruu246/rms/internal/ent/orderitemchange"
+	"github.com/Jiruu246/rms/internal/ent/orderstatusevent"
+	"github.com/Jiruu246/rms/internal/ent/payment"
 	"github.com/Jiruu246/rms/internal/ent/predicate"
 	"github.com/Jiruu246/rms/internal/ent/refreshtoken"
+	"github.com/Jiruu246/rms/internal/ent/refund"
 	"github.com/Jiruu246/rms/internal/ent/restaurant"
+	"github.com/Jiruu246/rms/internal/ent/stockmovement"
+	"github.com/Jiruu246/rms/internal/ent/tablesession"
 	"github.com/Jiruu246/rms/internal/ent/user"
 	"github.com/Jiruu246/rms/internal/ent/userauthprovider"
 	"github.com/google/uuid"
@@ -179,6 +185,96 @@ func (_u *UserUpdate) AddRefreshTokens(v ...*RefreshToken) *UserUpdate {
 	return _u.AddRefreshTokenIDs(ids...)
 }
 
+// AddOrderStatusEventIDs adds the "order_status_events" edge to the OrderStatusEvent entity by IDs.
+func (_u *UserUpdate) AddOrderStatusEventIDs(ids ...uuid.UUID) *UserUpdate {
+	_u.mutation.AddOrderStatusEventIDs(ids...)
+	return _u
+}
+
+// AddOrderStatusEvents adds the "order_status_events" edges to the OrderStatusEvent entity.
+func (_u *UserUpdate) AddOrderStatusEvents(v ...*OrderStatusEvent) *UserUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddOrderStatusEventIDs(ids...)
+}
+
+// AddOrderItemChangeIDs adds the "order_item_changes" edge to the OrderItemChange entity by IDs.
+func (_u *UserUpdate) AddOrderItemChangeIDs(ids ...uuid.UUID) *UserUpdate {
+	_u.mutation.AddOrderItemChangeIDs(ids...)
+	return _u
+}
+
+// AddOrderItemChanges adds the "order_item_changes" edges to the OrderItemChange entity.
+func (_u *UserUpdate) AddOrderItemChanges(v ...*OrderItemChange) *UserUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddOrderItemChangeIDs(ids...)
+}
+
+// AddPaymentIDs adds the "payments" edge to the Payment entity by IDs.
+func (_u *UserUpdate) AddPaymentIDs(ids ...uuid.UUID) *UserUpdate {
+	_u.mutation.AddPaymentIDs(ids...)
+	return _u
+}
+
+// AddPayments adds the "payments" edges to the Payment entity.
+func (_u *UserUpdate) AddPayments(v ...*Payment) *UserUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddPaymentIDs(ids...)
+}
+
+// AddRefundIDs adds the "refunds" edge to the Refund entity by IDs.
+func (_u *UserUpdate) AddRefundIDs(ids ...uuid.UUID) *UserUpdate {
+	_u.mutation.AddRefundIDs(ids...)
+	return _u
+}
+
+// AddRefunds adds the "refunds" edges to the Refund entity.
+func (_u *UserUpdate) AddRefunds(v ...*Refund) *UserUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddRefundIDs(ids...)
+}
+
+// AddClosedTableSessionIDs adds the "closed_table_sessions" edge to the TableSession entity by IDs.
+func (_u *UserUpdate) AddClosedTableSessionIDs(ids ...uuid.UUID) *UserUpdate {
+	_u.mutation.AddClosedTableSessionIDs(ids...)
+	return _u
+}
+
+// AddClosedTableSessions adds the "closed_table_sessions" edges to the TableSession entity.
+func (_u *UserUpdate) AddClosedTableSessions(v ...*TableSession) *UserUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddClosedTableSessionIDs(ids...)
+}
+
+// AddStockMovementIDs adds the "stock_movements" edge to the StockMovement entity by IDs.
+func (_u *UserUpdate) AddStockMovementIDs(ids ...uuid.UUID) *UserUpdate {
+	_u.mutation.AddStockMovementIDs(ids...)
+	return _u
+}
+
+// AddStockMovements adds the "stock_movements" edges to the StockMovement entity.
+func (_u *UserUpdate) AddStockMovements(v ...*StockMovement) *UserUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddStockMovementIDs(ids...)
+}
+
 // Mutation returns the UserMutation object of the builder.
 func (_u *UserUpdate) Mutation() *UserMutation {
 	return _u.mutation
@@ -247,6 +343,132 @@ func (_u *UserUpdate) RemoveRefreshTokens(v ...*RefreshToken) *UserUpdate {
 	return _u.RemoveRefreshTokenIDs(ids...)
 }
 
+// ClearOrderStatusEvents clears all "order_status_events" edges to the OrderStatusEvent entity.
+func (_u *UserUpdate) ClearOrderStatusEvents() *UserUpdate {
+	_u.mutation.ClearOrderStatusEvents()
+	return _u
+}
+
+// RemoveOrderStatusEventIDs removes the "order_status_events" edge to OrderStatusEvent entities by IDs.
+func (_u *UserUpdate) RemoveOrderStatusEventIDs(ids ...uuid.UUID) *UserUpdate {
+	_u.mutation.RemoveOrderStatusEventIDs(ids...)
+	return _u
+}
+
+// RemoveOrderStatusEvents removes "order_status_events" edges to OrderStatusEvent entities.
+func (_u *UserUpdate) RemoveOrderStatusEvents(v ...*OrderStatusEvent) *UserUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveOrderStatusEventIDs(ids...)
+}
+
+// ClearOrderItemChanges clears all "order_item_changes" edges to the OrderItemChange entity.
+func (_u *UserUpdate) ClearOrderItemChanges() *UserUpdate {
+	_u.mutation.ClearOrderItemChanges()
+	return _u
+}
+
+// RemoveOrderItemChangeIDs removes the "order_item_changes" edge to OrderItemChange entities by IDs.
+func (_u *UserUpdate) RemoveOrderItemChangeIDs(ids ...uuid.UUID) *UserUpdate {
+	_u.mutation.RemoveOrderItemChangeIDs(ids...)
+	return _u
+}
+
+// RemoveOrderItemChanges removes "order_item_changes" edges to OrderItemChange entities.
+func (_u *UserUpdate) RemoveOrderItemChanges(v ...*OrderItemChange) *UserUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveOrderItemChangeIDs(ids...)
+}
+
+// ClearPayments clears all "payments" edges to the Payment entity.
+func (_u *UserUpdate) ClearPayments() *UserUpdate {
+	_u.mutation.ClearPayments()
+	return _u
+}
+
+// RemovePaymentIDs removes the "payments" edge to Payment entities by IDs.
+func (_u *UserUpdate) RemovePaymentIDs(ids ...uuid.UUID) *UserUpdate {
+	_u.mutation.RemovePaymentIDs(ids...)
+	return _u
+}
+
+// RemovePayments removes "payments" edges to Payment entities.
+func (_u *UserUpdate) RemovePayments(v ...*Payment) *UserUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemovePaymentIDs(ids...)
+}
+
+// ClearRefunds clears all "refunds" edges to the Refund entity.
+func (_u *UserUpdate) ClearRefunds() *UserUpdate {
+	_u.mutation.ClearRefunds()
+	return _u
+}
+
+// RemoveRefundIDs removes the "refunds" edge to Refund entities by IDs.
+func (_u *UserUpdate) RemoveRefundIDs(ids ...uuid.UUID) *UserUpdate {
+	_u.mutation.RemoveRefundIDs(ids...)
+	return _u
+}
+
+// RemoveRefunds removes "refunds" edges to Refund entities.
+func (_u *UserUpdate) RemoveRefunds(v ...*Refund) *UserUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveRefundIDs(ids...)
+}
+
+// ClearClosedTableSessions clears all "closed_table_sessions" edges to the TableSession entity.
+func (_u *UserUpdate) ClearClosedTableSessions() *UserUpdate {
+	_u.mutation.ClearClosedTableSessions()
+	return _u
+}
+
+// RemoveClosedTableSessionIDs removes the "closed_table_sessions" edge to TableSession entities by IDs.
+func (_u *UserUpdate) RemoveClosedTableSessionIDs(ids ...uuid.UUID) *UserUpdate {
+	_u.mutation.RemoveClosedTableSessionIDs(ids...)
+	return _u
+}
+
+// RemoveClosedTableSessions removes "closed_table_sessions" edges to TableSession entities.
+func (_u *UserUpdate) RemoveClosedTableSessions(v ...*TableSession) *UserUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveClosedTableSessionIDs(ids...)
+}
+
+// ClearStockMovements clears all "stock_movements" edges to the StockMovement entity.
+func (_u *UserUpdate) ClearStockMovements() *UserUpdate {
+	_u.mutation.ClearStockMovements()
+	return _u
+}
+
+// RemoveStockMovementIDs removes the "stock_movements" edge to StockMovement entities by IDs.
+func (_u *UserUpdate) RemoveStockMovementIDs(ids ...uuid.UUID) *UserUpdate {
+	_u.mutation.RemoveStockMovementIDs(ids...)
+	return _u
+}
+
+// RemoveStockMovements removes "stock_movements" edges to StockMovement entities.
+func (_u *UserUpdate) RemoveStockMovements(v ...*StockMovement) *UserUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveStockMovementIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (_u *UserUpdate) Save(ctx context.Context) (int, error) {
 	_u.defaults()
@@ -467,6 +689,276 @@ func (_u *UserUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.OrderStatusEventsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.OrderStatusEventsTable,
+			Columns: []string{user.OrderStatusEventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(orderstatusevent.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedOrderStatusEventsIDs(); len(nodes) > 0 && !_u.mutation.OrderStatusEventsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.OrderStatusEventsTable,
+			Columns: []string{user.OrderStatusEventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(orderstatusevent.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.OrderStatusEventsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.OrderStatusEventsTable,
+			Columns: []string{user.OrderStatusEventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(orderstatusevent.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.OrderItemChangesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.OrderItemChangesTable,
+			Columns: []string{user.OrderItemChangesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(orderitemchange.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedOrderItemChangesIDs(); len(nodes) > 0 && !_u.mutation.OrderItemChangesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.OrderItemChangesTable,
+			Columns: []string{user.OrderItemChangesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(orderitemchange.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.OrderItemChangesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.OrderItemChangesTable,
+			Columns: []string{user.OrderItemChangesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(orderitemchange.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.PaymentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.PaymentsTable,
+			Columns: []string{user.PaymentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(payment.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedPaymentsIDs(); len(nodes) > 0 && !_u.mutation.PaymentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.PaymentsTable,
+			Columns: []string{user.PaymentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(payment.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.PaymentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.PaymentsTable,
+			Columns: []string{user.PaymentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(payment.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.RefundsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.RefundsTable,
+			Columns: []string{user.RefundsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(refund.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedRefundsIDs(); len(nodes) > 0 && !_u.mutation.RefundsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.RefundsTable,
+			Columns: []string{user.RefundsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(refund.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RefundsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.RefundsTable,
+			Columns: []string{user.RefundsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(refund.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ClosedTableSessionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ClosedTableSessionsTable,
+			Columns: []string{user.ClosedTableSessionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tablesession.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedClosedTableSessionsIDs(); len(nodes) > 0 && !_u.mutation.ClosedTableSessionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ClosedTableSessionsTable,
+			Columns: []string{user.ClosedTableSessionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tablesession.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ClosedTableSessionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ClosedTableSessionsTable,
+			Columns: []string{user.ClosedTableSessionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tablesession.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.StockMovementsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.StockMovementsTable,
+			Columns: []string{user.StockMovementsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(stockmovement.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedStockMovementsIDs(); len(nodes) > 0 && !_u.mutation.StockMovementsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.StockMovementsTable,
+			Columns: []string{user.StockMovementsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(stockmovement.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.StockMovementsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.StockMovementsTable,
+			Columns: []string{user.StockMovementsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(stockmovement.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{user.Label}
@@ -595,111 +1087,327 @@ func (_u *UserUpdateOne) AddRestaurantIDs(ids ...uuid.UUID) *UserUpdateOne {
 	return _u
 }
 
-// AddRestaurants adds the "restaurants" edges to the Restaurant entity.
-func (_u *UserUpdateOne) AddRestaurants(v ...*Restaurant) *UserUpdateOne {
+// AddRestaurants adds the "restaurants" edges to the Restaurant entity.
+func (_u *UserUpdateOne) AddRestaurants(v ...*Restaurant) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddRestaurantIDs(ids...)
+}
+
+// AddAuthProviderIDs adds the "auth_providers" edge to the UserAuthProvider entity by IDs.
+func (_u *UserUpdateOne) AddAuthProviderIDs(ids ...int) *UserUpdateOne {
+	_u.mutation.AddAuthProviderIDs(ids...)
+	return _u
+}
+
+// AddAuthProviders adds the "auth_providers" edges to the UserAuthProvider entity.
+func (_u *UserUpdateOne) AddAuthProviders(v ...*UserAuthProvider) *UserUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddAuthProviderIDs(ids...)
+}
+
+// AddRefreshTokenIDs adds the "refresh_tokens" edge to the RefreshToken entity by IDs.
+func (_u *UserUpdateOne) AddRefreshTokenIDs(ids ...uuid.UUID) *UserUpdateOne {
+	_u.mutation.AddRefreshTokenIDs(ids...)
+	return _u
+}
+
+// AddRefreshTokens adds the "refresh_tokens" edges to the RefreshToken entity.
+func (_u *UserUpdateOne) AddRefreshTokens(v ...*RefreshToken) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddRefreshTokenIDs(ids...)
+}
+
+// AddOrderStatusEventIDs adds the "order_status_events" edge to the OrderStatusEvent entity by IDs.
+func (_u *UserUpdateOne) AddOrderStatusEventIDs(ids ...uuid.UUID) *UserUpdateOne {
+	_u.mutation.AddOrderStatusEventIDs(ids...)
+	return _u
+}
+
+// AddOrderStatusEvents adds the "order_status_events" edges to the OrderStatusEvent entity.
+func (_u *UserUpdateOne) AddOrderStatusEvents(v ...*OrderStatusEvent) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddOrderStatusEventIDs(ids...)
+}
+
+// AddOrderItemChangeIDs adds the "order_item_changes" edge to the OrderItemChange entity by IDs.
+func (_u *UserUpdateOne) AddOrderItemChangeIDs(ids ...uuid.UUID) *UserUpdateOne {
+	_u.mutation.AddOrderItemChangeIDs(ids...)
+	return _u
+}
+
+// AddOrderItemChanges adds the "order_item_changes" edges to the OrderItemChange entity.
+func (_u *UserUpdateOne) AddOrderItemChanges(v ...*OrderItemChange) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddOrderItemChangeIDs(ids...)
+}
+
+// AddPaymentIDs adds the "payments" edge to the Payment entity by IDs.
+func (_u *UserUpdateOne) AddPaymentIDs(ids ...uuid.UUID) *UserUpdateOne {
+	_u.mutation.AddPaymentIDs(ids...)
+	return _u
+}
+
+// AddPayments adds the "payments" edges to the Payment entity.
+func (_u *UserUpdateOne) AddPayments(v ...*Payment) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddPaymentIDs(ids...)
+}
+
+// AddRefundIDs adds the "refunds" edge to the Refund entity by IDs.
+func (_u *UserUpdateOne) AddRefundIDs(ids ...uuid.UUID) *UserUpdateOne {
+	_u.mutation.AddRefundIDs(ids...)
+	return _u
+}
+
+// AddRefunds adds the "refunds" edges to the Refund entity.
+func (_u *UserUpdateOne) AddRefunds(v ...*Refund) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddRefundIDs(ids...)
+}
+
+// AddClosedTableSessionIDs adds the "closed_table_sessions" edge to the TableSession entity by IDs.
+func (_u *UserUpdateOne) AddClosedTableSessionIDs(ids ...uuid.UUID) *UserUpdateOne {
+	_u.mutation.AddClosedTableSessionIDs(ids...)
+	return _u
+}
+
+// AddClosedTableSessions adds the "closed_table_sessions" edges to the TableSession entity.
+func (_u *UserUpdateOne) AddClosedTableSessions(v ...*TableSession) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddClosedTableSessionIDs(ids...)
+}
+
+// AddStockMovementIDs adds the "stock_movements" edge to the StockMovement entity by IDs.
+func (_u *UserUpdateOne) AddStockMovementIDs(ids ...uuid.UUID) *UserUpdateOne {
+	_u.mutation.AddStockMovementIDs(ids...)
+	return _u
+}
+
+// AddStockMovements adds the "stock_movements" edges to the StockMovement entity.
+func (_u *UserUpdateOne) AddStockMovements(v ...*StockMovement) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddStockMovementIDs(ids...)
+}
+
+// Mutation returns the UserMutation object of the builder.
+func (_u *UserUpdateOne) Mutation() *UserMutation {
+	return _u.mutation
+}
+
+// ClearRestaurants clears all "restaurants" edges to the Restaurant entity.
+func (_u *UserUpdateOne) ClearRestaurants() *UserUpdateOne {
+	_u.mutation.ClearRestaurants()
+	return _u
+}
+
+// RemoveRestaurantIDs removes the "restaurants" edge to Restaurant entities by IDs.
+func (_u *UserUpdateOne) RemoveRestaurantIDs(ids ...uuid.UUID) *UserUpdateOne {
+	_u.mutation.RemoveRestaurantIDs(ids...)
+	return _u
+}
+
+// RemoveRestaurants removes "restaurants" edges to Restaurant entities.
+func (_u *UserUpdateOne) RemoveRestaurants(v ...*Restaurant) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveRestaurantIDs(ids...)
+}
+
+// ClearAuthProviders clears all "auth_providers" edges to the UserAuthProvider entity.
+func (_u *UserUpdateOne) ClearAuthProviders() *UserUpdateOne {
+	_u.mutation.ClearAuthProviders()
+	return _u
+}
+
+// RemoveAuthProviderIDs removes the "auth_providers" edge to UserAuthProvider entities by IDs.
+func (_u *UserUpdateOne) RemoveAuthProviderIDs(ids ...int) *UserUpdateOne {
+	_u.mutation.RemoveAuthProviderIDs(ids...)
+	return _u
+}
+
+// RemoveAuthProviders removes "auth_providers" edges to UserAuthProvider entities.
+func (_u *UserUpdateOne) RemoveAuthProviders(v ...*UserAuthProvider) *UserUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveAuthProviderIDs(ids...)
+}
+
+// ClearRefreshTokens clears all "refresh_tokens" edges to the RefreshToken entity.
+func (_u *UserUpdateOne) ClearRefreshTokens() *UserUpdateOne {
+	_u.mutation.ClearRefreshTokens()
+	return _u
+}
+
+// RemoveRefreshTokenIDs removes the "refresh_tokens" edge to RefreshToken entities by IDs.
+func (_u *UserUpdateOne) RemoveRefreshTokenIDs(ids ...uuid.UUID) *UserUpdateOne {
+	_u.mutation.RemoveRefreshTokenIDs(ids...)
+	return _u
+}
+
+// RemoveRefreshTokens removes "refresh_tokens" edges to RefreshToken entities.
+func (_u *UserUpdateOne) RemoveRefreshTokens(v ...*RefreshToken) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveRefreshTokenIDs(ids...)
+}
+
+// ClearOrderStatusEvents clears all "order_status_events" edges to the OrderStatusEvent entity.
+func (_u *UserUpdateOne) ClearOrderStatusEvents() *UserUpdateOne {
+	_u.mutation.ClearOrderStatusEvents()
+	return _u
+}
+
+// RemoveOrderStatusEventIDs removes the "order_status_events" edge to OrderStatusEvent entities by IDs.
+func (_u *UserUpdateOne) RemoveOrderStatusEventIDs(ids ...uuid.UUID) *UserUpdateOne {
+	_u.mutation.RemoveOrderStatusEventIDs(ids...)
+	return _u
+}
+
+// RemoveOrderStatusEvents removes "order_status_events" edges to OrderStatusEvent entities.
+func (_u *UserUpdateOne) RemoveOrderStatusEvents(v ...*OrderStatusEvent) *UserUpdateOne {
 	ids := make([]uuid.UUID, len(v))
 	for i := range v {
 		ids[i] = v[i].ID
 	}
-	return _u.AddRestaurantIDs(ids...)
+	return _u.RemoveOrderStatusEventIDs(ids...)
 }
 
-// AddAuthProviderIDs adds the "auth_providers" edge to the UserAuthProvider entity by IDs.
-func (_u *UserUpdateOne) AddAuthProviderIDs(ids ...int) *UserUpdateOne {
-	_u.mutation.AddAuthProviderIDs(ids...)
+// ClearOrderItemChanges clears all "order_item_changes" edges to the OrderItemChange entity.
+func (_u *UserUpdateOne) ClearOrderItemChanges() *UserUpdateOne {
+	_u.mutation.ClearOrderItemChanges()
 	return _u
 }
 
-// AddAuthProviders adds the "auth_providers" edges to the UserAuthProvider entity.
-func (_u *UserUpdateOne) AddAuthProviders(v ...*UserAuthProvider) *UserUpdateOne {
-	ids := make([]int, len(v))
+// RemoveOrderItemChangeIDs removes the "order_item_changes" edge to OrderItemChange entities by IDs.
+func (_u *UserUpdateOne) RemoveOrderItemChangeIDs(ids ...uuid.UUID) *UserUpdateOne {
+	_u.mutation.RemoveOrderItemChangeIDs(ids...)
+	return _u
+}
+
+// RemoveOrderItemChanges removes "order_item_changes" edges to OrderItemChange entities.
+func (_u *UserUpdateOne) RemoveOrderItemChanges(v ...*OrderItemChange) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(v))
 	for i := range v {
 		ids[i] = v[i].ID
 	}
-	return _u.AddAuthProviderIDs(ids...)
+	return _u.RemoveOrderItemChangeIDs(ids...)
 }
 
-// AddRefreshTokenIDs adds the "refresh_tokens" edge to the RefreshToken entity by IDs.
-func (_u *UserUpdateOne) AddRefreshTokenIDs(ids ...uuid.UUID) *UserUpdateOne {
-	_u.mutation.AddRefreshTokenIDs(ids...)
+// ClearPayments clears all "payments" edges to the Payment entity.
+func (_u *UserUpdateOne) ClearPayments() *UserUpdateOne {
+	_u.mutation.ClearPayments()
 	return _u
 }
 
-// AddRefreshTokens adds the "refresh_tokens" edges to the RefreshToken entity.
-func (_u *UserUpdateOne) AddRefreshTokens(v ...*RefreshToken) *UserUpdateOne {
+// RemovePaymentIDs removes the "payments" edge to Payment entities by IDs.
+func (_u *UserUpdateOne) RemovePaymentIDs(ids ...uuid.UUID) *UserUpdateOne {
+	_u.mutation.RemovePaymentIDs(ids...)
+	return _u
+}
+
+// RemovePayments removes "payments" edges to Payment entities.
+func (_u *UserUpdateOne) RemovePayments(v ...*Payment) *UserUpdateOne {
 	ids := make([]uuid.UUID, len(v))
 	for i := range v {
 		ids[i] = v[i].ID
 	}
-	return _u.AddRefreshTokenIDs(ids...)
+	return _u.RemovePaymentIDs(ids...)
 }
 
-// Mutation returns the UserMutation object of the builder.
-func (_u *UserUpdateOne) Mutation() *UserMutation {
-	return _u.mutation
-}
-
-// ClearRestaurants clears all "restaurants" edges to the Restaurant entity.
-func (_u *UserUpdateOne) ClearRestaurants() *UserUpdateOne {
-	_u.mutation.ClearRestaurants()
+// ClearRefunds clears all "refunds" edges to the Refund entity.
+func (_u *UserUpdateOne) ClearRefunds() *UserUpdateOne {
+	_u.mutation.ClearRefunds()
 	return _u
 }
 
-// RemoveRestaurantIDs removes the "restaurants" edge to Restaurant entities by IDs.
-func (_u *UserUpdateOne) RemoveRestaurantIDs(ids ...uuid.UUID) *UserUpdateOne {
-	_u.mutation.RemoveRestaurantIDs(ids...)
+// RemoveRefundIDs removes the "refunds" edge to Refund entities by IDs.
+func (_u *UserUpdateOne) RemoveRefundIDs(ids ...uuid.UUID) *UserUpdateOne {
+	_u.mutation.RemoveRefundIDs(ids...)
 	return _u
 }
 
-// RemoveRestaurants removes "restaurants" edges to Restaurant entities.
-func (_u *UserUpdateOne) RemoveRestaurants(v ...*Restaurant) *UserUpdateOne {
+// RemoveRefunds removes "refunds" edges to Refund entities.
+func (_u *UserUpdateOne) RemoveRefunds(v ...*Refund) *UserUpdateOne {
 	ids := make([]uuid.UUID, len(v))
 	for i := range v {
 		ids[i] = v[i].ID
 	}
-	return _u.RemoveRestaurantIDs(ids...)
+	return _u.RemoveRefundIDs(ids...)
 }
 
-// ClearAuthProviders clears all "auth_providers" edges to the UserAuthProvider entity.
-func (_u *UserUpdateOne) ClearAuthProviders() *UserUpdateOne {
-	_u.mutation.ClearAuthProviders()
+// ClearClosedTableSessions clears all "closed_table_sessions" edges to the TableSession entity.
+func (_u *UserUpdateOne) ClearClosedTableSessions() *UserUpdateOne {
+	_u.mutation.ClearClosedTableSessions()
 	return _u
 }
 
-// RemoveAuthProviderIDs removes the "auth_providers" edge to UserAuthProvider entities by IDs.
-func (_u *UserUpdateOne) RemoveAuthProviderIDs(ids ...int) *UserUpdateOne {
-	_u.mutation.RemoveAuthProviderIDs(ids...)
+// RemoveClosedTableSessionIDs removes the "closed_table_sessions" edge to TableSession entities by IDs.
+func (_u *UserUpdateOne) RemoveClosedTableSessionIDs(ids ...uuid.UUID) *UserUpdateOne {
+	_u.mutation.RemoveClosedTableSessionIDs(ids...)
 	return _u
 }
 
-// RemoveAuthProviders removes "auth_providers" edges to UserAuthProvider entities.
-func (_u *UserUpdateOne) RemoveAuthProviders(v ...*UserAuthProvider) *UserUpdateOne {
-	ids := make([]int, len(v))
+// RemoveClosedTableSessions removes "closed_table_sessions" edges to TableSession entities.
+func (_u *UserUpdateOne) RemoveClosedTableSessions(v ...*TableSession) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(v))
 	for i := range v {
 		ids[i] = v[i].ID
 	}
-	return _u.RemoveAuthProviderIDs(ids...)
+	return _u.RemoveClosedTableSessionIDs(ids...)
 }
 
-// ClearRefreshTokens clears all "refresh_tokens" edges to the RefreshToken entity.
-func (_u *UserUpdateOne) ClearRefreshTokens() *UserUpdateOne {
-	_u.mutation.ClearRefreshTokens()
+// ClearStockMovements clears all "stock_movements" edges to the StockMovement entity.
+func (_u *UserUpdateOne) ClearStockMovements() *UserUpdateOne {
+	_u.mutation.ClearStockMovements()
 	return _u
 }
 
-// RemoveRefreshTokenIDs removes the "refresh_tokens" edge to RefreshToken entities by IDs.
-func (_u *UserUpdateOne) RemoveRefreshTokenIDs(ids ...uuid.UUID) *UserUpdateOne {
-	_u.mutation.RemoveRefreshTokenIDs(ids...)
+// RemoveStockMovementIDs removes the "stock_movements" edge to StockMovement entities by IDs.
+func (_u *UserUpdateOne) RemoveStockMovementIDs(ids ...uuid.UUID) *UserUpdateOne {
+	_u.mutation.RemoveStockMovementIDs(ids...)
 	return _u
 }
 
-// RemoveRefreshTokens removes "refresh_tokens" edges to RefreshToken entities.
-func (_u *UserUpdateOne) RemoveRefreshTokens(v ...*RefreshToken) *UserUpdateOne {
+// RemoveStockMovements removes "stock_movements" edges to StockMovement entities.
+func (_u *UserUpdateOne) RemoveStockMovements(v ...*StockMovement) *UserUpdateOne {
 	ids := make([]uuid.UUID, len(v))
 	for i := range v {
 		ids[i] = v[i].ID
 	}
-	return _u.RemoveRefreshTokenIDs(ids...)
+	return _u.RemoveStockMovementIDs(ids...)
 }
 
 // Where appends a list predicates to the UserUpdate builder.
@@ -952,6 +1660,276 @@ func (_u *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.OrderStatusEventsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.OrderStatusEventsTable,
+			Columns: []string{user.OrderStatusEventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(orderstatusevent.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedOrderStatusEventsIDs(); len(nodes) > 0 && !_u.mutation.OrderStatusEventsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.OrderStatusEventsTable,
+			Columns: []string{user.OrderStatusEventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(orderstatusevent.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.OrderStatusEventsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.OrderStatusEventsTable,
+			Columns: []string{user.OrderStatusEventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(orderstatusevent.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.OrderItemChangesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.OrderItemChangesTable,
+			Columns: []string{user.OrderItemChangesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(orderitemchange.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedOrderItemChangesIDs(); len(nodes) > 0 && !_u.mutation.OrderItemChangesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.OrderItemChangesTable,
+			Columns: []string{user.OrderItemChangesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(orderitemchange.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.OrderItemChangesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.OrderItemChangesTable,
+			Columns: []string{user.OrderItemChangesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(orderitemchange.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.PaymentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.PaymentsTable,
+			Columns: []string{user.PaymentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(payment.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedPaymentsIDs(); len(nodes) > 0 && !_u.mutation.PaymentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.PaymentsTable,
+			Columns: []string{user.PaymentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(payment.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.PaymentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.PaymentsTable,
+			Columns: []string{user.PaymentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(payment.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.RefundsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.RefundsTable,
+			Columns: []string{user.RefundsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(refund.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedRefundsIDs(); len(nodes) > 0 && !_u.mutation.RefundsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.RefundsTable,
+			Columns: []string{user.RefundsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(refund.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RefundsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.RefundsTable,
+			Columns: []string{user.RefundsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(refund.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ClosedTableSessionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ClosedTableSessionsTable,
+			Columns: []string{user.ClosedTableSessionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tablesession.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedClosedTableSessionsIDs(); len(nodes) > 0 && !_u.mutation.ClosedTableSessionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ClosedTableSessionsTable,
+			Columns: []string{user.ClosedTableSessionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tablesession.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ClosedTableSessionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ClosedTableSessionsTable,
+			Columns: []string{user.ClosedTableSessionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tablesession.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.StockMovementsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.StockMovementsTable,
+			Columns: []string{user.StockMovementsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(stockmovement.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedStockMovementsIDs(); len(nodes) > 0 && !_u.mutation.StockMovementsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.StockMovementsTable,
+			Columns: []string{user.StockMovementsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(stockmovement.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.StockMovementsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.StockMovementsTable,
+			Columns: []string{user.StockMovementsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(stockmovement.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &User{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
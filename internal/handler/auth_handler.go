@@ -66,7 +66,7 @@ func (h *AuthHandler) Register(c *gin.Context) {
 // Login handles POST /api/auth/login
 //
 //	@Summary		Log in
-//	@Description	Authenticates a user and returns an access token; sets a refresh token cookie
+//	@Description	Authenticates a user and returns an access token; sets a refresh token cookie. Attempts are rate limited per client IP and per email; over the limit the response is 429 with Retry-After.
 //	@Tags			auth
 //	@Accept			json
 //	@Produce		json
@@ -74,6 +74,7 @@ func (h *AuthHandler) Register(c *gin.Context) {
 //	@Success		200		{object}	utils.APIResponse[dto.AccessToken]
 //	@Failure		400		{object}	utils.APIResponse[any]
 //	@Failure		401		{object}	utils.APIResponse[any]
+//	@Failure		429		{object}	utils.APIResponse[any]
 //	@Router			/auth/login [post]
 func (h *AuthHandler) Login(c *gin.Context) {
 	var req dto.LoginUserRequest
@@ -0,0 +1,135 @@
+package handler
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/Jiruu246/rms/internal/apperr"
+	"github.com/Jiruu246/rms/internal/authz"
+	"github.com/Jiruu246/rms/internal/dto"
+	"github.com/Jiruu246/rms/internal/services"
+	"github.com/Jiruu246/rms/pkg/utils"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// maxCatalogueBytes caps the size of an uploaded catalogue file.
+const maxCatalogueBytes = 10 << 20
+
+type CatalogueHandler struct {
+	service services.CatalogueService
+}
+
+func NewCatalogueHandler(service services.CatalogueService) *CatalogueHandler {
+	return &CatalogueHandler{service: service}
+}
+
+// ExportCatalogue handles GET /api/restaurants/{id}/catalogue?format=json|csv
+//
+//	@Summary		Export a restaurant's catalogue
+//	@Description	Downloads the restaurant's categories, modifiers, options and menu items as a catalogue file that can be edited and imported again. The file is the bare catalogue, not wrapped in the usual response envelope.
+//	@Tags			catalogue
+//	@Produce		json
+//	@Produce		text/csv
+//	@Security		BearerAuth
+//	@Param			id		path		string	true	"Restaurant ID"	format(uuid)
+//	@Param			format	query		string	false	"File format"	Enums(json, csv)	default(json)
+//	@Success		200		{object}	dto.Catalogue
+//	@Failure		400		{object}	utils.APIResponse[any]
+//	@Failure		404		{object}	utils.APIResponse[any]
+//	@Failure		500		{object}	utils.APIResponse[any]
+//	@Router			/restaurants/{id}/catalogue [get]
+func (h *CatalogueHandler) ExportCatalogue(c *gin.Context) {
+	claims := c.MustGet("claims").(utils.JWTClaims)
+	restaurantID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		utils.WriteBadRequest(c.Writer, "Invalid restaurant ID format")
+		return
+	}
+	format := c.DefaultQuery("format", "json")
+	if format != "json" && format != "csv" {
+		utils.WriteBadRequest(c.Writer, "format must be json or csv")
+		return
+	}
+
+	catalogue, err := h.service.Export(c.Request.Context(), authz.NewActorFromClaims(claims), restaurantID)
+	if err != nil {
+		apperr.WriteHTTPError(c.Writer, err, "Failed to export catalogue")
+		return
+	}
+
+	c.Header("Content-Disposition", fmt.Sprintf(`attachment; filename="catalogue-%s.%s"`, restaurantID, format))
+	if format == "csv" {
+		c.Header("Content-Type", "text/csv; charset=utf-8")
+		c.Status(http.StatusOK)
+		if err := services.WriteCatalogueCSV(c.Writer, catalogue); err != nil {
+			_ = c.Error(err)
+		}
+		return
+	}
+	body, err := json.MarshalIndent(catalogue, "", "  ")
+	if err != nil {
+		utils.WriteInternalError(c.Writer, "Failed to export catalogue")
+		return
+	}
+	c.Data(http.StatusOK, "application/json; charset=utf-8", append(body, '\n'))
+}
+
+// ImportCatalogue handles POST /api/restaurants/{id}/catalogue?dry_run=true
+//
+//	@Summary		Import a catalogue
+//	@Description	Upserts a catalogue file into the restaurant in one transaction: entries with an id update that row, others are matched by name or created. Rows not in the file are left alone. Send JSON, or CSV with Content-Type text/csv. With dry_run nothing is saved, and the report says what would change.
+//	@Tags			catalogue
+//	@Accept			json
+//	@Accept			text/csv
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			id		path		string			true	"Restaurant ID"	format(uuid)
+//	@Param			dry_run	query		bool			false	"Only report what would change"
+//	@Param			request	body		dto.Catalogue	true	"Catalogue"
+//	@Success		200		{object}	utils.APIResponse[dto.CatalogueImportReport]
+//	@Failure		400		{object}	utils.APIResponse[any]
+//	@Failure		404		{object}	utils.APIResponse[any]
+//	@Failure		500		{object}	utils.APIResponse[any]
+//	@Router			/restaurants/{id}/catalogue [post]
+func (h *CatalogueHandler) ImportCatalogue(c *gin.Context) {
+	claims := c.MustGet("claims").(utils.JWTClaims)
+	restaurantID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		utils.WriteBadRequest(c.Writer, "Invalid restaurant ID format")
+		return
+	}
+	dryRun := false
+	if v := c.Query("dry_run"); v != "" {
+		dryRun, err = strconv.ParseBool(v)
+		if err != nil {
+			utils.WriteBadRequest(c.Writer, "Invalid dry_run value")
+			return
+		}
+	}
+
+	c.Request.Body = http.MaxBytesReader(c.Writer, c.Request.Body, maxCatalogueBytes)
+	var catalogue *dto.Catalogue
+	if c.ContentType() == "text/csv" {
+		catalogue, err = services.ReadCatalogueCSV(c.Request.Body)
+		if err != nil {
+			apperr.WriteHTTPError(c.Writer, err, "Failed to read catalogue")
+			return
+		}
+	} else {
+		catalogue = &dto.Catalogue{}
+		if err := utils.ParseAndValidateRequest(c, catalogue); err != nil {
+			utils.WriteBadRequest(c.Writer, err.Error())
+			return
+		}
+	}
+
+	report, err := h.service.Import(c.Request.Context(), authz.NewActorFromClaims(claims), restaurantID, catalogue, dryRun)
+	if err != nil {
+		apperr.WriteHTTPError(c.Writer, err, "Failed to import catalogue")
+		return
+	}
+	utils.WriteSuccess(c.Writer, report)
+}
@@ -0,0 +1,169 @@
+package handler
+
+import (
+	"github.com/Jiruu246/rms/internal/apperr"
+	"github.com/Jiruu246/rms/internal/authz"
+	"github.com/Jiruu246/rms/internal/dto"
+	"github.com/Jiruu246/rms/internal/services"
+	"github.com/Jiruu246/rms/pkg/utils"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+type DeliveryZoneHandler struct {
+	service services.DeliveryZoneService
+}
+
+func NewDeliveryZoneHandler(service services.DeliveryZoneService) *DeliveryZoneHandler {
+	return &DeliveryZoneHandler{service: service}
+}
+
+// CreateDeliveryZone handles POST /api/delivery-zones
+//
+//	@Summary		Create a delivery zone
+//	@Description	RADIUS zones need radius_meters and are centred on the restaurant's latitude/longitude; POLYGON zones need a polygon of at least 3 points.
+//	@Tags			delivery-zones
+//	@Accept			json
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			request	body		dto.CreateDeliveryZoneRequest	true	"Zone details"
+//	@Success		201		{object}	utils.APIResponse[dto.DeliveryZone]
+//	@Failure		400		{object}	utils.APIResponse[any]
+//	@Failure		404		{object}	utils.APIResponse[any]
+//	@Failure		409		{object}	utils.APIResponse[any]
+//	@Failure		500		{object}	utils.APIResponse[any]
+//	@Router			/delivery-zones [post]
+func (h *DeliveryZoneHandler) CreateDeliveryZone(c *gin.Context) {
+	claims := c.MustGet("claims").(utils.JWTClaims)
+	var req dto.CreateDeliveryZoneRequest
+	if err := utils.ParseAndValidateRequest(c, &req); err != nil {
+		utils.WriteBadRequest(c.Writer, err.Error())
+		return
+	}
+	created, err := h.service.Create(c.Request.Context(), authz.NewActorFromClaims(claims), &req)
+	if err != nil {
+		apperr.WriteHTTPError(c.Writer, err, "Failed to create delivery zone")
+		return
+	}
+	utils.WriteCreated(c.Writer, created)
+}
+
+// GetDeliveryZones handles GET /api/delivery-zones?restaurant_id=xxx
+//
+//	@Summary		List a restaurant's delivery zones
+//	@Tags			delivery-zones
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			restaurant_id	query		string	true	"Restaurant ID"	format(uuid)
+//	@Success		200				{object}	utils.APIResponse[[]dto.DeliveryZone]
+//	@Failure		400				{object}	utils.APIResponse[any]
+//	@Failure		404				{object}	utils.APIResponse[any]
+//	@Failure		500				{object}	utils.APIResponse[any]
+//	@Router			/delivery-zones [get]
+func (h *DeliveryZoneHandler) GetDeliveryZones(c *gin.Context) {
+	claims := c.MustGet("claims").(utils.JWTClaims)
+	restaurantIDStr := c.Query("restaurant_id")
+	if restaurantIDStr == "" {
+		utils.WriteBadRequest(c.Writer, "restaurant_id is required")
+		return
+	}
+	restaurantID, err := uuid.Parse(restaurantIDStr)
+	if err != nil {
+		utils.WriteBadRequest(c.Writer, "Invalid restaurant_id format")
+		return
+	}
+	zones, err := h.service.GetAllByRestaurant(c.Request.Context(), authz.NewActorFromClaims(claims), restaurantID)
+	if err != nil {
+		apperr.WriteHTTPError(c.Writer, err, "Failed to retrieve delivery zones")
+		return
+	}
+	utils.WriteSuccess(c.Writer, zones)
+}
+
+// GetDeliveryZone handles GET /api/delivery-zones/{id}
+//
+//	@Summary		Get a delivery zone by ID
+//	@Tags			delivery-zones
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			id	path		string	true	"Delivery zone ID"	format(uuid)
+//	@Success		200	{object}	utils.APIResponse[dto.DeliveryZone]
+//	@Failure		400	{object}	utils.APIResponse[any]
+//	@Failure		404	{object}	utils.APIResponse[any]
+//	@Failure		500	{object}	utils.APIResponse[any]
+//	@Router			/delivery-zones/{id} [get]
+func (h *DeliveryZoneHandler) GetDeliveryZone(c *gin.Context) {
+	claims := c.MustGet("claims").(utils.JWTClaims)
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		utils.WriteBadRequest(c.Writer, "Invalid delivery zone ID format")
+		return
+	}
+	zone, err := h.service.GetByID(c.Request.Context(), authz.NewActorFromClaims(claims), id)
+	if err != nil {
+		apperr.WriteHTTPError(c.Writer, err, "Failed to retrieve delivery zone")
+		return
+	}
+	utils.WriteSuccess(c.Writer, zone)
+}
+
+// UpdateDeliveryZone handles PATCH /api/delivery-zones/{id}
+//
+//	@Summary		Update a delivery zone
+//	@Description	Changing kind drops the old shape, so send the new radius_meters or polygon along with it.
+//	@Tags			delivery-zones
+//	@Accept			json
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			id		path		string							true	"Delivery zone ID"	format(uuid)
+//	@Param			request	body		dto.UpdateDeliveryZoneRequest	true	"Fields to update"
+//	@Success		200		{object}	utils.APIResponse[dto.DeliveryZone]
+//	@Failure		400		{object}	utils.APIResponse[any]
+//	@Failure		404		{object}	utils.APIResponse[any]
+//	@Failure		409		{object}	utils.APIResponse[any]
+//	@Failure		500		{object}	utils.APIResponse[any]
+//	@Router			/delivery-zones/{id} [patch]
+func (h *DeliveryZoneHandler) UpdateDeliveryZone(c *gin.Context) {
+	claims := c.MustGet("claims").(utils.JWTClaims)
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		utils.WriteBadRequest(c.Writer, "Invalid delivery zone ID format")
+		return
+	}
+	var req dto.UpdateDeliveryZoneRequest
+	if err := utils.ParseAndValidateRequest(c, &req); err != nil {
+		utils.WriteBadRequest(c.Writer, err.Error())
+		return
+	}
+	updated, err := h.service.Update(c.Request.Context(), authz.NewActorFromClaims(claims), id, &req)
+	if err != nil {
+		apperr.WriteHTTPError(c.Writer, err, "Failed to update delivery zone")
+		return
+	}
+	utils.WriteSuccess(c.Writer, updated)
+}
+
+// DeleteDeliveryZone handles DELETE /api/delivery-zones/{id}
+//
+//	@Summary		Delete a delivery zone
+//	@Tags			delivery-zones
+//	@Security		BearerAuth
+//	@Param			id	path	string	true	"Delivery zone ID"	format(uuid)
+//	@Success		204	"No Content"
+//	@Failure		400	{object}	utils.APIResponse[any]
+//	@Failure		404	{object}	utils.APIResponse[any]
+//	@Failure		500	{object}	utils.APIResponse[any]
+//	@Router			/delivery-zones/{id} [delete]
+func (h *DeliveryZoneHandler) DeleteDeliveryZone(c *gin.Context) {
+	claims := c.MustGet("claims").(utils.JWTClaims)
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		utils.WriteBadRequest(c.Writer, "Invalid delivery zone ID format")
+		return
+	}
+	if err := h.service.Delete(c.Request.Context(), authz.NewActorFromClaims(claims), id); err != nil {
+		apperr.WriteHTTPError(c.Writer, err, "Failed to delete delivery zone")
+		return
+	}
+	utils.WriteNoContent(c.Writer)
+}
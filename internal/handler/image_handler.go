@@ -0,0 +1,262 @@
+package handler
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+
+	"github.com/Jiruu246/rms/internal/apperr"
+	"github.com/Jiruu246/rms/internal/authz"
+	_ "github.com/Jiruu246/rms/internal/dto" // response types in the swag annotations
+	"github.com/Jiruu246/rms/internal/services"
+	"github.com/Jiruu246/rms/pkg/utils"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// imageFormField is the multipart form field an uploaded image is sent in.
+const imageFormField = "image"
+
+// multipartOverhead is room in an upload's body limit for the multipart
+// boundaries and headers around the image.
+const multipartOverhead = 64 << 10
+
+type ImageHandler struct {
+	service  services.ImageService
+	maxBytes int64
+}
+
+// NewImageHandler returns an ImageHandler accepting images of up to
+// maxBytes bytes.
+func NewImageHandler(service services.ImageService, maxBytes int64) *ImageHandler {
+	return &ImageHandler{service: service, maxBytes: maxBytes}
+}
+
+// SetMenuItemImage handles PUT /api/menu-items/{id}/image
+//
+//	@Summary		Upload a menu item's image
+//	@Description	Stores a JPEG, PNG or GIF image for the menu item along with a thumbnail, and sets its image_url and thumbnail_url. Any previous image is deleted.
+//	@Tags			images
+//	@Accept			multipart/form-data
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			id		path		int		true	"Menu item ID"
+//	@Param			image	formData	file	true	"Image file"
+//	@Success		200		{object}	utils.APIResponse[dto.MenuItem]
+//	@Failure		400		{object}	utils.APIResponse[any]
+//	@Failure		404		{object}	utils.APIResponse[any]
+//	@Failure		500		{object}	utils.APIResponse[any]
+//	@Router			/menu-items/{id}/image [put]
+func (h *ImageHandler) SetMenuItemImage(c *gin.Context) {
+	claims := c.MustGet("claims").(utils.JWTClaims)
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		utils.WriteBadRequest(c.Writer, "Invalid menu item ID format")
+		return
+	}
+	data, ok := h.readImage(c)
+	if !ok {
+		return
+	}
+
+	item, err := h.service.SetMenuItemImage(c.Request.Context(), authz.NewActorFromClaims(claims), id, data)
+	if err != nil {
+		apperr.WriteHTTPError(c.Writer, err, "Failed to upload image")
+		return
+	}
+	utils.WriteSuccess(c.Writer, item)
+}
+
+// DeleteMenuItemImage handles DELETE /api/menu-items/{id}/image
+//
+//	@Summary		Remove a menu item's image
+//	@Description	Deletes the menu item's uploaded image and thumbnail, and clears its image_url and thumbnail_url.
+//	@Tags			images
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			id	path		int	true	"Menu item ID"
+//	@Success		200	{object}	utils.APIResponse[dto.MenuItem]
+//	@Failure		400	{object}	utils.APIResponse[any]
+//	@Failure		404	{object}	utils.APIResponse[any]
+//	@Failure		500	{object}	utils.APIResponse[any]
+//	@Router			/menu-items/{id}/image [delete]
+func (h *ImageHandler) DeleteMenuItemImage(c *gin.Context) {
+	claims := c.MustGet("claims").(utils.JWTClaims)
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		utils.WriteBadRequest(c.Writer, "Invalid menu item ID format")
+		return
+	}
+
+	item, err := h.service.DeleteMenuItemImage(c.Request.Context(), authz.NewActorFromClaims(claims), id)
+	if err != nil {
+		apperr.WriteHTTPError(c.Writer, err, "Failed to remove image")
+		return
+	}
+	utils.WriteSuccess(c.Writer, item)
+}
+
+// SetModifierOptionImage handles PUT /api/modifiers/options/{id}/image
+//
+//	@Summary		Upload a modifier option's image
+//	@Description	Stores a JPEG, PNG or GIF image for the modifier option along with a thumbnail, and sets its image_url and thumbnail_url. Any previous image is deleted.
+//	@Tags			images
+//	@Accept			multipart/form-data
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			id		path		string	true	"Modifier option ID"	format(uuid)
+//	@Param			image	formData	file	true	"Image file"
+//	@Success		200		{object}	utils.APIResponse[dto.ModifierOption]
+//	@Failure		400		{object}	utils.APIResponse[any]
+//	@Failure		404		{object}	utils.APIResponse[any]
+//	@Failure		500		{object}	utils.APIResponse[any]
+//	@Router			/modifiers/options/{id}/image [put]
+func (h *ImageHandler) SetModifierOptionImage(c *gin.Context) {
+	claims := c.MustGet("claims").(utils.JWTClaims)
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		utils.WriteBadRequest(c.Writer, "Invalid modifier option ID format")
+		return
+	}
+	data, ok := h.readImage(c)
+	if !ok {
+		return
+	}
+
+	option, err := h.service.SetModifierOptionImage(c.Request.Context(), authz.NewActorFromClaims(claims), id, data)
+	if err != nil {
+		apperr.WriteHTTPError(c.Writer, err, "Failed to upload image")
+		return
+	}
+	utils.WriteSuccess(c.Writer, option)
+}
+
+// DeleteModifierOptionImage handles DELETE /api/modifiers/options/{id}/image
+//
+//	@Summary		Remove a modifier option's image
+//	@Description	Deletes the modifier option's uploaded image and thumbnail, and clears its image_url and thumbnail_url.
+//	@Tags			images
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			id	path		string	true	"Modifier option ID"	format(uuid)
+//	@Success		200	{object}	utils.APIResponse[dto.ModifierOption]
+//	@Failure		400	{object}	utils.APIResponse[any]
+//	@Failure		404	{object}	utils.APIResponse[any]
+//	@Failure		500	{object}	utils.APIResponse[any]
+//	@Router			/modifiers/options/{id}/image [delete]
+func (h *ImageHandler) DeleteModifierOptionImage(c *gin.Context) {
+	claims := c.MustGet("claims").(utils.JWTClaims)
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		utils.WriteBadRequest(c.Writer, "Invalid modifier option ID format")
+		return
+	}
+
+	option, err := h.service.DeleteModifierOptionImage(c.Request.Context(), authz.NewActorFromClaims(claims), id)
+	if err != nil {
+		apperr.WriteHTTPError(c.Writer, err, "Failed to remove image")
+		return
+	}
+	utils.WriteSuccess(c.Writer, option)
+}
+
+// SetRestaurantLogo handles PUT /api/restaurants/{id}/logo
+//
+//	@Summary		Upload a restaurant's logo
+//	@Description	Stores a JPEG, PNG or GIF logo for the restaurant along with a thumbnail, and sets its logo_url and logo_thumbnail_url. Any previous logo is deleted.
+//	@Tags			images
+//	@Accept			multipart/form-data
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			id		path		string	true	"Restaurant ID"	format(uuid)
+//	@Param			image	formData	file	true	"Image file"
+//	@Success		200		{object}	utils.APIResponse[dto.RestaurantResponse]
+//	@Failure		400		{object}	utils.APIResponse[any]
+//	@Failure		404		{object}	utils.APIResponse[any]
+//	@Failure		500		{object}	utils.APIResponse[any]
+//	@Router			/restaurants/{id}/logo [put]
+func (h *ImageHandler) SetRestaurantLogo(c *gin.Context) {
+	claims := c.MustGet("claims").(utils.JWTClaims)
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		utils.WriteBadRequest(c.Writer, "Invalid restaurant ID format")
+		return
+	}
+	data, ok := h.readImage(c)
+	if !ok {
+		return
+	}
+
+	restaurant, err := h.service.SetRestaurantLogo(c.Request.Context(), authz.NewActorFromClaims(claims), id, data)
+	if err != nil {
+		apperr.WriteHTTPError(c.Writer, err, "Failed to upload logo")
+		return
+	}
+	utils.WriteSuccess(c.Writer, restaurant)
+}
+
+// DeleteRestaurantLogo handles DELETE /api/restaurants/{id}/logo
+//
+//	@Summary		Remove a restaurant's logo
+//	@Description	Deletes the restaurant's uploaded logo and thumbnail, and clears its logo_url and logo_thumbnail_url.
+//	@Tags			images
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			id	path		string	true	"Restaurant ID"	format(uuid)
+//	@Success		200	{object}	utils.APIResponse[dto.RestaurantResponse]
+//	@Failure		400	{object}	utils.APIResponse[any]
+//	@Failure		404	{object}	utils.APIResponse[any]
+//	@Failure		500	{object}	utils.APIResponse[any]
+//	@Router			/restaurants/{id}/logo [delete]
+func (h *ImageHandler) DeleteRestaurantLogo(c *gin.Context) {
+	claims := c.MustGet("claims").(utils.JWTClaims)
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		utils.WriteBadRequest(c.Writer, "Invalid restaurant ID format")
+		return
+	}
+
+	restaurant, err := h.service.DeleteRestaurantLogo(c.Request.Context(), authz.NewActorFromClaims(claims), id)
+	if err != nil {
+		apperr.WriteHTTPError(c.Writer, err, "Failed to remove logo")
+		return
+	}
+	utils.WriteSuccess(c.Writer, restaurant)
+}
+
+// readImage reads the uploaded file in the image form field, writing a bad
+// request and returning false if there is none or it is too large.
+func (h *ImageHandler) readImage(c *gin.Context) ([]byte, bool) {
+	tooLarge := fmt.Sprintf("image is larger than %d bytes", h.maxBytes)
+	c.Request.Body = http.MaxBytesReader(c.Writer, c.Request.Body, h.maxBytes+multipartOverhead)
+
+	header, err := c.FormFile(imageFormField)
+	if err != nil {
+		var maxBytesErr *http.MaxBytesError
+		if errors.As(err, &maxBytesErr) {
+			utils.WriteBadRequest(c.Writer, tooLarge)
+		} else {
+			utils.WriteBadRequest(c.Writer, "an image file is required in the image form field")
+		}
+		return nil, false
+	}
+	if header.Size > h.maxBytes {
+		utils.WriteBadRequest(c.Writer, tooLarge)
+		return nil, false
+	}
+
+	file, err := header.Open()
+	if err != nil {
+		utils.WriteInternalError(c.Writer, "Failed to read image")
+		return nil, false
+	}
+	defer file.Close()
+	data, err := io.ReadAll(file)
+	if err != nil {
+		utils.WriteInternalError(c.Writer, "Failed to read image")
+		return nil, false
+	}
+	return data, true
+}
@@ -0,0 +1,360 @@
+package handler
+
+import (
+	"strconv"
+
+	"github.com/Jiruu246/rms/internal/apperr"
+	"github.com/Jiruu246/rms/internal/authz"
+	"github.com/Jiruu246/rms/internal/dto"
+	"github.com/Jiruu246/rms/internal/services"
+	"github.com/Jiruu246/rms/pkg/utils"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+type IngredientHandler struct {
+	service services.IngredientService
+}
+
+func NewIngredientHandler(service services.IngredientService) *IngredientHandler {
+	return &IngredientHandler{service: service}
+}
+
+// CreateIngredient handles POST /api/ingredients
+//
+//	@Summary		Create an ingredient
+//	@Description	A non-zero on_hand is recorded in the ingredient's ledger as an initial COUNT.
+//	@Tags			inventory
+//	@Accept			json
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			request	body		dto.CreateIngredientRequest	true	"Ingredient details"
+//	@Success		201		{object}	utils.APIResponse[dto.Ingredient]
+//	@Failure		400		{object}	utils.APIResponse[any]
+//	@Failure		404		{object}	utils.APIResponse[any]
+//	@Failure		409		{object}	utils.APIResponse[any]
+//	@Failure		500		{object}	utils.APIResponse[any]
+//	@Router			/ingredients [post]
+func (h *IngredientHandler) CreateIngredient(c *gin.Context) {
+	claims := c.MustGet("claims").(utils.JWTClaims)
+	var req dto.CreateIngredientRequest
+	if err := utils.ParseAndValidateRequest(c, &req); err != nil {
+		utils.WriteBadRequest(c.Writer, err.Error())
+		return
+	}
+	created, err := h.service.Create(c.Request.Context(), authz.NewActorFromClaims(claims), &req)
+	if err != nil {
+		apperr.WriteHTTPError(c.Writer, err, "Failed to create ingredient")
+		return
+	}
+	utils.WriteCreated(c.Writer, created)
+}
+
+// GetIngredients handles GET /api/ingredients?restaurant_id=xxx
+//
+//	@Summary		List a restaurant's ingredients
+//	@Tags			inventory
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			restaurant_id	query		string	true	"Restaurant ID"	format(uuid)
+//	@Success		200				{object}	utils.APIResponse[[]dto.Ingredient]
+//	@Failure		400				{object}	utils.APIResponse[any]
+//	@Failure		404				{object}	utils.APIResponse[any]
+//	@Failure		500				{object}	utils.APIResponse[any]
+//	@Router			/ingredients [get]
+func (h *IngredientHandler) GetIngredients(c *gin.Context) {
+	claims := c.MustGet("claims").(utils.JWTClaims)
+	restaurantIDStr := c.Query("restaurant_id")
+	if restaurantIDStr == "" {
+		utils.WriteBadRequest(c.Writer, "restaurant_id is required")
+		return
+	}
+	restaurantID, err := uuid.Parse(restaurantIDStr)
+	if err != nil {
+		utils.WriteBadRequest(c.Writer, "Invalid restaurant_id format")
+		return
+	}
+	ingredients, err := h.service.GetAllByRestaurant(c.Request.Context(), authz.NewActorFromClaims(claims), restaurantID)
+	if err != nil {
+		apperr.WriteHTTPError(c.Writer, err, "Failed to retrieve ingredients")
+		return
+	}
+	utils.WriteSuccess(c.Writer, ingredients)
+}
+
+// GetIngredient handles GET /api/ingredients/{id}
+//
+//	@Summary		Get an ingredient by ID
+//	@Tags			inventory
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			id	path		string	true	"Ingredient ID"	format(uuid)
+//	@Success		200	{object}	utils.APIResponse[dto.Ingredient]
+//	@Failure		400	{object}	utils.APIResponse[any]
+//	@Failure		404	{object}	utils.APIResponse[any]
+//	@Failure		500	{object}	utils.APIResponse[any]
+//	@Router			/ingredients/{id} [get]
+func (h *IngredientHandler) GetIngredient(c *gin.Context) {
+	claims := c.MustGet("claims").(utils.JWTClaims)
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		utils.WriteBadRequest(c.Writer, "Invalid ingredient ID format")
+		return
+	}
+	ingredient, err := h.service.GetByID(c.Request.Context(), authz.NewActorFromClaims(claims), id)
+	if err != nil {
+		apperr.WriteHTTPError(c.Writer, err, "Failed to retrieve ingredient")
+		return
+	}
+	utils.WriteSuccess(c.Writer, ingredient)
+}
+
+// UpdateIngredient handles PATCH /api/ingredients/{id}
+//
+//	@Summary		Rename an ingredient
+//	@Description	Stock is changed through adjustments; the unit cannot be changed.
+//	@Tags			inventory
+//	@Accept			json
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			id		path		string						true	"Ingredient ID"	format(uuid)
+//	@Param			request	body		dto.UpdateIngredientRequest	true	"Fields to update"
+//	@Success		200		{object}	utils.APIResponse[dto.Ingredient]
+//	@Failure		400		{object}	utils.APIResponse[any]
+//	@Failure		404		{object}	utils.APIResponse[any]
+//	@Failure		409		{object}	utils.APIResponse[any]
+//	@Failure		500		{object}	utils.APIResponse[any]
+//	@Router			/ingredients/{id} [patch]
+func (h *IngredientHandler) UpdateIngredient(c *gin.Context) {
+	claims := c.MustGet("claims").(utils.JWTClaims)
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		utils.WriteBadRequest(c.Writer, "Invalid ingredient ID format")
+		return
+	}
+	var req dto.UpdateIngredientRequest
+	if err := utils.ParseAndValidateRequest(c, &req); err != nil {
+		utils.WriteBadRequest(c.Writer, err.Error())
+		return
+	}
+	updated, err := h.service.Update(c.Request.Context(), authz.NewActorFromClaims(claims), id, &req)
+	if err != nil {
+		apperr.WriteHTTPError(c.Writer, err, "Failed to update ingredient")
+		return
+	}
+	utils.WriteSuccess(c.Writer, updated)
+}
+
+// DeleteIngredient handles DELETE /api/ingredients/{id}
+//
+//	@Summary		Delete an ingredient
+//	@Description	Deletes the ingredient, its ledger and the recipe lines using it. Menu items and modifier options it had 86ed are made available again.
+//	@Tags			inventory
+//	@Security		BearerAuth
+//	@Param			id	path	string	true	"Ingredient ID"	format(uuid)
+//	@Success		204	"No Content"
+//	@Failure		400	{object}	utils.APIResponse[any]
+//	@Failure		404	{object}	utils.APIResponse[any]
+//	@Failure		500	{object}	utils.APIResponse[any]
+//	@Router			/ingredients/{id} [delete]
+func (h *IngredientHandler) DeleteIngredient(c *gin.Context) {
+	claims := c.MustGet("claims").(utils.JWTClaims)
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		utils.WriteBadRequest(c.Writer, "Invalid ingredient ID format")
+		return
+	}
+	if err := h.service.Delete(c.Request.Context(), authz.NewActorFromClaims(claims), id); err != nil {
+		apperr.WriteHTTPError(c.Writer, err, "Failed to delete ingredient")
+		return
+	}
+	utils.WriteNoContent(c.Writer)
+}
+
+// AdjustStock handles POST /api/ingredients/{id}/adjustments
+//
+//	@Summary		Adjust an ingredient's stock
+//	@Description	Records a DELIVERY (adds quantity), WASTE (takes quantity away) or COUNT (sets stock to quantity) in the ingredient's ledger. Menu items and modifier options the ingredient had 86ed are made available again once there is enough for one of them; those it can no longer make are 86ed.
+//	@Tags			inventory
+//	@Accept			json
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			id		path		string						true	"Ingredient ID"	format(uuid)
+//	@Param			request	body		dto.StockAdjustmentRequest	true	"Adjustment"
+//	@Success		201		{object}	utils.APIResponse[dto.StockMovement]
+//	@Failure		400		{object}	utils.APIResponse[any]
+//	@Failure		404		{object}	utils.APIResponse[any]
+//	@Failure		409		{object}	utils.APIResponse[any]
+//	@Failure		500		{object}	utils.APIResponse[any]
+//	@Router			/ingredients/{id}/adjustments [post]
+func (h *IngredientHandler) AdjustStock(c *gin.Context) {
+	claims := c.MustGet("claims").(utils.JWTClaims)
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		utils.WriteBadRequest(c.Writer, "Invalid ingredient ID format")
+		return
+	}
+	var req dto.StockAdjustmentRequest
+	if err := utils.ParseAndValidateRequest(c, &req); err != nil {
+		utils.WriteBadRequest(c.Writer, err.Error())
+		return
+	}
+	movement, err := h.service.Adjust(c.Request.Context(), authz.NewActorFromClaims(claims), id, &req)
+	if err != nil {
+		apperr.WriteHTTPError(c.Writer, err, "Failed to adjust stock")
+		return
+	}
+	utils.WriteCreated(c.Writer, movement)
+}
+
+// GetStockMovements handles GET /api/ingredients/{id}/movements
+//
+//	@Summary		Get an ingredient's stock ledger
+//	@Description	Lists sales, deliveries, waste and counts of the ingredient, newest first.
+//	@Tags			inventory
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			id	path		string	true	"Ingredient ID"	format(uuid)
+//	@Success		200	{object}	utils.APIResponse[[]dto.StockMovement]
+//	@Failure		400	{object}	utils.APIResponse[any]
+//	@Failure		404	{object}	utils.APIResponse[any]
+//	@Failure		500	{object}	utils.APIResponse[any]
+//	@Router			/ingredients/{id}/movements [get]
+func (h *IngredientHandler) GetStockMovements(c *gin.Context) {
+	claims := c.MustGet("claims").(utils.JWTClaims)
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		utils.WriteBadRequest(c.Writer, "Invalid ingredient ID format")
+		return
+	}
+	movements, err := h.service.GetMovements(c.Request.Context(), authz.NewActorFromClaims(claims), id)
+	if err != nil {
+		apperr.WriteHTTPError(c.Writer, err, "Failed to retrieve stock movements")
+		return
+	}
+	utils.WriteSuccess(c.Writer, movements)
+}
+
+// GetMenuItemRecipe handles GET /api/menu-items/{id}/recipe
+//
+//	@Summary		Get a menu item's recipe
+//	@Tags			inventory
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			id	path		int	true	"Menu item ID"
+//	@Success		200	{object}	utils.APIResponse[dto.Recipe]
+//	@Failure		400	{object}	utils.APIResponse[any]
+//	@Failure		404	{object}	utils.APIResponse[any]
+//	@Failure		500	{object}	utils.APIResponse[any]
+//	@Router			/menu-items/{id}/recipe [get]
+func (h *IngredientHandler) GetMenuItemRecipe(c *gin.Context) {
+	claims := c.MustGet("claims").(utils.JWTClaims)
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		utils.WriteBadRequest(c.Writer, "Invalid menu item ID format")
+		return
+	}
+	recipe, err := h.service.GetMenuItemRecipe(c.Request.Context(), authz.NewActorFromClaims(claims), id)
+	if err != nil {
+		apperr.WriteHTTPError(c.Writer, err, "Failed to retrieve recipe")
+		return
+	}
+	utils.WriteSuccess(c.Writer, recipe)
+}
+
+// SetMenuItemRecipe handles PUT /api/menu-items/{id}/recipe
+//
+//	@Summary		Set a menu item's recipe
+//	@Description	Replaces how much of each ingredient one unit of the menu item uses; an empty list clears it. Selling the item draws its recipe from stock, and it is 86ed while an ingredient has less on hand than one unit uses.
+//	@Tags			inventory
+//	@Accept			json
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			id		path		int						true	"Menu item ID"
+//	@Param			request	body		dto.SetRecipeRequest	true	"Recipe"
+//	@Success		200		{object}	utils.APIResponse[dto.Recipe]
+//	@Failure		400		{object}	utils.APIResponse[any]
+//	@Failure		404		{object}	utils.APIResponse[any]
+//	@Failure		500		{object}	utils.APIResponse[any]
+//	@Router			/menu-items/{id}/recipe [put]
+func (h *IngredientHandler) SetMenuItemRecipe(c *gin.Context) {
+	claims := c.MustGet("claims").(utils.JWTClaims)
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		utils.WriteBadRequest(c.Writer, "Invalid menu item ID format")
+		return
+	}
+	var req dto.SetRecipeRequest
+	if err := utils.ParseAndValidateRequest(c, &req); err != nil {
+		utils.WriteBadRequest(c.Writer, err.Error())
+		return
+	}
+	recipe, err := h.service.SetMenuItemRecipe(c.Request.Context(), authz.NewActorFromClaims(claims), id, &req)
+	if err != nil {
+		apperr.WriteHTTPError(c.Writer, err, "Failed to set recipe")
+		return
+	}
+	utils.WriteSuccess(c.Writer, recipe)
+}
+
+// GetModifierOptionRecipe handles GET /api/modifiers/options/{id}/recipe
+//
+//	@Summary		Get a modifier option's recipe
+//	@Tags			inventory
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			id	path		string	true	"Modifier option ID"	format(uuid)
+//	@Success		200	{object}	utils.APIResponse[dto.Recipe]
+//	@Failure		400	{object}	utils.APIResponse[any]
+//	@Failure		404	{object}	utils.APIResponse[any]
+//	@Failure		500	{object}	utils.APIResponse[any]
+//	@Router			/modifiers/options/{id}/recipe [get]
+func (h *IngredientHandler) GetModifierOptionRecipe(c *gin.Context) {
+	claims := c.MustGet("claims").(utils.JWTClaims)
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		utils.WriteBadRequest(c.Writer, "Invalid modifier option ID format")
+		return
+	}
+	recipe, err := h.service.GetModifierOptionRecipe(c.Request.Context(), authz.NewActorFromClaims(claims), id)
+	if err != nil {
+		apperr.WriteHTTPError(c.Writer, err, "Failed to retrieve recipe")
+		return
+	}
+	utils.WriteSuccess(c.Writer, recipe)
+}
+
+// SetModifierOptionRecipe handles PUT /api/modifiers/options/{id}/recipe
+//
+//	@Summary		Set a modifier option's recipe
+//	@Description	Replaces how much of each ingredient one of the option uses; an empty list clears it. The option's recipe is drawn from stock once per unit chosen on each unit of an order item, and it is 86ed while an ingredient has less on hand than one uses.
+//	@Tags			inventory
+//	@Accept			json
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			id		path		string					true	"Modifier option ID"	format(uuid)
+//	@Param			request	body		dto.SetRecipeRequest	true	"Recipe"
+//	@Success		200		{object}	utils.APIResponse[dto.Recipe]
+//	@Failure		400		{object}	utils.APIResponse[any]
+//	@Failure		404		{object}	utils.APIResponse[any]
+//	@Failure		500		{object}	utils.APIResponse[any]
+//	@Router			/modifiers/options/{id}/recipe [put]
+func (h *IngredientHandler) SetModifierOptionRecipe(c *gin.Context) {
+	claims := c.MustGet("claims").(utils.JWTClaims)
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		utils.WriteBadRequest(c.Writer, "Invalid modifier option ID format")
+		return
+	}
+	var req dto.SetRecipeRequest
+	if err := utils.ParseAndValidateRequest(c, &req); err != nil {
+		utils.WriteBadRequest(c.Writer, err.Error())
+		return
+	}
+	recipe, err := h.service.SetModifierOptionRecipe(c.Request.Context(), authz.NewActorFromClaims(claims), id, &req)
+	if err != nil {
+		apperr.WriteHTTPError(c.Writer, err, "Failed to set recipe")
+		return
+	}
+	utils.WriteSuccess(c.Writer, recipe)
+}
@@ -0,0 +1,238 @@
+package handler
+
+import (
+	"github.com/Jiruu246/rms/internal/apperr"
+	"github.com/Jiruu246/rms/internal/authz"
+	"github.com/Jiruu246/rms/internal/dto"
+	"github.com/Jiruu246/rms/internal/services"
+	"github.com/Jiruu246/rms/pkg/utils"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// publicMenuCacheControl lets browsers, kiosks and CDNs reuse the public
+// menu for a minute, and serve a stale copy while revalidating it with its
+// ETag for ten more.
+const publicMenuCacheControl = "public, max-age=60, stale-while-revalidate=600"
+
+type MenuHandler struct {
+	service services.MenuService
+}
+
+func NewMenuHandler(service services.MenuService) *MenuHandler {
+	return &MenuHandler{service: service}
+}
+
+// CreateMenu handles POST /api/menus
+//
+//	@Summary		Create a menu
+//	@Description	A menu is served during its schedule, in the restaurant's timezone, for its order types. Once a restaurant has a menu, only items on a menu served at order time can be ordered.
+//	@Tags			menus
+//	@Accept			json
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			request	body		dto.CreateMenuRequest	true	"Menu details"
+//	@Success		201		{object}	utils.APIResponse[dto.Menu]
+//	@Failure		400		{object}	utils.APIResponse[any]
+//	@Failure		404		{object}	utils.APIResponse[any]
+//	@Failure		409		{object}	utils.APIResponse[any]
+//	@Failure		500		{object}	utils.APIResponse[any]
+//	@Router			/menus [post]
+func (h *MenuHandler) CreateMenu(c *gin.Context) {
+	claims := c.MustGet("claims").(utils.JWTClaims)
+	var req dto.CreateMenuRequest
+	if err := utils.ParseAndValidateRequest(c, &req); err != nil {
+		utils.WriteBadRequest(c.Writer, err.Error())
+		return
+	}
+	created, err := h.service.Create(c.Request.Context(), authz.NewActorFromClaims(claims), &req)
+	if err != nil {
+		apperr.WriteHTTPError(c.Writer, err, "Failed to create menu")
+		return
+	}
+	utils.WriteCreated(c.Writer, created)
+}
+
+// GetMenus handles GET /api/menus?restaurant_id=xxx
+//
+//	@Summary		List a restaurant's menus
+//	@Tags			menus
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			restaurant_id	query		string	true	"Restaurant ID"	format(uuid)
+//	@Success		200				{object}	utils.APIResponse[[]dto.Menu]
+//	@Failure		400				{object}	utils.APIResponse[any]
+//	@Failure		404				{object}	utils.APIResponse[any]
+//	@Failure		500				{object}	utils.APIResponse[any]
+//	@Router			/menus [get]
+func (h *MenuHandler) GetMenus(c *gin.Context) {
+	claims := c.MustGet("claims").(utils.JWTClaims)
+	restaurantIDStr := c.Query("restaurant_id")
+	if restaurantIDStr == "" {
+		utils.WriteBadRequest(c.Writer, "restaurant_id is required")
+		return
+	}
+	restaurantID, err := uuid.Parse(restaurantIDStr)
+	if err != nil {
+		utils.WriteBadRequest(c.Writer, "Invalid restaurant_id format")
+		return
+	}
+	menus, err := h.service.GetAllByRestaurant(c.Request.Context(), authz.NewActorFromClaims(claims), restaurantID)
+	if err != nil {
+		apperr.WriteHTTPError(c.Writer, err, "Failed to retrieve menus")
+		return
+	}
+	utils.WriteSuccess(c.Writer, menus)
+}
+
+// GetMenu handles GET /api/menus/{id}
+//
+//	@Summary		Get a menu by ID
+//	@Description	Includes the menu's categories and item prices.
+//	@Tags			menus
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			id	path		string	true	"Menu ID"	format(uuid)
+//	@Success		200	{object}	utils.APIResponse[dto.Menu]
+//	@Failure		400	{object}	utils.APIResponse[any]
+//	@Failure		404	{object}	utils.APIResponse[any]
+//	@Failure		500	{object}	utils.APIResponse[any]
+//	@Router			/menus/{id} [get]
+func (h *MenuHandler) GetMenu(c *gin.Context) {
+	claims := c.MustGet("claims").(utils.JWTClaims)
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		utils.WriteBadRequest(c.Writer, "Invalid menu ID format")
+		return
+	}
+	menu, err := h.service.GetByID(c.Request.Context(), authz.NewActorFromClaims(claims), id)
+	if err != nil {
+		apperr.WriteHTTPError(c.Writer, err, "Failed to retrieve menu")
+		return
+	}
+	utils.WriteSuccess(c.Writer, menu)
+}
+
+// UpdateMenu handles PATCH /api/menus/{id}
+//
+//	@Summary		Update a menu
+//	@Tags			menus
+//	@Accept			json
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			id		path		string					true	"Menu ID"	format(uuid)
+//	@Param			request	body		dto.UpdateMenuRequest	true	"Fields to update"
+//	@Success		200		{object}	utils.APIResponse[dto.Menu]
+//	@Failure		400		{object}	utils.APIResponse[any]
+//	@Failure		404		{object}	utils.APIResponse[any]
+//	@Failure		409		{object}	utils.APIResponse[any]
+//	@Failure		500		{object}	utils.APIResponse[any]
+//	@Router			/menus/{id} [patch]
+func (h *MenuHandler) UpdateMenu(c *gin.Context) {
+	claims := c.MustGet("claims").(utils.JWTClaims)
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		utils.WriteBadRequest(c.Writer, "Invalid menu ID format")
+		return
+	}
+	var req dto.UpdateMenuRequest
+	if err := utils.ParseAndValidateRequest(c, &req); err != nil {
+		utils.WriteBadRequest(c.Writer, err.Error())
+		return
+	}
+	updated, err := h.service.Update(c.Request.Context(), authz.NewActorFromClaims(claims), id, &req)
+	if err != nil {
+		apperr.WriteHTTPError(c.Writer, err, "Failed to update menu")
+		return
+	}
+	utils.WriteSuccess(c.Writer, updated)
+}
+
+// DeleteMenu handles DELETE /api/menus/{id}
+//
+//	@Summary		Delete a menu
+//	@Description	Deletes the menu and its item prices. Its categories and items are kept.
+//	@Tags			menus
+//	@Security		BearerAuth
+//	@Param			id	path	string	true	"Menu ID"	format(uuid)
+//	@Success		204	"No Content"
+//	@Failure		400	{object}	utils.APIResponse[any]
+//	@Failure		404	{object}	utils.APIResponse[any]
+//	@Failure		500	{object}	utils.APIResponse[any]
+//	@Router			/menus/{id} [delete]
+func (h *MenuHandler) DeleteMenu(c *gin.Context) {
+	claims := c.MustGet("claims").(utils.JWTClaims)
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		utils.WriteBadRequest(c.Writer, "Invalid menu ID format")
+		return
+	}
+	if err := h.service.Delete(c.Request.Context(), authz.NewActorFromClaims(claims), id); err != nil {
+		apperr.WriteHTTPError(c.Writer, err, "Failed to delete menu")
+		return
+	}
+	utils.WriteNoContent(c.Writer)
+}
+
+// SetMenuContents handles PUT /api/menus/{id}/contents
+//
+//	@Summary		Set what is on a menu
+//	@Description	Replaces the menu's categories and item prices. Items in the categories can be ordered from the menu, at the menu's price for them if it lists one and at their own price otherwise. A category can be on several menus.
+//	@Tags			menus
+//	@Accept			json
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			id		path		string						true	"Menu ID"	format(uuid)
+//	@Param			request	body		dto.SetMenuContentsRequest	true	"Categories and item prices"
+//	@Success		200		{object}	utils.APIResponse[dto.Menu]
+//	@Failure		400		{object}	utils.APIResponse[any]
+//	@Failure		404		{object}	utils.APIResponse[any]
+//	@Failure		500		{object}	utils.APIResponse[any]
+//	@Router			/menus/{id}/contents [put]
+func (h *MenuHandler) SetMenuContents(c *gin.Context) {
+	claims := c.MustGet("claims").(utils.JWTClaims)
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		utils.WriteBadRequest(c.Writer, "Invalid menu ID format")
+		return
+	}
+	var req dto.SetMenuContentsRequest
+	if err := utils.ParseAndValidateRequest(c, &req); err != nil {
+		utils.WriteBadRequest(c.Writer, err.Error())
+		return
+	}
+	menu, err := h.service.SetContents(c.Request.Context(), authz.NewActorFromClaims(claims), id, &req)
+	if err != nil {
+		apperr.WriteHTTPError(c.Writer, err, "Failed to set menu contents")
+		return
+	}
+	utils.WriteSuccess(c.Writer, menu)
+}
+
+// GetPublicMenu handles GET /api/public/restaurants/{id}/menu
+//
+//	@Summary		Get a restaurant's full menu
+//	@Description	Public (no-auth) catalogue tree: active categories in display order, their available items, each item's modifiers and available options, plus the restaurant's active menus with their schedules (in timezone), order types, categories and item prices. Categories without available items are left out. The response carries a strong ETag and Cache-Control; send the ETag back in If-None-Match to get 304 Not Modified while nothing changed.
+//	@Tags			menus
+//	@Produce		json
+//	@Param			id				path		string	true	"Restaurant ID"	format(uuid)
+//	@Param			If-None-Match	header		string	false	"ETag of a cached copy"
+//	@Success		200				{object}	utils.APIResponse[dto.PublicMenu]
+//	@Success		304				"Not Modified"
+//	@Failure		400				{object}	utils.APIResponse[any]
+//	@Failure		404				{object}	utils.APIResponse[any]
+//	@Failure		500				{object}	utils.APIResponse[any]
+//	@Router			/public/restaurants/{id}/menu [get]
+func (h *MenuHandler) GetPublicMenu(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		utils.WriteBadRequest(c.Writer, "Invalid restaurant ID format")
+		return
+	}
+	menu, err := h.service.GetPublicMenu(c.Request.Context(), id)
+	if err != nil {
+		apperr.WriteHTTPError(c.Writer, err, "Failed to retrieve menu")
+		return
+	}
+	utils.WriteCacheableSuccess(c.Writer, c.Request, menu, menu.UpdatedAt, publicMenuCacheControl)
+}
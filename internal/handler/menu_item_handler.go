@@ -4,10 +4,12 @@ import (
 	"strconv"
 
 	"github.com/Jiruu246/rms/internal/apperr"
+	"github.com/Jiruu246/rms/internal/authz"
 	"github.com/Jiruu246/rms/internal/dto"
 	"github.com/Jiruu246/rms/internal/services"
 	"github.com/Jiruu246/rms/pkg/utils"
 	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
 )
 
 type MenuItemHandler struct {
@@ -28,15 +30,17 @@ func NewMenuItemHandler(service services.MenuItemService) *MenuItemHandler {
 //	@Param			request	body		dto.CreateMenuItemRequest	true	"Menu item details"
 //	@Success		201		{object}	utils.APIResponse[dto.MenuItem]
 //	@Failure		400		{object}	utils.APIResponse[any]
+//	@Failure		404		{object}	utils.APIResponse[any]
 //	@Failure		500		{object}	utils.APIResponse[any]
 //	@Router			/menu-items [post]
 func (h *MenuItemHandler) CreateMenuItem(c *gin.Context) {
+	claims := c.MustGet("claims").(utils.JWTClaims)
 	var req dto.CreateMenuItemRequest
 	if err := utils.ParseAndValidateRequest(c, &req); err != nil {
 		utils.WriteBadRequest(c.Writer, err.Error())
 		return
 	}
-	created, err := h.service.Create(c.Request.Context(), &req)
+	created, err := h.service.Create(c.Request.Context(), authz.NewActorFromClaims(claims), &req)
 	if err != nil {
 		apperr.WriteHTTPError(c.Writer, err, "Failed to create menu item")
 		return
@@ -44,17 +48,26 @@ func (h *MenuItemHandler) CreateMenuItem(c *gin.Context) {
 	utils.WriteCreated(c.Writer, created)
 }
 
-// GetMenuItems handles GET /api/menu-items
+// GetMenuItems handles GET /api/menu-items?restaurant_id=xxx
 //
-//	@Summary		List menu items
+//	@Summary		List a restaurant's menu items
 //	@Tags			menu-items
 //	@Produce		json
 //	@Security		BearerAuth
-//	@Success		200	{object}	utils.APIResponse[[]dto.MenuItem]
-//	@Failure		500	{object}	utils.APIResponse[any]
+//	@Param			restaurant_id	query		string	true	"Restaurant ID"	format(uuid)
+//	@Success		200				{object}	utils.APIResponse[[]dto.MenuItem]
+//	@Failure		400				{object}	utils.APIResponse[any]
+//	@Failure		404				{object}	utils.APIResponse[any]
+//	@Failure		500				{object}	utils.APIResponse[any]
 //	@Router			/menu-items [get]
 func (h *MenuItemHandler) GetMenuItems(c *gin.Context) {
-	items, err := h.service.GetAll(c.Request.Context())
+	claims := c.MustGet("claims").(utils.JWTClaims)
+	restaurantID, err := uuid.Parse(c.Query("restaurant_id"))
+	if err != nil {
+		utils.WriteBadRequest(c.Writer, "Invalid restaurant ID format")
+		return
+	}
+	items, err := h.service.GetAllByRestaurant(c.Request.Context(), authz.NewActorFromClaims(claims), restaurantID)
 	if err != nil {
 		apperr.WriteHTTPError(c.Writer, err, "Failed to fetch menu items")
 		return
@@ -74,13 +87,14 @@ func (h *MenuItemHandler) GetMenuItems(c *gin.Context) {
 //	@Failure		404	{object}	utils.APIResponse[any]
 //	@Router			/menu-items/{id} [get]
 func (h *MenuItemHandler) GetMenuItem(c *gin.Context) {
+	claims := c.MustGet("claims").(utils.JWTClaims)
 	idStr := c.Param("id")
 	id, err := strconv.ParseInt(idStr, 10, 64)
 	if err != nil {
 		utils.WriteBadRequest(c.Writer, "Invalid menu item ID format")
 		return
 	}
-	item, err := h.service.GetByID(c.Request.Context(), id)
+	item, err := h.service.GetByID(c.Request.Context(), authz.NewActorFromClaims(claims), id)
 	if err != nil {
 		apperr.WriteHTTPError(c.Writer, err, "Failed to retrieve menu item")
 		return
@@ -103,6 +117,7 @@ func (h *MenuItemHandler) GetMenuItem(c *gin.Context) {
 //	@Failure		500		{object}	utils.APIResponse[any]
 //	@Router			/menu-items/{id} [patch]
 func (h *MenuItemHandler) UpdateMenuItem(c *gin.Context) {
+	claims := c.MustGet("claims").(utils.JWTClaims)
 	idStr := c.Param("id")
 	id, err := strconv.ParseInt(idStr, 10, 64)
 	if err != nil {
@@ -114,7 +129,7 @@ func (h *MenuItemHandler) UpdateMenuItem(c *gin.Context) {
 		utils.WriteBadRequest(c.Writer, err.Error())
 		return
 	}
-	updated, err := h.service.Update(c.Request.Context(), id, &req)
+	updated, err := h.service.Update(c.Request.Context(), authz.NewActorFromClaims(claims), id, &req)
 	if err != nil {
 		apperr.WriteHTTPError(c.Writer, err, "Failed to update menu item")
 		return
@@ -134,15 +149,223 @@ func (h *MenuItemHandler) UpdateMenuItem(c *gin.Context) {
 //	@Failure		500	{object}	utils.APIResponse[any]
 //	@Router			/menu-items/{id} [delete]
 func (h *MenuItemHandler) DeleteMenuItem(c *gin.Context) {
+	claims := c.MustGet("claims").(utils.JWTClaims)
 	idStr := c.Param("id")
 	id, err := strconv.ParseInt(idStr, 10, 64)
 	if err != nil {
 		utils.WriteBadRequest(c.Writer, "Invalid menu item ID format")
 		return
 	}
-	if err := h.service.Delete(c.Request.Context(), id); err != nil {
+	if err := h.service.Delete(c.Request.Context(), authz.NewActorFromClaims(claims), id); err != nil {
 		apperr.WriteHTTPError(c.Writer, err, "Failed to delete menu item")
 		return
 	}
 	utils.WriteNoContent(c.Writer)
 }
+
+// SetMenuItemVariants handles PUT /api/menu-items/{id}/variants
+//
+//	@Summary		Replace a menu item's variants
+//	@Description	Replaces the item's variants with the given list. Variants with an id are updated, those without are created, and any not listed are deleted. An empty list removes them all.
+//	@Tags			menu-items
+//	@Accept			json
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			id		path		int								true	"Menu item ID"
+//	@Param			request	body		dto.SetMenuItemVariantsRequest	true	"The item's variants"
+//	@Success		200		{object}	utils.APIResponse[dto.MenuItem]
+//	@Failure		400		{object}	utils.APIResponse[any]
+//	@Failure		404		{object}	utils.APIResponse[any]
+//	@Failure		500		{object}	utils.APIResponse[any]
+//	@Router			/menu-items/{id}/variants [put]
+func (h *MenuItemHandler) SetMenuItemVariants(c *gin.Context) {
+	claims := c.MustGet("claims").(utils.JWTClaims)
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		utils.WriteBadRequest(c.Writer, "Invalid menu item ID format")
+		return
+	}
+	var req dto.SetMenuItemVariantsRequest
+	if err := utils.ParseAndValidateRequest(c, &req); err != nil {
+		utils.WriteBadRequest(c.Writer, err.Error())
+		return
+	}
+	item, err := h.service.SetVariants(c.Request.Context(), authz.NewActorFromClaims(claims), id, &req)
+	if err != nil {
+		apperr.WriteHTTPError(c.Writer, err, "Failed to set menu item variants")
+		return
+	}
+	utils.WriteSuccess(c.Writer, item)
+}
+
+// GetMenuItemModifiers handles GET /api/menu-items/{id}/modifiers
+//
+//	@Summary		List a menu item's modifiers
+//	@Description	Lists the modifier groups attached to the item in display order, with the item's overrides applied.
+//	@Tags			menu-items
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			id	path		int	true	"Menu item ID"
+//	@Success		200	{object}	utils.APIResponse[[]dto.MenuItemModifier]
+//	@Failure		400	{object}	utils.APIResponse[any]
+//	@Failure		404	{object}	utils.APIResponse[any]
+//	@Failure		500	{object}	utils.APIResponse[any]
+//	@Router			/menu-items/{id}/modifiers [get]
+func (h *MenuItemHandler) GetMenuItemModifiers(c *gin.Context) {
+	claims := c.MustGet("claims").(utils.JWTClaims)
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		utils.WriteBadRequest(c.Writer, "Invalid menu item ID format")
+		return
+	}
+	modifiers, err := h.service.GetModifiers(c.Request.Context(), authz.NewActorFromClaims(claims), id)
+	if err != nil {
+		apperr.WriteHTTPError(c.Writer, err, "Failed to get menu item modifiers")
+		return
+	}
+	utils.WriteSuccess(c.Writer, modifiers)
+}
+
+// AttachMenuItemModifier handles POST /api/menu-items/{id}/modifiers
+//
+//	@Summary		Attach a modifier to a menu item
+//	@Description	Attaches a modifier of the item's restaurant to the item, last in display order unless display_order is given, with optional overrides of its required, max and option prices.
+//	@Tags			menu-items
+//	@Accept			json
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			id		path		int									true	"Menu item ID"
+//	@Param			request	body		dto.AttachMenuItemModifierRequest	true	"The modifier and its overrides"
+//	@Success		201		{object}	utils.APIResponse[dto.MenuItemModifier]
+//	@Failure		400		{object}	utils.APIResponse[any]
+//	@Failure		404		{object}	utils.APIResponse[any]
+//	@Failure		409		{object}	utils.APIResponse[any]
+//	@Failure		500		{object}	utils.APIResponse[any]
+//	@Router			/menu-items/{id}/modifiers [post]
+func (h *MenuItemHandler) AttachMenuItemModifier(c *gin.Context) {
+	claims := c.MustGet("claims").(utils.JWTClaims)
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		utils.WriteBadRequest(c.Writer, "Invalid menu item ID format")
+		return
+	}
+	var req dto.AttachMenuItemModifierRequest
+	if err := utils.ParseAndValidateRequest(c, &req); err != nil {
+		utils.WriteBadRequest(c.Writer, err.Error())
+		return
+	}
+	modifier, err := h.service.AttachModifier(c.Request.Context(), authz.NewActorFromClaims(claims), id, &req)
+	if err != nil {
+		apperr.WriteHTTPError(c.Writer, err, "Failed to attach modifier")
+		return
+	}
+	utils.WriteCreated(c.Writer, modifier)
+}
+
+// ReorderMenuItemModifiers handles PUT /api/menu-items/{id}/modifiers
+//
+//	@Summary		Reorder a menu item's modifiers
+//	@Description	Sets the display order of the item's modifiers to the order given. The list must name every attached modifier exactly once.
+//	@Tags			menu-items
+//	@Accept			json
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			id		path		int										true	"Menu item ID"
+//	@Param			request	body		dto.ReorderMenuItemModifiersRequest	true	"The modifiers in their new order"
+//	@Success		200		{object}	utils.APIResponse[[]dto.MenuItemModifier]
+//	@Failure		400		{object}	utils.APIResponse[any]
+//	@Failure		404		{object}	utils.APIResponse[any]
+//	@Failure		500		{object}	utils.APIResponse[any]
+//	@Router			/menu-items/{id}/modifiers [put]
+func (h *MenuItemHandler) ReorderMenuItemModifiers(c *gin.Context) {
+	claims := c.MustGet("claims").(utils.JWTClaims)
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		utils.WriteBadRequest(c.Writer, "Invalid menu item ID format")
+		return
+	}
+	var req dto.ReorderMenuItemModifiersRequest
+	if err := utils.ParseAndValidateRequest(c, &req); err != nil {
+		utils.WriteBadRequest(c.Writer, err.Error())
+		return
+	}
+	modifiers, err := h.service.ReorderModifiers(c.Request.Context(), authz.NewActorFromClaims(claims), id, &req)
+	if err != nil {
+		apperr.WriteHTTPError(c.Writer, err, "Failed to reorder menu item modifiers")
+		return
+	}
+	utils.WriteSuccess(c.Writer, modifiers)
+}
+
+// SetMenuItemModifierOverrides handles PUT /api/menu-items/{id}/modifiers/{modifierId}
+//
+//	@Summary		Replace a menu item's overrides of a modifier
+//	@Description	Replaces the item's overrides of the modifier's required, max and option prices. Overrides left out are cleared, so the modifier's own values apply.
+//	@Tags			menu-items
+//	@Accept			json
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			id			path		int								true	"Menu item ID"
+//	@Param			modifierId	path		string							true	"Modifier ID"
+//	@Param			request		body		dto.MenuItemModifierOverrides	true	"The item's overrides"
+//	@Success		200			{object}	utils.APIResponse[dto.MenuItemModifier]
+//	@Failure		400			{object}	utils.APIResponse[any]
+//	@Failure		404			{object}	utils.APIResponse[any]
+//	@Failure		500			{object}	utils.APIResponse[any]
+//	@Router			/menu-items/{id}/modifiers/{modifierId} [put]
+func (h *MenuItemHandler) SetMenuItemModifierOverrides(c *gin.Context) {
+	claims := c.MustGet("claims").(utils.JWTClaims)
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		utils.WriteBadRequest(c.Writer, "Invalid menu item ID format")
+		return
+	}
+	modifierID, err := uuid.Parse(c.Param("modifierId"))
+	if err != nil {
+		utils.WriteBadRequest(c.Writer, "Invalid modifier ID format")
+		return
+	}
+	var req dto.MenuItemModifierOverrides
+	if err := utils.ParseAndValidateRequest(c, &req); err != nil {
+		utils.WriteBadRequest(c.Writer, err.Error())
+		return
+	}
+	modifier, err := h.service.SetModifierOverrides(c.Request.Context(), authz.NewActorFromClaims(claims), id, modifierID, &req)
+	if err != nil {
+		apperr.WriteHTTPError(c.Writer, err, "Failed to set modifier overrides")
+		return
+	}
+	utils.WriteSuccess(c.Writer, modifier)
+}
+
+// DetachMenuItemModifier handles DELETE /api/menu-items/{id}/modifiers/{modifierId}
+//
+//	@Summary		Detach a modifier from a menu item
+//	@Description	Detaches the modifier from the item, dropping the item's overrides of it. The modifier itself is kept.
+//	@Tags			menu-items
+//	@Security		BearerAuth
+//	@Param			id			path	int		true	"Menu item ID"
+//	@Param			modifierId	path	string	true	"Modifier ID"
+//	@Success		204			"No Content"
+//	@Failure		400			{object}	utils.APIResponse[any]
+//	@Failure		404			{object}	utils.APIResponse[any]
+//	@Failure		500			{object}	utils.APIResponse[any]
+//	@Router			/menu-items/{id}/modifiers/{modifierId} [delete]
+func (h *MenuItemHandler) DetachMenuItemModifier(c *gin.Context) {
+	claims := c.MustGet("claims").(utils.JWTClaims)
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		utils.WriteBadRequest(c.Writer, "Invalid menu item ID format")
+		return
+	}
+	modifierID, err := uuid.Parse(c.Param("modifierId"))
+	if err != nil {
+		utils.WriteBadRequest(c.Writer, "Invalid modifier ID format")
+		return
+	}
+	if err := h.service.DetachModifier(c.Request.Context(), authz.NewActorFromClaims(claims), id, modifierID); err != nil {
+		apperr.WriteHTTPError(c.Writer, err, "Failed to detach modifier")
+		return
+	}
+	utils.WriteNoContent(c.Writer)
+}
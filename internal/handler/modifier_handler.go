@@ -2,6 +2,7 @@ package handler
 
 import (
 	"github.com/Jiruu246/rms/internal/apperr"
+	"github.com/Jiruu246/rms/internal/authz"
 	"github.com/Jiruu246/rms/internal/dto"
 	"github.com/Jiruu246/rms/internal/services"
 	"github.com/Jiruu246/rms/pkg/utils"
@@ -29,16 +30,18 @@ func NewModifierHandler(service services.ModifierService) *ModifierHandler {
 //	@Param			request	body		dto.CreateModifierRequest	true	"Modifier details"
 //	@Success		201		{object}	utils.APIResponse[dto.Modifier]
 //	@Failure		400		{object}	utils.APIResponse[any]
+//	@Failure		404		{object}	utils.APIResponse[any]
 //	@Failure		500		{object}	utils.APIResponse[any]
 //	@Router			/modifiers [post]
 func (h *ModifierHandler) CreateModifier(c *gin.Context) {
+	claims := c.MustGet("claims").(utils.JWTClaims)
 	var req dto.CreateModifierRequest
 	if err := utils.ParseAndValidateRequest(c, &req); err != nil {
 		utils.WriteBadRequest(c.Writer, err.Error())
 		return
 	}
 	data := &dto.CreateModifierData{Request: &req}
-	created, err := h.service.Create(c.Request.Context(), data)
+	created, err := h.service.Create(c.Request.Context(), authz.NewActorFromClaims(claims), data)
 	if err != nil {
 		apperr.WriteHTTPError(c.Writer, err, "Failed to create modifier")
 		return
@@ -58,13 +61,14 @@ func (h *ModifierHandler) CreateModifier(c *gin.Context) {
 //	@Failure		404	{object}	utils.APIResponse[any]
 //	@Router			/modifiers/{id} [get]
 func (h *ModifierHandler) GetModifier(c *gin.Context) {
+	claims := c.MustGet("claims").(utils.JWTClaims)
 	idStr := c.Param("id")
 	id, err := uuid.Parse(idStr)
 	if err != nil {
 		utils.WriteBadRequest(c.Writer, "Invalid modifier ID format")
 		return
 	}
-	modifier, err := h.service.GetByID(c.Request.Context(), id)
+	modifier, err := h.service.GetByID(c.Request.Context(), authz.NewActorFromClaims(claims), id)
 	if err != nil {
 		apperr.WriteHTTPError(c.Writer, err, "Failed to retrieve modifier")
 		return
@@ -72,17 +76,26 @@ func (h *ModifierHandler) GetModifier(c *gin.Context) {
 	utils.WriteSuccess(c.Writer, modifier)
 }
 
-// GetAllModifiers handles GET /api/modifiers
+// GetAllModifiers handles GET /api/modifiers?restaurant_id=xxx
 //
-//	@Summary		List modifiers
+//	@Summary		List a restaurant's modifiers
 //	@Tags			modifiers
 //	@Produce		json
 //	@Security		BearerAuth
-//	@Success		200	{object}	utils.APIResponse[[]dto.Modifier]
-//	@Failure		500	{object}	utils.APIResponse[any]
+//	@Param			restaurant_id	query		string	true	"Restaurant ID"	format(uuid)
+//	@Success		200				{object}	utils.APIResponse[[]dto.Modifier]
+//	@Failure		400				{object}	utils.APIResponse[any]
+//	@Failure		404				{object}	utils.APIResponse[any]
+//	@Failure		500				{object}	utils.APIResponse[any]
 //	@Router			/modifiers [get]
 func (h *ModifierHandler) GetAllModifiers(c *gin.Context) {
-	modifiers, err := h.service.GetAll(c.Request.Context())
+	claims := c.MustGet("claims").(utils.JWTClaims)
+	restaurantID, err := uuid.Parse(c.Query("restaurant_id"))
+	if err != nil {
+		utils.WriteBadRequest(c.Writer, "Invalid restaurant ID format")
+		return
+	}
+	modifiers, err := h.service.GetAllByRestaurant(c.Request.Context(), authz.NewActorFromClaims(claims), restaurantID)
 	if err != nil {
 		apperr.WriteHTTPError(c.Writer, err, "Failed to get modifiers")
 		return
@@ -105,6 +118,7 @@ func (h *ModifierHandler) GetAllModifiers(c *gin.Context) {
 //	@Failure		500		{object}	utils.APIResponse[any]
 //	@Router			/modifiers/{id} [patch]
 func (h *ModifierHandler) UpdateModifier(c *gin.Context) {
+	claims := c.MustGet("claims").(utils.JWTClaims)
 	idStr := c.Param("id")
 	id, err := uuid.Parse(idStr)
 	if err != nil {
@@ -116,7 +130,7 @@ func (h *ModifierHandler) UpdateModifier(c *gin.Context) {
 		utils.WriteBadRequest(c.Writer, err.Error())
 		return
 	}
-	updated, err := h.service.Update(c.Request.Context(), id, &req)
+	updated, err := h.service.Update(c.Request.Context(), authz.NewActorFromClaims(claims), id, &req)
 	if err != nil {
 		apperr.WriteHTTPError(c.Writer, err, "Failed to update modifier")
 		return
@@ -136,13 +150,14 @@ func (h *ModifierHandler) UpdateModifier(c *gin.Context) {
 //	@Failure		500	{object}	utils.APIResponse[any]
 //	@Router			/modifiers/{id} [delete]
 func (h *ModifierHandler) DeleteModifier(c *gin.Context) {
+	claims := c.MustGet("claims").(utils.JWTClaims)
 	idStr := c.Param("id")
 	id, err := uuid.Parse(idStr)
 	if err != nil {
 		utils.WriteBadRequest(c.Writer, "Invalid modifier ID format")
 		return
 	}
-	if err := h.service.Delete(c.Request.Context(), id); err != nil {
+	if err := h.service.Delete(c.Request.Context(), authz.NewActorFromClaims(claims), id); err != nil {
 		apperr.WriteHTTPError(c.Writer, err, "Failed to delete modifier")
 		return
 	}
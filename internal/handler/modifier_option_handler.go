@@ -2,6 +2,7 @@ package handler
 
 import (
 	"github.com/Jiruu246/rms/internal/apperr"
+	"github.com/Jiruu246/rms/internal/authz"
 	"github.com/Jiruu246/rms/internal/dto"
 	"github.com/Jiruu246/rms/internal/services"
 	"github.com/Jiruu246/rms/pkg/utils"
@@ -29,16 +30,18 @@ func NewModifierOptionHandler(service services.ModifierOptionService) *ModifierO
 //	@Param			request	body		dto.CreateModifierOptionRequest	true	"Modifier option details"
 //	@Success		201		{object}	utils.APIResponse[dto.ModifierOption]
 //	@Failure		400		{object}	utils.APIResponse[any]
+//	@Failure		404		{object}	utils.APIResponse[any]
 //	@Failure		500		{object}	utils.APIResponse[any]
 //	@Router			/modifiers/options [post]
 func (h *ModifierOptionHandler) CreateModifierOption(c *gin.Context) {
+	claims := c.MustGet("claims").(utils.JWTClaims)
 	var req dto.CreateModifierOptionRequest
 	if err := utils.ParseAndValidateRequest(c, &req); err != nil {
 		utils.WriteBadRequest(c.Writer, err.Error())
 		return
 	}
 	data := &dto.CreateModifierOptionData{Request: &req}
-	created, err := h.service.Create(c.Request.Context(), data)
+	created, err := h.service.Create(c.Request.Context(), authz.NewActorFromClaims(claims), data)
 	if err != nil {
 		apperr.WriteHTTPError(c.Writer, err, "Failed to create modifier option")
 		return
@@ -58,13 +61,14 @@ func (h *ModifierOptionHandler) CreateModifierOption(c *gin.Context) {
 //	@Failure		404	{object}	utils.APIResponse[any]
 //	@Router			/modifiers/options/{id} [get]
 func (h *ModifierOptionHandler) GetModifierOption(c *gin.Context) {
+	claims := c.MustGet("claims").(utils.JWTClaims)
 	idStr := c.Param("id")
 	id, err := uuid.Parse(idStr)
 	if err != nil {
 		utils.WriteBadRequest(c.Writer, "Invalid modifier option ID format")
 		return
 	}
-	option, err := h.service.GetByID(c.Request.Context(), id)
+	option, err := h.service.GetByID(c.Request.Context(), authz.NewActorFromClaims(claims), id)
 	if err != nil {
 		apperr.WriteHTTPError(c.Writer, err, "Failed to retrieve modifier option")
 		return
@@ -72,17 +76,26 @@ func (h *ModifierOptionHandler) GetModifierOption(c *gin.Context) {
 	utils.WriteSuccess(c.Writer, option)
 }
 
-// GetAllModifierOptions handles GET /api/modifiers/options
+// GetAllModifierOptions handles GET /api/modifiers/options?restaurant_id=xxx
 //
-//	@Summary		List modifier options
+//	@Summary		List a restaurant's modifier options
 //	@Tags			modifier-options
 //	@Produce		json
 //	@Security		BearerAuth
-//	@Success		200	{object}	utils.APIResponse[[]dto.ModifierOption]
-//	@Failure		500	{object}	utils.APIResponse[any]
+//	@Param			restaurant_id	query		string	true	"Restaurant ID"	format(uuid)
+//	@Success		200				{object}	utils.APIResponse[[]dto.ModifierOption]
+//	@Failure		400				{object}	utils.APIResponse[any]
+//	@Failure		404				{object}	utils.APIResponse[any]
+//	@Failure		500				{object}	utils.APIResponse[any]
 //	@Router			/modifiers/options [get]
 func (h *ModifierOptionHandler) GetAllModifierOptions(c *gin.Context) {
-	options, err := h.service.GetAll(c.Request.Context())
+	claims := c.MustGet("claims").(utils.JWTClaims)
+	restaurantID, err := uuid.Parse(c.Query("restaurant_id"))
+	if err != nil {
+		utils.WriteBadRequest(c.Writer, "Invalid restaurant ID format")
+		return
+	}
+	options, err := h.service.GetAllByRestaurant(c.Request.Context(), authz.NewActorFromClaims(claims), restaurantID)
 	if err != nil {
 		apperr.WriteHTTPError(c.Writer, err, "Failed to get modifier options")
 		return
@@ -105,6 +118,7 @@ func (h *ModifierOptionHandler) GetAllModifierOptions(c *gin.Context) {
 //	@Failure		500		{object}	utils.APIResponse[any]
 //	@Router			/modifiers/options/{id} [patch]
 func (h *ModifierOptionHandler) UpdateModifierOption(c *gin.Context) {
+	claims := c.MustGet("claims").(utils.JWTClaims)
 	idStr := c.Param("id")
 	id, err := uuid.Parse(idStr)
 	if err != nil {
@@ -116,7 +130,7 @@ func (h *ModifierOptionHandler) UpdateModifierOption(c *gin.Context) {
 		utils.WriteBadRequest(c.Writer, err.Error())
 		return
 	}
-	updated, err := h.service.Update(c.Request.Context(), id, &req)
+	updated, err := h.service.Update(c.Request.Context(), authz.NewActorFromClaims(claims), id, &req)
 	if err != nil {
 		apperr.WriteHTTPError(c.Writer, err, "Failed to update modifier option")
 		return
@@ -136,13 +150,14 @@ func (h *ModifierOptionHandler) UpdateModifierOption(c *gin.Context) {
 //	@Failure		500	{object}	utils.APIResponse[any]
 //	@Router			/modifiers/options/{id} [delete]
 func (h *ModifierOptionHandler) DeleteModifierOption(c *gin.Context) {
+	claims := c.MustGet("claims").(utils.JWTClaims)
 	idStr := c.Param("id")
 	id, err := uuid.Parse(idStr)
 	if err != nil {
 		utils.WriteBadRequest(c.Writer, "Invalid modifier option ID format")
 		return
 	}
-	if err := h.service.Delete(c.Request.Context(), id); err != nil {
+	if err := h.service.Delete(c.Request.Context(), authz.NewActorFromClaims(claims), id); err != nil {
 		apperr.WriteHTTPError(c.Writer, err, "Failed to delete modifier option")
 		return
 	}
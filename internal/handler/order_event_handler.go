@@ -0,0 +1,170 @@
+package handler
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/Jiruu246/rms/internal/apperr"
+	"github.com/Jiruu246/rms/internal/authz"
+	"github.com/Jiruu246/rms/internal/dto"
+	"github.com/Jiruu246/rms/internal/services"
+	"github.com/Jiruu246/rms/pkg/utils"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+const (
+	// orderStreamKeepAlive is how often an idle stream sends a comment so
+	// proxies don't close it.
+	orderStreamKeepAlive = 15 * time.Second
+	// orderStreamRetry is the reconnect delay suggested to clients, in
+	// milliseconds.
+	orderStreamRetry = 2000
+)
+
+type OrderEventHandler struct {
+	service services.OrderEventService
+}
+
+func NewOrderEventHandler(service services.OrderEventService) *OrderEventHandler {
+	return &OrderEventHandler{service: service}
+}
+
+// StreamOrders handles GET /api/orders/stream?restaurant_id=xxx
+//
+//	@Summary		Stream a restaurant's order events
+//	@Description	Server-Sent Events feed of order.created, order.updated, order.status_changed, order.released (a scheduled order sent to the kitchen) and order.deleted events for the restaurant, plus the ticket.created and ticket.status_changed events of its stations. Each event's id is a per-restaurant sequence number; send it back as the Last-Event-ID header (or last_event_id query parameter) when reconnecting to receive every event missed in between. Without it, only events from now on are sent. The data of each event is an order event: id, type, order_id, order (the order after the change; absent for deletions) and created_at.
+//	@Tags			orders
+//	@Produce		text/event-stream
+//	@Security		BearerAuth
+//	@Param			restaurant_id	query		string	true	"Restaurant ID"	format(uuid)
+//	@Param			Last-Event-ID	header		int		false	"Resume after this event ID"
+//	@Param			last_event_id	query		int		false	"Resume after this event ID, for clients that can't set headers"
+//	@Success		200				{string}	string	"text/event-stream of order events"
+//	@Failure		400				{object}	utils.APIResponse[any]
+//	@Failure		404				{object}	utils.APIResponse[any]
+//	@Failure		500				{object}	utils.APIResponse[any]
+//	@Router			/orders/stream [get]
+func (h *OrderEventHandler) StreamOrders(c *gin.Context) {
+	claims := c.MustGet("claims").(utils.JWTClaims)
+	restaurantIDStr := c.Query("restaurant_id")
+	if restaurantIDStr == "" {
+		utils.WriteBadRequest(c.Writer, "restaurant_id is required")
+		return
+	}
+	restaurantID, err := uuid.Parse(restaurantIDStr)
+	if err != nil {
+		utils.WriteBadRequest(c.Writer, "Invalid restaurant_id format")
+		return
+	}
+
+	lastEventID, ok := parseLastEventID(c)
+	if !ok {
+		return
+	}
+
+	events, err := h.service.Subscribe(c.Request.Context(), authz.NewActorFromClaims(claims), restaurantID, lastEventID)
+	if err != nil {
+		apperr.WriteHTTPError(c.Writer, err, "Failed to open order stream")
+		return
+	}
+	writeEventStream(c, events)
+}
+
+// StreamStation handles GET /api/stations/{id}/stream
+//
+//	@Summary		Stream a station's ticket events
+//	@Description	Server-Sent Events feed of ticket.created and ticket.status_changed events for the station. Event ids come from the restaurant's order feed, so they increase but have gaps; resume with Last-Event-ID as for /orders/stream. The data of each event is an order event with station_id and ticket set.
+//	@Tags			stations
+//	@Produce		text/event-stream
+//	@Security		BearerAuth
+//	@Param			id				path		string	true	"Station ID"	format(uuid)
+//	@Param			Last-Event-ID	header		int		false	"Resume after this event ID"
+//	@Param			last_event_id	query		int		false	"Resume after this event ID, for clients that can't set headers"
+//	@Success		200				{string}	string	"text/event-stream of ticket events"
+//	@Failure		400				{object}	utils.APIResponse[any]
+//	@Failure		404				{object}	utils.APIResponse[any]
+//	@Failure		500				{object}	utils.APIResponse[any]
+//	@Router			/stations/{id}/stream [get]
+func (h *OrderEventHandler) StreamStation(c *gin.Context) {
+	claims := c.MustGet("claims").(utils.JWTClaims)
+	stationID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		utils.WriteBadRequest(c.Writer, "Invalid station ID format")
+		return
+	}
+
+	lastEventID, ok := parseLastEventID(c)
+	if !ok {
+		return
+	}
+
+	events, err := h.service.SubscribeStation(c.Request.Context(), authz.NewActorFromClaims(claims), stationID, lastEventID)
+	if err != nil {
+		apperr.WriteHTTPError(c.Writer, err, "Failed to open station stream")
+		return
+	}
+	writeEventStream(c, events)
+}
+
+// parseLastEventID reads the event ID a reconnecting client resumes from.
+// It writes a 400 and returns false if the ID is malformed.
+func parseLastEventID(c *gin.Context) (*int64, bool) {
+	raw := c.GetHeader("Last-Event-ID")
+	if raw == "" {
+		raw = c.Query("last_event_id")
+	}
+	if raw == "" {
+		return nil, true
+	}
+	id, err := strconv.ParseInt(raw, 10, 64)
+	if err != nil || id < 0 {
+		utils.WriteBadRequest(c.Writer, "Invalid Last-Event-ID")
+		return nil, false
+	}
+	return &id, true
+}
+
+// writeEventStream sends events to the client as Server-Sent Events until
+// the channel is closed or the client goes away.
+func writeEventStream(c *gin.Context, events <-chan *dto.OrderEvent) {
+	// The server's write timeout is meant for ordinary requests; a stream
+	// stays open until the client leaves.
+	_ = http.NewResponseController(c.Writer).SetWriteDeadline(time.Time{})
+
+	header := c.Writer.Header()
+	header.Set("Content-Type", "text/event-stream")
+	header.Set("Cache-Control", "no-cache")
+	header.Set("Connection", "keep-alive")
+	header.Set("X-Accel-Buffering", "no")
+	c.Writer.WriteHeader(http.StatusOK)
+	fmt.Fprintf(c.Writer, "retry: %d\n\n", orderStreamRetry)
+	c.Writer.Flush()
+
+	keepAlive := time.NewTicker(orderStreamKeepAlive)
+	defer keepAlive.Stop()
+	for {
+		select {
+		case event, ok := <-events:
+			if !ok {
+				return
+			}
+			data, err := json.Marshal(event)
+			if err != nil {
+				return
+			}
+			if _, err := fmt.Fprintf(c.Writer, "id: %d\nevent: %s\ndata: %s\n\n", event.ID, event.Type, data); err != nil {
+				return
+			}
+			c.Writer.Flush()
+		case <-keepAlive.C:
+			if _, err := fmt.Fprint(c.Writer, ": keep-alive\n\n"); err != nil {
+				return
+			}
+			c.Writer.Flush()
+		}
+	}
+}
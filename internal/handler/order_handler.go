@@ -1,7 +1,11 @@
 package handler
 
 import (
+	"strconv"
+	"time"
+
 	"github.com/Jiruu246/rms/internal/apperr"
+	"github.com/Jiruu246/rms/internal/authz"
 	"github.com/Jiruu246/rms/internal/dto"
 	"github.com/Jiruu246/rms/internal/services"
 	"github.com/Jiruu246/rms/pkg/utils"
@@ -11,19 +15,55 @@ import (
 
 type ModifierOption struct {
 	ModifierID uuid.UUID `json:"modifier_id" validate:"required" binding:"required"`
-	Quantity   int       `json:"quantity" validate:"required,min=1" binding:"required"`
+	// Quantity 0 deselects an option that is pre-selected by default.
+	Quantity int `json:"quantity" validate:"min=0"`
+	// Modifiers are the options chosen in this option's child modifier
+	// groups, e.g. the size of the fries chosen as a combo's side.
+	Modifiers []ModifierOption `json:"modifiers,omitempty" validate:"dive"`
 }
 
 type OrderItemSchema struct {
-	MenuItemID      int64            `json:"menu_item_id" validate:"required" binding:"required"`
+	MenuItemID int64 `json:"menu_item_id" validate:"required" binding:"required"`
+	// VariantID chooses one of the item's variants; required for items
+	// that have them.
+	VariantID       *uuid.UUID       `json:"variant_id,omitempty"`
 	Quantity        int              `json:"quantity" validate:"required,min=1" binding:"required"`
 	Notes           string           `json:"notes,omitempty" validate:"max=255" binding:"omitempty"`
 	ModifierOptions []ModifierOption `json:"modifiers,omitempty" validate:"dive" binding:"omitempty"`
 }
+
+// DeliverySchema is where a DELIVERY order goes. latitude/longitude place
+// the address in one of the restaurant's delivery zones.
+type DeliverySchema struct {
+	Address      string  `json:"address" validate:"required,max=500" binding:"required"`
+	Latitude     float64 `json:"latitude" validate:"min=-90,max=90"`
+	Longitude    float64 `json:"longitude" validate:"min=-180,max=180"`
+	ContactName  string  `json:"contact_name" validate:"required,max=255" binding:"required"`
+	ContactPhone string  `json:"contact_phone" validate:"required,max=50" binding:"required"`
+	Instructions string  `json:"instructions,omitempty" validate:"max=1000"`
+}
+
 type CreateOrderSchema struct {
-	OrderType    dto.OrderType     `json:"order_type" validate:"required" binding:"required"`
-	RestaurantID uuid.UUID         `json:"restaurant_id" validate:"required" binding:"required"`
+	OrderType dto.OrderType `json:"order_type" validate:"required" binding:"required"`
+	// RestaurantID may be left out when ordering with a TableToken, which
+	// identifies the restaurant.
+	RestaurantID uuid.UUID         `json:"restaurant_id" validate:"required_without=TableToken" binding:"required_without=TableToken"`
 	OrderItems   []OrderItemSchema `json:"order_items" validate:"dive"`
+	// TableID places a DINE_IN order at one of the restaurant's tables.
+	TableID *uuid.UUID `json:"table_id,omitempty"`
+	// TableToken is the token from a table's QR code, for customers
+	// ordering from their table through the public endpoint.
+	TableToken string `json:"table_token,omitempty" validate:"max=64"`
+	// Delivery is required for DELIVERY orders.
+	Delivery *DeliverySchema `json:"delivery,omitempty"`
+	// ScheduledFor places the order for a later pickup or delivery time;
+	// omitted, the order is prepared as soon as possible.
+	ScheduledFor *time.Time `json:"scheduled_for,omitempty"`
+}
+
+// AddOrderItemsSchema adds lines to an existing order.
+type AddOrderItemsSchema struct {
+	OrderItems []OrderItemSchema `json:"order_items" validate:"required,min=1,dive" binding:"required"`
 }
 
 type OrderHandler struct {
@@ -37,14 +77,18 @@ func NewOrderHandler(service services.OrderService) *OrderHandler {
 // CreateOrderPub handles POST /api/orders and POST /api/public/order
 //
 //	@Summary		Create an order
-//	@Description	Creates an order. Mounted both as an authenticated endpoint and as a public (no-auth) endpoint for customer-facing ordering.
+//	@Description	Creates an order. Mounted both as an authenticated endpoint and as a public (no-auth) endpoint for customer-facing ordering. On the authenticated endpoint the user must own the restaurant (404 otherwise), and orders are taken even while it is inactive or closed. A DINE_IN order can be placed at a table with table_id or, from the table's QR code, table_token (restaurant_id can then be left out); it joins the table's open session, opening one if needed. DELIVERY orders need delivery details; the address must fall in one of the restaurant's active delivery zones and the subtotal must meet the zone's minimum, and the zone's fee is added to the total. With scheduled_for, the order is booked for that time (orders placed at a table cannot be scheduled): it must be within the restaurant's opening hours, at least its kitchen lead time from now, at most 14 days ahead, and in a time slot that is not full (see GET /public/restaurants/{id}/slots). Scheduled orders are held from the kitchen until the lead time before their slot starts. Retries sent with the same Idempotency-Key (unique per restaurant) and body get the first response back, with Idempotent-Replayed: true, instead of creating another order; a retry while the first request is still being handled is 409. The public endpoint is rate limited per client IP and per restaurant; over the limit the response is 429 with Retry-After.
 //	@Tags			orders
 //	@Accept			json
 //	@Produce		json
-//	@Param			request	body		CreateOrderSchema	true	"Order details"
-//	@Success		201		{object}	utils.APIResponse[dto.Order]
-//	@Failure		400		{object}	utils.APIResponse[any]
-//	@Failure		500		{object}	utils.APIResponse[any]
+//	@Param			Idempotency-Key	header		string				false	"Client-chosen key making retries safe, at most 255 characters"
+//	@Param			request			body		CreateOrderSchema	true	"Order details"
+//	@Success		201				{object}	utils.APIResponse[dto.Order]
+//	@Failure		400				{object}	utils.APIResponse[any]
+//	@Failure		404				{object}	utils.APIResponse[any]
+//	@Failure		409				{object}	utils.APIResponse[any]
+//	@Failure		429				{object}	utils.APIResponse[any]
+//	@Failure		500				{object}	utils.APIResponse[any]
 //	@Router			/orders [post]
 //	@Router			/public/order [post]
 func (h *OrderHandler) CreateOrderPub(c *gin.Context) {
@@ -53,26 +97,29 @@ func (h *OrderHandler) CreateOrderPub(c *gin.Context) {
 		utils.WriteBadRequest(c.Writer, err.Error())
 		return
 	}
-	var orderItems []services.OrderItemInput
-	for _, item := range req.OrderItems {
-		var modifiers []services.ModifierOptionInput
-		for _, m := range item.ModifierOptions {
-			modifiers = append(modifiers, services.ModifierOptionInput{
-				ModifierOptionID: m.ModifierID,
-				Quantity:         m.Quantity,
-			})
-		}
-		orderItems = append(orderItems, services.OrderItemInput{
-			MenuItemID:         item.MenuItemID,
-			Quantity:           item.Quantity,
-			SpecialInstruction: item.Notes,
-			ModifierOptions:    modifiers,
-		})
-	}
 	input := services.CreateOrderInput{
 		OrderType:    req.OrderType,
 		RestaurantID: req.RestaurantID,
-		OrderItems:   orderItems,
+		OrderItems:   orderItemInputs(req.OrderItems),
+		TableID:      req.TableID,
+		TableToken:   req.TableToken,
+		ScheduledFor: req.ScheduledFor,
+	}
+	if d := req.Delivery; d != nil {
+		input.Delivery = &services.DeliveryInput{
+			Address:      d.Address,
+			Latitude:     d.Latitude,
+			Longitude:    d.Longitude,
+			ContactName:  d.ContactName,
+			ContactPhone: d.ContactPhone,
+			Instructions: d.Instructions,
+		}
+	}
+	// Claims are only present on the authenticated route, where the user
+	// must own the restaurant; public orders are recorded without a creator.
+	if claims, ok := c.Get("claims"); ok {
+		actor := authz.NewActorFromClaims(claims.(utils.JWTClaims))
+		input.Actor = &actor
 	}
 	created, err := h.service.Create(c.Request.Context(), input)
 	if err != nil {
@@ -94,13 +141,14 @@ func (h *OrderHandler) CreateOrderPub(c *gin.Context) {
 //	@Failure		404	{object}	utils.APIResponse[any]
 //	@Router			/orders/{id} [get]
 func (h *OrderHandler) GetOrder(c *gin.Context) {
+	claims := c.MustGet("claims").(utils.JWTClaims)
 	idStr := c.Param("id")
 	id, err := uuid.Parse(idStr)
 	if err != nil {
 		utils.WriteBadRequest(c.Writer, "Invalid order ID format")
 		return
 	}
-	order, err := h.service.GetByID(c.Request.Context(), id)
+	order, err := h.service.GetByID(c.Request.Context(), authz.NewActorFromClaims(claims), id)
 	if err != nil {
 		apperr.WriteHTTPError(c.Writer, err, "Failed to retrieve order")
 		return
@@ -114,12 +162,16 @@ func (h *OrderHandler) GetOrder(c *gin.Context) {
 //	@Tags			orders
 //	@Produce		json
 //	@Security		BearerAuth
-//	@Param			restaurant_id	query		string	true	"Restaurant ID"	format(uuid)
-//	@Success		200				{object}	utils.APIResponse[[]dto.Order]
-//	@Failure		400				{object}	utils.APIResponse[any]
-//	@Failure		500				{object}	utils.APIResponse[any]
+//	@Param			restaurant_id		query		string	true	"Restaurant ID"	format(uuid)
+//	@Param			order_number		query		int		false	"Only orders with this order number"
+//	@Param			order_number_period	query		string	false	"Only orders numbered in this period (YYYY-MM-DD, for restaurants that reset numbers daily)"
+//	@Success		200					{object}	utils.APIResponse[[]dto.Order]
+//	@Failure		400					{object}	utils.APIResponse[any]
+//	@Failure		404					{object}	utils.APIResponse[any]
+//	@Failure		500					{object}	utils.APIResponse[any]
 //	@Router			/orders [get]
 func (h *OrderHandler) GetOrders(c *gin.Context) {
+	claims := c.MustGet("claims").(utils.JWTClaims)
 	restaurantIDStr := c.Query("restaurant_id")
 	if restaurantIDStr == "" {
 		utils.WriteBadRequest(c.Writer, "restaurant_id is required")
@@ -130,7 +182,23 @@ func (h *OrderHandler) GetOrders(c *gin.Context) {
 		utils.WriteBadRequest(c.Writer, "Invalid restaurant_id format")
 		return
 	}
-	orders, err := h.service.GetAllByRestaurant(c.Request.Context(), restaurantID)
+	var filters dto.OrderListFilters
+	if s := c.Query("order_number"); s != "" {
+		number, err := strconv.Atoi(s)
+		if err != nil || number < 1 {
+			utils.WriteBadRequest(c.Writer, "Invalid order_number")
+			return
+		}
+		filters.OrderNumber = &number
+	}
+	if s := c.Query("order_number_period"); s != "" {
+		if _, err := time.Parse(time.DateOnly, s); err != nil {
+			utils.WriteBadRequest(c.Writer, "Invalid order_number_period, expected YYYY-MM-DD")
+			return
+		}
+		filters.OrderNumberPeriod = &s
+	}
+	orders, err := h.service.GetAllByRestaurant(c.Request.Context(), authz.NewActorFromClaims(claims), restaurantID, filters)
 	if err != nil {
 		apperr.WriteHTTPError(c.Writer, err, "Failed to fetch orders")
 		return
@@ -141,6 +209,7 @@ func (h *OrderHandler) GetOrders(c *gin.Context) {
 // UpdateOrder handles PATCH /api/orders/{id}
 //
 //	@Summary		Update an order
+//	@Description	Partially updates an order. order_status changes must follow the order lifecycle (OPEN -> CONFIRMED -> COMPLETED, CANCELLED from any non-terminal status); illegal transitions return 409. order_type cannot be changed once the order is placed (409).
 //	@Tags			orders
 //	@Accept			json
 //	@Produce		json
@@ -150,9 +219,12 @@ func (h *OrderHandler) GetOrders(c *gin.Context) {
 //	@Success		200		{object}	utils.APIResponse[dto.Order]
 //	@Failure		400		{object}	utils.APIResponse[any]
 //	@Failure		404		{object}	utils.APIResponse[any]
+//	@Failure		409		{object}	utils.APIResponse[any]
 //	@Failure		500		{object}	utils.APIResponse[any]
 //	@Router			/orders/{id} [patch]
 func (h *OrderHandler) UpdateOrder(c *gin.Context) {
+	claims := c.MustGet("claims").(utils.JWTClaims)
+
 	idStr := c.Param("id")
 	id, err := uuid.Parse(idStr)
 	if err != nil {
@@ -164,7 +236,7 @@ func (h *OrderHandler) UpdateOrder(c *gin.Context) {
 		utils.WriteBadRequest(c.Writer, err.Error())
 		return
 	}
-	updated, err := h.service.Update(c.Request.Context(), id, &req)
+	updated, err := h.service.Update(c.Request.Context(), authz.NewActorFromClaims(claims), id, &req)
 	if err != nil {
 		apperr.WriteHTTPError(c.Writer, err, "Failed to update order")
 		return
@@ -183,15 +255,226 @@ func (h *OrderHandler) UpdateOrder(c *gin.Context) {
 //	@Failure		404	{object}	utils.APIResponse[any]
 //	@Router			/orders/{id} [delete]
 func (h *OrderHandler) DeleteOrder(c *gin.Context) {
+	claims := c.MustGet("claims").(utils.JWTClaims)
 	idStr := c.Param("id")
 	id, err := uuid.Parse(idStr)
 	if err != nil {
 		utils.WriteBadRequest(c.Writer, "Invalid order ID format")
 		return
 	}
-	if err := h.service.Delete(c.Request.Context(), id); err != nil {
+	if err := h.service.Delete(c.Request.Context(), authz.NewActorFromClaims(claims), id); err != nil {
 		apperr.WriteHTTPError(c.Writer, err, "Failed to delete order")
 		return
 	}
 	utils.WriteNoContent(c.Writer)
 }
+
+// GetOrderHistory handles GET /api/orders/{id}/history
+//
+//	@Summary		Get an order's status history
+//	@Description	Lists every order_status transition of the order, oldest first, including the initial OPEN status recorded at creation.
+//	@Tags			orders
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			id	path		string	true	"Order ID"	format(uuid)
+//	@Success		200	{object}	utils.APIResponse[[]dto.OrderStatusEvent]
+//	@Failure		400	{object}	utils.APIResponse[any]
+//	@Failure		404	{object}	utils.APIResponse[any]
+//	@Failure		500	{object}	utils.APIResponse[any]
+//	@Router			/orders/{id}/history [get]
+func (h *OrderHandler) GetOrderHistory(c *gin.Context) {
+	claims := c.MustGet("claims").(utils.JWTClaims)
+	idStr := c.Param("id")
+	id, err := uuid.Parse(idStr)
+	if err != nil {
+		utils.WriteBadRequest(c.Writer, "Invalid order ID format")
+		return
+	}
+	history, err := h.service.GetStatusHistory(c.Request.Context(), authz.NewActorFromClaims(claims), id)
+	if err != nil {
+		apperr.WriteHTTPError(c.Writer, err, "Failed to retrieve order history")
+		return
+	}
+	utils.WriteSuccess(c.Writer, history)
+}
+
+// AddOrderItems handles POST /api/orders/{id}/items
+//
+//	@Summary		Add items to an order
+//	@Description	Adds lines to an OPEN or CONFIRMED order, such as a bar tab, validated like those of a new order. The order is re-priced at the restaurant's current tax rate. On an order already sent to the kitchen the new lines go out on new station tickets. The change is recorded in the order's item changes.
+//	@Tags			orders
+//	@Accept			json
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			id		path		string				true	"Order ID"	format(uuid)
+//	@Param			request	body		AddOrderItemsSchema	true	"Items to add"
+//	@Success		200		{object}	utils.APIResponse[dto.Order]
+//	@Failure		400		{object}	utils.APIResponse[any]
+//	@Failure		404		{object}	utils.APIResponse[any]
+//	@Failure		409		{object}	utils.APIResponse[any]
+//	@Failure		500		{object}	utils.APIResponse[any]
+//	@Router			/orders/{id}/items [post]
+func (h *OrderHandler) AddOrderItems(c *gin.Context) {
+	claims := c.MustGet("claims").(utils.JWTClaims)
+
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		utils.WriteBadRequest(c.Writer, "Invalid order ID format")
+		return
+	}
+	var req AddOrderItemsSchema
+	if err := utils.ParseAndValidateRequest(c, &req); err != nil {
+		utils.WriteBadRequest(c.Writer, err.Error())
+		return
+	}
+	updated, err := h.service.AddItems(c.Request.Context(), authz.NewActorFromClaims(claims), id, orderItemInputs(req.OrderItems))
+	if err != nil {
+		apperr.WriteHTTPError(c.Writer, err, "Failed to add order items")
+		return
+	}
+	utils.WriteSuccess(c.Writer, updated)
+}
+
+// UpdateOrderItem handles PATCH /api/orders/{id}/items/{itemId}
+//
+//	@Summary		Update an order item
+//	@Description	Changes the quantity and/or special instructions of a line of an OPEN or CONFIRMED order and re-prices the order. Lowering the quantity of a line already sent to the kitchen requires a reason; a line cannot go below the units refunded on it (409), and the total cannot drop below what has been paid (409).
+//	@Tags			orders
+//	@Accept			json
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			id		path		string						true	"Order ID"		format(uuid)
+//	@Param			itemId	path		string						true	"Order item ID"	format(uuid)
+//	@Param			request	body		dto.UpdateOrderItemRequest	true	"Fields to update"
+//	@Success		200		{object}	utils.APIResponse[dto.Order]
+//	@Failure		400		{object}	utils.APIResponse[any]
+//	@Failure		404		{object}	utils.APIResponse[any]
+//	@Failure		409		{object}	utils.APIResponse[any]
+//	@Failure		500		{object}	utils.APIResponse[any]
+//	@Router			/orders/{id}/items/{itemId} [patch]
+func (h *OrderHandler) UpdateOrderItem(c *gin.Context) {
+	claims := c.MustGet("claims").(utils.JWTClaims)
+
+	id, itemID, ok := parseOrderItemParams(c)
+	if !ok {
+		return
+	}
+	var req dto.UpdateOrderItemRequest
+	if err := utils.ParseAndValidateRequest(c, &req); err != nil {
+		utils.WriteBadRequest(c.Writer, err.Error())
+		return
+	}
+	updated, err := h.service.UpdateItem(c.Request.Context(), authz.NewActorFromClaims(claims), id, itemID, &req)
+	if err != nil {
+		apperr.WriteHTTPError(c.Writer, err, "Failed to update order item")
+		return
+	}
+	utils.WriteSuccess(c.Writer, updated)
+}
+
+// VoidOrderItem handles POST /api/orders/{id}/items/{itemId}/void
+//
+//	@Summary		Void an order item
+//	@Description	Voids a line of an OPEN or CONFIRMED order: it stays on the order with voided_at and void_reason set but no longer counts towards its totals. Voiding a line already sent to the kitchen requires a reason. Lines with refunded units and the order's last line cannot be voided (409); cancel the order instead.
+//	@Tags			orders
+//	@Accept			json
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			id		path		string						true	"Order ID"		format(uuid)
+//	@Param			itemId	path		string						true	"Order item ID"	format(uuid)
+//	@Param			request	body		dto.VoidOrderItemRequest	true	"Reason for the void"
+//	@Success		200		{object}	utils.APIResponse[dto.Order]
+//	@Failure		400		{object}	utils.APIResponse[any]
+//	@Failure		404		{object}	utils.APIResponse[any]
+//	@Failure		409		{object}	utils.APIResponse[any]
+//	@Failure		500		{object}	utils.APIResponse[any]
+//	@Router			/orders/{id}/items/{itemId}/void [post]
+func (h *OrderHandler) VoidOrderItem(c *gin.Context) {
+	claims := c.MustGet("claims").(utils.JWTClaims)
+
+	id, itemID, ok := parseOrderItemParams(c)
+	if !ok {
+		return
+	}
+	var req dto.VoidOrderItemRequest
+	if err := utils.ParseAndValidateRequest(c, &req); err != nil {
+		utils.WriteBadRequest(c.Writer, err.Error())
+		return
+	}
+	updated, err := h.service.VoidItem(c.Request.Context(), authz.NewActorFromClaims(claims), id, itemID, req.Reason)
+	if err != nil {
+		apperr.WriteHTTPError(c.Writer, err, "Failed to void order item")
+		return
+	}
+	utils.WriteSuccess(c.Writer, updated)
+}
+
+// GetOrderItemChanges handles GET /api/orders/{id}/item-changes
+//
+//	@Summary		Get an order's item changes
+//	@Description	Lists every line added, updated or voided on the order after it was placed, oldest first, with the quantities and instructions before and after, the reason and the user who made the change.
+//	@Tags			orders
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			id	path		string	true	"Order ID"	format(uuid)
+//	@Success		200	{object}	utils.APIResponse[[]dto.OrderItemChange]
+//	@Failure		400	{object}	utils.APIResponse[any]
+//	@Failure		404	{object}	utils.APIResponse[any]
+//	@Failure		500	{object}	utils.APIResponse[any]
+//	@Router			/orders/{id}/item-changes [get]
+func (h *OrderHandler) GetOrderItemChanges(c *gin.Context) {
+	claims := c.MustGet("claims").(utils.JWTClaims)
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		utils.WriteBadRequest(c.Writer, "Invalid order ID format")
+		return
+	}
+	changes, err := h.service.GetItemChanges(c.Request.Context(), authz.NewActorFromClaims(claims), id)
+	if err != nil {
+		apperr.WriteHTTPError(c.Writer, err, "Failed to retrieve order item changes")
+		return
+	}
+	utils.WriteSuccess(c.Writer, changes)
+}
+
+// parseOrderItemParams parses the order and order item IDs of the path,
+// writing a 400 if either is malformed.
+func parseOrderItemParams(c *gin.Context) (uuid.UUID, uuid.UUID, bool) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		utils.WriteBadRequest(c.Writer, "Invalid order ID format")
+		return uuid.Nil, uuid.Nil, false
+	}
+	itemID, err := uuid.Parse(c.Param("itemId"))
+	if err != nil {
+		utils.WriteBadRequest(c.Writer, "Invalid order item ID format")
+		return uuid.Nil, uuid.Nil, false
+	}
+	return id, itemID, true
+}
+
+func orderItemInputs(items []OrderItemSchema) []services.OrderItemInput {
+	var orderItems []services.OrderItemInput
+	for _, item := range items {
+		orderItems = append(orderItems, services.OrderItemInput{
+			MenuItemID:         item.MenuItemID,
+			VariantID:          item.VariantID,
+			Quantity:           item.Quantity,
+			SpecialInstruction: item.Notes,
+			ModifierOptions:    modifierOptionInputs(item.ModifierOptions),
+		})
+	}
+	return orderItems
+}
+
+func modifierOptionInputs(options []ModifierOption) []services.ModifierOptionInput {
+	var inputs []services.ModifierOptionInput
+	for _, m := range options {
+		inputs = append(inputs, services.ModifierOptionInput{
+			ModifierOptionID: m.ModifierID,
+			Quantity:         m.Quantity,
+			ModifierOptions:  modifierOptionInputs(m.Modifiers),
+		})
+	}
+	return inputs
+}
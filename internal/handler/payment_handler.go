@@ -0,0 +1,83 @@
+package handler
+
+import (
+	"github.com/Jiruu246/rms/internal/apperr"
+	"github.com/Jiruu246/rms/internal/authz"
+	"github.com/Jiruu246/rms/internal/dto"
+	"github.com/Jiruu246/rms/internal/services"
+	"github.com/Jiruu246/rms/pkg/utils"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+type PaymentHandler struct {
+	service services.PaymentService
+}
+
+func NewPaymentHandler(service services.PaymentService) *PaymentHandler {
+	return &PaymentHandler{service: service}
+}
+
+// CreatePayment handles POST /api/orders/{id}/payments
+//
+//	@Summary		Capture a payment against an order
+//	@Description	Takes one tender (cash or card) for part or all of the order's outstanding balance. Call it several times to split the bill across methods. The order's payment_status becomes PENDING while partially paid and PAID once fully paid. A declined card still returns 201 with status FAILED and a failure_reason.
+//	@Tags			payments
+//	@Accept			json
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			id		path		string						true	"Order ID"	format(uuid)
+//	@Param			request	body		dto.CreatePaymentRequest	true	"Payment details"
+//	@Success		201		{object}	utils.APIResponse[dto.Payment]
+//	@Failure		400		{object}	utils.APIResponse[any]
+//	@Failure		404		{object}	utils.APIResponse[any]
+//	@Failure		409		{object}	utils.APIResponse[any]
+//	@Failure		500		{object}	utils.APIResponse[any]
+//	@Router			/orders/{id}/payments [post]
+func (h *PaymentHandler) CreatePayment(c *gin.Context) {
+	claims := c.MustGet("claims").(utils.JWTClaims)
+	orderID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		utils.WriteBadRequest(c.Writer, "Invalid order ID format")
+		return
+	}
+	var req dto.CreatePaymentRequest
+	if err := utils.ParseAndValidateRequest(c, &req); err != nil {
+		utils.WriteBadRequest(c.Writer, err.Error())
+		return
+	}
+	payment, err := h.service.Capture(c.Request.Context(), authz.NewActorFromClaims(claims), orderID, &req)
+	if err != nil {
+		apperr.WriteHTTPError(c.Writer, err, "Failed to capture payment")
+		return
+	}
+	utils.WriteCreated(c.Writer, payment)
+}
+
+// GetPayments handles GET /api/orders/{id}/payments
+//
+//	@Summary		List an order's payments
+//	@Description	Lists every payment attempt on the order, oldest first, including failed ones.
+//	@Tags			payments
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			id	path		string	true	"Order ID"	format(uuid)
+//	@Success		200	{object}	utils.APIResponse[[]dto.Payment]
+//	@Failure		400	{object}	utils.APIResponse[any]
+//	@Failure		404	{object}	utils.APIResponse[any]
+//	@Failure		500	{object}	utils.APIResponse[any]
+//	@Router			/orders/{id}/payments [get]
+func (h *PaymentHandler) GetPayments(c *gin.Context) {
+	claims := c.MustGet("claims").(utils.JWTClaims)
+	orderID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		utils.WriteBadRequest(c.Writer, "Invalid order ID format")
+		return
+	}
+	payments, err := h.service.ListByOrder(c.Request.Context(), authz.NewActorFromClaims(claims), orderID)
+	if err != nil {
+		apperr.WriteHTTPError(c.Writer, err, "Failed to fetch payments")
+		return
+	}
+	utils.WriteSuccess(c.Writer, payments)
+}
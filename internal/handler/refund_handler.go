@@ -0,0 +1,83 @@
+package handler
+
+import (
+	"github.com/Jiruu246/rms/internal/apperr"
+	"github.com/Jiruu246/rms/internal/authz"
+	"github.com/Jiruu246/rms/internal/dto"
+	"github.com/Jiruu246/rms/internal/services"
+	"github.com/Jiruu246/rms/pkg/utils"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+type RefundHandler struct {
+	service services.RefundService
+}
+
+func NewRefundHandler(service services.RefundService) *RefundHandler {
+	return &RefundHandler{service: service}
+}
+
+// CreateRefund handles POST /api/orders/{id}/refunds
+//
+//	@Summary		Refund an order
+//	@Description	Refunds the whole order (full), specific order items (items, including their share of tax) or an arbitrary amount; exactly one must be set. The money is returned from the order's payments, newest first, as one refund per payment. The order's payment_status becomes REFUNDED once everything paid has been refunded. Only the restaurant's owner may refund.
+//	@Tags			refunds
+//	@Accept			json
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			id		path		string					true	"Order ID"	format(uuid)
+//	@Param			request	body		dto.CreateRefundRequest	true	"Refund details"
+//	@Success		201		{object}	utils.APIResponse[[]dto.Refund]
+//	@Failure		400		{object}	utils.APIResponse[any]
+//	@Failure		404		{object}	utils.APIResponse[any]
+//	@Failure		409		{object}	utils.APIResponse[any]
+//	@Failure		500		{object}	utils.APIResponse[any]
+//	@Router			/orders/{id}/refunds [post]
+func (h *RefundHandler) CreateRefund(c *gin.Context) {
+	claims := c.MustGet("claims").(utils.JWTClaims)
+	orderID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		utils.WriteBadRequest(c.Writer, "Invalid order ID format")
+		return
+	}
+	var req dto.CreateRefundRequest
+	if err := utils.ParseAndValidateRequest(c, &req); err != nil {
+		utils.WriteBadRequest(c.Writer, err.Error())
+		return
+	}
+	refunds, err := h.service.Refund(c.Request.Context(), authz.NewActorFromClaims(claims), orderID, &req)
+	if err != nil {
+		apperr.WriteHTTPError(c.Writer, err, "Failed to refund order")
+		return
+	}
+	utils.WriteCreated(c.Writer, refunds)
+}
+
+// GetRefunds handles GET /api/orders/{id}/refunds
+//
+//	@Summary		List an order's refunds
+//	@Description	Lists every refund on the order, oldest first, including failed ones.
+//	@Tags			refunds
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			id	path		string	true	"Order ID"	format(uuid)
+//	@Success		200	{object}	utils.APIResponse[[]dto.Refund]
+//	@Failure		400	{object}	utils.APIResponse[any]
+//	@Failure		404	{object}	utils.APIResponse[any]
+//	@Failure		500	{object}	utils.APIResponse[any]
+//	@Router			/orders/{id}/refunds [get]
+func (h *RefundHandler) GetRefunds(c *gin.Context) {
+	claims := c.MustGet("claims").(utils.JWTClaims)
+	orderID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		utils.WriteBadRequest(c.Writer, "Invalid order ID format")
+		return
+	}
+	refunds, err := h.service.ListByOrder(c.Request.Context(), authz.NewActorFromClaims(claims), orderID)
+	if err != nil {
+		apperr.WriteHTTPError(c.Writer, err, "Failed to fetch refunds")
+		return
+	}
+	utils.WriteSuccess(c.Writer, refunds)
+}
@@ -1,6 +1,8 @@
 package handler
 
 import (
+	"time"
+
 	"github.com/Jiruu246/rms/internal/apperr"
 	"github.com/Jiruu246/rms/internal/authz"
 	"github.com/Jiruu246/rms/internal/dto"
@@ -119,6 +121,7 @@ func (h *RestaurantHandler) GetRestaurants(c *gin.Context) {
 //	@Success		200		{object}	utils.APIResponse[dto.RestaurantResponse]
 //	@Failure		400		{object}	utils.APIResponse[any]
 //	@Failure		404		{object}	utils.APIResponse[any]
+//	@Failure		409		{object}	utils.APIResponse[any]
 //	@Failure		500		{object}	utils.APIResponse[any]
 //	@Router			/restaurants/{id} [patch]
 func (h *RestaurantHandler) UpdateRestaurant(c *gin.Context) {
@@ -173,3 +176,38 @@ func (h *RestaurantHandler) DeleteRestaurant(c *gin.Context) {
 
 	utils.WriteNoContent(c.Writer)
 }
+
+// GetPublicOpenStatus handles GET /api/public/restaurants/{id}/open-status
+//
+//	@Summary		Check whether a restaurant is open
+//	@Description	Public (no-auth) check of a restaurant's operating hours, holiday exceptions included, at a time (default now). Returns whether it is open, when the current opening ends and when it next opens. Inactive and closed restaurants are never open; a restaurant without weekly hours is always open.
+//	@Tags			restaurants
+//	@Produce		json
+//	@Param			id	path		string	true	"Restaurant ID"	format(uuid)
+//	@Param			at	query		string	false	"Time to check (RFC 3339)"	format(date-time)
+//	@Success		200	{object}	utils.APIResponse[dto.OpenStatus]
+//	@Failure		400	{object}	utils.APIResponse[any]
+//	@Failure		404	{object}	utils.APIResponse[any]
+//	@Failure		500	{object}	utils.APIResponse[any]
+//	@Router			/public/restaurants/{id}/open-status [get]
+func (h *RestaurantHandler) GetPublicOpenStatus(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		utils.WriteBadRequest(c.Writer, "Invalid restaurant ID format")
+		return
+	}
+	at := time.Now()
+	if s := c.Query("at"); s != "" {
+		at, err = time.Parse(time.RFC3339, s)
+		if err != nil {
+			utils.WriteBadRequest(c.Writer, "Invalid at format, want RFC 3339")
+			return
+		}
+	}
+	status, err := h.service.GetOpenStatus(c.Request.Context(), id, at)
+	if err != nil {
+		apperr.WriteHTTPError(c.Writer, err, "Failed to retrieve open status")
+		return
+	}
+	utils.WriteSuccess(c.Writer, status)
+}
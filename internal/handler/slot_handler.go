@@ -0,0 +1,58 @@
+package handler
+
+import (
+	"time"
+
+	"github.com/Jiruu246/rms/internal/apperr"
+	"github.com/Jiruu246/rms/internal/dto"
+	"github.com/Jiruu246/rms/internal/services"
+	"github.com/Jiruu246/rms/pkg/utils"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+type SlotHandler struct {
+	service services.SlotService
+}
+
+func NewSlotHandler(service services.SlotService) *SlotHandler {
+	return &SlotHandler{service: service}
+}
+
+// GetPublicSlots handles GET /api/public/restaurants/{id}/slots?date=YYYY-MM-DD
+//
+//	@Summary		List pickup/delivery time slots
+//	@Description	Public (no-auth) list of the time slots a restaurant takes scheduled orders for on a local date, cut from its operating hours into slots of its slot_minutes. Slots that are already too close (within the kitchen lead time) or too far ahead (over 14 days) are left out; full slots are listed with available false. remaining is null when the restaurant does not limit orders per slot.
+//	@Tags			orders
+//	@Produce		json
+//	@Param			id		path		string	true	"Restaurant ID"	format(uuid)
+//	@Param			date	query		string	true	"Local date"	format(date)
+//	@Success		200		{object}	utils.APIResponse[[]dto.TimeSlot]
+//	@Failure		400		{object}	utils.APIResponse[any]
+//	@Failure		404		{object}	utils.APIResponse[any]
+//	@Failure		500		{object}	utils.APIResponse[any]
+//	@Router			/public/restaurants/{id}/slots [get]
+func (h *SlotHandler) GetPublicSlots(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		utils.WriteBadRequest(c.Writer, "Invalid restaurant ID format")
+		return
+	}
+	dateStr := c.Query("date")
+	if dateStr == "" {
+		utils.WriteBadRequest(c.Writer, "date is required")
+		return
+	}
+	date, err := time.Parse(time.DateOnly, dateStr)
+	if err != nil {
+		utils.WriteBadRequest(c.Writer, "Invalid date format, want YYYY-MM-DD")
+		return
+	}
+	var slots []*dto.TimeSlot
+	slots, err = h.service.GetSlots(c.Request.Context(), id, date)
+	if err != nil {
+		apperr.WriteHTTPError(c.Writer, err, "Failed to retrieve time slots")
+		return
+	}
+	utils.WriteSuccess(c.Writer, slots)
+}
@@ -0,0 +1,280 @@
+package handler
+
+import (
+	"github.com/Jiruu246/rms/internal/apperr"
+	"github.com/Jiruu246/rms/internal/authz"
+	"github.com/Jiruu246/rms/internal/dto"
+	"github.com/Jiruu246/rms/internal/services"
+	"github.com/Jiruu246/rms/pkg/utils"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+type StationHandler struct {
+	service services.StationService
+}
+
+func NewStationHandler(service services.StationService) *StationHandler {
+	return &StationHandler{service: service}
+}
+
+// CreateStation handles POST /api/stations
+//
+//	@Summary		Create a kitchen station
+//	@Tags			stations
+//	@Accept			json
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			request	body		dto.CreateStationRequest	true	"Station details"
+//	@Success		201		{object}	utils.APIResponse[dto.Station]
+//	@Failure		400		{object}	utils.APIResponse[any]
+//	@Failure		404		{object}	utils.APIResponse[any]
+//	@Failure		409		{object}	utils.APIResponse[any]
+//	@Failure		500		{object}	utils.APIResponse[any]
+//	@Router			/stations [post]
+func (h *StationHandler) CreateStation(c *gin.Context) {
+	claims := c.MustGet("claims").(utils.JWTClaims)
+	var req dto.CreateStationRequest
+	if err := utils.ParseAndValidateRequest(c, &req); err != nil {
+		utils.WriteBadRequest(c.Writer, err.Error())
+		return
+	}
+	created, err := h.service.Create(c.Request.Context(), authz.NewActorFromClaims(claims), &req)
+	if err != nil {
+		apperr.WriteHTTPError(c.Writer, err, "Failed to create station")
+		return
+	}
+	utils.WriteCreated(c.Writer, created)
+}
+
+// GetStations handles GET /api/stations?restaurant_id=xxx
+//
+//	@Summary		List a restaurant's kitchen stations
+//	@Tags			stations
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			restaurant_id	query		string	true	"Restaurant ID"	format(uuid)
+//	@Success		200				{object}	utils.APIResponse[[]dto.Station]
+//	@Failure		400				{object}	utils.APIResponse[any]
+//	@Failure		404				{object}	utils.APIResponse[any]
+//	@Failure		500				{object}	utils.APIResponse[any]
+//	@Router			/stations [get]
+func (h *StationHandler) GetStations(c *gin.Context) {
+	claims := c.MustGet("claims").(utils.JWTClaims)
+	restaurantIDStr := c.Query("restaurant_id")
+	if restaurantIDStr == "" {
+		utils.WriteBadRequest(c.Writer, "restaurant_id is required")
+		return
+	}
+	restaurantID, err := uuid.Parse(restaurantIDStr)
+	if err != nil {
+		utils.WriteBadRequest(c.Writer, "Invalid restaurant_id format")
+		return
+	}
+	stations, err := h.service.GetAllByRestaurant(c.Request.Context(), authz.NewActorFromClaims(claims), restaurantID)
+	if err != nil {
+		apperr.WriteHTTPError(c.Writer, err, "Failed to retrieve stations")
+		return
+	}
+	utils.WriteSuccess(c.Writer, stations)
+}
+
+// GetStation handles GET /api/stations/{id}
+//
+//	@Summary		Get a kitchen station by ID
+//	@Description	Includes the menu items and categories routed to the station.
+//	@Tags			stations
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			id	path		string	true	"Station ID"	format(uuid)
+//	@Success		200	{object}	utils.APIResponse[dto.Station]
+//	@Failure		400	{object}	utils.APIResponse[any]
+//	@Failure		404	{object}	utils.APIResponse[any]
+//	@Failure		500	{object}	utils.APIResponse[any]
+//	@Router			/stations/{id} [get]
+func (h *StationHandler) GetStation(c *gin.Context) {
+	claims := c.MustGet("claims").(utils.JWTClaims)
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		utils.WriteBadRequest(c.Writer, "Invalid station ID format")
+		return
+	}
+	station, err := h.service.GetByID(c.Request.Context(), authz.NewActorFromClaims(claims), id)
+	if err != nil {
+		apperr.WriteHTTPError(c.Writer, err, "Failed to retrieve station")
+		return
+	}
+	utils.WriteSuccess(c.Writer, station)
+}
+
+// UpdateStation handles PATCH /api/stations/{id}
+//
+//	@Summary		Update a kitchen station
+//	@Tags			stations
+//	@Accept			json
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			id		path		string					true	"Station ID"	format(uuid)
+//	@Param			request	body		dto.UpdateStationRequest	true	"Fields to update"
+//	@Success		200		{object}	utils.APIResponse[dto.Station]
+//	@Failure		400		{object}	utils.APIResponse[any]
+//	@Failure		404		{object}	utils.APIResponse[any]
+//	@Failure		409		{object}	utils.APIResponse[any]
+//	@Failure		500		{object}	utils.APIResponse[any]
+//	@Router			/stations/{id} [patch]
+func (h *StationHandler) UpdateStation(c *gin.Context) {
+	claims := c.MustGet("claims").(utils.JWTClaims)
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		utils.WriteBadRequest(c.Writer, "Invalid station ID format")
+		return
+	}
+	var req dto.UpdateStationRequest
+	if err := utils.ParseAndValidateRequest(c, &req); err != nil {
+		utils.WriteBadRequest(c.Writer, err.Error())
+		return
+	}
+	updated, err := h.service.Update(c.Request.Context(), authz.NewActorFromClaims(claims), id, &req)
+	if err != nil {
+		apperr.WriteHTTPError(c.Writer, err, "Failed to update station")
+		return
+	}
+	utils.WriteSuccess(c.Writer, updated)
+}
+
+// DeleteStation handles DELETE /api/stations/{id}
+//
+//	@Summary		Delete a kitchen station
+//	@Description	Deletes the station and its tickets. Menu items and categories routed to it are no longer routed anywhere.
+//	@Tags			stations
+//	@Security		BearerAuth
+//	@Param			id	path	string	true	"Station ID"	format(uuid)
+//	@Success		204	"No Content"
+//	@Failure		400	{object}	utils.APIResponse[any]
+//	@Failure		404	{object}	utils.APIResponse[any]
+//	@Failure		500	{object}	utils.APIResponse[any]
+//	@Router			/stations/{id} [delete]
+func (h *StationHandler) DeleteStation(c *gin.Context) {
+	claims := c.MustGet("claims").(utils.JWTClaims)
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		utils.WriteBadRequest(c.Writer, "Invalid station ID format")
+		return
+	}
+	if err := h.service.Delete(c.Request.Context(), authz.NewActorFromClaims(claims), id); err != nil {
+		apperr.WriteHTTPError(c.Writer, err, "Failed to delete station")
+		return
+	}
+	utils.WriteNoContent(c.Writer)
+}
+
+// SetStationRouting handles PUT /api/stations/{id}/routing
+//
+//	@Summary		Set what is prepared at a station
+//	@Description	Replaces the menu items and categories routed to the station. An order item goes to its menu item's station, or failing that its category's; items routed nowhere are not put on a ticket. Routing an item or category here takes it off any other station.
+//	@Tags			stations
+//	@Accept			json
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			id		path		string							true	"Station ID"	format(uuid)
+//	@Param			request	body		dto.SetStationRoutingRequest	true	"Menu items and categories"
+//	@Success		200		{object}	utils.APIResponse[dto.Station]
+//	@Failure		400		{object}	utils.APIResponse[any]
+//	@Failure		404		{object}	utils.APIResponse[any]
+//	@Failure		500		{object}	utils.APIResponse[any]
+//	@Router			/stations/{id}/routing [put]
+func (h *StationHandler) SetStationRouting(c *gin.Context) {
+	claims := c.MustGet("claims").(utils.JWTClaims)
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		utils.WriteBadRequest(c.Writer, "Invalid station ID format")
+		return
+	}
+	var req dto.SetStationRoutingRequest
+	if err := utils.ParseAndValidateRequest(c, &req); err != nil {
+		utils.WriteBadRequest(c.Writer, err.Error())
+		return
+	}
+	station, err := h.service.SetRouting(c.Request.Context(), authz.NewActorFromClaims(claims), id, &req)
+	if err != nil {
+		apperr.WriteHTTPError(c.Writer, err, "Failed to set station routing")
+		return
+	}
+	utils.WriteSuccess(c.Writer, station)
+}
+
+// GetStationTickets handles GET /api/stations/{id}/tickets
+//
+//	@Summary		List a station's tickets
+//	@Description	Lists the station's tickets, oldest first, leaving out those of cancelled orders. Without status, tickets that are not READY yet are listed.
+//	@Tags			stations
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			id		path		string	true	"Station ID"	format(uuid)
+//	@Param			status	query		string	false	"Only tickets with this status"	Enums(QUEUED, IN_PROGRESS, READY)
+//	@Success		200		{object}	utils.APIResponse[[]dto.StationTicket]
+//	@Failure		400		{object}	utils.APIResponse[any]
+//	@Failure		404		{object}	utils.APIResponse[any]
+//	@Failure		500		{object}	utils.APIResponse[any]
+//	@Router			/stations/{id}/tickets [get]
+func (h *StationHandler) GetStationTickets(c *gin.Context) {
+	claims := c.MustGet("claims").(utils.JWTClaims)
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		utils.WriteBadRequest(c.Writer, "Invalid station ID format")
+		return
+	}
+	var filters dto.StationTicketListFilters
+	if s := c.Query("status"); s != "" {
+		status := dto.TicketStatus(s)
+		switch status {
+		case dto.TicketStatusQUEUED, dto.TicketStatusIN_PROGRESS, dto.TicketStatusREADY:
+		default:
+			utils.WriteBadRequest(c.Writer, "Invalid status")
+			return
+		}
+		filters.Status = &status
+	}
+	tickets, err := h.service.ListTickets(c.Request.Context(), authz.NewActorFromClaims(claims), id, filters)
+	if err != nil {
+		apperr.WriteHTTPError(c.Writer, err, "Failed to retrieve station tickets")
+		return
+	}
+	utils.WriteSuccess(c.Writer, tickets)
+}
+
+// UpdateStationTicket handles PATCH /api/station-tickets/{id}
+//
+//	@Summary		Update a station ticket's status
+//	@Description	Moves a ticket QUEUED -> IN_PROGRESS -> READY (QUEUED -> READY is allowed too); anything else is 409. When the last of an order's tickets becomes READY, an OPEN or CONFIRMED order becomes READY as well.
+//	@Tags			stations
+//	@Accept			json
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			id		path		string							true	"Station ticket ID"	format(uuid)
+//	@Param			request	body		dto.UpdateStationTicketRequest	true	"New status"
+//	@Success		200		{object}	utils.APIResponse[dto.StationTicket]
+//	@Failure		400		{object}	utils.APIResponse[any]
+//	@Failure		404		{object}	utils.APIResponse[any]
+//	@Failure		409		{object}	utils.APIResponse[any]
+//	@Failure		500		{object}	utils.APIResponse[any]
+//	@Router			/station-tickets/{id} [patch]
+func (h *StationHandler) UpdateStationTicket(c *gin.Context) {
+	claims := c.MustGet("claims").(utils.JWTClaims)
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		utils.WriteBadRequest(c.Writer, "Invalid station ticket ID format")
+		return
+	}
+	var req dto.UpdateStationTicketRequest
+	if err := utils.ParseAndValidateRequest(c, &req); err != nil {
+		utils.WriteBadRequest(c.Writer, err.Error())
+		return
+	}
+	ticket, err := h.service.UpdateTicketStatus(c.Request.Context(), authz.NewActorFromClaims(claims), id, &req)
+	if err != nil {
+		apperr.WriteHTTPError(c.Writer, err, "Failed to update station ticket")
+		return
+	}
+	utils.WriteSuccess(c.Writer, ticket)
+}
@@ -0,0 +1,303 @@
+package handler
+
+import (
+	"github.com/Jiruu246/rms/internal/apperr"
+	"github.com/Jiruu246/rms/internal/authz"
+	"github.com/Jiruu246/rms/internal/dto"
+	"github.com/Jiruu246/rms/internal/services"
+	"github.com/Jiruu246/rms/pkg/utils"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+type TableHandler struct {
+	service services.TableService
+}
+
+func NewTableHandler(service services.TableService) *TableHandler {
+	return &TableHandler{service: service}
+}
+
+// CreateTable handles POST /api/tables
+//
+//	@Summary		Create a table
+//	@Description	Creates a dine-in table with a fresh QR token.
+//	@Tags			tables
+//	@Accept			json
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			request	body		dto.CreateTableRequest	true	"Table details"
+//	@Success		201		{object}	utils.APIResponse[dto.Table]
+//	@Failure		400		{object}	utils.APIResponse[any]
+//	@Failure		404		{object}	utils.APIResponse[any]
+//	@Failure		409		{object}	utils.APIResponse[any]
+//	@Failure		500		{object}	utils.APIResponse[any]
+//	@Router			/tables [post]
+func (h *TableHandler) CreateTable(c *gin.Context) {
+	claims := c.MustGet("claims").(utils.JWTClaims)
+	var req dto.CreateTableRequest
+	if err := utils.ParseAndValidateRequest(c, &req); err != nil {
+		utils.WriteBadRequest(c.Writer, err.Error())
+		return
+	}
+	created, err := h.service.Create(c.Request.Context(), authz.NewActorFromClaims(claims), &req)
+	if err != nil {
+		apperr.WriteHTTPError(c.Writer, err, "Failed to create table")
+		return
+	}
+	utils.WriteCreated(c.Writer, created)
+}
+
+// GetTables handles GET /api/tables?restaurant_id=xxx
+//
+//	@Summary		List a restaurant's tables
+//	@Tags			tables
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			restaurant_id	query		string	true	"Restaurant ID"	format(uuid)
+//	@Success		200				{object}	utils.APIResponse[[]dto.Table]
+//	@Failure		400				{object}	utils.APIResponse[any]
+//	@Failure		404				{object}	utils.APIResponse[any]
+//	@Failure		500				{object}	utils.APIResponse[any]
+//	@Router			/tables [get]
+func (h *TableHandler) GetTables(c *gin.Context) {
+	claims := c.MustGet("claims").(utils.JWTClaims)
+	restaurantIDStr := c.Query("restaurant_id")
+	if restaurantIDStr == "" {
+		utils.WriteBadRequest(c.Writer, "restaurant_id is required")
+		return
+	}
+	restaurantID, err := uuid.Parse(restaurantIDStr)
+	if err != nil {
+		utils.WriteBadRequest(c.Writer, "Invalid restaurant_id format")
+		return
+	}
+	tables, err := h.service.GetAllByRestaurant(c.Request.Context(), authz.NewActorFromClaims(claims), restaurantID)
+	if err != nil {
+		apperr.WriteHTTPError(c.Writer, err, "Failed to retrieve tables")
+		return
+	}
+	utils.WriteSuccess(c.Writer, tables)
+}
+
+// GetTable handles GET /api/tables/{id}
+//
+//	@Summary		Get a table by ID
+//	@Tags			tables
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			id	path		string	true	"Table ID"	format(uuid)
+//	@Success		200	{object}	utils.APIResponse[dto.Table]
+//	@Failure		400	{object}	utils.APIResponse[any]
+//	@Failure		404	{object}	utils.APIResponse[any]
+//	@Failure		500	{object}	utils.APIResponse[any]
+//	@Router			/tables/{id} [get]
+func (h *TableHandler) GetTable(c *gin.Context) {
+	claims := c.MustGet("claims").(utils.JWTClaims)
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		utils.WriteBadRequest(c.Writer, "Invalid table ID format")
+		return
+	}
+	table, err := h.service.GetByID(c.Request.Context(), authz.NewActorFromClaims(claims), id)
+	if err != nil {
+		apperr.WriteHTTPError(c.Writer, err, "Failed to retrieve table")
+		return
+	}
+	utils.WriteSuccess(c.Writer, table)
+}
+
+// UpdateTable handles PATCH /api/tables/{id}
+//
+//	@Summary		Update a table
+//	@Description	Inactive tables reject new orders and their QR codes stop resolving.
+//	@Tags			tables
+//	@Accept			json
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			id		path		string					true	"Table ID"	format(uuid)
+//	@Param			request	body		dto.UpdateTableRequest	true	"Fields to update"
+//	@Success		200		{object}	utils.APIResponse[dto.Table]
+//	@Failure		400		{object}	utils.APIResponse[any]
+//	@Failure		404		{object}	utils.APIResponse[any]
+//	@Failure		409		{object}	utils.APIResponse[any]
+//	@Failure		500		{object}	utils.APIResponse[any]
+//	@Router			/tables/{id} [patch]
+func (h *TableHandler) UpdateTable(c *gin.Context) {
+	claims := c.MustGet("claims").(utils.JWTClaims)
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		utils.WriteBadRequest(c.Writer, "Invalid table ID format")
+		return
+	}
+	var req dto.UpdateTableRequest
+	if err := utils.ParseAndValidateRequest(c, &req); err != nil {
+		utils.WriteBadRequest(c.Writer, err.Error())
+		return
+	}
+	updated, err := h.service.Update(c.Request.Context(), authz.NewActorFromClaims(claims), id, &req)
+	if err != nil {
+		apperr.WriteHTTPError(c.Writer, err, "Failed to update table")
+		return
+	}
+	utils.WriteSuccess(c.Writer, updated)
+}
+
+// DeleteTable handles DELETE /api/tables/{id}
+//
+//	@Summary		Delete a table
+//	@Description	Fails with 409 while the table has an open session. Its closed sessions are deleted; their orders are kept.
+//	@Tags			tables
+//	@Security		BearerAuth
+//	@Param			id	path	string	true	"Table ID"	format(uuid)
+//	@Success		204	"No Content"
+//	@Failure		400	{object}	utils.APIResponse[any]
+//	@Failure		404	{object}	utils.APIResponse[any]
+//	@Failure		409	{object}	utils.APIResponse[any]
+//	@Failure		500	{object}	utils.APIResponse[any]
+//	@Router			/tables/{id} [delete]
+func (h *TableHandler) DeleteTable(c *gin.Context) {
+	claims := c.MustGet("claims").(utils.JWTClaims)
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		utils.WriteBadRequest(c.Writer, "Invalid table ID format")
+		return
+	}
+	if err := h.service.Delete(c.Request.Context(), authz.NewActorFromClaims(claims), id); err != nil {
+		apperr.WriteHTTPError(c.Writer, err, "Failed to delete table")
+		return
+	}
+	utils.WriteNoContent(c.Writer)
+}
+
+// RotateTableQRToken handles POST /api/tables/{id}/qr-token
+//
+//	@Summary		Issue a new QR token for a table
+//	@Description	Replaces the table's QR token. QR codes printed with the old token stop working.
+//	@Tags			tables
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			id	path		string	true	"Table ID"	format(uuid)
+//	@Success		200	{object}	utils.APIResponse[dto.Table]
+//	@Failure		400	{object}	utils.APIResponse[any]
+//	@Failure		404	{object}	utils.APIResponse[any]
+//	@Failure		500	{object}	utils.APIResponse[any]
+//	@Router			/tables/{id}/qr-token [post]
+func (h *TableHandler) RotateTableQRToken(c *gin.Context) {
+	claims := c.MustGet("claims").(utils.JWTClaims)
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		utils.WriteBadRequest(c.Writer, "Invalid table ID format")
+		return
+	}
+	table, err := h.service.RotateQRToken(c.Request.Context(), authz.NewActorFromClaims(claims), id)
+	if err != nil {
+		apperr.WriteHTTPError(c.Writer, err, "Failed to rotate table QR token")
+		return
+	}
+	utils.WriteSuccess(c.Writer, table)
+}
+
+// GetOpenTableSession handles GET /api/tables/{id}/session
+//
+//	@Summary		Get a table's open session
+//	@Description	Returns the open bill at the table with its orders, or 404 if nobody is seated there.
+//	@Tags			tables
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			id	path		string	true	"Table ID"	format(uuid)
+//	@Success		200	{object}	utils.APIResponse[dto.TableSession]
+//	@Failure		400	{object}	utils.APIResponse[any]
+//	@Failure		404	{object}	utils.APIResponse[any]
+//	@Failure		500	{object}	utils.APIResponse[any]
+//	@Router			/tables/{id}/session [get]
+func (h *TableHandler) GetOpenTableSession(c *gin.Context) {
+	claims := c.MustGet("claims").(utils.JWTClaims)
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		utils.WriteBadRequest(c.Writer, "Invalid table ID format")
+		return
+	}
+	session, err := h.service.GetOpenSession(c.Request.Context(), authz.NewActorFromClaims(claims), id)
+	if err != nil {
+		apperr.WriteHTTPError(c.Writer, err, "Failed to retrieve table session")
+		return
+	}
+	utils.WriteSuccess(c.Writer, session)
+}
+
+// GetTableSession handles GET /api/table-sessions/{id}
+//
+//	@Summary		Get a table session by ID
+//	@Tags			tables
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			id	path		string	true	"Table session ID"	format(uuid)
+//	@Success		200	{object}	utils.APIResponse[dto.TableSession]
+//	@Failure		400	{object}	utils.APIResponse[any]
+//	@Failure		404	{object}	utils.APIResponse[any]
+//	@Failure		500	{object}	utils.APIResponse[any]
+//	@Router			/table-sessions/{id} [get]
+func (h *TableHandler) GetTableSession(c *gin.Context) {
+	claims := c.MustGet("claims").(utils.JWTClaims)
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		utils.WriteBadRequest(c.Writer, "Invalid table session ID format")
+		return
+	}
+	session, err := h.service.GetSession(c.Request.Context(), authz.NewActorFromClaims(claims), id)
+	if err != nil {
+		apperr.WriteHTTPError(c.Writer, err, "Failed to retrieve table session")
+		return
+	}
+	utils.WriteSuccess(c.Writer, session)
+}
+
+// CloseTableSession handles POST /api/table-sessions/{id}/close
+//
+//	@Summary		Close a table session
+//	@Description	Closes the bill once every order in it is paid (cancelled orders and orders with nothing to pay don't count); otherwise 409. The next order at the table opens a new session.
+//	@Tags			tables
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			id	path		string	true	"Table session ID"	format(uuid)
+//	@Success		200	{object}	utils.APIResponse[dto.TableSession]
+//	@Failure		400	{object}	utils.APIResponse[any]
+//	@Failure		404	{object}	utils.APIResponse[any]
+//	@Failure		409	{object}	utils.APIResponse[any]
+//	@Failure		500	{object}	utils.APIResponse[any]
+//	@Router			/table-sessions/{id}/close [post]
+func (h *TableHandler) CloseTableSession(c *gin.Context) {
+	claims := c.MustGet("claims").(utils.JWTClaims)
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		utils.WriteBadRequest(c.Writer, "Invalid table session ID format")
+		return
+	}
+	session, err := h.service.CloseSession(c.Request.Context(), authz.NewActorFromClaims(claims), id)
+	if err != nil {
+		apperr.WriteHTTPError(c.Writer, err, "Failed to close table session")
+		return
+	}
+	utils.WriteSuccess(c.Writer, session)
+}
+
+// GetPublicTable handles GET /api/public/tables/{token}
+//
+//	@Summary		Resolve a table QR code
+//	@Description	Public (no-auth) lookup of the table behind a QR token, so the ordering page can show the table and load the restaurant's menu. Place orders with the same token as table_token.
+//	@Tags			tables
+//	@Produce		json
+//	@Param			token	path		string	true	"Table QR token"
+//	@Success		200		{object}	utils.APIResponse[dto.PublicTable]
+//	@Failure		404		{object}	utils.APIResponse[any]
+//	@Failure		500		{object}	utils.APIResponse[any]
+//	@Router			/public/tables/{token} [get]
+func (h *TableHandler) GetPublicTable(c *gin.Context) {
+	table, err := h.service.GetByQRToken(c.Request.Context(), c.Param("token"))
+	if err != nil {
+		apperr.WriteHTTPError(c.Writer, err, "Failed to retrieve table")
+		return
+	}
+	utils.WriteSuccess(c.Writer, table)
+}
@@ -40,10 +40,15 @@ func DefaultCORSConfig() CORSConfig {
 			"Accept",
 			"X-Requested-With",
 			"Cache-Control",
+			"Idempotency-Key",
+			"If-None-Match",
 		},
 		ExposeHeaders: []string{
 			"Content-Length",
 			"Content-Type",
+			"Idempotent-Replayed",
+			"Retry-After",
+			"ETag",
 		},
 		AllowCredentials: false,
 		MaxAge:           86400, // 24 hours
@@ -123,10 +128,15 @@ func RestrictiveCORS(allowedOrigins []string) gin.HandlerFunc {
 			"Authorization",
 			"Accept",
 			"X-Requested-With",
+			"Idempotency-Key",
+			"If-None-Match",
 		},
 		ExposeHeaders: []string{
 			"Content-Length",
 			"Content-Type",
+			"Idempotent-Replayed",
+			"Retry-After",
+			"ETag",
 		},
 		AllowCredentials: true,
 		MaxAge:           3600, // 1 hour
@@ -0,0 +1,209 @@
+package middlewares
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"io"
+	"log"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/Jiruu246/rms/internal/apperr"
+	"github.com/Jiruu246/rms/internal/dto"
+	"github.com/Jiruu246/rms/pkg/utils"
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	// IdempotencyKeyHeader carries the client's key for a request that may
+	// be retried.
+	IdempotencyKeyHeader = "Idempotency-Key"
+	// IdempotentReplayedHeader is set on responses replayed for a retry.
+	IdempotentReplayedHeader = "Idempotent-Replayed"
+
+	maxIdempotencyKeyLength = 255
+)
+
+// IdempotencyStore keeps the responses of requests made with an
+// Idempotency-Key; see repos.IdempotencyRepository.
+type IdempotencyStore interface {
+	Begin(ctx context.Context, scope, key, fingerprint string, lockedUntil time.Time) (*dto.IdempotentResponse, error)
+	Complete(ctx context.Context, scope, key string, resp *dto.IdempotentResponse, expiresAt time.Time) error
+	Release(ctx context.Context, scope, key string) error
+}
+
+// IdempotencyConfig holds the configuration for the idempotency middleware
+type IdempotencyConfig struct {
+	// TTL is how long a response is replayed for retries.
+	TTL time.Duration
+	// LockTimeout is how long a request may take before a retry is let
+	// through anyway, in case the server handling it died.
+	LockTimeout time.Duration
+	// Scope returns what keys are unique within for a request, given its
+	// body, e.g. the restaurant it is for. Nil makes keys unique per route.
+	Scope func(c *gin.Context, body []byte) string
+}
+
+// DefaultIdempotencyConfig returns a default idempotency configuration
+func DefaultIdempotencyConfig() IdempotencyConfig {
+	return IdempotencyConfig{
+		TTL:         24 * time.Hour,
+		LockTimeout: time.Minute,
+	}
+}
+
+// TableLookup finds a table by the token in its QR code; see
+// repos.TableRepository.
+type TableLookup interface {
+	GetByQRToken(ctx context.Context, token string) (*dto.Table, error)
+}
+
+const orderRestaurantContextKey = "orderRestaurant"
+
+// OrderRestaurantScope scopes an order request by the restaurant it is for:
+// its restaurant_id or, when that is left out, the restaurant of the table
+// whose table_token it carries. An unknown token scopes by the token itself;
+// the handler rejects such orders anyway. It also serves as a
+// RateLimitRule.Key, and the token is looked up once per request.
+func OrderRestaurantScope(tables TableLookup) func(c *gin.Context, body []byte) string {
+	return func(c *gin.Context, body []byte) string {
+		if id := jsonStringField(body, "restaurant_id"); id != "" {
+			return strings.ToLower(strings.TrimSpace(id))
+		}
+		token := jsonStringField(body, "table_token")
+		if token == "" {
+			return ""
+		}
+		if scope := c.GetString(orderRestaurantContextKey); scope != "" {
+			return scope
+		}
+		scope := "table_token:" + token
+		if table, err := tables.GetByQRToken(c.Request.Context(), token); err == nil {
+			scope = table.RestaurantID.String()
+		}
+		c.Set(orderRestaurantContextKey, scope)
+		return scope
+	}
+}
+
+// Idempotency makes a route safe to retry. The first request with an
+// Idempotency-Key header is handled as usual and its response stored;
+// retries with the same key and body within config.TTL get the stored
+// response back, marked with Idempotent-Replayed, without running the
+// handler again. The method and route count as part of the request, so a
+// key cannot be replayed on another route in the same scope. A retry while
+// the first request is still being handled is 409 with Retry-After, and
+// reusing a key for a different request is 400. Responses with a 5xx
+// status are not stored, so those requests can be retried. Requests
+// without the header are passed through.
+//
+// On routes behind JWT auth, keys are also unique per user: the middleware
+// runs before the handler authorizes the request, so a key shared across
+// users would let one replay a response stored for another.
+func Idempotency(store IdempotencyStore, config IdempotencyConfig) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		key := c.GetHeader(IdempotencyKeyHeader)
+		if key == "" {
+			c.Next()
+			return
+		}
+		if len(key) > maxIdempotencyKeyLength {
+			utils.WriteBadRequest(c.Writer, "Idempotency-Key must be at most 255 characters")
+			c.Abort()
+			return
+		}
+
+		body, err := io.ReadAll(c.Request.Body)
+		if err != nil {
+			utils.WriteBadRequest(c.Writer, "Failed to read request body")
+			c.Abort()
+			return
+		}
+		c.Request.Body = io.NopCloser(bytes.NewReader(body))
+
+		scope := c.FullPath()
+		if config.Scope != nil {
+			scope = config.Scope(c, body)
+		}
+		if claims, ok := c.Get("claims"); ok {
+			if claims, ok := claims.(utils.JWTClaims); ok {
+				scope += " user:" + claims.UserID.String()
+			}
+		}
+		sum := sha256.New()
+		sum.Write([]byte(c.Request.Method + " " + c.FullPath() + "\n"))
+		sum.Write(body)
+		fingerprint := hex.EncodeToString(sum.Sum(nil))
+
+		ctx := c.Request.Context()
+		stored, err := store.Begin(ctx, scope, key, fingerprint, time.Now().Add(config.LockTimeout))
+		if err != nil {
+			if errors.Is(err, apperr.ErrConflict) {
+				c.Header("Retry-After", "1")
+			}
+			apperr.WriteHTTPError(c.Writer, err, "Failed to process Idempotency-Key")
+			c.Abort()
+			return
+		}
+		if stored != nil {
+			c.Header(IdempotentReplayedHeader, "true")
+			if stored.ContentType != "" {
+				c.Header("Content-Type", stored.ContentType)
+			}
+			c.Status(stored.StatusCode)
+			if _, err := c.Writer.Write(stored.Body); err != nil {
+				log.Printf("failed to write idempotent response: %v", err)
+			}
+			c.Abort()
+			return
+		}
+
+		recorder := &responseRecorder{ResponseWriter: c.Writer}
+		c.Writer = recorder
+		completed := false
+		defer func() {
+			// The handler failed or panicked: let the client retry.
+			if !completed {
+				if err := store.Release(context.WithoutCancel(ctx), scope, key); err != nil {
+					log.Printf("failed to release idempotency key: %v", err)
+				}
+			}
+		}()
+
+		c.Next()
+
+		if recorder.Status() >= http.StatusInternalServerError {
+			return
+		}
+		resp := &dto.IdempotentResponse{
+			StatusCode:  recorder.Status(),
+			ContentType: recorder.Header().Get("Content-Type"),
+			Body:        recorder.body.Bytes(),
+		}
+		if err := store.Complete(context.WithoutCancel(ctx), scope, key, resp, time.Now().Add(config.TTL)); err != nil {
+			log.Printf("failed to store idempotent response: %v", err)
+			return
+		}
+		completed = true
+	}
+}
+
+// responseRecorder copies what the handler writes so it can be stored.
+type responseRecorder struct {
+	gin.ResponseWriter
+	body bytes.Buffer
+}
+
+func (w *responseRecorder) Write(b []byte) (int, error) {
+	w.body.Write(b)
+	return w.ResponseWriter.Write(b)
+}
+
+func (w *responseRecorder) WriteString(s string) (int, error) {
+	w.body.WriteString(s)
+	return w.ResponseWriter.WriteString(s)
+}
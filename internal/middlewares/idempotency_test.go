@@ -0,0 +1,66 @@
+package middlewares
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Jiruu246/rms/internal/dto"
+	"github.com/Jiruu246/rms/pkg/utils"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// memoryIdempotencyStore keeps completed responses by scope and key.
+type memoryIdempotencyStore struct {
+	responses map[string]*dto.IdempotentResponse
+}
+
+func (s *memoryIdempotencyStore) Begin(_ context.Context, scope, key, _ string, _ time.Time) (*dto.IdempotentResponse, error) {
+	return s.responses[scope+"\n"+key], nil
+}
+
+func (s *memoryIdempotencyStore) Complete(_ context.Context, scope, key string, resp *dto.IdempotentResponse, _ time.Time) error {
+	s.responses[scope+"\n"+key] = resp
+	return nil
+}
+
+func (s *memoryIdempotencyStore) Release(context.Context, string, string) error {
+	return nil
+}
+
+func TestIdempotency_KeysAreUniquePerUser(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	engine := gin.New()
+	handled := 0
+	engine.POST("/orders", func(c *gin.Context) {
+		c.Set("claims", utils.JWTClaims{UserID: uuid.MustParse(c.GetHeader("X-User"))})
+	}, Idempotency(&memoryIdempotencyStore{responses: map[string]*dto.IdempotentResponse{}}, DefaultIdempotencyConfig()), func(c *gin.Context) {
+		handled++
+		utils.WriteSuccess(c.Writer, c.GetHeader("X-User"))
+	})
+	post := func(userID uuid.UUID) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPost, "/orders", strings.NewReader(`{}`))
+		req.Header.Set(IdempotencyKeyHeader, "key")
+		req.Header.Set("X-User", userID.String())
+		w := httptest.NewRecorder()
+		engine.ServeHTTP(w, req)
+		return w
+	}
+	owner, other := uuid.New(), uuid.New()
+
+	require.Equal(t, http.StatusOK, post(owner).Code)
+	retry := post(owner)
+	assert.Equal(t, "true", retry.Header().Get(IdempotentReplayedHeader))
+	assert.Equal(t, 1, handled)
+
+	w := post(other)
+	assert.Empty(t, w.Header().Get(IdempotentReplayedHeader))
+	assert.Contains(t, w.Body.String(), other.String())
+	assert.Equal(t, 2, handled)
+}
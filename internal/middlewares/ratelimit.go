@@ -0,0 +1,110 @@
+package middlewares
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"math"
+	"net/http"
+	"strings"
+
+	"github.com/Jiruu246/rms/pkg/ratelimit"
+	"github.com/Jiruu246/rms/pkg/utils"
+	"github.com/gin-gonic/gin"
+)
+
+// RateLimitRule limits requests sharing a key, e.g. the client's IP.
+type RateLimitRule struct {
+	// Name prefixes the keys, keeping rules' buckets apart.
+	Name  string
+	Limit ratelimit.Limit
+	// Key returns what the request is limited by, given its body; an empty
+	// key exempts the request from the rule.
+	Key func(c *gin.Context, body []byte) string
+}
+
+// ByIP keys a rule by the client's IP.
+func ByIP(c *gin.Context, _ []byte) string {
+	return c.ClientIP()
+}
+
+// ByJSONField keys a rule by a top-level string field of the JSON request
+// body, case-insensitively, e.g. "restaurant_id" or "email".
+func ByJSONField(field string) func(c *gin.Context, body []byte) string {
+	return func(_ *gin.Context, body []byte) string {
+		return strings.ToLower(strings.TrimSpace(jsonStringField(body, field)))
+	}
+}
+
+// RateLimit refuses requests over any of rules' limits with 429 Too Many
+// Requests and a Retry-After header. Rules with a zero limit are skipped.
+// Rules are taken from in order and the first to refuse a request stops it,
+// so a client over a per-client rule listed first cannot use up a shared
+// bucket behind it.
+// If the store fails the request is let through, so an outage of the store
+// does not take the endpoint down with it.
+func RateLimit(store ratelimit.Store, rules ...RateLimitRule) gin.HandlerFunc {
+	active := make([]RateLimitRule, 0, len(rules))
+	for _, rule := range rules {
+		if !rule.Limit.IsZero() {
+			active = append(active, rule)
+		}
+	}
+
+	return func(c *gin.Context) {
+		if len(active) == 0 {
+			c.Next()
+			return
+		}
+
+		var body []byte
+		if c.Request.Body != nil {
+			var err error
+			body, err = io.ReadAll(c.Request.Body)
+			if err != nil {
+				utils.WriteBadRequest(c.Writer, "Failed to read request body")
+				c.Abort()
+				return
+			}
+			c.Request.Body = io.NopCloser(bytes.NewReader(body))
+		}
+
+		for _, rule := range active {
+			key := rule.Key(c, body)
+			if key == "" {
+				continue
+			}
+			result, err := store.Take(c.Request.Context(), rule.Name+":"+key, rule.Limit)
+			if err != nil {
+				log.Printf("rate limit %s: %v", rule.Name, err)
+				continue
+			}
+			if !result.Allowed {
+				seconds := max(1, int(math.Ceil(result.RetryAfter.Seconds())))
+				c.Header("Retry-After", fmt.Sprint(seconds))
+				utils.WriteError(c.Writer, http.StatusTooManyRequests, "Too many requests, please try again later", map[string]any{
+					"retry_after": seconds,
+				})
+				c.Abort()
+				return
+			}
+		}
+		c.Next()
+	}
+}
+
+// jsonStringField returns the top-level string field of a JSON object, or
+// "" if body is not one or the field is missing or not a string.
+func jsonStringField(body []byte, field string) string {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(body, &fields); err != nil {
+		return ""
+	}
+	var value string
+	if err := json.Unmarshal(fields[field], &value); err != nil {
+		return ""
+	}
+	return value
+}
@@ -0,0 +1,112 @@
+package middlewares
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Jiruu246/rms/pkg/ratelimit"
+	"github.com/Jiruu246/rms/pkg/utils"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type failingStore struct{}
+
+func (failingStore) Take(context.Context, string, ratelimit.Limit) (ratelimit.Result, error) {
+	return ratelimit.Result{}, errors.New("store down")
+}
+
+func newRateLimitedEngine(store ratelimit.Store, rules ...RateLimitRule) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	engine := gin.New()
+	engine.POST("/login", RateLimit(store, rules...), func(c *gin.Context) {
+		var body map[string]string
+		if err := c.ShouldBindJSON(&body); err != nil {
+			utils.WriteBadRequest(c.Writer, "handler could not read body")
+			return
+		}
+		utils.WriteSuccess(c.Writer, body["email"])
+	})
+	return engine
+}
+
+func postLogin(engine *gin.Engine, ip, email string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodPost, "/login", strings.NewReader(`{"email":"`+email+`"}`))
+	req.Header.Set("Content-Type", "application/json")
+	req.RemoteAddr = ip + ":1234"
+	w := httptest.NewRecorder()
+	engine.ServeHTTP(w, req)
+	return w
+}
+
+func TestRateLimit(t *testing.T) {
+	perMinute := ratelimit.Limit{Requests: 1, Period: time.Minute}
+	engine := newRateLimitedEngine(ratelimit.NewMemoryStore(),
+		RateLimitRule{Name: "ip", Limit: ratelimit.Limit{Requests: 3, Period: time.Minute}, Key: ByIP},
+		RateLimitRule{Name: "email", Limit: perMinute, Key: ByJSONField("email")},
+	)
+
+	w := postLogin(engine, "10.0.0.1", "a@example.com")
+	require.Equal(t, http.StatusOK, w.Code)
+	// The handler still gets the body.
+	assert.Contains(t, w.Body.String(), "a@example.com")
+
+	t.Run("by email, case-insensitively", func(t *testing.T) {
+		w := postLogin(engine, "10.0.0.2", "A@Example.com")
+		require.Equal(t, http.StatusTooManyRequests, w.Code)
+		assert.Equal(t, "60", w.Header().Get("Retry-After"))
+
+		var response utils.APIResponse[any]
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+		assert.False(t, response.Success)
+		require.NotNil(t, response.Error)
+		assert.Equal(t, http.StatusTooManyRequests, response.Error.Code)
+		assert.EqualValues(t, 60, response.Error.Details["retry_after"])
+	})
+
+	t.Run("by IP", func(t *testing.T) {
+		assert.Equal(t, http.StatusOK, postLogin(engine, "10.0.0.1", "b@example.com").Code)
+		assert.Equal(t, http.StatusOK, postLogin(engine, "10.0.0.1", "c@example.com").Code)
+		assert.Equal(t, http.StatusTooManyRequests, postLogin(engine, "10.0.0.1", "d@example.com").Code)
+	})
+}
+
+func TestRateLimit_ZeroLimitsAreSkipped(t *testing.T) {
+	engine := newRateLimitedEngine(failingStore{}, RateLimitRule{Name: "ip", Key: ByIP})
+
+	for range 3 {
+		assert.Equal(t, http.StatusOK, postLogin(engine, "10.0.0.1", "a@example.com").Code)
+	}
+}
+
+func TestRateLimit_StoreFailureLetsRequestsThrough(t *testing.T) {
+	engine := newRateLimitedEngine(failingStore{},
+		RateLimitRule{Name: "ip", Limit: ratelimit.Limit{Requests: 1, Period: time.Minute}, Key: ByIP},
+	)
+
+	assert.Equal(t, http.StatusOK, postLogin(engine, "10.0.0.1", "a@example.com").Code)
+	assert.Equal(t, http.StatusOK, postLogin(engine, "10.0.0.1", "a@example.com").Code)
+}
+
+func TestRateLimit_RefusedRequestsLeaveLaterBucketsAlone(t *testing.T) {
+	engine := newRateLimitedEngine(ratelimit.NewMemoryStore(),
+		RateLimitRule{Name: "ip", Limit: ratelimit.Limit{Requests: 1, Period: time.Minute}, Key: ByIP},
+		RateLimitRule{Name: "email", Limit: ratelimit.Limit{Requests: 2, Period: time.Minute}, Key: ByJSONField("email")},
+	)
+
+	// One client over its own limit keeps trying.
+	require.Equal(t, http.StatusOK, postLogin(engine, "10.0.0.1", "shared@example.com").Code)
+	for range 5 {
+		require.Equal(t, http.StatusTooManyRequests, postLogin(engine, "10.0.0.1", "shared@example.com").Code)
+	}
+
+	// The shared bucket still has room for someone else.
+	assert.Equal(t, http.StatusOK, postLogin(engine, "10.0.0.2", "shared@example.com").Code)
+}
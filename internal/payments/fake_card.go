@@ -0,0 +1,48 @@
+package payments
+
+import (
+	"context"
+	"errors"
+)
+
+// Tokens understood by FakeCardProvider. Any other non-empty token is
+// treated as a valid card and approved.
+const (
+	FakeCardTokenDeclined          = "tok_declined"
+	FakeCardTokenInsufficientFunds = "tok_insufficient_funds"
+	FakeCardTokenProviderError     = "tok_provider_error"
+)
+
+// ErrFakeCardUnavailable is returned for FakeCardTokenProviderError to
+// simulate the gateway being down.
+var ErrFakeCardUnavailable = errors.New("fake card provider unavailable")
+
+// FakeCardProvider simulates a card gateway for local development and
+// tests. It never moves money; its behaviour is driven by the token.
+type FakeCardProvider struct{}
+
+func NewFakeCardProvider() *FakeCardProvider {
+	return &FakeCardProvider{}
+}
+
+func (p *FakeCardProvider) Name() string { return "fake_card" }
+
+func (p *FakeCardProvider) RequiresToken() bool { return true }
+
+func (p *FakeCardProvider) Charge(ctx context.Context, req ChargeRequest) (ChargeResult, error) {
+	switch req.Token {
+	case FakeCardTokenDeclined:
+		return ChargeResult{Declined: true, DeclineReason: "card declined"}, nil
+	case FakeCardTokenInsufficientFunds:
+		return ChargeResult{Declined: true, DeclineReason: "insufficient funds"}, nil
+	case FakeCardTokenProviderError:
+		return ChargeResult{}, ErrFakeCardUnavailable
+	}
+	return ChargeResult{Reference: "fake_ch_" + req.PaymentID.String()}, nil
+}
+
+// Refund approves every refund; the fake gateway keeps no balances to
+// check it against.
+func (p *FakeCardProvider) Refund(ctx context.Context, req RefundRequest) (RefundResult, error) {
+	return RefundResult{Reference: "fake_re_" + req.RefundID.String()}, nil
+}
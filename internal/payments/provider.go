@@ -0,0 +1,100 @@
+// Package payments defines the PaymentProvider extension point used to
+// capture order payments, plus the providers that ship with the service.
+// A real card gateway plugs in by implementing Provider and registering it
+// under MethodCard in place of the fake card provider.
+package payments
+
+import (
+	"context"
+
+	"github.com/Jiruu246/rms/pkg/money"
+	"github.com/google/uuid"
+)
+
+// Method is how the customer tenders a payment.
+type Method string
+
+const (
+	MethodCash Method = "CASH"
+	MethodCard Method = "CARD"
+)
+
+// ChargeRequest asks a provider to take Amount for an order.
+type ChargeRequest struct {
+	// PaymentID is the local Payment row for this charge. Providers that
+	// support idempotency keys should pass it through so a retried call
+	// can't charge twice.
+	PaymentID uuid.UUID
+	OrderID   uuid.UUID
+	Amount    money.Money
+	// Token is an opaque, provider-issued payment source (e.g. a tokenized
+	// card). Providers that don't need one ignore it.
+	Token string
+}
+
+// ChargeResult is the provider's answer to a charge that it processed. A
+// declined charge is a normal result (Declined=true), not an error; errors
+// are reserved for the provider being unreachable or misbehaving.
+type ChargeResult struct {
+	Reference     string
+	Declined      bool
+	DeclineReason string
+}
+
+// RefundRequest asks a provider to return Amount from an earlier charge.
+type RefundRequest struct {
+	// RefundID is the local Refund row, passed through for idempotency in
+	// the same way as ChargeRequest.PaymentID.
+	RefundID  uuid.UUID
+	PaymentID uuid.UUID
+	// PaymentReference is the ChargeResult.Reference of the charge being
+	// refunded.
+	PaymentReference string
+	Amount           money.Money
+}
+
+// RefundResult is the provider's answer to a refund it processed. As with
+// charges, a rejected refund is reported with Declined, not an error.
+type RefundResult struct {
+	Reference     string
+	Declined      bool
+	DeclineReason string
+}
+
+// Provider captures and refunds payments for one Method.
+type Provider interface {
+	// Name identifies the provider on stored payments, e.g. "cash".
+	Name() string
+	// RequiresToken reports whether ChargeRequest.Token must be set.
+	RequiresToken() bool
+	Charge(ctx context.Context, req ChargeRequest) (ChargeResult, error)
+	Refund(ctx context.Context, req RefundRequest) (RefundResult, error)
+}
+
+// Providers maps each accepted Method to the Provider that handles it.
+type Providers map[Method]Provider
+
+// Named returns the provider whose Name is name. Refunds use it to reach
+// the provider that took the original payment.
+func (p Providers) Named(name string) (Provider, bool) {
+	for _, provider := range p {
+		if provider.Name() == name {
+			return provider, true
+		}
+	}
+	return nil, false
+}
+
+// NewDefaultProviders returns the built-in providers: cash and, if fakeCard
+// is set, the fake card provider for local development and tests. Without
+// it no provider handles MethodCard, so card payments are rejected until a
+// real gateway is registered.
+func NewDefaultProviders(fakeCard bool) Providers {
+	providers := Providers{
+		MethodCash: NewCashProvider(),
+	}
+	if fakeCard {
+		providers[MethodCard] = NewFakeCardProvider()
+	}
+	return providers
+}
@@ -0,0 +1,631 @@
+package repos
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/Jiruu246/rms/internal/apperr"
+	"github.com/Jiruu246/rms/internal/dto"
+	"github.com/Jiruu246/rms/internal/ent"
+	"github.com/Jiruu246/rms/internal/ent/category"
+	"github.com/Jiruu246/rms/internal/ent/menuitem"
+	"github.com/Jiruu246/rms/internal/ent/modifier"
+	"github.com/Jiruu246/rms/internal/ent/modifieroption"
+	"github.com/Jiruu246/rms/internal/ent/restaurant"
+	"github.com/google/uuid"
+)
+
+type CatalogueRepository interface {
+	// Export returns the restaurant's whole catalogue, with the IDs of its
+	// rows so that importing it again updates them in place.
+	Export(ctx context.Context, restaurantID uuid.UUID) (*dto.Catalogue, error)
+	// Import upserts c into the restaurant's catalogue in one transaction,
+	// see dto.Catalogue. Keys must already be filled in and unique, and
+	// items must only refer to keys in c. On a dry run the transaction is
+	// rolled back and only the report is kept. Rows whose image_url
+	// changes lose their uploaded image; the blob keys of those images are
+	// returned for the caller to delete.
+	Import(ctx context.Context, restaurantID uuid.UUID, c *dto.Catalogue, dryRun bool) (*dto.CatalogueImportReport, []string, error)
+}
+
+type catalogueRepository struct {
+	client *ent.Client
+}
+
+func NewEntCatalogueRepository(client *ent.Client) CatalogueRepository {
+	return &catalogueRepository{client: client}
+}
+
+func (r *catalogueRepository) Export(ctx context.Context, restaurantID uuid.UUID) (*dto.Catalogue, error) {
+	rest, err := r.client.Restaurant.
+		Query().
+		Where(restaurant.ID(restaurantID)).
+		WithCategories(func(q *ent.CategoryQuery) {
+			q.Order(category.ByDisplayOrder(), category.ByName(), category.ByID())
+		}).
+		WithModifiers(func(q *ent.ModifierQuery) {
+			q.Order(modifier.ByName(), modifier.ByID()).
+				WithModifierOptions(func(q *ent.ModifierOptionQuery) {
+					q.Order(modifieroption.ByDisplayOrder(), modifieroption.ByName(), modifieroption.ByID())
+				})
+		}).
+		WithMenuItems(func(q *ent.MenuItemQuery) {
+			q.Order(menuitem.ByDisplayOrder(), menuitem.ByName(), menuitem.ByID()).
+				WithModifiers(func(q *ent.ModifierQuery) {
+					q.Select(modifier.FieldID).Order(modifier.ByName(), modifier.ByID())
+				})
+		}).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, apperr.NotFound("restaurant %s", restaurantID)
+		}
+		return nil, fmt.Errorf("failed to get catalogue: %w", err)
+	}
+
+	return mapToCatalogue(rest), nil
+}
+
+func (r *catalogueRepository) Import(ctx context.Context, restaurantID uuid.UUID, c *dto.Catalogue, dryRun bool) (*dto.CatalogueImportReport, []string, error) {
+	tx, err := r.client.Tx(ctx)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to start transaction: %w", err)
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+
+	imp := &catalogueImport{
+		tx:           tx,
+		restaurantID: restaurantID,
+		report:       &dto.CatalogueImportReport{DryRun: dryRun, Changes: []dto.CatalogueChange{}},
+		categoryIDs:  make(map[string]uuid.UUID, len(c.Categories)),
+		modifierIDs:  make(map[string]uuid.UUID, len(c.Modifiers)),
+	}
+	if err = imp.run(ctx, c); err != nil {
+		return nil, nil, err
+	}
+
+	if dryRun {
+		if err = tx.Rollback(); err != nil {
+			return nil, nil, fmt.Errorf("failed to roll back transaction: %w", err)
+		}
+		for i, change := range imp.report.Changes {
+			if change.Action == dto.CatalogueCREATE {
+				imp.report.Changes[i].ID = ""
+			}
+		}
+		return imp.report, nil, nil
+	}
+	if err = tx.Commit(); err != nil {
+		return nil, nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return imp.report, imp.replacedImages, nil
+}
+
+// catalogueImport is the state of one Import: the rows the file's keys
+// resolved to, and what has changed so far.
+type catalogueImport struct {
+	tx           *ent.Tx
+	restaurantID uuid.UUID
+	report       *dto.CatalogueImportReport
+	categoryIDs  map[string]uuid.UUID
+	modifierIDs  map[string]uuid.UUID
+	// replacedImages are the blob keys of uploaded images whose URL the
+	// file changed.
+	replacedImages []string
+}
+
+func (imp *catalogueImport) run(ctx context.Context, c *dto.Catalogue) error {
+	currency, err := imp.tx.Restaurant.Query().
+		Where(restaurant.IDEQ(imp.restaurantID)).
+		Select(restaurant.FieldCurrency).
+		String(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return apperr.NotFound("restaurant %s", imp.restaurantID)
+		}
+		return fmt.Errorf("failed to get restaurant currency: %w", err)
+	}
+	if c.Currency != "" && c.Currency != currency {
+		return apperr.Invalid("catalogue prices are in %s but the restaurant uses %s", c.Currency, currency)
+	}
+
+	if err := imp.categories(ctx, c.Categories); err != nil {
+		return err
+	}
+	if err := imp.modifiers(ctx, c.Modifiers); err != nil {
+		return err
+	}
+	return imp.items(ctx, c.Items)
+}
+
+func (imp *catalogueImport) categories(ctx context.Context, entries []dto.CatalogueCategory) error {
+	rows, err := imp.tx.Category.Query().
+		Where(category.RestaurantIDEQ(imp.restaurantID)).
+		All(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get categories: %w", err)
+	}
+	match := newCatalogueMatcher(rows, func(c *ent.Category) uuid.UUID { return c.ID }, func(c *ent.Category) string { return c.Name })
+
+	for _, in := range entries {
+		isActive := in.IsActive == nil || *in.IsActive
+		row, err := match.find(in.ID, in.Name, "category "+strconv.Quote(in.Key))
+		if err != nil {
+			return err
+		}
+
+		if row == nil {
+			created, err := imp.tx.Category.Create().
+				SetName(in.Name).
+				SetDescription(in.Description).
+				SetDisplayOrder(in.DisplayOrder).
+				SetIsActive(isActive).
+				SetRestaurantID(imp.restaurantID).
+				Save(ctx)
+			if err != nil {
+				return fmt.Errorf("failed to create category %q: %w", in.Key, err)
+			}
+			imp.categoryIDs[in.Key] = created.ID
+			imp.created(dto.CatalogueCATEGORY, in.Key, created.ID.String())
+			continue
+		}
+
+		imp.categoryIDs[in.Key] = row.ID
+		var fields []string
+		update := imp.tx.Category.UpdateOneID(row.ID)
+		if changed(&fields, "name", row.Name != in.Name) {
+			update.SetName(in.Name)
+		}
+		if changed(&fields, "description", row.Description != in.Description) {
+			update.SetDescription(in.Description)
+		}
+		if changed(&fields, "display_order", row.DisplayOrder != in.DisplayOrder) {
+			update.SetDisplayOrder(in.DisplayOrder)
+		}
+		if changed(&fields, "is_active", row.IsActive != isActive) {
+			update.SetIsActive(isActive)
+		}
+		if len(fields) == 0 {
+			imp.report.Unchanged++
+			continue
+		}
+		if err := update.Exec(ctx); err != nil {
+			return fmt.Errorf("failed to update category %q: %w", in.Key, err)
+		}
+		imp.updated(dto.CatalogueCATEGORY, in.Key, row.ID.String(), fields)
+	}
+	return nil
+}
+
+func (imp *catalogueImport) modifiers(ctx context.Context, entries []dto.CatalogueModifier) error {
+	rows, err := imp.tx.Modifier.Query().
+		Where(modifier.RestaurantIDEQ(imp.restaurantID)).
+		WithModifierOptions().
+		All(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get modifiers: %w", err)
+	}
+	match := newCatalogueMatcher(rows, func(m *ent.Modifier) uuid.UUID { return m.ID }, func(m *ent.Modifier) string { return m.Name })
+
+	for _, in := range entries {
+		row, err := match.find(in.ID, in.Name, "modifier "+strconv.Quote(in.Key))
+		if err != nil {
+			return err
+		}
+
+		var modifierID uuid.UUID
+		var options []*ent.ModifierOption
+		if row == nil {
+			created, err := imp.tx.Modifier.Create().
+				SetName(in.Name).
+				SetRequired(in.Required).
+				SetMultiSelect(in.MultiSelect).
+				SetMax(in.Max).
+				SetMin(in.Min).
+				SetFreeQuantity(in.FreeQuantity).
+				SetRestaurantID(imp.restaurantID).
+				Save(ctx)
+			if err != nil {
+				return fmt.Errorf("failed to create modifier %q: %w", in.Key, err)
+			}
+			modifierID = created.ID
+			imp.created(dto.CatalogueMODIFIER, in.Key, created.ID.String())
+		} else {
+			modifierID = row.ID
+			options = row.Edges.ModifierOptions
+			var fields []string
+			update := imp.tx.Modifier.UpdateOneID(row.ID)
+			if changed(&fields, "name", row.Name != in.Name) {
+				update.SetName(in.Name)
+			}
+			if changed(&fields, "required", row.Required != in.Required) {
+				update.SetRequired(in.Required)
+			}
+			if changed(&fields, "multi_select", row.MultiSelect != in.MultiSelect) {
+				update.SetMultiSelect(in.MultiSelect)
+			}
+			if changed(&fields, "max", row.Max != in.Max) {
+				update.SetMax(in.Max)
+			}
+			if changed(&fields, "min", row.Min != in.Min) {
+				update.SetMin(in.Min)
+			}
+			if changed(&fields, "free_quantity", row.FreeQuantity != in.FreeQuantity) {
+				update.SetFreeQuantity(in.FreeQuantity)
+			}
+			if len(fields) == 0 {
+				imp.report.Unchanged++
+			} else {
+				if err := update.Exec(ctx); err != nil {
+					return fmt.Errorf("failed to update modifier %q: %w", in.Key, err)
+				}
+				imp.updated(dto.CatalogueMODIFIER, in.Key, row.ID.String(), fields)
+			}
+		}
+		imp.modifierIDs[in.Key] = modifierID
+
+		if err := imp.options(ctx, in.Key, modifierID, options, in.Options); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// options upserts the options of one modifier; rows are the options it
+// already has.
+func (imp *catalogueImport) options(ctx context.Context, modifierKey string, modifierID uuid.UUID, rows []*ent.ModifierOption, entries []dto.CatalogueModifierOption) error {
+	match := newCatalogueMatcher(rows, func(o *ent.ModifierOption) uuid.UUID { return o.ID }, func(o *ent.ModifierOption) string { return o.Name })
+
+	for _, in := range entries {
+		key := modifierKey + "/" + in.Key
+		available := in.Available == nil || *in.Available
+		row, err := match.find(in.ID, in.Name, "modifier option "+strconv.Quote(key))
+		if err != nil {
+			return err
+		}
+
+		if row == nil {
+			created, err := imp.tx.ModifierOption.Create().
+				SetName(in.Name).
+				SetPrice(in.Price).
+				SetImageURL(in.ImageURL).
+				SetAvailable(available).
+				SetPreSelect(in.PreSelect).
+				SetDisplayOrder(in.DisplayOrder).
+				SetMaxQuantity(in.MaxQuantity).
+				SetModifierID(modifierID).
+				Save(ctx)
+			if err != nil {
+				return fmt.Errorf("failed to create modifier option %q: %w", key, err)
+			}
+			imp.created(dto.CatalogueOPTION, key, created.ID.String())
+			continue
+		}
+
+		var fields []string
+		update := imp.tx.ModifierOption.UpdateOneID(row.ID)
+		if changed(&fields, "name", row.Name != in.Name) {
+			update.SetName(in.Name)
+		}
+		if changed(&fields, "price", row.Price != in.Price) {
+			update.SetPrice(in.Price)
+		}
+		if changed(&fields, "image_url", row.ImageURL != in.ImageURL) {
+			update.SetImageURL(in.ImageURL).SetThumbnailURL("").ClearImageKeys()
+			imp.replacedImages = append(imp.replacedImages, row.ImageKeys...)
+		}
+		if changed(&fields, "available", row.Available != available) {
+			update.SetAvailable(available).SetOutOfStock(false)
+		}
+		if changed(&fields, "pre_select", row.PreSelect != in.PreSelect) {
+			update.SetPreSelect(in.PreSelect)
+		}
+		if changed(&fields, "display_order", row.DisplayOrder != in.DisplayOrder) {
+			update.SetDisplayOrder(in.DisplayOrder)
+		}
+		if changed(&fields, "max_quantity", row.MaxQuantity != in.MaxQuantity) {
+			update.SetMaxQuantity(in.MaxQuantity)
+		}
+		if len(fields) == 0 {
+			imp.report.Unchanged++
+			continue
+		}
+		if err := update.Exec(ctx); err != nil {
+			return fmt.Errorf("failed to update modifier option %q: %w", key, err)
+		}
+		imp.updated(dto.CatalogueOPTION, key, row.ID.String(), fields)
+	}
+	return nil
+}
+
+func (imp *catalogueImport) items(ctx context.Context, entries []dto.CatalogueItem) error {
+	rows, err := imp.tx.MenuItem.Query().
+		Where(menuitem.RestaurantIDEQ(imp.restaurantID)).
+		WithModifiers(func(q *ent.ModifierQuery) {
+			q.Select(modifier.FieldID)
+		}).
+		All(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get menu items: %w", err)
+	}
+	match := newCatalogueMatcher(rows, func(i *ent.MenuItem) int64 { return i.ID }, func(i *ent.MenuItem) string { return i.Name })
+
+	for _, in := range entries {
+		isAvailable := in.IsAvailable == nil || *in.IsAvailable
+		categoryID := imp.categoryIDs[in.Category]
+		modifierIDs := make([]uuid.UUID, 0, len(in.Modifiers))
+		for _, key := range in.Modifiers {
+			modifierIDs = append(modifierIDs, imp.modifierIDs[key])
+		}
+		slices.SortFunc(modifierIDs, compareUUIDs)
+
+		row, err := match.find(in.ID, in.Name, "menu item "+strconv.Quote(in.Name))
+		if err != nil {
+			return err
+		}
+
+		if row == nil {
+			create := imp.tx.MenuItem.Create().
+				SetName(in.Name).
+				SetDescription(in.Description).
+				SetPrice(in.Price).
+				SetImageURL(in.ImageURL).
+				SetIsAvailable(isAvailable).
+				SetDisplayOrder(in.DisplayOrder).
+				SetRestaurantID(imp.restaurantID).
+				AddModifierIDs(modifierIDs...)
+			if categoryID != uuid.Nil {
+				create.SetCategoryID(categoryID)
+			}
+			created, err := create.Save(ctx)
+			if err != nil {
+				return fmt.Errorf("failed to create menu item %q: %w", in.Name, err)
+			}
+			imp.created(dto.CatalogueITEM, in.Name, strconv.FormatInt(created.ID, 10))
+			continue
+		}
+
+		current := make([]uuid.UUID, 0, len(row.Edges.Modifiers))
+		for _, m := range row.Edges.Modifiers {
+			current = append(current, m.ID)
+		}
+		slices.SortFunc(current, compareUUIDs)
+
+		var fields []string
+		update := imp.tx.MenuItem.UpdateOneID(row.ID)
+		if changed(&fields, "name", row.Name != in.Name) {
+			update.SetName(in.Name)
+		}
+		if changed(&fields, "description", row.Description != in.Description) {
+			update.SetDescription(in.Description)
+		}
+		if changed(&fields, "price", row.Price != in.Price) {
+			update.SetPrice(in.Price)
+		}
+		if changed(&fields, "image_url", row.ImageURL != in.ImageURL) {
+			update.SetImageURL(in.ImageURL).SetThumbnailURL("").ClearImageKeys()
+			imp.replacedImages = append(imp.replacedImages, row.ImageKeys...)
+		}
+		if changed(&fields, "is_available", row.IsAvailable != isAvailable) {
+			update.SetIsAvailable(isAvailable).SetOutOfStock(false)
+		}
+		if changed(&fields, "display_order", row.DisplayOrder != in.DisplayOrder) {
+			update.SetDisplayOrder(in.DisplayOrder)
+		}
+		if changed(&fields, "category", row.CategoryID != categoryID) {
+			if categoryID == uuid.Nil {
+				update.ClearCategory()
+			} else {
+				update.SetCategoryID(categoryID)
+			}
+		}
+		if changed(&fields, "modifiers", !slices.Equal(current, modifierIDs)) {
+			// Groups the item keeps keep its overrides of them.
+			for _, id := range current {
+				if !slices.Contains(modifierIDs, id) {
+					update.RemoveModifierIDs(id)
+				}
+			}
+			for _, id := range modifierIDs {
+				if !slices.Contains(current, id) {
+					update.AddModifierIDs(id)
+				}
+			}
+		}
+		if len(fields) == 0 {
+			imp.report.Unchanged++
+			continue
+		}
+		if err := update.Exec(ctx); err != nil {
+			return fmt.Errorf("failed to update menu item %q: %w", in.Name, err)
+		}
+		imp.updated(dto.CatalogueITEM, in.Name, strconv.FormatInt(row.ID, 10), fields)
+	}
+	return nil
+}
+
+func (imp *catalogueImport) created(kind dto.CatalogueEntityKind, key, id string) {
+	imp.report.Created++
+	imp.report.Changes = append(imp.report.Changes, dto.CatalogueChange{
+		Kind:   kind,
+		Key:    key,
+		Action: dto.CatalogueCREATE,
+		ID:     id,
+	})
+}
+
+func (imp *catalogueImport) updated(kind dto.CatalogueEntityKind, key, id string, fields []string) {
+	imp.report.Updated++
+	imp.report.Changes = append(imp.report.Changes, dto.CatalogueChange{
+		Kind:   kind,
+		Key:    key,
+		Action: dto.CatalogueUPDATE,
+		ID:     id,
+		Fields: fields,
+	})
+}
+
+// changed records name in fields when the field changed, and reports it.
+func changed(fields *[]string, name string, changed bool) bool {
+	if changed {
+		*fields = append(*fields, name)
+	}
+	return changed
+}
+
+func compareUUIDs(a, b uuid.UUID) int {
+	return strings.Compare(a.String(), b.String())
+}
+
+// catalogueMatcher finds the existing row an imported entry refers to: the
+// row with its ID, or else the only row with its name. Each row may be
+// matched once.
+type catalogueMatcher[K comparable, T any] struct {
+	byID    map[K]T
+	byName  map[string][]T
+	idOf    func(T) K
+	matched map[K]bool
+}
+
+func newCatalogueMatcher[K comparable, T any](rows []T, idOf func(T) K, nameOf func(T) string) *catalogueMatcher[K, T] {
+	m := &catalogueMatcher[K, T]{
+		byID:    make(map[K]T, len(rows)),
+		byName:  make(map[string][]T, len(rows)),
+		idOf:    idOf,
+		matched: make(map[K]bool),
+	}
+	for _, row := range rows {
+		m.byID[idOf(row)] = row
+		m.byName[nameOf(row)] = append(m.byName[nameOf(row)], row)
+	}
+	return m
+}
+
+// find returns the row id or name refers to, or the zero T when the entry
+// is new. desc names the entry in errors.
+func (m *catalogueMatcher[K, T]) find(id *K, name, desc string) (T, error) {
+	var row T
+	if id != nil {
+		found, ok := m.byID[*id]
+		if !ok {
+			return row, apperr.Invalid("%s: %v is not in this restaurant's catalogue", desc, *id)
+		}
+		row = found
+	} else {
+		switch rows := m.byName[name]; len(rows) {
+		case 0:
+			return row, nil
+		case 1:
+			row = rows[0]
+		default:
+			return row, apperr.Invalid("%s: more than one is named %q, set its id", desc, name)
+		}
+	}
+
+	if m.matched[m.idOf(row)] {
+		var zero T
+		return zero, apperr.Invalid("%s: %v is matched by more than one entry", desc, m.idOf(row))
+	}
+	m.matched[m.idOf(row)] = true
+	return row, nil
+}
+
+// mapToCatalogue maps a restaurant loaded with its categories, modifiers
+// and their options, and items with their modifier IDs. Keys are names,
+// or IDs where a name is shared.
+func mapToCatalogue(rest *ent.Restaurant) *dto.Catalogue {
+	c := &dto.Catalogue{
+		Version:    dto.CatalogueVersion,
+		Currency:   rest.Currency,
+		Categories: make([]dto.CatalogueCategory, 0, len(rest.Edges.Categories)),
+		Modifiers:  make([]dto.CatalogueModifier, 0, len(rest.Edges.Modifiers)),
+		Items:      make([]dto.CatalogueItem, 0, len(rest.Edges.MenuItems)),
+	}
+
+	categoryKeys := catalogueKeys(rest.Edges.Categories, func(c *ent.Category) (uuid.UUID, string) { return c.ID, c.Name })
+	for _, cat := range rest.Edges.Categories {
+		c.Categories = append(c.Categories, dto.CatalogueCategory{
+			Key:          categoryKeys[cat.ID],
+			ID:           &cat.ID,
+			Name:         cat.Name,
+			Description:  cat.Description,
+			DisplayOrder: cat.DisplayOrder,
+			IsActive:     &cat.IsActive,
+		})
+	}
+
+	modifierKeys := catalogueKeys(rest.Edges.Modifiers, func(m *ent.Modifier) (uuid.UUID, string) { return m.ID, m.Name })
+	for _, mod := range rest.Edges.Modifiers {
+		optionKeys := catalogueKeys(mod.Edges.ModifierOptions, func(o *ent.ModifierOption) (uuid.UUID, string) { return o.ID, o.Name })
+		options := make([]dto.CatalogueModifierOption, 0, len(mod.Edges.ModifierOptions))
+		for _, opt := range mod.Edges.ModifierOptions {
+			options = append(options, dto.CatalogueModifierOption{
+				Key:          optionKeys[opt.ID],
+				ID:           &opt.ID,
+				Name:         opt.Name,
+				Price:        opt.Price,
+				ImageURL:     opt.ImageURL,
+				PreSelect:    opt.PreSelect,
+				DisplayOrder: opt.DisplayOrder,
+				MaxQuantity:  opt.MaxQuantity,
+				Available:    &opt.Available,
+			})
+		}
+		c.Modifiers = append(c.Modifiers, dto.CatalogueModifier{
+			Key:          modifierKeys[mod.ID],
+			ID:           &mod.ID,
+			Name:         mod.Name,
+			Required:     mod.Required,
+			MultiSelect:  mod.MultiSelect,
+			Max:          mod.Max,
+			Min:          mod.Min,
+			FreeQuantity: mod.FreeQuantity,
+			Options:      options,
+		})
+	}
+
+	for _, item := range rest.Edges.MenuItems {
+		modifiers := make([]string, 0, len(item.Edges.Modifiers))
+		for _, mod := range item.Edges.Modifiers {
+			modifiers = append(modifiers, modifierKeys[mod.ID])
+		}
+		c.Items = append(c.Items, dto.CatalogueItem{
+			ID:           &item.ID,
+			Name:         item.Name,
+			Description:  item.Description,
+			Price:        item.Price,
+			ImageURL:     item.ImageURL,
+			DisplayOrder: item.DisplayOrder,
+			IsAvailable:  &item.IsAvailable,
+			Category:     categoryKeys[item.CategoryID],
+			Modifiers:    modifiers,
+		})
+	}
+	return c
+}
+
+// catalogueKeys keys rows by name, or by ID where rows share a name.
+func catalogueKeys[T any](rows []T, idAndName func(T) (uuid.UUID, string)) map[uuid.UUID]string {
+	named := make(map[string]int, len(rows))
+	for _, row := range rows {
+		_, name := idAndName(row)
+		named[name]++
+	}
+	keys := make(map[uuid.UUID]string, len(rows))
+	for _, row := range rows {
+		id, name := idAndName(row)
+		if named[name] > 1 {
+			keys[id] = id.String()
+		} else {
+			keys[id] = name
+		}
+	}
+	return keys
+}
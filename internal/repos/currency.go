@@ -0,0 +1,46 @@
+package repos
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Jiruu246/rms/internal/apperr"
+	"github.com/Jiruu246/rms/internal/ent"
+	"github.com/Jiruu246/rms/internal/ent/restaurant"
+	"github.com/Jiruu246/rms/pkg/money"
+	"github.com/google/uuid"
+)
+
+// Prices are stored as bare minor units; the currency they are in lives on
+// the owning restaurant. These helpers load just that column so mappers can
+// build money.Money values.
+
+// withRestaurantCurrency is an eager-loading option that selects only the
+// columns needed by restaurantCurrencyOf.
+func withRestaurantCurrency(q *ent.RestaurantQuery) {
+	q.Select(restaurant.FieldID, restaurant.FieldCurrency)
+}
+
+// restaurantCurrencyOf returns the currency of an eager-loaded restaurant
+// edge. r is nil only if the caller forgot to load the edge, which is a bug.
+func restaurantCurrencyOf(r *ent.Restaurant) money.Currency {
+	if r == nil {
+		return ""
+	}
+	return money.Currency(r.Currency)
+}
+
+// getRestaurantCurrency loads the currency of restaurantID.
+func getRestaurantCurrency(ctx context.Context, client *ent.Client, restaurantID uuid.UUID) (money.Currency, error) {
+	code, err := client.Restaurant.Query().
+		Where(restaurant.IDEQ(restaurantID)).
+		Select(restaurant.FieldCurrency).
+		String(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return "", apperr.NotFound("restaurant %s", restaurantID)
+		}
+		return "", fmt.Errorf("failed to get restaurant currency: %w", err)
+	}
+	return money.Currency(code), nil
+}
@@ -0,0 +1,235 @@
+package repos
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/Jiruu246/rms/internal/apperr"
+	"github.com/Jiruu246/rms/internal/authz"
+	"github.com/Jiruu246/rms/internal/dto"
+	"github.com/Jiruu246/rms/internal/ent"
+	"github.com/Jiruu246/rms/internal/ent/deliveryzone"
+	"github.com/Jiruu246/rms/internal/ent/restaurant"
+	"github.com/Jiruu246/rms/pkg/money"
+	"github.com/google/uuid"
+)
+
+type DeliveryZoneRepository interface {
+	Create(ctx context.Context, req *dto.CreateDeliveryZoneRequest) (*dto.DeliveryZone, error)
+	GetByID(ctx context.Context, restaurantID, id uuid.UUID) (*dto.DeliveryZone, error)
+	// GetAllByRestaurant lists the restaurant's zones, only the active ones
+	// if activeOnly is set.
+	GetAllByRestaurant(ctx context.Context, restaurantID uuid.UUID, activeOnly bool) ([]*dto.DeliveryZone, error)
+	// Update applies req. Switching kind clears the other kind's shape, so
+	// the service must have checked the resulting zone is complete.
+	Update(ctx context.Context, restaurantID, id uuid.UUID, req *dto.UpdateDeliveryZoneRequest) (*dto.DeliveryZone, error)
+	Delete(ctx context.Context, restaurantID, id uuid.UUID) error
+	GetAuthorizationResource(ctx context.Context, id uuid.UUID) (authz.Resource, error)
+}
+
+type deliveryZoneRepository struct {
+	client *ent.Client
+}
+
+func NewEntDeliveryZoneRepository(client *ent.Client) DeliveryZoneRepository {
+	return &deliveryZoneRepository{client: client}
+}
+
+func (r *deliveryZoneRepository) Create(ctx context.Context, req *dto.CreateDeliveryZoneRequest) (*dto.DeliveryZone, error) {
+	create := r.client.DeliveryZone.
+		Create().
+		SetName(req.Name).
+		SetKind(deliveryzone.Kind(req.Kind)).
+		SetDeliveryFee(req.DeliveryFee).
+		SetMinOrderValue(req.MinOrderValue).
+		SetRestaurantID(req.RestaurantID)
+	switch dto.DeliveryZoneKind(req.Kind) {
+	case dto.DeliveryZoneKindRADIUS:
+		create.SetNillableRadiusMeters(req.RadiusMeters)
+	case dto.DeliveryZoneKindPOLYGON:
+		create.SetPolygon(req.Polygon)
+	}
+
+	created, err := create.Save(ctx)
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return nil, apperr.Conflict("a delivery zone named %s already exists", req.Name)
+		}
+		return nil, fmt.Errorf("failed to create delivery zone: %w", err)
+	}
+
+	return r.GetByID(ctx, req.RestaurantID, created.ID)
+}
+
+func (r *deliveryZoneRepository) GetByID(ctx context.Context, restaurantID, id uuid.UUID) (*dto.DeliveryZone, error) {
+	zone, err := r.query().
+		Where(deliveryzone.ID(id), deliveryzone.RestaurantIDEQ(restaurantID)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, apperr.NotFound("delivery zone %s", id)
+		}
+		return nil, fmt.Errorf("failed to get delivery zone: %w", err)
+	}
+
+	return mapToDeliveryZone(zone), nil
+}
+
+func (r *deliveryZoneRepository) GetAllByRestaurant(ctx context.Context, restaurantID uuid.UUID, activeOnly bool) ([]*dto.DeliveryZone, error) {
+	query := r.query().
+		Where(deliveryzone.RestaurantIDEQ(restaurantID)).
+		Order(deliveryzone.ByName())
+	if activeOnly {
+		query.Where(deliveryzone.IsActive(true))
+	}
+
+	rows, err := query.All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get delivery zones: %w", err)
+	}
+
+	zones := make([]*dto.DeliveryZone, 0, len(rows))
+	for _, zone := range rows {
+		zones = append(zones, mapToDeliveryZone(zone))
+	}
+	return zones, nil
+}
+
+func (r *deliveryZoneRepository) Update(ctx context.Context, restaurantID, id uuid.UUID, req *dto.UpdateDeliveryZoneRequest) (*dto.DeliveryZone, error) {
+	update := r.client.DeliveryZone.UpdateOneID(id).Where(deliveryzone.RestaurantIDEQ(restaurantID))
+
+	hasUpdates := false
+
+	if req.Name != nil {
+		if strings.TrimSpace(*req.Name) == "" {
+			return nil, apperr.Invalid("name cannot be empty")
+		}
+		update.SetName(*req.Name)
+		hasUpdates = true
+	}
+
+	if req.Kind != nil {
+		update.SetKind(deliveryzone.Kind(*req.Kind))
+		switch dto.DeliveryZoneKind(*req.Kind) {
+		case dto.DeliveryZoneKindRADIUS:
+			update.ClearPolygon()
+		case dto.DeliveryZoneKindPOLYGON:
+			update.ClearRadiusMeters()
+		}
+		hasUpdates = true
+	}
+
+	if req.RadiusMeters != nil {
+		update.SetRadiusMeters(*req.RadiusMeters)
+		hasUpdates = true
+	}
+
+	if req.Polygon != nil {
+		update.SetPolygon(*req.Polygon)
+		hasUpdates = true
+	}
+
+	if req.DeliveryFee != nil {
+		update.SetDeliveryFee(*req.DeliveryFee)
+		hasUpdates = true
+	}
+
+	if req.MinOrderValue != nil {
+		update.SetMinOrderValue(*req.MinOrderValue)
+		hasUpdates = true
+	}
+
+	if req.IsActive != nil {
+		update.SetIsActive(*req.IsActive)
+		hasUpdates = true
+	}
+
+	if !hasUpdates {
+		return nil, apperr.Invalid("no valid fields provided for update")
+	}
+
+	if err := update.Exec(ctx); err != nil {
+		if ent.IsNotFound(err) {
+			return nil, apperr.NotFound("delivery zone %s", id)
+		}
+		if ent.IsConstraintError(err) {
+			return nil, apperr.Conflict("a delivery zone named %s already exists", *req.Name)
+		}
+		return nil, fmt.Errorf("failed to update delivery zone: %w", err)
+	}
+
+	return r.GetByID(ctx, restaurantID, id)
+}
+
+// Delete removes the zone. Orders priced with it keep their fee but lose
+// the reference.
+func (r *deliveryZoneRepository) Delete(ctx context.Context, restaurantID, id uuid.UUID) error {
+	err := r.client.DeliveryZone.
+		DeleteOneID(id).
+		Where(deliveryzone.RestaurantIDEQ(restaurantID)).
+		Exec(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return apperr.NotFound("delivery zone %s", id)
+		}
+		return fmt.Errorf("failed to delete delivery zone: %w", err)
+	}
+
+	return nil
+}
+
+// GetAuthorizationResource resolves the authz.Resource for a delivery zone
+// by joining through restaurant_id to the owning restaurant, in a single
+// query.
+func (r *deliveryZoneRepository) GetAuthorizationResource(ctx context.Context, id uuid.UUID) (authz.Resource, error) {
+	rest, err := r.client.DeliveryZone.
+		Query().
+		Where(deliveryzone.ID(id)).
+		QueryRestaurant().
+		Select(restaurant.FieldID, restaurant.FieldUserID).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return authz.Resource{}, apperr.NotFound("delivery zone %s", id)
+		}
+		return authz.Resource{}, fmt.Errorf("failed to get delivery zone: %w", err)
+	}
+
+	return authz.Resource{
+		Type:         "delivery_zone",
+		ID:           id,
+		RestaurantID: rest.ID,
+		OwnerUserID:  rest.UserID,
+	}, nil
+}
+
+// query loads zones with the restaurant currency their amounts are in.
+func (r *deliveryZoneRepository) query() *ent.DeliveryZoneQuery {
+	return r.client.DeliveryZone.
+		Query().
+		WithRestaurant(func(q *ent.RestaurantQuery) {
+			q.Select(restaurant.FieldID, restaurant.FieldCurrency)
+		})
+}
+
+func mapToDeliveryZone(zone *ent.DeliveryZone) *dto.DeliveryZone {
+	var currency money.Currency
+	if zone.Edges.Restaurant != nil {
+		currency = money.Currency(zone.Edges.Restaurant.Currency)
+	}
+
+	return &dto.DeliveryZone{
+		ID:            zone.ID,
+		Name:          zone.Name,
+		Kind:          dto.DeliveryZoneKind(zone.Kind),
+		RadiusMeters:  zone.RadiusMeters,
+		Polygon:       zone.Polygon,
+		DeliveryFee:   money.New(zone.DeliveryFee, currency),
+		MinOrderValue: money.New(zone.MinOrderValue, currency),
+		IsActive:      zone.IsActive,
+		RestaurantID:  zone.RestaurantID,
+		CreatedAt:     zone.CreateTime,
+		UpdatedAt:     zone.UpdateTime,
+	}
+}
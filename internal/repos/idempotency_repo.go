@@ -0,0 +1,140 @@
+package repos
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Jiruu246/rms/internal/apperr"
+	"github.com/Jiruu246/rms/internal/dto"
+	"github.com/Jiruu246/rms/internal/ent"
+	"github.com/Jiruu246/rms/internal/ent/idempotencykey"
+)
+
+type IdempotencyRepository interface {
+	// Begin claims scope+key for a request with fingerprint until
+	// lockedUntil. It returns the stored response when the key was already
+	// used for the same request and that has been handled, and nil when
+	// the caller now holds the key and should handle the request. A key
+	// that is still being handled is apperr.Conflict, and one used for a
+	// different request apperr.Invalid. Expired keys are claimed afresh.
+	Begin(ctx context.Context, scope, key, fingerprint string, lockedUntil time.Time) (*dto.IdempotentResponse, error)
+	// Complete stores the response for a key claimed with Begin, to be
+	// replayed until expiresAt.
+	Complete(ctx context.Context, scope, key string, resp *dto.IdempotentResponse, expiresAt time.Time) error
+	// Release gives up a key claimed with Begin without storing a
+	// response, so the request can be retried.
+	Release(ctx context.Context, scope, key string) error
+	// DeleteExpired deletes keys that expired before now.
+	DeleteExpired(ctx context.Context, now time.Time) (int, error)
+}
+
+type idempotencyRepository struct {
+	client *ent.Client
+}
+
+func NewEntIdempotencyRepository(client *ent.Client) IdempotencyRepository {
+	return &idempotencyRepository{client: client}
+}
+
+func (r *idempotencyRepository) Begin(ctx context.Context, scope, key, fingerprint string, lockedUntil time.Time) (*dto.IdempotentResponse, error) {
+	err := r.client.IdempotencyKey.Create().
+		SetScope(scope).
+		SetKey(key).
+		SetFingerprint(fingerprint).
+		SetExpiresAt(lockedUntil).
+		Exec(ctx)
+	if err == nil {
+		return nil, nil
+	}
+	if !ent.IsConstraintError(err) {
+		return nil, fmt.Errorf("failed to create idempotency key: %w", err)
+	}
+
+	existing, err := r.client.IdempotencyKey.Query().
+		Where(idempotencykey.Scope(scope), idempotencykey.Key(key)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			// Released since we tried to create it.
+			return nil, apperr.Conflict("a request with this Idempotency-Key is already being processed")
+		}
+		return nil, fmt.Errorf("failed to get idempotency key: %w", err)
+	}
+
+	if existing.ExpiresAt.After(time.Now()) {
+		if existing.Fingerprint != fingerprint {
+			return nil, apperr.Invalid("Idempotency-Key was already used for a different request")
+		}
+		if existing.StatusCode == nil {
+			return nil, apperr.Conflict("a request with this Idempotency-Key is already being processed")
+		}
+		return &dto.IdempotentResponse{
+			StatusCode:  *existing.StatusCode,
+			ContentType: existing.ContentType,
+			Body:        existing.ResponseBody,
+		}, nil
+	}
+
+	// Take over the expired key, unless another request beat us to it.
+	n, err := r.client.IdempotencyKey.Update().
+		Where(
+			idempotencykey.ID(existing.ID),
+			idempotencykey.ExpiresAt(existing.ExpiresAt),
+		).
+		SetFingerprint(fingerprint).
+		SetExpiresAt(lockedUntil).
+		ClearStatusCode().
+		ClearContentType().
+		ClearResponseBody().
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to claim idempotency key: %w", err)
+	}
+	if n == 0 {
+		return nil, apperr.Conflict("a request with this Idempotency-Key is already being processed")
+	}
+	return nil, nil
+}
+
+func (r *idempotencyRepository) Complete(ctx context.Context, scope, key string, resp *dto.IdempotentResponse, expiresAt time.Time) error {
+	err := r.client.IdempotencyKey.Update().
+		Where(
+			idempotencykey.Scope(scope),
+			idempotencykey.Key(key),
+			idempotencykey.StatusCodeIsNil(),
+		).
+		SetStatusCode(resp.StatusCode).
+		SetContentType(resp.ContentType).
+		SetResponseBody(resp.Body).
+		SetExpiresAt(expiresAt).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to store idempotent response: %w", err)
+	}
+	return nil
+}
+
+func (r *idempotencyRepository) Release(ctx context.Context, scope, key string) error {
+	_, err := r.client.IdempotencyKey.Delete().
+		Where(
+			idempotencykey.Scope(scope),
+			idempotencykey.Key(key),
+			idempotencykey.StatusCodeIsNil(),
+		).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to release idempotency key: %w", err)
+	}
+	return nil
+}
+
+func (r *idempotencyRepository) DeleteExpired(ctx context.Context, now time.Time) (int, error) {
+	n, err := r.client.IdempotencyKey.Delete().
+		Where(idempotencykey.ExpiresAtLT(now)).
+		Exec(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to delete expired idempotency keys: %w", err)
+	}
+	return n, nil
+}
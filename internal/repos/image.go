@@ -0,0 +1,10 @@
+package repos
+
+// ImageData is an uploaded image kept in a blob store: the URLs it is
+// served at, and the blob keys of the image and its thumbnail, which are
+// deleted when it is replaced or its owner is deleted.
+type ImageData struct {
+	URL          string
+	ThumbnailURL string
+	Keys         []string
+}
@@ -0,0 +1,557 @@
+package repos
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/Jiruu246/rms/internal/apperr"
+	"github.com/Jiruu246/rms/internal/authz"
+	"github.com/Jiruu246/rms/internal/dto"
+	"github.com/Jiruu246/rms/internal/ent"
+	"github.com/Jiruu246/rms/internal/ent/ingredient"
+	"github.com/Jiruu246/rms/internal/ent/menuitem"
+	"github.com/Jiruu246/rms/internal/ent/modifier"
+	"github.com/Jiruu246/rms/internal/ent/modifieroption"
+	"github.com/Jiruu246/rms/internal/ent/predicate"
+	"github.com/Jiruu246/rms/internal/ent/recipeingredient"
+	"github.com/Jiruu246/rms/internal/ent/restaurant"
+	"github.com/Jiruu246/rms/internal/ent/stockmovement"
+	"github.com/google/uuid"
+)
+
+// CreateIngredientData is a new ingredient. CreatedBy is recorded on the
+// COUNT movement of a non-zero initial stock.
+type CreateIngredientData struct {
+	Request   *dto.CreateIngredientRequest
+	CreatedBy uuid.UUID
+}
+
+// StockAdjustmentData is a validated stock adjustment of an ingredient.
+type StockAdjustmentData struct {
+	RestaurantID uuid.UUID
+	IngredientID uuid.UUID
+	Kind         dto.StockMovementKind
+	Quantity     int64
+	Note         string
+	CreatedBy    uuid.UUID
+}
+
+// RecipeTarget is what a recipe belongs to: a menu item or a modifier
+// option. Exactly one of the fields is set.
+type RecipeTarget struct {
+	MenuItemID       *int64
+	ModifierOptionID *uuid.UUID
+}
+
+func (t RecipeTarget) String() string {
+	if t.MenuItemID != nil {
+		return "menu item " + strconv.FormatInt(*t.MenuItemID, 10)
+	}
+	return "modifier option " + t.ModifierOptionID.String()
+}
+
+func (t RecipeTarget) predicate() predicate.RecipeIngredient {
+	if t.MenuItemID != nil {
+		return recipeingredient.MenuItemIDEQ(*t.MenuItemID)
+	}
+	return recipeingredient.ModifierOptionIDEQ(*t.ModifierOptionID)
+}
+
+type IngredientRepository interface {
+	Create(ctx context.Context, data *CreateIngredientData) (*dto.Ingredient, error)
+	GetByID(ctx context.Context, restaurantID, id uuid.UUID) (*dto.Ingredient, error)
+	GetAllByRestaurant(ctx context.Context, restaurantID uuid.UUID) ([]*dto.Ingredient, error)
+	Update(ctx context.Context, restaurantID, id uuid.UUID, req *dto.UpdateIngredientRequest) (*dto.Ingredient, error)
+	// Delete removes the ingredient, its recipe lines and its ledger. Menu
+	// items and options it had 86ed are made available again.
+	Delete(ctx context.Context, restaurantID, id uuid.UUID) error
+	// Adjust applies a stock adjustment and records it in the ledger,
+	// 86ing or restocking the menu items and options that use the
+	// ingredient. Taking away more than is on hand is apperr.Invalid.
+	Adjust(ctx context.Context, data *StockAdjustmentData) (*dto.StockMovement, error)
+	// GetMovements returns the ingredient's ledger, newest first.
+	GetMovements(ctx context.Context, restaurantID, id uuid.UUID) ([]*dto.StockMovement, error)
+	GetRecipe(ctx context.Context, restaurantID uuid.UUID, target RecipeTarget) (*dto.Recipe, error)
+	// SetRecipe replaces the recipe of target, which must belong to
+	// restaurantID like all the ingredients, and 86es or restocks target
+	// against it.
+	SetRecipe(ctx context.Context, restaurantID uuid.UUID, target RecipeTarget, lines []dto.RecipeLineRequest) (*dto.Recipe, error)
+	GetAuthorizationResource(ctx context.Context, id uuid.UUID) (authz.Resource, error)
+	// GetRecipeAuthorizationResource resolves the authz.Resource of the
+	// menu item or modifier option a recipe belongs to.
+	GetRecipeAuthorizationResource(ctx context.Context, target RecipeTarget) (authz.Resource, error)
+}
+
+type ingredientRepository struct {
+	client *ent.Client
+}
+
+func NewEntIngredientRepository(client *ent.Client) IngredientRepository {
+	return &ingredientRepository{client: client}
+}
+
+func (r *ingredientRepository) Create(ctx context.Context, data *CreateIngredientData) (*dto.Ingredient, error) {
+	req := data.Request
+
+	tx, err := r.client.Tx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to start transaction: %w", err)
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+
+	var created *ent.Ingredient
+	created, err = tx.Ingredient.
+		Create().
+		SetName(req.Name).
+		SetUnit(ingredient.Unit(req.Unit)).
+		SetOnHand(req.OnHand).
+		SetRestaurantID(req.RestaurantID).
+		Save(ctx)
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return nil, apperr.Conflict("an ingredient named %s already exists", req.Name)
+		}
+		return nil, fmt.Errorf("failed to create ingredient: %w", err)
+	}
+
+	if req.OnHand > 0 {
+		movement := tx.StockMovement.Create().
+			SetIngredientID(created.ID).
+			SetKind(stockmovement.KindCOUNT).
+			SetQuantity(req.OnHand).
+			SetOnHandAfter(req.OnHand)
+		if data.CreatedBy != uuid.Nil {
+			movement.SetCreatedByID(data.CreatedBy)
+		}
+		if err = movement.Exec(ctx); err != nil {
+			return nil, fmt.Errorf("failed to record stock movement: %w", err)
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return mapToIngredient(created), nil
+}
+
+func (r *ingredientRepository) GetByID(ctx context.Context, restaurantID, id uuid.UUID) (*dto.Ingredient, error) {
+	ing, err := r.client.Ingredient.
+		Query().
+		Where(ingredient.ID(id), ingredient.RestaurantIDEQ(restaurantID)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, apperr.NotFound("ingredient %s", id)
+		}
+		return nil, fmt.Errorf("failed to get ingredient: %w", err)
+	}
+
+	return mapToIngredient(ing), nil
+}
+
+func (r *ingredientRepository) GetAllByRestaurant(ctx context.Context, restaurantID uuid.UUID) ([]*dto.Ingredient, error) {
+	rows, err := r.client.Ingredient.
+		Query().
+		Where(ingredient.RestaurantIDEQ(restaurantID)).
+		Order(ingredient.ByName()).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get ingredients: %w", err)
+	}
+
+	ingredients := make([]*dto.Ingredient, 0, len(rows))
+	for _, ing := range rows {
+		ingredients = append(ingredients, mapToIngredient(ing))
+	}
+	return ingredients, nil
+}
+
+func (r *ingredientRepository) Update(ctx context.Context, restaurantID, id uuid.UUID, req *dto.UpdateIngredientRequest) (*dto.Ingredient, error) {
+	update := r.client.Ingredient.UpdateOneID(id).Where(ingredient.RestaurantIDEQ(restaurantID))
+
+	if req.Name == nil {
+		return nil, apperr.Invalid("no valid fields provided for update")
+	}
+	if strings.TrimSpace(*req.Name) == "" {
+		return nil, apperr.Invalid("name cannot be empty")
+	}
+	update.SetName(*req.Name)
+
+	updated, err := update.Save(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, apperr.NotFound("ingredient %s", id)
+		}
+		if ent.IsConstraintError(err) {
+			return nil, apperr.Conflict("an ingredient named %s already exists", *req.Name)
+		}
+		return nil, fmt.Errorf("failed to update ingredient: %w", err)
+	}
+
+	return mapToIngredient(updated), nil
+}
+
+func (r *ingredientRepository) Delete(ctx context.Context, restaurantID, id uuid.UUID) error {
+	tx, err := r.client.Tx(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+
+	var lines []*ent.RecipeIngredient
+	lines, err = tx.RecipeIngredient.Query().
+		Where(recipeingredient.IngredientIDEQ(id)).
+		All(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get recipes: %w", err)
+	}
+
+	err = tx.Ingredient.
+		DeleteOneID(id).
+		Where(ingredient.RestaurantIDEQ(restaurantID)).
+		Exec(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return apperr.NotFound("ingredient %s", id)
+		}
+		return fmt.Errorf("failed to delete ingredient: %w", err)
+	}
+
+	var menuItemIDs []int64
+	var optionIDs []uuid.UUID
+	for _, line := range lines {
+		if line.MenuItemID != nil {
+			menuItemIDs = append(menuItemIDs, *line.MenuItemID)
+		}
+		if line.ModifierOptionID != nil {
+			optionIDs = append(optionIDs, *line.ModifierOptionID)
+		}
+	}
+	if err = refreshRecipeAvailability(ctx, tx, menuItemIDs, optionIDs); err != nil {
+		return err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return nil
+}
+
+func (r *ingredientRepository) Adjust(ctx context.Context, data *StockAdjustmentData) (*dto.StockMovement, error) {
+	tx, err := r.client.Tx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to start transaction: %w", err)
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+
+	var ing *ent.Ingredient
+	ing, err = tx.Ingredient.Query().
+		Where(ingredient.ID(data.IngredientID), ingredient.RestaurantIDEQ(data.RestaurantID)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, apperr.NotFound("ingredient %s", data.IngredientID)
+		}
+		return nil, fmt.Errorf("failed to get ingredient: %w", err)
+	}
+
+	var delta int64
+	switch data.Kind {
+	case dto.StockMovementDELIVERY:
+		delta = data.Quantity
+	case dto.StockMovementWASTE:
+		if data.Quantity > ing.OnHand {
+			err = apperr.Invalid("only %d %s of %s is in stock", ing.OnHand, ing.Unit, ing.Name)
+			return nil, err
+		}
+		delta = -data.Quantity
+	case dto.StockMovementCOUNT:
+		delta = data.Quantity - ing.OnHand
+	default:
+		err = apperr.Invalid("invalid stock adjustment kind %s", data.Kind)
+		return nil, err
+	}
+
+	// Guarding on the stock read above keeps a sale drawing on it in the
+	// meantime from being lost, or counted twice by a COUNT.
+	ing, err = tx.Ingredient.UpdateOneID(ing.ID).
+		Where(ingredient.OnHandEQ(ing.OnHand)).
+		AddOnHand(delta).
+		Save(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, apperr.Conflict("stock of ingredient %s changed while it was being adjusted, please retry", data.IngredientID)
+		}
+		return nil, fmt.Errorf("failed to update stock: %w", err)
+	}
+
+	movement := tx.StockMovement.Create().
+		SetIngredientID(ing.ID).
+		SetKind(stockmovement.Kind(data.Kind)).
+		SetQuantity(delta).
+		SetOnHandAfter(ing.OnHand).
+		SetNote(data.Note)
+	if data.CreatedBy != uuid.Nil {
+		movement.SetCreatedByID(data.CreatedBy)
+	}
+	var created *ent.StockMovement
+	created, err = movement.Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to record stock movement: %w", err)
+	}
+
+	if err = refreshStockAvailability(ctx, tx, ing.ID); err != nil {
+		return nil, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return mapToStockMovement(created), nil
+}
+
+func (r *ingredientRepository) GetMovements(ctx context.Context, restaurantID, id uuid.UUID) ([]*dto.StockMovement, error) {
+	exists, err := r.client.Ingredient.Query().
+		Where(ingredient.ID(id), ingredient.RestaurantIDEQ(restaurantID)).
+		Exist(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get ingredient: %w", err)
+	}
+	if !exists {
+		return nil, apperr.NotFound("ingredient %s", id)
+	}
+
+	rows, err := r.client.StockMovement.Query().
+		Where(stockmovement.IngredientIDEQ(id)).
+		Order(stockmovement.ByCreateTime(sql.OrderDesc())).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get stock movements: %w", err)
+	}
+
+	movements := make([]*dto.StockMovement, 0, len(rows))
+	for _, m := range rows {
+		movements = append(movements, mapToStockMovement(m))
+	}
+	return movements, nil
+}
+
+func (r *ingredientRepository) GetRecipe(ctx context.Context, restaurantID uuid.UUID, target RecipeTarget) (*dto.Recipe, error) {
+	return getRecipe(ctx, r.client.RecipeIngredient, restaurantID, target)
+}
+
+func (r *ingredientRepository) SetRecipe(ctx context.Context, restaurantID uuid.UUID, target RecipeTarget, lines []dto.RecipeLineRequest) (*dto.Recipe, error) {
+	tx, err := r.client.Tx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to start transaction: %w", err)
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+
+	var exists bool
+	if target.MenuItemID != nil {
+		exists, err = tx.MenuItem.Query().
+			Where(menuitem.ID(*target.MenuItemID), menuitem.RestaurantIDEQ(restaurantID)).
+			Exist(ctx)
+	} else {
+		exists, err = tx.ModifierOption.Query().
+			Where(
+				modifieroption.ID(*target.ModifierOptionID),
+				modifieroption.HasModifierWith(modifier.RestaurantIDEQ(restaurantID)),
+			).
+			Exist(ctx)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get %s: %w", target, err)
+	}
+	if !exists {
+		err = apperr.NotFound("%s", target)
+		return nil, err
+	}
+
+	if _, err = tx.RecipeIngredient.Delete().Where(target.predicate()).Exec(ctx); err != nil {
+		return nil, fmt.Errorf("failed to clear recipe: %w", err)
+	}
+
+	if len(lines) > 0 {
+		ingredientIDs := make([]uuid.UUID, 0, len(lines))
+		creates := make([]*ent.RecipeIngredientCreate, 0, len(lines))
+		for _, line := range lines {
+			if slices.Contains(ingredientIDs, line.IngredientID) {
+				err = apperr.Invalid("ingredient %s is listed more than once", line.IngredientID)
+				return nil, err
+			}
+			ingredientIDs = append(ingredientIDs, line.IngredientID)
+			create := tx.RecipeIngredient.Create().
+				SetIngredientID(line.IngredientID).
+				SetQuantity(line.Quantity).
+				SetNillableMenuItemID(target.MenuItemID).
+				SetNillableModifierOptionID(target.ModifierOptionID)
+			creates = append(creates, create)
+		}
+
+		var n int
+		n, err = tx.Ingredient.Query().
+			Where(ingredient.IDIn(ingredientIDs...), ingredient.RestaurantIDEQ(restaurantID)).
+			Count(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get ingredients: %w", err)
+		}
+		if n != len(ingredientIDs) {
+			err = apperr.Invalid("one or more ingredients do not belong to restaurant %s", restaurantID)
+			return nil, err
+		}
+
+		if err = tx.RecipeIngredient.CreateBulk(creates...).Exec(ctx); err != nil {
+			return nil, fmt.Errorf("failed to save recipe: %w", err)
+		}
+	}
+
+	if target.MenuItemID != nil {
+		err = refreshRecipeAvailability(ctx, tx, []int64{*target.MenuItemID}, nil)
+	} else {
+		err = refreshRecipeAvailability(ctx, tx, nil, []uuid.UUID{*target.ModifierOptionID})
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var recipe *dto.Recipe
+	recipe, err = getRecipe(ctx, tx.RecipeIngredient, restaurantID, target)
+	if err != nil {
+		return nil, err
+	}
+	if err = tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return recipe, nil
+}
+
+// GetAuthorizationResource resolves the authz.Resource for an ingredient by
+// joining through restaurant_id to the owning restaurant, in a single query.
+func (r *ingredientRepository) GetAuthorizationResource(ctx context.Context, id uuid.UUID) (authz.Resource, error) {
+	rest, err := r.client.Ingredient.
+		Query().
+		Where(ingredient.ID(id)).
+		QueryRestaurant().
+		Select(restaurant.FieldID, restaurant.FieldUserID).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return authz.Resource{}, apperr.NotFound("ingredient %s", id)
+		}
+		return authz.Resource{}, fmt.Errorf("failed to get ingredient: %w", err)
+	}
+
+	return authz.Resource{
+		Type:         "ingredient",
+		ID:           id,
+		RestaurantID: rest.ID,
+		OwnerUserID:  rest.UserID,
+	}, nil
+}
+
+func (r *ingredientRepository) GetRecipeAuthorizationResource(ctx context.Context, target RecipeTarget) (authz.Resource, error) {
+	var query *ent.RestaurantQuery
+	resource := authz.Resource{Type: "menu_item"}
+	if target.MenuItemID != nil {
+		query = r.client.MenuItem.Query().
+			Where(menuitem.ID(*target.MenuItemID)).
+			QueryRestaurant()
+		resource.Attributes = map[string]string{"menu_item_id": strconv.FormatInt(*target.MenuItemID, 10)}
+	} else {
+		query = r.client.ModifierOption.Query().
+			Where(modifieroption.ID(*target.ModifierOptionID)).
+			QueryModifier().
+			QueryRestaurant()
+		resource.Type = "modifier_option"
+		resource.ID = *target.ModifierOptionID
+	}
+
+	rest, err := query.Select(restaurant.FieldID, restaurant.FieldUserID).Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return authz.Resource{}, apperr.NotFound("%s", target)
+		}
+		return authz.Resource{}, fmt.Errorf("failed to get %s: %w", target, err)
+	}
+
+	resource.RestaurantID = rest.ID
+	resource.OwnerUserID = rest.UserID
+	return resource, nil
+}
+
+// getRecipe reads target's recipe through client, which may be part of a
+// transaction, ordered by ingredient name.
+func getRecipe(ctx context.Context, client *ent.RecipeIngredientClient, restaurantID uuid.UUID, target RecipeTarget) (*dto.Recipe, error) {
+	lines, err := client.Query().
+		Where(target.predicate(), recipeingredient.HasIngredientWith(ingredient.RestaurantIDEQ(restaurantID))).
+		WithIngredient().
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get recipe: %w", err)
+	}
+
+	recipe := &dto.Recipe{
+		MenuItemID:       target.MenuItemID,
+		ModifierOptionID: target.ModifierOptionID,
+		Ingredients:      make([]dto.RecipeLine, 0, len(lines)),
+	}
+	for _, line := range lines {
+		recipe.Ingredients = append(recipe.Ingredients, dto.RecipeLine{
+			IngredientID: line.IngredientID,
+			Name:         line.Edges.Ingredient.Name,
+			Unit:         dto.IngredientUnit(line.Edges.Ingredient.Unit),
+			Quantity:     line.Quantity,
+		})
+	}
+	slices.SortFunc(recipe.Ingredients, func(a, b dto.RecipeLine) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+	return recipe, nil
+}
+
+func mapToIngredient(ing *ent.Ingredient) *dto.Ingredient {
+	return &dto.Ingredient{
+		ID:           ing.ID,
+		Name:         ing.Name,
+		Unit:         dto.IngredientUnit(ing.Unit),
+		OnHand:       ing.OnHand,
+		RestaurantID: ing.RestaurantID,
+		CreatedAt:    ing.CreateTime,
+		UpdatedAt:    ing.UpdateTime,
+	}
+}
+
+func mapToStockMovement(m *ent.StockMovement) *dto.StockMovement {
+	return &dto.StockMovement{
+		ID:           m.ID,
+		IngredientID: m.IngredientID,
+		Kind:         dto.StockMovementKind(m.Kind),
+		Quantity:     m.Quantity,
+		OnHandAfter:  m.OnHandAfter,
+		Note:         m.Note,
+		OrderID:      m.OrderID,
+		CreatedBy:    m.CreatedByID,
+		CreatedAt:    m.CreateTime,
+	}
+}
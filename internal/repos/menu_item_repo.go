@@ -3,12 +3,23 @@ package repos
 import (
 	"context"
 	"fmt"
+	"slices"
+	"strconv"
 
 	"github.com/Jiruu246/rms/internal/apperr"
+	"github.com/Jiruu246/rms/internal/authz"
 	ds "github.com/Jiruu246/rms/internal/data_structures"
 	"github.com/Jiruu246/rms/internal/dto"
 	"github.com/Jiruu246/rms/internal/ent"
+	"github.com/Jiruu246/rms/internal/ent/category"
 	"github.com/Jiruu246/rms/internal/ent/menuitem"
+	"github.com/Jiruu246/rms/internal/ent/menuitemmodifier"
+	"github.com/Jiruu246/rms/internal/ent/menuitemvariant"
+	"github.com/Jiruu246/rms/internal/ent/modifier"
+	"github.com/Jiruu246/rms/internal/ent/modifieroption"
+	"github.com/Jiruu246/rms/internal/ent/predicate"
+	"github.com/Jiruu246/rms/internal/ent/restaurant"
+	"github.com/Jiruu246/rms/pkg/money"
 	"github.com/google/uuid"
 )
 
@@ -19,19 +30,45 @@ type ItemModifierPair struct {
 
 type MenuItemQueryOptions func(*ent.MenuItemQuery)
 
+// WithModifierOptions loads the items' modifier groups, with the items'
+// overrides applied.
 func WithModifierOptions() MenuItemQueryOptions {
 	return func(query *ent.MenuItemQuery) {
-		query.WithModifiers()
+		query.WithItemModifiers(withItemModifiersOrdered)
 	}
 }
 
 type MenuItemRepository interface {
 	Create(ctx context.Context, req *dto.CreateMenuItemRequest) (*dto.MenuItem, error)
-	GetAll(ctx context.Context) ([]*dto.MenuItem, error)
-	GetByID(ctx context.Context, id int64) (*dto.MenuItem, error)
+	GetAllByRestaurant(ctx context.Context, restaurantID uuid.UUID) ([]*dto.MenuItem, error)
+	GetByID(ctx context.Context, restaurantID uuid.UUID, id int64) (*dto.MenuItem, error)
 	GetByIDsStrict(ctx context.Context, ids ds.Set[int64], opts ...MenuItemQueryOptions) (map[int64]*dto.MenuItem, error)
-	Update(ctx context.Context, id int64, req *dto.UpdateMenuItemRequest) (*dto.MenuItem, error)
-	Delete(ctx context.Context, id int64) error
+	Update(ctx context.Context, restaurantID uuid.UUID, id int64, req *dto.UpdateMenuItemRequest) (*dto.MenuItem, error)
+	Delete(ctx context.Context, restaurantID uuid.UUID, id int64) error
+	// SetVariants replaces the item's variants with variants, updating those
+	// with an ID and deleting those left out. Orders of deleted variants
+	// keep their snapshot of it.
+	SetVariants(ctx context.Context, restaurantID uuid.UUID, id int64, variants []dto.MenuItemVariantInput) (*dto.MenuItem, error)
+	// GetModifiers returns the modifier groups attached to the item, in
+	// display order.
+	GetModifiers(ctx context.Context, restaurantID uuid.UUID, id int64) ([]dto.MenuItemModifier, error)
+	// AttachModifier attaches a modifier group of the item's restaurant to
+	// the item.
+	AttachModifier(ctx context.Context, restaurantID uuid.UUID, id int64, req *dto.AttachMenuItemModifierRequest) (*dto.MenuItemModifier, error)
+	// SetModifierOverrides replaces the item's overrides of an attached
+	// modifier group.
+	SetModifierOverrides(ctx context.Context, restaurantID uuid.UUID, id int64, modifierID uuid.UUID, overrides *dto.MenuItemModifierOverrides) (*dto.MenuItemModifier, error)
+	// DetachModifier removes a modifier group from the item.
+	DetachModifier(ctx context.Context, restaurantID uuid.UUID, id int64, modifierID uuid.UUID) error
+	// ReorderModifiers puts the item's modifier groups in the order of
+	// modifierIDs, which must list each of them once.
+	ReorderModifiers(ctx context.Context, restaurantID uuid.UUID, id int64, modifierIDs []uuid.UUID) ([]dto.MenuItemModifier, error)
+	// SetImage sets the item's uploaded image, or clears it if image is
+	// nil, and returns the blob keys of the image it replaced.
+	SetImage(ctx context.Context, restaurantID uuid.UUID, id int64, image *ImageData) (*dto.MenuItem, []string, error)
+	// GetImageKeys returns the blob keys of the item's uploaded image.
+	GetImageKeys(ctx context.Context, restaurantID uuid.UUID, id int64) ([]string, error)
+	GetAuthorizationResource(ctx context.Context, id int64) (authz.Resource, error)
 	// FindUnavailableMenuItemsByIDsAndRestaurant(ctx context.Context, ids []int64, restaurantID uuid.UUID) ([]int64, error)
 	// FindUnavailableModifierOptionsForMenuItems(ctx context.Context, pairs []ItemModifierPair) ([]ItemModifierPair, error)
 }
@@ -45,50 +82,75 @@ func NewEntMenuItemRepository(client *ent.Client) MenuItemRepository {
 }
 
 func (r *menuItemRepository) Create(ctx context.Context, req *dto.CreateMenuItemRequest) (*dto.MenuItem, error) {
+	currency, err := getRestaurantCurrency(ctx, r.client, req.RestaurantID)
+	if err != nil {
+		return nil, err
+	}
 	create := r.client.MenuItem.Create().
 		SetName(req.Name).
 		SetDescription(req.Description).
 		SetPrice(req.Price).
 		SetIsAvailable(req.IsAvailable).
+		SetDisplayOrder(req.DisplayOrder).
 		SetRestaurantID(req.RestaurantID)
 	if req.CategoryID != uuid.Nil {
+		if err := checkItemCategory(ctx, r.client, req.RestaurantID, req.CategoryID); err != nil {
+			return nil, err
+		}
 		create = create.SetCategoryID(req.CategoryID)
 	}
 	item, err := create.Save(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create menu item: %w", err)
 	}
-	return mapToMenuItemResponse(item), nil
+	return mapToMenuItemResponse(item, currency), nil
 }
 
 // TODO: Implement pagination, filtering, sorting
-func (r *menuItemRepository) GetAll(ctx context.Context) ([]*dto.MenuItem, error) {
-	items, err := r.client.MenuItem.Query().All(ctx)
+func (r *menuItemRepository) GetAllByRestaurant(ctx context.Context, restaurantID uuid.UUID) ([]*dto.MenuItem, error) {
+	items, err := r.client.MenuItem.Query().
+		Where(menuitem.RestaurantIDEQ(restaurantID)).
+		WithRestaurant(withRestaurantCurrency).
+		WithVariants(withVariantsOrdered).
+		All(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get menu items: %w", err)
 	}
 	var responses []*dto.MenuItem
 	for _, item := range items {
-		responses = append(responses, mapToMenuItemResponse(item))
+		responses = append(responses, mapToMenuItemResponse(item, restaurantCurrencyOf(item.Edges.Restaurant)))
 	}
 	return responses, nil
 }
 
-func (r *menuItemRepository) GetByID(ctx context.Context, id int64) (*dto.MenuItem, error) {
-	item, err := r.client.MenuItem.Query().Where(menuitem.IDEQ(id)).Only(ctx)
+func (r *menuItemRepository) GetByID(ctx context.Context, restaurantID uuid.UUID, id int64) (*dto.MenuItem, error) {
+	return r.get(ctx, id, menuitem.RestaurantIDEQ(restaurantID))
+}
+
+// get reads the item after a change made by ID, where its restaurant has
+// already been checked.
+func (r *menuItemRepository) get(ctx context.Context, id int64, ps ...predicate.MenuItem) (*dto.MenuItem, error) {
+	item, err := r.client.MenuItem.Query().
+		Where(menuitem.IDEQ(id)).
+		Where(ps...).
+		WithRestaurant(withRestaurantCurrency).
+		WithVariants(withVariantsOrdered).
+		Only(ctx)
 	if err != nil {
 		if ent.IsNotFound(err) {
 			return nil, apperr.NotFound("menu item %d", id)
 		}
 		return nil, fmt.Errorf("failed to get menu item: %w", err)
 	}
-	return mapToMenuItemResponse(item), nil
+	return mapToMenuItemResponse(item, restaurantCurrencyOf(item.Edges.Restaurant)), nil
 }
 
 func (r *menuItemRepository) GetByIDsStrict(ctx context.Context, ids ds.Set[int64], opts ...MenuItemQueryOptions) (map[int64]*dto.MenuItem, error) {
 	query := r.client.MenuItem.
 		Query().
-		Where(menuitem.IDIn(ids.Items()...))
+		Where(menuitem.IDIn(ids.Items()...)).
+		WithRestaurant(withRestaurantCurrency).
+		WithVariants(withVariantsOrdered)
 
 	for _, opt := range opts {
 		opt(query)
@@ -103,11 +165,462 @@ func (r *menuItemRepository) GetByIDsStrict(ctx context.Context, ids ds.Set[int6
 	}
 	responses := make(map[int64]*dto.MenuItem, len(items))
 	for _, item := range items {
-		responses[item.ID] = mapToMenuItemResponse(item)
+		responses[item.ID] = mapToMenuItemResponse(item, restaurantCurrencyOf(item.Edges.Restaurant))
 	}
 	return responses, nil
 }
 
+func (r *menuItemRepository) SetVariants(ctx context.Context, restaurantID uuid.UUID, id int64, variants []dto.MenuItemVariantInput) (*dto.MenuItem, error) {
+	tx, err := r.client.Tx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to start transaction: %w", err)
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+
+	var exists bool
+	if exists, err = tx.MenuItem.Query().Where(menuitem.IDEQ(id), menuitem.RestaurantIDEQ(restaurantID)).Exist(ctx); err != nil {
+		return nil, fmt.Errorf("failed to get menu item: %w", err)
+	}
+	if !exists {
+		err = apperr.NotFound("menu item %d", id)
+		return nil, err
+	}
+	var existing []uuid.UUID
+	existing, err = tx.MenuItemVariant.Query().
+		Where(menuitemvariant.MenuItemIDEQ(id)).
+		IDs(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get menu item variants: %w", err)
+	}
+
+	kept := ds.NewSet[uuid.UUID]()
+	for _, v := range variants {
+		if v.ID != nil {
+			if !slices.Contains(existing, *v.ID) {
+				err = apperr.Invalid("variant %s is not a variant of menu item %d", *v.ID, id)
+				return nil, err
+			}
+			kept.Add(*v.ID)
+		}
+	}
+	var removed []uuid.UUID
+	for _, variantID := range existing {
+		if !kept.Contains(variantID) {
+			removed = append(removed, variantID)
+		}
+	}
+	if len(removed) > 0 {
+		if _, err = tx.MenuItemVariant.Delete().Where(menuitemvariant.IDIn(removed...)).Exec(ctx); err != nil {
+			return nil, fmt.Errorf("failed to delete menu item variants: %w", err)
+		}
+	}
+
+	for _, v := range variants {
+		isAvailable := v.IsAvailable == nil || *v.IsAvailable
+		if v.ID != nil {
+			err = tx.MenuItemVariant.UpdateOneID(*v.ID).
+				SetName(v.Name).
+				SetPrice(v.Price).
+				SetSku(v.SKU).
+				SetIsAvailable(isAvailable).
+				SetDisplayOrder(v.DisplayOrder).
+				Exec(ctx)
+		} else {
+			err = tx.MenuItemVariant.Create().
+				SetMenuItemID(id).
+				SetName(v.Name).
+				SetPrice(v.Price).
+				SetSku(v.SKU).
+				SetIsAvailable(isAvailable).
+				SetDisplayOrder(v.DisplayOrder).
+				Exec(ctx)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to save menu item variant: %w", err)
+		}
+	}
+
+	// Bump the item's update time, which deleting variants would not.
+	if err = tx.MenuItem.UpdateOneID(id).Exec(ctx); err != nil {
+		return nil, fmt.Errorf("failed to update menu item: %w", err)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return r.get(ctx, id)
+}
+
+func (r *menuItemRepository) GetModifiers(ctx context.Context, restaurantID uuid.UUID, id int64) ([]dto.MenuItemModifier, error) {
+	item, err := r.client.MenuItem.Query().
+		Where(menuitem.IDEQ(id), menuitem.RestaurantIDEQ(restaurantID)).
+		Select(menuitem.FieldID, menuitem.FieldRestaurantID).
+		WithRestaurant(withRestaurantCurrency).
+		WithItemModifiers(withItemModifiersOrdered).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, apperr.NotFound("menu item %d", id)
+		}
+		return nil, fmt.Errorf("failed to get menu item modifiers: %w", err)
+	}
+	currency := restaurantCurrencyOf(item.Edges.Restaurant)
+	links := make([]dto.MenuItemModifier, len(item.Edges.ItemModifiers))
+	for i, link := range item.Edges.ItemModifiers {
+		links[i] = mapToMenuItemModifier(link, currency)
+	}
+	return links, nil
+}
+
+// getModifier returns the modifier group attached to the item with the
+// given ID.
+func (r *menuItemRepository) getModifier(ctx context.Context, restaurantID uuid.UUID, id int64, modifierID uuid.UUID) (*dto.MenuItemModifier, error) {
+	links, err := r.GetModifiers(ctx, restaurantID, id)
+	if err != nil {
+		return nil, err
+	}
+	for i := range links {
+		if links[i].Modifier.ID == modifierID {
+			return &links[i], nil
+		}
+	}
+	return nil, apperr.NotFound("modifier %s on menu item %d", modifierID, id)
+}
+
+func (r *menuItemRepository) AttachModifier(ctx context.Context, restaurantID uuid.UUID, id int64, req *dto.AttachMenuItemModifierRequest) (*dto.MenuItemModifier, error) {
+	tx, err := r.client.Tx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to start transaction: %w", err)
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+
+	var item *ent.MenuItem
+	item, err = tx.MenuItem.Query().
+		Where(menuitem.IDEQ(id), menuitem.RestaurantIDEQ(restaurantID)).
+		Select(menuitem.FieldID, menuitem.FieldRestaurantID).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			err = apperr.NotFound("menu item %d", id)
+			return nil, err
+		}
+		return nil, fmt.Errorf("failed to get menu item: %w", err)
+	}
+	// Modifiers of other restaurants are reported as missing.
+	var mod *ent.Modifier
+	mod, err = tx.Modifier.Query().
+		Where(modifier.IDEQ(req.ModifierID), modifier.RestaurantIDEQ(item.RestaurantID)).
+		WithModifierOptions(func(q *ent.ModifierOptionQuery) {
+			q.Select(modifieroption.FieldID, modifieroption.FieldModifierID)
+		}).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			err = apperr.Invalid("modifier %s does not exist in the restaurant of menu item %d", req.ModifierID, id)
+			return nil, err
+		}
+		return nil, fmt.Errorf("failed to get modifier: %w", err)
+	}
+	if err = checkModifierOverrides(mod, &req.MenuItemModifierOverrides); err != nil {
+		return nil, err
+	}
+
+	var links []*ent.MenuItemModifier
+	links, err = tx.MenuItemModifier.Query().
+		Where(menuitemmodifier.MenuItemIDEQ(id)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get menu item modifiers: %w", err)
+	}
+	displayOrder := 0
+	for _, link := range links {
+		if link.ModifierID == req.ModifierID {
+			err = apperr.Conflict("modifier %s is already attached to menu item %d", req.ModifierID, id)
+			return nil, err
+		}
+		displayOrder = max(displayOrder, link.DisplayOrder+1)
+	}
+	if req.DisplayOrder != nil {
+		displayOrder = *req.DisplayOrder
+	}
+
+	create := tx.MenuItemModifier.Create().
+		SetMenuItemID(id).
+		SetModifierID(req.ModifierID).
+		SetDisplayOrder(displayOrder).
+		SetNillableRequired(req.Required).
+		SetNillableMax(req.Max)
+	if len(req.OptionPrices) > 0 {
+		create.SetOptionPrices(req.OptionPrices)
+	}
+	if err = create.Exec(ctx); err != nil {
+		return nil, fmt.Errorf("failed to attach modifier: %w", err)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return r.getModifier(ctx, restaurantID, id, req.ModifierID)
+}
+
+func (r *menuItemRepository) SetModifierOverrides(ctx context.Context, restaurantID uuid.UUID, id int64, modifierID uuid.UUID, overrides *dto.MenuItemModifierOverrides) (*dto.MenuItemModifier, error) {
+	tx, err := r.client.Tx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to start transaction: %w", err)
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+
+	var link *ent.MenuItemModifier
+	link, err = tx.MenuItemModifier.Query().
+		Where(
+			menuitemmodifier.MenuItemIDEQ(id),
+			menuitemmodifier.ModifierIDEQ(modifierID),
+			menuitemmodifier.HasMenuItemWith(menuitem.RestaurantIDEQ(restaurantID)),
+		).
+		WithModifier(func(q *ent.ModifierQuery) {
+			q.WithModifierOptions(func(q *ent.ModifierOptionQuery) {
+				q.Select(modifieroption.FieldID, modifieroption.FieldModifierID)
+			})
+		}).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			err = apperr.NotFound("modifier %s on menu item %d", modifierID, id)
+			return nil, err
+		}
+		return nil, fmt.Errorf("failed to get menu item modifier: %w", err)
+	}
+	if err = checkModifierOverrides(link.Edges.Modifier, overrides); err != nil {
+		return nil, err
+	}
+
+	update := tx.MenuItemModifier.UpdateOne(link)
+	if overrides.Required != nil {
+		update.SetRequired(*overrides.Required)
+	} else {
+		update.ClearRequired()
+	}
+	if overrides.Max != nil {
+		update.SetMax(*overrides.Max)
+	} else {
+		update.ClearMax()
+	}
+	if len(overrides.OptionPrices) > 0 {
+		update.SetOptionPrices(overrides.OptionPrices)
+	} else {
+		update.ClearOptionPrices()
+	}
+	if err = update.Exec(ctx); err != nil {
+		return nil, fmt.Errorf("failed to update menu item modifier: %w", err)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return r.getModifier(ctx, restaurantID, id, modifierID)
+}
+
+func (r *menuItemRepository) DetachModifier(ctx context.Context, restaurantID uuid.UUID, id int64, modifierID uuid.UUID) error {
+	tx, err := r.client.Tx(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+
+	var deleted int
+	deleted, err = tx.MenuItemModifier.Delete().
+		Where(
+			menuitemmodifier.MenuItemIDEQ(id),
+			menuitemmodifier.ModifierIDEQ(modifierID),
+			menuitemmodifier.HasMenuItemWith(menuitem.RestaurantIDEQ(restaurantID)),
+		).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to detach modifier: %w", err)
+	}
+	if deleted == 0 {
+		err = apperr.NotFound("modifier %s on menu item %d", modifierID, id)
+		return err
+	}
+	// Bump the item's update time, which deleting the link would not.
+	if err = tx.MenuItem.UpdateOneID(id).Exec(ctx); err != nil {
+		return fmt.Errorf("failed to update menu item: %w", err)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return nil
+}
+
+func (r *menuItemRepository) ReorderModifiers(ctx context.Context, restaurantID uuid.UUID, id int64, modifierIDs []uuid.UUID) ([]dto.MenuItemModifier, error) {
+	tx, err := r.client.Tx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to start transaction: %w", err)
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+
+	var exists bool
+	if exists, err = tx.MenuItem.Query().Where(menuitem.IDEQ(id), menuitem.RestaurantIDEQ(restaurantID)).Exist(ctx); err != nil {
+		return nil, fmt.Errorf("failed to get menu item: %w", err)
+	}
+	if !exists {
+		err = apperr.NotFound("menu item %d", id)
+		return nil, err
+	}
+	var links []*ent.MenuItemModifier
+	links, err = tx.MenuItemModifier.Query().
+		Where(menuitemmodifier.MenuItemIDEQ(id)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get menu item modifiers: %w", err)
+	}
+	attached := make(map[uuid.UUID]*ent.MenuItemModifier, len(links))
+	for _, link := range links {
+		attached[link.ModifierID] = link
+	}
+	listed := ds.NewSet[uuid.UUID]()
+	for _, modifierID := range modifierIDs {
+		if _, ok := attached[modifierID]; !ok {
+			err = apperr.Invalid("modifier %s is not attached to menu item %d", modifierID, id)
+			return nil, err
+		}
+		if listed.Contains(modifierID) {
+			err = apperr.Invalid("modifier %s is listed more than once", modifierID)
+			return nil, err
+		}
+		listed.Add(modifierID)
+	}
+	if listed.Size() != len(links) {
+		err = apperr.Invalid("all %d modifiers of menu item %d must be listed, %d were", len(links), id, listed.Size())
+		return nil, err
+	}
+
+	for i, modifierID := range modifierIDs {
+		if attached[modifierID].DisplayOrder == i {
+			continue
+		}
+		if err = tx.MenuItemModifier.UpdateOne(attached[modifierID]).SetDisplayOrder(i).Exec(ctx); err != nil {
+			return nil, fmt.Errorf("failed to reorder menu item modifiers: %w", err)
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return r.GetModifiers(ctx, restaurantID, id)
+}
+
+// checkModifierOverrides checks an item's overrides of mod, loaded with its
+// options: prices must be for its options, and the max may not fall below
+// the selections the group needs.
+func checkModifierOverrides(mod *ent.Modifier, overrides *dto.MenuItemModifierOverrides) error {
+	for optionID := range overrides.OptionPrices {
+		if !slices.ContainsFunc(mod.Edges.ModifierOptions, func(o *ent.ModifierOption) bool { return o.ID == optionID }) {
+			return apperr.Invalid("modifier option %s is not an option of modifier %s", optionID, mod.ID)
+		}
+	}
+	required, maxSelections := mod.Required, mod.Max
+	if overrides.Required != nil {
+		required = *overrides.Required
+	}
+	if overrides.Max != nil {
+		maxSelections = *overrides.Max
+	}
+	minSelections := mod.Min
+	if required {
+		minSelections = max(minSelections, 1)
+	}
+	if maxSelections < minSelections {
+		return apperr.Invalid("modifier %s needs at least %d selections, above a max of %d", mod.ID, minSelections, maxSelections)
+	}
+	return nil
+}
+
+func (r *menuItemRepository) SetImage(ctx context.Context, restaurantID uuid.UUID, id int64, image *ImageData) (*dto.MenuItem, []string, error) {
+	replaced, err := r.GetImageKeys(ctx, restaurantID, id)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	update := r.client.MenuItem.UpdateOneID(id).Where(menuitem.RestaurantIDEQ(restaurantID))
+	if image == nil {
+		update.SetImageURL("").SetThumbnailURL("").ClearImageKeys()
+	} else {
+		update.SetImageURL(image.URL).SetThumbnailURL(image.ThumbnailURL).SetImageKeys(image.Keys)
+	}
+	if err := update.Exec(ctx); err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil, apperr.NotFound("menu item %d", id)
+		}
+		return nil, nil, fmt.Errorf("failed to set menu item image: %w", err)
+	}
+
+	item, err := r.get(ctx, id, menuitem.RestaurantIDEQ(restaurantID))
+	if err != nil {
+		return nil, nil, err
+	}
+	return item, replaced, nil
+}
+
+func (r *menuItemRepository) GetImageKeys(ctx context.Context, restaurantID uuid.UUID, id int64) ([]string, error) {
+	item, err := r.client.MenuItem.Query().
+		Where(menuitem.IDEQ(id), menuitem.RestaurantIDEQ(restaurantID)).
+		Select(menuitem.FieldImageKeys).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, apperr.NotFound("menu item %d", id)
+		}
+		return nil, fmt.Errorf("failed to get menu item: %w", err)
+	}
+	return item.ImageKeys, nil
+}
+
+// GetAuthorizationResource resolves the authz.Resource for a menu item by
+// joining through restaurant_id to the owning restaurant, in a single query.
+func (r *menuItemRepository) GetAuthorizationResource(ctx context.Context, id int64) (authz.Resource, error) {
+	rest, err := r.client.MenuItem.
+		Query().
+		Where(menuitem.ID(id)).
+		QueryRestaurant().
+		Select(restaurant.FieldID, restaurant.FieldUserID).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return authz.Resource{}, apperr.NotFound("menu item %d", id)
+		}
+		return authz.Resource{}, fmt.Errorf("failed to get menu item: %w", err)
+	}
+
+	return authz.Resource{
+		Type:         "menu_item",
+		RestaurantID: rest.ID,
+		OwnerUserID:  rest.UserID,
+		Attributes:   map[string]string{"menu_item_id": strconv.FormatInt(id, 10)},
+	}, nil
+}
+
 // func (r *menuItemRepository) FindUnavailableMenuItemsByIDsAndRestaurant(ctx context.Context, ids []int64, restaurantID uuid.UUID) ([]int64, error) {
 // 	menuitem, err := r.client.MenuItem.Query().
 // 		Select(
@@ -134,8 +647,8 @@ func (r *menuItemRepository) GetByIDsStrict(ctx context.Context, ids ds.Set[int6
 // 	return unavailableIDs, nil
 // }
 
-func (r *menuItemRepository) Update(ctx context.Context, id int64, req *dto.UpdateMenuItemRequest) (*dto.MenuItem, error) {
-	update := r.client.MenuItem.UpdateOneID(id)
+func (r *menuItemRepository) Update(ctx context.Context, restaurantID uuid.UUID, id int64, req *dto.UpdateMenuItemRequest) (*dto.MenuItem, error) {
+	update := r.client.MenuItem.UpdateOneID(id).Where(menuitem.RestaurantIDEQ(restaurantID))
 	if req.Name != nil {
 		update.SetName(*req.Name)
 	}
@@ -146,20 +659,37 @@ func (r *menuItemRepository) Update(ctx context.Context, id int64, req *dto.Upda
 		update.SetPrice(*req.Price)
 	}
 	if req.IsAvailable != nil {
-		update.SetIsAvailable(*req.IsAvailable)
+		// Setting availability by hand overrides it being 86ed for stock.
+		update.SetIsAvailable(*req.IsAvailable).SetOutOfStock(false)
+	}
+	if req.DisplayOrder != nil {
+		update.SetDisplayOrder(*req.DisplayOrder)
 	}
 	if req.CategoryID != nil {
+		if err := checkItemCategory(ctx, r.client, restaurantID, *req.CategoryID); err != nil {
+			return nil, err
+		}
 		update.SetCategoryID(*req.CategoryID)
 	}
 	updated, err := update.Save(ctx)
 	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, apperr.NotFound("menu item %d", id)
+		}
 		return nil, fmt.Errorf("failed to update menu item: %w", err)
 	}
-	return mapToMenuItemResponse(updated), nil
+	currency, err := getRestaurantCurrency(ctx, r.client, updated.RestaurantID)
+	if err != nil {
+		return nil, err
+	}
+	return mapToMenuItemResponse(updated, currency), nil
 }
 
-func (r *menuItemRepository) Delete(ctx context.Context, id int64) error {
-	err := r.client.MenuItem.DeleteOneID(id).Exec(ctx)
+func (r *menuItemRepository) Delete(ctx context.Context, restaurantID uuid.UUID, id int64) error {
+	err := r.client.MenuItem.
+		DeleteOneID(id).
+		Where(menuitem.RestaurantIDEQ(restaurantID)).
+		Exec(ctx)
 	if err != nil {
 		if ent.IsNotFound(err) {
 			return apperr.NotFound("menu item %d", id)
@@ -169,13 +699,36 @@ func (r *menuItemRepository) Delete(ctx context.Context, id int64) error {
 	return nil
 }
 
-func mapToMenuItemResponse(item *ent.MenuItem) *dto.MenuItem {
+// checkItemCategory checks that categoryID is a category of restaurantID,
+// so an item cannot be filed under another restaurant's category.
+func checkItemCategory(ctx context.Context, client *ent.Client, restaurantID, categoryID uuid.UUID) error {
+	exists, err := client.Category.Query().
+		Where(category.IDEQ(categoryID), category.RestaurantIDEQ(restaurantID)).
+		Exist(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get category: %w", err)
+	}
+	if !exists {
+		return apperr.Invalid("category %s does not exist in restaurant %s", categoryID, restaurantID)
+	}
+	return nil
+}
+
+func mapToMenuItemResponse(item *ent.MenuItem, currency money.Currency) *dto.MenuItem {
 	var modifiers []dto.Modifier
 
-	if item.Edges.Modifiers != nil {
-		modifiers = make([]dto.Modifier, len(item.Edges.Modifiers))
-		for i, mod := range item.Edges.Modifiers {
-			modifiers[i] = *mapToModifier(mod)
+	if item.Edges.ItemModifiers != nil {
+		modifiers = make([]dto.Modifier, len(item.Edges.ItemModifiers))
+		for i, link := range item.Edges.ItemModifiers {
+			modifiers[i] = mapToMenuItemModifier(link, currency).Modifier
+		}
+	}
+
+	var variants []dto.MenuItemVariant
+	if item.Edges.Variants != nil {
+		variants = make([]dto.MenuItemVariant, len(item.Edges.Variants))
+		for i, v := range item.Edges.Variants {
+			variants[i] = mapToMenuItemVariant(v, currency)
 		}
 	}
 
@@ -183,10 +736,68 @@ func mapToMenuItemResponse(item *ent.MenuItem) *dto.MenuItem {
 		ID:           item.ID,
 		Name:         item.Name,
 		Description:  item.Description,
-		Price:        item.Price,
+		Price:        money.New(item.Price, currency),
+		ImageURL:     item.ImageURL,
+		ThumbnailURL: item.ThumbnailURL,
 		IsAvailable:  item.IsAvailable,
+		OutOfStock:   item.OutOfStock,
+		DisplayOrder: item.DisplayOrder,
 		RestaurantID: item.RestaurantID,
 		CategoryID:   item.CategoryID,
 		Modifiers:    modifiers,
+		Variants:     variants,
+	}
+}
+
+func mapToMenuItemVariant(v *ent.MenuItemVariant, currency money.Currency) dto.MenuItemVariant {
+	return dto.MenuItemVariant{
+		ID:           v.ID,
+		Name:         v.Name,
+		Price:        money.New(v.Price, currency),
+		SKU:          v.Sku,
+		IsAvailable:  v.IsAvailable,
+		DisplayOrder: v.DisplayOrder,
+	}
+}
+
+// mapToMenuItemModifier maps a modifier group attached to an item, loaded
+// with its modifier.
+func mapToMenuItemModifier(link *ent.MenuItemModifier, currency money.Currency) dto.MenuItemModifier {
+	mod := *mapToModifier(link.Edges.Modifier)
+	if link.Required != nil {
+		mod.Required = *link.Required
+	}
+	if link.Max != nil {
+		mod.Max = *link.Max
+	}
+	var optionPrices map[uuid.UUID]money.Money
+	if len(link.OptionPrices) > 0 {
+		optionPrices = make(map[uuid.UUID]money.Money, len(link.OptionPrices))
+		for optionID, price := range link.OptionPrices {
+			optionPrices[optionID] = money.New(price, currency)
+		}
+		mod.OptionPrices = optionPrices
+	}
+	return dto.MenuItemModifier{
+		Modifier:     mod,
+		DisplayOrder: link.DisplayOrder,
+		Required:     link.Required,
+		Max:          link.Max,
+		OptionPrices: optionPrices,
 	}
 }
+
+// withItemModifiersOrdered loads an item's modifier groups in display
+// order, with their modifiers.
+func withItemModifiersOrdered(q *ent.MenuItemModifierQuery) {
+	q.Order(
+		menuitemmodifier.ByDisplayOrder(),
+		menuitemmodifier.ByModifierField(modifier.FieldName),
+		menuitemmodifier.ByModifierID(),
+	).WithModifier()
+}
+
+// withVariantsOrdered loads a menu item's variants in display order.
+func withVariantsOrdered(q *ent.MenuItemVariantQuery) {
+	q.Order(ent.Asc(menuitemvariant.FieldDisplayOrder), ent.Asc(menuitemvariant.FieldName))
+}
@@ -0,0 +1,560 @@
+package repos
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/Jiruu246/rms/internal/apperr"
+	"github.com/Jiruu246/rms/internal/authz"
+	"github.com/Jiruu246/rms/internal/dto"
+	"github.com/Jiruu246/rms/internal/ent"
+	"github.com/Jiruu246/rms/internal/ent/category"
+	"github.com/Jiruu246/rms/internal/ent/menu"
+	"github.com/Jiruu246/rms/internal/ent/menuitem"
+	"github.com/Jiruu246/rms/internal/ent/menuitemmodifier"
+	"github.com/Jiruu246/rms/internal/ent/menuitemprice"
+	"github.com/Jiruu246/rms/internal/ent/menuitemvariant"
+	"github.com/Jiruu246/rms/internal/ent/modifier"
+	"github.com/Jiruu246/rms/internal/ent/modifieroption"
+	"github.com/Jiruu246/rms/internal/ent/restaurant"
+	"github.com/Jiruu246/rms/pkg/money"
+	"github.com/google/uuid"
+)
+
+type MenuRepository interface {
+	Create(ctx context.Context, req *dto.CreateMenuRequest) (*dto.Menu, error)
+	GetByID(ctx context.Context, restaurantID, id uuid.UUID) (*dto.Menu, error)
+	GetAllByRestaurant(ctx context.Context, restaurantID uuid.UUID) ([]*dto.Menu, error)
+	// GetAllWithContents lists all of the restaurant's menus, inactive ones
+	// included, with their categories and item prices, in display order.
+	GetAllWithContents(ctx context.Context, restaurantID uuid.UUID) ([]*dto.Menu, error)
+	Update(ctx context.Context, restaurantID, id uuid.UUID, req *dto.UpdateMenuRequest) (*dto.Menu, error)
+	Delete(ctx context.Context, restaurantID, id uuid.UUID) error
+	// SetContents replaces the categories and item prices of the menu. They
+	// must all belong to restaurantID.
+	SetContents(ctx context.Context, restaurantID, id uuid.UUID, req *dto.SetMenuContentsRequest) (*dto.Menu, error)
+	// GetPublicMenu loads the restaurant's catalogue tree in display order,
+	// leaving out inactive categories and menus, unavailable items and
+	// options, and categories without available items.
+	GetPublicMenu(ctx context.Context, restaurantID uuid.UUID) (*dto.PublicMenu, error)
+	GetAuthorizationResource(ctx context.Context, id uuid.UUID) (authz.Resource, error)
+}
+
+type menuRepository struct {
+	client *ent.Client
+}
+
+func NewEntMenuRepository(client *ent.Client) MenuRepository {
+	return &menuRepository{client: client}
+}
+
+func (r *menuRepository) Create(ctx context.Context, req *dto.CreateMenuRequest) (*dto.Menu, error) {
+	create := r.client.Menu.
+		Create().
+		SetName(req.Name).
+		SetDescription(req.Description).
+		SetOrderTypes(req.OrderTypes).
+		SetDisplayOrder(req.DisplayOrder).
+		SetNillableIsActive(req.IsActive).
+		SetRestaurantID(req.RestaurantID)
+	if req.Schedule != nil {
+		create.SetSchedule(req.Schedule)
+	}
+
+	created, err := create.Save(ctx)
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return nil, apperr.Conflict("a menu named %s already exists", req.Name)
+		}
+		return nil, fmt.Errorf("failed to create menu: %w", err)
+	}
+
+	return r.GetByID(ctx, req.RestaurantID, created.ID)
+}
+
+func (r *menuRepository) GetByID(ctx context.Context, restaurantID, id uuid.UUID) (*dto.Menu, error) {
+	m, err := r.queryWithContents().
+		Where(menu.ID(id), menu.RestaurantIDEQ(restaurantID)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, apperr.NotFound("menu %s", id)
+		}
+		return nil, fmt.Errorf("failed to get menu: %w", err)
+	}
+
+	return mapToMenu(m), nil
+}
+
+func (r *menuRepository) GetAllByRestaurant(ctx context.Context, restaurantID uuid.UUID) ([]*dto.Menu, error) {
+	rows, err := r.client.Menu.
+		Query().
+		Where(menu.RestaurantIDEQ(restaurantID)).
+		Order(menu.ByDisplayOrder(), menu.ByName()).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get menus: %w", err)
+	}
+
+	return mapToMenus(rows), nil
+}
+
+func (r *menuRepository) GetAllWithContents(ctx context.Context, restaurantID uuid.UUID) ([]*dto.Menu, error) {
+	rows, err := r.queryWithContents().
+		Where(menu.RestaurantIDEQ(restaurantID)).
+		Order(menu.ByDisplayOrder(), menu.ByName()).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get menus: %w", err)
+	}
+
+	return mapToMenus(rows), nil
+}
+
+func (r *menuRepository) Update(ctx context.Context, restaurantID, id uuid.UUID, req *dto.UpdateMenuRequest) (*dto.Menu, error) {
+	update := r.client.Menu.UpdateOneID(id).Where(menu.RestaurantIDEQ(restaurantID))
+
+	hasUpdates := false
+
+	if req.Name != nil {
+		if strings.TrimSpace(*req.Name) == "" {
+			return nil, apperr.Invalid("name cannot be empty")
+		}
+		update.SetName(*req.Name)
+		hasUpdates = true
+	}
+
+	if req.Description != nil {
+		update.SetDescription(*req.Description)
+		hasUpdates = true
+	}
+
+	if req.Schedule != nil {
+		update.SetSchedule(req.Schedule)
+		hasUpdates = true
+	}
+
+	if req.OrderTypes != nil {
+		update.SetOrderTypes(*req.OrderTypes)
+		hasUpdates = true
+	}
+
+	if req.DisplayOrder != nil {
+		update.SetDisplayOrder(*req.DisplayOrder)
+		hasUpdates = true
+	}
+
+	if req.IsActive != nil {
+		update.SetIsActive(*req.IsActive)
+		hasUpdates = true
+	}
+
+	if !hasUpdates {
+		return nil, apperr.Invalid("no valid fields provided for update")
+	}
+
+	if err := update.Exec(ctx); err != nil {
+		if ent.IsNotFound(err) {
+			return nil, apperr.NotFound("menu %s", id)
+		}
+		if ent.IsConstraintError(err) {
+			return nil, apperr.Conflict("a menu named %s already exists", *req.Name)
+		}
+		return nil, fmt.Errorf("failed to update menu: %w", err)
+	}
+
+	return r.GetByID(ctx, restaurantID, id)
+}
+
+// Delete removes the menu with its item prices. Its categories and items
+// are left as they are.
+func (r *menuRepository) Delete(ctx context.Context, restaurantID, id uuid.UUID) error {
+	err := r.client.Menu.
+		DeleteOneID(id).
+		Where(menu.RestaurantIDEQ(restaurantID)).
+		Exec(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return apperr.NotFound("menu %s", id)
+		}
+		return fmt.Errorf("failed to delete menu: %w", err)
+	}
+
+	return nil
+}
+
+func (r *menuRepository) SetContents(ctx context.Context, restaurantID, id uuid.UUID, req *dto.SetMenuContentsRequest) (*dto.Menu, error) {
+	categoryIDs := slices.Compact(slices.SortedFunc(slices.Values(req.CategoryIDs), func(a, b uuid.UUID) int {
+		return strings.Compare(a.String(), b.String())
+	}))
+	prices := make(map[int64]int64, len(req.ItemPrices))
+	for _, p := range req.ItemPrices {
+		if _, dup := prices[p.MenuItemID]; dup {
+			return nil, apperr.Invalid("menu item %d is priced more than once", p.MenuItemID)
+		}
+		prices[p.MenuItemID] = p.Price
+	}
+
+	tx, err := r.client.Tx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to start transaction: %w", err)
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+
+	var exists bool
+	exists, err = tx.Menu.Query().
+		Where(menu.ID(id), menu.RestaurantIDEQ(restaurantID)).
+		Exist(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get menu: %w", err)
+	}
+	if !exists {
+		err = apperr.NotFound("menu %s", id)
+		return nil, err
+	}
+
+	if len(categoryIDs) > 0 {
+		var n int
+		n, err = tx.Category.Query().
+			Where(category.IDIn(categoryIDs...), category.RestaurantIDEQ(restaurantID)).
+			Count(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get categories: %w", err)
+		}
+		if n != len(categoryIDs) {
+			err = apperr.Invalid("one or more categories do not belong to restaurant %s", restaurantID)
+			return nil, err
+		}
+	}
+	err = tx.Menu.UpdateOneID(id).
+		ClearCategories().
+		AddCategoryIDs(categoryIDs...).
+		Exec(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to set menu categories: %w", err)
+	}
+
+	_, err = tx.MenuItemPrice.Delete().
+		Where(menuitemprice.MenuIDEQ(id)).
+		Exec(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to clear menu prices: %w", err)
+	}
+	if len(prices) > 0 {
+		menuItemIDs := slices.Sorted(maps.Keys(prices))
+		var n int
+		n, err = tx.MenuItem.Query().
+			Where(menuitem.IDIn(menuItemIDs...), menuitem.RestaurantIDEQ(restaurantID)).
+			Count(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get menu items: %w", err)
+		}
+		if n != len(menuItemIDs) {
+			err = apperr.Invalid("one or more menu items do not belong to restaurant %s", restaurantID)
+			return nil, err
+		}
+
+		builders := make([]*ent.MenuItemPriceCreate, 0, len(menuItemIDs))
+		for _, itemID := range menuItemIDs {
+			builders = append(builders, tx.MenuItemPrice.Create().
+				SetMenuID(id).
+				SetMenuItemID(itemID).
+				SetPrice(prices[itemID]))
+		}
+		err = tx.MenuItemPrice.CreateBulk(builders...).Exec(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to set menu prices: %w", err)
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return r.GetByID(ctx, restaurantID, id)
+}
+
+func (r *menuRepository) GetPublicMenu(ctx context.Context, restaurantID uuid.UUID) (*dto.PublicMenu, error) {
+	availableItems := func(q *ent.MenuItemQuery) {
+		q.Where(menuitem.IsAvailable(true)).
+			Order(menuitem.ByDisplayOrder(), menuitem.ByName(), menuitem.ByID()).
+			WithVariants(func(q *ent.MenuItemVariantQuery) {
+				q.Where(menuitemvariant.IsAvailable(true))
+				withVariantsOrdered(q)
+			}).
+			WithItemModifiers(func(q *ent.MenuItemModifierQuery) {
+				q.Order(
+					menuitemmodifier.ByDisplayOrder(),
+					menuitemmodifier.ByModifierField(modifier.FieldName),
+					menuitemmodifier.ByModifierID(),
+				)
+			})
+	}
+
+	rest, err := r.client.Restaurant.
+		Query().
+		Where(restaurant.ID(restaurantID)).
+		WithCategories(func(q *ent.CategoryQuery) {
+			q.Where(category.IsActive(true)).
+				Order(category.ByDisplayOrder(), category.ByName(), category.ByID()).
+				WithMenuItems(availableItems)
+		}).
+		WithMenuItems(func(q *ent.MenuItemQuery) {
+			q.Where(menuitem.CategoryIDIsNil())
+			availableItems(q)
+		}).
+		// Modifiers are loaded once for the whole restaurant, with their
+		// options' child groups, and nested by mapToPublicMenu.
+		WithModifiers(func(q *ent.ModifierQuery) {
+			q.WithModifierOptions(func(q *ent.ModifierOptionQuery) {
+				q.Where(modifieroption.Available(true)).
+					Order(modifieroption.ByDisplayOrder(), modifieroption.ByName(), modifieroption.ByID()).
+					WithChildModifiers(withChildModifiersOrdered)
+			})
+		}).
+		WithMenus(func(q *ent.MenuQuery) {
+			q.Where(menu.IsActive(true)).
+				Order(menu.ByDisplayOrder(), menu.ByName()).
+				WithCategories(func(q *ent.CategoryQuery) {
+					q.Select(category.FieldID).Order(category.ByDisplayOrder(), category.ByName())
+				}).
+				WithItemPrices(func(q *ent.MenuItemPriceQuery) {
+					q.Order(menuitemprice.ByMenuItemID())
+				})
+		}).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, apperr.NotFound("restaurant %s", restaurantID)
+		}
+		return nil, fmt.Errorf("failed to get menu: %w", err)
+	}
+
+	return mapToPublicMenu(rest), nil
+}
+
+// GetAuthorizationResource resolves the authz.Resource for a menu by
+// joining through restaurant_id to the owning restaurant, in a single query.
+func (r *menuRepository) GetAuthorizationResource(ctx context.Context, id uuid.UUID) (authz.Resource, error) {
+	rest, err := r.client.Menu.
+		Query().
+		Where(menu.ID(id)).
+		QueryRestaurant().
+		Select(restaurant.FieldID, restaurant.FieldUserID).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return authz.Resource{}, apperr.NotFound("menu %s", id)
+		}
+		return authz.Resource{}, fmt.Errorf("failed to get menu: %w", err)
+	}
+
+	return authz.Resource{
+		Type:         "menu",
+		ID:           id,
+		RestaurantID: rest.ID,
+		OwnerUserID:  rest.UserID,
+	}, nil
+}
+
+// queryWithContents loads menus with their categories and item prices, and
+// the restaurant currency the prices are in.
+func (r *menuRepository) queryWithContents() *ent.MenuQuery {
+	return r.client.Menu.
+		Query().
+		WithRestaurant(withRestaurantCurrency).
+		WithCategories(func(q *ent.CategoryQuery) {
+			q.Select(category.FieldID).Order(category.ByDisplayOrder(), category.ByName())
+		}).
+		WithItemPrices(func(q *ent.MenuItemPriceQuery) {
+			q.Order(menuitemprice.ByMenuItemID())
+		})
+}
+
+// mapToPublicMenu maps a restaurant loaded by GetPublicMenu. Its menus are
+// loaded without their restaurant, which is set here for the currency of
+// their prices. UpdatedAt comes from the catalogue rows alone: the
+// restaurant's own update_time moves with every order event (see
+// appendOrderEvent), which would change the menu's ETag with every order.
+func mapToPublicMenu(rest *ent.Restaurant) *dto.PublicMenu {
+	currency := money.Currency(rest.Currency)
+	var updatedAt time.Time
+	touch := func(t time.Time) {
+		if t.After(updatedAt) {
+			updatedAt = t
+		}
+	}
+
+	modifiers := make(map[uuid.UUID]*ent.Modifier, len(rest.Edges.Modifiers))
+	for _, mod := range rest.Edges.Modifiers {
+		modifiers[mod.ID] = mod
+	}
+	// mapModifier maps a modifier with its options and, within each, the
+	// option's child groups. path holds the groups it is nested in, which
+	// are skipped should the links ever form a cycle.
+	var mapModifier func(id uuid.UUID, path []uuid.UUID) (dto.PublicModifier, bool)
+	mapModifier = func(id uuid.UUID, path []uuid.UUID) (dto.PublicModifier, bool) {
+		mod, ok := modifiers[id]
+		if !ok || slices.Contains(path, id) {
+			return dto.PublicModifier{}, false
+		}
+		path = append(slices.Clip(path), id)
+		touch(mod.UpdateTime)
+		response := dto.PublicModifier{
+			ID:           mod.ID,
+			Name:         mod.Name,
+			Required:     mod.Required,
+			MultiSelect:  mod.MultiSelect,
+			Max:          mod.Max,
+			Min:          mod.Min,
+			FreeQuantity: mod.FreeQuantity,
+			Options:      make([]dto.PublicModifierOption, 0, len(mod.Edges.ModifierOptions)),
+		}
+		for _, opt := range mod.Edges.ModifierOptions {
+			touch(opt.UpdateTime)
+			option := dto.PublicModifierOption{
+				ID:            opt.ID,
+				Name:          opt.Name,
+				Price:         money.New(opt.Price, currency),
+				VariantPrices: mapVariantPrices(opt.VariantPrices, currency),
+				ImageURL:      opt.ImageURL,
+				ThumbnailURL:  opt.ThumbnailURL,
+				PreSelect:     opt.PreSelect,
+				DisplayOrder:  opt.DisplayOrder,
+				MaxQuantity:   opt.MaxQuantity,
+			}
+			for _, child := range opt.Edges.ChildModifiers {
+				if nested, ok := mapModifier(child.ID, path); ok {
+					option.Modifiers = append(option.Modifiers, nested)
+				}
+			}
+			response.Options = append(response.Options, option)
+		}
+		return response, true
+	}
+
+	mapItems := func(rows []*ent.MenuItem) []dto.PublicMenuItem {
+		items := make([]dto.PublicMenuItem, 0, len(rows))
+		for _, item := range rows {
+			touch(item.UpdateTime)
+			response := dto.PublicMenuItem{
+				ID:           item.ID,
+				Name:         item.Name,
+				Description:  item.Description,
+				Price:        money.New(item.Price, currency),
+				ImageURL:     item.ImageURL,
+				ThumbnailURL: item.ThumbnailURL,
+				DisplayOrder: item.DisplayOrder,
+				Modifiers:    make([]dto.PublicModifier, 0, len(item.Edges.ItemModifiers)),
+			}
+			for _, v := range item.Edges.Variants {
+				touch(v.UpdateTime)
+				response.Variants = append(response.Variants, dto.PublicMenuItemVariant{
+					ID:    v.ID,
+					Name:  v.Name,
+					Price: money.New(v.Price, currency),
+				})
+			}
+			for _, link := range item.Edges.ItemModifiers {
+				modifier, ok := mapModifier(link.ModifierID, nil)
+				if !ok {
+					continue
+				}
+				touch(link.UpdateTime)
+				applyItemOverrides(&modifier, link, currency)
+				response.Modifiers = append(response.Modifiers, modifier)
+			}
+			items = append(items, response)
+		}
+		return items
+	}
+
+	response := &dto.PublicMenu{
+		RestaurantID:       rest.ID,
+		Currency:           currency,
+		Timezone:           rest.Timezone,
+		Menus:              make([]dto.Menu, 0, len(rest.Edges.Menus)),
+		Categories:         make([]dto.PublicCategory, 0, len(rest.Edges.Categories)),
+		UncategorizedItems: mapItems(rest.Edges.MenuItems),
+	}
+	for _, m := range rest.Edges.Menus {
+		touch(m.UpdateTime)
+		m.Edges.Restaurant = rest
+		response.Menus = append(response.Menus, *mapToMenu(m))
+	}
+	for _, cat := range rest.Edges.Categories {
+		if len(cat.Edges.MenuItems) == 0 {
+			continue
+		}
+		touch(cat.UpdateTime)
+		response.Categories = append(response.Categories, dto.PublicCategory{
+			ID:           cat.ID,
+			Name:         cat.Name,
+			Description:  cat.Description,
+			DisplayOrder: cat.DisplayOrder,
+			Items:        mapItems(cat.Edges.MenuItems),
+		})
+	}
+	response.UpdatedAt = updatedAt.UTC()
+	return response
+}
+
+func mapToMenus(rows []*ent.Menu) []*dto.Menu {
+	menus := make([]*dto.Menu, 0, len(rows))
+	for _, m := range rows {
+		menus = append(menus, mapToMenu(m))
+	}
+	return menus
+}
+
+func mapToMenu(m *ent.Menu) *dto.Menu {
+	response := &dto.Menu{
+		ID:           m.ID,
+		Name:         m.Name,
+		Description:  m.Description,
+		Schedule:     m.Schedule,
+		OrderTypes:   make([]dto.OrderType, 0, len(m.OrderTypes)),
+		DisplayOrder: m.DisplayOrder,
+		IsActive:     m.IsActive,
+		RestaurantID: m.RestaurantID,
+		CreatedAt:    m.CreateTime,
+		UpdatedAt:    m.UpdateTime,
+	}
+	for _, t := range m.OrderTypes {
+		response.OrderTypes = append(response.OrderTypes, dto.OrderType(t))
+	}
+	for _, cat := range m.Edges.Categories {
+		response.CategoryIDs = append(response.CategoryIDs, cat.ID)
+	}
+	currency := restaurantCurrencyOf(m.Edges.Restaurant)
+	for _, p := range m.Edges.ItemPrices {
+		response.ItemPrices = append(response.ItemPrices, dto.MenuItemPrice{
+			MenuItemID: p.MenuItemID,
+			Price:      money.New(p.Price, currency),
+		})
+	}
+	return response
+}
+
+// applyItemOverrides applies a menu item's overrides of an attached group
+// to the group as listed under the item.
+func applyItemOverrides(mod *dto.PublicModifier, link *ent.MenuItemModifier, currency money.Currency) {
+	if link.Required != nil {
+		mod.Required = *link.Required
+	}
+	if link.Max != nil {
+		mod.Max = *link.Max
+	}
+	for i := range mod.Options {
+		if price, ok := link.OptionPrices[mod.Options[i].ID]; ok {
+			mod.Options[i].Price = money.New(price, currency)
+		}
+	}
+}
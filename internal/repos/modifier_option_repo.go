@@ -3,22 +3,39 @@ package repos
 import (
 	"context"
 	"fmt"
+	"slices"
+	"strings"
 
 	"github.com/Jiruu246/rms/internal/apperr"
+	"github.com/Jiruu246/rms/internal/authz"
 	ds "github.com/Jiruu246/rms/internal/data_structures"
 	"github.com/Jiruu246/rms/internal/dto"
 	"github.com/Jiruu246/rms/internal/ent"
+	"github.com/Jiruu246/rms/internal/ent/modifier"
 	"github.com/Jiruu246/rms/internal/ent/modifieroption"
+	"github.com/Jiruu246/rms/internal/ent/predicate"
+	"github.com/Jiruu246/rms/internal/ent/restaurant"
+	"github.com/Jiruu246/rms/pkg/money"
 	"github.com/google/uuid"
 )
 
 type ModifierOptionRepository interface {
 	Create(ctx context.Context, data *dto.CreateModifierOptionData) (*dto.ModifierOption, error)
-	GetByID(ctx context.Context, id uuid.UUID) (*dto.ModifierOption, error)
+	GetByID(ctx context.Context, restaurantID, id uuid.UUID) (*dto.ModifierOption, error)
 	GetByIDsStrict(ctx context.Context, ids ds.Set[uuid.UUID]) (map[uuid.UUID]*dto.ModifierOption, error)
-	Update(ctx context.Context, data *dto.UpdateModifierOptionData) (*dto.ModifierOption, error)
-	Delete(ctx context.Context, id uuid.UUID) error
-	GetAll(ctx context.Context) ([]*dto.ModifierOption, error)
+	// GetPreSelected returns the available pre_select options of the given
+	// modifiers, keyed by ID.
+	GetPreSelected(ctx context.Context, modifierIDs ds.Set[uuid.UUID]) (map[uuid.UUID]*dto.ModifierOption, error)
+	// Update moves the option only to another modifier of restaurantID.
+	Update(ctx context.Context, restaurantID uuid.UUID, data *dto.UpdateModifierOptionData) (*dto.ModifierOption, error)
+	Delete(ctx context.Context, restaurantID, id uuid.UUID) error
+	GetAllByRestaurant(ctx context.Context, restaurantID uuid.UUID) ([]*dto.ModifierOption, error)
+	// SetImage sets the option's uploaded image, or clears it if image is
+	// nil, and returns the blob keys of the image it replaced.
+	SetImage(ctx context.Context, restaurantID, id uuid.UUID, image *ImageData) (*dto.ModifierOption, []string, error)
+	// GetImageKeys returns the blob keys of the option's uploaded image.
+	GetImageKeys(ctx context.Context, restaurantID, id uuid.UUID) ([]string, error)
+	GetAuthorizationResource(ctx context.Context, id uuid.UUID) (authz.Resource, error)
 }
 
 type modifierOptionRepository struct {
@@ -31,24 +48,55 @@ func NewEntModifierOptionRepository(client *ent.Client) ModifierOptionRepository
 	}
 }
 
-func (r *modifierOptionRepository) Create(ctx context.Context, data *dto.CreateModifierOptionData) (*dto.ModifierOption, error) {
-	create := r.client.ModifierOption.Create().
+func (r *modifierOptionRepository) Create(ctx context.Context, data *dto.CreateModifierOptionData) (option *dto.ModifierOption, err error) {
+	tx, err := r.client.Tx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to start transaction: %w", err)
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+
+	childIDs := uniqueModifierIDs(data.Request.ChildModifierIDs)
+	if err = checkChildModifiers(ctx, tx, data.Request.ModifierID, childIDs); err != nil {
+		return nil, err
+	}
+	m, err := tx.ModifierOption.Create().
 		SetName(data.Request.Name).
 		SetPrice(data.Request.Price).
+		SetVariantPrices(data.Request.VariantPrices).
 		SetImageURL(data.Request.ImageURL).
 		SetAvailable(data.Request.Available).
 		SetPreSelect(data.Request.PreSelect).
-		SetModifierID(data.Request.ModifierID)
-	m, err := create.Save(ctx)
+		SetDisplayOrder(data.Request.DisplayOrder).
+		SetMaxQuantity(data.Request.MaxQuantity).
+		SetModifierID(data.Request.ModifierID).
+		AddChildModifierIDs(childIDs...).
+		Save(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create modifier option: %w", err)
 	}
-	return mapToModifierOptionResponse(m), nil
+
+	if err = tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return r.get(ctx, m.ID)
 }
 
-func (r *modifierOptionRepository) GetByID(ctx context.Context, id uuid.UUID) (*dto.ModifierOption, error) {
+func (r *modifierOptionRepository) GetByID(ctx context.Context, restaurantID, id uuid.UUID) (*dto.ModifierOption, error) {
+	return r.get(ctx, id, inModifierRestaurant(restaurantID))
+}
+
+// get reads the option after a change made by ID, where its restaurant has
+// already been checked.
+func (r *modifierOptionRepository) get(ctx context.Context, id uuid.UUID, ps ...predicate.ModifierOption) (*dto.ModifierOption, error) {
 	m, err := r.client.ModifierOption.Query().
 		Where(modifieroption.IDEQ(id)).
+		Where(ps...).
+		WithModifier(withModifierRestaurantCurrency).
+		WithChildModifiers(withChildModifiersOrdered).
 		Only(ctx)
 	if err != nil {
 		if ent.IsNotFound(err) {
@@ -56,12 +104,14 @@ func (r *modifierOptionRepository) GetByID(ctx context.Context, id uuid.UUID) (*
 		}
 		return nil, fmt.Errorf("failed to get modifier option: %w", err)
 	}
-	return mapToModifierOptionResponse(m), nil
+	return mapToModifierOptionResponse(m, modifierOptionCurrencyOf(m)), nil
 }
 
 func (r *modifierOptionRepository) GetByIDsStrict(ctx context.Context, ids ds.Set[uuid.UUID]) (map[uuid.UUID]*dto.ModifierOption, error) {
 	modifierOptions, err := r.client.ModifierOption.Query().
 		Where(modifieroption.IDIn(ids.Items()...)).
+		WithModifier(withModifierRestaurantCurrency).
+		WithChildModifiers(withChildModifiersOrdered).
 		All(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get modifier options: %w", err)
@@ -71,40 +121,182 @@ func (r *modifierOptionRepository) GetByIDsStrict(ctx context.Context, ids ds.Se
 	}
 	responses := make(map[uuid.UUID]*dto.ModifierOption, len(modifierOptions))
 	for _, m := range modifierOptions {
-		responses[m.ID] = mapToModifierOptionResponse(m)
+		responses[m.ID] = mapToModifierOptionResponse(m, modifierOptionCurrencyOf(m))
 	}
 	return responses, nil
 }
 
-func (r *modifierOptionRepository) Update(ctx context.Context, data *dto.UpdateModifierOptionData) (*dto.ModifierOption, error) {
-	update := r.client.ModifierOption.UpdateOneID(data.ID)
+func (r *modifierOptionRepository) GetPreSelected(ctx context.Context, modifierIDs ds.Set[uuid.UUID]) (map[uuid.UUID]*dto.ModifierOption, error) {
+	modifierOptions, err := r.client.ModifierOption.Query().
+		Where(
+			modifieroption.ModifierIDIn(modifierIDs.Items()...),
+			modifieroption.PreSelect(true),
+			modifieroption.Available(true),
+		).
+		WithModifier(withModifierRestaurantCurrency).
+		WithChildModifiers(withChildModifiersOrdered).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get pre-selected modifier options: %w", err)
+	}
+	responses := make(map[uuid.UUID]*dto.ModifierOption, len(modifierOptions))
+	for _, m := range modifierOptions {
+		responses[m.ID] = mapToModifierOptionResponse(m, modifierOptionCurrencyOf(m))
+	}
+	return responses, nil
+}
+
+func (r *modifierOptionRepository) Update(ctx context.Context, restaurantID uuid.UUID, data *dto.UpdateModifierOptionData) (option *dto.ModifierOption, err error) {
+	tx, err := r.client.Tx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to start transaction: %w", err)
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+
+	update := tx.ModifierOption.UpdateOneID(data.ID).Where(inModifierRestaurant(restaurantID))
 	if data.Request.Name != nil {
 		update.SetName(*data.Request.Name)
 	}
 	if data.Request.Price != nil {
 		update.SetPrice(*data.Request.Price)
 	}
-	if data.Request.ImageURL != nil {
-		update.SetImageURL(*data.Request.ImageURL)
+	if data.Request.VariantPrices != nil {
+		update.SetVariantPrices(*data.Request.VariantPrices)
 	}
 	if data.Request.Available != nil {
-		update.SetAvailable(*data.Request.Available)
+		// Setting availability by hand overrides it being 86ed for stock.
+		update.SetAvailable(*data.Request.Available).SetOutOfStock(false)
 	}
 	if data.Request.PreSelect != nil {
 		update.SetPreSelect(*data.Request.PreSelect)
 	}
-	if data.Request.ModifierID != nil {
-		update.SetModifierID(*data.Request.ModifierID)
+	if data.Request.DisplayOrder != nil {
+		update.SetDisplayOrder(*data.Request.DisplayOrder)
 	}
-	m, err := update.Save(ctx)
-	if err != nil {
+	if data.Request.MaxQuantity != nil {
+		update.SetMaxQuantity(*data.Request.MaxQuantity)
+	}
+	if data.Request.ModifierID != nil || data.Request.ChildModifierIDs != nil {
+		// Moving the option or changing its children must keep the groups
+		// in one restaurant and free of cycles.
+		var current *ent.ModifierOption
+		current, err = tx.ModifierOption.Query().
+			Where(modifieroption.IDEQ(data.ID), inModifierRestaurant(restaurantID)).
+			WithChildModifiers(func(q *ent.ModifierQuery) { q.Select(modifier.FieldID) }).
+			Only(ctx)
+		if err != nil {
+			if ent.IsNotFound(err) {
+				return nil, apperr.NotFound("modifier option %s", data.ID)
+			}
+			return nil, fmt.Errorf("failed to get modifier option: %w", err)
+		}
+		modifierID := current.ModifierID
+		if data.Request.ModifierID != nil {
+			modifierID = *data.Request.ModifierID
+			var exists bool
+			exists, err = tx.Modifier.Query().
+				Where(modifier.IDEQ(modifierID), modifier.RestaurantIDEQ(restaurantID)).
+				Exist(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get modifier: %w", err)
+			}
+			if !exists {
+				err = apperr.Invalid("modifier %s does not exist in restaurant %s", modifierID, restaurantID)
+				return nil, err
+			}
+			update.SetModifierID(modifierID)
+		}
+		var childIDs []uuid.UUID
+		if data.Request.ChildModifierIDs != nil {
+			childIDs = uniqueModifierIDs(*data.Request.ChildModifierIDs)
+			update.ClearChildModifiers().AddChildModifierIDs(childIDs...)
+		} else {
+			for _, child := range current.Edges.ChildModifiers {
+				childIDs = append(childIDs, child.ID)
+			}
+		}
+		if err = checkChildModifiers(ctx, tx, modifierID, childIDs); err != nil {
+			return nil, err
+		}
+	}
+	if err = update.Exec(ctx); err != nil {
+		if ent.IsNotFound(err) {
+			return nil, apperr.NotFound("modifier option %s", data.ID)
+		}
 		return nil, fmt.Errorf("failed to update modifier option: %w", err)
 	}
-	return mapToModifierOptionResponse(m), nil
+
+	if err = tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return r.get(ctx, data.ID)
 }
 
-func (r *modifierOptionRepository) Delete(ctx context.Context, id uuid.UUID) error {
-	err := r.client.ModifierOption.DeleteOneID(id).Exec(ctx)
+// checkChildModifiers checks that an option of modifierID can have childIDs
+// as its child modifier groups: they must belong to the same restaurant,
+// and modifierID must not be among them or the groups they lead to, which
+// would let a selection nest forever.
+func checkChildModifiers(ctx context.Context, tx *ent.Tx, modifierID uuid.UUID, childIDs []uuid.UUID) error {
+	if len(childIDs) == 0 {
+		return nil
+	}
+	parent, err := tx.Modifier.Query().
+		Where(modifier.IDEQ(modifierID)).
+		Select(modifier.FieldID, modifier.FieldRestaurantID).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return apperr.Invalid("modifier %s does not exist", modifierID)
+		}
+		return fmt.Errorf("failed to get modifier: %w", err)
+	}
+	n, err := tx.Modifier.Query().
+		Where(modifier.IDIn(childIDs...), modifier.RestaurantIDEQ(parent.RestaurantID)).
+		Count(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get child modifiers: %w", err)
+	}
+	if n != len(childIDs) {
+		return apperr.Invalid("child modifiers must exist and belong to the option's restaurant")
+	}
+
+	seen := ds.NewSet[uuid.UUID]()
+	for _, id := range childIDs {
+		seen.Add(id)
+	}
+	frontier := childIDs
+	for len(frontier) > 0 {
+		if slices.Contains(frontier, modifierID) {
+			return apperr.Invalid("modifier %s cannot be nested within itself", modifierID)
+		}
+		ids, err := tx.Modifier.Query().
+			Where(modifier.IDIn(frontier...)).
+			QueryModifierOptions().
+			QueryChildModifiers().
+			IDs(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to get child modifiers: %w", err)
+		}
+		frontier = frontier[:0:0]
+		for _, id := range ids {
+			if !seen.Contains(id) {
+				seen.Add(id)
+				frontier = append(frontier, id)
+			}
+		}
+	}
+	return nil
+}
+
+func (r *modifierOptionRepository) Delete(ctx context.Context, restaurantID, id uuid.UUID) error {
+	err := r.client.ModifierOption.
+		DeleteOneID(id).
+		Where(inModifierRestaurant(restaurantID)).
+		Exec(ctx)
 	if err != nil {
 		if ent.IsNotFound(err) {
 			return apperr.NotFound("modifier option %s", id)
@@ -114,26 +306,173 @@ func (r *modifierOptionRepository) Delete(ctx context.Context, id uuid.UUID) err
 	return nil
 }
 
-func (r *modifierOptionRepository) GetAll(ctx context.Context) ([]*dto.ModifierOption, error) {
-	options, err := r.client.ModifierOption.Query().All(ctx)
+func (r *modifierOptionRepository) GetAllByRestaurant(ctx context.Context, restaurantID uuid.UUID) ([]*dto.ModifierOption, error) {
+	options, err := r.client.ModifierOption.Query().
+		Where(inModifierRestaurant(restaurantID)).
+		WithModifier(withModifierRestaurantCurrency).
+		WithChildModifiers(withChildModifiersOrdered).
+		All(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get modifier options: %w", err)
 	}
 	responses := make([]*dto.ModifierOption, 0, len(options))
 	for _, m := range options {
-		responses = append(responses, mapToModifierOptionResponse(m))
+		responses = append(responses, mapToModifierOptionResponse(m, modifierOptionCurrencyOf(m)))
 	}
 	return responses, nil
 }
 
-func mapToModifierOptionResponse(m *ent.ModifierOption) *dto.ModifierOption {
+// inModifierRestaurant matches options whose modifier belongs to
+// restaurantID; options have no restaurant_id of their own.
+func inModifierRestaurant(restaurantID uuid.UUID) predicate.ModifierOption {
+	return modifieroption.HasModifierWith(modifier.RestaurantIDEQ(restaurantID))
+}
+
+// modifierCurrency returns the currency of the restaurant owning modifierID.
+func (r *modifierOptionRepository) modifierCurrency(ctx context.Context, modifierID uuid.UUID) (money.Currency, error) {
+	mod, err := r.client.Modifier.Query().
+		Where(modifier.IDEQ(modifierID)).
+		Select(modifier.FieldID, modifier.FieldRestaurantID).
+		WithRestaurant(withRestaurantCurrency).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return "", apperr.NotFound("modifier %s", modifierID)
+		}
+		return "", fmt.Errorf("failed to get modifier currency: %w", err)
+	}
+	return restaurantCurrencyOf(mod.Edges.Restaurant), nil
+}
+
+// withModifierRestaurantCurrency eager-loads option -> modifier -> restaurant
+// with only the columns needed to resolve the option's currency.
+func withModifierRestaurantCurrency(q *ent.ModifierQuery) {
+	q.Select(modifier.FieldID, modifier.FieldRestaurantID).
+		WithRestaurant(withRestaurantCurrency)
+}
+
+func modifierOptionCurrencyOf(m *ent.ModifierOption) money.Currency {
+	if m.Edges.Modifier == nil {
+		return ""
+	}
+	return restaurantCurrencyOf(m.Edges.Modifier.Edges.Restaurant)
+}
+
+func (r *modifierOptionRepository) SetImage(ctx context.Context, restaurantID, id uuid.UUID, image *ImageData) (*dto.ModifierOption, []string, error) {
+	replaced, err := r.GetImageKeys(ctx, restaurantID, id)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	update := r.client.ModifierOption.UpdateOneID(id).Where(inModifierRestaurant(restaurantID))
+	if image == nil {
+		update.SetImageURL("").SetThumbnailURL("").ClearImageKeys()
+	} else {
+		update.SetImageURL(image.URL).SetThumbnailURL(image.ThumbnailURL).SetImageKeys(image.Keys)
+	}
+	if err := update.Exec(ctx); err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil, apperr.NotFound("modifier option %s", id)
+		}
+		return nil, nil, fmt.Errorf("failed to set modifier option image: %w", err)
+	}
+
+	option, err := r.get(ctx, id, inModifierRestaurant(restaurantID))
+	if err != nil {
+		return nil, nil, err
+	}
+	return option, replaced, nil
+}
+
+func (r *modifierOptionRepository) GetImageKeys(ctx context.Context, restaurantID, id uuid.UUID) ([]string, error) {
+	option, err := r.client.ModifierOption.Query().
+		Where(modifieroption.IDEQ(id), inModifierRestaurant(restaurantID)).
+		Select(modifieroption.FieldImageKeys).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, apperr.NotFound("modifier option %s", id)
+		}
+		return nil, fmt.Errorf("failed to get modifier option: %w", err)
+	}
+	return option.ImageKeys, nil
+}
+
+// GetAuthorizationResource resolves the authz.Resource for a modifier
+// option through its modifier to the owning restaurant, in a single query.
+func (r *modifierOptionRepository) GetAuthorizationResource(ctx context.Context, id uuid.UUID) (authz.Resource, error) {
+	rest, err := r.client.ModifierOption.
+		Query().
+		Where(modifieroption.ID(id)).
+		QueryModifier().
+		QueryRestaurant().
+		Select(restaurant.FieldID, restaurant.FieldUserID).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return authz.Resource{}, apperr.NotFound("modifier option %s", id)
+		}
+		return authz.Resource{}, fmt.Errorf("failed to get modifier option: %w", err)
+	}
+
+	return authz.Resource{
+		Type:         "modifier_option",
+		ID:           id,
+		RestaurantID: rest.ID,
+		OwnerUserID:  rest.UserID,
+	}, nil
+}
+
+func mapToModifierOptionResponse(m *ent.ModifierOption, currency money.Currency) *dto.ModifierOption {
 	return &dto.ModifierOption{
-		ID:         m.ID,
-		Name:       m.Name,
-		Price:      m.Price,
-		ImageURL:   m.ImageURL,
-		Available:  m.Available,
-		PreSelect:  m.PreSelect,
-		ModifierID: m.ModifierID,
+		ID:             m.ID,
+		Name:           m.Name,
+		Price:          money.New(m.Price, currency),
+		VariantPrices:  mapVariantPrices(m.VariantPrices, currency),
+		ImageURL:       m.ImageURL,
+		ThumbnailURL:   m.ThumbnailURL,
+		Available:      m.Available,
+		OutOfStock:     m.OutOfStock,
+		PreSelect:      m.PreSelect,
+		DisplayOrder:   m.DisplayOrder,
+		ModifierID:     m.ModifierID,
+		MaxQuantity:    m.MaxQuantity,
+		ChildModifiers: mapChildModifiers(m.Edges.ChildModifiers),
+	}
+}
+
+func uniqueModifierIDs(ids []uuid.UUID) []uuid.UUID {
+	return slices.Compact(slices.SortedFunc(slices.Values(ids), func(a, b uuid.UUID) int {
+		return strings.Compare(a.String(), b.String())
+	}))
+}
+
+// mapChildModifiers maps an option's child modifier groups, or nil if it
+// has none.
+func mapChildModifiers(rows []*ent.Modifier) []dto.Modifier {
+	if len(rows) == 0 {
+		return nil
+	}
+	mapped := make([]dto.Modifier, len(rows))
+	for i, mod := range rows {
+		mapped[i] = *mapToModifier(mod)
+	}
+	return mapped
+}
+
+func withChildModifiersOrdered(q *ent.ModifierQuery) {
+	q.Order(modifier.ByName(), modifier.ByID())
+}
+
+// mapVariantPrices maps an option's variant prices to money, or nil if it
+// has none.
+func mapVariantPrices(prices map[string]int64, currency money.Currency) map[string]money.Money {
+	if len(prices) == 0 {
+		return nil
+	}
+	mapped := make(map[string]money.Money, len(prices))
+	for name, price := range prices {
+		mapped[name] = money.New(price, currency)
 	}
+	return mapped
 }
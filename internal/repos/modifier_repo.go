@@ -5,18 +5,21 @@ import (
 	"fmt"
 
 	"github.com/Jiruu246/rms/internal/apperr"
+	"github.com/Jiruu246/rms/internal/authz"
 	"github.com/Jiruu246/rms/internal/dto"
 	"github.com/Jiruu246/rms/internal/ent"
 	"github.com/Jiruu246/rms/internal/ent/modifier"
+	"github.com/Jiruu246/rms/internal/ent/restaurant"
 	"github.com/google/uuid"
 )
 
 type ModifierRepository interface {
 	Create(ctx context.Context, data *dto.CreateModifierData) (*dto.Modifier, error)
-	GetByID(ctx context.Context, id uuid.UUID) (*dto.Modifier, error)
-	Update(ctx context.Context, data *dto.UpdateModifierData) (*dto.Modifier, error)
-	Delete(ctx context.Context, id uuid.UUID) error
-	GetAll(ctx context.Context) ([]*dto.Modifier, error)
+	GetByID(ctx context.Context, restaurantID, id uuid.UUID) (*dto.Modifier, error)
+	Update(ctx context.Context, restaurantID uuid.UUID, data *dto.UpdateModifierData) (*dto.Modifier, error)
+	Delete(ctx context.Context, restaurantID, id uuid.UUID) error
+	GetAllByRestaurant(ctx context.Context, restaurantID uuid.UUID) ([]*dto.Modifier, error)
+	GetAuthorizationResource(ctx context.Context, id uuid.UUID) (authz.Resource, error)
 }
 
 type modifierRepository struct {
@@ -35,6 +38,8 @@ func (r *modifierRepository) Create(ctx context.Context, data *dto.CreateModifie
 		SetRequired(data.Request.Required).
 		SetMultiSelect(data.Request.MultiSelect).
 		SetMax(data.Request.Max).
+		SetMin(data.Request.Min).
+		SetFreeQuantity(data.Request.FreeQuantity).
 		SetRestaurantID(data.Request.RestaurantID).
 		Save(ctx)
 	if err != nil {
@@ -43,9 +48,9 @@ func (r *modifierRepository) Create(ctx context.Context, data *dto.CreateModifie
 	return mapToModifier(create), nil
 }
 
-func (r *modifierRepository) GetByID(ctx context.Context, id uuid.UUID) (*dto.Modifier, error) {
+func (r *modifierRepository) GetByID(ctx context.Context, restaurantID, id uuid.UUID) (*dto.Modifier, error) {
 	m, err := r.client.Modifier.Query().
-		Where(modifier.IDEQ(id)).
+		Where(modifier.IDEQ(id), modifier.RestaurantIDEQ(restaurantID)).
 		Only(ctx)
 	if err != nil {
 		if ent.IsNotFound(err) {
@@ -56,8 +61,8 @@ func (r *modifierRepository) GetByID(ctx context.Context, id uuid.UUID) (*dto.Mo
 	return mapToModifier(m), nil
 }
 
-func (r *modifierRepository) Update(ctx context.Context, data *dto.UpdateModifierData) (*dto.Modifier, error) {
-	update := r.client.Modifier.UpdateOneID(data.ID)
+func (r *modifierRepository) Update(ctx context.Context, restaurantID uuid.UUID, data *dto.UpdateModifierData) (*dto.Modifier, error) {
+	update := r.client.Modifier.UpdateOneID(data.ID).Where(modifier.RestaurantIDEQ(restaurantID))
 	if data.Request.Name != nil {
 		update.SetName(*data.Request.Name)
 	}
@@ -70,18 +75,27 @@ func (r *modifierRepository) Update(ctx context.Context, data *dto.UpdateModifie
 	if data.Request.Max != nil {
 		update.SetMax(*data.Request.Max)
 	}
-	if data.Request.RestaurantID != nil {
-		update.SetRestaurantID(*data.Request.RestaurantID)
+	if data.Request.Min != nil {
+		update.SetMin(*data.Request.Min)
+	}
+	if data.Request.FreeQuantity != nil {
+		update.SetFreeQuantity(*data.Request.FreeQuantity)
 	}
 	m, err := update.Save(ctx)
 	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, apperr.NotFound("modifier %s", data.ID)
+		}
 		return nil, fmt.Errorf("failed to update modifier: %w", err)
 	}
 	return mapToModifier(m), nil
 }
 
-func (r *modifierRepository) Delete(ctx context.Context, id uuid.UUID) error {
-	err := r.client.Modifier.DeleteOneID(id).Exec(ctx)
+func (r *modifierRepository) Delete(ctx context.Context, restaurantID, id uuid.UUID) error {
+	err := r.client.Modifier.
+		DeleteOneID(id).
+		Where(modifier.RestaurantIDEQ(restaurantID)).
+		Exec(ctx)
 	if err != nil {
 		if ent.IsNotFound(err) {
 			return apperr.NotFound("modifier %s", id)
@@ -91,8 +105,10 @@ func (r *modifierRepository) Delete(ctx context.Context, id uuid.UUID) error {
 	return nil
 }
 
-func (r *modifierRepository) GetAll(ctx context.Context) ([]*dto.Modifier, error) {
-	modifiers, err := r.client.Modifier.Query().All(ctx)
+func (r *modifierRepository) GetAllByRestaurant(ctx context.Context, restaurantID uuid.UUID) ([]*dto.Modifier, error) {
+	modifiers, err := r.client.Modifier.Query().
+		Where(modifier.RestaurantIDEQ(restaurantID)).
+		All(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get modifiers: %w", err)
 	}
@@ -103,6 +119,30 @@ func (r *modifierRepository) GetAll(ctx context.Context) ([]*dto.Modifier, error
 	return responses, nil
 }
 
+// GetAuthorizationResource resolves the authz.Resource for a modifier by
+// joining through restaurant_id to the owning restaurant, in a single query.
+func (r *modifierRepository) GetAuthorizationResource(ctx context.Context, id uuid.UUID) (authz.Resource, error) {
+	rest, err := r.client.Modifier.
+		Query().
+		Where(modifier.IDEQ(id)).
+		QueryRestaurant().
+		Select(restaurant.FieldID, restaurant.FieldUserID).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return authz.Resource{}, apperr.NotFound("modifier %s", id)
+		}
+		return authz.Resource{}, fmt.Errorf("failed to get modifier: %w", err)
+	}
+
+	return authz.Resource{
+		Type:         "modifier",
+		ID:           id,
+		RestaurantID: rest.ID,
+		OwnerUserID:  rest.UserID,
+	}, nil
+}
+
 func mapToModifier(m *ent.Modifier) *dto.Modifier {
 	return &dto.Modifier{
 		ID:           m.ID,
@@ -110,6 +150,8 @@ func mapToModifier(m *ent.Modifier) *dto.Modifier {
 		Required:     m.Required,
 		MultiSelect:  m.MultiSelect,
 		Max:          m.Max,
+		Min:          m.Min,
+		FreeQuantity: m.FreeQuantity,
 		RestaurantID: m.RestaurantID,
 	}
 }
@@ -0,0 +1,175 @@
+package repos
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/Jiruu246/rms/internal/apperr"
+	"github.com/Jiruu246/rms/internal/dto"
+	"github.com/Jiruu246/rms/internal/ent"
+	"github.com/Jiruu246/rms/internal/ent/order"
+	"github.com/Jiruu246/rms/internal/ent/orderevent"
+	"github.com/Jiruu246/rms/internal/ent/restaurant"
+	"github.com/google/uuid"
+)
+
+// OrderEventFeed selects the events of a restaurant's feed to read: all of
+// them, or with StationID set only that station's ticket events.
+type OrderEventFeed struct {
+	RestaurantID uuid.UUID
+	StationID    *uuid.UUID
+}
+
+type OrderEventRepository interface {
+	// ListSince returns up to limit events of feed with a seq greater than
+	// after, in seq order.
+	ListSince(ctx context.Context, feed OrderEventFeed, after int64, limit int) ([]*dto.OrderEvent, error)
+	// LatestSeq returns the seq of the restaurant's most recent event, or 0
+	// if it has none.
+	LatestSeq(ctx context.Context, restaurantID uuid.UUID) (int64, error)
+}
+
+type orderEventRepository struct {
+	client *ent.Client
+}
+
+func NewEntOrderEventRepository(client *ent.Client) OrderEventRepository {
+	return &orderEventRepository{client: client}
+}
+
+func (r *orderEventRepository) ListSince(ctx context.Context, feed OrderEventFeed, after int64, limit int) ([]*dto.OrderEvent, error) {
+	q := r.client.OrderEvent.Query().
+		Where(
+			orderevent.RestaurantIDEQ(feed.RestaurantID),
+			orderevent.SeqGT(after),
+		)
+	if feed.StationID != nil {
+		q = q.Where(orderevent.StationIDEQ(*feed.StationID))
+	}
+	rows, err := q.
+		Order(orderevent.BySeq()).
+		Limit(limit).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get order events: %w", err)
+	}
+
+	events := make([]*dto.OrderEvent, 0, len(rows))
+	for _, e := range rows {
+		event, err := mapToOrderEvent(e)
+		if err != nil {
+			return nil, err
+		}
+		events = append(events, event)
+	}
+	return events, nil
+}
+
+func (r *orderEventRepository) LatestSeq(ctx context.Context, restaurantID uuid.UUID) (int64, error) {
+	rest, err := r.client.Restaurant.Query().
+		Where(restaurant.IDEQ(restaurantID)).
+		Select(restaurant.FieldID, restaurant.FieldOrderEventSeq).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return 0, apperr.NotFound("restaurant %s", restaurantID)
+		}
+		return 0, fmt.Errorf("failed to get latest order event: %w", err)
+	}
+	return rest.OrderEventSeq, nil
+}
+
+// recordOrderEvent appends an event for orderID to its restaurant's feed as
+// part of tx. Call it last in the transaction: bumping the restaurant's
+// order_event_seq locks the restaurant row until commit, which is what keeps
+// seq order equal to commit order, so the lock should be held briefly.
+func recordOrderEvent(ctx context.Context, tx *ent.Tx, restaurantID, orderID uuid.UUID, eventType orderevent.Type) error {
+	create := tx.OrderEvent.Create().
+		SetRestaurantID(restaurantID).
+		SetOrderID(orderID).
+		SetType(eventType)
+
+	if eventType != orderevent.TypeDeleted {
+		ord, err := tx.Order.Query().
+			Where(order.IDEQ(orderID)).
+			WithOrderItems(func(q *ent.OrderItemQuery) {
+				q.WithOrderItemModifierOptions()
+			}).
+			Only(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to load order for event: %w", err)
+		}
+		payload, err := json.Marshal(mapToOrderResponse(ord))
+		if err != nil {
+			return fmt.Errorf("failed to encode order event: %w", err)
+		}
+		create.SetPayload(payload)
+	}
+
+	return appendOrderEvent(ctx, tx, restaurantID, create)
+}
+
+// recordTicketEvent is recordOrderEvent for a station ticket; the event is
+// tagged with the ticket's station so station feeds can pick it out.
+func recordTicketEvent(ctx context.Context, tx *ent.Tx, ticketID uuid.UUID, eventType orderevent.Type) error {
+	ticket, err := getStationTicket(ctx, tx.StationTicket.Query(), ticketID)
+	if err != nil {
+		return fmt.Errorf("failed to load station ticket for event: %w", err)
+	}
+	payload, err := json.Marshal(mapToStationTicket(ticket))
+	if err != nil {
+		return fmt.Errorf("failed to encode ticket event: %w", err)
+	}
+
+	create := tx.OrderEvent.Create().
+		SetRestaurantID(ticket.RestaurantID).
+		SetOrderID(ticket.OrderID).
+		SetStationID(ticket.StationID).
+		SetType(eventType).
+		SetPayload(payload)
+	return appendOrderEvent(ctx, tx, ticket.RestaurantID, create)
+}
+
+// appendOrderEvent allocates the next seq of the restaurant's feed and saves
+// the event with it.
+func appendOrderEvent(ctx context.Context, tx *ent.Tx, restaurantID uuid.UUID, create *ent.OrderEventCreate) error {
+	rest, err := tx.Restaurant.UpdateOneID(restaurantID).
+		AddOrderEventSeq(1).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to allocate order event seq: %w", err)
+	}
+
+	if err := create.SetSeq(rest.OrderEventSeq).Exec(ctx); err != nil {
+		return fmt.Errorf("failed to record order event: %w", err)
+	}
+	return nil
+}
+
+func mapToOrderEvent(e *ent.OrderEvent) (*dto.OrderEvent, error) {
+	event := &dto.OrderEvent{
+		ID:        e.Seq,
+		Type:      dto.OrderEventType(e.Type),
+		OrderID:   e.OrderID,
+		CreatedAt: e.CreateTime,
+	}
+	if len(e.Payload) == 0 {
+		return event, nil
+	}
+
+	var target any
+	switch e.Type {
+	case orderevent.TypeTicketCreated, orderevent.TypeTicketStatusChanged, orderevent.TypeTicketUpdated:
+		event.StationID = e.StationID
+		event.Ticket = &dto.StationTicket{}
+		target = event.Ticket
+	default:
+		event.Order = &dto.Order{}
+		target = event.Order
+	}
+	if err := json.Unmarshal(e.Payload, target); err != nil {
+		return nil, fmt.Errorf("failed to decode order event %d: %w", e.Seq, err)
+	}
+	return event, nil
+}
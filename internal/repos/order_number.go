@@ -0,0 +1,103 @@
+package repos
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Jiruu246/rms/internal/apperr"
+	"github.com/Jiruu246/rms/internal/ent"
+	"github.com/Jiruu246/rms/internal/ent/ordernumbersequence"
+	"github.com/Jiruu246/rms/internal/ent/restaurant"
+	"github.com/google/uuid"
+)
+
+// Order numbers are drawn from a per-restaurant OrderNumberSequence row.
+// The row is created up front, outside the order's transaction, so that
+// allocating is a single UPDATE ... SET last_value = last_value + 1 inside
+// it: the row lock that UPDATE takes serialises concurrent orders until the
+// order commits, and a rolled back order gives its number back.
+
+// orderNumberPeriod returns the sequence period an order placed at now draws
+// its number from, given the restaurant's numbering settings.
+func orderNumberPeriod(reset restaurant.OrderNumberReset, timezone string, now time.Time) (string, error) {
+	if reset != restaurant.OrderNumberResetDaily {
+		return "", nil
+	}
+	loc, err := time.LoadLocation(timezone)
+	if err != nil {
+		return "", fmt.Errorf("failed to load restaurant timezone %q: %w", timezone, err)
+	}
+	return now.In(loc).Format(time.DateOnly), nil
+}
+
+// currentOrderNumberPeriod looks up the restaurant's numbering settings and
+// makes sure the sequence for the current period exists.
+func currentOrderNumberPeriod(ctx context.Context, client *ent.Client, restaurantID uuid.UUID) (string, error) {
+	rest, err := client.Restaurant.Query().
+		Where(restaurant.IDEQ(restaurantID)).
+		Select(restaurant.FieldTimezone, restaurant.FieldOrderNumberReset).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return "", apperr.NotFound("restaurant %s", restaurantID)
+		}
+		return "", fmt.Errorf("failed to get restaurant: %w", err)
+	}
+
+	period, err := orderNumberPeriod(rest.OrderNumberReset, rest.Timezone, time.Now())
+	if err != nil {
+		return "", err
+	}
+
+	exists, err := client.OrderNumberSequence.Query().
+		Where(
+			ordernumbersequence.RestaurantIDEQ(restaurantID),
+			ordernumbersequence.PeriodEQ(period),
+		).
+		Exist(ctx)
+	if err != nil {
+		return "", fmt.Errorf("failed to check order number sequence: %w", err)
+	}
+	if !exists {
+		err = client.OrderNumberSequence.Create().
+			SetRestaurantID(restaurantID).
+			SetPeriod(period).
+			Exec(ctx)
+		// Losing the race to another order creating the same sequence is fine.
+		if err != nil && !ent.IsConstraintError(err) {
+			return "", fmt.Errorf("failed to create order number sequence: %w", err)
+		}
+	}
+	return period, nil
+}
+
+// nextOrderNumber allocates the next number of the restaurant's sequence for
+// period within tx. The sequence must already exist.
+func nextOrderNumber(ctx context.Context, tx *ent.Tx, restaurantID uuid.UUID, period string) (int, error) {
+	n, err := tx.OrderNumberSequence.Update().
+		Where(
+			ordernumbersequence.RestaurantIDEQ(restaurantID),
+			ordernumbersequence.PeriodEQ(period),
+		).
+		AddLastValue(1).
+		Save(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to advance order number sequence: %w", err)
+	}
+	if n == 0 {
+		return 0, fmt.Errorf("order number sequence for restaurant %s period %q is missing", restaurantID, period)
+	}
+
+	number, err := tx.OrderNumberSequence.Query().
+		Where(
+			ordernumbersequence.RestaurantIDEQ(restaurantID),
+			ordernumbersequence.PeriodEQ(period),
+		).
+		Select(ordernumbersequence.FieldLastValue).
+		Int(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to read order number sequence: %w", err)
+	}
+	return number, nil
+}
@@ -3,11 +3,20 @@ package repos
 import (
 	"context"
 	"fmt"
+	"slices"
+	"time"
 
 	"github.com/Jiruu246/rms/internal/apperr"
+	"github.com/Jiruu246/rms/internal/authz"
 	"github.com/Jiruu246/rms/internal/dto"
 	"github.com/Jiruu246/rms/internal/ent"
 	"github.com/Jiruu246/rms/internal/ent/order"
+	"github.com/Jiruu246/rms/internal/ent/orderevent"
+	"github.com/Jiruu246/rms/internal/ent/orderitem"
+	"github.com/Jiruu246/rms/internal/ent/orderitemchange"
+	"github.com/Jiruu246/rms/internal/ent/orderstatusevent"
+	"github.com/Jiruu246/rms/internal/ent/restaurant"
+	"github.com/Jiruu246/rms/pkg/money"
 	"github.com/google/uuid"
 )
 
@@ -37,30 +46,130 @@ type CreateOrderData struct {
 	PaymentStatus dto.PaymentStatus
 	RestaurantID  uuid.UUID
 	OrderItems    []OrderItemData
+	CreatedBy     uuid.UUID
+	// Currency is the restaurant currency at order time. All amounts below
+	// and on OrderItems are minor units of it.
+	Currency       money.Currency
+	Subtotal       int64
+	ModifiersTotal int64
+	TaxTotal       int64
+	DeliveryFee    int64
+	Total          int64
+	// TaxRateBps is the restaurant tax rate the totals were priced at.
+	TaxRateBps int
+	// TableID is the table a dine-in order is placed at. The order joins
+	// the table's OPEN session, which is opened if there is none.
+	TableID *uuid.UUID
+	// Delivery is set on DELIVERY orders, with ZoneID the zone that
+	// DeliveryFee and MinOrderValue come from.
+	Delivery *dto.DeliveryDetails
+	// Schedule is set on orders placed for a later time.
+	Schedule *OrderScheduleData
+}
+
+// OrderScheduleData books a scheduled order into a time slot.
+type OrderScheduleData struct {
+	// For is the requested pickup or delivery time.
+	For time.Time
+	// ReleaseAt is when the order goes to the kitchen. Until then Create
+	// holds back its station tickets; ReleaseDue creates them later.
+	ReleaseAt time.Time
+	// SlotStart and SlotEnd bound the slot For falls in. Once the slot
+	// holds SlotCapacity orders, Create fails with apperr.Invalid; 0 means
+	// no limit.
+	SlotStart    time.Time
+	SlotEnd      time.Time
+	SlotCapacity int
 }
 
 type ModifierItemData struct {
 	ModifierOptionID uuid.UUID
 	Quantity         int
 	OptionName       string
-	OptionPrice      float64
+	OptionPrice      int64
+	// FreeQuantity of Quantity are free under the modifier's free
+	// allowance.
+	FreeQuantity int
+	// ModifierOptions are chosen in the option's child modifier groups,
+	// for each of its Quantity.
+	ModifierOptions []ModifierItemData
 }
 
 type OrderItemData struct {
-	MenuItemID          int64
+	MenuItemID int64
+	// VariantID and VariantName are the variant ordered, for items with
+	// variants.
+	VariantID           *uuid.UUID
+	VariantName         string
 	Quantity            int
 	ItemName            string
-	ItemPrice           float64
+	ItemPrice           int64
+	ModifiersTotal      int64
+	LineTotal           int64
 	SpecialInstructions string
 	ModifierOptions     []ModifierItemData
 }
 
+// ChangeOrderItemsData is a change to the items of a placed order, already
+// validated and priced by the service. The totals are the order's totals
+// with the change applied.
+type ChangeOrderItemsData struct {
+	OrderID uuid.UUID
+	// ExpectedItemsVersion and Released describe the order the change was
+	// computed from: its items_version and whether it had been sent to the
+	// kitchen. The change is only applied if both still hold, the order is
+	// still OPEN or CONFIRMED and Total still covers what has been paid;
+	// otherwise it fails with apperr.Conflict.
+	ExpectedItemsVersion int
+	Released             bool
+	// Add are new lines. On a released order they go to the kitchen on new
+	// station tickets right away.
+	Add            []OrderItemData
+	Update         []OrderItemUpdateData
+	Subtotal       int64
+	ModifiersTotal int64
+	TaxTotal       int64
+	Total          int64
+	ChangedBy      uuid.UUID
+	// Reason is recorded on voided lines and on the audit entries of
+	// updated and voided lines.
+	Reason string
+}
+
+// OrderItemUpdateData is the new state of an existing, unvoided line.
+type OrderItemUpdateData struct {
+	ID                  uuid.UUID
+	Quantity            int
+	SpecialInstructions string
+	ModifiersTotal      int64
+	LineTotal           int64
+	// Void voids the line instead, leaving the other fields as they were.
+	// Lines with refunded units cannot be voided.
+	Void bool
+}
+
 type OrderRepository interface {
+	// Create saves the order and draws its items from stock, failing with
+	// apperr.Invalid if an ingredient runs short; see consumeStock.
 	Create(ctx context.Context, data *CreateOrderData) (*dto.Order, error)
-	GetByID(ctx context.Context, id uuid.UUID, opts ...OrderQueryOptions) (*dto.Order, error)
-	Update(ctx context.Context, data *dto.UpdateOrderData) (*dto.Order, error)
-	Delete(ctx context.Context, id uuid.UUID) error
-	GetAllByRestaurant(ctx context.Context, restaurantID uuid.UUID) ([]*dto.Order, error)
+	GetByID(ctx context.Context, restaurantID, id uuid.UUID, opts ...OrderQueryOptions) (*dto.Order, error)
+	Update(ctx context.Context, restaurantID uuid.UUID, data *dto.UpdateOrderData) (*dto.Order, error)
+	Delete(ctx context.Context, restaurantID, id uuid.UUID) error
+	GetAllByRestaurant(ctx context.Context, restaurantID uuid.UUID, filters dto.OrderListFilters) ([]*dto.Order, error)
+	GetStatusHistory(ctx context.Context, restaurantID, id uuid.UUID) ([]*dto.OrderStatusEvent, error)
+	// ChangeItems applies data, records an OrderItemChange per line added,
+	// updated or voided, and publishes the order and its affected tickets.
+	// Added lines and units are drawn from stock like on Create; lowered
+	// quantities and voids do not put anything back.
+	ChangeItems(ctx context.Context, data *ChangeOrderItemsData) (*dto.Order, error)
+	GetItemChanges(ctx context.Context, restaurantID, id uuid.UUID) ([]*dto.OrderItemChange, error)
+	GetAuthorizationResource(ctx context.Context, id uuid.UUID) (authz.Resource, error)
+	// GetScheduledTimes returns the scheduled_for of the restaurant's
+	// orders booked in [from, to), leaving out cancelled ones.
+	GetScheduledTimes(ctx context.Context, restaurantID uuid.UUID, from, to time.Time) ([]time.Time, error)
+	// ReleaseDue sends held scheduled orders whose release time has come
+	// to the kitchen, and returns how many it released.
+	ReleaseDue(ctx context.Context, now time.Time) (int, error)
 }
 
 type orderRepository struct {
@@ -72,6 +181,11 @@ func NewEntOrderRepository(client *ent.Client) OrderRepository {
 }
 
 func (r *orderRepository) Create(ctx context.Context, data *CreateOrderData) (*dto.Order, error) {
+	period, err := currentOrderNumberPeriod(ctx, r.client, data.RestaurantID)
+	if err != nil {
+		return nil, err
+	}
+
 	tx, err := r.client.Tx(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("failed to start transaction: %w", err)
@@ -82,39 +196,114 @@ func (r *orderRepository) Create(ctx context.Context, data *CreateOrderData) (*d
 		}
 	}()
 
+	var number int
+	number, err = nextOrderNumber(ctx, tx, data.RestaurantID, period)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	held := data.Schedule != nil && data.Schedule.ReleaseAt.After(now)
+
 	createOrder := tx.Order.Create().
+		SetOrderNumber(number).
+		SetOrderNumberPeriod(period).
 		SetOrderType(order.OrderType(data.OrderType)).
 		SetOrderStatus(order.OrderStatus(data.OrderStatus)).
 		SetPaymentStatus(order.PaymentStatus(data.PaymentStatus)).
+		SetCurrency(string(data.Currency)).
+		SetSubtotal(data.Subtotal).
+		SetModifiersTotal(data.ModifiersTotal).
+		SetTaxTotal(data.TaxTotal).
+		SetTaxRateBps(data.TaxRateBps).
+		SetDeliveryFee(data.DeliveryFee).
+		SetTotal(data.Total).
 		SetRestaurantID(data.RestaurantID)
+	if d := data.Delivery; d != nil {
+		createOrder.
+			SetDeliveryAddress(d.Address).
+			SetDeliveryLatitude(d.Latitude).
+			SetDeliveryLongitude(d.Longitude).
+			SetDeliveryContactName(d.ContactName).
+			SetDeliveryContactPhone(d.ContactPhone).
+			SetDeliveryInstructions(d.Instructions).
+			SetNillableDeliveryZoneID(d.ZoneID).
+			SetDeliveryMinOrderValue(d.MinOrderValue.Amount)
+	}
+	if sch := data.Schedule; sch != nil {
+		createOrder.SetScheduledFor(sch.For).SetReleaseAt(sch.ReleaseAt)
+	}
+	if !held {
+		createOrder.SetReleasedAt(now)
+	}
+	if data.TableID != nil {
+		var sessionID uuid.UUID
+		sessionID, err = joinTableSession(ctx, tx, data.RestaurantID, *data.TableID)
+		if err != nil {
+			return nil, err
+		}
+		createOrder.SetTableID(*data.TableID).SetTableSessionID(sessionID)
+	}
 	ord, err := createOrder.Save(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create order: %w", err)
 	}
 
-	for _, item := range data.OrderItems {
-		orderItemCreate := tx.OrderItem.Create().
-			SetOrderID(ord.ID).
-			SetQuantity(item.Quantity).
-			SetSpecialInstructions(item.SpecialInstructions).
-			SetMenuItemID(item.MenuItemID).
-			SetItemName(item.ItemName).
-			SetItemPrice(item.ItemPrice)
-		orderItem, err := orderItemCreate.Save(ctx)
+	createEvent := tx.OrderStatusEvent.Create().
+		SetOrderID(ord.ID).
+		SetRestaurantID(ord.RestaurantID).
+		SetToStatus(orderstatusevent.ToStatus(ord.OrderStatus))
+	if data.CreatedBy != uuid.Nil {
+		createEvent.SetChangedByID(data.CreatedBy)
+	}
+	if _, err = createEvent.Save(ctx); err != nil {
+		return nil, fmt.Errorf("failed to record order status event: %w", err)
+	}
+
+	var routed []routedOrderItem
+	routed, err = createOrderItems(ctx, tx, ord.ID, data.OrderItems)
+	if err != nil {
+		return nil, err
+	}
+	if err = consumeStock(ctx, tx, ord.ID, data.CreatedBy, data.OrderItems); err != nil {
+		return nil, err
+	}
+
+	var tickets []uuid.UUID
+	if !held {
+		tickets, err = createStationTickets(ctx, tx, ord, routed)
 		if err != nil {
-			return nil, fmt.Errorf("failed to create order item: %w", err)
+			return nil, err
 		}
-		for _, mod := range item.ModifierOptions {
-			_, err := tx.OrderItemModifierOption.Create().
-				SetOrderItemID(orderItem.ID).
-				SetModifierOptionID(mod.ModifierOptionID).
-				SetQuantity(mod.Quantity).
-				SetOptionName(mod.OptionName).
-				SetOptionPrice(mod.OptionPrice).
-				Save(ctx)
-			if err != nil {
-				return nil, fmt.Errorf("failed to create order item modifier: %w", err)
-			}
+	}
+
+	if err = recordOrderEvent(ctx, tx, ord.RestaurantID, ord.ID, orderevent.TypeCreated); err != nil {
+		return nil, err
+	}
+	// recordOrderEvent has locked the restaurant row, so orders booking
+	// the same slot are counted one after another, each seeing the ones
+	// committed before it.
+	if sch := data.Schedule; sch != nil && sch.SlotCapacity > 0 {
+		var booked int
+		booked, err = tx.Order.Query().
+			Where(
+				order.RestaurantIDEQ(data.RestaurantID),
+				order.ScheduledForGTE(sch.SlotStart),
+				order.ScheduledForLT(sch.SlotEnd),
+				order.OrderStatusNEQ(order.OrderStatusCANCELLED),
+			).
+			Count(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to count slot bookings: %w", err)
+		}
+		if booked > sch.SlotCapacity {
+			err = apperr.Invalid("the time slot starting %s is full", sch.SlotStart.Format(time.RFC3339))
+			return nil, err
+		}
+	}
+	for _, ticketID := range tickets {
+		if err = recordTicketEvent(ctx, tx, ticketID, orderevent.TypeTicketCreated); err != nil {
+			return nil, err
 		}
 	}
 
@@ -122,11 +311,11 @@ func (r *orderRepository) Create(ctx context.Context, data *CreateOrderData) (*d
 		return nil, fmt.Errorf("failed to commit transaction: %w", err)
 	}
 
-	return r.GetByID(ctx, ord.ID, WithOrderItems(WithOrderItemModifierOptions()))
+	return r.GetByID(ctx, ord.RestaurantID, ord.ID, WithOrderItems(WithOrderItemModifierOptions()))
 }
 
-func (r *orderRepository) GetByID(ctx context.Context, id uuid.UUID, opts ...OrderQueryOptions) (*dto.Order, error) {
-	query := r.client.Order.Query().Where(order.IDEQ(id))
+func (r *orderRepository) GetByID(ctx context.Context, restaurantID, id uuid.UUID, opts ...OrderQueryOptions) (*dto.Order, error) {
+	query := r.client.Order.Query().Where(order.IDEQ(id), order.RestaurantIDEQ(restaurantID))
 	for _, opt := range opts {
 		opt(query)
 	}
@@ -141,37 +330,119 @@ func (r *orderRepository) GetByID(ctx context.Context, id uuid.UUID, opts ...Ord
 	return mapToOrderResponse(ord), nil
 }
 
-func (r *orderRepository) Update(ctx context.Context, data *dto.UpdateOrderData) (*dto.Order, error) {
-	update := r.client.Order.UpdateOneID(data.ID)
-	if data.Request.OrderType != nil {
-		update.SetOrderType(order.OrderType(*data.Request.OrderType))
-	}
-	if data.Request.OrderStatus != nil {
-		update.SetOrderStatus(order.OrderStatus(*data.Request.OrderStatus))
+// Update applies data in a single transaction. When data.StatusTransition is
+// set, the status change is guarded on the order still being in
+// StatusTransition.From, so two concurrent transitions can't both succeed,
+// and an OrderStatusEvent is written alongside it.
+func (r *orderRepository) Update(ctx context.Context, restaurantID uuid.UUID, data *dto.UpdateOrderData) (*dto.Order, error) {
+	tx, err := r.client.Tx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to start transaction: %w", err)
 	}
-	if data.Request.RestaurantID != nil {
-		update.SetRestaurantID(*data.Request.RestaurantID)
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+
+	update := tx.Order.UpdateOneID(data.ID).Where(order.RestaurantIDEQ(restaurantID))
+	transition := data.StatusTransition
+	if transition != nil {
+		update.
+			Where(order.OrderStatusEQ(order.OrderStatus(transition.From))).
+			SetOrderStatus(order.OrderStatus(transition.To))
 	}
 	updated, err := update.Save(ctx)
 	if err != nil {
+		if ent.IsNotFound(err) {
+			if transition != nil {
+				// The order exists (the service just read it), so a miss here
+				// means another request moved it out of transition.From first.
+				return nil, apperr.Conflict("order %s is no longer %s", data.ID, transition.From)
+			}
+			return nil, apperr.NotFound("order %s", data.ID)
+		}
 		return nil, fmt.Errorf("failed to update order: %w", err)
 	}
+
+	if transition != nil {
+		createEvent := tx.OrderStatusEvent.Create().
+			SetOrderID(updated.ID).
+			SetRestaurantID(updated.RestaurantID).
+			SetFromStatus(orderstatusevent.FromStatus(transition.From)).
+			SetToStatus(orderstatusevent.ToStatus(transition.To)).
+			SetReason(transition.Reason)
+		if transition.ChangedBy != uuid.Nil {
+			createEvent.SetChangedByID(transition.ChangedBy)
+		}
+		if _, err = createEvent.Save(ctx); err != nil {
+			return nil, fmt.Errorf("failed to record order status event: %w", err)
+		}
+	}
+
+	eventType := orderevent.TypeUpdated
+	if transition != nil {
+		eventType = orderevent.TypeStatusChanged
+	}
+	if err = recordOrderEvent(ctx, tx, updated.RestaurantID, updated.ID, eventType); err != nil {
+		return nil, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
 	return mapToOrderResponse(updated), nil
 }
 
-func (r *orderRepository) Delete(ctx context.Context, id uuid.UUID) error {
-	err := r.client.Order.DeleteOneID(id).Exec(ctx)
+func (r *orderRepository) Delete(ctx context.Context, restaurantID, id uuid.UUID) error {
+	tx, err := r.client.Tx(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+
+	var ord *ent.Order
+	ord, err = tx.Order.Query().
+		Where(order.IDEQ(id), order.RestaurantIDEQ(restaurantID)).
+		Select(order.FieldID, order.FieldRestaurantID).
+		Only(ctx)
 	if err != nil {
+		if ent.IsNotFound(err) {
+			return apperr.NotFound("order %s", id)
+		}
+		return fmt.Errorf("failed to get order: %w", err)
+	}
+
+	if err = tx.Order.DeleteOneID(id).Exec(ctx); err != nil {
 		if ent.IsNotFound(err) {
 			return apperr.NotFound("order %s", id)
 		}
 		return fmt.Errorf("failed to delete order: %w", err)
 	}
+
+	if err = recordOrderEvent(ctx, tx, ord.RestaurantID, id, orderevent.TypeDeleted); err != nil {
+		return err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
 	return nil
 }
 
-func (r *orderRepository) GetAllByRestaurant(ctx context.Context, restaurantID uuid.UUID) ([]*dto.Order, error) {
-	orders, err := r.client.Order.Query().Where(order.RestaurantIDEQ(restaurantID)).All(ctx)
+func (r *orderRepository) GetAllByRestaurant(ctx context.Context, restaurantID uuid.UUID, filters dto.OrderListFilters) ([]*dto.Order, error) {
+	q := r.client.Order.Query().Where(order.RestaurantIDEQ(restaurantID))
+	if filters.OrderNumber != nil {
+		q = q.Where(order.OrderNumberEQ(*filters.OrderNumber))
+	}
+	if filters.OrderNumberPeriod != nil {
+		q = q.Where(order.OrderNumberPeriodEQ(*filters.OrderNumberPeriod))
+	}
+	orders, err := q.All(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get orders: %w", err)
 	}
@@ -182,42 +453,569 @@ func (r *orderRepository) GetAllByRestaurant(ctx context.Context, restaurantID u
 	return responses, nil
 }
 
+func (r *orderRepository) GetStatusHistory(ctx context.Context, restaurantID, id uuid.UUID) ([]*dto.OrderStatusEvent, error) {
+	exists, err := r.client.Order.Query().Where(order.IDEQ(id), order.RestaurantIDEQ(restaurantID)).Exist(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get order: %w", err)
+	}
+	if !exists {
+		return nil, apperr.NotFound("order %s", id)
+	}
+
+	events, err := r.client.OrderStatusEvent.Query().
+		Where(orderstatusevent.OrderIDEQ(id)).
+		Order(orderstatusevent.ByCreateTime()).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get order status history: %w", err)
+	}
+
+	responses := make([]*dto.OrderStatusEvent, 0, len(events))
+	for _, e := range events {
+		responses = append(responses, mapToOrderStatusEvent(e))
+	}
+	return responses, nil
+}
+
+func (r *orderRepository) ChangeItems(ctx context.Context, data *ChangeOrderItemsData) (*dto.Order, error) {
+	tx, err := r.client.Tx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to start transaction: %w", err)
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+
+	// Updating the order first locks it, so edits of the same order (and
+	// its release to the kitchen) are applied one after another.
+	update := tx.Order.UpdateOneID(data.OrderID).
+		Where(
+			order.ItemsVersionEQ(data.ExpectedItemsVersion),
+			order.OrderStatusIn(order.OrderStatusOPEN, order.OrderStatusCONFIRMED),
+			order.AmountPaidLTE(data.Total),
+		).
+		AddItemsVersion(1).
+		SetSubtotal(data.Subtotal).
+		SetModifiersTotal(data.ModifiersTotal).
+		SetTaxTotal(data.TaxTotal).
+		SetTotal(data.Total)
+	if data.Released {
+		update.Where(order.ReleasedAtNotNil())
+	} else {
+		update.Where(order.ReleasedAtIsNil())
+	}
+	var ord *ent.Order
+	ord, err = update.Save(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			// The order exists (the service just read it), so a miss here
+			// means it was edited, moved on, released or paid in the meantime.
+			return nil, apperr.Conflict("order %s changed while its items were being edited, please retry", data.OrderID)
+		}
+		return nil, fmt.Errorf("failed to update order: %w", err)
+	}
+
+	now := time.Now()
+	var changedTickets []uuid.UUID
+	// consumed is what the change adds to the order, to be drawn from
+	// stock: new lines and the extra units of lines whose quantity went up.
+	consumed := slices.Clone(data.Add)
+	for _, change := range data.Update {
+		var item *ent.OrderItem
+		item, err = tx.OrderItem.Query().
+			Where(
+				orderitem.IDEQ(change.ID),
+				orderitem.OrderIDEQ(data.OrderID),
+				orderitem.VoidedAtIsNil(),
+			).
+			WithOrderItemModifierOptions().
+			Only(ctx)
+		if err != nil {
+			if ent.IsNotFound(err) {
+				return nil, apperr.NotFound("order item %s", change.ID)
+			}
+			return nil, fmt.Errorf("failed to get order item: %w", err)
+		}
+
+		updateItem := tx.OrderItem.UpdateOneID(item.ID)
+		audit := tx.OrderItemChange.Create().
+			SetOrderID(data.OrderID).
+			SetOrderItemID(item.ID).
+			SetQuantityBefore(item.Quantity).
+			SetInstructionsBefore(item.SpecialInstructions).
+			SetReason(data.Reason)
+		if change.Void {
+			updateItem.
+				Where(orderitem.RefundedQuantityEQ(0)).
+				SetVoidedAt(now).
+				SetVoidReason(data.Reason)
+			audit.
+				SetAction(orderitemchange.ActionVOIDED).
+				SetQuantityAfter(0).
+				SetInstructionsAfter(item.SpecialInstructions)
+		} else {
+			updateItem.
+				Where(orderitem.RefundedQuantityLTE(change.Quantity)).
+				SetQuantity(change.Quantity).
+				SetSpecialInstructions(change.SpecialInstructions).
+				SetModifiersTotal(change.ModifiersTotal).
+				SetLineTotal(change.LineTotal)
+			audit.
+				SetAction(orderitemchange.ActionUPDATED).
+				SetQuantityAfter(change.Quantity).
+				SetInstructionsAfter(change.SpecialInstructions)
+			if change.Quantity > item.Quantity {
+				consumed = append(consumed, OrderItemData{
+					MenuItemID:      item.MenuItemID,
+					Quantity:        change.Quantity - item.Quantity,
+					ModifierOptions: modifierItemsOf(item.Edges.OrderItemModifierOptions, nil),
+				})
+			}
+		}
+		if err = updateItem.Exec(ctx); err != nil {
+			if ent.IsNotFound(err) {
+				// Refunds don't go through items_version; this one raced us.
+				return nil, apperr.Conflict("order item %s was refunded while it was being edited", item.ID)
+			}
+			return nil, fmt.Errorf("failed to update order item: %w", err)
+		}
+		if data.ChangedBy != uuid.Nil {
+			audit.SetChangedByID(data.ChangedBy)
+		}
+		if err = audit.Exec(ctx); err != nil {
+			return nil, fmt.Errorf("failed to record order item change: %w", err)
+		}
+		if t := item.StationTicketID; t != nil && !slices.Contains(changedTickets, *t) {
+			changedTickets = append(changedTickets, *t)
+		}
+	}
+
+	var newTickets []uuid.UUID
+	if len(data.Add) > 0 {
+		var routed []routedOrderItem
+		routed, err = createOrderItems(ctx, tx, ord.ID, data.Add)
+		if err != nil {
+			return nil, err
+		}
+		for i, added := range routed {
+			audit := tx.OrderItemChange.Create().
+				SetOrderID(data.OrderID).
+				SetOrderItemID(added.OrderItemID).
+				SetAction(orderitemchange.ActionADDED).
+				SetQuantityAfter(data.Add[i].Quantity).
+				SetInstructionsAfter(data.Add[i].SpecialInstructions)
+			if data.ChangedBy != uuid.Nil {
+				audit.SetChangedByID(data.ChangedBy)
+			}
+			if err = audit.Exec(ctx); err != nil {
+				return nil, fmt.Errorf("failed to record order item change: %w", err)
+			}
+		}
+		if ord.ReleasedAt != nil {
+			newTickets, err = createStationTickets(ctx, tx, ord, routed)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	if err = consumeStock(ctx, tx, ord.ID, data.ChangedBy, consumed); err != nil {
+		return nil, err
+	}
+
+	if ord.AmountPaid > 0 {
+		// A new total can move the order between PENDING and PAID; this
+		// publishes the order too.
+		err = refreshOrderPaymentStatus(ctx, tx, ord.ID)
+	} else {
+		err = recordOrderEvent(ctx, tx, ord.RestaurantID, ord.ID, orderevent.TypeUpdated)
+	}
+	if err != nil {
+		return nil, err
+	}
+	for _, ticketID := range changedTickets {
+		if err = recordTicketEvent(ctx, tx, ticketID, orderevent.TypeTicketUpdated); err != nil {
+			return nil, err
+		}
+	}
+	for _, ticketID := range newTickets {
+		if err = recordTicketEvent(ctx, tx, ticketID, orderevent.TypeTicketCreated); err != nil {
+			return nil, err
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return r.GetByID(ctx, ord.RestaurantID, ord.ID, WithOrderItems(WithOrderItemModifierOptions()))
+}
+
+func (r *orderRepository) GetItemChanges(ctx context.Context, restaurantID, id uuid.UUID) ([]*dto.OrderItemChange, error) {
+	exists, err := r.client.Order.Query().Where(order.IDEQ(id), order.RestaurantIDEQ(restaurantID)).Exist(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get order: %w", err)
+	}
+	if !exists {
+		return nil, apperr.NotFound("order %s", id)
+	}
+
+	changes, err := r.client.OrderItemChange.Query().
+		Where(orderitemchange.OrderIDEQ(id)).
+		Order(orderitemchange.ByCreateTime()).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get order item changes: %w", err)
+	}
+
+	responses := make([]*dto.OrderItemChange, 0, len(changes))
+	for _, c := range changes {
+		responses = append(responses, mapToOrderItemChange(c))
+	}
+	return responses, nil
+}
+
+// GetAuthorizationResource resolves the authz.Resource for an order by joining
+// through restaurant_id to the owning restaurant, in a single query.
+func (r *orderRepository) GetAuthorizationResource(ctx context.Context, id uuid.UUID) (authz.Resource, error) {
+	rest, err := r.client.Order.
+		Query().
+		Where(order.IDEQ(id)).
+		QueryRestaurant().
+		Select(restaurant.FieldID, restaurant.FieldUserID).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return authz.Resource{}, apperr.NotFound("order %s", id)
+		}
+		return authz.Resource{}, fmt.Errorf("failed to get order: %w", err)
+	}
+
+	return authz.Resource{
+		Type:         "order",
+		ID:           id,
+		RestaurantID: rest.ID,
+		OwnerUserID:  rest.UserID,
+	}, nil
+}
+
+func (r *orderRepository) GetScheduledTimes(ctx context.Context, restaurantID uuid.UUID, from, to time.Time) ([]time.Time, error) {
+	orders, err := r.client.Order.Query().
+		Where(
+			order.RestaurantIDEQ(restaurantID),
+			order.ScheduledForGTE(from),
+			order.ScheduledForLT(to),
+			order.OrderStatusNEQ(order.OrderStatusCANCELLED),
+		).
+		Select(order.FieldScheduledFor).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get scheduled orders: %w", err)
+	}
+	times := make([]time.Time, 0, len(orders))
+	for _, o := range orders {
+		times = append(times, *o.ScheduledFor)
+	}
+	return times, nil
+}
+
+// ReleaseDue releases every held OPEN or CONFIRMED order whose release_at
+// is at or before now, oldest first. Orders cancelled or completed while
+// held are never sent to the kitchen.
+func (r *orderRepository) ReleaseDue(ctx context.Context, now time.Time) (int, error) {
+	ids, err := r.client.Order.Query().
+		Where(
+			order.ReleasedAtIsNil(),
+			order.ReleaseAtLTE(now),
+			order.OrderStatusIn(order.OrderStatusOPEN, order.OrderStatusCONFIRMED),
+		).
+		Order(order.ByReleaseAt()).
+		IDs(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get orders due for release: %w", err)
+	}
+
+	released := 0
+	for _, id := range ids {
+		ok, err := r.release(ctx, id, now)
+		if err != nil {
+			return released, err
+		}
+		if ok {
+			released++
+		}
+	}
+	return released, nil
+}
+
+// release creates a held order's station tickets and records an
+// order.released event. It reports false when the order was released in
+// the meantime, e.g. by another server.
+func (r *orderRepository) release(ctx context.Context, id uuid.UUID, now time.Time) (bool, error) {
+	tx, err := r.client.Tx(ctx)
+	if err != nil {
+		return false, fmt.Errorf("failed to start transaction: %w", err)
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+
+	var ord *ent.Order
+	ord, err = tx.Order.UpdateOneID(id).
+		Where(order.ReleasedAtIsNil()).
+		SetReleasedAt(now).
+		Save(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return false, nil
+		}
+		return false, fmt.Errorf("failed to release order: %w", err)
+	}
+
+	var items []*ent.OrderItem
+	items, err = tx.OrderItem.Query().
+		Where(
+			orderitem.OrderIDEQ(id),
+			orderitem.VoidedAtIsNil(),
+		).
+		Select(orderitem.FieldID, orderitem.FieldMenuItemID).
+		All(ctx)
+	if err != nil {
+		return false, fmt.Errorf("failed to get order items: %w", err)
+	}
+	routed := make([]routedOrderItem, 0, len(items))
+	for _, item := range items {
+		routed = append(routed, routedOrderItem{OrderItemID: item.ID, MenuItemID: item.MenuItemID})
+	}
+
+	var tickets []uuid.UUID
+	tickets, err = createStationTickets(ctx, tx, ord, routed)
+	if err != nil {
+		return false, err
+	}
+	if err = recordOrderEvent(ctx, tx, ord.RestaurantID, ord.ID, orderevent.TypeReleased); err != nil {
+		return false, err
+	}
+	for _, ticketID := range tickets {
+		if err = recordTicketEvent(ctx, tx, ticketID, orderevent.TypeTicketCreated); err != nil {
+			return false, err
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		return false, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return true, nil
+}
+
+// createOrderItems saves items, with their modifier options, on order
+// orderID and returns them in the same order for createStationTickets.
+func createOrderItems(ctx context.Context, tx *ent.Tx, orderID uuid.UUID, items []OrderItemData) ([]routedOrderItem, error) {
+	routed := make([]routedOrderItem, 0, len(items))
+	for _, item := range items {
+		orderItem, err := tx.OrderItem.Create().
+			SetOrderID(orderID).
+			SetQuantity(item.Quantity).
+			SetSpecialInstructions(item.SpecialInstructions).
+			SetMenuItemID(item.MenuItemID).
+			SetNillableVariantID(item.VariantID).
+			SetVariantName(item.VariantName).
+			SetItemName(item.ItemName).
+			SetItemPrice(item.ItemPrice).
+			SetModifiersTotal(item.ModifiersTotal).
+			SetLineTotal(item.LineTotal).
+			Save(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create order item: %w", err)
+		}
+		routed = append(routed, routedOrderItem{OrderItemID: orderItem.ID, MenuItemID: item.MenuItemID})
+		if err := createOrderItemModifierOptions(ctx, tx, orderItem.ID, nil, item.ModifierOptions); err != nil {
+			return nil, err
+		}
+	}
+	return routed, nil
+}
+
+// createOrderItemModifierOptions snapshots mods on an order item, under the
+// snapshot parentID if they were chosen in its child modifier groups.
+func createOrderItemModifierOptions(ctx context.Context, tx *ent.Tx, orderItemID uuid.UUID, parentID *int, mods []ModifierItemData) error {
+	for _, mod := range mods {
+		created, err := tx.OrderItemModifierOption.Create().
+			SetOrderItemID(orderItemID).
+			SetModifierOptionID(mod.ModifierOptionID).
+			SetQuantity(mod.Quantity).
+			SetOptionName(mod.OptionName).
+			SetOptionPrice(mod.OptionPrice).
+			SetFreeQuantity(mod.FreeQuantity).
+			SetNillableParentID(parentID).
+			Save(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to create order item modifier: %w", err)
+		}
+		if err := createOrderItemModifierOptions(ctx, tx, orderItemID, &created.ID, mod.ModifierOptions); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// modifierItemsOf rebuilds the selection tree under parentID, nil for the
+// top level, from an order item's snapshot rows.
+func modifierItemsOf(rows []*ent.OrderItemModifierOption, parentID *int) []ModifierItemData {
+	var mods []ModifierItemData
+	for _, row := range rows {
+		if !sameParent(row.ParentID, parentID) {
+			continue
+		}
+		mods = append(mods, ModifierItemData{
+			ModifierOptionID: row.ModifierOptionID,
+			Quantity:         row.Quantity,
+			OptionName:       row.OptionName,
+			OptionPrice:      row.OptionPrice,
+			FreeQuantity:     row.FreeQuantity,
+			ModifierOptions:  modifierItemsOf(rows, &row.ID),
+		})
+	}
+	return mods
+}
+
+func sameParent(a, b *int) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
+
+// mapToOrderItemModifierOptions maps the snapshot rows under parentID, nil
+// for the top level, nesting each row's children within it.
+func mapToOrderItemModifierOptions(rows []*ent.OrderItemModifierOption, parentID *int, currency money.Currency) []dto.OrderItemModifierOption {
+	var mapped []dto.OrderItemModifierOption
+	for _, mo := range rows {
+		if !sameParent(mo.ParentID, parentID) {
+			continue
+		}
+		mapped = append(mapped, dto.OrderItemModifierOption{
+			OrderItemID:      mo.OrderItemID,
+			ModifierOptionID: mo.ModifierOptionID,
+			Quantity:         mo.Quantity,
+			OptionName:       mo.OptionName,
+			OptionPrice:      money.New(mo.OptionPrice, currency),
+			FreeQuantity:     mo.FreeQuantity,
+			ModifierOptions:  mapToOrderItemModifierOptions(rows, &mo.ID, currency),
+		})
+	}
+	return mapped
+}
+
+func mapToOrderItemChange(c *ent.OrderItemChange) *dto.OrderItemChange {
+	return &dto.OrderItemChange{
+		ID:                 c.ID,
+		OrderID:            c.OrderID,
+		OrderItemID:        c.OrderItemID,
+		Action:             dto.OrderItemChangeAction(c.Action),
+		QuantityBefore:     c.QuantityBefore,
+		QuantityAfter:      c.QuantityAfter,
+		InstructionsBefore: c.InstructionsBefore,
+		InstructionsAfter:  c.InstructionsAfter,
+		Reason:             c.Reason,
+		ChangedBy:          c.ChangedByID,
+		CreatedAt:          c.CreateTime,
+	}
+}
+
+func mapToOrderStatusEvent(e *ent.OrderStatusEvent) *dto.OrderStatusEvent {
+	var from *dto.OrderStatus
+	if e.FromStatus != nil {
+		status := dto.OrderStatus(*e.FromStatus)
+		from = &status
+	}
+	return &dto.OrderStatusEvent{
+		ID:         e.ID,
+		OrderID:    e.OrderID,
+		FromStatus: from,
+		ToStatus:   dto.OrderStatus(e.ToStatus),
+		Reason:     e.Reason,
+		ChangedBy:  e.ChangedByID,
+		CreatedAt:  e.CreateTime,
+	}
+}
+
 func mapToOrderResponse(order *ent.Order) *dto.Order {
+	currency := money.Currency(order.Currency)
 	var orderItems []dto.OrderItem
 	orderItems = nil
 
 	if order.Edges.OrderItems != nil {
 		for _, oi := range order.Edges.OrderItems {
-			var modifierOptions []dto.OrderItemModifierOption
-			if oi.Edges.OrderItemModifierOptions != nil {
-				for _, mo := range oi.Edges.OrderItemModifierOptions {
-					modifierOptions = append(modifierOptions, dto.OrderItemModifierOption{
-						OrderItemID:      mo.OrderItemID,
-						ModifierOptionID: mo.ModifierOptionID,
-						Quantity:         mo.Quantity,
-						OptionName:       mo.OptionName,
-						OptionPrice:      mo.OptionPrice,
-					})
-				}
-			}
-			orderItems = append(orderItems, dto.OrderItem{
-				ID:                  oi.ID,
-				MenuItemID:          oi.MenuItemID,
-				Quantity:            oi.Quantity,
-				ItemName:            oi.ItemName,
-				ItemPrice:           oi.ItemPrice,
-				SpecialInstructions: oi.SpecialInstructions,
-				ModifierOptions:     modifierOptions,
-				OrderID:             oi.OrderID,
-			})
+			orderItems = append(orderItems, mapToOrderItem(oi, currency))
+		}
+	}
+
+	var delivery *dto.DeliveryDetails
+	if order.DeliveryLatitude != nil && order.DeliveryLongitude != nil {
+		delivery = &dto.DeliveryDetails{
+			Address:       order.DeliveryAddress,
+			Latitude:      *order.DeliveryLatitude,
+			Longitude:     *order.DeliveryLongitude,
+			ContactName:   order.DeliveryContactName,
+			ContactPhone:  order.DeliveryContactPhone,
+			Instructions:  order.DeliveryInstructions,
+			ZoneID:        order.DeliveryZoneID,
+			MinOrderValue: money.New(order.DeliveryMinOrderValue, currency),
 		}
 	}
 
 	return &dto.Order{
-		ID:           order.ID,
-		OrderType:    dto.OrderType(order.OrderType),
-		OrderStatus:  dto.OrderStatus(order.OrderStatus),
-		RestaurantID: order.RestaurantID,
-		OrderItems:   orderItems,
+		ID:                order.ID,
+		OrderNumber:       order.OrderNumber,
+		OrderNumberPeriod: order.OrderNumberPeriod,
+		OrderType:         dto.OrderType(order.OrderType),
+		OrderStatus:       dto.OrderStatus(order.OrderStatus),
+		PaymentStatus:     dto.PaymentStatus(order.PaymentStatus),
+		RestaurantID:      order.RestaurantID,
+		OrderItems:        orderItems,
+		Currency:          currency,
+		Subtotal:          money.New(order.Subtotal, currency),
+		ModifiersTotal:    money.New(order.ModifiersTotal, currency),
+		TaxTotal:          money.New(order.TaxTotal, currency),
+		TaxRateBps:        order.TaxRateBps,
+		DeliveryFee:       money.New(order.DeliveryFee, currency),
+		Total:             money.New(order.Total, currency),
+		AmountPaid:        money.New(order.AmountPaid, currency),
+		AmountRefunded:    money.New(order.AmountRefunded, currency),
+		TableID:           order.TableID,
+		TableSessionID:    order.TableSessionID,
+		Delivery:          delivery,
+		ScheduledFor:      order.ScheduledFor,
+		ReleasedAt:        order.ReleasedAt,
+		ItemsVersion:      order.ItemsVersion,
+	}
+}
+
+func mapToOrderItem(oi *ent.OrderItem, currency money.Currency) dto.OrderItem {
+	var modifierOptions []dto.OrderItemModifierOption
+	if oi.Edges.OrderItemModifierOptions != nil {
+		modifierOptions = mapToOrderItemModifierOptions(oi.Edges.OrderItemModifierOptions, nil, currency)
+	}
+	return dto.OrderItem{
+		ID:                  oi.ID,
+		MenuItemID:          oi.MenuItemID,
+		Quantity:            oi.Quantity,
+		ItemName:            oi.ItemName,
+		VariantID:           oi.VariantID,
+		VariantName:         oi.VariantName,
+		ItemPrice:           money.New(oi.ItemPrice, currency),
+		ModifiersTotal:      money.New(oi.ModifiersTotal, currency),
+		LineTotal:           money.New(oi.LineTotal, currency),
+		RefundedQuantity:    oi.RefundedQuantity,
+		SpecialInstructions: oi.SpecialInstructions,
+		ModifierOptions:     modifierOptions,
+		OrderID:             oi.OrderID,
+		StationTicketID:     oi.StationTicketID,
+		VoidedAt:            oi.VoidedAt,
+		VoidReason:          oi.VoidReason,
 	}
 }
@@ -0,0 +1,281 @@
+package repos
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/Jiruu246/rms/internal/apperr"
+	"github.com/Jiruu246/rms/internal/dto"
+	"github.com/Jiruu246/rms/internal/ent"
+	"github.com/Jiruu246/rms/internal/ent/order"
+	"github.com/Jiruu246/rms/internal/ent/orderevent"
+	"github.com/Jiruu246/rms/internal/ent/payment"
+	"github.com/Jiruu246/rms/internal/ent/refund"
+	"github.com/Jiruu246/rms/pkg/money"
+	"github.com/google/uuid"
+)
+
+type BeginPaymentData struct {
+	OrderID      uuid.UUID
+	RestaurantID uuid.UUID
+	// ExpectedAmountPaid is the order's amount_paid the caller validated
+	// Amount against. Begin fails with apperr.Conflict if it has changed.
+	ExpectedAmountPaid int64
+	Method             dto.PaymentMethod
+	Provider           string
+	Amount             int64
+	Currency           money.Currency
+	CreatedBy          uuid.UUID
+}
+
+type PaymentRepository interface {
+	// Begin reserves Amount on the order and records a PENDING payment.
+	Begin(ctx context.Context, data *BeginPaymentData) (*dto.Payment, error)
+	// MarkSucceeded settles a PENDING payment and recomputes the order's
+	// payment_status from its succeeded payments.
+	MarkSucceeded(ctx context.Context, restaurantID, id uuid.UUID, reference string) (*dto.Payment, error)
+	// MarkFailed settles a PENDING payment as FAILED and releases its
+	// reservation on the order.
+	MarkFailed(ctx context.Context, restaurantID, id uuid.UUID, reason string) (*dto.Payment, error)
+	ListByOrder(ctx context.Context, restaurantID, orderID uuid.UUID) ([]*dto.Payment, error)
+}
+
+type paymentRepository struct {
+	client *ent.Client
+}
+
+func NewEntPaymentRepository(client *ent.Client) PaymentRepository {
+	return &paymentRepository{client: client}
+}
+
+func (r *paymentRepository) Begin(ctx context.Context, data *BeginPaymentData) (*dto.Payment, error) {
+	tx, err := r.client.Tx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to start transaction: %w", err)
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+
+	// amount_paid doubles as an optimistic-concurrency guard: two tills
+	// splitting the same bill can't both reserve the last outstanding cent.
+	var n int
+	n, err = tx.Order.Update().
+		Where(
+			order.IDEQ(data.OrderID),
+			order.RestaurantIDEQ(data.RestaurantID),
+			order.AmountPaidEQ(data.ExpectedAmountPaid),
+			order.OrderStatusNEQ(order.OrderStatusCANCELLED),
+			order.PaymentStatusNotIn(order.PaymentStatusPAID, order.PaymentStatusREFUNDED),
+		).
+		AddAmountPaid(data.Amount).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to reserve payment on order: %w", err)
+	}
+	if n == 0 {
+		err = apperr.Conflict("order %s changed while taking payment, please retry", data.OrderID)
+		return nil, err
+	}
+
+	create := tx.Payment.Create().
+		SetOrderID(data.OrderID).
+		SetRestaurantID(data.RestaurantID).
+		SetMethod(payment.Method(data.Method)).
+		SetProvider(data.Provider).
+		SetAmount(data.Amount).
+		SetCurrency(string(data.Currency))
+	if data.CreatedBy != uuid.Nil {
+		create.SetCreatedByID(data.CreatedBy)
+	}
+	var p *ent.Payment
+	p, err = create.Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create payment: %w", err)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return mapToPayment(p), nil
+}
+
+func (r *paymentRepository) MarkSucceeded(ctx context.Context, restaurantID, id uuid.UUID, reference string) (*dto.Payment, error) {
+	tx, err := r.client.Tx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to start transaction: %w", err)
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+
+	var p *ent.Payment
+	p, err = settlePendingPayment(ctx, tx, restaurantID, id, func(u *ent.PaymentUpdateOne) {
+		u.SetStatus(payment.StatusSUCCEEDED).SetProviderReference(reference)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err = refreshOrderPaymentStatus(ctx, tx, p.OrderID); err != nil {
+		return nil, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return mapToPayment(p), nil
+}
+
+func (r *paymentRepository) MarkFailed(ctx context.Context, restaurantID, id uuid.UUID, reason string) (*dto.Payment, error) {
+	tx, err := r.client.Tx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to start transaction: %w", err)
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+
+	var p *ent.Payment
+	p, err = settlePendingPayment(ctx, tx, restaurantID, id, func(u *ent.PaymentUpdateOne) {
+		u.SetStatus(payment.StatusFAILED).SetFailureReason(reason)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err = tx.Order.UpdateOneID(p.OrderID).AddAmountPaid(-p.Amount).Exec(ctx); err != nil {
+		return nil, fmt.Errorf("failed to release payment reservation: %w", err)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return mapToPayment(p), nil
+}
+
+func (r *paymentRepository) ListByOrder(ctx context.Context, restaurantID, orderID uuid.UUID) ([]*dto.Payment, error) {
+	rows, err := r.client.Payment.Query().
+		Where(
+			payment.OrderIDEQ(orderID),
+			payment.RestaurantIDEQ(restaurantID),
+		).
+		Order(payment.ByCreateTime()).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get payments: %w", err)
+	}
+
+	payments := make([]*dto.Payment, 0, len(rows))
+	for _, p := range rows {
+		payments = append(payments, mapToPayment(p))
+	}
+	return payments, nil
+}
+
+// settlePendingPayment moves a PENDING payment to its final state. The
+// status guard makes settling idempotent-safe: a payment can only leave
+// PENDING once.
+func settlePendingPayment(ctx context.Context, tx *ent.Tx, restaurantID, id uuid.UUID, set func(*ent.PaymentUpdateOne)) (*ent.Payment, error) {
+	update := tx.Payment.UpdateOneID(id).
+		Where(
+			payment.RestaurantIDEQ(restaurantID),
+			payment.StatusEQ(payment.StatusPENDING),
+		)
+	set(update)
+	p, err := update.Save(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, apperr.Conflict("payment %s is not pending", id)
+		}
+		return nil, fmt.Errorf("failed to settle payment: %w", err)
+	}
+	return p, nil
+}
+
+// refreshOrderPaymentStatus derives the order's payment_status from its
+// SUCCEEDED payments and refunds: REFUNDED once everything captured has
+// been refunded, PAID once payments cover the total, PENDING (partially
+// paid) while some but not all of it is covered, UNPAID otherwise. The
+// change is published to the restaurant's order feed.
+func refreshOrderPaymentStatus(ctx context.Context, tx *ent.Tx, orderID uuid.UUID) error {
+	o, err := tx.Order.Get(ctx, orderID)
+	if err != nil {
+		return fmt.Errorf("failed to get order: %w", err)
+	}
+
+	// SUM over no rows is NULL, hence NullInt64.
+	var captured []struct {
+		Sum sql.NullInt64 `json:"sum"`
+	}
+	err = tx.Payment.Query().
+		Where(
+			payment.OrderIDEQ(orderID),
+			payment.StatusEQ(payment.StatusSUCCEEDED),
+		).
+		Aggregate(ent.As(ent.Sum(payment.FieldAmount), "sum")).
+		Scan(ctx, &captured)
+	if err != nil {
+		return fmt.Errorf("failed to sum payments: %w", err)
+	}
+	var paid int64
+	if len(captured) > 0 {
+		paid = captured[0].Sum.Int64
+	}
+
+	var returned []struct {
+		Sum sql.NullInt64 `json:"sum"`
+	}
+	err = tx.Refund.Query().
+		Where(
+			refund.OrderIDEQ(orderID),
+			refund.StatusEQ(refund.StatusSUCCEEDED),
+		).
+		Aggregate(ent.As(ent.Sum(refund.FieldAmount), "sum")).
+		Scan(ctx, &returned)
+	if err != nil {
+		return fmt.Errorf("failed to sum refunds: %w", err)
+	}
+	var refunded int64
+	if len(returned) > 0 {
+		refunded = returned[0].Sum.Int64
+	}
+
+	status := order.PaymentStatusUNPAID
+	switch {
+	case refunded > 0 && refunded >= paid:
+		status = order.PaymentStatusREFUNDED
+	case paid >= o.Total && paid > 0:
+		status = order.PaymentStatusPAID
+	case paid > 0:
+		status = order.PaymentStatusPENDING
+	}
+
+	if err := tx.Order.UpdateOneID(orderID).SetPaymentStatus(status).Exec(ctx); err != nil {
+		return fmt.Errorf("failed to update order payment status: %w", err)
+	}
+	return recordOrderEvent(ctx, tx, o.RestaurantID, orderID, orderevent.TypeUpdated)
+}
+
+func mapToPayment(p *ent.Payment) *dto.Payment {
+	return &dto.Payment{
+		ID:                p.ID,
+		OrderID:           p.OrderID,
+		Method:            dto.PaymentMethod(p.Method),
+		Provider:          p.Provider,
+		ProviderReference: p.ProviderReference,
+		Amount:            money.New(p.Amount, money.Currency(p.Currency)),
+		AmountRefunded:    money.New(p.RefundedAmount, money.Currency(p.Currency)),
+		Status:            dto.PaymentState(p.Status),
+		FailureReason:     p.FailureReason,
+		CreatedBy:         p.CreatedByID,
+		CreatedAt:         p.CreateTime,
+	}
+}
@@ -0,0 +1,100 @@
+package repos
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Jiruu246/rms/internal/ent"
+	"github.com/Jiruu246/rms/internal/ent/ratelimitbucket"
+	"github.com/Jiruu246/rms/pkg/ratelimit"
+)
+
+// rateLimitAttempts bounds how often Take retries after losing a race for
+// a bucket to a concurrent request.
+const rateLimitAttempts = 5
+
+// RateLimitRepository is a ratelimit.Store kept in the database, so that
+// limits hold across server instances.
+type RateLimitRepository interface {
+	Take(ctx context.Context, key string, limit ratelimit.Limit) (ratelimit.Result, error)
+	// DeleteExpired deletes buckets that were full again before now; they
+	// are no different from buckets never used.
+	DeleteExpired(ctx context.Context, now time.Time) (int, error)
+}
+
+type rateLimitRepository struct {
+	client *ent.Client
+}
+
+func NewEntRateLimitRepository(client *ent.Client) RateLimitRepository {
+	return &rateLimitRepository{client: client}
+}
+
+// Take reads the bucket and writes it back only if it has not changed in
+// the meantime, retrying otherwise. A request that keeps losing races is
+// refused: that many concurrent requests for one key exceed any sensible
+// limit.
+func (r *rateLimitRepository) Take(ctx context.Context, key string, limit ratelimit.Limit) (ratelimit.Result, error) {
+	if limit.IsZero() {
+		return ratelimit.Result{Allowed: true}, nil
+	}
+
+	for range rateLimitAttempts {
+		now := time.Now()
+		existing, err := r.client.RateLimitBucket.Query().
+			Where(ratelimitbucket.Key(key)).
+			Only(ctx)
+		if ent.IsNotFound(err) {
+			bucket, result, full := limit.Take(limit.Full(now), now)
+			err := r.client.RateLimitBucket.Create().
+				SetKey(key).
+				SetTokens(bucket.Tokens).
+				SetUpdatedAt(now).
+				SetFullAt(full).
+				Exec(ctx)
+			if err == nil {
+				return result, nil
+			}
+			if ent.IsConstraintError(err) {
+				continue
+			}
+			return ratelimit.Result{}, fmt.Errorf("failed to create rate limit bucket: %w", err)
+		}
+		if err != nil {
+			return ratelimit.Result{}, fmt.Errorf("failed to get rate limit bucket: %w", err)
+		}
+
+		bucket, result, full := limit.Take(ratelimit.Bucket{Tokens: existing.Tokens, UpdatedAt: existing.UpdatedAt}, now)
+		if !result.Allowed {
+			// Nothing was taken, and refilling is a function of time.
+			return result, nil
+		}
+		n, err := r.client.RateLimitBucket.Update().
+			Where(
+				ratelimitbucket.ID(existing.ID),
+				ratelimitbucket.UpdatedAt(existing.UpdatedAt),
+			).
+			SetTokens(bucket.Tokens).
+			SetUpdatedAt(now).
+			SetFullAt(full).
+			Save(ctx)
+		if err != nil {
+			return ratelimit.Result{}, fmt.Errorf("failed to update rate limit bucket: %w", err)
+		}
+		if n == 1 {
+			return result, nil
+		}
+	}
+	return ratelimit.Result{RetryAfter: limit.Period / time.Duration(limit.Requests)}, nil
+}
+
+func (r *rateLimitRepository) DeleteExpired(ctx context.Context, now time.Time) (int, error) {
+	n, err := r.client.RateLimitBucket.Delete().
+		Where(ratelimitbucket.FullAtLT(now)).
+		Exec(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to delete expired rate limit buckets: %w", err)
+	}
+	return n, nil
+}
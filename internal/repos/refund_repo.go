@@ -0,0 +1,318 @@
+package repos
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Jiruu246/rms/internal/apperr"
+	"github.com/Jiruu246/rms/internal/dto"
+	"github.com/Jiruu246/rms/internal/ent"
+	"github.com/Jiruu246/rms/internal/ent/order"
+	"github.com/Jiruu246/rms/internal/ent/orderitem"
+	"github.com/Jiruu246/rms/internal/ent/payment"
+	"github.com/Jiruu246/rms/internal/ent/refund"
+	"github.com/Jiruu246/rms/internal/ent/schema"
+	"github.com/Jiruu246/rms/pkg/money"
+	"github.com/google/uuid"
+)
+
+// RefundAllocation is the part of a refund request drawn from one payment.
+type RefundAllocation struct {
+	PaymentID uuid.UUID
+	// ExpectedRefundedAmount is the payment's refunded_amount the caller
+	// validated Amount against. Begin fails with apperr.Conflict if it has
+	// changed.
+	ExpectedRefundedAmount int64
+	Amount                 int64
+	// Items are the order item units of an item refund that Amount pays
+	// for; they are recorded on the allocation's refund.
+	Items []dto.RefundItem
+}
+
+// RefundItemData marks Quantity units of an order item as refunded.
+type RefundItemData struct {
+	OrderItemID uuid.UUID
+	// ExpectedRefundedQuantity guards refunded_quantity the same way
+	// RefundAllocation.ExpectedRefundedAmount guards the payment.
+	ExpectedRefundedQuantity int
+	Quantity                 int
+}
+
+type BeginRefundData struct {
+	OrderID      uuid.UUID
+	RestaurantID uuid.UUID
+	Reason       dto.RefundReason
+	Note         string
+	Currency     money.Currency
+	Items        []RefundItemData
+	Allocations  []RefundAllocation
+	CreatedBy    uuid.UUID
+}
+
+type RefundRepository interface {
+	// Begin reserves each allocation on its payment, marks the items as
+	// refunded and records one PENDING refund per allocation, in the order
+	// of data.Allocations.
+	Begin(ctx context.Context, data *BeginRefundData) ([]*dto.Refund, error)
+	// MarkSucceeded settles a PENDING refund and recomputes the order's
+	// payment_status.
+	MarkSucceeded(ctx context.Context, restaurantID, id uuid.UUID, reference string) (*dto.Refund, error)
+	// MarkFailed settles a PENDING refund as FAILED and releases its
+	// reservation on the payment and the order.
+	MarkFailed(ctx context.Context, restaurantID, id uuid.UUID, reason string) (*dto.Refund, error)
+	// ReleaseItems undoes the refunded_quantity Begin added for items, for
+	// the units of refunds that did not go through.
+	ReleaseItems(ctx context.Context, orderID uuid.UUID, items []dto.RefundItem) error
+	ListByOrder(ctx context.Context, restaurantID, orderID uuid.UUID) ([]*dto.Refund, error)
+}
+
+type refundRepository struct {
+	client *ent.Client
+}
+
+func NewEntRefundRepository(client *ent.Client) RefundRepository {
+	return &refundRepository{client: client}
+}
+
+func (r *refundRepository) Begin(ctx context.Context, data *BeginRefundData) ([]*dto.Refund, error) {
+	tx, err := r.client.Tx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to start transaction: %w", err)
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+
+	for _, item := range data.Items {
+		var n int
+		n, err = tx.OrderItem.Update().
+			Where(
+				orderitem.IDEQ(item.OrderItemID),
+				orderitem.OrderIDEQ(data.OrderID),
+				orderitem.RefundedQuantityEQ(item.ExpectedRefundedQuantity),
+			).
+			AddRefundedQuantity(item.Quantity).
+			Save(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to mark order item refunded: %w", err)
+		}
+		if n == 0 {
+			err = apperr.Conflict("order item %s changed while refunding, please retry", item.OrderItemID)
+			return nil, err
+		}
+	}
+
+	var total int64
+	refunds := make([]*dto.Refund, 0, len(data.Allocations))
+	for _, alloc := range data.Allocations {
+		var n int
+		n, err = tx.Payment.Update().
+			Where(
+				payment.IDEQ(alloc.PaymentID),
+				payment.OrderIDEQ(data.OrderID),
+				payment.StatusEQ(payment.StatusSUCCEEDED),
+				payment.RefundedAmountEQ(alloc.ExpectedRefundedAmount),
+			).
+			AddRefundedAmount(alloc.Amount).
+			Save(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to reserve refund on payment: %w", err)
+		}
+		if n == 0 {
+			err = apperr.Conflict("payment %s changed while refunding, please retry", alloc.PaymentID)
+			return nil, err
+		}
+
+		var items []schema.RefundedItem
+		for _, item := range alloc.Items {
+			items = append(items, schema.RefundedItem{OrderItemID: item.OrderItemID, Quantity: item.Quantity})
+		}
+		create := tx.Refund.Create().
+			SetPaymentID(alloc.PaymentID).
+			SetOrderID(data.OrderID).
+			SetRestaurantID(data.RestaurantID).
+			SetAmount(alloc.Amount).
+			SetCurrency(string(data.Currency)).
+			SetReason(refund.Reason(data.Reason)).
+			SetNote(data.Note).
+			SetItems(items)
+		if data.CreatedBy != uuid.Nil {
+			create.SetCreatedByID(data.CreatedBy)
+		}
+		var rf *ent.Refund
+		rf, err = create.Save(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create refund: %w", err)
+		}
+		refunds = append(refunds, mapToRefund(rf))
+		total += alloc.Amount
+	}
+
+	err = tx.Order.UpdateOneID(data.OrderID).
+		Where(order.RestaurantIDEQ(data.RestaurantID)).
+		AddAmountRefunded(total).
+		Exec(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			err = apperr.NotFound("order %s", data.OrderID)
+			return nil, err
+		}
+		return nil, fmt.Errorf("failed to reserve refund on order: %w", err)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return refunds, nil
+}
+
+func (r *refundRepository) MarkSucceeded(ctx context.Context, restaurantID, id uuid.UUID, reference string) (*dto.Refund, error) {
+	tx, err := r.client.Tx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to start transaction: %w", err)
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+
+	var rf *ent.Refund
+	rf, err = settlePendingRefund(ctx, tx, restaurantID, id, func(u *ent.RefundUpdateOne) {
+		u.SetStatus(refund.StatusSUCCEEDED).SetProviderReference(reference)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err = refreshOrderPaymentStatus(ctx, tx, rf.OrderID); err != nil {
+		return nil, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return mapToRefund(rf), nil
+}
+
+func (r *refundRepository) MarkFailed(ctx context.Context, restaurantID, id uuid.UUID, reason string) (*dto.Refund, error) {
+	tx, err := r.client.Tx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to start transaction: %w", err)
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+
+	var rf *ent.Refund
+	rf, err = settlePendingRefund(ctx, tx, restaurantID, id, func(u *ent.RefundUpdateOne) {
+		u.SetStatus(refund.StatusFAILED).SetFailureReason(reason)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err = tx.Payment.UpdateOneID(rf.PaymentID).AddRefundedAmount(-rf.Amount).Exec(ctx); err != nil {
+		return nil, fmt.Errorf("failed to release refund reservation on payment: %w", err)
+	}
+	if err = tx.Order.UpdateOneID(rf.OrderID).AddAmountRefunded(-rf.Amount).Exec(ctx); err != nil {
+		return nil, fmt.Errorf("failed to release refund reservation on order: %w", err)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return mapToRefund(rf), nil
+}
+
+func (r *refundRepository) ReleaseItems(ctx context.Context, orderID uuid.UUID, items []dto.RefundItem) error {
+	tx, err := r.client.Tx(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+
+	for _, item := range items {
+		err = tx.OrderItem.Update().
+			Where(
+				orderitem.IDEQ(item.OrderItemID),
+				orderitem.OrderIDEQ(orderID),
+			).
+			AddRefundedQuantity(-item.Quantity).
+			Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to release refunded order item: %w", err)
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return nil
+}
+
+func (r *refundRepository) ListByOrder(ctx context.Context, restaurantID, orderID uuid.UUID) ([]*dto.Refund, error) {
+	rows, err := r.client.Refund.Query().
+		Where(
+			refund.OrderIDEQ(orderID),
+			refund.RestaurantIDEQ(restaurantID),
+		).
+		Order(refund.ByCreateTime()).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get refunds: %w", err)
+	}
+
+	refunds := make([]*dto.Refund, 0, len(rows))
+	for _, rf := range rows {
+		refunds = append(refunds, mapToRefund(rf))
+	}
+	return refunds, nil
+}
+
+// settlePendingRefund is settlePendingPayment for refunds.
+func settlePendingRefund(ctx context.Context, tx *ent.Tx, restaurantID, id uuid.UUID, set func(*ent.RefundUpdateOne)) (*ent.Refund, error) {
+	update := tx.Refund.UpdateOneID(id).
+		Where(
+			refund.RestaurantIDEQ(restaurantID),
+			refund.StatusEQ(refund.StatusPENDING),
+		)
+	set(update)
+	rf, err := update.Save(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, apperr.Conflict("refund %s is not pending", id)
+		}
+		return nil, fmt.Errorf("failed to settle refund: %w", err)
+	}
+	return rf, nil
+}
+
+func mapToRefund(rf *ent.Refund) *dto.Refund {
+	var items []dto.RefundItem
+	for _, item := range rf.Items {
+		items = append(items, dto.RefundItem{OrderItemID: item.OrderItemID, Quantity: item.Quantity})
+	}
+	return &dto.Refund{
+		ID:                rf.ID,
+		OrderID:           rf.OrderID,
+		PaymentID:         rf.PaymentID,
+		Amount:            money.New(rf.Amount, money.Currency(rf.Currency)),
+		Reason:            dto.RefundReason(rf.Reason),
+		Note:              rf.Note,
+		Items:             items,
+		Status:            dto.PaymentState(rf.Status),
+		ProviderReference: rf.ProviderReference,
+		FailureReason:     rf.FailureReason,
+		CreatedBy:         rf.CreatedByID,
+		CreatedAt:         rf.CreateTime,
+	}
+}
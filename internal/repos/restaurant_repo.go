@@ -19,6 +19,11 @@ type RestaurantRepository interface {
 	Delete(ctx context.Context, id uuid.UUID) error
 	GetAllForUser(ctx context.Context, userID uuid.UUID) ([]*dto.RestaurantResponse, error)
 	GetAuthorizationResource(ctx context.Context, id uuid.UUID) (authz.Resource, error)
+	// SetLogo sets the restaurant's uploaded logo, or clears it if logo is
+	// nil, and returns the blob keys of the logo it replaced.
+	SetLogo(ctx context.Context, id uuid.UUID, logo *ImageData) (*dto.RestaurantResponse, []string, error)
+	// GetLogoKeys returns the blob keys of the restaurant's uploaded logo.
+	GetLogoKeys(ctx context.Context, id uuid.UUID) ([]string, error)
 }
 
 type restaurantRepository struct {
@@ -33,7 +38,7 @@ func NewEntRestaurantRepository(client *ent.Client) RestaurantRepository {
 }
 
 func (r *restaurantRepository) Create(ctx context.Context, data *dto.CreateRestaurantData) (*dto.RestaurantResponse, error) {
-	create, err := r.client.Restaurant.Create().
+	create := r.client.Restaurant.Create().
 		SetName(data.Request.Name).
 		SetDescription(data.Request.Description).
 		SetPhone(data.Request.Phone).
@@ -43,19 +48,33 @@ func (r *restaurantRepository) Create(ctx context.Context, data *dto.CreateResta
 		SetState(data.Request.State).
 		SetZipCode(data.Request.ZipCode).
 		SetCountry(data.Request.Country).
+		SetNillableLatitude(data.Request.Latitude).
+		SetNillableLongitude(data.Request.Longitude).
 		SetStatus(restaurant.StatusActive).
 		SetCurrency(data.Request.Currency).
+		SetTaxRateBps(data.Request.TaxRateBps).
 		SetLogoURL(data.Request.LogoURL).
 		SetCoverImageURL(data.Request.CoverImageURL).
-		SetOperatingHours(data.Request.OperatingHours).
-		SetUserID(data.UserID).
-		Save(ctx)
+		SetNillableSlotMinutes(data.Request.SlotMinutes).
+		SetNillableSlotCapacity(data.Request.SlotCapacity).
+		SetNillableKitchenLeadMinutes(data.Request.KitchenLeadMinutes).
+		SetUserID(data.UserID)
+	if data.Request.OperatingHours != nil {
+		create.SetOperatingHours(data.Request.OperatingHours)
+	}
+	if data.Request.Timezone != "" {
+		create.SetTimezone(data.Request.Timezone)
+	}
+	if data.Request.OrderNumberReset != "" {
+		create.SetOrderNumberReset(restaurant.OrderNumberReset(data.Request.OrderNumberReset))
+	}
+	created, err := create.Save(ctx)
 
 	if err != nil {
 		return nil, fmt.Errorf("failed to create restaurant: %w", err)
 	}
 
-	return mapToRestaurantResponse(create), nil
+	return mapToRestaurantResponse(created), nil
 }
 
 func (r *restaurantRepository) GetByID(ctx context.Context, id uuid.UUID) (*dto.RestaurantResponse, error) {
@@ -112,10 +131,6 @@ func (r *restaurantRepository) Update(ctx context.Context, data *dto.UpdateResta
 		update.SetCountry(*data.Request.Country)
 	}
 
-	if data.Request.LogoURL != nil {
-		update.SetLogoURL(*data.Request.LogoURL)
-	}
-
 	if data.Request.CoverImageURL != nil {
 		update.SetCoverImageURL(*data.Request.CoverImageURL)
 	}
@@ -125,13 +140,57 @@ func (r *restaurantRepository) Update(ctx context.Context, data *dto.UpdateResta
 	}
 
 	if data.Request.OperatingHours != nil {
-		update.SetOperatingHours(*data.Request.OperatingHours)
+		update.SetOperatingHours(data.Request.OperatingHours)
 	}
 
 	if data.Request.Currency != nil {
+		// Prices are stored as minor units of the restaurant currency, so
+		// switching currency would silently reinterpret every price
+		// (e.g. 1200 JPY -> 12.00 USD). Only allow it before anything is priced.
+		priced, err := r.client.Restaurant.Query().
+			Where(
+				restaurant.IDEQ(data.ID),
+				restaurant.CurrencyNEQ(*data.Request.Currency),
+				restaurant.Or(restaurant.HasMenuItems(), restaurant.HasModifiers()),
+			).
+			Exist(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to check restaurant prices: %w", err)
+		}
+		if priced {
+			return nil, apperr.Conflict("cannot change the currency of restaurant %s once it has menu items or modifiers", data.ID)
+		}
 		update.SetCurrency(*data.Request.Currency)
 	}
 
+	if data.Request.TaxRateBps != nil {
+		update.SetTaxRateBps(*data.Request.TaxRateBps)
+	}
+
+	if data.Request.Timezone != nil {
+		update.SetTimezone(*data.Request.Timezone)
+	}
+
+	if data.Request.Latitude != nil && data.Request.Longitude != nil {
+		update.SetLatitude(*data.Request.Latitude).SetLongitude(*data.Request.Longitude)
+	}
+
+	if data.Request.OrderNumberReset != nil {
+		update.SetOrderNumberReset(restaurant.OrderNumberReset(*data.Request.OrderNumberReset))
+	}
+
+	if data.Request.SlotMinutes != nil {
+		update.SetSlotMinutes(*data.Request.SlotMinutes)
+	}
+
+	if data.Request.SlotCapacity != nil {
+		update.SetSlotCapacity(*data.Request.SlotCapacity)
+	}
+
+	if data.Request.KitchenLeadMinutes != nil {
+		update.SetKitchenLeadMinutes(*data.Request.KitchenLeadMinutes)
+	}
+
 	updated, err := update.Save(ctx)
 	if err != nil {
 		if ent.IsNotFound(err) {
@@ -191,22 +250,71 @@ func (r *restaurantRepository) GetAllForUser(ctx context.Context, userID uuid.UU
 	return responses, nil
 }
 
+func (r *restaurantRepository) SetLogo(ctx context.Context, id uuid.UUID, logo *ImageData) (*dto.RestaurantResponse, []string, error) {
+	replaced, err := r.GetLogoKeys(ctx, id)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	update := r.client.Restaurant.UpdateOneID(id)
+	if logo == nil {
+		update.SetLogoURL("").SetLogoThumbnailURL("").ClearLogoKeys()
+	} else {
+		update.SetLogoURL(logo.URL).SetLogoThumbnailURL(logo.ThumbnailURL).SetLogoKeys(logo.Keys)
+	}
+	if err := update.Exec(ctx); err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil, apperr.NotFound("restaurant %s", id)
+		}
+		return nil, nil, fmt.Errorf("failed to set restaurant logo: %w", err)
+	}
+
+	rest, err := r.GetByID(ctx, id)
+	if err != nil {
+		return nil, nil, err
+	}
+	return rest, replaced, nil
+}
+
+func (r *restaurantRepository) GetLogoKeys(ctx context.Context, id uuid.UUID) ([]string, error) {
+	rest, err := r.client.Restaurant.Query().
+		Where(restaurant.IDEQ(id)).
+		Select(restaurant.FieldLogoKeys).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, apperr.NotFound("restaurant %s", id)
+		}
+		return nil, fmt.Errorf("failed to get restaurant: %w", err)
+	}
+	return rest.LogoKeys, nil
+}
+
 func mapToRestaurantResponse(restaurant *ent.Restaurant) *dto.RestaurantResponse {
 	return &dto.RestaurantResponse{
-		ID:             restaurant.ID,
-		Name:           restaurant.Name,
-		Description:    restaurant.Description,
-		Phone:          restaurant.Phone,
-		Email:          restaurant.Email,
-		Address:        restaurant.Address,
-		City:           restaurant.City,
-		State:          restaurant.State,
-		ZipCode:        restaurant.ZipCode,
-		Country:        restaurant.Country,
-		LogoURL:        restaurant.LogoURL,
-		CoverImageURL:  restaurant.CoverImageURL,
-		Status:         restaurant.Status.String(),
-		OperatingHours: restaurant.OperatingHours,
-		Currency:       restaurant.Currency,
+		ID:                 restaurant.ID,
+		Name:               restaurant.Name,
+		Description:        restaurant.Description,
+		Phone:              restaurant.Phone,
+		Email:              restaurant.Email,
+		Address:            restaurant.Address,
+		City:               restaurant.City,
+		State:              restaurant.State,
+		ZipCode:            restaurant.ZipCode,
+		Country:            restaurant.Country,
+		Latitude:           restaurant.Latitude,
+		Longitude:          restaurant.Longitude,
+		LogoURL:            restaurant.LogoURL,
+		LogoThumbnailURL:   restaurant.LogoThumbnailURL,
+		CoverImageURL:      restaurant.CoverImageURL,
+		Status:             restaurant.Status.String(),
+		OperatingHours:     restaurant.OperatingHours,
+		Currency:           restaurant.Currency,
+		TaxRateBps:         restaurant.TaxRateBps,
+		Timezone:           restaurant.Timezone,
+		OrderNumberReset:   string(restaurant.OrderNumberReset),
+		SlotMinutes:        restaurant.SlotMinutes,
+		SlotCapacity:       restaurant.SlotCapacity,
+		KitchenLeadMinutes: restaurant.KitchenLeadMinutes,
 	}
 }
@@ -0,0 +1,264 @@
+package repos
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/Jiruu246/rms/internal/apperr"
+	"github.com/Jiruu246/rms/internal/authz"
+	"github.com/Jiruu246/rms/internal/dto"
+	"github.com/Jiruu246/rms/internal/ent"
+	"github.com/Jiruu246/rms/internal/ent/category"
+	"github.com/Jiruu246/rms/internal/ent/menuitem"
+	"github.com/Jiruu246/rms/internal/ent/restaurant"
+	"github.com/Jiruu246/rms/internal/ent/station"
+	"github.com/google/uuid"
+)
+
+type StationRepository interface {
+	Create(ctx context.Context, req *dto.CreateStationRequest) (*dto.Station, error)
+	GetByID(ctx context.Context, restaurantID, id uuid.UUID) (*dto.Station, error)
+	GetAllByRestaurant(ctx context.Context, restaurantID uuid.UUID) ([]*dto.Station, error)
+	Update(ctx context.Context, restaurantID, id uuid.UUID, req *dto.UpdateStationRequest) (*dto.Station, error)
+	Delete(ctx context.Context, restaurantID, id uuid.UUID) error
+	// SetRouting replaces the menu items and categories routed to the
+	// station. They must all belong to restaurantID.
+	SetRouting(ctx context.Context, restaurantID, id uuid.UUID, req *dto.SetStationRoutingRequest) (*dto.Station, error)
+	GetAuthorizationResource(ctx context.Context, id uuid.UUID) (authz.Resource, error)
+}
+
+type stationRepository struct {
+	client *ent.Client
+}
+
+func NewEntStationRepository(client *ent.Client) StationRepository {
+	return &stationRepository{client: client}
+}
+
+func (r *stationRepository) Create(ctx context.Context, req *dto.CreateStationRequest) (*dto.Station, error) {
+	created, err := r.client.Station.
+		Create().
+		SetName(req.Name).
+		SetDisplayOrder(req.DisplayOrder).
+		SetRestaurantID(req.RestaurantID).
+		Save(ctx)
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return nil, apperr.Conflict("a station named %s already exists", req.Name)
+		}
+		return nil, fmt.Errorf("failed to create station: %w", err)
+	}
+
+	return mapToStation(created), nil
+}
+
+func (r *stationRepository) GetByID(ctx context.Context, restaurantID, id uuid.UUID) (*dto.Station, error) {
+	st, err := r.client.Station.
+		Query().
+		Where(station.ID(id), station.RestaurantIDEQ(restaurantID)).
+		WithMenuItems(func(q *ent.MenuItemQuery) {
+			q.Select(menuitem.FieldID, menuitem.FieldStationID).Order(menuitem.ByID())
+		}).
+		WithCategories(func(q *ent.CategoryQuery) {
+			q.Select(category.FieldID, category.FieldStationID).Order(category.ByName())
+		}).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, apperr.NotFound("station %s", id)
+		}
+		return nil, fmt.Errorf("failed to get station: %w", err)
+	}
+
+	return mapToStation(st), nil
+}
+
+func (r *stationRepository) GetAllByRestaurant(ctx context.Context, restaurantID uuid.UUID) ([]*dto.Station, error) {
+	rows, err := r.client.Station.
+		Query().
+		Where(station.RestaurantIDEQ(restaurantID)).
+		Order(station.ByDisplayOrder(), station.ByName()).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get stations: %w", err)
+	}
+
+	stations := make([]*dto.Station, 0, len(rows))
+	for _, st := range rows {
+		stations = append(stations, mapToStation(st))
+	}
+	return stations, nil
+}
+
+func (r *stationRepository) Update(ctx context.Context, restaurantID, id uuid.UUID, req *dto.UpdateStationRequest) (*dto.Station, error) {
+	update := r.client.Station.UpdateOneID(id).Where(station.RestaurantIDEQ(restaurantID))
+
+	hasUpdates := false
+
+	if req.Name != nil {
+		if strings.TrimSpace(*req.Name) == "" {
+			return nil, apperr.Invalid("name cannot be empty")
+		}
+		update.SetName(*req.Name)
+		hasUpdates = true
+	}
+
+	if req.DisplayOrder != nil {
+		update.SetDisplayOrder(*req.DisplayOrder)
+		hasUpdates = true
+	}
+
+	if !hasUpdates {
+		return nil, apperr.Invalid("no valid fields provided for update")
+	}
+
+	updated, err := update.Save(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, apperr.NotFound("station %s", id)
+		}
+		if ent.IsConstraintError(err) {
+			return nil, apperr.Conflict("a station named %s already exists", *req.Name)
+		}
+		return nil, fmt.Errorf("failed to update station: %w", err)
+	}
+
+	return mapToStation(updated), nil
+}
+
+// Delete removes the station together with its tickets. Items and
+// categories routed to it are left without a station.
+func (r *stationRepository) Delete(ctx context.Context, restaurantID, id uuid.UUID) error {
+	err := r.client.Station.
+		DeleteOneID(id).
+		Where(station.RestaurantIDEQ(restaurantID)).
+		Exec(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return apperr.NotFound("station %s", id)
+		}
+		return fmt.Errorf("failed to delete station: %w", err)
+	}
+
+	return nil
+}
+
+func (r *stationRepository) SetRouting(ctx context.Context, restaurantID, id uuid.UUID, req *dto.SetStationRoutingRequest) (*dto.Station, error) {
+	menuItemIDs := slices.Compact(slices.Sorted(slices.Values(req.MenuItemIDs)))
+	categoryIDs := slices.Compact(slices.SortedFunc(slices.Values(req.CategoryIDs), func(a, b uuid.UUID) int {
+		return strings.Compare(a.String(), b.String())
+	}))
+
+	tx, err := r.client.Tx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to start transaction: %w", err)
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+
+	var exists bool
+	exists, err = tx.Station.Query().
+		Where(station.ID(id), station.RestaurantIDEQ(restaurantID)).
+		Exist(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get station: %w", err)
+	}
+	if !exists {
+		err = apperr.NotFound("station %s", id)
+		return nil, err
+	}
+
+	err = tx.MenuItem.Update().
+		Where(menuitem.StationIDEQ(id), menuitem.IDNotIn(menuItemIDs...)).
+		ClearStationID().
+		Exec(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to unroute menu items: %w", err)
+	}
+	if len(menuItemIDs) > 0 {
+		var n int
+		n, err = tx.MenuItem.Update().
+			Where(menuitem.IDIn(menuItemIDs...), menuitem.RestaurantIDEQ(restaurantID)).
+			SetStationID(id).
+			Save(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to route menu items: %w", err)
+		}
+		if n != len(menuItemIDs) {
+			err = apperr.Invalid("one or more menu items do not belong to restaurant %s", restaurantID)
+			return nil, err
+		}
+	}
+
+	err = tx.Category.Update().
+		Where(category.StationIDEQ(id), category.IDNotIn(categoryIDs...)).
+		ClearStationID().
+		Exec(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to unroute categories: %w", err)
+	}
+	if len(categoryIDs) > 0 {
+		var n int
+		n, err = tx.Category.Update().
+			Where(category.IDIn(categoryIDs...), category.RestaurantIDEQ(restaurantID)).
+			SetStationID(id).
+			Save(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to route categories: %w", err)
+		}
+		if n != len(categoryIDs) {
+			err = apperr.Invalid("one or more categories do not belong to restaurant %s", restaurantID)
+			return nil, err
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return r.GetByID(ctx, restaurantID, id)
+}
+
+// GetAuthorizationResource resolves the authz.Resource for a station by
+// joining through restaurant_id to the owning restaurant, in a single query.
+func (r *stationRepository) GetAuthorizationResource(ctx context.Context, id uuid.UUID) (authz.Resource, error) {
+	rest, err := r.client.Station.
+		Query().
+		Where(station.ID(id)).
+		QueryRestaurant().
+		Select(restaurant.FieldID, restaurant.FieldUserID).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return authz.Resource{}, apperr.NotFound("station %s", id)
+		}
+		return authz.Resource{}, fmt.Errorf("failed to get station: %w", err)
+	}
+
+	return authz.Resource{
+		Type:         "station",
+		ID:           id,
+		RestaurantID: rest.ID,
+		OwnerUserID:  rest.UserID,
+	}, nil
+}
+
+func mapToStation(st *ent.Station) *dto.Station {
+	response := &dto.Station{
+		ID:           st.ID,
+		Name:         st.Name,
+		DisplayOrder: st.DisplayOrder,
+		RestaurantID: st.RestaurantID,
+	}
+	for _, item := range st.Edges.MenuItems {
+		response.MenuItemIDs = append(response.MenuItemIDs, item.ID)
+	}
+	for _, cat := range st.Edges.Categories {
+		response.CategoryIDs = append(response.CategoryIDs, cat.ID)
+	}
+	return response
+}
@@ -0,0 +1,331 @@
+package repos
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Jiruu246/rms/internal/apperr"
+	"github.com/Jiruu246/rms/internal/authz"
+	"github.com/Jiruu246/rms/internal/dto"
+	"github.com/Jiruu246/rms/internal/ent"
+	"github.com/Jiruu246/rms/internal/ent/category"
+	"github.com/Jiruu246/rms/internal/ent/menuitem"
+	"github.com/Jiruu246/rms/internal/ent/order"
+	"github.com/Jiruu246/rms/internal/ent/orderevent"
+	"github.com/Jiruu246/rms/internal/ent/orderitem"
+	"github.com/Jiruu246/rms/internal/ent/orderstatusevent"
+	"github.com/Jiruu246/rms/internal/ent/restaurant"
+	"github.com/Jiruu246/rms/internal/ent/stationticket"
+	"github.com/Jiruu246/rms/pkg/money"
+	"github.com/google/uuid"
+)
+
+// orderReadyReason is recorded on the status event of an order that became
+// READY because its last ticket did.
+const orderReadyReason = "all station tickets ready"
+
+// UpdateTicketStatusData describes a single, already validated, ticket
+// status change. From guards the update the same way
+// dto.OrderStatusTransition.From does for orders.
+type UpdateTicketStatusData struct {
+	ID           uuid.UUID
+	RestaurantID uuid.UUID
+	From         dto.TicketStatus
+	To           dto.TicketStatus
+	ChangedBy    uuid.UUID
+}
+
+type StationTicketRepository interface {
+	GetByID(ctx context.Context, restaurantID, id uuid.UUID) (*dto.StationTicket, error)
+	// ListByStation returns the station's tickets oldest first. Tickets of
+	// cancelled orders are left out.
+	ListByStation(ctx context.Context, restaurantID, stationID uuid.UUID, filters dto.StationTicketListFilters) ([]*dto.StationTicket, error)
+	// UpdateStatus applies data. When the ticket becomes READY and it was
+	// the order's last ticket that wasn't, an OPEN or CONFIRMED order is
+	// moved to READY in the same transaction.
+	UpdateStatus(ctx context.Context, data *UpdateTicketStatusData) (*dto.StationTicket, error)
+	GetAuthorizationResource(ctx context.Context, id uuid.UUID) (authz.Resource, error)
+}
+
+type stationTicketRepository struct {
+	client *ent.Client
+}
+
+func NewEntStationTicketRepository(client *ent.Client) StationTicketRepository {
+	return &stationTicketRepository{client: client}
+}
+
+func (r *stationTicketRepository) GetByID(ctx context.Context, restaurantID, id uuid.UUID) (*dto.StationTicket, error) {
+	ticket, err := getStationTicket(ctx, r.client.StationTicket.Query().Where(stationticket.RestaurantIDEQ(restaurantID)), id)
+	if err != nil {
+		return nil, err
+	}
+	return mapToStationTicket(ticket), nil
+}
+
+func (r *stationTicketRepository) ListByStation(ctx context.Context, restaurantID, stationID uuid.UUID, filters dto.StationTicketListFilters) ([]*dto.StationTicket, error) {
+	q := r.client.StationTicket.Query().
+		Where(
+			stationticket.StationIDEQ(stationID),
+			stationticket.RestaurantIDEQ(restaurantID),
+			stationticket.HasOrderWith(order.OrderStatusNEQ(order.OrderStatusCANCELLED)),
+		)
+	if filters.Status != nil {
+		q = q.Where(stationticket.StatusEQ(stationticket.Status(*filters.Status)))
+	} else {
+		q = q.Where(stationticket.StatusNEQ(stationticket.StatusREADY))
+	}
+	rows, err := withStationTicketDetails(q).
+		Order(stationticket.ByCreateTime()).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get station tickets: %w", err)
+	}
+
+	tickets := make([]*dto.StationTicket, 0, len(rows))
+	for _, t := range rows {
+		tickets = append(tickets, mapToStationTicket(t))
+	}
+	return tickets, nil
+}
+
+func (r *stationTicketRepository) UpdateStatus(ctx context.Context, data *UpdateTicketStatusData) (*dto.StationTicket, error) {
+	tx, err := r.client.Tx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to start transaction: %w", err)
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+
+	var updated *ent.StationTicket
+	updated, err = tx.StationTicket.UpdateOneID(data.ID).
+		Where(
+			stationticket.RestaurantIDEQ(data.RestaurantID),
+			stationticket.StatusEQ(stationticket.Status(data.From)),
+		).
+		SetStatus(stationticket.Status(data.To)).
+		Save(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			// As in orderRepository.Update, the service has just read the
+			// ticket, so a miss means it was moved on concurrently.
+			err = apperr.Conflict("ticket %s is no longer %s", data.ID, data.From)
+			return nil, err
+		}
+		return nil, fmt.Errorf("failed to update station ticket: %w", err)
+	}
+
+	if err = recordTicketEvent(ctx, tx, updated.ID, orderevent.TypeTicketStatusChanged); err != nil {
+		return nil, err
+	}
+
+	if data.To == dto.TicketStatusREADY {
+		if err = markOrderReadyIfDone(ctx, tx, updated.OrderID, data.ChangedBy); err != nil {
+			return nil, err
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return r.GetByID(ctx, data.RestaurantID, data.ID)
+}
+
+// GetAuthorizationResource resolves the authz.Resource for a ticket by
+// joining through restaurant_id to the owning restaurant, in a single query.
+func (r *stationTicketRepository) GetAuthorizationResource(ctx context.Context, id uuid.UUID) (authz.Resource, error) {
+	rest, err := r.client.StationTicket.
+		Query().
+		Where(stationticket.ID(id)).
+		QueryRestaurant().
+		Select(restaurant.FieldID, restaurant.FieldUserID).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return authz.Resource{}, apperr.NotFound("station ticket %s", id)
+		}
+		return authz.Resource{}, fmt.Errorf("failed to get station ticket: %w", err)
+	}
+
+	return authz.Resource{
+		Type:         "station_ticket",
+		ID:           id,
+		RestaurantID: rest.ID,
+		OwnerUserID:  rest.UserID,
+	}, nil
+}
+
+// markOrderReadyIfDone moves the order to READY, recording the transition,
+// once none of its tickets is left to prepare. Orders that are already
+// READY, COMPLETED or CANCELLED are left alone.
+func markOrderReadyIfDone(ctx context.Context, tx *ent.Tx, orderID, changedBy uuid.UUID) error {
+	pending, err := tx.StationTicket.Query().
+		Where(
+			stationticket.OrderIDEQ(orderID),
+			stationticket.StatusNEQ(stationticket.StatusREADY),
+		).
+		Exist(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to check station tickets: %w", err)
+	}
+	if pending {
+		return nil
+	}
+
+	ord, err := tx.Order.Get(ctx, orderID)
+	if err != nil {
+		return fmt.Errorf("failed to get order: %w", err)
+	}
+	if ord.OrderStatus != order.OrderStatusOPEN && ord.OrderStatus != order.OrderStatusCONFIRMED {
+		return nil
+	}
+
+	n, err := tx.Order.Update().
+		Where(
+			order.IDEQ(orderID),
+			order.OrderStatusEQ(ord.OrderStatus),
+		).
+		SetOrderStatus(order.OrderStatusREADY).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to mark order ready: %w", err)
+	}
+	if n == 0 {
+		return apperr.Conflict("order %s changed while its ticket was updated, please retry", orderID)
+	}
+
+	createEvent := tx.OrderStatusEvent.Create().
+		SetOrderID(orderID).
+		SetRestaurantID(ord.RestaurantID).
+		SetFromStatus(orderstatusevent.FromStatus(ord.OrderStatus)).
+		SetToStatus(orderstatusevent.ToStatusREADY).
+		SetReason(orderReadyReason)
+	if changedBy != uuid.Nil {
+		createEvent.SetChangedByID(changedBy)
+	}
+	if _, err := createEvent.Save(ctx); err != nil {
+		return fmt.Errorf("failed to record order status event: %w", err)
+	}
+
+	return recordOrderEvent(ctx, tx, ord.RestaurantID, orderID, orderevent.TypeStatusChanged)
+}
+
+// routedOrderItem pairs a freshly created order item with the menu item it
+// was ordered from, for createStationTickets.
+type routedOrderItem struct {
+	OrderItemID uuid.UUID
+	MenuItemID  int64
+}
+
+// createStationTickets splits a new order into one ticket per station its
+// items are routed to, and puts each item on its ticket. An item goes to its
+// menu item's station, or failing that its category's; items routed nowhere
+// are not put on a ticket. Ticket IDs are returned in the order of the first
+// item on each.
+func createStationTickets(ctx context.Context, tx *ent.Tx, ord *ent.Order, items []routedOrderItem) ([]uuid.UUID, error) {
+	menuItemIDs := make([]int64, 0, len(items))
+	for _, item := range items {
+		menuItemIDs = append(menuItemIDs, item.MenuItemID)
+	}
+	menuItems, err := tx.MenuItem.Query().
+		Where(menuitem.IDIn(menuItemIDs...)).
+		Select(menuitem.FieldID, menuitem.FieldStationID, menuitem.FieldCategoryID).
+		WithCategory(func(q *ent.CategoryQuery) {
+			q.Select(category.FieldID, category.FieldStationID)
+		}).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get menu item stations: %w", err)
+	}
+	stationOf := make(map[int64]uuid.UUID, len(menuItems))
+	for _, mi := range menuItems {
+		switch {
+		case mi.StationID != nil:
+			stationOf[mi.ID] = *mi.StationID
+		case mi.Edges.Category != nil && mi.Edges.Category.StationID != nil:
+			stationOf[mi.ID] = *mi.Edges.Category.StationID
+		}
+	}
+
+	var stations []uuid.UUID
+	itemsByStation := make(map[uuid.UUID][]uuid.UUID)
+	for _, item := range items {
+		stationID, ok := stationOf[item.MenuItemID]
+		if !ok {
+			continue
+		}
+		if _, seen := itemsByStation[stationID]; !seen {
+			stations = append(stations, stationID)
+		}
+		itemsByStation[stationID] = append(itemsByStation[stationID], item.OrderItemID)
+	}
+
+	tickets := make([]uuid.UUID, 0, len(stations))
+	for _, stationID := range stations {
+		ticket, err := tx.StationTicket.Create().
+			SetOrderID(ord.ID).
+			SetStationID(stationID).
+			SetRestaurantID(ord.RestaurantID).
+			Save(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create station ticket: %w", err)
+		}
+		err = tx.OrderItem.Update().
+			Where(orderitem.IDIn(itemsByStation[stationID]...)).
+			SetStationTicketID(ticket.ID).
+			Exec(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to route order items: %w", err)
+		}
+		tickets = append(tickets, ticket.ID)
+	}
+	return tickets, nil
+}
+
+func withStationTicketDetails(q *ent.StationTicketQuery) *ent.StationTicketQuery {
+	return q.
+		WithOrder(func(oq *ent.OrderQuery) {
+			oq.Select(order.FieldID, order.FieldOrderNumber, order.FieldOrderType, order.FieldCurrency)
+		}).
+		WithOrderItems(func(oq *ent.OrderItemQuery) {
+			oq.WithOrderItemModifierOptions()
+		})
+}
+
+// getStationTicket loads ticket id from q, which the caller may have scoped,
+// with everything mapToStationTicket needs.
+func getStationTicket(ctx context.Context, q *ent.StationTicketQuery, id uuid.UUID) (*ent.StationTicket, error) {
+	ticket, err := withStationTicketDetails(q.Where(stationticket.ID(id))).Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, apperr.NotFound("station ticket %s", id)
+		}
+		return nil, fmt.Errorf("failed to get station ticket: %w", err)
+	}
+	return ticket, nil
+}
+
+func mapToStationTicket(t *ent.StationTicket) *dto.StationTicket {
+	ticket := &dto.StationTicket{
+		ID:        t.ID,
+		Status:    dto.TicketStatus(t.Status),
+		OrderID:   t.OrderID,
+		StationID: t.StationID,
+		Items:     []dto.OrderItem{},
+		CreatedAt: t.CreateTime,
+		UpdatedAt: t.UpdateTime,
+	}
+	var currency money.Currency
+	if ord := t.Edges.Order; ord != nil {
+		ticket.OrderNumber = ord.OrderNumber
+		ticket.OrderType = dto.OrderType(ord.OrderType)
+		currency = money.Currency(ord.Currency)
+	}
+	for _, oi := range t.Edges.OrderItems {
+		ticket.Items = append(ticket.Items, mapToOrderItem(oi, currency))
+	}
+	return ticket
+}
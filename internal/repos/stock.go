@@ -0,0 +1,238 @@
+package repos
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/Jiruu246/rms/internal/apperr"
+	"github.com/Jiruu246/rms/internal/ent"
+	"github.com/Jiruu246/rms/internal/ent/ingredient"
+	"github.com/Jiruu246/rms/internal/ent/menuitem"
+	"github.com/Jiruu246/rms/internal/ent/modifieroption"
+	"github.com/Jiruu246/rms/internal/ent/predicate"
+	"github.com/Jiruu246/rms/internal/ent/recipeingredient"
+	"github.com/Jiruu246/rms/internal/ent/stockmovement"
+	"github.com/google/uuid"
+)
+
+// consumeStock draws what items use from stock, following the recipes of
+// their menu items and modifier options, and records a SALE movement per
+// ingredient against orderID. If an ingredient does not have enough on hand
+// it fails with apperr.Invalid. Menu items and options that can no longer be
+// made are then 86ed; see refreshStockAvailability.
+//
+// Ingredient rows are locked in ID order, so orders drawing on the same
+// ingredients cannot deadlock each other.
+func consumeStock(ctx context.Context, tx *ent.Tx, orderID, createdBy uuid.UUID, items []OrderItemData) error {
+	menuItemUnits := make(map[int64]int64)
+	optionUnits := make(map[uuid.UUID]int64)
+	for _, item := range items {
+		menuItemUnits[item.MenuItemID] += int64(item.Quantity)
+		addOptionUnits(optionUnits, item.ModifierOptions, int64(item.Quantity))
+	}
+
+	var preds []predicate.RecipeIngredient
+	if len(menuItemUnits) > 0 {
+		preds = append(preds, recipeingredient.MenuItemIDIn(slices.Collect(maps.Keys(menuItemUnits))...))
+	}
+	if len(optionUnits) > 0 {
+		preds = append(preds, recipeingredient.ModifierOptionIDIn(slices.Collect(maps.Keys(optionUnits))...))
+	}
+	if len(preds) == 0 {
+		return nil
+	}
+	lines, err := tx.RecipeIngredient.Query().
+		Where(recipeingredient.Or(preds...)).
+		All(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get recipes: %w", err)
+	}
+
+	used := make(map[uuid.UUID]int64)
+	for _, line := range lines {
+		switch {
+		case line.MenuItemID != nil:
+			used[line.IngredientID] += line.Quantity * menuItemUnits[*line.MenuItemID]
+		case line.ModifierOptionID != nil:
+			used[line.IngredientID] += line.Quantity * optionUnits[*line.ModifierOptionID]
+		}
+	}
+	if len(used) == 0 {
+		return nil
+	}
+
+	ingredientIDs := slices.SortedFunc(maps.Keys(used), func(a, b uuid.UUID) int {
+		return strings.Compare(a.String(), b.String())
+	})
+	for _, id := range ingredientIDs {
+		quantity := used[id]
+		ing, err := tx.Ingredient.UpdateOneID(id).
+			Where(ingredient.OnHandGTE(quantity)).
+			AddOnHand(-quantity).
+			Save(ctx)
+		if err != nil {
+			if ent.IsNotFound(err) {
+				name, err := tx.Ingredient.Query().
+					Where(ingredient.IDEQ(id)).
+					Select(ingredient.FieldName).
+					String(ctx)
+				if err != nil {
+					return fmt.Errorf("failed to get ingredient: %w", err)
+				}
+				return apperr.Invalid("not enough %s in stock", name)
+			}
+			return fmt.Errorf("failed to update stock: %w", err)
+		}
+
+		movement := tx.StockMovement.Create().
+			SetIngredientID(id).
+			SetKind(stockmovement.KindSALE).
+			SetQuantity(-quantity).
+			SetOnHandAfter(ing.OnHand).
+			SetOrderID(orderID)
+		if createdBy != uuid.Nil {
+			movement.SetCreatedByID(createdBy)
+		}
+		if err := movement.Exec(ctx); err != nil {
+			return fmt.Errorf("failed to record stock movement: %w", err)
+		}
+	}
+
+	return refreshStockAvailability(ctx, tx, ingredientIDs...)
+}
+
+// refreshStockAvailability re-checks the menu items and modifier options
+// whose recipes use ingredientIDs; see refreshRecipeAvailability.
+func refreshStockAvailability(ctx context.Context, tx *ent.Tx, ingredientIDs ...uuid.UUID) error {
+	if len(ingredientIDs) == 0 {
+		return nil
+	}
+	lines, err := tx.RecipeIngredient.Query().
+		Where(recipeingredient.IngredientIDIn(ingredientIDs...)).
+		All(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get recipes: %w", err)
+	}
+
+	var menuItemIDs []int64
+	var optionIDs []uuid.UUID
+	for _, line := range lines {
+		if line.MenuItemID != nil && !slices.Contains(menuItemIDs, *line.MenuItemID) {
+			menuItemIDs = append(menuItemIDs, *line.MenuItemID)
+		}
+		if line.ModifierOptionID != nil && !slices.Contains(optionIDs, *line.ModifierOptionID) {
+			optionIDs = append(optionIDs, *line.ModifierOptionID)
+		}
+	}
+	return refreshRecipeAvailability(ctx, tx, menuItemIDs, optionIDs)
+}
+
+// refreshRecipeAvailability 86es the given menu items and modifier options
+// that can no longer be made because an ingredient of their recipe has less
+// on hand than one of them uses, marking them out_of_stock. Those that were
+// 86ed this way and can be made again are made available again. Items and
+// options turned off by hand are left alone.
+func refreshRecipeAvailability(ctx context.Context, tx *ent.Tx, menuItemIDs []int64, optionIDs []uuid.UUID) error {
+	if len(menuItemIDs) > 0 {
+		lines, err := tx.RecipeIngredient.Query().
+			Where(recipeingredient.MenuItemIDIn(menuItemIDs...)).
+			WithIngredient().
+			All(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to get recipes: %w", err)
+		}
+		soldOut := make(map[int64]bool)
+		for _, line := range lines {
+			if line.Quantity > line.Edges.Ingredient.OnHand {
+				soldOut[*line.MenuItemID] = true
+			}
+		}
+		out, in := partitionBy(menuItemIDs, soldOut)
+
+		if len(out) > 0 {
+			err = tx.MenuItem.Update().
+				Where(menuitem.IDIn(out...), menuitem.IsAvailable(true)).
+				SetIsAvailable(false).
+				SetOutOfStock(true).
+				Exec(ctx)
+			if err != nil {
+				return fmt.Errorf("failed to 86 menu items: %w", err)
+			}
+		}
+		if len(in) > 0 {
+			err = tx.MenuItem.Update().
+				Where(menuitem.IDIn(in...), menuitem.OutOfStock(true)).
+				SetIsAvailable(true).
+				SetOutOfStock(false).
+				Exec(ctx)
+			if err != nil {
+				return fmt.Errorf("failed to restock menu items: %w", err)
+			}
+		}
+	}
+
+	if len(optionIDs) > 0 {
+		lines, err := tx.RecipeIngredient.Query().
+			Where(recipeingredient.ModifierOptionIDIn(optionIDs...)).
+			WithIngredient().
+			All(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to get recipes: %w", err)
+		}
+		soldOut := make(map[uuid.UUID]bool)
+		for _, line := range lines {
+			if line.Quantity > line.Edges.Ingredient.OnHand {
+				soldOut[*line.ModifierOptionID] = true
+			}
+		}
+		out, in := partitionBy(optionIDs, soldOut)
+
+		if len(out) > 0 {
+			err = tx.ModifierOption.Update().
+				Where(modifieroption.IDIn(out...), modifieroption.Available(true)).
+				SetAvailable(false).
+				SetOutOfStock(true).
+				Exec(ctx)
+			if err != nil {
+				return fmt.Errorf("failed to 86 modifier options: %w", err)
+			}
+		}
+		if len(in) > 0 {
+			err = tx.ModifierOption.Update().
+				Where(modifieroption.IDIn(in...), modifieroption.OutOfStock(true)).
+				SetAvailable(true).
+				SetOutOfStock(false).
+				Exec(ctx)
+			if err != nil {
+				return fmt.Errorf("failed to restock modifier options: %w", err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// partitionBy splits ids into those set in marked and the rest.
+func partitionBy[K comparable](ids []K, marked map[K]bool) (in, out []K) {
+	for _, id := range ids {
+		if marked[id] {
+			in = append(in, id)
+		} else {
+			out = append(out, id)
+		}
+	}
+	return in, out
+}
+
+// addOptionUnits adds how many of each option mods use to units, each
+// chosen times times, following the options chosen within them.
+func addOptionUnits(units map[uuid.UUID]int64, mods []ModifierItemData, times int64) {
+	for _, mod := range mods {
+		n := int64(mod.Quantity) * times
+		units[mod.ModifierOptionID] += n
+		addOptionUnits(units, mod.ModifierOptions, n)
+	}
+}
@@ -0,0 +1,282 @@
+package repos
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+	"strings"
+
+	"github.com/Jiruu246/rms/internal/apperr"
+	"github.com/Jiruu246/rms/internal/authz"
+	"github.com/Jiruu246/rms/internal/dto"
+	"github.com/Jiruu246/rms/internal/ent"
+	"github.com/Jiruu246/rms/internal/ent/predicate"
+	"github.com/Jiruu246/rms/internal/ent/restaurant"
+	"github.com/Jiruu246/rms/internal/ent/table"
+	"github.com/Jiruu246/rms/internal/ent/tablesession"
+	"github.com/google/uuid"
+)
+
+type TableRepository interface {
+	Create(ctx context.Context, req *dto.CreateTableRequest) (*dto.Table, error)
+	GetByID(ctx context.Context, restaurantID, id uuid.UUID) (*dto.Table, error)
+	// GetByQRToken returns apperr.NotFound for unknown tokens, including
+	// ones that have been rotated away.
+	GetByQRToken(ctx context.Context, token string) (*dto.Table, error)
+	GetAllByRestaurant(ctx context.Context, restaurantID uuid.UUID) ([]*dto.Table, error)
+	Update(ctx context.Context, restaurantID, id uuid.UUID, req *dto.UpdateTableRequest) (*dto.Table, error)
+	// Delete refuses to delete a table with an OPEN session. Closed
+	// sessions go with the table; their orders are kept.
+	Delete(ctx context.Context, restaurantID, id uuid.UUID) error
+	// RotateQRToken gives the table a new QR token, invalidating the old one.
+	RotateQRToken(ctx context.Context, restaurantID, id uuid.UUID) (*dto.Table, error)
+	GetAuthorizationResource(ctx context.Context, id uuid.UUID) (authz.Resource, error)
+}
+
+type tableRepository struct {
+	client *ent.Client
+}
+
+func NewEntTableRepository(client *ent.Client) TableRepository {
+	return &tableRepository{client: client}
+}
+
+func (r *tableRepository) Create(ctx context.Context, req *dto.CreateTableRequest) (*dto.Table, error) {
+	token, err := newTableToken()
+	if err != nil {
+		return nil, err
+	}
+
+	created, err := r.client.Table.
+		Create().
+		SetName(req.Name).
+		SetSection(req.Section).
+		SetSeats(req.Seats).
+		SetQrToken(token).
+		SetRestaurantID(req.RestaurantID).
+		Save(ctx)
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return nil, apperr.Conflict("a table named %s already exists", req.Name)
+		}
+		return nil, fmt.Errorf("failed to create table: %w", err)
+	}
+
+	return mapToTable(created), nil
+}
+
+func (r *tableRepository) GetByID(ctx context.Context, restaurantID, id uuid.UUID) (*dto.Table, error) {
+	t, err := r.client.Table.
+		Query().
+		Where(table.ID(id), table.RestaurantIDEQ(restaurantID)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, apperr.NotFound("table %s", id)
+		}
+		return nil, fmt.Errorf("failed to get table: %w", err)
+	}
+
+	return mapToTable(t), nil
+}
+
+func (r *tableRepository) GetByQRToken(ctx context.Context, token string) (*dto.Table, error) {
+	t, err := r.client.Table.
+		Query().
+		Where(table.QrToken(token)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, apperr.NotFound("table for token")
+		}
+		return nil, fmt.Errorf("failed to get table: %w", err)
+	}
+
+	return mapToTable(t), nil
+}
+
+func (r *tableRepository) GetAllByRestaurant(ctx context.Context, restaurantID uuid.UUID) ([]*dto.Table, error) {
+	rows, err := r.client.Table.
+		Query().
+		Where(table.RestaurantIDEQ(restaurantID)).
+		Order(table.BySection(), table.ByName()).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get tables: %w", err)
+	}
+
+	tables := make([]*dto.Table, 0, len(rows))
+	for _, t := range rows {
+		tables = append(tables, mapToTable(t))
+	}
+	return tables, nil
+}
+
+func (r *tableRepository) Update(ctx context.Context, restaurantID, id uuid.UUID, req *dto.UpdateTableRequest) (*dto.Table, error) {
+	update := r.client.Table.UpdateOneID(id).Where(table.RestaurantIDEQ(restaurantID))
+
+	hasUpdates := false
+
+	if req.Name != nil {
+		if strings.TrimSpace(*req.Name) == "" {
+			return nil, apperr.Invalid("name cannot be empty")
+		}
+		update.SetName(*req.Name)
+		hasUpdates = true
+	}
+
+	if req.Section != nil {
+		update.SetSection(*req.Section)
+		hasUpdates = true
+	}
+
+	if req.Seats != nil {
+		update.SetSeats(*req.Seats)
+		hasUpdates = true
+	}
+
+	if req.IsActive != nil {
+		update.SetIsActive(*req.IsActive)
+		hasUpdates = true
+	}
+
+	if !hasUpdates {
+		return nil, apperr.Invalid("no valid fields provided for update")
+	}
+
+	updated, err := update.Save(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, apperr.NotFound("table %s", id)
+		}
+		if ent.IsConstraintError(err) {
+			return nil, apperr.Conflict("a table named %s already exists", *req.Name)
+		}
+		return nil, fmt.Errorf("failed to update table: %w", err)
+	}
+
+	return mapToTable(updated), nil
+}
+
+func (r *tableRepository) Delete(ctx context.Context, restaurantID, id uuid.UUID) error {
+	tx, err := r.client.Tx(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+
+	// Lock the table so that no order can open a session while it is
+	// being deleted; see joinTableSession.
+	if err = lockTable(ctx, tx, restaurantID, id); err != nil {
+		if ent.IsNotFound(err) {
+			err = apperr.NotFound("table %s", id)
+			return err
+		}
+		return fmt.Errorf("failed to lock table: %w", err)
+	}
+
+	var open bool
+	open, err = tx.TableSession.Query().
+		Where(tablesession.TableIDEQ(id), tablesession.StatusEQ(tablesession.StatusOPEN)).
+		Exist(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get table sessions: %w", err)
+	}
+	if open {
+		err = apperr.Conflict("table %s has an open session", id)
+		return err
+	}
+
+	if err = tx.Table.DeleteOneID(id).Exec(ctx); err != nil {
+		return fmt.Errorf("failed to delete table: %w", err)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return nil
+}
+
+func (r *tableRepository) RotateQRToken(ctx context.Context, restaurantID, id uuid.UUID) (*dto.Table, error) {
+	token, err := newTableToken()
+	if err != nil {
+		return nil, err
+	}
+
+	updated, err := r.client.Table.
+		UpdateOneID(id).
+		Where(table.RestaurantIDEQ(restaurantID)).
+		SetQrToken(token).
+		Save(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, apperr.NotFound("table %s", id)
+		}
+		return nil, fmt.Errorf("failed to rotate table QR token: %w", err)
+	}
+
+	return mapToTable(updated), nil
+}
+
+// GetAuthorizationResource resolves the authz.Resource for a table by
+// joining through restaurant_id to the owning restaurant, in a single query.
+func (r *tableRepository) GetAuthorizationResource(ctx context.Context, id uuid.UUID) (authz.Resource, error) {
+	rest, err := r.client.Table.
+		Query().
+		Where(table.ID(id)).
+		QueryRestaurant().
+		Select(restaurant.FieldID, restaurant.FieldUserID).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return authz.Resource{}, apperr.NotFound("table %s", id)
+		}
+		return authz.Resource{}, fmt.Errorf("failed to get table: %w", err)
+	}
+
+	return authz.Resource{
+		Type:         "table",
+		ID:           id,
+		RestaurantID: rest.ID,
+		OwnerUserID:  rest.UserID,
+	}, nil
+}
+
+// lockTable takes a row lock on the table for the rest of tx by touching
+// its update_time. Everything that opens, closes or removes a table's
+// sessions goes through it first, which serialises them per table.
+func lockTable(ctx context.Context, tx *ent.Tx, restaurantID, id uuid.UUID, ps ...predicate.Table) error {
+	return tx.Table.
+		UpdateOneID(id).
+		Where(table.RestaurantIDEQ(restaurantID)).
+		Where(ps...).
+		Exec(ctx)
+}
+
+// newTableToken returns a random, URL-safe QR token.
+func newTableToken() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("failed to generate table token: %w", err)
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+func mapToTable(t *ent.Table) *dto.Table {
+	return &dto.Table{
+		ID:           t.ID,
+		Name:         t.Name,
+		Section:      t.Section,
+		Seats:        t.Seats,
+		IsActive:     t.IsActive,
+		QRToken:      t.QrToken,
+		RestaurantID: t.RestaurantID,
+		CreatedAt:    t.CreateTime,
+		UpdatedAt:    t.UpdateTime,
+	}
+}
@@ -0,0 +1,240 @@
+package repos
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Jiruu246/rms/internal/apperr"
+	"github.com/Jiruu246/rms/internal/authz"
+	"github.com/Jiruu246/rms/internal/dto"
+	"github.com/Jiruu246/rms/internal/ent"
+	"github.com/Jiruu246/rms/internal/ent/order"
+	"github.com/Jiruu246/rms/internal/ent/restaurant"
+	"github.com/Jiruu246/rms/internal/ent/table"
+	"github.com/Jiruu246/rms/internal/ent/tablesession"
+	"github.com/Jiruu246/rms/pkg/money"
+	"github.com/google/uuid"
+)
+
+type TableSessionRepository interface {
+	GetByID(ctx context.Context, restaurantID, id uuid.UUID) (*dto.TableSession, error)
+	// GetOpenByTable returns the table's OPEN session, or apperr.NotFound if
+	// nobody is seated at it.
+	GetOpenByTable(ctx context.Context, restaurantID, tableID uuid.UUID) (*dto.TableSession, error)
+	// Close closes an OPEN session. It fails with apperr.Conflict if the
+	// session is already closed or any of its orders is still unpaid.
+	Close(ctx context.Context, restaurantID, id, closedBy uuid.UUID) (*dto.TableSession, error)
+	GetAuthorizationResource(ctx context.Context, id uuid.UUID) (authz.Resource, error)
+}
+
+type tableSessionRepository struct {
+	client *ent.Client
+}
+
+func NewEntTableSessionRepository(client *ent.Client) TableSessionRepository {
+	return &tableSessionRepository{client: client}
+}
+
+func (r *tableSessionRepository) GetByID(ctx context.Context, restaurantID, id uuid.UUID) (*dto.TableSession, error) {
+	s, err := r.query().
+		Where(tablesession.ID(id), tablesession.RestaurantIDEQ(restaurantID)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, apperr.NotFound("table session %s", id)
+		}
+		return nil, fmt.Errorf("failed to get table session: %w", err)
+	}
+
+	return mapToTableSession(s), nil
+}
+
+func (r *tableSessionRepository) GetOpenByTable(ctx context.Context, restaurantID, tableID uuid.UUID) (*dto.TableSession, error) {
+	s, err := r.query().
+		Where(
+			tablesession.TableIDEQ(tableID),
+			tablesession.RestaurantIDEQ(restaurantID),
+			tablesession.StatusEQ(tablesession.StatusOPEN),
+		).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, apperr.NotFound("open session for table %s", tableID)
+		}
+		return nil, fmt.Errorf("failed to get table session: %w", err)
+	}
+
+	return mapToTableSession(s), nil
+}
+
+func (r *tableSessionRepository) Close(ctx context.Context, restaurantID, id, closedBy uuid.UUID) (*dto.TableSession, error) {
+	tx, err := r.client.Tx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to start transaction: %w", err)
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+
+	var s *ent.TableSession
+	s, err = tx.TableSession.Query().
+		Where(tablesession.ID(id), tablesession.RestaurantIDEQ(restaurantID)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			err = apperr.NotFound("table session %s", id)
+			return nil, err
+		}
+		return nil, fmt.Errorf("failed to get table session: %w", err)
+	}
+
+	// Orders join a session under the same lock, so none can be added
+	// between the check below and closing.
+	if err = lockTable(ctx, tx, restaurantID, s.TableID); err != nil {
+		return nil, fmt.Errorf("failed to lock table: %w", err)
+	}
+
+	var unpaid int
+	unpaid, err = tx.Order.Query().
+		Where(
+			order.TableSessionIDEQ(id),
+			order.OrderStatusNEQ(order.OrderStatusCANCELLED),
+			order.PaymentStatusNotIn(order.PaymentStatusPAID, order.PaymentStatusREFUNDED),
+			order.TotalGT(0),
+		).
+		Count(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get session orders: %w", err)
+	}
+	if unpaid > 0 {
+		err = apperr.Conflict("table session %s has %d unpaid order(s)", id, unpaid)
+		return nil, err
+	}
+
+	update := tx.TableSession.UpdateOneID(id).
+		Where(tablesession.StatusEQ(tablesession.StatusOPEN)).
+		SetStatus(tablesession.StatusCLOSED).
+		SetClosedAt(time.Now())
+	if closedBy != uuid.Nil {
+		update.SetClosedByID(closedBy)
+	}
+	if err = update.Exec(ctx); err != nil {
+		if ent.IsNotFound(err) {
+			err = apperr.Conflict("table session %s is already closed", id)
+			return nil, err
+		}
+		return nil, fmt.Errorf("failed to close table session: %w", err)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return r.GetByID(ctx, restaurantID, id)
+}
+
+// GetAuthorizationResource resolves the authz.Resource for a table session
+// by joining through restaurant_id to the owning restaurant, in a single
+// query.
+func (r *tableSessionRepository) GetAuthorizationResource(ctx context.Context, id uuid.UUID) (authz.Resource, error) {
+	rest, err := r.client.TableSession.
+		Query().
+		Where(tablesession.ID(id)).
+		QueryRestaurant().
+		Select(restaurant.FieldID, restaurant.FieldUserID).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return authz.Resource{}, apperr.NotFound("table session %s", id)
+		}
+		return authz.Resource{}, fmt.Errorf("failed to get table session: %w", err)
+	}
+
+	return authz.Resource{
+		Type:         "table_session",
+		ID:           id,
+		RestaurantID: rest.ID,
+		OwnerUserID:  rest.UserID,
+	}, nil
+}
+
+// query loads a session with everything mapToTableSession needs.
+func (r *tableSessionRepository) query() *ent.TableSessionQuery {
+	return r.client.TableSession.
+		Query().
+		WithRestaurant(func(q *ent.RestaurantQuery) {
+			q.Select(restaurant.FieldID, restaurant.FieldCurrency)
+		}).
+		WithOrders(func(q *ent.OrderQuery) {
+			WithOrderItems(WithOrderItemModifierOptions())(q)
+			q.Order(order.ByOrderNumberPeriod(), order.ByOrderNumber())
+		})
+}
+
+// joinTableSession returns the OPEN session of the table an order is being
+// placed at, opening one if there is none. It runs inside the order's
+// transaction and locks the table first, so concurrent orders at the same
+// table end up in the same session. Inactive or unknown tables are
+// rejected with apperr.Invalid.
+func joinTableSession(ctx context.Context, tx *ent.Tx, restaurantID, tableID uuid.UUID) (uuid.UUID, error) {
+	if err := lockTable(ctx, tx, restaurantID, tableID, table.IsActive(true)); err != nil {
+		if ent.IsNotFound(err) {
+			return uuid.Nil, apperr.Invalid("table %s is not taking orders", tableID)
+		}
+		return uuid.Nil, fmt.Errorf("failed to lock table: %w", err)
+	}
+
+	id, err := tx.TableSession.Query().
+		Where(tablesession.TableIDEQ(tableID), tablesession.StatusEQ(tablesession.StatusOPEN)).
+		OnlyID(ctx)
+	if err == nil {
+		return id, nil
+	}
+	if !ent.IsNotFound(err) {
+		return uuid.Nil, fmt.Errorf("failed to get table session: %w", err)
+	}
+
+	created, err := tx.TableSession.Create().
+		SetTableID(tableID).
+		SetRestaurantID(restaurantID).
+		Save(ctx)
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("failed to open table session: %w", err)
+	}
+	return created.ID, nil
+}
+
+func mapToTableSession(s *ent.TableSession) *dto.TableSession {
+	var currency money.Currency
+	if s.Edges.Restaurant != nil {
+		currency = money.Currency(s.Edges.Restaurant.Currency)
+	}
+
+	response := &dto.TableSession{
+		ID:           s.ID,
+		Status:       dto.TableSessionStatus(s.Status),
+		TableID:      s.TableID,
+		RestaurantID: s.RestaurantID,
+		Orders:       []dto.Order{},
+		Currency:     currency,
+		CreatedAt:    s.CreateTime,
+		ClosedAt:     s.ClosedAt,
+		ClosedBy:     s.ClosedByID,
+	}
+
+	var total, paid int64
+	for _, o := range s.Edges.Orders {
+		response.Orders = append(response.Orders, *mapToOrderResponse(o))
+		if o.OrderStatus == order.OrderStatusCANCELLED {
+			continue
+		}
+		total += o.Total
+		paid += o.AmountPaid
+	}
+	response.Total = money.New(total, currency)
+	response.AmountPaid = money.New(paid, currency)
+	response.Balance = money.New(max(total-paid, 0), currency)
+	return response
+}
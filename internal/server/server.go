@@ -4,14 +4,20 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"os"
+	"strings"
 	"time"
 
+	"github.com/Jiruu246/rms/internal/blobs"
 	"github.com/Jiruu246/rms/internal/config"
 	"github.com/Jiruu246/rms/internal/cookies"
 	"github.com/Jiruu246/rms/internal/ent"
 	"github.com/Jiruu246/rms/internal/handler"
+	"github.com/Jiruu246/rms/internal/middlewares"
+	"github.com/Jiruu246/rms/internal/payments"
 	"github.com/Jiruu246/rms/internal/repos"
 	"github.com/Jiruu246/rms/internal/services"
+	"github.com/Jiruu246/rms/pkg/ratelimit"
 	"github.com/gin-gonic/gin"
 	swaggerFiles "github.com/swaggo/files"
 	ginSwagger "github.com/swaggo/gin-swagger"
@@ -28,6 +34,12 @@ type Server struct {
 	srv           *http.Server
 	middlewares   Middlewares
 	cookieFactory *cookies.Factory
+	orderService  services.OrderService
+	idempotency   repos.IdempotencyRepository
+	rateLimits    repos.RateLimitRepository
+	// jobs is cancelled on Shutdown to stop the background jobs.
+	jobs     context.Context
+	stopJobs context.CancelFunc
 }
 
 func New(cfg *config.Config, client *ent.Client, middlewares Middlewares) *Server {
@@ -38,6 +50,10 @@ func New(cfg *config.Config, client *ent.Client, middlewares Middlewares) *Serve
 	cookieFactory := cookies.NewFactory(cfg.CookieConfig)
 
 	engine := gin.New()
+	if err := engine.SetTrustedProxies(cfg.TrustedProxies); err != nil {
+		fmt.Fprintf(os.Stderr, "invalid trusted proxies, trusting none: %v\n", err)
+		_ = engine.SetTrustedProxies(nil)
+	}
 	engine.Use(gin.Recovery())
 	engine.Use(gin.Logger())
 
@@ -50,6 +66,7 @@ func New(cfg *config.Config, client *ent.Client, middlewares Middlewares) *Serve
 		middlewares:   middlewares,
 		cookieFactory: cookieFactory,
 	}
+	s.jobs, s.stopJobs = context.WithCancel(context.Background())
 
 	s.routes()
 
@@ -67,6 +84,12 @@ func (s *Server) routes() {
 		s.engine.GET("/swagger/*any", ginSwagger.WrapHandler(swaggerFiles.Handler))
 	}
 
+	// Uploaded images in a local store are served from BaseURL unless it
+	// points elsewhere, e.g. at a CDN in front of the directory.
+	if blobCfg := s.cfg.BlobConfig; blobCfg.Store == config.BlobStoreLocal && strings.HasPrefix(blobCfg.BaseURL, "/") {
+		s.engine.Static(blobCfg.BaseURL, blobCfg.LocalDir)
+	}
+
 	// initialize repositories
 	categoryRepo := repos.NewEntCategoryRepository(s.client)
 	userRepo := repos.NewEntUserRepository(s.client)
@@ -76,16 +99,42 @@ func (s *Server) routes() {
 	modifierRepo := repos.NewEntModifierRepository(s.client)
 	modifierOptionRepo := repos.NewEntModifierOptionRepository(s.client)
 	orderRepo := repos.NewEntOrderRepository(s.client)
+	paymentRepo := repos.NewEntPaymentRepository(s.client)
+	refundRepo := repos.NewEntRefundRepository(s.client)
+	orderEventRepo := repos.NewEntOrderEventRepository(s.client)
+	stationRepo := repos.NewEntStationRepository(s.client)
+	stationTicketRepo := repos.NewEntStationTicketRepository(s.client)
+	tableRepo := repos.NewEntTableRepository(s.client)
+	tableSessionRepo := repos.NewEntTableSessionRepository(s.client)
+	deliveryZoneRepo := repos.NewEntDeliveryZoneRepository(s.client)
+	idempotencyRepo := repos.NewEntIdempotencyRepository(s.client)
+	ingredientRepo := repos.NewEntIngredientRepository(s.client)
+	menuRepo := repos.NewEntMenuRepository(s.client)
+	catalogueRepo := repos.NewEntCatalogueRepository(s.client)
+
+	blobStore := blobs.New(s.cfg.BlobConfig)
 
 	// initialize services
-	restaurantService := services.NewRestaurantService(restaurantRepo)
+	restaurantService := services.NewRestaurantService(restaurantRepo, blobStore)
 	categoryService := services.NewCategoryService(categoryRepo, restaurantService)
 	authService := services.NewAuthService(s.cfg.AuthConfig, userRepo, refreshTokenRepo)
 	userService := services.NewUserService(userRepo)
-	menuItemService := services.NewMenuItemService(menuitemRepo)
-	modifierService := services.NewModifierService(modifierRepo)
-	modifierOptionService := services.NewModifierOptionService(modifierOptionRepo)
-	orderService := services.NewOrderService(orderRepo, menuitemRepo, modifierOptionRepo)
+	menuItemService := services.NewMenuItemService(menuitemRepo, restaurantService, blobStore)
+	modifierService := services.NewModifierService(modifierRepo, restaurantService)
+	modifierOptionService := services.NewModifierOptionService(modifierOptionRepo, modifierRepo, restaurantService, blobStore)
+	orderService := services.NewOrderService(orderRepo, menuitemRepo, modifierOptionRepo, restaurantRepo, tableRepo, deliveryZoneRepo, menuRepo)
+	paymentProviders := payments.NewDefaultProviders(s.cfg.UseFakeCardPayments())
+	paymentService := services.NewPaymentService(paymentRepo, orderRepo, paymentProviders)
+	refundService := services.NewRefundService(refundRepo, paymentRepo, orderRepo, paymentProviders)
+	orderEventService := services.NewOrderEventService(orderEventRepo, stationRepo, restaurantService)
+	stationService := services.NewStationService(stationRepo, stationTicketRepo, restaurantService)
+	tableService := services.NewTableService(tableRepo, tableSessionRepo, restaurantService)
+	deliveryZoneService := services.NewDeliveryZoneService(deliveryZoneRepo, restaurantService)
+	slotService := services.NewSlotService(restaurantRepo, orderRepo)
+	ingredientService := services.NewIngredientService(ingredientRepo, restaurantService)
+	menuService := services.NewMenuService(menuRepo, restaurantService)
+	catalogueService := services.NewCatalogueService(catalogueRepo, restaurantService, blobStore)
+	imageService := services.NewImageService(blobStore, s.cfg.BlobConfig.MaxUploadBytes, menuitemRepo, modifierOptionRepo, restaurantRepo, restaurantService)
 
 	// initialize handlers
 	categoryHandler := handler.NewCategoryHandler(categoryService)
@@ -96,21 +145,67 @@ func (s *Server) routes() {
 	modifierHandler := handler.NewModifierHandler(modifierService)
 	modifierOptionHandler := handler.NewModifierOptionHandler(modifierOptionService)
 	orderHandler := handler.NewOrderHandler(orderService)
+	paymentHandler := handler.NewPaymentHandler(paymentService)
+	refundHandler := handler.NewRefundHandler(refundService)
+	orderEventHandler := handler.NewOrderEventHandler(orderEventService)
+	stationHandler := handler.NewStationHandler(stationService)
+	tableHandler := handler.NewTableHandler(tableService)
+	deliveryZoneHandler := handler.NewDeliveryZoneHandler(deliveryZoneService)
+	slotHandler := handler.NewSlotHandler(slotService)
+	ingredientHandler := handler.NewIngredientHandler(ingredientService)
+	menuHandler := handler.NewMenuHandler(menuService)
+	catalogueHandler := handler.NewCatalogueHandler(catalogueService)
+	imageHandler := handler.NewImageHandler(imageService, s.cfg.BlobConfig.MaxUploadBytes)
+
+	s.orderService = orderService
+	s.idempotency = idempotencyRepo
 
 	JwtMiddleware := s.middlewares.JWTMiddleware([]byte(s.cfg.AuthConfig.JwtSecret))
 
+	// Order creation is retried by clients on flaky connections; an
+	// Idempotency-Key is unique per restaurant.
+	orderRestaurant := middlewares.OrderRestaurantScope(tableRepo)
+	idempotencyConfig := middlewares.DefaultIdempotencyConfig()
+	if s.cfg.IdempotencyTTL > 0 {
+		idempotencyConfig.TTL = s.cfg.IdempotencyTTL
+	}
+	idempotencyConfig.Scope = orderRestaurant
+	idempotentOrder := middlewares.Idempotency(idempotencyRepo, idempotencyConfig)
+
+	// Unauthenticated endpoints are rate limited against abuse.
+	var rateLimitStore ratelimit.Store = ratelimit.NewMemoryStore()
+	if s.cfg.RateLimitConfig.Store == config.RateLimitStorePostgres {
+		s.rateLimits = repos.NewEntRateLimitRepository(s.client)
+		rateLimitStore = s.rateLimits
+	}
+	limits := s.cfg.RateLimitConfig
+	// Per-IP rules come first, so an IP over its limit stops there and
+	// doesn't use up the shared restaurant or email bucket.
+	publicOrderRateLimit := middlewares.RateLimit(rateLimitStore,
+		middlewares.RateLimitRule{Name: "order:ip", Limit: limits.PublicOrderPerIP, Key: middlewares.ByIP},
+		middlewares.RateLimitRule{Name: "order:restaurant", Limit: limits.PublicOrderPerRestaurant, Key: orderRestaurant},
+	)
+	loginRateLimit := middlewares.RateLimit(rateLimitStore,
+		middlewares.RateLimitRule{Name: "login:ip", Limit: limits.LoginPerIP, Key: middlewares.ByIP},
+		middlewares.RateLimitRule{Name: "login:email", Limit: limits.LoginPerEmail, Key: middlewares.ByJSONField("email")},
+	)
+
 	api := s.engine.Group("/api")
 	{
 		//TODO: Not a great pattern, refactor later
 		public := api.Group("/public")
 		{
-			public.POST("/order", orderHandler.CreateOrderPub)
+			public.POST("/order", publicOrderRateLimit, idempotentOrder, orderHandler.CreateOrderPub)
+			public.GET("/tables/:token", tableHandler.GetPublicTable)
+			public.GET("/restaurants/:id/open-status", restaurantHandler.GetPublicOpenStatus)
+			public.GET("/restaurants/:id/slots", slotHandler.GetPublicSlots)
+			public.GET("/restaurants/:id/menu", menuHandler.GetPublicMenu)
 		}
 
 		auth := api.Group("/auth")
 		{
 			auth.POST("/register", authHandler.Register)
-			auth.POST("/login", authHandler.Login)
+			auth.POST("/login", loginRateLimit, authHandler.Login)
 			auth.POST("/refresh", authHandler.Refresh)
 			auth.POST("/logout", authHandler.Logout)
 		}
@@ -127,6 +222,18 @@ func (s *Server) routes() {
 			categories.DELETE("/:id", categoryHandler.DeleteCategory)
 		}
 
+		menus := api.Group("/menus")
+		{
+			menus.Use(JwtMiddleware)
+
+			menus.POST("", menuHandler.CreateMenu)
+			menus.GET("", menuHandler.GetMenus)
+			menus.GET("/:id", menuHandler.GetMenu)
+			menus.PATCH("/:id", menuHandler.UpdateMenu)
+			menus.DELETE("/:id", menuHandler.DeleteMenu)
+			menus.PUT("/:id/contents", menuHandler.SetMenuContents)
+		}
+
 		users := api.Group("/users")
 		users.Use(JwtMiddleware)
 		{
@@ -144,6 +251,10 @@ func (s *Server) routes() {
 			restaurants.GET("/:id", restaurantHandler.GetRestaurant)
 			restaurants.PATCH("/:id", restaurantHandler.UpdateRestaurant)
 			restaurants.DELETE("/:id", restaurantHandler.DeleteRestaurant)
+			restaurants.GET("/:id/catalogue", catalogueHandler.ExportCatalogue)
+			restaurants.POST("/:id/catalogue", catalogueHandler.ImportCatalogue)
+			restaurants.PUT("/:id/logo", imageHandler.SetRestaurantLogo)
+			restaurants.DELETE("/:id/logo", imageHandler.DeleteRestaurantLogo)
 		}
 
 		menuItems := api.Group("/menu-items")
@@ -156,6 +267,16 @@ func (s *Server) routes() {
 			menuItems.GET("/:id", menuItemHandler.GetMenuItem)
 			menuItems.PATCH("/:id", menuItemHandler.UpdateMenuItem)
 			menuItems.DELETE("/:id", menuItemHandler.DeleteMenuItem)
+			menuItems.PUT("/:id/variants", menuItemHandler.SetMenuItemVariants)
+			menuItems.GET("/:id/modifiers", menuItemHandler.GetMenuItemModifiers)
+			menuItems.POST("/:id/modifiers", menuItemHandler.AttachMenuItemModifier)
+			menuItems.PUT("/:id/modifiers", menuItemHandler.ReorderMenuItemModifiers)
+			menuItems.PUT("/:id/modifiers/:modifierId", menuItemHandler.SetMenuItemModifierOverrides)
+			menuItems.DELETE("/:id/modifiers/:modifierId", menuItemHandler.DetachMenuItemModifier)
+			menuItems.GET("/:id/recipe", ingredientHandler.GetMenuItemRecipe)
+			menuItems.PUT("/:id/recipe", ingredientHandler.SetMenuItemRecipe)
+			menuItems.PUT("/:id/image", imageHandler.SetMenuItemImage)
+			menuItems.DELETE("/:id/image", imageHandler.DeleteMenuItemImage)
 		}
 
 		modifiers := api.Group("/modifiers")
@@ -177,6 +298,10 @@ func (s *Server) routes() {
 				options.GET(":id", modifierOptionHandler.GetModifierOption)
 				options.PATCH(":id", modifierOptionHandler.UpdateModifierOption)
 				options.DELETE(":id", modifierOptionHandler.DeleteModifierOption)
+				options.GET(":id/recipe", ingredientHandler.GetModifierOptionRecipe)
+				options.PUT(":id/recipe", ingredientHandler.SetModifierOptionRecipe)
+				options.PUT(":id/image", imageHandler.SetModifierOptionImage)
+				options.DELETE(":id/image", imageHandler.DeleteModifierOptionImage)
 			}
 		}
 
@@ -184,23 +309,158 @@ func (s *Server) routes() {
 		{
 			orders.Use(JwtMiddleware)
 
-			orders.POST("", orderHandler.CreateOrderPub)
+			orders.POST("", idempotentOrder, orderHandler.CreateOrderPub)
+			orders.GET("/stream", orderEventHandler.StreamOrders)
 			orders.GET("/:id", orderHandler.GetOrder)
+			orders.GET("/:id/history", orderHandler.GetOrderHistory)
+			orders.POST("/:id/items", orderHandler.AddOrderItems)
+			orders.PATCH("/:id/items/:itemId", orderHandler.UpdateOrderItem)
+			orders.POST("/:id/items/:itemId/void", orderHandler.VoidOrderItem)
+			orders.GET("/:id/item-changes", orderHandler.GetOrderItemChanges)
+			orders.POST("/:id/payments", paymentHandler.CreatePayment)
+			orders.GET("/:id/payments", paymentHandler.GetPayments)
+			orders.POST("/:id/refunds", refundHandler.CreateRefund)
+			orders.GET("/:id/refunds", refundHandler.GetRefunds)
 			orders.GET("", orderHandler.GetOrders)
 			orders.PATCH("/:id", orderHandler.UpdateOrder)
 			orders.DELETE("/:id", orderHandler.DeleteOrder)
 		}
+
+		stations := api.Group("/stations")
+		{
+			stations.Use(JwtMiddleware)
+
+			stations.POST("", stationHandler.CreateStation)
+			stations.GET("", stationHandler.GetStations)
+			stations.GET("/:id", stationHandler.GetStation)
+			stations.PATCH("/:id", stationHandler.UpdateStation)
+			stations.DELETE("/:id", stationHandler.DeleteStation)
+			stations.PUT("/:id/routing", stationHandler.SetStationRouting)
+			stations.GET("/:id/tickets", stationHandler.GetStationTickets)
+			stations.GET("/:id/stream", orderEventHandler.StreamStation)
+		}
+
+		stationTickets := api.Group("/station-tickets")
+		{
+			stationTickets.Use(JwtMiddleware)
+
+			stationTickets.PATCH("/:id", stationHandler.UpdateStationTicket)
+		}
+
+		tables := api.Group("/tables")
+		{
+			tables.Use(JwtMiddleware)
+
+			tables.POST("", tableHandler.CreateTable)
+			tables.GET("", tableHandler.GetTables)
+			tables.GET("/:id", tableHandler.GetTable)
+			tables.PATCH("/:id", tableHandler.UpdateTable)
+			tables.DELETE("/:id", tableHandler.DeleteTable)
+			tables.POST("/:id/qr-token", tableHandler.RotateTableQRToken)
+			tables.GET("/:id/session", tableHandler.GetOpenTableSession)
+		}
+
+		tableSessions := api.Group("/table-sessions")
+		{
+			tableSessions.Use(JwtMiddleware)
+
+			tableSessions.GET("/:id", tableHandler.GetTableSession)
+			tableSessions.POST("/:id/close", tableHandler.CloseTableSession)
+		}
+
+		deliveryZones := api.Group("/delivery-zones")
+		{
+			deliveryZones.Use(JwtMiddleware)
+
+			deliveryZones.POST("", deliveryZoneHandler.CreateDeliveryZone)
+			deliveryZones.GET("", deliveryZoneHandler.GetDeliveryZones)
+			deliveryZones.GET("/:id", deliveryZoneHandler.GetDeliveryZone)
+			deliveryZones.PATCH("/:id", deliveryZoneHandler.UpdateDeliveryZone)
+			deliveryZones.DELETE("/:id", deliveryZoneHandler.DeleteDeliveryZone)
+		}
+
+		ingredients := api.Group("/ingredients")
+		{
+			ingredients.Use(JwtMiddleware)
+
+			ingredients.POST("", ingredientHandler.CreateIngredient)
+			ingredients.GET("", ingredientHandler.GetIngredients)
+			ingredients.GET("/:id", ingredientHandler.GetIngredient)
+			ingredients.PATCH("/:id", ingredientHandler.UpdateIngredient)
+			ingredients.DELETE("/:id", ingredientHandler.DeleteIngredient)
+			ingredients.POST("/:id/adjustments", ingredientHandler.AdjustStock)
+			ingredients.GET("/:id/movements", ingredientHandler.GetStockMovements)
+		}
 	}
 }
 
-// Start runs the HTTP server.
+// Start runs the HTTP server, along with the background jobs.
 func (s *Server) Start() error {
+	if s.cfg.OrderReleaseInterval > 0 {
+		go s.releaseScheduledOrders(s.cfg.OrderReleaseInterval)
+	}
+	go s.deleteExpiredIdempotencyKeys(time.Hour)
+	if s.rateLimits != nil {
+		go s.deleteExpiredRateLimits(time.Hour)
+	}
 	fmt.Printf("listening on %s\n", s.srv.Addr)
 	return s.srv.ListenAndServe()
 }
 
+// releaseScheduledOrders sends held scheduled orders to the kitchen once
+// their release time comes, checking every interval until Shutdown.
+func (s *Server) releaseScheduledOrders(interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-s.jobs.Done():
+			return
+		case <-ticker.C:
+			if _, err := s.orderService.ReleaseDueOrders(s.jobs); err != nil && s.jobs.Err() == nil {
+				fmt.Fprintf(os.Stderr, "failed to release scheduled orders: %v\n", err)
+			}
+		}
+	}
+}
+
+// deleteExpiredIdempotencyKeys deletes idempotency keys whose responses are
+// no longer replayed, every interval until Shutdown.
+func (s *Server) deleteExpiredIdempotencyKeys(interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-s.jobs.Done():
+			return
+		case now := <-ticker.C:
+			if _, err := s.idempotency.DeleteExpired(s.jobs, now); err != nil && s.jobs.Err() == nil {
+				fmt.Fprintf(os.Stderr, "failed to delete expired idempotency keys: %v\n", err)
+			}
+		}
+	}
+}
+
+// deleteExpiredRateLimits deletes rate limit buckets that are full again,
+// every interval until Shutdown.
+func (s *Server) deleteExpiredRateLimits(interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-s.jobs.Done():
+			return
+		case now := <-ticker.C:
+			if _, err := s.rateLimits.DeleteExpired(s.jobs, now); err != nil && s.jobs.Err() == nil {
+				fmt.Fprintf(os.Stderr, "failed to delete expired rate limit buckets: %v\n", err)
+			}
+		}
+	}
+}
+
 // Shutdown gracefully stops the server.
 func (s *Server) Shutdown(ctx context.Context) error {
+	s.stopJobs()
 	// allow in-flight requests a short time to finish
 	ctxTimeout, cancel := context.WithTimeout(ctx, 10*time.Second)
 	defer cancel()
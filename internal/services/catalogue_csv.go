@@ -0,0 +1,351 @@
+package services
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/Jiruu246/rms/internal/apperr"
+	"github.com/Jiruu246/rms/internal/dto"
+	"github.com/google/uuid"
+)
+
+// catalogueCSVColumns are the columns of the CSV catalogue format, one row
+// per category, modifier, option or item, told apart by type. Columns that
+// do not apply to a row's type are left empty; see docs/api.md.
+var catalogueCSVColumns = []string{
+	"type", "id", "key", "name", "description", "price", "image_url", "available",
+	"pre_select", "display_order", "category", "modifier", "modifiers", "required",
+	"multi_select", "max", "min", "free_quantity", "max_quantity",
+}
+
+// catalogueCSVListSeparator separates the modifier keys of an item's
+// modifiers cell.
+const catalogueCSVListSeparator = "|"
+
+// WriteCatalogueCSV writes c in the CSV catalogue format: categories, then
+// each modifier followed by its options, then items.
+func WriteCatalogueCSV(w io.Writer, c *dto.Catalogue) error {
+	cw := csv.NewWriter(w)
+	if err := cw.Write(catalogueCSVColumns); err != nil {
+		return err
+	}
+
+	write := func(cells map[string]string) error {
+		record := make([]string, len(catalogueCSVColumns))
+		for i, column := range catalogueCSVColumns {
+			record[i] = cells[column]
+		}
+		return cw.Write(record)
+	}
+
+	for _, cat := range c.Categories {
+		err := write(map[string]string{
+			"type":          string(dto.CatalogueCATEGORY),
+			"id":            formatOptionalUUID(cat.ID),
+			"key":           cat.Key,
+			"name":          cat.Name,
+			"description":   cat.Description,
+			"available":     formatOptionalBool(cat.IsActive),
+			"display_order": strconv.Itoa(cat.DisplayOrder),
+		})
+		if err != nil {
+			return err
+		}
+	}
+	for _, mod := range c.Modifiers {
+		err := write(map[string]string{
+			"type":          string(dto.CatalogueMODIFIER),
+			"id":            formatOptionalUUID(mod.ID),
+			"key":           mod.Key,
+			"name":          mod.Name,
+			"required":      strconv.FormatBool(mod.Required),
+			"multi_select":  strconv.FormatBool(mod.MultiSelect),
+			"max":           strconv.Itoa(mod.Max),
+			"min":           strconv.Itoa(mod.Min),
+			"free_quantity": strconv.Itoa(mod.FreeQuantity),
+		})
+		if err != nil {
+			return err
+		}
+		for _, opt := range mod.Options {
+			err := write(map[string]string{
+				"type":          string(dto.CatalogueOPTION),
+				"id":            formatOptionalUUID(opt.ID),
+				"key":           opt.Key,
+				"name":          opt.Name,
+				"price":         strconv.FormatInt(opt.Price, 10),
+				"image_url":     opt.ImageURL,
+				"available":     formatOptionalBool(opt.Available),
+				"pre_select":    strconv.FormatBool(opt.PreSelect),
+				"display_order": strconv.Itoa(opt.DisplayOrder),
+				"max_quantity":  strconv.Itoa(opt.MaxQuantity),
+				"modifier":      mod.Key,
+			})
+			if err != nil {
+				return err
+			}
+		}
+	}
+	for _, item := range c.Items {
+		id := ""
+		if item.ID != nil {
+			id = strconv.FormatInt(*item.ID, 10)
+		}
+		err := write(map[string]string{
+			"type":          string(dto.CatalogueITEM),
+			"id":            id,
+			"name":          item.Name,
+			"description":   item.Description,
+			"price":         strconv.FormatInt(item.Price, 10),
+			"image_url":     item.ImageURL,
+			"available":     formatOptionalBool(item.IsAvailable),
+			"display_order": strconv.Itoa(item.DisplayOrder),
+			"category":      item.Category,
+			"modifiers":     strings.Join(item.Modifiers, catalogueCSVListSeparator),
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	cw.Flush()
+	return cw.Error()
+}
+
+// ReadCatalogueCSV reads a catalogue in the CSV format. The header row
+// names the columns, in any order; only type and name are required. An
+// option row belongs to the modifier whose key (or name) is in its modifier
+// column. Errors are apperr.Invalid and name the offending line.
+func ReadCatalogueCSV(r io.Reader) (*dto.Catalogue, error) {
+	cr := csv.NewReader(r)
+	cr.FieldsPerRecord = -1
+
+	header, err := cr.Read()
+	if err != nil {
+		if errors.Is(err, io.EOF) {
+			return nil, apperr.Invalid("catalogue CSV is empty")
+		}
+		return nil, apperr.Invalid("invalid catalogue CSV: %v", err)
+	}
+	columns := make(map[string]int, len(header))
+	for i, name := range header {
+		name = strings.ToLower(strings.TrimSpace(name))
+		if !slices.Contains(catalogueCSVColumns, name) {
+			return nil, apperr.Invalid("catalogue CSV has unknown column %q", name)
+		}
+		columns[name] = i
+	}
+	for _, required := range []string{"type", "name"} {
+		if _, ok := columns[required]; !ok {
+			return nil, apperr.Invalid("catalogue CSV is missing the %s column", required)
+		}
+	}
+
+	c := &dto.Catalogue{Version: dto.CatalogueVersion}
+	type optionRow struct {
+		line     int
+		modifier string
+		option   dto.CatalogueModifierOption
+	}
+	var options []optionRow
+
+	for {
+		record, err := cr.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, apperr.Invalid("invalid catalogue CSV: %v", err)
+		}
+		line, _ := cr.FieldPos(0)
+		row := catalogueCSVRow{record: record, columns: columns}
+
+		switch kind := dto.CatalogueEntityKind(strings.ToLower(row.get("type"))); kind {
+		case dto.CatalogueCATEGORY:
+			cat := dto.CatalogueCategory{
+				Key:         row.get("key"),
+				Name:        row.get("name"),
+				Description: row.get("description"),
+			}
+			cat.ID = row.uuid("id")
+			cat.DisplayOrder = row.int("display_order")
+			cat.IsActive = row.optionalBool("available")
+			if row.err != nil {
+				return nil, apperr.Invalid("line %d: %v", line, row.err)
+			}
+			c.Categories = append(c.Categories, cat)
+
+		case dto.CatalogueMODIFIER:
+			mod := dto.CatalogueModifier{
+				Key:     row.get("key"),
+				Name:    row.get("name"),
+				Options: []dto.CatalogueModifierOption{},
+			}
+			mod.ID = row.uuid("id")
+			mod.Required = row.bool("required")
+			mod.MultiSelect = row.bool("multi_select")
+			mod.Max = row.int("max")
+			mod.Min = row.int("min")
+			mod.FreeQuantity = row.int("free_quantity")
+			if row.err != nil {
+				return nil, apperr.Invalid("line %d: %v", line, row.err)
+			}
+			c.Modifiers = append(c.Modifiers, mod)
+
+		case dto.CatalogueOPTION:
+			opt := dto.CatalogueModifierOption{
+				Key:      row.get("key"),
+				Name:     row.get("name"),
+				ImageURL: row.get("image_url"),
+			}
+			opt.ID = row.uuid("id")
+			opt.Price = row.int64("price")
+			opt.Available = row.optionalBool("available")
+			opt.PreSelect = row.bool("pre_select")
+			opt.DisplayOrder = row.int("display_order")
+			opt.MaxQuantity = row.int("max_quantity")
+			if row.err != nil {
+				return nil, apperr.Invalid("line %d: %v", line, row.err)
+			}
+			options = append(options, optionRow{line: line, modifier: row.get("modifier"), option: opt})
+
+		case dto.CatalogueITEM:
+			item := dto.CatalogueItem{
+				Name:        row.get("name"),
+				Description: row.get("description"),
+				ImageURL:    row.get("image_url"),
+				Category:    row.get("category"),
+				Modifiers:   []string{},
+			}
+			if id := row.get("id"); id != "" {
+				parsed, err := strconv.ParseInt(id, 10, 64)
+				if err != nil {
+					return nil, apperr.Invalid("line %d: invalid id %q", line, id)
+				}
+				item.ID = &parsed
+			}
+			item.Price = row.int64("price")
+			item.IsAvailable = row.optionalBool("available")
+			item.DisplayOrder = row.int("display_order")
+			if row.err != nil {
+				return nil, apperr.Invalid("line %d: %v", line, row.err)
+			}
+			if mods := row.get("modifiers"); mods != "" {
+				for _, key := range strings.Split(mods, catalogueCSVListSeparator) {
+					item.Modifiers = append(item.Modifiers, strings.TrimSpace(key))
+				}
+			}
+			c.Items = append(c.Items, item)
+
+		default:
+			return nil, apperr.Invalid("line %d: unknown row type %q", line, row.get("type"))
+		}
+	}
+
+	for _, o := range options {
+		i := slices.IndexFunc(c.Modifiers, func(m dto.CatalogueModifier) bool {
+			return m.Key == o.modifier || (m.Key == "" && m.Name == o.modifier)
+		})
+		if i < 0 {
+			return nil, apperr.Invalid("line %d: option refers to unknown modifier %q", o.line, o.modifier)
+		}
+		c.Modifiers[i].Options = append(c.Modifiers[i].Options, o.option)
+	}
+	return c, nil
+}
+
+// catalogueCSVRow reads typed cells from a record. Parsing stops at the
+// first bad cell, which is kept in err.
+type catalogueCSVRow struct {
+	record  []string
+	columns map[string]int
+	err     error
+}
+
+func (r *catalogueCSVRow) get(column string) string {
+	i, ok := r.columns[column]
+	if !ok || i >= len(r.record) {
+		return ""
+	}
+	return strings.TrimSpace(r.record[i])
+}
+
+func (r *catalogueCSVRow) fail(column, value string) {
+	if r.err == nil {
+		r.err = fmt.Errorf("invalid %s %q", column, value)
+	}
+}
+
+func (r *catalogueCSVRow) uuid(column string) *uuid.UUID {
+	v := r.get(column)
+	if v == "" {
+		return nil
+	}
+	id, err := uuid.Parse(v)
+	if err != nil {
+		r.fail(column, v)
+		return nil
+	}
+	return &id
+}
+
+func (r *catalogueCSVRow) int64(column string) int64 {
+	v := r.get(column)
+	if v == "" {
+		return 0
+	}
+	n, err := strconv.ParseInt(v, 10, 64)
+	if err != nil {
+		r.fail(column, v)
+	}
+	return n
+}
+
+func (r *catalogueCSVRow) int(column string) int {
+	v := r.get(column)
+	if v == "" {
+		return 0
+	}
+	n, err := strconv.Atoi(v)
+	if err != nil {
+		r.fail(column, v)
+	}
+	return n
+}
+
+func (r *catalogueCSVRow) bool(column string) bool {
+	b := r.optionalBool(column)
+	return b != nil && *b
+}
+
+func (r *catalogueCSVRow) optionalBool(column string) *bool {
+	v := r.get(column)
+	if v == "" {
+		return nil
+	}
+	b, err := strconv.ParseBool(v)
+	if err != nil {
+		r.fail(column, v)
+		return nil
+	}
+	return &b
+}
+
+func formatOptionalUUID(id *uuid.UUID) string {
+	if id == nil {
+		return ""
+	}
+	return id.String()
+}
+
+func formatOptionalBool(b *bool) string {
+	if b == nil {
+		return ""
+	}
+	return strconv.FormatBool(*b)
+}
@@ -0,0 +1,136 @@
+package services
+
+import (
+	"context"
+
+	"github.com/Jiruu246/rms/internal/apperr"
+	"github.com/Jiruu246/rms/internal/authz"
+	"github.com/Jiruu246/rms/internal/blobs"
+	"github.com/Jiruu246/rms/internal/dto"
+	"github.com/Jiruu246/rms/internal/repos"
+	"github.com/Jiruu246/rms/pkg/utils"
+	"github.com/google/uuid"
+)
+
+const (
+	ActionExportCatalogue authz.Action = "catalogue:export"
+	ActionImportCatalogue authz.Action = "catalogue:import"
+)
+
+// CatalogueService moves a restaurant's whole catalogue of categories,
+// modifiers, options and menu items in and out in bulk, for onboarding and
+// copying menus between restaurants. See dto.Catalogue for the format.
+type CatalogueService interface {
+	Export(ctx context.Context, actor authz.Actor, restaurantID uuid.UUID) (*dto.Catalogue, error)
+	// Import upserts c into the restaurant's catalogue, all or nothing. A
+	// dry run reports what would change without saving it. Changing a
+	// row's image_url deletes the image uploaded for it.
+	Import(ctx context.Context, actor authz.Actor, restaurantID uuid.UUID, c *dto.Catalogue, dryRun bool) (*dto.CatalogueImportReport, error)
+}
+
+type catalogueService struct {
+	repo              repos.CatalogueRepository
+	restaurantService RestaurantService
+	blobs             blobs.Store
+}
+
+func NewCatalogueService(repo repos.CatalogueRepository, restaurantService RestaurantService, blobStore blobs.Store) CatalogueService {
+	return &catalogueService{
+		repo:              repo,
+		restaurantService: restaurantService,
+		blobs:             blobStore,
+	}
+}
+
+func (s *catalogueService) Export(ctx context.Context, actor authz.Actor, restaurantID uuid.UUID) (*dto.Catalogue, error) {
+	if err := s.restaurantService.AuthorizeOwnership(ctx, actor, ActionExportCatalogue, restaurantID); err != nil {
+		return nil, err
+	}
+
+	return s.repo.Export(ctx, restaurantID)
+}
+
+func (s *catalogueService) Import(ctx context.Context, actor authz.Actor, restaurantID uuid.UUID, c *dto.Catalogue, dryRun bool) (*dto.CatalogueImportReport, error) {
+	if err := s.restaurantService.AuthorizeOwnership(ctx, actor, ActionImportCatalogue, restaurantID); err != nil {
+		return nil, err
+	}
+	if err := resolveCatalogueKeys(c); err != nil {
+		return nil, err
+	}
+
+	report, replaced, err := s.repo.Import(ctx, restaurantID, c, dryRun)
+	if err != nil {
+		return nil, err
+	}
+	deleteBlobs(ctx, s.blobs, replaced)
+	return report, nil
+}
+
+// resolveCatalogueKeys validates c and defaults the keys of its entries to
+// their names. Keys must be unique among categories, among modifiers and
+// among each modifier's options, and items may only refer to categories and
+// modifiers in c.
+func resolveCatalogueKeys(c *dto.Catalogue) error {
+	if err := utils.ValidateStruct(c); err != nil {
+		return apperr.Invalid("%v", err)
+	}
+	if c.Version > dto.CatalogueVersion {
+		return apperr.Invalid("catalogue version %d is newer than the supported version %d", c.Version, dto.CatalogueVersion)
+	}
+
+	categories := make(map[string]bool, len(c.Categories))
+	for i := range c.Categories {
+		cat := &c.Categories[i]
+		if cat.Key == "" {
+			cat.Key = cat.Name
+		}
+		if categories[cat.Key] {
+			return apperr.Invalid("category key %q is used more than once", cat.Key)
+		}
+		categories[cat.Key] = true
+	}
+
+	modifiers := make(map[string]bool, len(c.Modifiers))
+	for i := range c.Modifiers {
+		mod := &c.Modifiers[i]
+		if mod.Key == "" {
+			mod.Key = mod.Name
+		}
+		if modifiers[mod.Key] {
+			return apperr.Invalid("modifier key %q is used more than once", mod.Key)
+		}
+		modifiers[mod.Key] = true
+		if mod.Min > mod.Max {
+			return apperr.Invalid("modifier %q has min %d above its max %d", mod.Key, mod.Min, mod.Max)
+		}
+
+		options := make(map[string]bool, len(mod.Options))
+		for j := range mod.Options {
+			opt := &mod.Options[j]
+			if opt.Key == "" {
+				opt.Key = opt.Name
+			}
+			if options[opt.Key] {
+				return apperr.Invalid("option key %q is used more than once in modifier %q", opt.Key, mod.Key)
+			}
+			options[opt.Key] = true
+		}
+	}
+
+	for _, item := range c.Items {
+		if item.Category != "" && !categories[item.Category] {
+			return apperr.Invalid("menu item %q refers to unknown category %q", item.Name, item.Category)
+		}
+		seen := make(map[string]bool, len(item.Modifiers))
+		for _, key := range item.Modifiers {
+			if !modifiers[key] {
+				return apperr.Invalid("menu item %q refers to unknown modifier %q", item.Name, key)
+			}
+			if seen[key] {
+				return apperr.Invalid("menu item %q lists modifier %q more than once", item.Name, key)
+			}
+			seen[key] = true
+		}
+	}
+	return nil
+}
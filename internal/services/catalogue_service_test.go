@@ -0,0 +1,240 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/Jiruu246/rms/internal/apperr"
+	"github.com/Jiruu246/rms/internal/blobs"
+	"github.com/Jiruu246/rms/internal/dto"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// MockCatalogueRepository is a mock implementation of CatalogueRepository
+type MockCatalogueRepository struct {
+	mock.Mock
+}
+
+func (m *MockCatalogueRepository) Export(ctx context.Context, restaurantID uuid.UUID) (*dto.Catalogue, error) {
+	args := m.Called(ctx, restaurantID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*dto.Catalogue), args.Error(1)
+}
+
+func (m *MockCatalogueRepository) Import(ctx context.Context, restaurantID uuid.UUID, c *dto.Catalogue, dryRun bool) (*dto.CatalogueImportReport, []string, error) {
+	args := m.Called(ctx, restaurantID, c, dryRun)
+	if args.Get(0) == nil {
+		return nil, nil, args.Error(2)
+	}
+	replaced, _ := args.Get(1).([]string)
+	return args.Get(0).(*dto.CatalogueImportReport), replaced, args.Error(2)
+}
+
+func TestCatalogueService_Import(t *testing.T) {
+	restaurantID := uuid.New()
+
+	valid := func() *dto.Catalogue {
+		return &dto.Catalogue{
+			Version:    dto.CatalogueVersion,
+			Categories: []dto.CatalogueCategory{{Name: "Mains"}},
+			Modifiers: []dto.CatalogueModifier{{
+				Key:     "size",
+				Name:    "Size",
+				Max:     1,
+				Options: []dto.CatalogueModifierOption{{Name: "Small"}, {Name: "Large", Price: 200}},
+			}},
+			Items: []dto.CatalogueItem{{Name: "Burger", Price: 1200, Category: "Mains", Modifiers: []string{"size"}}},
+		}
+	}
+
+	testCases := []struct {
+		name          string
+		edit          func(c *dto.Catalogue)
+		authErr       error
+		expectedError error
+	}{
+		{
+			name: "valid",
+			edit: func(c *dto.Catalogue) {},
+		},
+		{
+			name:          "not owner",
+			edit:          func(c *dto.Catalogue) {},
+			authErr:       apperr.ErrForbidden,
+			expectedError: apperr.ErrForbidden,
+		},
+		{
+			name: "duplicate category key",
+			edit: func(c *dto.Catalogue) {
+				c.Categories = append(c.Categories, dto.CatalogueCategory{Name: "Mains"})
+			},
+			expectedError: apperr.ErrInvalid,
+		},
+		{
+			name: "duplicate option key",
+			edit: func(c *dto.Catalogue) {
+				c.Modifiers[0].Options = append(c.Modifiers[0].Options, dto.CatalogueModifierOption{Name: "Small"})
+			},
+			expectedError: apperr.ErrInvalid,
+		},
+		{
+			name: "same option key in different modifiers",
+			edit: func(c *dto.Catalogue) {
+				c.Modifiers = append(c.Modifiers, dto.CatalogueModifier{
+					Name:    "Milk",
+					Options: []dto.CatalogueModifierOption{{Name: "Small"}},
+				})
+			},
+		},
+		{
+			name:          "unknown category",
+			edit:          func(c *dto.Catalogue) { c.Items[0].Category = "Desserts" },
+			expectedError: apperr.ErrInvalid,
+		},
+		{
+			name:          "unknown modifier",
+			edit:          func(c *dto.Catalogue) { c.Items[0].Modifiers = []string{"Size"} },
+			expectedError: apperr.ErrInvalid,
+		},
+		{
+			name:          "modifier listed twice",
+			edit:          func(c *dto.Catalogue) { c.Items[0].Modifiers = []string{"size", "size"} },
+			expectedError: apperr.ErrInvalid,
+		},
+		{
+			name:          "negative price",
+			edit:          func(c *dto.Catalogue) { c.Items[0].Price = -1 },
+			expectedError: apperr.ErrInvalid,
+		},
+		{
+			name:          "newer version",
+			edit:          func(c *dto.Catalogue) { c.Version = dto.CatalogueVersion + 1 },
+			expectedError: apperr.ErrInvalid,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockRepo := new(MockCatalogueRepository)
+			mockRestaurantService := new(MockRestaurantService)
+			mockRestaurantService.On("AuthorizeOwnership", mock.Anything, adminActor, ActionImportCatalogue, restaurantID).
+				Return(tc.authErr)
+			c := valid()
+			tc.edit(c)
+			if tc.expectedError == nil {
+				mockRepo.On("Import", mock.Anything, restaurantID, c, true).
+					Return(&dto.CatalogueImportReport{DryRun: true, Created: 4}, nil, nil)
+			}
+
+			svc := NewCatalogueService(mockRepo, mockRestaurantService, blobs.NewMemoryStore(""))
+			report, err := svc.Import(context.Background(), adminActor, restaurantID, c, true)
+
+			if tc.expectedError != nil {
+				assert.ErrorIs(t, err, tc.expectedError)
+				mockRepo.AssertNotCalled(t, "Import", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+				return
+			}
+			require.NoError(t, err)
+			assert.True(t, report.DryRun)
+			assert.Equal(t, "Mains", c.Categories[0].Key)
+			assert.Equal(t, "Small", c.Modifiers[0].Options[0].Key)
+			mockRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestCatalogueService_Import_DeletesReplacedImages(t *testing.T) {
+	restaurantID := uuid.New()
+	store := blobs.NewMemoryStore("https://cdn.example.com")
+	replaced := []string{"items/1/image.png", "items/1/thumb.png"}
+	kept := "items/2/image.png"
+	for _, key := range append(replaced, kept) {
+		require.NoError(t, store.Put(t.Context(), key, "image/png", []byte("image")))
+	}
+	c := &dto.Catalogue{
+		Version: dto.CatalogueVersion,
+		Items:   []dto.CatalogueItem{{Name: "Burger", Price: 1250, ImageURL: "https://example.com/burger.png", Modifiers: []string{}}},
+	}
+
+	mockRepo := new(MockCatalogueRepository)
+	mockRepo.On("Import", mock.Anything, restaurantID, c, false).
+		Return(&dto.CatalogueImportReport{Updated: 1}, replaced, nil)
+	mockRestaurantService := new(MockRestaurantService)
+	mockRestaurantService.On("AuthorizeOwnership", mock.Anything, adminActor, ActionImportCatalogue, restaurantID).Return(nil)
+
+	svc := NewCatalogueService(mockRepo, mockRestaurantService, store)
+	report, err := svc.Import(t.Context(), adminActor, restaurantID, c, false)
+
+	require.NoError(t, err)
+	assert.Equal(t, 1, report.Updated)
+	assert.Equal(t, []string{kept}, store.Keys())
+}
+
+func TestCatalogueCSV(t *testing.T) {
+	categoryID := uuid.New()
+	itemID := int64(42)
+	available := false
+	catalogue := &dto.Catalogue{
+		Version: dto.CatalogueVersion,
+		Categories: []dto.CatalogueCategory{
+			{Key: "Mains", ID: &categoryID, Name: "Mains", Description: "Big plates, with \"sides\"", DisplayOrder: 1},
+		},
+		Modifiers: []dto.CatalogueModifier{
+			{Key: "Size", Name: "Size", Required: true, Max: 1, Options: []dto.CatalogueModifierOption{
+				{Key: "Small", Name: "Small"},
+				{Key: "Large", Name: "Large", Price: 250, Available: &available, DisplayOrder: 1},
+			}},
+			{Key: "Sauce", Name: "Sauce", MultiSelect: true, Max: 3, Options: []dto.CatalogueModifierOption{}},
+		},
+		Items: []dto.CatalogueItem{
+			{ID: &itemID, Name: "Burger, double", Price: 1250, Category: "Mains", Modifiers: []string{"Size", "Sauce"}},
+			{Name: "Water", Price: 300, Modifiers: []string{}},
+		},
+	}
+
+	t.Run("round trip", func(t *testing.T) {
+		var buf bytes.Buffer
+		require.NoError(t, WriteCatalogueCSV(&buf, catalogue))
+
+		read, err := ReadCatalogueCSV(&buf)
+		require.NoError(t, err)
+		assert.Equal(t, catalogue, read)
+	})
+
+	t.Run("columns in any order", func(t *testing.T) {
+		read, err := ReadCatalogueCSV(strings.NewReader(
+			"name,type,modifier,price\n" +
+				"Large,option,Size,250\n" +
+				"Size,modifier,,\n"))
+		require.NoError(t, err)
+		require.Len(t, read.Modifiers, 1)
+		require.Len(t, read.Modifiers[0].Options, 1)
+		assert.Equal(t, int64(250), read.Modifiers[0].Options[0].Price)
+	})
+
+	testCases := []struct {
+		name  string
+		input string
+	}{
+		{name: "empty", input: ""},
+		{name: "unknown column", input: "type,name,colour\n"},
+		{name: "missing type column", input: "name\nMains\n"},
+		{name: "unknown row type", input: "type,name\ndrink,Water\n"},
+		{name: "bad price", input: "type,name,price\nitem,Water,3.00\n"},
+		{name: "bad boolean", input: "type,name,available\ncategory,Mains,maybe\n"},
+		{name: "option without modifier", input: "type,name,modifier\noption,Large,Size\n"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := ReadCatalogueCSV(strings.NewReader(tc.input))
+			assert.ErrorIs(t, err, apperr.ErrInvalid)
+		})
+	}
+}
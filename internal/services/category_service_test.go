@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/Jiruu246/rms/internal/authz"
 	"github.com/Jiruu246/rms/internal/dto"
@@ -112,6 +113,14 @@ func (m *MockRestaurantService) AuthorizeOwnership(ctx context.Context, actor au
 	return args.Error(0)
 }
 
+func (m *MockRestaurantService) GetOpenStatus(ctx context.Context, id uuid.UUID, at time.Time) (*dto.OpenStatus, error) {
+	args := m.Called(ctx, id, at)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*dto.OpenStatus), args.Error(1)
+}
+
 func TestCategoryService_Create(t *testing.T) {
 	restaurantID := uuid.New()
 
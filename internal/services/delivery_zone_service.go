@@ -0,0 +1,189 @@
+package services
+
+import (
+	"cmp"
+	"context"
+	"slices"
+
+	"github.com/Jiruu246/rms/internal/apperr"
+	"github.com/Jiruu246/rms/internal/authz"
+	"github.com/Jiruu246/rms/internal/dto"
+	"github.com/Jiruu246/rms/internal/repos"
+	"github.com/Jiruu246/rms/pkg/geo"
+	"github.com/google/uuid"
+)
+
+const (
+	ActionCreateDeliveryZone authz.Action = "delivery_zone:create"
+	ActionReadDeliveryZone   authz.Action = "delivery_zone:read"
+	ActionUpdateDeliveryZone authz.Action = "delivery_zone:update"
+	ActionDeleteDeliveryZone authz.Action = "delivery_zone:delete"
+)
+
+type DeliveryZoneService interface {
+	Create(ctx context.Context, actor authz.Actor, req *dto.CreateDeliveryZoneRequest) (*dto.DeliveryZone, error)
+	GetByID(ctx context.Context, actor authz.Actor, id uuid.UUID) (*dto.DeliveryZone, error)
+	GetAllByRestaurant(ctx context.Context, actor authz.Actor, restaurantID uuid.UUID) ([]*dto.DeliveryZone, error)
+	Update(ctx context.Context, actor authz.Actor, id uuid.UUID, req *dto.UpdateDeliveryZoneRequest) (*dto.DeliveryZone, error)
+	Delete(ctx context.Context, actor authz.Actor, id uuid.UUID) error
+}
+
+type deliveryZoneService struct {
+	repo              repos.DeliveryZoneRepository
+	restaurantService RestaurantService
+	authorizer        authz.Authorizer
+}
+
+func NewDeliveryZoneService(repo repos.DeliveryZoneRepository, restaurantService RestaurantService) DeliveryZoneService {
+	return &deliveryZoneService{
+		repo:              repo,
+		restaurantService: restaurantService,
+		authorizer:        authz.NewPolicyAuthorizer(),
+	}
+}
+
+func (s *deliveryZoneService) Create(ctx context.Context, actor authz.Actor, req *dto.CreateDeliveryZoneRequest) (*dto.DeliveryZone, error) {
+	if err := s.restaurantService.AuthorizeOwnership(ctx, actor, ActionCreateDeliveryZone, req.RestaurantID); err != nil {
+		return nil, err
+	}
+	if err := validateZoneShape(dto.DeliveryZoneKind(req.Kind), req.RadiusMeters, req.Polygon); err != nil {
+		return nil, err
+	}
+
+	return s.repo.Create(ctx, req)
+}
+
+func (s *deliveryZoneService) GetByID(ctx context.Context, actor authz.Actor, id uuid.UUID) (*dto.DeliveryZone, error) {
+	resource, err := s.authorize(ctx, actor, ActionReadDeliveryZone, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.repo.GetByID(ctx, resource.RestaurantID, id)
+}
+
+func (s *deliveryZoneService) GetAllByRestaurant(ctx context.Context, actor authz.Actor, restaurantID uuid.UUID) ([]*dto.DeliveryZone, error) {
+	if err := s.restaurantService.AuthorizeOwnership(ctx, actor, ActionReadDeliveryZone, restaurantID); err != nil {
+		return nil, err
+	}
+
+	return s.repo.GetAllByRestaurant(ctx, restaurantID, false)
+}
+
+// Update applies a partial update, checking that the zone still has the
+// shape its kind needs afterwards. Switching kind drops the old shape, so
+// the new one has to come with it.
+func (s *deliveryZoneService) Update(ctx context.Context, actor authz.Actor, id uuid.UUID, req *dto.UpdateDeliveryZoneRequest) (*dto.DeliveryZone, error) {
+	resource, err := s.authorize(ctx, actor, ActionUpdateDeliveryZone, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Kind != nil || req.RadiusMeters != nil || req.Polygon != nil {
+		current, err := s.repo.GetByID(ctx, resource.RestaurantID, id)
+		if err != nil {
+			return nil, err
+		}
+
+		kind, radius, polygon := current.Kind, current.RadiusMeters, current.Polygon
+		if req.Kind != nil && dto.DeliveryZoneKind(*req.Kind) != kind {
+			kind, radius, polygon = dto.DeliveryZoneKind(*req.Kind), nil, nil
+		}
+		if req.RadiusMeters != nil {
+			radius = req.RadiusMeters
+		}
+		if req.Polygon != nil {
+			polygon = *req.Polygon
+		}
+		if err := validateZoneShape(kind, radius, polygon); err != nil {
+			return nil, err
+		}
+	}
+
+	return s.repo.Update(ctx, resource.RestaurantID, id, req)
+}
+
+func (s *deliveryZoneService) Delete(ctx context.Context, actor authz.Actor, id uuid.UUID) error {
+	resource, err := s.authorize(ctx, actor, ActionDeleteDeliveryZone, id)
+	if err != nil {
+		return err
+	}
+
+	return s.repo.Delete(ctx, resource.RestaurantID, id)
+}
+
+// authorize resolves the zone's authz.Resource and checks the actor may
+// perform action on it.
+func (s *deliveryZoneService) authorize(ctx context.Context, actor authz.Actor, action authz.Action, id uuid.UUID) (authz.Resource, error) {
+	resource, err := s.repo.GetAuthorizationResource(ctx, id)
+	if err != nil {
+		return authz.Resource{}, err
+	}
+
+	if _, err := s.authorizer.Authorize(ctx, authz.Request{
+		Actor:    actor,
+		Action:   action,
+		Resource: resource,
+	}); err != nil {
+		return authz.Resource{}, err
+	}
+	return resource, nil
+}
+
+// validateZoneShape checks a zone has exactly the shape its kind uses: a
+// radius for RADIUS zones, a polygon of at least three points for POLYGON
+// zones.
+func validateZoneShape(kind dto.DeliveryZoneKind, radiusMeters *int, polygon []geo.Point) error {
+	switch kind {
+	case dto.DeliveryZoneKindRADIUS:
+		if radiusMeters == nil {
+			return apperr.Invalid("RADIUS zones need radius_meters")
+		}
+		if len(polygon) > 0 {
+			return apperr.Invalid("RADIUS zones cannot have a polygon")
+		}
+	case dto.DeliveryZoneKindPOLYGON:
+		if len(polygon) < 3 {
+			return apperr.Invalid("POLYGON zones need a polygon of at least 3 points")
+		}
+		if radiusMeters != nil {
+			return apperr.Invalid("POLYGON zones cannot have radius_meters")
+		}
+	default:
+		return apperr.Invalid("unknown delivery zone kind %s", kind)
+	}
+	return nil
+}
+
+// matchDeliveryZone returns the zone a delivery to p falls in, or nil if it
+// is outside all of them. Where zones overlap, the cheapest to deliver to
+// wins (then the lowest minimum, then the name), so concentric RADIUS zones
+// price each address by the innermost ring it is in. RADIUS zones are
+// centred on restaurantLocation and match nothing while it is unset.
+func matchDeliveryZone(zones []*dto.DeliveryZone, restaurantLocation *geo.Point, p geo.Point) *dto.DeliveryZone {
+	var matches []*dto.DeliveryZone
+	for _, zone := range zones {
+		switch zone.Kind {
+		case dto.DeliveryZoneKindRADIUS:
+			if restaurantLocation != nil && zone.RadiusMeters != nil &&
+				geo.WithinRadius(*restaurantLocation, p, float64(*zone.RadiusMeters)) {
+				matches = append(matches, zone)
+			}
+		case dto.DeliveryZoneKindPOLYGON:
+			if geo.PolygonContains(zone.Polygon, p) {
+				matches = append(matches, zone)
+			}
+		}
+	}
+	if len(matches) == 0 {
+		return nil
+	}
+
+	return slices.MinFunc(matches, func(a, b *dto.DeliveryZone) int {
+		return cmp.Or(
+			cmp.Compare(a.DeliveryFee.Amount, b.DeliveryFee.Amount),
+			cmp.Compare(a.MinOrderValue.Amount, b.MinOrderValue.Amount),
+			cmp.Compare(a.Name, b.Name),
+		)
+	})
+}
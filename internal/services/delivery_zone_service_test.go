@@ -0,0 +1,184 @@
+package services
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Jiruu246/rms/internal/apperr"
+	"github.com/Jiruu246/rms/internal/authz"
+	"github.com/Jiruu246/rms/internal/dto"
+	"github.com/Jiruu246/rms/pkg/geo"
+	"github.com/Jiruu246/rms/pkg/money"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// MockDeliveryZoneRepository is a mock implementation of DeliveryZoneRepository
+type MockDeliveryZoneRepository struct {
+	mock.Mock
+}
+
+func (m *MockDeliveryZoneRepository) Create(ctx context.Context, req *dto.CreateDeliveryZoneRequest) (*dto.DeliveryZone, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*dto.DeliveryZone), args.Error(1)
+}
+
+func (m *MockDeliveryZoneRepository) GetByID(ctx context.Context, restaurantID, id uuid.UUID) (*dto.DeliveryZone, error) {
+	args := m.Called(ctx, restaurantID, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*dto.DeliveryZone), args.Error(1)
+}
+
+func (m *MockDeliveryZoneRepository) GetAllByRestaurant(ctx context.Context, restaurantID uuid.UUID, activeOnly bool) ([]*dto.DeliveryZone, error) {
+	args := m.Called(ctx, restaurantID, activeOnly)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*dto.DeliveryZone), args.Error(1)
+}
+
+func (m *MockDeliveryZoneRepository) Update(ctx context.Context, restaurantID, id uuid.UUID, req *dto.UpdateDeliveryZoneRequest) (*dto.DeliveryZone, error) {
+	args := m.Called(ctx, restaurantID, id, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*dto.DeliveryZone), args.Error(1)
+}
+
+func (m *MockDeliveryZoneRepository) Delete(ctx context.Context, restaurantID, id uuid.UUID) error {
+	args := m.Called(ctx, restaurantID, id)
+	return args.Error(0)
+}
+
+func (m *MockDeliveryZoneRepository) GetAuthorizationResource(ctx context.Context, id uuid.UUID) (authz.Resource, error) {
+	args := m.Called(ctx, id)
+	return args.Get(0).(authz.Resource), args.Error(1)
+}
+
+func intPtr(v int) *int {
+	return &v
+}
+
+var square = []geo.Point{
+	{Lat: 0, Lng: 0},
+	{Lat: 0, Lng: 1},
+	{Lat: 1, Lng: 1},
+	{Lat: 1, Lng: 0},
+}
+
+func TestValidateZoneShape(t *testing.T) {
+	testCases := []struct {
+		name    string
+		kind    dto.DeliveryZoneKind
+		radius  *int
+		polygon []geo.Point
+		valid   bool
+	}{
+		{"radius", dto.DeliveryZoneKindRADIUS, intPtr(3000), nil, true},
+		{"radius missing", dto.DeliveryZoneKindRADIUS, nil, nil, false},
+		{"radius with polygon", dto.DeliveryZoneKindRADIUS, intPtr(3000), square, false},
+		{"polygon", dto.DeliveryZoneKindPOLYGON, nil, square, true},
+		{"polygon too small", dto.DeliveryZoneKindPOLYGON, nil, square[:2], false},
+		{"polygon with radius", dto.DeliveryZoneKindPOLYGON, intPtr(3000), square, false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := validateZoneShape(tc.kind, tc.radius, tc.polygon)
+			if tc.valid {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorIs(t, err, apperr.ErrInvalid)
+			}
+		})
+	}
+}
+
+func TestMatchDeliveryZone(t *testing.T) {
+	restaurant := geo.Point{Lat: 0.5, Lng: 0.5}
+	inner := &dto.DeliveryZone{Name: "inner", Kind: dto.DeliveryZoneKindRADIUS, RadiusMeters: intPtr(2_000), DeliveryFee: money.New(200, "USD")}
+	outer := &dto.DeliveryZone{Name: "outer", Kind: dto.DeliveryZoneKindRADIUS, RadiusMeters: intPtr(10_000), DeliveryFee: money.New(500, "USD")}
+	drawn := &dto.DeliveryZone{Name: "drawn", Kind: dto.DeliveryZoneKindPOLYGON, Polygon: square, DeliveryFee: money.New(800, "USD")}
+	zones := []*dto.DeliveryZone{drawn, outer, inner}
+
+	// 0.01 degrees of latitude is about 1.1 km.
+	assert.Equal(t, inner, matchDeliveryZone(zones, &restaurant, geo.Point{Lat: 0.51, Lng: 0.5}))
+	assert.Equal(t, outer, matchDeliveryZone(zones, &restaurant, geo.Point{Lat: 0.55, Lng: 0.5}))
+	assert.Equal(t, drawn, matchDeliveryZone(zones, &restaurant, geo.Point{Lat: 0.9, Lng: 0.9}))
+	assert.Nil(t, matchDeliveryZone(zones, &restaurant, geo.Point{Lat: 2, Lng: 2}))
+	// Without a restaurant location only drawn zones can match.
+	assert.Equal(t, drawn, matchDeliveryZone(zones, nil, geo.Point{Lat: 0.51, Lng: 0.5}))
+}
+
+func TestDeliveryZoneService_Create(t *testing.T) {
+	restaurantID := uuid.New()
+
+	t.Run("forbidden", func(t *testing.T) {
+		repo := new(MockDeliveryZoneRepository)
+		restaurantService := new(MockRestaurantService)
+		req := &dto.CreateDeliveryZoneRequest{Name: "Near", Kind: "RADIUS", RadiusMeters: intPtr(3000), RestaurantID: restaurantID}
+		restaurantService.On("AuthorizeOwnership", mock.Anything, adminActor, ActionCreateDeliveryZone, restaurantID).Return(apperr.ErrForbidden)
+
+		zone, err := NewDeliveryZoneService(repo, restaurantService).Create(t.Context(), adminActor, req)
+
+		assert.ErrorIs(t, err, apperr.ErrForbidden)
+		assert.Nil(t, zone)
+		repo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+	})
+
+	t.Run("incomplete shape", func(t *testing.T) {
+		repo := new(MockDeliveryZoneRepository)
+		restaurantService := new(MockRestaurantService)
+		req := &dto.CreateDeliveryZoneRequest{Name: "Drawn", Kind: "POLYGON", RestaurantID: restaurantID}
+		restaurantService.On("AuthorizeOwnership", mock.Anything, adminActor, ActionCreateDeliveryZone, restaurantID).Return(nil)
+
+		zone, err := NewDeliveryZoneService(repo, restaurantService).Create(t.Context(), adminActor, req)
+
+		assert.ErrorIs(t, err, apperr.ErrInvalid)
+		assert.Nil(t, zone)
+		repo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+	})
+}
+
+func TestDeliveryZoneService_Update_SwitchKind(t *testing.T) {
+	restaurantID := uuid.New()
+	zoneID := uuid.New()
+	resource := authz.Resource{Type: "delivery_zone", ID: zoneID, RestaurantID: restaurantID, OwnerUserID: uuid.New()}
+	current := &dto.DeliveryZone{ID: zoneID, Kind: dto.DeliveryZoneKindRADIUS, RadiusMeters: intPtr(3000)}
+	polygon := dto.DeliveryZoneKindPOLYGON
+
+	t.Run("without new shape", func(t *testing.T) {
+		repo := new(MockDeliveryZoneRepository)
+		repo.On("GetAuthorizationResource", mock.Anything, zoneID).Return(resource, nil)
+		repo.On("GetByID", mock.Anything, restaurantID, zoneID).Return(current, nil)
+		kind := string(polygon)
+
+		zone, err := NewDeliveryZoneService(repo, new(MockRestaurantService)).Update(t.Context(), adminActor, zoneID, &dto.UpdateDeliveryZoneRequest{Kind: &kind})
+
+		assert.ErrorIs(t, err, apperr.ErrInvalid)
+		assert.Nil(t, zone)
+		repo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("with new shape", func(t *testing.T) {
+		repo := new(MockDeliveryZoneRepository)
+		repo.On("GetAuthorizationResource", mock.Anything, zoneID).Return(resource, nil)
+		repo.On("GetByID", mock.Anything, restaurantID, zoneID).Return(current, nil)
+		kind := string(polygon)
+		req := &dto.UpdateDeliveryZoneRequest{Kind: &kind, Polygon: &square}
+		repo.On("Update", mock.Anything, restaurantID, zoneID, req).Return(&dto.DeliveryZone{ID: zoneID, Kind: polygon, Polygon: square}, nil)
+
+		zone, err := NewDeliveryZoneService(repo, new(MockRestaurantService)).Update(t.Context(), adminActor, zoneID, req)
+
+		require.NoError(t, err)
+		assert.Equal(t, polygon, zone.Kind)
+		repo.AssertExpectations(t)
+	})
+}
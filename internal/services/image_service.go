@@ -0,0 +1,262 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"log"
+
+	"github.com/Jiruu246/rms/internal/apperr"
+	"github.com/Jiruu246/rms/internal/authz"
+	"github.com/Jiruu246/rms/internal/blobs"
+	"github.com/Jiruu246/rms/internal/dto"
+	"github.com/Jiruu246/rms/internal/repos"
+	"github.com/Jiruu246/rms/pkg/imaging"
+	"github.com/google/uuid"
+)
+
+const (
+	ActionUpdateMenuItemImage       authz.Action = "menu_item:update_image"
+	ActionUpdateModifierOptionImage authz.Action = "modifier_option:update_image"
+)
+
+const (
+	// maxImagePixels caps the decoded size of an uploaded image, about a
+	// 8000x5000 photo.
+	maxImagePixels = 40_000_000
+	// thumbnailSize is the longest side of generated thumbnails, in pixels.
+	thumbnailSize = 320
+)
+
+// ImageService stores uploaded images of menu items and modifier options,
+// and restaurant logos, in a blobs.Store with a thumbnail of each, and sets
+// the owner's URL fields to them. Replacing or removing an image deletes the
+// old blobs.
+type ImageService interface {
+	SetMenuItemImage(ctx context.Context, actor authz.Actor, id int64, data []byte) (*dto.MenuItem, error)
+	DeleteMenuItemImage(ctx context.Context, actor authz.Actor, id int64) (*dto.MenuItem, error)
+	SetModifierOptionImage(ctx context.Context, actor authz.Actor, id uuid.UUID, data []byte) (*dto.ModifierOption, error)
+	DeleteModifierOptionImage(ctx context.Context, actor authz.Actor, id uuid.UUID) (*dto.ModifierOption, error)
+	SetRestaurantLogo(ctx context.Context, actor authz.Actor, id uuid.UUID, data []byte) (*dto.RestaurantResponse, error)
+	DeleteRestaurantLogo(ctx context.Context, actor authz.Actor, id uuid.UUID) (*dto.RestaurantResponse, error)
+}
+
+type imageService struct {
+	store              blobs.Store
+	maxBytes           int64
+	menuItemRepo       repos.MenuItemRepository
+	modifierOptionRepo repos.ModifierOptionRepository
+	restaurantRepo     repos.RestaurantRepository
+	restaurantService  RestaurantService
+	authorizer         authz.Authorizer
+}
+
+// NewImageService returns an ImageService storing images of up to maxBytes
+// bytes in store.
+func NewImageService(
+	store blobs.Store,
+	maxBytes int64,
+	menuItemRepo repos.MenuItemRepository,
+	modifierOptionRepo repos.ModifierOptionRepository,
+	restaurantRepo repos.RestaurantRepository,
+	restaurantService RestaurantService,
+) ImageService {
+	return &imageService{
+		store:              store,
+		maxBytes:           maxBytes,
+		menuItemRepo:       menuItemRepo,
+		modifierOptionRepo: modifierOptionRepo,
+		restaurantRepo:     restaurantRepo,
+		restaurantService:  restaurantService,
+		authorizer:         authz.NewPolicyAuthorizer(),
+	}
+}
+
+func (s *imageService) SetMenuItemImage(ctx context.Context, actor authz.Actor, id int64, data []byte) (*dto.MenuItem, error) {
+	resource, err := s.authorizeMenuItem(ctx, actor, id)
+	if err != nil {
+		return nil, err
+	}
+
+	prefix := fmt.Sprintf("restaurants/%s/menu-items/%d", resource.RestaurantID, id)
+	var item *dto.MenuItem
+	err = s.replace(ctx, prefix, data, func(image *repos.ImageData) (replaced []string, err error) {
+		item, replaced, err = s.menuItemRepo.SetImage(ctx, resource.RestaurantID, id, image)
+		return replaced, err
+	})
+	return item, err
+}
+
+func (s *imageService) DeleteMenuItemImage(ctx context.Context, actor authz.Actor, id int64) (*dto.MenuItem, error) {
+	resource, err := s.authorizeMenuItem(ctx, actor, id)
+	if err != nil {
+		return nil, err
+	}
+
+	item, replaced, err := s.menuItemRepo.SetImage(ctx, resource.RestaurantID, id, nil)
+	if err != nil {
+		return nil, err
+	}
+	deleteBlobs(ctx, s.store, replaced)
+	return item, nil
+}
+
+func (s *imageService) SetModifierOptionImage(ctx context.Context, actor authz.Actor, id uuid.UUID, data []byte) (*dto.ModifierOption, error) {
+	resource, err := s.authorizeModifierOption(ctx, actor, id)
+	if err != nil {
+		return nil, err
+	}
+
+	prefix := fmt.Sprintf("restaurants/%s/modifier-options/%s", resource.RestaurantID, id)
+	var option *dto.ModifierOption
+	err = s.replace(ctx, prefix, data, func(image *repos.ImageData) (replaced []string, err error) {
+		option, replaced, err = s.modifierOptionRepo.SetImage(ctx, resource.RestaurantID, id, image)
+		return replaced, err
+	})
+	return option, err
+}
+
+func (s *imageService) DeleteModifierOptionImage(ctx context.Context, actor authz.Actor, id uuid.UUID) (*dto.ModifierOption, error) {
+	resource, err := s.authorizeModifierOption(ctx, actor, id)
+	if err != nil {
+		return nil, err
+	}
+
+	option, replaced, err := s.modifierOptionRepo.SetImage(ctx, resource.RestaurantID, id, nil)
+	if err != nil {
+		return nil, err
+	}
+	deleteBlobs(ctx, s.store, replaced)
+	return option, nil
+}
+
+func (s *imageService) SetRestaurantLogo(ctx context.Context, actor authz.Actor, id uuid.UUID, data []byte) (*dto.RestaurantResponse, error) {
+	if err := s.restaurantService.AuthorizeOwnership(ctx, actor, ActionUpdateRestaurant, id); err != nil {
+		return nil, err
+	}
+
+	prefix := fmt.Sprintf("restaurants/%s/logo", id)
+	var restaurant *dto.RestaurantResponse
+	err := s.replace(ctx, prefix, data, func(image *repos.ImageData) (replaced []string, err error) {
+		restaurant, replaced, err = s.restaurantRepo.SetLogo(ctx, id, image)
+		return replaced, err
+	})
+	return restaurant, err
+}
+
+func (s *imageService) DeleteRestaurantLogo(ctx context.Context, actor authz.Actor, id uuid.UUID) (*dto.RestaurantResponse, error) {
+	if err := s.restaurantService.AuthorizeOwnership(ctx, actor, ActionUpdateRestaurant, id); err != nil {
+		return nil, err
+	}
+
+	restaurant, replaced, err := s.restaurantRepo.SetLogo(ctx, id, nil)
+	if err != nil {
+		return nil, err
+	}
+	deleteBlobs(ctx, s.store, replaced)
+	return restaurant, nil
+}
+
+// replace stores data and its thumbnail under prefix and hands them to set,
+// which saves them on the owner and returns the keys of the image they
+// replaced. Those are deleted once set succeeds; if it fails, the new
+// blobs are deleted instead.
+func (s *imageService) replace(ctx context.Context, prefix string, data []byte, set func(*repos.ImageData) ([]string, error)) error {
+	image, err := s.put(ctx, prefix, data)
+	if err != nil {
+		return err
+	}
+
+	replaced, err := set(image)
+	if err != nil {
+		deleteBlobs(ctx, s.store, image.Keys)
+		return err
+	}
+	deleteBlobs(ctx, s.store, replaced)
+	return nil
+}
+
+// put validates data as an image, and stores it and a thumbnail of it
+// under new keys beneath prefix.
+func (s *imageService) put(ctx context.Context, prefix string, data []byte) (*repos.ImageData, error) {
+	if int64(len(data)) > s.maxBytes {
+		return nil, apperr.Invalid("image is larger than %d bytes", s.maxBytes)
+	}
+	img, format, err := imaging.Decode(data, maxImagePixels)
+	if err != nil {
+		if errors.Is(err, imaging.ErrUnsupported) || errors.Is(err, imaging.ErrTooLarge) {
+			return nil, apperr.Invalid("%v; upload a JPEG, PNG or GIF image", err)
+		}
+		return nil, err
+	}
+
+	thumbFormat := imaging.ThumbnailFormat(format)
+	var thumb bytes.Buffer
+	if err := imaging.Encode(&thumb, imaging.Thumbnail(img, thumbnailSize), thumbFormat); err != nil {
+		return nil, fmt.Errorf("failed to make thumbnail: %w", err)
+	}
+
+	name := uuid.NewString()
+	key := fmt.Sprintf("%s/%s.%s", prefix, name, imageExtension(format))
+	thumbKey := fmt.Sprintf("%s/%s-thumb.%s", prefix, name, imageExtension(thumbFormat))
+	if err := s.store.Put(ctx, key, imaging.ContentType(format), data); err != nil {
+		return nil, fmt.Errorf("failed to store image: %w", err)
+	}
+	if err := s.store.Put(ctx, thumbKey, imaging.ContentType(thumbFormat), thumb.Bytes()); err != nil {
+		deleteBlobs(ctx, s.store, []string{key})
+		return nil, fmt.Errorf("failed to store thumbnail: %w", err)
+	}
+
+	return &repos.ImageData{
+		URL:          s.store.URL(key),
+		ThumbnailURL: s.store.URL(thumbKey),
+		Keys:         []string{key, thumbKey},
+	}, nil
+}
+
+func (s *imageService) authorizeMenuItem(ctx context.Context, actor authz.Actor, id int64) (authz.Resource, error) {
+	resource, err := s.menuItemRepo.GetAuthorizationResource(ctx, id)
+	if err != nil {
+		return authz.Resource{}, err
+	}
+	return s.authorize(ctx, actor, ActionUpdateMenuItemImage, resource)
+}
+
+func (s *imageService) authorizeModifierOption(ctx context.Context, actor authz.Actor, id uuid.UUID) (authz.Resource, error) {
+	resource, err := s.modifierOptionRepo.GetAuthorizationResource(ctx, id)
+	if err != nil {
+		return authz.Resource{}, err
+	}
+	return s.authorize(ctx, actor, ActionUpdateModifierOptionImage, resource)
+}
+
+func (s *imageService) authorize(ctx context.Context, actor authz.Actor, action authz.Action, resource authz.Resource) (authz.Resource, error) {
+	if _, err := s.authorizer.Authorize(ctx, authz.Request{
+		Actor:    actor,
+		Action:   action,
+		Resource: resource,
+	}); err != nil {
+		return authz.Resource{}, err
+	}
+	return resource, nil
+}
+
+// imageExtension is the file extension of format images.
+func imageExtension(format string) string {
+	if format == imaging.FormatJPEG {
+		return "jpg"
+	}
+	return format
+}
+
+// deleteBlobs deletes keys from store. Failing to is only logged: the
+// blobs are orphaned, but whatever referred to them has already changed.
+func deleteBlobs(ctx context.Context, store blobs.Store, keys []string) {
+	if len(keys) == 0 {
+		return
+	}
+	if err := store.Delete(ctx, keys...); err != nil {
+		log.Printf("failed to delete blobs %v: %v", keys, err)
+	}
+}
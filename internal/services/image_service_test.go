@@ -0,0 +1,136 @@
+package services
+
+import (
+	"bytes"
+	"errors"
+	"image"
+	"image/color"
+	"image/png"
+	"strings"
+	"testing"
+
+	"github.com/Jiruu246/rms/internal/apperr"
+	"github.com/Jiruu246/rms/internal/blobs"
+	"github.com/Jiruu246/rms/internal/dto"
+	"github.com/Jiruu246/rms/internal/repos"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func testPNG(t *testing.T, width, height int) []byte {
+	img := image.NewRGBA(image.Rect(0, 0, width, height))
+	for x := 0; x < width; x++ {
+		img.Set(x, x%height, color.RGBA{R: 200, A: 255})
+	}
+	var buf bytes.Buffer
+	require.NoError(t, png.Encode(&buf, img))
+	return buf.Bytes()
+}
+
+func TestImageService_SetRestaurantLogo(t *testing.T) {
+	restaurantID := uuid.New()
+	oldKeys := []string{"restaurants/old/logo/a.png", "restaurants/old/logo/a-thumb.png"}
+	valid := testPNG(t, 800, 400)
+
+	testCases := []struct {
+		name          string
+		data          []byte
+		maxBytes      int64
+		authErr       error
+		setErr        error
+		expectedError error
+	}{
+		{
+			name:     "valid",
+			data:     valid,
+			maxBytes: 1 << 20,
+		},
+		{
+			name:          "not owner",
+			data:          valid,
+			maxBytes:      1 << 20,
+			authErr:       apperr.ErrForbidden,
+			expectedError: apperr.ErrForbidden,
+		},
+		{
+			name:          "not an image",
+			data:          []byte("GIF89a but not really"),
+			maxBytes:      1 << 20,
+			expectedError: apperr.ErrInvalid,
+		},
+		{
+			name:          "too large",
+			data:          valid,
+			maxBytes:      int64(len(valid) - 1),
+			expectedError: apperr.ErrInvalid,
+		},
+		{
+			name:          "saving fails",
+			data:          valid,
+			maxBytes:      1 << 20,
+			setErr:        errors.New("database error"),
+			expectedError: errors.New("database error"),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			store := blobs.NewMemoryStore("https://cdn.example.com")
+			for _, key := range oldKeys {
+				require.NoError(t, store.Put(t.Context(), key, "image/png", []byte("old")))
+			}
+			mockRepo := new(MockRestaurantRepository)
+			mockRestaurantService := new(MockRestaurantService)
+			mockRestaurantService.On("AuthorizeOwnership", mock.Anything, adminActor, ActionUpdateRestaurant, restaurantID).
+				Return(tc.authErr)
+			var saved *repos.ImageData
+			setLogo := mockRepo.On("SetLogo", mock.Anything, restaurantID, mock.Anything).
+				Run(func(args mock.Arguments) { saved = args.Get(2).(*repos.ImageData) }).
+				Maybe()
+			if tc.setErr != nil {
+				setLogo.Return(nil, nil, tc.setErr)
+			} else {
+				setLogo.Return(&dto.RestaurantResponse{ID: restaurantID}, oldKeys, nil)
+			}
+
+			svc := NewImageService(store, tc.maxBytes, nil, nil, mockRepo, mockRestaurantService)
+			restaurant, err := svc.SetRestaurantLogo(t.Context(), adminActor, restaurantID, tc.data)
+
+			if tc.expectedError != nil {
+				require.Error(t, err)
+				if errors.Is(tc.expectedError, apperr.ErrForbidden) || errors.Is(tc.expectedError, apperr.ErrInvalid) {
+					assert.ErrorIs(t, err, tc.expectedError)
+				} else {
+					assert.Contains(t, err.Error(), tc.expectedError.Error())
+				}
+				// Only the old logo is left.
+				assert.ElementsMatch(t, oldKeys, store.Keys())
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, restaurantID, restaurant.ID)
+
+			// The old logo was replaced by the new one and its thumbnail.
+			require.NotNil(t, saved)
+			assert.ElementsMatch(t, saved.Keys, store.Keys())
+			require.Len(t, saved.Keys, 2)
+			prefix := "restaurants/" + restaurantID.String() + "/logo/"
+			assert.True(t, strings.HasPrefix(saved.Keys[0], prefix), saved.Keys[0])
+			assert.Equal(t, "https://cdn.example.com/"+saved.Keys[0], saved.URL)
+			assert.Equal(t, "https://cdn.example.com/"+saved.Keys[1], saved.ThumbnailURL)
+
+			original, contentType, ok := store.Get(saved.Keys[0])
+			require.True(t, ok)
+			assert.Equal(t, "image/png", contentType)
+			assert.Equal(t, tc.data, original)
+			thumb, _, ok := store.Get(saved.Keys[1])
+			require.True(t, ok)
+			cfg, err := png.DecodeConfig(bytes.NewReader(thumb))
+			require.NoError(t, err)
+			assert.Equal(t, thumbnailSize, cfg.Width)
+			assert.Equal(t, thumbnailSize/2, cfg.Height)
+		})
+	}
+}
@@ -0,0 +1,203 @@
+package services
+
+import (
+	"context"
+
+	"github.com/Jiruu246/rms/internal/apperr"
+	"github.com/Jiruu246/rms/internal/authz"
+	"github.com/Jiruu246/rms/internal/dto"
+	"github.com/Jiruu246/rms/internal/repos"
+	"github.com/google/uuid"
+)
+
+const (
+	ActionCreateIngredient authz.Action = "ingredient:create"
+	ActionReadIngredient   authz.Action = "ingredient:read"
+	ActionUpdateIngredient authz.Action = "ingredient:update"
+	ActionDeleteIngredient authz.Action = "ingredient:delete"
+	ActionAdjustStock      authz.Action = "ingredient:adjust"
+	ActionReadRecipe       authz.Action = "recipe:read"
+	ActionUpdateRecipe     authz.Action = "recipe:update"
+)
+
+// IngredientService manages a restaurant's stock: ingredients, the recipes
+// linking menu items and modifier options to them, and stock adjustments.
+// Sales draw stock down on their own; see repos.OrderRepository.Create.
+type IngredientService interface {
+	Create(ctx context.Context, actor authz.Actor, req *dto.CreateIngredientRequest) (*dto.Ingredient, error)
+	GetByID(ctx context.Context, actor authz.Actor, id uuid.UUID) (*dto.Ingredient, error)
+	GetAllByRestaurant(ctx context.Context, actor authz.Actor, restaurantID uuid.UUID) ([]*dto.Ingredient, error)
+	Update(ctx context.Context, actor authz.Actor, id uuid.UUID, req *dto.UpdateIngredientRequest) (*dto.Ingredient, error)
+	Delete(ctx context.Context, actor authz.Actor, id uuid.UUID) error
+	Adjust(ctx context.Context, actor authz.Actor, id uuid.UUID, req *dto.StockAdjustmentRequest) (*dto.StockMovement, error)
+	GetMovements(ctx context.Context, actor authz.Actor, id uuid.UUID) ([]*dto.StockMovement, error)
+	GetMenuItemRecipe(ctx context.Context, actor authz.Actor, menuItemID int64) (*dto.Recipe, error)
+	SetMenuItemRecipe(ctx context.Context, actor authz.Actor, menuItemID int64, req *dto.SetRecipeRequest) (*dto.Recipe, error)
+	GetModifierOptionRecipe(ctx context.Context, actor authz.Actor, optionID uuid.UUID) (*dto.Recipe, error)
+	SetModifierOptionRecipe(ctx context.Context, actor authz.Actor, optionID uuid.UUID, req *dto.SetRecipeRequest) (*dto.Recipe, error)
+}
+
+type ingredientService struct {
+	repo              repos.IngredientRepository
+	restaurantService RestaurantService
+	authorizer        authz.Authorizer
+}
+
+func NewIngredientService(repo repos.IngredientRepository, restaurantService RestaurantService) IngredientService {
+	return &ingredientService{
+		repo:              repo,
+		restaurantService: restaurantService,
+		authorizer:        authz.NewPolicyAuthorizer(),
+	}
+}
+
+func (s *ingredientService) Create(ctx context.Context, actor authz.Actor, req *dto.CreateIngredientRequest) (*dto.Ingredient, error) {
+	if err := s.restaurantService.AuthorizeOwnership(ctx, actor, ActionCreateIngredient, req.RestaurantID); err != nil {
+		return nil, err
+	}
+
+	return s.repo.Create(ctx, &repos.CreateIngredientData{Request: req, CreatedBy: actor.UserID})
+}
+
+func (s *ingredientService) GetByID(ctx context.Context, actor authz.Actor, id uuid.UUID) (*dto.Ingredient, error) {
+	resource, err := s.authorize(ctx, actor, ActionReadIngredient, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.repo.GetByID(ctx, resource.RestaurantID, id)
+}
+
+func (s *ingredientService) GetAllByRestaurant(ctx context.Context, actor authz.Actor, restaurantID uuid.UUID) ([]*dto.Ingredient, error) {
+	if err := s.restaurantService.AuthorizeOwnership(ctx, actor, ActionReadIngredient, restaurantID); err != nil {
+		return nil, err
+	}
+
+	return s.repo.GetAllByRestaurant(ctx, restaurantID)
+}
+
+func (s *ingredientService) Update(ctx context.Context, actor authz.Actor, id uuid.UUID, req *dto.UpdateIngredientRequest) (*dto.Ingredient, error) {
+	resource, err := s.authorize(ctx, actor, ActionUpdateIngredient, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.repo.Update(ctx, resource.RestaurantID, id, req)
+}
+
+func (s *ingredientService) Delete(ctx context.Context, actor authz.Actor, id uuid.UUID) error {
+	resource, err := s.authorize(ctx, actor, ActionDeleteIngredient, id)
+	if err != nil {
+		return err
+	}
+
+	return s.repo.Delete(ctx, resource.RestaurantID, id)
+}
+
+// Adjust records a delivery, waste or stock count. Deliveries and waste
+// must move a positive quantity; a count may set stock to zero.
+func (s *ingredientService) Adjust(ctx context.Context, actor authz.Actor, id uuid.UUID, req *dto.StockAdjustmentRequest) (*dto.StockMovement, error) {
+	resource, err := s.authorize(ctx, actor, ActionAdjustStock, id)
+	if err != nil {
+		return nil, err
+	}
+
+	kind := dto.StockMovementKind(req.Kind)
+	switch kind {
+	case dto.StockMovementDELIVERY, dto.StockMovementWASTE:
+		if req.Quantity <= 0 {
+			return nil, apperr.Invalid("quantity must be positive for a %s", kind)
+		}
+	case dto.StockMovementCOUNT:
+	default:
+		return nil, apperr.Invalid("invalid stock adjustment kind %s", req.Kind)
+	}
+
+	return s.repo.Adjust(ctx, &repos.StockAdjustmentData{
+		RestaurantID: resource.RestaurantID,
+		IngredientID: id,
+		Kind:         kind,
+		Quantity:     req.Quantity,
+		Note:         req.Note,
+		CreatedBy:    actor.UserID,
+	})
+}
+
+func (s *ingredientService) GetMovements(ctx context.Context, actor authz.Actor, id uuid.UUID) ([]*dto.StockMovement, error) {
+	resource, err := s.authorize(ctx, actor, ActionReadIngredient, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.repo.GetMovements(ctx, resource.RestaurantID, id)
+}
+
+func (s *ingredientService) GetMenuItemRecipe(ctx context.Context, actor authz.Actor, menuItemID int64) (*dto.Recipe, error) {
+	return s.getRecipe(ctx, actor, repos.RecipeTarget{MenuItemID: &menuItemID})
+}
+
+func (s *ingredientService) SetMenuItemRecipe(ctx context.Context, actor authz.Actor, menuItemID int64, req *dto.SetRecipeRequest) (*dto.Recipe, error) {
+	return s.setRecipe(ctx, actor, repos.RecipeTarget{MenuItemID: &menuItemID}, req)
+}
+
+func (s *ingredientService) GetModifierOptionRecipe(ctx context.Context, actor authz.Actor, optionID uuid.UUID) (*dto.Recipe, error) {
+	return s.getRecipe(ctx, actor, repos.RecipeTarget{ModifierOptionID: &optionID})
+}
+
+func (s *ingredientService) SetModifierOptionRecipe(ctx context.Context, actor authz.Actor, optionID uuid.UUID, req *dto.SetRecipeRequest) (*dto.Recipe, error) {
+	return s.setRecipe(ctx, actor, repos.RecipeTarget{ModifierOptionID: &optionID}, req)
+}
+
+func (s *ingredientService) getRecipe(ctx context.Context, actor authz.Actor, target repos.RecipeTarget) (*dto.Recipe, error) {
+	resource, err := s.authorizeRecipe(ctx, actor, ActionReadRecipe, target)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.repo.GetRecipe(ctx, resource.RestaurantID, target)
+}
+
+func (s *ingredientService) setRecipe(ctx context.Context, actor authz.Actor, target repos.RecipeTarget, req *dto.SetRecipeRequest) (*dto.Recipe, error) {
+	resource, err := s.authorizeRecipe(ctx, actor, ActionUpdateRecipe, target)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.repo.SetRecipe(ctx, resource.RestaurantID, target, req.Ingredients)
+}
+
+// authorize resolves the ingredient's authz.Resource and checks the actor
+// may perform action on it.
+func (s *ingredientService) authorize(ctx context.Context, actor authz.Actor, action authz.Action, id uuid.UUID) (authz.Resource, error) {
+	resource, err := s.repo.GetAuthorizationResource(ctx, id)
+	if err != nil {
+		return authz.Resource{}, err
+	}
+
+	if _, err := s.authorizer.Authorize(ctx, authz.Request{
+		Actor:    actor,
+		Action:   action,
+		Resource: resource,
+	}); err != nil {
+		return authz.Resource{}, err
+	}
+	return resource, nil
+}
+
+// authorizeRecipe is authorize for recipes, which are governed by the menu
+// item or modifier option they belong to.
+func (s *ingredientService) authorizeRecipe(ctx context.Context, actor authz.Actor, action authz.Action, target repos.RecipeTarget) (authz.Resource, error) {
+	resource, err := s.repo.GetRecipeAuthorizationResource(ctx, target)
+	if err != nil {
+		return authz.Resource{}, err
+	}
+
+	if _, err := s.authorizer.Authorize(ctx, authz.Request{
+		Actor:    actor,
+		Action:   action,
+		Resource: resource,
+	}); err != nil {
+		return authz.Resource{}, err
+	}
+	return resource, nil
+}
@@ -0,0 +1,199 @@
+package services
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Jiruu246/rms/internal/apperr"
+	"github.com/Jiruu246/rms/internal/authz"
+	"github.com/Jiruu246/rms/internal/dto"
+	"github.com/Jiruu246/rms/internal/repos"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// MockIngredientRepository is a mock implementation of IngredientRepository
+type MockIngredientRepository struct {
+	mock.Mock
+}
+
+func (m *MockIngredientRepository) Create(ctx context.Context, data *repos.CreateIngredientData) (*dto.Ingredient, error) {
+	args := m.Called(ctx, data)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*dto.Ingredient), args.Error(1)
+}
+
+func (m *MockIngredientRepository) GetByID(ctx context.Context, restaurantID, id uuid.UUID) (*dto.Ingredient, error) {
+	args := m.Called(ctx, restaurantID, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*dto.Ingredient), args.Error(1)
+}
+
+func (m *MockIngredientRepository) GetAllByRestaurant(ctx context.Context, restaurantID uuid.UUID) ([]*dto.Ingredient, error) {
+	args := m.Called(ctx, restaurantID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*dto.Ingredient), args.Error(1)
+}
+
+func (m *MockIngredientRepository) Update(ctx context.Context, restaurantID, id uuid.UUID, req *dto.UpdateIngredientRequest) (*dto.Ingredient, error) {
+	args := m.Called(ctx, restaurantID, id, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*dto.Ingredient), args.Error(1)
+}
+
+func (m *MockIngredientRepository) Delete(ctx context.Context, restaurantID, id uuid.UUID) error {
+	args := m.Called(ctx, restaurantID, id)
+	return args.Error(0)
+}
+
+func (m *MockIngredientRepository) Adjust(ctx context.Context, data *repos.StockAdjustmentData) (*dto.StockMovement, error) {
+	args := m.Called(ctx, data)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*dto.StockMovement), args.Error(1)
+}
+
+func (m *MockIngredientRepository) GetMovements(ctx context.Context, restaurantID, id uuid.UUID) ([]*dto.StockMovement, error) {
+	args := m.Called(ctx, restaurantID, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*dto.StockMovement), args.Error(1)
+}
+
+func (m *MockIngredientRepository) GetRecipe(ctx context.Context, restaurantID uuid.UUID, target repos.RecipeTarget) (*dto.Recipe, error) {
+	args := m.Called(ctx, restaurantID, target)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*dto.Recipe), args.Error(1)
+}
+
+func (m *MockIngredientRepository) SetRecipe(ctx context.Context, restaurantID uuid.UUID, target repos.RecipeTarget, lines []dto.RecipeLineRequest) (*dto.Recipe, error) {
+	args := m.Called(ctx, restaurantID, target, lines)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*dto.Recipe), args.Error(1)
+}
+
+func (m *MockIngredientRepository) GetAuthorizationResource(ctx context.Context, id uuid.UUID) (authz.Resource, error) {
+	args := m.Called(ctx, id)
+	return args.Get(0).(authz.Resource), args.Error(1)
+}
+
+func (m *MockIngredientRepository) GetRecipeAuthorizationResource(ctx context.Context, target repos.RecipeTarget) (authz.Resource, error) {
+	args := m.Called(ctx, target)
+	return args.Get(0).(authz.Resource), args.Error(1)
+}
+
+func TestIngredientService_Create(t *testing.T) {
+	restaurantID := uuid.New()
+	req := &dto.CreateIngredientRequest{Name: "Flour", Unit: "g", OnHand: 5000, RestaurantID: restaurantID}
+
+	t.Run("owner", func(t *testing.T) {
+		repo := new(MockIngredientRepository)
+		restaurantService := new(MockRestaurantService)
+		restaurantService.On("AuthorizeOwnership", mock.Anything, adminActor, ActionCreateIngredient, restaurantID).Return(nil)
+		repo.On("Create", mock.Anything, &repos.CreateIngredientData{Request: req, CreatedBy: adminActor.UserID}).
+			Return(&dto.Ingredient{ID: uuid.New(), Name: "Flour", Unit: dto.IngredientUnitG, OnHand: 5000}, nil)
+
+		ingredient, err := NewIngredientService(repo, restaurantService).Create(t.Context(), adminActor, req)
+
+		require.NoError(t, err)
+		assert.Equal(t, int64(5000), ingredient.OnHand)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("forbidden", func(t *testing.T) {
+		repo := new(MockIngredientRepository)
+		restaurantService := new(MockRestaurantService)
+		restaurantService.On("AuthorizeOwnership", mock.Anything, adminActor, ActionCreateIngredient, restaurantID).Return(apperr.ErrForbidden)
+
+		ingredient, err := NewIngredientService(repo, restaurantService).Create(t.Context(), adminActor, req)
+
+		assert.ErrorIs(t, err, apperr.ErrForbidden)
+		assert.Nil(t, ingredient)
+		repo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+	})
+}
+
+func TestIngredientService_Adjust(t *testing.T) {
+	restaurantID := uuid.New()
+	ingredientID := uuid.New()
+	resource := authz.Resource{Type: "ingredient", ID: ingredientID, RestaurantID: restaurantID, OwnerUserID: uuid.New()}
+
+	testCases := []struct {
+		name          string
+		request       *dto.StockAdjustmentRequest
+		expectedError error
+	}{
+		{name: "delivery", request: &dto.StockAdjustmentRequest{Kind: "DELIVERY", Quantity: 1000, Note: "weekly order"}},
+		{name: "waste", request: &dto.StockAdjustmentRequest{Kind: "WASTE", Quantity: 200}},
+		{name: "count down to nothing", request: &dto.StockAdjustmentRequest{Kind: "COUNT", Quantity: 0}},
+		{name: "empty delivery", request: &dto.StockAdjustmentRequest{Kind: "DELIVERY", Quantity: 0}, expectedError: apperr.ErrInvalid},
+		{name: "empty waste", request: &dto.StockAdjustmentRequest{Kind: "WASTE", Quantity: 0}, expectedError: apperr.ErrInvalid},
+		{name: "sales are not adjustments", request: &dto.StockAdjustmentRequest{Kind: "SALE", Quantity: 1}, expectedError: apperr.ErrInvalid},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			repo := new(MockIngredientRepository)
+			repo.On("GetAuthorizationResource", mock.Anything, ingredientID).Return(resource, nil)
+			if tc.expectedError == nil {
+				repo.On("Adjust", mock.Anything, &repos.StockAdjustmentData{
+					RestaurantID: restaurantID,
+					IngredientID: ingredientID,
+					Kind:         dto.StockMovementKind(tc.request.Kind),
+					Quantity:     tc.request.Quantity,
+					Note:         tc.request.Note,
+					CreatedBy:    adminActor.UserID,
+				}).Return(&dto.StockMovement{IngredientID: ingredientID, Kind: dto.StockMovementKind(tc.request.Kind)}, nil)
+			}
+
+			svc := NewIngredientService(repo, new(MockRestaurantService))
+			movement, err := svc.Adjust(t.Context(), adminActor, ingredientID, tc.request)
+
+			if tc.expectedError != nil {
+				assert.ErrorIs(t, err, tc.expectedError)
+				assert.Nil(t, movement)
+				repo.AssertNotCalled(t, "Adjust", mock.Anything, mock.Anything)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, dto.StockMovementKind(tc.request.Kind), movement.Kind)
+			repo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestIngredientService_SetMenuItemRecipe_Forbidden(t *testing.T) {
+	menuItemID := int64(42)
+	target := repos.RecipeTarget{MenuItemID: &menuItemID}
+	repo := new(MockIngredientRepository)
+	repo.On("GetRecipeAuthorizationResource", mock.Anything, target).Return(authz.Resource{
+		Type:         "menu_item",
+		RestaurantID: uuid.New(),
+		OwnerUserID:  uuid.New(),
+	}, nil)
+
+	svc := NewIngredientService(repo, new(MockRestaurantService))
+	recipe, err := svc.SetMenuItemRecipe(t.Context(), authz.Actor{UserID: uuid.New()}, menuItemID, &dto.SetRecipeRequest{
+		Ingredients: []dto.RecipeLineRequest{{IngredientID: uuid.New(), Quantity: 150}},
+	})
+
+	assert.ErrorIs(t, err, apperr.ErrForbidden)
+	assert.Nil(t, recipe)
+	repo.AssertNotCalled(t, "SetRecipe", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
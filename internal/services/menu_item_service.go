@@ -2,43 +2,190 @@ package services
 
 import (
 	"context"
+	"strings"
 
+	"github.com/Jiruu246/rms/internal/apperr"
+	"github.com/Jiruu246/rms/internal/authz"
+	"github.com/Jiruu246/rms/internal/blobs"
 	"github.com/Jiruu246/rms/internal/dto"
 	"github.com/Jiruu246/rms/internal/repos"
+	"github.com/google/uuid"
+)
+
+const (
+	ActionCreateMenuItem authz.Action = "menu_item:create"
+	ActionReadMenuItem   authz.Action = "menu_item:read"
+	ActionUpdateMenuItem authz.Action = "menu_item:update"
+	ActionDeleteMenuItem authz.Action = "menu_item:delete"
 )
 
 type MenuItemService interface {
-	Create(ctx context.Context, req *dto.CreateMenuItemRequest) (*dto.MenuItem, error)
-	GetAll(ctx context.Context) ([]*dto.MenuItem, error)
-	GetByID(ctx context.Context, id int64) (*dto.MenuItem, error)
-	Update(ctx context.Context, id int64, req *dto.UpdateMenuItemRequest) (*dto.MenuItem, error)
-	Delete(ctx context.Context, id int64) error
+	Create(ctx context.Context, actor authz.Actor, req *dto.CreateMenuItemRequest) (*dto.MenuItem, error)
+	GetAllByRestaurant(ctx context.Context, actor authz.Actor, restaurantID uuid.UUID) ([]*dto.MenuItem, error)
+	GetByID(ctx context.Context, actor authz.Actor, id int64) (*dto.MenuItem, error)
+	Update(ctx context.Context, actor authz.Actor, id int64, req *dto.UpdateMenuItemRequest) (*dto.MenuItem, error)
+	Delete(ctx context.Context, actor authz.Actor, id int64) error
+	// SetVariants replaces the item's variants; see
+	// dto.SetMenuItemVariantsRequest.
+	SetVariants(ctx context.Context, actor authz.Actor, id int64, req *dto.SetMenuItemVariantsRequest) (*dto.MenuItem, error)
+	// GetModifiers, AttachModifier, SetModifierOverrides, DetachModifier
+	// and ReorderModifiers manage the modifier groups attached to the
+	// item; see repos.MenuItemRepository.
+	GetModifiers(ctx context.Context, actor authz.Actor, id int64) ([]dto.MenuItemModifier, error)
+	AttachModifier(ctx context.Context, actor authz.Actor, id int64, req *dto.AttachMenuItemModifierRequest) (*dto.MenuItemModifier, error)
+	SetModifierOverrides(ctx context.Context, actor authz.Actor, id int64, modifierID uuid.UUID, req *dto.MenuItemModifierOverrides) (*dto.MenuItemModifier, error)
+	DetachModifier(ctx context.Context, actor authz.Actor, id int64, modifierID uuid.UUID) error
+	ReorderModifiers(ctx context.Context, actor authz.Actor, id int64, req *dto.ReorderMenuItemModifiersRequest) ([]dto.MenuItemModifier, error)
 }
 
 type menuItemService struct {
-	repo repos.MenuItemRepository
+	repo              repos.MenuItemRepository
+	restaurantService RestaurantService
+	blobs             blobs.Store
+	authorizer        authz.Authorizer
 }
 
-func NewMenuItemService(repo repos.MenuItemRepository) MenuItemService {
-	return &menuItemService{repo: repo}
+func NewMenuItemService(repo repos.MenuItemRepository, restaurantService RestaurantService, blobStore blobs.Store) MenuItemService {
+	return &menuItemService{
+		repo:              repo,
+		restaurantService: restaurantService,
+		blobs:             blobStore,
+		authorizer:        authz.NewPolicyAuthorizer(),
+	}
 }
 
-func (s *menuItemService) Create(ctx context.Context, req *dto.CreateMenuItemRequest) (*dto.MenuItem, error) {
+// Create requires the actor to own the restaurant named by req.RestaurantID,
+// as the item has no owner of its own until it exists.
+func (s *menuItemService) Create(ctx context.Context, actor authz.Actor, req *dto.CreateMenuItemRequest) (*dto.MenuItem, error) {
+	if err := s.restaurantService.AuthorizeOwnership(ctx, actor, ActionCreateMenuItem, req.RestaurantID); err != nil {
+		return nil, err
+	}
 	return s.repo.Create(ctx, req)
 }
 
-func (s *menuItemService) GetAll(ctx context.Context) ([]*dto.MenuItem, error) {
-	return s.repo.GetAll(ctx)
+func (s *menuItemService) GetAllByRestaurant(ctx context.Context, actor authz.Actor, restaurantID uuid.UUID) ([]*dto.MenuItem, error) {
+	if err := s.restaurantService.AuthorizeOwnership(ctx, actor, ActionReadMenuItem, restaurantID); err != nil {
+		return nil, err
+	}
+	return s.repo.GetAllByRestaurant(ctx, restaurantID)
+}
+
+func (s *menuItemService) GetByID(ctx context.Context, actor authz.Actor, id int64) (*dto.MenuItem, error) {
+	resource, err := s.authorize(ctx, actor, ActionReadMenuItem, id)
+	if err != nil {
+		return nil, err
+	}
+	return s.repo.GetByID(ctx, resource.RestaurantID, id)
+}
+
+func (s *menuItemService) Update(ctx context.Context, actor authz.Actor, id int64, req *dto.UpdateMenuItemRequest) (*dto.MenuItem, error) {
+	resource, err := s.authorize(ctx, actor, ActionUpdateMenuItem, id)
+	if err != nil {
+		return nil, err
+	}
+	return s.repo.Update(ctx, resource.RestaurantID, id, req)
+}
+
+func (s *menuItemService) Delete(ctx context.Context, actor authz.Actor, id int64) error {
+	resource, err := s.authorize(ctx, actor, ActionDeleteMenuItem, id)
+	if err != nil {
+		return err
+	}
+	keys, err := s.repo.GetImageKeys(ctx, resource.RestaurantID, id)
+	if err != nil {
+		return err
+	}
+	if err := s.repo.Delete(ctx, resource.RestaurantID, id); err != nil {
+		return err
+	}
+	deleteBlobs(ctx, s.blobs, keys)
+	return nil
+}
+
+// authorize resolves the item's authz.Resource and checks the actor may
+// perform action on it. Callers scope their repo query by the returned
+// resource's RestaurantID.
+func (s *menuItemService) authorize(ctx context.Context, actor authz.Actor, action authz.Action, id int64) (authz.Resource, error) {
+	resource, err := s.repo.GetAuthorizationResource(ctx, id)
+	if err != nil {
+		return authz.Resource{}, err
+	}
+	if _, err := s.authorizer.Authorize(ctx, authz.Request{
+		Actor:    actor,
+		Action:   action,
+		Resource: resource,
+	}); err != nil {
+		return authz.Resource{}, err
+	}
+	return resource, nil
+}
+
+func (s *menuItemService) SetVariants(ctx context.Context, actor authz.Actor, id int64, req *dto.SetMenuItemVariantsRequest) (*dto.MenuItem, error) {
+	resource, err := s.authorize(ctx, actor, ActionUpdateMenuItem, id)
+	if err != nil {
+		return nil, err
+	}
+
+	names := make(map[string]bool, len(req.Variants))
+	ids := make(map[uuid.UUID]bool, len(req.Variants))
+	for i := range req.Variants {
+		v := &req.Variants[i]
+		v.Name = strings.TrimSpace(v.Name)
+		if v.Name == "" {
+			return nil, apperr.Invalid("variant names cannot be blank")
+		}
+		if key := strings.ToLower(v.Name); names[key] {
+			return nil, apperr.Invalid("variant %q is listed more than once", v.Name)
+		} else {
+			names[key] = true
+		}
+		if v.ID != nil {
+			if ids[*v.ID] {
+				return nil, apperr.Invalid("variant %s is listed more than once", *v.ID)
+			}
+			ids[*v.ID] = true
+		}
+	}
+
+	return s.repo.SetVariants(ctx, resource.RestaurantID, id, req.Variants)
+}
+
+func (s *menuItemService) GetModifiers(ctx context.Context, actor authz.Actor, id int64) ([]dto.MenuItemModifier, error) {
+	resource, err := s.authorize(ctx, actor, ActionReadMenuItem, id)
+	if err != nil {
+		return nil, err
+	}
+	return s.repo.GetModifiers(ctx, resource.RestaurantID, id)
+}
+
+func (s *menuItemService) AttachModifier(ctx context.Context, actor authz.Actor, id int64, req *dto.AttachMenuItemModifierRequest) (*dto.MenuItemModifier, error) {
+	resource, err := s.authorize(ctx, actor, ActionUpdateMenuItem, id)
+	if err != nil {
+		return nil, err
+	}
+	return s.repo.AttachModifier(ctx, resource.RestaurantID, id, req)
 }
 
-func (s *menuItemService) GetByID(ctx context.Context, id int64) (*dto.MenuItem, error) {
-	return s.repo.GetByID(ctx, id)
+func (s *menuItemService) SetModifierOverrides(ctx context.Context, actor authz.Actor, id int64, modifierID uuid.UUID, req *dto.MenuItemModifierOverrides) (*dto.MenuItemModifier, error) {
+	resource, err := s.authorize(ctx, actor, ActionUpdateMenuItem, id)
+	if err != nil {
+		return nil, err
+	}
+	return s.repo.SetModifierOverrides(ctx, resource.RestaurantID, id, modifierID, req)
 }
 
-func (s *menuItemService) Update(ctx context.Context, id int64, req *dto.UpdateMenuItemRequest) (*dto.MenuItem, error) {
-	return s.repo.Update(ctx, id, req)
+func (s *menuItemService) DetachModifier(ctx context.Context, actor authz.Actor, id int64, modifierID uuid.UUID) error {
+	resource, err := s.authorize(ctx, actor, ActionUpdateMenuItem, id)
+	if err != nil {
+		return err
+	}
+	return s.repo.DetachModifier(ctx, resource.RestaurantID, id, modifierID)
 }
 
-func (s *menuItemService) Delete(ctx context.Context, id int64) error {
-	return s.repo.Delete(ctx, id)
+func (s *menuItemService) ReorderModifiers(ctx context.Context, actor authz.Actor, id int64, req *dto.ReorderMenuItemModifiersRequest) ([]dto.MenuItemModifier, error) {
+	resource, err := s.authorize(ctx, actor, ActionUpdateMenuItem, id)
+	if err != nil {
+		return nil, err
+	}
+	return s.repo.ReorderModifiers(ctx, resource.RestaurantID, id, req.ModifierIDs)
 }
@@ -0,0 +1,217 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/Jiruu246/rms/internal/apperr"
+	"github.com/Jiruu246/rms/internal/authz"
+	"github.com/Jiruu246/rms/internal/dto"
+	"github.com/Jiruu246/rms/internal/repos"
+	"github.com/Jiruu246/rms/pkg/hours"
+	"github.com/google/uuid"
+)
+
+const (
+	ActionCreateMenu authz.Action = "menu:create"
+	ActionReadMenu   authz.Action = "menu:read"
+	ActionUpdateMenu authz.Action = "menu:update"
+	ActionDeleteMenu authz.Action = "menu:delete"
+)
+
+// MenuService manages a restaurant's menus. Which menus are served when
+// decides what can be ordered and at what price; see menuPrices.
+type MenuService interface {
+	Create(ctx context.Context, actor authz.Actor, req *dto.CreateMenuRequest) (*dto.Menu, error)
+	GetByID(ctx context.Context, actor authz.Actor, id uuid.UUID) (*dto.Menu, error)
+	GetAllByRestaurant(ctx context.Context, actor authz.Actor, restaurantID uuid.UUID) ([]*dto.Menu, error)
+	Update(ctx context.Context, actor authz.Actor, id uuid.UUID, req *dto.UpdateMenuRequest) (*dto.Menu, error)
+	Delete(ctx context.Context, actor authz.Actor, id uuid.UUID) error
+	SetContents(ctx context.Context, actor authz.Actor, id uuid.UUID, req *dto.SetMenuContentsRequest) (*dto.Menu, error)
+	// GetPublicMenu returns the restaurant's catalogue as customers see it.
+	// It is public, so it takes no actor.
+	GetPublicMenu(ctx context.Context, restaurantID uuid.UUID) (*dto.PublicMenu, error)
+}
+
+type menuService struct {
+	repo              repos.MenuRepository
+	restaurantService RestaurantService
+	authorizer        authz.Authorizer
+}
+
+func NewMenuService(repo repos.MenuRepository, restaurantService RestaurantService) MenuService {
+	return &menuService{
+		repo:              repo,
+		restaurantService: restaurantService,
+		authorizer:        authz.NewPolicyAuthorizer(),
+	}
+}
+
+func (s *menuService) Create(ctx context.Context, actor authz.Actor, req *dto.CreateMenuRequest) (*dto.Menu, error) {
+	if err := s.restaurantService.AuthorizeOwnership(ctx, actor, ActionCreateMenu, req.RestaurantID); err != nil {
+		return nil, err
+	}
+	if err := validateMenuSchedule(req.Schedule); err != nil {
+		return nil, err
+	}
+
+	return s.repo.Create(ctx, req)
+}
+
+func (s *menuService) GetByID(ctx context.Context, actor authz.Actor, id uuid.UUID) (*dto.Menu, error) {
+	resource, err := s.authorize(ctx, actor, ActionReadMenu, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.repo.GetByID(ctx, resource.RestaurantID, id)
+}
+
+func (s *menuService) GetAllByRestaurant(ctx context.Context, actor authz.Actor, restaurantID uuid.UUID) ([]*dto.Menu, error) {
+	if err := s.restaurantService.AuthorizeOwnership(ctx, actor, ActionReadMenu, restaurantID); err != nil {
+		return nil, err
+	}
+
+	return s.repo.GetAllByRestaurant(ctx, restaurantID)
+}
+
+func (s *menuService) Update(ctx context.Context, actor authz.Actor, id uuid.UUID, req *dto.UpdateMenuRequest) (*dto.Menu, error) {
+	resource, err := s.authorize(ctx, actor, ActionUpdateMenu, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := validateMenuSchedule(req.Schedule); err != nil {
+		return nil, err
+	}
+
+	return s.repo.Update(ctx, resource.RestaurantID, id, req)
+}
+
+func (s *menuService) Delete(ctx context.Context, actor authz.Actor, id uuid.UUID) error {
+	resource, err := s.authorize(ctx, actor, ActionDeleteMenu, id)
+	if err != nil {
+		return err
+	}
+
+	return s.repo.Delete(ctx, resource.RestaurantID, id)
+}
+
+func (s *menuService) SetContents(ctx context.Context, actor authz.Actor, id uuid.UUID, req *dto.SetMenuContentsRequest) (*dto.Menu, error) {
+	resource, err := s.authorize(ctx, actor, ActionUpdateMenu, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.repo.SetContents(ctx, resource.RestaurantID, id, req)
+}
+
+func (s *menuService) GetPublicMenu(ctx context.Context, restaurantID uuid.UUID) (*dto.PublicMenu, error) {
+	return s.repo.GetPublicMenu(ctx, restaurantID)
+}
+
+// authorize resolves the menu's authz.Resource and checks the actor may
+// perform action on it.
+func (s *menuService) authorize(ctx context.Context, actor authz.Actor, action authz.Action, id uuid.UUID) (authz.Resource, error) {
+	resource, err := s.repo.GetAuthorizationResource(ctx, id)
+	if err != nil {
+		return authz.Resource{}, err
+	}
+
+	if _, err := s.authorizer.Authorize(ctx, authz.Request{
+		Actor:    actor,
+		Action:   action,
+		Resource: resource,
+	}); err != nil {
+		return authz.Resource{}, err
+	}
+	return resource, nil
+}
+
+// validateMenuSchedule checks the rules of hours.Schedule.Validate that the
+// request's validate tags cannot express.
+func validateMenuSchedule(schedule *hours.Schedule) error {
+	if schedule == nil {
+		return nil
+	}
+	if err := schedule.Validate(); err != nil {
+		return apperr.Invalid("invalid schedule: %v", err)
+	}
+	return nil
+}
+
+// menuPrices decides what each of items costs when ordered as an orderType
+// order for at. An item can be ordered from the first of menus, in display
+// order, that is served then (see menuServed) and has the item's category;
+// it costs that menu's price for it, or its own price if the menu sets
+// none. Items on no such menu are apperr.Invalid. A restaurant without any
+// menus is not restricted, and its items cost their own price.
+func menuPrices(
+	menus []*dto.Menu,
+	restaurant *dto.RestaurantResponse,
+	orderType dto.OrderType,
+	at time.Time,
+	items map[int64]*dto.MenuItem,
+) (map[int64]int64, error) {
+	prices := make(map[int64]int64, len(items))
+	if len(menus) == 0 {
+		for id, item := range items {
+			prices[id] = item.Price.Amount
+		}
+		return prices, nil
+	}
+
+	loc, err := time.LoadLocation(restaurant.Timezone)
+	if err != nil {
+		return nil, fmt.Errorf("invalid restaurant timezone %q: %w", restaurant.Timezone, err)
+	}
+	var served []*dto.Menu
+	for _, m := range menus {
+		ok, err := menuServed(m, orderType, at, loc)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			served = append(served, m)
+		}
+	}
+
+	for id, item := range items {
+		idx := slices.IndexFunc(served, func(m *dto.Menu) bool {
+			return slices.Contains(m.CategoryIDs, item.CategoryID)
+		})
+		if idx < 0 {
+			return nil, apperr.Invalid("menu item with ID %d is not on a menu served for %s orders at %s",
+				id, orderType, at.In(loc).Format(time.RFC3339))
+		}
+		prices[id] = item.Price.Amount
+		for _, p := range served[idx].ItemPrices {
+			if p.MenuItemID == id {
+				prices[id] = p.Price.Amount
+				break
+			}
+		}
+	}
+	return prices, nil
+}
+
+// menuServed reports whether m is served for orderType orders at at: it is
+// active, applies to orderType, and at falls within its schedule in loc. A
+// menu without weekly hours is served at all times.
+func menuServed(m *dto.Menu, orderType dto.OrderType, at time.Time, loc *time.Location) (bool, error) {
+	if !m.IsActive {
+		return false, nil
+	}
+	if len(m.OrderTypes) > 0 && !slices.Contains(m.OrderTypes, orderType) {
+		return false, nil
+	}
+	if m.Schedule == nil || m.Schedule.IsEmpty() {
+		return true, nil
+	}
+	_, open, err := m.Schedule.OpenSpan(at, loc)
+	if err != nil {
+		return false, fmt.Errorf("invalid schedule on menu %s: %w", m.ID, err)
+	}
+	return open, nil
+}
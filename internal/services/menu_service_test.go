@@ -0,0 +1,245 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/Jiruu246/rms/internal/apperr"
+	"github.com/Jiruu246/rms/internal/authz"
+	"github.com/Jiruu246/rms/internal/dto"
+	"github.com/Jiruu246/rms/pkg/hours"
+	"github.com/Jiruu246/rms/pkg/money"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// MockMenuRepository is a mock implementation of MenuRepository
+type MockMenuRepository struct {
+	mock.Mock
+}
+
+func (m *MockMenuRepository) Create(ctx context.Context, req *dto.CreateMenuRequest) (*dto.Menu, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*dto.Menu), args.Error(1)
+}
+
+func (m *MockMenuRepository) GetByID(ctx context.Context, restaurantID, id uuid.UUID) (*dto.Menu, error) {
+	args := m.Called(ctx, restaurantID, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*dto.Menu), args.Error(1)
+}
+
+func (m *MockMenuRepository) GetAllByRestaurant(ctx context.Context, restaurantID uuid.UUID) ([]*dto.Menu, error) {
+	args := m.Called(ctx, restaurantID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*dto.Menu), args.Error(1)
+}
+
+func (m *MockMenuRepository) GetAllWithContents(ctx context.Context, restaurantID uuid.UUID) ([]*dto.Menu, error) {
+	args := m.Called(ctx, restaurantID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*dto.Menu), args.Error(1)
+}
+
+func (m *MockMenuRepository) Update(ctx context.Context, restaurantID, id uuid.UUID, req *dto.UpdateMenuRequest) (*dto.Menu, error) {
+	args := m.Called(ctx, restaurantID, id, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*dto.Menu), args.Error(1)
+}
+
+func (m *MockMenuRepository) Delete(ctx context.Context, restaurantID, id uuid.UUID) error {
+	args := m.Called(ctx, restaurantID, id)
+	return args.Error(0)
+}
+
+func (m *MockMenuRepository) SetContents(ctx context.Context, restaurantID, id uuid.UUID, req *dto.SetMenuContentsRequest) (*dto.Menu, error) {
+	args := m.Called(ctx, restaurantID, id, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*dto.Menu), args.Error(1)
+}
+
+func (m *MockMenuRepository) GetPublicMenu(ctx context.Context, restaurantID uuid.UUID) (*dto.PublicMenu, error) {
+	args := m.Called(ctx, restaurantID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*dto.PublicMenu), args.Error(1)
+}
+
+func (m *MockMenuRepository) GetAuthorizationResource(ctx context.Context, id uuid.UUID) (authz.Resource, error) {
+	args := m.Called(ctx, id)
+	return args.Get(0).(authz.Resource), args.Error(1)
+}
+
+func TestMenuService_Create(t *testing.T) {
+	restaurantID := uuid.New()
+
+	testCases := []struct {
+		name          string
+		schedule      *hours.Schedule
+		expectedError error
+	}{
+		{
+			name:     "without schedule",
+			schedule: nil,
+		},
+		{
+			name:     "with schedule",
+			schedule: &hours.Schedule{Monday: []hours.Interval{{Open: "07:00", Close: "11:00"}}},
+		},
+		{
+			name:          "overlapping intervals",
+			schedule:      &hours.Schedule{Monday: []hours.Interval{{Open: "07:00", Close: "11:00"}, {Open: "10:00", Close: "14:00"}}},
+			expectedError: apperr.ErrInvalid,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockRepo := new(MockMenuRepository)
+			mockRestaurantService := new(MockRestaurantService)
+			mockRestaurantService.On("AuthorizeOwnership", mock.Anything, adminActor, ActionCreateMenu, restaurantID).
+				Return(nil)
+			req := &dto.CreateMenuRequest{Name: "Breakfast", Schedule: tc.schedule, RestaurantID: restaurantID}
+			if tc.expectedError == nil {
+				mockRepo.On("Create", mock.Anything, req).
+					Return(&dto.Menu{ID: uuid.New(), Name: req.Name, RestaurantID: restaurantID}, nil)
+			}
+
+			svc := NewMenuService(mockRepo, mockRestaurantService)
+			menu, err := svc.Create(context.Background(), adminActor, req)
+
+			if tc.expectedError != nil {
+				assert.ErrorIs(t, err, tc.expectedError)
+				mockRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, "Breakfast", menu.Name)
+			mockRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestMenuPrices(t *testing.T) {
+	mains, drinks := uuid.New(), uuid.New()
+	burger := &dto.MenuItem{ID: 1, Price: money.New(1200, "EUR"), CategoryID: mains}
+	cola := &dto.MenuItem{ID: 2, Price: money.New(300, "EUR"), CategoryID: drinks}
+	items := map[int64]*dto.MenuItem{1: burger, 2: cola}
+
+	lunch := &dto.Menu{
+		ID:          uuid.New(),
+		Schedule:    &hours.Schedule{Friday: []hours.Interval{{Open: "11:00", Close: "15:00"}}},
+		IsActive:    true,
+		CategoryIDs: []uuid.UUID{mains, drinks},
+	}
+	dinner := &dto.Menu{
+		ID:          uuid.New(),
+		Schedule:    &hours.Schedule{Friday: []hours.Interval{{Open: "17:00", Close: "23:00"}}},
+		IsActive:    true,
+		CategoryIDs: []uuid.UUID{mains, drinks},
+		ItemPrices:  []dto.MenuItemPrice{{MenuItemID: 1, Price: money.New(1500, "EUR")}},
+	}
+	delivery := &dto.Menu{
+		ID:          uuid.New(),
+		OrderTypes:  []dto.OrderType{dto.OrderTypeDELIVERY},
+		IsActive:    true,
+		CategoryIDs: []uuid.UUID{mains},
+		ItemPrices:  []dto.MenuItemPrice{{MenuItemID: 1, Price: money.New(1400, "EUR")}},
+	}
+	inactive := &dto.Menu{ID: uuid.New(), CategoryIDs: []uuid.UUID{mains, drinks}}
+
+	// 2026-03-06 is a Friday; the restaurant is in Berlin (UTC+1).
+	noon := time.Date(2026, 3, 6, 11, 0, 0, 0, time.UTC)
+	evening := time.Date(2026, 3, 6, 19, 0, 0, 0, time.UTC)
+	night := time.Date(2026, 3, 6, 23, 30, 0, 0, time.UTC)
+
+	testCases := []struct {
+		name          string
+		menus         []*dto.Menu
+		orderType     dto.OrderType
+		at            time.Time
+		expected      map[int64]int64
+		expectedError error
+	}{
+		{
+			name:      "no menus",
+			orderType: dto.OrderTypeTAKEOUT,
+			at:        night,
+			expected:  map[int64]int64{1: 1200, 2: 300},
+		},
+		{
+			name:      "lunch keeps own prices",
+			menus:     []*dto.Menu{lunch, dinner},
+			orderType: dto.OrderTypeDINE_IN,
+			at:        noon,
+			expected:  map[int64]int64{1: 1200, 2: 300},
+		},
+		{
+			name:      "dinner price",
+			menus:     []*dto.Menu{lunch, dinner},
+			orderType: dto.OrderTypeDINE_IN,
+			at:        evening,
+			expected:  map[int64]int64{1: 1500, 2: 300},
+		},
+		{
+			name:          "no menu served",
+			menus:         []*dto.Menu{lunch, dinner, inactive},
+			orderType:     dto.OrderTypeDINE_IN,
+			at:            night,
+			expectedError: apperr.ErrInvalid,
+		},
+		{
+			name:      "first menu in display order wins",
+			menus:     []*dto.Menu{delivery, dinner},
+			orderType: dto.OrderTypeDELIVERY,
+			at:        evening,
+			expected:  map[int64]int64{1: 1400, 2: 300},
+		},
+		{
+			name:          "menu for other order types",
+			menus:         []*dto.Menu{delivery},
+			orderType:     dto.OrderTypeTAKEOUT,
+			at:            evening,
+			expectedError: apperr.ErrInvalid,
+		},
+		{
+			name:          "category not on menu",
+			menus:         []*dto.Menu{delivery},
+			orderType:     dto.OrderTypeDELIVERY,
+			at:            evening,
+			expectedError: apperr.ErrInvalid,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			restaurant := &dto.RestaurantResponse{ID: uuid.New(), Timezone: "Europe/Berlin"}
+
+			prices, err := menuPrices(tc.menus, restaurant, tc.orderType, tc.at, items)
+
+			if tc.expectedError != nil {
+				assert.ErrorIs(t, err, tc.expectedError)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, prices)
+		})
+	}
+}
@@ -3,48 +3,120 @@ package services
 import (
 	"context"
 
+	"github.com/Jiruu246/rms/internal/authz"
+	"github.com/Jiruu246/rms/internal/blobs"
 	"github.com/Jiruu246/rms/internal/dto"
 	"github.com/Jiruu246/rms/internal/repos"
 	"github.com/google/uuid"
 )
 
+const (
+	ActionCreateModifierOption authz.Action = "modifier_option:create"
+	ActionReadModifierOption   authz.Action = "modifier_option:read"
+	ActionUpdateModifierOption authz.Action = "modifier_option:update"
+	ActionDeleteModifierOption authz.Action = "modifier_option:delete"
+)
+
 type ModifierOptionService interface {
-	Create(ctx context.Context, data *dto.CreateModifierOptionData) (*dto.ModifierOption, error)
-	GetByID(ctx context.Context, id uuid.UUID) (*dto.ModifierOption, error)
-	GetAll(ctx context.Context) ([]*dto.ModifierOption, error)
-	Update(ctx context.Context, id uuid.UUID, req *dto.UpdateModifierOptionRequest) (*dto.ModifierOption, error)
-	Delete(ctx context.Context, id uuid.UUID) error
+	Create(ctx context.Context, actor authz.Actor, data *dto.CreateModifierOptionData) (*dto.ModifierOption, error)
+	GetByID(ctx context.Context, actor authz.Actor, id uuid.UUID) (*dto.ModifierOption, error)
+	GetAllByRestaurant(ctx context.Context, actor authz.Actor, restaurantID uuid.UUID) ([]*dto.ModifierOption, error)
+	Update(ctx context.Context, actor authz.Actor, id uuid.UUID, req *dto.UpdateModifierOptionRequest) (*dto.ModifierOption, error)
+	Delete(ctx context.Context, actor authz.Actor, id uuid.UUID) error
 }
 
 type modifierOptionService struct {
-	repo repos.ModifierOptionRepository
+	repo              repos.ModifierOptionRepository
+	modifierRepo      repos.ModifierRepository
+	restaurantService RestaurantService
+	blobs             blobs.Store
+	authorizer        authz.Authorizer
 }
 
-func NewModifierOptionService(repo repos.ModifierOptionRepository) ModifierOptionService {
+func NewModifierOptionService(repo repos.ModifierOptionRepository, modifierRepo repos.ModifierRepository, restaurantService RestaurantService, blobStore blobs.Store) ModifierOptionService {
 	return &modifierOptionService{
-		repo: repo,
+		repo:              repo,
+		modifierRepo:      modifierRepo,
+		restaurantService: restaurantService,
+		blobs:             blobStore,
+		authorizer:        authz.NewPolicyAuthorizer(),
 	}
 }
 
-func (s *modifierOptionService) Create(ctx context.Context, data *dto.CreateModifierOptionData) (*dto.ModifierOption, error) {
+// Create requires the actor to own the modifier named by
+// data.Request.ModifierID, as the option has no owner of its own until it
+// exists.
+func (s *modifierOptionService) Create(ctx context.Context, actor authz.Actor, data *dto.CreateModifierOptionData) (*dto.ModifierOption, error) {
+	resource, err := s.modifierRepo.GetAuthorizationResource(ctx, data.Request.ModifierID)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := s.authorizer.Authorize(ctx, authz.Request{
+		Actor:    actor,
+		Action:   ActionCreateModifierOption,
+		Resource: resource,
+	}); err != nil {
+		return nil, err
+	}
 	return s.repo.Create(ctx, data)
 }
 
-func (s *modifierOptionService) GetByID(ctx context.Context, id uuid.UUID) (*dto.ModifierOption, error) {
-	return s.repo.GetByID(ctx, id)
+func (s *modifierOptionService) GetByID(ctx context.Context, actor authz.Actor, id uuid.UUID) (*dto.ModifierOption, error) {
+	resource, err := s.authorize(ctx, actor, ActionReadModifierOption, id)
+	if err != nil {
+		return nil, err
+	}
+	return s.repo.GetByID(ctx, resource.RestaurantID, id)
 }
 
-func (s *modifierOptionService) GetAll(ctx context.Context) ([]*dto.ModifierOption, error) {
-	return s.repo.GetAll(ctx)
+func (s *modifierOptionService) GetAllByRestaurant(ctx context.Context, actor authz.Actor, restaurantID uuid.UUID) ([]*dto.ModifierOption, error) {
+	if err := s.restaurantService.AuthorizeOwnership(ctx, actor, ActionReadModifierOption, restaurantID); err != nil {
+		return nil, err
+	}
+	return s.repo.GetAllByRestaurant(ctx, restaurantID)
 }
 
-func (s *modifierOptionService) Update(ctx context.Context, id uuid.UUID, req *dto.UpdateModifierOptionRequest) (*dto.ModifierOption, error) {
-	return s.repo.Update(ctx, &dto.UpdateModifierOptionData{
+func (s *modifierOptionService) Update(ctx context.Context, actor authz.Actor, id uuid.UUID, req *dto.UpdateModifierOptionRequest) (*dto.ModifierOption, error) {
+	resource, err := s.authorize(ctx, actor, ActionUpdateModifierOption, id)
+	if err != nil {
+		return nil, err
+	}
+	return s.repo.Update(ctx, resource.RestaurantID, &dto.UpdateModifierOptionData{
 		Request: req,
 		ID:      id,
 	})
 }
 
-func (s *modifierOptionService) Delete(ctx context.Context, id uuid.UUID) error {
-	return s.repo.Delete(ctx, id)
+func (s *modifierOptionService) Delete(ctx context.Context, actor authz.Actor, id uuid.UUID) error {
+	resource, err := s.authorize(ctx, actor, ActionDeleteModifierOption, id)
+	if err != nil {
+		return err
+	}
+	keys, err := s.repo.GetImageKeys(ctx, resource.RestaurantID, id)
+	if err != nil {
+		return err
+	}
+	if err := s.repo.Delete(ctx, resource.RestaurantID, id); err != nil {
+		return err
+	}
+	deleteBlobs(ctx, s.blobs, keys)
+	return nil
+}
+
+// authorize resolves the option's authz.Resource through its modifier and
+// checks the actor may perform action on it; options are governed by their
+// modifier's restaurant.
+func (s *modifierOptionService) authorize(ctx context.Context, actor authz.Actor, action authz.Action, id uuid.UUID) (authz.Resource, error) {
+	resource, err := s.repo.GetAuthorizationResource(ctx, id)
+	if err != nil {
+		return authz.Resource{}, err
+	}
+	if _, err := s.authorizer.Authorize(ctx, authz.Request{
+		Actor:    actor,
+		Action:   action,
+		Resource: resource,
+	}); err != nil {
+		return authz.Resource{}, err
+	}
+	return resource, nil
 }
@@ -2,49 +2,130 @@ package services
 
 import (
 	"context"
+	"fmt"
 
+	"github.com/Jiruu246/rms/internal/apperr"
+	"github.com/Jiruu246/rms/internal/authz"
 	"github.com/Jiruu246/rms/internal/dto"
 	"github.com/Jiruu246/rms/internal/repos"
 	"github.com/google/uuid"
 )
 
+const (
+	ActionCreateModifier authz.Action = "modifier:create"
+	ActionReadModifier   authz.Action = "modifier:read"
+	ActionUpdateModifier authz.Action = "modifier:update"
+	ActionDeleteModifier authz.Action = "modifier:delete"
+)
+
 type ModifierService interface {
-	Create(ctx context.Context, data *dto.CreateModifierData) (*dto.Modifier, error)
-	GetByID(ctx context.Context, id uuid.UUID) (*dto.Modifier, error)
-	GetAll(ctx context.Context) ([]*dto.Modifier, error)
-	Update(ctx context.Context, id uuid.UUID, req *dto.UpdateModifierRequest) (*dto.Modifier, error)
-	Delete(ctx context.Context, id uuid.UUID) error
+	Create(ctx context.Context, actor authz.Actor, data *dto.CreateModifierData) (*dto.Modifier, error)
+	GetByID(ctx context.Context, actor authz.Actor, id uuid.UUID) (*dto.Modifier, error)
+	GetAllByRestaurant(ctx context.Context, actor authz.Actor, restaurantID uuid.UUID) ([]*dto.Modifier, error)
+	Update(ctx context.Context, actor authz.Actor, id uuid.UUID, req *dto.UpdateModifierRequest) (*dto.Modifier, error)
+	Delete(ctx context.Context, actor authz.Actor, id uuid.UUID) error
 }
 
 type modifierService struct {
-	repo repos.ModifierRepository
+	repo              repos.ModifierRepository
+	restaurantService RestaurantService
+	authorizer        authz.Authorizer
 }
 
-func NewModifierService(repo repos.ModifierRepository) ModifierService {
+func NewModifierService(repo repos.ModifierRepository, restaurantService RestaurantService) ModifierService {
 	return &modifierService{
-		repo: repo,
+		repo:              repo,
+		restaurantService: restaurantService,
+		authorizer:        authz.NewPolicyAuthorizer(),
 	}
 }
 
-func (s *modifierService) Create(ctx context.Context, data *dto.CreateModifierData) (*dto.Modifier, error) {
+// Create requires the actor to own the restaurant named by
+// data.Request.RestaurantID, as the modifier has no owner of its own until
+// it exists.
+func (s *modifierService) Create(ctx context.Context, actor authz.Actor, data *dto.CreateModifierData) (*dto.Modifier, error) {
+	if err := s.restaurantService.AuthorizeOwnership(ctx, actor, ActionCreateModifier, data.Request.RestaurantID); err != nil {
+		return nil, err
+	}
+	if err := checkModifierBounds(data.Request.Min, data.Request.Max); err != nil {
+		return nil, err
+	}
 	return s.repo.Create(ctx, data)
 }
 
-func (s *modifierService) GetByID(ctx context.Context, id uuid.UUID) (*dto.Modifier, error) {
-	return s.repo.GetByID(ctx, id)
+func (s *modifierService) GetByID(ctx context.Context, actor authz.Actor, id uuid.UUID) (*dto.Modifier, error) {
+	resource, err := s.authorize(ctx, actor, ActionReadModifier, id)
+	if err != nil {
+		return nil, err
+	}
+	return s.repo.GetByID(ctx, resource.RestaurantID, id)
 }
 
-func (s *modifierService) GetAll(ctx context.Context) ([]*dto.Modifier, error) {
-	return s.repo.GetAll(ctx)
+func (s *modifierService) GetAllByRestaurant(ctx context.Context, actor authz.Actor, restaurantID uuid.UUID) ([]*dto.Modifier, error) {
+	if err := s.restaurantService.AuthorizeOwnership(ctx, actor, ActionReadModifier, restaurantID); err != nil {
+		return nil, err
+	}
+	return s.repo.GetAllByRestaurant(ctx, restaurantID)
 }
 
-func (s *modifierService) Update(ctx context.Context, id uuid.UUID, req *dto.UpdateModifierRequest) (*dto.Modifier, error) {
-	return s.repo.Update(ctx, &dto.UpdateModifierData{
+func (s *modifierService) Update(ctx context.Context, actor authz.Actor, id uuid.UUID, req *dto.UpdateModifierRequest) (*dto.Modifier, error) {
+	resource, err := s.authorize(ctx, actor, ActionUpdateModifier, id)
+	if err != nil {
+		return nil, err
+	}
+	if req.Min != nil || req.Max != nil {
+		current, err := s.repo.GetByID(ctx, resource.RestaurantID, id)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get modifier: %w", err)
+		}
+		min, max := current.Min, current.Max
+		if req.Min != nil {
+			min = *req.Min
+		}
+		if req.Max != nil {
+			max = *req.Max
+		}
+		if err := checkModifierBounds(min, max); err != nil {
+			return nil, err
+		}
+	}
+	return s.repo.Update(ctx, resource.RestaurantID, &dto.UpdateModifierData{
 		Request: req,
 		ID:      id,
 	})
 }
 
-func (s *modifierService) Delete(ctx context.Context, id uuid.UUID) error {
-	return s.repo.Delete(ctx, id)
+func (s *modifierService) Delete(ctx context.Context, actor authz.Actor, id uuid.UUID) error {
+	resource, err := s.authorize(ctx, actor, ActionDeleteModifier, id)
+	if err != nil {
+		return err
+	}
+	return s.repo.Delete(ctx, resource.RestaurantID, id)
+}
+
+// authorize resolves the modifier's authz.Resource and checks the actor may
+// perform action on it, returning the resource whose RestaurantID scopes
+// the query that follows.
+func (s *modifierService) authorize(ctx context.Context, actor authz.Actor, action authz.Action, id uuid.UUID) (authz.Resource, error) {
+	resource, err := s.repo.GetAuthorizationResource(ctx, id)
+	if err != nil {
+		return authz.Resource{}, err
+	}
+	if _, err := s.authorizer.Authorize(ctx, authz.Request{
+		Actor:    actor,
+		Action:   action,
+		Resource: resource,
+	}); err != nil {
+		return authz.Resource{}, err
+	}
+	return resource, nil
+}
+
+// checkModifierBounds rejects a group that could never be satisfied
+// because it asks for more selections than it allows.
+func checkModifierBounds(min, max int) error {
+	if min > max {
+		return apperr.Invalid("modifier min %d is above its max %d", min, max)
+	}
+	return nil
 }
@@ -0,0 +1,126 @@
+package services
+
+import (
+	"context"
+	"time"
+
+	"github.com/Jiruu246/rms/internal/authz"
+	"github.com/Jiruu246/rms/internal/dto"
+	"github.com/Jiruu246/rms/internal/repos"
+	"github.com/google/uuid"
+)
+
+const (
+	ActionStreamOrders  authz.Action = "order:stream"
+	ActionStreamTickets authz.Action = "ticket:stream"
+)
+
+const (
+	// defaultOrderEventPollInterval bounds how stale a live feed can be.
+	// Events are read back from the database rather than pushed in-process
+	// so that every server instance sees every change.
+	defaultOrderEventPollInterval = time.Second
+	orderEventBatchSize           = 100
+)
+
+type OrderEventService interface {
+	// Subscribe streams the restaurant's order events until ctx is done.
+	// With lastEventID set it first replays every event after it; otherwise
+	// only events from now on are sent. The channel is closed when ctx is
+	// done or reading the feed fails; clients then reconnect with the last
+	// ID they saw.
+	Subscribe(ctx context.Context, actor authz.Actor, restaurantID uuid.UUID, lastEventID *int64) (<-chan *dto.OrderEvent, error)
+	// SubscribeStation is Subscribe for a single station's ticket events.
+	// Event IDs are those of the restaurant's feed, so they have gaps.
+	SubscribeStation(ctx context.Context, actor authz.Actor, stationID uuid.UUID, lastEventID *int64) (<-chan *dto.OrderEvent, error)
+}
+
+type orderEventService struct {
+	repo              repos.OrderEventRepository
+	stationRepo       repos.StationRepository
+	restaurantService RestaurantService
+	authorizer        authz.Authorizer
+	pollInterval      time.Duration
+}
+
+func NewOrderEventService(repo repos.OrderEventRepository, stationRepo repos.StationRepository, restaurantService RestaurantService) OrderEventService {
+	return &orderEventService{
+		repo:              repo,
+		stationRepo:       stationRepo,
+		restaurantService: restaurantService,
+		authorizer:        authz.NewPolicyAuthorizer(),
+		pollInterval:      defaultOrderEventPollInterval,
+	}
+}
+
+func (s *orderEventService) Subscribe(ctx context.Context, actor authz.Actor, restaurantID uuid.UUID, lastEventID *int64) (<-chan *dto.OrderEvent, error) {
+	if err := s.restaurantService.AuthorizeOwnership(ctx, actor, ActionStreamOrders, restaurantID); err != nil {
+		return nil, err
+	}
+
+	return s.subscribe(ctx, repos.OrderEventFeed{RestaurantID: restaurantID}, lastEventID)
+}
+
+func (s *orderEventService) SubscribeStation(ctx context.Context, actor authz.Actor, stationID uuid.UUID, lastEventID *int64) (<-chan *dto.OrderEvent, error) {
+	resource, err := s.stationRepo.GetAuthorizationResource(ctx, stationID)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := s.authorizer.Authorize(ctx, authz.Request{
+		Actor:    actor,
+		Action:   ActionStreamTickets,
+		Resource: resource,
+	}); err != nil {
+		return nil, err
+	}
+
+	return s.subscribe(ctx, repos.OrderEventFeed{RestaurantID: resource.RestaurantID, StationID: &stationID}, lastEventID)
+}
+
+// subscribe starts streaming feed after lastEventID, or after the
+// restaurant's latest event if it is nil.
+func (s *orderEventService) subscribe(ctx context.Context, feed repos.OrderEventFeed, lastEventID *int64) (<-chan *dto.OrderEvent, error) {
+	var cursor int64
+	if lastEventID != nil {
+		cursor = *lastEventID
+	} else {
+		latest, err := s.repo.LatestSeq(ctx, feed.RestaurantID)
+		if err != nil {
+			return nil, err
+		}
+		cursor = latest
+	}
+
+	events := make(chan *dto.OrderEvent)
+	go func() {
+		defer close(events)
+		ticker := time.NewTicker(s.pollInterval)
+		defer ticker.Stop()
+
+		for {
+			batch, err := s.repo.ListSince(ctx, feed, cursor, orderEventBatchSize)
+			if err != nil {
+				return
+			}
+			for _, event := range batch {
+				select {
+				case events <- event:
+					cursor = event.ID
+				case <-ctx.Done():
+					return
+				}
+			}
+			if len(batch) == orderEventBatchSize {
+				// Still catching up; don't wait for the next tick.
+				continue
+			}
+
+			select {
+			case <-ticker.C:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return events, nil
+}
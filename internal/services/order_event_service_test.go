@@ -0,0 +1,176 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/Jiruu246/rms/internal/apperr"
+	"github.com/Jiruu246/rms/internal/authz"
+	"github.com/Jiruu246/rms/internal/dto"
+	"github.com/Jiruu246/rms/internal/repos"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// MockOrderEventRepository is a mock implementation of OrderEventRepository
+type MockOrderEventRepository struct {
+	mock.Mock
+}
+
+func (m *MockOrderEventRepository) ListSince(ctx context.Context, feed repos.OrderEventFeed, after int64, limit int) ([]*dto.OrderEvent, error) {
+	args := m.Called(ctx, feed, after, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*dto.OrderEvent), args.Error(1)
+}
+
+func (m *MockOrderEventRepository) LatestSeq(ctx context.Context, restaurantID uuid.UUID) (int64, error) {
+	args := m.Called(ctx, restaurantID)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func newTestOrderEventService(repo *MockOrderEventRepository, restaurantService *MockRestaurantService) *orderEventService {
+	svc := NewOrderEventService(repo, new(MockStationRepository), restaurantService).(*orderEventService)
+	svc.pollInterval = 10 * time.Millisecond
+	return svc
+}
+
+func receiveOrderEvents(t *testing.T, events <-chan *dto.OrderEvent, n int) []int64 {
+	t.Helper()
+	var ids []int64
+	for len(ids) < n {
+		select {
+		case event, ok := <-events:
+			require.True(t, ok, "stream closed after %d events", len(ids))
+			ids = append(ids, event.ID)
+		case <-time.After(time.Second):
+			t.Fatalf("timed out after %d events", len(ids))
+		}
+	}
+	return ids
+}
+
+func TestOrderEventService_Subscribe_ResumesFromLastEventID(t *testing.T) {
+	restaurantID := uuid.New()
+	feed := repos.OrderEventFeed{RestaurantID: restaurantID}
+	repo := new(MockOrderEventRepository)
+	restaurantService := new(MockRestaurantService)
+	restaurantService.On("AuthorizeOwnership", mock.Anything, adminActor, ActionStreamOrders, restaurantID).Return(nil)
+	repo.On("ListSince", mock.Anything, feed, int64(4), orderEventBatchSize).
+		Return([]*dto.OrderEvent{{ID: 5}, {ID: 6}}, nil).Once()
+	repo.On("ListSince", mock.Anything, feed, int64(6), orderEventBatchSize).
+		Return([]*dto.OrderEvent{}, nil).Once()
+	repo.On("ListSince", mock.Anything, feed, int64(6), orderEventBatchSize).
+		Return([]*dto.OrderEvent{{ID: 7}}, nil).Once()
+	repo.On("ListSince", mock.Anything, feed, int64(7), orderEventBatchSize).
+		Return([]*dto.OrderEvent{}, nil)
+
+	ctx, cancel := context.WithCancel(t.Context())
+	defer cancel()
+	lastEventID := int64(4)
+	events, err := newTestOrderEventService(repo, restaurantService).Subscribe(ctx, adminActor, restaurantID, &lastEventID)
+	require.NoError(t, err)
+
+	assert.Equal(t, []int64{5, 6, 7}, receiveOrderEvents(t, events, 3))
+	repo.AssertNotCalled(t, "LatestSeq", mock.Anything, mock.Anything)
+}
+
+func TestOrderEventService_Subscribe_StartsAtLatestWithoutLastEventID(t *testing.T) {
+	restaurantID := uuid.New()
+	feed := repos.OrderEventFeed{RestaurantID: restaurantID}
+	repo := new(MockOrderEventRepository)
+	restaurantService := new(MockRestaurantService)
+	restaurantService.On("AuthorizeOwnership", mock.Anything, adminActor, ActionStreamOrders, restaurantID).Return(nil)
+	repo.On("LatestSeq", mock.Anything, restaurantID).Return(int64(41), nil)
+	repo.On("ListSince", mock.Anything, feed, int64(41), orderEventBatchSize).
+		Return([]*dto.OrderEvent{{ID: 42}}, nil).Once()
+	repo.On("ListSince", mock.Anything, feed, int64(42), orderEventBatchSize).
+		Return([]*dto.OrderEvent{}, nil)
+
+	ctx, cancel := context.WithCancel(t.Context())
+	defer cancel()
+	events, err := newTestOrderEventService(repo, restaurantService).Subscribe(ctx, adminActor, restaurantID, nil)
+	require.NoError(t, err)
+
+	assert.Equal(t, []int64{42}, receiveOrderEvents(t, events, 1))
+}
+
+func TestOrderEventService_Subscribe_ClosesWhenContextDone(t *testing.T) {
+	restaurantID := uuid.New()
+	feed := repos.OrderEventFeed{RestaurantID: restaurantID}
+	repo := new(MockOrderEventRepository)
+	restaurantService := new(MockRestaurantService)
+	restaurantService.On("AuthorizeOwnership", mock.Anything, adminActor, ActionStreamOrders, restaurantID).Return(nil)
+	repo.On("ListSince", mock.Anything, feed, int64(0), orderEventBatchSize).Return([]*dto.OrderEvent{}, nil)
+
+	ctx, cancel := context.WithCancel(t.Context())
+	lastEventID := int64(0)
+	events, err := newTestOrderEventService(repo, restaurantService).Subscribe(ctx, adminActor, restaurantID, &lastEventID)
+	require.NoError(t, err)
+
+	cancel()
+	select {
+	case _, ok := <-events:
+		assert.False(t, ok)
+	case <-time.After(time.Second):
+		t.Fatal("stream was not closed")
+	}
+}
+
+func TestOrderEventService_Subscribe_Forbidden(t *testing.T) {
+	restaurantID := uuid.New()
+	repo := new(MockOrderEventRepository)
+	restaurantService := new(MockRestaurantService)
+	restaurantService.On("AuthorizeOwnership", mock.Anything, adminActor, ActionStreamOrders, restaurantID).
+		Return(apperr.ErrForbidden)
+
+	events, err := newTestOrderEventService(repo, restaurantService).Subscribe(t.Context(), adminActor, restaurantID, nil)
+
+	assert.Error(t, err)
+	assert.Nil(t, events)
+	repo.AssertNotCalled(t, "LatestSeq", mock.Anything, mock.Anything)
+	repo.AssertNotCalled(t, "ListSince", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestOrderEventService_SubscribeStation(t *testing.T) {
+	restaurantID := uuid.New()
+	stationID := uuid.New()
+	ownerID := uuid.New()
+	owner := authz.Actor{UserID: ownerID}
+	feed := repos.OrderEventFeed{RestaurantID: restaurantID, StationID: &stationID}
+
+	repo := new(MockOrderEventRepository)
+	stationRepo := new(MockStationRepository)
+	stationRepo.On("GetAuthorizationResource", mock.Anything, stationID).Return(authz.Resource{
+		Type:         "station",
+		ID:           stationID,
+		RestaurantID: restaurantID,
+		OwnerUserID:  ownerID,
+	}, nil)
+	repo.On("ListSince", mock.Anything, feed, int64(10), orderEventBatchSize).
+		Return([]*dto.OrderEvent{{ID: 12, StationID: &stationID}}, nil).Once()
+	repo.On("ListSince", mock.Anything, feed, int64(12), orderEventBatchSize).
+		Return([]*dto.OrderEvent{}, nil)
+
+	svc := NewOrderEventService(repo, stationRepo, new(MockRestaurantService)).(*orderEventService)
+	svc.pollInterval = 10 * time.Millisecond
+
+	t.Run("owner", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(t.Context())
+		defer cancel()
+		lastEventID := int64(10)
+		events, err := svc.SubscribeStation(ctx, owner, stationID, &lastEventID)
+		require.NoError(t, err)
+		assert.Equal(t, []int64{12}, receiveOrderEvents(t, events, 1))
+	})
+
+	t.Run("other user", func(t *testing.T) {
+		events, err := svc.SubscribeStation(t.Context(), authz.Actor{UserID: uuid.New()}, stationID, nil)
+		assert.ErrorIs(t, err, apperr.ErrForbidden)
+		assert.Nil(t, events)
+	})
+}
@@ -0,0 +1,127 @@
+package services
+
+import (
+	"github.com/Jiruu246/rms/internal/dto"
+	"github.com/Jiruu246/rms/internal/repos"
+	"github.com/Jiruu246/rms/pkg/money"
+	"github.com/google/uuid"
+)
+
+// OrderTotals is the server-side price breakdown of an order, in minor units
+// of the order currency. It is the only source of truth for what an order
+// costs — clients display these numbers rather than recomputing them from
+// the item/option snapshots.
+type OrderTotals struct {
+	Subtotal       int64
+	ModifiersTotal int64
+	TaxTotal       int64
+	// DeliveryFee is the fee of a DELIVERY order's zone. It is not taxed.
+	DeliveryFee int64
+	Total       int64
+}
+
+// priceOrderItems computes each line's ModifiersTotal and LineTotal in place
+// from the price snapshots already on items, and returns the order-level
+// totals. Tax is applied once, to the subtotal, at taxRateBps basis points,
+// rounded half away from zero to the currency's minor unit. Delivery fees
+// are added on top by addDeliveryFee.
+//
+// Every code path that creates or changes an order's items must run the
+// full list of its unvoided items through this function and persist the
+// result, so the stored totals never drift from the stored lines.
+func priceOrderItems(items []repos.OrderItemData, taxRateBps int) OrderTotals {
+	var totals OrderTotals
+	for i := range items {
+		item := &items[i]
+
+		item.ModifiersTotal = modifiersUnitPrice(item.ModifierOptions) * int64(item.Quantity)
+		item.LineTotal = item.ItemPrice*int64(item.Quantity) + item.ModifiersTotal
+
+		totals.ModifiersTotal += item.ModifiersTotal
+		totals.Subtotal += item.LineTotal
+	}
+
+	totals.TaxTotal = money.ApplyBasisPoints(totals.Subtotal, taxRateBps)
+	totals.Total = totals.Subtotal + totals.TaxTotal
+	return totals
+}
+
+// modifiersUnitPrice is what mods add to one unit of an item: each option's
+// price times its quantity less the free ones, with the options chosen
+// within it priced for each of that quantity.
+func modifiersUnitPrice(mods []repos.ModifierItemData) int64 {
+	var total int64
+	for _, mod := range mods {
+		total += mod.OptionPrice * int64(mod.Quantity-mod.FreeQuantity)
+		total += modifiersUnitPrice(mod.ModifierOptions) * int64(mod.Quantity)
+	}
+	return total
+}
+
+// modifierItemData converts an order line's option snapshots back to the
+// form they were priced in.
+func modifierItemData(mods []dto.OrderItemModifierOption) []repos.ModifierItemData {
+	var data []repos.ModifierItemData
+	for _, mod := range mods {
+		data = append(data, repos.ModifierItemData{
+			ModifierOptionID: mod.ModifierOptionID,
+			Quantity:         mod.Quantity,
+			OptionName:       mod.OptionName,
+			OptionPrice:      mod.OptionPrice.Amount,
+			FreeQuantity:     mod.FreeQuantity,
+			ModifierOptions:  modifierItemData(mod.ModifierOptions),
+		})
+	}
+	return data
+}
+
+// addDeliveryFee adds a delivery fee to totals computed by priceOrderItems.
+func addDeliveryFee(totals *OrderTotals, fee int64) {
+	totals.DeliveryFee += fee
+	totals.Total += fee
+}
+
+// repriceOrderItems prices ord's unvoided lines with update applied and add
+// appended, filling in the line totals of add and update, and returns the
+// order's new totals, delivery fee included.
+func repriceOrderItems(ord *dto.Order, add []repos.OrderItemData, update []repos.OrderItemUpdateData, taxRateBps int) OrderTotals {
+	updates := make(map[uuid.UUID]int, len(update))
+	for i, u := range update {
+		updates[u.ID] = i
+	}
+
+	lines := make([]repos.OrderItemData, 0, len(ord.OrderItems)+len(add))
+	lineOf := make(map[int]int, len(update))
+	for _, item := range ord.OrderItems {
+		if item.VoidedAt != nil {
+			continue
+		}
+		line := repos.OrderItemData{
+			MenuItemID:          item.MenuItemID,
+			Quantity:            item.Quantity,
+			ItemName:            item.ItemName,
+			ItemPrice:           item.ItemPrice.Amount,
+			SpecialInstructions: item.SpecialInstructions,
+		}
+		line.ModifierOptions = modifierItemData(item.ModifierOptions)
+		if i, ok := updates[item.ID]; ok {
+			if update[i].Void {
+				continue
+			}
+			line.Quantity = update[i].Quantity
+			line.SpecialInstructions = update[i].SpecialInstructions
+			lineOf[i] = len(lines)
+		}
+		lines = append(lines, line)
+	}
+	lines = append(lines, add...)
+
+	totals := priceOrderItems(lines, taxRateBps)
+	for i, l := range lineOf {
+		update[i].ModifiersTotal = lines[l].ModifiersTotal
+		update[i].LineTotal = lines[l].LineTotal
+	}
+	copy(add, lines[len(lines)-len(add):])
+	addDeliveryFee(&totals, ord.DeliveryFee.Amount)
+	return totals
+}
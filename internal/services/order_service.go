@@ -1,59 +1,149 @@
 package services
 
 import (
+	"cmp"
 	"context"
 	"errors"
 	"fmt"
+	"slices"
+	"strings"
+	"time"
 
 	"github.com/Jiruu246/rms/internal/apperr"
+	"github.com/Jiruu246/rms/internal/authz"
 	ds "github.com/Jiruu246/rms/internal/data_structures"
 	"github.com/Jiruu246/rms/internal/dto"
 	"github.com/Jiruu246/rms/internal/repos"
+	"github.com/Jiruu246/rms/pkg/geo"
+	"github.com/Jiruu246/rms/pkg/money"
 	"github.com/Jiruu246/rms/pkg/utils"
 	"github.com/google/uuid"
 )
 
+// orderStatusTransitions is the order lifecycle: OPEN -> CONFIRMED ->
+// READY -> COMPLETED, with CANCELLED reachable from any non-terminal status.
+// READY can be skipped; it is also set automatically once all of an order's
+// station tickets are ready (see StationTicketRepository.UpdateStatus).
+// COMPLETED and CANCELLED are terminal and have no outgoing transitions.
+var orderStatusTransitions = map[dto.OrderStatus][]dto.OrderStatus{
+	dto.OrderStatusOPEN:      {dto.OrderStatusCONFIRMED, dto.OrderStatusCANCELLED},
+	dto.OrderStatusCONFIRMED: {dto.OrderStatusREADY, dto.OrderStatusCOMPLETED, dto.OrderStatusCANCELLED},
+	dto.OrderStatusREADY:     {dto.OrderStatusCOMPLETED, dto.OrderStatusCANCELLED},
+}
+
+func canTransitionOrderStatus(from, to dto.OrderStatus) bool {
+	for _, next := range orderStatusTransitions[from] {
+		if next == to {
+			return true
+		}
+	}
+	return false
+}
+
 type CreateOrderInput struct {
 	OrderType    dto.OrderType
 	RestaurantID uuid.UUID
 	OrderItems   []OrderItemInput
+	// Actor is the authenticated user placing the order, who must own the
+	// restaurant, or nil for orders placed through the public endpoint,
+	// which are only taken while the restaurant is active and open.
+	Actor *authz.Actor
+	// TableID or TableToken place a DINE_IN order at a table. A token
+	// identifies the restaurant too, so RestaurantID may then be uuid.Nil.
+	TableID    *uuid.UUID
+	TableToken string
+	// Delivery is required on DELIVERY orders and rejected on others.
+	Delivery *DeliveryInput
+	// ScheduledFor is the requested pickup or delivery time of an order
+	// placed in advance; nil means as soon as possible.
+	ScheduledFor *time.Time
+}
+
+// DeliveryInput is where a DELIVERY order goes. Latitude and Longitude
+// decide the delivery zone, and with it the fee and minimum order value.
+type DeliveryInput struct {
+	Address      string
+	Latitude     float64
+	Longitude    float64
+	ContactName  string
+	ContactPhone string
+	Instructions string
 }
 
 type ModifierOptionInput struct {
 	ModifierOptionID uuid.UUID
 	Quantity         int
+	// ModifierOptions are chosen in the option's child modifier groups,
+	// for each of its Quantity.
+	ModifierOptions []ModifierOptionInput
 }
 
 type OrderItemInput struct {
-	MenuItemID         int64
+	MenuItemID int64
+	// VariantID is required for items with variants, and must be one of
+	// them.
+	VariantID          *uuid.UUID
 	Quantity           int
 	SpecialInstruction string
 	ModifierOptions    []ModifierOptionInput
 }
 
+const (
+	ActionCreateOrder authz.Action = "order:create"
+	ActionReadOrder   authz.Action = "order:read"
+	ActionUpdateOrder authz.Action = "order:update"
+	ActionDeleteOrder authz.Action = "order:delete"
+)
+
 type OrderService interface {
+	// Create places an order. Orders with an input.Actor are placed by the
+	// restaurant's staff; those without are a customer's and take no actor.
 	Create(ctx context.Context, input CreateOrderInput) (*dto.Order, error)
-	GetByID(ctx context.Context, id uuid.UUID) (*dto.Order, error)
-	GetAllByRestaurant(ctx context.Context, restaurantID uuid.UUID) ([]*dto.Order, error)
-	Update(ctx context.Context, id uuid.UUID, req *dto.UpdateOrderRequest) (*dto.Order, error)
-	Delete(ctx context.Context, id uuid.UUID) error
+	GetByID(ctx context.Context, actor authz.Actor, id uuid.UUID) (*dto.Order, error)
+	GetAllByRestaurant(ctx context.Context, actor authz.Actor, restaurantID uuid.UUID, filters dto.OrderListFilters) ([]*dto.Order, error)
+	Update(ctx context.Context, actor authz.Actor, id uuid.UUID, req *dto.UpdateOrderRequest) (*dto.Order, error)
+	Delete(ctx context.Context, actor authz.Actor, id uuid.UUID) error
+	GetStatusHistory(ctx context.Context, actor authz.Actor, id uuid.UUID) ([]*dto.OrderStatusEvent, error)
+	// AddItems, UpdateItem and VoidItem change the items of an OPEN or
+	// CONFIRMED order, as on a bar tab, and re-price it.
+	AddItems(ctx context.Context, actor authz.Actor, orderID uuid.UUID, items []OrderItemInput) (*dto.Order, error)
+	UpdateItem(ctx context.Context, actor authz.Actor, orderID, itemID uuid.UUID, req *dto.UpdateOrderItemRequest) (*dto.Order, error)
+	VoidItem(ctx context.Context, actor authz.Actor, orderID, itemID uuid.UUID, reason string) (*dto.Order, error)
+	GetItemChanges(ctx context.Context, actor authz.Actor, id uuid.UUID) ([]*dto.OrderItemChange, error)
+	// ReleaseDueOrders sends scheduled orders whose slot is within the
+	// kitchen lead time to the kitchen; see OrderRepository.ReleaseDue.
+	ReleaseDueOrders(ctx context.Context) (int, error)
 }
 
 type orderService struct {
 	OrderRepo          repos.OrderRepository
 	MenuItemRepo       repos.MenuItemRepository
 	ModifierOptionRepo repos.ModifierOptionRepository
+	RestaurantRepo     repos.RestaurantRepository
+	TableRepo          repos.TableRepository
+	DeliveryZoneRepo   repos.DeliveryZoneRepository
+	MenuRepo           repos.MenuRepository
+	authorizer         authz.Authorizer
 }
 
 func NewOrderService(
 	orderRepo repos.OrderRepository,
 	menuItemRepo repos.MenuItemRepository,
 	modifierOptionRepo repos.ModifierOptionRepository,
+	restaurantRepo repos.RestaurantRepository,
+	tableRepo repos.TableRepository,
+	deliveryZoneRepo repos.DeliveryZoneRepository,
+	menuRepo repos.MenuRepository,
 ) OrderService {
 	return &orderService{
 		OrderRepo:          orderRepo,
 		MenuItemRepo:       menuItemRepo,
 		ModifierOptionRepo: modifierOptionRepo,
+		RestaurantRepo:     restaurantRepo,
+		TableRepo:          tableRepo,
+		DeliveryZoneRepo:   deliveryZoneRepo,
+		MenuRepo:           menuRepo,
+		authorizer:         authz.NewPolicyAuthorizer(),
 	}
 }
 
@@ -62,14 +152,120 @@ func (s *orderService) Create(ctx context.Context, input CreateOrderInput) (*dto
 		return nil, apperr.Invalid("order must contain at least one item")
 	}
 
+	var tableID *uuid.UUID
+	if input.TableID != nil || input.TableToken != "" {
+		table, err := s.resolveTable(ctx, input)
+		if err != nil {
+			return nil, err
+		}
+		if input.OrderType != dto.OrderTypeDINE_IN {
+			return nil, apperr.Invalid("orders placed at a table must be %s", dto.OrderTypeDINE_IN)
+		}
+		input.RestaurantID = table.RestaurantID
+		tableID = &table.ID
+	}
+
+	if input.Actor != nil {
+		if err := s.authorizeRestaurant(ctx, *input.Actor, ActionCreateOrder, input.RestaurantID); err != nil {
+			return nil, err
+		}
+	}
+
+	restaurant, err := s.RestaurantRepo.GetByID(ctx, input.RestaurantID)
+	if err != nil {
+		if errors.Is(err, apperr.ErrNotFound) {
+			return nil, apperr.Invalid("restaurant %s does not exist", input.RestaurantID)
+		}
+		return nil, fmt.Errorf("failed to get restaurant: %w", err)
+	}
+
+	// Staff can take orders while the restaurant is closed to the public.
+	now := time.Now()
+	var createdBy uuid.UUID
+	if input.Actor != nil {
+		createdBy = input.Actor.UserID
+	} else if err := checkTakingOrders(restaurant, input.ScheduledFor != nil, now); err != nil {
+		return nil, err
+	}
+
+	var schedule *repos.OrderScheduleData
+	orderedFor := now
+	if input.ScheduledFor != nil {
+		if tableID != nil {
+			return nil, apperr.Invalid("orders placed at a table cannot be scheduled")
+		}
+		schedule, err = scheduleOrder(restaurant, *input.ScheduledFor, now)
+		if err != nil {
+			return nil, err
+		}
+		orderedFor = *input.ScheduledFor
+	}
+
+	orderItems, err := s.buildOrderItems(ctx, restaurant, input.OrderType, orderedFor, input.OrderItems)
+	if err != nil {
+		return nil, err
+	}
+	totals := priceOrderItems(orderItems, restaurant.TaxRateBps)
+
+	var delivery *dto.DeliveryDetails
+	if input.OrderType == dto.OrderTypeDELIVERY {
+		delivery, err = s.priceDelivery(ctx, restaurant, input.Delivery, &totals)
+		if err != nil {
+			return nil, err
+		}
+	} else if input.Delivery != nil {
+		return nil, apperr.Invalid("delivery details are only accepted on %s orders", dto.OrderTypeDELIVERY)
+	}
+
+	data := &repos.CreateOrderData{
+		OrderType:      input.OrderType,
+		OrderStatus:    dto.OrderStatusOPEN,
+		PaymentStatus:  dto.PaymentStatusUNPAID,
+		RestaurantID:   input.RestaurantID,
+		OrderItems:     orderItems,
+		CreatedBy:      createdBy,
+		Currency:       money.Currency(restaurant.Currency),
+		Subtotal:       totals.Subtotal,
+		ModifiersTotal: totals.ModifiersTotal,
+		TaxTotal:       totals.TaxTotal,
+		DeliveryFee:    totals.DeliveryFee,
+		Total:          totals.Total,
+		TaxRateBps:     restaurant.TaxRateBps,
+		TableID:        tableID,
+		Delivery:       delivery,
+		Schedule:       schedule,
+	}
+	return s.OrderRepo.Create(ctx, data)
+}
+
+// buildOrderItems validates the requested lines against the restaurant's
+// catalogue and snapshots their names and prices, ready for
+// priceOrderItems. Modifier groups left alone get their pre-selected
+// options; see applyDefaultSelections. Items must be on a menu served for
+// orderType orders at at, and are priced as on that menu; see menuPrices.
+// Items ordered as one of their variants cost the variant's price instead,
+// and their modifier options cost the item's or the variant's price for
+// the option where set; see optionPrice.
+func (s *orderService) buildOrderItems(
+	ctx context.Context,
+	restaurant *dto.RestaurantResponse,
+	orderType dto.OrderType,
+	at time.Time,
+	items []OrderItemInput,
+) ([]repos.OrderItemData, error) {
 	uniqueItemIDs := ds.NewSet[int64]()
 	uniqueModifierIDs := ds.NewSet[uuid.UUID]()
-	for _, item := range input.OrderItems {
-		uniqueItemIDs.Add(item.MenuItemID)
-		for _, mod := range item.ModifierOptions {
+	var addOptionIDs func([]ModifierOptionInput)
+	addOptionIDs = func(mods []ModifierOptionInput) {
+		for _, mod := range mods {
 			uniqueModifierIDs.Add(mod.ModifierOptionID)
+			addOptionIDs(mod.ModifierOptions)
 		}
 	}
+	for _, item := range items {
+		uniqueItemIDs.Add(item.MenuItemID)
+		addOptionIDs(item.ModifierOptions)
+	}
 
 	menuItemsFromDB, err := s.MenuItemRepo.GetByIDsStrict(ctx, *uniqueItemIDs, repos.WithModifierOptions())
 	if err != nil {
@@ -87,58 +283,650 @@ func (s *orderService) Create(ctx context.Context, input CreateOrderInput) (*dto
 		return nil, fmt.Errorf("failed to get modifier options: %w", err)
 	}
 
-	err = s.validateOrderItems(input.OrderItems, input.RestaurantID, menuItemsFromDB, modifierOptionsFromDB)
+	items, err = s.applyDefaultSelections(ctx, items, menuItemsFromDB, modifierOptionsFromDB)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.validateOrderItems(items, restaurant.ID, menuItemsFromDB, modifierOptionsFromDB)
+	if err != nil {
+		return nil, err
+	}
+
+	menus, err := s.MenuRepo.GetAllWithContents(ctx, restaurant.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get menus: %w", err)
+	}
+	itemPrices, err := menuPrices(menus, restaurant, orderType, at, menuItemsFromDB)
 	if err != nil {
 		return nil, err
 	}
 
 	var orderItems []repos.OrderItemData
-	for _, item := range input.OrderItems {
-		var modifiers []repos.ModifierItemData
-		for _, m := range item.ModifierOptions {
-			modifiers = append(modifiers, repos.ModifierItemData{
-				ModifierOptionID: m.ModifierOptionID,
-				Quantity:         m.Quantity,
-				OptionName:       modifierOptionsFromDB[m.ModifierOptionID].Name,
-				OptionPrice:      modifierOptionsFromDB[m.ModifierOptionID].Price,
-			})
-		}
-		orderItems = append(orderItems, repos.OrderItemData{
+	for _, item := range items {
+		variant := findVariant(menuItemsFromDB[item.MenuItemID], item.VariantID)
+		data := repos.OrderItemData{
 			MenuItemID:          item.MenuItemID,
 			Quantity:            item.Quantity,
 			ItemName:            menuItemsFromDB[item.MenuItemID].Name,
-			ItemPrice:           menuItemsFromDB[item.MenuItemID].Price,
+			ItemPrice:           itemPrices[item.MenuItemID],
 			SpecialInstructions: item.SpecialInstruction,
-			ModifierOptions:     modifiers,
+			ModifierOptions:     modifierItems(item.ModifierOptions, menuItemsFromDB[item.MenuItemID].Modifiers, modifierOptionsFromDB, variant),
+		}
+		if variant != nil {
+			data.VariantID = &variant.ID
+			data.VariantName = variant.Name
+			data.ItemPrice = variant.Price.Amount
+		}
+		orderItems = append(orderItems, data)
+	}
+	return orderItems, nil
+}
+
+// applyDefaultSelections returns items with the pre_select options of each
+// modifier group nothing was selected from added at quantity 1, and with
+// the pre_select options selected at quantity 0 left out: a customer
+// deselects a default by ordering none of it, which still counts as a
+// choice in its group. Defaults get the defaults of their own child groups
+// in turn. The default options are added to options.
+func (s *orderService) applyDefaultSelections(
+	ctx context.Context,
+	items []OrderItemInput,
+	menuItems map[int64]*dto.MenuItem,
+	options map[uuid.UUID]*dto.ModifierOption,
+) ([]OrderItemInput, error) {
+	preSelected, err := s.preSelectedOptions(ctx, items, menuItems, options)
+	if err != nil {
+		return nil, err
+	}
+
+	withDefaults := slices.Clone(items)
+	for i := range withDefaults {
+		menuItem := menuItems[withDefaults[i].MenuItemID]
+		withDefaults[i].ModifierOptions = defaultSelections(withDefaults[i].ModifierOptions, menuItem.Modifiers, options, preSelected)
+	}
+	return withDefaults, nil
+}
+
+// preSelectedOptions returns the pre_select options of the modifier groups
+// of items and of the options in options, and of the child groups of those
+// options in turn, by modifier ID in display order. They are added to
+// options.
+func (s *orderService) preSelectedOptions(
+	ctx context.Context,
+	items []OrderItemInput,
+	menuItems map[int64]*dto.MenuItem,
+	options map[uuid.UUID]*dto.ModifierOption,
+) (map[uuid.UUID][]*dto.ModifierOption, error) {
+	seen := ds.NewSet[uuid.UUID]()
+	pending := ds.NewSet[uuid.UUID]()
+	addGroups := func(groups []dto.Modifier) {
+		for _, group := range groups {
+			if !seen.Contains(group.ID) {
+				seen.Add(group.ID)
+				pending.Add(group.ID)
+			}
+		}
+	}
+	for _, item := range items {
+		if menuItem, ok := menuItems[item.MenuItemID]; ok {
+			addGroups(menuItem.Modifiers)
+		}
+	}
+	for _, option := range options {
+		addGroups(option.ChildModifiers)
+	}
+
+	byGroup := make(map[uuid.UUID][]*dto.ModifierOption)
+	for pending.Size() > 0 {
+		fetched, err := s.ModifierOptionRepo.GetPreSelected(ctx, *pending)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get pre-selected modifier options: %w", err)
+		}
+		pending = ds.NewSet[uuid.UUID]()
+		for id, option := range fetched {
+			options[id] = option
+			byGroup[option.ModifierID] = append(byGroup[option.ModifierID], option)
+			addGroups(option.ChildModifiers)
+		}
+	}
+
+	for _, group := range byGroup {
+		slices.SortFunc(group, func(a, b *dto.ModifierOption) int {
+			return cmp.Or(cmp.Compare(a.DisplayOrder, b.DisplayOrder), strings.Compare(a.ID.String(), b.ID.String()))
 		})
 	}
-	data := &repos.CreateOrderData{
-		OrderType:     input.OrderType,
-		OrderStatus:   dto.OrderStatusOPEN,
-		PaymentStatus: dto.PaymentStatusUNPAID,
-		RestaurantID:  input.RestaurantID,
-		OrderItems:    orderItems,
+	return byGroup, nil
+}
+
+// defaultSelections applies the defaults described by
+// applyDefaultSelections to the options selected from groups.
+func defaultSelections(
+	selections []ModifierOptionInput,
+	groups []dto.Modifier,
+	options map[uuid.UUID]*dto.ModifierOption,
+	preSelected map[uuid.UUID][]*dto.ModifierOption,
+) []ModifierOptionInput {
+	touched := ds.NewSet[uuid.UUID]()
+	var result []ModifierOptionInput
+	for _, sel := range selections {
+		option, exists := options[sel.ModifierOptionID]
+		if !exists {
+			// Left for validateModifierSelections to reject.
+			result = append(result, sel)
+			continue
+		}
+		touched.Add(option.ModifierID)
+		inGroups := slices.ContainsFunc(groups, func(g dto.Modifier) bool { return g.ID == option.ModifierID })
+		if sel.Quantity == 0 && option.PreSelect && inGroups {
+			continue
+		}
+		sel.ModifierOptions = defaultSelections(sel.ModifierOptions, option.ChildModifiers, options, preSelected)
+		result = append(result, sel)
 	}
-	return s.OrderRepo.Create(ctx, data)
+
+	for _, group := range groups {
+		if touched.Contains(group.ID) {
+			continue
+		}
+		for _, option := range preSelected[group.ID] {
+			result = append(result, ModifierOptionInput{
+				ModifierOptionID: option.ID,
+				Quantity:         1,
+				ModifierOptions:  defaultSelections(nil, option.ChildModifiers, options, preSelected),
+			})
+		}
+	}
+	return result
+}
+
+// modifierItems snapshots the names and prices of the options selected
+// from groups and those chosen within them. Each group's free_quantity
+// units are given to its cheapest selected options first.
+func modifierItems(mods []ModifierOptionInput, groups []dto.Modifier, options map[uuid.UUID]*dto.ModifierOption, variant *dto.MenuItemVariant) []repos.ModifierItemData {
+	var items []repos.ModifierItemData
+	for _, m := range mods {
+		option := options[m.ModifierOptionID]
+		group, _ := utils.FindFirst(groups, func(g dto.Modifier) bool { return g.ID == option.ModifierID })
+		items = append(items, repos.ModifierItemData{
+			ModifierOptionID: m.ModifierOptionID,
+			Quantity:         m.Quantity,
+			OptionName:       option.Name,
+			OptionPrice:      optionPrice(option, variant, group.OptionPrices),
+			ModifierOptions:  modifierItems(m.ModifierOptions, option.ChildModifiers, options, variant),
+		})
+	}
+
+	for _, group := range groups {
+		if group.FreeQuantity == 0 {
+			continue
+		}
+		var inGroup []int
+		for i, m := range mods {
+			if options[m.ModifierOptionID].ModifierID == group.ID {
+				inGroup = append(inGroup, i)
+			}
+		}
+		slices.SortStableFunc(inGroup, func(a, b int) int {
+			return cmp.Compare(items[a].OptionPrice, items[b].OptionPrice)
+		})
+		free := group.FreeQuantity
+		for _, i := range inGroup {
+			items[i].FreeQuantity = min(free, items[i].Quantity)
+			free -= items[i].FreeQuantity
+		}
+	}
+	return items
+}
+
+// findVariant returns item's variant with the given ID, or nil if id is nil
+// or item has no such variant.
+func findVariant(item *dto.MenuItem, id *uuid.UUID) *dto.MenuItemVariant {
+	if id == nil {
+		return nil
+	}
+	for i := range item.Variants {
+		if item.Variants[i].ID == *id {
+			return &item.Variants[i]
+		}
+	}
+	return nil
+}
+
+// optionPrice is what option costs on an item ordered as variant: its price
+// for the variant's name if it sets one, otherwise the price itemPrices,
+// the item's prices for its group's options, set for it, otherwise its own
+// price.
+func optionPrice(option *dto.ModifierOption, variant *dto.MenuItemVariant, itemPrices map[uuid.UUID]money.Money) int64 {
+	if variant != nil {
+		if price, ok := option.VariantPrices[variant.Name]; ok {
+			return price.Amount
+		}
+	}
+	if price, ok := itemPrices[option.ID]; ok {
+		return price.Amount
+	}
+	return option.Price.Amount
+}
+
+// minSelections is the fewest options that must be selected from mod: its
+// min, or one if it is required.
+func minSelections(mod dto.Modifier) int {
+	if mod.Required {
+		return max(mod.Min, 1)
+	}
+	return mod.Min
+}
+
+// checkTakingOrders rejects public orders for restaurants that are inactive
+// or closed, and orders for as soon as possible while the restaurant is
+// outside its operating hours. Whether a scheduled order's time is within
+// hours is checked by scheduleOrder.
+func checkTakingOrders(restaurant *dto.RestaurantResponse, scheduled bool, now time.Time) error {
+	if restaurant.Status != dto.RestaurantStatusActive {
+		return apperr.Invalid("restaurant %s is not taking orders", restaurant.ID)
+	}
+	if scheduled {
+		return nil
+	}
+	status, err := openStatus(restaurant, now)
+	if err != nil {
+		return err
+	}
+	if !status.IsOpen {
+		if status.NextOpening != nil {
+			return apperr.Invalid("restaurant %s is closed until %s", restaurant.ID, status.NextOpening.Format(time.RFC3339))
+		}
+		return apperr.Invalid("restaurant %s is closed", restaurant.ID)
+	}
+	return nil
+}
+
+// priceDelivery finds the restaurant's active delivery zone the address is
+// in, checks the order meets the zone's minimum value (on the subtotal) and
+// adds its delivery fee to totals. Addresses outside every zone and orders
+// under the minimum are apperr.Invalid.
+func (s *orderService) priceDelivery(ctx context.Context, restaurant *dto.RestaurantResponse, input *DeliveryInput, totals *OrderTotals) (*dto.DeliveryDetails, error) {
+	if input == nil {
+		return nil, apperr.Invalid("%s orders need delivery details", dto.OrderTypeDELIVERY)
+	}
+
+	zones, err := s.DeliveryZoneRepo.GetAllByRestaurant(ctx, restaurant.ID, true)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get delivery zones: %w", err)
+	}
+	if len(zones) == 0 {
+		return nil, apperr.Invalid("restaurant %s does not deliver", restaurant.ID)
+	}
+
+	var location *geo.Point
+	if restaurant.Latitude != nil && restaurant.Longitude != nil {
+		location = &geo.Point{Lat: *restaurant.Latitude, Lng: *restaurant.Longitude}
+	}
+	zone := matchDeliveryZone(zones, location, geo.Point{Lat: input.Latitude, Lng: input.Longitude})
+	if zone == nil {
+		return nil, apperr.Invalid("delivery address is outside the delivery area")
+	}
+	if totals.Subtotal < zone.MinOrderValue.Amount {
+		return nil, apperr.Invalid("delivery orders to %s must be at least %s", zone.Name, zone.MinOrderValue)
+	}
+	addDeliveryFee(totals, zone.DeliveryFee.Amount)
+
+	return &dto.DeliveryDetails{
+		Address:       input.Address,
+		Latitude:      input.Latitude,
+		Longitude:     input.Longitude,
+		ContactName:   input.ContactName,
+		ContactPhone:  input.ContactPhone,
+		Instructions:  input.Instructions,
+		ZoneID:        &zone.ID,
+		MinOrderValue: zone.MinOrderValue,
+	}, nil
+}
+
+// resolveTable finds the table an order is placed at, by QR token or by ID
+// within input.RestaurantID. Unknown, mismatched and inactive tables are
+// apperr.Invalid, as for the order's other references.
+func (s *orderService) resolveTable(ctx context.Context, input CreateOrderInput) (*dto.Table, error) {
+	var (
+		table *dto.Table
+		err   error
+	)
+	if input.TableToken != "" {
+		table, err = s.TableRepo.GetByQRToken(ctx, input.TableToken)
+		if errors.Is(err, apperr.ErrNotFound) {
+			return nil, apperr.Invalid("unknown table token")
+		}
+	} else {
+		table, err = s.TableRepo.GetByID(ctx, input.RestaurantID, *input.TableID)
+		if errors.Is(err, apperr.ErrNotFound) {
+			return nil, apperr.Invalid("table %s does not belong to restaurant %s", *input.TableID, input.RestaurantID)
+		}
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get table: %w", err)
+	}
+
+	if input.TableID != nil && *input.TableID != table.ID {
+		return nil, apperr.Invalid("table_id does not match table_token")
+	}
+	if input.RestaurantID != uuid.Nil && input.RestaurantID != table.RestaurantID {
+		return nil, apperr.Invalid("table does not belong to restaurant %s", input.RestaurantID)
+	}
+	if !table.IsActive {
+		return nil, apperr.Invalid("table %s is not taking orders", table.Name)
+	}
+	return table, nil
 }
 
-func (s *orderService) GetByID(ctx context.Context, id uuid.UUID) (*dto.Order, error) {
-	return s.OrderRepo.GetByID(ctx, id, repos.WithOrderItems(repos.WithOrderItemModifierOptions()))
+func (s *orderService) GetByID(ctx context.Context, actor authz.Actor, id uuid.UUID) (*dto.Order, error) {
+	resource, err := s.authorize(ctx, actor, ActionReadOrder, id)
+	if err != nil {
+		return nil, err
+	}
+	return s.OrderRepo.GetByID(ctx, resource.RestaurantID, id, repos.WithOrderItems(repos.WithOrderItemModifierOptions()))
 }
 
-func (s *orderService) GetAllByRestaurant(ctx context.Context, restaurantID uuid.UUID) ([]*dto.Order, error) {
-	return s.OrderRepo.GetAllByRestaurant(ctx, restaurantID)
+func (s *orderService) ReleaseDueOrders(ctx context.Context) (int, error) {
+	return s.OrderRepo.ReleaseDue(ctx, time.Now())
 }
 
-func (s *orderService) Update(ctx context.Context, id uuid.UUID, req *dto.UpdateOrderRequest) (*dto.Order, error) {
-	return s.OrderRepo.Update(ctx, &dto.UpdateOrderData{
+func (s *orderService) GetAllByRestaurant(ctx context.Context, actor authz.Actor, restaurantID uuid.UUID, filters dto.OrderListFilters) ([]*dto.Order, error) {
+	if err := s.authorizeRestaurant(ctx, actor, ActionReadOrder, restaurantID); err != nil {
+		return nil, err
+	}
+	return s.OrderRepo.GetAllByRestaurant(ctx, restaurantID, filters)
+}
+
+// Update applies a partial update. A requested order_status change is
+// checked against orderStatusTransitions and rejected with apperr.Conflict if
+// the lifecycle doesn't allow it; the repository then records it as an
+// OrderStatusEvent attributed to actor. The order_type is fixed once the
+// order is placed, since its table, delivery details and fee, and the menus
+// its items came from all depend on it; a different one is rejected with
+// apperr.Conflict.
+func (s *orderService) Update(ctx context.Context, actor authz.Actor, id uuid.UUID, req *dto.UpdateOrderRequest) (*dto.Order, error) {
+	resource, err := s.authorize(ctx, actor, ActionUpdateOrder, id)
+	if err != nil {
+		return nil, err
+	}
+	data := &dto.UpdateOrderData{
 		Request: req,
 		ID:      id,
+	}
+	if req.OrderType == nil && req.OrderStatus == nil {
+		return s.OrderRepo.Update(ctx, resource.RestaurantID, data)
+	}
+
+	current, err := s.OrderRepo.GetByID(ctx, resource.RestaurantID, id)
+	if err != nil {
+		return nil, err
+	}
+	if req.OrderType != nil && dto.OrderType(*req.OrderType) != current.OrderType {
+		return nil, apperr.Conflict("cannot change order type from %s to %s once the order is placed", current.OrderType, *req.OrderType)
+	}
+
+	if req.OrderStatus != nil {
+		to := dto.OrderStatus(*req.OrderStatus)
+		if !canTransitionOrderStatus(current.OrderStatus, to) {
+			return nil, apperr.Conflict("cannot change order status from %s to %s", current.OrderStatus, to)
+		}
+
+		transition := &dto.OrderStatusTransition{
+			From:      current.OrderStatus,
+			To:        to,
+			ChangedBy: actor.UserID,
+		}
+		if req.Reason != nil {
+			transition.Reason = *req.Reason
+		}
+		data.StatusTransition = transition
+	}
+
+	return s.OrderRepo.Update(ctx, resource.RestaurantID, data)
+}
+
+func (s *orderService) Delete(ctx context.Context, actor authz.Actor, id uuid.UUID) error {
+	resource, err := s.authorize(ctx, actor, ActionDeleteOrder, id)
+	if err != nil {
+		return err
+	}
+	return s.OrderRepo.Delete(ctx, resource.RestaurantID, id)
+}
+
+func (s *orderService) GetStatusHistory(ctx context.Context, actor authz.Actor, id uuid.UUID) ([]*dto.OrderStatusEvent, error) {
+	resource, err := s.authorize(ctx, actor, ActionReadOrder, id)
+	if err != nil {
+		return nil, err
+	}
+	return s.OrderRepo.GetStatusHistory(ctx, resource.RestaurantID, id)
+}
+
+// AddItems adds lines to an order, validated against the catalogue like
+// those of a new order, and priced from the menus served now, or at the
+// time a scheduled order is for. On an order already sent to the kitchen
+// they go out on new station tickets.
+func (s *orderService) AddItems(ctx context.Context, actor authz.Actor, orderID uuid.UUID, items []OrderItemInput) (*dto.Order, error) {
+	if len(items) == 0 {
+		return nil, apperr.Invalid("at least one item is required")
+	}
+	ord, err := s.getEditableOrder(ctx, actor, orderID)
+	if err != nil {
+		return nil, err
+	}
+	restaurant, err := s.RestaurantRepo.GetByID(ctx, ord.RestaurantID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get restaurant: %w", err)
+	}
+	orderedFor := time.Now()
+	if ord.ScheduledFor != nil {
+		orderedFor = *ord.ScheduledFor
+	}
+	added, err := s.buildOrderItems(ctx, restaurant, ord.OrderType, orderedFor, items)
+	if err != nil {
+		return nil, err
+	}
+	return s.changeItems(ctx, actor, ord, added, nil, "")
+}
+
+// UpdateItem changes a line's quantity and/or special instructions. Lowering
+// the quantity of a line already sent to the kitchen takes a reason, and a
+// line cannot drop below the units refunded on it.
+func (s *orderService) UpdateItem(ctx context.Context, actor authz.Actor, orderID, itemID uuid.UUID, req *dto.UpdateOrderItemRequest) (*dto.Order, error) {
+	if req.Quantity == nil && req.SpecialInstructions == nil {
+		return nil, apperr.Invalid("quantity or special_instructions is required")
+	}
+	ord, err := s.getEditableOrder(ctx, actor, orderID)
+	if err != nil {
+		return nil, err
+	}
+	item, err := liveOrderItem(ord, itemID)
+	if err != nil {
+		return nil, err
+	}
+
+	update := repos.OrderItemUpdateData{
+		ID:                  item.ID,
+		Quantity:            item.Quantity,
+		SpecialInstructions: item.SpecialInstructions,
+	}
+	if req.Quantity != nil {
+		update.Quantity = *req.Quantity
+	}
+	if req.SpecialInstructions != nil {
+		update.SpecialInstructions = *req.SpecialInstructions
+	}
+	var reason string
+	if req.Reason != nil {
+		reason = strings.TrimSpace(*req.Reason)
+	}
+	if update.Quantity < item.Quantity {
+		if ord.ReleasedAt != nil && reason == "" {
+			return nil, apperr.Invalid("a reason is required to lower the quantity of an item already sent to the kitchen")
+		}
+		if update.Quantity < item.RefundedQuantity {
+			return nil, apperr.Conflict("%d units of order item %s have been refunded", item.RefundedQuantity, item.ID)
+		}
+	}
+	return s.changeItems(ctx, actor, ord, nil, []repos.OrderItemUpdateData{update}, reason)
+}
+
+// VoidItem takes a line off the order's totals, keeping it on the order
+// marked as voided. Voiding a line already sent to the kitchen takes a
+// reason. Lines with refunded units, and the order's last line, cannot be
+// voided; the order is cancelled instead.
+func (s *orderService) VoidItem(ctx context.Context, actor authz.Actor, orderID, itemID uuid.UUID, reason string) (*dto.Order, error) {
+	reason = strings.TrimSpace(reason)
+	ord, err := s.getEditableOrder(ctx, actor, orderID)
+	if err != nil {
+		return nil, err
+	}
+	item, err := liveOrderItem(ord, itemID)
+	if err != nil {
+		return nil, err
+	}
+	if ord.ReleasedAt != nil && reason == "" {
+		return nil, apperr.Invalid("a reason is required to void an item already sent to the kitchen")
+	}
+	if item.RefundedQuantity > 0 {
+		return nil, apperr.Conflict("order item %s has refunded units and cannot be voided", item.ID)
+	}
+	live := 0
+	for _, oi := range ord.OrderItems {
+		if oi.VoidedAt == nil {
+			live++
+		}
+	}
+	if live == 1 {
+		return nil, apperr.Conflict("order item %s is the order's last item, cancel the order instead", item.ID)
+	}
+	return s.changeItems(ctx, actor, ord, nil, []repos.OrderItemUpdateData{{ID: item.ID, Void: true}}, reason)
+}
+
+func (s *orderService) GetItemChanges(ctx context.Context, actor authz.Actor, id uuid.UUID) ([]*dto.OrderItemChange, error) {
+	resource, err := s.authorize(ctx, actor, ActionReadOrder, id)
+	if err != nil {
+		return nil, err
+	}
+	return s.OrderRepo.GetItemChanges(ctx, resource.RestaurantID, id)
+}
+
+// authorize resolves the order's authz.Resource, checks the actor may perform
+// action on it, and returns the resource so callers can scope the follow-up
+// repo query by RestaurantID.
+func (s *orderService) authorize(ctx context.Context, actor authz.Actor, action authz.Action, id uuid.UUID) (authz.Resource, error) {
+	resource, err := s.OrderRepo.GetAuthorizationResource(ctx, id)
+	if err != nil {
+		return authz.Resource{}, err
+	}
+	if _, err := s.authorizer.Authorize(ctx, authz.Request{
+		Actor:    actor,
+		Action:   action,
+		Resource: resource,
+	}); err != nil {
+		return authz.Resource{}, err
+	}
+	return resource, nil
+}
+
+// authorizeRestaurant checks the actor may perform action on the orders of
+// restaurantID, which it must own.
+func (s *orderService) authorizeRestaurant(ctx context.Context, actor authz.Actor, action authz.Action, restaurantID uuid.UUID) error {
+	resource, err := s.RestaurantRepo.GetAuthorizationResource(ctx, restaurantID)
+	if err != nil {
+		return err
+	}
+	_, err = s.authorizer.Authorize(ctx, authz.Request{
+		Actor:    actor,
+		Action:   action,
+		Resource: resource,
 	})
+	return err
+}
+
+// getEditableOrder authorizes the actor to update an order and loads it,
+// with its items, as they are about to change. Only OPEN and CONFIRMED
+// orders can be changed; others are apperr.Conflict.
+func (s *orderService) getEditableOrder(ctx context.Context, actor authz.Actor, id uuid.UUID) (*dto.Order, error) {
+	resource, err := s.authorize(ctx, actor, ActionUpdateOrder, id)
+	if err != nil {
+		return nil, err
+	}
+	ord, err := s.OrderRepo.GetByID(ctx, resource.RestaurantID, id, repos.WithOrderItems(repos.WithOrderItemModifierOptions()))
+	if err != nil {
+		return nil, err
+	}
+	if ord.OrderStatus != dto.OrderStatusOPEN && ord.OrderStatus != dto.OrderStatusCONFIRMED {
+		return nil, apperr.Conflict("items of a %s order cannot be changed", ord.OrderStatus)
+	}
+	return ord, nil
 }
 
-func (s *orderService) Delete(ctx context.Context, id uuid.UUID) error {
-	return s.OrderRepo.Delete(ctx, id)
+// liveOrderItem finds line itemID of ord; voided lines are apperr.Conflict.
+func liveOrderItem(ord *dto.Order, itemID uuid.UUID) (*dto.OrderItem, error) {
+	idx := slices.IndexFunc(ord.OrderItems, func(oi dto.OrderItem) bool { return oi.ID == itemID })
+	if idx < 0 {
+		return nil, apperr.NotFound("order item %s", itemID)
+	}
+	item := &ord.OrderItems[idx]
+	if item.VoidedAt != nil {
+		return nil, apperr.Conflict("order item %s has been voided", itemID)
+	}
+	return item, nil
+}
+
+// changeItems re-prices ord with the change applied, at the tax rate it was
+// placed at, and saves it. The total may not drop below what has already
+// been paid; that money has to be refunded first. A DELIVERY order's
+// subtotal may not drop below its zone's minimum either.
+func (s *orderService) changeItems(
+	ctx context.Context,
+	actor authz.Actor,
+	ord *dto.Order,
+	add []repos.OrderItemData,
+	update []repos.OrderItemUpdateData,
+	reason string,
+) (*dto.Order, error) {
+	taxRateBps, err := s.orderTaxRate(ctx, ord)
+	if err != nil {
+		return nil, err
+	}
+
+	totals := repriceOrderItems(ord, add, update, taxRateBps)
+	if d := ord.Delivery; d != nil && totals.Subtotal < d.MinOrderValue.Amount {
+		return nil, apperr.Invalid("delivery order %s must stay at least %s, its delivery zone's minimum", ord.ID, d.MinOrderValue)
+	}
+	if totals.Total < ord.AmountPaid.Amount {
+		return nil, apperr.Conflict("%s has been paid on order %s, refund it before lowering the total to %s",
+			ord.AmountPaid, ord.ID, money.New(totals.Total, ord.Currency))
+	}
+
+	return s.OrderRepo.ChangeItems(ctx, &repos.ChangeOrderItemsData{
+		OrderID:              ord.ID,
+		ExpectedItemsVersion: ord.ItemsVersion,
+		Released:             ord.ReleasedAt != nil,
+		Add:                  add,
+		Update:               update,
+		Subtotal:             totals.Subtotal,
+		ModifiersTotal:       totals.ModifiersTotal,
+		TaxTotal:             totals.TaxTotal,
+		Total:                totals.Total,
+		ChangedBy:            actor.UserID,
+		Reason:               reason,
+	})
+}
+
+// orderTaxRate is the tax rate ord was placed at. Orders placed before the
+// rate was recorded fall back to the restaurant's current rate.
+func (s *orderService) orderTaxRate(ctx context.Context, ord *dto.Order) (int, error) {
+	if ord.TaxRateBps != nil {
+		return *ord.TaxRateBps, nil
+	}
+	restaurant, err := s.RestaurantRepo.GetByID(ctx, ord.RestaurantID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get restaurant: %w", err)
+	}
+	return restaurant.TaxRateBps, nil
 }
 
 func (s *orderService) validateOrderItems(
@@ -158,69 +946,104 @@ func (s *orderService) validateOrderItems(
 		if !menuItem.IsAvailable {
 			return apperr.Invalid("menu item with ID %d is not available", item.MenuItemID)
 		}
+		if len(menuItem.Variants) > 0 {
+			if item.VariantID == nil {
+				return apperr.Invalid("menu item with ID %d has variants; one must be chosen", item.MenuItemID)
+			}
+			variant := findVariant(menuItem, item.VariantID)
+			if variant == nil {
+				return apperr.Invalid("variant with ID %s does not belong to menu item with ID %d", *item.VariantID, item.MenuItemID)
+			}
+			if !variant.IsAvailable {
+				return apperr.Invalid("variant with ID %s is not available", *item.VariantID)
+			}
+		} else if item.VariantID != nil {
+			return apperr.Invalid("menu item with ID %d has no variants", item.MenuItemID)
+		}
 		if menuItem.Modifiers == nil {
 			return fmt.Errorf("INTERNAL ERROR: menu item with ID %d has no modifiers loaded", item.MenuItemID)
 		}
 
-		ModifiersIds := ds.NewSet[uuid.UUID]()
-		for _, mod := range menuItem.Modifiers {
-			ModifiersIds.Add(mod.ID)
+		owner := fmt.Sprintf("menu item with ID %d", item.MenuItemID)
+		if err := validateModifierSelections(item.ModifierOptions, menuItem.Modifiers, owner, modifierOptionsFromDB); err != nil {
+			return err
 		}
+	}
 
-		modifierGroup := make(map[uuid.UUID][]ModifierOptionInput)
-		for _, modOpt := range item.ModifierOptions {
-			modifierOption, exists := modifierOptionsFromDB[modOpt.ModifierOptionID]
-			if !exists {
-				return apperr.Invalid("modifier option with ID %s does not exist", modOpt.ModifierOptionID)
-			}
-			if !modifierOption.Available {
-				return apperr.Invalid("modifier option with ID %s is not available", modOpt.ModifierOptionID)
-			}
-			if modOpt.Quantity < 1 {
-				return apperr.Invalid("modifier option with ID %s has invalid quantity %d", modOpt.ModifierOptionID, modOpt.Quantity)
-			}
-			if !ModifiersIds.Contains(modifierOption.ModifierID) {
-				return apperr.Invalid("modifier option with ID %s does not belong to menu item with ID %d", modOpt.ModifierOptionID, item.MenuItemID)
-			}
-			modifierGroup[modifierOption.ModifierID] = append(modifierGroup[modifierOption.ModifierID], modOpt)
-		}
+	return nil
+}
 
-		for _, mod := range menuItem.Modifiers {
-			if mod.Required {
-				if _, exists := modifierGroup[mod.ID]; !exists {
-					return apperr.Invalid("required modifier group with ID %s has no selected options", mod.ID)
-				}
-			}
+// validateModifierSelections checks the options selected from groups, the
+// modifier groups of owner, against the groups' min, max and multi_select
+// rules and the options' max_quantity, then the options chosen within each
+// selected option against its child groups in turn.
+func validateModifierSelections(
+	selections []ModifierOptionInput,
+	groups []dto.Modifier,
+	owner string,
+	modifierOptionsFromDB map[uuid.UUID]*dto.ModifierOption,
+) error {
+	ModifiersIds := ds.NewSet[uuid.UUID]()
+	for _, mod := range groups {
+		ModifiersIds.Add(mod.ID)
+	}
+
+	selected := ds.NewSet[uuid.UUID]()
+	modifierGroup := make(map[uuid.UUID][]ModifierOptionInput)
+	for _, modOpt := range selections {
+		if selected.Contains(modOpt.ModifierOptionID) {
+			return apperr.Invalid("modifier option with ID %s is selected more than once for %s; set its quantity instead", modOpt.ModifierOptionID, owner)
+		}
+		selected.Add(modOpt.ModifierOptionID)
+		modifierOption, exists := modifierOptionsFromDB[modOpt.ModifierOptionID]
+		if !exists {
+			return apperr.Invalid("modifier option with ID %s does not exist", modOpt.ModifierOptionID)
+		}
+		if !modifierOption.Available {
+			return apperr.Invalid("modifier option with ID %s is not available", modOpt.ModifierOptionID)
+		}
+		if modOpt.Quantity < 1 {
+			return apperr.Invalid("modifier option with ID %s has invalid quantity %d", modOpt.ModifierOptionID, modOpt.Quantity)
+		}
+		if modifierOption.MaxQuantity > 0 && modOpt.Quantity > modifierOption.MaxQuantity {
+			return apperr.Invalid("modifier option with ID %s can be selected at most %d times, %d selected",
+				modOpt.ModifierOptionID, modifierOption.MaxQuantity, modOpt.Quantity)
 		}
+		if !ModifiersIds.Contains(modifierOption.ModifierID) {
+			return apperr.Invalid("modifier option with ID %s does not belong to %s", modOpt.ModifierOptionID, owner)
+		}
+		modifierGroup[modifierOption.ModifierID] = append(modifierGroup[modifierOption.ModifierID], modOpt)
+	}
 
-		for groupId, modOptions := range modifierGroup {
-			modifier, found := utils.FindFirst(menuItem.Modifiers, func(m dto.Modifier) bool {
-				return m.ID == groupId
-			})
-			if !found {
-				return fmt.Errorf("INTERNAL ERROR: modifier with ID %s is not loaded correctly", groupId)
-			}
-			NumSelected := utils.Reduce(
-				modOptions,
-				func(acc int, modOpt ModifierOptionInput) int {
-					return acc + modOpt.Quantity
-				},
-				0,
-			)
-			if (modifier.Required && NumSelected < 1) || NumSelected > modifier.Max {
-				min := 0
-				if modifier.Required {
-					min = 1
-				}
-				return apperr.Invalid("number of selected modifier options for modifier ID %s violates constraints (%d selected, min %d, max %d)",
-					groupId,
-					NumSelected,
-					min,
-					modifier.Max,
-				)
-			}
+	for _, modifier := range groups {
+		modOptions := modifierGroup[modifier.ID]
+		NumSelected := utils.Reduce(
+			modOptions,
+			func(acc int, modOpt ModifierOptionInput) int {
+				return acc + modOpt.Quantity
+			},
+			0,
+		)
+		if atLeast := minSelections(modifier); NumSelected < atLeast {
+			return apperr.Invalid("modifier group %q with ID %s needs at least %d selected options for %s, %d selected",
+				modifier.Name, modifier.ID, atLeast, owner, NumSelected)
+		}
+		if NumSelected > modifier.Max {
+			return apperr.Invalid("modifier group %q with ID %s allows at most %d selected options for %s, %d selected",
+				modifier.Name, modifier.ID, modifier.Max, owner, NumSelected)
+		}
+		if !modifier.MultiSelect && len(modOptions) > 1 {
+			return apperr.Invalid("modifier group %q with ID %s takes a single option for %s, %d different options selected",
+				modifier.Name, modifier.ID, owner, len(modOptions))
 		}
 	}
 
+	for _, modOpt := range selections {
+		option := modifierOptionsFromDB[modOpt.ModifierOptionID]
+		owner := fmt.Sprintf("modifier option with ID %s", modOpt.ModifierOptionID)
+		if err := validateModifierSelections(modOpt.ModifierOptions, option.ChildModifiers, owner, modifierOptionsFromDB); err != nil {
+			return err
+		}
+	}
 	return nil
 }
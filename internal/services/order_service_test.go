@@ -0,0 +1,794 @@
+package services
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Jiruu246/rms/internal/apperr"
+	"github.com/Jiruu246/rms/internal/authz"
+	"github.com/Jiruu246/rms/internal/dto"
+	"github.com/Jiruu246/rms/internal/repos"
+	"github.com/Jiruu246/rms/pkg/hours"
+	"github.com/Jiruu246/rms/pkg/money"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCanTransitionOrderStatus(t *testing.T) {
+	testCases := []struct {
+		from     dto.OrderStatus
+		to       dto.OrderStatus
+		expected bool
+	}{
+		{dto.OrderStatusOPEN, dto.OrderStatusCONFIRMED, true},
+		{dto.OrderStatusOPEN, dto.OrderStatusCANCELLED, true},
+		{dto.OrderStatusOPEN, dto.OrderStatusCOMPLETED, false},
+		{dto.OrderStatusOPEN, dto.OrderStatusOPEN, false},
+		{dto.OrderStatusCONFIRMED, dto.OrderStatusCOMPLETED, true},
+		{dto.OrderStatusCONFIRMED, dto.OrderStatusCANCELLED, true},
+		{dto.OrderStatusCONFIRMED, dto.OrderStatusOPEN, false},
+		{dto.OrderStatusCONFIRMED, dto.OrderStatusREADY, true},
+		{dto.OrderStatusOPEN, dto.OrderStatusREADY, false},
+		{dto.OrderStatusREADY, dto.OrderStatusCOMPLETED, true},
+		{dto.OrderStatusREADY, dto.OrderStatusCANCELLED, true},
+		{dto.OrderStatusREADY, dto.OrderStatusCONFIRMED, false},
+		{dto.OrderStatusCOMPLETED, dto.OrderStatusOPEN, false},
+		{dto.OrderStatusCOMPLETED, dto.OrderStatusCANCELLED, false},
+		{dto.OrderStatusCANCELLED, dto.OrderStatusCONFIRMED, false},
+		{dto.OrderStatusCANCELLED, dto.OrderStatusOPEN, false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(string(tc.from)+"->"+string(tc.to), func(t *testing.T) {
+			assert.Equal(t, tc.expected, canTransitionOrderStatus(tc.from, tc.to))
+		})
+	}
+}
+
+func TestPriceOrderItems(t *testing.T) {
+	items := []repos.OrderItemData{
+		{
+			Quantity:  2,
+			ItemPrice: 999,
+			ModifierOptions: []repos.ModifierItemData{
+				{OptionPrice: 150, Quantity: 1},
+				{OptionPrice: 25, Quantity: 2},
+			},
+		},
+		{
+			Quantity:  1,
+			ItemPrice: 450,
+		},
+	}
+
+	totals := priceOrderItems(items, 1000)
+
+	// Line 1: modifiers (150 + 50) * 2 = 400, line = 1998 + 400.
+	assert.Equal(t, int64(400), items[0].ModifiersTotal)
+	assert.Equal(t, int64(2398), items[0].LineTotal)
+	assert.Equal(t, int64(0), items[1].ModifiersTotal)
+	assert.Equal(t, int64(450), items[1].LineTotal)
+
+	assert.Equal(t, int64(2848), totals.Subtotal)
+	assert.Equal(t, int64(400), totals.ModifiersTotal)
+	assert.Equal(t, int64(285), totals.TaxTotal) // 284.8 rounds up
+	assert.Equal(t, int64(3133), totals.Total)
+}
+
+func TestPriceOrderItems_NoTax(t *testing.T) {
+	items := []repos.OrderItemData{{Quantity: 3, ItemPrice: 10}}
+
+	totals := priceOrderItems(items, 0)
+
+	assert.Equal(t, int64(30), totals.Subtotal)
+	assert.Equal(t, int64(0), totals.TaxTotal)
+	assert.Equal(t, int64(30), totals.Total)
+}
+
+func TestOrderService_ResolveTable(t *testing.T) {
+	restaurantID := uuid.New()
+	table := &dto.Table{ID: uuid.New(), Name: "T1", IsActive: true, QRToken: "tok", RestaurantID: restaurantID}
+	inactive := &dto.Table{ID: uuid.New(), Name: "T2", IsActive: false, QRToken: "off", RestaurantID: restaurantID}
+	otherID := uuid.New()
+
+	testCases := []struct {
+		name          string
+		input         CreateOrderInput
+		expectedError error
+	}{
+		{name: "token", input: CreateOrderInput{TableToken: "tok"}},
+		{name: "token with matching restaurant and table", input: CreateOrderInput{TableToken: "tok", RestaurantID: restaurantID, TableID: &table.ID}},
+		{name: "id", input: CreateOrderInput{RestaurantID: restaurantID, TableID: &table.ID}},
+		{name: "unknown token", input: CreateOrderInput{TableToken: "nope"}, expectedError: apperr.ErrInvalid},
+		{name: "token for another restaurant", input: CreateOrderInput{TableToken: "tok", RestaurantID: uuid.New()}, expectedError: apperr.ErrInvalid},
+		{name: "token for another table", input: CreateOrderInput{TableToken: "tok", TableID: &otherID}, expectedError: apperr.ErrInvalid},
+		{name: "id from another restaurant", input: CreateOrderInput{RestaurantID: restaurantID, TableID: &otherID}, expectedError: apperr.ErrInvalid},
+		{name: "inactive", input: CreateOrderInput{TableToken: "off"}, expectedError: apperr.ErrInvalid},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			repo := new(MockTableRepository)
+			repo.On("GetByQRToken", mock.Anything, "tok").Return(table, nil)
+			repo.On("GetByQRToken", mock.Anything, "off").Return(inactive, nil)
+			repo.On("GetByQRToken", mock.Anything, "nope").Return(nil, apperr.NotFound("table for token"))
+			repo.On("GetByID", mock.Anything, restaurantID, table.ID).Return(table, nil)
+			repo.On("GetByID", mock.Anything, restaurantID, otherID).Return(nil, apperr.NotFound("table %s", otherID))
+
+			svc := &orderService{TableRepo: repo}
+			got, err := svc.resolveTable(t.Context(), tc.input)
+
+			if tc.expectedError != nil {
+				assert.ErrorIs(t, err, tc.expectedError)
+				assert.Nil(t, got)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, table.ID, got.ID)
+		})
+	}
+}
+
+func TestOrderService_PriceDelivery(t *testing.T) {
+	lat, lng := 0.5, 0.5
+	restaurant := &dto.RestaurantResponse{ID: uuid.New(), Latitude: &lat, Longitude: &lng}
+	zone := &dto.DeliveryZone{
+		ID:            uuid.New(),
+		Name:          "Near",
+		Kind:          dto.DeliveryZoneKindRADIUS,
+		RadiusMeters:  intPtr(2_000),
+		DeliveryFee:   money.New(300, "USD"),
+		MinOrderValue: money.New(1_500, "USD"),
+	}
+	near := &DeliveryInput{Address: "1 Main St", Latitude: 0.51, Longitude: 0.5, ContactName: "Sam", ContactPhone: "555"}
+	far := &DeliveryInput{Address: "99 Far Rd", Latitude: 0.6, Longitude: 0.5, ContactName: "Sam", ContactPhone: "555"}
+
+	testCases := []struct {
+		name          string
+		zones         []*dto.DeliveryZone
+		input         *DeliveryInput
+		subtotal      int64
+		expectedError error
+	}{
+		{name: "in zone", zones: []*dto.DeliveryZone{zone}, input: near, subtotal: 2_000},
+		{name: "exactly the minimum", zones: []*dto.DeliveryZone{zone}, input: near, subtotal: 1_500},
+		{name: "under minimum", zones: []*dto.DeliveryZone{zone}, input: near, subtotal: 1_499, expectedError: apperr.ErrInvalid},
+		{name: "out of zone", zones: []*dto.DeliveryZone{zone}, input: far, subtotal: 2_000, expectedError: apperr.ErrInvalid},
+		{name: "no zones", zones: []*dto.DeliveryZone{}, input: near, subtotal: 2_000, expectedError: apperr.ErrInvalid},
+		{name: "no details", zones: []*dto.DeliveryZone{zone}, subtotal: 2_000, expectedError: apperr.ErrInvalid},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			repo := new(MockDeliveryZoneRepository)
+			repo.On("GetAllByRestaurant", mock.Anything, restaurant.ID, true).Return(tc.zones, nil)
+			totals := OrderTotals{Subtotal: tc.subtotal, TaxTotal: 100, Total: tc.subtotal + 100}
+
+			svc := &orderService{DeliveryZoneRepo: repo}
+			details, err := svc.priceDelivery(t.Context(), restaurant, tc.input, &totals)
+
+			if tc.expectedError != nil {
+				assert.ErrorIs(t, err, tc.expectedError)
+				assert.Nil(t, details)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, zone.ID, *details.ZoneID)
+			assert.Equal(t, zone.MinOrderValue, details.MinOrderValue)
+			assert.Equal(t, int64(300), totals.DeliveryFee)
+			assert.Equal(t, tc.subtotal+100+300, totals.Total)
+		})
+	}
+}
+
+func TestCheckTakingOrders(t *testing.T) {
+	lunch := &hours.Schedule{Friday: []hours.Interval{{Open: "11:00", Close: "14:00"}}}
+	// 2026-03-06 is a Friday.
+	noon := time.Date(2026, 3, 6, 12, 0, 0, 0, time.UTC)
+	evening := time.Date(2026, 3, 6, 20, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		name          string
+		status        string
+		scheduled     bool
+		now           time.Time
+		expectedError error
+	}{
+		{name: "open", status: dto.RestaurantStatusActive, now: noon},
+		{name: "outside hours", status: dto.RestaurantStatusActive, now: evening, expectedError: apperr.ErrInvalid},
+		{name: "scheduled outside hours", status: dto.RestaurantStatusActive, scheduled: true, now: evening},
+		{name: "inactive", status: dto.RestaurantStatusInactive, now: noon, expectedError: apperr.ErrInvalid},
+		{name: "closed", status: dto.RestaurantStatusClosed, scheduled: true, now: noon, expectedError: apperr.ErrInvalid},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			restaurant := &dto.RestaurantResponse{ID: uuid.New(), Status: tc.status, Timezone: "UTC", OperatingHours: lunch}
+
+			err := checkTakingOrders(restaurant, tc.scheduled, tc.now)
+
+			if tc.expectedError != nil {
+				assert.ErrorIs(t, err, tc.expectedError)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestRepriceOrderItems(t *testing.T) {
+	voidedAt := time.Now()
+	keep := dto.OrderItem{
+		ID:        uuid.New(),
+		Quantity:  2,
+		ItemPrice: money.New(500, "USD"),
+		ModifierOptions: []dto.OrderItemModifierOption{
+			{Quantity: 1, OptionPrice: money.New(100, "USD")},
+		},
+	}
+	changed := dto.OrderItem{ID: uuid.New(), Quantity: 1, ItemPrice: money.New(300, "USD")}
+	voided := dto.OrderItem{ID: uuid.New(), Quantity: 5, ItemPrice: money.New(1_000, "USD"), VoidedAt: &voidedAt}
+	ord := &dto.Order{
+		OrderItems:  []dto.OrderItem{keep, changed, voided},
+		DeliveryFee: money.New(250, "USD"),
+	}
+	update := []repos.OrderItemUpdateData{{ID: changed.ID, Quantity: 3}}
+	add := []repos.OrderItemData{{Quantity: 1, ItemPrice: 200}}
+
+	totals := repriceOrderItems(ord, add, update, 1000)
+
+	// keep: 1000 + 200, changed: 900, added: 200; the voided line is left out.
+	assert.Equal(t, int64(900), update[0].LineTotal)
+	assert.Equal(t, int64(200), add[0].LineTotal)
+	assert.Equal(t, int64(2_300), totals.Subtotal)
+	assert.Equal(t, int64(200), totals.ModifiersTotal)
+	assert.Equal(t, int64(230), totals.TaxTotal)
+	assert.Equal(t, int64(250), totals.DeliveryFee)
+	assert.Equal(t, int64(2_780), totals.Total)
+
+	totals = repriceOrderItems(ord, nil, []repos.OrderItemUpdateData{{ID: changed.ID, Void: true}}, 0)
+	assert.Equal(t, int64(1_200), totals.Subtotal)
+}
+
+func TestOrderService_ChangeItems(t *testing.T) {
+	orderID := uuid.New()
+	restaurantID := uuid.New()
+	released := time.Now()
+	first := dto.OrderItem{ID: uuid.New(), Quantity: 2, ItemPrice: money.New(500, "USD")}
+	second := dto.OrderItem{ID: uuid.New(), Quantity: 1, ItemPrice: money.New(300, "USD")}
+	refunded := dto.OrderItem{ID: uuid.New(), Quantity: 3, RefundedQuantity: 2, ItemPrice: money.New(400, "USD")}
+
+	newOrder := func(status dto.OrderStatus, items ...dto.OrderItem) *dto.Order {
+		return &dto.Order{
+			ID:           orderID,
+			RestaurantID: restaurantID,
+			OrderStatus:  status,
+			OrderItems:   items,
+			Currency:     "USD",
+			ReleasedAt:   &released,
+			ItemsVersion: 3,
+		}
+	}
+
+	testCases := []struct {
+		name          string
+		order         *dto.Order
+		change        func(OrderService) (*dto.Order, error)
+		expectedError error
+		expectedData  func(t *testing.T, data *repos.ChangeOrderItemsData)
+	}{
+		{
+			name:  "lower quantity with reason",
+			order: newOrder(dto.OrderStatusCONFIRMED, first, second),
+			change: func(s OrderService) (*dto.Order, error) {
+				return s.UpdateItem(t.Context(), adminActor, orderID, first.ID, &dto.UpdateOrderItemRequest{Quantity: intPtr(1), Reason: ptr("sent back")})
+			},
+			expectedData: func(t *testing.T, data *repos.ChangeOrderItemsData) {
+				assert.Equal(t, 3, data.ExpectedItemsVersion)
+				assert.True(t, data.Released)
+				assert.Equal(t, "sent back", data.Reason)
+				require.Len(t, data.Update, 1)
+				assert.Equal(t, int64(500), data.Update[0].LineTotal)
+				assert.Equal(t, int64(800), data.Total)
+			},
+		},
+		{
+			name:  "lower quantity sent to the kitchen without reason",
+			order: newOrder(dto.OrderStatusOPEN, first, second),
+			change: func(s OrderService) (*dto.Order, error) {
+				return s.UpdateItem(t.Context(), adminActor, orderID, first.ID, &dto.UpdateOrderItemRequest{Quantity: intPtr(1)})
+			},
+			expectedError: apperr.ErrInvalid,
+		},
+		{
+			name:  "raise quantity without reason",
+			order: newOrder(dto.OrderStatusOPEN, first, second),
+			change: func(s OrderService) (*dto.Order, error) {
+				return s.UpdateItem(t.Context(), adminActor, orderID, first.ID, &dto.UpdateOrderItemRequest{Quantity: intPtr(4)})
+			},
+			expectedData: func(t *testing.T, data *repos.ChangeOrderItemsData) {
+				assert.Equal(t, int64(2_300), data.Total)
+			},
+		},
+		{
+			name:  "below refunded units",
+			order: newOrder(dto.OrderStatusOPEN, refunded, second),
+			change: func(s OrderService) (*dto.Order, error) {
+				return s.UpdateItem(t.Context(), adminActor, orderID, refunded.ID, &dto.UpdateOrderItemRequest{Quantity: intPtr(1), Reason: ptr("returned")})
+			},
+			expectedError: apperr.ErrConflict,
+		},
+		{
+			name:  "completed order",
+			order: newOrder(dto.OrderStatusCOMPLETED, first, second),
+			change: func(s OrderService) (*dto.Order, error) {
+				return s.UpdateItem(t.Context(), adminActor, orderID, first.ID, &dto.UpdateOrderItemRequest{SpecialInstructions: ptr("no ice")})
+			},
+			expectedError: apperr.ErrConflict,
+		},
+		{
+			name:  "unknown item",
+			order: newOrder(dto.OrderStatusOPEN, first, second),
+			change: func(s OrderService) (*dto.Order, error) {
+				return s.UpdateItem(t.Context(), adminActor, orderID, uuid.New(), &dto.UpdateOrderItemRequest{SpecialInstructions: ptr("no ice")})
+			},
+			expectedError: apperr.ErrNotFound,
+		},
+		{
+			name:  "void with reason",
+			order: newOrder(dto.OrderStatusOPEN, first, second),
+			change: func(s OrderService) (*dto.Order, error) {
+				return s.VoidItem(t.Context(), adminActor, orderID, second.ID, "wrong table")
+			},
+			expectedData: func(t *testing.T, data *repos.ChangeOrderItemsData) {
+				assert.True(t, data.Update[0].Void)
+				assert.Equal(t, int64(1_000), data.Total)
+			},
+		},
+		{
+			name:  "void sent to the kitchen without reason",
+			order: newOrder(dto.OrderStatusOPEN, first, second),
+			change: func(s OrderService) (*dto.Order, error) {
+				return s.VoidItem(t.Context(), adminActor, orderID, second.ID, " ")
+			},
+			expectedError: apperr.ErrInvalid,
+		},
+		{
+			name: "void held order without reason",
+			order: func() *dto.Order {
+				ord := newOrder(dto.OrderStatusOPEN, first, second)
+				ord.ReleasedAt = nil
+				return ord
+			}(),
+			change: func(s OrderService) (*dto.Order, error) {
+				return s.VoidItem(t.Context(), adminActor, orderID, second.ID, "")
+			},
+			expectedData: func(t *testing.T, data *repos.ChangeOrderItemsData) {
+				assert.False(t, data.Released)
+			},
+		},
+		{
+			name:  "void last item",
+			order: newOrder(dto.OrderStatusOPEN, first),
+			change: func(s OrderService) (*dto.Order, error) {
+				return s.VoidItem(t.Context(), adminActor, orderID, first.ID, "mistake")
+			},
+			expectedError: apperr.ErrConflict,
+		},
+		{
+			name:  "void refunded item",
+			order: newOrder(dto.OrderStatusOPEN, refunded, second),
+			change: func(s OrderService) (*dto.Order, error) {
+				return s.VoidItem(t.Context(), adminActor, orderID, refunded.ID, "mistake")
+			},
+			expectedError: apperr.ErrConflict,
+		},
+		{
+			name: "total below amount paid",
+			order: func() *dto.Order {
+				ord := newOrder(dto.OrderStatusOPEN, first, second)
+				ord.AmountPaid = money.New(1_300, "USD")
+				return ord
+			}(),
+			change: func(s OrderService) (*dto.Order, error) {
+				return s.VoidItem(t.Context(), adminActor, orderID, second.ID, "mistake")
+			},
+			expectedError: apperr.ErrConflict,
+		},
+		{
+			name: "reprice at the rate the order was placed at",
+			order: func() *dto.Order {
+				ord := newOrder(dto.OrderStatusOPEN, first, second)
+				ord.TaxRateBps = intPtr(1_000)
+				return ord
+			}(),
+			change: func(s OrderService) (*dto.Order, error) {
+				return s.VoidItem(t.Context(), adminActor, orderID, second.ID, "mistake")
+			},
+			expectedData: func(t *testing.T, data *repos.ChangeOrderItemsData) {
+				assert.Equal(t, int64(100), data.TaxTotal)
+				assert.Equal(t, int64(1_100), data.Total)
+			},
+		},
+		{
+			name: "delivery subtotal below the zone minimum",
+			order: func() *dto.Order {
+				ord := newOrder(dto.OrderStatusOPEN, first, second)
+				ord.OrderType = dto.OrderTypeDELIVERY
+				ord.Delivery = &dto.DeliveryDetails{MinOrderValue: money.New(1_200, "USD")}
+				return ord
+			}(),
+			change: func(s OrderService) (*dto.Order, error) {
+				return s.VoidItem(t.Context(), adminActor, orderID, second.ID, "mistake")
+			},
+			expectedError: apperr.ErrInvalid,
+		},
+		{
+			name: "delivery subtotal at the zone minimum",
+			order: func() *dto.Order {
+				ord := newOrder(dto.OrderStatusOPEN, first, second)
+				ord.OrderType = dto.OrderTypeDELIVERY
+				ord.Delivery = &dto.DeliveryDetails{MinOrderValue: money.New(1_000, "USD")}
+				return ord
+			}(),
+			change: func(s OrderService) (*dto.Order, error) {
+				return s.VoidItem(t.Context(), adminActor, orderID, second.ID, "mistake")
+			},
+			expectedData: func(t *testing.T, data *repos.ChangeOrderItemsData) {
+				assert.Equal(t, int64(1_000), data.Subtotal)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			orderRepo := new(MockOrderRepository)
+			orderRepo.On("GetAuthorizationResource", mock.Anything, orderID).
+				Return(authz.Resource{Type: "order", ID: orderID, RestaurantID: restaurantID}, nil)
+			orderRepo.On("GetByID", mock.Anything, restaurantID, orderID).Return(tc.order, nil)
+			restaurantRepo := new(MockRestaurantRepository)
+			restaurantRepo.On("GetByID", mock.Anything, restaurantID).Return(&dto.RestaurantResponse{ID: restaurantID}, nil)
+
+			var saved *repos.ChangeOrderItemsData
+			orderRepo.On("ChangeItems", mock.Anything, mock.Anything).
+				Run(func(args mock.Arguments) { saved = args.Get(1).(*repos.ChangeOrderItemsData) }).
+				Return(tc.order, nil)
+
+			svc := &orderService{OrderRepo: orderRepo, RestaurantRepo: restaurantRepo, authorizer: authz.NewPolicyAuthorizer()}
+			_, err := tc.change(svc)
+
+			if tc.expectedError != nil {
+				assert.ErrorIs(t, err, tc.expectedError)
+				orderRepo.AssertNotCalled(t, "ChangeItems", mock.Anything, mock.Anything)
+				return
+			}
+			require.NoError(t, err)
+			require.NotNil(t, saved)
+			tc.expectedData(t, saved)
+		})
+	}
+}
+
+func TestOrderService_Update_OrderType(t *testing.T) {
+	orderID := uuid.New()
+	restaurantID := uuid.New()
+	tableID := uuid.New()
+
+	testCases := []struct {
+		name          string
+		order         dto.Order
+		req           dto.UpdateOrderRequest
+		expectedError error
+	}{
+		{
+			name:          "takeout to delivery",
+			order:         dto.Order{OrderType: dto.OrderTypeTAKEOUT},
+			req:           dto.UpdateOrderRequest{OrderType: ptr(string(dto.OrderTypeDELIVERY))},
+			expectedError: apperr.ErrConflict,
+		},
+		{
+			name: "delivery to takeout",
+			order: dto.Order{
+				OrderType:   dto.OrderTypeDELIVERY,
+				DeliveryFee: money.New(300, "USD"),
+				Delivery:    &dto.DeliveryDetails{},
+			},
+			req:           dto.UpdateOrderRequest{OrderType: ptr(string(dto.OrderTypeTAKEOUT))},
+			expectedError: apperr.ErrConflict,
+		},
+		{
+			name:          "table order leaving dine-in",
+			order:         dto.Order{OrderType: dto.OrderTypeDINE_IN, TableID: &tableID},
+			req:           dto.UpdateOrderRequest{OrderType: ptr(string(dto.OrderTypeTAKEOUT))},
+			expectedError: apperr.ErrConflict,
+		},
+		{
+			// Its items were taken from menus served for dine-in.
+			name:          "dine-in to takeout",
+			order:         dto.Order{OrderType: dto.OrderTypeDINE_IN},
+			req:           dto.UpdateOrderRequest{OrderType: ptr(string(dto.OrderTypeTAKEOUT))},
+			expectedError: apperr.ErrConflict,
+		},
+		{
+			name:  "same type",
+			order: dto.Order{OrderType: dto.OrderTypeDELIVERY, OrderStatus: dto.OrderStatusOPEN},
+			req: dto.UpdateOrderRequest{
+				OrderType:   ptr(string(dto.OrderTypeDELIVERY)),
+				OrderStatus: ptr(string(dto.OrderStatusCONFIRMED)),
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			current := tc.order
+			current.ID = orderID
+			current.RestaurantID = restaurantID
+			orderRepo := new(MockOrderRepository)
+			orderRepo.On("GetAuthorizationResource", mock.Anything, orderID).
+				Return(authz.Resource{Type: "order", ID: orderID, RestaurantID: restaurantID}, nil)
+			orderRepo.On("GetByID", mock.Anything, restaurantID, orderID).Return(&current, nil)
+			orderRepo.On("Update", mock.Anything, restaurantID, mock.Anything).Return(&current, nil)
+
+			svc := &orderService{OrderRepo: orderRepo, authorizer: authz.NewPolicyAuthorizer()}
+			_, err := svc.Update(t.Context(), adminActor, orderID, &tc.req)
+
+			if tc.expectedError != nil {
+				assert.ErrorIs(t, err, tc.expectedError)
+				orderRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything, mock.Anything)
+				return
+			}
+			require.NoError(t, err)
+			orderRepo.AssertCalled(t, "Update", mock.Anything, restaurantID, mock.Anything)
+		})
+	}
+}
+
+func TestOrderService_ValidateOrderItems_Variants(t *testing.T) {
+	restaurantID := uuid.New()
+	small := dto.MenuItemVariant{ID: uuid.New(), Name: "Small", IsAvailable: true}
+	large := dto.MenuItemVariant{ID: uuid.New(), Name: "Large"}
+	menuItems := map[int64]*dto.MenuItem{
+		1: {ID: 1, RestaurantID: restaurantID, IsAvailable: true, Modifiers: []dto.Modifier{}, Variants: []dto.MenuItemVariant{small, large}},
+		2: {ID: 2, RestaurantID: restaurantID, IsAvailable: true, Modifiers: []dto.Modifier{}},
+	}
+	unknown := uuid.New()
+
+	testCases := []struct {
+		name          string
+		item          OrderItemInput
+		expectedError error
+	}{
+		{name: "variant chosen", item: OrderItemInput{MenuItemID: 1, VariantID: &small.ID, Quantity: 1}},
+		{name: "no variant chosen", item: OrderItemInput{MenuItemID: 1, Quantity: 1}, expectedError: apperr.ErrInvalid},
+		{name: "another item's variant", item: OrderItemInput{MenuItemID: 1, VariantID: &unknown, Quantity: 1}, expectedError: apperr.ErrInvalid},
+		{name: "unavailable variant", item: OrderItemInput{MenuItemID: 1, VariantID: &large.ID, Quantity: 1}, expectedError: apperr.ErrInvalid},
+		{name: "item without variants", item: OrderItemInput{MenuItemID: 2, Quantity: 1}},
+		{name: "variant of item without variants", item: OrderItemInput{MenuItemID: 2, VariantID: &small.ID, Quantity: 1}, expectedError: apperr.ErrInvalid},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			svc := &orderService{}
+			err := svc.validateOrderItems([]OrderItemInput{tc.item}, restaurantID, menuItems, nil)
+
+			if tc.expectedError != nil {
+				assert.ErrorIs(t, err, tc.expectedError)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestOptionPrice(t *testing.T) {
+	option := &dto.ModifierOption{
+		ID:            uuid.New(),
+		Price:         money.New(100, "USD"),
+		VariantPrices: map[string]money.Money{"Large": money.New(150, "USD")},
+	}
+
+	assert.Equal(t, int64(100), optionPrice(option, nil, nil))
+	assert.Equal(t, int64(150), optionPrice(option, &dto.MenuItemVariant{Name: "Large"}, nil))
+	assert.Equal(t, int64(100), optionPrice(option, &dto.MenuItemVariant{Name: "Small"}, nil))
+
+	itemPrices := map[uuid.UUID]money.Money{option.ID: money.New(80, "USD")}
+	assert.Equal(t, int64(80), optionPrice(option, nil, itemPrices))
+	assert.Equal(t, int64(150), optionPrice(option, &dto.MenuItemVariant{Name: "Large"}, itemPrices))
+	assert.Equal(t, int64(80), optionPrice(option, &dto.MenuItemVariant{Name: "Small"}, itemPrices))
+}
+
+func TestOrderService_ValidateOrderItems_Nested(t *testing.T) {
+	restaurantID := uuid.New()
+	side := dto.Modifier{ID: uuid.New(), Required: true, Max: 1}
+	size := dto.Modifier{ID: uuid.New(), Required: true, Max: 1}
+	sauce := dto.Modifier{ID: uuid.New(), Max: 2}
+	fries := &dto.ModifierOption{ID: uuid.New(), ModifierID: side.ID, Available: true, ChildModifiers: []dto.Modifier{size, sauce}}
+	salad := &dto.ModifierOption{ID: uuid.New(), ModifierID: side.ID, Available: true}
+	large := &dto.ModifierOption{ID: uuid.New(), ModifierID: size.ID, Available: true}
+	ketchup := &dto.ModifierOption{ID: uuid.New(), ModifierID: sauce.ID, Available: true}
+	options := map[uuid.UUID]*dto.ModifierOption{fries.ID: fries, salad.ID: salad, large.ID: large, ketchup.ID: ketchup}
+	menuItems := map[int64]*dto.MenuItem{
+		1: {ID: 1, RestaurantID: restaurantID, IsAvailable: true, Modifiers: []dto.Modifier{side}},
+	}
+	choose := func(option *dto.ModifierOption, quantity int, within ...ModifierOptionInput) ModifierOptionInput {
+		return ModifierOptionInput{ModifierOptionID: option.ID, Quantity: quantity, ModifierOptions: within}
+	}
+
+	testCases := []struct {
+		name          string
+		modifiers     []ModifierOptionInput
+		expectedError error
+	}{
+		{name: "option without children", modifiers: []ModifierOptionInput{choose(salad, 1)}},
+		{name: "required child group chosen", modifiers: []ModifierOptionInput{choose(fries, 1, choose(large, 1), choose(ketchup, 2))}},
+		{name: "required child group missing", modifiers: []ModifierOptionInput{choose(fries, 1, choose(ketchup, 1))}, expectedError: apperr.ErrInvalid},
+		{name: "child group over max", modifiers: []ModifierOptionInput{choose(fries, 1, choose(large, 1), choose(ketchup, 3))}, expectedError: apperr.ErrInvalid},
+		{name: "child of another option", modifiers: []ModifierOptionInput{choose(salad, 1, choose(large, 1))}, expectedError: apperr.ErrInvalid},
+		{name: "child group chosen at the top", modifiers: []ModifierOptionInput{choose(salad, 1), choose(large, 1)}, expectedError: apperr.ErrInvalid},
+		{name: "option listed twice", modifiers: []ModifierOptionInput{choose(fries, 1, choose(large, 1), choose(large, 1))}, expectedError: apperr.ErrInvalid},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			svc := &orderService{}
+			items := []OrderItemInput{{MenuItemID: 1, Quantity: 1, ModifierOptions: tc.modifiers}}
+			err := svc.validateOrderItems(items, restaurantID, menuItems, options)
+
+			if tc.expectedError != nil {
+				assert.ErrorIs(t, err, tc.expectedError)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestPriceOrderItems_Nested(t *testing.T) {
+	items := []repos.OrderItemData{{
+		Quantity:  2,
+		ItemPrice: 1000,
+		ModifierOptions: []repos.ModifierItemData{{
+			OptionPrice: 300,
+			Quantity:    2,
+			ModifierOptions: []repos.ModifierItemData{
+				{OptionPrice: 50, Quantity: 1},
+				{OptionPrice: 0, Quantity: 1},
+			},
+		}},
+	}}
+
+	totals := priceOrderItems(items, 0)
+
+	// Each unit has two sides at 300, each with a 50 upgrade: 700 per
+	// unit, 1400 for both.
+	assert.Equal(t, int64(1400), items[0].ModifiersTotal)
+	assert.Equal(t, int64(3400), items[0].LineTotal)
+	assert.Equal(t, int64(3400), totals.Total)
+}
+
+func TestOrderService_ValidateOrderItems_SelectionRules(t *testing.T) {
+	restaurantID := uuid.New()
+	toppings := dto.Modifier{ID: uuid.New(), Name: "Toppings", MultiSelect: true, Min: 2, Max: 4}
+	sauce := dto.Modifier{ID: uuid.New(), Name: "Sauce", Max: 2}
+	cheese := &dto.ModifierOption{ID: uuid.New(), ModifierID: toppings.ID, Available: true, MaxQuantity: 2}
+	olives := &dto.ModifierOption{ID: uuid.New(), ModifierID: toppings.ID, Available: true}
+	ketchup := &dto.ModifierOption{ID: uuid.New(), ModifierID: sauce.ID, Available: true}
+	mayo := &dto.ModifierOption{ID: uuid.New(), ModifierID: sauce.ID, Available: true}
+	options := map[uuid.UUID]*dto.ModifierOption{cheese.ID: cheese, olives.ID: olives, ketchup.ID: ketchup, mayo.ID: mayo}
+	menuItems := map[int64]*dto.MenuItem{
+		1: {ID: 1, RestaurantID: restaurantID, IsAvailable: true, Modifiers: []dto.Modifier{toppings, sauce}},
+	}
+	choose := func(option *dto.ModifierOption, quantity int) ModifierOptionInput {
+		return ModifierOptionInput{ModifierOptionID: option.ID, Quantity: quantity}
+	}
+
+	testCases := []struct {
+		name          string
+		modifiers     []ModifierOptionInput
+		expectedError string
+	}{
+		{name: "min met across options", modifiers: []ModifierOptionInput{choose(cheese, 1), choose(olives, 1)}},
+		{name: "min met by quantity", modifiers: []ModifierOptionInput{choose(olives, 2)}},
+		{name: "below min", modifiers: []ModifierOptionInput{choose(olives, 1)}, expectedError: "needs at least 2"},
+		{name: "nothing selected below min", modifiers: nil, expectedError: "needs at least 2"},
+		{name: "over max", modifiers: []ModifierOptionInput{choose(cheese, 2), choose(olives, 3)}, expectedError: "allows at most 4"},
+		{name: "over option max_quantity", modifiers: []ModifierOptionInput{choose(cheese, 3)}, expectedError: "at most 2 times"},
+		{name: "single select takes one option twice", modifiers: []ModifierOptionInput{choose(olives, 2), choose(ketchup, 2)}},
+		{name: "single select with two options", modifiers: []ModifierOptionInput{choose(olives, 2), choose(ketchup, 1), choose(mayo, 1)}, expectedError: "takes a single option"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			svc := &orderService{}
+			items := []OrderItemInput{{MenuItemID: 1, Quantity: 1, ModifierOptions: tc.modifiers}}
+			err := svc.validateOrderItems(items, restaurantID, menuItems, options)
+
+			if tc.expectedError != "" {
+				assert.ErrorIs(t, err, apperr.ErrInvalid)
+				assert.ErrorContains(t, err, tc.expectedError)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestDefaultSelections(t *testing.T) {
+	bread := dto.Modifier{ID: uuid.New()}
+	extras := dto.Modifier{ID: uuid.New()}
+	toast := dto.Modifier{ID: uuid.New()}
+	white := &dto.ModifierOption{ID: uuid.New(), ModifierID: bread.ID, PreSelect: true, ChildModifiers: []dto.Modifier{toast}}
+	rye := &dto.ModifierOption{ID: uuid.New(), ModifierID: bread.ID}
+	lettuce := &dto.ModifierOption{ID: uuid.New(), ModifierID: extras.ID, PreSelect: true}
+	tomato := &dto.ModifierOption{ID: uuid.New(), ModifierID: extras.ID, PreSelect: true, DisplayOrder: 1}
+	toasted := &dto.ModifierOption{ID: uuid.New(), ModifierID: toast.ID, PreSelect: true}
+	options := map[uuid.UUID]*dto.ModifierOption{white.ID: white, rye.ID: rye, lettuce.ID: lettuce, tomato.ID: tomato, toasted.ID: toasted}
+	preSelected := map[uuid.UUID][]*dto.ModifierOption{
+		bread.ID:  {white},
+		extras.ID: {lettuce, tomato},
+		toast.ID:  {toasted},
+	}
+	groups := []dto.Modifier{bread, extras}
+	choose := func(option *dto.ModifierOption, quantity int, within ...ModifierOptionInput) ModifierOptionInput {
+		return ModifierOptionInput{ModifierOptionID: option.ID, Quantity: quantity, ModifierOptions: within}
+	}
+
+	testCases := []struct {
+		name      string
+		modifiers []ModifierOptionInput
+		expected  []ModifierOptionInput
+	}{
+		{
+			name:     "nothing selected",
+			expected: []ModifierOptionInput{choose(white, 1, choose(toasted, 1)), choose(lettuce, 1), choose(tomato, 1)},
+		},
+		{
+			name:      "group chosen from",
+			modifiers: []ModifierOptionInput{choose(rye, 1)},
+			expected:  []ModifierOptionInput{choose(rye, 1), choose(lettuce, 1), choose(tomato, 1)},
+		},
+		{
+			name:      "default deselected",
+			modifiers: []ModifierOptionInput{choose(tomato, 0)},
+			expected:  []ModifierOptionInput{choose(white, 1, choose(toasted, 1))},
+		},
+		{
+			name:      "default chosen with its own children",
+			modifiers: []ModifierOptionInput{choose(white, 2, choose(toasted, 0))},
+			expected:  []ModifierOptionInput{choose(white, 2), choose(lettuce, 1), choose(tomato, 1)},
+		},
+		{
+			name:      "quantity 0 of an option that is not a default",
+			modifiers: []ModifierOptionInput{choose(rye, 0), choose(lettuce, 1)},
+			expected:  []ModifierOptionInput{choose(rye, 0), choose(lettuce, 1)},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, defaultSelections(tc.modifiers, groups, options, preSelected))
+		})
+	}
+}
+
+func TestModifierItems_FreeQuantity(t *testing.T) {
+	toppings := dto.Modifier{ID: uuid.New(), FreeQuantity: 3}
+	cheese := &dto.ModifierOption{ID: uuid.New(), ModifierID: toppings.ID, Price: money.New(150, "USD")}
+	olives := &dto.ModifierOption{ID: uuid.New(), ModifierID: toppings.ID, Price: money.New(100, "USD")}
+	options := map[uuid.UUID]*dto.ModifierOption{cheese.ID: cheese, olives.ID: olives}
+
+	items := modifierItems([]ModifierOptionInput{
+		{ModifierOptionID: cheese.ID, Quantity: 2},
+		{ModifierOptionID: olives.ID, Quantity: 2},
+	}, []dto.Modifier{toppings}, options, nil)
+
+	// The two olives are cheaper, so they and one cheese are free.
+	assert.Equal(t, 1, items[0].FreeQuantity)
+	assert.Equal(t, 2, items[1].FreeQuantity)
+
+	totals := priceOrderItems([]repos.OrderItemData{{Quantity: 1, ItemPrice: 1000, ModifierOptions: items}}, 0)
+	assert.Equal(t, int64(1150), totals.Total)
+}
@@ -0,0 +1,140 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/Jiruu246/rms/internal/apperr"
+	"github.com/Jiruu246/rms/internal/authz"
+	"github.com/Jiruu246/rms/internal/dto"
+	"github.com/Jiruu246/rms/internal/payments"
+	"github.com/Jiruu246/rms/internal/repos"
+	"github.com/Jiruu246/rms/pkg/money"
+	"github.com/google/uuid"
+)
+
+const (
+	ActionCreatePayment authz.Action = "payment:create"
+	ActionReadPayment   authz.Action = "payment:read"
+)
+
+type PaymentService interface {
+	Capture(ctx context.Context, actor authz.Actor, orderID uuid.UUID, req *dto.CreatePaymentRequest) (*dto.Payment, error)
+	ListByOrder(ctx context.Context, actor authz.Actor, orderID uuid.UUID) ([]*dto.Payment, error)
+}
+
+type paymentService struct {
+	repo       repos.PaymentRepository
+	orderRepo  repos.OrderRepository
+	providers  payments.Providers
+	authorizer authz.Authorizer
+}
+
+func NewPaymentService(repo repos.PaymentRepository, orderRepo repos.OrderRepository, providers payments.Providers) PaymentService {
+	return &paymentService{
+		repo:       repo,
+		orderRepo:  orderRepo,
+		providers:  providers,
+		authorizer: authz.NewPolicyAuthorizer(),
+	}
+}
+
+// Capture takes one tender against an order. Several captures with
+// different methods make up a split tender; each may cover at most the
+// outstanding balance. The amount is reserved on the order before the
+// provider is called, so concurrent captures can't overpay it.
+//
+// A declined charge is not an error: the returned payment has status FAILED
+// and a failure_reason.
+func (s *paymentService) Capture(ctx context.Context, actor authz.Actor, orderID uuid.UUID, req *dto.CreatePaymentRequest) (*dto.Payment, error) {
+	resource, err := s.authorize(ctx, actor, ActionCreatePayment, orderID)
+	if err != nil {
+		return nil, err
+	}
+
+	provider, ok := s.providers[payments.Method(req.Method)]
+	if !ok {
+		return nil, apperr.Invalid("payment method %s is not supported", req.Method)
+	}
+	if provider.RequiresToken() && req.CardToken == "" {
+		return nil, apperr.Invalid("card_token is required for %s payments", req.Method)
+	}
+
+	ord, err := s.orderRepo.GetByID(ctx, resource.RestaurantID, orderID)
+	if err != nil {
+		return nil, err
+	}
+	if ord.OrderStatus == dto.OrderStatusCANCELLED {
+		return nil, apperr.Conflict("cannot take payment for a cancelled order")
+	}
+	if ord.PaymentStatus == dto.PaymentStatusPAID || ord.PaymentStatus == dto.PaymentStatusREFUNDED {
+		return nil, apperr.Conflict("order is already %s", ord.PaymentStatus)
+	}
+	outstanding := ord.Total.Amount - ord.AmountPaid.Amount
+	if req.Amount > outstanding {
+		return nil, apperr.Invalid("amount %s exceeds the outstanding balance of %s",
+			money.New(req.Amount, ord.Currency), money.New(outstanding, ord.Currency))
+	}
+
+	pending, err := s.repo.Begin(ctx, &repos.BeginPaymentData{
+		OrderID:            orderID,
+		RestaurantID:       resource.RestaurantID,
+		ExpectedAmountPaid: ord.AmountPaid.Amount,
+		Method:             req.Method,
+		Provider:           provider.Name(),
+		Amount:             req.Amount,
+		Currency:           ord.Currency,
+		CreatedBy:          actor.UserID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result, chargeErr := provider.Charge(ctx, payments.ChargeRequest{
+		PaymentID: pending.ID,
+		OrderID:   orderID,
+		Amount:    pending.Amount,
+		Token:     req.CardToken,
+	})
+
+	// The charge has happened (or definitively not): record the outcome
+	// even if the client has gone away in the meantime.
+	settleCtx := context.WithoutCancel(ctx)
+	switch {
+	case chargeErr != nil:
+		_, err := s.repo.MarkFailed(settleCtx, resource.RestaurantID, pending.ID, "payment provider error")
+		return nil, errors.Join(fmt.Errorf("payment provider %s: %w", provider.Name(), chargeErr), err)
+	case result.Declined:
+		return s.repo.MarkFailed(settleCtx, resource.RestaurantID, pending.ID, result.DeclineReason)
+	default:
+		return s.repo.MarkSucceeded(settleCtx, resource.RestaurantID, pending.ID, result.Reference)
+	}
+}
+
+func (s *paymentService) ListByOrder(ctx context.Context, actor authz.Actor, orderID uuid.UUID) ([]*dto.Payment, error) {
+	resource, err := s.authorize(ctx, actor, ActionReadPayment, orderID)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.repo.ListByOrder(ctx, resource.RestaurantID, orderID)
+}
+
+// authorize resolves the order's authz.Resource — payments are governed by
+// the order they belong to — and checks the actor may perform action on it.
+func (s *paymentService) authorize(ctx context.Context, actor authz.Actor, action authz.Action, orderID uuid.UUID) (authz.Resource, error) {
+	resource, err := s.orderRepo.GetAuthorizationResource(ctx, orderID)
+	if err != nil {
+		return authz.Resource{}, err
+	}
+
+	if _, err := s.authorizer.Authorize(ctx, authz.Request{
+		Actor:    actor,
+		Action:   action,
+		Resource: resource,
+	}); err != nil {
+		return authz.Resource{}, err
+	}
+	return resource, nil
+}
@@ -0,0 +1,331 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/Jiruu246/rms/internal/apperr"
+	"github.com/Jiruu246/rms/internal/authz"
+	"github.com/Jiruu246/rms/internal/dto"
+	"github.com/Jiruu246/rms/internal/payments"
+	"github.com/Jiruu246/rms/internal/repos"
+	"github.com/Jiruu246/rms/pkg/money"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// MockOrderRepository is a mock implementation of OrderRepository
+type MockOrderRepository struct {
+	mock.Mock
+}
+
+func (m *MockOrderRepository) Create(ctx context.Context, data *repos.CreateOrderData) (*dto.Order, error) {
+	args := m.Called(ctx, data)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*dto.Order), args.Error(1)
+}
+
+func (m *MockOrderRepository) GetByID(ctx context.Context, restaurantID, id uuid.UUID, opts ...repos.OrderQueryOptions) (*dto.Order, error) {
+	args := m.Called(ctx, restaurantID, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*dto.Order), args.Error(1)
+}
+
+func (m *MockOrderRepository) Update(ctx context.Context, restaurantID uuid.UUID, data *dto.UpdateOrderData) (*dto.Order, error) {
+	args := m.Called(ctx, restaurantID, data)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*dto.Order), args.Error(1)
+}
+
+func (m *MockOrderRepository) Delete(ctx context.Context, restaurantID, id uuid.UUID) error {
+	args := m.Called(ctx, restaurantID, id)
+	return args.Error(0)
+}
+
+func (m *MockOrderRepository) GetAllByRestaurant(ctx context.Context, restaurantID uuid.UUID, filters dto.OrderListFilters) ([]*dto.Order, error) {
+	args := m.Called(ctx, restaurantID, filters)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*dto.Order), args.Error(1)
+}
+
+func (m *MockOrderRepository) GetStatusHistory(ctx context.Context, restaurantID, id uuid.UUID) ([]*dto.OrderStatusEvent, error) {
+	args := m.Called(ctx, restaurantID, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*dto.OrderStatusEvent), args.Error(1)
+}
+
+func (m *MockOrderRepository) ChangeItems(ctx context.Context, data *repos.ChangeOrderItemsData) (*dto.Order, error) {
+	args := m.Called(ctx, data)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*dto.Order), args.Error(1)
+}
+
+func (m *MockOrderRepository) GetItemChanges(ctx context.Context, restaurantID, id uuid.UUID) ([]*dto.OrderItemChange, error) {
+	args := m.Called(ctx, restaurantID, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*dto.OrderItemChange), args.Error(1)
+}
+
+func (m *MockOrderRepository) GetAuthorizationResource(ctx context.Context, id uuid.UUID) (authz.Resource, error) {
+	args := m.Called(ctx, id)
+	return args.Get(0).(authz.Resource), args.Error(1)
+}
+
+func (m *MockOrderRepository) GetScheduledTimes(ctx context.Context, restaurantID uuid.UUID, from, to time.Time) ([]time.Time, error) {
+	args := m.Called(ctx, restaurantID, from, to)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]time.Time), args.Error(1)
+}
+
+func (m *MockOrderRepository) ReleaseDue(ctx context.Context, now time.Time) (int, error) {
+	args := m.Called(ctx, now)
+	return args.Int(0), args.Error(1)
+}
+
+// MockPaymentRepository is a mock implementation of PaymentRepository
+type MockPaymentRepository struct {
+	mock.Mock
+}
+
+func (m *MockPaymentRepository) Begin(ctx context.Context, data *repos.BeginPaymentData) (*dto.Payment, error) {
+	args := m.Called(ctx, data)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*dto.Payment), args.Error(1)
+}
+
+func (m *MockPaymentRepository) MarkSucceeded(ctx context.Context, restaurantID, id uuid.UUID, reference string) (*dto.Payment, error) {
+	args := m.Called(ctx, restaurantID, id, reference)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*dto.Payment), args.Error(1)
+}
+
+func (m *MockPaymentRepository) MarkFailed(ctx context.Context, restaurantID, id uuid.UUID, reason string) (*dto.Payment, error) {
+	args := m.Called(ctx, restaurantID, id, reason)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*dto.Payment), args.Error(1)
+}
+
+func (m *MockPaymentRepository) ListByOrder(ctx context.Context, restaurantID, orderID uuid.UUID) ([]*dto.Payment, error) {
+	args := m.Called(ctx, restaurantID, orderID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*dto.Payment), args.Error(1)
+}
+
+func TestPaymentService_Capture(t *testing.T) {
+	restaurantID := uuid.New()
+	orderID := uuid.New()
+	paymentID := uuid.New()
+	resource := authz.Resource{Type: "order", ID: orderID, RestaurantID: restaurantID}
+
+	openOrder := func(total, paid int64) *dto.Order {
+		status := dto.PaymentStatusUNPAID
+		if paid > 0 {
+			status = dto.PaymentStatusPENDING
+		}
+		return &dto.Order{
+			ID:            orderID,
+			OrderStatus:   dto.OrderStatusOPEN,
+			PaymentStatus: status,
+			Currency:      "USD",
+			Total:         money.New(total, "USD"),
+			AmountPaid:    money.New(paid, "USD"),
+		}
+	}
+	pending := func(amount int64) *dto.Payment {
+		return &dto.Payment{ID: paymentID, OrderID: orderID, Amount: money.New(amount, "USD"), Status: dto.PaymentStatePENDING}
+	}
+
+	testCases := []struct {
+		name        string
+		order       *dto.Order
+		req         *dto.CreatePaymentRequest
+		setupMock   func(*MockPaymentRepository)
+		expectedErr error
+		expected    dto.PaymentState
+	}{
+		{
+			name:  "cash for part of the balance",
+			order: openOrder(3000, 0),
+			req:   &dto.CreatePaymentRequest{Method: dto.PaymentMethodCASH, Amount: 1000},
+			setupMock: func(repo *MockPaymentRepository) {
+				repo.On("Begin", mock.Anything, mock.MatchedBy(func(d *repos.BeginPaymentData) bool {
+					return d.Provider == "cash" && d.Amount == 1000 && d.ExpectedAmountPaid == 0 && d.RestaurantID == restaurantID
+				})).Return(pending(1000), nil)
+				repo.On("MarkSucceeded", mock.Anything, restaurantID, paymentID, "cash_"+paymentID.String()).
+					Return(&dto.Payment{ID: paymentID, Status: dto.PaymentStateSUCCEEDED}, nil)
+			},
+			expected: dto.PaymentStateSUCCEEDED,
+		},
+		{
+			name:  "card for the remainder of a split bill",
+			order: openOrder(3000, 1000),
+			req:   &dto.CreatePaymentRequest{Method: dto.PaymentMethodCARD, Amount: 2000, CardToken: "tok_visa"},
+			setupMock: func(repo *MockPaymentRepository) {
+				repo.On("Begin", mock.Anything, mock.MatchedBy(func(d *repos.BeginPaymentData) bool {
+					return d.Provider == "fake_card" && d.Amount == 2000 && d.ExpectedAmountPaid == 1000
+				})).Return(pending(2000), nil)
+				repo.On("MarkSucceeded", mock.Anything, restaurantID, paymentID, "fake_ch_"+paymentID.String()).
+					Return(&dto.Payment{ID: paymentID, Status: dto.PaymentStateSUCCEEDED}, nil)
+			},
+			expected: dto.PaymentStateSUCCEEDED,
+		},
+		{
+			name:  "declined card is recorded as failed",
+			order: openOrder(3000, 0),
+			req:   &dto.CreatePaymentRequest{Method: dto.PaymentMethodCARD, Amount: 3000, CardToken: payments.FakeCardTokenDeclined},
+			setupMock: func(repo *MockPaymentRepository) {
+				repo.On("Begin", mock.Anything, mock.Anything).Return(pending(3000), nil)
+				repo.On("MarkFailed", mock.Anything, restaurantID, paymentID, "card declined").
+					Return(&dto.Payment{ID: paymentID, Status: dto.PaymentStateFAILED, FailureReason: "card declined"}, nil)
+			},
+			expected: dto.PaymentStateFAILED,
+		},
+		{
+			name:        "card without token",
+			order:       openOrder(3000, 0),
+			req:         &dto.CreatePaymentRequest{Method: dto.PaymentMethodCARD, Amount: 3000},
+			setupMock:   func(repo *MockPaymentRepository) {},
+			expectedErr: apperr.ErrInvalid,
+		},
+		{
+			name:        "amount exceeds outstanding balance",
+			order:       openOrder(3000, 2500),
+			req:         &dto.CreatePaymentRequest{Method: dto.PaymentMethodCASH, Amount: 1000},
+			setupMock:   func(repo *MockPaymentRepository) {},
+			expectedErr: apperr.ErrInvalid,
+		},
+		{
+			name: "cancelled order",
+			order: func() *dto.Order {
+				o := openOrder(3000, 0)
+				o.OrderStatus = dto.OrderStatusCANCELLED
+				return o
+			}(),
+			req:         &dto.CreatePaymentRequest{Method: dto.PaymentMethodCASH, Amount: 1000},
+			setupMock:   func(repo *MockPaymentRepository) {},
+			expectedErr: apperr.ErrConflict,
+		},
+		{
+			name: "already paid",
+			order: func() *dto.Order {
+				o := openOrder(3000, 3000)
+				o.PaymentStatus = dto.PaymentStatusPAID
+				return o
+			}(),
+			req:         &dto.CreatePaymentRequest{Method: dto.PaymentMethodCASH, Amount: 1},
+			setupMock:   func(repo *MockPaymentRepository) {},
+			expectedErr: apperr.ErrConflict,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			paymentRepo := new(MockPaymentRepository)
+			orderRepo := new(MockOrderRepository)
+			orderRepo.On("GetAuthorizationResource", mock.Anything, orderID).Return(resource, nil)
+			orderRepo.On("GetByID", mock.Anything, restaurantID, orderID).Return(tc.order, nil)
+			tc.setupMock(paymentRepo)
+
+			service := NewPaymentService(paymentRepo, orderRepo, payments.NewDefaultProviders(true))
+			result, err := service.Capture(context.Background(), adminActor, orderID, tc.req)
+
+			if tc.expectedErr != nil {
+				assert.True(t, errors.Is(err, tc.expectedErr), "expected %v, got %v", tc.expectedErr, err)
+				assert.Nil(t, result)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tc.expected, result.Status)
+			}
+			paymentRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestPaymentService_Capture_ProviderError(t *testing.T) {
+	restaurantID := uuid.New()
+	orderID := uuid.New()
+	paymentID := uuid.New()
+
+	paymentRepo := new(MockPaymentRepository)
+	orderRepo := new(MockOrderRepository)
+	orderRepo.On("GetAuthorizationResource", mock.Anything, orderID).
+		Return(authz.Resource{ID: orderID, RestaurantID: restaurantID}, nil)
+	orderRepo.On("GetByID", mock.Anything, restaurantID, orderID).Return(&dto.Order{
+		ID: orderID, OrderStatus: dto.OrderStatusOPEN, PaymentStatus: dto.PaymentStatusUNPAID,
+		Currency: "USD", Total: money.New(500, "USD"), AmountPaid: money.New(0, "USD"),
+	}, nil)
+	paymentRepo.On("Begin", mock.Anything, mock.Anything).
+		Return(&dto.Payment{ID: paymentID, Amount: money.New(500, "USD")}, nil)
+	paymentRepo.On("MarkFailed", mock.Anything, restaurantID, paymentID, "payment provider error").
+		Return(&dto.Payment{ID: paymentID, Status: dto.PaymentStateFAILED}, nil)
+
+	service := NewPaymentService(paymentRepo, orderRepo, payments.NewDefaultProviders(true))
+	_, err := service.Capture(context.Background(), adminActor, orderID, &dto.CreatePaymentRequest{
+		Method: dto.PaymentMethodCARD, Amount: 500, CardToken: payments.FakeCardTokenProviderError,
+	})
+
+	assert.ErrorIs(t, err, payments.ErrFakeCardUnavailable)
+	paymentRepo.AssertExpectations(t)
+}
+
+func TestPaymentService_Capture_Forbidden(t *testing.T) {
+	orderID := uuid.New()
+
+	paymentRepo := new(MockPaymentRepository)
+	orderRepo := new(MockOrderRepository)
+	orderRepo.On("GetAuthorizationResource", mock.Anything, orderID).
+		Return(authz.Resource{ID: orderID, RestaurantID: uuid.New(), OwnerUserID: uuid.New()}, nil)
+
+	service := NewPaymentService(paymentRepo, orderRepo, payments.NewDefaultProviders(true))
+	_, err := service.Capture(context.Background(), authz.Actor{UserID: uuid.New()}, orderID, &dto.CreatePaymentRequest{
+		Method: dto.PaymentMethodCASH, Amount: 100,
+	})
+
+	assert.ErrorIs(t, err, apperr.ErrForbidden)
+	orderRepo.AssertNotCalled(t, "GetByID", mock.Anything, mock.Anything)
+}
+
+func TestPaymentService_Capture_NoCardProvider(t *testing.T) {
+	orderID := uuid.New()
+
+	paymentRepo := new(MockPaymentRepository)
+	orderRepo := new(MockOrderRepository)
+	orderRepo.On("GetAuthorizationResource", mock.Anything, orderID).
+		Return(authz.Resource{ID: orderID, RestaurantID: uuid.New()}, nil)
+
+	service := NewPaymentService(paymentRepo, orderRepo, payments.NewDefaultProviders(false))
+	_, err := service.Capture(context.Background(), adminActor, orderID, &dto.CreatePaymentRequest{
+		Method: dto.PaymentMethodCARD, Amount: 500, CardToken: "tok_visa",
+	})
+
+	assert.ErrorIs(t, err, apperr.ErrInvalid)
+	paymentRepo.AssertNotCalled(t, "Begin", mock.Anything, mock.Anything)
+}
@@ -0,0 +1,360 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/Jiruu246/rms/internal/apperr"
+	"github.com/Jiruu246/rms/internal/authz"
+	"github.com/Jiruu246/rms/internal/dto"
+	"github.com/Jiruu246/rms/internal/payments"
+	"github.com/Jiruu246/rms/internal/repos"
+	"github.com/Jiruu246/rms/pkg/money"
+	"github.com/google/uuid"
+)
+
+// Refund actions are kept apart from the payment ones so the policy can
+// grant them to fewer people: taking a payment is a till job, giving money
+// back is for the restaurant's owner (and managers, once they exist).
+const (
+	ActionCreateRefund authz.Action = "refund:create"
+	ActionReadRefund   authz.Action = "refund:read"
+)
+
+type RefundService interface {
+	Refund(ctx context.Context, actor authz.Actor, orderID uuid.UUID, req *dto.CreateRefundRequest) ([]*dto.Refund, error)
+	ListByOrder(ctx context.Context, actor authz.Actor, orderID uuid.UUID) ([]*dto.Refund, error)
+}
+
+type refundService struct {
+	repo        repos.RefundRepository
+	paymentRepo repos.PaymentRepository
+	orderRepo   repos.OrderRepository
+	providers   payments.Providers
+	authorizer  authz.Authorizer
+}
+
+func NewRefundService(repo repos.RefundRepository, paymentRepo repos.PaymentRepository, orderRepo repos.OrderRepository, providers payments.Providers) RefundService {
+	return &refundService{
+		repo:        repo,
+		paymentRepo: paymentRepo,
+		orderRepo:   orderRepo,
+		providers:   providers,
+		authorizer:  authz.NewPolicyAuthorizer(),
+	}
+}
+
+// Refund returns money for an order: everything still refundable (Full),
+// given quantities of order items with their share of tax (Items), or an
+// arbitrary amount (Amount). The amount is drawn from the order's succeeded
+// payments, newest first, as one refund per payment, and each refund goes
+// back through the provider that took the payment.
+//
+// The units of an item refund are split between its refunds by amount, a
+// unit straddling two payments going with both. As with Capture, a rejected
+// refund is not an error: it is returned with status FAILED. The units of
+// refunds that fail or are rejected are released so they can be refunded
+// again, and the refunds that were settled are returned along with any
+// error.
+func (s *refundService) Refund(ctx context.Context, actor authz.Actor, orderID uuid.UUID, req *dto.CreateRefundRequest) ([]*dto.Refund, error) {
+	resource, err := s.authorize(ctx, actor, ActionCreateRefund, orderID)
+	if err != nil {
+		return nil, err
+	}
+
+	modes := 0
+	for _, set := range []bool{req.Full, len(req.Items) > 0, req.Amount != nil} {
+		if set {
+			modes++
+		}
+	}
+	if modes != 1 {
+		return nil, apperr.Invalid("exactly one of full, items or amount must be set")
+	}
+
+	ord, err := s.orderRepo.GetByID(ctx, resource.RestaurantID, orderID, repos.WithOrderItems())
+	if err != nil {
+		return nil, err
+	}
+	paid, err := s.paymentRepo.ListByOrder(ctx, resource.RestaurantID, orderID)
+	if err != nil {
+		return nil, err
+	}
+
+	var refundable int64
+	for _, p := range paid {
+		if p.Status == dto.PaymentStateSUCCEEDED {
+			refundable += p.Amount.Amount - p.AmountRefunded.Amount
+		}
+	}
+	if refundable == 0 {
+		return nil, apperr.Conflict("order %s has nothing left to refund", orderID)
+	}
+
+	var amount int64
+	var items []repos.RefundItemData
+	switch {
+	case req.Full:
+		amount = refundable
+	case req.Amount != nil:
+		amount = *req.Amount
+	default:
+		items, amount, err = refundItems(ord, req.Items)
+		if err != nil {
+			return nil, err
+		}
+		if amount == 0 {
+			return nil, apperr.Invalid("the selected items have nothing to refund")
+		}
+	}
+	if amount > refundable {
+		return nil, apperr.Invalid("refund of %s exceeds the refundable balance of %s",
+			money.New(amount, ord.Currency), money.New(refundable, ord.Currency))
+	}
+
+	allocations := allocateRefund(paid, amount)
+	var units []refundUnit
+	unitsOf := make([][]int, len(allocations))
+	if len(items) > 0 {
+		units = refundUnits(ord, items)
+		var start int64
+		for i := range allocations {
+			end := start + allocations[i].Amount
+			for j, u := range units {
+				if u.within(start, end) {
+					unitsOf[i] = append(unitsOf[i], j)
+				}
+			}
+			allocations[i].Items = countRefundUnits(units, unitsOf[i])
+			start = end
+		}
+	}
+
+	pending, err := s.repo.Begin(ctx, &repos.BeginRefundData{
+		OrderID:      orderID,
+		RestaurantID: resource.RestaurantID,
+		Reason:       req.Reason,
+		Note:         req.Note,
+		Currency:     ord.Currency,
+		Items:        items,
+		Allocations:  allocations,
+		CreatedBy:    actor.UserID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// See Capture: outcomes are recorded even if the client has gone away.
+	settleCtx := context.WithoutCancel(ctx)
+	results := make([]*dto.Refund, 0, len(pending))
+	var errs []error
+	var unsettled []int
+	for i, rf := range pending {
+		settled, err := s.settle(ctx, settleCtx, resource.RestaurantID, rf, paid)
+		if err != nil {
+			errs = append(errs, err)
+		} else {
+			results = append(results, settled)
+		}
+		if (err != nil || settled.Status != dto.PaymentStateSUCCEEDED) && i < len(unitsOf) {
+			unsettled = append(unsettled, unitsOf[i]...)
+		}
+	}
+
+	if released := countRefundUnits(units, unsettled); len(released) > 0 {
+		if err := s.repo.ReleaseItems(settleCtx, orderID, released); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return results, errors.Join(errs...)
+}
+
+func (s *refundService) ListByOrder(ctx context.Context, actor authz.Actor, orderID uuid.UUID) ([]*dto.Refund, error) {
+	resource, err := s.authorize(ctx, actor, ActionReadRefund, orderID)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.repo.ListByOrder(ctx, resource.RestaurantID, orderID)
+}
+
+// settle sends one pending refund to the provider of the payment it draws
+// from and records the outcome. A provider error marks the refund FAILED and
+// is returned.
+func (s *refundService) settle(ctx, settleCtx context.Context, restaurantID uuid.UUID, rf *dto.Refund, paid []*dto.Payment) (*dto.Refund, error) {
+	idx := slices.IndexFunc(paid, func(p *dto.Payment) bool { return p.ID == rf.PaymentID })
+	if idx < 0 {
+		_, err := s.repo.MarkFailed(settleCtx, restaurantID, rf.ID, "payment not found")
+		return nil, errors.Join(fmt.Errorf("refund %s: payment %s not loaded", rf.ID, rf.PaymentID), err)
+	}
+	p := paid[idx]
+
+	provider, ok := s.providers.Named(p.Provider)
+	if !ok {
+		_, err := s.repo.MarkFailed(settleCtx, restaurantID, rf.ID, "payment provider unavailable")
+		return nil, errors.Join(fmt.Errorf("payment provider %s is not configured", p.Provider), err)
+	}
+
+	result, refundErr := provider.Refund(ctx, payments.RefundRequest{
+		RefundID:         rf.ID,
+		PaymentID:        p.ID,
+		PaymentReference: p.ProviderReference,
+		Amount:           rf.Amount,
+	})
+	switch {
+	case refundErr != nil:
+		_, err := s.repo.MarkFailed(settleCtx, restaurantID, rf.ID, "payment provider error")
+		return nil, errors.Join(fmt.Errorf("payment provider %s: %w", provider.Name(), refundErr), err)
+	case result.Declined:
+		return s.repo.MarkFailed(settleCtx, restaurantID, rf.ID, result.DeclineReason)
+	default:
+		return s.repo.MarkSucceeded(settleCtx, restaurantID, rf.ID, result.Reference)
+	}
+}
+
+// refundItems validates an item refund against the order and returns the
+// items to mark refunded together with the amount to return for them.
+func refundItems(ord *dto.Order, requested []dto.RefundItemRequest) ([]repos.RefundItemData, int64, error) {
+	seen := make(map[uuid.UUID]bool, len(requested))
+	items := make([]repos.RefundItemData, 0, len(requested))
+	var amount int64
+	for _, req := range requested {
+		if seen[req.OrderItemID] {
+			return nil, 0, apperr.Invalid("order item %s is listed more than once", req.OrderItemID)
+		}
+		seen[req.OrderItemID] = true
+
+		idx := slices.IndexFunc(ord.OrderItems, func(oi dto.OrderItem) bool { return oi.ID == req.OrderItemID })
+		if idx < 0 {
+			return nil, 0, apperr.Invalid("order item %s is not part of order %s", req.OrderItemID, ord.ID)
+		}
+		item := ord.OrderItems[idx]
+		if item.VoidedAt != nil {
+			return nil, 0, apperr.Invalid("order item %s has been voided", item.ID)
+		}
+		if left := item.Quantity - item.RefundedQuantity; req.Quantity > left {
+			return nil, 0, apperr.Invalid("only %d of order item %s can still be refunded", left, item.ID)
+		}
+
+		amount += itemRefundAmount(ord, item, req.Quantity)
+		items = append(items, repos.RefundItemData{
+			OrderItemID:              item.ID,
+			ExpectedRefundedQuantity: item.RefundedQuantity,
+			Quantity:                 req.Quantity,
+		})
+	}
+	return items, amount, nil
+}
+
+// itemRefundAmount is what quantity more units of item are worth, including
+// their share of the order's tax. It is computed as the difference between
+// cumulative shares so that refunding a line unit by unit adds up to exactly
+// what refunding it at once would.
+func itemRefundAmount(ord *dto.Order, item dto.OrderItem, quantity int) int64 {
+	share := func(units int) int64 {
+		net := money.Prorate(item.LineTotal.Amount, int64(units), int64(item.Quantity))
+		if ord.Subtotal.Amount == 0 {
+			return net
+		}
+		return net + money.Prorate(net, ord.TaxTotal.Amount, ord.Subtotal.Amount)
+	}
+	return share(item.RefundedQuantity+quantity) - share(item.RefundedQuantity)
+}
+
+// refundUnit is one unit of an order item in an item refund, laid out over
+// [start, end) of the refund's amount.
+type refundUnit struct {
+	orderItemID uuid.UUID
+	start, end  int64
+}
+
+// within reports whether the allocation paying [start, end) of the refund
+// pays for any of the unit. A unit worth nothing goes with the allocation
+// its position falls in.
+func (u refundUnit) within(start, end int64) bool {
+	if u.start == u.end {
+		return start <= u.start && u.start <= end
+	}
+	return u.start < end && start < u.end
+}
+
+// refundUnits lays the units of items end to end, each worth what
+// itemRefundAmount gives for it, so they add up to the refund's amount.
+func refundUnits(ord *dto.Order, items []repos.RefundItemData) []refundUnit {
+	var units []refundUnit
+	var offset int64
+	for _, data := range items {
+		idx := slices.IndexFunc(ord.OrderItems, func(oi dto.OrderItem) bool { return oi.ID == data.OrderItemID })
+		item := ord.OrderItems[idx]
+		item.RefundedQuantity = data.ExpectedRefundedQuantity
+		for range data.Quantity {
+			amount := itemRefundAmount(ord, item, 1)
+			units = append(units, refundUnit{orderItemID: item.ID, start: offset, end: offset + amount})
+			offset += amount
+			item.RefundedQuantity++
+		}
+	}
+	return units
+}
+
+// countRefundUnits counts the distinct units at indexes per order item, in
+// the order the items were requested.
+func countRefundUnits(units []refundUnit, indexes []int) []dto.RefundItem {
+	var counted []dto.RefundItem
+	for j, u := range units {
+		if !slices.Contains(indexes, j) {
+			continue
+		}
+		if n := len(counted); n > 0 && counted[n-1].OrderItemID == u.orderItemID {
+			counted[n-1].Quantity++
+		} else {
+			counted = append(counted, dto.RefundItem{OrderItemID: u.orderItemID, Quantity: 1})
+		}
+	}
+	return counted
+}
+
+// allocateRefund spreads amount over the succeeded payments in paid, most
+// recent first, taking from each at most what hasn't been refunded yet.
+// The caller has checked amount against the total refundable balance.
+func allocateRefund(paid []*dto.Payment, amount int64) []repos.RefundAllocation {
+	var allocations []repos.RefundAllocation
+	for i := len(paid) - 1; i >= 0 && amount > 0; i-- {
+		p := paid[i]
+		if p.Status != dto.PaymentStateSUCCEEDED {
+			continue
+		}
+		take := min(amount, p.Amount.Amount-p.AmountRefunded.Amount)
+		if take <= 0 {
+			continue
+		}
+		allocations = append(allocations, repos.RefundAllocation{
+			PaymentID:              p.ID,
+			ExpectedRefundedAmount: p.AmountRefunded.Amount,
+			Amount:                 take,
+		})
+		amount -= take
+	}
+	return allocations
+}
+
+// authorize resolves the order's authz.Resource — refunds are governed by
+// the order they belong to — and checks the actor may perform action on it.
+func (s *refundService) authorize(ctx context.Context, actor authz.Actor, action authz.Action, orderID uuid.UUID) (authz.Resource, error) {
+	resource, err := s.orderRepo.GetAuthorizationResource(ctx, orderID)
+	if err != nil {
+		return authz.Resource{}, err
+	}
+
+	if _, err := s.authorizer.Authorize(ctx, authz.Request{
+		Actor:    actor,
+		Action:   action,
+		Resource: resource,
+	}); err != nil {
+		return authz.Resource{}, err
+	}
+	return resource, nil
+}
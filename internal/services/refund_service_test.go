@@ -0,0 +1,372 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/Jiruu246/rms/internal/apperr"
+	"github.com/Jiruu246/rms/internal/authz"
+	"github.com/Jiruu246/rms/internal/dto"
+	"github.com/Jiruu246/rms/internal/payments"
+	"github.com/Jiruu246/rms/internal/repos"
+	"github.com/Jiruu246/rms/pkg/money"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// MockRefundRepository is a mock implementation of RefundRepository
+type MockRefundRepository struct {
+	mock.Mock
+}
+
+func (m *MockRefundRepository) Begin(ctx context.Context, data *repos.BeginRefundData) ([]*dto.Refund, error) {
+	args := m.Called(ctx, data)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*dto.Refund), args.Error(1)
+}
+
+func (m *MockRefundRepository) MarkSucceeded(ctx context.Context, restaurantID, id uuid.UUID, reference string) (*dto.Refund, error) {
+	args := m.Called(ctx, restaurantID, id, reference)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*dto.Refund), args.Error(1)
+}
+
+func (m *MockRefundRepository) MarkFailed(ctx context.Context, restaurantID, id uuid.UUID, reason string) (*dto.Refund, error) {
+	args := m.Called(ctx, restaurantID, id, reason)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*dto.Refund), args.Error(1)
+}
+
+func (m *MockRefundRepository) ReleaseItems(ctx context.Context, orderID uuid.UUID, items []dto.RefundItem) error {
+	args := m.Called(ctx, orderID, items)
+	return args.Error(0)
+}
+
+func (m *MockRefundRepository) ListByOrder(ctx context.Context, restaurantID, orderID uuid.UUID) ([]*dto.Refund, error) {
+	args := m.Called(ctx, restaurantID, orderID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*dto.Refund), args.Error(1)
+}
+
+func TestRefundService_Refund(t *testing.T) {
+	restaurantID := uuid.New()
+	orderID := uuid.New()
+	cashID := uuid.New()
+	cardID := uuid.New()
+	burgerID := uuid.New()
+	resource := authz.Resource{Type: "order", ID: orderID, RestaurantID: restaurantID}
+
+	// 3 burgers (3000) + 10% tax = 3300, paid 1300 cash then 2000 card.
+	paidOrder := &dto.Order{
+		ID:            orderID,
+		PaymentStatus: dto.PaymentStatusPAID,
+		Currency:      "USD",
+		Subtotal:      money.New(3000, "USD"),
+		TaxTotal:      money.New(300, "USD"),
+		Total:         money.New(3300, "USD"),
+		OrderItems: []dto.OrderItem{
+			{ID: burgerID, Quantity: 3, LineTotal: money.New(3000, "USD")},
+		},
+	}
+	paymentsWithRefunded := func(cashRefunded, cardRefunded int64) []*dto.Payment {
+		return []*dto.Payment{
+			{ID: cashID, Provider: "cash", Status: dto.PaymentStateSUCCEEDED,
+				Amount: money.New(1300, "USD"), AmountRefunded: money.New(cashRefunded, "USD")},
+			{ID: cardID, Provider: "fake_card", ProviderReference: "fake_ch_1", Status: dto.PaymentStateSUCCEEDED,
+				Amount: money.New(2000, "USD"), AmountRefunded: money.New(cardRefunded, "USD")},
+		}
+	}
+	pending := func(paymentID uuid.UUID, amount int64) *dto.Refund {
+		return &dto.Refund{ID: uuid.New(), PaymentID: paymentID, Amount: money.New(amount, "USD"), Status: dto.PaymentStatePENDING}
+	}
+	succeed := func(repo *MockRefundRepository, refunds ...*dto.Refund) {
+		for _, rf := range refunds {
+			repo.On("MarkSucceeded", mock.Anything, restaurantID, rf.ID, mock.Anything).
+				Return(&dto.Refund{ID: rf.ID, PaymentID: rf.PaymentID, Amount: rf.Amount, Status: dto.PaymentStateSUCCEEDED}, nil)
+		}
+	}
+	amount := func(a int64) *int64 { return &a }
+
+	testCases := []struct {
+		name        string
+		paid        []*dto.Payment
+		req         *dto.CreateRefundRequest
+		setupMock   func(*MockRefundRepository)
+		expectedErr error
+		expected    []int64
+	}{
+		{
+			name: "full refund spans both payments, newest first",
+			paid: paymentsWithRefunded(0, 0),
+			req:  &dto.CreateRefundRequest{Reason: dto.RefundReasonCUSTOMER_REQUEST, Full: true},
+			setupMock: func(repo *MockRefundRepository) {
+				card, cash := pending(cardID, 2000), pending(cashID, 1300)
+				repo.On("Begin", mock.Anything, mock.MatchedBy(func(d *repos.BeginRefundData) bool {
+					return len(d.Allocations) == 2 &&
+						d.Allocations[0].PaymentID == cardID && d.Allocations[0].Amount == 2000 &&
+						d.Allocations[1].PaymentID == cashID && d.Allocations[1].Amount == 1300
+				})).Return([]*dto.Refund{card, cash}, nil)
+				succeed(repo, card, cash)
+			},
+			expected: []int64{2000, 1300},
+		},
+		{
+			name: "partial amount comes from the latest payment",
+			paid: paymentsWithRefunded(0, 0),
+			req:  &dto.CreateRefundRequest{Reason: dto.RefundReasonQUALITY_ISSUE, Amount: amount(500)},
+			setupMock: func(repo *MockRefundRepository) {
+				card := pending(cardID, 500)
+				repo.On("Begin", mock.Anything, mock.MatchedBy(func(d *repos.BeginRefundData) bool {
+					return len(d.Allocations) == 1 && d.Allocations[0].PaymentID == cardID &&
+						d.Allocations[0].ExpectedRefundedAmount == 0 && d.Reason == dto.RefundReasonQUALITY_ISSUE
+				})).Return([]*dto.Refund{card}, nil)
+				succeed(repo, card)
+			},
+			expected: []int64{500},
+		},
+		{
+			name: "item refund includes its share of tax",
+			paid: paymentsWithRefunded(0, 0),
+			req: &dto.CreateRefundRequest{
+				Reason: dto.RefundReasonORDER_ERROR,
+				Items:  []dto.RefundItemRequest{{OrderItemID: burgerID, Quantity: 1}},
+			},
+			setupMock: func(repo *MockRefundRepository) {
+				card := pending(cardID, 1100)
+				repo.On("Begin", mock.Anything, mock.MatchedBy(func(d *repos.BeginRefundData) bool {
+					return len(d.Items) == 1 && d.Items[0].OrderItemID == burgerID && d.Items[0].Quantity == 1 &&
+						len(d.Allocations) == 1 && d.Allocations[0].Amount == 1100
+				})).Return([]*dto.Refund{card}, nil)
+				succeed(repo, card)
+			},
+			expected: []int64{1100},
+		},
+		{
+			name: "full refund skips what was already refunded",
+			paid: paymentsWithRefunded(0, 2000),
+			req:  &dto.CreateRefundRequest{Reason: dto.RefundReasonOTHER, Full: true},
+			setupMock: func(repo *MockRefundRepository) {
+				cash := pending(cashID, 1300)
+				repo.On("Begin", mock.Anything, mock.MatchedBy(func(d *repos.BeginRefundData) bool {
+					return len(d.Allocations) == 1 && d.Allocations[0].PaymentID == cashID && d.Allocations[0].Amount == 1300
+				})).Return([]*dto.Refund{cash}, nil)
+				succeed(repo, cash)
+			},
+			expected: []int64{1300},
+		},
+		{
+			name:        "no mode",
+			paid:        paymentsWithRefunded(0, 0),
+			req:         &dto.CreateRefundRequest{Reason: dto.RefundReasonOTHER},
+			setupMock:   func(repo *MockRefundRepository) {},
+			expectedErr: apperr.ErrInvalid,
+		},
+		{
+			name:        "more than one mode",
+			paid:        paymentsWithRefunded(0, 0),
+			req:         &dto.CreateRefundRequest{Reason: dto.RefundReasonOTHER, Full: true, Amount: amount(100)},
+			setupMock:   func(repo *MockRefundRepository) {},
+			expectedErr: apperr.ErrInvalid,
+		},
+		{
+			name:        "amount exceeds refundable balance",
+			paid:        paymentsWithRefunded(0, 2000),
+			req:         &dto.CreateRefundRequest{Reason: dto.RefundReasonOTHER, Amount: amount(1301)},
+			setupMock:   func(repo *MockRefundRepository) {},
+			expectedErr: apperr.ErrInvalid,
+		},
+		{
+			name: "item not on the order",
+			paid: paymentsWithRefunded(0, 0),
+			req: &dto.CreateRefundRequest{
+				Reason: dto.RefundReasonORDER_ERROR,
+				Items:  []dto.RefundItemRequest{{OrderItemID: uuid.New(), Quantity: 1}},
+			},
+			setupMock:   func(repo *MockRefundRepository) {},
+			expectedErr: apperr.ErrInvalid,
+		},
+		{
+			name: "more units than were ordered",
+			paid: paymentsWithRefunded(0, 0),
+			req: &dto.CreateRefundRequest{
+				Reason: dto.RefundReasonORDER_ERROR,
+				Items:  []dto.RefundItemRequest{{OrderItemID: burgerID, Quantity: 4}},
+			},
+			setupMock:   func(repo *MockRefundRepository) {},
+			expectedErr: apperr.ErrInvalid,
+		},
+		{
+			name:        "nothing left to refund",
+			paid:        paymentsWithRefunded(1300, 2000),
+			req:         &dto.CreateRefundRequest{Reason: dto.RefundReasonOTHER, Full: true},
+			setupMock:   func(repo *MockRefundRepository) {},
+			expectedErr: apperr.ErrConflict,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			refundRepo := new(MockRefundRepository)
+			paymentRepo := new(MockPaymentRepository)
+			orderRepo := new(MockOrderRepository)
+			orderRepo.On("GetAuthorizationResource", mock.Anything, orderID).Return(resource, nil)
+			orderRepo.On("GetByID", mock.Anything, restaurantID, orderID).Return(paidOrder, nil)
+			paymentRepo.On("ListByOrder", mock.Anything, restaurantID, orderID).Return(tc.paid, nil)
+			tc.setupMock(refundRepo)
+
+			service := NewRefundService(refundRepo, paymentRepo, orderRepo, payments.NewDefaultProviders(true))
+			result, err := service.Refund(context.Background(), adminActor, orderID, tc.req)
+
+			if tc.expectedErr != nil {
+				assert.True(t, errors.Is(err, tc.expectedErr), "expected %v, got %v", tc.expectedErr, err)
+				refundRepo.AssertNotCalled(t, "Begin", mock.Anything, mock.Anything)
+				return
+			}
+			require.NoError(t, err)
+			amounts := make([]int64, 0, len(result))
+			for _, rf := range result {
+				assert.Equal(t, dto.PaymentStateSUCCEEDED, rf.Status)
+				amounts = append(amounts, rf.Amount.Amount)
+			}
+			assert.Equal(t, tc.expected, amounts)
+			refundRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestItemRefundAmount_AddsUpToLineTotal(t *testing.T) {
+	// 1000 over 3 units with 7.25% tax doesn't divide evenly; refunding one
+	// unit at a time must still return exactly what one full refund would.
+	ord := &dto.Order{Subtotal: money.New(1000, "USD"), TaxTotal: money.New(73, "USD")}
+	item := dto.OrderItem{Quantity: 3, LineTotal: money.New(1000, "USD")}
+
+	full := itemRefundAmount(ord, item, 3)
+	var oneByOne int64
+	for i := 0; i < 3; i++ {
+		oneByOne += itemRefundAmount(ord, item, 1)
+		item.RefundedQuantity++
+	}
+
+	assert.Equal(t, int64(1073), full)
+	assert.Equal(t, full, oneByOne)
+}
+
+func TestRefundService_Refund_ProviderFailureReleasesItems(t *testing.T) {
+	restaurantID := uuid.New()
+	orderID := uuid.New()
+	paymentID := uuid.New()
+	itemID := uuid.New()
+	refundID := uuid.New()
+
+	refundRepo := new(MockRefundRepository)
+	paymentRepo := new(MockPaymentRepository)
+	orderRepo := new(MockOrderRepository)
+	orderRepo.On("GetAuthorizationResource", mock.Anything, orderID).
+		Return(authz.Resource{ID: orderID, RestaurantID: restaurantID}, nil)
+	orderRepo.On("GetByID", mock.Anything, restaurantID, orderID).Return(&dto.Order{
+		ID: orderID, Currency: "USD", Subtotal: money.New(500, "USD"), Total: money.New(500, "USD"),
+		OrderItems: []dto.OrderItem{{ID: itemID, Quantity: 1, LineTotal: money.New(500, "USD")}},
+	}, nil)
+	// The payment was taken by a provider that is no longer configured.
+	paymentRepo.On("ListByOrder", mock.Anything, restaurantID, orderID).Return([]*dto.Payment{
+		{ID: paymentID, Provider: "retired_gateway", Status: dto.PaymentStateSUCCEEDED, Amount: money.New(500, "USD")},
+	}, nil)
+	refundRepo.On("Begin", mock.Anything, mock.Anything).
+		Return([]*dto.Refund{{ID: refundID, PaymentID: paymentID, Amount: money.New(500, "USD")}}, nil)
+	refundRepo.On("MarkFailed", mock.Anything, restaurantID, refundID, "payment provider unavailable").
+		Return(&dto.Refund{ID: refundID, Status: dto.PaymentStateFAILED}, nil)
+	refundRepo.On("ReleaseItems", mock.Anything, orderID, []dto.RefundItem{{OrderItemID: itemID, Quantity: 1}}).
+		Return(nil)
+
+	service := NewRefundService(refundRepo, paymentRepo, orderRepo, payments.NewDefaultProviders(true))
+	_, err := service.Refund(context.Background(), adminActor, orderID, &dto.CreateRefundRequest{
+		Reason: dto.RefundReasonORDER_ERROR,
+		Items:  []dto.RefundItemRequest{{OrderItemID: itemID, Quantity: 1}},
+	})
+
+	assert.Error(t, err)
+	refundRepo.AssertExpectations(t)
+}
+
+func TestRefundService_Refund_PartialFailureReleasesItsItems(t *testing.T) {
+	restaurantID := uuid.New()
+	orderID := uuid.New()
+	cashID, retiredID := uuid.New(), uuid.New()
+	itemID := uuid.New()
+	cashRefundID, retiredRefundID := uuid.New(), uuid.New()
+
+	refundRepo := new(MockRefundRepository)
+	paymentRepo := new(MockPaymentRepository)
+	orderRepo := new(MockOrderRepository)
+	orderRepo.On("GetAuthorizationResource", mock.Anything, orderID).
+		Return(authz.Resource{ID: orderID, RestaurantID: restaurantID}, nil)
+	orderRepo.On("GetByID", mock.Anything, restaurantID, orderID).Return(&dto.Order{
+		ID: orderID, Currency: "USD", Subtotal: money.New(1000, "USD"), Total: money.New(1000, "USD"),
+		OrderItems: []dto.OrderItem{{ID: itemID, Quantity: 2, LineTotal: money.New(1000, "USD")}},
+	}, nil)
+	// The newer payment, refunded first, was taken by a provider that is
+	// no longer configured.
+	paymentRepo.On("ListByOrder", mock.Anything, restaurantID, orderID).Return([]*dto.Payment{
+		{ID: cashID, Provider: "cash", Status: dto.PaymentStateSUCCEEDED, Amount: money.New(600, "USD")},
+		{ID: retiredID, Provider: "retired_gateway", Status: dto.PaymentStateSUCCEEDED, Amount: money.New(400, "USD")},
+	}, nil)
+	var begun *repos.BeginRefundData
+	refundRepo.On("Begin", mock.Anything, mock.Anything).
+		Run(func(args mock.Arguments) { begun = args.Get(1).(*repos.BeginRefundData) }).
+		Return([]*dto.Refund{
+			{ID: retiredRefundID, PaymentID: retiredID, Amount: money.New(400, "USD")},
+			{ID: cashRefundID, PaymentID: cashID, Amount: money.New(600, "USD")},
+		}, nil)
+	refundRepo.On("MarkFailed", mock.Anything, restaurantID, retiredRefundID, "payment provider unavailable").
+		Return(&dto.Refund{ID: retiredRefundID, Status: dto.PaymentStateFAILED}, nil)
+	refundRepo.On("MarkSucceeded", mock.Anything, restaurantID, cashRefundID, mock.Anything).
+		Return(&dto.Refund{ID: cashRefundID, Status: dto.PaymentStateSUCCEEDED, Amount: money.New(600, "USD")}, nil)
+	// The first unit was partly paid from the failed refund.
+	refundRepo.On("ReleaseItems", mock.Anything, orderID, []dto.RefundItem{{OrderItemID: itemID, Quantity: 1}}).
+		Return(nil)
+
+	service := NewRefundService(refundRepo, paymentRepo, orderRepo, payments.NewDefaultProviders(true))
+	results, err := service.Refund(context.Background(), adminActor, orderID, &dto.CreateRefundRequest{
+		Reason: dto.RefundReasonORDER_ERROR,
+		Items:  []dto.RefundItemRequest{{OrderItemID: itemID, Quantity: 2}},
+	})
+
+	assert.Error(t, err)
+	require.Len(t, results, 1)
+	assert.Equal(t, cashRefundID, results[0].ID)
+	require.NotNil(t, begun)
+	require.Len(t, begun.Allocations, 2)
+	assert.Equal(t, []dto.RefundItem{{OrderItemID: itemID, Quantity: 1}}, begun.Allocations[0].Items)
+	assert.Equal(t, []dto.RefundItem{{OrderItemID: itemID, Quantity: 2}}, begun.Allocations[1].Items)
+	refundRepo.AssertExpectations(t)
+}
+
+func TestRefundService_Refund_Forbidden(t *testing.T) {
+	orderID := uuid.New()
+
+	refundRepo := new(MockRefundRepository)
+	paymentRepo := new(MockPaymentRepository)
+	orderRepo := new(MockOrderRepository)
+	orderRepo.On("GetAuthorizationResource", mock.Anything, orderID).
+		Return(authz.Resource{ID: orderID, RestaurantID: uuid.New(), OwnerUserID: uuid.New()}, nil)
+
+	service := NewRefundService(refundRepo, paymentRepo, orderRepo, payments.NewDefaultProviders(true))
+	_, err := service.Refund(context.Background(), authz.Actor{UserID: uuid.New()}, orderID, &dto.CreateRefundRequest{
+		Reason: dto.RefundReasonOTHER, Full: true,
+	})
+
+	assert.ErrorIs(t, err, apperr.ErrForbidden)
+	orderRepo.AssertNotCalled(t, "GetByID", mock.Anything, mock.Anything)
+	refundRepo.AssertNotCalled(t, "Begin", mock.Anything, mock.Anything)
+}
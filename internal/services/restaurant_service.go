@@ -2,10 +2,15 @@ package services
 
 import (
 	"context"
+	"fmt"
+	"time"
 
+	"github.com/Jiruu246/rms/internal/apperr"
 	"github.com/Jiruu246/rms/internal/authz"
+	"github.com/Jiruu246/rms/internal/blobs"
 	"github.com/Jiruu246/rms/internal/dto"
 	"github.com/Jiruu246/rms/internal/repos"
+	"github.com/Jiruu246/rms/pkg/hours"
 	"github.com/google/uuid"
 )
 
@@ -25,21 +30,29 @@ type RestaurantService interface {
 	// actor may act on a restaurant without duplicating the
 	// GetAuthorizationResource + Authorizer.Authorize dance themselves.
 	AuthorizeOwnership(ctx context.Context, actor authz.Actor, action authz.Action, restaurantID uuid.UUID) error
+	// GetOpenStatus reports whether the restaurant is open at the given
+	// time and when it next opens. It is public and needs no actor.
+	GetOpenStatus(ctx context.Context, id uuid.UUID, at time.Time) (*dto.OpenStatus, error)
 }
 
 type restaurantService struct {
 	repo       repos.RestaurantRepository
+	blobs      blobs.Store
 	authorizer authz.Authorizer
 }
 
-func NewRestaurantService(repo repos.RestaurantRepository) RestaurantService {
+func NewRestaurantService(repo repos.RestaurantRepository, blobStore blobs.Store) RestaurantService {
 	return &restaurantService{
 		repo:       repo,
+		blobs:      blobStore,
 		authorizer: authz.NewPolicyAuthorizer(),
 	}
 }
 
 func (s *restaurantService) Create(ctx context.Context, data *dto.CreateRestaurantData) (*dto.RestaurantResponse, error) {
+	if err := validateOperatingHours(data.Request.OperatingHours); err != nil {
+		return nil, err
+	}
 	return s.repo.Create(ctx, data)
 }
 
@@ -58,6 +71,9 @@ func (s *restaurantService) Update(ctx context.Context, actor authz.Actor, id uu
 	if err := s.AuthorizeOwnership(ctx, actor, ActionUpdateRestaurant, id); err != nil {
 		return nil, err
 	}
+	if err := validateOperatingHours(req.OperatingHours); err != nil {
+		return nil, err
+	}
 	return s.repo.Update(ctx, &dto.UpdateRestaurantData{
 		Request: req,
 		ID:      id,
@@ -68,7 +84,15 @@ func (s *restaurantService) Delete(ctx context.Context, actor authz.Actor, id uu
 	if err := s.AuthorizeOwnership(ctx, actor, ActionDeleteRestaurant, id); err != nil {
 		return err
 	}
-	return s.repo.Delete(ctx, id)
+	keys, err := s.repo.GetLogoKeys(ctx, id)
+	if err != nil {
+		return err
+	}
+	if err := s.repo.Delete(ctx, id); err != nil {
+		return err
+	}
+	deleteBlobs(ctx, s.blobs, keys)
+	return nil
 }
 
 func (s *restaurantService) AuthorizeOwnership(ctx context.Context, actor authz.Actor, action authz.Action, restaurantID uuid.UUID) error {
@@ -84,3 +108,61 @@ func (s *restaurantService) AuthorizeOwnership(ctx context.Context, actor authz.
 	})
 	return err
 }
+
+func (s *restaurantService) GetOpenStatus(ctx context.Context, id uuid.UUID, at time.Time) (*dto.OpenStatus, error) {
+	restaurant, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return openStatus(restaurant, at)
+}
+
+// validateOperatingHours checks the rules of hours.Schedule.Validate that
+// the request's validate tags cannot express.
+func validateOperatingHours(schedule *hours.Schedule) error {
+	if schedule == nil {
+		return nil
+	}
+	if err := schedule.Validate(); err != nil {
+		return apperr.Invalid("invalid operating_hours: %v", err)
+	}
+	return nil
+}
+
+// openStatus evaluates the restaurant's status and operating hours at at.
+// Only active restaurants are ever open; one without weekly hours is open
+// around the clock.
+func openStatus(restaurant *dto.RestaurantResponse, at time.Time) (*dto.OpenStatus, error) {
+	status := &dto.OpenStatus{At: at}
+	if restaurant.Status != dto.RestaurantStatusActive {
+		return status, nil
+	}
+	schedule := restaurant.OperatingHours
+	if schedule == nil || schedule.IsEmpty() {
+		status.IsOpen = true
+		return status, nil
+	}
+
+	loc, err := time.LoadLocation(restaurant.Timezone)
+	if err != nil {
+		return nil, fmt.Errorf("invalid restaurant timezone %q: %w", restaurant.Timezone, err)
+	}
+	span, open, err := schedule.OpenSpan(at, loc)
+	if err != nil {
+		return nil, fmt.Errorf("invalid operating hours: %w", err)
+	}
+	if open {
+		status.IsOpen = true
+		if !span.End.IsZero() {
+			status.ClosesAt = &span.End
+		}
+	}
+	next, ok, err := schedule.NextOpening(at, loc)
+	if err != nil {
+		return nil, fmt.Errorf("invalid operating hours: %w", err)
+	}
+	if ok {
+		status.NextOpening = &next
+	}
+	return status, nil
+}
@@ -4,14 +4,19 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/Jiruu246/rms/internal/apperr"
 	"github.com/Jiruu246/rms/internal/authz"
+	"github.com/Jiruu246/rms/internal/blobs"
 	"github.com/Jiruu246/rms/internal/dto"
 	"github.com/Jiruu246/rms/internal/ent/restaurant"
+	"github.com/Jiruu246/rms/internal/repos"
+	"github.com/Jiruu246/rms/pkg/hours"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 )
 
 type MockRestaurantRepository struct {
@@ -60,12 +65,25 @@ func (m *MockRestaurantRepository) GetAuthorizationResource(ctx context.Context,
 	return args.Get(0).(authz.Resource), args.Error(1)
 }
 
+func (m *MockRestaurantRepository) SetLogo(ctx context.Context, id uuid.UUID, logo *repos.ImageData) (*dto.RestaurantResponse, []string, error) {
+	args := m.Called(ctx, id, logo)
+	if args.Get(0) == nil {
+		return nil, nil, args.Error(2)
+	}
+	return args.Get(0).(*dto.RestaurantResponse), args.Get(1).([]string), args.Error(2)
+}
+
+func (m *MockRestaurantRepository) GetLogoKeys(ctx context.Context, id uuid.UUID) ([]string, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]string), args.Error(1)
+}
+
 func TestRestaurantService_Create(t *testing.T) {
-	operatingHours := map[string]any{
-		"monday": map[string]string{
-			"open":  "09:00",
-			"close": "22:00",
-		},
+	operatingHours := &hours.Schedule{
+		Monday: []hours.Interval{{Open: "09:00", Close: "22:00"}},
 	}
 
 	type testCase struct {
@@ -275,7 +293,7 @@ func TestRestaurantService_Create(t *testing.T) {
 				mockRepo.On("Create", mock.Anything, tc.input).Return(tc.expected, nil)
 			}
 
-			service := NewRestaurantService(mockRepo)
+			service := NewRestaurantService(mockRepo, blobs.NewMemoryStore(""))
 			result, err := service.Create(t.Context(), tc.input)
 
 			if tc.expectedError != "" {
@@ -292,6 +310,116 @@ func TestRestaurantService_Create(t *testing.T) {
 	}
 }
 
+func TestRestaurantService_Create_InvalidOperatingHours(t *testing.T) {
+	mockRepo := new(MockRestaurantRepository)
+	data := &dto.CreateRestaurantData{
+		Request: &dto.CreateRestaurantRequest{
+			Name:     "Test Restaurant",
+			Currency: "USD",
+			OperatingHours: &hours.Schedule{
+				Friday: []hours.Interval{{Open: "11:00", Close: "15:00"}, {Open: "14:00", Close: "22:00"}},
+			},
+		},
+		UserID: uuid.New(),
+	}
+
+	result, err := NewRestaurantService(mockRepo, blobs.NewMemoryStore("")).Create(t.Context(), data)
+
+	assert.ErrorIs(t, err, apperr.ErrInvalid)
+	assert.Nil(t, result)
+	mockRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+}
+
+func TestOpenStatus(t *testing.T) {
+	schedule := &hours.Schedule{
+		Friday:   []hours.Interval{{Open: "11:00", Close: "14:00"}, {Open: "18:00", Close: "01:00"}},
+		Saturday: []hours.Interval{{Open: "11:00", Close: "14:00"}},
+		// Closed for a holiday on Friday 2026-12-25.
+		Exceptions: []hours.Exception{{Date: "2026-12-25", Note: "Christmas"}},
+	}
+	loc, err := time.LoadLocation("Europe/Berlin")
+	require.NoError(t, err)
+	active := &dto.RestaurantResponse{Status: dto.RestaurantStatusActive, Timezone: "Europe/Berlin", OperatingHours: schedule}
+	at := func(month time.Month, day, hour, min int) time.Time {
+		return time.Date(2026, month, day, hour, min, 0, 0, loc)
+	}
+
+	testCases := []struct {
+		name        string
+		restaurant  *dto.RestaurantResponse
+		at          time.Time
+		isOpen      bool
+		closesAt    *time.Time
+		nextOpening *time.Time
+	}{
+		{
+			name:        "open for lunch",
+			restaurant:  active,
+			at:          at(time.March, 6, 12, 0),
+			isOpen:      true,
+			closesAt:    ptr(at(time.March, 6, 14, 0)),
+			nextOpening: ptr(at(time.March, 6, 18, 0)),
+		},
+		{
+			name:        "between openings",
+			restaurant:  active,
+			at:          at(time.March, 6, 15, 0),
+			nextOpening: ptr(at(time.March, 6, 18, 0)),
+		},
+		{
+			name:        "after midnight",
+			restaurant:  active,
+			at:          at(time.March, 7, 0, 30),
+			isOpen:      true,
+			closesAt:    ptr(at(time.March, 7, 1, 0)),
+			nextOpening: ptr(at(time.March, 7, 11, 0)),
+		},
+		{
+			name:        "holiday",
+			restaurant:  active,
+			at:          at(time.December, 25, 12, 0),
+			nextOpening: ptr(at(time.December, 26, 11, 0)),
+		},
+		{
+			name:       "no hours",
+			restaurant: &dto.RestaurantResponse{Status: dto.RestaurantStatusActive, Timezone: "UTC"},
+			at:         at(time.March, 6, 3, 0),
+			isOpen:     true,
+		},
+		{
+			name:       "inactive",
+			restaurant: &dto.RestaurantResponse{Status: dto.RestaurantStatusInactive, Timezone: "Europe/Berlin", OperatingHours: schedule},
+			at:         at(time.March, 6, 12, 0),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			status, err := openStatus(tc.restaurant, tc.at)
+
+			require.NoError(t, err)
+			assert.Equal(t, tc.isOpen, status.IsOpen)
+			assertSameTime(t, tc.closesAt, status.ClosesAt)
+			assertSameTime(t, tc.nextOpening, status.NextOpening)
+		})
+	}
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
+
+func assertSameTime(t *testing.T, expected, actual *time.Time) {
+	t.Helper()
+	if expected == nil {
+		assert.Nil(t, actual)
+		return
+	}
+	if assert.NotNil(t, actual) {
+		assert.True(t, expected.Equal(*actual), "expected %s, got %s", expected, actual)
+	}
+}
+
 func TestRestaurantService_GetByID(t *testing.T) {
 	testId := uuid.New()
 	ownerId := uuid.New()
@@ -348,7 +476,7 @@ func TestRestaurantService_GetByID(t *testing.T) {
 			mockRepo := new(MockRestaurantRepository)
 			testCase.mockSetup(mockRepo)
 
-			service := NewRestaurantService(mockRepo)
+			service := NewRestaurantService(mockRepo, blobs.NewMemoryStore(""))
 			result, err := service.GetByID(t.Context(), testCase.actor, testCase.id)
 
 			if testCase.expectedError != "" {
@@ -517,7 +645,7 @@ func TestRestaurantService_Update(t *testing.T) {
 			mockRepo := new(MockRestaurantRepository)
 			testCase.mockSetup(mockRepo, testCase.request)
 
-			service := NewRestaurantService(mockRepo)
+			service := NewRestaurantService(mockRepo, blobs.NewMemoryStore(""))
 			result, err := service.Update(t.Context(), testCase.actor, restaurantId, testCase.request)
 
 			if testCase.expectedError != "" {
@@ -540,6 +668,7 @@ func TestRestaurantService_Delete(t *testing.T) {
 	owner := authz.Actor{UserID: ownerId}
 	stranger := authz.Actor{UserID: uuid.New()}
 	resource := authz.Resource{ID: id, RestaurantID: id, OwnerUserID: ownerId}
+	logoKeys := []string{"restaurants/" + id.String() + "/logo/a.png", "restaurants/" + id.String() + "/logo/a-thumb.png"}
 
 	testCases := []struct {
 		name          string
@@ -547,6 +676,7 @@ func TestRestaurantService_Delete(t *testing.T) {
 		actor         authz.Actor
 		mockSetup     func(*MockRestaurantRepository)
 		expectedError string
+		logoDeleted   bool
 	}{
 		{
 			name:  "successful deletion",
@@ -554,9 +684,11 @@ func TestRestaurantService_Delete(t *testing.T) {
 			actor: owner,
 			mockSetup: func(mockRepo *MockRestaurantRepository) {
 				mockRepo.On("GetAuthorizationResource", mock.Anything, id).Return(resource, nil)
+				mockRepo.On("GetLogoKeys", mock.Anything, id).Return(logoKeys, nil)
 				mockRepo.On("Delete", mock.Anything, id).Return(nil)
 			},
 			expectedError: "",
+			logoDeleted:   true,
 		},
 		{
 			name:  "forbidden - not the owner",
@@ -573,6 +705,7 @@ func TestRestaurantService_Delete(t *testing.T) {
 			actor: owner,
 			mockSetup: func(mockRepo *MockRestaurantRepository) {
 				mockRepo.On("GetAuthorizationResource", mock.Anything, id).Return(resource, nil)
+				mockRepo.On("GetLogoKeys", mock.Anything, id).Return(logoKeys, nil)
 				mockRepo.On("Delete", mock.Anything, id).Return(errors.New("database error"))
 			},
 			expectedError: "database error",
@@ -584,7 +717,12 @@ func TestRestaurantService_Delete(t *testing.T) {
 			mockRepo := new(MockRestaurantRepository)
 			testCase.mockSetup(mockRepo)
 
-			service := NewRestaurantService(mockRepo)
+			store := blobs.NewMemoryStore("")
+			for _, key := range logoKeys {
+				require.NoError(t, store.Put(t.Context(), key, "image/png", []byte("png")))
+			}
+
+			service := NewRestaurantService(mockRepo, store)
 			err := service.Delete(t.Context(), testCase.actor, testCase.id)
 
 			if testCase.expectedError != "" {
@@ -593,6 +731,11 @@ func TestRestaurantService_Delete(t *testing.T) {
 			} else {
 				assert.NoError(t, err)
 			}
+			if testCase.logoDeleted {
+				assert.Empty(t, store.Keys())
+			} else {
+				assert.Len(t, store.Keys(), len(logoKeys))
+			}
 
 			mockRepo.AssertExpectations(t)
 		})
@@ -670,7 +813,7 @@ func TestRestaurantService_GetAll(t *testing.T) {
 				mockRepo.On("GetAllForUser", mock.Anything, testCase.actor.UserID).Return(testCase.expected, nil)
 			}
 
-			service := NewRestaurantService(mockRepo)
+			service := NewRestaurantService(mockRepo, blobs.NewMemoryStore(""))
 			result, err := service.GetAll(t.Context(), testCase.actor)
 
 			if testCase.expectedError != "" {
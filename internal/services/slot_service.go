@@ -0,0 +1,155 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Jiruu246/rms/internal/apperr"
+	"github.com/Jiruu246/rms/internal/dto"
+	"github.com/Jiruu246/rms/internal/repos"
+	"github.com/Jiruu246/rms/pkg/hours"
+	"github.com/google/uuid"
+)
+
+// maxScheduleAhead is how far in advance an order can be scheduled.
+const maxScheduleAhead = 14 * 24 * time.Hour
+
+type SlotService interface {
+	// GetSlots lists the restaurant's time slots on the local calendar date
+	// of date that an order can still be scheduled into, full ones included
+	// but marked unavailable. Inactive and closed restaurants have none.
+	GetSlots(ctx context.Context, restaurantID uuid.UUID, date time.Time) ([]*dto.TimeSlot, error)
+}
+
+type slotService struct {
+	restaurantRepo repos.RestaurantRepository
+	orderRepo      repos.OrderRepository
+}
+
+func NewSlotService(restaurantRepo repos.RestaurantRepository, orderRepo repos.OrderRepository) SlotService {
+	return &slotService{
+		restaurantRepo: restaurantRepo,
+		orderRepo:      orderRepo,
+	}
+}
+
+func (s *slotService) GetSlots(ctx context.Context, restaurantID uuid.UUID, date time.Time) ([]*dto.TimeSlot, error) {
+	restaurant, err := s.restaurantRepo.GetByID(ctx, restaurantID)
+	if err != nil {
+		return nil, err
+	}
+	if restaurant.Status != dto.RestaurantStatusActive {
+		return []*dto.TimeSlot{}, nil
+	}
+	slots, err := slotsOn(restaurant, date)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	earliest := now.Add(time.Duration(restaurant.KitchenLeadMinutes) * time.Minute)
+	latest := now.Add(maxScheduleAhead)
+	bookable := make([]hours.Span, 0, len(slots))
+	for _, slot := range slots {
+		if slot.End.After(earliest) && !slot.Start.After(latest) {
+			bookable = append(bookable, slot)
+		}
+	}
+	result := make([]*dto.TimeSlot, 0, len(bookable))
+	if len(bookable) == 0 {
+		return result, nil
+	}
+
+	booked, err := s.orderRepo.GetScheduledTimes(ctx, restaurantID, bookable[0].Start, bookable[len(bookable)-1].End)
+	if err != nil {
+		return nil, err
+	}
+	for _, slot := range bookable {
+		ts := &dto.TimeSlot{Start: slot.Start, End: slot.End, Available: true}
+		if restaurant.SlotCapacity > 0 {
+			remaining := restaurant.SlotCapacity
+			for _, t := range booked {
+				if slot.Contains(t) {
+					remaining--
+				}
+			}
+			remaining = max(remaining, 0)
+			ts.Remaining = &remaining
+			ts.Available = remaining > 0
+		}
+		result = append(result, ts)
+	}
+	return result, nil
+}
+
+// slotsOn cuts the restaurant's openings that start on the calendar date of
+// date, taken as a date in the restaurant's time zone, into slots of its
+// slot length.
+func slotsOn(restaurant *dto.RestaurantResponse, date time.Time) ([]hours.Span, error) {
+	if restaurant.OperatingHours == nil {
+		return nil, nil
+	}
+	loc, err := time.LoadLocation(restaurant.Timezone)
+	if err != nil {
+		return nil, fmt.Errorf("invalid restaurant timezone %q: %w", restaurant.Timezone, err)
+	}
+	spans, err := restaurant.OperatingHours.SpansOn(date, loc)
+	if err != nil {
+		return nil, fmt.Errorf("invalid operating hours: %w", err)
+	}
+	return hours.Slots(spans, time.Duration(restaurant.SlotMinutes)*time.Minute), nil
+}
+
+// findSlot returns the slot at falls in, looking at the openings of its
+// local date and, for ones running past midnight, the day before. It
+// returns nil when the restaurant is closed at that time.
+func findSlot(restaurant *dto.RestaurantResponse, at time.Time) (*hours.Span, error) {
+	loc, err := time.LoadLocation(restaurant.Timezone)
+	if err != nil {
+		return nil, fmt.Errorf("invalid restaurant timezone %q: %w", restaurant.Timezone, err)
+	}
+	local := at.In(loc)
+	for _, day := range []time.Time{local.AddDate(0, 0, -1), local} {
+		slots, err := slotsOn(restaurant, day)
+		if err != nil {
+			return nil, err
+		}
+		for _, slot := range slots {
+			if slot.Contains(at) {
+				return &slot, nil
+			}
+		}
+	}
+	return nil, nil
+}
+
+// scheduleOrder checks at is a time the restaurant takes scheduled orders
+// for: at least its kitchen lead time from now, at most maxScheduleAhead,
+// and within its opening hours. The order is released to the kitchen the
+// lead time before its slot starts.
+func scheduleOrder(restaurant *dto.RestaurantResponse, at, now time.Time) (*repos.OrderScheduleData, error) {
+	lead := time.Duration(restaurant.KitchenLeadMinutes) * time.Minute
+	if at.Before(now.Add(lead)) {
+		return nil, apperr.Invalid("scheduled_for must be at least %d minutes from now", restaurant.KitchenLeadMinutes)
+	}
+	if at.After(now.Add(maxScheduleAhead)) {
+		return nil, apperr.Invalid("orders can be scheduled at most %d days ahead", int(maxScheduleAhead/(24*time.Hour)))
+	}
+
+	slot, err := findSlot(restaurant, at)
+	if err != nil {
+		return nil, err
+	}
+	if slot == nil {
+		return nil, apperr.Invalid("restaurant %s is closed at %s", restaurant.ID, at.Format(time.RFC3339))
+	}
+
+	return &repos.OrderScheduleData{
+		For:          at,
+		ReleaseAt:    slot.Start.Add(-lead),
+		SlotStart:    slot.Start,
+		SlotEnd:      slot.End,
+		SlotCapacity: restaurant.SlotCapacity,
+	}, nil
+}
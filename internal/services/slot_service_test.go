@@ -0,0 +1,144 @@
+package services
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Jiruu246/rms/internal/apperr"
+	"github.com/Jiruu246/rms/internal/dto"
+	"github.com/Jiruu246/rms/pkg/hours"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func everyDay(open, close string) *hours.Schedule {
+	day := []hours.Interval{{Open: open, Close: close}}
+	return &hours.Schedule{Monday: day, Tuesday: day, Wednesday: day, Thursday: day, Friday: day, Saturday: day, Sunday: day}
+}
+
+func TestScheduleOrder(t *testing.T) {
+	restaurant := &dto.RestaurantResponse{
+		ID:       uuid.New(),
+		Timezone: "America/New_York",
+		OperatingHours: &hours.Schedule{
+			Friday: []hours.Interval{{Open: "11:00", Close: "14:00"}, {Open: "18:00", Close: "01:00"}},
+		},
+		SlotMinutes:        15,
+		SlotCapacity:       4,
+		KitchenLeadMinutes: 20,
+	}
+	loc, err := time.LoadLocation(restaurant.Timezone)
+	require.NoError(t, err)
+	// 2026-03-06 is a Friday.
+	now := time.Date(2026, 3, 6, 9, 0, 0, 0, loc)
+
+	testCases := []struct {
+		name          string
+		at            time.Time
+		slotStart     time.Time
+		expectedError error
+	}{
+		{name: "lunch", at: time.Date(2026, 3, 6, 12, 40, 0, 0, loc), slotStart: time.Date(2026, 3, 6, 12, 30, 0, 0, loc)},
+		{name: "after midnight", at: time.Date(2026, 3, 7, 0, 50, 0, 0, loc), slotStart: time.Date(2026, 3, 7, 0, 45, 0, 0, loc)},
+		{name: "between openings", at: time.Date(2026, 3, 6, 15, 0, 0, 0, loc), expectedError: apperr.ErrInvalid},
+		{name: "at closing", at: time.Date(2026, 3, 6, 14, 0, 0, 0, loc), expectedError: apperr.ErrInvalid},
+		{name: "closed day", at: time.Date(2026, 3, 7, 12, 0, 0, 0, loc), expectedError: apperr.ErrInvalid},
+		{name: "within lead time", at: now.Add(10 * time.Minute), expectedError: apperr.ErrInvalid},
+		{name: "in the past", at: now.Add(-time.Hour), expectedError: apperr.ErrInvalid},
+		{name: "too far ahead", at: time.Date(2026, 3, 27, 12, 0, 0, 0, loc), expectedError: apperr.ErrInvalid},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			schedule, err := scheduleOrder(restaurant, tc.at, now)
+
+			if tc.expectedError != nil {
+				assert.ErrorIs(t, err, tc.expectedError)
+				assert.Nil(t, schedule)
+				return
+			}
+			require.NoError(t, err)
+			assert.True(t, tc.at.Equal(schedule.For))
+			assert.True(t, tc.slotStart.Equal(schedule.SlotStart))
+			assert.Equal(t, 15*time.Minute, schedule.SlotEnd.Sub(schedule.SlotStart))
+			assert.True(t, tc.slotStart.Add(-20*time.Minute).Equal(schedule.ReleaseAt))
+			assert.Equal(t, 4, schedule.SlotCapacity)
+		})
+	}
+}
+
+func TestSlotService_GetSlots(t *testing.T) {
+	restaurant := &dto.RestaurantResponse{
+		ID:                 uuid.New(),
+		Status:             dto.RestaurantStatusActive,
+		Timezone:           "UTC",
+		OperatingHours:     everyDay("10:00", "12:00"),
+		SlotMinutes:        60,
+		SlotCapacity:       2,
+		KitchenLeadMinutes: 20,
+	}
+	date := time.Now().UTC().AddDate(0, 0, 2).Truncate(24 * time.Hour)
+	tenAM := date.Add(10 * time.Hour)
+
+	t.Run("counts bookings per slot", func(t *testing.T) {
+		restaurantRepo := new(MockRestaurantRepository)
+		restaurantRepo.On("GetByID", mock.Anything, restaurant.ID).Return(restaurant, nil)
+		orderRepo := new(MockOrderRepository)
+		orderRepo.On("GetScheduledTimes", mock.Anything, restaurant.ID, tenAM, tenAM.Add(2*time.Hour)).
+			Return([]time.Time{tenAM.Add(5 * time.Minute), tenAM.Add(55 * time.Minute), tenAM.Add(90 * time.Minute)}, nil)
+
+		slots, err := NewSlotService(restaurantRepo, orderRepo).GetSlots(t.Context(), restaurant.ID, date)
+
+		require.NoError(t, err)
+		require.Len(t, slots, 2)
+		assert.True(t, tenAM.Equal(slots[0].Start))
+		assert.Equal(t, 0, *slots[0].Remaining)
+		assert.False(t, slots[0].Available)
+		assert.Equal(t, 1, *slots[1].Remaining)
+		assert.True(t, slots[1].Available)
+	})
+
+	t.Run("unlimited", func(t *testing.T) {
+		unlimited := *restaurant
+		unlimited.SlotCapacity = 0
+		restaurantRepo := new(MockRestaurantRepository)
+		restaurantRepo.On("GetByID", mock.Anything, restaurant.ID).Return(&unlimited, nil)
+		orderRepo := new(MockOrderRepository)
+		orderRepo.On("GetScheduledTimes", mock.Anything, restaurant.ID, mock.Anything, mock.Anything).Return([]time.Time{tenAM}, nil)
+
+		slots, err := NewSlotService(restaurantRepo, orderRepo).GetSlots(t.Context(), restaurant.ID, date)
+
+		require.NoError(t, err)
+		require.Len(t, slots, 2)
+		assert.Nil(t, slots[0].Remaining)
+		assert.True(t, slots[0].Available)
+	})
+
+	t.Run("inactive", func(t *testing.T) {
+		inactive := *restaurant
+		inactive.Status = dto.RestaurantStatusInactive
+		restaurantRepo := new(MockRestaurantRepository)
+		restaurantRepo.On("GetByID", mock.Anything, restaurant.ID).Return(&inactive, nil)
+		orderRepo := new(MockOrderRepository)
+
+		slots, err := NewSlotService(restaurantRepo, orderRepo).GetSlots(t.Context(), restaurant.ID, date)
+
+		require.NoError(t, err)
+		assert.Empty(t, slots)
+		orderRepo.AssertNotCalled(t, "GetScheduledTimes", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("past date", func(t *testing.T) {
+		restaurantRepo := new(MockRestaurantRepository)
+		restaurantRepo.On("GetByID", mock.Anything, restaurant.ID).Return(restaurant, nil)
+		orderRepo := new(MockOrderRepository)
+
+		slots, err := NewSlotService(restaurantRepo, orderRepo).GetSlots(t.Context(), restaurant.ID, date.AddDate(0, 0, -5))
+
+		require.NoError(t, err)
+		assert.Empty(t, slots)
+		orderRepo.AssertNotCalled(t, "GetScheduledTimes", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+}
@@ -0,0 +1,178 @@
+package services
+
+import (
+	"context"
+
+	"github.com/Jiruu246/rms/internal/apperr"
+	"github.com/Jiruu246/rms/internal/authz"
+	"github.com/Jiruu246/rms/internal/dto"
+	"github.com/Jiruu246/rms/internal/repos"
+	"github.com/google/uuid"
+)
+
+const (
+	ActionCreateStation authz.Action = "station:create"
+	ActionReadStation   authz.Action = "station:read"
+	ActionUpdateStation authz.Action = "station:update"
+	ActionDeleteStation authz.Action = "station:delete"
+	ActionReadTicket    authz.Action = "ticket:read"
+	ActionUpdateTicket  authz.Action = "ticket:update"
+)
+
+// ticketStatusTransitions is the station ticket lifecycle: QUEUED ->
+// IN_PROGRESS -> READY, where a ticket can also be bumped straight from
+// QUEUED to READY. READY is terminal.
+var ticketStatusTransitions = map[dto.TicketStatus][]dto.TicketStatus{
+	dto.TicketStatusQUEUED:      {dto.TicketStatusIN_PROGRESS, dto.TicketStatusREADY},
+	dto.TicketStatusIN_PROGRESS: {dto.TicketStatusREADY},
+}
+
+func canTransitionTicketStatus(from, to dto.TicketStatus) bool {
+	for _, next := range ticketStatusTransitions[from] {
+		if next == to {
+			return true
+		}
+	}
+	return false
+}
+
+type StationService interface {
+	Create(ctx context.Context, actor authz.Actor, req *dto.CreateStationRequest) (*dto.Station, error)
+	GetByID(ctx context.Context, actor authz.Actor, id uuid.UUID) (*dto.Station, error)
+	GetAllByRestaurant(ctx context.Context, actor authz.Actor, restaurantID uuid.UUID) ([]*dto.Station, error)
+	Update(ctx context.Context, actor authz.Actor, id uuid.UUID, req *dto.UpdateStationRequest) (*dto.Station, error)
+	Delete(ctx context.Context, actor authz.Actor, id uuid.UUID) error
+	SetRouting(ctx context.Context, actor authz.Actor, id uuid.UUID, req *dto.SetStationRoutingRequest) (*dto.Station, error)
+	ListTickets(ctx context.Context, actor authz.Actor, stationID uuid.UUID, filters dto.StationTicketListFilters) ([]*dto.StationTicket, error)
+	UpdateTicketStatus(ctx context.Context, actor authz.Actor, ticketID uuid.UUID, req *dto.UpdateStationTicketRequest) (*dto.StationTicket, error)
+}
+
+type stationService struct {
+	repo              repos.StationRepository
+	ticketRepo        repos.StationTicketRepository
+	restaurantService RestaurantService
+	authorizer        authz.Authorizer
+}
+
+func NewStationService(repo repos.StationRepository, ticketRepo repos.StationTicketRepository, restaurantService RestaurantService) StationService {
+	return &stationService{
+		repo:              repo,
+		ticketRepo:        ticketRepo,
+		restaurantService: restaurantService,
+		authorizer:        authz.NewPolicyAuthorizer(),
+	}
+}
+
+func (s *stationService) Create(ctx context.Context, actor authz.Actor, req *dto.CreateStationRequest) (*dto.Station, error) {
+	if err := s.restaurantService.AuthorizeOwnership(ctx, actor, ActionCreateStation, req.RestaurantID); err != nil {
+		return nil, err
+	}
+
+	return s.repo.Create(ctx, req)
+}
+
+func (s *stationService) GetByID(ctx context.Context, actor authz.Actor, id uuid.UUID) (*dto.Station, error) {
+	resource, err := s.authorize(ctx, actor, ActionReadStation, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.repo.GetByID(ctx, resource.RestaurantID, id)
+}
+
+func (s *stationService) GetAllByRestaurant(ctx context.Context, actor authz.Actor, restaurantID uuid.UUID) ([]*dto.Station, error) {
+	if err := s.restaurantService.AuthorizeOwnership(ctx, actor, ActionReadStation, restaurantID); err != nil {
+		return nil, err
+	}
+
+	return s.repo.GetAllByRestaurant(ctx, restaurantID)
+}
+
+func (s *stationService) Update(ctx context.Context, actor authz.Actor, id uuid.UUID, req *dto.UpdateStationRequest) (*dto.Station, error) {
+	resource, err := s.authorize(ctx, actor, ActionUpdateStation, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.repo.Update(ctx, resource.RestaurantID, id, req)
+}
+
+func (s *stationService) Delete(ctx context.Context, actor authz.Actor, id uuid.UUID) error {
+	resource, err := s.authorize(ctx, actor, ActionDeleteStation, id)
+	if err != nil {
+		return err
+	}
+
+	return s.repo.Delete(ctx, resource.RestaurantID, id)
+}
+
+func (s *stationService) SetRouting(ctx context.Context, actor authz.Actor, id uuid.UUID, req *dto.SetStationRoutingRequest) (*dto.Station, error) {
+	resource, err := s.authorize(ctx, actor, ActionUpdateStation, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.repo.SetRouting(ctx, resource.RestaurantID, id, req)
+}
+
+func (s *stationService) ListTickets(ctx context.Context, actor authz.Actor, stationID uuid.UUID, filters dto.StationTicketListFilters) ([]*dto.StationTicket, error) {
+	resource, err := s.authorize(ctx, actor, ActionReadTicket, stationID)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.ticketRepo.ListByStation(ctx, resource.RestaurantID, stationID, filters)
+}
+
+// UpdateTicketStatus moves a ticket along ticketStatusTransitions, rejecting
+// anything else with apperr.Conflict. Marking the order's last open ticket
+// READY also makes the order READY; see StationTicketRepository.UpdateStatus.
+func (s *stationService) UpdateTicketStatus(ctx context.Context, actor authz.Actor, ticketID uuid.UUID, req *dto.UpdateStationTicketRequest) (*dto.StationTicket, error) {
+	resource, err := s.ticketRepo.GetAuthorizationResource(ctx, ticketID)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := s.authorizer.Authorize(ctx, authz.Request{
+		Actor:    actor,
+		Action:   ActionUpdateTicket,
+		Resource: resource,
+	}); err != nil {
+		return nil, err
+	}
+
+	current, err := s.ticketRepo.GetByID(ctx, resource.RestaurantID, ticketID)
+	if err != nil {
+		return nil, err
+	}
+
+	to := dto.TicketStatus(req.Status)
+	if !canTransitionTicketStatus(current.Status, to) {
+		return nil, apperr.Conflict("cannot change ticket status from %s to %s", current.Status, to)
+	}
+
+	return s.ticketRepo.UpdateStatus(ctx, &repos.UpdateTicketStatusData{
+		ID:           ticketID,
+		RestaurantID: resource.RestaurantID,
+		From:         current.Status,
+		To:           to,
+		ChangedBy:    actor.UserID,
+	})
+}
+
+// authorize resolves the station's authz.Resource and checks the actor may
+// perform action on it; tickets are governed by their station.
+func (s *stationService) authorize(ctx context.Context, actor authz.Actor, action authz.Action, id uuid.UUID) (authz.Resource, error) {
+	resource, err := s.repo.GetAuthorizationResource(ctx, id)
+	if err != nil {
+		return authz.Resource{}, err
+	}
+
+	if _, err := s.authorizer.Authorize(ctx, authz.Request{
+		Actor:    actor,
+		Action:   action,
+		Resource: resource,
+	}); err != nil {
+		return authz.Resource{}, err
+	}
+	return resource, nil
+}
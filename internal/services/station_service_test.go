@@ -0,0 +1,221 @@
+package services
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Jiruu246/rms/internal/apperr"
+	"github.com/Jiruu246/rms/internal/authz"
+	"github.com/Jiruu246/rms/internal/dto"
+	"github.com/Jiruu246/rms/internal/repos"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// MockStationRepository is a mock implementation of StationRepository
+type MockStationRepository struct {
+	mock.Mock
+}
+
+func (m *MockStationRepository) Create(ctx context.Context, req *dto.CreateStationRequest) (*dto.Station, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*dto.Station), args.Error(1)
+}
+
+func (m *MockStationRepository) GetByID(ctx context.Context, restaurantID, id uuid.UUID) (*dto.Station, error) {
+	args := m.Called(ctx, restaurantID, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*dto.Station), args.Error(1)
+}
+
+func (m *MockStationRepository) GetAllByRestaurant(ctx context.Context, restaurantID uuid.UUID) ([]*dto.Station, error) {
+	args := m.Called(ctx, restaurantID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*dto.Station), args.Error(1)
+}
+
+func (m *MockStationRepository) Update(ctx context.Context, restaurantID, id uuid.UUID, req *dto.UpdateStationRequest) (*dto.Station, error) {
+	args := m.Called(ctx, restaurantID, id, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*dto.Station), args.Error(1)
+}
+
+func (m *MockStationRepository) Delete(ctx context.Context, restaurantID, id uuid.UUID) error {
+	args := m.Called(ctx, restaurantID, id)
+	return args.Error(0)
+}
+
+func (m *MockStationRepository) SetRouting(ctx context.Context, restaurantID, id uuid.UUID, req *dto.SetStationRoutingRequest) (*dto.Station, error) {
+	args := m.Called(ctx, restaurantID, id, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*dto.Station), args.Error(1)
+}
+
+func (m *MockStationRepository) GetAuthorizationResource(ctx context.Context, id uuid.UUID) (authz.Resource, error) {
+	args := m.Called(ctx, id)
+	return args.Get(0).(authz.Resource), args.Error(1)
+}
+
+// MockStationTicketRepository is a mock implementation of StationTicketRepository
+type MockStationTicketRepository struct {
+	mock.Mock
+}
+
+func (m *MockStationTicketRepository) GetByID(ctx context.Context, restaurantID, id uuid.UUID) (*dto.StationTicket, error) {
+	args := m.Called(ctx, restaurantID, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*dto.StationTicket), args.Error(1)
+}
+
+func (m *MockStationTicketRepository) ListByStation(ctx context.Context, restaurantID, stationID uuid.UUID, filters dto.StationTicketListFilters) ([]*dto.StationTicket, error) {
+	args := m.Called(ctx, restaurantID, stationID, filters)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*dto.StationTicket), args.Error(1)
+}
+
+func (m *MockStationTicketRepository) UpdateStatus(ctx context.Context, data *repos.UpdateTicketStatusData) (*dto.StationTicket, error) {
+	args := m.Called(ctx, data)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*dto.StationTicket), args.Error(1)
+}
+
+func (m *MockStationTicketRepository) GetAuthorizationResource(ctx context.Context, id uuid.UUID) (authz.Resource, error) {
+	args := m.Called(ctx, id)
+	return args.Get(0).(authz.Resource), args.Error(1)
+}
+
+func TestCanTransitionTicketStatus(t *testing.T) {
+	testCases := []struct {
+		from     dto.TicketStatus
+		to       dto.TicketStatus
+		expected bool
+	}{
+		{dto.TicketStatusQUEUED, dto.TicketStatusIN_PROGRESS, true},
+		{dto.TicketStatusQUEUED, dto.TicketStatusREADY, true},
+		{dto.TicketStatusQUEUED, dto.TicketStatusQUEUED, false},
+		{dto.TicketStatusIN_PROGRESS, dto.TicketStatusREADY, true},
+		{dto.TicketStatusIN_PROGRESS, dto.TicketStatusQUEUED, false},
+		{dto.TicketStatusREADY, dto.TicketStatusIN_PROGRESS, false},
+		{dto.TicketStatusREADY, dto.TicketStatusQUEUED, false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(string(tc.from)+"->"+string(tc.to), func(t *testing.T) {
+			assert.Equal(t, tc.expected, canTransitionTicketStatus(tc.from, tc.to))
+		})
+	}
+}
+
+func TestStationService_Create(t *testing.T) {
+	restaurantID := uuid.New()
+	req := &dto.CreateStationRequest{Name: "Grill", RestaurantID: restaurantID}
+
+	t.Run("owner", func(t *testing.T) {
+		repo := new(MockStationRepository)
+		restaurantService := new(MockRestaurantService)
+		restaurantService.On("AuthorizeOwnership", mock.Anything, adminActor, ActionCreateStation, restaurantID).Return(nil)
+		repo.On("Create", mock.Anything, req).Return(&dto.Station{ID: uuid.New(), Name: "Grill", RestaurantID: restaurantID}, nil)
+
+		station, err := NewStationService(repo, new(MockStationTicketRepository), restaurantService).Create(t.Context(), adminActor, req)
+
+		require.NoError(t, err)
+		assert.Equal(t, "Grill", station.Name)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("forbidden", func(t *testing.T) {
+		repo := new(MockStationRepository)
+		restaurantService := new(MockRestaurantService)
+		restaurantService.On("AuthorizeOwnership", mock.Anything, adminActor, ActionCreateStation, restaurantID).Return(apperr.ErrForbidden)
+
+		station, err := NewStationService(repo, new(MockStationTicketRepository), restaurantService).Create(t.Context(), adminActor, req)
+
+		assert.ErrorIs(t, err, apperr.ErrForbidden)
+		assert.Nil(t, station)
+		repo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+	})
+}
+
+func TestStationService_UpdateTicketStatus(t *testing.T) {
+	restaurantID := uuid.New()
+	ticketID := uuid.New()
+	resource := authz.Resource{Type: "station_ticket", ID: ticketID, RestaurantID: restaurantID, OwnerUserID: uuid.New()}
+
+	testCases := []struct {
+		name          string
+		current       dto.TicketStatus
+		requested     dto.TicketStatus
+		expectedError error
+	}{
+		{name: "start", current: dto.TicketStatusQUEUED, requested: dto.TicketStatusIN_PROGRESS},
+		{name: "bump", current: dto.TicketStatusIN_PROGRESS, requested: dto.TicketStatusREADY},
+		{name: "reopen ready ticket", current: dto.TicketStatusREADY, requested: dto.TicketStatusIN_PROGRESS, expectedError: apperr.ErrConflict},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ticketRepo := new(MockStationTicketRepository)
+			ticketRepo.On("GetAuthorizationResource", mock.Anything, ticketID).Return(resource, nil)
+			ticketRepo.On("GetByID", mock.Anything, restaurantID, ticketID).Return(&dto.StationTicket{ID: ticketID, Status: tc.current}, nil)
+			if tc.expectedError == nil {
+				ticketRepo.On("UpdateStatus", mock.Anything, &repos.UpdateTicketStatusData{
+					ID:           ticketID,
+					RestaurantID: restaurantID,
+					From:         tc.current,
+					To:           tc.requested,
+					ChangedBy:    adminActor.UserID,
+				}).Return(&dto.StationTicket{ID: ticketID, Status: tc.requested}, nil)
+			}
+
+			svc := NewStationService(new(MockStationRepository), ticketRepo, new(MockRestaurantService))
+			ticket, err := svc.UpdateTicketStatus(t.Context(), adminActor, ticketID, &dto.UpdateStationTicketRequest{Status: string(tc.requested)})
+
+			if tc.expectedError != nil {
+				assert.ErrorIs(t, err, tc.expectedError)
+				assert.Nil(t, ticket)
+				ticketRepo.AssertNotCalled(t, "UpdateStatus", mock.Anything, mock.Anything)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.requested, ticket.Status)
+			ticketRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestStationService_UpdateTicketStatus_Forbidden(t *testing.T) {
+	ticketID := uuid.New()
+	ticketRepo := new(MockStationTicketRepository)
+	ticketRepo.On("GetAuthorizationResource", mock.Anything, ticketID).Return(authz.Resource{
+		Type:         "station_ticket",
+		ID:           ticketID,
+		RestaurantID: uuid.New(),
+		OwnerUserID:  uuid.New(),
+	}, nil)
+
+	svc := NewStationService(new(MockStationRepository), ticketRepo, new(MockRestaurantService))
+	ticket, err := svc.UpdateTicketStatus(t.Context(), authz.Actor{UserID: uuid.New()}, ticketID, &dto.UpdateStationTicketRequest{Status: string(dto.TicketStatusREADY)})
+
+	assert.ErrorIs(t, err, apperr.ErrForbidden)
+	assert.Nil(t, ticket)
+	ticketRepo.AssertNotCalled(t, "GetByID", mock.Anything, mock.Anything, mock.Anything)
+}
@@ -0,0 +1,185 @@
+package services
+
+import (
+	"context"
+
+	"github.com/Jiruu246/rms/internal/apperr"
+	"github.com/Jiruu246/rms/internal/authz"
+	"github.com/Jiruu246/rms/internal/dto"
+	"github.com/Jiruu246/rms/internal/repos"
+	"github.com/google/uuid"
+)
+
+const (
+	ActionCreateTable       authz.Action = "table:create"
+	ActionReadTable         authz.Action = "table:read"
+	ActionUpdateTable       authz.Action = "table:update"
+	ActionDeleteTable       authz.Action = "table:delete"
+	ActionReadTableSession  authz.Action = "table_session:read"
+	ActionCloseTableSession authz.Action = "table_session:close"
+)
+
+type TableService interface {
+	Create(ctx context.Context, actor authz.Actor, req *dto.CreateTableRequest) (*dto.Table, error)
+	GetByID(ctx context.Context, actor authz.Actor, id uuid.UUID) (*dto.Table, error)
+	GetAllByRestaurant(ctx context.Context, actor authz.Actor, restaurantID uuid.UUID) ([]*dto.Table, error)
+	Update(ctx context.Context, actor authz.Actor, id uuid.UUID, req *dto.UpdateTableRequest) (*dto.Table, error)
+	Delete(ctx context.Context, actor authz.Actor, id uuid.UUID) error
+	RotateQRToken(ctx context.Context, actor authz.Actor, id uuid.UUID) (*dto.Table, error)
+	// GetByQRToken is the unauthenticated lookup behind a scanned QR code.
+	// Inactive tables are not found.
+	GetByQRToken(ctx context.Context, token string) (*dto.PublicTable, error)
+	GetOpenSession(ctx context.Context, actor authz.Actor, tableID uuid.UUID) (*dto.TableSession, error)
+	GetSession(ctx context.Context, actor authz.Actor, id uuid.UUID) (*dto.TableSession, error)
+	CloseSession(ctx context.Context, actor authz.Actor, id uuid.UUID) (*dto.TableSession, error)
+}
+
+type tableService struct {
+	repo              repos.TableRepository
+	sessionRepo       repos.TableSessionRepository
+	restaurantService RestaurantService
+	authorizer        authz.Authorizer
+}
+
+func NewTableService(repo repos.TableRepository, sessionRepo repos.TableSessionRepository, restaurantService RestaurantService) TableService {
+	return &tableService{
+		repo:              repo,
+		sessionRepo:       sessionRepo,
+		restaurantService: restaurantService,
+		authorizer:        authz.NewPolicyAuthorizer(),
+	}
+}
+
+func (s *tableService) Create(ctx context.Context, actor authz.Actor, req *dto.CreateTableRequest) (*dto.Table, error) {
+	if err := s.restaurantService.AuthorizeOwnership(ctx, actor, ActionCreateTable, req.RestaurantID); err != nil {
+		return nil, err
+	}
+
+	return s.repo.Create(ctx, req)
+}
+
+func (s *tableService) GetByID(ctx context.Context, actor authz.Actor, id uuid.UUID) (*dto.Table, error) {
+	resource, err := s.authorize(ctx, actor, ActionReadTable, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.repo.GetByID(ctx, resource.RestaurantID, id)
+}
+
+func (s *tableService) GetAllByRestaurant(ctx context.Context, actor authz.Actor, restaurantID uuid.UUID) ([]*dto.Table, error) {
+	if err := s.restaurantService.AuthorizeOwnership(ctx, actor, ActionReadTable, restaurantID); err != nil {
+		return nil, err
+	}
+
+	return s.repo.GetAllByRestaurant(ctx, restaurantID)
+}
+
+func (s *tableService) Update(ctx context.Context, actor authz.Actor, id uuid.UUID, req *dto.UpdateTableRequest) (*dto.Table, error) {
+	resource, err := s.authorize(ctx, actor, ActionUpdateTable, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.repo.Update(ctx, resource.RestaurantID, id, req)
+}
+
+func (s *tableService) Delete(ctx context.Context, actor authz.Actor, id uuid.UUID) error {
+	resource, err := s.authorize(ctx, actor, ActionDeleteTable, id)
+	if err != nil {
+		return err
+	}
+
+	return s.repo.Delete(ctx, resource.RestaurantID, id)
+}
+
+func (s *tableService) RotateQRToken(ctx context.Context, actor authz.Actor, id uuid.UUID) (*dto.Table, error) {
+	resource, err := s.authorize(ctx, actor, ActionUpdateTable, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.repo.RotateQRToken(ctx, resource.RestaurantID, id)
+}
+
+func (s *tableService) GetByQRToken(ctx context.Context, token string) (*dto.PublicTable, error) {
+	table, err := s.repo.GetByQRToken(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+	if !table.IsActive {
+		return nil, apperr.NotFound("table for token")
+	}
+
+	return &dto.PublicTable{
+		ID:           table.ID,
+		Name:         table.Name,
+		Section:      table.Section,
+		RestaurantID: table.RestaurantID,
+	}, nil
+}
+
+func (s *tableService) GetOpenSession(ctx context.Context, actor authz.Actor, tableID uuid.UUID) (*dto.TableSession, error) {
+	resource, err := s.authorize(ctx, actor, ActionReadTableSession, tableID)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.sessionRepo.GetOpenByTable(ctx, resource.RestaurantID, tableID)
+}
+
+func (s *tableService) GetSession(ctx context.Context, actor authz.Actor, id uuid.UUID) (*dto.TableSession, error) {
+	resource, err := s.authorizeSession(ctx, actor, ActionReadTableSession, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.sessionRepo.GetByID(ctx, resource.RestaurantID, id)
+}
+
+// CloseSession closes an OPEN session once all of its orders are paid;
+// otherwise it fails with apperr.Conflict. The next order at the table
+// opens a new session.
+func (s *tableService) CloseSession(ctx context.Context, actor authz.Actor, id uuid.UUID) (*dto.TableSession, error) {
+	resource, err := s.authorizeSession(ctx, actor, ActionCloseTableSession, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.sessionRepo.Close(ctx, resource.RestaurantID, id, actor.UserID)
+}
+
+// authorize resolves the table's authz.Resource and checks the actor may
+// perform action on it.
+func (s *tableService) authorize(ctx context.Context, actor authz.Actor, action authz.Action, id uuid.UUID) (authz.Resource, error) {
+	resource, err := s.repo.GetAuthorizationResource(ctx, id)
+	if err != nil {
+		return authz.Resource{}, err
+	}
+
+	if err := s.check(ctx, actor, action, resource); err != nil {
+		return authz.Resource{}, err
+	}
+	return resource, nil
+}
+
+func (s *tableService) authorizeSession(ctx context.Context, actor authz.Actor, action authz.Action, id uuid.UUID) (authz.Resource, error) {
+	resource, err := s.sessionRepo.GetAuthorizationResource(ctx, id)
+	if err != nil {
+		return authz.Resource{}, err
+	}
+
+	if err := s.check(ctx, actor, action, resource); err != nil {
+		return authz.Resource{}, err
+	}
+	return resource, nil
+}
+
+func (s *tableService) check(ctx context.Context, actor authz.Actor, action authz.Action, resource authz.Resource) error {
+	_, err := s.authorizer.Authorize(ctx, authz.Request{
+		Actor:    actor,
+		Action:   action,
+		Resource: resource,
+	})
+	return err
+}
@@ -0,0 +1,197 @@
+package services
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Jiruu246/rms/internal/apperr"
+	"github.com/Jiruu246/rms/internal/authz"
+	"github.com/Jiruu246/rms/internal/dto"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// MockTableRepository is a mock implementation of TableRepository
+type MockTableRepository struct {
+	mock.Mock
+}
+
+func (m *MockTableRepository) Create(ctx context.Context, req *dto.CreateTableRequest) (*dto.Table, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*dto.Table), args.Error(1)
+}
+
+func (m *MockTableRepository) GetByID(ctx context.Context, restaurantID, id uuid.UUID) (*dto.Table, error) {
+	args := m.Called(ctx, restaurantID, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*dto.Table), args.Error(1)
+}
+
+func (m *MockTableRepository) GetByQRToken(ctx context.Context, token string) (*dto.Table, error) {
+	args := m.Called(ctx, token)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*dto.Table), args.Error(1)
+}
+
+func (m *MockTableRepository) GetAllByRestaurant(ctx context.Context, restaurantID uuid.UUID) ([]*dto.Table, error) {
+	args := m.Called(ctx, restaurantID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*dto.Table), args.Error(1)
+}
+
+func (m *MockTableRepository) Update(ctx context.Context, restaurantID, id uuid.UUID, req *dto.UpdateTableRequest) (*dto.Table, error) {
+	args := m.Called(ctx, restaurantID, id, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*dto.Table), args.Error(1)
+}
+
+func (m *MockTableRepository) Delete(ctx context.Context, restaurantID, id uuid.UUID) error {
+	args := m.Called(ctx, restaurantID, id)
+	return args.Error(0)
+}
+
+func (m *MockTableRepository) RotateQRToken(ctx context.Context, restaurantID, id uuid.UUID) (*dto.Table, error) {
+	args := m.Called(ctx, restaurantID, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*dto.Table), args.Error(1)
+}
+
+func (m *MockTableRepository) GetAuthorizationResource(ctx context.Context, id uuid.UUID) (authz.Resource, error) {
+	args := m.Called(ctx, id)
+	return args.Get(0).(authz.Resource), args.Error(1)
+}
+
+// MockTableSessionRepository is a mock implementation of TableSessionRepository
+type MockTableSessionRepository struct {
+	mock.Mock
+}
+
+func (m *MockTableSessionRepository) GetByID(ctx context.Context, restaurantID, id uuid.UUID) (*dto.TableSession, error) {
+	args := m.Called(ctx, restaurantID, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*dto.TableSession), args.Error(1)
+}
+
+func (m *MockTableSessionRepository) GetOpenByTable(ctx context.Context, restaurantID, tableID uuid.UUID) (*dto.TableSession, error) {
+	args := m.Called(ctx, restaurantID, tableID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*dto.TableSession), args.Error(1)
+}
+
+func (m *MockTableSessionRepository) Close(ctx context.Context, restaurantID, id, closedBy uuid.UUID) (*dto.TableSession, error) {
+	args := m.Called(ctx, restaurantID, id, closedBy)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*dto.TableSession), args.Error(1)
+}
+
+func (m *MockTableSessionRepository) GetAuthorizationResource(ctx context.Context, id uuid.UUID) (authz.Resource, error) {
+	args := m.Called(ctx, id)
+	return args.Get(0).(authz.Resource), args.Error(1)
+}
+
+func TestTableService_Create(t *testing.T) {
+	restaurantID := uuid.New()
+	req := &dto.CreateTableRequest{Name: "T1", Seats: 4, RestaurantID: restaurantID}
+
+	t.Run("owner", func(t *testing.T) {
+		repo := new(MockTableRepository)
+		restaurantService := new(MockRestaurantService)
+		restaurantService.On("AuthorizeOwnership", mock.Anything, adminActor, ActionCreateTable, restaurantID).Return(nil)
+		repo.On("Create", mock.Anything, req).Return(&dto.Table{ID: uuid.New(), Name: "T1", RestaurantID: restaurantID}, nil)
+
+		table, err := NewTableService(repo, new(MockTableSessionRepository), restaurantService).Create(t.Context(), adminActor, req)
+
+		require.NoError(t, err)
+		assert.Equal(t, "T1", table.Name)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("forbidden", func(t *testing.T) {
+		repo := new(MockTableRepository)
+		restaurantService := new(MockRestaurantService)
+		restaurantService.On("AuthorizeOwnership", mock.Anything, adminActor, ActionCreateTable, restaurantID).Return(apperr.ErrForbidden)
+
+		table, err := NewTableService(repo, new(MockTableSessionRepository), restaurantService).Create(t.Context(), adminActor, req)
+
+		assert.ErrorIs(t, err, apperr.ErrForbidden)
+		assert.Nil(t, table)
+		repo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+	})
+}
+
+func TestTableService_GetByQRToken(t *testing.T) {
+	restaurantID := uuid.New()
+
+	t.Run("active", func(t *testing.T) {
+		repo := new(MockTableRepository)
+		repo.On("GetByQRToken", mock.Anything, "tok").Return(&dto.Table{ID: uuid.New(), Name: "T1", IsActive: true, QRToken: "tok", RestaurantID: restaurantID}, nil)
+
+		table, err := NewTableService(repo, new(MockTableSessionRepository), new(MockRestaurantService)).GetByQRToken(t.Context(), "tok")
+
+		require.NoError(t, err)
+		assert.Equal(t, "T1", table.Name)
+		assert.Equal(t, restaurantID, table.RestaurantID)
+	})
+
+	t.Run("inactive", func(t *testing.T) {
+		repo := new(MockTableRepository)
+		repo.On("GetByQRToken", mock.Anything, "tok").Return(&dto.Table{ID: uuid.New(), Name: "T1", IsActive: false, RestaurantID: restaurantID}, nil)
+
+		table, err := NewTableService(repo, new(MockTableSessionRepository), new(MockRestaurantService)).GetByQRToken(t.Context(), "tok")
+
+		assert.ErrorIs(t, err, apperr.ErrNotFound)
+		assert.Nil(t, table)
+	})
+}
+
+func TestTableService_CloseSession(t *testing.T) {
+	restaurantID := uuid.New()
+	sessionID := uuid.New()
+	owner := authz.Actor{UserID: uuid.New()}
+	resource := authz.Resource{Type: "table_session", ID: sessionID, RestaurantID: restaurantID, OwnerUserID: owner.UserID}
+
+	t.Run("owner", func(t *testing.T) {
+		sessionRepo := new(MockTableSessionRepository)
+		sessionRepo.On("GetAuthorizationResource", mock.Anything, sessionID).Return(resource, nil)
+		sessionRepo.On("Close", mock.Anything, restaurantID, sessionID, owner.UserID).
+			Return(&dto.TableSession{ID: sessionID, Status: dto.TableSessionStatusCLOSED}, nil)
+
+		session, err := NewTableService(new(MockTableRepository), sessionRepo, new(MockRestaurantService)).CloseSession(t.Context(), owner, sessionID)
+
+		require.NoError(t, err)
+		assert.Equal(t, dto.TableSessionStatusCLOSED, session.Status)
+		sessionRepo.AssertExpectations(t)
+	})
+
+	t.Run("forbidden", func(t *testing.T) {
+		sessionRepo := new(MockTableSessionRepository)
+		sessionRepo.On("GetAuthorizationResource", mock.Anything, sessionID).Return(resource, nil)
+
+		session, err := NewTableService(new(MockTableRepository), sessionRepo, new(MockRestaurantService)).CloseSession(t.Context(), authz.Actor{UserID: uuid.New()}, sessionID)
+
+		assert.ErrorIs(t, err, apperr.ErrForbidden)
+		assert.Nil(t, session)
+		sessionRepo.AssertNotCalled(t, "Close", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+}
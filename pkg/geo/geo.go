@@ -0,0 +1,56 @@
+// Package geo has the small amount of geometry needed for delivery zones:
+// great-circle distances and point-in-polygon tests on WGS84 coordinates.
+package geo
+
+import "math"
+
+// earthRadiusMeters is the mean Earth radius used by DistanceMeters.
+const earthRadiusMeters = 6371008.8
+
+// Point is a WGS84 coordinate in decimal degrees.
+type Point struct {
+	Lat float64 `json:"lat" validate:"min=-90,max=90"`
+	Lng float64 `json:"lng" validate:"min=-180,max=180"`
+}
+
+// DistanceMeters returns the great-circle (haversine) distance between a
+// and b.
+func DistanceMeters(a, b Point) float64 {
+	lat1, lat2 := radians(a.Lat), radians(b.Lat)
+	dLat := lat2 - lat1
+	dLng := radians(b.Lng - a.Lng)
+
+	h := math.Sin(dLat/2)*math.Sin(dLat/2) +
+		math.Cos(lat1)*math.Cos(lat2)*math.Sin(dLng/2)*math.Sin(dLng/2)
+	return 2 * earthRadiusMeters * math.Asin(math.Min(1, math.Sqrt(h)))
+}
+
+// WithinRadius reports whether p is at most radiusMeters from center.
+func WithinRadius(center, p Point, radiusMeters float64) bool {
+	return DistanceMeters(center, p) <= radiusMeters
+}
+
+// PolygonContains reports whether p lies inside polygon, given as its
+// vertices in order (the closing edge back to the first vertex is implied).
+// Coordinates are treated as planar, which is accurate enough for
+// city-sized zones that don't cross the antimeridian. Polygons with fewer
+// than three vertices contain nothing.
+func PolygonContains(polygon []Point, p Point) bool {
+	if len(polygon) < 3 {
+		return false
+	}
+
+	inside := false
+	for i, j := 0, len(polygon)-1; i < len(polygon); j, i = i, i+1 {
+		a, b := polygon[i], polygon[j]
+		if (a.Lat > p.Lat) != (b.Lat > p.Lat) &&
+			p.Lng < (b.Lng-a.Lng)*(p.Lat-a.Lat)/(b.Lat-a.Lat)+a.Lng {
+			inside = !inside
+		}
+	}
+	return inside
+}
+
+func radians(deg float64) float64 {
+	return deg * math.Pi / 180
+}
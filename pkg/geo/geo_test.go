@@ -0,0 +1,63 @@
+package geo
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDistanceMeters(t *testing.T) {
+	paris := Point{Lat: 48.8566, Lng: 2.3522}
+	london := Point{Lat: 51.5074, Lng: -0.1278}
+
+	assert.InDelta(t, 343_500, DistanceMeters(paris, london), 1_000)
+	assert.InDelta(t, DistanceMeters(paris, london), DistanceMeters(london, paris), 1e-6)
+	assert.Zero(t, DistanceMeters(paris, paris))
+}
+
+func TestWithinRadius(t *testing.T) {
+	center := Point{Lat: 40.7128, Lng: -74.0060}
+	// Roughly 1.1 km north of center.
+	near := Point{Lat: 40.7228, Lng: -74.0060}
+
+	assert.True(t, WithinRadius(center, near, 1_200))
+	assert.False(t, WithinRadius(center, near, 1_000))
+}
+
+func TestPolygonContains(t *testing.T) {
+	square := []Point{
+		{Lat: 0, Lng: 0},
+		{Lat: 0, Lng: 10},
+		{Lat: 10, Lng: 10},
+		{Lat: 10, Lng: 0},
+	}
+	// An L shape: the square minus its top-right quarter.
+	concave := []Point{
+		{Lat: 0, Lng: 0},
+		{Lat: 0, Lng: 10},
+		{Lat: 5, Lng: 10},
+		{Lat: 5, Lng: 5},
+		{Lat: 10, Lng: 5},
+		{Lat: 10, Lng: 0},
+	}
+
+	testCases := []struct {
+		name     string
+		polygon  []Point
+		point    Point
+		expected bool
+	}{
+		{"inside", square, Point{Lat: 5, Lng: 5}, true},
+		{"outside", square, Point{Lat: 11, Lng: 5}, false},
+		{"left of", square, Point{Lat: 5, Lng: -1}, false},
+		{"concave inside", concave, Point{Lat: 2, Lng: 8}, true},
+		{"concave notch", concave, Point{Lat: 8, Lng: 8}, false},
+		{"degenerate", square[:2], Point{Lat: 0, Lng: 5}, false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, PolygonContains(tc.polygon, tc.point))
+		})
+	}
+}
@@ -0,0 +1,285 @@
+// Package hours models a restaurant's weekly operating hours, with dated
+// exceptions for holidays, and turns them into concrete opening spans and
+// fixed-length time slots in the restaurant's time zone.
+package hours
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+)
+
+// lookahead bounds how far ahead NextOpening and OpenSpan look for an
+// opening or closing.
+const lookahead = 366
+
+// Interval is one opening on a day, as local "HH:MM" times. A Close before
+// Open means the interval runs past midnight into the next day; "24:00"
+// closes at midnight.
+type Interval struct {
+	Open  string `json:"open" validate:"required,datetime=15:04"`
+	Close string `json:"close" validate:"required,datetime=15:04|eq=24:00"`
+}
+
+// Exception replaces the weekly hours on one local date, e.g. a holiday.
+// Without intervals the restaurant is closed all day.
+type Exception struct {
+	Date      string     `json:"date" validate:"required,datetime=2006-01-02"`
+	Intervals []Interval `json:"intervals" validate:"max=8,dive"`
+	Note      string     `json:"note,omitempty" validate:"max=255"`
+}
+
+// Schedule is the regular weekly opening hours plus dated exceptions. A
+// weekday without intervals is closed all day.
+type Schedule struct {
+	Monday     []Interval  `json:"monday,omitempty" validate:"max=8,dive"`
+	Tuesday    []Interval  `json:"tuesday,omitempty" validate:"max=8,dive"`
+	Wednesday  []Interval  `json:"wednesday,omitempty" validate:"max=8,dive"`
+	Thursday   []Interval  `json:"thursday,omitempty" validate:"max=8,dive"`
+	Friday     []Interval  `json:"friday,omitempty" validate:"max=8,dive"`
+	Saturday   []Interval  `json:"saturday,omitempty" validate:"max=8,dive"`
+	Sunday     []Interval  `json:"sunday,omitempty" validate:"max=8,dive"`
+	Exceptions []Exception `json:"exceptions,omitempty" validate:"max=366,dive"`
+}
+
+// Span is a concrete, absolute opening: [Start, End).
+type Span struct {
+	Start time.Time `json:"start"`
+	End   time.Time `json:"end"`
+}
+
+// Contains reports whether t falls in [Start, End).
+func (s Span) Contains(t time.Time) bool {
+	return !t.Before(s.Start) && t.Before(s.End)
+}
+
+// Day returns the weekly intervals of weekday wd.
+func (s Schedule) Day(wd time.Weekday) []Interval {
+	switch wd {
+	case time.Monday:
+		return s.Monday
+	case time.Tuesday:
+		return s.Tuesday
+	case time.Wednesday:
+		return s.Wednesday
+	case time.Thursday:
+		return s.Thursday
+	case time.Friday:
+		return s.Friday
+	case time.Saturday:
+		return s.Saturday
+	default:
+		return s.Sunday
+	}
+}
+
+// IsEmpty reports whether the weekly hours have no openings at all, in
+// which case the schedule does not describe when the restaurant is open.
+func (s Schedule) IsEmpty() bool {
+	for wd := time.Sunday; wd <= time.Saturday; wd++ {
+		if len(s.Day(wd)) > 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// Validate checks what struct tags cannot: that intervals are well formed,
+// that a day's intervals do not overlap, and that no date has two
+// exceptions.
+func (s Schedule) Validate() error {
+	for wd := time.Sunday; wd <= time.Saturday; wd++ {
+		if err := validateDay(s.Day(wd)); err != nil {
+			return fmt.Errorf("%s: %w", wd, err)
+		}
+	}
+	seen := make(map[string]bool, len(s.Exceptions))
+	for _, ex := range s.Exceptions {
+		if _, err := time.Parse(time.DateOnly, ex.Date); err != nil {
+			return fmt.Errorf("exception date %q: want YYYY-MM-DD", ex.Date)
+		}
+		if seen[ex.Date] {
+			return fmt.Errorf("more than one exception for %s", ex.Date)
+		}
+		seen[ex.Date] = true
+		if err := validateDay(ex.Intervals); err != nil {
+			return fmt.Errorf("%s: %w", ex.Date, err)
+		}
+	}
+	return nil
+}
+
+func validateDay(intervals []Interval) error {
+	type minutes struct{ open, close int }
+	ranges := make([]minutes, 0, len(intervals))
+	for _, iv := range intervals {
+		open, closing, err := iv.minutes()
+		if err != nil {
+			return err
+		}
+		ranges = append(ranges, minutes{open, closing})
+	}
+	slices.SortFunc(ranges, func(a, b minutes) int { return a.open - b.open })
+	for i := 1; i < len(ranges); i++ {
+		if ranges[i].open < ranges[i-1].close {
+			return errors.New("intervals overlap")
+		}
+	}
+	return nil
+}
+
+// intervalsOn returns the intervals of the calendar date y-m-d: its
+// exception's if it has one, otherwise its weekday's.
+func (s Schedule) intervalsOn(y int, m time.Month, d int) []Interval {
+	date := fmt.Sprintf("%04d-%02d-%02d", y, m, d)
+	for _, ex := range s.Exceptions {
+		if ex.Date == date {
+			return ex.Intervals
+		}
+	}
+	return s.Day(time.Date(y, m, d, 12, 0, 0, 0, time.UTC).Weekday())
+}
+
+// SpansOn returns the openings that start on the calendar date of day,
+// taken as a date in loc, in the order they are listed. Overnight
+// intervals end on the following date.
+func (s Schedule) SpansOn(day time.Time, loc *time.Location) ([]Span, error) {
+	y, m, d := day.Date()
+	var spans []Span
+	for _, iv := range s.intervalsOn(y, m, d) {
+		open, closing, err := iv.minutes()
+		if err != nil {
+			return nil, err
+		}
+		spans = append(spans, Span{
+			Start: time.Date(y, m, d, 0, open, 0, 0, loc),
+			End:   time.Date(y, m, d, 0, closing, 0, 0, loc),
+		})
+	}
+	return spans, nil
+}
+
+// Spans returns the openings overlapping [from, to), sorted, with
+// overlapping and back-to-back openings (an overnight interval running
+// into the next day's first one, say) merged into one.
+func (s Schedule) Spans(from, to time.Time, loc *time.Location) ([]Span, error) {
+	var all []Span
+	// Start a day early for intervals running past midnight into from.
+	y, m, d := from.In(loc).Date()
+	for day := d - 1; time.Date(y, m, day, 0, 0, 0, 0, loc).Before(to); day++ {
+		spans, err := s.SpansOn(time.Date(y, m, day, 12, 0, 0, 0, loc), loc)
+		if err != nil {
+			return nil, err
+		}
+		all = append(all, spans...)
+	}
+	slices.SortFunc(all, func(a, b Span) int { return a.Start.Compare(b.Start) })
+
+	var merged []Span
+	for _, span := range all {
+		if n := len(merged); n > 0 && !span.Start.After(merged[n-1].End) {
+			if span.End.After(merged[n-1].End) {
+				merged[n-1].End = span.End
+			}
+			continue
+		}
+		merged = append(merged, span)
+	}
+
+	result := merged[:0]
+	for _, span := range merged {
+		if span.End.After(from) && span.Start.Before(to) {
+			result = append(result, span)
+		}
+	}
+	return result, nil
+}
+
+// OpenSpan returns the opening t falls in, or false when closed at t. If
+// the restaurant does not close within a year of t, End is left zero.
+func (s Schedule) OpenSpan(t time.Time, loc *time.Location) (Span, bool, error) {
+	until := t.AddDate(0, 0, lookahead)
+	spans, err := s.Spans(t, until, loc)
+	if err != nil {
+		return Span{}, false, err
+	}
+	if len(spans) == 0 || !spans[0].Contains(t) {
+		return Span{}, false, nil
+	}
+	span := spans[0]
+	if !span.End.Before(until) {
+		span.End = time.Time{}
+	}
+	return span, true, nil
+}
+
+// NextOpening returns when the restaurant next opens after t, looking up
+// to a year ahead. An opening t already falls in does not count.
+func (s Schedule) NextOpening(t time.Time, loc *time.Location) (time.Time, bool, error) {
+	spans, err := s.Spans(t, t.AddDate(0, 0, lookahead), loc)
+	if err != nil {
+		return time.Time{}, false, err
+	}
+	for _, span := range spans {
+		if span.Start.After(t) {
+			return span.Start, true, nil
+		}
+	}
+	return time.Time{}, false, nil
+}
+
+// Slots cuts spans into consecutive slots of length, each starting at its
+// span's opening or where the previous slot ended. The last slot of a span
+// is cut short at closing.
+func Slots(spans []Span, length time.Duration) []Span {
+	var slots []Span
+	if length <= 0 {
+		return slots
+	}
+	for _, span := range spans {
+		for start := span.Start; start.Before(span.End); start = start.Add(length) {
+			end := start.Add(length)
+			if end.After(span.End) {
+				end = span.End
+			}
+			slots = append(slots, Span{Start: start, End: end})
+		}
+	}
+	return slots
+}
+
+// minutes returns Open and Close as minutes after midnight of the day the
+// interval starts, so Close is past 24*60 for overnight intervals.
+func (iv Interval) minutes() (int, int, error) {
+	open, err := parseClock(iv.Open)
+	if err != nil {
+		return 0, 0, err
+	}
+	if open == 24*60 {
+		return 0, 0, fmt.Errorf("invalid opening time %q", iv.Open)
+	}
+	closing, err := parseClock(iv.Close)
+	if err != nil {
+		return 0, 0, err
+	}
+	if closing == open {
+		return 0, 0, fmt.Errorf("interval %s-%s is empty", iv.Open, iv.Close)
+	}
+	if closing < open {
+		closing += 24 * 60
+	}
+	return open, closing, nil
+}
+
+// parseClock turns "HH:MM" (00:00 to 24:00) into minutes after midnight.
+func parseClock(s string) (int, error) {
+	if s == "24:00" {
+		return 24 * 60, nil
+	}
+	t, err := time.Parse("15:04", s)
+	if err != nil {
+		return 0, fmt.Errorf("invalid time %q, want HH:MM", s)
+	}
+	return t.Hour()*60 + t.Minute(), nil
+}
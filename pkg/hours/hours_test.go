@@ -0,0 +1,204 @@
+package hours
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSchedule_Validate(t *testing.T) {
+	testCases := []struct {
+		name     string
+		schedule Schedule
+		valid    bool
+	}{
+		{
+			name: "split day",
+			schedule: Schedule{
+				Monday: []Interval{{Open: "17:00", Close: "22:00"}, {Open: "11:00", Close: "14:00"}},
+			},
+			valid: true,
+		},
+		{
+			name:     "overnight",
+			schedule: Schedule{Friday: []Interval{{Open: "18:00", Close: "02:00"}}},
+			valid:    true,
+		},
+		{
+			name:     "until midnight",
+			schedule: Schedule{Friday: []Interval{{Open: "18:00", Close: "24:00"}}},
+			valid:    true,
+		},
+		{
+			name:     "overlapping",
+			schedule: Schedule{Monday: []Interval{{Open: "11:00", Close: "15:00"}, {Open: "14:00", Close: "22:00"}}},
+		},
+		{
+			name:     "empty interval",
+			schedule: Schedule{Monday: []Interval{{Open: "11:00", Close: "11:00"}}},
+		},
+		{
+			name:     "opens at 24:00",
+			schedule: Schedule{Monday: []Interval{{Open: "24:00", Close: "02:00"}}},
+		},
+		{
+			name: "holiday",
+			schedule: Schedule{
+				Monday:     []Interval{{Open: "11:00", Close: "22:00"}},
+				Exceptions: []Exception{{Date: "2026-12-25"}, {Date: "2026-12-31", Intervals: []Interval{{Open: "11:00", Close: "16:00"}}}},
+			},
+			valid: true,
+		},
+		{
+			name:     "duplicate exception",
+			schedule: Schedule{Exceptions: []Exception{{Date: "2026-12-25"}, {Date: "2026-12-25"}}},
+		},
+		{
+			name:     "bad exception date",
+			schedule: Schedule{Exceptions: []Exception{{Date: "25/12/2026"}}},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.schedule.Validate()
+			if tc.valid {
+				assert.NoError(t, err)
+			} else {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestSchedule_SpansOn(t *testing.T) {
+	loc, err := time.LoadLocation("America/New_York")
+	require.NoError(t, err)
+	s := Schedule{
+		Friday:   []Interval{{Open: "18:00", Close: "02:00"}},
+		Saturday: []Interval{{Open: "00:00", Close: "24:00"}},
+		Sunday:   []Interval{{Open: "nine", Close: "12:00"}},
+	}
+	// 2026-03-06 is a Friday.
+	friday := time.Date(2026, 3, 6, 0, 0, 0, 0, time.UTC)
+
+	t.Run("overnight", func(t *testing.T) {
+		spans, err := s.SpansOn(friday, loc)
+		require.NoError(t, err)
+		require.Len(t, spans, 1)
+		assert.Equal(t, time.Date(2026, 3, 6, 18, 0, 0, 0, loc), spans[0].Start)
+		assert.Equal(t, time.Date(2026, 3, 7, 2, 0, 0, 0, loc), spans[0].End)
+	})
+
+	t.Run("all day", func(t *testing.T) {
+		spans, err := s.SpansOn(friday.AddDate(0, 0, 1), loc)
+		require.NoError(t, err)
+		require.Len(t, spans, 1)
+		assert.Equal(t, 24*time.Hour, spans[0].End.Sub(spans[0].Start))
+	})
+
+	t.Run("closed", func(t *testing.T) {
+		spans, err := s.SpansOn(friday.AddDate(0, 0, -1), loc)
+		require.NoError(t, err)
+		assert.Empty(t, spans)
+	})
+
+	t.Run("exception", func(t *testing.T) {
+		s := s
+		s.Exceptions = []Exception{{Date: "2026-03-06", Intervals: []Interval{{Open: "12:00", Close: "15:00"}}}}
+		spans, err := s.SpansOn(friday, loc)
+		require.NoError(t, err)
+		require.Len(t, spans, 1)
+		assert.Equal(t, time.Date(2026, 3, 6, 12, 0, 0, 0, loc), spans[0].Start)
+	})
+
+	t.Run("malformed time", func(t *testing.T) {
+		_, err := s.SpansOn(friday.AddDate(0, 0, 2), loc)
+		assert.Error(t, err)
+	})
+}
+
+func TestSchedule_OpenSpanAndNextOpening(t *testing.T) {
+	loc, err := time.LoadLocation("Europe/London")
+	require.NoError(t, err)
+	s := Schedule{
+		Thursday: []Interval{{Open: "11:00", Close: "14:00"}},
+		Friday:   []Interval{{Open: "11:00", Close: "14:00"}, {Open: "18:00", Close: "24:00"}},
+		Saturday: []Interval{{Open: "00:00", Close: "01:00"}, {Open: "11:00", Close: "14:00"}},
+		// Closed on Thursday 2026-03-12.
+		Exceptions: []Exception{{Date: "2026-03-12"}},
+	}
+	at := func(day, hour, min int) time.Time {
+		return time.Date(2026, time.March, day, hour, min, 0, 0, loc)
+	}
+
+	t.Run("open", func(t *testing.T) {
+		span, open, err := s.OpenSpan(at(6, 12, 0), loc)
+		require.NoError(t, err)
+		assert.True(t, open)
+		assert.Equal(t, at(6, 11, 0), span.Start)
+		assert.Equal(t, at(6, 14, 0), span.End)
+	})
+
+	t.Run("back-to-back openings are merged", func(t *testing.T) {
+		span, open, err := s.OpenSpan(at(6, 23, 30), loc)
+		require.NoError(t, err)
+		assert.True(t, open)
+		assert.Equal(t, at(7, 1, 0), span.End)
+	})
+
+	t.Run("closed", func(t *testing.T) {
+		_, open, err := s.OpenSpan(at(6, 15, 0), loc)
+		require.NoError(t, err)
+		assert.False(t, open)
+
+		next, ok, err := s.NextOpening(at(6, 15, 0), loc)
+		require.NoError(t, err)
+		assert.True(t, ok)
+		assert.Equal(t, at(6, 18, 0), next)
+	})
+
+	t.Run("skips holiday", func(t *testing.T) {
+		next, ok, err := s.NextOpening(at(7, 15, 0), loc)
+		require.NoError(t, err)
+		assert.True(t, ok)
+		assert.Equal(t, at(13, 11, 0), next)
+	})
+
+	t.Run("never opens", func(t *testing.T) {
+		_, ok, err := Schedule{}.NextOpening(at(6, 12, 0), loc)
+		require.NoError(t, err)
+		assert.False(t, ok)
+	})
+
+	t.Run("never closes", func(t *testing.T) {
+		day := []Interval{{Open: "00:00", Close: "24:00"}}
+		always := Schedule{Monday: day, Tuesday: day, Wednesday: day, Thursday: day, Friday: day, Saturday: day, Sunday: day}
+		span, open, err := always.OpenSpan(at(6, 12, 0), loc)
+		require.NoError(t, err)
+		assert.True(t, open)
+		assert.True(t, span.End.IsZero())
+	})
+}
+
+func TestSlots(t *testing.T) {
+	start := time.Date(2026, 3, 6, 12, 0, 0, 0, time.UTC)
+	spans := []Span{
+		{Start: start, End: start.Add(40 * time.Minute)},
+		{Start: start.Add(5 * time.Hour), End: start.Add(5*time.Hour + 30*time.Minute)},
+	}
+
+	slots := Slots(spans, 15*time.Minute)
+
+	require.Len(t, slots, 5)
+	assert.Equal(t, Span{Start: start, End: start.Add(15 * time.Minute)}, slots[0])
+	// The last slot of a span is cut short at closing.
+	assert.Equal(t, Span{Start: start.Add(30 * time.Minute), End: start.Add(40 * time.Minute)}, slots[2])
+	assert.Equal(t, start.Add(5*time.Hour), slots[3].Start)
+	assert.True(t, slots[0].Contains(start))
+	assert.False(t, slots[0].Contains(slots[0].End))
+
+	assert.Empty(t, Slots(spans, 0))
+}
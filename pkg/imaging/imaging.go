@@ -0,0 +1,122 @@
+// Package imaging decodes uploaded images and makes thumbnails of them with
+// the standard library alone: JPEG, PNG and GIF are supported.
+package imaging
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"image"
+	"image/draw"
+	_ "image/gif" // registers the GIF decoder
+	"image/jpeg"
+	"image/png"
+	"io"
+)
+
+const (
+	FormatJPEG = "jpeg"
+	FormatPNG  = "png"
+	FormatGIF  = "gif"
+)
+
+var (
+	ErrUnsupported = errors.New("unsupported image format")
+	ErrTooLarge    = errors.New("image has too many pixels")
+)
+
+// Decode decodes data, refusing images of more than maxPixels pixels before
+// decoding them, so a small file cannot expand into a huge bitmap. It
+// returns the image and its format.
+func Decode(data []byte, maxPixels int) (image.Image, string, error) {
+	cfg, format, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return nil, "", fmt.Errorf("%w: %v", ErrUnsupported, err)
+	}
+	if cfg.Width <= 0 || cfg.Height <= 0 {
+		return nil, "", fmt.Errorf("%w: empty image", ErrUnsupported)
+	}
+	if cfg.Width > maxPixels/cfg.Height {
+		return nil, "", fmt.Errorf("%w: %dx%d", ErrTooLarge, cfg.Width, cfg.Height)
+	}
+
+	img, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, "", fmt.Errorf("%w: %v", ErrUnsupported, err)
+	}
+	return img, format, nil
+}
+
+// ContentType is the MIME type of format.
+func ContentType(format string) string {
+	return "image/" + format
+}
+
+// ThumbnailFormat is the format thumbnails of format images are encoded
+// in: JPEG stays JPEG, anything else becomes PNG to keep transparency.
+func ThumbnailFormat(format string) string {
+	if format == FormatJPEG {
+		return FormatJPEG
+	}
+	return FormatPNG
+}
+
+// Thumbnail scales img down to fit in a maxSide square, keeping its aspect
+// ratio, by averaging the pixels each thumbnail pixel covers. Images that
+// already fit are returned as they are.
+func Thumbnail(img image.Image, maxSide int) image.Image {
+	bounds := img.Bounds()
+	srcW, srcH := bounds.Dx(), bounds.Dy()
+	if srcW <= maxSide && srcH <= maxSide {
+		return img
+	}
+	dstW, dstH := maxSide, maxSide
+	if srcW >= srcH {
+		dstH = max(1, srcH*maxSide/srcW)
+	} else {
+		dstW = max(1, srcW*maxSide/srcH)
+	}
+
+	// Average in premultiplied RGBA so transparent pixels do not bleed
+	// their colour into their neighbours.
+	src := image.NewRGBA(image.Rect(0, 0, srcW, srcH))
+	draw.Draw(src, src.Bounds(), img, bounds.Min, draw.Src)
+	dst := image.NewRGBA(image.Rect(0, 0, dstW, dstH))
+
+	for y := 0; y < dstH; y++ {
+		y0, y1 := y*srcH/dstH, max((y+1)*srcH/dstH, y*srcH/dstH+1)
+		for x := 0; x < dstW; x++ {
+			x0, x1 := x*srcW/dstW, max((x+1)*srcW/dstW, x*srcW/dstW+1)
+			var r, g, b, a, n int
+			for sy := y0; sy < y1; sy++ {
+				row := src.Pix[sy*src.Stride:]
+				for sx := x0; sx < x1; sx++ {
+					p := row[sx*4 : sx*4+4]
+					r += int(p[0])
+					g += int(p[1])
+					b += int(p[2])
+					a += int(p[3])
+					n++
+				}
+			}
+			i := dst.PixOffset(x, y)
+			dst.Pix[i+0] = uint8(r / n)
+			dst.Pix[i+1] = uint8(g / n)
+			dst.Pix[i+2] = uint8(b / n)
+			dst.Pix[i+3] = uint8(a / n)
+		}
+	}
+	return dst
+}
+
+// Encode writes img to w in format, JPEG or PNG.
+func Encode(w io.Writer, img image.Image, format string) error {
+	switch format {
+	case FormatJPEG:
+		return jpeg.Encode(w, img, &jpeg.Options{Quality: 85})
+	case FormatPNG:
+		return png.Encode(w, img)
+	default:
+		return fmt.Errorf("%w: cannot encode %s", ErrUnsupported, format)
+	}
+}
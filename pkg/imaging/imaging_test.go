@@ -0,0 +1,83 @@
+package imaging
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"image/png"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func encodePNG(t *testing.T, img image.Image) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	require.NoError(t, png.Encode(&buf, img))
+	return buf.Bytes()
+}
+
+func TestDecode(t *testing.T) {
+	data := encodePNG(t, image.NewRGBA(image.Rect(0, 0, 40, 30)))
+
+	img, format, err := Decode(data, 1200)
+	require.NoError(t, err)
+	assert.Equal(t, FormatPNG, format)
+	assert.Equal(t, image.Rect(0, 0, 40, 30), img.Bounds())
+
+	_, _, err = Decode(data, 1199)
+	assert.ErrorIs(t, err, ErrTooLarge)
+
+	_, _, err = Decode([]byte("<svg></svg>"), 1200)
+	assert.ErrorIs(t, err, ErrUnsupported)
+}
+
+func TestThumbnail(t *testing.T) {
+	testCases := []struct {
+		name          string
+		width, height int
+		expected      image.Rectangle
+	}{
+		{name: "landscape", width: 800, height: 600, expected: image.Rect(0, 0, 320, 240)},
+		{name: "portrait", width: 600, height: 1200, expected: image.Rect(0, 0, 160, 320)},
+		{name: "thin strip", width: 4000, height: 2, expected: image.Rect(0, 0, 320, 1)},
+		{name: "already small", width: 100, height: 50, expected: image.Rect(0, 0, 100, 50)},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			thumb := Thumbnail(image.NewRGBA(image.Rect(0, 0, tc.width, tc.height)), 320)
+			assert.Equal(t, tc.expected, thumb.Bounds())
+		})
+	}
+
+	t.Run("averages pixels", func(t *testing.T) {
+		// Left half black, right half white: the 2x1 thumbnail keeps them.
+		src := image.NewRGBA(image.Rect(0, 0, 4, 2))
+		for y := 0; y < 2; y++ {
+			for x := 0; x < 4; x++ {
+				c := color.RGBA{A: 255}
+				if x >= 2 {
+					c = color.RGBA{R: 255, G: 255, B: 255, A: 255}
+				}
+				src.Set(x, y, c)
+			}
+		}
+		thumb := Thumbnail(src, 2)
+		assert.Equal(t, color.RGBAModel.Convert(color.RGBA{A: 255}), thumb.At(0, 0))
+		assert.Equal(t, color.RGBAModel.Convert(color.RGBA{R: 255, G: 255, B: 255, A: 255}), thumb.At(1, 0))
+	})
+}
+
+func TestEncode(t *testing.T) {
+	img := image.NewRGBA(image.Rect(0, 0, 8, 8))
+	for _, format := range []string{FormatJPEG, FormatPNG} {
+		var buf bytes.Buffer
+		require.NoError(t, Encode(&buf, img, format))
+		_, decoded, err := Decode(buf.Bytes(), 64)
+		require.NoError(t, err)
+		assert.Equal(t, format, decoded)
+	}
+	assert.ErrorIs(t, Encode(&bytes.Buffer{}, img, FormatGIF), ErrUnsupported)
+	assert.Equal(t, FormatPNG, ThumbnailFormat(FormatGIF))
+}
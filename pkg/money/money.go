@@ -0,0 +1,124 @@
+// Package money represents monetary amounts as integer minor units (cents,
+// fils, yen, ...) tagged with an ISO 4217 currency code, so that totals can be
+// summed and taxed without floating point drift.
+package money
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Currency is an upper-case ISO 4217 alphabetic currency code, e.g. "USD".
+type Currency string
+
+// exponents lists every ISO 4217 currency whose minor unit is not 1/100 of
+// the major unit. All other codes use the default exponent of 2.
+var exponents = map[Currency]int{
+	// No minor unit.
+	"BIF": 0, "CLP": 0, "DJF": 0, "GNF": 0, "ISK": 0, "JPY": 0, "KMF": 0,
+	"KRW": 0, "PYG": 0, "RWF": 0, "UGX": 0, "UYI": 0, "VND": 0, "VUV": 0,
+	"XAF": 0, "XOF": 0, "XPF": 0,
+	// Three decimal places.
+	"BHD": 3, "IQD": 3, "JOD": 3, "KWD": 3, "LYD": 3, "OMR": 3, "TND": 3,
+	// Four decimal places.
+	"CLF": 4, "UYW": 4,
+}
+
+const defaultExponent = 2
+
+// ParseCurrency normalises code to upper case and checks that it is
+// shaped like an ISO 4217 code (three ASCII letters). It does not check the
+// code against the ISO registry; request validation does that.
+func ParseCurrency(code string) (Currency, error) {
+	code = strings.ToUpper(strings.TrimSpace(code))
+	if len(code) != 3 {
+		return "", fmt.Errorf("invalid currency code %q", code)
+	}
+	for _, r := range code {
+		if r < 'A' || r > 'Z' {
+			return "", fmt.Errorf("invalid currency code %q", code)
+		}
+	}
+	return Currency(code), nil
+}
+
+// Exponent returns the number of decimal places of the currency's minor unit:
+// 0 for JPY, 2 for USD, 3 for BHD.
+func (c Currency) Exponent() int {
+	if exp, ok := exponents[c]; ok {
+		return exp
+	}
+	return defaultExponent
+}
+
+// Exponents returns a copy of the non-default currency exponents. Codes not
+// in the map use an exponent of 2.
+func Exponents() map[Currency]int {
+	out := make(map[Currency]int, len(exponents))
+	for c, exp := range exponents {
+		out[c] = exp
+	}
+	return out
+}
+
+// DefaultExponent is the exponent used by currencies not listed in Exponents.
+func DefaultExponent() int {
+	return defaultExponent
+}
+
+// Money is an amount in the minor unit of Currency. Its JSON form is
+// {"amount": 1299, "currency": "USD"}, meaning 12.99 USD.
+type Money struct {
+	Amount   int64    `json:"amount"`
+	Currency Currency `json:"currency"`
+}
+
+// New returns amount minor units of currency.
+func New(amount int64, currency Currency) Money {
+	return Money{Amount: amount, Currency: currency}
+}
+
+// String formats m in major units followed by the currency code, e.g.
+// "12.99 USD", "1200 JPY" or "-1.250 BHD".
+func (m Money) String() string {
+	exp := m.Currency.Exponent()
+	sign := ""
+	amount := m.Amount
+	if amount < 0 {
+		sign = "-"
+		amount = -amount
+	}
+	if exp == 0 {
+		return fmt.Sprintf("%s%d %s", sign, amount, m.Currency)
+	}
+	scale := pow10(exp)
+	return fmt.Sprintf("%s%d.%0*d %s", sign, amount/scale, exp, amount%scale, m.Currency)
+}
+
+// ApplyBasisPoints returns amount * bps / 10000, rounded half away from zero.
+// It is used for rates such as tax, where 1000 bps = 10%.
+func ApplyBasisPoints(amount int64, bps int) int64 {
+	return Prorate(amount, int64(bps), 10000)
+}
+
+// Prorate returns amount * part / whole, rounded half away from zero. whole
+// must be positive. It is used to split an amount in proportion, e.g. the
+// share of a line total covered by some of its units.
+func Prorate(amount, part, whole int64) int64 {
+	product := amount * part
+	quotient, remainder := product/whole, product%whole
+	if 2*remainder >= whole {
+		quotient++
+	} else if 2*remainder <= -whole {
+		quotient--
+	}
+	return quotient
+}
+
+func pow10(exp int) int64 {
+	n := int64(1)
+	for i := 0; i < exp; i++ {
+		n *= 10
+	}
+	return n
+}
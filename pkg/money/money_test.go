@@ -0,0 +1,90 @@
+package money
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseCurrency(t *testing.T) {
+	c, err := ParseCurrency(" usd ")
+	require.NoError(t, err)
+	assert.Equal(t, Currency("USD"), c)
+
+	for _, bad := range []string{"", "US", "USDT", "U$D", "123"} {
+		_, err := ParseCurrency(bad)
+		assert.Error(t, err, bad)
+	}
+}
+
+func TestCurrencyExponent(t *testing.T) {
+	assert.Equal(t, 0, Currency("JPY").Exponent())
+	assert.Equal(t, 2, Currency("USD").Exponent())
+	assert.Equal(t, 2, Currency("EUR").Exponent())
+	assert.Equal(t, 3, Currency("BHD").Exponent())
+	assert.Equal(t, 4, Currency("CLF").Exponent())
+}
+
+func TestMoneyString(t *testing.T) {
+	testCases := []struct {
+		money    Money
+		expected string
+	}{
+		{New(1299, "USD"), "12.99 USD"},
+		{New(5, "USD"), "0.05 USD"},
+		{New(-1250, "BHD"), "-1.250 BHD"},
+		{New(1200, "JPY"), "1200 JPY"},
+		{New(0, "EUR"), "0.00 EUR"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.expected, func(t *testing.T) {
+			assert.Equal(t, tc.expected, tc.money.String())
+		})
+	}
+}
+
+func TestMoneyJSON(t *testing.T) {
+	b, err := json.Marshal(New(1299, "USD"))
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"amount":1299,"currency":"USD"}`, string(b))
+}
+
+func TestApplyBasisPoints(t *testing.T) {
+	testCases := []struct {
+		amount   int64
+		bps      int
+		expected int64
+	}{
+		{3192, 1000, 319}, // 319.2
+		{1005, 1000, 101}, // 100.5 rounds up
+		{1004, 1000, 100}, // 100.4 rounds down
+		{-1005, 1000, -101},
+		{999, 0, 0},
+		{999, 10000, 999},
+	}
+
+	for _, tc := range testCases {
+		assert.Equal(t, tc.expected, ApplyBasisPoints(tc.amount, tc.bps), "%d @ %d bps", tc.amount, tc.bps)
+	}
+}
+
+func TestProrate(t *testing.T) {
+	testCases := []struct {
+		amount, part, whole int64
+		expected            int64
+	}{
+		{1000, 1, 3, 333},
+		{1000, 2, 3, 667},
+		{1000, 3, 3, 1000},
+		{5, 1, 2, 3}, // 2.5 rounds up
+		{-5, 1, 2, -3},
+		{0, 1, 7, 0},
+	}
+
+	for _, tc := range testCases {
+		assert.Equal(t, tc.expected, Prorate(tc.amount, tc.part, tc.whole), "%d * %d/%d", tc.amount, tc.part, tc.whole)
+	}
+}
@@ -0,0 +1,162 @@
+// Package ratelimit implements token-bucket rate limits: a bucket holds up
+// to Limit.Requests tokens, refilled evenly over Limit.Period, and each
+// request takes one token or is refused until one has been refilled.
+package ratelimit
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Limit allows Requests requests per Period, in bursts of up to Requests.
+// A zero Limit allows everything.
+type Limit struct {
+	Requests int
+	Period   time.Duration
+}
+
+// ParseLimit parses "N/period", e.g. "20/1m" or "5/30s". An empty string
+// or "0" is the zero Limit.
+func ParseLimit(s string) (Limit, error) {
+	s = strings.TrimSpace(s)
+	if s == "" || s == "0" {
+		return Limit{}, nil
+	}
+	requests, period, ok := strings.Cut(s, "/")
+	if !ok {
+		return Limit{}, fmt.Errorf("invalid rate limit %q, want N/period", s)
+	}
+	n, err := strconv.Atoi(requests)
+	if err != nil || n < 0 {
+		return Limit{}, fmt.Errorf("invalid rate limit %q: bad request count", s)
+	}
+	d, err := time.ParseDuration(period)
+	if err != nil || d <= 0 {
+		return Limit{}, fmt.Errorf("invalid rate limit %q: bad period", s)
+	}
+	if n == 0 {
+		return Limit{}, nil
+	}
+	return Limit{Requests: n, Period: d}, nil
+}
+
+// IsZero reports whether l allows everything.
+func (l Limit) IsZero() bool {
+	return l.Requests <= 0 || l.Period <= 0
+}
+
+func (l Limit) String() string {
+	if l.IsZero() {
+		return "unlimited"
+	}
+	return fmt.Sprintf("%d/%s", l.Requests, l.Period)
+}
+
+// rate is the number of tokens refilled per second.
+func (l Limit) rate() float64 {
+	return float64(l.Requests) / l.Period.Seconds()
+}
+
+// Bucket is the state of one key's bucket: Tokens as of UpdatedAt.
+type Bucket struct {
+	Tokens    float64
+	UpdatedAt time.Time
+}
+
+// Full returns a bucket with every token available at now, the state of a
+// key that has not been seen.
+func (l Limit) Full(now time.Time) Bucket {
+	return Bucket{Tokens: float64(l.Requests), UpdatedAt: now}
+}
+
+// Result is the outcome of taking a token.
+type Result struct {
+	Allowed bool
+	// RetryAfter is how long until a token is available when not Allowed.
+	RetryAfter time.Duration
+}
+
+// Take refills b up to now and takes a token from it if there is one. It
+// returns the bucket to store, the result and when the bucket will be full
+// again, after which it can be forgotten.
+func (l Limit) Take(b Bucket, now time.Time) (Bucket, Result, time.Time) {
+	if l.IsZero() {
+		return b, Result{Allowed: true}, now
+	}
+	rate := l.rate()
+	capacity := float64(l.Requests)
+	elapsed := now.Sub(b.UpdatedAt).Seconds()
+	if elapsed < 0 {
+		elapsed = 0
+	}
+	tokens := math.Min(capacity, b.Tokens+elapsed*rate)
+
+	var result Result
+	if tokens >= 1 {
+		tokens--
+		result.Allowed = true
+	} else {
+		result.RetryAfter = time.Duration((1 - tokens) / rate * float64(time.Second))
+	}
+	next := Bucket{Tokens: tokens, UpdatedAt: now}
+	full := now.Add(time.Duration((capacity - tokens) / rate * float64(time.Second)))
+	return next, result, full
+}
+
+// Store keeps buckets by key.
+type Store interface {
+	// Take takes a token from key's bucket under limit.
+	Take(ctx context.Context, key string, limit Limit) (Result, error)
+}
+
+// sweepInterval is how often MemoryStore forgets full buckets.
+const sweepInterval = time.Minute
+
+// MemoryStore keeps buckets in memory, so each server instance limits on
+// its own.
+type MemoryStore struct {
+	mu        sync.Mutex
+	buckets   map[string]memoryBucket
+	nextSweep time.Time
+	now       func() time.Time
+}
+
+type memoryBucket struct {
+	Bucket
+	full time.Time
+}
+
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{
+		buckets: make(map[string]memoryBucket),
+		now:     time.Now,
+	}
+}
+
+func (s *MemoryStore) Take(_ context.Context, key string, limit Limit) (Result, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := s.now()
+	if now.After(s.nextSweep) {
+		for k, b := range s.buckets {
+			if !b.full.After(now) {
+				delete(s.buckets, k)
+			}
+		}
+		s.nextSweep = now.Add(sweepInterval)
+	}
+
+	b, ok := s.buckets[key]
+	if !ok {
+		b.Bucket = limit.Full(now)
+	}
+	next, result, full := limit.Take(b.Bucket, now)
+	s.buckets[key] = memoryBucket{Bucket: next, full: full}
+	return result, nil
+}
@@ -0,0 +1,95 @@
+package ratelimit
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseLimit(t *testing.T) {
+	testCases := []struct {
+		in       string
+		expected Limit
+		wantErr  bool
+	}{
+		{in: "20/1m", expected: Limit{Requests: 20, Period: time.Minute}},
+		{in: " 5/30s ", expected: Limit{Requests: 5, Period: 30 * time.Second}},
+		{in: "", expected: Limit{}},
+		{in: "0", expected: Limit{}},
+		{in: "0/1m", expected: Limit{}},
+		{in: "20", wantErr: true},
+		{in: "x/1m", wantErr: true},
+		{in: "-1/1m", wantErr: true},
+		{in: "20/minute", wantErr: true},
+		{in: "20/0s", wantErr: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.in, func(t *testing.T) {
+			limit, err := ParseLimit(tc.in)
+			if tc.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, limit)
+		})
+	}
+}
+
+func TestLimit_Take(t *testing.T) {
+	limit := Limit{Requests: 2, Period: time.Minute}
+	now := time.Date(2026, 3, 6, 12, 0, 0, 0, time.UTC)
+
+	b := limit.Full(now)
+	b, result, _ := limit.Take(b, now)
+	assert.True(t, result.Allowed)
+	b, result, full := limit.Take(b, now)
+	assert.True(t, result.Allowed)
+	// Both tokens are refilled a minute later.
+	assert.Equal(t, now.Add(time.Minute), full)
+
+	b, result, _ = limit.Take(b, now.Add(10*time.Second))
+	assert.False(t, result.Allowed)
+	// One token takes 30s to refill, a third of which has passed.
+	assert.Equal(t, 20*time.Second, result.RetryAfter)
+
+	_, result, _ = limit.Take(b, now.Add(30*time.Second))
+	assert.True(t, result.Allowed)
+
+	// Refilling stops at capacity.
+	b, _, _ = limit.Take(limit.Full(now), now.Add(time.Hour))
+	assert.Equal(t, 1.0, b.Tokens)
+
+	_, result, _ = Limit{}.Take(Bucket{}, now)
+	assert.True(t, result.Allowed)
+}
+
+func TestMemoryStore(t *testing.T) {
+	limit := Limit{Requests: 1, Period: time.Minute}
+	now := time.Date(2026, 3, 6, 12, 0, 0, 0, time.UTC)
+	store := NewMemoryStore()
+	store.now = func() time.Time { return now }
+
+	result, err := store.Take(t.Context(), "ip:1", limit)
+	require.NoError(t, err)
+	assert.True(t, result.Allowed)
+
+	result, err = store.Take(t.Context(), "ip:1", limit)
+	require.NoError(t, err)
+	assert.False(t, result.Allowed)
+	assert.Equal(t, time.Minute, result.RetryAfter)
+
+	// Keys have their own buckets.
+	result, err = store.Take(t.Context(), "ip:2", limit)
+	require.NoError(t, err)
+	assert.True(t, result.Allowed)
+
+	// Full buckets are forgotten.
+	now = now.Add(2 * time.Minute)
+	_, err = store.Take(t.Context(), "ip:1", limit)
+	require.NoError(t, err)
+	assert.Len(t, store.buckets, 1)
+}
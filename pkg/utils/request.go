@@ -22,8 +22,13 @@ func ParseAndValidateRequest(c *gin.Context, req any) error {
 		return fmt.Errorf("invalid JSON format: %v", err)
 	}
 
-	// Validate struct
-	if err := validate.Struct(req); err != nil {
+	return ValidateStruct(req)
+}
+
+// ValidateStruct checks v against its validate tags, for input that is not
+// read from a JSON body (e.g. CSV uploads).
+func ValidateStruct(v any) error {
+	if err := validate.Struct(v); err != nil {
 		// Format validation errors nicely
 		var validationErrors []string
 		for _, err := range err.(validator.ValidationErrors) {
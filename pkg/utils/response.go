@@ -1,9 +1,12 @@
 package utils
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
 	"log"
 	"net/http"
+	"strings"
 	"time"
 )
 
@@ -79,3 +82,51 @@ func WriteUnauthorized(w http.ResponseWriter, message string) {
 func WriteNoContent(w http.ResponseWriter) {
 	w.WriteHeader(http.StatusNoContent)
 }
+
+// WriteCacheableSuccess writes data like WriteSuccess, for responses caches
+// may keep: with a strong ETag over the body and the given Cache-Control.
+// The envelope's timestamp is asOf rather than the current time, so the
+// same data always gives the same bytes and ETag. A request whose
+// If-None-Match lists that ETag gets an empty 304 Not Modified instead.
+func WriteCacheableSuccess(w http.ResponseWriter, r *http.Request, data any, asOf time.Time, cacheControl string) {
+	body, err := json.Marshal(APIResponse[any]{
+		Success:   true,
+		Data:      data,
+		Timestamp: asOf,
+	})
+	if err != nil {
+		log.Printf("failed to encode API response: %v", err)
+		WriteInternalError(w, "Failed to encode response")
+		return
+	}
+	body = append(body, '\n')
+
+	sum := sha256.Sum256(body)
+	etag := `"` + hex.EncodeToString(sum[:16]) + `"`
+	w.Header().Set("ETag", etag)
+	w.Header().Set("Cache-Control", cacheControl)
+	if etagMatches(r.Header.Values("If-None-Match"), etag) {
+		w.WriteHeader(http.StatusNotModified)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if _, err := w.Write(body); err != nil {
+		log.Printf("failed to write API response: %v", err)
+	}
+}
+
+// etagMatches reports whether any If-None-Match header value lists etag,
+// using the weak comparison RFC 9110 prescribes for If-None-Match.
+func etagMatches(ifNoneMatch []string, etag string) bool {
+	for _, value := range ifNoneMatch {
+		for _, candidate := range strings.Split(value, ",") {
+			candidate = strings.TrimSpace(candidate)
+			if candidate == "*" || strings.TrimPrefix(candidate, "W/") == etag {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,70 @@
+package utils
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestWriteCacheableSuccess(t *testing.T) {
+	asOf := time.Date(2026, 3, 6, 12, 0, 0, 0, time.UTC)
+	write := func(data any, ifNoneMatch ...string) *httptest.ResponseRecorder {
+		r := httptest.NewRequest(http.MethodGet, "/", nil)
+		for _, v := range ifNoneMatch {
+			r.Header.Add("If-None-Match", v)
+		}
+		w := httptest.NewRecorder()
+		WriteCacheableSuccess(w, r, data, asOf, "public, max-age=60")
+		return w
+	}
+
+	first := write(map[string]int{"a": 1})
+	if first.Code != http.StatusOK {
+		t.Fatalf("expected 200, got %d", first.Code)
+	}
+	etag := first.Header().Get("ETag")
+	if etag == "" || etag[0] != '"' {
+		t.Fatalf("expected a strong ETag, got %q", etag)
+	}
+	if got := first.Header().Get("Cache-Control"); got != "public, max-age=60" {
+		t.Fatalf("unexpected Cache-Control %q", got)
+	}
+
+	again := write(map[string]int{"a": 1})
+	if again.Header().Get("ETag") != etag || again.Body.String() != first.Body.String() {
+		t.Fatal("expected the same data to give the same body and ETag")
+	}
+	if changed := write(map[string]int{"a": 2}); changed.Header().Get("ETag") == etag {
+		t.Fatal("expected different data to give a different ETag")
+	}
+
+	tests := []struct {
+		name        string
+		ifNoneMatch []string
+		expected    int
+	}{
+		{"exact", []string{etag}, http.StatusNotModified},
+		{"in a list", []string{`"stale", ` + etag}, http.StatusNotModified},
+		{"in a second header", []string{`"stale"`, etag}, http.StatusNotModified},
+		{"weak", []string{"W/" + etag}, http.StatusNotModified},
+		{"any", []string{"*"}, http.StatusNotModified},
+		{"stale", []string{`"stale"`}, http.StatusOK},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := write(map[string]int{"a": 1}, tt.ifNoneMatch...)
+
+			if w.Code != tt.expected {
+				t.Fatalf("expected %d, got %d", tt.expected, w.Code)
+			}
+			if w.Code == http.StatusNotModified && w.Body.Len() != 0 {
+				t.Fatal("expected an empty body on 304")
+			}
+			if w.Header().Get("ETag") != etag {
+				t.Fatal("expected the ETag on every response")
+			}
+		})
+	}
+}